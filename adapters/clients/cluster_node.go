@@ -19,8 +19,11 @@ import (
 	"net/url"
 	"path"
 
+	"github.com/go-openapi/strfmt"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
 )
 
 type RemoteNode struct {
@@ -64,3 +67,89 @@ func (c *RemoteNode) GetNodeStatus(ctx context.Context, hostName, className, out
 
 	return &nodeStatus, nil
 }
+
+func (c *RemoteNode) GetTenantStats(ctx context.Context, hostName, className, tenant string) (*schema.TenantStats, error) {
+	p := path.Join("/nodes/tenant-stats", className, tenant)
+	url := url.URL{Scheme: "http", Host: hostName, Path: p}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, enterrors.NewErrOpenHttpRequest(err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, enterrors.NewErrSendHttpRequest(err)
+	}
+
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusOK {
+		return nil, enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+	}
+
+	var stats schema.TenantStats
+	if err := json.Unmarshal(body, &stats); err != nil {
+		return nil, enterrors.NewErrUnmarshalBody(err)
+	}
+
+	return &stats, nil
+}
+
+func (c *RemoteNode) GetObjectVersions(ctx context.Context, hostName, className, shard string,
+	id strfmt.UUID,
+) ([]*storobj.ObjectVersion, error) {
+	p := path.Join("/nodes/object-versions", className, shard, id.String())
+	url := url.URL{Scheme: "http", Host: hostName, Path: p}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, enterrors.NewErrOpenHttpRequest(err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, enterrors.NewErrSendHttpRequest(err)
+	}
+
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusOK {
+		return nil, enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+	}
+
+	var versions []*storobj.ObjectVersion
+	if err := json.Unmarshal(body, &versions); err != nil {
+		return nil, enterrors.NewErrUnmarshalBody(err)
+	}
+
+	return versions, nil
+}
+
+// SetDraining marks a node as draining, so that no new shards are placed on
+// it, or unmarks it
+func (c *RemoteNode) SetDraining(ctx context.Context, hostName string, draining bool) error {
+	method := http.MethodDelete
+	if draining {
+		method = http.MethodPut
+	}
+	url := url.URL{Scheme: "http", Host: hostName, Path: "/nodes/drain"}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return enterrors.NewErrOpenHttpRequest(err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return enterrors.NewErrSendHttpRequest(err)
+	}
+
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusNoContent {
+		return enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+	}
+
+	return nil
+}
@@ -75,6 +75,42 @@ func (c *replicationClient) DigestObjects(ctx context.Context,
 	return resp, err
 }
 
+func (c *replicationClient) HashTreeLeaves(ctx context.Context,
+	host, index, shard string, depth int,
+) ([]uint64, error) {
+	var resp []uint64
+	body, err := json.Marshal(replica.HashTreeRequest{Depth: depth})
+	if err != nil {
+		return nil, fmt.Errorf("marshal hash tree input: %w", err)
+	}
+	req, err := newHttpReplicaRequest(
+		ctx, http.MethodGet, host, index, shard,
+		"", "_hashtree", bytes.NewReader(body))
+	if err != nil {
+		return resp, fmt.Errorf("create http request: %w", err)
+	}
+	err = c.do(c.timeoutUnit*90, req, body, &resp)
+	return resp, err
+}
+
+func (c *replicationClient) LeafDigests(ctx context.Context,
+	host, index, shard string, depth, leaf int,
+) ([]replica.RepairResponse, error) {
+	var resp []replica.RepairResponse
+	body, err := json.Marshal(replica.HashTreeRequest{Depth: depth, Leaf: leaf})
+	if err != nil {
+		return nil, fmt.Errorf("marshal leaf digests input: %w", err)
+	}
+	req, err := newHttpReplicaRequest(
+		ctx, http.MethodGet, host, index, shard,
+		"", "_leafdigest", bytes.NewReader(body))
+	if err != nil {
+		return resp, fmt.Errorf("create http request: %w", err)
+	}
+	err = c.do(c.timeoutUnit*20, req, body, &resp)
+	return resp, err
+}
+
 func (c *replicationClient) OverwriteObjects(ctx context.Context,
 	host, index, shard string, vobjects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
@@ -16,6 +16,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -528,6 +529,42 @@ func TestReplicationDigestObjects(t *testing.T) {
 	assert.Equal(t, expected[1].Version, resp[1].Version)
 }
 
+func TestReplicationHashTree(t *testing.T) {
+	t.Parallel()
+
+	var received []replica.HashTreeRequest
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req replica.HashTreeRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		received = append(received, req)
+		paths = append(paths, r.URL.Path)
+
+		var b []byte
+		if strings.HasSuffix(r.URL.Path, "_hashtree") {
+			b, _ = json.Marshal([]uint64{1, 2})
+		} else {
+			b, _ = json.Marshal([]replica.RepairResponse{{ID: UUID1.String(), UpdateTime: 3}})
+		}
+		w.Write(b)
+	}))
+
+	c := newReplicationClient(server.Client())
+	leaves, err := c.HashTreeLeaves(context.Background(), server.URL[7:], "C1", "S1", 1)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{1, 2}, leaves)
+
+	digests, err := c.LeafDigests(context.Background(), server.URL[7:], "C1", "S1", 1, 1)
+	require.Nil(t, err)
+	assert.Equal(t, []replica.RepairResponse{{ID: UUID1.String(), UpdateTime: 3}}, digests)
+
+	assert.Equal(t, []replica.HashTreeRequest{{Depth: 1}, {Depth: 1, Leaf: 1}}, received)
+	assert.Equal(t, []string{
+		"/replicas/indices/C1/shards/S1/objects/_hashtree",
+		"/replicas/indices/C1/shards/S1/objects/_leafdigest",
+	}, paths)
+}
+
 func TestReplicationOverwriteObjects(t *testing.T) {
 	t.Parallel()
 
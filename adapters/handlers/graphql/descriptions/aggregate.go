@@ -20,6 +20,10 @@ const (
 
 const GroupBy = "Specify which properties to group by"
 
+const EventuallyConsistent = "Answer the meta count and the minimum, maximum and count of numeric and date " +
+	"properties from statistics which are maintained with every write instead of reading the whole index. " +
+	"The statistics may briefly not reflect recent writes. Only applies without filters, grouping and search."
+
 const (
 	AggregatePropertyObject = "An object containing Aggregation information about this property"
 )
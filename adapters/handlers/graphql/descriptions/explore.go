@@ -23,8 +23,16 @@ const (
 	Certainty            = "Normalized Distance between the result item and the search vector. Normalized to be between 0 (identical vectors) and 1 (perfect opposite)."
 	Distance             = "The required degree of similarity between an object's characteristics and the provided filter values"
 	Vector               = "Target vector to be used in kNN search"
+	NearVectorVectors    = "Vectors composed into the target vector as their weighted sum, such as king - man + woman, set instead of vector"
+	NearVectorWeight     = "Weight the vector is added with, a negative weight subtracts it, defaults to 1"
 	Force                = "The force to apply for a particular movements. Must be between 0 and 1 where 0 is equivalent to no movement and 1 is equivalent to largest movement possible"
 	ClassName            = "Name of the Class"
 	ID                   = "Concept identifier in the uuid format"
 	Beacon               = "Concept identifier in the beacon format, such as weaviate://<hostname>/<kind>/id"
+	Recommend            = "Search for objects like the positive and unlike the negative examples, the search vector is the weighted mean of the positive examples minus negativeWeight times the weighted mean of the negative examples"
+	RecommendPositive    = "Examples the results should be similar to, at least one is required"
+	RecommendNegative    = "Examples the results should be dissimilar to"
+	RecommendWeight      = "Weight of the example in the mean of its examples, defaults to 1"
+	RecommendNegWeight   = "Weight of the mean of the negative examples, defaults to 0.5"
+	RecommendExample     = "An example is either an object, by id or beacon, or a vector"
 )
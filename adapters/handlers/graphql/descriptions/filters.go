@@ -66,6 +66,13 @@ const (
 	WhereValueRangeGeoCoordinatesLongitude = "The longitude (in decimal format) of the geoCoordinates to search around."
 	WhereValueRangeDistance                = "The distance from the point specified via geoCoordinates."
 	WhereValueRangeDistanceMax             = "The maximum distance from the point specified geoCoordinates."
+	WhereValueGeoPolygon                   = "Specify the outline of an area as a list of geo-coordinates. The search will return any result which is located within the area, the polygon is closed implicitly."
+	WhereValueGeoPolygonPoints             = "The geoCoordinates of the corners of the polygon, at least three are required."
+	WhereValueGeoBoundingBox               = "Specify a box by its north-west (topLeft) and south-east (bottomRight) corners. The search will return any result which is located within the box."
+	WhereValueGeoBoundingBoxTopLeft        = "The geoCoordinates of the north-west corner of the box."
+	WhereValueGeoBoundingBoxBottomRight    = "The geoCoordinates of the south-east corner of the box."
+	WhereValueGeoLatitude                  = "The latitude (in decimal format) of the geoCoordinates."
+	WhereValueGeoLongitude                 = "The longitude (in decimal format) of the geoCoordinates."
 	WhereValueText                         = "Specify a Text value that the target property will be compared to"
 	WhereValueDate                         = "Specify a Date value that the target property will be compared to"
 )
@@ -144,6 +151,13 @@ const (
 const (
 	SortPath  = "Specify the path from the Objects fields to the property name (e.g. ['Get', 'City', 'population'] leads to the 'population' property of a 'City' object)"
 	SortOrder = "Specify the sort order, either ascending (asc) which is default or descending (desc)"
+
+	SortCollation              = "Specify a locale-aware collation for text properties instead of the default binary ordering"
+	SortCollationLocale        = "The BCP 47 language tag of the collation (e.g. 'de', 'sv-SE')"
+	SortCollationCaseSensitive = "Distinguish between upper and lower case variants of a letter (default false)"
+	SortCollationNumeric       = "Order sequences of digits by their numeric value, so that '2' comes before '10' (default false)"
+
+	SortGeoPoint = "Order a geoCoordinates property by the distance from this point instead, ascending order returns the nearest results first"
 )
 
 const (
@@ -152,3 +166,17 @@ const (
 	GroupByGroups          = "Specify the number of groups to be created"
 	GroupByObjectsPerGroup = "Specify the number of max objects in group"
 )
+
+const (
+	Diversity           = "Re-rank the results of a vector search with Maximal Marginal Relevance to keep near-duplicate objects out of the top results"
+	DiversityLambda     = "Trade-off between relevance (1) and dissimilarity to the results ranked before (0)"
+	DiversityCandidates = "Number of results of the vector search to select from, defaults to 4 times offset plus limit"
+)
+
+const DedupBy = "Collapse the results sharing the values of these properties, keeping the best-ranked one"
+
+const (
+	Facets        = "Count the top values of properties over all results of the query, before the limit is applied. The counts are returned in the facets of the response"
+	FacetProperty = "Property whose values are counted"
+	FacetLimit    = "Number of the most frequent values returned, defaults to 10"
+)
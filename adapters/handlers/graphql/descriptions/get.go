@@ -48,3 +48,26 @@ const ConsistencyLevel = "Determines how many replicas must acknowledge a reques
 	"before it is considered successful. Can be 'ONE', 'QUORUM', or 'ALL'"
 
 const Tenant = "The value by which a tenant is identified, specified in the class schema"
+
+const Tenants = "Search across the listed tenants, or all active tenants if the list contains \"*\". Requires cross-tenant search to be enabled"
+
+const AdditionalTenant = "The tenant the object belongs to"
+
+const AdditionalHighlights = "The terms of the bm25 query or the keyword query of the hybrid search " +
+	"found in the text properties, with their offsets in characters and a snippet around the first matches"
+
+const AdditionalScoreExplanation = "The components of the score of a bm25 or hybrid search result: " +
+	"the contributions of the query terms to the bm25 score, the vector distance, the weighted " +
+	"result sets of the fusion and the reranker score"
+
+const Timeout = "The maximum duration of the query as a duration string, e.g. '500ms' or '2s'. " +
+	"The timeout configured for the class applies as well, whichever is shorter"
+
+const PartialResults = "Return the results of the shards which finished before the timeout instead of failing. " +
+	"The shards which did not finish are reported in the errors of the response"
+
+const Oversampling = "Multiplies the limit of the vector search to get the number of candidates which " +
+	"indexes with compressed vectors rescore with the uncompressed vectors. Must be at least 1"
+
+const Explain = "Report the execution plan and the timings of the search of every shard in the 'explain' field of the response. " +
+	"Use the /v1/graphql/explain endpoint to plan queries without executing them"
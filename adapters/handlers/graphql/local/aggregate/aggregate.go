@@ -126,6 +126,14 @@ func classField(class *models.Class, description string,
 				Type:        graphql.Int,
 			},
 			"hybrid": hybridArgument(fieldsObject, class, modulesProvider),
+			"timeout": &graphql.ArgumentConfig{
+				Description: descriptions.Timeout,
+				Type:        graphql.String,
+			},
+			"eventuallyConsistent": &graphql.ArgumentConfig{
+				Description: descriptions.EventuallyConsistent,
+				Type:        graphql.Boolean,
+			},
 		},
 		Resolve: makeResolveClass(modulesProvider, class),
 	}
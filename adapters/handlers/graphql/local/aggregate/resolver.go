@@ -146,6 +146,13 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		tenant = tk.(string)
 	}
 
+	timeout, err := common_filters.ExtractTimeout(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	eventuallyConsistent, _ := p.Args["eventuallyConsistent"].(bool)
+
 	params := &aggregation.Params{
 		Filters:          filters,
 		ClassName:        className,
@@ -159,6 +166,9 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		ModuleParams:     moduleParams,
 		Hybrid:           hybridParams,
 		Tenant:           tenant,
+		Timeout:          timeout,
+
+		EventuallyConsistent: eventuallyConsistent,
 	}
 
 	// we might support objectLimit without nearMedia filters later, e.g. with sort
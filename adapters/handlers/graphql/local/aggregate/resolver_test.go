@@ -35,6 +35,7 @@ type testCase struct {
 	expectedIncludeMetaCount bool
 	expectedLimit            *int
 	expectedObjectLimit      *int
+	expectedConsistency      bool
 }
 
 type testCases []testCase
@@ -211,6 +212,38 @@ func Test_Resolve(t *testing.T) {
 				},
 			}},
 		},
+		testCase{
+			name:  "eventually consistent",
+			query: `{ Aggregate { Car(eventuallyConsistent:true) { horsepower { maximum } } } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name:        "horsepower",
+					Aggregators: []aggregation.Aggregator{aggregation.MaximumAggregator},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					Properties: map[string]aggregation.Property{
+						"horsepower": {
+							Type: aggregation.PropertyTypeNumerical,
+							NumericalAggregations: map[string]interface{}{
+								"maximum": 500.0,
+							},
+						},
+					},
+				},
+			},
+
+			expectedConsistency: true,
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"horsepower": map[string]interface{}{"maximum": 500.0},
+					},
+				},
+			}},
+		},
 		testCase{
 			name: "with props formerly contained only in Meta",
 			query: `{ Aggregate { Car {
@@ -946,6 +979,8 @@ func (tests testCases) AssertExtraction(t *testing.T, className string) {
 				IncludeMetaCount: testCase.expectedIncludeMetaCount,
 				Limit:            testCase.expectedLimit,
 				ObjectLimit:      testCase.expectedObjectLimit,
+
+				EventuallyConsistent: testCase.expectedConsistency,
 			}
 
 			resolver.On("Aggregate", expectedParams).
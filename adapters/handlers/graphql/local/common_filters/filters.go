@@ -21,25 +21,29 @@ import (
 
 // The filters common to Local->Get and Local->Meta queries.
 func BuildNew(path string) graphql.InputObjectConfigFieldMap {
+	geoCoordinates := newGeoCoordinatesInputObject(path)
 	commonFilters := graphql.InputObjectConfigFieldMap{
 		"operator": &graphql.InputObjectFieldConfig{
 			Type: graphql.NewEnum(graphql.EnumConfig{
 				Name: fmt.Sprintf("%sWhereOperatorEnum", path),
 				Values: graphql.EnumValueConfigMap{
-					"And":              &graphql.EnumValueConfig{},
-					"Like":             &graphql.EnumValueConfig{},
-					"Or":               &graphql.EnumValueConfig{},
-					"Equal":            &graphql.EnumValueConfig{},
-					"Not":              &graphql.EnumValueConfig{},
-					"NotEqual":         &graphql.EnumValueConfig{},
-					"GreaterThan":      &graphql.EnumValueConfig{},
-					"GreaterThanEqual": &graphql.EnumValueConfig{},
-					"LessThan":         &graphql.EnumValueConfig{},
-					"LessThanEqual":    &graphql.EnumValueConfig{},
-					"WithinGeoRange":   &graphql.EnumValueConfig{},
-					"IsNull":           &graphql.EnumValueConfig{},
-					"ContainsAny":      &graphql.EnumValueConfig{},
-					"ContainsAll":      &graphql.EnumValueConfig{},
+					"And":                  &graphql.EnumValueConfig{},
+					"Like":                 &graphql.EnumValueConfig{},
+					"Or":                   &graphql.EnumValueConfig{},
+					"Equal":                &graphql.EnumValueConfig{},
+					"Not":                  &graphql.EnumValueConfig{},
+					"NotEqual":             &graphql.EnumValueConfig{},
+					"GreaterThan":          &graphql.EnumValueConfig{},
+					"GreaterThanEqual":     &graphql.EnumValueConfig{},
+					"LessThan":             &graphql.EnumValueConfig{},
+					"LessThanEqual":        &graphql.EnumValueConfig{},
+					"WithinGeoRange":       &graphql.EnumValueConfig{},
+					"IsNull":               &graphql.EnumValueConfig{},
+					"ContainsAny":          &graphql.EnumValueConfig{},
+					"ContainsAll":          &graphql.EnumValueConfig{},
+					"WithinGeoPolygon":     &graphql.EnumValueConfig{},
+					"WithinGeoBoundingBox": &graphql.EnumValueConfig{},
+					"IsMissing":            &graphql.EnumValueConfig{},
 				},
 				Description: descriptions.WhereOperatorEnum,
 			}),
@@ -77,6 +81,14 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 			Type:        newGeoRangeInputObject(path),
 			Description: descriptions.WhereValueRange,
 		},
+		"valueGeoPolygon": &graphql.InputObjectFieldConfig{
+			Type:        newGeoPolygonInputObject(path, geoCoordinates),
+			Description: descriptions.WhereValueGeoPolygon,
+		},
+		"valueGeoBoundingBox": &graphql.InputObjectFieldConfig{
+			Type:        newGeoBoundingBoxInputObject(path, geoCoordinates),
+			Description: descriptions.WhereValueGeoBoundingBox,
+		},
 	}
 
 	// Recurse into the same time.
@@ -139,3 +151,47 @@ func newGeoRangeDistanceInputObject(path string) *graphql.InputObject {
 		},
 	})
 }
+
+func newGeoCoordinatesInputObject(path string) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoCoordinatesInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"latitude": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: descriptions.WhereValueGeoLatitude,
+			},
+			"longitude": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: descriptions.WhereValueGeoLongitude,
+			},
+		},
+	})
+}
+
+func newGeoPolygonInputObject(path string, geoCoordinates *graphql.InputObject) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoPolygonInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"points": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(geoCoordinates))),
+				Description: descriptions.WhereValueGeoPolygonPoints,
+			},
+		},
+	})
+}
+
+func newGeoBoundingBoxInputObject(path string, geoCoordinates *graphql.InputObject) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoBoundingBoxInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"topLeft": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(geoCoordinates),
+				Description: descriptions.WhereValueGeoBoundingBoxTopLeft,
+			},
+			"bottomRight": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(geoCoordinates),
+				Description: descriptions.WhereValueGeoBoundingBoxBottomRight,
+			},
+		},
+	})
+}
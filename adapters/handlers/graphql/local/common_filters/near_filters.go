@@ -32,10 +32,30 @@ func NearVectorArgument(argumentPrefix, className string) *graphql.ArgumentConfi
 }
 
 func nearVectorFields(prefix string) graphql.InputObjectConfigFieldMap {
+	weightedVector := graphql.NewInputObject(
+		graphql.InputObjectConfig{
+			Name: fmt.Sprintf("%sNearVectorWeightedVectorInpObj", prefix),
+			Fields: graphql.InputObjectConfigFieldMap{
+				"vector": &graphql.InputObjectFieldConfig{
+					Description: descriptions.Vector,
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+				},
+				"weight": &graphql.InputObjectFieldConfig{
+					Description: descriptions.NearVectorWeight,
+					Type:        graphql.Float,
+				},
+			},
+		},
+	)
+
 	return graphql.InputObjectConfigFieldMap{
 		"vector": &graphql.InputObjectFieldConfig{
 			Description: descriptions.Vector,
-			Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+			Type:        graphql.NewList(graphql.Float),
+		},
+		"vectors": &graphql.InputObjectFieldConfig{
+			Description: descriptions.NearVectorVectors,
+			Type:        graphql.NewList(weightedVector),
 		},
 		"certainty": &graphql.InputObjectFieldConfig{
 			Description: descriptions.Certainty,
@@ -21,11 +21,32 @@ import (
 func ExtractNearVector(source map[string]interface{}) (searchparams.NearVector, error) {
 	var args searchparams.NearVector
 
-	// vector is a required argument, so we don't need to check for its existing
-	vector := source["vector"].([]interface{})
-	args.Vector = make([]float32, len(vector))
-	for i, value := range vector {
-		args.Vector[i] = float32(value.(float64))
+	vector, vectorOK := source["vector"]
+	if vectorOK {
+		args.Vector = extractFloat32s(vector)
+	}
+
+	vectors, vectorsOK := source["vectors"]
+	if vectorsOK {
+		list, _ := vectors.([]interface{})
+		args.Vectors = make([]searchparams.WeightedVector, 0, len(list))
+		for _, item := range list {
+			wv, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			// vector is a required field, so we don't need to check for its existing
+			weighted := searchparams.WeightedVector{Vector: extractFloat32s(wv["vector"])}
+			if weight, ok := wv["weight"]; ok {
+				weighted.Weight = weight.(float64)
+			}
+			args.Vectors = append(args.Vectors, weighted)
+		}
+	}
+
+	if vectorOK == vectorsOK {
+		return searchparams.NearVector{},
+			fmt.Errorf("provide exactly one of vector and vectors")
 	}
 
 	certainty, certaintyOK := source["certainty"]
@@ -46,3 +67,12 @@ func ExtractNearVector(source map[string]interface{}) (searchparams.NearVector,
 
 	return args, nil
 }
+
+func extractFloat32s(source interface{}) []float32 {
+	values, _ := source.([]interface{})
+	out := make([]float32, len(values))
+	for i, value := range values {
+		out[i] = float32(value.(float64))
+	}
+	return out
+}
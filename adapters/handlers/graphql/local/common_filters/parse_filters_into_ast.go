@@ -134,6 +134,12 @@ func (c *converter) do(in *WhereFilter) (*models.WhereFilter, error) {
 	if in.ValueGeoRange != nil {
 		whereFilter.ValueGeoRange = in.ValueGeoRange
 	}
+	if in.ValueGeoPolygon != nil {
+		whereFilter.ValueGeoPolygon = in.ValueGeoPolygon
+	}
+	if in.ValueGeoBoundingBox != nil {
+		whereFilter.ValueGeoBoundingBox = in.ValueGeoBoundingBox
+	}
 
 	// recursively build operands
 	for i, op := range in.Operands {
@@ -163,14 +169,16 @@ func (c *converter) parseString(in interface{}) (value *string, valueArray []str
 }
 
 type WhereFilter struct {
-	Operands      []*WhereFilter              `json:"operands"`
-	Operator      string                      `json:"operator,omitempty"`
-	Path          []string                    `json:"path"`
-	ValueBoolean  interface{}                 `json:"valueBoolean,omitempty"`
-	ValueDate     interface{}                 `json:"valueDate,omitempty"`
-	ValueInt      interface{}                 `json:"valueInt,omitempty"`
-	ValueNumber   interface{}                 `json:"valueNumber,omitempty"`
-	ValueString   interface{}                 `json:"valueString,omitempty"`
-	ValueText     interface{}                 `json:"valueText,omitempty"`
-	ValueGeoRange *models.WhereFilterGeoRange `json:"valueGeoRange,omitempty"`
+	Operands            []*WhereFilter                    `json:"operands"`
+	Operator            string                            `json:"operator,omitempty"`
+	Path                []string                          `json:"path"`
+	ValueBoolean        interface{}                       `json:"valueBoolean,omitempty"`
+	ValueDate           interface{}                       `json:"valueDate,omitempty"`
+	ValueInt            interface{}                       `json:"valueInt,omitempty"`
+	ValueNumber         interface{}                       `json:"valueNumber,omitempty"`
+	ValueString         interface{}                       `json:"valueString,omitempty"`
+	ValueText           interface{}                       `json:"valueText,omitempty"`
+	ValueGeoRange       *models.WhereFilterGeoRange       `json:"valueGeoRange,omitempty"`
+	ValueGeoPolygon     *models.WhereFilterGeoPolygon     `json:"valueGeoPolygon,omitempty"`
+	ValueGeoBoundingBox *models.WhereFilterGeoBoundingBox `json:"valueGeoBoundingBox,omitempty"`
 }
@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func RecommendArgument(argumentPrefix, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("%s%s", argumentPrefix, className)
+	example := graphql.NewInputObject(
+		graphql.InputObjectConfig{
+			Name:        fmt.Sprintf("%sRecommendExampleInpObj", prefix),
+			Description: descriptions.RecommendExample,
+			Fields: graphql.InputObjectConfigFieldMap{
+				"id": &graphql.InputObjectFieldConfig{
+					Description: descriptions.ID,
+					Type:        graphql.String,
+				},
+				"beacon": &graphql.InputObjectFieldConfig{
+					Description: descriptions.Beacon,
+					Type:        graphql.String,
+				},
+				"vector": &graphql.InputObjectFieldConfig{
+					Description: descriptions.Vector,
+					Type:        graphql.NewList(graphql.Float),
+				},
+				"weight": &graphql.InputObjectFieldConfig{
+					Description: descriptions.RecommendWeight,
+					Type:        graphql.Float,
+				},
+			},
+		},
+	)
+
+	return &graphql.ArgumentConfig{
+		Description: descriptions.Recommend,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sRecommendInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"positive": &graphql.InputObjectFieldConfig{
+						Description: descriptions.RecommendPositive,
+						Type:        graphql.NewNonNull(graphql.NewList(example)),
+					},
+					"negative": &graphql.InputObjectFieldConfig{
+						Description: descriptions.RecommendNegative,
+						Type:        graphql.NewList(example),
+					},
+					"negativeWeight": &graphql.InputObjectFieldConfig{
+						Description: descriptions.RecommendNegWeight,
+						Type:        graphql.Float,
+					},
+					"certainty": &graphql.InputObjectFieldConfig{
+						Description: descriptions.Certainty,
+						Type:        graphql.Float,
+					},
+					"distance": &graphql.InputObjectFieldConfig{
+						Description: descriptions.Distance,
+						Type:        graphql.Float,
+					},
+				},
+			},
+		),
+	}
+}
+
+// ExtractRecommend arguments, such as "positive" and "negative" examples
+func ExtractRecommend(source map[string]interface{}) (searchparams.Recommend, error) {
+	var args searchparams.Recommend
+
+	// positive is a required argument, so we don't need to check for its existing
+	args.Positive = extractRecommendExamples(source["positive"])
+	if negative, ok := source["negative"]; ok {
+		args.Negative = extractRecommendExamples(negative)
+	}
+
+	if negativeWeight, ok := source["negativeWeight"]; ok {
+		args.NegativeWeight = negativeWeight.(float64)
+	}
+
+	certainty, certaintyOK := source["certainty"]
+	if certaintyOK {
+		args.Certainty = certainty.(float64)
+	}
+
+	distance, distanceOK := source["distance"]
+	if distanceOK {
+		args.Distance = distance.(float64)
+		args.WithDistance = true
+	}
+
+	if certaintyOK && distanceOK {
+		return searchparams.Recommend{},
+			fmt.Errorf("cannot provide distance and certainty")
+	}
+
+	return args, nil
+}
+
+func extractRecommendExamples(source interface{}) []searchparams.RecommendExample {
+	list, _ := source.([]interface{})
+	examples := make([]searchparams.RecommendExample, 0, len(list))
+	for _, item := range list {
+		example, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var ex searchparams.RecommendExample
+		if id, ok := example["id"]; ok {
+			ex.ID = id.(string)
+		}
+		if beacon, ok := example["beacon"]; ok {
+			ex.Beacon = beacon.(string)
+		}
+		if vector, ok := example["vector"]; ok {
+			values := vector.([]interface{})
+			ex.Vector = make([]float32, len(values))
+			for i, value := range values {
+				ex.Vector[i] = float32(value.(float64))
+			}
+		}
+		if weight, ok := example["weight"]; ok {
+			ex.Weight = weight.(float64)
+		}
+		examples = append(examples, ex)
+	}
+	return examples
+}
@@ -0,0 +1,34 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+	"time"
+)
+
+// ExtractTimeout parses the optional timeout argument of a query, it is 0
+// if the argument is not set
+func ExtractTimeout(args map[string]interface{}) (time.Duration, error) {
+	raw, ok := args["timeout"]
+	if !ok {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(raw.(string))
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout: %w", err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("invalid timeout: must be positive, got %s", timeout)
+	}
+	return timeout, nil
+}
@@ -160,10 +160,15 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["lastUpdateTimeUnix"] = b.additionalLastUpdateTimeUnix()
 	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["explainScore"] = b.additionalExplainScoreField()
+	additionalProperties["highlights"] = b.additionalHighlightsField(class)
+	additionalProperties["scoreExplanation"] = b.additionalScoreExplanationField(class)
 	additionalProperties["group"] = b.additionalGroupField(classProperties, class)
 	if replicationEnabled(class) {
 		additionalProperties["isConsistent"] = b.isConsistentField()
 	}
+	if schema.MultiTenancyEnabled(class) {
+		additionalProperties["tenant"] = b.additionalTenantField()
+	}
 	// module specific additional properties
 	if b.modulesProvider != nil {
 		for name, field := range b.modulesProvider.GetAdditionalFields(class) {
@@ -236,12 +241,81 @@ func (b *classBuilder) additionalExplainScoreField() *graphql.Field {
 	}
 }
 
+func (b *classBuilder) additionalScoreExplanationField(class *models.Class) *graphql.Field {
+	terms := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%sAdditionalScoreExplanationTerms", class.Class),
+		Fields: graphql.Fields{
+			"term":       &graphql.Field{Type: graphql.String},
+			"frequency":  &graphql.Field{Type: graphql.Float},
+			"propLength": &graphql.Field{Type: graphql.Float},
+			"idf":        &graphql.Field{Type: graphql.Float},
+			"score":      &graphql.Field{Type: graphql.Float},
+		},
+	})
+	components := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%sAdditionalScoreExplanationComponents", class.Class),
+		Fields: graphql.Fields{
+			"name":            &graphql.Field{Type: graphql.String},
+			"weight":          &graphql.Field{Type: graphql.Float},
+			"rank":            &graphql.Field{Type: graphql.Int},
+			"originalScore":   &graphql.Field{Type: graphql.Float},
+			"normalizedScore": &graphql.Field{Type: graphql.Float},
+			"contribution":    &graphql.Field{Type: graphql.Float},
+		},
+	})
+	return &graphql.Field{
+		Description: descriptions.AdditionalScoreExplanation,
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalScoreExplanation", class.Class),
+			Fields: graphql.Fields{
+				"score":           &graphql.Field{Type: graphql.Float},
+				"terms":           &graphql.Field{Type: graphql.NewList(terms)},
+				"distance":        &graphql.Field{Type: graphql.Float},
+				"fusionAlgorithm": &graphql.Field{Type: graphql.String},
+				"components":      &graphql.Field{Type: graphql.NewList(components)},
+				"rerankerScore":   &graphql.Field{Type: graphql.Float},
+			},
+		}),
+	}
+}
+
+func (b *classBuilder) additionalHighlightsField(class *models.Class) *graphql.Field {
+	match := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%sAdditionalHighlightsMatches", class.Class),
+		Fields: graphql.Fields{
+			"term":  &graphql.Field{Type: graphql.String},
+			"start": &graphql.Field{Type: graphql.Int},
+			"end":   &graphql.Field{Type: graphql.Int},
+		},
+	})
+	return &graphql.Field{
+		Description: descriptions.AdditionalHighlights,
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalHighlights", class.Class),
+			Fields: graphql.Fields{
+				"property":     &graphql.Field{Type: graphql.String},
+				"index":        &graphql.Field{Type: graphql.Int},
+				"snippet":      &graphql.Field{Type: graphql.String},
+				"snippetStart": &graphql.Field{Type: graphql.Int},
+				"matches":      &graphql.Field{Type: graphql.NewList(match)},
+			},
+		})),
+	}
+}
+
 func (b *classBuilder) additionalLastUpdateTimeUnix() *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.String,
 	}
 }
 
+func (b *classBuilder) additionalTenantField() *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.AdditionalTenant,
+		Type:        graphql.String,
+	}
+}
+
 func (b *classBuilder) isConsistentField() *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.Boolean,
@@ -228,9 +228,32 @@ func buildGetClassField(classObject *graphql.Object,
 			"sort":       sortArgument(class.Class),
 			"nearVector": nearVectorArgument(class.Class),
 			"nearObject": nearObjectArgument(class.Class),
+			"recommend":  recommendArgument(class.Class),
 			"where":      whereArgument(class.Class),
 			"group":      groupArgument(class.Class),
 			"groupBy":    groupByArgument(class.Class),
+			"diversity":  diversityArgument(class.Class),
+			"facets":     facetsArgument(class.Class),
+			"dedupBy": &graphql.ArgumentConfig{
+				Description: descriptions.DedupBy,
+				Type:        graphql.NewList(graphql.String),
+			},
+			"timeout": &graphql.ArgumentConfig{
+				Description: descriptions.Timeout,
+				Type:        graphql.String,
+			},
+			"partialResults": &graphql.ArgumentConfig{
+				Description: descriptions.PartialResults,
+				Type:        graphql.Boolean,
+			},
+			"explain": &graphql.ArgumentConfig{
+				Description: descriptions.Explain,
+				Type:        graphql.Boolean,
+			},
+			"oversampling": &graphql.ArgumentConfig{
+				Description: descriptions.Oversampling,
+				Type:        graphql.Float,
+			},
 		},
 		Resolve: newResolver(modulesProvider).makeResolveGetClass(class.Class),
 	}
@@ -250,6 +273,7 @@ func buildGetClassField(classObject *graphql.Object,
 
 	if schema.MultiTenancyEnabled(class) {
 		field.Args["tenant"] = tenantArgument()
+		field.Args["tenants"] = tenantsArgument()
 	}
 
 	return field
@@ -387,6 +411,15 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		nearObjectParams = &p
 	}
 
+	var recommendParams *searchparams.Recommend
+	if recommend, ok := p.Args["recommend"]; ok {
+		p, err := common_filters.ExtractRecommend(recommend.(map[string]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract recommend params: %s", err)
+		}
+		recommendParams = &p
+	}
+
 	var moduleParams map[string]interface{}
 	if r.modulesProvider != nil {
 		extractedParams := r.modulesProvider.ExtractSearchParams(p.Args, className)
@@ -401,7 +434,8 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		if len(sort) > 0 {
 			return nil, fmt.Errorf("bm25 search is not compatible with sort")
 		}
-		p := common_filters.ExtractBM25(bm25.(map[string]interface{}), addlProps.ExplainScore)
+		p := common_filters.ExtractBM25(bm25.(map[string]interface{}),
+			addlProps.ExplainScore || addlProps.ScoreExplanation)
 		keywordRankingParams = &p
 	}
 
@@ -413,7 +447,8 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		if len(sort) > 0 {
 			return nil, fmt.Errorf("hybrid search is not compatible with sort")
 		}
-		p, err := common_filters.ExtractHybridSearch(hybrid.(map[string]interface{}), addlProps.ExplainScore)
+		p, err := common_filters.ExtractHybridSearch(hybrid.(map[string]interface{}),
+			addlProps.ExplainScore || addlProps.ScoreExplanation)
 		if err != nil {
 			return nil, fmt.Errorf("failed to extract hybrid params: %w", err)
 		}
@@ -435,11 +470,59 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		groupByParams = &p
 	}
 
+	var diversityParams *searchparams.Diversity
+	if diversity, ok := p.Args["diversity"]; ok {
+		p := extractDiversity(diversity.(map[string]interface{}))
+		diversityParams = &p
+	}
+
+	var dedupBy []string
+	if props, ok := p.Args["dedupBy"]; ok {
+		for _, prop := range props.([]interface{}) {
+			dedupBy = append(dedupBy, schema.LowercaseFirstLetter(prop.(string)))
+		}
+	}
+
+	var facets []searchparams.Facet
+	if facetsArg, ok := p.Args["facets"]; ok {
+		facets = extractFacets(facetsArg.([]interface{}))
+	}
+
 	var tenant string
 	if tk, ok := p.Args["tenant"]; ok {
 		tenant = tk.(string)
 	}
 
+	var tenants []string
+	if tk, ok := p.Args["tenants"]; ok {
+		for _, t := range tk.([]interface{}) {
+			tenants = append(tenants, t.(string))
+		}
+	}
+
+	timeout, err := common_filters.ExtractTimeout(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var partialResults bool
+	if pr, ok := p.Args["partialResults"]; ok {
+		partialResults = pr.(bool)
+	}
+
+	var explain bool
+	if e, ok := p.Args["explain"]; ok {
+		explain = e.(bool)
+	}
+
+	var oversampling float64
+	if o, ok := p.Args["oversampling"]; ok {
+		oversampling = o.(float64)
+		if oversampling < 1 {
+			return nil, fmt.Errorf("oversampling must be at least 1, got %v", oversampling)
+		}
+	}
+
 	params := dto.GetParams{
 		Filters:               filters,
 		ClassName:             className,
@@ -449,6 +532,7 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		Sort:                  sort,
 		NearVector:            nearVectorParams,
 		NearObject:            nearObjectParams,
+		Recommend:             recommendParams,
 		Group:                 group,
 		ModuleParams:          moduleParams,
 		AdditionalProperties:  addlProps,
@@ -456,7 +540,15 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		HybridSearch:          hybridParams,
 		ReplicationProperties: replProps,
 		GroupBy:               groupByParams,
+		Diversity:             diversityParams,
+		DedupBy:               dedupBy,
+		Facets:                facets,
 		Tenant:                tenant,
+		Tenants:               tenants,
+		Timeout:               timeout,
+		PartialResults:        partialResults,
+		Explain:               explain,
+		Oversampling:          oversampling,
 	}
 
 	// need to perform vector search by distance
@@ -504,6 +596,12 @@ func setLimitBasedOnVectorSearchParams(params *dto.GetParams) {
 		return
 	}
 
+	if params.Recommend != nil &&
+		(params.Recommend.Certainty != 0 || params.Recommend.WithDistance) {
+		setLimit(params)
+		return
+	}
+
 	for _, param := range params.ModuleParams {
 		nearParam, ok := param.(modulecapabilities.NearParam)
 		if ok && nearParam.SimilarityMetricProvided() {
@@ -565,8 +663,8 @@ func (ac *additionalCheck) isAdditional(parentName, name string) bool {
 		if name == "classification" || name == "certainty" ||
 			name == "distance" || name == "id" || name == "vector" ||
 			name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
-			name == "score" || name == "explainScore" || name == "isConsistent" ||
-			name == "group" {
+			name == "score" || name == "explainScore" || name == "scoreExplanation" || name == "highlights" || name == "isConsistent" ||
+			name == "group" || name == "tenant" {
 			return true
 		}
 		if ac.isModuleAdditional(name) {
@@ -660,6 +758,14 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.ExplainScore = true
 							continue
 						}
+						if additionalProperty == "scoreExplanation" {
+							additionalProps.ScoreExplanation = true
+							continue
+						}
+						if additionalProperty == "highlights" {
+							additionalProps.Highlights = true
+							continue
+						}
 						if additionalProperty == "lastUpdateTimeUnix" {
 							additionalProps.LastUpdateTimeUnix = true
 							continue
@@ -668,6 +774,10 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.IsConsistent = true
 							continue
 						}
+						if additionalProperty == "tenant" {
+							additionalProps.Tenant = true
+							continue
+						}
 						if additionalProperty == "group" {
 							additionalProps.Group = true
 							additionalGroupHitProperties, err := extractGroupHitProperties(className, additionalProps, subSelection, fragments, modulesProvider)
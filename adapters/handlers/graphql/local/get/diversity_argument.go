@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func diversityArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sDiversityInpObj", prefix),
+				Fields:      diversityFields(),
+				Description: descriptions.Diversity,
+			},
+		),
+	}
+}
+
+func diversityFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"lambda": &graphql.InputObjectFieldConfig{
+			Description: descriptions.DiversityLambda,
+			Type:        graphql.NewNonNull(graphql.Float),
+		},
+		"candidates": &graphql.InputObjectFieldConfig{
+			Description: descriptions.DiversityCandidates,
+			Type:        graphql.Int,
+		},
+	}
+}
+
+func extractDiversity(source map[string]interface{}) searchparams.Diversity {
+	var args searchparams.Diversity
+
+	// lambda is a required argument, so we don't need to check for its existing
+	args.Lambda = source["lambda"].(float64)
+	if candidates, ok := source["candidates"]; ok {
+		args.Candidates = candidates.(int)
+	}
+	return args
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func facetsArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.Facets,
+		Type: graphql.NewList(graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sFacetInpObj", prefix),
+				Fields: facetFields(),
+			},
+		)),
+	}
+}
+
+func facetFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"property": &graphql.InputObjectFieldConfig{
+			Description: descriptions.FacetProperty,
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"limit": &graphql.InputObjectFieldConfig{
+			Description: descriptions.FacetLimit,
+			Type:        graphql.Int,
+		},
+	}
+}
+
+func extractFacets(source []interface{}) []searchparams.Facet {
+	facets := make([]searchparams.Facet, 0, len(source))
+	for _, item := range source {
+		facet, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// property is a required argument, so we don't need to check for its existing
+		f := searchparams.Facet{Property: schema.LowercaseFirstLetter(facet["property"].(string))}
+		if limit, ok := facet["limit"]; ok {
+			f.Limit = limit.(int)
+		}
+		facets = append(facets, f)
+	}
+	return facets
+}
@@ -411,6 +411,78 @@ func TestExtractAdditionalFields(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "with _additional scoreExplanation",
+			query: "{ Get { SomeAction { _additional { scoreExplanation { score distance rerankerScore fusionAlgorithm terms { term score } components { name rank contribution } } } } } }",
+			expectedParams: dto.GetParams{
+				ClassName: "SomeAction",
+				AdditionalProperties: additional.Properties{
+					ScoreExplanation: true,
+				},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"scoreExplanation": &additional.ScoreExplanation{
+							Score:           0.5,
+							Distance:        ptFloat32(0.25),
+							FusionAlgorithm: "rankedFusion",
+							Terms:           []additional.TermScore{{Term: "fox", Score: 1.5}},
+							Components:      []additional.ScoreComponent{{Name: "keyword", Rank: 1, Contribution: 0.5}},
+						},
+					},
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"_additional": map[string]interface{}{
+					"scoreExplanation": map[string]interface{}{
+						"score":           float32(0.5),
+						"distance":        float32(0.25),
+						"rerankerScore":   nil,
+						"fusionAlgorithm": "rankedFusion",
+						"terms": []interface{}{
+							map[string]interface{}{"term": "fox", "score": 1.5},
+						},
+						"components": []interface{}{
+							map[string]interface{}{"name": "keyword", "rank": 1, "contribution": float32(0.5)},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "with _additional highlights",
+			query: "{ Get { SomeAction { _additional { highlights { property snippet matches { term start end } } } } } }",
+			expectedParams: dto.GetParams{
+				ClassName: "SomeAction",
+				AdditionalProperties: additional.Properties{
+					Highlights: true,
+				},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"highlights": []additional.Highlight{{
+							Property: "intField", Snippet: "hello world",
+							Matches: []additional.HighlightMatch{{Term: "world", Start: 6, End: 11}},
+						}},
+					},
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"_additional": map[string]interface{}{
+					"highlights": []interface{}{
+						map[string]interface{}{
+							"property": "intField",
+							"snippet":  "hello world",
+							"matches": []interface{}{
+								map[string]interface{}{"term": "world", "start": 6, "end": 11},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:  "with _additional vector",
 			query: "{ Get { SomeAction { _additional { vector } } } }",
@@ -1346,6 +1418,80 @@ func TestNearVectorRanker(t *testing.T) {
 	})
 }
 
+func TestNearVectorComposed(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("with weighted vectors", func(t *testing.T) {
+		query := `{ Get { SomeAction(nearVector: {
+								vectors: [
+									{vector: [0.5, 0.5]}
+									{vector: [0.25, 0], weight: -1}
+								]
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vectors: []searchparams.WeightedVector{
+					{Vector: []float32{0.5, 0.5}},
+					{Vector: []float32{0.25, 0}, Weight: -1},
+				},
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with both vector and vectors", func(t *testing.T) {
+		query := `{ Get { SomeAction(nearVector: {
+								vector: [0.123, 0.984]
+								vectors: [{vector: [0.5, 0.5]}]
+							}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query, "failed to extract nearVector params: provide exactly one of vector and vectors")
+	})
+}
+
+func TestNearVectorOversampling(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("with an oversampling factor", func(t *testing.T) {
+		query := `{ Get { SomeAction(nearVector: {
+								vector: [0.123, 0.984]
+							}, oversampling: 2.5) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{0.123, 0.984},
+			},
+			Oversampling: 2.5,
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with an oversampling factor below 1", func(t *testing.T) {
+		query := `{ Get { SomeAction(nearVector: {
+								vector: [0.123, 0.984]
+							}, oversampling: 0.5) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query, "oversampling must be at least 1, got 0.5")
+	})
+}
+
 func TestExtractPagination(t *testing.T) {
 	t.Parallel()
 
@@ -1815,6 +1961,153 @@ func TestNearObject(t *testing.T) {
 	})
 }
 
+func TestRecommend(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("with positive and negative examples", func(t *testing.T) {
+		query := `{ Get { SomeThing(
+						recommend: {
+							positive: [{id: "some-uuid", weight: 2}, {vector: [1, 0]}]
+							negative: [{beacon: "weaviate://localhost/SomeAction/some-other-uuid"}]
+							negativeWeight: 0.25
+						}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Recommend: &searchparams.Recommend{
+				Positive: []searchparams.RecommendExample{
+					{ID: "some-uuid", Weight: 2},
+					{Vector: []float32{1, 0}},
+				},
+				Negative: []searchparams.RecommendExample{
+					{Beacon: "weaviate://localhost/SomeAction/some-other-uuid"},
+				},
+				NegativeWeight: 0.25,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with distance and limit set", func(t *testing.T) {
+		query := `{ Get { SomeThing(
+						limit: -1
+						recommend: {
+							positive: [{id: "some-uuid"}]
+							distance: 0.3
+						}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Pagination: &filters.Pagination{Limit: filters.LimitFlagSearchByDist},
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Recommend: &searchparams.Recommend{
+				Positive:     []searchparams.RecommendExample{{ID: "some-uuid"}},
+				Negative:     nil,
+				Distance:     0.3,
+				WithDistance: true,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with distance and certainty", func(t *testing.T) {
+		query := `{ Get { SomeThing(
+						recommend: {
+							positive: [{id: "some-uuid"}]
+							distance: 0.3
+							certainty: 0.7
+						}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
+func TestDiversity(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("with lambda and candidates", func(t *testing.T) {
+		query := `{ Get { SomeThing(
+						nearVector: {vector: [1, 0]}
+						diversity: {lambda: 0.7, candidates: 50}
+						limit: 5) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Pagination: &filters.Pagination{Limit: 5},
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{Vector: []float32{1, 0}},
+			Diversity:  &searchparams.Diversity{Lambda: 0.7, Candidates: 50},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("without lambda", func(t *testing.T) {
+		query := `{ Get { SomeThing(
+						nearVector: {vector: [1, 0]}
+						diversity: {candidates: 50}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
+func TestDedupBy(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	query := `{ Get { SomeThing(
+					nearVector: {vector: [1, 0]}
+					dedupBy: ["IntField"]
+					limit: 5) { intField } } }`
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeThing",
+		Pagination: &filters.Pagination{Limit: 5},
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		NearVector: &searchparams.NearVector{Vector: []float32{1, 0}},
+		DedupBy:    []string{"intField"},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
+func TestFacets(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	query := `{ Get { SomeThing(
+					facets: [{property: "IntField", limit: 3}, {property: "name"}]
+					limit: 5) { intField } } }`
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeThing",
+		Pagination: &filters.Pagination{Limit: 5},
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		Facets:     []searchparams.Facet{{Property: "intField", Limit: 3}, {Property: "name"}},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
 func TestNearTextNoNoModules(t *testing.T) {
 	t.Parallel()
 
@@ -2241,6 +2534,51 @@ func TestSort(t *testing.T) {
 
 				tt.resolver.AssertResolve(t, query)
 			})
+
+			t.Run("sort with collation", func(t *testing.T) {
+				query := `{ Get { SomeAction(sort:[{
+										path: ["name"] order: asc
+										collation: {locale: "sv-SE", numeric: true}
+									}]) { intField } } }`
+
+				expectedParams := dto.GetParams{
+					ClassName:  "SomeAction",
+					Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+					Sort: []filters.Sort{{
+						Path:      []string{"name"},
+						Order:     "asc",
+						Collation: &filters.Collation{Locale: "sv-SE", Numeric: true},
+					}},
+				}
+
+				tt.resolver.On("GetClass", expectedParams).
+					Return([]interface{}{}, nil).Once()
+
+				tt.resolver.AssertResolve(t, query)
+			})
+
+			t.Run("sort by distance from a geo point", func(t *testing.T) {
+				query := `{ Get { SomeAction(sort:[{
+										path: ["location"] order: asc
+										geoPoint: {latitude: 52.5, longitude: 13.25}
+									}]) { intField } } }`
+
+				lat, lon := float32(52.5), float32(13.25)
+				expectedParams := dto.GetParams{
+					ClassName:  "SomeAction",
+					Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+					Sort: []filters.Sort{{
+						Path:     []string{"location"},
+						Order:    "asc",
+						GeoPoint: &models.GeoCoordinates{Latitude: &lat, Longitude: &lon},
+					}},
+				}
+
+				tt.resolver.On("GetClass", expectedParams).
+					Return([]interface{}{}, nil).Once()
+
+				tt.resolver.AssertResolve(t, query)
+			})
 		})
 	}
 }
@@ -22,3 +22,10 @@ func tenantArgument() *graphql.ArgumentConfig {
 		Type:        graphql.String,
 	}
 }
+
+func tenantsArgument() *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Description: descriptions.Tenants,
+		Type:        graphql.NewList(graphql.String),
+	}
+}
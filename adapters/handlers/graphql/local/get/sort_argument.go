@@ -49,5 +49,41 @@ func sortFields(prefix string) graphql.InputObjectConfigFieldMap {
 				},
 			}),
 		},
+		"collation": &graphql.InputObjectFieldConfig{
+			Description: descriptions.SortCollation,
+			Type: graphql.NewInputObject(graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sSortInpObjCollationInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"locale": &graphql.InputObjectFieldConfig{
+						Description: descriptions.SortCollationLocale,
+						Type:        graphql.NewNonNull(graphql.String),
+					},
+					"caseSensitive": &graphql.InputObjectFieldConfig{
+						Description: descriptions.SortCollationCaseSensitive,
+						Type:        graphql.Boolean,
+					},
+					"numeric": &graphql.InputObjectFieldConfig{
+						Description: descriptions.SortCollationNumeric,
+						Type:        graphql.Boolean,
+					},
+				},
+			}),
+		},
+		"geoPoint": &graphql.InputObjectFieldConfig{
+			Description: descriptions.SortGeoPoint,
+			Type: graphql.NewInputObject(graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sSortInpObjGeoPointInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"latitude": &graphql.InputObjectFieldConfig{
+						Description: descriptions.WhereValueGeoLatitude,
+						Type:        graphql.NewNonNull(graphql.Float),
+					},
+					"longitude": &graphql.InputObjectFieldConfig{
+						Description: descriptions.WhereValueGeoLongitude,
+						Type:        graphql.NewNonNull(graphql.Float),
+					},
+				},
+			}),
+		},
 	}
 }
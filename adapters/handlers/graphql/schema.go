@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/get"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/modules"
 )
@@ -68,7 +69,11 @@ func Build(schema *schema.Schema, traverser Traverser,
 
 // Resolve at query time
 func (g *graphQL) Resolve(context context.Context, query string, operationName string, variables map[string]interface{}) *graphql.Result {
-	return graphql.Do(graphql.Params{
+	context, span := tracing.Start(context, "graphql.Resolve")
+	defer span.End()
+	span.SetAttribute("graphql.operation.name", operationName)
+
+	result := graphql.Do(graphql.Params{
 		Schema: g.schema,
 		RootObject: map[string]interface{}{
 			"Resolver": g.traverser,
@@ -79,6 +84,10 @@ func (g *graphQL) Resolve(context context.Context, query string, operationName s
 		VariableValues: variables,
 		Context:        context,
 	})
+	if len(result.Errors) > 0 {
+		span.RecordError(result.Errors[0])
+	}
+	return result
 }
 
 func buildGraphqlSchema(dbSchema *schema.Schema, logger logrus.FieldLogger,
@@ -0,0 +1,419 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flight
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/flight"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/export"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DoPutMethod writes objects, it is guarded like batch writes
+	DoPutMethod = "/arrow.flight.protocol.FlightService/DoPut"
+
+	maxErrorSamples = 10
+)
+
+type batchManager interface {
+	ExportObjects(ctx context.Context, principal *models.Principal,
+		params objects.ExportParams, w objects.ObjectsWriter) (*objects.ExportResult, error)
+	AddObjects(ctx context.Context, principal *models.Principal, objects []*models.Object,
+		fields []*string, repl *additional.ReplicationProperties) (objects.BatchObjects, error)
+}
+
+type schemaGetter interface {
+	GetSchema(principal *models.Principal) (schema.Schema, error)
+}
+
+// Service is an Arrow Flight service, so that data frame libraries can read
+// and write the objects of a class as record batches:
+//
+//   - DoGet exports the objects of the class of a ticket. The ticket is
+//     JSON {"class", "tenant", "after", "limit"} like the parameters of the
+//     REST export, the app metadata of each batch contains the cursor the
+//     export can be resumed from.
+//   - DoPut imports the objects of a stream. Its descriptor is the path
+//     [class] or [class, tenant], or the command JSON {"class", "tenant"}.
+//     Each batch is acknowledged with the number of imported and failed
+//     objects.
+//   - GetFlightInfo, GetSchema and ListFlights describe the classes.
+//
+// The record batches have the columns of the parquet export, columns of
+// imported batches are converted like the columns of parquet files.
+type Service struct {
+	pb.UnimplementedFlightServiceServer
+	batch                batchManager
+	schema               schemaGetter
+	authComposer         composer.TokenFunc
+	allowAnonymousAccess bool
+}
+
+func NewService(batch batchManager, schema schemaGetter, authComposer composer.TokenFunc,
+	allowAnonymousAccess bool,
+) *Service {
+	return &Service{
+		batch:                batch,
+		schema:               schema,
+		authComposer:         authComposer,
+		allowAnonymousAccess: allowAnonymousAccess,
+	}
+}
+
+func (s *Service) Register(server *grpc.Server) {
+	pb.RegisterFlightServiceServer(server, s)
+}
+
+// target is the class and tenant of a descriptor
+type target struct {
+	Class  string `json:"class"`
+	Tenant string `json:"tenant,omitempty"`
+}
+
+type exportTicket struct {
+	Class    string `json:"class"`
+	Tenant   string `json:"tenant,omitempty"`
+	After    string `json:"after,omitempty"`
+	Limit    int    `json:"limit,omitempty"`
+	Snapshot bool   `json:"snapshot,omitempty"`
+}
+
+type exportMetadata struct {
+	Cursor string `json:"cursor"`
+}
+
+type putMetadata struct {
+	Imported int      `json:"imported"`
+	Failed   int      `json:"failed"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+func parseDescriptor(d *pb.FlightDescriptor) (target, error) {
+	var t target
+	switch d.Type {
+	case pb.FlightDescriptor_PATH:
+		if len(d.Path) == 0 || len(d.Path) > 2 {
+			return t, status.Error(codes.InvalidArgument, "descriptor path must be [class] or [class, tenant]")
+		}
+		t.Class = d.Path[0]
+		if len(d.Path) == 2 {
+			t.Tenant = d.Path[1]
+		}
+	case pb.FlightDescriptor_CMD:
+		if err := json.Unmarshal(d.Cmd, &t); err != nil {
+			return t, status.Errorf(codes.InvalidArgument, "descriptor command: %v", err)
+		}
+	default:
+		return t, status.Error(codes.InvalidArgument, "descriptor must be a path or a command")
+	}
+	if t.Class == "" {
+		return t, status.Error(codes.InvalidArgument, "descriptor has no class")
+	}
+	t.Class = schema.UppercaseClassName(t.Class)
+	return t, nil
+}
+
+func (s *Service) GetFlightInfo(ctx context.Context, d *pb.FlightDescriptor) (*pb.FlightInfo, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := parseDescriptor(d)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.class(principal, t.Class); err != nil {
+		return nil, err
+	}
+	return flightInfo(t, d), nil
+}
+
+func (s *Service) GetSchema(ctx context.Context, d *pb.FlightDescriptor) (*pb.SchemaResult, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := parseDescriptor(d)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.class(principal, t.Class); err != nil {
+		return nil, err
+	}
+	return &pb.SchemaResult{Schema: export.EncapsulateArrowMessage(export.ArrowSchema())}, nil
+}
+
+func (s *Service) ListFlights(criteria *pb.Criteria, stream pb.FlightService_ListFlightsServer) error {
+	principal, err := s.principalFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	sch, err := s.schema.GetSchema(principal)
+	if err != nil {
+		return toStatus(err)
+	}
+	if sch.Objects == nil {
+		return nil
+	}
+	for _, class := range sch.Objects.Classes {
+		t := target{Class: class.Class}
+		d := &pb.FlightDescriptor{Type: pb.FlightDescriptor_PATH, Path: []string{class.Class}}
+		if err := stream.Send(flightInfo(t, d)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) class(principal *models.Principal, name string) (*models.Class, error) {
+	sch, err := s.schema.GetSchema(principal)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	class := sch.GetClass(schema.ClassName(name))
+	if class == nil {
+		return nil, status.Errorf(codes.NotFound, "class %q not found", name)
+	}
+	return class, nil
+}
+
+// flightInfo describes the export of the whole class, the number of
+// objects is not known in advance
+func flightInfo(t target, d *pb.FlightDescriptor) *pb.FlightInfo {
+	ticket, _ := json.Marshal(exportTicket{Class: t.Class, Tenant: t.Tenant})
+	return &pb.FlightInfo{
+		Schema:           export.EncapsulateArrowMessage(export.ArrowSchema()),
+		FlightDescriptor: d,
+		Endpoint:         []*pb.FlightEndpoint{{Ticket: &pb.Ticket{Ticket: ticket}}},
+		TotalRecords:     -1,
+		TotalBytes:       -1,
+		Ordered:          true,
+	}
+}
+
+// flightWriter sends the schema before the first batch of an export
+type flightWriter struct {
+	stream  pb.FlightService_DoGetServer
+	started bool
+}
+
+func (w *flightWriter) start() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+	return w.stream.Send(&pb.FlightData{DataHeader: export.ArrowSchema()})
+}
+
+func (w *flightWriter) Write(objs []*models.Object) error {
+	if err := w.start(); err != nil {
+		return err
+	}
+	header, body, err := export.ArrowRecordBatch(objs)
+	if err != nil {
+		return err
+	}
+	meta, _ := json.Marshal(exportMetadata{Cursor: objs[len(objs)-1].ID.String()})
+	return w.stream.Send(&pb.FlightData{DataHeader: header, DataBody: body, AppMetadata: meta})
+}
+
+func (s *Service) DoGet(ticket *pb.Ticket, stream pb.FlightService_DoGetServer) error {
+	principal, err := s.principalFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	var t exportTicket
+	if err := json.Unmarshal(ticket.Ticket, &t); err != nil {
+		return status.Errorf(codes.InvalidArgument, "ticket: %v", err)
+	}
+	if t.Limit < 0 {
+		return status.Error(codes.InvalidArgument, "limit must be a positive number")
+	}
+
+	w := &flightWriter{stream: stream}
+	_, err = s.batch.ExportObjects(stream.Context(), principal, objects.ExportParams{
+		Class:    t.Class,
+		Tenant:   t.Tenant,
+		After:    t.After,
+		Limit:    t.Limit,
+		Snapshot: t.Snapshot,
+	}, w)
+	if err != nil {
+		return toStatus(err)
+	}
+	// the stream of an empty export consists of the schema
+	return w.start()
+}
+
+func (s *Service) DoPut(stream pb.FlightService_DoPutServer) error {
+	ctx := stream.Context()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if first.FlightDescriptor == nil {
+		return status.Error(codes.InvalidArgument, "the first message must have a descriptor")
+	}
+	t, err := parseDescriptor(first.FlightDescriptor)
+	if err != nil {
+		return err
+	}
+	r, err := export.NewArrowReader(first.DataHeader, nil)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for {
+		data, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		batch, err := r.Batch(data.DataHeader, data.DataBody)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		res, err := s.putBatch(ctx, principal, t, batch)
+		if err != nil {
+			return err
+		}
+		meta, _ := json.Marshal(res)
+		if err := stream.Send(&pb.PutResult{AppMetadata: meta}); err != nil {
+			return err
+		}
+	}
+}
+
+// putBatch adds the objects of a record batch, errors of single objects
+// are reported in the result
+func (s *Service) putBatch(ctx context.Context, principal *models.Principal, t target,
+	r export.Reader,
+) (*putMetadata, error) {
+	res := &putMetadata{}
+	addError := func(msg string) {
+		res.Failed++
+		if len(res.Errors) < maxErrorSamples {
+			res.Errors = append(res.Errors, msg)
+		}
+	}
+
+	var (
+		batch []*models.Object
+		lines []int
+	)
+	for {
+		obj, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			addError(err.Error())
+			continue
+		}
+		obj.Class = t.Class
+		obj.Tenant = t.Tenant
+		batch = append(batch, obj)
+		lines = append(lines, r.Line())
+	}
+	if len(batch) == 0 {
+		return res, nil
+	}
+
+	added, err := s.batch.AddObjects(ctx, principal, batch, nil, nil)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	for _, obj := range added {
+		if obj.Err != nil {
+			addError(fmt.Sprintf("line %d: %v", lines[obj.OriginalIndex], obj.Err))
+		} else {
+			res.Imported++
+		}
+	}
+	return res, nil
+}
+
+// Handshake has nothing to negotiate, the requests are authenticated with
+// their metadata
+func (s *Service) Handshake(stream pb.FlightService_HandshakeServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if _, err := s.principalFromContext(stream.Context()); err != nil {
+		return err
+	}
+	return stream.Send(&pb.HandshakeResponse{ProtocolVersion: req.ProtocolVersion})
+}
+
+func (s *Service) principalFromContext(ctx context.Context) (*models.Principal, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 &&
+			strings.HasPrefix(values[0], "Bearer ") {
+			token = strings.TrimPrefix(values[0], "Bearer ")
+		}
+	}
+	if token == "" && s.allowAnonymousAccess {
+		return nil, nil
+	}
+
+	principal, err := s.authComposer(token, nil)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return principal, nil
+}
+
+func toStatus(err error) error {
+	var (
+		forbidden    autherrs.Forbidden
+		notFound     objects.ErrNotFound
+		invalid      objects.ErrInvalidUserInput
+		multiTenancy objects.ErrMultiTenancy
+	)
+	switch {
+	case errors.As(err, &forbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.As(err, &notFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.As(err, &invalid), errors.As(err, &multiTenancy):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
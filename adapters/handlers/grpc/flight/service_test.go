@@ -0,0 +1,234 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flight
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/flight"
+	"github.com/weaviate/weaviate/usecases/export"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakeBatchManager struct {
+	objects []*models.Object
+	added   []*models.Object
+}
+
+func (f *fakeBatchManager) ExportObjects(ctx context.Context, principal *models.Principal,
+	params objects.ExportParams, w objects.ObjectsWriter,
+) (*objects.ExportResult, error) {
+	if params.Class != "Article" {
+		return nil, objects.NewErrNotFound("class %q not found", params.Class)
+	}
+	for _, obj := range f.objects {
+		if err := w.Write([]*models.Object{obj}); err != nil {
+			return nil, err
+		}
+	}
+	return &objects.ExportResult{Objects: len(f.objects), Done: true}, nil
+}
+
+func (f *fakeBatchManager) AddObjects(ctx context.Context, principal *models.Principal,
+	objs []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+) (objects.BatchObjects, error) {
+	res := make(objects.BatchObjects, len(objs))
+	for i, obj := range objs {
+		res[i] = objects.BatchObject{Object: obj, OriginalIndex: i}
+		if obj.Properties == nil {
+			res[i].Err = errors.New("no properties")
+			continue
+		}
+		f.added = append(f.added, obj)
+	}
+	return res, nil
+}
+
+type fakeSchemaGetter struct{}
+
+func (f *fakeSchemaGetter) GetSchema(principal *models.Principal) (schema.Schema, error) {
+	return schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{{Class: "Article"}},
+	}}, nil
+}
+
+func newTestClient(t *testing.T, batch *fakeBatchManager) pb.FlightServiceClient {
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	authComposer := func(token string, scopes []string) (*models.Principal, error) {
+		if token != "secret" {
+			return nil, errors.New("invalid token")
+		}
+		return &models.Principal{Username: "jane"}, nil
+	}
+	NewService(batch, &fakeSchemaGetter{}, authComposer, true).Register(server)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewFlightServiceClient(conn)
+}
+
+func testObjects() []*models.Object {
+	return []*models.Object{
+		{
+			ID:         strfmt.UUID("00000000-0000-0000-0000-000000000001"),
+			Properties: map[string]interface{}{"title": "hello"},
+			Vector:     []float32{1, 2},
+		},
+		{ID: strfmt.UUID("00000000-0000-0000-0000-000000000002")},
+	}
+}
+
+func TestDoGet(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, &fakeBatchManager{objects: testObjects()})
+
+	info, err := client.GetFlightInfo(ctx,
+		&pb.FlightDescriptor{Type: pb.FlightDescriptor_PATH, Path: []string{"article"}})
+	require.Nil(t, err)
+	require.Len(t, info.Endpoint, 1)
+	assert.Equal(t, int64(-1), info.TotalRecords)
+	assert.JSONEq(t, `{"class":"Article"}`, string(info.Endpoint[0].Ticket.Ticket))
+
+	stream, err := client.DoGet(ctx, info.Endpoint[0].Ticket)
+	require.Nil(t, err)
+
+	schemaMsg, err := stream.Recv()
+	require.Nil(t, err)
+	r, err := export.NewArrowReader(schemaMsg.DataHeader, nil)
+	require.Nil(t, err)
+
+	var (
+		objs    []*models.Object
+		cursors []string
+	)
+	for {
+		data, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else {
+			require.Nil(t, err)
+		}
+		var meta exportMetadata
+		require.Nil(t, json.Unmarshal(data.AppMetadata, &meta))
+		cursors = append(cursors, meta.Cursor)
+
+		batch, err := r.Batch(data.DataHeader, data.DataBody)
+		require.Nil(t, err)
+		for {
+			obj, err := batch.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.Nil(t, err)
+			objs = append(objs, obj)
+		}
+	}
+	require.Len(t, objs, 2)
+	assert.Equal(t, []float32{1, 2}, []float32(objs[0].Vector))
+	assert.Equal(t, map[string]interface{}{"title": "hello"}, objs[0].Properties)
+	assert.Equal(t, []string{
+		"00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002",
+	}, cursors)
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := client.GetSchema(ctx,
+			&pb.FlightDescriptor{Type: pb.FlightDescriptor_PATH, Path: []string{"Other"}})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		stream, err := client.DoGet(ctx, &pb.Ticket{Ticket: []byte(`{"class":"Other"}`)})
+		require.Nil(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestDoPut(t *testing.T) {
+	ctx := context.Background()
+	batch := &fakeBatchManager{}
+	client := newTestClient(t, batch)
+
+	stream, err := client.DoPut(ctx)
+	require.Nil(t, err)
+	require.Nil(t, stream.Send(&pb.FlightData{
+		FlightDescriptor: &pb.FlightDescriptor{Type: pb.FlightDescriptor_CMD, Cmd: []byte(`{"class":"Article","tenant":"t1"}`)},
+		DataHeader:       export.ArrowSchema(),
+	}))
+	header, body, err := export.ArrowRecordBatch(testObjects())
+	require.Nil(t, err)
+	require.Nil(t, stream.Send(&pb.FlightData{DataHeader: header, DataBody: body}))
+
+	res, err := stream.Recv()
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"imported":1,"failed":1,"errors":["line 2: no properties"]}`,
+		string(res.AppMetadata))
+	require.Len(t, batch.added, 1)
+	assert.Equal(t, "Article", batch.added[0].Class)
+	assert.Equal(t, "t1", batch.added[0].Tenant)
+
+	require.Nil(t, stream.Send(&pb.FlightData{DataHeader: []byte{1, 2, 3}}))
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, err := client.GetSchema(metadataContext(ctx, "Bearer wrong"),
+			&pb.FlightDescriptor{Type: pb.FlightDescriptor_PATH, Path: []string{"Article"}})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestHandshake(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, &fakeBatchManager{})
+
+	stream, err := client.Handshake(metadataContext(ctx, "Bearer secret"))
+	require.Nil(t, err)
+	require.Nil(t, stream.Send(&pb.HandshakeRequest{ProtocolVersion: 1}))
+	res, err := stream.Recv()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), res.ProtocolVersion)
+
+	t.Run("actions are not implemented", func(t *testing.T) {
+		actions, err := client.ListActions(ctx, &pb.Empty{})
+		require.Nil(t, err)
+		_, err = actions.Recv()
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func metadataContext(ctx context.Context, auth string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+}
@@ -12,28 +12,74 @@
 package grpc
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/tracing"
 	pbv0 "github.com/weaviate/weaviate/grpc/generated/protocol/v0"
 	pbv1 "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/modulecredentials"
+	"github.com/weaviate/weaviate/usecases/nodes"
+	"github.com/weaviate/weaviate/usecases/standby"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	_ "google.golang.org/grpc/encoding/gzip" // Install the gzip compressor
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 
+	"github.com/weaviate/weaviate/adapters/handlers/grpc/flight"
 	v0 "github.com/weaviate/weaviate/adapters/handlers/grpc/v0"
 	v1 "github.com/weaviate/weaviate/adapters/handlers/grpc/v1"
+	_ "github.com/weaviate/weaviate/adapters/handlers/grpc/zstd" // Install the zstd compressor
 )
 
-const maxMsgSize = 104858000 // 10mb, needs to be synchronized with clients
+const (
+	maxMsgSize         = 104858000 // 10mb, needs to be synchronized with clients
+	batchObjectsMethod = "/weaviate.v1.Weaviate/BatchObjects"
+)
+
+// clientWriteMethods are rejected by standbys and read-only nodes, like the
+// writes of the REST API
+var clientWriteMethods = map[string]bool{
+	batchObjectsMethod:                       true,
+	"/weaviate.v1.Weaviate/CreateCollection": true,
+	"/weaviate.v1.Weaviate/UpdateCollection": true,
+	"/weaviate.v1.Weaviate/DeleteCollection": true,
+	"/weaviate.v1.Weaviate/AddProperty":      true,
+	"/weaviate.v1.Weaviate/TenantsCreate":    true,
+	"/weaviate.v1.Weaviate/TenantsUpdate":    true,
+	"/weaviate.v1.Weaviate/TenantsDelete":    true,
+}
 
 func CreateGRPCServer(state *state.State) *GRPCServer {
+	credentialsPrincipal := metadataPrincipal(composer.New(
+		state.ServerConfig.Config.Authentication,
+		state.APIKey, state.OIDC),
+		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled)
 	o := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(maxMsgSize),
 		grpc.MaxSendMsgSize(maxMsgSize),
+		grpc.ChainUnaryInterceptor(
+			makeCompressionInterceptor(state.ServerConfig.Config.GRPC.Compression),
+			makeRequestTracingInterceptor(state.Tracer),
+			makeStandbyInterceptor(state.Standby), makeReadOnlyInterceptor(state.Cluster),
+			makeMemoryPressureInterceptor(state.MemoryGovernor),
+			makeModuleCredentialsInterceptor(state.ModuleCredentials, credentialsPrincipal)),
+		grpc.ChainStreamInterceptor(
+			makeCompressionStreamInterceptor(state.ServerConfig.Config.GRPC.Compression),
+			makeWriteStreamInterceptor(state.Standby, state.Cluster,
+				state.MemoryGovernor),
+			makeModuleCredentialsStreamInterceptor(state.ModuleCredentials, credentialsPrincipal)),
 	}
 
 	// Add TLS creds for the GRPC connection, if defined.
@@ -57,14 +103,39 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 		state.SchemaManager,
 		state.BatchManager,
+		nodes.NewManager(state.Logger, state.Authorizer, state.DB, state.SchemaManager),
+	)
+	flightService := flight.NewService(state.BatchManager, state.SchemaManager,
+		composer.New(
+			state.ServerConfig.Config.Authentication,
+			state.APIKey, state.OIDC),
+		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 	)
+	weaviateV1.SetHealthChecker(nodeHealth{state})
+	weaviateV1.SetAsker(state.Ask)
 	pbv0.RegisterWeaviateServer(s, weaviateV0)
 	pbv1.RegisterWeaviateServer(s, weaviateV1)
 	grpc_health_v1.RegisterHealthServer(s, weaviateV1)
+	flightService.Register(s)
+	reflection.Register(s)
 
 	return &GRPCServer{s}
 }
 
+// nodeHealth reports the health of the node and its classes to the gRPC
+// health checks
+type nodeHealth struct {
+	state *state.State
+}
+
+func (h nodeHealth) Ready() bool {
+	return h.state.DB.StartupComplete() && h.state.Cluster.ClusterHealthScore() == 0
+}
+
+func (h nodeHealth) ClassServing(class string) (bool, bool) {
+	return h.state.DB.ClassServing(class)
+}
+
 func StartAndListen(s *GRPCServer, state *state.State) error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d",
 		state.ServerConfig.Config.GRPC.Port))
@@ -80,6 +151,234 @@ func StartAndListen(s *GRPCServer, state *state.State) error {
 	return nil
 }
 
+// makeCompressionInterceptor compresses the replies with the first of the
+// preferred compressors which the client accepts, even if its request was
+// not compressed. Without preferred compressors, or if the client accepts
+// none of them, replies are compressed like the request.
+func makeCompressionInterceptor(preferred []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		setSendCompressor(ctx, preferred)
+		return handler(ctx, req)
+	}
+}
+
+func makeCompressionStreamInterceptor(preferred []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		setSendCompressor(ss.Context(), preferred)
+		return handler(srv, ss)
+	}
+}
+
+func setSendCompressor(ctx context.Context, preferred []string) {
+	if len(preferred) == 0 {
+		return
+	}
+	accepted, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil {
+		return
+	}
+	for _, name := range preferred {
+		for _, a := range accepted {
+			if strings.TrimSpace(a) == name {
+				grpc.SetSendCompressor(ctx, name)
+				return
+			}
+		}
+	}
+}
+
+// makeRequestTracingInterceptor is the gRPC counterpart of the REST request
+// tracing middlewares. The request id is returned as header metadata and
+// appended to the message of errors, requests are traced with the tracer.
+func makeRequestTracingInterceptor(tracer *tracing.Tracer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx, requestID := tracing.FromIncoming(ctx, func(key string) string {
+			if values := md.Get(key); len(values) > 0 {
+				return values[0]
+			}
+			return ""
+		})
+		grpc.SetHeader(ctx, metadata.Pairs(tracing.RequestIDHeader, requestID))
+
+		ctx, span := tracer.StartServer(ctx, info.FullMethod)
+		span.SetAttribute("rpc.system", "grpc")
+		span.SetAttribute("rpc.method", info.FullMethod)
+		resp, err := handler(ctx, req)
+		span.RecordError(err)
+		span.End()
+
+		if err != nil {
+			st, _ := status.FromError(err)
+			return resp, status.Errorf(st.Code(), "%s (request id: %s)", st.Message(), requestID)
+		}
+		return resp, nil
+	}
+}
+
+type standbyState interface {
+	Active() bool
+}
+
+// makeStandbyInterceptor rejects writes while the cluster is a standby, like
+// the REST API does
+func makeStandbyInterceptor(s standbyState) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if clientWriteMethods[info.FullMethod] && s.Active() {
+			return nil, status.Error(codes.Unavailable, standby.ErrStandby.Error())
+		}
+		return handler(ctx, req)
+	}
+}
+
+type readOnlyState interface {
+	ReadOnly() bool
+}
+
+// makeReadOnlyInterceptor rejects writes on read-only nodes, like the REST
+// API does
+func makeReadOnlyInterceptor(s readOnlyState) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if clientWriteMethods[info.FullMethod] && s.ReadOnly() {
+			return nil, status.Error(codes.Unavailable, cluster.ErrReadOnly.Error())
+		}
+		return handler(ctx, req)
+	}
+}
+
+// metadataPrincipal authenticates the metadata of a request like the
+// services do
+func metadataPrincipal(authComposer composer.TokenFunc,
+	allowAnonymous bool,
+) func(md metadata.MD) (*models.Principal, error) {
+	return func(md metadata.MD) (*models.Principal, error) {
+		values := md.Get("authorization")
+		if len(values) == 0 || !strings.HasPrefix(values[0], "Bearer ") {
+			if allowAnonymous {
+				return nil, nil
+			}
+			return authComposer("", nil)
+		}
+		return authComposer(strings.TrimPrefix(values[0], "Bearer "), nil)
+	}
+}
+
+// makeModuleCredentialsInterceptor adds the stored module credentials to the
+// metadata of requests, unless the requests carry them already, like the
+// REST API does
+func makeModuleCredentialsInterceptor(store *modulecredentials.Store,
+	authenticate func(md metadata.MD) (*models.Principal, error),
+) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		return handler(withModuleCredentials(ctx, store, authenticate), req)
+	}
+}
+
+// makeModuleCredentialsStreamInterceptor adds the stored module credentials
+// to streams, e.g. the Arrow Flight imports which vectorize their objects
+func makeModuleCredentialsStreamInterceptor(store *modulecredentials.Store,
+	authenticate func(md metadata.MD) (*models.Principal, error),
+) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		ctx := withModuleCredentials(stream.Context(), store, authenticate)
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// withModuleCredentials returns the context with the stored credentials added
+// to its incoming metadata. The credentials of a tenant are only added for
+// principals bound to that tenant, the tenant a request names is not
+// authorized yet.
+func withModuleCredentials(ctx context.Context, store *modulecredentials.Store,
+	authenticate func(md metadata.MD) (*models.Principal, error),
+) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var tenant string
+	if store.HasTenants() {
+		// requests which fail authentication are rejected by their
+		// service, they only get the credentials for all tenants
+		if principal, err := authenticate(md); err == nil && principal != nil {
+			tenant = principal.Tenant
+		}
+	}
+
+	values := store.Resolve(tenant)
+	if len(values) == 0 {
+		return ctx
+	}
+
+	md = md.Copy()
+	for name, value := range values {
+		if len(md.Get(name)) == 0 {
+			md.Set(name, value)
+		}
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// contextStream replaces the context of a stream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
 type GRPCServer struct {
 	*grpc.Server
 }
+
+type memoryPressureState interface {
+	RejectImports() bool
+}
+
+// makeMemoryPressureInterceptor rejects batch writes while the memory
+// pressure governor sheds load, like the REST import guard
+func makeMemoryPressureInterceptor(s memoryPressureState) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if info.FullMethod == batchObjectsMethod && s.RejectImports() {
+			return nil, status.Error(codes.Unavailable, memwatch.ErrMemoryPressure.Error())
+		}
+		return handler(ctx, req)
+	}
+}
+
+// makeWriteStreamInterceptor guards the Arrow Flight imports like the unary
+// interceptors guard batch writes
+func makeWriteStreamInterceptor(sb standbyState, ro readOnlyState,
+	mp memoryPressureState,
+) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		if info.FullMethod == flight.DoPutMethod {
+			switch {
+			case sb.Active():
+				return status.Error(codes.Unavailable, standby.ErrStandby.Error())
+			case ro.ReadOnly():
+				return status.Error(codes.Unavailable, cluster.ErrReadOnly.Error())
+			case mp.RejectImports():
+				return status.Error(codes.Unavailable, memwatch.ErrMemoryPressure.Error())
+			}
+		}
+		return handler(srv, stream)
+	}
+}
@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/modulecredentials"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestModuleCredentialsInterceptors(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	store := modulecredentials.New(logger)
+	require.Nil(t, store.SetMetadataStore(newFakeMetadata()))
+	require.Nil(t, store.Set(ctx, "", map[string]string{"X-Openai-Api-Key": "global"}))
+	require.Nil(t, store.Set(ctx, "tenant1", map[string]string{"X-Openai-Api-Key": "tenant1"}))
+
+	// principals are bound to the tenant named by their token
+	authenticate := func(md metadata.MD) (*models.Principal, error) {
+		var token string
+		if values := md.Get("authorization"); len(values) > 0 {
+			token = strings.TrimPrefix(values[0], "Bearer ")
+		}
+		return &models.Principal{Username: "user", Tenant: token}, nil
+	}
+	seen := func(ctx context.Context) string {
+		if values := modulecomponents.GetValueFromGRPC(ctx, "X-Openai-Api-Key"); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		expected string
+	}{
+		{"without tenant", metadata.MD{}, "global"},
+		{"principal bound to tenant", metadata.Pairs("authorization", "Bearer tenant1"), "tenant1"},
+		{"tenant header", metadata.Pairs(modulecredentials.TenantHeader, "tenant1"), "global"},
+		{"request metadata", metadata.Pairs("authorization", "Bearer tenant1",
+			"x-openai-api-key", "request"), "request"},
+	}
+	for _, tt := range tests {
+		t.Run("unary "+tt.name, func(t *testing.T) {
+			var got string
+			interceptor := makeModuleCredentialsInterceptor(store, authenticate)
+			_, err := interceptor(metadata.NewIncomingContext(ctx, tt.md), nil,
+				&grpc.UnaryServerInfo{FullMethod: "/weaviate.v1.Weaviate/Search"},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					got = seen(ctx)
+					return nil, nil
+				})
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+
+		t.Run("stream "+tt.name, func(t *testing.T) {
+			var got string
+			interceptor := makeModuleCredentialsStreamInterceptor(store, authenticate)
+			stream := &contextStream{ctx: metadata.NewIncomingContext(ctx, tt.md)}
+			err := interceptor(nil, stream, &grpc.StreamServerInfo{},
+				func(srv interface{}, stream grpc.ServerStream) error {
+					got = seen(stream.Context())
+					return nil
+				})
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+// fakeMetadata is the metadata of the schema on a single node
+type fakeMetadata struct {
+	values    map[string][]byte
+	callbacks []func()
+}
+
+func newFakeMetadata() *fakeMetadata {
+	return &fakeMetadata{values: map[string][]byte{}}
+}
+
+func (f *fakeMetadata) PutMetadata(ctx context.Context, key string, value []byte) error {
+	f.values[key] = value
+	f.changed()
+	return nil
+}
+
+func (f *fakeMetadata) DeleteMetadata(ctx context.Context, key string) error {
+	delete(f.values, key)
+	f.changed()
+	return nil
+}
+
+func (f *fakeMetadata) Metadata(prefix string) map[string][]byte {
+	out := map[string][]byte{}
+	for key, value := range f.values {
+		if strings.HasPrefix(key, prefix) {
+			out[key] = value
+		}
+	}
+	return out
+}
+
+func (f *fakeMetadata) RegisterMetadataCallback(prefix string, callback func()) {
+	f.callbacks = append(f.callbacks, callback)
+}
+
+func (f *fakeMetadata) changed() {
+	for _, cb := range f.callbacks {
+		cb()
+	}
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/ask"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/usage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Asker answers questions like the /v1/ask endpoint
+type Asker interface {
+	Ask(ctx context.Context, principal *models.Principal, req ask.Request) (*ask.Answer, error)
+}
+
+// SetAsker enables the Ask method, without it the method is unimplemented
+func (s *Service) SetAsker(asker Asker) {
+	s.asker = asker
+}
+
+func (s *Service) Ask(ctx context.Context, req *pb.AskRequest) (*pb.AskReply, error) {
+	if s.asker == nil {
+		return nil, status.Error(codes.Unimplemented, "method Ask not implemented")
+	}
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = usage.WithPrincipal(ctx, principal, req.Tenant)
+
+	answer, err := s.asker.Ask(ctx, principal, askRequestFromProto(req))
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.Is(err, ask.ErrInvalidRequest):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.As(err, &forbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return askReplyToProto(answer)
+}
+
+func askRequestFromProto(req *pb.AskRequest) ask.Request {
+	out := ask.Request{
+		Class:      req.Collection,
+		Tenant:     req.Tenant,
+		Question:   req.Question,
+		Properties: req.Properties,
+		Limit:      int(req.Limit),
+		Alpha:      req.Alpha,
+	}
+	if req.Rerank != nil {
+		out.Rerank = &ask.Rerank{
+			Property: req.Rerank.Property,
+			Query:    req.Rerank.Query,
+			Limit:    int(req.Rerank.Limit),
+		}
+	}
+	return out
+}
+
+func askReplyToProto(answer *ask.Answer) (*pb.AskReply, error) {
+	reply := &pb.AskReply{
+		Answer:    answer.Answer,
+		Citations: make([]*pb.AskReply_Citation, 0, len(answer.Citations)),
+		Sources:   make([]*pb.AskReply_Source, 0, len(answer.Sources)),
+		Timings: &pb.AskReply_Timings{
+			Retrieve: float32(answer.Timings.Retrieve.Seconds()),
+			Rerank:   float32(answer.Timings.Rerank.Seconds()),
+			Generate: float32(answer.Timings.Generate.Seconds()),
+			Total:    float32(answer.Timings.Total.Seconds()),
+		},
+	}
+	for _, c := range answer.Citations {
+		reply.Citations = append(reply.Citations, &pb.AskReply_Citation{
+			Source: uint32(c.Source),
+			Id:     c.ID.String(),
+			Start:  uint32(c.Start),
+			End:    uint32(c.End),
+		})
+	}
+	for _, source := range answer.Sources {
+		properties, err := structFromConfig(source.Properties)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		reply.Sources = append(reply.Sources, &pb.AskReply_Source{
+			Id:          source.ID.String(),
+			Collection:  source.Class,
+			Score:       source.Score,
+			RerankScore: source.RerankScore,
+			Properties:  properties,
+		})
+	}
+	return reply, nil
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/ask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type fakeAsker struct {
+	req ask.Request
+	err error
+}
+
+func (f *fakeAsker) Ask(ctx context.Context, principal *models.Principal,
+	req ask.Request,
+) (*ask.Answer, error) {
+	f.req = req
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	score := 0.9
+	return &ask.Answer{
+		Answer: "See [1].",
+		Citations: []ask.Citation{{
+			Source: 1, ID: "00000000-0000-0000-0000-000000000001", Start: 4, End: 7,
+		}},
+		Sources: []ask.Source{{
+			ID:          strfmt.UUID("00000000-0000-0000-0000-000000000001"),
+			Class:       "Article",
+			Score:       0.5,
+			RerankScore: &score,
+			Properties:  map[string]interface{}{"title": "Hello"},
+		}},
+		Timings: ask.Timings{Retrieve: time.Second, Total: 2 * time.Second},
+	}, nil
+}
+
+func TestAsk(t *testing.T) {
+	t.Run("without asker", func(t *testing.T) {
+		s := &Service{allowAnonymousAccess: true}
+		_, err := s.Ask(context.Background(), &pb.AskRequest{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("answer", func(t *testing.T) {
+		asker := &fakeAsker{}
+		s := &Service{allowAnonymousAccess: true}
+		s.SetAsker(asker)
+
+		alpha := 0.5
+		reply, err := s.Ask(context.Background(), &pb.AskRequest{
+			Collection: "Article",
+			Question:   "What?",
+			Limit:      5,
+			Alpha:      &alpha,
+			Rerank:     &pb.AskRequest_Rerank{Property: "title", Limit: 2},
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, ask.Request{
+			Class:    "Article",
+			Question: "What?",
+			Limit:    5,
+			Alpha:    &alpha,
+			Rerank:   &ask.Rerank{Property: "title", Limit: 2},
+		}, asker.req)
+
+		properties, err := structpb.NewStruct(map[string]interface{}{"title": "Hello"})
+		require.Nil(t, err)
+		score := 0.9
+		assert.Equal(t, &pb.AskReply{
+			Answer: "See [1].",
+			Citations: []*pb.AskReply_Citation{{
+				Source: 1, Id: "00000000-0000-0000-0000-000000000001", Start: 4, End: 7,
+			}},
+			Sources: []*pb.AskReply_Source{{
+				Id:          "00000000-0000-0000-0000-000000000001",
+				Collection:  "Article",
+				Score:       0.5,
+				RerankScore: &score,
+				Properties:  properties,
+			}},
+			Timings: &pb.AskReply_Timings{Retrieve: 1, Total: 2},
+		}, reply)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		s := &Service{allowAnonymousAccess: true}
+		s.SetAsker(&fakeAsker{err: fmt.Errorf("%w: question is required", ask.ErrInvalidRequest)})
+
+		_, err := s.Ask(context.Background(), &pb.AskRequest{Collection: "Article"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
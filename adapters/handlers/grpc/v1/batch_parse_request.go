@@ -72,7 +72,11 @@ func batchFromProto(req *pb.BatchObjectsRequest, scheme schema.Schema) ([]*model
 		var vector []float32
 		// bytes vector has precedent for being more efficient
 		if len(obj.VectorBytes) > 0 {
-			vector = byteops.Float32FromByteVector(obj.VectorBytes)
+			vector, err = vectorFromBytes(obj.VectorEncoding, obj.VectorBytes)
+			if err != nil {
+				objectErrors[i] = err
+				continue
+			}
 		} else if len(obj.Vector) > 0 {
 			vector = obj.Vector
 		}
@@ -18,6 +18,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
 )
 
 const (
@@ -265,6 +266,23 @@ func TestGRPCBatchRequest(t *testing.T) {
 				},
 			}}},
 		},
+		{
+			name: "float16 vector",
+			req: []*pb.BatchObject{{
+				Collection: collection, Uuid: UUID4,
+				VectorBytes:    byteops.Float16ToByteVector([]float32{0.5, -2}),
+				VectorEncoding: pb.VectorEncoding_VECTOR_ENCODING_FLOAT16,
+			}},
+			out: []*models.Object{{Class: collection, Properties: nilMap, ID: UUID4, Vector: []float32{0.5, -2}}},
+		},
+		{
+			name: "truncated float32 vector",
+			req: []*pb.BatchObject{{
+				Collection: collection, Uuid: UUID4, VectorBytes: []byte{1, 2, 3},
+			}},
+			out:      []*models.Object{},
+			outError: []int{0},
+		},
 		{
 			name:      "mix of errors and no errors",
 			req:       []*pb.BatchObject{{Collection: collection, Uuid: UUID4}, {Collection: collection}, {Collection: collection}, {Collection: collection, Uuid: UUID3}},
@@ -13,18 +13,85 @@ package v1
 
 import (
 	"context"
+	"time"
 
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
-func (s *Service) healthCheck() *grpc_health_v1.HealthCheckResponse {
-	return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}
+// healthWatchInterval is how often watched services are checked for changes
+var healthWatchInterval = time.Second
+
+// HealthChecker reports the readiness of the node and the health of the
+// classes on it
+type HealthChecker interface {
+	// Ready is true like the /v1/.well-known/ready endpoint
+	Ready() bool
+	// ClassServing reports whether the shards of the class on this node
+	// serve requests, ok is false if the class does not exist on this node
+	ClassServing(class string) (serving, ok bool)
+}
+
+// SetHealthChecker enables health checks of the node and of the classes,
+// without it the node is always reported as serving
+func (s *Service) SetHealthChecker(health HealthChecker) {
+	s.health = health
+}
+
+// healthStatus of a service, which is either the whole node, named by the
+// empty string or the name of the Weaviate service, or a class
+func (s *Service) healthStatus(service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if s.health == nil {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+
+	var serving bool
+	switch service {
+	case "", pb.Weaviate_ServiceDesc.ServiceName:
+		serving = s.health.Ready()
+	default:
+		var ok bool
+		if serving, ok = s.health.ClassServing(service); !ok {
+			return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+		}
+	}
+
+	if serving {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
 }
 
 func (s *Service) Check(ctx context.Context, request *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
-	return s.healthCheck(), nil
+	health := s.healthStatus(request.Service)
+	if health == grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", request.Service)
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: health}, nil
 }
 
+// Watch sends the health of the service and then every change of it, until
+// the client cancels the stream. Unknown services are reported as such, as
+// they might be created later.
 func (s *Service) Watch(request *grpc_health_v1.HealthCheckRequest, server grpc_health_v1.Health_WatchServer) error {
-	return server.Send(s.healthCheck())
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	var last grpc_health_v1.HealthCheckResponse_ServingStatus = -1
+	for {
+		if health := s.healthStatus(request.Service); health != last {
+			if err := server.Send(&grpc_health_v1.HealthCheckResponse{Status: health}); err != nil {
+				return err
+			}
+			last = health
+		}
+
+		select {
+		case <-server.Context().Done():
+			return status.FromContextError(server.Context().Err()).Err()
+		case <-ticker.C:
+		}
+	}
 }
@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type fakeHealth struct {
+	sync.Mutex
+	ready   bool
+	classes map[string]bool
+}
+
+func (f *fakeHealth) Ready() bool {
+	f.Lock()
+	defer f.Unlock()
+	return f.ready
+}
+
+func (f *fakeHealth) ClassServing(class string) (bool, bool) {
+	f.Lock()
+	defer f.Unlock()
+	serving, ok := f.classes[class]
+	return serving, ok
+}
+
+func (f *fakeHealth) set(ready bool, classes map[string]bool) {
+	f.Lock()
+	defer f.Unlock()
+	f.ready, f.classes = ready, classes
+}
+
+type fakeWatchServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan grpc_health_v1.HealthCheckResponse_ServingStatus
+}
+
+func (f *fakeWatchServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeWatchServer) Send(res *grpc_health_v1.HealthCheckResponse) error {
+	f.sent <- res.Status
+	return nil
+}
+
+func TestHealthCheck(t *testing.T) {
+	ctx := context.Background()
+	check := func(s *Service, service string) (grpc_health_v1.HealthCheckResponse_ServingStatus, error) {
+		res, err := s.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: service})
+		if err != nil {
+			return 0, err
+		}
+		return res.Status, nil
+	}
+
+	t.Run("without health checker", func(t *testing.T) {
+		health, err := check(&Service{}, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, health)
+	})
+
+	s := &Service{}
+	s.SetHealthChecker(&fakeHealth{
+		ready:   false,
+		classes: map[string]bool{"Article": true, "Author": false},
+	})
+
+	for _, tc := range []struct {
+		service string
+		health  grpc_health_v1.HealthCheckResponse_ServingStatus
+	}{
+		{"", grpc_health_v1.HealthCheckResponse_NOT_SERVING},
+		{"weaviate.v1.Weaviate", grpc_health_v1.HealthCheckResponse_NOT_SERVING},
+		{"Article", grpc_health_v1.HealthCheckResponse_SERVING},
+		{"Author", grpc_health_v1.HealthCheckResponse_NOT_SERVING},
+	} {
+		t.Run(tc.service, func(t *testing.T) {
+			health, err := check(s, tc.service)
+			require.Nil(t, err)
+			assert.Equal(t, tc.health, health)
+		})
+	}
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := check(s, "Unknown")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestHealthWatch(t *testing.T) {
+	interval := healthWatchInterval
+	healthWatchInterval = time.Millisecond
+	defer func() { healthWatchInterval = interval }()
+
+	health := &fakeHealth{classes: map[string]bool{}}
+	s := &Service{}
+	s.SetHealthChecker(health)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	server := &fakeWatchServer{
+		ctx:  ctx,
+		sent: make(chan grpc_health_v1.HealthCheckResponse_ServingStatus, 10),
+	}
+	done := make(chan error)
+	go func() {
+		done <- s.Watch(&grpc_health_v1.HealthCheckRequest{Service: "Article"}, server)
+	}()
+
+	// unknown classes might be created later
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN, <-server.sent)
+	health.set(true, map[string]bool{"Article": false})
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, <-server.sent)
+	health.set(true, map[string]bool{"Article": true})
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, <-server.sent)
+
+	cancel()
+	assert.Equal(t, codes.Canceled, status.Code(<-done))
+	// only changes are sent
+	assert.Len(t, server.sent, 0)
+}
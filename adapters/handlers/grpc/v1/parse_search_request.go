@@ -12,10 +12,10 @@
 package v1
 
 import (
+	"encoding/base64"
 	"fmt"
 
 	"github.com/pkg/errors"
-	"github.com/weaviate/weaviate/usecases/byteops"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
 
@@ -83,7 +83,10 @@ func searchParamsFromProto(req *pb.SearchRequest, scheme schema.Schema) (dto.Get
 		var vector []float32
 		// bytes vector has precedent for being more efficient
 		if len(hs.VectorBytes) > 0 {
-			vector = byteops.Float32FromByteVector(hs.VectorBytes)
+			vector, err = vectorFromBytes(req.VectorEncoding, hs.VectorBytes)
+			if err != nil {
+				return dto.GetParams{}, errors.Wrap(err, "hybrid")
+			}
 		} else if len(hs.Vector) > 0 {
 			vector = hs.Vector
 		}
@@ -99,12 +102,20 @@ func searchParamsFromProto(req *pb.SearchRequest, scheme schema.Schema) (dto.Get
 		var vector []float32
 		// bytes vector has precedent for being more efficient
 		if len(nv.VectorBytes) > 0 {
-			vector = byteops.Float32FromByteVector(nv.VectorBytes)
+			vector, err = vectorFromBytes(req.VectorEncoding, nv.VectorBytes)
+			if err != nil {
+				return dto.GetParams{}, errors.Wrap(err, "near_vector")
+			}
 		} else {
 			vector = nv.Vector
 		}
+		vectors, err := extractWeightedVectors(req.VectorEncoding, nv.Vectors)
+		if err != nil {
+			return dto.GetParams{}, errors.Wrap(err, "near_vector")
+		}
 		out.NearVector = &searchparams.NearVector{
-			Vector: vector,
+			Vector:  vector,
+			Vectors: vectors,
 		}
 
 		// The following business logic should not sit in the API. However, it is
@@ -146,6 +157,38 @@ func searchParamsFromProto(req *pb.SearchRequest, scheme schema.Schema) (dto.Get
 		}
 	}
 
+	if rec := req.Recommend; rec != nil {
+		positive, err := extractRecommendExamples(req.VectorEncoding, rec.Positive)
+		if err != nil {
+			return dto.GetParams{}, errors.Wrap(err, "recommend")
+		}
+		negative, err := extractRecommendExamples(req.VectorEncoding, rec.Negative)
+		if err != nil {
+			return dto.GetParams{}, errors.Wrap(err, "recommend")
+		}
+		out.Recommend = &searchparams.Recommend{
+			Positive: positive,
+			Negative: negative,
+		}
+
+		if rec.NegativeWeight != nil {
+			out.Recommend.NegativeWeight = *rec.NegativeWeight
+		}
+
+		if rec.Distance != nil && rec.Certainty != nil {
+			return out, fmt.Errorf("recommend: cannot provide distance and certainty")
+		}
+
+		if rec.Certainty != nil {
+			out.Recommend.Certainty = *rec.Certainty
+		}
+
+		if rec.Distance != nil {
+			out.Recommend.Distance = *rec.Distance
+			out.Recommend.WithDistance = true
+		}
+	}
+
 	if ni := req.NearImage; ni != nil {
 		nearImageOut, err := parseNearImage(ni)
 		if err != nil {
@@ -242,7 +285,7 @@ func searchParamsFromProto(req *pb.SearchRequest, scheme schema.Schema) (dto.Get
 	}
 
 	if len(req.SortBy) > 0 {
-		if req.NearText != nil || req.NearVideo != nil || req.NearAudio != nil || req.NearImage != nil || req.NearObject != nil || req.NearVector != nil || req.HybridSearch != nil || req.Bm25Search != nil || req.Generative != nil {
+		if req.NearText != nil || req.NearVideo != nil || req.NearAudio != nil || req.NearImage != nil || req.NearObject != nil || req.NearVector != nil || req.Recommend != nil || req.HybridSearch != nil || req.Bm25Search != nil || req.Generative != nil {
 			return dto.GetParams{}, errors.New("sorting cannot be combined with search")
 		}
 		out.Sort = extractSorting(req.SortBy)
@@ -261,6 +304,47 @@ func searchParamsFromProto(req *pb.SearchRequest, scheme schema.Schema) (dto.Get
 	return out, nil
 }
 
+func extractRecommendExamples(encoding pb.VectorEncoding, examplesIn []*pb.Recommend_Example,
+) ([]searchparams.RecommendExample, error) {
+	if len(examplesIn) == 0 {
+		return nil, nil
+	}
+	examples := make([]searchparams.RecommendExample, len(examplesIn))
+	for i, ex := range examplesIn {
+		examples[i] = searchparams.RecommendExample{ID: ex.Id}
+		if len(ex.VectorBytes) > 0 {
+			vector, err := vectorFromBytes(encoding, ex.VectorBytes)
+			if err != nil {
+				return nil, fmt.Errorf("example %d: %w", i, err)
+			}
+			examples[i].Vector = vector
+		}
+		if ex.Weight != nil {
+			examples[i].Weight = *ex.Weight
+		}
+	}
+	return examples, nil
+}
+
+func extractWeightedVectors(encoding pb.VectorEncoding, vectorsIn []*pb.NearVector_WeightedVector,
+) ([]searchparams.WeightedVector, error) {
+	if len(vectorsIn) == 0 {
+		return nil, nil
+	}
+	vectors := make([]searchparams.WeightedVector, len(vectorsIn))
+	for i, wv := range vectorsIn {
+		vector, err := vectorFromBytes(encoding, wv.VectorBytes)
+		if err != nil {
+			return nil, fmt.Errorf("vector %d: %w", i, err)
+		}
+		vectors[i] = searchparams.WeightedVector{Vector: vector}
+		if wv.Weight != nil {
+			vectors[i].Weight = *wv.Weight
+		}
+	}
+	return vectors, nil
+}
+
 func extractGroupBy(groupIn *pb.GroupBy, out *dto.GetParams) (*searchparams.GroupBy, error) {
 	if len(groupIn.Path) != 1 {
 		return nil, fmt.Errorf("groupby path can only have one entry, received %v", groupIn.Path)
@@ -386,6 +470,10 @@ func extractFilters(filterIn *pb.Filters, scheme schema.Schema, className string
 			returnFilter.Operator = filters.ContainsAny
 		case pb.Filters_OPERATOR_CONTAINS_ALL:
 			returnFilter.Operator = filters.ContainsAll
+		case pb.Filters_OPERATOR_WITHIN_GEO_POLYGON:
+			returnFilter.Operator = filters.OperatorWithinGeoPolygon
+		case pb.Filters_OPERATOR_WITHIN_GEO_BOUNDING_BOX:
+			returnFilter.Operator = filters.OperatorWithinGeoBoundingBox
 		default:
 			return filters.Clause{}, fmt.Errorf("unknown filter operator %v", filterIn.Operator)
 		}
@@ -433,6 +521,18 @@ func extractFilters(filterIn *pb.Filters, scheme schema.Schema, className string
 				},
 				Distance: valueFilter.Distance,
 			}
+		case *pb.Filters_ValueGeoPolygon:
+			points := filterIn.GetValueGeoPolygon().GetPoints()
+			polygon := filters.GeoPolygon{Points: make([]models.GeoCoordinates, len(points))}
+			for i, point := range points {
+				polygon.Points[i] = geoCoordinatesFromProto(point)
+			}
+			val = polygon
+		case *pb.Filters_ValueGeoBoundingBox:
+			valueFilter := filterIn.GetValueGeoBoundingBox()
+			topLeft := geoCoordinatesFromProto(valueFilter.GetTopLeft())
+			bottomRight := geoCoordinatesFromProto(valueFilter.GetBottomRight())
+			val = filters.GeoBoundingBox{TopLeft: &topLeft, BottomRight: &bottomRight}
 		default:
 			return filters.Clause{}, fmt.Errorf("unknown value type %v", filterIn.TestValue)
 		}
@@ -483,6 +583,11 @@ func extractFilters(filterIn *pb.Filters, scheme schema.Schema, className string
 	return returnFilter, nil
 }
 
+func geoCoordinatesFromProto(in *pb.GeoCoordinate) models.GeoCoordinates {
+	lat, lon := in.GetLatitude(), in.GetLongitude()
+	return models.GeoCoordinates{Latitude: &lat, Longitude: &lon}
+}
+
 func extractDataTypeProperty(scheme schema.Schema, operator filters.Operator, classname string, on []string) (schema.DataType, error) {
 	var dataType schema.DataType
 	if operator == filters.OperatorIsNull {
@@ -881,6 +986,15 @@ func parseNearImage(ni *pb.NearImageSearch) (*nearImage.NearImageParams, error)
 		Image: ni.Image,
 	}
 
+	// the modules expect base64 encoded media, raw bytes only save the
+	// encoding overhead on the wire
+	if len(ni.ImageBytes) > 0 {
+		if ni.Image != "" {
+			return nil, fmt.Errorf("near_image: cannot provide image and image_bytes")
+		}
+		nearImageOut.Image = base64.StdEncoding.EncodeToString(ni.ImageBytes)
+	}
+
 	// The following business logic should not sit in the API. However, it is
 	// also part of the GraphQL API, so we need to duplicate it in order to get
 	// the same behavior
@@ -905,6 +1019,15 @@ func parseNearAudio(na *pb.NearAudioSearch) (*nearAudio.NearAudioParams, error)
 		Audio: na.Audio,
 	}
 
+	// the modules expect base64 encoded media, raw bytes only save the
+	// encoding overhead on the wire
+	if len(na.AudioBytes) > 0 {
+		if na.Audio != "" {
+			return nil, fmt.Errorf("near_audio: cannot provide audio and audio_bytes")
+		}
+		nearAudioOut.Audio = base64.StdEncoding.EncodeToString(na.AudioBytes)
+	}
+
 	// The following business logic should not sit in the API. However, it is
 	// also part of the GraphQL API, so we need to duplicate it in order to get
 	// the same behavior
@@ -929,6 +1052,15 @@ func parseNearVideo(nv *pb.NearVideoSearch) (*nearVideo.NearVideoParams, error)
 		Video: nv.Video,
 	}
 
+	// the modules expect base64 encoded media, raw bytes only save the
+	// encoding overhead on the wire
+	if len(nv.VideoBytes) > 0 {
+		if nv.Video != "" {
+			return nil, fmt.Errorf("near_video: cannot provide video and video_bytes")
+		}
+		nearVideoOut.Video = base64.StdEncoding.EncodeToString(nv.VideoBytes)
+	}
+
 	// The following business logic should not sit in the API. However, it is
 	// also part of the GraphQL API, so we need to duplicate it in order to get
 	// the same behavior
@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	vectorIndex "github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/usecases/byteops"
 
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -47,7 +48,7 @@ func TestGRPCRequest(t *testing.T) {
 	dotClass := "DotClass"
 	objClass := "ObjClass"
 
-	defaultTestClassProps := search.SelectProperties{{Name: "name", IsPrimitive: true}, {Name: "number", IsPrimitive: true}, {Name: "floats", IsPrimitive: true}, {Name: "uuid", IsPrimitive: true}}
+	defaultTestClassProps := search.SelectProperties{{Name: "name", IsPrimitive: true}, {Name: "number", IsPrimitive: true}, {Name: "floats", IsPrimitive: true}, {Name: "uuid", IsPrimitive: true}, {Name: "location", IsPrimitive: true}}
 
 	scheme := schema.Schema{
 		Objects: &models.Schema{
@@ -59,6 +60,7 @@ func TestGRPCRequest(t *testing.T) {
 						{Name: "number", DataType: schema.DataTypeInt.PropString()},
 						{Name: "floats", DataType: schema.DataTypeNumberArray.PropString()},
 						{Name: "uuid", DataType: schema.DataTypeUUID.PropString()},
+						{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
 						{Name: "ref", DataType: []string{refClass1}},
 						{Name: "multiRef", DataType: []string{refClass1, refClass2}},
 					},
@@ -130,6 +132,7 @@ func TestGRPCRequest(t *testing.T) {
 	quorum := pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM
 	someString1 := "a word"
 	someString2 := "other"
+	weight, negativeWeight, distance := 2.0, 0.25, 0.3
 
 	tests := []struct {
 		name  string
@@ -252,6 +255,7 @@ func TestGRPCRequest(t *testing.T) {
 					{Name: "number", IsPrimitive: true},
 					{Name: "floats", IsPrimitive: true},
 					{Name: "uuid", IsPrimitive: true},
+					{Name: "location", IsPrimitive: true},
 					{Name: "ref", IsPrimitive: false, Refs: []search.SelectClass{
 						{
 							ClassName:            refClass1,
@@ -280,6 +284,7 @@ func TestGRPCRequest(t *testing.T) {
 					{Name: "number", IsPrimitive: true},
 					{Name: "floats", IsPrimitive: true},
 					{Name: "uuid", IsPrimitive: true},
+					{Name: "location", IsPrimitive: true},
 					{Name: "ref", IsPrimitive: false, Refs: []search.SelectClass{
 						{
 							ClassName: refClass1,
@@ -469,6 +474,66 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "filter geo polygon",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Filters: &pb.Filters{
+					Operator: pb.Filters_OPERATOR_WITHIN_GEO_POLYGON, On: []string{"location"},
+					TestValue: &pb.Filters_ValueGeoPolygon{ValueGeoPolygon: &pb.GeoPolygonFilter{
+						Points: []*pb.GeoCoordinate{
+							{Latitude: 1, Longitude: 1}, {Latitude: 2, Longitude: 1}, {Latitude: 2, Longitude: 2},
+						},
+					}},
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+				Filters: &filters.LocalFilter{
+					Root: &filters.Clause{
+						On:       &filters.Path{Class: schema.ClassName(classname), Property: "location"},
+						Operator: filters.OperatorWithinGeoPolygon,
+						Value: &filters.Value{Value: filters.GeoPolygon{Points: []models.GeoCoordinates{
+							geoCoordinatesFromProto(&pb.GeoCoordinate{Latitude: 1, Longitude: 1}),
+							geoCoordinatesFromProto(&pb.GeoCoordinate{Latitude: 2, Longitude: 1}),
+							geoCoordinatesFromProto(&pb.GeoCoordinate{Latitude: 2, Longitude: 2}),
+						}}, Type: schema.DataTypeGeoCoordinates},
+					},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "filter geo bounding box",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Filters: &pb.Filters{
+					Operator: pb.Filters_OPERATOR_WITHIN_GEO_BOUNDING_BOX, On: []string{"location"},
+					TestValue: &pb.Filters_ValueGeoBoundingBox{ValueGeoBoundingBox: &pb.GeoBoundingBoxFilter{
+						TopLeft:     &pb.GeoCoordinate{Latitude: 2, Longitude: 1},
+						BottomRight: &pb.GeoCoordinate{Latitude: 1, Longitude: 2},
+					}},
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+				Filters: &filters.LocalFilter{
+					Root: &filters.Clause{
+						On:       &filters.Path{Class: schema.ClassName(classname), Property: "location"},
+						Operator: filters.OperatorWithinGeoBoundingBox,
+						Value: &filters.Value{Value: filters.GeoBoundingBox{
+							TopLeft:     &models.GeoCoordinates{Latitude: ptFloat32(2), Longitude: ptFloat32(1)},
+							BottomRight: &models.GeoCoordinates{Latitude: ptFloat32(1), Longitude: ptFloat32(2)},
+						}, Type: schema.DataTypeGeoCoordinates},
+					},
+				},
+			},
+			error: false,
+		},
 		{
 			name: "filter or",
 			req: &pb.SearchRequest{
@@ -810,6 +875,107 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "near vector search with weighted vectors",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				NearVector: &pb.NearVector{
+					Vectors: []*pb.NearVector_WeightedVector{
+						{VectorBytes: byteops.Float32ToByteVector([]float32{1, 2})},
+						{VectorBytes: byteops.Float32ToByteVector([]float32{0, 1}), Weight: &negativeWeight},
+					},
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+				NearVector: &searchparams.NearVector{
+					Vectors: []searchparams.WeightedVector{
+						{Vector: []float32{1, 2}},
+						{Vector: []float32{0, 1}, Weight: 0.25},
+					},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "near vector search with float16 weighted vectors",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				VectorEncoding: pb.VectorEncoding_VECTOR_ENCODING_FLOAT16,
+				NearVector: &pb.NearVector{
+					VectorBytes: byteops.Float16ToByteVector([]float32{0.5, 2}),
+					Vectors: []*pb.NearVector_WeightedVector{
+						{VectorBytes: byteops.Float16ToByteVector([]float32{1, 2})},
+					},
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+				NearVector: &searchparams.NearVector{
+					Vector:  []float32{0.5, 2},
+					Vectors: []searchparams.WeightedVector{{Vector: []float32{1, 2}}},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "near vector search with truncated float16 vector",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				VectorEncoding: pb.VectorEncoding_VECTOR_ENCODING_FLOAT16,
+				NearVector:     &pb.NearVector{VectorBytes: []byte{1, 2, 3}},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
+		{
+			name: "recommend search",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Recommend: &pb.Recommend{
+					Positive: []*pb.Recommend_Example{
+						{Id: UUID3, Weight: &weight},
+						{VectorBytes: byteops.Float32ToByteVector([]float32{1, 2})},
+					},
+					Negative:       []*pb.Recommend_Example{{Id: UUID4}},
+					NegativeWeight: &negativeWeight,
+					Distance:       &distance,
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+				Recommend: &searchparams.Recommend{
+					Positive: []searchparams.RecommendExample{
+						{ID: UUID3, Weight: 2},
+						{Vector: []float32{1, 2}},
+					},
+					Negative:       []searchparams.RecommendExample{{ID: UUID4}},
+					NegativeWeight: 0.25,
+					Distance:       0.3,
+					WithDistance:   true,
+				},
+			},
+			error: false,
+		},
+		{
+			name: "recommend search with distance and certainty",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Recommend: &pb.Recommend{
+					Positive:  []*pb.Recommend_Example{{Id: UUID3}},
+					Distance:  &distance,
+					Certainty: &distance,
+				},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
 		{
 			name: "near video search",
 			req: &pb.SearchRequest{
@@ -850,6 +1016,38 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "near image search with raw bytes",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				NearImage: &pb.NearImageSearch{
+					ImageBytes: []byte("image file"),
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+				ModuleParams: map[string]interface{}{
+					"nearImage": &nearImage.NearImageParams{
+						Image: "aW1hZ2UgZmlsZQ==",
+					},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "near audio search with both raw and base64 encoded audio",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				NearAudio: &pb.NearAudioSearch{
+					Audio:      "audio file",
+					AudioBytes: []byte("audio file"),
+				},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
 		{
 			name: "Consistency",
 			req: &pb.SearchRequest{
@@ -1057,3 +1255,7 @@ func TestGRPCRequest(t *testing.T) {
 		})
 	}
 }
+
+func ptFloat32(f float32) *float32 {
+	return &f
+}
@@ -0,0 +1,512 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/verbosity"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type nodesStatusGetter interface {
+	GetNodeStatus(ctx context.Context, principal *models.Principal,
+		className, verbosity string) ([]*models.NodeStatus, error)
+}
+
+func (s *Service) GetSchema(ctx context.Context, req *pb.GetSchemaRequest) (*pb.GetSchemaReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	sch, err := s.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, schemaErrorStatus(err)
+	}
+
+	reply := &pb.GetSchemaReply{}
+	if sch.Objects == nil {
+		return reply, nil
+	}
+	for _, class := range sch.Objects.Classes {
+		collection, err := collectionToProto(class)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		reply.Collections = append(reply.Collections, collection)
+	}
+	return reply, nil
+}
+
+func (s *Service) GetCollection(ctx context.Context, req *pb.GetCollectionRequest) (*pb.CollectionReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	class, err := s.schemaManager.GetClass(ctx, principal, req.Name)
+	if err != nil {
+		return nil, schemaErrorStatus(err)
+	}
+	if class == nil {
+		return nil, status.Errorf(codes.NotFound, "collection %q not found", req.Name)
+	}
+	return collectionReply(class)
+}
+
+func (s *Service) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.CollectionReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	class, err := collectionFromProto(req.Collection)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.schemaManager.AddClass(ctx, principal, class); err != nil {
+		return nil, schemaErrorStatus(err)
+	}
+	return collectionReply(class)
+}
+
+func (s *Service) UpdateCollection(ctx context.Context, req *pb.UpdateCollectionRequest) (*pb.CollectionReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	class, err := collectionFromProto(req.Collection)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.schemaManager.UpdateClass(ctx, principal, class.Class, class); err != nil {
+		return nil, schemaErrorStatus(err)
+	}
+	return collectionReply(class)
+}
+
+func (s *Service) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (*pb.DeleteCollectionReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	if err := s.schemaManager.DeleteClass(ctx, principal, req.Name); err != nil {
+		return nil, schemaErrorStatus(err)
+	}
+	return &pb.DeleteCollectionReply{}, nil
+}
+
+func (s *Service) AddProperty(ctx context.Context, req *pb.AddPropertyRequest) (*pb.AddPropertyReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	prop, err := propertyFromProto(req.Property)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.schemaManager.AddClassProperty(ctx, principal, req.Collection, prop); err != nil {
+		return nil, schemaErrorStatus(err)
+	}
+
+	reply, err := propertyToProto(prop)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.AddPropertyReply{Property: reply}, nil
+}
+
+func (s *Service) TenantsGet(ctx context.Context, req *pb.TenantsGetRequest) (*pb.TenantsReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	tenants, err := s.schemaManager.GetTenants(ctx, principal, req.Collection)
+	if err != nil {
+		return nil, schemaErrorStatus(err)
+	}
+	return tenantsReply(tenants), nil
+}
+
+func (s *Service) TenantsCreate(ctx context.Context, req *pb.TenantsCreateRequest) (*pb.TenantsReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	tenants, err := tenantsFromProto(req.Tenants)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	created, err := s.schemaManager.AddTenants(ctx, principal, req.Collection, tenants)
+	if err != nil {
+		return nil, schemaErrorStatus(err)
+	}
+	return tenantsReply(created), nil
+}
+
+func (s *Service) TenantsUpdate(ctx context.Context, req *pb.TenantsUpdateRequest) (*pb.TenantsReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	tenants, err := tenantsFromProto(req.Tenants)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.schemaManager.UpdateTenants(ctx, principal, req.Collection, tenants); err != nil {
+		return nil, schemaErrorStatus(err)
+	}
+	return tenantsReply(tenants), nil
+}
+
+func (s *Service) TenantsDelete(ctx context.Context, req *pb.TenantsDeleteRequest) (*pb.TenantsDeleteReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	if err := s.schemaManager.DeleteTenants(ctx, principal, req.Collection, req.Tenants); err != nil {
+		return nil, schemaErrorStatus(err)
+	}
+	return &pb.TenantsDeleteReply{}, nil
+}
+
+func (s *Service) NodesStatus(ctx context.Context, req *pb.NodesStatusRequest) (*pb.NodesStatusReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	output := verbosity.OutputMinimal
+	if req.Verbose {
+		output = verbosity.OutputVerbose
+	}
+	nodes, err := s.nodesManager.GetNodeStatus(ctx, principal, req.Collection, output)
+	if err != nil {
+		return nil, schemaErrorStatus(err)
+	}
+
+	reply := &pb.NodesStatusReply{Nodes: make([]*pb.NodesStatusReply_Node, 0, len(nodes))}
+	for _, node := range nodes {
+		n := &pb.NodesStatusReply_Node{
+			Name:    node.Name,
+			Version: node.Version,
+			GitHash: node.GitHash,
+		}
+		if node.Status != nil {
+			n.Status = *node.Status
+		}
+		if node.Stats != nil {
+			n.ShardCount = node.Stats.ShardCount
+			n.ObjectCount = node.Stats.ObjectCount
+		}
+		for _, shard := range node.Shards {
+			n.Shards = append(n.Shards, &pb.NodesStatusReply_Shard{
+				Name:                 shard.Name,
+				Collection:           shard.Class,
+				ObjectCount:          shard.ObjectCount,
+				VectorIndexingStatus: shard.VectorIndexingStatus,
+				VectorQueueLength:    shard.VectorQueueLength,
+				Compressed:           shard.Compressed,
+			})
+		}
+		reply.Nodes = append(reply.Nodes, n)
+	}
+	return reply, nil
+}
+
+// schemaErrorStatus maps the errors of schema changes like the REST API
+// does: they are invalid unless they are not authorized or do not find the
+// class
+func schemaErrorStatus(err error) error {
+	var forbidden autherrs.Forbidden
+	switch {
+	case errors.As(err, &forbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, schemaManager.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+}
+
+func collectionReply(class *models.Class) (*pb.CollectionReply, error) {
+	collection, err := collectionToProto(class)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.CollectionReply{Collection: collection}, nil
+}
+
+func collectionToProto(class *models.Class) (*pb.Collection, error) {
+	c := &pb.Collection{
+		Name:            class.Class,
+		Description:     class.Description,
+		Vectorizer:      class.Vectorizer,
+		VectorIndexType: class.VectorIndexType,
+	}
+	for _, prop := range class.Properties {
+		p, err := propertyToProto(prop)
+		if err != nil {
+			return nil, err
+		}
+		c.Properties = append(c.Properties, p)
+	}
+
+	configs := []struct {
+		name   string
+		config interface{}
+		target **structpb.Struct
+	}{
+		{"vectorIndexConfig", class.VectorIndexConfig, &c.VectorIndexConfig},
+		{"invertedIndexConfig", class.InvertedIndexConfig, &c.InvertedIndexConfig},
+		{"moduleConfig", class.ModuleConfig, &c.ModuleConfig},
+		{"replicationConfig", class.ReplicationConfig, &c.ReplicationConfig},
+		{"shardingConfig", class.ShardingConfig, &c.ShardingConfig},
+		{"multiTenancyConfig", class.MultiTenancyConfig, &c.MultiTenancyConfig},
+		{"queryConfig", class.QueryConfig, &c.QueryConfig},
+		{"versioningConfig", class.VersioningConfig, &c.VersioningConfig},
+	}
+	for _, config := range configs {
+		s, err := structFromConfig(config.config)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", config.name, err)
+		}
+		*config.target = s
+	}
+	return c, nil
+}
+
+func collectionFromProto(c *pb.Collection) (*models.Class, error) {
+	if c == nil {
+		return nil, fmt.Errorf("collection is required")
+	}
+
+	class := &models.Class{
+		Class:           c.Name,
+		Description:     c.Description,
+		Vectorizer:      c.Vectorizer,
+		VectorIndexType: c.VectorIndexType,
+		Properties:      []*models.Property{},
+	}
+	for _, p := range c.Properties {
+		prop, err := propertyFromProto(p)
+		if err != nil {
+			return nil, err
+		}
+		class.Properties = append(class.Properties, prop)
+	}
+
+	// configs without a schema are passed on as maps like the ones decoded
+	// from JSON by the REST API
+	class.VectorIndexConfig = structAsMap(c.VectorIndexConfig)
+	class.ModuleConfig = structAsMap(c.ModuleConfig)
+	class.ShardingConfig = structAsMap(c.ShardingConfig)
+
+	configs := []struct {
+		name   string
+		config *structpb.Struct
+		target interface{}
+	}{
+		{"invertedIndexConfig", c.InvertedIndexConfig, &class.InvertedIndexConfig},
+		{"replicationConfig", c.ReplicationConfig, &class.ReplicationConfig},
+		{"multiTenancyConfig", c.MultiTenancyConfig, &class.MultiTenancyConfig},
+		{"queryConfig", c.QueryConfig, &class.QueryConfig},
+		{"versioningConfig", c.VersioningConfig, &class.VersioningConfig},
+	}
+	for _, config := range configs {
+		if err := configFromStruct(config.config, config.target); err != nil {
+			return nil, fmt.Errorf("%s: %w", config.name, err)
+		}
+	}
+	return class, nil
+}
+
+func propertyToProto(prop *models.Property) (*pb.Property, error) {
+	moduleConfig, err := structFromConfig(prop.ModuleConfig)
+	if err != nil {
+		return nil, fmt.Errorf("property %q: moduleConfig: %w", prop.Name, err)
+	}
+	return &pb.Property{
+		Name:             prop.Name,
+		DataType:         prop.DataType,
+		Description:      prop.Description,
+		Tokenization:     prop.Tokenization,
+		IndexFilterable:  prop.IndexFilterable,
+		IndexSearchable:  prop.IndexSearchable,
+		ModuleConfig:     moduleConfig,
+		NestedProperties: nestedPropertiesToProto(prop.NestedProperties),
+		Encrypted:        prop.Encrypted,
+		Sensitive:        prop.Sensitive,
+	}, nil
+}
+
+func nestedPropertiesToProto(props []*models.NestedProperty) []*pb.Property {
+	if len(props) == 0 {
+		return nil
+	}
+	nested := make([]*pb.Property, len(props))
+	for i, prop := range props {
+		nested[i] = &pb.Property{
+			Name:             prop.Name,
+			DataType:         prop.DataType,
+			Description:      prop.Description,
+			Tokenization:     prop.Tokenization,
+			IndexFilterable:  prop.IndexFilterable,
+			IndexSearchable:  prop.IndexSearchable,
+			NestedProperties: nestedPropertiesToProto(prop.NestedProperties),
+		}
+	}
+	return nested
+}
+
+func propertyFromProto(p *pb.Property) (*models.Property, error) {
+	if p == nil {
+		return nil, fmt.Errorf("property is required")
+	}
+
+	nested, err := nestedPropertiesFromProto(p.NestedProperties)
+	if err != nil {
+		return nil, fmt.Errorf("property %q: %w", p.Name, err)
+	}
+	return &models.Property{
+		Name:             p.Name,
+		DataType:         p.DataType,
+		Description:      p.Description,
+		Tokenization:     p.Tokenization,
+		IndexFilterable:  p.IndexFilterable,
+		IndexSearchable:  p.IndexSearchable,
+		ModuleConfig:     structAsMap(p.ModuleConfig),
+		NestedProperties: nested,
+		Encrypted:        p.Encrypted,
+		Sensitive:        p.Sensitive,
+	}, nil
+}
+
+func nestedPropertiesFromProto(props []*pb.Property) ([]*models.NestedProperty, error) {
+	if len(props) == 0 {
+		return nil, nil
+	}
+	nested := make([]*models.NestedProperty, len(props))
+	for i, p := range props {
+		if p.ModuleConfig != nil || p.Encrypted || p.Sensitive {
+			return nil, fmt.Errorf("nested property %q: module config, encrypted and "+
+				"sensitive are only supported by top level properties", p.Name)
+		}
+		children, err := nestedPropertiesFromProto(p.NestedProperties)
+		if err != nil {
+			return nil, err
+		}
+		nested[i] = &models.NestedProperty{
+			Name:             p.Name,
+			DataType:         p.DataType,
+			Description:      p.Description,
+			Tokenization:     p.Tokenization,
+			IndexFilterable:  p.IndexFilterable,
+			IndexSearchable:  p.IndexSearchable,
+			NestedProperties: children,
+		}
+	}
+	return nested, nil
+}
+
+// structFromConfig encodes a config as the JSON object of the REST API
+func structFromConfig(config interface{}) (*structpb.Struct, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, nil
+	}
+	return structpb.NewStruct(m)
+}
+
+func structAsMap(s *structpb.Struct) interface{} {
+	if s == nil {
+		return nil
+	}
+	return s.AsMap()
+}
+
+func configFromStruct(s *structpb.Struct, target interface{}) error {
+	if s == nil {
+		return nil
+	}
+	b, err := json.Marshal(s.AsMap())
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, target)
+}
+
+var (
+	tenantStatusToProto = map[string]pb.TenantActivityStatus{
+		models.TenantActivityStatusHOT:    pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_HOT,
+		models.TenantActivityStatusWARM:   pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_WARM,
+		models.TenantActivityStatusCOLD:   pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_COLD,
+		models.TenantActivityStatusFROZEN: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_FROZEN,
+	}
+	tenantStatusFromProto = map[pb.TenantActivityStatus]string{
+		pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_UNSPECIFIED: "",
+		pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_HOT:         models.TenantActivityStatusHOT,
+		pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_WARM:        models.TenantActivityStatusWARM,
+		pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_COLD:        models.TenantActivityStatusCOLD,
+		pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_FROZEN:      models.TenantActivityStatusFROZEN,
+	}
+)
+
+func tenantsReply(tenants []*models.Tenant) *pb.TenantsReply {
+	reply := &pb.TenantsReply{Tenants: make([]*pb.Tenant, len(tenants))}
+	for i, tenant := range tenants {
+		reply.Tenants[i] = &pb.Tenant{
+			Name:           tenant.Name,
+			ActivityStatus: tenantStatusToProto[tenant.ActivityStatus],
+		}
+	}
+	return reply
+}
+
+// tenantsFromProto leaves the status of unspecified ones empty, tenants
+// without a status are created hot and are rejected by updates
+func tenantsFromProto(tenants []*pb.Tenant) ([]*models.Tenant, error) {
+	converted := make([]*models.Tenant, len(tenants))
+	for i, tenant := range tenants {
+		activityStatus, ok := tenantStatusFromProto[tenant.ActivityStatus]
+		if !ok {
+			return nil, fmt.Errorf("tenant %q: invalid activity status %v",
+				tenant.Name, tenant.ActivityStatus)
+		}
+		converted[i] = &models.Tenant{Name: tenant.Name, ActivityStatus: activityStatus}
+	}
+	return converted, nil
+}
@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestCollectionConversion(t *testing.T) {
+	vTrue := true
+	class := &models.Class{
+		Class:           "Article",
+		Description:     "articles",
+		Vectorizer:      "none",
+		VectorIndexType: "hnsw",
+		VectorIndexConfig: map[string]interface{}{
+			"distance": "cosine",
+			"ef":       float64(64),
+		},
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			IndexNullState: true,
+			Bm25:           &models.BM25Config{B: 0.75, K1: 1.2},
+		},
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		Properties: []*models.Property{
+			{
+				Name:            "title",
+				DataType:        []string{"text"},
+				Tokenization:    "word",
+				IndexFilterable: &vTrue,
+				ModuleConfig: map[string]interface{}{
+					"text2vec-contextionary": map[string]interface{}{"skip": true},
+				},
+				Sensitive: true,
+			},
+			{
+				Name:     "author",
+				DataType: []string{"object"},
+				NestedProperties: []*models.NestedProperty{
+					{Name: "name", DataType: []string{"text"}, IndexSearchable: &vTrue},
+				},
+			},
+		},
+	}
+
+	collection, err := collectionToProto(class)
+	require.Nil(t, err)
+	assert.Equal(t, "cosine", collection.VectorIndexConfig.Fields["distance"].GetStringValue())
+	assert.True(t, collection.MultiTenancyConfig.Fields["enabled"].GetBoolValue())
+	assert.Nil(t, collection.ReplicationConfig)
+	assert.True(t, collection.Properties[0].GetIndexFilterable())
+	assert.Nil(t, collection.Properties[0].IndexSearchable)
+
+	converted, err := collectionFromProto(collection)
+	require.Nil(t, err)
+	assert.Equal(t, class, converted)
+
+	t.Run("invalid config", func(t *testing.T) {
+		config, err := structpb.NewStruct(map[string]interface{}{"enabled": "yes"})
+		require.Nil(t, err)
+		_, err = collectionFromProto(&pb.Collection{Name: "Article", MultiTenancyConfig: config})
+		assert.ErrorContains(t, err, "multiTenancyConfig")
+
+		_, err = collectionFromProto(nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("nested module config", func(t *testing.T) {
+		_, err := propertyFromProto(&pb.Property{
+			Name:     "author",
+			DataType: []string{"object"},
+			NestedProperties: []*pb.Property{
+				{Name: "name", DataType: []string{"text"}, Sensitive: true},
+			},
+		})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestTenantsConversion(t *testing.T) {
+	tenants, err := tenantsFromProto([]*pb.Tenant{
+		{Name: "t1", ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_COLD},
+		{Name: "t2"},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []*models.Tenant{
+		{Name: "t1", ActivityStatus: models.TenantActivityStatusCOLD},
+		{Name: "t2"},
+	}, tenants)
+
+	_, err = tenantsFromProto([]*pb.Tenant{{Name: "t1", ActivityStatus: 42}})
+	assert.NotNil(t, err)
+
+	reply := tenantsReply([]*models.Tenant{{Name: "t1", ActivityStatus: models.TenantActivityStatusHOT}})
+	assert.Equal(t, pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_HOT, reply.Tenants[0].ActivityStatus)
+}
+
+func TestSchemaErrorStatus(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{autherrs.NewForbidden(&models.Principal{Username: "jane"}, "create", "schema/Article"), codes.PermissionDenied},
+		{fmt.Errorf("class %q: %w", "Article", schemaManager.ErrNotFound), codes.NotFound},
+		{errors.New("class name must start with a capital letter"), codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		st, ok := status.FromError(schemaErrorStatus(test.err))
+		require.True(t, ok)
+		assert.Equal(t, test.code, st.Code(), test.err.Error())
+	}
+}
+
+type fakeNodesManager struct {
+	verbosity string
+}
+
+func (f *fakeNodesManager) GetNodeStatus(ctx context.Context, principal *models.Principal,
+	className, verbosity string,
+) ([]*models.NodeStatus, error) {
+	f.verbosity = verbosity
+	healthy := models.NodeStatusStatusHEALTHY
+	return []*models.NodeStatus{{
+		Name:    "node1",
+		Status:  &healthy,
+		Version: "1.22.0",
+		Stats:   &models.NodeStats{ShardCount: 1, ObjectCount: 3},
+		Shards: []*models.NodeShardStatus{
+			{Name: "abc", Class: "Article", ObjectCount: 3, VectorIndexingStatus: "READY"},
+		},
+	}}, nil
+}
+
+func TestNodesStatus(t *testing.T) {
+	nodes := &fakeNodesManager{}
+	s := &Service{allowAnonymousAccess: true, nodesManager: nodes}
+
+	reply, err := s.NodesStatus(context.Background(), &pb.NodesStatusRequest{Verbose: true})
+	require.Nil(t, err)
+	assert.Equal(t, "verbose", nodes.verbosity)
+	assert.Equal(t, &pb.NodesStatusReply{Nodes: []*pb.NodesStatusReply_Node{{
+		Name:        "node1",
+		Status:      "HEALTHY",
+		Version:     "1.22.0",
+		ShardCount:  1,
+		ObjectCount: 3,
+		Shards: []*pb.NodesStatusReply_Shard{{
+			Name: "abc", Collection: "Article", ObjectCount: 3, VectorIndexingStatus: "READY",
+		}},
+	}}}, reply)
+}
@@ -13,9 +13,12 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/weaviate/weaviate/usecases/admission"
+	"github.com/weaviate/weaviate/usecases/ingest"
 	"github.com/weaviate/weaviate/usecases/objects"
 
 	"github.com/weaviate/weaviate/entities/additional"
@@ -23,8 +26,12 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/backpressure"
 	schemaManager "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/traverser"
+	"github.com/weaviate/weaviate/usecases/usage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Service struct {
@@ -34,11 +41,14 @@ type Service struct {
 	allowAnonymousAccess bool
 	schemaManager        *schemaManager.Manager
 	batchManager         *objects.BatchManager
+	nodesManager         nodesStatusGetter
+	health               HealthChecker
+	asker                Asker
 }
 
 func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 	allowAnonymousAccess bool, schemaManager *schemaManager.Manager,
-	batchManager *objects.BatchManager,
+	batchManager *objects.BatchManager, nodesManager nodesStatusGetter,
 ) *Service {
 	return &Service{
 		traverser:            traverser,
@@ -46,6 +56,7 @@ func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 		allowAnonymousAccess: allowAnonymousAccess,
 		schemaManager:        schemaManager,
 		batchManager:         batchManager,
+		nodesManager:         nodesManager,
 	}
 }
 
@@ -55,6 +66,7 @@ func (s *Service) BatchObjects(ctx context.Context, req *pb.BatchObjectsRequest)
 	if err != nil {
 		return nil, fmt.Errorf("extract auth: %w", err)
 	}
+	ctx = usage.WithPrincipal(ctx, principal, "")
 	scheme := s.schemaManager.GetSchemaSkipAuth()
 
 	objs, objOriginalIndex, objectParsingErrors := batchFromProto(req, scheme)
@@ -64,6 +76,13 @@ func (s *Service) BatchObjects(ctx context.Context, req *pb.BatchObjectsRequest)
 	all := "ALL"
 	response, err := s.batchManager.AddObjects(ctx, principal, objs, []*string{&all}, replicationProperties)
 	if err != nil {
+		// the retry hint of overloaded nodes is part of the message
+		if errors.As(err, &backpressure.ErrOverloaded{}) || errors.As(err, &ingest.ErrQueueFull{}) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		if errors.As(err, &backpressure.ErrBatchTooLarge{}) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
 	var objErrors []*pb.BatchObjectsReply_BatchError
@@ -92,6 +111,7 @@ func (s *Service) Search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 	if err != nil {
 		return nil, fmt.Errorf("extract auth: %w", err)
 	}
+	ctx = usage.WithPrincipal(ctx, principal, req.Tenant)
 
 	scheme := s.schemaManager.GetSchemaSkipAuth()
 
@@ -130,11 +150,12 @@ func (s *Service) Search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 		if err != nil {
 			c <- reply{
 				Result: nil,
-				Error:  err,
+				Error:  searchError(err),
 			}
 		}
 
 		proto, err := searchResultsToProto(res, before, searchParams, scheme, req.Uses_123Api)
+		encodeReplyVectors(proto, req.VectorEncoding)
 		c <- reply{
 			Result: proto,
 			Error:  err,
@@ -144,6 +165,18 @@ func (s *Service) Search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 	return res.Result, res.Error
 }
 
+// searchError returns the status of queries which were not admitted, the
+// retry hint is part of the message
+func searchError(err error) error {
+	if errors.As(err, &admission.ErrOverloaded{}) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if errors.As(err, &admission.ErrCircuitOpen{}) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return err
+}
+
 func (s *Service) validateClassAndProperty(searchParams dto.GetParams) error {
 	scheme := s.schemaManager.GetSchemaSkipAuth()
 	class, err := schema.GetClassByName(scheme.Objects, searchParams.ClassName)
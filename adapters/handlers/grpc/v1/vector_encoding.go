@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"fmt"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+// vectorFromBytes decodes vector_bytes of the given encoding
+func vectorFromBytes(encoding pb.VectorEncoding, in []byte) ([]float32, error) {
+	switch encoding {
+	case pb.VectorEncoding_VECTOR_ENCODING_UNSPECIFIED, pb.VectorEncoding_VECTOR_ENCODING_FLOAT32:
+		if len(in)%4 != 0 {
+			return nil, fmt.Errorf("float32 vector has %d bytes, which is not a multiple of 4", len(in))
+		}
+		return byteops.Float32FromByteVector(in), nil
+	case pb.VectorEncoding_VECTOR_ENCODING_FLOAT16:
+		if len(in)%2 != 0 {
+			return nil, fmt.Errorf("float16 vector has %d bytes, which is not a multiple of 2", len(in))
+		}
+		return byteops.Float32FromFloat16ByteVector(in), nil
+	default:
+		return nil, fmt.Errorf("unknown vector encoding %v", encoding)
+	}
+}
+
+// encodeReplyVectors converts the float32 vector_bytes of the reply, its
+// references included, to the requested encoding
+func encodeReplyVectors(reply *pb.SearchReply, encoding pb.VectorEncoding) {
+	if reply == nil || encoding != pb.VectorEncoding_VECTOR_ENCODING_FLOAT16 {
+		return
+	}
+	for _, res := range reply.Results {
+		encodeResultVectors(res)
+	}
+	for _, group := range reply.GroupByResults {
+		for _, res := range group.Objects {
+			encodeResultVectors(res)
+		}
+	}
+}
+
+func encodeResultVectors(res *pb.SearchResult) {
+	if res == nil {
+		return
+	}
+	encodeMetadataVector(res.Metadata)
+	encodePropertiesVectors(res.Properties)
+}
+
+func encodePropertiesVectors(props *pb.PropertiesResult) {
+	if props == nil {
+		return
+	}
+	encodeMetadataVector(props.Metadata)
+	for _, ref := range props.RefProps {
+		for _, refProps := range ref.Properties {
+			encodePropertiesVectors(refProps)
+		}
+	}
+}
+
+func encodeMetadataVector(metadata *pb.MetadataResult) {
+	if metadata == nil || len(metadata.VectorBytes) == 0 {
+		return
+	}
+	metadata.VectorBytes = byteops.Float16ToByteVector(byteops.Float32FromByteVector(metadata.VectorBytes))
+}
@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+func TestEncodeReplyVectors(t *testing.T) {
+	vector := []float32{0.5, -2, 3}
+	newReply := func() *pb.SearchReply {
+		return &pb.SearchReply{
+			Results: []*pb.SearchResult{{
+				Metadata: &pb.MetadataResult{VectorBytes: byteops.Float32ToByteVector(vector)},
+				Properties: &pb.PropertiesResult{
+					RefProps: []*pb.RefPropertiesResult{{
+						Properties: []*pb.PropertiesResult{{
+							Metadata: &pb.MetadataResult{VectorBytes: byteops.Float32ToByteVector(vector)},
+						}},
+					}},
+				},
+			}},
+			GroupByResults: []*pb.GroupByResult{{
+				Objects: []*pb.SearchResult{{
+					Metadata: &pb.MetadataResult{VectorBytes: byteops.Float32ToByteVector(vector)},
+				}},
+			}},
+		}
+	}
+
+	t.Run("float32 is left untouched", func(t *testing.T) {
+		reply := newReply()
+		encodeReplyVectors(reply, pb.VectorEncoding_VECTOR_ENCODING_UNSPECIFIED)
+		require.Equal(t, newReply(), reply)
+	})
+
+	t.Run("float16", func(t *testing.T) {
+		reply := newReply()
+		encodeReplyVectors(reply, pb.VectorEncoding_VECTOR_ENCODING_FLOAT16)
+		expected := byteops.Float16ToByteVector(vector)
+		require.Equal(t, expected, reply.Results[0].Metadata.VectorBytes)
+		require.Equal(t, expected, reply.Results[0].Properties.RefProps[0].Properties[0].Metadata.VectorBytes)
+		require.Equal(t, expected, reply.GroupByResults[0].Objects[0].Metadata.VectorBytes)
+	})
+}
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package zstd
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+)
+
+func TestCompressor(t *testing.T) {
+	c := encoding.GetCompressor(Name)
+	require.NotNil(t, c)
+
+	msg := bytes.Repeat([]byte("vector"), 1000)
+	// the second round reuses the pooled encoder and decoder
+	for i := 0; i < 2; i++ {
+		var buf bytes.Buffer
+		w, err := c.Compress(&buf)
+		require.Nil(t, err)
+		_, err = w.Write(msg)
+		require.Nil(t, err)
+		require.Nil(t, w.Close())
+		assert.Less(t, buf.Len(), len(msg))
+
+		r, err := c.Decompress(&buf)
+		require.Nil(t, err)
+		decompressed, err := io.ReadAll(r)
+		require.Nil(t, err)
+		assert.Equal(t, msg, decompressed)
+	}
+
+	t.Run("corrupt message", func(t *testing.T) {
+		r, err := c.Decompress(bytes.NewReader([]byte("not zstd")))
+		if err == nil {
+			_, err = io.ReadAll(r)
+		}
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,485 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package pgwire
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/sql"
+)
+
+const (
+	protocolVersion = 196608 // 3.0
+	sslRequestCode  = 80877103
+	gssRequestCode  = 80877104
+	cancelCode      = 80877102
+
+	maxMessageSize = 1 << 24
+
+	startupParameterTenant = "tenant"
+)
+
+// type oids of the row descriptions
+const (
+	oidBool   = 16
+	oidInt8   = 20
+	oidText   = 25
+	oidFloat8 = 701
+	oidUUID   = 2950
+)
+
+// error codes of error responses
+const (
+	codeSyntaxError         = "42601"
+	codeInsufficientPrivs   = "42501"
+	codeInvalidPassword     = "28P01"
+	codeFeatureNotSupported = "0A000"
+	codeProtocolViolation   = "08P01"
+	codeInternalError       = "XX000"
+)
+
+type executor interface {
+	Query(ctx context.Context, principal *models.Principal,
+		query, tenant string) (*sql.Result, error)
+}
+
+// Server serves SQL queries with the simple query protocol of Postgres, so
+// that BI tools and Postgres drivers can query classes. The password is the
+// API key or OIDC token of the user, it can be empty if anonymous access is
+// enabled. Queries address the tenant of the startup parameter "tenant"
+// (e.g. options='-c tenant=...' with libpq). TLS and the extended query
+// protocol are not supported.
+type Server struct {
+	executor             executor
+	authComposer         composer.TokenFunc
+	allowAnonymousAccess bool
+	logger               logrus.FieldLogger
+
+	sync.Mutex
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+func NewServer(executor executor, authComposer composer.TokenFunc,
+	allowAnonymousAccess bool, logger logrus.FieldLogger,
+) *Server {
+	return &Server{
+		executor:             executor,
+		authComposer:         authComposer,
+		allowAnonymousAccess: allowAnonymousAccess,
+		logger:               logger,
+		conns:                map[net.Conn]struct{}{},
+	}
+}
+
+// Serve accepts connections until the server is closed
+func (s *Server) Serve(l net.Listener) error {
+	s.Lock()
+	if s.closed {
+		s.Unlock()
+		l.Close()
+		return nil
+	}
+	s.listener = l
+	s.Unlock()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			s.Lock()
+			closed := s.closed
+			s.Unlock()
+			if closed {
+				return nil
+			}
+			return err
+		}
+
+		s.Lock()
+		if s.closed {
+			s.Unlock()
+			conn.Close()
+			return nil
+		}
+		s.conns[conn] = struct{}{}
+		s.wg.Add(1)
+		s.Unlock()
+
+		go func() {
+			defer s.wg.Done()
+			defer func() {
+				s.Lock()
+				delete(s.conns, conn)
+				s.Unlock()
+				conn.Close()
+			}()
+			if err := s.serveConn(conn); err != nil && !errors.Is(err, io.EOF) &&
+				!errors.Is(err, net.ErrClosed) {
+				s.logger.WithField("action", "pgwire_conn").WithError(err).
+					Debug("connection closed")
+			}
+		}()
+	}
+}
+
+// Close stops accepting connections and closes the open ones
+func (s *Server) Close() error {
+	if s == nil {
+		return nil
+	}
+
+	s.Lock()
+	s.closed = true
+	var err error
+	if s.listener != nil {
+		err = s.listener.Close()
+	}
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.Unlock()
+
+	s.wg.Wait()
+	return err
+}
+
+type conn struct {
+	r *bufio.Reader
+	w *bufio.Writer
+}
+
+func (s *Server) serveConn(nc net.Conn) error {
+	c := &conn{r: bufio.NewReader(nc), w: bufio.NewWriter(nc)}
+
+	params, err := c.startup()
+	if err != nil || params == nil {
+		return err
+	}
+
+	principal, err := s.authenticate(c)
+	if err != nil {
+		return err
+	}
+
+	c.writeMessage('R', binary.BigEndian.AppendUint32(nil, 0))
+	for _, p := range [][2]string{
+		{"server_version", "14.0"},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO, MDY"},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+	} {
+		c.writeMessage('S', cstrings(p[0], p[1]))
+	}
+	c.writeReady()
+	if err := c.w.Flush(); err != nil {
+		return err
+	}
+
+	tenant := params[startupParameterTenant]
+	// an error of the extended protocol discards messages until Sync
+	discarding := false
+	for {
+		typ, body, err := c.readMessage()
+		if err != nil {
+			return err
+		}
+
+		switch typ {
+		case 'X':
+			return nil
+		case 'Q':
+			query := strings.TrimSpace(strings.TrimRight(string(body), "\x00"))
+			s.query(c, principal, query, tenant)
+			c.writeReady()
+		case 'S':
+			discarding = false
+			c.writeReady()
+		case 'H':
+		default:
+			if !discarding {
+				c.writeError(codeFeatureNotSupported,
+					"only the simple query protocol is supported")
+				discarding = true
+			}
+		}
+		if err := c.w.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+// startup reads the startup message, it declines SSL and GSS encryption.
+// The parameters are nil if the connection is a cancel request.
+func (c *conn) startup() (map[string]string, error) {
+	for {
+		var header [8]byte
+		if _, err := io.ReadFull(c.r, header[:]); err != nil {
+			return nil, err
+		}
+		size := int(binary.BigEndian.Uint32(header[:4]))
+		if size < 8 || size > maxMessageSize {
+			return nil, fmt.Errorf("invalid startup message size %d", size)
+		}
+		body := make([]byte, size-8)
+		if _, err := io.ReadFull(c.r, body); err != nil {
+			return nil, err
+		}
+
+		switch code := binary.BigEndian.Uint32(header[4:]); code {
+		case sslRequestCode, gssRequestCode:
+			c.w.WriteByte('N')
+			if err := c.w.Flush(); err != nil {
+				return nil, err
+			}
+		case cancelCode:
+			return nil, nil
+		case protocolVersion:
+			return parseStartupParameters(body), nil
+		default:
+			c.writeError(codeProtocolViolation,
+				fmt.Sprintf("unsupported protocol version %d.%d", code>>16, code&0xffff))
+			return nil, c.w.Flush()
+		}
+	}
+}
+
+func parseStartupParameters(body []byte) map[string]string {
+	params := map[string]string{}
+	fields := strings.Split(string(body), "\x00")
+	for i := 0; i+1 < len(fields) && fields[i] != ""; i += 2 {
+		params[fields[i]] = fields[i+1]
+	}
+
+	// libpq passes custom parameters as -c name=value in the options
+	options := strings.Fields(params["options"])
+	for i := 0; i < len(options); i++ {
+		option := options[i]
+		if option == "-c" && i+1 < len(options) {
+			i++
+			option = options[i]
+		} else if !strings.HasPrefix(option, "-c") {
+			continue
+		}
+		option = strings.TrimPrefix(option, "-c")
+		if name, value, ok := strings.Cut(option, "="); ok {
+			if _, set := params[name]; !set {
+				params[name] = value
+			}
+		}
+	}
+	return params
+}
+
+// authenticate requests the password, which is the token of the user
+func (s *Server) authenticate(c *conn) (*models.Principal, error) {
+	c.writeMessage('R', binary.BigEndian.AppendUint32(nil, 3))
+	if err := c.w.Flush(); err != nil {
+		return nil, err
+	}
+
+	typ, body, err := c.readMessage()
+	if err != nil {
+		return nil, err
+	}
+	if typ != 'p' {
+		c.writeError(codeProtocolViolation, "expected a password message")
+		return nil, errors.Join(c.w.Flush(), fmt.Errorf("unexpected message %q", typ))
+	}
+
+	token := strings.TrimRight(string(body), "\x00")
+	var principal *models.Principal
+	if token != "" || !s.allowAnonymousAccess {
+		principal, err = s.authComposer(token, nil)
+	}
+	if err != nil {
+		c.writeError(codeInvalidPassword, err.Error())
+		return nil, errors.Join(c.w.Flush(), err)
+	}
+	return principal, nil
+}
+
+func (s *Server) query(c *conn, principal *models.Principal, query, tenant string) {
+	if strings.Trim(query, "; \t\r\n") == "" {
+		c.writeMessage('I', nil)
+		return
+	}
+
+	res, err := s.executor.Query(context.Background(), principal, query, tenant)
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.Is(err, sql.ErrInvalidQuery):
+			c.writeError(codeSyntaxError, err.Error())
+		case errors.As(err, &forbidden):
+			c.writeError(codeInsufficientPrivs, err.Error())
+		default:
+			c.writeError(codeInternalError, err.Error())
+		}
+		return
+	}
+
+	oids := make([]uint32, len(res.Columns))
+	description := binary.BigEndian.AppendUint16(nil, uint16(len(res.Columns)))
+	for i, column := range res.Columns {
+		oids[i] = typeOID(column.Type)
+		description = append(description, cstrings(column.Name)...)
+		description = binary.BigEndian.AppendUint32(description, 0) // table
+		description = binary.BigEndian.AppendUint16(description, 0) // attribute
+		description = binary.BigEndian.AppendUint32(description, oids[i])
+		description = binary.BigEndian.AppendUint16(description, typeSize(oids[i]))
+		description = binary.BigEndian.AppendUint32(description, 0xffffffff) // modifier
+		description = binary.BigEndian.AppendUint16(description, 0)          // text format
+	}
+	c.writeMessage('T', description)
+
+	for _, row := range res.Rows {
+		data := binary.BigEndian.AppendUint16(nil, uint16(len(row)))
+		for i, value := range row {
+			text, ok := formatValue(value, oids[i])
+			if !ok {
+				data = binary.BigEndian.AppendUint32(data, 0xffffffff)
+				continue
+			}
+			data = binary.BigEndian.AppendUint32(data, uint32(len(text)))
+			data = append(data, text...)
+		}
+		c.writeMessage('D', data)
+	}
+	c.writeMessage('C', cstrings(fmt.Sprintf("SELECT %d", len(res.Rows))))
+}
+
+func typeOID(dataType string) uint32 {
+	switch schema.DataType(dataType) {
+	case schema.DataTypeInt:
+		return oidInt8
+	case schema.DataTypeNumber:
+		return oidFloat8
+	case schema.DataTypeBoolean:
+		return oidBool
+	case schema.DataTypeUUID:
+		return oidUUID
+	default:
+		// arrays and other types are encoded as JSON
+		return oidText
+	}
+}
+
+func typeSize(oid uint32) uint16 {
+	switch oid {
+	case oidBool:
+		return 1
+	case oidInt8, oidFloat8:
+		return 8
+	case oidUUID:
+		return 16
+	default:
+		return 0xffff // variable
+	}
+}
+
+// formatValue returns the text format of a value, it returns false for
+// NULL
+func formatValue(value interface{}, oid uint32) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	case fmt.Stringer:
+		return v.String(), true
+	case bool:
+		if v {
+			return "t", true
+		}
+		return "f", true
+	case float64:
+		if oid == oidInt8 {
+			return strconv.FormatFloat(v, 'f', -1, 64), true
+		}
+		return strconv.FormatFloat(v, 'g', -1, 64), true
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32), true
+	case int, int32, int64:
+		return fmt.Sprint(v), true
+	default:
+		text, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v), true
+		}
+		return string(text), true
+	}
+}
+
+func (c *conn) readMessage() (byte, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(c.r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	size := int(binary.BigEndian.Uint32(header[1:]))
+	if size < 4 || size > maxMessageSize {
+		return 0, nil, fmt.Errorf("invalid message size %d", size)
+	}
+	body := make([]byte, size-4)
+	if _, err := io.ReadFull(c.r, body); err != nil {
+		return 0, nil, err
+	}
+	return header[0], body, nil
+}
+
+func (c *conn) writeMessage(typ byte, body []byte) {
+	c.w.WriteByte(typ)
+	c.w.Write(binary.BigEndian.AppendUint32(nil, uint32(len(body)+4)))
+	c.w.Write(body)
+}
+
+func (c *conn) writeReady() {
+	c.writeMessage('Z', []byte{'I'})
+}
+
+func (c *conn) writeError(code, message string) {
+	body := []byte{}
+	for _, field := range [][2]string{
+		{"S", "ERROR"}, {"V", "ERROR"}, {"C", code}, {"M", message},
+	} {
+		body = append(body, field[0][0])
+		body = append(body, cstrings(field[1])...)
+	}
+	c.writeMessage('E', append(body, 0))
+}
+
+func cstrings(values ...string) []byte {
+	var b []byte
+	for _, v := range values {
+		b = append(b, v...)
+		b = append(b, 0)
+	}
+	return b
+}
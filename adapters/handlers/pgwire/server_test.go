@@ -0,0 +1,236 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package pgwire
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sql"
+)
+
+type fakeExecutor struct {
+	tenant    string
+	principal *models.Principal
+}
+
+func (f *fakeExecutor) Query(ctx context.Context, principal *models.Principal,
+	query, tenant string,
+) (*sql.Result, error) {
+	f.tenant = tenant
+	f.principal = principal
+	if query != "SELECT * FROM Article" {
+		return nil, errors.New("invalid query: unexpected")
+	}
+	return &sql.Result{
+		Columns: []sql.Column{
+			{Name: "id", Type: "uuid"},
+			{Name: "title", Type: "text"},
+			{Name: "wordCount", Type: "int"},
+			{Name: "tags", Type: "text[]"},
+		},
+		Rows: [][]interface{}{
+			{strfmt.UUID("5a4bd6d1-3a9b-4a58-8c18-67b8b2dc6c9a"), "hello", float64(1000000), []string{"a"}},
+			{strfmt.UUID("6a4bd6d1-3a9b-4a58-8c18-67b8b2dc6c9a"), nil, nil, nil},
+		},
+	}, nil
+}
+
+type message struct {
+	typ  byte
+	body []byte
+}
+
+type client struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func (c *client) send(typ byte, body []byte) {
+	msg := []byte{}
+	if typ != 0 {
+		msg = append(msg, typ)
+	}
+	msg = binary.BigEndian.AppendUint32(msg, uint32(len(body)+4))
+	c.conn.Write(append(msg, body...))
+}
+
+func (c *client) receive(t *testing.T) message {
+	var header [5]byte
+	_, err := io.ReadFull(c.r, header[:])
+	require.Nil(t, err)
+	body := make([]byte, binary.BigEndian.Uint32(header[1:])-4)
+	_, err = io.ReadFull(c.r, body)
+	require.Nil(t, err)
+	return message{typ: header[0], body: body}
+}
+
+// receiveUntilReady returns the types of the messages until ReadyForQuery
+// and the messages themselves
+func (c *client) receiveUntilReady(t *testing.T) (string, []message) {
+	var types string
+	var msgs []message
+	for {
+		msg := c.receive(t)
+		types += string(msg.typ)
+		msgs = append(msgs, msg)
+		if msg.typ == 'Z' {
+			return types, msgs
+		}
+	}
+}
+
+func newTestServer(t *testing.T, allowAnonymous bool) (*Server, *fakeExecutor, string) {
+	logger, _ := test.NewNullLogger()
+	executor := &fakeExecutor{}
+	auth := func(token string, scopes []string) (*models.Principal, error) {
+		if token != "secret" {
+			return nil, errors.New("invalid api key")
+		}
+		return &models.Principal{Username: "jane"}, nil
+	}
+	s := NewServer(executor, auth, allowAnonymous, logger)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	go s.Serve(l)
+	t.Cleanup(func() { s.Close() })
+	return s, executor, l.Addr().String()
+}
+
+func connect(t *testing.T, addr string, params ...string) *client {
+	conn, err := net.Dial("tcp", addr)
+	require.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+	c := &client{conn: conn, r: bufio.NewReader(conn)}
+
+	// SSL is declined
+	c.send(0, binary.BigEndian.AppendUint32(nil, sslRequestCode))
+	b, err := c.r.ReadByte()
+	require.Nil(t, err)
+	require.Equal(t, byte('N'), b)
+
+	c.send(0, append(binary.BigEndian.AppendUint32(nil, protocolVersion),
+		append(cstrings(params...), 0)...))
+	msg := c.receive(t)
+	require.Equal(t, byte('R'), msg.typ)
+	require.Equal(t, uint32(3), binary.BigEndian.Uint32(msg.body))
+	return c
+}
+
+func TestServer(t *testing.T) {
+	_, executor, addr := newTestServer(t, false)
+	c := connect(t, addr, "user", "jane", "options", "-c tenant=tenant1")
+	c.send('p', cstrings("secret"))
+	types, _ := c.receiveUntilReady(t)
+	assert.Equal(t, byte('R'), types[0])
+	assert.Equal(t, byte('Z'), types[len(types)-1])
+
+	t.Run("query", func(t *testing.T) {
+		c.send('Q', cstrings("SELECT * FROM Article"))
+		types, msgs := c.receiveUntilReady(t)
+		require.Equal(t, "TDDCZ", types)
+		assert.Equal(t, "tenant1", executor.tenant)
+		assert.Equal(t, "jane", executor.principal.Username)
+
+		description := msgs[0].body
+		assert.Equal(t, uint16(4), binary.BigEndian.Uint16(description))
+		assert.True(t, strings.HasPrefix(string(description[2:]), "id\x00"))
+
+		assert.Equal(t, []string{
+			"5a4bd6d1-3a9b-4a58-8c18-67b8b2dc6c9a", "hello", "1000000", `["a"]`,
+		}, dataRow(msgs[1].body))
+		assert.Equal(t, []string{"6a4bd6d1-3a9b-4a58-8c18-67b8b2dc6c9a", "NULL", "NULL", "NULL"},
+			dataRow(msgs[2].body))
+		assert.Equal(t, "SELECT 2\x00", string(msgs[3].body))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		c.send('Q', cstrings("SELECT version()"))
+		types, msgs := c.receiveUntilReady(t)
+		require.Equal(t, "EZ", types)
+		assert.Contains(t, string(msgs[0].body), "unexpected")
+
+		c.send('Q', cstrings(""))
+		types, _ = c.receiveUntilReady(t)
+		assert.Equal(t, "IZ", types)
+	})
+
+	t.Run("extended protocol", func(t *testing.T) {
+		c.send('P', cstrings("", "SELECT * FROM Article"))
+		c.send('B', nil)
+		c.send('S', nil)
+		types, _ := c.receiveUntilReady(t)
+		assert.Equal(t, "EZ", types)
+	})
+
+	c.send('X', nil)
+	_, err := c.r.ReadByte()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestServerAuthentication(t *testing.T) {
+	t.Run("invalid password", func(t *testing.T) {
+		_, _, addr := newTestServer(t, true)
+		c := connect(t, addr, "user", "jane")
+		c.send('p', cstrings("wrong"))
+		msg := c.receive(t)
+		assert.Equal(t, byte('E'), msg.typ)
+		assert.Contains(t, string(msg.body), codeInvalidPassword)
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		_, executor, addr := newTestServer(t, true)
+		c := connect(t, addr, "user", "jane")
+		c.send('p', cstrings(""))
+		c.receiveUntilReady(t)
+		c.send('Q', cstrings("SELECT * FROM Article"))
+		types, _ := c.receiveUntilReady(t)
+		assert.Equal(t, "TDDCZ", types)
+		assert.Nil(t, executor.principal)
+	})
+
+	t.Run("anonymous disabled", func(t *testing.T) {
+		_, _, addr := newTestServer(t, false)
+		c := connect(t, addr, "user", "jane")
+		c.send('p', cstrings(""))
+		msg := c.receive(t)
+		assert.Equal(t, byte('E'), msg.typ)
+	})
+}
+
+func dataRow(body []byte) []string {
+	n := int(binary.BigEndian.Uint16(body))
+	body = body[2:]
+	values := make([]string, n)
+	for i := range values {
+		size := int32(binary.BigEndian.Uint32(body))
+		body = body[4:]
+		if size < 0 {
+			values[i] = "NULL"
+			continue
+		}
+		values[i] = string(body[:size])
+		body = body[size:]
+	}
+	return values
+}
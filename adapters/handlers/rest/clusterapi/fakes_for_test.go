@@ -13,6 +13,7 @@ package clusterapi_test
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
@@ -65,6 +66,14 @@ func (f *fakeRepo) DeleteShards(ctx context.Context, class string, shards []stri
 	return nil
 }
 
+func (f *fakeRepo) PutMetadata(ctx context.Context, key string, value []byte) error {
+	return nil
+}
+
+func (f *fakeRepo) DeleteMetadata(ctx context.Context, key string) error {
+	return nil
+}
+
 type fakeAuthorizer struct{}
 
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
@@ -234,6 +243,18 @@ func (n *NilMigrator) DeleteTenants(ctx context.Context, class *models.Class, pa
 	return nil, nil
 }
 
+func (n *NilMigrator) DropShards(ctx context.Context, className string, shards []string) error {
+	return nil
+}
+
+func (n *NilMigrator) AddShards(ctx context.Context, class *models.Class, shards []string) error {
+	return nil
+}
+
+func (n *NilMigrator) MoveReshardedObjects(ctx context.Context, className, shard string, moved *atomic.Int64) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
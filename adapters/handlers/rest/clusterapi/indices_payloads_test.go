@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
 )
 
 func Test_objectListPayload_Marshal(t *testing.T) {
@@ -94,3 +95,27 @@ func Test_objectListPayload_Marshal(t *testing.T) {
 	assert.EqualValues(t, objs[2].Object, received[1].Object)
 	assert.EqualValues(t, objs[2].ID(), received[1].ID())
 }
+
+func Test_referenceListPayload_Marshal(t *testing.T) {
+	source, err := crossref.ParseSource(
+		"weaviate://localhost/SomeClass/c6f85bf5-c3b7-4c1d-bd51-e899f9605336/hasOther")
+	require.Nil(t, err)
+	target := crossref.New("localhost", "OtherClass", "88750a99-a72d-46c2-a582-89f02654391d")
+
+	refs := objects.BatchReferences{
+		{OriginalIndex: 3, From: source, To: target, Tenant: "tenant1", UpdateTime: 1234},
+	}
+
+	payload := referenceListPayload{}
+	marshalled, err := payload.Marshal(refs)
+	require.Nil(t, err)
+
+	unmarshalled, err := payload.Unmarshal(marshalled)
+	require.Nil(t, err)
+	require.Len(t, unmarshalled, 1)
+	assert.Equal(t, int64(1234), unmarshalled[0].UpdateTime,
+		"replicas must receive the update time assigned by the coordinator")
+	assert.Equal(t, "tenant1", unmarshalled[0].Tenant)
+	assert.Equal(t, source, unmarshalled[0].From)
+	assert.Equal(t, target, unmarshalled[0].To)
+}
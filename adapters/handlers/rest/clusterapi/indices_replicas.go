@@ -54,6 +54,11 @@ type replicator interface {
 		shardName string, ids []strfmt.UUID) ([]objects.Replica, error)
 	DigestObjects(ctx context.Context, class, shardName string,
 		ids []strfmt.UUID) (result []replica.RepairResponse, err error)
+	// Anti-entropy endpoints
+	HashTreeLeaves(ctx context.Context, class, shardName string,
+		depth int) ([]uint64, error)
+	LeafDigests(ctx context.Context, class, shardName string,
+		depth, leaf int) ([]replica.RepairResponse, error)
 }
 
 type localScaler interface {
@@ -74,6 +79,10 @@ var (
 		`\/shards\/(` + sh + `)\/objects/_overwrite`)
 	regxObjectsDigest = regexp.MustCompile(`\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects/_digest`)
+	regxHashTree = regexp.MustCompile(`\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objects/_hashtree`)
+	regxLeafDigest = regexp.MustCompile(`\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objects/_leafdigest`)
 	regxObjects = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects`)
 	regxReferences = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
@@ -100,6 +109,22 @@ func (i *replicatedIndices) indicesHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		path := r.URL.Path
 		switch {
+		case regxHashTree.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getHashTree().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case regxLeafDigest.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getLeafDigest().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
 		case regxObjectsDigest.MatchString(path):
 			if r.Method == http.MethodGet {
 				i.getObjectsDigest().ServeHTTP(w, r)
@@ -380,6 +405,88 @@ func (i *replicatedIndices) getObjectsDigest() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) getHashTree() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxHashTree.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var req replica.HashTreeRequest
+		if err := json.Unmarshal(reqPayload, &req); err != nil {
+			http.Error(w, "unmarshal hash tree params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		results, err := i.shards.HashTreeLeaves(r.Context(), index, shard, req.Depth)
+		if err != nil {
+			http.Error(w, "hash tree: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := json.Marshal(results)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(resBytes)
+	})
+}
+
+func (i *replicatedIndices) getLeafDigest() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxLeafDigest.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var req replica.HashTreeRequest
+		if err := json.Unmarshal(reqPayload, &req); err != nil {
+			http.Error(w, "unmarshal leaf digest params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		results, err := i.shards.LeafDigests(r.Context(), index, shard, req.Depth, req.Leaf)
+		if err != nil {
+			http.Error(w, "leaf digests: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := json.Marshal(results)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(resBytes)
+	})
+}
+
 func (i *replicatedIndices) putOverwriteObjects() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxOverwriteObjects.FindStringSubmatch(r.URL.Path)
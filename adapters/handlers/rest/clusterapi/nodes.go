@@ -14,31 +14,49 @@ package clusterapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
 
+	"github.com/go-openapi/strfmt"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	entschema "github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/entities/verbosity"
 )
 
 type nodesManager interface {
 	GetNodeStatus(ctx context.Context, className, output string) (*models.NodeStatus, error)
+	GetTenantStats(ctx context.Context, className, tenant string) (*entschema.TenantStats, error)
+	GetObjectVersions(ctx context.Context, className, shard string,
+		id strfmt.UUID) ([]*storobj.ObjectVersion, error)
+}
+
+// drainer marks the local node as draining
+type drainer interface {
+	SetDraining(draining bool) error
 }
 
 type nodes struct {
 	nodesManager nodesManager
+	drainer      drainer
 	auth         auth
 }
 
-func NewNodes(manager nodesManager, auth auth) *nodes {
-	return &nodes{nodesManager: manager, auth: auth}
+func NewNodes(manager nodesManager, drainer drainer, auth auth) *nodes {
+	return &nodes{nodesManager: manager, drainer: drainer, auth: auth}
 }
 
 var (
-	regxNodes      = regexp.MustCompile(`/status`)
-	regxNodesClass = regexp.MustCompile(`/status/(` + entschema.ClassNameRegexCore + `)`)
+	regxNodes       = regexp.MustCompile(`/status`)
+	regxDrain       = regexp.MustCompile(`^/nodes/drain$`)
+	regxNodesClass  = regexp.MustCompile(`/status/(` + entschema.ClassNameRegexCore + `)`)
+	regxTenantStats = regexp.MustCompile(`/tenant-stats/(` + entschema.ClassNameRegexCore +
+		`)/(` + entschema.ShardNameRegexCore + `)$`)
+	regxObjectVersions = regexp.MustCompile(`/object-versions/(` + entschema.ClassNameRegexCore +
+		`)/(` + entschema.ShardNameRegexCore + `)/(` + ob + `)$`)
 )
 
 func (s *nodes) Nodes() http.Handler {
@@ -49,6 +67,33 @@ func (s *nodes) nodesHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		path := r.URL.Path
 		switch {
+		case regxDrain.MatchString(path):
+			if r.Method != http.MethodPut && r.Method != http.MethodDelete {
+				msg := fmt.Sprintf("/nodes api path %q not found", path)
+				http.Error(w, msg, http.StatusMethodNotAllowed)
+				return
+			}
+
+			s.incomingDrain().ServeHTTP(w, r)
+			return
+		case regxTenantStats.MatchString(path):
+			if r.Method != http.MethodGet {
+				msg := fmt.Sprintf("/nodes api path %q not found", path)
+				http.Error(w, msg, http.StatusMethodNotAllowed)
+				return
+			}
+
+			s.incomingTenantStats().ServeHTTP(w, r)
+			return
+		case regxObjectVersions.MatchString(path):
+			if r.Method != http.MethodGet {
+				msg := fmt.Sprintf("/nodes api path %q not found", path)
+				http.Error(w, msg, http.StatusMethodNotAllowed)
+				return
+			}
+
+			s.incomingObjectVersions().ServeHTTP(w, r)
+			return
 		case regxNodes.MatchString(path) || regxNodesClass.MatchString(path):
 			if r.Method != http.MethodGet {
 				msg := fmt.Sprintf("/nodes api path %q not found", path)
@@ -103,3 +148,83 @@ func (s *nodes) incomingNodeStatus() http.Handler {
 		w.Write(nodeStatusBytes)
 	})
 }
+
+func (s *nodes) incomingTenantStats() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		args := regxTenantStats.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		stats, err := s.nodesManager.GetTenantStats(r.Context(), args[1], args[2])
+		if err != nil {
+			if errors.As(err, &enterrors.ErrNotFound{}) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			http.Error(w, "/nodes fulfill request: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		statsBytes, err := json.Marshal(stats)
+		if err != nil {
+			http.Error(w, "/nodes marshal response: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(statsBytes)
+	})
+}
+
+func (s *nodes) incomingObjectVersions() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		args := regxObjectVersions.FindStringSubmatch(r.URL.Path)
+		if len(args) != 4 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		versions, err := s.nodesManager.GetObjectVersions(r.Context(), args[1], args[2],
+			strfmt.UUID(args[3]))
+		if err != nil {
+			if errors.As(err, &enterrors.ErrNotFound{}) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			http.Error(w, "/nodes fulfill request: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		versionsBytes, err := json.Marshal(versions)
+		if err != nil {
+			http.Error(w, "/nodes marshal response: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(versionsBytes)
+	})
+}
+
+// incomingDrain marks this node as draining on PUT and unmarks it on DELETE
+func (s *nodes) incomingDrain() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		if err := s.drainer.SetDraining(r.Method == http.MethodPut); err != nil {
+			http.Error(w, "/nodes fulfill request: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
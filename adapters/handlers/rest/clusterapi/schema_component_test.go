@@ -182,7 +182,7 @@ func newSchemaManagerWithClusterStateAndClient(clusterState *fakeClusterState,
 type fakeScaleOutManager struct{}
 
 func (f *fakeScaleOutManager) Scale(ctx context.Context,
-	className string, updated sharding.Config, _, _ int64,
+	className string, updated sharding.Config, _, _ int64, _ *scaler.Progress,
 ) (*sharding.State, error) {
 	return nil, nil
 }
@@ -17,6 +17,7 @@ import (
 	"net/http"
 
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/tracing"
 )
 
 func Serve(appState *state.State) {
@@ -31,7 +32,7 @@ func Serve(appState *state.State) {
 	indices := NewIndices(appState.RemoteIndexIncoming, appState.DB, auth)
 	replicatedIndices := NewReplicatedIndices(appState.RemoteReplicaIncoming, appState.Scaler, auth)
 	classifications := NewClassifications(appState.ClassificationRepo.TxManager(), auth)
-	nodes := NewNodes(appState.RemoteNodeIncoming, auth)
+	nodes := NewNodes(appState.RemoteNodeIncoming, appState.Cluster, auth)
 	backups := NewBackups(appState.BackupManager, auth)
 
 	mux := http.NewServeMux()
@@ -51,7 +52,17 @@ func Serve(appState *state.State) {
 	mux.Handle("/backups/status", backups.Status())
 
 	mux.Handle("/", index())
-	http.ListenAndServe(fmt.Sprintf(":%d", port), mux)
+	http.ListenAndServe(fmt.Sprintf(":%d", port),
+		addRequestTracing(tracing.Handler(appState.Tracer, mux)))
+}
+
+// addRequestTracing continues the trace and request id of the node which
+// sent the request, so that a search can be traced across nodes
+func addRequestTracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, _ := tracing.FromIncoming(r.Context(), r.Header.Get)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
 }
 
 func index() http.Handler {
@@ -43,8 +43,11 @@ import (
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
 	txstore "github.com/weaviate/weaviate/adapters/repos/transactions"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/replication"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tracing"
 	vectorIndex "github.com/weaviate/weaviate/entities/vectorindex"
 	modstgazure "github.com/weaviate/weaviate/modules/backup-azure"
 	modstgfs "github.com/weaviate/weaviate/modules/backup-filesystem"
@@ -65,30 +68,40 @@ import (
 	modrerankercohere "github.com/weaviate/weaviate/modules/reranker-cohere"
 	modrerankertransformers "github.com/weaviate/weaviate/modules/reranker-transformers"
 	modsum "github.com/weaviate/weaviate/modules/sum-transformers"
+	modtextextraction "github.com/weaviate/weaviate/modules/text-extraction"
 	modspellcheck "github.com/weaviate/weaviate/modules/text-spellcheck"
 	modtext2vecaws "github.com/weaviate/weaviate/modules/text2vec-aws"
 	modcohere "github.com/weaviate/weaviate/modules/text2vec-cohere"
 	modcontextionary "github.com/weaviate/weaviate/modules/text2vec-contextionary"
+	modcustom "github.com/weaviate/weaviate/modules/text2vec-custom"
 	modgpt4all "github.com/weaviate/weaviate/modules/text2vec-gpt4all"
 	modhuggingface "github.com/weaviate/weaviate/modules/text2vec-huggingface"
 	modjinaai "github.com/weaviate/weaviate/modules/text2vec-jinaai"
 	modopenai "github.com/weaviate/weaviate/modules/text2vec-openai"
 	modtext2vecpalm "github.com/weaviate/weaviate/modules/text2vec-palm"
 	modtransformers "github.com/weaviate/weaviate/modules/text2vec-transformers"
+	"github.com/weaviate/weaviate/usecases/admission"
+	"github.com/weaviate/weaviate/usecases/ask"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/backpressure"
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/classification"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/idempotency"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/scheduler"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/sql"
 	"github.com/weaviate/weaviate/usecases/traverser"
+	"github.com/weaviate/weaviate/usecases/usage"
 )
 
 const MinimumRequiredContextionaryVersion = "1.0.2"
@@ -98,6 +111,20 @@ func makeConfigureServer(appState *state.State) func(*http.Server, string, strin
 		// Add properties to the config
 		appState.ServerConfig.Hostname = addr
 		appState.ServerConfig.Scheme = scheme
+
+		if !appState.ServerConfig.Config.HTTP3.Enabled {
+			return
+		}
+		switch scheme {
+		case "https":
+			if err := serveHTTP3(s, addr, appState.Logger); err != nil {
+				appState.Logger.WithField("action", "http3_startup").WithError(err).
+					Fatal("failed to start http3 server")
+			}
+		case "http":
+			appState.Logger.WithField("action", "http3_startup").
+				Warn("HTTP/3 requires TLS, it is not served on the http scheme")
+		}
 	}
 }
 
@@ -135,7 +162,25 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	}
 
 	limitResources(appState)
+	makeAppState(ctx, appState, true)
+	return appState
+}
+
+// MakeEmbeddedAppState builds the state of a single node which runs inside
+// another process. Unlike MakeAppState it does not load the config from the
+// flags and the environment, and it does not serve the cluster API.
+func MakeEmbeddedAppState(ctx context.Context, serverConfig *config.WeaviateConfig,
+	logger *logrus.Logger,
+) *state.State {
+	config.ServerVersion = parseVersionFromSwaggerSpec()
+	appState := &state.State{Logger: logger, ServerConfig: serverConfig}
+	initAppState(ctx, appState)
+	makeAppState(ctx, appState, false)
+	appState.ObjectsManager = configureObjectsManager(appState)
+	return appState
+}
 
+func makeAppState(ctx context.Context, appState *state.State, serveClusterAPI bool) {
 	err := registerModules(appState)
 	if err != nil {
 		appState.Logger.
@@ -151,7 +196,9 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 			Fatal("invalid config")
 	}
 
-	appState.ClusterHttpClient = reasonableHttpClient(appState.ServerConfig.Config.Cluster.AuthConfig)
+	appState.Tracer, appState.TraceExporter = configureTracing(appState)
+	appState.ClusterHttpClient = reasonableHttpClient(appState.ServerConfig.Config.Cluster.AuthConfig,
+		appState.Tracer != nil)
 
 	var vectorRepo vectorRepo
 	var vectorMigrator migrate.Migrator
@@ -160,12 +207,29 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	if appState.ServerConfig.Config.Monitoring.Enabled {
 		promMetrics := monitoring.GetMetrics()
 		appState.Metrics = promMetrics
+		appState.Modules.SetMetrics(modules.NewMetrics(promMetrics))
 	}
+	appState.Usage = usage.NewTracker(usage.NewMetrics(appState.Metrics))
+	appState.Modules.SetUsage(appState.Usage)
 
 	// TODO: configure http transport for efficient intra-cluster comm
 	remoteIndexClient := clients.NewRemoteIndex(appState.ClusterHttpClient)
 	remoteNodesClient := clients.NewRemoteNode(appState.ClusterHttpClient)
 	replicationClient := clients.NewReplicationClient(appState.ClusterHttpClient)
+	var propertyEncryption *encryption.Keyring
+	if appState.ServerConfig.Config.PropertyEncryption.Enabled {
+		propertyEncryption, err = encryption.NewKeyring(appState.ServerConfig.Config.PropertyEncryption.Keys)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("invalid property encryption keys")
+		}
+	}
+
+	// searches and imports on this node share the CPU by their priority
+	priorityScheduler := scheduler.New(appState.ServerConfig.Config.PriorityScheduler,
+		scheduler.NewMetrics(appState.Metrics))
+
 	repo, err := db.New(appState.Logger, db.Config{
 		ServerVersion:             config.ServerVersion,
 		GitHash:                   config.GitHash,
@@ -182,7 +246,14 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		TrackVectorDimensions:     appState.ServerConfig.Config.TrackVectorDimensions,
 		ResourceUsage:             appState.ServerConfig.Config.ResourceUsage,
 		AvoidMMap:                 appState.ServerConfig.Config.AvoidMmap,
+		LSMReadMode:               lsmReadMode(appState),
+		ShardSearchConcurrency:    appState.ServerConfig.Config.QueryShardConcurrency,
+		MaxShardSearches:          appState.ServerConfig.Config.QueryMaxShardSearches,
+		Scheduler:                 priorityScheduler,
 		DisableLazyLoadShards:     appState.ServerConfig.Config.DisableLazyLoadShards,
+		StartupPriorityClasses:    appState.ServerConfig.Config.StartupPriorityClasses,
+		PropertyEncryption:        propertyEncryption,
+		TieredStorageColdAfter:    tieredStorageColdAfter(appState),
 		// Pass dummy replication config with minimum factor 1. Otherwise the
 		// setting is not backward-compatible. The user may have created a class
 		// with factor=1 before the change was introduced. Now their setup would no
@@ -198,6 +269,15 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	}
 
 	appState.DB = repo
+	appState.Quotas = configureQuotas(appState)
+	appState.BatchBackpressure = backpressure.NewController(
+		appState.ServerConfig.Config.BatchBackpressure, backpressure.NewMetrics(appState.Metrics))
+	appState.QueryCache = configureQueryCache(appState)
+	appState.SlowQueryLog = configureSlowQueryLog(appState)
+	appState.Idempotency = idempotency.New(appState.ServerConfig.Config.Idempotency,
+		idempotency.NewMetrics(appState.Metrics))
+	appState.MemoryGovernor = configureMemoryGovernor(appState)
+	appState.MemoryGovernor.Register(repo)
 	vectorMigrator = db.NewMigrator(repo, appState.Logger)
 	vectorRepo = repo
 	migrator = vectorMigrator
@@ -209,6 +289,10 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 			Fatal("could not initialize schema repo")
 		os.Exit(1)
 	}
+	appState.StoreClosers = append(appState.StoreClosers, func() error {
+		schemaRepo.Close()
+		return nil
+	})
 
 	localClassifierRepo, err := classifications.NewRepo(
 		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
@@ -218,6 +302,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 			Fatal("could not initialize classifications repo")
 		os.Exit(1)
 	}
+	appState.StoreClosers = append(appState.StoreClosers, localClassifierRepo.Close)
 
 	// TODO: configure http transport for efficient intra-cluster comm
 	classificationsTxClient := clients.NewClusterClassifications(appState.ClusterHttpClient)
@@ -241,6 +326,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		os.Exit(1)
 
 	}
+	appState.StoreClosers = append(appState.StoreClosers, schemaTxPersistence.Close)
 
 	schemaManager, err := schemaUC.NewManager(migrator, schemaRepo,
 		appState.Logger, appState.Authorizer, appState.ServerConfig.Config,
@@ -255,8 +341,28 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		os.Exit(1)
 	}
 
+	schemaManager.SetAuditLog(appState.AuditLog)
 	appState.SchemaManager = schemaManager
 
+	if appState.Roles != nil {
+		// roles are replicated with the metadata of the schema
+		if err := appState.Roles.SetMetadataStore(schemaManager); err != nil {
+			appState.Logger.
+				WithField("action", "rbac_init").WithError(err).
+				Fatal("roles could not be loaded")
+			os.Exit(1)
+		}
+	}
+	if appState.APIKeys != nil {
+		// managed api keys are replicated with the metadata of the schema
+		if err := appState.APIKeys.SetMetadataStore(schemaManager); err != nil {
+			appState.Logger.
+				WithField("action", "apikey_init").WithError(err).
+				Fatal("api keys could not be loaded")
+			os.Exit(1)
+		}
+	}
+
 	appState.RemoteIndexIncoming = sharding.NewRemoteIndexIncoming(repo)
 	appState.RemoteNodeIncoming = sharding.NewRemoteNodeIncoming(repo)
 	appState.RemoteReplicaIncoming = replica.NewRemoteReplicaIncoming(repo)
@@ -265,7 +371,9 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		schemaManager, repo, appState.Modules)
 	appState.BackupManager = backupManager
 
-	go clusterapi.Serve(appState)
+	if serveClusterAPI {
+		go clusterapi.Serve(appState)
+	}
 
 	vectorRepo.SetSchemaGetter(schemaManager)
 	explorer.SetSchemaGetter(schemaManager)
@@ -279,6 +387,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 			Fatal("db didn't start up")
 		os.Exit(1)
 	}
+	appState.MemoryGovernor.Start()
 
 	if err := schemaManager.StartServing(ctx); err != nil {
 		appState.Logger.
@@ -291,16 +400,35 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	batchManager := objects.NewBatchManager(vectorRepo, appState.Modules,
 		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
-		appState.Authorizer, appState.Metrics)
+		appState.Authorizer, appState.Metrics, appState.BatchBackpressure)
+	batchManager.SetAuditLog(appState.AuditLog)
+	batchManager.SetChangeStream(appState.ChangeStream)
+	batchManager.SetQuotas(appState.Quotas)
+	batchManager.SetQueryCache(appState.QueryCache)
+	batchManager.SetPartitionCreator(schemaManager)
 	appState.BatchManager = batchManager
 	objectsTraverser := traverser.NewTraverser(appState.ServerConfig, appState.Locks,
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
 		appState.Modules, traverser.NewMetrics(appState.Metrics),
 		appState.ServerConfig.Config.MaximumConcurrentGetRequests)
+	objectsTraverser.SetQuotas(appState.Quotas)
+	objectsTraverser.SetQueryCache(appState.QueryCache)
+	objectsTraverser.SetSlowQueryLog(appState.SlowQueryLog)
+	objectsTraverser.SetQueryAdmission(admission.New(appState.ServerConfig.Config.QueryAdmission,
+		admission.NewMetrics(appState.Metrics)))
 	appState.Traverser = objectsTraverser
+	appState.SQL = sql.NewExecutor(objectsTraverser, schemaManager)
+	appState.Ask = ask.NewPipeline(objectsTraverser, schemaManager, appState.Modules)
 
 	updateSchemaCallback := makeUpdateSchemaCall(appState.Logger, appState, objectsTraverser)
 	schemaManager.RegisterSchemaUpdateCallback(updateSchemaCallback)
+	if appState.QueryCache != nil {
+		// dropped classes or deactivated tenants must not be answered from
+		// the cache
+		schemaManager.RegisterSchemaUpdateCallback(func(schema.Schema) {
+			appState.QueryCache.Purge()
+		})
+	}
 
 	err = migrator.AdjustFilterablePropSettings(ctx)
 	if err != nil {
@@ -323,6 +451,21 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	if appState.ServerConfig.Config.IndexMissingTextFilterableAtStartup {
 		reindexTaskNames = append(reindexTaskNames, "ShardInvertedReindexTaskMissingTextFilterable")
 	}
+	if appState.ServerConfig.Config.PropertyEncryption.ReencryptAtStartup {
+		// values encrypted with a retired key are rewritten in the background,
+		// they remain readable in the meantime
+		go func() {
+			count, err := repo.ReencryptProperties(reindexCtx)
+			logger := appState.Logger.WithField("action", "reencrypt_properties").
+				WithField("objects", count)
+			if err != nil {
+				logger.WithError(err).Error("re-encrypting properties failed")
+				return
+			}
+			logger.Info("re-encrypted all properties with the active key, " +
+				"retired keys can be removed")
+		}()
+	}
 	if len(reindexTaskNames) > 0 {
 		// start reindexing inverted indexes (if requested by user) in the background
 		// allowing db to complete api configuration and start handling requests
@@ -349,6 +492,20 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 			Fatal("modules didn't initialize")
 	}
 
+	// the offload backend is provided by a backup module
+	appState.TenantOffload = configureTenantOffload(appState)
+	batchManager.SetTenantOffload(appState.TenantOffload)
+	objectsTraverser.SetTenantOffload(appState.TenantOffload)
+	configureWALArchive(appState)
+	configureTieredStorage(appState)
+	appState.Standby = configureStandby(appState)
+	appState.AsyncReplication = configureAsyncReplication(appState)
+	appState.ShardBalancer = configureShardBalancer(appState)
+	appState.PartitionRetention = configurePartitionRetention(appState)
+	appState.Scrubber = configureScrubber(appState)
+	appState.Ref2VecRecomputer = configureRef2VecRecomputer(appState)
+	appState.IngestQueue = configureIngestQueue(appState)
+
 	// manually update schema once
 	schema := schemaManager.GetSchemaSkipAuth()
 	updateSchemaCallback(schema)
@@ -365,8 +522,6 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	if appState.ServerConfig.Config.RecountPropertiesAtStartup {
 		migrator.RecountProperties(ctx)
 	}
-
-	return appState
 }
 
 func configureAPI(api *operations.WeaviateAPI) http.Handler {
@@ -380,6 +535,11 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	api.ServeError = openapierrors.ServeError
 
 	api.JSONConsumer = runtime.JSONConsumer()
+	api.GzipConsumer = runtime.ByteStreamConsumer()
+	api.GzipProducer = runtime.ByteStreamProducer()
+	api.ApplicationVndApacheParquetProducer = runtime.ByteStreamProducer()
+	api.BinConsumer = runtime.ByteStreamConsumer()
+	api.BinProducer = runtime.ByteStreamProducer()
 
 	api.OidcAuth = composer.New(
 		appState.ServerConfig.Config.Authentication,
@@ -394,15 +554,26 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Logger, appState.Modules)
 
 	setupSchemaHandlers(api, appState.SchemaManager, appState.Metrics, appState.Logger)
-	objectsManager := objects.NewManager(appState.Locks,
-		appState.SchemaManager, appState.ServerConfig, appState.Logger,
-		appState.Authorizer, appState.DB, appState.Modules,
-		objects.NewMetrics(appState.Metrics))
+	setupRebalanceHandlers(api, appState.SchemaManager)
+	objectsManager := configureObjectsManager(appState)
+	appState.ObjectsManager = objectsManager
 	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger,
 		appState.Modules, appState.Metrics)
-	setupObjectBatchHandlers(api, appState.BatchManager, appState.Metrics, appState.Logger)
+	setupObjectVersionsHandlers(api, objectsManager)
+	setupObjectsExportHandlers(api, appState.BatchManager, appState.Logger)
+	setupObjectsDuplicatesHandlers(api, objectsManager, appState.Standby, appState.Cluster)
+	setupObjectsUploadHandlers(api, appState.Authorizer, objectsManager, appState.Modules)
+	setupTransactionsHandlers(api, objectsManager)
+	setupObjectBatchHandlers(api, appState.BatchManager, appState.BatchBackpressure,
+		appState.Metrics, appState.Logger)
+	setupIngestQueueHandlers(api, appState.Authorizer, appState.IngestQueue)
 	setupGraphQLHandlers(api, appState, appState.SchemaManager, appState.ServerConfig.Config.DisableGraphQL,
 		appState.Metrics, appState.Logger)
+	setupGraphQLExplainHandlers(api, appState.Authorizer, appState)
+	setupSQLHandlers(api, appState.SQL)
+	setupAskHandlers(api, appState.Ask)
+	setupAuthzHandlers(api, appState.Authorizer, appState.Roles)
+	setupAPIKeysHandlers(api, appState.Authorizer, appState.APIKeys)
 	setupMiscHandlers(api, appState.ServerConfig, appState.SchemaManager, appState.Modules,
 		appState.Metrics, appState.Logger)
 	setupClassificationHandlers(api, classifier, appState.Metrics, appState.Logger)
@@ -413,9 +584,33 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Cluster,
 		appState.Logger)
 	setupBackupHandlers(api, backupScheduler, appState.Metrics, appState.Logger)
+	backupSchedule := configureBackupSchedule(appState, backupScheduler)
 	setupNodesHandlers(api, appState.SchemaManager, appState.DB, appState)
+	setupTenantsHandlers(api, appState)
+	setupStandbyHandlers(api, appState.Authorizer, appState.Standby)
+	setupAsyncReplicationHandlers(api, appState.Authorizer, appState.AsyncReplication)
+	setupShardHandlers(api, appState.Authorizer, appState.ShardBalancer)
+	setupDrainHandlers(api, appState.Authorizer, appState.ShardBalancer)
+	setupSlowQueryHandlers(api, appState.Authorizer, appState.SlowQueryLog)
+	setupDebugBundleHandlers(api, appState)
+	setupStartupHandlers(api, appState.Authorizer, appState.DB)
+	setupRecallHandlers(api, appState.Authorizer, appState.DB)
+	setupVectorGraphHandlers(api, appState)
+
+	appState.ConfigReloader = configureReloader(appState)
+	appState.ConfigReloader.Start()
+	setupConfigHandlers(api, appState.Authorizer, appState.ConfigReloader)
+	appState.BulkImports = configureBulkImports(appState)
+	setupImportsHandlers(api, appState.BulkImports)
+	appState.QueryTemplates = configureQueryTemplates(appState)
+	setupQueryTemplatesHandlers(api, appState.Authorizer, appState.QueryTemplates, appState,
+		appState.ServerConfig.Config.DisableGraphQL)
+	appState.ModuleCredentials = configureModuleCredentials(appState)
+	setupModuleCredentialsHandlers(api, appState.Authorizer, appState.ModuleCredentials)
+	setupUsageHandlers(api, appState.Authorizer, appState.Usage)
 
 	grpcServer := createGrpcServer(appState)
+	postgresServer := createPostgresServer(appState)
 	setupMiddlewares := makeSetupMiddlewares(appState)
 	setupGlobalMiddleware := makeSetupGlobalMiddleware(appState)
 
@@ -426,23 +621,146 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		// gracefully stop gRPC server
 		grpcServer.GracefulStop()
 
+		if err := postgresServer.Close(); err != nil {
+			appState.Logger.WithField("action", "postgres_shutdown").WithError(err).
+				Error("could not stop postgres server")
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
-		if err := appState.SchemaManager.Shutdown(ctx); err != nil {
-			panic(err)
+		if err := backupSchedule.Shutdown(ctx); err != nil {
+			appState.Logger.WithField("action", "backup_schedule_shutdown").WithError(err).
+				Error("could not stop backup schedule")
 		}
 
-		if err := appState.DB.Shutdown(ctx); err != nil {
+		if err := ShutdownAppState(ctx, appState); err != nil {
 			panic(err)
 		}
 	}
 
 	startGrpcServer(grpcServer, appState)
+	startPostgresServer(postgresServer, appState)
+
+	// wraps the handler of the operation, so all handlers must be set up
+	api.AddMiddlewareFor(http.MethodPost, "/objects:upload", limitUploadSize)
 
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
 }
 
+// ShutdownAppState stops the background processes of the state and closes
+// the database, it is shared by the server and embedded nodes
+func ShutdownAppState(ctx context.Context, appState *state.State) error {
+	if err := appState.TenantOffload.Shutdown(ctx); err != nil {
+		appState.Logger.WithField("action", "tenant_offload_shutdown").WithError(err).
+			Error("could not stop tenant offload")
+	}
+
+	if err := appState.Standby.Shutdown(ctx); err != nil {
+		appState.Logger.WithField("action", "standby_shutdown").WithError(err).
+			Error("could not stop standby")
+	}
+
+	if err := appState.AsyncReplication.Shutdown(ctx); err != nil {
+		appState.Logger.WithField("action", "async_replication_shutdown").WithError(err).
+			Error("could not stop async replication")
+	}
+
+	if err := appState.ShardBalancer.Shutdown(ctx); err != nil {
+		appState.Logger.WithField("action", "shard_balancer_shutdown").WithError(err).
+			Error("could not stop shard balancer")
+	}
+
+	if err := appState.PartitionRetention.Shutdown(ctx); err != nil {
+		appState.Logger.WithField("action", "partition_retention_shutdown").WithError(err).
+			Error("could not stop partition retention")
+	}
+
+	if err := appState.Scrubber.Shutdown(ctx); err != nil {
+		appState.Logger.WithField("action", "scrubber_shutdown").WithError(err).
+			Error("could not stop scrubber")
+	}
+
+	if err := appState.Ref2VecRecomputer.Shutdown(ctx); err != nil {
+		appState.Logger.WithField("action", "ref2vec_recompute_shutdown").WithError(err).
+			Error("could not stop ref2vec recomputation")
+	}
+
+	if err := appState.BulkImports.Close(); err != nil {
+		appState.Logger.WithField("action", "bulk_import_close").WithError(err).
+			Error("could not stop import jobs")
+	}
+
+	if err := appState.IngestQueue.Shutdown(ctx); err != nil {
+		appState.Logger.WithField("action", "ingest_queue_shutdown").WithError(err).
+			Error("could not stop ingest queue")
+	}
+
+	if err := appState.SchemaManager.Shutdown(ctx); err != nil {
+		return errors.Wrap(err, "shutdown schema manager")
+	}
+
+	if err := appState.DB.Shutdown(ctx); err != nil {
+		return errors.Wrap(err, "shutdown db")
+	}
+
+	for _, closeStore := range appState.StoreClosers {
+		if err := closeStore(); err != nil {
+			appState.Logger.WithField("action", "store_close").WithError(err).
+				Error("could not close store")
+		}
+	}
+
+	if err := appState.AuditLog.Close(); err != nil {
+		appState.Logger.WithField("action", "audit_log_close").WithError(err).
+			Error("could not close audit log")
+	}
+
+	if err := appState.ChangeStream.Close(); err != nil {
+		appState.Logger.WithField("action", "change_stream_close").WithError(err).
+			Error("could not close change stream")
+	}
+
+	if err := appState.QueryCache.Close(); err != nil {
+		appState.Logger.WithField("action", "query_cache_close").WithError(err).
+			Error("could not close query cache")
+	}
+
+	if err := appState.TraceExporter.Close(); err != nil {
+		appState.Logger.WithField("action", "tracing_close").WithError(err).
+			Error("could not close trace exporter")
+	}
+
+	if err := appState.MemoryGovernor.Close(); err != nil {
+		appState.Logger.WithField("action", "memory_pressure_close").WithError(err).
+			Error("could not close memory governor")
+	}
+
+	if err := appState.ConfigReloader.Close(); err != nil {
+		appState.Logger.WithField("action", "config_reload_close").WithError(err).
+			Error("could not stop config reloader")
+	}
+
+	return nil
+}
+
+// configureObjectsManager creates the manager of single objects, it is not
+// part of MakeAppState since the server creates it with its handlers
+func configureObjectsManager(appState *state.State) *objects.Manager {
+	objectsManager := objects.NewManager(appState.Locks,
+		appState.SchemaManager, appState.ServerConfig, appState.Logger,
+		appState.Authorizer, appState.DB, appState.Modules,
+		objects.NewMetrics(appState.Metrics))
+	objectsManager.SetAuditLog(appState.AuditLog)
+	objectsManager.SetChangeStream(appState.ChangeStream)
+	objectsManager.SetQuotas(appState.Quotas)
+	objectsManager.SetQueryCache(appState.QueryCache)
+	objectsManager.SetTenantOffload(appState.TenantOffload)
+	objectsManager.SetPartitionCreator(appState.SchemaManager)
+	objectsManager.SetShardResolver(appState.SchemaManager)
+	return objectsManager
+}
+
 // TODO: Split up and don't write into global variables. Instead return an appState
 func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup) *state.State {
 	appState := &state.State{}
@@ -462,6 +780,14 @@ func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup)
 		logger.Exit(1)
 	}
 
+	initAppState(ctx, appState)
+	return appState
+}
+
+// initAppState configures authentication and the cluster state once the
+// config was loaded
+func initAppState(ctx context.Context, appState *state.State) {
+	logger, serverConfig := appState.Logger, appState.ServerConfig
 	monitoring.InitConfig(serverConfig.Config.Monitoring)
 
 	if serverConfig.Config.DisableGraphQL {
@@ -509,12 +835,16 @@ func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup)
 	}
 
 	appState.Cluster = clusterState
+	if clusterState.ReadOnly() {
+		logger.WithField("action", "startup").
+			Info("node is read-only, client writes are rejected")
+	}
+	appState.AuditLog = configureAuditLog(appState)
+	appState.ChangeStream = configureChangeStream(appState)
 
 	appState.Logger.
 		WithField("action", "startup").
 		Debug("startup routine complete")
-
-	return appState
 }
 
 // logger does not parse the regular config object, as logging needs to be
@@ -528,14 +858,12 @@ func logger() *logrus.Logger {
 	if os.Getenv("LOG_FORMAT") != "text" {
 		logger.SetFormatter(&logrus.JSONFormatter{})
 	}
-	switch os.Getenv("LOG_LEVEL") {
-	case "debug":
-		logger.SetLevel(logrus.DebugLevel)
-	case "trace":
-		logger.SetLevel(logrus.TraceLevel)
-	default:
-		logger.SetLevel(logrus.InfoLevel)
+	// an invalid level fails loading the config right after
+	level, err := config.ParseLogLevel(os.Getenv("LOG_LEVEL"))
+	if err != nil {
+		level = logrus.InfoLevel
 	}
+	logger.SetLevel(level)
 
 	return logger
 }
@@ -638,6 +966,14 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	if _, ok := enabledModules[modtextextraction.Name]; ok {
+		appState.Modules.Register(modtextextraction.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modtextextraction.Name).
+			Debug("enabled module")
+	}
+
 	if _, ok := enabledModules["text-spellcheck"]; ok {
 		appState.Modules.Register(modspellcheck.New())
 		appState.Logger.
@@ -798,6 +1134,14 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	if _, ok := enabledModules[modcustom.Name]; ok {
+		appState.Modules.Register(modcustom.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modcustom.Name).
+			Debug("enabled module")
+	}
+
 	appState.Logger.
 		WithField("action", "startup").
 		Debug("completed registering modules")
@@ -811,6 +1155,15 @@ func initModules(ctx context.Context, appState *state.State) error {
 	if err != nil {
 		return errors.Wrap(err, "init storage provider")
 	}
+	appState.StoreClosers = append(appState.StoreClosers, storageProvider.Close)
+
+	httpConfig, err := httpclient.ConfigFromEnv()
+	if err != nil {
+		return errors.Wrap(err, "modules http client config")
+	}
+	if err := httpclient.Configure(httpConfig); err != nil {
+		return errors.Wrap(err, "modules http client")
+	}
 
 	// TODO: gh-1481 don't pass entire appState in, but only what's needed. Probably only
 	// config?
@@ -841,8 +1194,10 @@ func (c clientWithAuth) RoundTrip(r *http.Request) (*http.Response, error) {
 	return c.r.RoundTrip(r)
 }
 
-func reasonableHttpClient(authConfig cluster.AuthConfig) *http.Client {
-	t := &http.Transport{
+// reasonableHttpClient propagates the trace context of traced requests to
+// the other nodes, if tracing is enabled
+func reasonableHttpClient(authConfig cluster.AuthConfig, tracingEnabled bool) *http.Client {
+	var t http.RoundTripper = &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
 			Timeout:   30 * time.Second,
@@ -854,6 +1209,9 @@ func reasonableHttpClient(authConfig cluster.AuthConfig) *http.Client {
 		TLSHandshakeTimeout:   10 * time.Second,
 		ExpectContinueTimeout: 1 * time.Second,
 	}
+	if tracingEnabled {
+		t = tracing.Transport{Base: t}
+	}
 	if authConfig.BasicAuth.Enabled() {
 		return &http.Client{Transport: clientWithAuth{r: t, basicAuth: authConfig.BasicAuth}}
 	}
@@ -15,19 +15,47 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/clients"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/utils"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tracing"
+	"github.com/weaviate/weaviate/usecases/antientropy"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/scoped"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/tenantscope"
+	"github.com/weaviate/weaviate/usecases/backup"
+	"github.com/weaviate/weaviate/usecases/balancer"
+	"github.com/weaviate/weaviate/usecases/bulkimport"
+	"github.com/weaviate/weaviate/usecases/cdc"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/ingest"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/modulecredentials"
 	"github.com/weaviate/weaviate/usecases/modules"
+	"github.com/weaviate/weaviate/usecases/offload"
+	"github.com/weaviate/weaviate/usecases/otlp"
+	"github.com/weaviate/weaviate/usecases/querycache"
+	"github.com/weaviate/weaviate/usecases/quota"
+	"github.com/weaviate/weaviate/usecases/ref2vec"
+	"github.com/weaviate/weaviate/usecases/retention"
+	"github.com/weaviate/weaviate/usecases/scrubber"
+	"github.com/weaviate/weaviate/usecases/slowquery"
+	"github.com/weaviate/weaviate/usecases/standby"
+	"github.com/weaviate/weaviate/usecases/templates"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
 
@@ -96,9 +124,424 @@ func configureAPIKey(appState *state.State) *apikey.Client {
 		os.Exit(1)
 	}
 
+	cfg := appState.ServerConfig.Config
+	if cfg.Authentication.APIKey.Enabled && cfg.Authentication.APIKey.ManagementEnabled {
+		// the keys are loaded once the schema is, see makeAppState
+		keys := apikey.NewKeyStore(appState.Logger)
+		c.SetKeyStore(keys)
+		appState.APIKeys = keys
+	}
+
 	return c
 }
 
+// configureAuditLog returns nil if the audit log is disabled, all usecases
+// accept a nil audit log
+func configureAuditLog(appState *state.State) *audit.Logger {
+	cfg := appState.ServerConfig.Config.AuditLog
+	if !cfg.Enabled {
+		return nil
+	}
+
+	auditLog, err := audit.New(cfg, appState.Cluster.LocalName(), appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "audit_log_init").WithError(err).Fatal("audit log could not start up")
+		os.Exit(1)
+	}
+	return auditLog
+}
+
+// configureChangeStream returns nil if the change stream is disabled, all
+// usecases accept a nil stream. Changes are spooled in the data path until
+// they are published.
+func configureChangeStream(appState *state.State) *cdc.Stream {
+	cfg := appState.ServerConfig.Config.ChangeStream
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var metrics *cdc.Metrics
+	if appState.Metrics != nil {
+		metrics = cdc.NewMetrics(appState.Metrics.ChangeStreamPublished)
+	}
+	dir := filepath.Join(appState.ServerConfig.Config.Persistence.DataPath, ".change-stream")
+	stream, err := cdc.New(cfg, dir, appState.Cluster.LocalName(), metrics, appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "change_stream_init").WithError(err).
+			Fatal("change stream could not start up")
+		os.Exit(1)
+	}
+	return stream
+}
+
+// configureIngestQueue returns nil if the ingest queue is disabled or on
+// read-only nodes, which must not write objects. Batches are queued in the
+// data path until they are applied.
+func configureIngestQueue(appState *state.State) *ingest.Queue {
+	cfg := appState.ServerConfig.Config.IngestQueue
+	if !cfg.Enabled || appState.Cluster.ReadOnly() {
+		return nil
+	}
+
+	dir := filepath.Join(appState.ServerConfig.Config.Persistence.DataPath, ".ingest-queue")
+	queue, err := ingest.New(cfg, dir, ingest.NewMetrics(appState.Metrics), appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "ingest_queue_init").WithError(err).
+			Fatal("ingest queue could not start up")
+		os.Exit(1)
+	}
+	appState.BatchManager.SetIngestQueue(queue)
+	return queue
+}
+
+// configureQueryCache returns nil if the query cache is disabled, all
+// usecases accept a nil cache
+func configureQueryCache(appState *state.State) *querycache.Cache {
+	cfg := appState.ServerConfig.Config.QueryCache
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var metrics *querycache.Metrics
+	if appState.Metrics != nil {
+		metrics = querycache.NewMetrics(appState.Metrics.QueryCacheLookups,
+			appState.Metrics.ClassLabel)
+	}
+	var shared querycache.Generations
+	if cfg.Redis.URL != "" {
+		redis, err := querycache.NewRedisGenerations(cfg.Redis)
+		if err != nil {
+			appState.Logger.WithField("action", "query_cache_init").WithError(err).
+				Fatal("query cache could not start up")
+			os.Exit(1)
+		}
+		shared = redis
+	}
+	return querycache.New(cfg, shared, metrics, appState.Logger)
+}
+
+// configureSlowQueryLog returns nil if the slow query log is disabled, the
+// traverser tracks no queries then
+func configureSlowQueryLog(appState *state.State) *slowquery.Log {
+	cfg := appState.ServerConfig.Config.SlowQueryLog
+	if !cfg.Enabled {
+		return nil
+	}
+	return slowquery.New(cfg, appState.Logger)
+}
+
+// configureMemoryGovernor returns nil if the memory pressure governor is
+// disabled, no load is shed then
+func configureMemoryGovernor(appState *state.State) *memwatch.Governor {
+	return memwatch.NewGovernor(appState.ServerConfig.Config.MemoryPressure, appState.Logger)
+}
+
+// configureBulkImports runs import jobs with the backends of the backup
+// modules, the files are downloaded into the data path while they are
+// imported
+func configureBulkImports(appState *state.State) *bulkimport.Manager {
+	return bulkimport.NewManager(appState.Authorizer, appState.Modules,
+		appState.SchemaManager, appState.BatchManager,
+		filepath.Join(appState.ServerConfig.Config.Persistence.DataPath, ".imports"),
+		appState.Logger)
+}
+
+// configureQueryTemplates loads the query templates persisted in the data
+// path
+func configureQueryTemplates(appState *state.State) *templates.Store {
+	store, err := templates.NewStore(
+		filepath.Join(appState.ServerConfig.Config.Persistence.DataPath, "templates"))
+	if err != nil {
+		appState.Logger.WithField("action", "query_templates_init").WithError(err).
+			Fatal("query templates could not be loaded")
+		os.Exit(1)
+	}
+	return store
+}
+
+// configureModuleCredentials loads the module credentials which were set at
+// runtime, they are replicated with the metadata of the schema
+func configureModuleCredentials(appState *state.State) *modulecredentials.Store {
+	store := modulecredentials.New(appState.Logger)
+	if err := store.SetMetadataStore(appState.SchemaManager); err != nil {
+		appState.Logger.WithField("action", "module_credentials_init").WithError(err).
+			Fatal("module credentials could not be loaded")
+		os.Exit(1)
+	}
+	return store
+}
+
+// configureReloader applies the settings which can be changed at runtime,
+// changes of all other settings are reported to require a restart
+func configureReloader(appState *state.State) *config.Reloader {
+	load := func() (config.Config, error) {
+		serverConfig := &config.WeaviateConfig{}
+		err := serverConfig.LoadConfig(connectorOptionGroup, appState.Logger)
+		return serverConfig.Config, err
+	}
+	reloader := config.NewReloader(appState.ServerConfig.Config, load, appState.Logger)
+
+	reloader.Register("log_level", func(cfg config.Config) error {
+		level, err := config.ParseLogLevel(cfg.LogLevel)
+		if err != nil {
+			return err
+		}
+		appState.Logger.SetLevel(level)
+		return nil
+	})
+	reloader.Register("maximum_concurrent_get_requests", func(cfg config.Config) error {
+		appState.Traverser.SetMaxConcurrentGetRequests(cfg.MaximumConcurrentGetRequests)
+		return nil
+	})
+	reloader.Register("auto_schema", func(cfg config.Config) error {
+		appState.ObjectsManager.SetAutoSchema(cfg.AutoSchema)
+		appState.BatchManager.SetAutoSchema(cfg.AutoSchema)
+		return nil
+	})
+	if appState.Quotas != nil {
+		// the checks are only wired up if quotas were enabled at startup
+		reloader.Register("quotas", func(cfg config.Config) error {
+			appState.Quotas.SetConfig(cfg.Quotas)
+			return nil
+		})
+	}
+
+	mods := appState.Modules.GetAll()
+	sort.Slice(mods, func(i, j int) bool { return mods[i].Name() < mods[j].Name() })
+	for _, module := range mods {
+		backend, ok := module.(modulecapabilities.BackupCredentialsReloader)
+		if !ok {
+			continue
+		}
+		reloader.RegisterHook(module.Name()+"/credentials", func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			return backend.ReloadCredentials(ctx)
+		})
+	}
+
+	return reloader
+}
+
+// configureTracing returns a nil tracer if tracing is disabled, no spans
+// are started then
+func configureTracing(appState *state.State) (*tracing.Tracer, *otlp.Exporter) {
+	cfg := appState.ServerConfig.Config.Tracing
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	exporter := otlp.New(cfg, appState.ServerConfig.Config.Cluster.Hostname, appState.Logger)
+	return tracing.NewTracer(exporter, cfg.SampleRatio), exporter
+}
+
+// configureQuotas returns nil if quotas are disabled, all checks of a nil
+// enforcer pass
+func configureQuotas(appState *state.State) *quota.Enforcer {
+	cfg := appState.ServerConfig.Config.Quotas
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var metrics *quota.Metrics
+	if appState.Metrics != nil {
+		metrics = quota.NewMetrics(appState.Metrics.QuotaUsage,
+			appState.Metrics.QuotaLimit, appState.Metrics.QuotaRejections,
+			appState.Metrics.ClassLabel, appState.Metrics.ShardLabel)
+	}
+	return quota.NewEnforcer(cfg, appState.DB, metrics)
+}
+
+// configureTenantOffload returns nil if offloading is disabled, tenants are
+// then never activated on access either
+func configureTenantOffload(appState *state.State) *offload.Manager {
+	cfg := appState.ServerConfig.Config.TenantOffload
+	if !cfg.Enabled {
+		return nil
+	}
+
+	backend, err := appState.Modules.BackupBackend(cfg.Backend)
+	if err != nil {
+		appState.Logger.WithField("action", "tenant_offload_init").WithError(err).
+			Fatal("tenant offload backend could not be found")
+		os.Exit(1)
+	}
+	appState.DB.SetOffloadBackend(backend)
+
+	manager := offload.NewManager(cfg, appState.SchemaManager, appState.DB,
+		offload.NewMetrics(appState.Metrics), appState.Logger)
+	manager.Start()
+	return manager
+}
+
+// configureWALArchive starts archiving the changes of objects if restoring
+// backups to a point in time is enabled
+func configureWALArchive(appState *state.State) {
+	cfg := appState.ServerConfig.Config.WALArchive
+	if !cfg.Enabled {
+		return
+	}
+
+	backend, err := appState.Modules.BackupBackend(cfg.Backend)
+	if err != nil {
+		appState.Logger.WithField("action", "wal_archive_init").WithError(err).
+			Fatal("wal archive backend could not be found")
+		os.Exit(1)
+	}
+	if err := appState.DB.StartWALArchive(backend, cfg.Interval); err != nil {
+		appState.Logger.WithField("action", "wal_archive_init").WithError(err).
+			Fatal("could not start wal archive")
+		os.Exit(1)
+	}
+}
+
+// lsmReadMode warns if the configured read mode is not supported for the data
+// path, segments are read with pread in that case
+func lsmReadMode(appState *state.State) diskio.ReadMode {
+	mode := appState.ServerConfig.Config.LSMReadMode
+	if mode == "" {
+		return mode
+	}
+	if err := diskio.CheckReadMode(appState.ServerConfig.Config.Persistence.DataPath, mode); err != nil {
+		appState.Logger.WithField("action", "startup").WithField("read_mode", mode).
+			WithError(err).Warn("lsm read mode is not supported, falling back to pread")
+	}
+	return mode
+}
+
+// tieredStorageColdAfter is passed to the DB before the shards are loaded, so
+// that segments which were tiered before are found. Zero disables tiering.
+func tieredStorageColdAfter(appState *state.State) time.Duration {
+	cfg := appState.ServerConfig.Config.TieredStorage
+	if !cfg.Enabled {
+		return 0
+	}
+	return cfg.ColdAfter
+}
+
+// configureTieredStorage sets the backend cold segments are moved to. Backup
+// modules are initialized after the DB, until then tiered segments cannot
+// be fetched.
+func configureTieredStorage(appState *state.State) {
+	cfg := appState.ServerConfig.Config.TieredStorage
+	if !cfg.Enabled {
+		return
+	}
+
+	backend, err := appState.Modules.BackupBackend(cfg.Backend)
+	if err != nil {
+		appState.Logger.WithField("action", "tiered_storage_init").WithError(err).
+			Fatal("tiered storage backend could not be found")
+		os.Exit(1)
+	}
+	appState.DB.SetTieredStorageBackend(backend)
+}
+
+// configureStandby returns nil unless the cluster is configured as the
+// standby of another cluster, in which case it starts following the changes
+// the primary archives
+func configureStandby(appState *state.State) *standby.Manager {
+	cfg := appState.ServerConfig.Config.Standby
+	if !cfg.Enabled {
+		return nil
+	}
+
+	backend, err := appState.Modules.BackupBackend(cfg.Backend)
+	if err != nil {
+		appState.Logger.WithField("action", "standby_init").WithError(err).
+			Fatal("standby backend could not be found")
+		os.Exit(1)
+	}
+	manager, err := standby.NewManager(cfg, appState.ServerConfig.Config.Persistence.DataPath,
+		backend, appState.DB, appState.SchemaManager,
+		standby.NewMetrics(appState.Metrics), appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "standby_init").WithError(err).
+			Fatal("could not create standby")
+		os.Exit(1)
+	}
+	manager.Start()
+	return manager
+}
+
+// configureAsyncReplication creates the manager of replica repairs, which can
+// always be triggered by operators. Shards are only repaired in the
+// background if enabled.
+func configureAsyncReplication(appState *state.State) *antientropy.Manager {
+	manager := antientropy.NewManager(appState.ServerConfig.Config.AsyncReplication,
+		appState.SchemaManager, appState.DB,
+		antientropy.NewMetrics(appState.Metrics), appState.Logger)
+	manager.Start()
+	return manager
+}
+
+// configureShardBalancer creates the manager of shard moves and node
+// drains, which can always be started by operators. Shards are only
+// balanced automatically if enabled.
+func configureShardBalancer(appState *state.State) *balancer.Manager {
+	manager := balancer.NewManager(appState.ServerConfig.Config.ShardBalancer,
+		appState.SchemaManager, appState.Scaler, appState.Cluster,
+		clients.NewRemoteNode(appState.ClusterHttpClient),
+		balancer.NewMetrics(appState.Metrics), appState.Logger)
+	manager.Start()
+	return manager
+}
+
+// configurePartitionRetention starts dropping temporal partitions once they
+// are older than the retention of their class
+func configurePartitionRetention(appState *state.State) *retention.Manager {
+	manager := retention.NewManager(appState.ServerConfig.Config.PartitionRetentionInterval,
+		appState.SchemaManager, appState.Logger)
+	manager.Start()
+	return manager
+}
+
+// configureScrubber returns nil if scrubbing is disabled. Corrupt shards are
+// repaired by the async replication manager if enabled.
+func configureScrubber(appState *state.State) *scrubber.Manager {
+	cfg := appState.ServerConfig.Config.Scrub
+	if !cfg.Enabled {
+		return nil
+	}
+
+	manager := scrubber.NewManager(cfg, appState.SchemaManager, appState.DB,
+		appState.AsyncReplication, scrubber.NewMetrics(appState.Metrics), appState.Logger)
+	manager.Start()
+	return manager
+}
+
+// configureRef2VecRecomputer returns nil on read-only nodes, which must not
+// write objects
+func configureRef2VecRecomputer(appState *state.State) *ref2vec.Recomputer {
+	if appState.Cluster.ReadOnly() {
+		return nil
+	}
+
+	recomputer := ref2vec.NewRecomputer(appState.SchemaManager, appState.DB,
+		appState.Modules, appState.Locks, appState.QueryCache, appState.Logger)
+	recomputer.Start()
+	return recomputer
+}
+
+// configureBackupSchedule returns nil if scheduled backups are disabled,
+// backups are taken once it is returned
+func configureBackupSchedule(appState *state.State, scheduler *backup.Scheduler) *backup.Schedule {
+	cfg := appState.ServerConfig.Config.BackupSchedule
+	if !cfg.Enabled {
+		return nil
+	}
+
+	schedule, err := backup.NewSchedule(cfg, scheduler, appState.Modules, appState.Cluster,
+		backup.NewMetrics(appState.Metrics), appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "backup_schedule_init").WithError(err).
+			Fatal("could not create backup schedule")
+		os.Exit(1)
+	}
+	schedule.Start()
+	return schedule
+}
+
 // configureAnonymousAccess will always be called, even if anonymous access is
 // disabled. In this case the middleware provided by this client will block
 // anonymous requests
@@ -107,7 +550,29 @@ func configureAnonymousAccess(appState *state.State) *anonymous.Client {
 }
 
 func configureAuthorizer(appState *state.State) authorization.Authorizer {
-	return authorization.New(appState.ServerConfig.Config)
+	authorizer := configureBaseAuthorizer(appState)
+	if appState.ServerConfig.Config.Authentication.OIDC.TenantClaim != "" {
+		// principals bound to a tenant can only access the data of that tenant
+		authorizer = tenantscope.New(authorizer)
+	}
+	if appState.APIKeys != nil {
+		// managed keys can be restricted further than the user they belong to
+		authorizer = scoped.New(authorizer, appState.APIKeys)
+	}
+	return authorizer
+}
+
+func configureBaseAuthorizer(appState *state.State) authorization.Authorizer {
+	cfg := appState.ServerConfig.Config
+	if !cfg.Authorization.RBAC.Enabled {
+		return authorization.New(cfg)
+	}
+
+	// the roles are loaded once the schema is, see makeAppState
+	roles := rbac.NewStore(appState.Logger)
+	appState.Roles = roles
+
+	return rbac.New(cfg.Authorization.RBAC, roles)
 }
 
 func timeTillDeadline(ctx context.Context) string {
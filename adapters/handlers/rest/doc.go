@@ -22,11 +22,18 @@
 //	Contact: Weaviate<hello@weaviate.io> https://github.com/weaviate
 //
 //	Consumes:
+//	  - application/octet-stream
+//	  - application/gzip
 //	  - application/json
+//	  - multipart/form-data
 //	  - application/yaml
 //
 //	Produces:
+//	  - application/vnd.apache.parquet
+//	  - application/octet-stream
+//	  - application/gzip
 //	  - application/json
+//	  - application/x-ndjson
 //
 // swagger:meta
 package rest
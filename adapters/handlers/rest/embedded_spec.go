@@ -147,35 +147,68 @@ func init() {
         }
       }
     },
-    "/backups/{backend}": {
+    "/apikeys": {
+      "get": {
+        "description": "Lists the metadata of all managed API keys sorted by creation time.",
+        "tags": [
+          "apikeys"
+        ],
+        "operationId": "keys.list",
+        "responses": {
+          "200": {
+            "description": "The API keys",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/APIKey"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "API key management is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
       "post": {
-        "description": "Starts a process of creating a backup for a set of classes",
+        "description": "Creates an API key for a user. The response is the only time the key itself is returned, only its hash is stored.",
         "tags": [
-          "backups"
+          "apikeys"
         ],
-        "operationId": "backups.create",
+        "operationId": "keys.create",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The user and scopes of the key",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
+              "$ref": "#/definitions/APIKeyCreateRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup create process successfully started.",
+            "description": "The API key was created",
             "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
+              "$ref": "#/definitions/APIKeyCreated"
             }
           },
           "401": {
@@ -188,7 +221,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup creation attempt.",
+            "description": "Invalid request, or aPI key management is not enabled",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -199,30 +232,20 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.backup"
-        ]
+        }
       }
     },
-    "/backups/{backend}/{id}": {
+    "/apikeys/{id}": {
       "get": {
-        "description": "Returns status of backup creation attempt for a set of classes",
+        "description": "Returns the metadata of an API key.",
         "tags": [
-          "backups"
+          "apikeys"
         ],
-        "operationId": "backups.create.status",
+        "operationId": "keys.get",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "description": "The id of the API key",
             "name": "id",
             "in": "path",
             "required": true
@@ -230,9 +253,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Backup creation status successfully returned",
+            "description": "The API key",
             "schema": {
-              "$ref": "#/definitions/BackupCreateStatusResponse"
+              "$ref": "#/definitions/APIKey"
             }
           },
           "401": {
@@ -245,13 +268,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "The API key does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "API key management is not enabled",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -262,41 +285,26 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.backup"
-        ]
-      }
-    },
-    "/backups/{backend}/{id}/restore": {
-      "get": {
-        "description": "Returns status of a backup restoration attempt for a set of classes",
+        }
+      },
+      "delete": {
+        "description": "Revokes an API key, it can no longer be used immediately.",
         "tags": [
-          "backups"
+          "apikeys"
         ],
-        "operationId": "backups.restore.status",
+        "operationId": "keys.revoke",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "description": "The id of the API key",
             "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Backup restoration status successfully returned",
-            "schema": {
-              "$ref": "#/definitions/BackupRestoreStatusResponse"
-            }
+          "204": {
+            "description": "The API key was revoked"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -308,7 +316,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "The API key does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "API key management is not enabled",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -319,46 +333,32 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.backup"
-        ]
-      },
+        }
+      }
+    },
+    "/ask": {
       "post": {
-        "description": "Starts a process of restoring a backup for a set of classes",
+        "description": "Answers a question from the objects of a class. The objects are retrieved with a hybrid search, reranked if rerank is set and the answer is generated from them. The response contains the answer, the citations of the sources in the answer, the sources and the timings of the stages. The retrieval is authorized like a Get query of the class.",
         "tags": [
-          "backups"
+          "ask"
         ],
-        "operationId": "backups.restore",
+        "operationId": "ask.answer",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The question and how the objects are retrieved",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupRestoreRequest"
+              "$ref": "#/definitions/AskRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration process successfully started.",
+            "description": "The answer to the question",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreResponse"
+              "$ref": "#/definitions/AskAnswer"
             }
           },
           "401": {
@@ -370,14 +370,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Invalid backup restoration attempt.",
+            "description": "Invalid request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -388,73 +382,26 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.backup"
-        ]
+        }
       }
     },
-    "/batch/objects": {
-      "post": {
-        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
+    "/authz/roles": {
+      "get": {
+        "description": "Lists all roles sorted by name.",
         "tags": [
-          "batch",
-          "objects"
-        ],
-        "summary": "Creates new Objects based on a Object template as a batch.",
-        "operationId": "batch.objects.create",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Object"
-                  }
-                }
-              }
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          }
+          "authz"
         ],
+        "operationId": "roles.list",
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "The roles",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
+                "$ref": "#/definitions/Role"
               }
             }
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -465,7 +412,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Role based access control is not enabled",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -476,48 +423,30 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.add"
-        ]
+        }
       },
-      "delete": {
-        "description": "Delete Objects in bulk that match a certain filter.",
+      "post": {
+        "description": "Creates a role. The role is replicated to all nodes in the cluster.",
         "tags": [
-          "batch",
-          "objects"
+          "authz"
         ],
-        "summary": "Deletes Objects based on a match filter as a batch.",
-        "operationId": "batch.objects.delete",
+        "operationId": "roles.create",
         "parameters": [
           {
+            "description": "The role",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BatchDelete"
+              "$ref": "#/definitions/Role"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "$ref": "#/definitions/BatchDeleteResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The role was created",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Role"
             }
           },
           "401": {
@@ -529,8 +458,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "A role with the same name exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid role, or role based access control is not enabled",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -541,54 +476,30 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        }
       }
     },
-    "/batch/references": {
-      "post": {
-        "description": "Register cross-references between any class items (objects or objects) in bulk.",
+    "/authz/roles/{name}": {
+      "get": {
+        "description": "Returns a role.",
         "tags": [
-          "batch",
-          "references"
+          "authz"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
+        "operationId": "roles.get",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
-            "name": "body",
-            "in": "body",
-            "required": true,
+            "type": "string",
+            "description": "The name of the role",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The role",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Role"
             }
           },
           "401": {
@@ -600,8 +511,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The role does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Role based access control is not enabled",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -612,44 +529,37 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.add"
-        ]
-      }
-    },
-    "/classifications/": {
-      "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        }
+      },
+      "put": {
+        "description": "Replaces the permissions and assignments of a role. The name of the role is set from the path if it is empty.",
         "tags": [
-          "classifications"
+          "authz"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "operationId": "roles.update",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "type": "string",
+            "description": "The name of the role",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The role",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/Role"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+          "200": {
+            "description": "The role was updated",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Role"
             }
           },
           "401": {
@@ -661,41 +571,44 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The role does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid role, or role based access control is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.classifications.post"
-        ]
-      }
-    },
-    "/classifications/{id}": {
-      "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+        }
+      },
+      "delete": {
+        "description": "Deletes a role.",
         "tags": [
-          "classifications"
+          "authz"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "operationId": "roles.delete",
         "parameters": [
           {
             "type": "string",
-            "description": "classification id",
-            "name": "id",
+            "description": "The name of the role",
+            "name": "name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Found the classification, returned as body",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
+          "204": {
+            "description": "The role was deleted"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -707,7 +620,16 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Classification does not exist"
+            "description": "The role does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Role based access control is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -715,36 +637,38 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.classifications.get"
-        ]
+        }
       }
     },
-    "/graphql": {
+    "/backups/{backend}": {
       "post": {
-        "description": "Get an object based on GraphQL",
+        "description": "Starts a process of creating a backup for a set of classes",
         "tags": [
-          "graphql"
+          "backups"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "operationId": "backups.create",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "$ref": "#/definitions/BackupCreateRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Backup create process successfully started.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/BackupCreateResponse"
             }
           },
           "401": {
@@ -757,7 +681,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup creation attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -769,40 +693,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of backup creation attempt for a set of classes",
         "tags": [
-          "graphql"
+          "backups"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "operationId": "backups.create.status",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Backup creation status successfully returned",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
+              "$ref": "#/definitions/BackupCreateStatusResponse"
             }
           },
           "401": {
@@ -814,49 +737,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+          "404": {
+            "description": "Not Found - Backup does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
-        ]
-      }
-    },
-    "/meta": {
-      "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
-        "tags": [
-          "meta"
-        ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
-        "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Meta"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
           },
-          "403": {
-            "description": "Forbidden",
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -868,30 +756,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/nodes": {
+    "/backups/{backend}/{id}/restore": {
       "get": {
-        "description": "Returns status of Weaviate DB.",
+        "description": "Returns status of a backup restoration attempt for a set of classes",
         "tags": [
-          "nodes"
+          "backups"
         ],
-        "operationId": "nodes.get",
+        "operationId": "backups.restore.status",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Nodes status successfully returned",
+            "description": "Backup restoration status successfully returned",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/BackupRestoreStatusResponse"
             }
           },
           "401": {
@@ -909,47 +806,52 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup restoration status attempt.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.nodes.status.get"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/nodes/{className}": {
-      "get": {
-        "description": "Returns status of Weaviate DB.",
+      },
+      "post": {
+        "description": "Starts a process of restoring a backup for a set of classes",
         "tags": [
-          "nodes"
+          "backups"
         ],
-        "operationId": "nodes.get.class",
+        "operationId": "backups.restore",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
             "in": "path",
             "required": true
           },
           {
-            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Nodes status successfully returned",
+            "description": "Backup restoration process successfully started.",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/BackupRestoreResponse"
             }
           },
           "401": {
@@ -968,7 +870,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Invalid backup restoration attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -981,55 +883,22 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.nodes.status.get.class"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/objects": {
+    "/batch/ingest": {
       "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+        "description": "Returns the indexing lag of the ingest queue of the node serving the request. Clients can wait for an acknowledged batch to become visible to queries until appliedSeq reaches the acceptedSeq they observed after the batch.",
         "tags": [
-          "objects"
-        ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
-        "parameters": [
-          {
-            "$ref": "#/parameters/CommonAfterParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonOffsetParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonLimitParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonSortParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonOrderParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonClassParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
-          }
+          "batch"
         ],
+        "operationId": "batch.ingest.get",
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The status of the ingest queue",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/IngestQueueStatus"
             }
           },
           "401": {
@@ -1041,11 +910,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The ingest queue is not enabled",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1056,38 +922,71 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query"
-        ]
-      },
+        }
+      }
+    },
+    "/batch/objects": {
       "post": {
-        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
+        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
         "tags": [
+          "batch",
           "objects"
         ],
-        "summary": "Create Objects between two Objects (object and subject).",
-        "operationId": "objects.create",
+        "summary": "Creates new Objects based on a Object template as a batch.",
+        "operationId": "batch.objects.create",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
+                }
+              }
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIdempotencyKeyParameterHeader"
           }
         ],
         "responses": {
           "200": {
-            "description": "Object created.",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
+            },
+            "headers": {
+              "Idempotent-Replayed": {
+                "type": "string",
+                "description": "Set to true if the response is the kept response to an earlier request with the same Idempotency-Key"
+              }
             }
           },
           "400": {
@@ -1105,8 +1004,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "A request with the same Idempotency-Key is still in progress",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file? Also returned if the Idempotency-Key was used before with a different body.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1123,29 +1028,43 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.add"
         ]
-      }
-    },
-    "/objects/validate": {
-      "post": {
-        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+      },
+      "delete": {
+        "description": "Delete Objects in bulk that match a certain filter.",
         "tags": [
+          "batch",
           "objects"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/BatchDelete"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully validated."
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchDeleteResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1172,51 +1091,44 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/objects/{className}/{id}": {
-      "get": {
-        "description": "Get a single data object",
+    "/batch/references": {
+      "post": {
+        "description": "Register cross-references between any class items (objects or objects) in bulk.",
         "tags": [
-          "objects"
+          "batch",
+          "references"
         ],
-        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
-        "operationId": "objects.class.get",
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonNodeNameParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
             }
           },
           "400": {
@@ -1234,11 +1146,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1253,64 +1162,47 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.add"
         ]
-      },
-      "put": {
-        "description": "Update an individual data object based on its class and uuid.",
+      }
+    },
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
         "tags": [
-          "objects"
+          "classifications"
         ],
-        "summary": "Update a class object based on its uuid",
-        "operationId": "objects.class.put",
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "description": "parameters to start a classification",
+            "name": "params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Classification"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully received.",
+          "201": {
+            "description": "Successfully started classification.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Classification"
             }
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
+          "400": {
+            "description": "Incorrect request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1322,49 +1214,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.classifications.post"
         ]
-      },
-      "delete": {
-        "description": "Delete a single data object.",
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "objects"
+          "classifications"
         ],
-        "summary": "Delete object based on its class and UUID.",
-        "operationId": "objects.class.delete",
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "classification id",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "Found the classification, returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Classification"
             }
           },
           "401": {
@@ -1377,13 +1253,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Not Found - Classification does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1392,45 +1262,24 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.classifications.get"
         ]
-      },
-      "head": {
-        "description": "Checks if a data object exists without retrieving it.",
+      }
+    },
+    "/cluster/shards": {
+      "get": {
+        "description": "Returns the configuration of the shard balancer, the active replicas per node and the moves started by this node.",
         "tags": [
-          "objects"
-        ],
-        "summary": "Checks object's existence based on its class and uuid.",
-        "operationId": "objects.class.head",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
-          }
+          "cluster"
         ],
+        "operationId": "cluster.shards.get",
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Status of the shard balancer",
+            "schema": {
+              "$ref": "#/definitions/ShardBalancerStatus"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1441,11 +1290,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Object doesn't exist."
-          },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "The shard balancer is not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1456,56 +1302,31 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        }
+      }
+    },
+    "/cluster/shards/bandwidth": {
+      "put": {
+        "description": "Limits the bandwidth moves started by this node may use.",
         "tags": [
-          "objects"
+          "cluster"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.class.patch",
+        "operationId": "cluster.shards.bandwidth.update",
         "parameters": [
           {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/BandwidthLimit"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed.",
+          "200": {
+            "description": "The limit was changed",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ShardBalancerStatus"
             }
           },
           "401": {
@@ -1517,11 +1338,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Invalid bandwidth limit",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1532,68 +1350,49 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        }
       }
     },
-    "/objects/{className}/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Update all references of a property of a data object.",
+    "/cluster/shards/{shardName}/move": {
+      "post": {
+        "description": "Moves a replica of a shard to another node. The replica is copied in the background and dropped from the source node once the target serves it.",
         "tags": [
-          "objects"
+          "cluster"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "operationId": "cluster.shards.move",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
+            "description": "Name of the shard",
+            "name": "shardName",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
+            "description": "The node to move the replica to",
+            "name": "target",
+            "in": "query",
             "required": true
           },
           {
             "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "description": "The class of the shard, required if the shard name is not unique",
+            "name": "class",
+            "in": "query"
           },
           {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "type": "string",
+            "description": "The node to move the replica from, required if the shard has several replicas",
+            "name": "source",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully replaced all the references."
-          },
-          "400": {
-            "description": "Malformed request.",
+          "202": {
+            "description": "The move was started",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ShardMove"
             }
           },
           "401": {
@@ -1606,10 +1405,19 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
+            "description": "Class, shard or node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The shard is already being moved",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid move",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1620,66 +1428,32 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "post": {
-        "description": "Add a single reference to a class-property.",
+        }
+      }
+    },
+    "/config": {
+      "put": {
+        "description": "Changes settings of the node serving the request at runtime, like sending SIGHUP to it. The body is a partial configuration with the same keys as the configuration file, e.g. ` + "`" + `{\"log_level\": \"debug\"}` + "`" + `. The response lists the changed settings which were applied and those which require a restart.",
         "tags": [
-          "objects"
+          "nodes"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "operationId": "config.update",
         "parameters": [
           {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The settings to change",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "type": "object"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The settings were changed",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ConfigReloadResult"
             }
           },
           "401": {
@@ -1691,11 +1465,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Source object doesn't exist."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid settings, or config reload is not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1706,66 +1477,85 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
+        }
+      }
+    },
+    "/debug/bundle": {
+      "get": {
+        "description": "Returns a diagnostics bundle of the node serving the request for support cases. The bundle is a gzip compressed tar archive of the profiles, the goroutines, the redacted configuration, the schema, the node and shard status and the slow queries.",
+        "produces": [
+          "application/gzip",
+          "application/json"
+        ],
         "tags": [
-          "objects"
+          "debug"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
+        "operationId": "debug.bundle.get",
         "parameters": [
           {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "maximum": 120,
+            "type": "integer",
+            "format": "int64",
+            "default": 10,
+            "description": "How long the cpu profile is sampled for, 0 skips it",
+            "name": "cpu_seconds",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The diagnostics bundle",
+            "schema": {
+              "type": "file"
+            }
           },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "422": {
+            "description": "Invalid cpu_seconds",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/debug/recall": {
+      "post": {
+        "description": "Evaluates the recall of the vector indexes of a class on the node serving the request, to tune the ef setting against the real data. The vectors of sampleSize objects per shard are used as queries. The ground truth is a flat search over all vectors of the shard, so the request takes as long as scanning the local shards of the class.",
+        "tags": [
+          "debug"
+        ],
+        "operationId": "debug.recall.evaluate",
+        "parameters": [
           {
+            "description": "The class and the ef settings to evaluate",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/RecallRequest"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "The recall and latency for each ef setting",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/RecallReport"
             }
           },
           "401": {
@@ -1778,13 +1568,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
+            "description": "The class or tenant does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid request, or the database is not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1795,47 +1585,41 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        }
       }
     },
-    "/objects/{id}": {
+    "/debug/vector-graph/{className}/{shardName}": {
       "get": {
-        "description": "Lists Objects.",
+        "description": "Exports the graph of the hnsw vector index of a shard on the node serving the request in the portable graph format. The export is a snapshot of the graph, the vectors are not part of it. Inserts and deletes of the shard are blocked while the graph is exported.",
+        "produces": [
+          "application/octet-stream",
+          "application/json"
+        ],
         "tags": [
-          "objects"
+          "debug"
         ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
-        "deprecated": true,
+        "operationId": "debug.vectorGraph.export",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "description": "The class of the shard",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "type": "string",
+            "description": "The shard on the node serving the request",
+            "name": "shardName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The graph of the vector index",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "file"
             }
           },
           "401": {
@@ -1848,7 +1632,16 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or shard does not exist on this node",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The vector index does not support graph exports, or the database is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1856,48 +1649,46 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query"
-        ]
+        }
       },
       "put": {
-        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Imports a graph exported by debug.vectorGraph.export into the empty hnsw vector index of a shard on the node serving the request. The objects of the graph must already be part of the shard, their vectors are read from it. The input is validated before anything is written, the imported graph is persisted like any other change of the index.",
+        "consumes": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "objects"
+          "debug"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
-        "deprecated": true,
+        "operationId": "debug.vectorGraph.import",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "description": "The class of the shard",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The shard on the node serving the request",
+            "name": "shardName",
             "in": "path",
             "required": true
           },
           {
+            "description": "A graph as returned by debug.vectorGraph.export",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "type": "string",
+              "format": "binary"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully received.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+          "204": {
+            "description": "The graph was imported"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1909,10 +1700,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The class or shard does not exist on this node",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid graph, the vector index is not empty, or the database is not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1923,40 +1717,34 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "delete": {
-        "description": "Deletes an Object from the system.",
+        }
+      }
+    },
+    "/graphql": {
+      "post": {
+        "description": "Get an object based on GraphQL",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "description": "The GraphQL query request parameters.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQuery"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1967,8 +1755,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1977,33 +1768,41 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
-      "head": {
-        "description": "Checks if an Object exists in the system.",
+      }
+    },
+    "/graphql/batch": {
+      "post": {
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
-        "deprecated": true,
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "The GraphQL queries.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQueries"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponses"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2014,8 +1813,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Object doesn't exist."
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -2024,47 +1826,40 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
-      "patch": {
-        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/graphql/explain": {
+      "post": {
+        "description": "Plans the Get queries of a GraphQL request without executing them. The filters of the queries are evaluated to count the objects matching them, the vector and keyword searches are not executed and no results are returned.",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
-        "deprecated": true,
+        "operationId": "graphql.explain",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "description": "The GraphQL query request parameters, the same as for /graphql.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/GraphQLQuery"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "200": {
+            "description": "The plans of the queries",
+            "schema": {
+              "$ref": "#/definitions/GraphQLExplainResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2075,11 +1870,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2090,54 +1882,71 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        }
       }
     },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
+    "/imports": {
+      "get": {
+        "description": "Lists the import jobs of the node serving the request.",
         "tags": [
-          "objects"
+          "batch"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
+        "operationId": "imports.list",
+        "responses": {
+          "200": {
+            "description": "The import jobs",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ImportJob"
+              }
+            }
           },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid import job, or bulk imports are not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Starts a job which imports files of objects from a backup backend into a class. The files are imported in the background one after another on the node which received the request, the job is not visible on other nodes.",
+        "tags": [
+          "batch"
+        ],
+        "operationId": "imports.create",
+        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/ImportJobRequest"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully replaced all the references."
+          "202": {
+            "description": "The import job was started",
+            "schema": {
+              "$ref": "#/definitions/ImportJob"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2149,7 +1958,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid import job, or bulk imports are not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2160,52 +1969,31 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "post": {
-        "description": "Add a single reference to a class-property.",
+        }
+      }
+    },
+    "/imports/{id}": {
+      "get": {
+        "description": "Returns the progress of an import job per file.",
         "tags": [
-          "objects"
+          "batch"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
-        "deprecated": true,
+        "operationId": "imports.get",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The id of the import job",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "The import job",
+            "schema": {
+              "$ref": "#/definitions/ImportJob"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2216,8 +2004,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The import job does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid import job, or bulk imports are not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2228,52 +2022,26 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        }
       },
       "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "description": "Cancels an import job. Objects which were already imported are kept.",
         "tags": [
-          "objects"
+          "batch"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
-        "deprecated": true,
+        "operationId": "imports.cancel",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The id of the import job",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "204": {
-            "description": "Successfully deleted."
+            "description": "The import job was canceled"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2285,7 +2053,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
+            "description": "The import job does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid import job, or bulk imports are not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2296,69 +2070,73 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        }
       }
     },
-    "/schema": {
-      "get": {
+    "/imports:csv": {
+      "post": {
+        "description": "Starts a job which imports an uploaded CSV file into a class. The columns of the file are the properties of the objects, auto schema creates the class or adds the properties of columns missing from it before the file is imported.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "schema"
+          "batch"
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
-        "responses": {
-          "200": {
-            "description": "Successfully dumped the database schema.",
-            "schema": {
-              "$ref": "#/definitions/Schema"
-            }
+        "operationId": "imports.csv.upload",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class to import into",
+            "name": "class",
+            "in": "formData",
+            "required": true
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
+          {
+            "type": "string",
+            "description": "The tenant to import into, required for classes with multi-tenancy enabled",
+            "name": "tenant",
+            "in": "formData"
           },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of objects imported per batch",
+            "name": "batchSize",
+            "in": "formData"
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-serviceIds": [
-          "weaviate.local.query.meta"
-        ]
-      },
-      "post": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
-        "parameters": [
           {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "type": "boolean",
+            "default": true,
+            "description": "Derive the properties of the class from the header and the first rows of the file",
+            "name": "autoSchema",
+            "in": "formData"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The rows whose values determine the data types of the columns",
+            "name": "sampleRows",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "description": "A JSON object of the data types of columns which are not to be derived by auto schema, e.g. {\"zipCode\": \"text\"}",
+            "name": "types",
+            "in": "formData"
+          },
+          {
+            "type": "file",
+            "description": "The CSV file",
+            "name": "file",
+            "in": "formData",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Added the new Object class to the schema.",
+          "202": {
+            "description": "The import job was started",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ImportJob"
             }
           },
           "401": {
@@ -2371,7 +2149,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid Object class",
+            "description": "Invalid import job, or bulk imports are not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2382,54 +2160,59 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.add.meta"
-        ]
+        }
       }
     },
-    "/schema/cluster-status": {
+    "/meta": {
       "get": {
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "schema"
+          "meta"
         ],
-        "operationId": "schema.cluster.status",
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "The schema in the cluster is in sync.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/SchemaClusterStatus"
+              "$ref": "#/definitions/Meta"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "The schema is either out of sync (see response body) or the sync check could not be completed.",
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
-              "$ref": "#/definitions/SchemaClusterStatus"
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
       }
     },
-    "/schema/{className}": {
+    "/module-credentials": {
       "get": {
+        "description": "Lists the names of the stored module credentials of all tenants and of single tenants. The values are never returned.",
         "tags": [
-          "schema"
-        ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          }
+          "modules"
         ],
+        "operationId": "modules.credentials.list",
         "responses": {
           "200": {
-            "description": "Found the Class, returned as body",
+            "description": "The names of the stored credentials",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ModuleCredentialsListing"
             }
           },
           "401": {
@@ -2441,49 +2224,37 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "This class does not exist"
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.get.meta"
-        ]
+        }
       },
       "put": {
-        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
+        "description": "Sets or rotates module credentials for all tenants at runtime. The credentials are added to requests as headers unless the request carries them already. They are replicated to all nodes of the cluster.",
         "tags": [
-          "schema"
+          "modules"
         ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
+        "operationId": "modules.credentials.update",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "objectClass",
+            "description": "The credentials by the name of the request header the modules read them from, e.g. {\"X-Openai-Api-Key\": \"sk-...\"}. Empty values remove a credential.",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "type": "object",
+              "additionalProperties": {
+                "type": "string"
+              }
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Class was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+          "204": {
+            "description": "The credentials were stored"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2494,14 +2265,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Class to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Invalid credentials, or module credentials are not enabled",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2512,34 +2277,17 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
+        }
       },
       "delete": {
+        "description": "Deletes the module credentials for all tenants. The credentials of single tenants are kept.",
         "tags": [
-          "schema"
-        ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          }
+          "modules"
         ],
+        "operationId": "modules.credentials.delete",
         "responses": {
-          "200": {
-            "description": "Removed the Object class from the schema."
-          },
-          "400": {
-            "description": "Could not delete the Object class.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "The credentials were deleted"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2556,41 +2304,40 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
+        }
       }
     },
-    "/schema/{className}/properties": {
-      "post": {
+    "/module-credentials/{tenant}": {
+      "put": {
+        "description": "Sets or rotates the module credentials of a single tenant at runtime. They take precedence over the credentials for all tenants for requests of principals bound to the tenant, the tenant a request names does not select them.",
         "tags": [
-          "schema"
+          "modules"
         ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
+        "operationId": "modules.credentials.tenant.update",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "The tenant whose credentials are changed",
+            "name": "tenant",
             "in": "path",
             "required": true
           },
           {
+            "description": "The credentials by the name of the request header the modules read them from, e.g. {\"X-Openai-Api-Key\": \"sk-...\"}. Empty values remove a credential.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "type": "object",
+              "additionalProperties": {
+                "type": "string"
+              }
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Added the property.",
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
+          "204": {
+            "description": "The credentials were stored"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2602,7 +2349,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid property.",
+            "description": "Invalid credentials, or module credentials are not enabled",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2613,38 +2360,26 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
-      }
-    },
-    "/schema/{className}/shards": {
-      "get": {
+        }
+      },
+      "delete": {
+        "description": "Deletes the module credentials of a single tenant.",
         "tags": [
-          "schema"
+          "modules"
         ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
+        "operationId": "modules.credentials.tenant.delete",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "The tenant whose credentials are changed",
+            "name": "tenant",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "tenant",
-            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Found the status of the shards, returned as body",
-            "schema": {
-              "$ref": "#/definitions/ShardStatusList"
-            }
+          "204": {
+            "description": "The credentials were deleted"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2656,7 +2391,7 @@ func init() {
             }
           },
           "404": {
-            "description": "This class does not exist",
+            "description": "The tenant has no module credentials",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2667,46 +2402,26 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.get.meta"
-        ]
+        }
       }
     },
-    "/schema/{className}/shards/{shardName}": {
-      "put": {
-        "description": "Update shard status of an Object Class",
+    "/nodes": {
+      "get": {
+        "description": "Returns status of Weaviate DB.",
         "tags": [
-          "schema"
+          "nodes"
         ],
-        "operationId": "schema.objects.shards.update",
+        "operationId": "nodes.get",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "shardName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ShardStatus"
-            }
+            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status was updated successfully",
+            "description": "Nodes status successfully returned",
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -2719,13 +2434,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Shard to be updated does not exist",
+            "description": "Not Found - Backup does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2738,33 +2453,33 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.nodes.status.get"
         ]
       }
     },
-    "/schema/{className}/tenants": {
+    "/nodes/{className}": {
       "get": {
-        "description": "get all tenants from a specific class",
+        "description": "Returns status of Weaviate DB.",
         "tags": [
-          "schema"
+          "nodes"
         ],
-        "operationId": "tenants.get",
+        "operationId": "nodes.get.class",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "tenants from specified class.",
+            "description": "Nodes status successfully returned",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -2776,8 +2491,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2788,41 +2509,33 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "put": {
-        "description": "Update tenant of a specific class",
+        },
+        "x-serviceIds": [
+          "weaviate.nodes.status.get.class"
+        ]
+      }
+    },
+    "/nodes/{nodeName}/drain": {
+      "get": {
+        "description": "Returns the replicas left on a node and whether the node can be removed safely.",
         "tags": [
-          "schema"
+          "nodes"
         ],
-        "operationId": "tenants.update",
+        "operationId": "nodes.drain.get",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "Name of the node",
+            "name": "nodeName",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Updated tenants of the specified class",
+            "description": "Drain status of the node",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/NodeDrain"
             }
           },
           "401": {
@@ -2834,8 +2547,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "The shard balancer is not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2849,38 +2568,25 @@ func init() {
         }
       },
       "post": {
-        "description": "Create a new tenant for a specific class",
+        "description": "Stops placing shards on a node and moves its replicas to the other nodes. The node can be removed safely once no replicas are left on it.",
         "tags": [
-          "schema"
+          "nodes"
         ],
-        "operationId": "tenants.create",
+        "operationId": "nodes.drain.create",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "Name of the node",
+            "name": "nodeName",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Added new tenants to the specified class",
+          "202": {
+            "description": "The drain was started",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/NodeDrain"
             }
           },
           "401": {
@@ -2892,8 +2598,20 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The node is being drained",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "The shard balancer is not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2907,33 +2625,26 @@ func init() {
         }
       },
       "delete": {
-        "description": "delete tenants from a specific class",
+        "description": "Places shards on a drained node again. Replicas which were already moved stay on their new nodes.",
         "tags": [
-          "schema"
+          "nodes"
         ],
-        "operationId": "tenants.delete",
+        "operationId": "nodes.drain.delete",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "Name of the node",
+            "name": "nodeName",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "tenants",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Deleted tenants from specified class."
+            "description": "The node is no longer drained",
+            "schema": {
+              "$ref": "#/definitions/NodeDrain"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2944,8 +2655,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "The shard balancer is not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2958,2116 +2675,2520 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "Additional Meta information about a single object object.",
-      "type": "object",
-      "additionalProperties": {
-        "type": "object"
-      }
-    },
-    "BM25Config": {
-      "description": "tuning parameters for the BM25 algorithm",
-      "type": "object",
-      "properties": {
-        "b": {
-          "description": "calibrates term-weight scaling based on the document length",
-          "type": "number",
-          "format": "float"
-        },
-        "k1": {
-          "description": "calibrates term-weight scaling based on the term frequency within a document",
-          "type": "number",
-          "format": "float"
-        }
-      }
     },
-    "BackupCreateRequest": {
-      "description": "Request body for creating a backup of a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup creation process",
-          "type": "object"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup creation process",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/objects": {
+      "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
+        "parameters": [
+          {
+            "$ref": "#/parameters/CommonAfterParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonOffsetParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonLimitParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonFieldsParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonSortParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonOrderParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonClassParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "include": {
-          "description": "List of classes to include in the backup creation process",
-          "type": "array",
-          "items": {
-            "type": "string"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "post": {
+        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Create Objects between two Objects (object and subject).",
+        "operationId": "objects.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIdempotencyKeyParameterHeader"
           }
-        }
-      }
-    },
-    "BackupCreateResponse": {
-      "description": "The definition of a backup create response body",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "classes": {
-          "description": "The list of classes for which the backup creation process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Object created.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            },
+            "headers": {
+              "Idempotent-Replayed": {
+                "type": "string",
+                "description": "Set to true if the response is the kept response to an earlier request with the same Idempotency-Key"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A request with the same Idempotency-Key is still in progress",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file? Also returned if the Idempotency-Key was used before with a different body.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "error": {
-          "description": "error message if creation failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup creation process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
-        }
-      }
-    },
-    "BackupCreateStatusResponse": {
-      "description": "The definition of a backup create metadata",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "error": {
-          "description": "error message if creation failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup creation process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
       }
     },
-    "BackupRestoreRequest": {
-      "description": "Request body for restoring a backup for a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup restoration process",
-          "type": "object"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup restoration process",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/objects/validate": {
+      "post": {
+        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           }
-        },
-        "include": {
-          "description": "List of classes to include in the backup restoration process",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully validated."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "node_mapping": {
-          "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
       }
     },
-    "BackupRestoreResponse": {
-      "description": "The definition of a backup restore response body",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "classes": {
-          "description": "The list of classes for which the backup restoration process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
-        }
-      }
-    },
-    "BackupRestoreStatusResponse": {
-      "description": "The definition of a backup restore metadata",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backup backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
-        }
-      }
-    },
-    "BatchDelete": {
-      "type": "object",
-      "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
+    "/objects/{className}/{id}": {
+      "get": {
+        "description": "Get a single data object",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
+        "operationId": "objects.class.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonFieldsParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonNodeNameParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
-        }
-      }
-    },
-    "BatchDeleteResponse": {
-      "description": "Delete Objects response.",
-      "type": "object",
-      "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Update an individual data object based on its class and uuid.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update a class object based on its uuid",
+        "operationId": "objects.class.put",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
-        },
-        "results": {
-          "type": "object",
-          "properties": {
-            "failed": {
-              "description": "How many objects should have been deleted but could not be deleted.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "limit": {
-              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "matches": {
-              "description": "How many objects were matched by the filter.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "objects": {
-              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
-              "type": "array",
-              "items": {
-                "description": "Results for this specific Object.",
-                "format": "object",
-                "properties": {
-                  "errors": {
-                    "$ref": "#/definitions/ErrorResponse"
-                  },
-                  "id": {
-                    "description": "ID of the Object.",
-                    "type": "string",
-                    "format": "uuid"
-                  },
-                  "status": {
-                    "type": "string",
-                    "default": "SUCCESS",
-                    "enum": [
-                      "SUCCESS",
-                      "DRYRUN",
-                      "FAILED"
-                    ]
-                  }
-                }
-              }
-            },
-            "successful": {
-              "description": "How many objects were successfully deleted in this round.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete a single data object.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete object based on its class and UUID.",
+        "operationId": "objects.class.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
-        }
-      }
-    },
-    "BatchReference": {
-      "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
-        },
-        "tenant": {
-          "description": "Name of the reference tenant.",
-          "type": "string"
-        },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
-        }
-      }
-    },
-    "BatchReferenceResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        }
-      ]
-    },
-    "BatchStats": {
-      "description": "The summary of a nodes batch queue congestion status.",
-      "properties": {
-        "queueLength": {
-          "description": "How many objects are currently in the batch queue.",
-          "type": "number",
-          "format": "int",
-          "x-nullable": true,
-          "x-omitempty": true
-        },
-        "ratePerSecond": {
-          "description": "How many objects are approximately processed from the batch queue per second.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
-        }
-      }
-    },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
-      "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
-        },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
-          "type": "string"
-        },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "distance": {
-            "type": "number",
-            "format": "float"
           },
-          "word": {
-            "type": "string"
-          }
-        }
-      }
-    },
-    "C11yVector": {
-      "description": "A Vector in the Contextionary",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float"
-      }
-    },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classProps": {
-            "description": "Vectorized properties.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "object",
-              "properties": {
-                "propsVectors": {
-                  "type": "array",
-                  "items": {
-                    "type": "number",
-                    "format": "float"
-                  }
-                },
-                "value": {
-                  "description": "String with valuename.",
-                  "type": "string"
-                }
-              }
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "classVectors": {
-            "description": "Vectorized classname.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "number",
-              "format": "float"
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
-      "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
-          "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
-              "type": "array",
-              "items": {
-                "format": "string"
-              }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "head": {
+        "description": "Checks if a data object exists without retrieving it.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Checks object's existence based on its class and uuid.",
+        "operationId": "objects.class.head",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Object exists."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Object doesn't exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "info": {
-                "type": "object",
-                "properties": {
-                  "nearestNeighbors": {
-                    "$ref": "#/definitions/C11yNearestNeighbors"
-                  },
-                  "vector": {
-                    "$ref": "#/definitions/C11yVector"
-                  }
-                }
-              },
-              "present": {
-                "type": "boolean"
-              },
-              "word": {
-                "type": "string"
-              }
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.class.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
-        }
-      }
-    },
-    "Class": {
-      "type": "object",
-      "properties": {
-        "class": {
-          "description": "Name of the class as URI relative to the schema URL.",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of the class.",
-          "type": "string"
-        },
-        "invertedIndexConfig": {
-          "$ref": "#/definitions/InvertedIndexConfig"
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
-        },
-        "multiTenancyConfig": {
-          "$ref": "#/definitions/MultiTenancyConfig"
-        },
-        "properties": {
-          "description": "The properties of the class.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Property"
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "replicationConfig": {
-          "$ref": "#/definitions/ReplicationConfig"
-        },
-        "shardingConfig": {
-          "description": "Manage how the index should be sharded and distributed in the cluster",
-          "type": "object"
-        },
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
-        },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
-          "type": "string"
-        },
-        "vectorizer": {
-          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
-          "type": "string"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
-      "type": "object",
-      "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Update all references of a property of a data object.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.class.references.put",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
-          "example": [
-            "description"
-          ]
-        },
-        "class": {
-          "description": "class (name) which is used in this classification",
-          "type": "string",
-          "example": "City"
-        },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
-          "type": "array",
-          "items": {
-            "type": "string"
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
-          "example": [
-            "inCountry"
-          ]
-        },
-        "error": {
-          "description": "error message if status == failed",
-          "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
-        },
-        "filters": {
-          "type": "object",
-          "properties": {
-            "sourceWhere": {
-              "description": "limit the objects to be classified",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "targetWhere": {
-              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "trainingSetWhere": {
-              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
-        },
-        "id": {
-          "description": "ID to uniquely identify this classification run",
-          "type": "string",
-          "format": "uuid",
-          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
-        },
-        "meta": {
-          "description": "additional meta information about the classification",
-          "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
-        },
-        "settings": {
-          "description": "classification-type specific settings",
-          "type": "object"
-        },
-        "status": {
-          "description": "status of this classification",
-          "type": "string",
-          "enum": [
-            "running",
-            "completed",
-            "failed"
-          ],
-          "example": "running"
-        },
-        "type": {
-          "description": "which algorithm to use for classifications",
-          "type": "string"
-        }
-      }
-    },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
-      "type": "object",
-      "properties": {
-        "completed": {
-          "description": "time when this classification finished",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
-        },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
-          "type": "integer",
-          "example": 147
-        },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
-          "type": "integer",
-          "example": 7
-        },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
-          "type": "integer",
-          "example": 140
-        },
-        "started": {
-          "description": "time when this classification was started",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
-        }
-      }
-    },
-    "Deprecation": {
-      "type": "object",
-      "properties": {
-        "apiType": {
-          "description": "Describes which API is effected, usually one of: REST, GraphQL",
-          "type": "string"
-        },
-        "id": {
-          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
-          "type": "string"
-        },
-        "locations": {
-          "description": "The locations within the specified API affected by this deprecation",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "mitigation": {
-          "description": "User-required object to not be affected by the (planned) removal",
-          "type": "string"
-        },
-        "msg": {
-          "description": "What this deprecation is about",
-          "type": "string"
-        },
-        "plannedRemovalVersion": {
-          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
-          "type": "string"
-        },
-        "removedIn": {
-          "description": "If the feature has already been removed, it was removed in this version",
-          "type": "string",
-          "x-nullable": true
-        },
-        "removedTime": {
-          "description": "If the feature has already been removed, it was removed at this timestamp",
-          "type": "string",
-          "format": "date-time",
-          "x-nullable": true
-        },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string",
-          "format": "date-time"
-        },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string"
-        },
-        "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
-          "type": "string"
-        }
-      }
-    },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "message": {
-                "type": "string"
-              }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        }
-      }
-    },
-    "GeoCoordinates": {
-      "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        }
-      }
-    },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
-      "properties": {
-        "locations": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "column": {
-                "type": "integer",
-                "format": "int64"
-              },
-              "line": {
-                "type": "integer",
-                "format": "int64"
-              }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "message": {
-          "type": "string"
-        },
-        "path": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.class.references.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
-      "type": "object",
-      "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
-          "type": "string"
         },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
-          "type": "string"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.class.references.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
-      "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
+    "/objects/{className}/{id}/versions": {
+      "get": {
+        "description": "Lists the kept versions of an object of a class with versioning enabled, newest first.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.class.versions.list",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include the vectors of the versions if set to ` + "`" + `vector` + "`" + `",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
-        },
-        "errors": {
-          "description": "Array with errors.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLError"
+        ],
+        "responses": {
+          "200": {
+            "description": "Versions of the object",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectVersion"
+              }
+            }
           },
-          "x-omitempty": true
-        }
-      }
-    },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
-      }
-    },
-    "InvertedIndexConfig": {
-      "description": "Configure the inverted index built into Weaviate",
-      "type": "object",
-      "properties": {
-        "bm25": {
-          "$ref": "#/definitions/BM25Config"
-        },
-        "cleanupIntervalSeconds": {
-          "description": "Asynchronous index clean up happens every n seconds",
-          "type": "number",
-          "format": "int"
-        },
-        "indexNullState": {
-          "description": "Index each object with the null state",
-          "type": "boolean"
-        },
-        "indexPropertyLength": {
-          "description": "Index length of properties",
-          "type": "boolean"
-        },
-        "indexTimestamps": {
-          "description": "Index each object by its internal timestamps",
-          "type": "boolean"
-        },
-        "stopwords": {
-          "$ref": "#/definitions/StopwordConfig"
-        }
-      }
-    },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
-    "Link": {
-      "type": "object",
-      "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
-          "type": "string"
-        },
-        "href": {
-          "description": "target of the link",
-          "type": "string"
-        },
-        "name": {
-          "description": "human readable name of the resource group",
-          "type": "string"
-        },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
-          "type": "string"
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
-      "type": "object",
-      "properties": {
-        "hostname": {
-          "description": "The url of the host.",
-          "type": "string",
-          "format": "url"
-        },
-        "modules": {
-          "description": "Module-specific meta information",
-          "type": "object"
-        },
-        "version": {
-          "description": "Version of weaviate you are currently running",
-          "type": "string"
+    "/objects/{className}/{id}/versions/diff": {
+      "get": {
+        "description": "Lists the properties which differ between two versions of an object.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.class.versions.diff",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to compare",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to compare with, defaults to the current version",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Differences of the versions",
+            "schema": {
+              "$ref": "#/definitions/ObjectVersionDiff"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "MultiTenancyConfig": {
-      "description": "Configuration related to multi-tenancy within a class",
-      "properties": {
-        "enabled": {
-          "description": "Whether or not multi-tenancy is enabled for this class",
-          "type": "boolean",
-          "x-omitempty": false
+    "/objects/{className}/{id}/versions/{version}/rollback": {
+      "post": {
+        "description": "Replaces an object with one of its kept versions. The rollback itself is kept as a new version.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.class.versions.rollback",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to roll back to",
+            "name": "version",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The object after the rollback",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
-      }
-    },
-    "NestedProperty": {
-      "type": "object",
-      "properties": {
-        "dataType": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/objects/{id}": {
+      "get": {
+        "description": "Lists Objects.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonFieldsParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "description": {
-          "type": "string"
-        },
-        "indexFilterable": {
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexSearchable": {
-          "type": "boolean",
-          "x-nullable": true
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "name": {
-          "type": "string"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Deletes an Object from the system.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "nestedProperties": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NestedProperty"
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "head": {
+        "description": "Checks if an Object exists in the system.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Object exists."
           },
-          "x-omitempty": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Object doesn't exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "tokenization": {
-          "type": "string",
-          "enum": [
-            "word",
-            "lowercase",
-            "whitespace",
-            "field"
-          ]
-        }
-      }
-    },
-    "NodeShardStatus": {
-      "description": "The definition of a node shard status response body",
-      "properties": {
-        "class": {
-          "description": "The name of shard's class.",
-          "type": "string",
-          "x-omitempty": false
-        },
-        "compressed": {
-          "description": "The status of vector compression/quantization.",
-          "format": "boolean",
-          "x-omitempty": false
-        },
-        "name": {
-          "description": "The name of the shard.",
-          "type": "string",
-          "x-omitempty": false
-        },
-        "objectCount": {
-          "description": "The number of objects in shard.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        },
-        "vectorIndexingStatus": {
-          "description": "The status of the vector indexing process.",
-          "format": "string",
-          "x-omitempty": false
-        },
-        "vectorQueueLength": {
-          "description": "The length of the vector indexing queue.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        }
-      }
-    },
-    "NodeStats": {
-      "description": "The summary of Weaviate's statistics.",
-      "properties": {
-        "objectCount": {
-          "description": "The total number of objects in DB.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        },
-        "shardCount": {
-          "description": "The count of Weaviate's shards.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
-        }
-      }
-    },
-    "NodeStatus": {
-      "description": "The definition of a backup node status response body",
-      "properties": {
-        "batchStats": {
-          "description": "Weaviate batch statistics.",
-          "type": "object",
-          "$ref": "#/definitions/BatchStats"
-        },
-        "gitHash": {
-          "description": "The gitHash of Weaviate.",
-          "type": "string"
-        },
-        "name": {
-          "description": "The name of the node.",
-          "type": "string"
-        },
-        "shards": {
-          "description": "The list of the shards with it's statistics.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NodeShardStatus"
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.patch",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "stats": {
-          "description": "Weaviate overall statistics.",
-          "type": "object",
-          "$ref": "#/definitions/NodeStats"
-        },
-        "status": {
-          "description": "Node's status.",
-          "type": "string",
-          "default": "HEALTHY",
-          "enum": [
-            "HEALTHY",
-            "UNHEALTHY",
-            "UNAVAILABLE"
-          ]
-        },
-        "version": {
-          "description": "The version of Weaviate.",
-          "type": "string"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "NodesStatusResponse": {
-      "description": "The status of all of the Weaviate nodes",
-      "type": "object",
-      "properties": {
-        "nodes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NodeStatus"
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.references.update",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "Object": {
-      "type": "object",
-      "properties": {
-        "additional": {
-          "$ref": "#/definitions/AdditionalProperties"
-        },
-        "class": {
-          "description": "Class of the Object, defined in the schema.",
-          "type": "string"
-        },
-        "creationTimeUnix": {
-          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "id": {
-          "description": "ID of the Object.",
-          "type": "string",
-          "format": "uuid"
         },
-        "lastUpdateTimeUnix": {
-          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "properties": {
-          "$ref": "#/definitions/PropertySchema"
-        },
-        "tenant": {
-          "description": "Name of the Objects tenant.",
-          "type": "string"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.references.create",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "vector": {
-          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
-          "$ref": "#/definitions/C11yVector"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.references.delete",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "ObjectsGetResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Object"
-        },
-        {
-          "properties": {
-            "deprecations": {
+    "/objects/{id}/versions": {
+      "get": {
+        "description": "Lists the kept versions of an object of a class with versioning enabled, newest first. The object is looked up by its id only, this is deprecated in favor of the class-specific endpoint.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.versions.list",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include the vectors of the versions if set to ` + "`" + `vector` + "`" + `",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Versions of the object",
+            "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/Deprecation"
+                "$ref": "#/definitions/ObjectVersion"
               }
             }
-          }
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Object.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         }
-      ]
+      }
     },
-    "ObjectsListResponse": {
-      "description": "List of Objects.",
-      "type": "object",
-      "properties": {
-        "deprecations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Deprecation"
-          }
-        },
-        "objects": {
-          "description": "The actual list of Objects.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Object"
-          }
-        },
-        "totalResults": {
-          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
+    "/objects/{id}/versions/diff": {
+      "get": {
+        "description": "Lists the properties which differ between two versions of an object. The object is looked up by its id only, this is deprecated in favor of the class-specific endpoint.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.versions.diff",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to compare",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to compare with, defaults to the current version",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Differences of the versions",
+            "schema": {
+              "$ref": "#/definitions/ObjectVersionDiff"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "PatchDocumentAction": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
-      "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Object"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
-        },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
-        },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+    "/objects/{id}/versions/{version}/rollback": {
+      "post": {
+        "description": "Replaces an object with one of its kept versions. The rollback itself is kept as a new version. The object is looked up by its id only, this is deprecated in favor of the class-specific endpoint.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.versions.rollback",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to roll back to",
+            "name": "version",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The object after the rollback",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "PatchDocumentObject": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
-      "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Object"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
-        },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
-        },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+    "/objects:duplicates": {
+      "post": {
+        "description": "Finds groups of near-duplicate objects of a class and optionally merges or deletes the duplicates. Without an action the groups are only reported. The objects of the shards on the node serving the request are compared.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.duplicates",
+        "parameters": [
+          {
+            "description": "Which objects are duplicates and what to do with them",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/DuplicatesRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The groups of duplicates",
+            "schema": {
+              "$ref": "#/definitions/DuplicatesResult"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class or tenant does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network.",
-      "properties": {
-        "id": {
-          "description": "The session ID of the peer.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Human readable name.",
-          "type": "string"
-        },
-        "schemaHash": {
-          "description": "The latest known hash of the peer's schema.",
-          "type": "string"
-        },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet.",
-          "type": "string",
-          "format": "uri"
+    "/objects:export": {
+      "get": {
+        "description": "Streams all objects of a class including their vectors as JSONL or Parquet, so a class can be copied into a data lake without paginating through the objects. The objects are ordered by id. The trailers ` + "`" + `X-Weaviate-Export-Cursor` + "`" + ` and ` + "`" + `X-Weaviate-Export-Done` + "`" + ` of the response contain the id of the last exported object and whether the class was exported completely, the next export continues after that id. An interrupted JSONL export can be resumed after the last complete line.",
+        "produces": [
+          "application/x-ndjson",
+          "application/vnd.apache.parquet",
+          "application/json"
+        ],
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.export",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class to export",
+            "name": "class",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "jsonl",
+              "parquet"
+            ],
+            "type": "string",
+            "default": "jsonl",
+            "description": "The format of the export",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The tenant to export, required for classes with multi-tenancy enabled",
+            "name": "tenant",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Continue the export after the object with this id",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of objects to export, 0 exports all objects",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Read a point-in-time snapshot of each shard, so objects written while the export runs are not torn across its pages",
+            "name": "snapshot",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The exported objects",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class or tenant does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid export parameters",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "PeerUpdateList": {
-      "description": "List of known peers.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/PeerUpdate"
+    "/objects:upload": {
+      "post": {
+        "description": "Creates an object of a class from every uploaded file. The text and metadata of the files are extracted by the enabled File2Text module, which maps them to the properties of the class. More files are uploaded by repeating the field file, all files of an upload are limited to 64 MiB. A file which can't be extracted or stored is reported in the response without failing the other files.",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.upload",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class of the objects",
+            "name": "class",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The tenant of the objects, required for classes with multi-tenancy enabled",
+            "name": "tenant",
+            "in": "formData"
+          },
+          {
+            "type": "file",
+            "description": "A file to create an object from",
+            "name": "file",
+            "in": "formData",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The objects created from the files",
+            "schema": {
+              "$ref": "#/definitions/ObjectsUploadResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid upload",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
       }
     },
-    "PhoneNumber": {
-      "properties": {
-        "countryCode": {
-          "description": "Read-only. The numerical country code (e.g. 49)",
-          "type": "number",
-          "format": "uint64"
-        },
-        "defaultCountry": {
-          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
-          "type": "string"
-        },
-        "input": {
-          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
-          "type": "string"
-        },
-        "internationalFormatted": {
-          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
-          "type": "string"
-        },
-        "national": {
-          "description": "Read-only. The numerical representation of the national part",
-          "type": "number",
-          "format": "uint64"
-        },
-        "nationalFormatted": {
-          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
-          "type": "string"
-        },
-        "valid": {
-          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
-          "type": "boolean"
+    "/replication/async": {
+      "get": {
+        "description": "Returns the anti-entropy configuration of this node and the latest repairs of the shards it leads.",
+        "tags": [
+          "replication"
+        ],
+        "operationId": "replication.async.get",
+        "responses": {
+          "200": {
+            "description": "Status of async replication",
+            "schema": {
+              "$ref": "#/definitions/AsyncReplicationStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Async replication is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "Principal": {
-      "type": "object",
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/replication/async/bandwidth": {
+      "put": {
+        "description": "Limits the bandwidth repairs on this node may use.",
+        "tags": [
+          "replication"
+        ],
+        "operationId": "replication.async.bandwidth.update",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BandwidthLimit"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The limit was changed",
+            "schema": {
+              "$ref": "#/definitions/AsyncReplicationStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid bandwidth limit",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        },
-        "username": {
-          "description": "The username that was extracted either from the authentication information",
-          "type": "string"
         }
       }
     },
-    "Property": {
-      "type": "object",
-      "properties": {
-        "dataType": {
-          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/replication/async/repair": {
+      "post": {
+        "description": "Starts repairing the replicas of a shard, or of all shards of a class led by this node if no shard is given.",
+        "tags": [
+          "replication"
+        ],
+        "operationId": "replication.async.repair",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AsyncReplicationRepairRequest"
+            }
           }
-        },
-        "description": {
-          "description": "Description of the property.",
-          "type": "string"
-        },
-        "indexFilterable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexInverted": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexSearchable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
-        },
-        "name": {
-          "description": "Name of the property as URI relative to the schema URL.",
-          "type": "string"
-        },
-        "nestedProperties": {
-          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NestedProperty"
+        ],
+        "responses": {
+          "202": {
+            "description": "The repairs were started",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/AsyncReplicationJob"
+              }
+            }
           },
-          "x-omitempty": true
-        },
-        "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
-          "type": "string",
-          "enum": [
-            "word",
-            "lowercase",
-            "whitespace",
-            "field"
-          ]
-        }
-      }
-    },
-    "PropertySchema": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ReferenceMetaClassification": {
-      "description": "This meta field contains additional info about the classified reference property",
-      "properties": {
-        "closestLosingDistance": {
-          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "closestOverallDistance": {
-          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
-          "type": "number",
-          "format": "float32"
-        },
-        "closestWinningDistance": {
-          "description": "Closest distance of a neighbor from the winning group",
-          "type": "number",
-          "format": "float32"
-        },
-        "losingCount": {
-          "description": "size of the losing group, can be 0 if the winning group size equals k",
-          "type": "number",
-          "format": "int64"
-        },
-        "losingDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "meanLosingDistance": {
-          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "meanWinningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
-        },
-        "overallCount": {
-          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
-          "type": "number",
-          "format": "int64"
-        },
-        "winningCount": {
-          "description": "size of the winning group, a number between 1..k",
-          "type": "number",
-          "format": "int64"
-        },
-        "winningDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or shard does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A repair of the shard is already running",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid repair request, e.g. the class is not replicated",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "ReplicationConfig": {
-      "description": "Configure how replication is executed in a cluster",
-      "type": "object",
-      "properties": {
-        "factor": {
-          "description": "Number of times a class is replicated",
-          "type": "integer"
+    "/replication/standby": {
+      "get": {
+        "description": "Returns whether this cluster is an active standby and how far it lags behind each node of its primary.",
+        "tags": [
+          "replication"
+        ],
+        "operationId": "replication.standby.get",
+        "responses": {
+          "200": {
+            "description": "Status of the standby",
+            "schema": {
+              "$ref": "#/definitions/StandbyStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "This cluster is not configured as a standby",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "Schema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
-      "type": "object",
-      "properties": {
-        "classes": {
-          "description": "Semantic classes that are available.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Class"
+    "/replication/standby/promote": {
+      "post": {
+        "description": "Promotes the standby, so that it stops applying the changes of its primary and accepts writes. Unless forced, all nodes of the primary need to be synced.",
+        "tags": [
+          "replication"
+        ],
+        "operationId": "replication.standby.promote",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Promote the standby even if it did not sync all nodes of the primary",
+            "name": "force",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The standby was promoted",
+            "schema": {
+              "$ref": "#/definitions/StandbyStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The standby cannot be promoted, e.g. because it is not synced",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "This cluster is not configured as a standby",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        },
-        "maintainer": {
-          "description": "Email of the maintainer.",
-          "type": "string",
-          "format": "email"
-        },
-        "name": {
-          "description": "Name of the schema.",
-          "type": "string"
         }
       }
     },
-    "SchemaClusterStatus": {
-      "description": "Indicates the health of the schema in a cluster.",
-      "type": "object",
-      "properties": {
-        "error": {
-          "description": "Contains the sync check error if one occurred",
-          "type": "string",
-          "x-omitempty": true
+    "/schema": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
+        "responses": {
+          "200": {
+            "description": "Successfully dumped the database schema.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "healthy": {
-          "description": "True if the cluster is in sync, false if there is an issue (see error).",
-          "type": "boolean",
-          "x-omitempty": false
-        },
-        "hostname": {
-          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
-          "type": "string"
-        },
-        "ignoreSchemaSync": {
-          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
-          "type": "boolean",
-          "x-omitempty": false
-        },
-        "nodeCount": {
-          "description": "Number of nodes that participated in the sync check",
-          "type": "number",
-          "format": "int"
-        }
-      }
-    },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ShardStatus": {
-      "description": "The status of a single shard",
-      "properties": {
-        "status": {
-          "description": "Status of the shard",
-          "type": "string"
-        }
-      }
-    },
-    "ShardStatusGetResponse": {
-      "description": "Response body of shard status get request",
-      "properties": {
-        "name": {
-          "description": "Name of the shard",
-          "type": "string"
-        },
-        "status": {
-          "description": "Status of the shard",
-          "type": "string"
-        },
-        "vectorQueueSize": {
-          "description": "Size of the vector queue of the shard",
-          "type": "integer",
-          "x-omitempty": false
-        }
-      }
-    },
-    "ShardStatusList": {
-      "description": "The status of all the shards of a Class",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/ShardStatusGetResponse"
-      }
-    },
-    "SingleRef": {
-      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
-      "properties": {
-        "beacon": {
-          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri"
-        },
-        "class": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
-          "type": "string",
-          "format": "uri"
-        },
-        "classification": {
-          "description": "Additional Meta information about classifications if the item was part of one",
-          "$ref": "#/definitions/ReferenceMetaClassification"
-        },
-        "href": {
-          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
-          "type": "string",
-          "format": "uri"
-        },
-        "schema": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
-          "$ref": "#/definitions/PropertySchema"
-        }
-      }
-    },
-    "StopwordConfig": {
-      "description": "fine-grained control over stopword list usage",
-      "type": "object",
-      "properties": {
-        "additions": {
-          "description": "stopwords to be considered additionally",
-          "type": "array",
-          "items": {
-            "type": "string"
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
+        "parameters": [
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           }
-        },
-        "preset": {
-          "description": "pre-existing list of common words by language",
-          "type": "string"
-        },
-        "removals": {
-          "description": "stopwords to be removed from consideration",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the new Object class to the schema.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Object class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
       }
     },
-    "Tenant": {
-      "description": "attributes representing a single tenant within weaviate",
-      "type": "object",
-      "properties": {
-        "activityStatus": {
-          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `WARM` + "`" + ` - tenant is active, some restrictions are imposed (TBD; not supported yet), ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - as COLD, but files are stored on cloud storage (not supported yet)",
-          "type": "string",
-          "enum": [
-            "HOT",
-            "WARM",
-            "COLD",
-            "FROZEN"
-          ]
-        },
-        "name": {
-          "description": "name of the tenant",
-          "type": "string"
+    "/schema/cluster-status": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.cluster.status",
+        "responses": {
+          "200": {
+            "description": "The schema in the cluster is in sync.",
+            "schema": {
+              "$ref": "#/definitions/SchemaClusterStatus"
+            }
+          },
+          "500": {
+            "description": "The schema is either out of sync (see response body) or the sync check could not be completed.",
+            "schema": {
+              "$ref": "#/definitions/SchemaClusterStatus"
+            }
+          }
         }
       }
     },
-    "VectorWeights": {
-      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
-      "type": "object"
-    },
-    "WhereFilter": {
-      "description": "Filter search results using a where filter",
-      "type": "object",
-      "properties": {
-        "operands": {
-          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/WhereFilter"
+    "/schema/{className}": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           }
-        },
-        "operator": {
-          "description": "operator to use",
-          "type": "string",
-          "enum": [
-            "And",
-            "Or",
-            "Equal",
-            "Like",
-            "NotEqual",
-            "GreaterThan",
-            "GreaterThanEqual",
-            "LessThan",
-            "LessThanEqual",
-            "WithinGeoRange",
-            "IsNull",
-            "ContainsAny",
-            "ContainsAll"
-          ],
-          "example": "GreaterThanEqual"
-        },
-        "path": {
-          "description": "path to the property currently being filtered",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the Class, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
-          "example": [
-            "inCity",
-            "City",
-            "name"
-          ]
-        },
-        "valueBoolean": {
-          "description": "value as boolean",
-          "type": "boolean",
-          "x-nullable": true,
-          "example": false
-        },
-        "valueBooleanArray": {
-          "description": "value as boolean",
-          "type": "array",
-          "items": {
-            "type": "boolean"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            true,
-            false
-          ]
-        },
-        "valueDate": {
-          "description": "value as date (as string)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "TODO"
-        },
-        "valueDateArray": {
-          "description": "value as date (as string)",
-          "type": "array",
-          "items": {
-            "type": "string"
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": "TODO"
-        },
-        "valueGeoRange": {
-          "description": "value as geo coordinates and distance",
-          "type": "object",
-          "x-nullable": true,
-          "$ref": "#/definitions/WhereFilterGeoRange"
-        },
-        "valueInt": {
-          "description": "value as integer",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true,
-          "example": 2000
-        },
-        "valueIntArray": {
-          "description": "value as integer",
-          "type": "array",
-          "items": {
-            "type": "integer",
-            "format": "int64"
+          "404": {
+            "description": "This class does not exist"
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": "[100, 200]"
-        },
-        "valueNumber": {
-          "description": "value as number/float",
-          "type": "number",
-          "format": "float64",
-          "x-nullable": true,
-          "example": 3.14
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "valueNumberArray": {
-          "description": "value as number/float",
-          "type": "array",
-          "items": {
-            "type": "number",
-            "format": "float64"
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      },
+      "put": {
+        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            3.14
-          ]
-        },
-        "valueString": {
-          "description": "value as text (deprecated as of v1.19; alias for valueText)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
-        },
-        "valueStringArray": {
-          "description": "value as text (deprecated as of v1.19; alias for valueText)",
-          "type": "array",
-          "items": {
-            "type": "string"
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Class was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            "my search term"
-          ]
-        },
-        "valueText": {
-          "description": "value as text",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "valueTextArray": {
-          "description": "value as text",
-          "type": "array",
-          "items": {
-            "type": "string"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the Object class from the schema."
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            "my search term"
-          ]
-        }
-      }
-    },
-    "WhereFilterGeoRange": {
-      "description": "filter within a distance of a georange",
-      "type": "object",
-      "properties": {
-        "distance": {
-          "type": "object",
-          "properties": {
-            "max": {
-              "type": "number",
-              "format": "float64"
+          "400": {
+            "description": "Could not delete the Object class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "geoCoordinates": {
-          "x-nullable": false,
-          "$ref": "#/definitions/GeoCoordinates"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
-    }
-  },
-  "parameters": {
-    "CommonAfterParameterQuery": {
-      "type": "string",
-      "description": "The starting ID of the result window.",
-      "name": "after",
-      "in": "query"
-    },
-    "CommonClassParameterQuery": {
-      "type": "string",
-      "description": "Class parameter specifies the class from which to query objects",
-      "name": "class",
-      "in": "query"
     },
-    "CommonConsistencyLevelParameterQuery": {
-      "type": "string",
-      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-      "name": "consistency_level",
-      "in": "query"
-    },
-    "CommonIncludeParameterQuery": {
-      "type": "string",
-      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-      "name": "include",
-      "in": "query"
-    },
-    "CommonLimitParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-      "name": "limit",
-      "in": "query"
-    },
-    "CommonNodeNameParameterQuery": {
-      "type": "string",
-      "description": "The target node which should fulfill the request",
-      "name": "node_name",
-      "in": "query"
-    },
-    "CommonOffsetParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "default": 0,
-      "description": "The starting index of the result window. Default value is 0.",
-      "name": "offset",
-      "in": "query"
-    },
-    "CommonOrderParameterQuery": {
-      "type": "string",
-      "description": "Order parameter to tell how to order (asc or desc) data within given field",
-      "name": "order",
-      "in": "query"
-    },
-    "CommonOutputVerbosityParameterQuery": {
-      "type": "string",
-      "default": "minimal",
-      "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-      "name": "output",
-      "in": "query"
-    },
-    "CommonSortParameterQuery": {
-      "type": "string",
-      "description": "Sort parameter to pass an information about the names of the sort fields",
-      "name": "sort",
-      "in": "query"
-    },
-    "CommonTenantParameterQuery": {
-      "type": "string",
-      "description": "Specifies the tenant in a request targeting a multi-tenant class",
-      "name": "tenant",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "oidc": {
-      "description": "OIDC (OpenConnect ID - based on OAuth2)",
-      "type": "oauth2",
-      "flow": "implicit",
-      "authorizationUrl": "http://to-be-configured-in-the-application-config"
-    }
-  },
-  "security": [
-    {},
-    {
-      "oidc": []
-    }
-  ],
-  "tags": [
-    {
-      "name": "objects"
-    },
-    {
-      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
-      "name": "batch"
-    },
-    {
-      "name": "graphql"
-    },
-    {
-      "name": "meta"
-    },
-    {
-      "name": "P2P"
-    },
-    {
-      "description": "All functions related to the Contextionary.",
-      "name": "contextionary-API"
-    },
-    {
-      "description": "These operations enable manipulation of the schema in Weaviate schema.",
-      "name": "schema"
-    }
-  ],
-  "externalDocs": {
-    "url": "https://github.com/weaviate/weaviate"
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json",
-    "application/yaml"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Cloud-native, modular vector database",
-    "title": "Weaviate",
-    "contact": {
-      "name": "Weaviate",
-      "url": "https://github.com/weaviate",
-      "email": "hello@weaviate.io"
-    },
-    "version": "1.23.0"
-  },
-  "basePath": "/v1",
-  "paths": {
-    "/": {
-      "get": {
-        "description": "Home. Discover the REST API",
-        "operationId": "weaviate.root",
-        "responses": {
-          "200": {
-            "description": "Weaviate is alive and ready to serve content",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "links": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Link"
-                  }
-                }
-              }
-            }
-          }
-        }
-      }
-    },
-    "/.well-known/live": {
-      "get": {
-        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
-        "operationId": "weaviate.wellknown.liveness",
-        "responses": {
-          "200": {
-            "description": "The application is able to respond to HTTP requests"
-          }
-        }
-      }
-    },
-    "/.well-known/openid-configuration": {
-      "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
-        "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
-        ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
-        "responses": {
-          "200": {
-            "description": "Successful response, inspect body",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
-                },
-                "scopes": {
-                  "description": "OAuth Scopes",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  },
-                  "x-omitempty": true
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "Not found, no oidc provider present"
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
-      }
-    },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "operationId": "weaviate.wellknown.readiness",
-        "responses": {
-          "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
-          },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
-          }
-        }
-      }
-    },
-    "/backups/{backend}": {
+    "/schema/{className}/properties": {
       "post": {
-        "description": "Starts a process of creating a backup for a set of classes",
         "tags": [
-          "backups"
+          "schema"
         ],
-        "operationId": "backups.create",
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
+            "name": "className",
             "in": "path",
             "required": true
           },
@@ -5076,15 +5197,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
+              "$ref": "#/definitions/Property"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup create process successfully started.",
+            "description": "Added the property.",
             "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
+              "$ref": "#/definitions/Property"
             }
           },
           "401": {
@@ -5097,7 +5218,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup creation attempt.",
+            "description": "Invalid property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5110,38 +5231,30 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/backups/{backend}/{id}": {
+    "/schema/{className}/rebalance": {
       "get": {
-        "description": "Returns status of backup creation attempt for a set of classes",
+        "description": "Returns the progress of the latest change of the replication factor of a class, which is started by updating the class. It is only known to the node which received the update.",
         "tags": [
-          "backups"
+          "schema"
         ],
-        "operationId": "backups.create.status",
+        "operationId": "schema.objects.rebalance.get",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup creation status successfully returned",
+            "description": "Progress of the rebalance",
             "schema": {
-              "$ref": "#/definitions/BackupCreateStatusResponse"
+              "$ref": "#/definitions/ClassRebalance"
             }
           },
           "401": {
@@ -5154,13 +5267,53 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "The class was not changed since this node started",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup restoration status attempt.",
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/schema/{className}/reshard": {
+      "get": {
+        "description": "Returns the progress of the latest increase of the shard count of a class, which is started by updating the class. It is only known to the node which received the update.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.objects.reshard.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Progress of the reshard",
+            "schema": {
+              "$ref": "#/definitions/ClassReshard"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class was not changed since this node started",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5171,40 +5324,34 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.backup"
-        ]
+        }
       }
     },
-    "/backups/{backend}/{id}/restore": {
+    "/schema/{className}/shards": {
       "get": {
-        "description": "Returns status of a backup restoration attempt for a set of classes",
         "tags": [
-          "backups"
+          "schema"
         ],
-        "operationId": "backups.restore.status",
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration status successfully returned",
+            "description": "Found the status of the shards, returned as body",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreStatusResponse"
+              "$ref": "#/definitions/ShardStatusList"
             }
           },
           "401": {
@@ -5217,7 +5364,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "This class does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5230,27 +5377,27 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.get.meta"
         ]
-      },
-      "post": {
-        "description": "Starts a process of restoring a backup for a set of classes",
+      }
+    },
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update shard status of an Object Class",
         "tags": [
-          "backups"
+          "schema"
         ],
-        "operationId": "backups.restore",
+        "operationId": "schema.objects.shards.update",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
+            "name": "shardName",
             "in": "path",
             "required": true
           },
@@ -5259,15 +5406,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupRestoreRequest"
+              "$ref": "#/definitions/ShardStatus"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration process successfully started.",
+            "description": "Shard status was updated successfully",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreResponse"
+              "$ref": "#/definitions/ShardStatus"
             }
           },
           "401": {
@@ -5280,13 +5427,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "Shard to be updated does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid backup restoration attempt.",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5299,74 +5446,35 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/batch/objects": {
-      "post": {
-        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
+    "/schema/{className}/tenants": {
+      "get": {
+        "description": "get all tenants from a specific class",
         "tags": [
-          "batch",
-          "objects"
+          "schema"
         ],
-        "summary": "Creates new Objects based on a Object template as a batch.",
-        "operationId": "batch.objects.create",
+        "operationId": "tenants.get",
         "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Object"
-                  }
-                }
-              }
-            }
-          },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
+            "name": "className",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "tenants from specified class.",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
+                "$ref": "#/definitions/Tenant"
               }
             }
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -5377,7 +5485,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid Tenant class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5388,54 +5496,41 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.add"
-        ]
+        }
       },
-      "delete": {
-        "description": "Delete Objects in bulk that match a certain filter.",
+      "put": {
+        "description": "Update tenant of a specific class",
         "tags": [
-          "batch",
-          "objects"
+          "schema"
         ],
-        "summary": "Deletes Objects based on a match filter as a batch.",
-        "operationId": "batch.objects.delete",
+        "operationId": "tenants.update",
         "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BatchDelete"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "$ref": "#/definitions/BatchDeleteResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Updated tenants of the specified class",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
             }
           },
           "401": {
@@ -5448,7 +5543,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid Tenant class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5459,59 +5554,43 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      }
-    },
-    "/batch/references": {
+        }
+      },
       "post": {
-        "description": "Register cross-references between any class items (objects or objects) in bulk.",
+        "description": "Create a new tenant for a specific class",
         "tags": [
-          "batch",
-          "references"
+          "schema"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
+        "operationId": "tenants.create",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/BatchReference"
+                "$ref": "#/definitions/Tenant"
               }
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "description": "Added new tenants to the specified class",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
+                "$ref": "#/definitions/Tenant"
               }
             }
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -5522,7 +5601,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid Tenant class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5533,45 +5612,36 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.add"
-        ]
-      }
-    },
-    "/classifications/": {
-      "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        }
+      },
+      "delete": {
+        "description": "delete tenants from a specific class",
         "tags": [
-          "classifications"
+          "schema"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "operationId": "tenants.delete",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "tenants",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "200": {
+            "description": "Deleted tenants from specified class."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5582,40 +5652,44 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.classifications.post"
-        ]
+        }
       }
     },
-    "/classifications/{id}": {
+    "/schema/{className}/tenants/jobs": {
       "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+        "description": "Lists the tenants jobs of a class which are known to the node serving the request.",
         "tags": [
-          "classifications"
+          "schema"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "operationId": "tenants.jobs.list",
         "parameters": [
           {
             "type": "string",
-            "description": "classification id",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "Tenants jobs of the class",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/TenantsJob"
+              }
             }
           },
           "401": {
@@ -5628,7 +5702,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Classification does not exist"
+            "description": "Class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5636,36 +5713,35 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.classifications.get"
-        ]
-      }
-    },
-    "/graphql": {
+        }
+      },
       "post": {
-        "description": "Get an object based on GraphQL",
+        "description": "Starts a job which creates, activates, deactivates or deletes many tenants of a class at once. The tenants are changed in the background in chunks, tenants which cannot be changed are reported as failed by the job.",
         "tags": [
-          "graphql"
+          "schema"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "operationId": "tenants.jobs.create",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "$ref": "#/definitions/TenantsJobRequest"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful query (with select).",
+          "202": {
+            "description": "The job was started",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/TenantsJob"
             }
           },
           "401": {
@@ -5677,8 +5753,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid tenants job",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5689,41 +5771,36 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
-        ]
+        }
       }
     },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+    "/schema/{className}/tenants/jobs/{id}": {
+      "get": {
+        "description": "Returns the progress of a tenants job. Jobs are kept in memory of the node which received the request and can be looked up for 24 hours after they finished.",
         "tags": [
-          "graphql"
+          "schema"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "operationId": "tenants.jobs.get",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the tenants job",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Progress of the tenants job",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
+              "$ref": "#/definitions/TenantsJob"
             }
           },
           "401": {
@@ -5735,8 +5812,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+          "404": {
+            "description": "Class or tenants job does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5747,30 +5824,39 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
-        ]
+        }
       }
     },
-    "/meta": {
+    "/schema/{className}/tenants/{tenantName}/export": {
       "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+        "description": "Exports all objects of a tenant including their vectors as a gzip compressed stream of JSON documents. The first document is a header containing the class, followed by one document per object. The indexes are not exported, they are rebuilt when the tenant is imported.",
+        "produces": [
+          "application/gzip",
+          "application/json"
+        ],
         "tags": [
-          "meta"
+          "schema"
+        ],
+        "operationId": "tenants.export",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "The export of the tenant",
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "type": "file"
             }
           },
           "401": {
@@ -5782,41 +5868,66 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Class or tenant does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The class is not multi-tenant",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query.meta"
-        ]
+        }
       }
     },
-    "/nodes": {
-      "get": {
-        "description": "Returns status of Weaviate DB.",
+    "/schema/{className}/tenants/{tenantName}/import": {
+      "post": {
+        "description": "Imports a tenant export into an existing tenant. The objects are imported like a batch, so their vector and inverted indexes are rebuilt.",
+        "consumes": [
+          "application/gzip"
+        ],
         "tags": [
-          "nodes"
+          "schema"
         ],
-        "operationId": "nodes.get",
+        "operationId": "tenants.import",
         "parameters": [
           {
             "type": "string",
-            "default": "minimal",
-            "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-            "name": "output",
-            "in": "query"
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "A tenant export as returned by tenants.export",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Nodes status successfully returned",
+            "description": "The tenant was imported",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/TenantImportResult"
             }
           },
           "401": {
@@ -5829,13 +5940,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "Class or tenant does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Invalid tenant export",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5846,19 +5957,16 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.nodes.status.get"
-        ]
+        }
       }
     },
-    "/nodes/{className}": {
+    "/schema/{className}/tenants/{tenantName}/stats": {
       "get": {
-        "description": "Returns status of Weaviate DB.",
+        "description": "Returns the object count and disk usage of a tenant.",
         "tags": [
-          "nodes"
+          "schema"
         ],
-        "operationId": "nodes.get.class",
+        "operationId": "tenants.stats.get",
         "parameters": [
           {
             "type": "string",
@@ -5868,17 +5976,16 @@ func init() {
           },
           {
             "type": "string",
-            "default": "minimal",
-            "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-            "name": "output",
-            "in": "query"
+            "name": "tenantName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Nodes status successfully returned",
+            "description": "Statistics of the tenant",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/TenantStats"
             }
           },
           "401": {
@@ -5891,101 +5998,32 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "Class or tenant does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup restoration status attempt.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.nodes.status.get.class"
-        ]
+        }
       }
     },
-    "/objects": {
+    "/slow-queries": {
       "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+        "description": "Returns the slow queries kept by the log of the node serving the request, latest first.",
         "tags": [
-          "objects"
-        ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The starting ID of the result window.",
-            "name": "after",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "default": 0,
-            "description": "The starting index of the result window. Default value is 0.",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Sort parameter to pass an information about the names of the sort fields",
-            "name": "sort",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Order parameter to tell how to order (asc or desc) data within given field",
-            "name": "order",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Class parameter specifies the class from which to query objects",
-            "name": "class",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
-          }
+          "debug"
         ],
+        "operationId": "slow.queries.get",
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The slow queries",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/SlowQueriesResponse"
             }
           },
           "401": {
@@ -5997,11 +6035,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The slow query log is not enabled",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6012,48 +6047,17 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query"
-        ]
+        }
       },
-      "post": {
-        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
+      "delete": {
+        "description": "Clears the slow query log of the node serving the request.",
         "tags": [
-          "objects"
-        ],
-        "summary": "Create Objects between two Objects (object and subject).",
-        "operationId": "objects.create",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          }
+          "debug"
         ],
+        "operationId": "slow.queries.delete",
         "responses": {
-          "200": {
-            "description": "Object created.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "The log was cleared"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6065,7 +6069,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The slow query log is not enabled",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6076,35 +6080,33 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.add"
-        ]
+        }
       }
     },
-    "/objects/validate": {
+    "/sql": {
       "post": {
-        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+        "description": "Runs a SELECT query on the objects of a class. The response contains the columns with their data types and the rows as arrays of values in the order of the columns.",
         "tags": [
-          "objects"
+          "sql"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "operationId": "sql.query",
         "parameters": [
           {
+            "description": "The query to run",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SQLQuery"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully validated."
+            "description": "The result of the query",
+            "schema": {
+              "$ref": "#/definitions/SQLResult"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6116,7 +6118,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid query",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6127,73 +6129,21 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query.meta"
-        ]
+        }
       }
     },
-    "/objects/{className}/{id}": {
+    "/startup": {
       "get": {
-        "description": "Get a single data object",
+        "description": "Returns the progress of loading the shards of the node serving the request with an estimate of the remaining time, so that a slow restart can be told apart from a hung one.",
         "tags": [
-          "objects"
-        ],
-        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
-        "operationId": "objects.class.get",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The target node which should fulfill the request",
-            "name": "node_name",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
-          }
+          "nodes"
         ],
+        "operationId": "startup.get",
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Progress of loading the shards",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/StartupProgress"
             }
           },
           "401": {
@@ -6205,11 +6155,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "The database is not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6220,55 +6167,31 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query"
-        ]
-      },
+        }
+      }
+    },
+    "/startup/priority": {
       "put": {
-        "description": "Update an individual data object based on its class and uuid.",
+        "description": "Loads the shards of the given classes before the shards of the other classes which are still pending.",
         "tags": [
-          "objects"
+          "nodes"
         ],
-        "summary": "Update a class object based on its uuid",
-        "operationId": "objects.class.put",
+        "operationId": "startup.priority.update",
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/StartupPriority"
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "The classes are loaded first",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/StartupProgress"
             }
           },
           "401": {
@@ -6280,11 +6203,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The database is not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6295,56 +6215,65 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "delete": {
-        "description": "Delete a single data object.",
+        }
+      }
+    },
+    "/templates": {
+      "get": {
+        "description": "Lists the query templates, which are GraphQL queries stored under a name.",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Delete object based on its class and UUID.",
-        "operationId": "objects.class.delete",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+        "operationId": "graphql.templates.list",
+        "responses": {
+          "200": {
+            "description": "The query templates",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/QueryTemplate"
+              }
+            }
           },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/templates/{name}": {
+      "get": {
+        "description": "Returns a query template.",
+        "tags": [
+          "graphql"
+        ],
+        "operationId": "graphql.templates.get",
+        "parameters": [
           {
             "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+            "description": "The name of the query template",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "The query template",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/QueryTemplate"
             }
           },
           "401": {
@@ -6357,10 +6286,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "The query template does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6371,52 +6297,38 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        }
       },
-      "head": {
-        "description": "Checks if a data object exists without retrieving it.",
+      "put": {
+        "description": "Creates or replaces a query template. The variables declared by its query are derived from the query.",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Checks object's existence based on its class and uuid.",
-        "operationId": "objects.class.head",
+        "operationId": "graphql.templates.put",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object",
-            "name": "id",
+            "description": "The name of the query template",
+            "name": "name",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+            "description": "The query template",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/QueryTemplate"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "The query template was stored",
+            "schema": {
+              "$ref": "#/definitions/QueryTemplate"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6427,11 +6339,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Object doesn't exist."
-          },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Invalid query template",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6442,59 +6351,80 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        }
       },
-      "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "delete": {
+        "description": "Deletes a query template.",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.class.patch",
+        "operationId": "graphql.templates.delete",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
+            "description": "The name of the query template",
+            "name": "name",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The query template was deleted"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The query template does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/templates/{name}/execute": {
+      "post": {
+        "description": "Executes a query template with the given variables, the defaults of the template are used for the variables which are not set. The response is the same as the response of /graphql.",
+        "tags": [
+          "graphql"
+        ],
+        "operationId": "graphql.templates.execute",
+        "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
+            "description": "The name of the query template",
+            "name": "name",
             "in": "path",
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "description": "The variables of the query",
             "name": "body",
             "in": "body",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/QueryTemplateExecuteRequest"
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed.",
+          "200": {
+            "description": "The result of the query",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -6507,10 +6437,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The query template does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Invalid variables, or the GraphQL API is not available",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6521,69 +6454,33 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        }
       }
     },
-    "/objects/{className}/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Update all references of a property of a data object.",
+    "/transactions": {
+      "post": {
+        "description": "Applies several object writes all-or-nothing. If an operation fails, the operations before it are rolled back. This is atomic if all operations fall on one shard and best-effort otherwise, the response tells which applied.",
         "tags": [
           "objects"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "operationId": "objects.transaction",
         "parameters": [
           {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The operations of the transaction",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/TransactionRequest"
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "The transaction was applied",
+            "schema": {
+              "$ref": "#/definitions/TransactionResult"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -6595,16 +6492,25 @@ func init() {
             "description": "Unauthorized or invalid credentials."
           },
           "403": {
-            "description": "Forbidden",
+            "description": "Forbidden, or a quota is exceeded",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
+            "description": "An object of an operation does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid operations",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "The rate limit of the tenant is exceeded",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6615,164 +6521,53 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "post": {
-        "description": "Add a single reference to a class-property.",
+        }
+      }
+    },
+    "/usage": {
+      "get": {
+        "description": "Returns the calls and tokens of the modules of the node serving the request since its start, for the chargeback of the costs of the providers. All filters are optional.",
         "tags": [
-          "objects"
+          "modules"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "operationId": "modules.usage.get",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
+            "description": "Only report the calls of this module",
+            "name": "module",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
+            "description": "Only report the calls for this class",
+            "name": "class",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "description": "Only report the calls for this tenant",
             "name": "tenant",
             "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Successfully added the reference."
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Source object doesn't exist."
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
           },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
+            "description": "Only report the calls of this user",
+            "name": "user",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
+            "description": "Only report the calls with this API key",
+            "name": "apiKeyId",
             "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "The usage of the modules",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ModuleUsageReport"
             }
           },
           "401": {
@@ -6784,1660 +6579,13569 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "APIKey": {
+      "description": "The metadata of a managed API key. The key itself is never returned, except when it is created.",
+      "type": "object",
+      "properties": {
+        "createdAt": {
+          "description": "When the key was created",
+          "type": "string",
+          "format": "date-time"
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        "expiresAt": {
+          "description": "When the key expires, it does not expire if not set",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "The id of the key",
+          "type": "string"
+        },
+        "scopes": {
+          "$ref": "#/definitions/APIKeyScopes"
+        },
+        "user": {
+          "description": "The user the key belongs to",
+          "type": "string"
+        }
       }
     },
-    "/objects/{id}": {
-      "get": {
-        "description": "Lists Objects.",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+    "APIKeyCreateRequest": {
+      "description": "A new managed API key",
+      "type": "object",
+      "required": [
+        "user"
+      ],
+      "properties": {
+        "expiresAt": {
+          "description": "When the key expires, must be in the future. The key does not expire if not set.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "scopes": {
+          "$ref": "#/definitions/APIKeyScopes"
+        },
+        "user": {
+          "description": "The user the key belongs to",
+          "type": "string"
+        }
+      }
+    },
+    "APIKeyCreated": {
+      "description": "A created API key, the response to creating a key is the only one which contains the key itself",
+      "allOf": [
+        {
+          "$ref": "#/definitions/APIKey"
+        },
+        {
+          "type": "object",
+          "properties": {
+            "apiKey": {
+              "description": "The key, it can not be recovered later",
+              "type": "string",
+              "x-go-name": "Key"
             }
           }
+        }
+      ]
+    },
+    "APIKeyScopes": {
+      "description": "Limits what a key can be used for, on top of the permissions of the user the key belongs to. Empty lists are not restricted. Classes and tenants are patterns following the syntax of path.Match.",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "The patterns of the classes the key can access",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query"
-        ]
-      },
-      "put": {
-        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Successfully received.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        "tenants": {
+          "description": "The patterns of the tenants the key can access",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "delete": {
-        "description": "Deletes an Object from the system.",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+        "verbs": {
+          "description": "The verbs the key is allowed, possible values are get, list, head, validate, create, update and delete",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
-        ],
-        "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        }
+      }
+    },
+    "AdditionalProperties": {
+      "description": "Additional Meta information about a single object object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "AskAnswer": {
+      "description": "The answer to a question with its sources",
+      "type": "object",
+      "properties": {
+        "answer": {
+          "description": "The generated answer",
+          "type": "string"
+        },
+        "citations": {
+          "description": "The citations of the sources in the answer",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AskCitation"
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "head": {
-        "description": "Checks if an Object exists in the system.",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
+        "sources": {
+          "description": "The objects the answer was generated from",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AskSource"
           }
-        ],
-        "responses": {
-          "204": {
-            "description": "Object exists."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Object doesn't exist."
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        },
+        "timings": {
+          "$ref": "#/definitions/AskTimings"
+        }
+      }
+    },
+    "AskCitation": {
+      "description": "A citation of a source in the answer",
+      "type": "object",
+      "properties": {
+        "end": {
+          "description": "The byte offset of the end of the citation marker in the answer",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "id": {
+          "description": "The id of the cited object",
+          "type": "string",
+          "format": "uuid"
+        },
+        "source": {
+          "description": "The position of the source in the list of sources, starting at 1",
+          "type": "integer",
+          "format": "int64"
+        },
+        "start": {
+          "description": "The byte offset of the start of the citation marker, e.g. \"[2]\", in the answer",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "AskRequest": {
+      "description": "A question which is answered from the objects of a class",
+      "type": "object",
+      "required": [
+        "class",
+        "question"
+      ],
+      "properties": {
+        "alpha": {
+          "description": "Weighs the vector search against the keyword search, the default of hybrid searches if not set",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "class": {
+          "description": "The class the answer is generated from",
+          "type": "string"
+        },
+        "limit": {
+          "description": "The number of retrieved objects, 10 by default",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "description": "The text properties the answer is generated from, all text properties of the class if empty",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "patch": {
-        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+        "question": {
+          "description": "The question",
+          "type": "string"
+        },
+        "rerank": {
+          "$ref": "#/definitions/AskRerank"
+        },
+        "tenant": {
+          "description": "The tenant of the objects, required for classes with multi-tenancy enabled",
+          "type": "string"
+        }
+      }
+    },
+    "AskRerank": {
+      "description": "Reranks the retrieved objects with the reranker module of the class",
+      "type": "object",
+      "required": [
+        "property"
+      ],
+      "properties": {
+        "limit": {
+          "description": "The number of objects kept after reranking, all if zero",
+          "type": "integer",
+          "format": "int64"
+        },
+        "property": {
+          "description": "The text property which is ranked",
+          "type": "string"
+        },
+        "query": {
+          "description": "The query the objects are ranked by, the question if empty",
+          "type": "string"
+        }
+      }
+    },
+    "AskSource": {
+      "description": "An object the answer was generated from, sources are cited by their position in the list of sources, starting at 1",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class of the object",
+          "type": "string"
+        },
+        "id": {
+          "description": "The id of the object",
+          "type": "string",
+          "format": "uuid"
+        },
+        "properties": {
+          "description": "The properties of the object the answer was generated from",
+          "type": "object"
+        },
+        "rerankScore": {
+          "description": "The score of the object in the reranking, if the objects were reranked",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "score": {
+          "description": "The score of the object in the hybrid search",
+          "type": "number",
+          "format": "float",
+          "x-omitempty": false
+        }
+      }
+    },
+    "AskTimings": {
+      "description": "How long the stages of the answer took",
+      "type": "object",
+      "properties": {
+        "generateMs": {
+          "description": "The generation of the answer",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "rerankMs": {
+          "description": "The reranking of the objects, zero if they were not reranked",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "retrieveMs": {
+          "description": "The retrieval of the objects",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "totalMs": {
+          "description": "All stages",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        }
+      }
+    },
+    "AsyncReplicationJob": {
+      "description": "The latest repair of a shard",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class of the shard",
+          "type": "string"
+        },
+        "error": {
+          "description": "Why the repair failed",
+          "type": "string"
+        },
+        "finishedAt": {
+          "description": "When the repair finished",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "progress": {
+          "$ref": "#/definitions/ReplicaSyncStats"
+        },
+        "shard": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "When the repair started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "Status of the repair",
+          "type": "string"
+        },
+        "trigger": {
+          "description": "What started the repair",
+          "type": "string"
+        }
+      }
+    },
+    "AsyncReplicationRepairRequest": {
+      "description": "The shards to repair",
+      "type": "object",
+      "required": [
+        "class"
+      ],
+      "properties": {
+        "class": {
+          "description": "The class to repair",
+          "type": "string"
+        },
+        "shard": {
+          "description": "The shard to repair, all shards of the class led by this node if not set",
+          "type": "string"
+        }
+      }
+    },
+    "AsyncReplicationStatus": {
+      "description": "Anti-entropy configuration and repairs of a node",
+      "type": "object",
+      "properties": {
+        "bandwidthLimit": {
+          "description": "Bytes per second repairs may use, 0 means no limit",
+          "type": "integer",
+          "format": "int64"
+        },
+        "conflictResolution": {
+          "description": "How conflicting objects are resolved",
+          "type": "string"
+        },
+        "enabled": {
+          "description": "Whether shards are repaired periodically",
+          "type": "boolean"
+        },
+        "hashTreeDepth": {
+          "description": "Depth of the hash trees compared",
+          "type": "integer",
+          "format": "int64"
+        },
+        "interval": {
+          "description": "How often shards are repaired",
+          "type": "string"
+        },
+        "jobs": {
+          "description": "The latest repair of each shard",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AsyncReplicationJob"
+          }
+        }
+      }
+    },
+    "BM25Config": {
+      "description": "tuning parameters for the BM25 algorithm",
+      "type": "object",
+      "properties": {
+        "b": {
+          "description": "calibrates term-weight scaling based on the document length",
+          "type": "number",
+          "format": "float"
+        },
+        "k1": {
+          "description": "calibrates term-weight scaling based on the term frequency within a document",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes",
+      "properties": {
+        "config": {
+          "description": "Custom configuration for the backup creation process",
+          "type": "object"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup creation process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of classes to include in the backup creation process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "BackupCreateResponse": {
+      "description": "The definition of a backup create response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup creation process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupCreateStatusResponse": {
+      "description": "The definition of a backup create metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupRestoreRequest": {
+      "description": "Request body for restoring a backup for a set of classes",
+      "properties": {
+        "classMapping": {
+          "description": "Restores classes under a different name. The key is the name of the class in the backup, the value the name it is restored as.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "config": {
+          "description": "Custom configuration for the backup restoration process",
+          "type": "object"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "include": {
+          "description": "List of classes to include in the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "node_mapping": {
+          "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "pointInTime": {
+          "description": "Restores the state at this time (RFC3339) by replaying the archived changes made after the backup. Requires the WAL archive to be enabled.",
+          "type": "string"
+        },
+        "tenants": {
+          "description": "Restores only these tenants of multi-tenant classes. The key is the name of the class in the backup. If the class already exists, the tenants are added to it.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "BackupRestoreResponse": {
+      "description": "The definition of a backup restore response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup restoration process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupRestoreStatusResponse": {
+      "description": "The definition of a backup restore metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backup backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BandwidthLimit": {
+      "description": "Limits the bandwidth of a background process",
+      "type": "object",
+      "required": [
+        "bytesPerSecond"
+      ],
+      "properties": {
+        "bytesPerSecond": {
+          "description": "Bytes per second the process may use, 0 means no limit",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BatchDelete": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        }
+      }
+    },
+    "BatchDeleteResponse": {
+      "description": "Delete Objects response.",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "description": "Results for this specific Object.",
+                "format": "object",
+                "properties": {
+                  "errors": {
+                    "$ref": "#/definitions/ErrorResponse"
+                  },
+                  "id": {
+                    "description": "ID of the Object.",
+                    "type": "string",
+                    "format": "uuid"
+                  },
+                  "status": {
+                    "type": "string",
+                    "default": "SUCCESS",
+                    "enum": [
+                      "SUCCESS",
+                      "DRYRUN",
+                      "FAILED"
+                    ]
+                  }
+                }
+              }
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
+      }
+    },
+    "BatchReference": {
+      "properties": {
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        },
+        "tenant": {
+          "description": "Name of the reference tenant.",
+          "type": "string"
+        },
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        }
+      }
+    },
+    "BatchReferenceResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific reference.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "BatchStats": {
+      "description": "The summary of a nodes batch queue congestion status.",
+      "properties": {
+        "queueLength": {
+          "description": "How many objects are currently in the batch queue.",
+          "type": "number",
+          "format": "int",
+          "x-nullable": true,
+          "x-omitempty": true
+        },
+        "ratePerSecond": {
+          "description": "How many objects are approximately processed from the batch queue per second.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
+        }
+      }
+    },
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+      "properties": {
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
+        },
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
+          "type": "string"
+        },
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "distance": {
+            "type": "number",
+            "format": "float"
+          },
+          "word": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "C11yVector": {
+      "description": "A Vector in the Contextionary",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classProps": {
+            "description": "Vectorized properties.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "object",
+              "properties": {
+                "propsVectors": {
+                  "type": "array",
+                  "items": {
+                    "type": "number",
+                    "format": "float"
+                  }
+                },
+                "value": {
+                  "description": "String with valuename.",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "classVectors": {
+            "description": "Vectorized classname.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          }
+        }
+      }
+    },
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
+      "properties": {
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
+          "type": "object",
+          "properties": {
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
+            },
+            "concatenatedWord": {
+              "type": "string"
+            },
+            "singleWords": {
+              "type": "array",
+              "items": {
+                "format": "string"
+              }
+            }
+          }
+        },
+        "individualWords": {
+          "description": "Weighted results for per individual word",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "info": {
+                "type": "object",
+                "properties": {
+                  "nearestNeighbors": {
+                    "$ref": "#/definitions/C11yNearestNeighbors"
+                  },
+                  "vector": {
+                    "$ref": "#/definitions/C11yVector"
+                  }
+                }
+              },
+              "present": {
+                "type": "boolean"
+              },
+              "word": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "ChunkingConfig": {
+      "description": "Configuration to split a long text property of the objects of a class into chunks. Every chunk is stored as an object of the target class with a reference to its parent object.",
+      "properties": {
+        "chunkOverlap": {
+          "description": "Number of tokens shared by two consecutive chunks. Only used by the 'token' splitter. Defaults to 0.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "chunkSize": {
+          "description": "Maximum number of whitespace separated tokens per chunk. Defaults to 200.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "property": {
+          "description": "Name of the text property that is split into chunks.",
+          "type": "string"
+        },
+        "referenceProperty": {
+          "description": "Name of the reference property of the target class that points to the parent object. Defaults to 'parent'.",
+          "type": "string"
+        },
+        "splitter": {
+          "description": "How the text is split, one of 'sentence', 'token' or 'recursive'. Defaults to 'recursive'.",
+          "type": "string"
+        },
+        "targetClass": {
+          "description": "Name of the class the chunks are stored in. It needs a text property with the same name as the chunked property, an int property 'chunkIndex' and the reference property.",
+          "type": "string"
+        }
+      }
+    },
+    "Class": {
+      "type": "object",
+      "properties": {
+        "chunkingConfig": {
+          "$ref": "#/definitions/ChunkingConfig"
+        },
+        "class": {
+          "description": "Name of the class as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "compactionConfig": {
+          "$ref": "#/definitions/CompactionConfig"
+        },
+        "description": {
+          "description": "Description of the class.",
+          "type": "string"
+        },
+        "invertedIndexConfig": {
+          "$ref": "#/definitions/InvertedIndexConfig"
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "multiTenancyConfig": {
+          "$ref": "#/definitions/MultiTenancyConfig"
+        },
+        "properties": {
+          "description": "The properties of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Property"
+          }
+        },
+        "queryConfig": {
+          "$ref": "#/definitions/QueryConfig"
+        },
+        "replicationConfig": {
+          "$ref": "#/definitions/ReplicationConfig"
+        },
+        "segmentCompressionConfig": {
+          "$ref": "#/definitions/SegmentCompressionConfig"
+        },
+        "shardingConfig": {
+          "description": "Manage how the index should be sharded and distributed in the cluster",
+          "type": "object"
+        },
+        "temporalPartitioningConfig": {
+          "$ref": "#/definitions/TemporalPartitioningConfig"
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
+          "type": "string"
+        },
+        "versioningConfig": {
+          "$ref": "#/definitions/VersioningConfig"
+        }
+      }
+    },
+    "ClassRebalance": {
+      "description": "Change of the replication factor of a class. The shard replicas are copied to new nodes in the background, the new factor takes effect once all of them have been copied.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class which is changed",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "When the rebalance completed",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "error": {
+          "description": "Why the rebalance failed",
+          "type": "string"
+        },
+        "fromFactor": {
+          "description": "The previous replication factor",
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicasDone": {
+          "description": "Number of shard replicas copied or removed",
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicasTotal": {
+          "description": "Number of shard replicas to copy or to remove",
+          "type": "integer",
+          "format": "int64"
+        },
+        "startedAt": {
+          "description": "When the rebalance started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "Status of the rebalance",
+          "type": "string"
+        },
+        "toFactor": {
+          "description": "The new replication factor",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ClassReshard": {
+      "description": "Increase of the shard count of a class. The existing shards hand over objects to the new shards in the background.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class which is changed",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "When the reshard completed",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "error": {
+          "description": "Why the reshard failed",
+          "type": "string"
+        },
+        "fromCount": {
+          "description": "The previous shard count",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsMoved": {
+          "description": "Number of objects moved to the new shards",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shardsDone": {
+          "description": "Number of shards which handed over their objects",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shardsTotal": {
+          "description": "Number of shards which hand over objects",
+          "type": "integer",
+          "format": "int64"
+        },
+        "startedAt": {
+          "description": "When the reshard started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "Status of the reshard",
+          "type": "string"
+        },
+        "toCount": {
+          "description": "The new shard count",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
+      "type": "object",
+      "properties": {
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "description"
+          ]
+        },
+        "class": {
+          "description": "class (name) which is used in this classification",
+          "type": "string",
+          "example": "City"
+        },
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCountry"
+          ]
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "classify xzy: something went wrong"
+        },
+        "filters": {
+          "type": "object",
+          "properties": {
+            "sourceWhere": {
+              "description": "limit the objects to be classified",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "targetWhere": {
+              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "trainingSetWhere": {
+              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "settings": {
+          "description": "classification-type specific settings",
+          "type": "object"
+        },
+        "status": {
+          "description": "status of this classification",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "description": "which algorithm to use for classifications",
+          "type": "string"
+        }
+      }
+    },
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "CompactionConfig": {
+      "description": "Configuration of the compaction of the segments the objects and indexes of a class are stored in. Can be changed at any time, changes apply from the next compaction on.",
+      "properties": {
+        "maxBytesPerSecond": {
+          "description": "Maximum rate at which compactions of a shard write to disk, in bytes per second. 0 disables the limit.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "strategy": {
+          "description": "How segments are picked for compaction, one of 'sizeTiered' or 'leveled'. 'sizeTiered' only merges segments of a similar size, which writes less. 'leveled' also merges small segments into larger ones, which keeps fewer segments to be read. Defaults to 'sizeTiered'.",
+          "type": "string"
+        }
+      }
+    },
+    "ConfigReloadResult": {
+      "description": "The changed settings which were applied at runtime and those which only take effect after a restart of the node",
+      "type": "object",
+      "properties": {
+        "applied": {
+          "description": "Settings which were applied",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "failed": {
+          "description": "Settings which could not be applied, with the reason",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "requiresRestart": {
+          "description": "Settings which take effect after a restart",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "Deprecation": {
+      "type": "object",
+      "properties": {
+        "apiType": {
+          "description": "Describes which API is effected, usually one of: REST, GraphQL",
+          "type": "string"
+        },
+        "id": {
+          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
+          "type": "string"
+        },
+        "locations": {
+          "description": "The locations within the specified API affected by this deprecation",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mitigation": {
+          "description": "User-required object to not be affected by the (planned) removal",
+          "type": "string"
+        },
+        "msg": {
+          "description": "What this deprecation is about",
+          "type": "string"
+        },
+        "plannedRemovalVersion": {
+          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+          "type": "string"
+        },
+        "removedIn": {
+          "description": "If the feature has already been removed, it was removed in this version",
+          "type": "string",
+          "x-nullable": true
+        },
+        "removedTime": {
+          "description": "If the feature has already been removed, it was removed at this timestamp",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string",
+          "format": "date-time"
+        },
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string"
+        },
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+          "type": "string"
+        }
+      }
+    },
+    "DuplicateGroup": {
+      "description": "A group of near-duplicate objects",
+      "type": "object",
+      "properties": {
+        "duplicates": {
+          "description": "The duplicates of the kept object",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DuplicateObject"
+          }
+        },
+        "keep": {
+          "description": "The id of the oldest object of the group, which is kept",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "DuplicateObject": {
+      "description": "A duplicate of the kept object of a group",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "description": "The distance of the vectors of the duplicate and the kept object",
+          "type": "number",
+          "format": "float",
+          "x-omitempty": false
+        },
+        "id": {
+          "description": "The id of the duplicate",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "DuplicatesRequest": {
+      "description": "Selects the near-duplicate objects of a class. Two objects are duplicates if the distance of their vectors is at most distance and all properties are equal. Groups are formed transitively. Each object is compared to its neighbors nearest objects only.",
+      "type": "object",
+      "required": [
+        "class"
+      ],
+      "properties": {
+        "action": {
+          "description": "What to do with the duplicates. delete keeps the oldest object of each group, merge also sets the properties which it lacks from the other objects. Empty to only report the groups.",
+          "type": "string",
+          "enum": [
+            "delete",
+            "merge"
+          ]
+        },
+        "class": {
+          "description": "The class to search for duplicates",
+          "type": "string"
+        },
+        "distance": {
+          "description": "The maximum distance of the vectors of duplicates",
+          "type": "number",
+          "format": "float"
+        },
+        "limit": {
+          "description": "The maximum number of groups, 100 by default",
+          "type": "integer",
+          "format": "int64"
+        },
+        "neighbors": {
+          "description": "The number of nearest objects each object is compared to, 10 by default",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "description": "The properties which must be equal for duplicates",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tenant": {
+          "description": "The tenant to search, required for classes with multi-tenancy enabled",
+          "type": "string"
+        }
+      }
+    },
+    "DuplicatesResult": {
+      "description": "The groups of near-duplicate objects of a class",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The searched class",
+          "type": "string"
+        },
+        "deleted": {
+          "description": "The number of objects which were deleted",
+          "type": "integer",
+          "format": "int64"
+        },
+        "errors": {
+          "description": "The errors of objects which could not be deleted or merged",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "groups": {
+          "description": "The groups of duplicates",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DuplicateGroup"
+          }
+        },
+        "merged": {
+          "description": "The number of objects which were merged",
+          "type": "integer",
+          "format": "int64"
+        },
+        "scanned": {
+          "description": "The number of objects which were compared",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "message": {
+                "type": "string"
+              }
+            }
+          }
+        },
+        "requestId": {
+          "description": "The id of the request that caused the error. It is also returned in the X-Request-Id response header and forwarded to module providers, so it can be used to correlate a failure across systems.",
+          "type": "string"
+        }
+      }
+    },
+    "GeoCoordinates": {
+      "properties": {
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        }
+      }
+    },
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
+      "properties": {
+        "locations": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "column": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "line": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
+        },
+        "message": {
+          "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "GraphQLExplainResponse": {
+      "description": "Plans of the queries of an explained GraphQL request",
+      "type": "object",
+      "properties": {
+        "errors": {
+          "description": "Errors of the queries which could not be planned.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
+        },
+        "plans": {
+          "description": "Execution plans of the queries.",
+          "type": "array",
+          "items": {
+            "type": "object"
+          }
+        }
+      }
+    },
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+      "type": "object",
+      "properties": {
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
+          "type": "string"
+        },
+        "query": {
+          "description": "Query based on GraphQL syntax.",
+          "type": "string"
+        },
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
+        }
+      }
+    },
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+      "properties": {
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
+        },
+        "errors": {
+          "description": "Array with errors.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
+        },
+        "explain": {
+          "description": "Execution plans of the explained queries.",
+          "type": "array",
+          "items": {
+            "type": "object"
+          },
+          "x-omitempty": true
+        },
+        "facets": {
+          "description": "Value counts of the facet properties of the queries requesting them.",
+          "type": "array",
+          "items": {
+            "type": "object"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "ImportAutoSchema": {
+      "description": "Creates the class, or adds the properties of columns missing from it, from the header and the first rows of CSV files before each file is imported",
+      "type": "object",
+      "properties": {
+        "sampleRows": {
+          "description": "The rows whose values determine the data types of the columns",
+          "type": "integer",
+          "format": "int64"
+        },
+        "types": {
+          "description": "The data types of columns which are not derived, e.g. {\"zipCode\": \"text\"}",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "ImportFile": {
+      "description": "The progress of a single file of an import job",
+      "type": "object",
+      "properties": {
+        "errors": {
+          "description": "Samples of the errors of objects which could not be imported, or the error which stopped reading the file",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "failed": {
+          "description": "The number of objects which could not be imported",
+          "type": "integer",
+          "format": "int64"
+        },
+        "format": {
+          "description": "The format of the file",
+          "type": "string"
+        },
+        "imported": {
+          "description": "The number of objects which were imported",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the file",
+          "type": "string"
+        },
+        "status": {
+          "description": "The status of the file",
+          "type": "string",
+          "enum": [
+            "PENDING",
+            "STARTED",
+            "FINISHED",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "ImportJob": {
+      "description": "The progress of an import job, whose files are imported in the background one after another",
+      "type": "object",
+      "properties": {
+        "autoSchema": {
+          "$ref": "#/definitions/ImportAutoSchema"
+        },
+        "backend": {
+          "description": "The backup backend the files are read from, empty for uploaded files",
+          "type": "string"
+        },
+        "class": {
+          "description": "The class the files are imported into",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "When the job completed, was canceled or failed",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "error": {
+          "description": "The error which stopped the job",
+          "type": "string"
+        },
+        "files": {
+          "description": "The progress of each file",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ImportFile"
+          }
+        },
+        "id": {
+          "description": "The id of the job",
+          "type": "string"
+        },
+        "path": {
+          "description": "The path of the files within the backend",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "When the job was started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "The status of the job",
+          "type": "string",
+          "enum": [
+            "PENDING",
+            "STARTED",
+            "FINISHED",
+            "FAILED",
+            "CANCELED"
+          ]
+        },
+        "tenant": {
+          "description": "The tenant the files are imported into",
+          "type": "string"
+        }
+      }
+    },
+    "ImportJobRequest": {
+      "description": "Imports files from a backup backend into a class. The files are looked up by the backend like the files of a backup whose id is the path, e.g. below s3://\u003cbucket\u003e/\u003cpath\u003e/ for the S3 backend.",
+      "type": "object",
+      "required": [
+        "backend",
+        "class",
+        "files"
+      ],
+      "properties": {
+        "autoSchema": {
+          "$ref": "#/definitions/ImportAutoSchema"
+        },
+        "backend": {
+          "description": "The backup backend to read the files from, e.g. s3",
+          "type": "string"
+        },
+        "batchSize": {
+          "description": "The number of objects imported per batch",
+          "type": "integer",
+          "format": "int64"
+        },
+        "class": {
+          "description": "The class to import into",
+          "type": "string"
+        },
+        "files": {
+          "description": "The files to import",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "format": {
+          "description": "The format of all files, derived from their extensions if empty",
+          "type": "string",
+          "enum": [
+            "jsonl",
+            "parquet",
+            "csv"
+          ]
+        },
+        "path": {
+          "description": "The path of the files within the backend",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "The tenant to import into, required for classes with multi-tenancy enabled",
+          "type": "string"
+        }
+      }
+    },
+    "IngestQueueStatus": {
+      "description": "The batches accepted into and applied from the ingest queue of a node",
+      "type": "object",
+      "properties": {
+        "acceptedSeq": {
+          "description": "The sequence number of the last accepted batch",
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false
+        },
+        "appliedObjects": {
+          "description": "The number of objects which were applied",
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false
+        },
+        "appliedSeq": {
+          "description": "The sequence number of the last applied batch",
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false
+        },
+        "failedObjects": {
+          "description": "The number of objects which could not be applied",
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false
+        },
+        "lagSeconds": {
+          "description": "How long the oldest pending batch is waiting",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "lastError": {
+          "description": "The last error applying a batch",
+          "type": "string"
+        },
+        "oldestPending": {
+          "description": "When the oldest pending batch was accepted",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "pendingBatches": {
+          "description": "The number of batches which are not applied yet",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "pendingObjects": {
+          "description": "The number of objects which are not applied yet",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "InvertedIndexConfig": {
+      "description": "Configure the inverted index built into Weaviate",
+      "type": "object",
+      "properties": {
+        "bm25": {
+          "$ref": "#/definitions/BM25Config"
+        },
+        "cleanupIntervalSeconds": {
+          "description": "Asynchronous index clean up happens every n seconds",
+          "type": "number",
+          "format": "int"
+        },
+        "indexNullState": {
+          "description": "Index each object with the null state",
+          "type": "boolean"
+        },
+        "indexPropertyLength": {
+          "description": "Index length of properties",
+          "type": "boolean"
+        },
+        "indexTimestamps": {
+          "description": "Index each object by its internal timestamps",
+          "type": "boolean"
+        },
+        "stopwords": {
+          "$ref": "#/definitions/StopwordConfig"
+        }
+      }
+    },
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Link": {
+      "type": "object",
+      "properties": {
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
+          "type": "string"
+        },
+        "href": {
+          "description": "target of the link",
+          "type": "string"
+        },
+        "name": {
+          "description": "human readable name of the resource group",
+          "type": "string"
+        },
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+          "type": "string"
+        }
+      }
+    },
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
+      "type": "object",
+      "properties": {
+        "hostname": {
+          "description": "The url of the host.",
+          "type": "string",
+          "format": "url"
+        },
+        "modules": {
+          "description": "Module-specific meta information",
+          "type": "object"
+        },
+        "version": {
+          "description": "Version of weaviate you are currently running",
+          "type": "string"
+        }
+      }
+    },
+    "ModuleCredentialsListing": {
+      "description": "The names of the stored module credentials without their values",
+      "type": "object",
+      "properties": {
+        "global": {
+          "description": "The credentials for all tenants",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tenants": {
+          "description": "The credentials of single tenants by tenant",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "ModuleUsageReport": {
+      "description": "The calls and tokens of the modules of a node since its start",
+      "type": "object",
+      "properties": {
+        "calls": {
+          "description": "The number of module calls of all totals",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "inputTokens": {
+          "description": "The input tokens of the module calls of all totals",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "outputTokens": {
+          "description": "The output tokens of the module calls of all totals",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "since": {
+          "description": "When the node started counting",
+          "type": "string",
+          "format": "date-time"
+        },
+        "totals": {
+          "description": "The totals per module, class, tenant, user and API key",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ModuleUsageTotals"
+          }
+        }
+      }
+    },
+    "ModuleUsageTotals": {
+      "description": "The calls and tokens of one module, class, tenant, user and API key",
+      "type": "object",
+      "properties": {
+        "apiKeyId": {
+          "description": "The id of the API key the module was called with",
+          "type": "string"
+        },
+        "calls": {
+          "description": "The number of module calls",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "class": {
+          "description": "The class the module was called for",
+          "type": "string"
+        },
+        "inputTokens": {
+          "description": "The input tokens of the module calls",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "module": {
+          "description": "The called module",
+          "type": "string"
+        },
+        "outputTokens": {
+          "description": "The output tokens of the module calls",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "tenant": {
+          "description": "The tenant the module was called for",
+          "type": "string"
+        },
+        "user": {
+          "description": "The user who called the module",
+          "type": "string"
+        }
+      }
+    },
+    "MultiTenancyConfig": {
+      "description": "Configuration related to multi-tenancy within a class",
+      "properties": {
+        "enabled": {
+          "description": "Whether or not multi-tenancy is enabled for this class",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
+      }
+    },
+    "NestedProperty": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "indexFilterable": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "nestedProperties": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field"
+          ]
+        }
+      }
+    },
+    "NodeDrain": {
+      "description": "Drain of a node. Draining nodes are not considered for new shards, their replicas are moved to the other nodes.",
+      "type": "object",
+      "properties": {
+        "draining": {
+          "description": "Whether the node is drained",
+          "type": "boolean"
+        },
+        "error": {
+          "description": "Why the drain failed",
+          "type": "string"
+        },
+        "finishedAt": {
+          "description": "When the drain finished",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "node": {
+          "description": "Name of the node",
+          "type": "string"
+        },
+        "replicas": {
+          "description": "Replicas left on the node as ` + "`" + `class/shard` + "`" + `. Inactive tenants are not moved, they need to be activated first.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "safe": {
+          "description": "Whether the node can be removed safely",
+          "type": "boolean"
+        },
+        "startedAt": {
+          "description": "When the drain started",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "status": {
+          "description": "Status of the moves started by this node, empty if there are none",
+          "type": "string"
+        }
+      }
+    },
+    "NodeShardStatus": {
+      "description": "The definition of a node shard status response body",
+      "properties": {
+        "class": {
+          "description": "The name of shard's class.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "compressed": {
+          "description": "The status of vector compression/quantization.",
+          "format": "boolean",
+          "x-omitempty": false
+        },
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "objectCount": {
+          "description": "The number of objects in shard.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorIndexingStatus": {
+          "description": "The status of the vector indexing process.",
+          "format": "string",
+          "x-omitempty": false
+        },
+        "vectorQueueLength": {
+          "description": "The length of the vector indexing queue.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "NodeStats": {
+      "description": "The summary of Weaviate's statistics.",
+      "properties": {
+        "objectCount": {
+          "description": "The total number of objects in DB.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shardCount": {
+          "description": "The count of Weaviate's shards.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
+        }
+      }
+    },
+    "NodeStatus": {
+      "description": "The definition of a backup node status response body",
+      "properties": {
+        "batchStats": {
+          "description": "Weaviate batch statistics.",
+          "type": "object",
+          "$ref": "#/definitions/BatchStats"
+        },
+        "gitHash": {
+          "description": "The gitHash of Weaviate.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the node.",
+          "type": "string"
+        },
+        "shards": {
+          "description": "The list of the shards with it's statistics.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeShardStatus"
+          }
+        },
+        "stats": {
+          "description": "Weaviate overall statistics.",
+          "type": "object",
+          "$ref": "#/definitions/NodeStats"
+        },
+        "status": {
+          "description": "Node's status.",
+          "type": "string",
+          "default": "HEALTHY",
+          "enum": [
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE"
+          ]
+        },
+        "version": {
+          "description": "The version of Weaviate.",
+          "type": "string"
+        }
+      }
+    },
+    "NodesStatusResponse": {
+      "description": "The status of all of the Weaviate nodes",
+      "type": "object",
+      "properties": {
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeStatus"
+          }
+        }
+      }
+    },
+    "Object": {
+      "type": "object",
+      "properties": {
+        "additional": {
+          "$ref": "#/definitions/AdditionalProperties"
+        },
+        "class": {
+          "description": "Class of the Object, defined in the schema.",
+          "type": "string"
+        },
+        "creationTimeUnix": {
+          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "lastUpdateTimeUnix": {
+          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "tenant": {
+          "description": "Name of the Objects tenant.",
+          "type": "string"
+        },
+        "vector": {
+          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
+          "$ref": "#/definitions/C11yVector"
+        },
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
+        }
+      }
+    },
+    "ObjectPropertyChange": {
+      "description": "Change of a single property, a missing value means the property was not set in the version",
+      "type": "object",
+      "properties": {
+        "from": {
+          "description": "Value of the property in the version compared"
+        },
+        "property": {
+          "description": "Name of the property",
+          "type": "string"
+        },
+        "to": {
+          "description": "Value of the property in the version compared with"
+        }
+      }
+    },
+    "ObjectVersion": {
+      "description": "A kept version of an object",
+      "type": "object",
+      "properties": {
+        "deleted": {
+          "description": "Whether the object was deleted in this version",
+          "type": "boolean"
+        },
+        "object": {
+          "description": "The object as of this version, unless it was deleted",
+          "$ref": "#/definitions/Object"
+        },
+        "version": {
+          "description": "The version number, increasing with every change of the object",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ObjectVersionDiff": {
+      "description": "The properties which differ between two versions of an object. A deleted version has no properties.",
+      "type": "object",
+      "properties": {
+        "changes": {
+          "description": "The properties which differ",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ObjectPropertyChange"
+          }
+        },
+        "from": {
+          "description": "The version compared",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "ID of the object",
+          "type": "string",
+          "format": "uuid"
+        },
+        "to": {
+          "description": "The version compared with",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorChanged": {
+          "description": "Whether the vectors of the versions differ",
+          "type": "boolean"
+        }
+      }
+    },
+    "ObjectsGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Object"
+        },
+        {
+          "properties": {
+            "deprecations": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Deprecation"
+              }
+            }
+          }
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Object.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "ObjectsListResponse": {
+      "description": "List of Objects.",
+      "type": "object",
+      "properties": {
+        "deprecations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Deprecation"
+          }
+        },
+        "objects": {
+          "description": "The actual list of Objects.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Object"
+          }
+        },
+        "totalResults": {
+          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ObjectsUploadResponse": {
+      "description": "The objects created from uploaded files",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class of the objects",
+          "type": "string"
+        },
+        "objects": {
+          "description": "The object created from each file, in the order of the files",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UploadedObject"
+          }
+        }
+      }
+    },
+    "PatchDocumentAction": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PatchDocumentObject": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PeerUpdate": {
+      "description": "A single peer in the network.",
+      "properties": {
+        "id": {
+          "description": "The session ID of the peer.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Human readable name.",
+          "type": "string"
+        },
+        "schemaHash": {
+          "description": "The latest known hash of the peer's schema.",
+          "type": "string"
+        },
+        "uri": {
+          "description": "The location where the peer is exposed to the internet.",
+          "type": "string",
+          "format": "uri"
+        }
+      }
+    },
+    "PeerUpdateList": {
+      "description": "List of known peers.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/PeerUpdate"
+      }
+    },
+    "Permission": {
+      "description": "Grants an action on all collections and tenants matching the patterns. Patterns follow the syntax of path.Match, e.g. \"*\" or \"Article*\", an empty pattern is the same as \"*\".",
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "description": "read allows reading objects and the schema of a collection, write allows creating and updating objects and their references, schema allows changing the definition of a collection and managing its tenants, delete allows deleting objects",
+          "type": "string",
+          "enum": [
+            "read",
+            "write",
+            "schema",
+            "delete"
+          ]
+        },
+        "collection": {
+          "description": "The pattern of the collections",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "The pattern of the tenants",
+          "type": "string"
+        }
+      }
+    },
+    "PhoneNumber": {
+      "properties": {
+        "countryCode": {
+          "description": "Read-only. The numerical country code (e.g. 49)",
+          "type": "number",
+          "format": "uint64"
+        },
+        "defaultCountry": {
+          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
+          "type": "string"
+        },
+        "input": {
+          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
+          "type": "string"
+        },
+        "internationalFormatted": {
+          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
+          "type": "string"
+        },
+        "national": {
+          "description": "Read-only. The numerical representation of the national part",
+          "type": "number",
+          "format": "uint64"
+        },
+        "nationalFormatted": {
+          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
+          "type": "string"
+        },
+        "valid": {
+          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+          "type": "boolean"
+        }
+      }
+    },
+    "Principal": {
+      "type": "object",
+      "properties": {
+        "apiKeyId": {
+          "description": "The id of the managed API key the principal authenticated with, if any",
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "roles": {
+          "description": "Roles assigned to the principal by the authentication provider, e.g. through OIDC claims",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tenant": {
+          "description": "The tenant all requests of the principal are restricted to, if any",
+          "type": "string"
+        },
+        "username": {
+          "description": "The username that was extracted either from the authentication information",
+          "type": "string"
+        }
+      }
+    },
+    "Property": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the property.",
+          "type": "string"
+        },
+        "encrypted": {
+          "description": "Optional. Should the values of this property be encrypted at rest. Defaults to false. Applicable only to properties of data type text and text[], requires property encryption to be configured on the server. Encrypted properties can not be indexed in the inverted index.",
+          "type": "boolean"
+        },
+        "indexFilterable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexInverted": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "name": {
+          "description": "Name of the property as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "sensitive": {
+          "description": "Optional. Marks the property as containing sensitive data, e.g. personally identifiable information. Values of sensitive properties are redacted from responses unless the requesting user holds the sensitive data reader role. Defaults to false.",
+          "type": "boolean"
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field"
+          ]
+        }
+      }
+    },
+    "PropertySchema": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "QueryConfig": {
+      "description": "Configuration related to the queries of a class",
+      "properties": {
+        "timeoutMilliseconds": {
+          "description": "Maximum duration of queries on this class in milliseconds. Queries which take longer fail, unless they accept partial results. 0 means no limit.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "QueryTemplate": {
+      "description": "A GraphQL query with variables stored under a name. Defaults are used for the variables which are not set when the template is executed.",
+      "type": "object",
+      "required": [
+        "query"
+      ],
+      "properties": {
+        "createdAt": {
+          "description": "When the template was created",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "defaults": {
+          "description": "The values of the variables which are not set when the template is executed",
+          "type": "object"
+        },
+        "description": {
+          "description": "The description of the template",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the template, must start with a letter and contain letters, digits, '_' and '-' only. Set from the path if empty.",
+          "type": "string"
+        },
+        "query": {
+          "description": "The GraphQL query",
+          "type": "string"
+        },
+        "updatedAt": {
+          "description": "When the template was last changed",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "variables": {
+          "description": "The variables declared by the query",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "readOnly": true
+        }
+      }
+    },
+    "QueryTemplateExecuteRequest": {
+      "description": "The variables a query template is executed with",
+      "type": "object",
+      "properties": {
+        "variables": {
+          "description": "The values of the variables of the query",
+          "type": "object"
+        }
+      }
+    },
+    "RecallPoint": {
+      "description": "The share of the true nearest neighbors which were found with an ef setting, and the latencies of the queries",
+      "type": "object",
+      "properties": {
+        "ef": {
+          "description": "The ef setting",
+          "type": "integer",
+          "format": "int64"
+        },
+        "meanLatencyMs": {
+          "description": "The mean latency of the queries",
+          "type": "number",
+          "format": "double"
+        },
+        "p50LatencyMs": {
+          "description": "The median latency of the queries",
+          "type": "number",
+          "format": "double"
+        },
+        "p99LatencyMs": {
+          "description": "The 99th percentile of the latency of the queries",
+          "type": "number",
+          "format": "double"
+        },
+        "recall": {
+          "description": "The share of the true nearest neighbors which were found",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        }
+      }
+    },
+    "RecallReport": {
+      "description": "The recall and latency of the vector indexes of a class for each evaluated ef setting",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The evaluated class",
+          "type": "string"
+        },
+        "groundTruthTookMs": {
+          "description": "How long the flat search for the ground truth took",
+          "type": "number",
+          "format": "double"
+        },
+        "limit": {
+          "description": "The number of nearest neighbors each query searched for",
+          "type": "integer",
+          "format": "int64"
+        },
+        "points": {
+          "description": "The recall and latency for each ef setting",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RecallPoint"
+          }
+        },
+        "queries": {
+          "description": "The number of queries per ef setting",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shards": {
+          "description": "The evaluated shards of the node",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "RecallRequest": {
+      "description": "The class whose vector indexes are evaluated and the ef settings to evaluate",
+      "type": "object",
+      "required": [
+        "class"
+      ],
+      "properties": {
+        "class": {
+          "description": "The class to evaluate",
+          "type": "string"
+        },
+        "ef": {
+          "description": "The ef settings to evaluate",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "limit": {
+          "description": "The number of nearest neighbors each query searches for",
+          "type": "integer",
+          "format": "int64"
+        },
+        "sampleSize": {
+          "description": "The number of queries per shard",
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenant": {
+          "description": "The tenant to evaluate, required for classes with multi-tenancy enabled",
+          "type": "string"
+        }
+      }
+    },
+    "ReferenceMetaClassification": {
+      "description": "This meta field contains additional info about the classified reference property",
+      "properties": {
+        "closestLosingDistance": {
+          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "closestOverallDistance": {
+          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
+          "type": "number",
+          "format": "float32"
+        },
+        "closestWinningDistance": {
+          "description": "Closest distance of a neighbor from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "losingCount": {
+          "description": "size of the losing group, can be 0 if the winning group size equals k",
+          "type": "number",
+          "format": "int64"
+        },
+        "losingDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanLosingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanWinningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "overallCount": {
+          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningCount": {
+          "description": "size of the winning group, a number between 1..k",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "ReplicaSyncStats": {
+      "description": "Progress of a repair",
+      "type": "object",
+      "properties": {
+        "bytes": {
+          "description": "Bytes transferred",
+          "type": "integer",
+          "format": "int64"
+        },
+        "conflicts": {
+          "description": "Number of conflicting objects",
+          "type": "integer",
+          "format": "int64"
+        },
+        "leavesCompared": {
+          "description": "Number of hash tree leaves compared",
+          "type": "integer",
+          "format": "int64"
+        },
+        "leavesDiverged": {
+          "description": "Number of hash tree leaves which differ between replicas",
+          "type": "integer",
+          "format": "int64"
+        },
+        "leavesTotal": {
+          "description": "Number of hash tree leaves to compare",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsCompared": {
+          "description": "Number of objects compared",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsRepaired": {
+          "description": "Number of objects repaired",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ReplicationConfig": {
+      "description": "Configure how replication is executed in a cluster",
+      "type": "object",
+      "properties": {
+        "factor": {
+          "description": "Number of times a class is replicated",
+          "type": "integer"
+        }
+      }
+    },
+    "Role": {
+      "description": "A role bundles permissions and the users and groups they are granted to. Users who are assigned the role by name by the authentication provider are granted the permissions as well.",
+      "type": "object",
+      "required": [
+        "permissions"
+      ],
+      "properties": {
+        "groups": {
+          "description": "The groups the role is granted to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "The name of the role, must start with a letter and contain only letters, numbers, '_' and '-' (max 64 characters)",
+          "type": "string"
+        },
+        "permissions": {
+          "description": "The permissions of the role, at least one is required",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Permission"
+          }
+        },
+        "users": {
+          "description": "The users the role is granted to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "SQLColumn": {
+      "description": "A column of the result of a SQL query",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "The name of the column",
+          "type": "string"
+        },
+        "type": {
+          "description": "The data type of the property, \"uuid\" for the id and \"number\" for the distance",
+          "type": "string"
+        }
+      }
+    },
+    "SQLQuery": {
+      "description": "A SELECT query on the objects of a class",
+      "type": "object",
+      "required": [
+        "query"
+      ],
+      "properties": {
+        "query": {
+          "description": "The query, e.g. SELECT title FROM Article WHERE wordCount \u003e 100 LIMIT 10",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "The tenant to query, required for classes with multi-tenancy enabled",
+          "type": "string"
+        }
+      }
+    },
+    "SQLResult": {
+      "description": "The result of a SQL query",
+      "type": "object",
+      "properties": {
+        "columns": {
+          "description": "The columns of the result",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SQLColumn"
+          }
+        },
+        "rows": {
+          "description": "The rows of the result as arrays of values in the order of the columns",
+          "type": "array",
+          "items": {
+            "type": "array",
+            "items": {}
+          }
+        }
+      }
+    },
+    "Schema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "Semantic classes that are available.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
+        },
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
+        },
+        "name": {
+          "description": "Name of the schema.",
+          "type": "string"
+        }
+      }
+    },
+    "SchemaClusterStatus": {
+      "description": "Indicates the health of the schema in a cluster.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "Contains the sync check error if one occurred",
+          "type": "string",
+          "x-omitempty": true
+        },
+        "healthy": {
+          "description": "True if the cluster is in sync, false if there is an issue (see error).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "hostname": {
+          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
+          "type": "string"
+        },
+        "ignoreSchemaSync": {
+          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "nodeCount": {
+          "description": "Number of nodes that participated in the sync check",
+          "type": "number",
+          "format": "int"
+        }
+      }
+    },
+    "SchemaHistory": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "SegmentCompressionConfig": {
+      "description": "Configuration of the compression of the segments the objects and indexes of a class are stored in. Cannot be changed after the class was created.",
+      "properties": {
+        "inverted": {
+          "description": "Codec the searchable inverted indexes are compressed with on disk, one of 'none', 'zstd' or 'snappy'. Filterable indexes are stored as compressed bitmaps already. Defaults to 'none'.",
+          "type": "string"
+        },
+        "objects": {
+          "description": "Codec the objects are compressed with on disk, one of 'none', 'zstd' or 'snappy'. Defaults to 'none'.",
+          "type": "string"
+        }
+      }
+    },
+    "ShardBalancerStatus": {
+      "description": "Status of the shard balancer and the moves started by this node",
+      "type": "object",
+      "properties": {
+        "bandwidthLimit": {
+          "description": "Bytes per second moves may use, 0 means no limit",
+          "type": "integer",
+          "format": "int64"
+        },
+        "enabled": {
+          "description": "Whether shards are balanced periodically",
+          "type": "boolean"
+        },
+        "interval": {
+          "description": "How often shards are balanced",
+          "type": "string"
+        },
+        "moves": {
+          "description": "Moves started by this node",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ShardMove"
+          }
+        },
+        "replicas": {
+          "description": "Active replicas per node",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "threshold": {
+          "description": "Difference of the replica counts of nodes from which on replicas are moved",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ShardMove": {
+      "description": "Move of a shard replica to another node",
+      "type": "object",
+      "properties": {
+        "bytes": {
+          "description": "Bytes copied by this node so far",
+          "type": "integer",
+          "format": "int64"
+        },
+        "class": {
+          "description": "The class of the shard",
+          "type": "string"
+        },
+        "error": {
+          "description": "Why the move failed",
+          "type": "string"
+        },
+        "finishedAt": {
+          "description": "When the move finished",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "shard": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "source": {
+          "description": "The node the replica is moved from",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "When the move started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "Status of the move",
+          "type": "string"
+        },
+        "target": {
+          "description": "The node the replica is moved to",
+          "type": "string"
+        },
+        "trigger": {
+          "description": "What started the move",
+          "type": "string"
+        }
+      }
+    },
+    "ShardStartup": {
+      "description": "Progress of loading a single shard",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class of the shard",
+          "type": "string"
+        },
+        "error": {
+          "description": "Why the shard could not be loaded",
+          "type": "string"
+        },
+        "etaSeconds": {
+          "description": "Rough estimate of the time until the shard is loaded",
+          "type": "number",
+          "format": "double"
+        },
+        "finishedAt": {
+          "description": "When the shard finished loading",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "phase": {
+          "description": "The phase of loading the shard while it is LOADING",
+          "type": "string"
+        },
+        "shard": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "When the shard started loading",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        },
+        "vectorIndexLoad": {
+          "description": "Ratio of the commit logs of the vector index replayed so far",
+          "type": "number",
+          "format": "double"
+        },
+        "walReplay": {
+          "description": "Ratio of the write-ahead logs of the LSM stores replayed so far",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "ShardStatus": {
+      "description": "The status of a single shard",
+      "properties": {
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        }
+      }
+    },
+    "ShardStatusGetResponse": {
+      "description": "Response body of shard status get request",
+      "properties": {
+        "name": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        },
+        "vectorQueueSize": {
+          "description": "Size of the vector queue of the shard",
+          "type": "integer",
+          "x-omitempty": false
+        }
+      }
+    },
+    "ShardStatusList": {
+      "description": "The status of all the shards of a Class",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ShardStatusGetResponse"
+      }
+    },
+    "SingleRef": {
+      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+      "properties": {
+        "beacon": {
+          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri"
+        },
+        "class": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
+          "type": "string",
+          "format": "uri"
+        },
+        "classification": {
+          "description": "Additional Meta information about classifications if the item was part of one",
+          "$ref": "#/definitions/ReferenceMetaClassification"
+        },
+        "href": {
+          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+          "type": "string",
+          "format": "uri"
+        },
+        "schema": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "SlowQueriesResponse": {
+      "description": "The slow queries kept by the log of a node",
+      "type": "object",
+      "properties": {
+        "entries": {
+          "description": "The slow queries, latest first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SlowQuery"
+          }
+        }
+      }
+    },
+    "SlowQuery": {
+      "description": "A query which took longer than the threshold of the slow query log",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class which was queried",
+          "type": "string"
+        },
+        "error": {
+          "description": "Why the query failed",
+          "type": "string"
+        },
+        "params": {
+          "description": "Parameters of the query",
+          "type": "object"
+        },
+        "shards": {
+          "description": "Plans of the shard searches of the query",
+          "type": "array",
+          "items": {
+            "type": "object"
+          },
+          "x-omitempty": true
+        },
+        "tenant": {
+          "description": "The tenant which was queried",
+          "type": "string"
+        },
+        "time": {
+          "description": "When the query was received",
+          "type": "string",
+          "format": "date-time"
+        },
+        "tookMs": {
+          "description": "Duration of the query in milliseconds",
+          "type": "number",
+          "format": "double"
+        },
+        "type": {
+          "description": "The kind of query, e.g. a vector or a hybrid search",
+          "type": "string"
+        }
+      }
+    },
+    "StandbyNodeStatus": {
+      "description": "Replication status of a single node of the primary",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "Why the last sync of the node failed",
+          "type": "string"
+        },
+        "lagSeconds": {
+          "description": "How far the standby lags behind the node",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "lastChange": {
+          "description": "Time of the last change applied",
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "description": "Name of the node",
+          "type": "string"
+        },
+        "syncedUntil": {
+          "description": "Time until which all changes of the node are applied, not set until the first sync succeeded",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        }
+      }
+    },
+    "StandbyStatus": {
+      "description": "Status of a standby cluster",
+      "type": "object",
+      "properties": {
+        "active": {
+          "description": "Whether the standby applies the changes of its primary",
+          "type": "boolean"
+        },
+        "backend": {
+          "description": "The backup backend the changes of the primary are read from",
+          "type": "string"
+        },
+        "lastSync": {
+          "description": "When the standby last synced",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "nodes": {
+          "description": "Replication status of each node of the primary",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/StandbyNodeStatus"
+          }
+        },
+        "promoted": {
+          "description": "Whether the standby was promoted",
+          "type": "boolean"
+        },
+        "promotedAt": {
+          "description": "When the standby was promoted",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        }
+      }
+    },
+    "StartupPriority": {
+      "description": "Classes to load first",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "Names of the classes",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "StartupProgress": {
+      "description": "Progress of loading the shards of a node",
+      "type": "object",
+      "properties": {
+        "etaSeconds": {
+          "description": "Rough estimate of the time until all shards are loaded, based on the average duration of the shards loaded so far",
+          "type": "number",
+          "format": "double"
+        },
+        "failed": {
+          "description": "Number of shards which could not be loaded",
+          "type": "integer",
+          "format": "int64"
+        },
+        "loaded": {
+          "description": "Number of shards which are loaded",
+          "type": "integer",
+          "format": "int64"
+        },
+        "loading": {
+          "description": "Number of shards which are being loaded",
+          "type": "integer",
+          "format": "int64"
+        },
+        "pending": {
+          "description": "Number of shards which are not loaded yet",
+          "type": "integer",
+          "format": "int64"
+        },
+        "priorityClasses": {
+          "description": "Classes which are loaded first",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "shards": {
+          "description": "Progress of each shard",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ShardStartup"
+          }
+        },
+        "startedAt": {
+          "description": "When the node started loading its shards",
+          "type": "string",
+          "format": "date-time"
+        },
+        "startupComplete": {
+          "description": "Whether all shards are loaded",
+          "type": "boolean"
+        },
+        "total": {
+          "description": "Number of shards to load",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "StopwordConfig": {
+      "description": "fine-grained control over stopword list usage",
+      "type": "object",
+      "properties": {
+        "additions": {
+          "description": "stopwords to be considered additionally",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "preset": {
+          "description": "pre-existing list of common words by language",
+          "type": "string"
+        },
+        "removals": {
+          "description": "stopwords to be removed from consideration",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "TemporalPartitioningConfig": {
+      "description": "Configuration to partition the objects of a multi-tenant class by a date property. Every partition is a tenant named after its time range, e.g. '2023-10' for monthly partitions. Partitions are created when the first object is written to them and queries without a tenant only search the partitions matching the range of their filter on the date property. Partitions can be dropped once they are older than the retention.",
+      "properties": {
+        "interval": {
+          "description": "Time range of a partition, one of 'day', 'month' or 'year'. Defaults to 'month'.",
+          "type": "string"
+        },
+        "property": {
+          "description": "Name of the date property the objects are partitioned by.",
+          "type": "string"
+        },
+        "retentionDays": {
+          "description": "Number of days after the end of its time range after which a partition is dropped with all of its objects. 0 keeps all partitions.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Tenant": {
+      "description": "attributes representing a single tenant within weaviate",
+      "type": "object",
+      "properties": {
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `WARM` + "`" + ` - tenant is active, some restrictions are imposed (TBD; not supported yet), ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - as COLD, but files are stored on cloud storage (not supported yet)",
+          "type": "string",
+          "enum": [
+            "HOT",
+            "WARM",
+            "COLD",
+            "FROZEN"
+          ]
+        },
+        "name": {
+          "description": "name of the tenant",
+          "type": "string"
+        }
+      }
+    },
+    "TenantFailure": {
+      "description": "A tenant a tenants job could not change",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "Why the tenant could not be changed",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the tenant",
+          "type": "string"
+        }
+      }
+    },
+    "TenantImportResult": {
+      "description": "Summary of the import of a tenant",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class the tenant was imported into",
+          "type": "string"
+        },
+        "errors": {
+          "description": "Why objects could not be imported",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "failed": {
+          "description": "Number of objects which could not be imported",
+          "type": "integer",
+          "format": "int64"
+        },
+        "imported": {
+          "description": "Number of objects which were imported",
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenant": {
+          "description": "Name of the tenant",
+          "type": "string"
+        }
+      }
+    },
+    "TenantStats": {
+      "description": "Object count and disk usage of a tenant",
+      "type": "object",
+      "properties": {
+        "activityStatus": {
+          "description": "Activity status of the tenant",
+          "type": "string"
+        },
+        "class": {
+          "description": "The class of the tenant",
+          "type": "string"
+        },
+        "diskUsageBytes": {
+          "description": "Size of the files of the tenant on disk",
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastAccess": {
+          "description": "When the tenant was last accessed, if it was accessed since the node started",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "node": {
+          "description": "The node the statistics were collected on",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "Number of objects of the tenant",
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenant": {
+          "description": "Name of the tenant",
+          "type": "string"
+        },
+        "vectorIndexBytes": {
+          "description": "Size of the vector index of the tenant on disk",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "TenantsJob": {
+      "description": "Progress of a tenants job",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class the job changes tenants of",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "When the job finished",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "failed": {
+          "description": "Tenants which could not be changed",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TenantFailure"
+          }
+        },
+        "id": {
+          "description": "The id of the job",
+          "type": "string"
+        },
+        "operation": {
+          "description": "The change the job applies to the tenants",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "When the job was started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "Status of the job",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "FINISHED"
+          ]
+        },
+        "succeeded": {
+          "description": "Number of tenants which were changed",
+          "type": "integer",
+          "format": "int64"
+        },
+        "total": {
+          "description": "Number of tenants the job changes",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "TenantsJobRequest": {
+      "description": "Changes many tenants of a class at once",
+      "type": "object",
+      "properties": {
+        "operation": {
+          "description": "The change to apply to the tenants",
+          "type": "string",
+          "enum": [
+            "create",
+            "activate",
+            "deactivate",
+            "delete"
+          ]
+        },
+        "tenants": {
+          "description": "Names of the tenants to change",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "TransactionOperation": {
+      "description": "A single write of a transaction. Create and update take the object, delete and the reference changes the class, id and tenant of the object they change.",
+      "type": "object",
+      "required": [
+        "op"
+      ],
+      "properties": {
+        "class": {
+          "description": "The class of the changed object",
+          "type": "string"
+        },
+        "id": {
+          "description": "The id of the changed object",
+          "type": "string",
+          "format": "uuid"
+        },
+        "object": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The kind of write",
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "delete",
+            "add_reference",
+            "delete_reference"
+          ]
+        },
+        "property": {
+          "description": "The reference property which is changed",
+          "type": "string"
+        },
+        "reference": {
+          "$ref": "#/definitions/SingleRef"
+        },
+        "tenant": {
+          "description": "The tenant of the changed object",
+          "type": "string"
+        }
+      }
+    },
+    "TransactionRequest": {
+      "description": "Object writes which are applied all-or-nothing, at most 100 per transaction",
+      "type": "object",
+      "required": [
+        "operations"
+      ],
+      "properties": {
+        "consistencyLevel": {
+          "description": "Determines how many replicas must acknowledge the writes before they are considered successful",
+          "type": "string"
+        },
+        "operations": {
+          "description": "The writes in the order they are applied",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TransactionOperation"
+          }
+        }
+      }
+    },
+    "TransactionResult": {
+      "description": "The result of an applied transaction",
+      "type": "object",
+      "properties": {
+        "atomic": {
+          "description": "Whether all operations fell on one shard and were applied atomically",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "objects": {
+          "description": "The created or updated object of each operation, null for deletes and reference changes",
+          "type": "array",
+          "items": {
+            "x-nullable": true,
+            "$ref": "#/definitions/Object"
+          }
+        }
+      }
+    },
+    "UploadedObject": {
+      "description": "The object created from an uploaded file, or why it could not be created",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "Why the object could not be created",
+          "type": "string"
+        },
+        "file": {
+          "description": "The name of the file",
+          "type": "string"
+        },
+        "id": {
+          "description": "The id of the created object",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "VectorWeights": {
+      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
+      "type": "object"
+    },
+    "VersioningConfig": {
+      "description": "Configuration related to the version history of the objects of a class",
+      "properties": {
+        "enabled": {
+          "description": "Whether or not previous versions of the objects of this class are kept. Can not be changed after the class was created.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "maxVersions": {
+          "description": "Number of versions kept per object, including the current one. Older versions are removed on every change of the object. Defaults to 10.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "WhereFilter": {
+      "description": "Filter search results using a where filter",
+      "type": "object",
+      "properties": {
+        "operands": {
+          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WhereFilter"
+          }
+        },
+        "operator": {
+          "description": "operator to use",
+          "type": "string",
+          "enum": [
+            "And",
+            "Or",
+            "Equal",
+            "Like",
+            "NotEqual",
+            "GreaterThan",
+            "GreaterThanEqual",
+            "LessThan",
+            "LessThanEqual",
+            "WithinGeoRange",
+            "IsNull",
+            "ContainsAny",
+            "ContainsAll",
+            "WithinGeoPolygon",
+            "WithinGeoBoundingBox",
+            "IsMissing"
+          ],
+          "example": "GreaterThanEqual"
+        },
+        "path": {
+          "description": "path to the property currently being filtered",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCity",
+            "City",
+            "name"
+          ]
+        },
+        "valueBoolean": {
+          "description": "value as boolean",
+          "type": "boolean",
+          "x-nullable": true,
+          "example": false
+        },
+        "valueBooleanArray": {
+          "description": "value as boolean",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            true,
+            false
+          ]
+        },
+        "valueDate": {
+          "description": "value as date (as string)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "TODO"
+        },
+        "valueDateArray": {
+          "description": "value as date (as string)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "TODO"
+        },
+        "valueGeoBoundingBox": {
+          "description": "value as the corners of a box",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoBoundingBox"
+        },
+        "valueGeoPolygon": {
+          "description": "value as the outline of an area",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoPolygon"
+        },
+        "valueGeoRange": {
+          "description": "value as geo coordinates and distance",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoRange"
+        },
+        "valueInt": {
+          "description": "value as integer",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true,
+          "example": 2000
+        },
+        "valueIntArray": {
+          "description": "value as integer",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "[100, 200]"
+        },
+        "valueNumber": {
+          "description": "value as number/float",
+          "type": "number",
+          "format": "float64",
+          "x-nullable": true,
+          "example": 3.14
+        },
+        "valueNumberArray": {
+          "description": "value as number/float",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            3.14
+          ]
+        },
+        "valueString": {
+          "description": "value as text (deprecated as of v1.19; alias for valueText)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueStringArray": {
+          "description": "value as text (deprecated as of v1.19; alias for valueText)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        },
+        "valueText": {
+          "description": "value as text",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueTextArray": {
+          "description": "value as text",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        }
+      }
+    },
+    "WhereFilterGeoBoundingBox": {
+      "description": "filter within a box spanned by its north-west and south-east corners",
+      "type": "object",
+      "properties": {
+        "bottomRight": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        },
+        "topLeft": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    },
+    "WhereFilterGeoPolygon": {
+      "description": "filter within the outline of an area, the polygon is implicitly closed",
+      "type": "object",
+      "properties": {
+        "points": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GeoCoordinates"
+          }
+        }
+      }
+    },
+    "WhereFilterGeoRange": {
+      "description": "filter within a distance of a georange",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "type": "object",
+          "properties": {
+            "max": {
+              "type": "number",
+              "format": "float64"
+            }
+          }
+        },
+        "geoCoordinates": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "CommonAfterParameterQuery": {
+      "type": "string",
+      "description": "The starting ID of the result window.",
+      "name": "after",
+      "in": "query"
+    },
+    "CommonClassParameterQuery": {
+      "type": "string",
+      "description": "Class parameter specifies the class from which to query objects",
+      "name": "class",
+      "in": "query"
+    },
+    "CommonConsistencyLevelParameterQuery": {
+      "type": "string",
+      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+      "name": "consistency_level",
+      "in": "query"
+    },
+    "CommonFieldsParameterQuery": {
+      "type": "string",
+      "description": "Comma separated names of the properties to return, all properties are returned if not set. Vectors are only returned if they are included with the include parameter.",
+      "name": "fields",
+      "in": "query"
+    },
+    "CommonIdempotencyKeyParameterHeader": {
+      "maxLength": 255,
+      "type": "string",
+      "description": "Makes retries of the request safe. The response to the first request with a key is kept and returned to retries with the same key and body instead of writing the objects again.",
+      "name": "Idempotency-Key",
+      "in": "header"
+    },
+    "CommonIncludeParameterQuery": {
+      "type": "string",
+      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+      "name": "include",
+      "in": "query"
+    },
+    "CommonLimitParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+      "name": "limit",
+      "in": "query"
+    },
+    "CommonNodeNameParameterQuery": {
+      "type": "string",
+      "description": "The target node which should fulfill the request",
+      "name": "node_name",
+      "in": "query"
+    },
+    "CommonOffsetParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "default": 0,
+      "description": "The starting index of the result window. Default value is 0.",
+      "name": "offset",
+      "in": "query"
+    },
+    "CommonOrderParameterQuery": {
+      "type": "string",
+      "description": "Order parameter to tell how to order (asc or desc) data within given field",
+      "name": "order",
+      "in": "query"
+    },
+    "CommonOutputVerbosityParameterQuery": {
+      "type": "string",
+      "default": "minimal",
+      "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+      "name": "output",
+      "in": "query"
+    },
+    "CommonSortParameterQuery": {
+      "type": "string",
+      "description": "Sort parameter to pass an information about the names of the sort fields",
+      "name": "sort",
+      "in": "query"
+    },
+    "CommonTenantParameterQuery": {
+      "type": "string",
+      "description": "Specifies the tenant in a request targeting a multi-tenant class",
+      "name": "tenant",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "oidc": {
+      "description": "OIDC (OpenConnect ID - based on OAuth2)",
+      "type": "oauth2",
+      "flow": "implicit",
+      "authorizationUrl": "http://to-be-configured-in-the-application-config"
+    }
+  },
+  "security": [
+    {},
+    {
+      "oidc": []
+    }
+  ],
+  "tags": [
+    {
+      "name": "objects"
+    },
+    {
+      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
+      "name": "batch"
+    },
+    {
+      "name": "graphql"
+    },
+    {
+      "name": "meta"
+    },
+    {
+      "name": "P2P"
+    },
+    {
+      "description": "All functions related to the Contextionary.",
+      "name": "contextionary-API"
+    },
+    {
+      "description": "These operations enable manipulation of the schema in Weaviate schema.",
+      "name": "schema"
+    },
+    {
+      "description": "Answers SQL queries on the objects of a class for analytics tools. The same queries are served with the Postgres wire protocol if its port is set.",
+      "name": "sql"
+    },
+    {
+      "description": "Operations on the modules of the instance, like their credentials.",
+      "name": "modules"
+    },
+    {
+      "description": "Answers questions from the objects of a class in one call.",
+      "name": "ask"
+    },
+    {
+      "description": "Manages the roles of role based access control. Roles are the same on every node of the cluster.",
+      "name": "authz"
+    },
+    {
+      "description": "Manages API keys at runtime. Keys are valid on every node of the cluster and can be rotated without changing the configuration.",
+      "name": "apikeys"
+    }
+  ],
+  "externalDocs": {
+    "url": "https://github.com/weaviate/weaviate"
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json",
+    "application/yaml"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Cloud-native, modular vector database",
+    "title": "Weaviate",
+    "contact": {
+      "name": "Weaviate",
+      "url": "https://github.com/weaviate",
+      "email": "hello@weaviate.io"
+    },
+    "version": "1.23.0"
+  },
+  "basePath": "/v1",
+  "paths": {
+    "/": {
+      "get": {
+        "description": "Home. Discover the REST API",
+        "operationId": "weaviate.root",
+        "responses": {
+          "200": {
+            "description": "Weaviate is alive and ready to serve content",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "links": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Link"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/live": {
+      "get": {
+        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
+        "operationId": "weaviate.wellknown.liveness",
+        "responses": {
+          "200": {
+            "description": "The application is able to respond to HTTP requests"
+          }
+        }
+      }
+    },
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                },
+                "scopes": {
+                  "description": "OAuth Scopes",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-omitempty": true
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
+        }
+      }
+    },
+    "/apikeys": {
+      "get": {
+        "description": "Lists the metadata of all managed API keys sorted by creation time.",
+        "tags": [
+          "apikeys"
+        ],
+        "operationId": "keys.list",
+        "responses": {
+          "200": {
+            "description": "The API keys",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/APIKey"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "API key management is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates an API key for a user. The response is the only time the key itself is returned, only its hash is stored.",
+        "tags": [
+          "apikeys"
+        ],
+        "operationId": "keys.create",
+        "parameters": [
+          {
+            "description": "The user and scopes of the key",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/APIKeyCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The API key was created",
+            "schema": {
+              "$ref": "#/definitions/APIKeyCreated"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid request, or aPI key management is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apikeys/{id}": {
+      "get": {
+        "description": "Returns the metadata of an API key.",
+        "tags": [
+          "apikeys"
+        ],
+        "operationId": "keys.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The id of the API key",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The API key",
+            "schema": {
+              "$ref": "#/definitions/APIKey"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The API key does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "API key management is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Revokes an API key, it can no longer be used immediately.",
+        "tags": [
+          "apikeys"
+        ],
+        "operationId": "keys.revoke",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The id of the API key",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The API key was revoked"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The API key does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "API key management is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/ask": {
+      "post": {
+        "description": "Answers a question from the objects of a class. The objects are retrieved with a hybrid search, reranked if rerank is set and the answer is generated from them. The response contains the answer, the citations of the sources in the answer, the sources and the timings of the stages. The retrieval is authorized like a Get query of the class.",
+        "tags": [
+          "ask"
+        ],
+        "operationId": "ask.answer",
+        "parameters": [
+          {
+            "description": "The question and how the objects are retrieved",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AskRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The answer to the question",
+            "schema": {
+              "$ref": "#/definitions/AskAnswer"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/authz/roles": {
+      "get": {
+        "description": "Lists all roles sorted by name.",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "roles.list",
+        "responses": {
+          "200": {
+            "description": "The roles",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Role"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Role based access control is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates a role. The role is replicated to all nodes in the cluster.",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "roles.create",
+        "parameters": [
+          {
+            "description": "The role",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The role was created",
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A role with the same name exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid role, or role based access control is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/authz/roles/{name}": {
+      "get": {
+        "description": "Returns a role.",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "roles.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the role",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The role",
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The role does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Role based access control is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Replaces the permissions and assignments of a role. The name of the role is set from the path if it is empty.",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "roles.update",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the role",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The role",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The role was updated",
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The role does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid role, or role based access control is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes a role.",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "roles.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the role",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The role was deleted"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The role does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Role based access control is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/backups/{backend}": {
+      "post": {
+        "description": "Starts a process of creating a backup for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup create process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup creation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of backup creation attempt for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.create.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup creation status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}/restore": {
+      "get": {
+        "description": "Returns status of a backup restoration attempt for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.restore.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup restoration status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Starts a process of restoring a backup for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.restore",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup restoration process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/batch/ingest": {
+      "get": {
+        "description": "Returns the indexing lag of the ingest queue of the node serving the request. Clients can wait for an acknowledged batch to become visible to queries until appliedSeq reaches the acceptedSeq they observed after the batch.",
+        "tags": [
+          "batch"
+        ],
+        "operationId": "batch.ingest.get",
+        "responses": {
+          "200": {
+            "description": "The status of the ingest queue",
+            "schema": {
+              "$ref": "#/definitions/IngestQueueStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The ingest queue is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/batch/objects": {
+      "post": {
+        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Creates new Objects based on a Object template as a batch.",
+        "operationId": "batch.objects.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
+                }
+              }
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "maxLength": 255,
+            "type": "string",
+            "description": "Makes retries of the request safe. The response to the first request with a key is kept and returned to retries with the same key and body instead of writing the objects again.",
+            "name": "Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
+            },
+            "headers": {
+              "Idempotent-Replayed": {
+                "type": "string",
+                "description": "Set to true if the response is the kept response to an earlier request with the same Idempotency-Key"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A request with the same Idempotency-Key is still in progress",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file? Also returned if the Idempotency-Key was used before with a different body.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      },
+      "delete": {
+        "description": "Delete Objects in bulk that match a certain filter.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchDeleteResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/batch/references": {
+      "post": {
+        "description": "Register cross-references between any class items (objects or objects) in bulk.",
+        "tags": [
+          "batch",
+          "references"
+        ],
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
+        "parameters": [
+          {
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
+        "parameters": [
+          {
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.post"
+        ]
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "classification id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the classification, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Classification does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.get"
+        ]
+      }
+    },
+    "/cluster/shards": {
+      "get": {
+        "description": "Returns the configuration of the shard balancer, the active replicas per node and the moves started by this node.",
+        "tags": [
+          "cluster"
+        ],
+        "operationId": "cluster.shards.get",
+        "responses": {
+          "200": {
+            "description": "Status of the shard balancer",
+            "schema": {
+              "$ref": "#/definitions/ShardBalancerStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The shard balancer is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/cluster/shards/bandwidth": {
+      "put": {
+        "description": "Limits the bandwidth moves started by this node may use.",
+        "tags": [
+          "cluster"
+        ],
+        "operationId": "cluster.shards.bandwidth.update",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BandwidthLimit"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The limit was changed",
+            "schema": {
+              "$ref": "#/definitions/ShardBalancerStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid bandwidth limit",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/cluster/shards/{shardName}/move": {
+      "post": {
+        "description": "Moves a replica of a shard to another node. The replica is copied in the background and dropped from the source node once the target serves it.",
+        "tags": [
+          "cluster"
+        ],
+        "operationId": "cluster.shards.move",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the shard",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The node to move the replica to",
+            "name": "target",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The class of the shard, required if the shard name is not unique",
+            "name": "class",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The node to move the replica from, required if the shard has several replicas",
+            "name": "source",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The move was started",
+            "schema": {
+              "$ref": "#/definitions/ShardMove"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class, shard or node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The shard is already being moved",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid move",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/config": {
+      "put": {
+        "description": "Changes settings of the node serving the request at runtime, like sending SIGHUP to it. The body is a partial configuration with the same keys as the configuration file, e.g. ` + "`" + `{\"log_level\": \"debug\"}` + "`" + `. The response lists the changed settings which were applied and those which require a restart.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "config.update",
+        "parameters": [
+          {
+            "description": "The settings to change",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The settings were changed",
+            "schema": {
+              "$ref": "#/definitions/ConfigReloadResult"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid settings, or config reload is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/debug/bundle": {
+      "get": {
+        "description": "Returns a diagnostics bundle of the node serving the request for support cases. The bundle is a gzip compressed tar archive of the profiles, the goroutines, the redacted configuration, the schema, the node and shard status and the slow queries.",
+        "produces": [
+          "application/gzip",
+          "application/json"
+        ],
+        "tags": [
+          "debug"
+        ],
+        "operationId": "debug.bundle.get",
+        "parameters": [
+          {
+            "maximum": 120,
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 10,
+            "description": "How long the cpu profile is sampled for, 0 skips it",
+            "name": "cpu_seconds",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The diagnostics bundle",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid cpu_seconds",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/debug/recall": {
+      "post": {
+        "description": "Evaluates the recall of the vector indexes of a class on the node serving the request, to tune the ef setting against the real data. The vectors of sampleSize objects per shard are used as queries. The ground truth is a flat search over all vectors of the shard, so the request takes as long as scanning the local shards of the class.",
+        "tags": [
+          "debug"
+        ],
+        "operationId": "debug.recall.evaluate",
+        "parameters": [
+          {
+            "description": "The class and the ef settings to evaluate",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RecallRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The recall and latency for each ef setting",
+            "schema": {
+              "$ref": "#/definitions/RecallReport"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class or tenant does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid request, or the database is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/debug/vector-graph/{className}/{shardName}": {
+      "get": {
+        "description": "Exports the graph of the hnsw vector index of a shard on the node serving the request in the portable graph format. The export is a snapshot of the graph, the vectors are not part of it. Inserts and deletes of the shard are blocked while the graph is exported.",
+        "produces": [
+          "application/json",
+          "application/octet-stream"
+        ],
+        "tags": [
+          "debug"
+        ],
+        "operationId": "debug.vectorGraph.export",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class of the shard",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The shard on the node serving the request",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The graph of the vector index",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class or shard does not exist on this node",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The vector index does not support graph exports, or the database is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Imports a graph exported by debug.vectorGraph.export into the empty hnsw vector index of a shard on the node serving the request. The objects of the graph must already be part of the shard, their vectors are read from it. The input is validated before anything is written, the imported graph is persisted like any other change of the index.",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "debug"
+        ],
+        "operationId": "debug.vectorGraph.import",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class of the shard",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The shard on the node serving the request",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "A graph as returned by debug.vectorGraph.export",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The graph was imported"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class or shard does not exist on this node",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid graph, the vector index is not empty, or the database is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/graphql": {
+      "post": {
+        "description": "Get an object based on GraphQL",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
+        "parameters": [
+          {
+            "description": "The GraphQL query request parameters.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
+        ]
+      }
+    },
+    "/graphql/batch": {
+      "post": {
+        "description": "Perform a batched GraphQL query",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
+        "parameters": [
+          {
+            "description": "The GraphQL queries.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQueries"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponses"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
+        ]
+      }
+    },
+    "/graphql/explain": {
+      "post": {
+        "description": "Plans the Get queries of a GraphQL request without executing them. The filters of the queries are evaluated to count the objects matching them, the vector and keyword searches are not executed and no results are returned.",
+        "tags": [
+          "graphql"
+        ],
+        "operationId": "graphql.explain",
+        "parameters": [
+          {
+            "description": "The GraphQL query request parameters, the same as for /graphql.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The plans of the queries",
+            "schema": {
+              "$ref": "#/definitions/GraphQLExplainResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/imports": {
+      "get": {
+        "description": "Lists the import jobs of the node serving the request.",
+        "tags": [
+          "batch"
+        ],
+        "operationId": "imports.list",
+        "responses": {
+          "200": {
+            "description": "The import jobs",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ImportJob"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid import job, or bulk imports are not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Starts a job which imports files of objects from a backup backend into a class. The files are imported in the background one after another on the node which received the request, the job is not visible on other nodes.",
+        "tags": [
+          "batch"
+        ],
+        "operationId": "imports.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ImportJobRequest"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The import job was started",
+            "schema": {
+              "$ref": "#/definitions/ImportJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid import job, or bulk imports are not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/imports/{id}": {
+      "get": {
+        "description": "Returns the progress of an import job per file.",
+        "tags": [
+          "batch"
+        ],
+        "operationId": "imports.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The id of the import job",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The import job",
+            "schema": {
+              "$ref": "#/definitions/ImportJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The import job does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid import job, or bulk imports are not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Cancels an import job. Objects which were already imported are kept.",
+        "tags": [
+          "batch"
+        ],
+        "operationId": "imports.cancel",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The id of the import job",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The import job was canceled"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The import job does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid import job, or bulk imports are not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/imports:csv": {
+      "post": {
+        "description": "Starts a job which imports an uploaded CSV file into a class. The columns of the file are the properties of the objects, auto schema creates the class or adds the properties of columns missing from it before the file is imported.",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "batch"
+        ],
+        "operationId": "imports.csv.upload",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class to import into",
+            "name": "class",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The tenant to import into, required for classes with multi-tenancy enabled",
+            "name": "tenant",
+            "in": "formData"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of objects imported per batch",
+            "name": "batchSize",
+            "in": "formData"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "Derive the properties of the class from the header and the first rows of the file",
+            "name": "autoSchema",
+            "in": "formData"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "description": "The rows whose values determine the data types of the columns",
+            "name": "sampleRows",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "description": "A JSON object of the data types of columns which are not to be derived by auto schema, e.g. {\"zipCode\": \"text\"}",
+            "name": "types",
+            "in": "formData"
+          },
+          {
+            "type": "file",
+            "description": "The CSV file",
+            "name": "file",
+            "in": "formData",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The import job was started",
+            "schema": {
+              "$ref": "#/definitions/ImportJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid import job, or bulk imports are not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/meta": {
+      "get": {
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+        "tags": [
+          "meta"
+        ],
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Meta"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/module-credentials": {
+      "get": {
+        "description": "Lists the names of the stored module credentials of all tenants and of single tenants. The values are never returned.",
+        "tags": [
+          "modules"
+        ],
+        "operationId": "modules.credentials.list",
+        "responses": {
+          "200": {
+            "description": "The names of the stored credentials",
+            "schema": {
+              "$ref": "#/definitions/ModuleCredentialsListing"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Sets or rotates module credentials for all tenants at runtime. The credentials are added to requests as headers unless the request carries them already. They are replicated to all nodes of the cluster.",
+        "tags": [
+          "modules"
+        ],
+        "operationId": "modules.credentials.update",
+        "parameters": [
+          {
+            "description": "The credentials by the name of the request header the modules read them from, e.g. {\"X-Openai-Api-Key\": \"sk-...\"}. Empty values remove a credential.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "additionalProperties": {
+                "type": "string"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The credentials were stored"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid credentials, or module credentials are not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes the module credentials for all tenants. The credentials of single tenants are kept.",
+        "tags": [
+          "modules"
+        ],
+        "operationId": "modules.credentials.delete",
+        "responses": {
+          "204": {
+            "description": "The credentials were deleted"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/module-credentials/{tenant}": {
+      "put": {
+        "description": "Sets or rotates the module credentials of a single tenant at runtime. They take precedence over the credentials for all tenants for requests of principals bound to the tenant, the tenant a request names does not select them.",
+        "tags": [
+          "modules"
+        ],
+        "operationId": "modules.credentials.tenant.update",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The tenant whose credentials are changed",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The credentials by the name of the request header the modules read them from, e.g. {\"X-Openai-Api-Key\": \"sk-...\"}. Empty values remove a credential.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "additionalProperties": {
+                "type": "string"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The credentials were stored"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid credentials, or module credentials are not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes the module credentials of a single tenant.",
+        "tags": [
+          "modules"
+        ],
+        "operationId": "modules.credentials.tenant.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The tenant whose credentials are changed",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The credentials were deleted"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The tenant has no module credentials",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/nodes": {
+      "get": {
+        "description": "Returns status of Weaviate DB.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "nodes.get",
+        "parameters": [
+          {
+            "type": "string",
+            "default": "minimal",
+            "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+            "name": "output",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Nodes status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/NodesStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.nodes.status.get"
+        ]
+      }
+    },
+    "/nodes/{className}": {
+      "get": {
+        "description": "Returns status of Weaviate DB.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "nodes.get.class",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "default": "minimal",
+            "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+            "name": "output",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Nodes status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/NodesStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.nodes.status.get.class"
+        ]
+      }
+    },
+    "/nodes/{nodeName}/drain": {
+      "get": {
+        "description": "Returns the replicas left on a node and whether the node can be removed safely.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "nodes.drain.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the node",
+            "name": "nodeName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Drain status of the node",
+            "schema": {
+              "$ref": "#/definitions/NodeDrain"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The shard balancer is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Stops placing shards on a node and moves its replicas to the other nodes. The node can be removed safely once no replicas are left on it.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "nodes.drain.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the node",
+            "name": "nodeName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The drain was started",
+            "schema": {
+              "$ref": "#/definitions/NodeDrain"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The node is being drained",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The shard balancer is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Places shards on a drained node again. Replicas which were already moved stay on their new nodes.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "nodes.drain.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the node",
+            "name": "nodeName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The node is no longer drained",
+            "schema": {
+              "$ref": "#/definitions/NodeDrain"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The shard balancer is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/objects": {
+      "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The starting ID of the result window.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The starting index of the result window. Default value is 0.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma separated names of the properties to return, all properties are returned if not set. Vectors are only returned if they are included with the include parameter.",
+            "name": "fields",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Sort parameter to pass an information about the names of the sort fields",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Order parameter to tell how to order (asc or desc) data within given field",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Class parameter specifies the class from which to query objects",
+            "name": "class",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "post": {
+        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Create Objects between two Objects (object and subject).",
+        "operationId": "objects.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "maxLength": 255,
+            "type": "string",
+            "description": "Makes retries of the request safe. The response to the first request with a key is kept and returned to retries with the same key and body instead of writing the objects again.",
+            "name": "Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Object created.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            },
+            "headers": {
+              "Idempotent-Replayed": {
+                "type": "string",
+                "description": "Set to true if the response is the kept response to an earlier request with the same Idempotency-Key"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A request with the same Idempotency-Key is still in progress",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file? Also returned if the Idempotency-Key was used before with a different body.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/objects/validate": {
+      "post": {
+        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully validated."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/objects/{className}/{id}": {
+      "get": {
+        "description": "Get a single data object",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
+        "operationId": "objects.class.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma separated names of the properties to return, all properties are returned if not set. Vectors are only returned if they are included with the include parameter.",
+            "name": "fields",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The target node which should fulfill the request",
+            "name": "node_name",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Update an individual data object based on its class and uuid.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update a class object based on its uuid",
+        "operationId": "objects.class.put",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete a single data object.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete object based on its class and UUID.",
+        "operationId": "objects.class.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "head": {
+        "description": "Checks if a data object exists without retrieving it.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Checks object's existence based on its class and uuid.",
+        "operationId": "objects.class.head",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Object exists."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Object doesn't exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.class.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Update all references of a property of a data object.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.class.references.put",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.class.references.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.class.references.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{className}/{id}/versions": {
+      "get": {
+        "description": "Lists the kept versions of an object of a class with versioning enabled, newest first.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.class.versions.list",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include the vectors of the versions if set to ` + "`" + `vector` + "`" + `",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Versions of the object",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectVersion"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/objects/{className}/{id}/versions/diff": {
+      "get": {
+        "description": "Lists the properties which differ between two versions of an object.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.class.versions.diff",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to compare",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to compare with, defaults to the current version",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Differences of the versions",
+            "schema": {
+              "$ref": "#/definitions/ObjectVersionDiff"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/objects/{className}/{id}/versions/{version}/rollback": {
+      "post": {
+        "description": "Replaces an object with one of its kept versions. The rollback itself is kept as a new version.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.class.versions.rollback",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to roll back to",
+            "name": "version",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The object after the rollback",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/objects/{id}": {
+      "get": {
+        "description": "Lists Objects.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma separated names of the properties to return, all properties are returned if not set. Vectors are only returned if they are included with the include parameter.",
+            "name": "fields",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Deletes an Object from the system.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "head": {
+        "description": "Checks if an Object exists in the system.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Object exists."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Object doesn't exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.patch",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.references.update",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.references.create",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.references.delete",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{id}/versions": {
+      "get": {
+        "description": "Lists the kept versions of an object of a class with versioning enabled, newest first. The object is looked up by its id only, this is deprecated in favor of the class-specific endpoint.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.versions.list",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include the vectors of the versions if set to ` + "`" + `vector` + "`" + `",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Versions of the object",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectVersion"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/objects/{id}/versions/diff": {
+      "get": {
+        "description": "Lists the properties which differ between two versions of an object. The object is looked up by its id only, this is deprecated in favor of the class-specific endpoint.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.versions.diff",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to compare",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to compare with, defaults to the current version",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Differences of the versions",
+            "schema": {
+              "$ref": "#/definitions/ObjectVersionDiff"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/objects/{id}/versions/{version}/rollback": {
+      "post": {
+        "description": "Replaces an object with one of its kept versions. The rollback itself is kept as a new version. The object is looked up by its id only, this is deprecated in favor of the class-specific endpoint.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.versions.rollback",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to roll back to",
+            "name": "version",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The object after the rollback",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/objects:duplicates": {
+      "post": {
+        "description": "Finds groups of near-duplicate objects of a class and optionally merges or deletes the duplicates. Without an action the groups are only reported. The objects of the shards on the node serving the request are compared.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.duplicates",
+        "parameters": [
+          {
+            "description": "Which objects are duplicates and what to do with them",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/DuplicatesRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The groups of duplicates",
+            "schema": {
+              "$ref": "#/definitions/DuplicatesResult"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class or tenant does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/objects:export": {
+      "get": {
+        "description": "Streams all objects of a class including their vectors as JSONL or Parquet, so a class can be copied into a data lake without paginating through the objects. The objects are ordered by id. The trailers ` + "`" + `X-Weaviate-Export-Cursor` + "`" + ` and ` + "`" + `X-Weaviate-Export-Done` + "`" + ` of the response contain the id of the last exported object and whether the class was exported completely, the next export continues after that id. An interrupted JSONL export can be resumed after the last complete line.",
+        "produces": [
+          "application/json",
+          "application/vnd.apache.parquet",
+          "application/x-ndjson"
+        ],
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.export",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class to export",
+            "name": "class",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "jsonl",
+              "parquet"
+            ],
+            "type": "string",
+            "default": "jsonl",
+            "description": "The format of the export",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The tenant to export, required for classes with multi-tenancy enabled",
+            "name": "tenant",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Continue the export after the object with this id",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of objects to export, 0 exports all objects",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Read a point-in-time snapshot of each shard, so objects written while the export runs are not torn across its pages",
+            "name": "snapshot",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The exported objects",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class or tenant does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid export parameters",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/objects:upload": {
+      "post": {
+        "description": "Creates an object of a class from every uploaded file. The text and metadata of the files are extracted by the enabled File2Text module, which maps them to the properties of the class. More files are uploaded by repeating the field file, all files of an upload are limited to 64 MiB. A file which can't be extracted or stored is reported in the response without failing the other files.",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.upload",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class of the objects",
+            "name": "class",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The tenant of the objects, required for classes with multi-tenancy enabled",
+            "name": "tenant",
+            "in": "formData"
+          },
+          {
+            "type": "file",
+            "description": "A file to create an object from",
+            "name": "file",
+            "in": "formData",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The objects created from the files",
+            "schema": {
+              "$ref": "#/definitions/ObjectsUploadResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid upload",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/replication/async": {
+      "get": {
+        "description": "Returns the anti-entropy configuration of this node and the latest repairs of the shards it leads.",
+        "tags": [
+          "replication"
+        ],
+        "operationId": "replication.async.get",
+        "responses": {
+          "200": {
+            "description": "Status of async replication",
+            "schema": {
+              "$ref": "#/definitions/AsyncReplicationStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Async replication is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/replication/async/bandwidth": {
+      "put": {
+        "description": "Limits the bandwidth repairs on this node may use.",
+        "tags": [
+          "replication"
+        ],
+        "operationId": "replication.async.bandwidth.update",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BandwidthLimit"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The limit was changed",
+            "schema": {
+              "$ref": "#/definitions/AsyncReplicationStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid bandwidth limit",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/replication/async/repair": {
+      "post": {
+        "description": "Starts repairing the replicas of a shard, or of all shards of a class led by this node if no shard is given.",
+        "tags": [
+          "replication"
+        ],
+        "operationId": "replication.async.repair",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AsyncReplicationRepairRequest"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The repairs were started",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/AsyncReplicationJob"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or shard does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A repair of the shard is already running",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid repair request, e.g. the class is not replicated",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/replication/standby": {
+      "get": {
+        "description": "Returns whether this cluster is an active standby and how far it lags behind each node of its primary.",
+        "tags": [
+          "replication"
+        ],
+        "operationId": "replication.standby.get",
+        "responses": {
+          "200": {
+            "description": "Status of the standby",
+            "schema": {
+              "$ref": "#/definitions/StandbyStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "This cluster is not configured as a standby",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/replication/standby/promote": {
+      "post": {
+        "description": "Promotes the standby, so that it stops applying the changes of its primary and accepts writes. Unless forced, all nodes of the primary need to be synced.",
+        "tags": [
+          "replication"
+        ],
+        "operationId": "replication.standby.promote",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Promote the standby even if it did not sync all nodes of the primary",
+            "name": "force",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The standby was promoted",
+            "schema": {
+              "$ref": "#/definitions/StandbyStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The standby cannot be promoted, e.g. because it is not synced",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "This cluster is not configured as a standby",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/schema": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
+        "responses": {
+          "200": {
+            "description": "Successfully dumped the database schema.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
+        "parameters": [
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the new Object class to the schema.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Object class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
+      }
+    },
+    "/schema/cluster-status": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.cluster.status",
+        "responses": {
+          "200": {
+            "description": "The schema in the cluster is in sync.",
+            "schema": {
+              "$ref": "#/definitions/SchemaClusterStatus"
+            }
+          },
+          "500": {
+            "description": "The schema is either out of sync (see response body) or the sync check could not be completed.",
+            "schema": {
+              "$ref": "#/definitions/SchemaClusterStatus"
+            }
+          }
+        }
+      }
+    },
+    "/schema/{className}": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the Class, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      },
+      "put": {
+        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Class was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the Object class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Object class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/properties": {
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/rebalance": {
+      "get": {
+        "description": "Returns the progress of the latest change of the replication factor of a class, which is started by updating the class. It is only known to the node which received the update.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.objects.rebalance.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Progress of the rebalance",
+            "schema": {
+              "$ref": "#/definitions/ClassRebalance"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class was not changed since this node started",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/schema/{className}/reshard": {
+      "get": {
+        "description": "Returns the progress of the latest increase of the shard count of a class, which is started by updating the class. It is only known to the node which received the update.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.objects.reshard.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Progress of the reshard",
+            "schema": {
+              "$ref": "#/definitions/ClassReshard"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The class was not changed since this node started",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/schema/{className}/shards": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the status of the shards, returned as body",
+            "schema": {
+              "$ref": "#/definitions/ShardStatusList"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update shard status of an Object Class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.objects.shards.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/tenants": {
+      "get": {
+        "description": "get all tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "tenants from specified class.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update tenant of a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated tenants of the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a new tenant for a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.create",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added new tenants to the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "delete tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deleted tenants from specified class."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/schema/{className}/tenants/jobs": {
+      "get": {
+        "description": "Lists the tenants jobs of a class which are known to the node serving the request.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.jobs.list",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Tenants jobs of the class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/TenantsJob"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Starts a job which creates, activates, deactivates or deletes many tenants of a class at once. The tenants are changed in the background in chunks, tenants which cannot be changed are reported as failed by the job.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.jobs.create",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/TenantsJobRequest"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The job was started",
+            "schema": {
+              "$ref": "#/definitions/TenantsJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid tenants job",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/schema/{className}/tenants/jobs/{id}": {
+      "get": {
+        "description": "Returns the progress of a tenants job. Jobs are kept in memory of the node which received the request and can be looked up for 24 hours after they finished.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.jobs.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the tenants job",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Progress of the tenants job",
+            "schema": {
+              "$ref": "#/definitions/TenantsJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or tenants job does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/schema/{className}/tenants/{tenantName}/export": {
+      "get": {
+        "description": "Exports all objects of a tenant including their vectors as a gzip compressed stream of JSON documents. The first document is a header containing the class, followed by one document per object. The indexes are not exported, they are rebuilt when the tenant is imported.",
+        "produces": [
+          "application/gzip",
+          "application/json"
+        ],
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.export",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The export of the tenant",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or tenant does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The class is not multi-tenant",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/schema/{className}/tenants/{tenantName}/import": {
+      "post": {
+        "description": "Imports a tenant export into an existing tenant. The objects are imported like a batch, so their vector and inverted indexes are rebuilt.",
+        "consumes": [
+          "application/gzip"
+        ],
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.import",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "A tenant export as returned by tenants.export",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The tenant was imported",
+            "schema": {
+              "$ref": "#/definitions/TenantImportResult"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or tenant does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid tenant export",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/schema/{className}/tenants/{tenantName}/stats": {
+      "get": {
+        "description": "Returns the object count and disk usage of a tenant.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.stats.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Statistics of the tenant",
+            "schema": {
+              "$ref": "#/definitions/TenantStats"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or tenant does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/slow-queries": {
+      "get": {
+        "description": "Returns the slow queries kept by the log of the node serving the request, latest first.",
+        "tags": [
+          "debug"
+        ],
+        "operationId": "slow.queries.get",
+        "responses": {
+          "200": {
+            "description": "The slow queries",
+            "schema": {
+              "$ref": "#/definitions/SlowQueriesResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The slow query log is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Clears the slow query log of the node serving the request.",
+        "tags": [
+          "debug"
+        ],
+        "operationId": "slow.queries.delete",
+        "responses": {
+          "204": {
+            "description": "The log was cleared"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The slow query log is not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/sql": {
+      "post": {
+        "description": "Runs a SELECT query on the objects of a class. The response contains the columns with their data types and the rows as arrays of values in the order of the columns.",
+        "tags": [
+          "sql"
+        ],
+        "operationId": "sql.query",
+        "parameters": [
+          {
+            "description": "The query to run",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SQLQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The result of the query",
+            "schema": {
+              "$ref": "#/definitions/SQLResult"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid query",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/startup": {
+      "get": {
+        "description": "Returns the progress of loading the shards of the node serving the request with an estimate of the remaining time, so that a slow restart can be told apart from a hung one.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "startup.get",
+        "responses": {
+          "200": {
+            "description": "Progress of loading the shards",
+            "schema": {
+              "$ref": "#/definitions/StartupProgress"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The database is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/startup/priority": {
+      "put": {
+        "description": "Loads the shards of the given classes before the shards of the other classes which are still pending.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "startup.priority.update",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/StartupPriority"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The classes are loaded first",
+            "schema": {
+              "$ref": "#/definitions/StartupProgress"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The database is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/templates": {
+      "get": {
+        "description": "Lists the query templates, which are GraphQL queries stored under a name.",
+        "tags": [
+          "graphql"
+        ],
+        "operationId": "graphql.templates.list",
+        "responses": {
+          "200": {
+            "description": "The query templates",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/QueryTemplate"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/templates/{name}": {
+      "get": {
+        "description": "Returns a query template.",
+        "tags": [
+          "graphql"
+        ],
+        "operationId": "graphql.templates.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the query template",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The query template",
+            "schema": {
+              "$ref": "#/definitions/QueryTemplate"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The query template does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Creates or replaces a query template. The variables declared by its query are derived from the query.",
+        "tags": [
+          "graphql"
+        ],
+        "operationId": "graphql.templates.put",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the query template",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The query template",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/QueryTemplate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The query template was stored",
+            "schema": {
+              "$ref": "#/definitions/QueryTemplate"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid query template",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes a query template.",
+        "tags": [
+          "graphql"
+        ],
+        "operationId": "graphql.templates.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the query template",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The query template was deleted"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The query template does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/templates/{name}/execute": {
+      "post": {
+        "description": "Executes a query template with the given variables, the defaults of the template are used for the variables which are not set. The response is the same as the response of /graphql.",
+        "tags": [
+          "graphql"
+        ],
+        "operationId": "graphql.templates.execute",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the query template",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The variables of the query",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/QueryTemplateExecuteRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The result of the query",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The query template does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid variables, or the GraphQL API is not available",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transactions": {
+      "post": {
+        "description": "Applies several object writes all-or-nothing. If an operation fails, the operations before it are rolled back. This is atomic if all operations fall on one shard and best-effort otherwise, the response tells which applied.",
+        "tags": [
+          "objects"
+        ],
+        "operationId": "objects.transaction",
+        "parameters": [
+          {
+            "description": "The operations of the transaction",
             "name": "body",
             "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/TransactionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The transaction was applied",
+            "schema": {
+              "$ref": "#/definitions/TransactionResult"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden, or a quota is exceeded",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "An object of an operation does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid operations",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "The rate limit of the tenant is exceeded",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/usage": {
+      "get": {
+        "description": "Returns the calls and tokens of the modules of the node serving the request since its start, for the chargeback of the costs of the providers. All filters are optional.",
+        "tags": [
+          "modules"
+        ],
+        "operationId": "modules.usage.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only report the calls of this module",
+            "name": "module",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only report the calls for this class",
+            "name": "class",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only report the calls for this tenant",
+            "name": "tenant",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only report the calls of this user",
+            "name": "user",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only report the calls with this API key",
+            "name": "apiKeyId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The usage of the modules",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ModuleUsageReport"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "APIKey": {
+      "description": "The metadata of a managed API key. The key itself is never returned, except when it is created.",
+      "type": "object",
+      "properties": {
+        "createdAt": {
+          "description": "When the key was created",
+          "type": "string",
+          "format": "date-time"
+        },
+        "expiresAt": {
+          "description": "When the key expires, it does not expire if not set",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "The id of the key",
+          "type": "string"
+        },
+        "scopes": {
+          "$ref": "#/definitions/APIKeyScopes"
+        },
+        "user": {
+          "description": "The user the key belongs to",
+          "type": "string"
+        }
+      }
+    },
+    "APIKeyCreateRequest": {
+      "description": "A new managed API key",
+      "type": "object",
+      "required": [
+        "user"
+      ],
+      "properties": {
+        "expiresAt": {
+          "description": "When the key expires, must be in the future. The key does not expire if not set.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "scopes": {
+          "$ref": "#/definitions/APIKeyScopes"
+        },
+        "user": {
+          "description": "The user the key belongs to",
+          "type": "string"
+        }
+      }
+    },
+    "APIKeyCreated": {
+      "description": "A created API key, the response to creating a key is the only one which contains the key itself",
+      "allOf": [
+        {
+          "$ref": "#/definitions/APIKey"
+        },
+        {
+          "type": "object",
+          "properties": {
+            "apiKey": {
+              "description": "The key, it can not be recovered later",
+              "type": "string",
+              "x-go-name": "Key"
+            }
+          }
+        }
+      ]
+    },
+    "APIKeyScopes": {
+      "description": "Limits what a key can be used for, on top of the permissions of the user the key belongs to. Empty lists are not restricted. Classes and tenants are patterns following the syntax of path.Match.",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "The patterns of the classes the key can access",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tenants": {
+          "description": "The patterns of the tenants the key can access",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "verbs": {
+          "description": "The verbs the key is allowed, possible values are get, list, head, validate, create, update and delete",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "AdditionalProperties": {
+      "description": "Additional Meta information about a single object object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "AskAnswer": {
+      "description": "The answer to a question with its sources",
+      "type": "object",
+      "properties": {
+        "answer": {
+          "description": "The generated answer",
+          "type": "string"
+        },
+        "citations": {
+          "description": "The citations of the sources in the answer",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AskCitation"
+          }
+        },
+        "sources": {
+          "description": "The objects the answer was generated from",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AskSource"
+          }
+        },
+        "timings": {
+          "$ref": "#/definitions/AskTimings"
+        }
+      }
+    },
+    "AskCitation": {
+      "description": "A citation of a source in the answer",
+      "type": "object",
+      "properties": {
+        "end": {
+          "description": "The byte offset of the end of the citation marker in the answer",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "id": {
+          "description": "The id of the cited object",
+          "type": "string",
+          "format": "uuid"
+        },
+        "source": {
+          "description": "The position of the source in the list of sources, starting at 1",
+          "type": "integer",
+          "format": "int64"
+        },
+        "start": {
+          "description": "The byte offset of the start of the citation marker, e.g. \"[2]\", in the answer",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "AskRequest": {
+      "description": "A question which is answered from the objects of a class",
+      "type": "object",
+      "required": [
+        "class",
+        "question"
+      ],
+      "properties": {
+        "alpha": {
+          "description": "Weighs the vector search against the keyword search, the default of hybrid searches if not set",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "class": {
+          "description": "The class the answer is generated from",
+          "type": "string"
+        },
+        "limit": {
+          "description": "The number of retrieved objects, 10 by default",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "description": "The text properties the answer is generated from, all text properties of the class if empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "question": {
+          "description": "The question",
+          "type": "string"
+        },
+        "rerank": {
+          "$ref": "#/definitions/AskRerank"
+        },
+        "tenant": {
+          "description": "The tenant of the objects, required for classes with multi-tenancy enabled",
+          "type": "string"
+        }
+      }
+    },
+    "AskRerank": {
+      "description": "Reranks the retrieved objects with the reranker module of the class",
+      "type": "object",
+      "required": [
+        "property"
+      ],
+      "properties": {
+        "limit": {
+          "description": "The number of objects kept after reranking, all if zero",
+          "type": "integer",
+          "format": "int64"
+        },
+        "property": {
+          "description": "The text property which is ranked",
+          "type": "string"
+        },
+        "query": {
+          "description": "The query the objects are ranked by, the question if empty",
+          "type": "string"
+        }
+      }
+    },
+    "AskSource": {
+      "description": "An object the answer was generated from, sources are cited by their position in the list of sources, starting at 1",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class of the object",
+          "type": "string"
+        },
+        "id": {
+          "description": "The id of the object",
+          "type": "string",
+          "format": "uuid"
+        },
+        "properties": {
+          "description": "The properties of the object the answer was generated from",
+          "type": "object"
+        },
+        "rerankScore": {
+          "description": "The score of the object in the reranking, if the objects were reranked",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "score": {
+          "description": "The score of the object in the hybrid search",
+          "type": "number",
+          "format": "float",
+          "x-omitempty": false
+        }
+      }
+    },
+    "AskTimings": {
+      "description": "How long the stages of the answer took",
+      "type": "object",
+      "properties": {
+        "generateMs": {
+          "description": "The generation of the answer",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "rerankMs": {
+          "description": "The reranking of the objects, zero if they were not reranked",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "retrieveMs": {
+          "description": "The retrieval of the objects",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "totalMs": {
+          "description": "All stages",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        }
+      }
+    },
+    "AsyncReplicationJob": {
+      "description": "The latest repair of a shard",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class of the shard",
+          "type": "string"
+        },
+        "error": {
+          "description": "Why the repair failed",
+          "type": "string"
+        },
+        "finishedAt": {
+          "description": "When the repair finished",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "progress": {
+          "$ref": "#/definitions/ReplicaSyncStats"
+        },
+        "shard": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "When the repair started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "Status of the repair",
+          "type": "string"
+        },
+        "trigger": {
+          "description": "What started the repair",
+          "type": "string"
+        }
+      }
+    },
+    "AsyncReplicationRepairRequest": {
+      "description": "The shards to repair",
+      "type": "object",
+      "required": [
+        "class"
+      ],
+      "properties": {
+        "class": {
+          "description": "The class to repair",
+          "type": "string"
+        },
+        "shard": {
+          "description": "The shard to repair, all shards of the class led by this node if not set",
+          "type": "string"
+        }
+      }
+    },
+    "AsyncReplicationStatus": {
+      "description": "Anti-entropy configuration and repairs of a node",
+      "type": "object",
+      "properties": {
+        "bandwidthLimit": {
+          "description": "Bytes per second repairs may use, 0 means no limit",
+          "type": "integer",
+          "format": "int64"
+        },
+        "conflictResolution": {
+          "description": "How conflicting objects are resolved",
+          "type": "string"
+        },
+        "enabled": {
+          "description": "Whether shards are repaired periodically",
+          "type": "boolean"
+        },
+        "hashTreeDepth": {
+          "description": "Depth of the hash trees compared",
+          "type": "integer",
+          "format": "int64"
+        },
+        "interval": {
+          "description": "How often shards are repaired",
+          "type": "string"
+        },
+        "jobs": {
+          "description": "The latest repair of each shard",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AsyncReplicationJob"
+          }
+        }
+      }
+    },
+    "BM25Config": {
+      "description": "tuning parameters for the BM25 algorithm",
+      "type": "object",
+      "properties": {
+        "b": {
+          "description": "calibrates term-weight scaling based on the document length",
+          "type": "number",
+          "format": "float"
+        },
+        "k1": {
+          "description": "calibrates term-weight scaling based on the term frequency within a document",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes",
+      "properties": {
+        "config": {
+          "description": "Custom configuration for the backup creation process",
+          "type": "object"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup creation process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of classes to include in the backup creation process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "BackupCreateResponse": {
+      "description": "The definition of a backup create response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup creation process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupCreateStatusResponse": {
+      "description": "The definition of a backup create metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupRestoreRequest": {
+      "description": "Request body for restoring a backup for a set of classes",
+      "properties": {
+        "classMapping": {
+          "description": "Restores classes under a different name. The key is the name of the class in the backup, the value the name it is restored as.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "config": {
+          "description": "Custom configuration for the backup restoration process",
+          "type": "object"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "include": {
+          "description": "List of classes to include in the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "node_mapping": {
+          "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "pointInTime": {
+          "description": "Restores the state at this time (RFC3339) by replaying the archived changes made after the backup. Requires the WAL archive to be enabled.",
+          "type": "string"
+        },
+        "tenants": {
+          "description": "Restores only these tenants of multi-tenant classes. The key is the name of the class in the backup. If the class already exists, the tenants are added to it.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "BackupRestoreResponse": {
+      "description": "The definition of a backup restore response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup restoration process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupRestoreStatusResponse": {
+      "description": "The definition of a backup restore metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backup backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BandwidthLimit": {
+      "description": "Limits the bandwidth of a background process",
+      "type": "object",
+      "required": [
+        "bytesPerSecond"
+      ],
+      "properties": {
+        "bytesPerSecond": {
+          "description": "Bytes per second the process may use, 0 means no limit",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BatchDelete": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
           }
-        ],
-        "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        }
+      }
+    },
+    "BatchDeleteMatch": {
+      "description": "Outlines how to find the objects to be deleted.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class (name) which objects will be deleted.",
+          "type": "string",
+          "example": "City"
+        },
+        "where": {
+          "description": "Filter to limit the objects to be deleted.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "BatchDeleteResponse": {
+      "description": "Delete Objects response.",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
             }
-          },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
+              }
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
             }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+          }
+        }
+      }
+    },
+    "BatchDeleteResponseMatch": {
+      "description": "Outlines how to find the objects to be deleted.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class (name) which objects will be deleted.",
+          "type": "string",
+          "example": "City"
+        },
+        "where": {
+          "description": "Filter to limit the objects to be deleted.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "BatchDeleteResponseResults": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "description": "How many objects should have been deleted but could not be deleted.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "limit": {
+          "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "matches": {
+          "description": "How many objects were matched by the filter.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objects": {
+          "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
+          }
+        },
+        "successful": {
+          "description": "How many objects were successfully deleted in this round.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "BatchDeleteResponseResultsObjectsItems0": {
+      "description": "Results for this specific Object.",
+      "format": "object",
+      "properties": {
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "type": "string",
+          "default": "SUCCESS",
+          "enum": [
+            "SUCCESS",
+            "DRYRUN",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BatchReference": {
+      "properties": {
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        },
+        "tenant": {
+          "description": "Name of the reference tenant.",
+          "type": "string"
+        },
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        }
+      }
+    },
+    "BatchReferenceResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific reference.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
             }
           }
+        }
+      ]
+    },
+    "BatchReferenceResponseAO1Result": {
+      "description": "Results for this specific reference.",
+      "format": "object",
+      "properties": {
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        "status": {
+          "type": "string",
+          "default": "SUCCESS",
+          "enum": [
+            "SUCCESS",
+            "PENDING",
+            "FAILED"
+          ]
+        }
       }
     },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+    "BatchStats": {
+      "description": "The summary of a nodes batch queue congestion status.",
+      "properties": {
+        "queueLength": {
+          "description": "How many objects are currently in the batch queue.",
+          "type": "number",
+          "format": "int",
+          "x-nullable": true,
+          "x-omitempty": true
+        },
+        "ratePerSecond": {
+          "description": "How many objects are approximately processed from the batch queue per second.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
+        }
+      }
+    },
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+      "properties": {
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
+        },
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
+          "type": "string"
+        },
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/C11yNearestNeighborsItems0"
+      }
+    },
+    "C11yNearestNeighborsItems0": {
+      "type": "object",
+      "properties": {
+        "distance": {
+          "type": "number",
+          "format": "float"
+        },
+        "word": {
+          "type": "string"
+        }
+      }
+    },
+    "C11yVector": {
+      "description": "A Vector in the Contextionary",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/C11yVectorBasedQuestionItems0"
+      }
+    },
+    "C11yVectorBasedQuestionItems0": {
+      "type": "object",
+      "properties": {
+        "classProps": {
+          "description": "Vectorized properties.",
+          "type": "array",
+          "maxItems": 300,
+          "minItems": 300,
+          "items": {
+            "$ref": "#/definitions/C11yVectorBasedQuestionItems0ClassPropsItems0"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "Successfully replaced all the references."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        },
+        "classVectors": {
+          "description": "Vectorized classname.",
+          "type": "array",
+          "maxItems": 300,
+          "minItems": 300,
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "C11yVectorBasedQuestionItems0ClassPropsItems0": {
+      "type": "object",
+      "properties": {
+        "propsVectors": {
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "post": {
-        "description": "Add a single reference to a class-property.",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
+        "value": {
+          "description": "String with valuename.",
+          "type": "string"
+        }
+      }
+    },
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
+      "properties": {
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
+          "type": "object",
+          "properties": {
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
+            },
+            "concatenatedWord": {
+              "type": "string"
+            },
+            "singleWords": {
+              "type": "array",
+              "items": {
+                "format": "string"
+              }
             }
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "Successfully added the reference."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        },
+        "individualWords": {
+          "description": "Weighted results for per individual word",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/C11yWordsResponseIndividualWordsItems0"
           }
+        }
+      }
+    },
+    "C11yWordsResponseConcatenatedWord": {
+      "description": "Weighted results for all words",
+      "type": "object",
+      "properties": {
+        "concatenatedNearestNeighbors": {
+          "$ref": "#/definitions/C11yNearestNeighbors"
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+        "concatenatedVector": {
+          "$ref": "#/definitions/C11yVector"
+        },
+        "concatenatedWord": {
+          "type": "string"
+        },
+        "singleWords": {
+          "type": "array",
+          "items": {
+            "format": "string"
           }
-        ],
-        "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+        }
+      }
+    },
+    "C11yWordsResponseIndividualWordsItems0": {
+      "type": "object",
+      "properties": {
+        "info": {
+          "type": "object",
+          "properties": {
+            "nearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "vector": {
+              "$ref": "#/definitions/C11yVector"
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        "present": {
+          "type": "boolean"
+        },
+        "word": {
+          "type": "string"
+        }
+      }
+    },
+    "C11yWordsResponseIndividualWordsItems0Info": {
+      "type": "object",
+      "properties": {
+        "nearestNeighbors": {
+          "$ref": "#/definitions/C11yNearestNeighbors"
+        },
+        "vector": {
+          "$ref": "#/definitions/C11yVector"
+        }
+      }
+    },
+    "ChunkingConfig": {
+      "description": "Configuration to split a long text property of the objects of a class into chunks. Every chunk is stored as an object of the target class with a reference to its parent object.",
+      "properties": {
+        "chunkOverlap": {
+          "description": "Number of tokens shared by two consecutive chunks. Only used by the 'token' splitter. Defaults to 0.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "chunkSize": {
+          "description": "Maximum number of whitespace separated tokens per chunk. Defaults to 200.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "property": {
+          "description": "Name of the text property that is split into chunks.",
+          "type": "string"
+        },
+        "referenceProperty": {
+          "description": "Name of the reference property of the target class that points to the parent object. Defaults to 'parent'.",
+          "type": "string"
+        },
+        "splitter": {
+          "description": "How the text is split, one of 'sentence', 'token' or 'recursive'. Defaults to 'recursive'.",
+          "type": "string"
+        },
+        "targetClass": {
+          "description": "Name of the class the chunks are stored in. It needs a text property with the same name as the chunked property, an int property 'chunkIndex' and the reference property.",
+          "type": "string"
+        }
+      }
+    },
+    "Class": {
+      "type": "object",
+      "properties": {
+        "chunkingConfig": {
+          "$ref": "#/definitions/ChunkingConfig"
+        },
+        "class": {
+          "description": "Name of the class as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "compactionConfig": {
+          "$ref": "#/definitions/CompactionConfig"
+        },
+        "description": {
+          "description": "Description of the class.",
+          "type": "string"
+        },
+        "invertedIndexConfig": {
+          "$ref": "#/definitions/InvertedIndexConfig"
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "multiTenancyConfig": {
+          "$ref": "#/definitions/MultiTenancyConfig"
+        },
+        "properties": {
+          "description": "The properties of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Property"
+          }
+        },
+        "queryConfig": {
+          "$ref": "#/definitions/QueryConfig"
+        },
+        "replicationConfig": {
+          "$ref": "#/definitions/ReplicationConfig"
+        },
+        "segmentCompressionConfig": {
+          "$ref": "#/definitions/SegmentCompressionConfig"
+        },
+        "shardingConfig": {
+          "description": "Manage how the index should be sharded and distributed in the cluster",
+          "type": "object"
+        },
+        "temporalPartitioningConfig": {
+          "$ref": "#/definitions/TemporalPartitioningConfig"
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
+          "type": "string"
+        },
+        "versioningConfig": {
+          "$ref": "#/definitions/VersioningConfig"
+        }
+      }
+    },
+    "ClassRebalance": {
+      "description": "Change of the replication factor of a class. The shard replicas are copied to new nodes in the background, the new factor takes effect once all of them have been copied.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class which is changed",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "When the rebalance completed",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "error": {
+          "description": "Why the rebalance failed",
+          "type": "string"
+        },
+        "fromFactor": {
+          "description": "The previous replication factor",
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicasDone": {
+          "description": "Number of shard replicas copied or removed",
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicasTotal": {
+          "description": "Number of shard replicas to copy or to remove",
+          "type": "integer",
+          "format": "int64"
+        },
+        "startedAt": {
+          "description": "When the rebalance started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "Status of the rebalance",
+          "type": "string"
+        },
+        "toFactor": {
+          "description": "The new replication factor",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ClassReshard": {
+      "description": "Increase of the shard count of a class. The existing shards hand over objects to the new shards in the background.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class which is changed",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "When the reshard completed",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "error": {
+          "description": "Why the reshard failed",
+          "type": "string"
+        },
+        "fromCount": {
+          "description": "The previous shard count",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsMoved": {
+          "description": "Number of objects moved to the new shards",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shardsDone": {
+          "description": "Number of shards which handed over their objects",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shardsTotal": {
+          "description": "Number of shards which hand over objects",
+          "type": "integer",
+          "format": "int64"
+        },
+        "startedAt": {
+          "description": "When the reshard started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "Status of the reshard",
+          "type": "string"
+        },
+        "toCount": {
+          "description": "The new shard count",
+          "type": "integer",
+          "format": "int64"
+        }
       }
     },
-    "/schema": {
-      "get": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
-        "responses": {
-          "200": {
-            "description": "Successfully dumped the database schema.",
-            "schema": {
-              "$ref": "#/definitions/Schema"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
+      "type": "object",
+      "properties": {
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
+          "type": "array",
+          "items": {
+            "type": "string"
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+          "example": [
+            "description"
+          ]
         },
-        "x-serviceIds": [
-          "weaviate.local.query.meta"
-        ]
-      },
-      "post": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
-        "parameters": [
-          {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Added the new Object class to the schema.",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid Object class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        "class": {
+          "description": "class (name) which is used in this classification",
+          "type": "string",
+          "example": "City"
+        },
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
+          "type": "array",
+          "items": {
+            "type": "string"
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+          "example": [
+            "inCountry"
+          ]
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "classify xzy: something went wrong"
+        },
+        "filters": {
+          "type": "object",
+          "properties": {
+            "sourceWhere": {
+              "description": "limit the objects to be classified",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "targetWhere": {
+              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "trainingSetWhere": {
+              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
             }
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.add.meta"
-        ]
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "settings": {
+          "description": "classification-type specific settings",
+          "type": "object"
+        },
+        "status": {
+          "description": "status of this classification",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "description": "which algorithm to use for classifications",
+          "type": "string"
+        }
       }
     },
-    "/schema/cluster-status": {
-      "get": {
-        "tags": [
-          "schema"
-        ],
-        "operationId": "schema.cluster.status",
-        "responses": {
-          "200": {
-            "description": "The schema in the cluster is in sync.",
-            "schema": {
-              "$ref": "#/definitions/SchemaClusterStatus"
-            }
-          },
-          "500": {
-            "description": "The schema is either out of sync (see response body) or the sync check could not be completed.",
-            "schema": {
-              "$ref": "#/definitions/SchemaClusterStatus"
-            }
-          }
+    "ClassificationFilters": {
+      "type": "object",
+      "properties": {
+        "sourceWhere": {
+          "description": "limit the objects to be classified",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "targetWhere": {
+          "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "trainingSetWhere": {
+          "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
         }
       }
     },
-    "/schema/{className}": {
-      "get": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Found the Class, returned as body",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "This class does not exist"
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+    "CompactionConfig": {
+      "description": "Configuration of the compaction of the segments the objects and indexes of a class are stored in. Can be changed at any time, changes apply from the next compaction on.",
+      "properties": {
+        "maxBytesPerSecond": {
+          "description": "Maximum rate at which compactions of a shard write to disk, in bytes per second. 0 disables the limit.",
+          "type": "integer",
+          "format": "int64"
         },
-        "x-serviceIds": [
-          "weaviate.local.get.meta"
-        ]
-      },
-      "put": {
-        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
-        "tags": [
-          "schema"
-        ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Class was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Class to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid update attempt",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        "strategy": {
+          "description": "How segments are picked for compaction, one of 'sizeTiered' or 'leveled'. 'sizeTiered' only merges segments of a similar size, which writes less. 'leveled' also merges small segments into larger ones, which keeps fewer segments to be read. Defaults to 'sizeTiered'.",
+          "type": "string"
+        }
+      }
+    },
+    "ConfigReloadResult": {
+      "description": "The changed settings which were applied at runtime and those which only take effect after a restart of the node",
+      "type": "object",
+      "properties": {
+        "applied": {
+          "description": "Settings which were applied",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
-      },
-      "delete": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Removed the Object class from the schema."
-          },
-          "400": {
-            "description": "Could not delete the Object class.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        "failed": {
+          "description": "Settings which could not be applied, with the reason",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
+        "requiresRestart": {
+          "description": "Settings which take effect after a restart",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
       }
     },
-    "/schema/{className}/properties": {
-      "post": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Added the property.",
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid property.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    "Deprecation": {
+      "type": "object",
+      "properties": {
+        "apiType": {
+          "description": "Describes which API is effected, usually one of: REST, GraphQL",
+          "type": "string"
+        },
+        "id": {
+          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
+          "type": "string"
+        },
+        "locations": {
+          "description": "The locations within the specified API affected by this deprecation",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
+        "mitigation": {
+          "description": "User-required object to not be affected by the (planned) removal",
+          "type": "string"
+        },
+        "msg": {
+          "description": "What this deprecation is about",
+          "type": "string"
+        },
+        "plannedRemovalVersion": {
+          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+          "type": "string"
+        },
+        "removedIn": {
+          "description": "If the feature has already been removed, it was removed in this version",
+          "type": "string",
+          "x-nullable": true
+        },
+        "removedTime": {
+          "description": "If the feature has already been removed, it was removed at this timestamp",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string",
+          "format": "date-time"
+        },
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string"
+        },
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+          "type": "string"
+        }
       }
     },
-    "/schema/{className}/shards": {
-      "get": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "tenant",
-            "in": "query"
+    "DuplicateGroup": {
+      "description": "A group of near-duplicate objects",
+      "type": "object",
+      "properties": {
+        "duplicates": {
+          "description": "The duplicates of the kept object",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DuplicateObject"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "Found the status of the shards, returned as body",
-            "schema": {
-              "$ref": "#/definitions/ShardStatusList"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "This class does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        },
+        "keep": {
+          "description": "The id of the oldest object of the group, which is kept",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "DuplicateObject": {
+      "description": "A duplicate of the kept object of a group",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "description": "The distance of the vectors of the duplicate and the kept object",
+          "type": "number",
+          "format": "float",
+          "x-omitempty": false
+        },
+        "id": {
+          "description": "The id of the duplicate",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "DuplicatesRequest": {
+      "description": "Selects the near-duplicate objects of a class. Two objects are duplicates if the distance of their vectors is at most distance and all properties are equal. Groups are formed transitively. Each object is compared to its neighbors nearest objects only.",
+      "type": "object",
+      "required": [
+        "class"
+      ],
+      "properties": {
+        "action": {
+          "description": "What to do with the duplicates. delete keeps the oldest object of each group, merge also sets the properties which it lacks from the other objects. Empty to only report the groups.",
+          "type": "string",
+          "enum": [
+            "delete",
+            "merge"
+          ]
+        },
+        "class": {
+          "description": "The class to search for duplicates",
+          "type": "string"
+        },
+        "distance": {
+          "description": "The maximum distance of the vectors of duplicates",
+          "type": "number",
+          "format": "float"
+        },
+        "limit": {
+          "description": "The maximum number of groups, 100 by default",
+          "type": "integer",
+          "format": "int64"
+        },
+        "neighbors": {
+          "description": "The number of nearest objects each object is compared to, 10 by default",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "description": "The properties which must be equal for duplicates",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.get.meta"
-        ]
+        "tenant": {
+          "description": "The tenant to search, required for classes with multi-tenancy enabled",
+          "type": "string"
+        }
       }
     },
-    "/schema/{className}/shards/{shardName}": {
-      "put": {
-        "description": "Update shard status of an Object Class",
-        "tags": [
-          "schema"
-        ],
-        "operationId": "schema.objects.shards.update",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "shardName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ShardStatus"
-            }
+    "DuplicatesResult": {
+      "description": "The groups of near-duplicate objects of a class",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The searched class",
+          "type": "string"
+        },
+        "deleted": {
+          "description": "The number of objects which were deleted",
+          "type": "integer",
+          "format": "int64"
+        },
+        "errors": {
+          "description": "The errors of objects which could not be deleted or merged",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "Shard status was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/ShardStatus"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Shard to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid update attempt",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        },
+        "groups": {
+          "description": "The groups of duplicates",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DuplicateGroup"
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
+        "merged": {
+          "description": "The number of objects which were merged",
+          "type": "integer",
+          "format": "int64"
+        },
+        "scanned": {
+          "description": "The number of objects which were compared",
+          "type": "integer",
+          "format": "int64"
+        }
       }
     },
-    "/schema/{className}/tenants": {
-      "get": {
-        "description": "get all tenants from a specific class",
-        "tags": [
-          "schema"
-        ],
-        "operationId": "tenants.get",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "tenants from specified class.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ErrorResponseErrorItems0"
           }
+        },
+        "requestId": {
+          "description": "The id of the request that caused the error. It is also returned in the X-Request-Id response header and forwarded to module providers, so it can be used to correlate a failure across systems.",
+          "type": "string"
         }
-      },
-      "put": {
-        "description": "Update tenant of a specific class",
-        "tags": [
-          "schema"
-        ],
-        "operationId": "tenants.update",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
+      }
+    },
+    "ErrorResponseErrorItems0": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "GeoCoordinates": {
+      "properties": {
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        }
+      }
+    },
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
+      "properties": {
+        "locations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLErrorLocationsItems0"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "Updated tenants of the specified class",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        },
+        "message": {
+          "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         }
-      },
-      "post": {
-        "description": "Create a new tenant for a specific class",
-        "tags": [
-          "schema"
-        ],
-        "operationId": "tenants.create",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Added new tenants to the specified class",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+      }
+    },
+    "GraphQLErrorLocationsItems0": {
+      "type": "object",
+      "properties": {
+        "column": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "line": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "GraphQLExplainResponse": {
+      "description": "Plans of the queries of an explained GraphQL request",
+      "type": "object",
+      "properties": {
+        "errors": {
+          "description": "Errors of the queries which could not be planned.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLError"
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "x-omitempty": true
+        },
+        "plans": {
+          "description": "Execution plans of the queries.",
+          "type": "array",
+          "items": {
+            "type": "object"
           }
         }
-      },
-      "delete": {
-        "description": "delete tenants from a specific class",
-        "tags": [
-          "schema"
-        ],
-        "operationId": "tenants.delete",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "tenants",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+      }
+    },
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+      "type": "object",
+      "properties": {
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
+          "type": "string"
+        },
+        "query": {
+          "description": "Query based on GraphQL syntax.",
+          "type": "string"
+        },
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
+        }
+      }
+    },
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+      "properties": {
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "Deleted tenants from specified class."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
+        },
+        "errors": {
+          "description": "Array with errors.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLError"
           },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "x-omitempty": true
+        },
+        "explain": {
+          "description": "Execution plans of the explained queries.",
+          "type": "array",
+          "items": {
+            "type": "object"
           },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "x-omitempty": true
+        },
+        "facets": {
+          "description": "Value counts of the facet properties of the queries requesting them.",
+          "type": "array",
+          "items": {
+            "type": "object"
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "x-omitempty": true
+        }
+      }
+    },
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "ImportAutoSchema": {
+      "description": "Creates the class, or adds the properties of columns missing from it, from the header and the first rows of CSV files before each file is imported",
+      "type": "object",
+      "properties": {
+        "sampleRows": {
+          "description": "The rows whose values determine the data types of the columns",
+          "type": "integer",
+          "format": "int64"
+        },
+        "types": {
+          "description": "The data types of columns which are not derived, e.g. {\"zipCode\": \"text\"}",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "Additional Meta information about a single object object.",
+    },
+    "ImportFile": {
+      "description": "The progress of a single file of an import job",
       "type": "object",
-      "additionalProperties": {
-        "type": "object"
+      "properties": {
+        "errors": {
+          "description": "Samples of the errors of objects which could not be imported, or the error which stopped reading the file",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "failed": {
+          "description": "The number of objects which could not be imported",
+          "type": "integer",
+          "format": "int64"
+        },
+        "format": {
+          "description": "The format of the file",
+          "type": "string"
+        },
+        "imported": {
+          "description": "The number of objects which were imported",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the file",
+          "type": "string"
+        },
+        "status": {
+          "description": "The status of the file",
+          "type": "string",
+          "enum": [
+            "PENDING",
+            "STARTED",
+            "FINISHED",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "ImportJob": {
+      "description": "The progress of an import job, whose files are imported in the background one after another",
+      "type": "object",
+      "properties": {
+        "autoSchema": {
+          "$ref": "#/definitions/ImportAutoSchema"
+        },
+        "backend": {
+          "description": "The backup backend the files are read from, empty for uploaded files",
+          "type": "string"
+        },
+        "class": {
+          "description": "The class the files are imported into",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "When the job completed, was canceled or failed",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "error": {
+          "description": "The error which stopped the job",
+          "type": "string"
+        },
+        "files": {
+          "description": "The progress of each file",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ImportFile"
+          }
+        },
+        "id": {
+          "description": "The id of the job",
+          "type": "string"
+        },
+        "path": {
+          "description": "The path of the files within the backend",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "When the job was started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "The status of the job",
+          "type": "string",
+          "enum": [
+            "PENDING",
+            "STARTED",
+            "FINISHED",
+            "FAILED",
+            "CANCELED"
+          ]
+        },
+        "tenant": {
+          "description": "The tenant the files are imported into",
+          "type": "string"
+        }
+      }
+    },
+    "ImportJobRequest": {
+      "description": "Imports files from a backup backend into a class. The files are looked up by the backend like the files of a backup whose id is the path, e.g. below s3://\u003cbucket\u003e/\u003cpath\u003e/ for the S3 backend.",
+      "type": "object",
+      "required": [
+        "backend",
+        "class",
+        "files"
+      ],
+      "properties": {
+        "autoSchema": {
+          "$ref": "#/definitions/ImportAutoSchema"
+        },
+        "backend": {
+          "description": "The backup backend to read the files from, e.g. s3",
+          "type": "string"
+        },
+        "batchSize": {
+          "description": "The number of objects imported per batch",
+          "type": "integer",
+          "format": "int64"
+        },
+        "class": {
+          "description": "The class to import into",
+          "type": "string"
+        },
+        "files": {
+          "description": "The files to import",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "format": {
+          "description": "The format of all files, derived from their extensions if empty",
+          "type": "string",
+          "enum": [
+            "jsonl",
+            "parquet",
+            "csv"
+          ]
+        },
+        "path": {
+          "description": "The path of the files within the backend",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "The tenant to import into, required for classes with multi-tenancy enabled",
+          "type": "string"
+        }
+      }
+    },
+    "IngestQueueStatus": {
+      "description": "The batches accepted into and applied from the ingest queue of a node",
+      "type": "object",
+      "properties": {
+        "acceptedSeq": {
+          "description": "The sequence number of the last accepted batch",
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false
+        },
+        "appliedObjects": {
+          "description": "The number of objects which were applied",
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false
+        },
+        "appliedSeq": {
+          "description": "The sequence number of the last applied batch",
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false
+        },
+        "failedObjects": {
+          "description": "The number of objects which could not be applied",
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false
+        },
+        "lagSeconds": {
+          "description": "How long the oldest pending batch is waiting",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "lastError": {
+          "description": "The last error applying a batch",
+          "type": "string"
+        },
+        "oldestPending": {
+          "description": "When the oldest pending batch was accepted",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "pendingBatches": {
+          "description": "The number of batches which are not applied yet",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "pendingObjects": {
+          "description": "The number of objects which are not applied yet",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "InvertedIndexConfig": {
+      "description": "Configure the inverted index built into Weaviate",
+      "type": "object",
+      "properties": {
+        "bm25": {
+          "$ref": "#/definitions/BM25Config"
+        },
+        "cleanupIntervalSeconds": {
+          "description": "Asynchronous index clean up happens every n seconds",
+          "type": "number",
+          "format": "int"
+        },
+        "indexNullState": {
+          "description": "Index each object with the null state",
+          "type": "boolean"
+        },
+        "indexPropertyLength": {
+          "description": "Index length of properties",
+          "type": "boolean"
+        },
+        "indexTimestamps": {
+          "description": "Index each object by its internal timestamps",
+          "type": "boolean"
+        },
+        "stopwords": {
+          "$ref": "#/definitions/StopwordConfig"
+        }
+      }
+    },
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Link": {
+      "type": "object",
+      "properties": {
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
+          "type": "string"
+        },
+        "href": {
+          "description": "target of the link",
+          "type": "string"
+        },
+        "name": {
+          "description": "human readable name of the resource group",
+          "type": "string"
+        },
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+          "type": "string"
+        }
+      }
+    },
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
+      "type": "object",
+      "properties": {
+        "hostname": {
+          "description": "The url of the host.",
+          "type": "string",
+          "format": "url"
+        },
+        "modules": {
+          "description": "Module-specific meta information",
+          "type": "object"
+        },
+        "version": {
+          "description": "Version of weaviate you are currently running",
+          "type": "string"
+        }
       }
     },
-    "BM25Config": {
-      "description": "tuning parameters for the BM25 algorithm",
+    "ModuleCredentialsListing": {
+      "description": "The names of the stored module credentials without their values",
       "type": "object",
       "properties": {
-        "b": {
-          "description": "calibrates term-weight scaling based on the document length",
-          "type": "number",
-          "format": "float"
+        "global": {
+          "description": "The credentials for all tenants",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "k1": {
-          "description": "calibrates term-weight scaling based on the term frequency within a document",
-          "type": "number",
-          "format": "float"
+        "tenants": {
+          "description": "The credentials of single tenants by tenant",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
         }
       }
     },
-    "BackupCreateRequest": {
-      "description": "Request body for creating a backup of a set of classes",
+    "ModuleUsageReport": {
+      "description": "The calls and tokens of the modules of a node since its start",
+      "type": "object",
       "properties": {
-        "config": {
-          "description": "Custom configuration for the backup creation process",
-          "type": "object"
+        "calls": {
+          "description": "The number of module calls of all totals",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "exclude": {
-          "description": "List of classes to exclude from the backup creation process",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "inputTokens": {
+          "description": "The input tokens of the module calls of all totals",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
+        "outputTokens": {
+          "description": "The output tokens of the module calls of all totals",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "include": {
-          "description": "List of classes to include in the backup creation process",
+        "since": {
+          "description": "When the node started counting",
+          "type": "string",
+          "format": "date-time"
+        },
+        "totals": {
+          "description": "The totals per module, class, tenant, user and API key",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/ModuleUsageTotals"
           }
         }
       }
     },
-    "BackupCreateResponse": {
-      "description": "The definition of a backup create response body",
+    "ModuleUsageTotals": {
+      "description": "The calls and tokens of one module, class, tenant, user and API key",
+      "type": "object",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+        "apiKeyId": {
+          "description": "The id of the API key the module was called with",
           "type": "string"
         },
-        "classes": {
-          "description": "The list of classes for which the backup creation process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "calls": {
+          "description": "The number of module calls",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "error": {
-          "description": "error message if creation failed",
+        "class": {
+          "description": "The class the module was called for",
           "type": "string"
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+        "inputTokens": {
+          "description": "The input tokens of the module calls",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "module": {
+          "description": "The called module",
           "type": "string"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
+        "outputTokens": {
+          "description": "The output tokens of the module calls",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "tenant": {
+          "description": "The tenant the module was called for",
           "type": "string"
         },
-        "status": {
-          "description": "phase of backup creation process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
+        "user": {
+          "description": "The user who called the module",
+          "type": "string"
         }
       }
     },
-    "BackupCreateStatusResponse": {
-      "description": "The definition of a backup create metadata",
+    "MultiTenancyConfig": {
+      "description": "Configuration related to multi-tenancy within a class",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
+        "enabled": {
+          "description": "Whether or not multi-tenancy is enabled for this class",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
+      }
+    },
+    "NestedProperty": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "error": {
-          "description": "error message if creation failed",
+        "description": {
           "type": "string"
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
+        "indexFilterable": {
+          "type": "boolean",
+          "x-nullable": true
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
+        "indexSearchable": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
           "type": "string"
         },
-        "status": {
-          "description": "phase of backup creation process",
+        "nestedProperties": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
           "type": "string",
-          "default": "STARTED",
           "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
+            "word",
+            "lowercase",
+            "whitespace",
+            "field"
           ]
         }
       }
     },
-    "BackupRestoreRequest": {
-      "description": "Request body for restoring a backup for a set of classes",
+    "NodeDrain": {
+      "description": "Drain of a node. Draining nodes are not considered for new shards, their replicas are moved to the other nodes.",
+      "type": "object",
       "properties": {
-        "config": {
-          "description": "Custom configuration for the backup restoration process",
-          "type": "object"
+        "draining": {
+          "description": "Whether the node is drained",
+          "type": "boolean"
         },
-        "exclude": {
-          "description": "List of classes to exclude from the backup restoration process",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "error": {
+          "description": "Why the drain failed",
+          "type": "string"
         },
-        "include": {
-          "description": "List of classes to include in the backup restoration process",
+        "finishedAt": {
+          "description": "When the drain finished",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "node": {
+          "description": "Name of the node",
+          "type": "string"
+        },
+        "replicas": {
+          "description": "Replicas left on the node as ` + "`" + `class/shard` + "`" + `. Inactive tenants are not moved, they need to be activated first.",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "node_mapping": {
-          "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
+        "safe": {
+          "description": "Whether the node can be removed safely",
+          "type": "boolean"
+        },
+        "startedAt": {
+          "description": "When the drain started",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "status": {
+          "description": "Status of the moves started by this node, empty if there are none",
+          "type": "string"
         }
       }
     },
-    "BackupRestoreResponse": {
-      "description": "The definition of a backup restore response body",
+    "NodeShardStatus": {
+      "description": "The definition of a node shard status response body",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
+        "class": {
+          "description": "The name of shard's class.",
+          "type": "string",
+          "x-omitempty": false
         },
-        "classes": {
-          "description": "The list of classes for which the backup restoration process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "compressed": {
+          "description": "The status of vector compression/quantization.",
+          "format": "boolean",
+          "x-omitempty": false
         },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string",
+          "x-omitempty": false
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
+        "objectCount": {
+          "description": "The number of objects in shard.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
+        "vectorIndexingStatus": {
+          "description": "The status of the vector indexing process.",
+          "format": "string",
+          "x-omitempty": false
         },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
+        "vectorQueueLength": {
+          "description": "The length of the vector indexing queue.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "NodeStats": {
+      "description": "The summary of Weaviate's statistics.",
+      "properties": {
+        "objectCount": {
+          "description": "The total number of objects in DB.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shardCount": {
+          "description": "The count of Weaviate's shards.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
         }
       }
     },
-    "BackupRestoreStatusResponse": {
-      "description": "The definition of a backup restore metadata",
+    "NodeStatus": {
+      "description": "The definition of a backup node status response body",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
+        "batchStats": {
+          "description": "Weaviate batch statistics.",
+          "type": "object",
+          "$ref": "#/definitions/BatchStats"
         },
-        "error": {
-          "description": "error message if restoration failed",
+        "gitHash": {
+          "description": "The gitHash of Weaviate.",
           "type": "string"
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+        "name": {
+          "description": "The name of the node.",
           "type": "string"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backup backend",
-          "type": "string"
+        "shards": {
+          "description": "The list of the shards with it's statistics.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeShardStatus"
+          }
+        },
+        "stats": {
+          "description": "Weaviate overall statistics.",
+          "type": "object",
+          "$ref": "#/definitions/NodeStats"
         },
         "status": {
-          "description": "phase of backup restoration process",
+          "description": "Node's status.",
           "type": "string",
-          "default": "STARTED",
+          "default": "HEALTHY",
           "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE"
           ]
+        },
+        "version": {
+          "description": "The version of Weaviate.",
+          "type": "string"
         }
       }
     },
-    "BatchDelete": {
+    "NodesStatusResponse": {
+      "description": "The status of all of the Weaviate nodes",
       "type": "object",
       "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeStatus"
           }
-        },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
         }
       }
     },
-    "BatchDeleteMatch": {
-      "description": "Outlines how to find the objects to be deleted.",
+    "Object": {
       "type": "object",
       "properties": {
+        "additional": {
+          "$ref": "#/definitions/AdditionalProperties"
+        },
         "class": {
-          "description": "Class (name) which objects will be deleted.",
+          "description": "Class of the Object, defined in the schema.",
+          "type": "string"
+        },
+        "creationTimeUnix": {
+          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "ID of the Object.",
           "type": "string",
-          "example": "City"
+          "format": "uuid"
         },
-        "where": {
-          "description": "Filter to limit the objects to be deleted.",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
-        }
-      }
-    },
-    "BatchDeleteResponse": {
-      "description": "Delete Objects response.",
-      "type": "object",
-      "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
+        "lastUpdateTimeUnix": {
+          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
         },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
+        "properties": {
+          "$ref": "#/definitions/PropertySchema"
         },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
+        "tenant": {
+          "description": "Name of the Objects tenant.",
+          "type": "string"
         },
-        "results": {
-          "type": "object",
-          "properties": {
-            "failed": {
-              "description": "How many objects should have been deleted but could not be deleted.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "limit": {
-              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "matches": {
-              "description": "How many objects were matched by the filter.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "objects": {
-              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
-              }
-            },
-            "successful": {
-              "description": "How many objects were successfully deleted in this round.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            }
-          }
-        }
-      }
-    },
-    "BatchDeleteResponseMatch": {
-      "description": "Outlines how to find the objects to be deleted.",
-      "type": "object",
-      "properties": {
-        "class": {
-          "description": "Class (name) which objects will be deleted.",
-          "type": "string",
-          "example": "City"
+        "vector": {
+          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
+          "$ref": "#/definitions/C11yVector"
         },
-        "where": {
-          "description": "Filter to limit the objects to be deleted.",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
         }
       }
     },
-    "BatchDeleteResponseResults": {
+    "ObjectPropertyChange": {
+      "description": "Change of a single property, a missing value means the property was not set in the version",
       "type": "object",
       "properties": {
-        "failed": {
-          "description": "How many objects should have been deleted but could not be deleted.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        },
-        "limit": {
-          "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        },
-        "matches": {
-          "description": "How many objects were matched by the filter.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
+        "from": {
+          "description": "Value of the property in the version compared"
         },
-        "objects": {
-          "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
-          }
+        "property": {
+          "description": "Name of the property",
+          "type": "string"
         },
-        "successful": {
-          "description": "How many objects were successfully deleted in this round.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
+        "to": {
+          "description": "Value of the property in the version compared with"
         }
       }
     },
-    "BatchDeleteResponseResultsObjectsItems0": {
-      "description": "Results for this specific Object.",
-      "format": "object",
+    "ObjectVersion": {
+      "description": "A kept version of an object",
+      "type": "object",
       "properties": {
-        "errors": {
-          "$ref": "#/definitions/ErrorResponse"
+        "deleted": {
+          "description": "Whether the object was deleted in this version",
+          "type": "boolean"
         },
-        "id": {
-          "description": "ID of the Object.",
-          "type": "string",
-          "format": "uuid"
+        "object": {
+          "description": "The object as of this version, unless it was deleted",
+          "$ref": "#/definitions/Object"
         },
-        "status": {
-          "type": "string",
-          "default": "SUCCESS",
-          "enum": [
-            "SUCCESS",
-            "DRYRUN",
-            "FAILED"
-          ]
+        "version": {
+          "description": "The version number, increasing with every change of the object",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "BatchReference": {
+    "ObjectVersionDiff": {
+      "description": "The properties which differ between two versions of an object. A deleted version has no properties.",
+      "type": "object",
       "properties": {
+        "changes": {
+          "description": "The properties which differ",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ObjectPropertyChange"
+          }
+        },
         "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+          "description": "The version compared",
+          "type": "integer",
+          "format": "int64"
         },
-        "tenant": {
-          "description": "Name of the reference tenant.",
-          "type": "string"
+        "id": {
+          "description": "ID of the object",
+          "type": "string",
+          "format": "uuid"
         },
         "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+          "description": "The version compared with",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorChanged": {
+          "description": "Whether the vectors of the versions differ",
+          "type": "boolean"
         }
       }
     },
-    "BatchReferenceResponse": {
+    "ObjectsGetResponse": {
       "type": "object",
       "allOf": [
         {
-          "$ref": "#/definitions/BatchReference"
+          "$ref": "#/definitions/Object"
+        },
+        {
+          "properties": {
+            "deprecations": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Deprecation"
+              }
+            }
+          }
         },
         {
           "properties": {
             "result": {
-              "description": "Results for this specific reference.",
+              "description": "Results for this specific Object.",
               "format": "object",
               "properties": {
                 "errors": {
@@ -8458,8 +20162,8 @@ func init() {
         }
       ]
     },
-    "BatchReferenceResponseAO1Result": {
-      "description": "Results for this specific reference.",
+    "ObjectsGetResponseAO2Result": {
+      "description": "Results for this specific Object.",
       "format": "object",
       "properties": {
         "errors": {
@@ -8476,497 +20180,438 @@ func init() {
         }
       }
     },
-    "BatchStats": {
-      "description": "The summary of a nodes batch queue congestion status.",
-      "properties": {
-        "queueLength": {
-          "description": "How many objects are currently in the batch queue.",
-          "type": "number",
-          "format": "int",
-          "x-nullable": true,
-          "x-omitempty": true
-        },
-        "ratePerSecond": {
-          "description": "How many objects are approximately processed from the batch queue per second.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
-        }
-      }
-    },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
-      "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
-        },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
-          "type": "string"
-        },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/C11yNearestNeighborsItems0"
-      }
-    },
-    "C11yNearestNeighborsItems0": {
-      "type": "object",
-      "properties": {
-        "distance": {
-          "type": "number",
-          "format": "float"
-        },
-        "word": {
-          "type": "string"
-        }
-      }
-    },
-    "C11yVector": {
-      "description": "A Vector in the Contextionary",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float"
-      }
-    },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/C11yVectorBasedQuestionItems0"
-      }
-    },
-    "C11yVectorBasedQuestionItems0": {
+    "ObjectsListResponse": {
+      "description": "List of Objects.",
       "type": "object",
       "properties": {
-        "classProps": {
-          "description": "Vectorized properties.",
+        "deprecations": {
           "type": "array",
-          "maxItems": 300,
-          "minItems": 300,
           "items": {
-            "$ref": "#/definitions/C11yVectorBasedQuestionItems0ClassPropsItems0"
+            "$ref": "#/definitions/Deprecation"
           }
         },
-        "classVectors": {
-          "description": "Vectorized classname.",
+        "objects": {
+          "description": "The actual list of Objects.",
           "type": "array",
-          "maxItems": 300,
-          "minItems": 300,
           "items": {
-            "type": "number",
-            "format": "float"
+            "$ref": "#/definitions/Object"
           }
+        },
+        "totalResults": {
+          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "C11yVectorBasedQuestionItems0ClassPropsItems0": {
+    "ObjectsUploadResponse": {
+      "description": "The objects created from uploaded files",
       "type": "object",
       "properties": {
-        "propsVectors": {
+        "class": {
+          "description": "The class of the objects",
+          "type": "string"
+        },
+        "objects": {
+          "description": "The object created from each file, in the order of the files",
           "type": "array",
           "items": {
-            "type": "number",
-            "format": "float"
+            "$ref": "#/definitions/UploadedObject"
           }
-        },
-        "value": {
-          "description": "String with valuename.",
-          "type": "string"
         }
       }
     },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
+    "PatchDocumentAction": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
       "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
-          "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
-              "type": "array",
-              "items": {
-                "format": "string"
-              }
-            }
-          }
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
         },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/C11yWordsResponseIndividualWordsItems0"
-          }
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
         }
       }
     },
-    "C11yWordsResponseConcatenatedWord": {
-      "description": "Weighted results for all words",
-      "type": "object",
+    "PatchDocumentObject": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
       "properties": {
-        "concatenatedNearestNeighbors": {
-          "$ref": "#/definitions/C11yNearestNeighbors"
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
         },
-        "concatenatedVector": {
-          "$ref": "#/definitions/C11yVector"
+        "merge": {
+          "$ref": "#/definitions/Object"
         },
-        "concatenatedWord": {
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
           "type": "string"
         },
-        "singleWords": {
-          "type": "array",
-          "items": {
-            "format": "string"
-          }
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
         }
       }
     },
-    "C11yWordsResponseIndividualWordsItems0": {
-      "type": "object",
+    "PeerUpdate": {
+      "description": "A single peer in the network.",
       "properties": {
-        "info": {
-          "type": "object",
-          "properties": {
-            "nearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "vector": {
-              "$ref": "#/definitions/C11yVector"
-            }
-          }
+        "id": {
+          "description": "The session ID of the peer.",
+          "type": "string",
+          "format": "uuid"
         },
-        "present": {
-          "type": "boolean"
+        "name": {
+          "description": "Human readable name.",
+          "type": "string"
         },
-        "word": {
+        "schemaHash": {
+          "description": "The latest known hash of the peer's schema.",
           "type": "string"
+        },
+        "uri": {
+          "description": "The location where the peer is exposed to the internet.",
+          "type": "string",
+          "format": "uri"
         }
       }
     },
-    "C11yWordsResponseIndividualWordsItems0Info": {
+    "PeerUpdateList": {
+      "description": "List of known peers.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/PeerUpdate"
+      }
+    },
+    "Permission": {
+      "description": "Grants an action on all collections and tenants matching the patterns. Patterns follow the syntax of path.Match, e.g. \"*\" or \"Article*\", an empty pattern is the same as \"*\".",
       "type": "object",
+      "required": [
+        "action"
+      ],
       "properties": {
-        "nearestNeighbors": {
-          "$ref": "#/definitions/C11yNearestNeighbors"
+        "action": {
+          "description": "read allows reading objects and the schema of a collection, write allows creating and updating objects and their references, schema allows changing the definition of a collection and managing its tenants, delete allows deleting objects",
+          "type": "string",
+          "enum": [
+            "read",
+            "write",
+            "schema",
+            "delete"
+          ]
         },
-        "vector": {
-          "$ref": "#/definitions/C11yVector"
+        "collection": {
+          "description": "The pattern of the collections",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "The pattern of the tenants",
+          "type": "string"
         }
       }
     },
-    "Class": {
-      "type": "object",
+    "PhoneNumber": {
       "properties": {
-        "class": {
-          "description": "Name of the class as URI relative to the schema URL.",
+        "countryCode": {
+          "description": "Read-only. The numerical country code (e.g. 49)",
+          "type": "number",
+          "format": "uint64"
+        },
+        "defaultCountry": {
+          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
           "type": "string"
         },
-        "description": {
-          "description": "Description of the class.",
+        "input": {
+          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
           "type": "string"
         },
-        "invertedIndexConfig": {
-          "$ref": "#/definitions/InvertedIndexConfig"
+        "internationalFormatted": {
+          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
+          "type": "string"
         },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
+        "national": {
+          "description": "Read-only. The numerical representation of the national part",
+          "type": "number",
+          "format": "uint64"
         },
-        "multiTenancyConfig": {
-          "$ref": "#/definitions/MultiTenancyConfig"
+        "nationalFormatted": {
+          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
+          "type": "string"
         },
-        "properties": {
-          "description": "The properties of the class.",
+        "valid": {
+          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+          "type": "boolean"
+        }
+      }
+    },
+    "Principal": {
+      "type": "object",
+      "properties": {
+        "apiKeyId": {
+          "description": "The id of the managed API key the principal authenticated with, if any",
+          "type": "string"
+        },
+        "groups": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Property"
+            "type": "string"
           }
         },
-        "replicationConfig": {
-          "$ref": "#/definitions/ReplicationConfig"
-        },
-        "shardingConfig": {
-          "description": "Manage how the index should be sharded and distributed in the cluster",
-          "type": "object"
-        },
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
+        "roles": {
+          "description": "Roles assigned to the principal by the authentication provider, e.g. through OIDC claims",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
+        "tenant": {
+          "description": "The tenant all requests of the principal are restricted to, if any",
           "type": "string"
         },
-        "vectorizer": {
-          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
+        "username": {
+          "description": "The username that was extracted either from the authentication information",
           "type": "string"
         }
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
+    "Property": {
       "type": "object",
       "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
+        "dataType": {
+          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
           "type": "array",
           "items": {
             "type": "string"
-          },
-          "example": [
-            "description"
-          ]
-        },
-        "class": {
-          "description": "class (name) which is used in this classification",
-          "type": "string",
-          "example": "City"
+          }
         },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "inCountry"
-          ]
+        "description": {
+          "description": "Description of the property.",
+          "type": "string"
         },
-        "error": {
-          "description": "error message if status == failed",
-          "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
+        "encrypted": {
+          "description": "Optional. Should the values of this property be encrypted at rest. Defaults to false. Applicable only to properties of data type text and text[], requires property encryption to be configured on the server. Encrypted properties can not be indexed in the inverted index.",
+          "type": "boolean"
         },
-        "filters": {
-          "type": "object",
-          "properties": {
-            "sourceWhere": {
-              "description": "limit the objects to be classified",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "targetWhere": {
-              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "trainingSetWhere": {
-              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
+        "indexFilterable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
         },
-        "id": {
-          "description": "ID to uniquely identify this classification run",
-          "type": "string",
-          "format": "uuid",
-          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        "indexInverted": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
+          "type": "boolean",
+          "x-nullable": true
         },
-        "meta": {
-          "description": "additional meta information about the classification",
-          "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
+        "indexSearchable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
         },
-        "settings": {
-          "description": "classification-type specific settings",
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
           "type": "object"
         },
-        "status": {
-          "description": "status of this classification",
-          "type": "string",
-          "enum": [
-            "running",
-            "completed",
-            "failed"
-          ],
-          "example": "running"
-        },
-        "type": {
-          "description": "which algorithm to use for classifications",
+        "name": {
+          "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
-        }
-      }
-    },
-    "ClassificationFilters": {
-      "type": "object",
-      "properties": {
-        "sourceWhere": {
-          "description": "limit the objects to be classified",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
         },
-        "targetWhere": {
-          "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
         },
-        "trainingSetWhere": {
-          "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
+        "sensitive": {
+          "description": "Optional. Marks the property as containing sensitive data, e.g. personally identifiable information. Values of sensitive properties are redacted from responses unless the requesting user holds the sensitive data reader role. Defaults to false.",
+          "type": "boolean"
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field"
+          ]
         }
       }
     },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
-      "type": "object",
+    "PropertySchema": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "QueryConfig": {
+      "description": "Configuration related to the queries of a class",
       "properties": {
-        "completed": {
-          "description": "time when this classification finished",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
-        },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
-          "type": "integer",
-          "example": 147
-        },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
-          "type": "integer",
-          "example": 7
-        },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
+        "timeoutMilliseconds": {
+          "description": "Maximum duration of queries on this class in milliseconds. Queries which take longer fail, unless they accept partial results. 0 means no limit.",
           "type": "integer",
-          "example": 140
-        },
-        "started": {
-          "description": "time when this classification was started",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+          "format": "int64"
         }
       }
     },
-    "Deprecation": {
+    "QueryTemplate": {
+      "description": "A GraphQL query with variables stored under a name. Defaults are used for the variables which are not set when the template is executed.",
       "type": "object",
+      "required": [
+        "query"
+      ],
       "properties": {
-        "apiType": {
-          "description": "Describes which API is effected, usually one of: REST, GraphQL",
-          "type": "string"
-        },
-        "id": {
-          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
-          "type": "string"
+        "createdAt": {
+          "description": "When the template was created",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
         },
-        "locations": {
-          "description": "The locations within the specified API affected by this deprecation",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "defaults": {
+          "description": "The values of the variables which are not set when the template is executed",
+          "type": "object"
         },
-        "mitigation": {
-          "description": "User-required object to not be affected by the (planned) removal",
+        "description": {
+          "description": "The description of the template",
           "type": "string"
         },
-        "msg": {
-          "description": "What this deprecation is about",
+        "name": {
+          "description": "The name of the template, must start with a letter and contain letters, digits, '_' and '-' only. Set from the path if empty.",
           "type": "string"
         },
-        "plannedRemovalVersion": {
-          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+        "query": {
+          "description": "The GraphQL query",
           "type": "string"
         },
-        "removedIn": {
-          "description": "If the feature has already been removed, it was removed in this version",
-          "type": "string",
-          "x-nullable": true
-        },
-        "removedTime": {
-          "description": "If the feature has already been removed, it was removed at this timestamp",
+        "updatedAt": {
+          "description": "When the template was last changed",
           "type": "string",
           "format": "date-time",
-          "x-nullable": true
-        },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string",
-          "format": "date-time"
-        },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string"
+          "readOnly": true
         },
-        "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
-          "type": "string"
-        }
-      }
-    },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
-      "type": "object",
-      "properties": {
-        "error": {
+        "variables": {
+          "description": "The variables declared by the query",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/ErrorResponseErrorItems0"
-          }
+            "type": "string"
+          },
+          "readOnly": true
         }
       }
     },
-    "ErrorResponseErrorItems0": {
+    "QueryTemplateExecuteRequest": {
+      "description": "The variables a query template is executed with",
       "type": "object",
       "properties": {
-        "message": {
-          "type": "string"
+        "variables": {
+          "description": "The values of the variables of the query",
+          "type": "object"
         }
       }
     },
-    "GeoCoordinates": {
+    "RecallPoint": {
+      "description": "The share of the true nearest neighbors which were found with an ef setting, and the latencies of the queries",
+      "type": "object",
       "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
+        "ef": {
+          "description": "The ef setting",
+          "type": "integer",
+          "format": "int64"
+        },
+        "meanLatencyMs": {
+          "description": "The mean latency of the queries",
           "type": "number",
-          "format": "float",
-          "x-nullable": true
+          "format": "double"
         },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
+        "p50LatencyMs": {
+          "description": "The median latency of the queries",
           "type": "number",
-          "format": "float",
-          "x-nullable": true
+          "format": "double"
+        },
+        "p99LatencyMs": {
+          "description": "The 99th percentile of the latency of the queries",
+          "type": "number",
+          "format": "double"
+        },
+        "recall": {
+          "description": "The share of the true nearest neighbors which were found",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
         }
       }
     },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
+    "RecallReport": {
+      "description": "The recall and latency of the vector indexes of a class for each evaluated ef setting",
+      "type": "object",
       "properties": {
-        "locations": {
+        "class": {
+          "description": "The evaluated class",
+          "type": "string"
+        },
+        "groundTruthTookMs": {
+          "description": "How long the flat search for the ground truth took",
+          "type": "number",
+          "format": "double"
+        },
+        "limit": {
+          "description": "The number of nearest neighbors each query searched for",
+          "type": "integer",
+          "format": "int64"
+        },
+        "points": {
+          "description": "The recall and latency for each ef setting",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/GraphQLErrorLocationsItems0"
+            "$ref": "#/definitions/RecallPoint"
           }
         },
-        "message": {
-          "type": "string"
+        "queries": {
+          "description": "The number of queries per ef setting",
+          "type": "integer",
+          "format": "int64"
         },
-        "path": {
+        "shards": {
+          "description": "The evaluated shards of the node",
           "type": "array",
           "items": {
             "type": "string"
@@ -8974,874 +20619,1031 @@ func init() {
         }
       }
     },
-    "GraphQLErrorLocationsItems0": {
+    "RecallRequest": {
+      "description": "The class whose vector indexes are evaluated and the ef settings to evaluate",
       "type": "object",
+      "required": [
+        "class"
+      ],
       "properties": {
-        "column": {
+        "class": {
+          "description": "The class to evaluate",
+          "type": "string"
+        },
+        "ef": {
+          "description": "The ef settings to evaluate",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "limit": {
+          "description": "The number of nearest neighbors each query searches for",
           "type": "integer",
           "format": "int64"
         },
-        "line": {
+        "sampleSize": {
+          "description": "The number of queries per shard",
           "type": "integer",
           "format": "int64"
-        }
-      }
-    },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
-      "type": "object",
-      "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
-          "type": "string"
         },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
+        "tenant": {
+          "description": "The tenant to evaluate, required for classes with multi-tenancy enabled",
           "type": "string"
-        },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
         }
       }
     },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+    "ReferenceMetaClassification": {
+      "description": "This meta field contains additional info about the classified reference property",
       "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
-          }
+        "closestLosingDistance": {
+          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
         },
-        "errors": {
-          "description": "Array with errors.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLError"
-          },
-          "x-omitempty": true
+        "closestOverallDistance": {
+          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
+          "type": "number",
+          "format": "float32"
+        },
+        "closestWinningDistance": {
+          "description": "Closest distance of a neighbor from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "losingCount": {
+          "description": "size of the losing group, can be 0 if the winning group size equals k",
+          "type": "number",
+          "format": "int64"
+        },
+        "losingDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanLosingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanWinningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "overallCount": {
+          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningCount": {
+          "description": "size of the winning group, a number between 1..k",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32"
         }
       }
     },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
-      }
-    },
-    "InvertedIndexConfig": {
-      "description": "Configure the inverted index built into Weaviate",
+    "ReplicaSyncStats": {
+      "description": "Progress of a repair",
       "type": "object",
       "properties": {
-        "bm25": {
-          "$ref": "#/definitions/BM25Config"
+        "bytes": {
+          "description": "Bytes transferred",
+          "type": "integer",
+          "format": "int64"
         },
-        "cleanupIntervalSeconds": {
-          "description": "Asynchronous index clean up happens every n seconds",
-          "type": "number",
-          "format": "int"
+        "conflicts": {
+          "description": "Number of conflicting objects",
+          "type": "integer",
+          "format": "int64"
         },
-        "indexNullState": {
-          "description": "Index each object with the null state",
-          "type": "boolean"
+        "leavesCompared": {
+          "description": "Number of hash tree leaves compared",
+          "type": "integer",
+          "format": "int64"
         },
-        "indexPropertyLength": {
-          "description": "Index length of properties",
-          "type": "boolean"
+        "leavesDiverged": {
+          "description": "Number of hash tree leaves which differ between replicas",
+          "type": "integer",
+          "format": "int64"
         },
-        "indexTimestamps": {
-          "description": "Index each object by its internal timestamps",
-          "type": "boolean"
+        "leavesTotal": {
+          "description": "Number of hash tree leaves to compare",
+          "type": "integer",
+          "format": "int64"
         },
-        "stopwords": {
-          "$ref": "#/definitions/StopwordConfig"
+        "objectsCompared": {
+          "description": "Number of objects compared",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsRepaired": {
+          "description": "Number of objects repaired",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
+    "ReplicationConfig": {
+      "description": "Configure how replication is executed in a cluster",
+      "type": "object",
+      "properties": {
+        "factor": {
+          "description": "Number of times a class is replicated",
+          "type": "integer"
+        }
+      }
     },
-    "Link": {
+    "Role": {
+      "description": "A role bundles permissions and the users and groups they are granted to. Users who are assigned the role by name by the authentication provider are granted the permissions as well.",
       "type": "object",
+      "required": [
+        "permissions"
+      ],
       "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
-          "type": "string"
+        "groups": {
+          "description": "The groups the role is granted to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "href": {
-          "description": "target of the link",
+        "name": {
+          "description": "The name of the role, must start with a letter and contain only letters, numbers, '_' and '-' (max 64 characters)",
           "type": "string"
         },
+        "permissions": {
+          "description": "The permissions of the role, at least one is required",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Permission"
+          }
+        },
+        "users": {
+          "description": "The users the role is granted to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "SQLColumn": {
+      "description": "A column of the result of a SQL query",
+      "type": "object",
+      "properties": {
         "name": {
-          "description": "human readable name of the resource group",
+          "description": "The name of the column",
           "type": "string"
         },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+        "type": {
+          "description": "The data type of the property, \"uuid\" for the id and \"number\" for the distance",
           "type": "string"
         }
       }
     },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
+    "SQLQuery": {
+      "description": "A SELECT query on the objects of a class",
       "type": "object",
+      "required": [
+        "query"
+      ],
       "properties": {
-        "hostname": {
-          "description": "The url of the host.",
-          "type": "string",
-          "format": "url"
-        },
-        "modules": {
-          "description": "Module-specific meta information",
-          "type": "object"
+        "query": {
+          "description": "The query, e.g. SELECT title FROM Article WHERE wordCount \u003e 100 LIMIT 10",
+          "type": "string"
         },
-        "version": {
-          "description": "Version of weaviate you are currently running",
+        "tenant": {
+          "description": "The tenant to query, required for classes with multi-tenancy enabled",
           "type": "string"
         }
       }
     },
-    "MultiTenancyConfig": {
-      "description": "Configuration related to multi-tenancy within a class",
+    "SQLResult": {
+      "description": "The result of a SQL query",
+      "type": "object",
       "properties": {
-        "enabled": {
-          "description": "Whether or not multi-tenancy is enabled for this class",
-          "type": "boolean",
-          "x-omitempty": false
+        "columns": {
+          "description": "The columns of the result",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SQLColumn"
+          }
+        },
+        "rows": {
+          "description": "The rows of the result as arrays of values in the order of the columns",
+          "type": "array",
+          "items": {
+            "type": "array",
+            "items": {}
+          }
         }
       }
     },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
-      }
-    },
-    "NestedProperty": {
+    "Schema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
       "type": "object",
       "properties": {
-        "dataType": {
+        "classes": {
+          "description": "Semantic classes that are available.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/Class"
           }
         },
-        "description": {
-          "type": "string"
-        },
-        "indexFilterable": {
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexSearchable": {
-          "type": "boolean",
-          "x-nullable": true
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
         },
         "name": {
+          "description": "Name of the schema.",
           "type": "string"
-        },
-        "nestedProperties": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NestedProperty"
-          },
-          "x-omitempty": true
-        },
-        "tokenization": {
-          "type": "string",
-          "enum": [
-            "word",
-            "lowercase",
-            "whitespace",
-            "field"
-          ]
         }
       }
     },
-    "NodeShardStatus": {
-      "description": "The definition of a node shard status response body",
+    "SchemaClusterStatus": {
+      "description": "Indicates the health of the schema in a cluster.",
+      "type": "object",
       "properties": {
-        "class": {
-          "description": "The name of shard's class.",
+        "error": {
+          "description": "Contains the sync check error if one occurred",
           "type": "string",
-          "x-omitempty": false
-        },
-        "compressed": {
-          "description": "The status of vector compression/quantization.",
-          "format": "boolean",
-          "x-omitempty": false
+          "x-omitempty": true
         },
-        "name": {
-          "description": "The name of the shard.",
-          "type": "string",
+        "healthy": {
+          "description": "True if the cluster is in sync, false if there is an issue (see error).",
+          "type": "boolean",
           "x-omitempty": false
         },
-        "objectCount": {
-          "description": "The number of objects in shard.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
+        "hostname": {
+          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
+          "type": "string"
         },
-        "vectorIndexingStatus": {
-          "description": "The status of the vector indexing process.",
-          "format": "string",
+        "ignoreSchemaSync": {
+          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
+          "type": "boolean",
           "x-omitempty": false
         },
-        "vectorQueueLength": {
-          "description": "The length of the vector indexing queue.",
+        "nodeCount": {
+          "description": "Number of nodes that participated in the sync check",
           "type": "number",
-          "format": "int64",
-          "x-omitempty": false
+          "format": "int"
         }
       }
     },
-    "NodeStats": {
-      "description": "The summary of Weaviate's statistics.",
-      "properties": {
-        "objectCount": {
-          "description": "The total number of objects in DB.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        },
-        "shardCount": {
-          "description": "The count of Weaviate's shards.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
-        }
-      }
+    "SchemaHistory": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
     },
-    "NodeStatus": {
-      "description": "The definition of a backup node status response body",
+    "SegmentCompressionConfig": {
+      "description": "Configuration of the compression of the segments the objects and indexes of a class are stored in. Cannot be changed after the class was created.",
       "properties": {
-        "batchStats": {
-          "description": "Weaviate batch statistics.",
-          "type": "object",
-          "$ref": "#/definitions/BatchStats"
-        },
-        "gitHash": {
-          "description": "The gitHash of Weaviate.",
+        "inverted": {
+          "description": "Codec the searchable inverted indexes are compressed with on disk, one of 'none', 'zstd' or 'snappy'. Filterable indexes are stored as compressed bitmaps already. Defaults to 'none'.",
           "type": "string"
         },
-        "name": {
-          "description": "The name of the node.",
-          "type": "string"
-        },
-        "shards": {
-          "description": "The list of the shards with it's statistics.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NodeShardStatus"
-          }
-        },
-        "stats": {
-          "description": "Weaviate overall statistics.",
-          "type": "object",
-          "$ref": "#/definitions/NodeStats"
-        },
-        "status": {
-          "description": "Node's status.",
-          "type": "string",
-          "default": "HEALTHY",
-          "enum": [
-            "HEALTHY",
-            "UNHEALTHY",
-            "UNAVAILABLE"
-          ]
-        },
-        "version": {
-          "description": "The version of Weaviate.",
+        "objects": {
+          "description": "Codec the objects are compressed with on disk, one of 'none', 'zstd' or 'snappy'. Defaults to 'none'.",
           "type": "string"
         }
       }
     },
-    "NodesStatusResponse": {
-      "description": "The status of all of the Weaviate nodes",
+    "ShardBalancerStatus": {
+      "description": "Status of the shard balancer and the moves started by this node",
       "type": "object",
       "properties": {
-        "nodes": {
+        "bandwidthLimit": {
+          "description": "Bytes per second moves may use, 0 means no limit",
+          "type": "integer",
+          "format": "int64"
+        },
+        "enabled": {
+          "description": "Whether shards are balanced periodically",
+          "type": "boolean"
+        },
+        "interval": {
+          "description": "How often shards are balanced",
+          "type": "string"
+        },
+        "moves": {
+          "description": "Moves started by this node",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/NodeStatus"
+            "$ref": "#/definitions/ShardMove"
+          }
+        },
+        "replicas": {
+          "description": "Active replicas per node",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
           }
+        },
+        "threshold": {
+          "description": "Difference of the replica counts of nodes from which on replicas are moved",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "Object": {
+    "ShardMove": {
+      "description": "Move of a shard replica to another node",
       "type": "object",
       "properties": {
-        "additional": {
-          "$ref": "#/definitions/AdditionalProperties"
+        "bytes": {
+          "description": "Bytes copied by this node so far",
+          "type": "integer",
+          "format": "int64"
         },
         "class": {
-          "description": "Class of the Object, defined in the schema.",
+          "description": "The class of the shard",
           "type": "string"
         },
-        "creationTimeUnix": {
-          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
+        "error": {
+          "description": "Why the move failed",
+          "type": "string"
         },
-        "id": {
-          "description": "ID of the Object.",
+        "finishedAt": {
+          "description": "When the move finished",
           "type": "string",
-          "format": "uuid"
+          "format": "date-time",
+          "x-nullable": true
         },
-        "lastUpdateTimeUnix": {
-          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
+        "shard": {
+          "description": "Name of the shard",
+          "type": "string"
         },
-        "properties": {
-          "$ref": "#/definitions/PropertySchema"
+        "source": {
+          "description": "The node the replica is moved from",
+          "type": "string"
         },
-        "tenant": {
-          "description": "Name of the Objects tenant.",
+        "startedAt": {
+          "description": "When the move started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "Status of the move",
           "type": "string"
         },
-        "vector": {
-          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
-          "$ref": "#/definitions/C11yVector"
+        "target": {
+          "description": "The node the replica is moved to",
+          "type": "string"
         },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
+        "trigger": {
+          "description": "What started the move",
+          "type": "string"
         }
       }
     },
-    "ObjectsGetResponse": {
+    "ShardStartup": {
+      "description": "Progress of loading a single shard",
       "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Object"
+      "properties": {
+        "class": {
+          "description": "The class of the shard",
+          "type": "string"
         },
-        {
-          "properties": {
-            "deprecations": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Deprecation"
-              }
-            }
-          }
+        "error": {
+          "description": "Why the shard could not be loaded",
+          "type": "string"
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Object.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
-          }
+        "etaSeconds": {
+          "description": "Rough estimate of the time until the shard is loaded",
+          "type": "number",
+          "format": "double"
+        },
+        "finishedAt": {
+          "description": "When the shard finished loading",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "phase": {
+          "description": "The phase of loading the shard while it is LOADING",
+          "type": "string"
+        },
+        "shard": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "When the shard started loading",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        },
+        "vectorIndexLoad": {
+          "description": "Ratio of the commit logs of the vector index replayed so far",
+          "type": "number",
+          "format": "double"
+        },
+        "walReplay": {
+          "description": "Ratio of the write-ahead logs of the LSM stores replayed so far",
+          "type": "number",
+          "format": "double"
         }
-      ]
+      }
     },
-    "ObjectsGetResponseAO2Result": {
-      "description": "Results for this specific Object.",
-      "format": "object",
+    "ShardStatus": {
+      "description": "The status of a single shard",
       "properties": {
-        "errors": {
-          "$ref": "#/definitions/ErrorResponse"
-        },
         "status": {
-          "type": "string",
-          "default": "SUCCESS",
-          "enum": [
-            "SUCCESS",
-            "PENDING",
-            "FAILED"
-          ]
+          "description": "Status of the shard",
+          "type": "string"
         }
       }
     },
-    "ObjectsListResponse": {
-      "description": "List of Objects.",
-      "type": "object",
+    "ShardStatusGetResponse": {
+      "description": "Response body of shard status get request",
       "properties": {
-        "deprecations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Deprecation"
-          }
+        "name": {
+          "description": "Name of the shard",
+          "type": "string"
         },
-        "objects": {
-          "description": "The actual list of Objects.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Object"
-          }
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
         },
-        "totalResults": {
-          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
+        "vectorQueueSize": {
+          "description": "Size of the vector queue of the shard",
           "type": "integer",
-          "format": "int64"
+          "x-omitempty": false
         }
       }
     },
-    "PatchDocumentAction": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
+    "ShardStatusList": {
+      "description": "The status of all the shards of a Class",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ShardStatusGetResponse"
+      }
+    },
+    "SingleRef": {
+      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Object"
+        "beacon": {
+          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri"
         },
-        "op": {
-          "description": "The operation to be performed.",
+        "class": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
           "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
+          "format": "uri"
         },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
+        "classification": {
+          "description": "Additional Meta information about classifications if the item was part of one",
+          "$ref": "#/definitions/ReferenceMetaClassification"
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+        "href": {
+          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+          "type": "string",
+          "format": "uri"
+        },
+        "schema": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
+          "$ref": "#/definitions/PropertySchema"
         }
       }
     },
-    "PatchDocumentObject": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
+    "SlowQueriesResponse": {
+      "description": "The slow queries kept by the log of a node",
+      "type": "object",
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
+        "entries": {
+          "description": "The slow queries, latest first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SlowQuery"
+          }
+        }
+      }
+    },
+    "SlowQuery": {
+      "description": "A query which took longer than the threshold of the slow query log",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class which was queried",
           "type": "string"
         },
-        "merge": {
-          "$ref": "#/definitions/Object"
+        "error": {
+          "description": "Why the query failed",
+          "type": "string"
         },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
+        "params": {
+          "description": "Parameters of the query",
+          "type": "object"
+        },
+        "shards": {
+          "description": "Plans of the shard searches of the query",
+          "type": "array",
+          "items": {
+            "type": "object"
+          },
+          "x-omitempty": true
         },
-        "path": {
-          "description": "A JSON-Pointer.",
+        "tenant": {
+          "description": "The tenant which was queried",
           "type": "string"
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+        "time": {
+          "description": "When the query was received",
+          "type": "string",
+          "format": "date-time"
+        },
+        "tookMs": {
+          "description": "Duration of the query in milliseconds",
+          "type": "number",
+          "format": "double"
+        },
+        "type": {
+          "description": "The kind of query, e.g. a vector or a hybrid search",
+          "type": "string"
         }
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network.",
+    "StandbyNodeStatus": {
+      "description": "Replication status of a single node of the primary",
+      "type": "object",
       "properties": {
-        "id": {
-          "description": "The session ID of the peer.",
+        "error": {
+          "description": "Why the last sync of the node failed",
+          "type": "string"
+        },
+        "lagSeconds": {
+          "description": "How far the standby lags behind the node",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "lastChange": {
+          "description": "Time of the last change applied",
           "type": "string",
-          "format": "uuid"
+          "format": "date-time"
         },
         "name": {
-          "description": "Human readable name.",
-          "type": "string"
-        },
-        "schemaHash": {
-          "description": "The latest known hash of the peer's schema.",
+          "description": "Name of the node",
           "type": "string"
         },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet.",
+        "syncedUntil": {
+          "description": "Time until which all changes of the node are applied, not set until the first sync succeeded",
           "type": "string",
-          "format": "uri"
+          "format": "date-time",
+          "x-nullable": true
         }
       }
     },
-    "PeerUpdateList": {
-      "description": "List of known peers.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/PeerUpdate"
-      }
-    },
-    "PhoneNumber": {
+    "StandbyStatus": {
+      "description": "Status of a standby cluster",
+      "type": "object",
       "properties": {
-        "countryCode": {
-          "description": "Read-only. The numerical country code (e.g. 49)",
-          "type": "number",
-          "format": "uint64"
-        },
-        "defaultCountry": {
-          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
-          "type": "string"
-        },
-        "input": {
-          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
-          "type": "string"
+        "active": {
+          "description": "Whether the standby applies the changes of its primary",
+          "type": "boolean"
         },
-        "internationalFormatted": {
-          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
+        "backend": {
+          "description": "The backup backend the changes of the primary are read from",
           "type": "string"
         },
-        "national": {
-          "description": "Read-only. The numerical representation of the national part",
-          "type": "number",
-          "format": "uint64"
+        "lastSync": {
+          "description": "When the standby last synced",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
         },
-        "nationalFormatted": {
-          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
-          "type": "string"
+        "nodes": {
+          "description": "Replication status of each node of the primary",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/StandbyNodeStatus"
+          }
         },
-        "valid": {
-          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+        "promoted": {
+          "description": "Whether the standby was promoted",
           "type": "boolean"
+        },
+        "promotedAt": {
+          "description": "When the standby was promoted",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
         }
       }
     },
-    "Principal": {
+    "StartupPriority": {
+      "description": "Classes to load first",
       "type": "object",
       "properties": {
-        "groups": {
+        "classes": {
+          "description": "Names of the classes",
           "type": "array",
           "items": {
             "type": "string"
           }
-        },
-        "username": {
-          "description": "The username that was extracted either from the authentication information",
-          "type": "string"
         }
       }
     },
-    "Property": {
+    "StartupProgress": {
+      "description": "Progress of loading the shards of a node",
       "type": "object",
       "properties": {
-        "dataType": {
-          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
+        "etaSeconds": {
+          "description": "Rough estimate of the time until all shards are loaded, based on the average duration of the shards loaded so far",
+          "type": "number",
+          "format": "double"
+        },
+        "failed": {
+          "description": "Number of shards which could not be loaded",
+          "type": "integer",
+          "format": "int64"
+        },
+        "loaded": {
+          "description": "Number of shards which are loaded",
+          "type": "integer",
+          "format": "int64"
+        },
+        "loading": {
+          "description": "Number of shards which are being loaded",
+          "type": "integer",
+          "format": "int64"
+        },
+        "pending": {
+          "description": "Number of shards which are not loaded yet",
+          "type": "integer",
+          "format": "int64"
+        },
+        "priorityClasses": {
+          "description": "Classes which are loaded first",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "description": {
-          "description": "Description of the property.",
-          "type": "string"
-        },
-        "indexFilterable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
+        "shards": {
+          "description": "Progress of each shard",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ShardStartup"
+          }
         },
-        "indexInverted": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
-          "type": "boolean",
-          "x-nullable": true
+        "startedAt": {
+          "description": "When the node started loading its shards",
+          "type": "string",
+          "format": "date-time"
         },
-        "indexSearchable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
+        "startupComplete": {
+          "description": "Whether all shards are loaded",
+          "type": "boolean"
         },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
+        "total": {
+          "description": "Number of shards to load",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "StopwordConfig": {
+      "description": "fine-grained control over stopword list usage",
+      "type": "object",
+      "properties": {
+        "additions": {
+          "description": "stopwords to be considered additionally",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "name": {
-          "description": "Name of the property as URI relative to the schema URL.",
+        "preset": {
+          "description": "pre-existing list of common words by language",
           "type": "string"
         },
-        "nestedProperties": {
-          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+        "removals": {
+          "description": "stopwords to be removed from consideration",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/NestedProperty"
-          },
-          "x-omitempty": true
-        },
-        "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
-          "type": "string",
-          "enum": [
-            "word",
-            "lowercase",
-            "whitespace",
-            "field"
-          ]
+            "type": "string"
+          }
         }
       }
     },
-    "PropertySchema": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ReferenceMetaClassification": {
-      "description": "This meta field contains additional info about the classified reference property",
+    "TemporalPartitioningConfig": {
+      "description": "Configuration to partition the objects of a multi-tenant class by a date property. Every partition is a tenant named after its time range, e.g. '2023-10' for monthly partitions. Partitions are created when the first object is written to them and queries without a tenant only search the partitions matching the range of their filter on the date property. Partitions can be dropped once they are older than the retention.",
       "properties": {
-        "closestLosingDistance": {
-          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "closestOverallDistance": {
-          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
-          "type": "number",
-          "format": "float32"
-        },
-        "closestWinningDistance": {
-          "description": "Closest distance of a neighbor from the winning group",
-          "type": "number",
-          "format": "float32"
-        },
-        "losingCount": {
-          "description": "size of the losing group, can be 0 if the winning group size equals k",
-          "type": "number",
-          "format": "int64"
-        },
-        "losingDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "meanLosingDistance": {
-          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "meanWinningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
+        "interval": {
+          "description": "Time range of a partition, one of 'day', 'month' or 'year'. Defaults to 'month'.",
+          "type": "string"
         },
-        "overallCount": {
-          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
-          "type": "number",
-          "format": "int64"
+        "property": {
+          "description": "Name of the date property the objects are partitioned by.",
+          "type": "string"
         },
-        "winningCount": {
-          "description": "size of the winning group, a number between 1..k",
-          "type": "number",
+        "retentionDays": {
+          "description": "Number of days after the end of its time range after which a partition is dropped with all of its objects. 0 keeps all partitions.",
+          "type": "integer",
           "format": "int64"
+        }
+      }
+    },
+    "Tenant": {
+      "description": "attributes representing a single tenant within weaviate",
+      "type": "object",
+      "properties": {
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `WARM` + "`" + ` - tenant is active, some restrictions are imposed (TBD; not supported yet), ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - as COLD, but files are stored on cloud storage (not supported yet)",
+          "type": "string",
+          "enum": [
+            "HOT",
+            "WARM",
+            "COLD",
+            "FROZEN"
+          ]
         },
-        "winningDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32"
+        "name": {
+          "description": "name of the tenant",
+          "type": "string"
         }
       }
     },
-    "ReplicationConfig": {
-      "description": "Configure how replication is executed in a cluster",
+    "TenantFailure": {
+      "description": "A tenant a tenants job could not change",
       "type": "object",
       "properties": {
-        "factor": {
-          "description": "Number of times a class is replicated",
-          "type": "integer"
+        "error": {
+          "description": "Why the tenant could not be changed",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the tenant",
+          "type": "string"
         }
       }
     },
-    "Schema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
+    "TenantImportResult": {
+      "description": "Summary of the import of a tenant",
       "type": "object",
       "properties": {
-        "classes": {
-          "description": "Semantic classes that are available.",
+        "class": {
+          "description": "The class the tenant was imported into",
+          "type": "string"
+        },
+        "errors": {
+          "description": "Why objects could not be imported",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Class"
+            "type": "string"
           }
         },
-        "maintainer": {
-          "description": "Email of the maintainer.",
-          "type": "string",
-          "format": "email"
+        "failed": {
+          "description": "Number of objects which could not be imported",
+          "type": "integer",
+          "format": "int64"
         },
-        "name": {
-          "description": "Name of the schema.",
+        "imported": {
+          "description": "Number of objects which were imported",
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenant": {
+          "description": "Name of the tenant",
           "type": "string"
         }
       }
     },
-    "SchemaClusterStatus": {
-      "description": "Indicates the health of the schema in a cluster.",
+    "TenantStats": {
+      "description": "Object count and disk usage of a tenant",
       "type": "object",
       "properties": {
-        "error": {
-          "description": "Contains the sync check error if one occurred",
-          "type": "string",
-          "x-omitempty": true
+        "activityStatus": {
+          "description": "Activity status of the tenant",
+          "type": "string"
         },
-        "healthy": {
-          "description": "True if the cluster is in sync, false if there is an issue (see error).",
-          "type": "boolean",
-          "x-omitempty": false
+        "class": {
+          "description": "The class of the tenant",
+          "type": "string"
         },
-        "hostname": {
-          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
+        "diskUsageBytes": {
+          "description": "Size of the files of the tenant on disk",
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastAccess": {
+          "description": "When the tenant was last accessed, if it was accessed since the node started",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "node": {
+          "description": "The node the statistics were collected on",
           "type": "string"
         },
-        "ignoreSchemaSync": {
-          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
-          "type": "boolean",
-          "x-omitempty": false
+        "objectCount": {
+          "description": "Number of objects of the tenant",
+          "type": "integer",
+          "format": "int64"
         },
-        "nodeCount": {
-          "description": "Number of nodes that participated in the sync check",
-          "type": "number",
-          "format": "int"
-        }
-      }
-    },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ShardStatus": {
-      "description": "The status of a single shard",
-      "properties": {
-        "status": {
-          "description": "Status of the shard",
+        "tenant": {
+          "description": "Name of the tenant",
           "type": "string"
+        },
+        "vectorIndexBytes": {
+          "description": "Size of the vector index of the tenant on disk",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "ShardStatusGetResponse": {
-      "description": "Response body of shard status get request",
+    "TenantsJob": {
+      "description": "Progress of a tenants job",
+      "type": "object",
       "properties": {
-        "name": {
-          "description": "Name of the shard",
+        "class": {
+          "description": "The class the job changes tenants of",
           "type": "string"
         },
-        "status": {
-          "description": "Status of the shard",
+        "completedAt": {
+          "description": "When the job finished",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "failed": {
+          "description": "Tenants which could not be changed",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TenantFailure"
+          }
+        },
+        "id": {
+          "description": "The id of the job",
           "type": "string"
         },
-        "vectorQueueSize": {
-          "description": "Size of the vector queue of the shard",
+        "operation": {
+          "description": "The change the job applies to the tenants",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "When the job was started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "Status of the job",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "FINISHED"
+          ]
+        },
+        "succeeded": {
+          "description": "Number of tenants which were changed",
           "type": "integer",
-          "x-omitempty": false
+          "format": "int64"
+        },
+        "total": {
+          "description": "Number of tenants the job changes",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "ShardStatusList": {
-      "description": "The status of all the shards of a Class",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/ShardStatusGetResponse"
-      }
-    },
-    "SingleRef": {
-      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+    "TenantsJobRequest": {
+      "description": "Changes many tenants of a class at once",
+      "type": "object",
       "properties": {
-        "beacon": {
-          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+        "operation": {
+          "description": "The change to apply to the tenants",
           "type": "string",
-          "format": "uri"
+          "enum": [
+            "create",
+            "activate",
+            "deactivate",
+            "delete"
+          ]
         },
+        "tenants": {
+          "description": "Names of the tenants to change",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "TransactionOperation": {
+      "description": "A single write of a transaction. Create and update take the object, delete and the reference changes the class, id and tenant of the object they change.",
+      "type": "object",
+      "required": [
+        "op"
+      ],
+      "properties": {
         "class": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
+          "description": "The class of the changed object",
+          "type": "string"
+        },
+        "id": {
+          "description": "The id of the changed object",
           "type": "string",
-          "format": "uri"
+          "format": "uuid"
         },
-        "classification": {
-          "description": "Additional Meta information about classifications if the item was part of one",
-          "$ref": "#/definitions/ReferenceMetaClassification"
+        "object": {
+          "$ref": "#/definitions/Object"
         },
-        "href": {
-          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+        "op": {
+          "description": "The kind of write",
           "type": "string",
-          "format": "uri"
+          "enum": [
+            "create",
+            "update",
+            "delete",
+            "add_reference",
+            "delete_reference"
+          ]
         },
-        "schema": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
-          "$ref": "#/definitions/PropertySchema"
+        "property": {
+          "description": "The reference property which is changed",
+          "type": "string"
+        },
+        "reference": {
+          "$ref": "#/definitions/SingleRef"
+        },
+        "tenant": {
+          "description": "The tenant of the changed object",
+          "type": "string"
         }
       }
     },
-    "StopwordConfig": {
-      "description": "fine-grained control over stopword list usage",
+    "TransactionRequest": {
+      "description": "Object writes which are applied all-or-nothing, at most 100 per transaction",
       "type": "object",
+      "required": [
+        "operations"
+      ],
       "properties": {
-        "additions": {
-          "description": "stopwords to be considered additionally",
+        "consistencyLevel": {
+          "description": "Determines how many replicas must acknowledge the writes before they are considered successful",
+          "type": "string"
+        },
+        "operations": {
+          "description": "The writes in the order they are applied",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/TransactionOperation"
           }
+        }
+      }
+    },
+    "TransactionResult": {
+      "description": "The result of an applied transaction",
+      "type": "object",
+      "properties": {
+        "atomic": {
+          "description": "Whether all operations fell on one shard and were applied atomically",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "preset": {
-          "description": "pre-existing list of common words by language",
-          "type": "string"
-        },
-        "removals": {
-          "description": "stopwords to be removed from consideration",
+        "objects": {
+          "description": "The created or updated object of each operation, null for deletes and reference changes",
           "type": "array",
           "items": {
-            "type": "string"
+            "x-nullable": true,
+            "$ref": "#/definitions/Object"
           }
         }
       }
     },
-    "Tenant": {
-      "description": "attributes representing a single tenant within weaviate",
+    "UploadedObject": {
+      "description": "The object created from an uploaded file, or why it could not be created",
       "type": "object",
       "properties": {
-        "activityStatus": {
-          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `WARM` + "`" + ` - tenant is active, some restrictions are imposed (TBD; not supported yet), ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - as COLD, but files are stored on cloud storage (not supported yet)",
-          "type": "string",
-          "enum": [
-            "HOT",
-            "WARM",
-            "COLD",
-            "FROZEN"
-          ]
+        "error": {
+          "description": "Why the object could not be created",
+          "type": "string"
         },
-        "name": {
-          "description": "name of the tenant",
+        "file": {
+          "description": "The name of the file",
           "type": "string"
+        },
+        "id": {
+          "description": "The id of the created object",
+          "type": "string",
+          "format": "uuid"
         }
       }
     },
@@ -9849,6 +21651,21 @@ func init() {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
     },
+    "VersioningConfig": {
+      "description": "Configuration related to the version history of the objects of a class",
+      "properties": {
+        "enabled": {
+          "description": "Whether or not previous versions of the objects of this class are kept. Can not be changed after the class was created.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "maxVersions": {
+          "description": "Number of versions kept per object, including the current one. Older versions are removed on every change of the object. Defaults to 10.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "WhereFilter": {
       "description": "Filter search results using a where filter",
       "type": "object",
@@ -9876,7 +21693,10 @@ func init() {
             "WithinGeoRange",
             "IsNull",
             "ContainsAny",
-            "ContainsAll"
+            "ContainsAll",
+            "WithinGeoPolygon",
+            "WithinGeoBoundingBox",
+            "IsMissing"
           ],
           "example": "GreaterThanEqual"
         },
@@ -9927,6 +21747,18 @@ func init() {
           "x-omitempty": true,
           "example": "TODO"
         },
+        "valueGeoBoundingBox": {
+          "description": "value as the corners of a box",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoBoundingBox"
+        },
+        "valueGeoPolygon": {
+          "description": "value as the outline of an area",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoPolygon"
+        },
         "valueGeoRange": {
           "description": "value as geo coordinates and distance",
           "type": "object",
@@ -10009,6 +21841,32 @@ func init() {
         }
       }
     },
+    "WhereFilterGeoBoundingBox": {
+      "description": "filter within a box spanned by its north-west and south-east corners",
+      "type": "object",
+      "properties": {
+        "bottomRight": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        },
+        "topLeft": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    },
+    "WhereFilterGeoPolygon": {
+      "description": "filter within the outline of an area, the polygon is implicitly closed",
+      "type": "object",
+      "properties": {
+        "points": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GeoCoordinates"
+          }
+        }
+      }
+    },
     "WhereFilterGeoRange": {
       "description": "filter within a distance of a georange",
       "type": "object",
@@ -10057,6 +21915,19 @@ func init() {
       "name": "consistency_level",
       "in": "query"
     },
+    "CommonFieldsParameterQuery": {
+      "type": "string",
+      "description": "Comma separated names of the properties to return, all properties are returned if not set. Vectors are only returned if they are included with the include parameter.",
+      "name": "fields",
+      "in": "query"
+    },
+    "CommonIdempotencyKeyParameterHeader": {
+      "maxLength": 255,
+      "type": "string",
+      "description": "Makes retries of the request safe. The response to the first request with a key is kept and returned to retries with the same key and body instead of writing the objects again.",
+      "name": "Idempotency-Key",
+      "in": "header"
+    },
     "CommonIncludeParameterQuery": {
       "type": "string",
       "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
@@ -10148,6 +22019,26 @@ func init() {
     {
       "description": "These operations enable manipulation of the schema in Weaviate schema.",
       "name": "schema"
+    },
+    {
+      "description": "Answers SQL queries on the objects of a class for analytics tools. The same queries are served with the Postgres wire protocol if its port is set.",
+      "name": "sql"
+    },
+    {
+      "description": "Operations on the modules of the instance, like their credentials.",
+      "name": "modules"
+    },
+    {
+      "description": "Answers questions from the objects of a class in one call.",
+      "name": "ask"
+    },
+    {
+      "description": "Manages the roles of role based access control. Roles are the same on every node of the cluster.",
+      "name": "authz"
+    },
+    {
+      "description": "Manages API keys at runtime. Keys are valid on every node of the cluster and can be rotated without changing the configuration.",
+      "name": "apikeys"
     }
   ],
   "externalDocs": {
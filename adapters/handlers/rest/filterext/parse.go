@@ -141,10 +141,16 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorOr, nil
 	case models.WhereFilterOperatorIsNull:
 		return filters.OperatorIsNull, nil
+	case models.WhereFilterOperatorIsMissing:
+		return filters.OperatorIsMissing, nil
 	case models.WhereFilterOperatorContainsAny:
 		return filters.ContainsAny, nil
 	case models.WhereFilterOperatorContainsAll:
 		return filters.ContainsAll, nil
+	case models.WhereFilterOperatorWithinGeoPolygon:
+		return filters.OperatorWithinGeoPolygon, nil
+	case models.WhereFilterOperatorWithinGeoBoundingBox:
+		return filters.OperatorWithinGeoBoundingBox, nil
 	default:
 		return -1, fmt.Errorf("unrecognized operator: %s", in)
 	}
@@ -171,6 +177,8 @@ func allValuesNil(in *models.WhereFilter) bool {
 		in.ValueInt == nil &&
 		in.ValueNumber == nil &&
 		in.ValueGeoRange == nil &&
+		in.ValueGeoPolygon == nil &&
+		in.ValueGeoBoundingBox == nil &&
 		len(in.ValueBooleanArray) == 0 &&
 		len(in.ValueDateArray) == 0 &&
 		len(in.ValueStringArray) == 0 &&
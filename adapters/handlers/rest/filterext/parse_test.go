@@ -156,6 +156,62 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				}},
 			},
+			{
+				name: "valid geo polygon filter",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoPolygon",
+					ValueGeoPolygon: &models.WhereFilterGeoPolygon{
+						Points: []*models.GeoCoordinates{
+							{Latitude: ptFloat32(0.5), Longitude: ptFloat32(0.6)},
+							{Latitude: ptFloat32(1.5), Longitude: ptFloat32(0.6)},
+							{Latitude: ptFloat32(1.0), Longitude: ptFloat32(1.6)},
+						},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorWithinGeoPolygon,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("geoField"),
+					},
+					Value: &filters.Value{
+						Value: filters.GeoPolygon{
+							Points: []models.GeoCoordinates{
+								{Latitude: ptFloat32(0.5), Longitude: ptFloat32(0.6)},
+								{Latitude: ptFloat32(1.5), Longitude: ptFloat32(0.6)},
+								{Latitude: ptFloat32(1.0), Longitude: ptFloat32(1.6)},
+							},
+						},
+						Type: schema.DataTypeGeoCoordinates,
+					},
+				}},
+			},
+			{
+				name: "valid geo bounding box filter",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoBoundingBox",
+					ValueGeoBoundingBox: &models.WhereFilterGeoBoundingBox{
+						TopLeft:     &models.GeoCoordinates{Latitude: ptFloat32(1.5), Longitude: ptFloat32(0.5)},
+						BottomRight: &models.GeoCoordinates{Latitude: ptFloat32(0.5), Longitude: ptFloat32(1.5)},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorWithinGeoBoundingBox,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("geoField"),
+					},
+					Value: &filters.Value{
+						Value: filters.GeoBoundingBox{
+							TopLeft:     &models.GeoCoordinates{Latitude: ptFloat32(1.5), Longitude: ptFloat32(0.5)},
+							BottomRight: &models.GeoCoordinates{Latitude: ptFloat32(0.5), Longitude: ptFloat32(1.5)},
+						},
+						Type: schema.DataTypeGeoCoordinates,
+					},
+				}},
+			},
 			{
 				name: "[deprecated string] valid string filter",
 				input: &models.WhereFilter{
@@ -235,6 +291,33 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				expectedErr: fmt.Errorf("invalid where filter: valueGeoRange: " +
 					"field 'distance.max' must be a positive number"),
 			},
+			{
+				name: "geo polygon with too few points",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoPolygon",
+					ValueGeoPolygon: &models.WhereFilterGeoPolygon{
+						Points: []*models.GeoCoordinates{
+							{Latitude: ptFloat32(0.5), Longitude: ptFloat32(0.6)},
+							{Latitude: ptFloat32(1.5), Longitude: ptFloat32(0.6)},
+						},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueGeoPolygon: " +
+					"a polygon needs at least 3 points, got 2"),
+			},
+			{
+				name: "geo bounding box missing corner",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoBoundingBox",
+					ValueGeoBoundingBox: &models.WhereFilterGeoBoundingBox{
+						TopLeft: &models.GeoCoordinates{Latitude: ptFloat32(1.5), Longitude: ptFloat32(0.5)},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueGeoBoundingBox: " +
+					"bottomRight must be set"),
+			},
 			{
 				name: "and operator and path set",
 				input: &models.WhereFilter{
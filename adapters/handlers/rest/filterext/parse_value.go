@@ -162,6 +162,46 @@ var valueExtractors = []valueExtractorFunc{
 			},
 		}, schema.DataTypeGeoCoordinates), nil
 	},
+	// geo polygon
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueGeoPolygon == nil {
+			return nil, nil
+		}
+
+		points := make([]models.GeoCoordinates, len(in.ValueGeoPolygon.Points))
+		for i, point := range in.ValueGeoPolygon.Points {
+			if point == nil {
+				return nil, fmt.Errorf("valueGeoPolygon: point at position %d must be set", i)
+			}
+			points[i] = models.GeoCoordinates{
+				Latitude:  point.Latitude,
+				Longitude: point.Longitude,
+			}
+		}
+
+		polygon := filters.GeoPolygon{Points: points}
+		if err := polygon.Validate(); err != nil {
+			return nil, fmt.Errorf("valueGeoPolygon: %w", err)
+		}
+
+		return valueFilter(polygon, schema.DataTypeGeoCoordinates), nil
+	},
+	// geo bounding box
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueGeoBoundingBox == nil {
+			return nil, nil
+		}
+
+		box := filters.GeoBoundingBox{
+			TopLeft:     in.ValueGeoBoundingBox.TopLeft,
+			BottomRight: in.ValueGeoBoundingBox.BottomRight,
+		}
+		if err := box.Validate(); err != nil {
+			return nil, fmt.Errorf("valueGeoBoundingBox: %w", err)
+		}
+
+		return valueFilter(box, schema.DataTypeGeoCoordinates), nil
+	},
 	// deprecated string
 	func(in *models.WhereFilter) (*filters.Value, error) {
 		if in.ValueString == nil {
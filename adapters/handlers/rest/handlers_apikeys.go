@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/apikeys"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+var errAPIKeyManagementDisabled = fmt.Errorf("api key management is not enabled")
+
+// apiKeysHandlers serve the management of API keys at runtime. The keys are
+// stored in the metadata of the schema, so they are valid on every node.
+type apiKeysHandlers struct {
+	authorizer authorization.Authorizer
+	keys       *apikey.KeyStore
+}
+
+func (h *apiKeysHandlers) list(params apikeys.KeysListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "list", authorization.APIKeys("")); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return apikeys.NewKeysListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return apikeys.NewKeysListInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.keys == nil {
+		return apikeys.NewKeysListUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errAPIKeyManagementDisabled))
+	}
+
+	keys := h.keys.List()
+	out := make([]*models.APIKey, len(keys))
+	for i, key := range keys {
+		out[i] = apiKeyToModel(key)
+	}
+	return apikeys.NewKeysListOK().WithPayload(out)
+}
+
+func (h *apiKeysHandlers) create(params apikeys.KeysCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "create", authorization.APIKeys("")); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return apikeys.NewKeysCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return apikeys.NewKeysCreateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.keys == nil {
+		return apikeys.NewKeysCreateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errAPIKeyManagementDisabled))
+	}
+
+	req := apikey.CreateRequest{User: *params.Body.User}
+	if params.Body.Scopes != nil {
+		req.Scopes = apikey.Scopes{
+			Classes: params.Body.Scopes.Classes,
+			Tenants: params.Body.Scopes.Tenants,
+			Verbs:   params.Body.Scopes.Verbs,
+		}
+	}
+	if params.Body.ExpiresAt != nil {
+		expiresAt := time.Time(*params.Body.ExpiresAt)
+		req.ExpiresAt = &expiresAt
+	}
+
+	key, token, err := h.keys.Create(params.HTTPRequest.Context(), req)
+	if err != nil {
+		return apikeys.NewKeysCreateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return apikeys.NewKeysCreateOK().WithPayload(&models.APIKeyCreated{
+		APIKey: *apiKeyToModel(key),
+		Key:    token,
+	})
+}
+
+func (h *apiKeysHandlers) get(params apikeys.KeysGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", authorization.APIKeys(params.ID)); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return apikeys.NewKeysGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return apikeys.NewKeysGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.keys == nil {
+		return apikeys.NewKeysGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errAPIKeyManagementDisabled))
+	}
+
+	key, err := h.keys.Get(params.ID)
+	if err != nil {
+		return apikeys.NewKeysGetNotFound().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return apikeys.NewKeysGetOK().WithPayload(apiKeyToModel(key))
+}
+
+func (h *apiKeysHandlers) revoke(params apikeys.KeysRevokeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "delete", authorization.APIKeys(params.ID)); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return apikeys.NewKeysRevokeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return apikeys.NewKeysRevokeInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.keys == nil {
+		return apikeys.NewKeysRevokeUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errAPIKeyManagementDisabled))
+	}
+
+	if err := h.keys.Revoke(params.HTTPRequest.Context(), params.ID); err != nil {
+		if errors.Is(err, apikey.ErrKeyNotFound) {
+			return apikeys.NewKeysRevokeNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return apikeys.NewKeysRevokeInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return apikeys.NewKeysRevokeNoContent()
+}
+
+func apiKeyToModel(key apikey.Key) *models.APIKey {
+	m := &models.APIKey{
+		ID:   key.ID,
+		User: key.User,
+		Scopes: &models.APIKeyScopes{
+			Classes: key.Scopes.Classes,
+			Tenants: key.Scopes.Tenants,
+			Verbs:   key.Scopes.Verbs,
+		},
+		CreatedAt: strfmt.DateTime(key.CreatedAt),
+	}
+	if key.ExpiresAt != nil {
+		expiresAt := strfmt.DateTime(*key.ExpiresAt)
+		m.ExpiresAt = &expiresAt
+	}
+	return m
+}
+
+func setupAPIKeysHandlers(api *operations.WeaviateAPI,
+	authorizer authorization.Authorizer, keys *apikey.KeyStore,
+) {
+	h := &apiKeysHandlers{authorizer: authorizer, keys: keys}
+
+	api.ApikeysKeysListHandler = apikeys.KeysListHandlerFunc(h.list)
+	api.ApikeysKeysCreateHandler = apikeys.KeysCreateHandlerFunc(h.create)
+	api.ApikeysKeysGetHandler = apikeys.KeysGetHandlerFunc(h.get)
+	api.ApikeysKeysRevokeHandler = apikeys.KeysRevokeHandlerFunc(h.revoke)
+}
@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/ask"
+	"github.com/weaviate/weaviate/entities/models"
+	uask "github.com/weaviate/weaviate/usecases/ask"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// askHandlers answer questions from the objects of a class in one call. The
+// objects are retrieved with a hybrid search, reranked if rerank is set and
+// the answer is generated from them.
+type askHandlers struct {
+	pipeline *uask.Pipeline
+}
+
+func (h *askHandlers) answer(params ask.AskAnswerParams,
+	principal *models.Principal,
+) middleware.Responder {
+	answer, err := h.pipeline.Ask(params.HTTPRequest.Context(), principal,
+		askRequestFromModel(params.Body))
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.Is(err, uask.ErrInvalidRequest):
+			return ask.NewAskAnswerUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &forbidden):
+			return ask.NewAskAnswerForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return ask.NewAskAnswerInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return ask.NewAskAnswerOK().WithPayload(askAnswerToModel(answer))
+}
+
+func askRequestFromModel(body *models.AskRequest) uask.Request {
+	req := uask.Request{
+		Class:      *body.Class,
+		Tenant:     body.Tenant,
+		Question:   *body.Question,
+		Properties: body.Properties,
+		Limit:      int(body.Limit),
+		Alpha:      body.Alpha,
+	}
+	if body.Rerank != nil {
+		req.Rerank = &uask.Rerank{
+			Property: *body.Rerank.Property,
+			Query:    body.Rerank.Query,
+			Limit:    int(body.Rerank.Limit),
+		}
+	}
+	return req
+}
+
+func askAnswerToModel(answer *uask.Answer) *models.AskAnswer {
+	ms := func(d time.Duration) float64 { return float64(d.Microseconds()) / 1000 }
+
+	citations := make([]*models.AskCitation, len(answer.Citations))
+	for i, c := range answer.Citations {
+		citations[i] = &models.AskCitation{
+			Source: int64(c.Source),
+			ID:     c.ID,
+			Start:  int64(c.Start),
+			End:    int64(c.End),
+		}
+	}
+	sources := make([]*models.AskSource, len(answer.Sources))
+	for i, s := range answer.Sources {
+		sources[i] = &models.AskSource{
+			ID:          s.ID,
+			Class:       s.Class,
+			Score:       s.Score,
+			RerankScore: s.RerankScore,
+			Properties:  s.Properties,
+		}
+	}
+
+	return &models.AskAnswer{
+		Answer:    answer.Answer,
+		Citations: citations,
+		Sources:   sources,
+		Timings: &models.AskTimings{
+			RetrieveMs: ms(answer.Timings.Retrieve),
+			RerankMs:   ms(answer.Timings.Rerank),
+			GenerateMs: ms(answer.Timings.Generate),
+			TotalMs:    ms(answer.Timings.Total),
+		},
+	}
+}
+
+func setupAskHandlers(api *operations.WeaviateAPI, pipeline *uask.Pipeline) {
+	h := &askHandlers{pipeline: pipeline}
+
+	api.AskAskAnswerHandler = ask.AskAnswerHandlerFunc(h.answer)
+}
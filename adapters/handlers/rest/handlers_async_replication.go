@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/antientropy"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+var errAsyncReplicationUnavailable = fmt.Errorf("async replication is not available")
+
+// asyncReplicationHandlers serve the repair of replicated shards
+type asyncReplicationHandlers struct {
+	authorizer authorization.Authorizer
+	manager    *antientropy.Manager
+}
+
+func (h *asyncReplicationHandlers) getStatus(params replication.ReplicationAsyncGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", authorization.AsyncReplication()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return replication.NewReplicationAsyncGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return replication.NewReplicationAsyncGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.manager == nil {
+		return replication.NewReplicationAsyncGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errAsyncReplicationUnavailable))
+	}
+
+	return replication.NewReplicationAsyncGetOK().
+		WithPayload(asyncReplicationStatusToModel(h.manager.Status()))
+}
+
+func (h *asyncReplicationHandlers) repair(params replication.ReplicationAsyncRepairParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "update", authorization.AsyncReplication()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return replication.NewReplicationAsyncRepairForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return replication.NewReplicationAsyncRepairInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.manager == nil {
+		return replication.NewReplicationAsyncRepairUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errAsyncReplicationUnavailable))
+	}
+
+	jobs, err := h.manager.Trigger(*params.Body.Class, params.Body.Shard)
+	if err != nil {
+		switch {
+		case errors.Is(err, antientropy.ErrNotFound):
+			return replication.NewReplicationAsyncRepairNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, antientropy.ErrNotReplicated):
+			return replication.NewReplicationAsyncRepairUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, antientropy.ErrRunning):
+			return replication.NewReplicationAsyncRepairConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationAsyncRepairInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := make([]*models.AsyncReplicationJob, len(jobs))
+	for i, job := range jobs {
+		payload[i] = asyncReplicationJobToModel(job)
+	}
+	return replication.NewReplicationAsyncRepairAccepted().WithPayload(payload)
+}
+
+func (h *asyncReplicationHandlers) updateBandwidth(params replication.ReplicationAsyncBandwidthUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "update", authorization.AsyncReplication()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return replication.NewReplicationAsyncBandwidthUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return replication.NewReplicationAsyncBandwidthUpdateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.manager == nil {
+		return replication.NewReplicationAsyncBandwidthUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errAsyncReplicationUnavailable))
+	}
+
+	if err := h.manager.SetBandwidthLimit(*params.Body.BytesPerSecond); err != nil {
+		return replication.NewReplicationAsyncBandwidthUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	return replication.NewReplicationAsyncBandwidthUpdateOK().
+		WithPayload(asyncReplicationStatusToModel(h.manager.Status()))
+}
+
+func asyncReplicationStatusToModel(status antientropy.Status) *models.AsyncReplicationStatus {
+	out := &models.AsyncReplicationStatus{
+		Enabled:            status.Enabled,
+		Interval:           status.Interval,
+		HashTreeDepth:      int64(status.HashTreeDepth),
+		BandwidthLimit:     status.BandwidthLimit,
+		ConflictResolution: status.ConflictResolution,
+		Jobs:               make([]*models.AsyncReplicationJob, len(status.Jobs)),
+	}
+	for i, job := range status.Jobs {
+		out.Jobs[i] = asyncReplicationJobToModel(job)
+	}
+	return out
+}
+
+func asyncReplicationJobToModel(job antientropy.Job) *models.AsyncReplicationJob {
+	out := &models.AsyncReplicationJob{
+		Class:     job.Class,
+		Shard:     job.Shard,
+		Trigger:   job.Trigger,
+		Status:    job.Status,
+		Error:     job.Error,
+		StartedAt: strfmt.DateTime(job.StartedAt),
+		Progress: &models.ReplicaSyncStats{
+			LeavesTotal:     job.Progress.LeavesTotal,
+			LeavesCompared:  job.Progress.LeavesCompared,
+			LeavesDiverged:  job.Progress.LeavesDiverged,
+			ObjectsCompared: job.Progress.ObjectsCompared,
+			ObjectsRepaired: job.Progress.ObjectsRepaired,
+			Conflicts:       job.Progress.Conflicts,
+			Bytes:           job.Progress.Bytes,
+		},
+	}
+	if job.FinishedAt != nil {
+		finishedAt := strfmt.DateTime(*job.FinishedAt)
+		out.FinishedAt = &finishedAt
+	}
+	return out
+}
+
+func setupAsyncReplicationHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer,
+	manager *antientropy.Manager,
+) {
+	h := &asyncReplicationHandlers{authorizer: authorizer, manager: manager}
+
+	api.ReplicationReplicationAsyncGetHandler = replication.
+		ReplicationAsyncGetHandlerFunc(h.getStatus)
+	api.ReplicationReplicationAsyncRepairHandler = replication.
+		ReplicationAsyncRepairHandlerFunc(h.repair)
+	api.ReplicationReplicationAsyncBandwidthUpdateHandler = replication.
+		ReplicationAsyncBandwidthUpdateHandlerFunc(h.updateBandwidth)
+}
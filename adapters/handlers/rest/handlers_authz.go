@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+)
+
+var errRBACDisabled = fmt.Errorf("role based access control is not enabled")
+
+// authzHandlers serve the management of rbac roles. The roles are stored in
+// the metadata of the schema, so they are the same on every node.
+type authzHandlers struct {
+	authorizer authorization.Authorizer
+	roles      *rbac.Store
+}
+
+func (h *authzHandlers) list(params authz.RolesListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "list", authorization.Roles("")); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return authz.NewRolesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return authz.NewRolesListInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.roles == nil {
+		return authz.NewRolesListUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errRBACDisabled))
+	}
+
+	roles := h.roles.List()
+	out := make([]*models.Role, len(roles))
+	for i, role := range roles {
+		out[i] = roleToModel(role)
+	}
+	return authz.NewRolesListOK().WithPayload(out)
+}
+
+func (h *authzHandlers) create(params authz.RolesCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "create", authorization.Roles(params.Body.Name)); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return authz.NewRolesCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return authz.NewRolesCreateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.roles == nil {
+		return authz.NewRolesCreateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errRBACDisabled))
+	}
+
+	role := roleFromModel(params.Body)
+	if err := h.roles.Create(params.HTTPRequest.Context(), role); err != nil {
+		if errors.Is(err, rbac.ErrRoleAlreadyExists) {
+			return authz.NewRolesCreateConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return authz.NewRolesCreateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return authz.NewRolesCreateOK().WithPayload(roleToModel(role))
+}
+
+func (h *authzHandlers) get(params authz.RolesGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", authorization.Roles(params.Name)); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return authz.NewRolesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return authz.NewRolesGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.roles == nil {
+		return authz.NewRolesGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errRBACDisabled))
+	}
+
+	role, err := h.roles.Get(params.Name)
+	if err != nil {
+		return authz.NewRolesGetNotFound().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return authz.NewRolesGetOK().WithPayload(roleToModel(role))
+}
+
+func (h *authzHandlers) update(params authz.RolesUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "update", authorization.Roles(params.Name)); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return authz.NewRolesUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return authz.NewRolesUpdateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.roles == nil {
+		return authz.NewRolesUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errRBACDisabled))
+	}
+
+	role := roleFromModel(params.Body)
+	if role.Name == "" {
+		role.Name = params.Name
+	}
+	if role.Name != params.Name {
+		return authz.NewRolesUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf(
+				"role name %q does not match name %q in path", role.Name, params.Name)))
+	}
+
+	if err := h.roles.Update(params.HTTPRequest.Context(), role); err != nil {
+		if errors.Is(err, rbac.ErrRoleNotFound) {
+			return authz.NewRolesUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return authz.NewRolesUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return authz.NewRolesUpdateOK().WithPayload(roleToModel(role))
+}
+
+func (h *authzHandlers) delete(params authz.RolesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "delete", authorization.Roles(params.Name)); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return authz.NewRolesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return authz.NewRolesDeleteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.roles == nil {
+		return authz.NewRolesDeleteUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errRBACDisabled))
+	}
+
+	if err := h.roles.Delete(params.HTTPRequest.Context(), params.Name); err != nil {
+		if errors.Is(err, rbac.ErrRoleNotFound) {
+			return authz.NewRolesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return authz.NewRolesDeleteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return authz.NewRolesDeleteNoContent()
+}
+
+func roleFromModel(m *models.Role) rbac.Role {
+	role := rbac.Role{
+		Name:        m.Name,
+		Permissions: make([]rbac.Permission, len(m.Permissions)),
+		Users:       m.Users,
+		Groups:      m.Groups,
+	}
+	for i, p := range m.Permissions {
+		role.Permissions[i] = rbac.Permission{
+			Action:     *p.Action,
+			Collection: p.Collection,
+			Tenant:     p.Tenant,
+		}
+	}
+	return role
+}
+
+func roleToModel(role rbac.Role) *models.Role {
+	m := &models.Role{
+		Name:        role.Name,
+		Permissions: make([]*models.Permission, len(role.Permissions)),
+		Users:       role.Users,
+		Groups:      role.Groups,
+	}
+	for i, p := range role.Permissions {
+		action := p.Action
+		m.Permissions[i] = &models.Permission{
+			Action:     &action,
+			Collection: p.Collection,
+			Tenant:     p.Tenant,
+		}
+	}
+	return m
+}
+
+func setupAuthzHandlers(api *operations.WeaviateAPI,
+	authorizer authorization.Authorizer, roles *rbac.Store,
+) {
+	h := &authzHandlers{authorizer: authorizer, roles: roles}
+
+	api.AuthzRolesListHandler = authz.RolesListHandlerFunc(h.list)
+	api.AuthzRolesCreateHandler = authz.RolesCreateHandlerFunc(h.create)
+	api.AuthzRolesGetHandler = authz.RolesGetHandlerFunc(h.get)
+	api.AuthzRolesUpdateHandler = authz.RolesUpdateHandlerFunc(h.update)
+	api.AuthzRolesDeleteHandler = authz.RolesDeleteHandlerFunc(h.delete)
+}
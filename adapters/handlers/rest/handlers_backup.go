@@ -12,6 +12,9 @@
 package rest
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
@@ -95,11 +98,22 @@ func (s *backupHandlers) restoreBackup(params backups.BackupsRestoreParams,
 	principal *models.Principal,
 ) middleware.Responder {
 	req := ubak.BackupRequest{
-		ID:          params.ID,
-		Backend:     params.Backend,
-		Include:     params.Body.Include,
-		Exclude:     params.Body.Exclude,
-		NodeMapping: params.Body.NodeMapping,
+		ID:           params.ID,
+		Backend:      params.Backend,
+		Include:      params.Body.Include,
+		Exclude:      params.Body.Exclude,
+		NodeMapping:  params.Body.NodeMapping,
+		ClassMapping: params.Body.ClassMapping,
+		Tenants:      params.Body.Tenants,
+	}
+	if params.Body.PointInTime != "" {
+		pit, err := time.Parse(time.RFC3339, params.Body.PointInTime)
+		if err != nil {
+			s.metricRequestsTotal.logUserError("")
+			return backups.NewBackupsRestoreUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("parse point in time: %w", err)))
+		}
+		req.PointInTime = pit
 	}
 	meta, err := s.manager.Restore(params.HTTPRequest.Context(), principal, &req)
 	if err != nil {
@@ -13,26 +13,45 @@ package rest
 
 import (
 	"errors"
+	"net/http"
+	"strconv"
 
+	"github.com/go-openapi/runtime"
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/entities/verbosity"
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/backpressure"
+	"github.com/weaviate/weaviate/usecases/ingest"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/quota"
+)
+
+const (
+	batchQueueDepthHeader    = "X-Weaviate-Batch-Queue-Depth"
+	batchSuggestedSizeHeader = "X-Weaviate-Batch-Suggested-Size"
 )
 
 type batchObjectHandlers struct {
 	manager             *objects.BatchManager
+	backpressure        *backpressure.Controller
 	metricRequestsTotal restApiRequestsTotal
 }
 
 func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 	principal *models.Principal,
+) middleware.Responder {
+	return h.withBatchHints(h.addObjectsResponse(params, principal))
+}
+
+func (h *batchObjectHandlers) addObjectsResponse(params batch.BatchObjectsCreateParams,
+	principal *models.Principal,
 ) middleware.Responder {
 	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
 	if err != nil {
@@ -46,10 +65,22 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		switch err.(type) {
-		case autherrs.Forbidden:
+		case autherrs.Forbidden, quota.ErrQuotaExceeded:
 			return batch.NewBatchObjectsCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
-		case objects.ErrInvalidUserInput:
+		case quota.ErrRateLimited:
+			return newTooManyRequests(err)
+		case backpressure.ErrOverloaded:
+			return &tooManyRequests{
+				payload:    errPayloadFromSingleErr(err),
+				retryAfter: err.(backpressure.ErrOverloaded).RetryAfter,
+			}
+		case ingest.ErrQueueFull:
+			return &tooManyRequests{
+				payload:    errPayloadFromSingleErr(err),
+				retryAfter: err.(ingest.ErrQueueFull).RetryAfter,
+			}
+		case objects.ErrInvalidUserInput, backpressure.ErrBatchTooLarge:
 			return batch.NewBatchObjectsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		case objects.ErrMultiTenancy:
@@ -63,16 +94,42 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 
 	h.metricRequestsTotal.logOk("")
 	return batch.NewBatchObjectsCreateOK().
-		WithPayload(h.objectsResponse(objs))
+		WithPayload(h.objectsResponse(objs, tracing.RequestID(params.HTTPRequest.Context())))
 }
 
-func (h *batchObjectHandlers) objectsResponse(input objects.BatchObjects) []*models.ObjectsGetResponse {
+// batchHintsResponder adds the load hints of the batch backpressure to a
+// response, so clients can adapt the size of their next batch
+type batchHintsResponder struct {
+	middleware.Responder
+	hints backpressure.Hints
+}
+
+func (h *batchObjectHandlers) withBatchHints(r middleware.Responder) middleware.Responder {
+	if h.backpressure == nil {
+		return r
+	}
+	return &batchHintsResponder{Responder: r, hints: h.backpressure.Hints()}
+}
+
+func (r *batchHintsResponder) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+	rw.Header().Set(batchQueueDepthHeader, strconv.Itoa(r.hints.QueueDepth))
+	rw.Header().Set(batchSuggestedSizeHeader, strconv.Itoa(r.hints.SuggestedBatchSize))
+	r.Responder.WriteResponse(rw, producer)
+}
+
+// objectsResponse converts the batch results, failed objects carry the id of
+// the request in their error, so they can be traced in logs and module
+// provider requests
+func (h *batchObjectHandlers) objectsResponse(input objects.BatchObjects,
+	requestID string,
+) []*models.ObjectsGetResponse {
 	response := make([]*models.ObjectsGetResponse, len(input))
 	for i, object := range input {
 		var errorResponse *models.ErrorResponse
 		status := models.ObjectsGetResponseAO2ResultStatusSUCCESS
 		if object.Err != nil {
 			errorResponse = errPayloadFromSingleErr(object.Err)
+			errorResponse.RequestID = requestID
 			status = models.ObjectsGetResponseAO2ResultStatusFAILED
 		}
 
@@ -120,10 +177,12 @@ func (h *batchObjectHandlers) addReferences(params batch.BatchReferencesCreatePa
 
 	h.metricRequestsTotal.logOk("")
 	return batch.NewBatchReferencesCreateOK().
-		WithPayload(h.referencesResponse(references))
+		WithPayload(h.referencesResponse(references, tracing.RequestID(params.HTTPRequest.Context())))
 }
 
-func (h *batchObjectHandlers) referencesResponse(input objects.BatchReferences) []*models.BatchReferenceResponse {
+func (h *batchObjectHandlers) referencesResponse(input objects.BatchReferences,
+	requestID string,
+) []*models.BatchReferenceResponse {
 	response := make([]*models.BatchReferenceResponse, len(input))
 	for i, ref := range input {
 		var errorResponse *models.ErrorResponse
@@ -132,6 +191,7 @@ func (h *batchObjectHandlers) referencesResponse(input objects.BatchReferences)
 		status := models.BatchReferenceResponseAO1ResultStatusSUCCESS
 		if ref.Err != nil {
 			errorResponse = errPayloadFromSingleErr(ref.Err)
+			errorResponse.RequestID = requestID
 			status = models.BatchReferenceResponseAO1ResultStatusFAILED
 		} else {
 			reference.From = strfmt.URI(ref.From.String())
@@ -236,8 +296,10 @@ func (h *batchObjectHandlers) objectsDeleteResponse(input *objects.BatchDeleteRe
 	return response
 }
 
-func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.BatchManager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) {
-	h := &batchObjectHandlers{manager, newBatchRequestsTotal(metrics, logger)}
+func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.BatchManager,
+	backpressure *backpressure.Controller, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &batchObjectHandlers{manager, backpressure, newBatchRequestsTotal(metrics, logger)}
 
 	api.BatchBatchObjectsCreateHandler = batch.
 		BatchObjectsCreateHandlerFunc(h.addObjects)
@@ -263,6 +325,10 @@ func (e *batchRequestsTotal) logError(className string, err error) {
 		e.logUserError(className)
 	case autherrs.Forbidden, objects.ErrInvalidUserInput:
 		e.logUserError(className)
+	case quota.ErrQuotaExceeded, quota.ErrRateLimited:
+		e.logUserError(className)
+	case backpressure.ErrOverloaded, backpressure.ErrBatchTooLarge, ingest.ErrQueueFull:
+		e.logUserError(className)
 	case objects.ErrMultiTenancy:
 		e.logUserError(className)
 	default:
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
+	"github.com/weaviate/weaviate/usecases/backpressure"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestBatchHints(t *testing.T) {
+	t.Run("without backpressure", func(t *testing.T) {
+		h := &batchObjectHandlers{}
+		rec := httptest.NewRecorder()
+		h.withBatchHints(batch.NewBatchObjectsCreateOK()).WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get(batchQueueDepthHeader))
+		assert.Empty(t, rec.Header().Get(batchSuggestedSizeHeader))
+	})
+
+	t.Run("with backpressure", func(t *testing.T) {
+		h := &batchObjectHandlers{backpressure: backpressure.NewController(config.BatchBackpressure{
+			Enabled:          true,
+			MaxQueuedObjects: 100,
+			MaxBatchSize:     50,
+			TargetLatency:    time.Second,
+		}, nil)}
+		for i := 0; i < 2; i++ {
+			done, err := h.backpressure.Admit(40)
+			require.Nil(t, err)
+			defer done()
+		}
+
+		rec := httptest.NewRecorder()
+		h.withBatchHints(batch.NewBatchObjectsCreateOK()).WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "80", rec.Header().Get(batchQueueDepthHeader))
+		assert.Equal(t, "20", rec.Header().Get(batchSuggestedSizeHeader))
+	})
+
+	t.Run("overloaded", func(t *testing.T) {
+		err := backpressure.ErrOverloaded{RetryAfter: 3 * time.Second}
+		rec := httptest.NewRecorder()
+		(&tooManyRequests{payload: errPayloadFromSingleErr(err), retryAfter: err.RetryAfter}).
+			WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "3", rec.Header().Get("Retry-After"))
+	})
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+var errConfigReloadUnavailable = fmt.Errorf("config reload is not available")
+
+// configHandlers change the settings of the node serving the request at
+// runtime, like sending SIGHUP to it
+type configHandlers struct {
+	authorizer authorization.Authorizer
+	reloader   *config.Reloader
+}
+
+func (h *configHandlers) update(params nodes.ConfigUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "update", authorization.NodeConfig()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return nodes.NewConfigUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return nodes.NewConfigUpdateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.reloader == nil {
+		return nodes.NewConfigUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errConfigReloadUnavailable))
+	}
+
+	patch, err := json.Marshal(params.Body)
+	if err != nil {
+		return nodes.NewConfigUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("read config: %w", err)))
+	}
+	result, err := h.reloader.Update(patch)
+	if err != nil {
+		return nodes.NewConfigUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	return nodes.NewConfigUpdateOK().WithPayload(&models.ConfigReloadResult{
+		Applied:         result.Applied,
+		RequiresRestart: result.RequiresRestart,
+		Failed:          result.Failed,
+	})
+}
+
+func setupConfigHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer,
+	reloader *config.Reloader,
+) {
+	h := &configHandlers{authorizer: authorizer, reloader: reloader}
+
+	api.NodesConfigUpdateHandler = nodes.ConfigUpdateHandlerFunc(h.update)
+}
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	goruntime "runtime"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/debug"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/verbosity"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/diagnostics"
+)
+
+// debugBundleHandlers serve a diagnostics bundle of the node serving the
+// request for support cases
+type debugBundleHandlers struct {
+	appState *state.State
+}
+
+type bundleInfo struct {
+	Version    string             `json:"version"`
+	Node       string             `json:"node"`
+	Time       time.Time          `json:"time"`
+	GoVersion  string             `json:"goVersion"`
+	GOMAXPROCS int                `json:"gomaxprocs"`
+	NumCPU     int                `json:"numCPU"`
+	Goroutines int                `json:"goroutines"`
+	MemStats   goruntime.MemStats `json:"memStats"`
+}
+
+func (h *debugBundleHandlers) getBundle(params debug.DebugBundleGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.appState.Authorizer.Authorize(principal, "get", authorization.DebugBundle())
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return debug.NewDebugBundleGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return debug.NewDebugBundleGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	// the bundle is streamed, so it is truncated if a collector fails after
+	// the status was sent
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		node := h.appState.Cluster.LocalName()
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(
+			"attachment; filename=%q", fmt.Sprintf("weaviate-bundle-%s-%s.tar.gz",
+				node, time.Now().UTC().Format("20060102T150405Z"))))
+		w.WriteHeader(http.StatusOK)
+
+		cpu := time.Duration(*params.CPUSeconds) * time.Second
+		if err := diagnostics.Write(params.HTTPRequest.Context(), w, h.collectors(node, cpu)); err != nil {
+			h.appState.Logger.WithField("action", "debug_bundle").WithError(err).
+				Error("write diagnostics bundle")
+		}
+	})
+}
+
+func (h *debugBundleHandlers) collectors(node string, cpu time.Duration) []diagnostics.Collector {
+	collectors := []diagnostics.Collector{
+		diagnostics.JSON("info.json", func(ctx context.Context) (interface{}, error) {
+			info := bundleInfo{
+				Version:    config.ServerVersion,
+				Node:       node,
+				Time:       time.Now(),
+				GoVersion:  goruntime.Version(),
+				GOMAXPROCS: goruntime.GOMAXPROCS(0),
+				NumCPU:     goruntime.NumCPU(),
+				Goroutines: goruntime.NumGoroutine(),
+			}
+			goruntime.ReadMemStats(&info.MemStats)
+			return info, nil
+		}),
+		diagnostics.JSON("config.json", func(ctx context.Context) (interface{}, error) {
+			return diagnostics.Redact(h.appState.ServerConfig.Config)
+		}),
+		diagnostics.JSON("schema.json", func(ctx context.Context) (interface{}, error) {
+			return h.appState.SchemaManager.GetSchemaSkipAuth(), nil
+		}),
+		diagnostics.JSON("nodes.json", func(ctx context.Context) (interface{}, error) {
+			return h.appState.DB.GetNodeStatus(ctx, "", verbosity.OutputVerbose)
+		}),
+	}
+
+	if h.appState.SlowQueryLog != nil {
+		collectors = append(collectors, diagnostics.JSON("slow_queries.json",
+			func(ctx context.Context) (interface{}, error) {
+				return h.appState.SlowQueryLog.Entries(), nil
+			}))
+	}
+
+	return append(collectors, diagnostics.Profiles(cpu)...)
+}
+
+func setupDebugBundleHandlers(api *operations.WeaviateAPI, appState *state.State) {
+	h := &debugBundleHandlers{appState: appState}
+
+	api.DebugDebugBundleGetHandler = debug.DebugBundleGetHandlerFunc(h.getBundle)
+}
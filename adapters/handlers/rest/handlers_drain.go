@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/balancer"
+)
+
+// drainHandlers serve the decommissioning of nodes
+type drainHandlers struct {
+	authorizer authorization.Authorizer
+	manager    *balancer.Manager
+}
+
+func (h *drainHandlers) getDrain(params nodes.NodesDrainGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", authorization.ShardPlacement()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return nodes.NewNodesDrainGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return nodes.NewNodesDrainGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.manager == nil {
+		return nodes.NewNodesDrainGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errShardBalancerUnavailable))
+	}
+
+	status, err := h.manager.DrainStatus(params.NodeName)
+	if err != nil {
+		switch {
+		case errors.Is(err, balancer.ErrNotFound):
+			return nodes.NewNodesDrainGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesDrainGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesDrainGetOK().WithPayload(nodeDrainToModel(status))
+}
+
+func (h *drainHandlers) drain(params nodes.NodesDrainCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "update", authorization.ShardPlacement()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return nodes.NewNodesDrainCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return nodes.NewNodesDrainCreateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.manager == nil {
+		return nodes.NewNodesDrainCreateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errShardBalancerUnavailable))
+	}
+
+	status, err := h.manager.Drain(params.HTTPRequest.Context(), params.NodeName)
+	if err != nil {
+		switch {
+		case errors.Is(err, balancer.ErrNotFound):
+			return nodes.NewNodesDrainCreateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, balancer.ErrDraining):
+			return nodes.NewNodesDrainCreateConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesDrainCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesDrainCreateAccepted().WithPayload(nodeDrainToModel(status))
+}
+
+func (h *drainHandlers) undrain(params nodes.NodesDrainDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "update", authorization.ShardPlacement()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return nodes.NewNodesDrainDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return nodes.NewNodesDrainDeleteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.manager == nil {
+		return nodes.NewNodesDrainDeleteUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errShardBalancerUnavailable))
+	}
+
+	status, err := h.manager.Undrain(params.HTTPRequest.Context(), params.NodeName)
+	if err != nil {
+		switch {
+		case errors.Is(err, balancer.ErrNotFound):
+			return nodes.NewNodesDrainDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesDrainDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesDrainDeleteOK().WithPayload(nodeDrainToModel(status))
+}
+
+func nodeDrainToModel(drain balancer.Drain) *models.NodeDrain {
+	out := &models.NodeDrain{
+		Node:     drain.Node,
+		Draining: drain.Draining,
+		Status:   drain.Status,
+		Error:    drain.Error,
+		Replicas: drain.Replicas,
+		Safe:     drain.Safe,
+	}
+	if drain.StartedAt != nil {
+		startedAt := strfmt.DateTime(*drain.StartedAt)
+		out.StartedAt = &startedAt
+	}
+	if drain.FinishedAt != nil {
+		finishedAt := strfmt.DateTime(*drain.FinishedAt)
+		out.FinishedAt = &finishedAt
+	}
+	return out
+}
+
+func setupDrainHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer,
+	manager *balancer.Manager,
+) {
+	h := &drainHandlers{authorizer: authorizer, manager: manager}
+
+	api.NodesNodesDrainGetHandler = nodes.NodesDrainGetHandlerFunc(h.getDrain)
+	api.NodesNodesDrainCreateHandler = nodes.NodesDrainCreateHandlerFunc(h.drain)
+	api.NodesNodesDrainDeleteHandler = nodes.NodesDrainDeleteHandlerFunc(h.undrain)
+}
@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/schema"
@@ -32,6 +33,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
 )
 
 const error422 string = "The request is well-formed but was unable to be followed due to semantic errors."
@@ -58,7 +60,7 @@ func setupGraphQLHandlers(
 		// All requests to the graphQL API need at least permissions to read the schema. Request might have further
 		// authorization requirements.
 
-		err := m.Authorizer.Authorize(principal, "list", "schema/*")
+		err := m.Authorizer.Authorize(principal, "list", authorization.CollectionsMetadata(""))
 		if err != nil {
 			metricRequestsTotal.logUserError()
 			switch err.(type) {
@@ -114,6 +116,9 @@ func setupGraphQLHandlers(
 
 		ctx := params.HTTPRequest.Context()
 		ctx = context.WithValue(ctx, "principal", principal)
+		ctx, partial := search.WithPartialResults(ctx)
+		ctx, plans := search.WithQueryPlans(ctx, false)
+		ctx, facets := search.WithFacets(ctx)
 
 		result := graphQL.Resolve(ctx, query,
 			operationName, variables)
@@ -146,6 +151,9 @@ func setupGraphQLHandlers(
 		}
 
 		metricRequestsTotal.log(result)
+		graphQLResponse.Errors = append(graphQLResponse.Errors, partialResultsErrors(partial)...)
+		graphQLResponse.Explain = explainedQueries(plans)
+		graphQLResponse.Facets = queryFacets(facets)
 		// Return the response
 		return graphql.NewGraphqlPostOK().WithPayload(graphQLResponse)
 	})
@@ -235,6 +243,9 @@ func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, grap
 			}
 		}
 
+		ctx, partial := search.WithPartialResults(ctx)
+		ctx, plans := search.WithQueryPlans(ctx, false)
+		ctx, facets := search.WithFacets(ctx)
 		result := graphQL.Resolve(ctx, query, operationName, variables)
 
 		// Marshal the JSON
@@ -270,6 +281,9 @@ func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, grap
 				}
 			} else {
 				metricRequestsTotal.log(result)
+				graphQLResponse.Errors = append(graphQLResponse.Errors, partialResultsErrors(partial)...)
+				graphQLResponse.Explain = explainedQueries(plans)
+				graphQLResponse.Facets = queryFacets(facets)
 				// Return the GraphQL response
 				*requestResults <- gqlUnbatchedRequestResponse{
 					requestIndex,
@@ -280,6 +294,48 @@ func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, grap
 	}
 }
 
+// partialResultsErrors reports the queries which only returned the results
+// of some shards, because the other shards did not finish before the timeout
+// or are offloaded tenants which the query did not name
+func partialResultsErrors(partial *search.PartialResults) []*models.GraphQLError {
+	var errs []*models.GraphQLError
+	for _, q := range partial.Queries() {
+		if len(q.Shards) > 0 {
+			errs = append(errs, &models.GraphQLError{
+				Message: fmt.Sprintf("partial results due to timeout: shards %s of class %q did not finish in time",
+					strings.Join(q.Shards, ", "), q.Class),
+				Path: []string{"Get", q.Class},
+			})
+		}
+		if len(q.Offloaded) > 0 {
+			errs = append(errs, &models.GraphQLError{
+				Message: fmt.Sprintf("partial results: offloaded tenants %s of class %q were skipped, "+
+					"name them in tenants to activate them", strings.Join(q.Offloaded, ", "), q.Class),
+				Path: []string{"Get", q.Class},
+			})
+		}
+	}
+	return errs
+}
+
+// explainedQueries are the plans of the queries of the request which asked
+// to be explained
+func explainedQueries(plans *search.QueryPlans) []interface{} {
+	var explain []interface{}
+	for _, plan := range plans.Plans() {
+		explain = append(explain, plan)
+	}
+	return explain
+}
+
+func queryFacets(facets *search.Facets) []interface{} {
+	var out []interface{}
+	for _, query := range facets.Queries() {
+		out = append(out, query)
+	}
+	return out
+}
+
 type graphqlRequestsTotal struct {
 	metrics *requestsTotalMetric
 	logger  logrus.FieldLogger
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// graphQLExplainHandlers plan the Get queries of a GraphQL request without
+// executing them. The filters of the queries are evaluated to count the
+// objects matching them, the vector and keyword searches are not executed
+// and no results are returned.
+type graphQLExplainHandlers struct {
+	authorizer  authorization.Authorizer
+	gqlProvider graphQLProvider
+}
+
+func (h *graphQLExplainHandlers) explain(params graphql.GraphqlExplainParams,
+	principal *models.Principal,
+) middleware.Responder {
+	// like for /graphql, explaining a query needs at least permissions to
+	// read the schema
+	if err := h.authorizer.Authorize(principal, "list", authorization.CollectionsMetadata("")); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return graphql.NewGraphqlExplainForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return graphql.NewGraphqlExplainInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if params.Body.Query == "" {
+		return graphql.NewGraphqlExplainUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("query cannot be empty")))
+	}
+	variables, ok := params.Body.Variables.(map[string]interface{})
+	if params.Body.Variables != nil && !ok {
+		return graphql.NewGraphqlExplainUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf(
+				"expected variables to be an object, got %T", params.Body.Variables)))
+	}
+
+	graphQL := h.gqlProvider.GetGraphQL()
+	if graphQL == nil {
+		return graphql.NewGraphqlExplainUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("no graphql provider " +
+				"present, this is most likely because no schema is present. Import a schema first!")))
+	}
+
+	ctx := context.WithValue(params.HTTPRequest.Context(), "principal", principal)
+	ctx, plans := search.WithQueryPlans(ctx, true)
+	result := graphQL.Resolve(ctx, params.Body.Query, params.Body.OperationName, variables)
+
+	payload := &models.GraphQLExplainResponse{Plans: explainedQueries(plans)}
+	for _, gqlErr := range result.Errors {
+		path := make([]string, 0, len(gqlErr.Path))
+		for _, p := range gqlErr.Path {
+			path = append(path, fmt.Sprint(p))
+		}
+		payload.Errors = append(payload.Errors, &models.GraphQLError{Message: gqlErr.Message, Path: path})
+	}
+	return graphql.NewGraphqlExplainOK().WithPayload(payload)
+}
+
+func setupGraphQLExplainHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer,
+	gqlProvider graphQLProvider,
+) {
+	h := &graphQLExplainHandlers{authorizer: authorizer, gqlProvider: gqlProvider}
+
+	api.GraphqlGraphqlExplainHandler = graphql.GraphqlExplainHandlerFunc(h.explain)
+}
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/weaviate/weaviate/usecases/idempotency"
+)
+
+const (
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotencyReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	// maxIdempotentBodyBytes bounds the bodies of writes with a key, which
+	// are buffered to be hashed before the handler reads them. Larger writes
+	// must be split or sent without a key.
+	maxIdempotentBodyBytes = 64 << 20
+)
+
+// makeAddIdempotency returns the kept response to retries of object writes
+// with an Idempotency-Key header, instead of adding the objects again. Keys
+// are scoped to the path, the query and the credentials of the request, since
+// the principal is not known before authentication.
+func makeAddIdempotency(store *idempotency.Store) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(idempotencyKeyHeader)
+			if store == nil || key == "" || !isIdempotentWrite(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				writePlainError(w, http.StatusBadRequest, fmt.Errorf(
+					"%s must not be longer than %d characters", idempotencyKeyHeader, maxIdempotencyKeyLength))
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodyBytes))
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					writePlainError(w, http.StatusRequestEntityTooLarge, fmt.Errorf(
+						"bodies of requests with an %s must not be larger than %d bytes",
+						idempotencyKeyHeader, tooLarge.Limit))
+					return
+				}
+				writePlainError(w, http.StatusBadRequest, fmt.Errorf("read body: %w", err))
+				return
+			}
+			r.Body.Close()
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			replay, complete, err := store.Begin(idempotencyScope(r, key), sha256.Sum256(body))
+			switch {
+			case errors.Is(err, idempotency.ErrInProgress):
+				writePlainError(w, http.StatusConflict, err)
+				return
+			case errors.Is(err, idempotency.ErrKeyReused):
+				writePlainError(w, http.StatusUnprocessableEntity, err)
+				return
+			case replay != nil:
+				w.Header().Set("Content-Type", replay.ContentType)
+				w.Header().Set(idempotencyReplayedHeader, "true")
+				w.WriteHeader(replay.Status)
+				w.Write(replay.Body)
+				return
+			}
+
+			rec := &idempotencyRecorder{ResponseWriter: w}
+			completed := false
+			defer func() {
+				if !completed {
+					// the handler panicked, a retry must be executed again
+					complete(nil)
+				}
+			}()
+			next.ServeHTTP(rec, r)
+			completed = true
+			complete(rec.response())
+		})
+	}
+}
+
+func isIdempotentWrite(r *http.Request) bool {
+	return r.Method == http.MethodPost &&
+		(r.URL.Path == "/v1/objects" || r.URL.Path == "/v1/batch/objects")
+}
+
+func idempotencyScope(r *http.Request, key string) string {
+	credentials := sha256.Sum256([]byte(r.Header.Get("Authorization")))
+	// the query is encoded again, so that the order of the parameters doesn't
+	// matter, e.g. the consistency level of a batch changes the response
+	return r.URL.Path + "?" + r.URL.Query().Encode() + " " +
+		hex.EncodeToString(credentials[:]) + " " + key
+}
+
+// idempotencyRecorder keeps a copy of the response written by the handler
+type idempotencyRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *idempotencyRecorder) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *idempotencyRecorder) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *idempotencyRecorder) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// response returns nil for responses which a retry may change, i.e. server
+// errors and rejections of overloaded nodes
+func (w *idempotencyRecorder) response() *idempotency.Response {
+	status := w.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	if status >= http.StatusInternalServerError || status == http.StatusTooManyRequests {
+		return nil
+	}
+
+	return &idempotency.Response{
+		Status:      status,
+		ContentType: w.Header().Get("Content-Type"),
+		Body:        w.body.Bytes(),
+	}
+}
@@ -0,0 +1,273 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/bulkimport"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+var errBulkImportsUnavailable = fmt.Errorf("bulk imports are not available")
+
+// importsHandlers import files of objects from object storage within the
+// cluster, or an uploaded CSV file. Jobs run on the node which received the
+// request and are not visible on other nodes.
+type importsHandlers struct {
+	manager *bulkimport.Manager
+}
+
+func (h *importsHandlers) create(params batch.ImportsCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.manager == nil {
+		return batch.NewImportsCreateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errBulkImportsUnavailable))
+	}
+
+	job, err := h.manager.Start(params.HTTPRequest.Context(), principal, importRequestFromModel(params.Body))
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &forbidden):
+			return batch.NewImportsCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case isImportUserError(err):
+			return batch.NewImportsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batch.NewImportsCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return batch.NewImportsCreateAccepted().WithPayload(importJobToModel(job))
+}
+
+func (h *importsHandlers) list(params batch.ImportsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.manager == nil {
+		return batch.NewImportsListUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errBulkImportsUnavailable))
+	}
+
+	jobs, err := h.manager.Jobs(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &forbidden):
+			return batch.NewImportsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batch.NewImportsListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	out := make([]*models.ImportJob, len(jobs))
+	for i, job := range jobs {
+		out[i] = importJobToModel(job)
+	}
+	return batch.NewImportsListOK().WithPayload(out)
+}
+
+func (h *importsHandlers) get(params batch.ImportsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.manager == nil {
+		return batch.NewImportsGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errBulkImportsUnavailable))
+	}
+
+	job, err := h.manager.Job(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &forbidden):
+			return batch.NewImportsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, bulkimport.ErrJobNotFound):
+			return batch.NewImportsGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batch.NewImportsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return batch.NewImportsGetOK().WithPayload(importJobToModel(job))
+}
+
+func (h *importsHandlers) cancel(params batch.ImportsCancelParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.manager == nil {
+		return batch.NewImportsCancelUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errBulkImportsUnavailable))
+	}
+
+	if err := h.manager.Cancel(params.HTTPRequest.Context(), principal, params.ID); err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &forbidden):
+			return batch.NewImportsCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, bulkimport.ErrJobNotFound):
+			return batch.NewImportsCancelNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batch.NewImportsCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return batch.NewImportsCancelNoContent()
+}
+
+func (h *importsHandlers) uploadCSV(params batch.ImportsCsvUploadParams,
+	principal *models.Principal,
+) middleware.Responder {
+	defer params.File.Close()
+
+	if h.manager == nil {
+		return batch.NewImportsCsvUploadUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errBulkImportsUnavailable))
+	}
+
+	req := bulkimport.Request{Class: params.Class}
+	if params.Tenant != nil {
+		req.Tenant = *params.Tenant
+	}
+	if params.BatchSize != nil {
+		req.BatchSize = int(*params.BatchSize)
+	}
+	if params.AutoSchema == nil || *params.AutoSchema {
+		req.AutoSchema = &bulkimport.AutoSchema{}
+		if params.SampleRows != nil {
+			req.AutoSchema.SampleRows = int(*params.SampleRows)
+		}
+		if params.Types != nil {
+			if err := json.Unmarshal([]byte(*params.Types), &req.AutoSchema.Types); err != nil {
+				return batch.NewImportsCsvUploadUnprocessableEntity().
+					WithPayload(errPayloadFromSingleErr(fmt.Errorf("field \"types\": %w", err)))
+			}
+		}
+	}
+	name := ""
+	if file, ok := params.File.(*runtime.File); ok {
+		name = file.Header.Filename
+	}
+
+	job, err := h.manager.StartUpload(params.HTTPRequest.Context(), principal, req, name, params.File)
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &forbidden):
+			return batch.NewImportsCsvUploadForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case isImportUserError(err):
+			return batch.NewImportsCsvUploadUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batch.NewImportsCsvUploadInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return batch.NewImportsCsvUploadAccepted().WithPayload(importJobToModel(job))
+}
+
+// isImportUserError tells whether a job could not be started because of
+// its request, like a missing class or tenant
+func isImportUserError(err error) bool {
+	var (
+		notFound     objects.ErrNotFound
+		invalid      objects.ErrInvalidUserInput
+		multiTenancy objects.ErrMultiTenancy
+	)
+	return errors.As(err, &notFound) || errors.As(err, &invalid) || errors.As(err, &multiTenancy)
+}
+
+func importRequestFromModel(body *models.ImportJobRequest) bulkimport.Request {
+	req := bulkimport.Request{
+		Backend:   *body.Backend,
+		Path:      body.Path,
+		Files:     body.Files,
+		Class:     *body.Class,
+		Tenant:    body.Tenant,
+		Format:    body.Format,
+		BatchSize: int(body.BatchSize),
+	}
+	if auto := body.AutoSchema; auto != nil {
+		req.AutoSchema = &bulkimport.AutoSchema{
+			SampleRows: int(auto.SampleRows),
+			Types:      auto.Types,
+		}
+	}
+	return req
+}
+
+func importJobToModel(job *bulkimport.Job) *models.ImportJob {
+	out := &models.ImportJob{
+		ID:        job.ID,
+		Backend:   job.Backend,
+		Path:      job.Path,
+		Class:     job.Class,
+		Tenant:    job.Tenant,
+		Status:    job.Status,
+		Files:     make([]*models.ImportFile, len(job.Files)),
+		StartedAt: strfmt.DateTime(job.StartedAt),
+		Error:     job.Error,
+	}
+	if auto := job.AutoSchema; auto != nil {
+		out.AutoSchema = &models.ImportAutoSchema{
+			SampleRows: int64(auto.SampleRows),
+			Types:      auto.Types,
+		}
+	}
+	if job.CompletedAt != nil {
+		completedAt := strfmt.DateTime(*job.CompletedAt)
+		out.CompletedAt = &completedAt
+	}
+	for i, file := range job.Files {
+		out.Files[i] = &models.ImportFile{
+			Name:     file.Name,
+			Format:   file.Format,
+			Status:   file.Status,
+			Imported: int64(file.Imported),
+			Failed:   int64(file.Failed),
+			Errors:   file.Errors,
+		}
+	}
+	return out
+}
+
+func setupImportsHandlers(api *operations.WeaviateAPI, manager *bulkimport.Manager) {
+	h := &importsHandlers{manager: manager}
+
+	api.BatchImportsCreateHandler = batch.ImportsCreateHandlerFunc(h.create)
+	api.BatchImportsListHandler = batch.ImportsListHandlerFunc(h.list)
+	api.BatchImportsGetHandler = batch.ImportsGetHandlerFunc(h.get)
+	api.BatchImportsCancelHandler = batch.ImportsCancelHandlerFunc(h.cancel)
+	api.BatchImportsCsvUploadHandler = batch.ImportsCsvUploadHandlerFunc(h.uploadCSV)
+}
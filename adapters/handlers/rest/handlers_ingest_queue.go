@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/ingest"
+)
+
+var errIngestQueueDisabled = fmt.Errorf("ingest queue is not enabled")
+
+// ingestQueueHandlers report the indexing lag of the ingest queue of the node
+// serving the request
+type ingestQueueHandlers struct {
+	authorizer authorization.Authorizer
+	queue      *ingest.Queue
+}
+
+func (h *ingestQueueHandlers) getStatus(params batch.BatchIngestGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", authorization.IngestQueue()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return batch.NewBatchIngestGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return batch.NewBatchIngestGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.queue == nil {
+		return batch.NewBatchIngestGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errIngestQueueDisabled))
+	}
+
+	return batch.NewBatchIngestGetOK().WithPayload(ingestStatusToModel(h.queue.Status()))
+}
+
+func ingestStatusToModel(status ingest.Status) *models.IngestQueueStatus {
+	out := &models.IngestQueueStatus{
+		AcceptedSeq:    status.AcceptedSeq,
+		AppliedSeq:     status.AppliedSeq,
+		PendingBatches: int64(status.PendingBatches),
+		PendingObjects: int64(status.PendingObjects),
+		LagSeconds:     status.LagSeconds,
+		AppliedObjects: status.AppliedObjects,
+		FailedObjects:  status.FailedObjects,
+		LastError:      status.LastError,
+	}
+	if status.OldestPending != nil {
+		oldest := strfmt.DateTime(*status.OldestPending)
+		out.OldestPending = &oldest
+	}
+	return out
+}
+
+func setupIngestQueueHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer,
+	queue *ingest.Queue,
+) {
+	h := &ingestQueueHandlers{authorizer: authorizer, queue: queue}
+
+	api.BatchBatchIngestGetHandler = batch.BatchIngestGetHandlerFunc(h.getStatus)
+}
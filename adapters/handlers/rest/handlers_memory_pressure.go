@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+type memoryPressureState interface {
+	RejectImports() bool
+}
+
+// importPaths are the paths of the writes which add objects or references,
+// deletes and schema changes are still accepted under memory pressure
+var importPaths = []string{
+	"/v1/objects", "/v1/objects:upload", "/v1/batch/objects", "/v1/batch/references",
+	"/v1/imports", "/v1/imports:csv", "/v1/transactions",
+}
+
+// makeAddMemoryPressureImportGuard rejects imports while the memory pressure
+// governor sheds load, so that clients back off until memory was freed
+func makeAddMemoryPressureImportGuard(s memoryPressureState) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isImport(r) && s.RejectImports() {
+				writePlainError(w, http.StatusServiceUnavailable, memwatch.ErrMemoryPressure)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isImport(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+	default:
+		return false
+	}
+	if r.URL.Path == "/v1/objects/validate" {
+		return false
+	}
+
+	for _, path := range importPaths {
+		if r.URL.Path == path || strings.HasPrefix(r.URL.Path, path+"/") {
+			return true
+		}
+	}
+	return false
+}
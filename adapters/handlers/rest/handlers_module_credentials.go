@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/modules"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/modulecredentials"
+)
+
+// moduleCredentialsHandlers set and rotate the credentials of modules at
+// runtime, for all tenants or for a single tenant. The values are never
+// returned.
+type moduleCredentialsHandlers struct {
+	authorizer authorization.Authorizer
+	store      *modulecredentials.Store
+}
+
+func (h *moduleCredentialsHandlers) list(params modules.ModulesCredentialsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "list", authorization.ModuleCredentials("")); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return modules.NewModulesCredentialsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return modules.NewModulesCredentialsListInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	listing := h.store.List()
+	return modules.NewModulesCredentialsListOK().WithPayload(&models.ModuleCredentialsListing{
+		Global:  listing.Global,
+		Tenants: listing.Tenants,
+	})
+}
+
+func (h *moduleCredentialsHandlers) update(params modules.ModulesCredentialsUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "update", authorization.ModuleCredentials("")); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return modules.NewModulesCredentialsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return modules.NewModulesCredentialsUpdateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.store.Set(params.HTTPRequest.Context(), "", params.Body); err != nil {
+		return modules.NewModulesCredentialsUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return modules.NewModulesCredentialsUpdateNoContent()
+}
+
+func (h *moduleCredentialsHandlers) delete(params modules.ModulesCredentialsDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "delete", authorization.ModuleCredentials("")); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return modules.NewModulesCredentialsDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return modules.NewModulesCredentialsDeleteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.store.Delete(params.HTTPRequest.Context(), ""); err != nil {
+		return modules.NewModulesCredentialsDeleteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return modules.NewModulesCredentialsDeleteNoContent()
+}
+
+func (h *moduleCredentialsHandlers) updateTenant(params modules.ModulesCredentialsTenantUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "update", authorization.ModuleCredentials(params.Tenant)); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return modules.NewModulesCredentialsTenantUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return modules.NewModulesCredentialsTenantUpdateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.store.Set(params.HTTPRequest.Context(), params.Tenant, params.Body); err != nil {
+		return modules.NewModulesCredentialsTenantUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return modules.NewModulesCredentialsTenantUpdateNoContent()
+}
+
+func (h *moduleCredentialsHandlers) deleteTenant(params modules.ModulesCredentialsTenantDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "delete", authorization.ModuleCredentials(params.Tenant)); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return modules.NewModulesCredentialsTenantDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return modules.NewModulesCredentialsTenantDeleteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.store.Delete(params.HTTPRequest.Context(), params.Tenant); err != nil {
+		if errors.Is(err, modulecredentials.ErrTenantNotFound) {
+			return modules.NewModulesCredentialsTenantDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return modules.NewModulesCredentialsTenantDeleteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return modules.NewModulesCredentialsTenantDeleteNoContent()
+}
+
+func setupModuleCredentialsHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer,
+	store *modulecredentials.Store,
+) {
+	h := &moduleCredentialsHandlers{authorizer: authorizer, store: store}
+
+	api.ModulesModulesCredentialsListHandler = modules.ModulesCredentialsListHandlerFunc(h.list)
+	api.ModulesModulesCredentialsUpdateHandler = modules.ModulesCredentialsUpdateHandlerFunc(h.update)
+	api.ModulesModulesCredentialsDeleteHandler = modules.ModulesCredentialsDeleteHandlerFunc(h.delete)
+	api.ModulesModulesCredentialsTenantUpdateHandler = modules.ModulesCredentialsTenantUpdateHandlerFunc(h.updateTenant)
+	api.ModulesModulesCredentialsTenantDeleteHandler = modules.ModulesCredentialsTenantDeleteHandlerFunc(h.deleteTenant)
+}
+
+// makeAddModuleCredentials adds the stored module credentials to requests as
+// headers, unless the request carries them already. The credentials of a
+// tenant are only added for principals bound to that tenant, the tenant a
+// request names is not authorized yet. The principal is only authenticated
+// if credentials of single tenants are stored.
+func makeAddModuleCredentials(store *modulecredentials.Store,
+	authenticate func(r *http.Request) (*models.Principal, error),
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var tenant string
+			if store.HasTenants() {
+				// requests which fail authentication are rejected by their
+				// handler, they only get the credentials for all tenants
+				if principal, err := authenticate(r); err == nil && principal != nil {
+					tenant = principal.Tenant
+				}
+			}
+
+			values := store.Resolve(tenant)
+			if len(values) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			r = r.Clone(r.Context())
+			for name, value := range values {
+				if r.Header.Get(name) == "" {
+					r.Header.Set(name, value)
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -26,9 +26,11 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/masking"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/quota"
 	"github.com/weaviate/weaviate/usecases/replica"
 )
 
@@ -99,6 +101,11 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 		} else if errors.As(err, &autherrs.Forbidden{}) {
 			return objects.NewObjectsCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &quota.ErrQuotaExceeded{}) {
+			return objects.NewObjectsCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &quota.ErrRateLimited{}) {
+			return newTooManyRequests(err)
 		} else {
 			return objects.NewObjectsCreateInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -203,6 +210,7 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 		}
 	}
 
+	selectProperties(object, parseFieldsParam(params.Fields))
 	propertiesMap, ok := object.Properties.(map[string]interface{})
 	if ok {
 		object.Properties = h.extendPropertiesWithAPILinks(propertiesMap)
@@ -233,7 +241,7 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		switch err.(type) {
-		case autherrs.Forbidden:
+		case autherrs.Forbidden, masking.ErrSensitive:
 			return objects.NewObjectsListForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		case uco.ErrMultiTenancy:
@@ -245,7 +253,9 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 		}
 	}
 
+	fields := parseFieldsParam(params.Fields)
 	for i, object := range list {
+		selectProperties(object, fields)
 		propertiesMap, ok := object.Properties.(map[string]interface{})
 		if ok {
 			list[i].Properties = h.extendPropertiesWithAPILinks(propertiesMap)
@@ -301,7 +311,9 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 		}
 	}
 
+	fields := parseFieldsParam(params.Fields)
 	for i, object := range resultSet {
+		selectProperties(object, fields)
 		propertiesMap, ok := object.Properties.(map[string]interface{})
 		if ok {
 			resultSet[i].Properties = h.extendPropertiesWithAPILinks(propertiesMap)
@@ -650,6 +662,7 @@ func (h *objectHandlers) getObjectDeprecated(params objects.ObjectsGetParams,
 		HTTPRequest: params.HTTPRequest,
 		ID:          params.ID,
 		Include:     params.Include,
+		Fields:      params.Fields,
 	}
 	return h.getObject(ps, principal)
 }
@@ -804,6 +817,8 @@ func (e *objectsRequestsTotal) logError(className string, err error) {
 		e.logUserError(className)
 	case autherrs.Forbidden:
 		e.logUserError(className)
+	case quota.ErrQuotaExceeded, quota.ErrRateLimited:
+		e.logUserError(className)
 	case uco.ErrInvalidUserInput, uco.ErrNotFound:
 		e.logUserError(className)
 	case *uco.Error:
@@ -860,6 +875,40 @@ func parseIncludeParam(in *string, modulesProvider ModulesProvider, includeModul
 	return out, nil
 }
 
+// parseFieldsParam returns the names of the properties to return, nil if
+// all properties are returned
+func parseFieldsParam(in *string) map[string]struct{} {
+	if in == nil {
+		return nil
+	}
+	fields := map[string]struct{}{}
+	for _, name := range strings.Split(*in, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			fields[name] = struct{}{}
+		}
+	}
+	return fields
+}
+
+// selectProperties removes the properties of the object which are not
+// selected, references are properties as well
+func selectProperties(object *models.Object, fields map[string]struct{}) {
+	if fields == nil || object == nil {
+		return
+	}
+	props, ok := object.Properties.(map[string]interface{})
+	if !ok {
+		return
+	}
+	selected := make(map[string]interface{}, len(fields))
+	for name, value := range props {
+		if _, ok := fields[name]; ok {
+			selected[name] = value
+		}
+	}
+	object.Properties = selected
+}
+
 func getModuleParams(moduleParams map[string]interface{}) map[string]interface{} {
 	if moduleParams == nil {
 		return map[string]interface{}{}
@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/standby"
+)
+
+// objectsDuplicatesHandlers find groups of near-duplicate objects of a class
+// and optionally merge or delete the duplicates. The objects of the shards
+// on the node serving the request are compared.
+type objectsDuplicatesHandlers struct {
+	manager  *uco.Manager
+	standby  standbyState
+	readOnly readOnlyState
+}
+
+func (h *objectsDuplicatesHandlers) findDuplicates(params objects.ObjectsDuplicatesParams,
+	principal *models.Principal,
+) middleware.Responder {
+	// reports are reads, so only the merge and delete actions are subject to
+	// the write guards, which can't tell them apart by the path
+	if params.Body.Action != "" {
+		if err := h.writeRejected(); err != nil {
+			return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+				writePlainError(w, http.StatusServiceUnavailable, err)
+			})
+		}
+	}
+
+	res, err := h.manager.FindDuplicates(params.HTTPRequest.Context(), principal, uco.DuplicatesParams{
+		Class:      *params.Body.Class,
+		Tenant:     params.Body.Tenant,
+		Distance:   params.Body.Distance,
+		Properties: params.Body.Properties,
+		Neighbors:  int(params.Body.Neighbors),
+		Limit:      int(params.Body.Limit),
+		Action:     params.Body.Action,
+	})
+	if err != nil {
+		var (
+			forbidden    autherrs.Forbidden
+			notFound     uco.ErrNotFound
+			invalid      uco.ErrInvalidUserInput
+			multiTenancy uco.ErrMultiTenancy
+		)
+		switch {
+		case errors.As(err, &forbidden):
+			return objects.NewObjectsDuplicatesForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &notFound):
+			return objects.NewObjectsDuplicatesNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &invalid), errors.As(err, &multiTenancy):
+			return objects.NewObjectsDuplicatesUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return objects.NewObjectsDuplicatesInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return objects.NewObjectsDuplicatesOK().WithPayload(duplicatesResultToModel(res))
+}
+
+func (h *objectsDuplicatesHandlers) writeRejected() error {
+	if h.standby.Active() {
+		return standby.ErrStandby
+	}
+	if h.readOnly.ReadOnly() {
+		return cluster.ErrReadOnly
+	}
+	return nil
+}
+
+func duplicatesResultToModel(res *uco.DuplicatesResult) *models.DuplicatesResult {
+	out := &models.DuplicatesResult{
+		Class:   res.Class,
+		Scanned: int64(res.Scanned),
+		Groups:  make([]*models.DuplicateGroup, len(res.Groups)),
+		Deleted: int64(res.Deleted),
+		Merged:  int64(res.Merged),
+		Errors:  res.Errors,
+	}
+	for i, group := range res.Groups {
+		duplicates := make([]*models.DuplicateObject, len(group.Duplicates))
+		for j, d := range group.Duplicates {
+			duplicates[j] = &models.DuplicateObject{ID: d.ID, Distance: d.Distance}
+		}
+		out.Groups[i] = &models.DuplicateGroup{Keep: group.Keep, Duplicates: duplicates}
+	}
+	return out
+}
+
+func setupObjectsDuplicatesHandlers(api *operations.WeaviateAPI, manager *uco.Manager,
+	standbyNode standbyState, readOnlyNode readOnlyState,
+) {
+	h := &objectsDuplicatesHandlers{manager: manager, standby: standbyNode, readOnly: readOnlyNode}
+
+	api.ObjectsObjectsDuplicatesHandler = objects.ObjectsDuplicatesHandlerFunc(h.findDuplicates)
+}
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/export"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	exportCursorTrailer = "X-Weaviate-Export-Cursor"
+	exportDoneTrailer   = "X-Weaviate-Export-Done"
+	exportErrorTrailer  = "X-Weaviate-Export-Error"
+)
+
+// objectsExportHandlers stream all objects of a class including their
+// vectors, so a class can be copied into a data lake without paginating
+// through the objects. The trailers of the response contain the id of the
+// last exported object and whether the class was exported completely.
+type objectsExportHandlers struct {
+	batch  *uco.BatchManager
+	logger logrus.FieldLogger
+}
+
+func (h *objectsExportHandlers) export(params objects.ObjectsExportParams,
+	principal *models.Principal,
+) middleware.Responder {
+	exportParams := uco.ExportParams{
+		Class:  params.Class,
+		Tenant: getTenant(params.Tenant),
+	}
+	if params.After != nil {
+		exportParams.After = *params.After
+	}
+	if params.Limit != nil {
+		exportParams.Limit = int(*params.Limit)
+	}
+	if params.Snapshot != nil {
+		exportParams.Snapshot = *params.Snapshot
+	}
+	format := export.FormatJSONL
+	if params.Format != nil {
+		format = *params.Format
+	}
+
+	// the status is only known once the export wrote its first bytes,
+	// errors after that are reported in a trailer
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		out := &exportWriter{
+			w:           w,
+			contentType: export.ContentType(format),
+			filename:    fmt.Sprintf("%s.%s", exportParams.Class, format),
+		}
+		enc, err := export.NewWriter(format, out)
+		if err != nil {
+			objects.NewObjectsExportUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err)).WriteResponse(w, p)
+			return
+		}
+		w.Header().Set("Trailer", exportCursorTrailer+", "+exportDoneTrailer+", "+exportErrorTrailer)
+
+		res, err := h.batch.ExportObjects(params.HTTPRequest.Context(), principal, exportParams, enc)
+		if err == nil {
+			// completes the file, an incomplete parquet file can not be read
+			err = enc.Close()
+		}
+		if err != nil && !out.started {
+			objectsExportError(err).WriteResponse(w, p)
+			return
+		}
+		out.start()
+
+		if res != nil {
+			w.Header().Set(exportCursorTrailer, res.Cursor)
+			w.Header().Set(exportDoneTrailer, strconv.FormatBool(res.Done && err == nil))
+		}
+		if err != nil {
+			w.Header().Set(exportErrorTrailer, err.Error())
+			h.logger.WithField("action", "objects_export").
+				WithField("class", exportParams.Class).
+				WithField("tenant", exportParams.Tenant).
+				WithError(err).Error("export objects")
+		}
+	})
+}
+
+func objectsExportError(err error) middleware.Responder {
+	var (
+		forbidden    autherrs.Forbidden
+		notFound     uco.ErrNotFound
+		invalid      uco.ErrInvalidUserInput
+		multiTenancy uco.ErrMultiTenancy
+	)
+	switch {
+	case errors.As(err, &forbidden):
+		return objects.NewObjectsExportForbidden().WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &notFound):
+		return objects.NewObjectsExportNotFound().WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &invalid), errors.As(err, &multiTenancy):
+		return objects.NewObjectsExportUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+	default:
+		return objects.NewObjectsExportInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
+func setupObjectsExportHandlers(api *operations.WeaviateAPI, batch *uco.BatchManager,
+	logger logrus.FieldLogger,
+) {
+	h := &objectsExportHandlers{batch: batch, logger: logger}
+
+	api.ObjectsObjectsExportHandler = objects.ObjectsExportHandlerFunc(h.export)
+}
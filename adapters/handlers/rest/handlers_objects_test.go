@@ -14,6 +14,7 @@ package rest
 import (
 	"context"
 	stderrors "errors"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
@@ -680,10 +681,12 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 		type test struct {
 			name           string
 			object         *models.Object
+			fields         *string
 			err            error
 			expectedResult *models.Object
 		}
 
+		selectedFields := "name, numericalField,missing"
 		tests := []test{
 			{
 				name:           "without props - noaction changes",
@@ -723,6 +726,19 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 					},
 				}},
 			},
+			{
+				name: "with selected fields",
+				object: &models.Object{Class: cls, Properties: map[string]interface{}{
+					"name":           "hello world",
+					"numericalField": 134,
+					"text":           "a long text",
+				}},
+				fields: &selectedFields,
+				expectedResult: &models.Object{Class: cls, Properties: map[string]interface{}{
+					"name":           "hello world",
+					"numericalField": 134,
+				}},
+			},
 			{
 				name: "error forbidden",
 				err:  errors.NewForbidden(&models.Principal{}, "get", "Myclass/123"),
@@ -748,6 +764,7 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 					HTTPRequest: httptest.NewRequest("GET", "/v1/objects/MyClass/123", nil),
 					ClassName:   cls,
 					ID:          "123",
+					Fields:      test.fields,
 				}
 				res := h.getObject(req, nil)
 				parsed, ok := res.(*objects.ObjectsClassGetOK)
@@ -1153,3 +1170,31 @@ func (f *fakeMetricRequestsTotal) logError(className string, err error)       {}
 func (f *fakeMetricRequestsTotal) logOk(className string)                     {}
 func (f *fakeMetricRequestsTotal) logUserError(className string)              {}
 func (f *fakeMetricRequestsTotal) logServerError(className string, err error) {}
+
+func TestDuplicatesActionsAreWrites(t *testing.T) {
+	class := "Article"
+	tests := []struct {
+		name     string
+		standby  bool
+		readOnly bool
+	}{
+		{"standby", true, false},
+		{"read-only", false, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &objectsDuplicatesHandlers{
+				standby:  fakeStandby(test.standby),
+				readOnly: fakeReadOnly(test.readOnly),
+			}
+			params := objects.ObjectsDuplicatesParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/v1/objects:duplicates", nil),
+				Body:        &models.DuplicatesRequest{Class: &class, Action: "delete"},
+			}
+
+			rec := httptest.NewRecorder()
+			h.findDuplicates(params, nil).WriteResponse(rec, nil)
+			assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	// maxUploadBytes limits the size of all files of a single upload
+	maxUploadBytes = 64 << 20
+	// maxUploadMemory is the part of an upload kept in memory, the rest is
+	// buffered in temporary files
+	maxUploadMemory = 16 << 20
+)
+
+// fileExtractor is implemented by the modules provider
+type fileExtractor interface {
+	ExtractFile(ctx context.Context, className string,
+		file modulecapabilities.File) (map[string]interface{}, error)
+}
+
+// objectsUploadHandlers create an object of a class from every uploaded
+// file. The text and metadata of the files are extracted by the enabled
+// File2Text module, which maps them to the properties of the class. A file
+// which can't be extracted or stored is reported in the response without
+// failing the other files.
+type objectsUploadHandlers struct {
+	authorizer authorization.Authorizer
+	manager    *uco.Manager
+	extractor  fileExtractor
+}
+
+func (h *objectsUploadHandlers) upload(params objects.ObjectsUploadParams,
+	principal *models.Principal,
+) middleware.Responder {
+	defer params.File.Close()
+	form := params.HTTPRequest.MultipartForm
+	defer form.RemoveAll()
+
+	tenant := getTenant(params.Tenant)
+	// files are only sent to the extraction module if the objects can be
+	// created at all
+	if err := h.authorizer.Authorize(principal, "create", authorization.Objects(params.Class, tenant, "")); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return objects.NewObjectsUploadForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return objects.NewObjectsUploadInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	// the parameters only bind the first file, an upload can contain more
+	files := form.File["file"]
+	res := &models.ObjectsUploadResponse{
+		Class:   params.Class,
+		Objects: make([]*models.UploadedObject, len(files)),
+	}
+	for i, header := range files {
+		res.Objects[i] = &models.UploadedObject{File: header.Filename}
+		id, err := h.createObject(params.HTTPRequest.Context(), principal, params.Class, tenant, header)
+		if err != nil {
+			res.Objects[i].Error = err.Error()
+			continue
+		}
+		res.Objects[i].ID = id
+	}
+	return objects.NewObjectsUploadOK().WithPayload(res)
+}
+
+func (h *objectsUploadHandlers) createObject(ctx context.Context,
+	principal *models.Principal, class, tenant string, header *multipart.FileHeader,
+) (strfmt.UUID, error) {
+	f, err := header.Open()
+	if err != nil {
+		return "", fmt.Errorf("open file: %w", err)
+	}
+	content, err := io.ReadAll(f)
+	f.Close()
+	if err != nil {
+		return "", fmt.Errorf("read file: %w", err)
+	}
+
+	props, err := h.extractor.ExtractFile(ctx, class, modulecapabilities.File{
+		Name:        header.Filename,
+		ContentType: header.Header.Get("Content-Type"),
+		Content:     content,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	obj, err := h.manager.AddObject(ctx, principal, &models.Object{
+		Class:      class,
+		Tenant:     tenant,
+		Properties: props,
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+	return obj.ID, nil
+}
+
+// limitUploadSize bounds the body of an upload before its parameters are
+// bound, which buffers the files beyond maxUploadMemory on disk
+func limitUploadSize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, maxUploadBytes)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func setupObjectsUploadHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer,
+	manager *uco.Manager, extractor fileExtractor,
+) {
+	h := &objectsUploadHandlers{authorizer: authorizer, manager: manager, extractor: extractor}
+
+	objects.ObjectsUploadMaxParseMemory = maxUploadMemory
+	api.ObjectsObjectsUploadHandler = objects.ObjectsUploadHandlerFunc(h.upload)
+}
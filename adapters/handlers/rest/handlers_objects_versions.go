@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+// objectVersionsHandlers serve the version history of objects of classes
+// with versioning enabled. The class may be omitted like in the deprecated
+// object endpoints, as long as the object exists.
+type objectVersionsHandlers struct {
+	manager *uco.Manager
+}
+
+func (h *objectVersionsHandlers) listClassVersions(params objects.ObjectsClassVersionsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	versions, err := h.manager.GetObjectVersions(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ID, getTenant(params.Tenant))
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &forbidden):
+			return objects.NewObjectsClassVersionsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrNotFound{}):
+			return objects.NewObjectsClassVersionsListNotFound()
+		case errors.As(err, &uco.ErrInvalidUserInput{}), errors.As(err, &uco.ErrMultiTenancy{}):
+			return objects.NewObjectsClassVersionsListUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return objects.NewObjectsClassVersionsListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	withVector := params.Include != nil && *params.Include == "vector"
+	payload := make([]*models.ObjectVersion, len(versions))
+	for i, v := range versions {
+		if v.Object != nil && !withVector {
+			v.Object.Vector = nil
+		}
+		payload[i] = &models.ObjectVersion{
+			Version: v.Version,
+			Deleted: v.Deleted,
+			Object:  v.Object,
+		}
+	}
+	return objects.NewObjectsClassVersionsListOK().WithPayload(payload)
+}
+
+func (h *objectVersionsHandlers) diffClassVersions(params objects.ObjectsClassVersionsDiffParams,
+	principal *models.Principal,
+) middleware.Responder {
+	var to int64
+	if params.To != nil {
+		to = *params.To
+	}
+	diff, err := h.manager.DiffObjectVersions(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ID, params.From, to, getTenant(params.Tenant))
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &forbidden):
+			return objects.NewObjectsClassVersionsDiffForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrNotFound{}):
+			return objects.NewObjectsClassVersionsDiffNotFound()
+		case errors.As(err, &uco.ErrInvalidUserInput{}), errors.As(err, &uco.ErrMultiTenancy{}):
+			return objects.NewObjectsClassVersionsDiffUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return objects.NewObjectsClassVersionsDiffInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := &models.ObjectVersionDiff{
+		ID:            diff.ID,
+		From:          diff.From,
+		To:            diff.To,
+		Changes:       make([]*models.ObjectPropertyChange, len(diff.Changes)),
+		VectorChanged: diff.VectorChanged,
+	}
+	for i, c := range diff.Changes {
+		payload.Changes[i] = &models.ObjectPropertyChange{
+			Property: c.Property,
+			From:     c.From,
+			To:       c.To,
+		}
+	}
+	return objects.NewObjectsClassVersionsDiffOK().WithPayload(payload)
+}
+
+func (h *objectVersionsHandlers) rollbackClassVersion(params objects.ObjectsClassVersionsRollbackParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		return objects.NewObjectsClassVersionsRollbackBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	obj, err := h.manager.RollbackObject(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ID, params.Version, getTenant(params.Tenant), repl)
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &forbidden):
+			return objects.NewObjectsClassVersionsRollbackForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrNotFound{}):
+			return objects.NewObjectsClassVersionsRollbackNotFound()
+		case errors.As(err, &uco.ErrInvalidUserInput{}), errors.As(err, &uco.ErrMultiTenancy{}):
+			return objects.NewObjectsClassVersionsRollbackUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return objects.NewObjectsClassVersionsRollbackInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return objects.NewObjectsClassVersionsRollbackOK().WithPayload(obj)
+}
+
+// the deprecated endpoints look up the object by its id only, their
+// responses are the same as the ones of the class-specific endpoints
+
+func (h *objectVersionsHandlers) listVersions(params objects.ObjectsVersionsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	return h.listClassVersions(objects.ObjectsClassVersionsListParams{
+		HTTPRequest: params.HTTPRequest,
+		ID:          params.ID,
+		Include:     params.Include,
+		Tenant:      params.Tenant,
+	}, principal)
+}
+
+func (h *objectVersionsHandlers) diffVersions(params objects.ObjectsVersionsDiffParams,
+	principal *models.Principal,
+) middleware.Responder {
+	return h.diffClassVersions(objects.ObjectsClassVersionsDiffParams{
+		HTTPRequest: params.HTTPRequest,
+		ID:          params.ID,
+		From:        params.From,
+		To:          params.To,
+		Tenant:      params.Tenant,
+	}, principal)
+}
+
+func (h *objectVersionsHandlers) rollbackVersion(params objects.ObjectsVersionsRollbackParams,
+	principal *models.Principal,
+) middleware.Responder {
+	return h.rollbackClassVersion(objects.ObjectsClassVersionsRollbackParams{
+		HTTPRequest:      params.HTTPRequest,
+		ID:               params.ID,
+		Version:          params.Version,
+		ConsistencyLevel: params.ConsistencyLevel,
+		Tenant:           params.Tenant,
+	}, principal)
+}
+
+func setupObjectVersionsHandlers(api *operations.WeaviateAPI, manager *uco.Manager) {
+	h := &objectVersionsHandlers{manager: manager}
+
+	api.ObjectsObjectsClassVersionsListHandler = objects.
+		ObjectsClassVersionsListHandlerFunc(h.listClassVersions)
+	api.ObjectsObjectsClassVersionsDiffHandler = objects.
+		ObjectsClassVersionsDiffHandlerFunc(h.diffClassVersions)
+	api.ObjectsObjectsClassVersionsRollbackHandler = objects.
+		ObjectsClassVersionsRollbackHandlerFunc(h.rollbackClassVersion)
+	api.ObjectsObjectsVersionsListHandler = objects.
+		ObjectsVersionsListHandlerFunc(h.listVersions)
+	api.ObjectsObjectsVersionsDiffHandler = objects.
+		ObjectsVersionsDiffHandlerFunc(h.diffVersions)
+	api.ObjectsObjectsVersionsRollbackHandler = objects.
+		ObjectsVersionsRollbackHandlerFunc(h.rollbackVersion)
+}
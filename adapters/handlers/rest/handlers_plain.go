@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+)
+
+// plainAuth authenticates requests to middlewares which are served outside
+// of the generated swagger API
+type plainAuth struct {
+	authComposer   composer.TokenFunc
+	allowAnonymous bool
+}
+
+func newPlainAuth(appState *state.State) plainAuth {
+	return plainAuth{
+		authComposer: composer.New(appState.ServerConfig.Config.Authentication,
+			appState.APIKey, appState.OIDC),
+		allowAnonymous: appState.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
+	}
+}
+
+// principal authenticates the request the same way the generated API does
+// for bearer tokens, falling back to anonymous access if it is enabled
+func (h plainAuth) principal(r *http.Request) (*models.Principal, error) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		if h.allowAnonymous {
+			return nil, nil
+		}
+		return h.authComposer("", nil)
+	}
+
+	return h.authComposer(strings.TrimPrefix(auth, "Bearer "), nil)
+}
+
+func writePlainError(w http.ResponseWriter, code int, err error) {
+	writePlainJSON(w, code, errPayloadFromSingleErr(err))
+}
+
+func writePlainJSON(w http.ResponseWriter, code int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(payload)
+}
@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// tooManyRequests is returned when a request exceeds the requests per
+// second quota of a class or tenant, or a batch is rejected by the batch
+// backpressure. The generated operations have no 429 response, so it is
+// written by hand.
+type tooManyRequests struct {
+	payload    *models.ErrorResponse
+	retryAfter time.Duration
+}
+
+func newTooManyRequests(err error) middleware.Responder {
+	// quotas are refilled continuously, one second is always enough to
+	// allow at least a single request again
+	return &tooManyRequests{payload: errPayloadFromSingleErr(err), retryAfter: time.Second}
+}
+
+func (r *tooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+	rw.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(r.retryAfter.Seconds()))))
+	rw.WriteHeader(http.StatusTooManyRequests)
+	if err := producer.Produce(rw, r.payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
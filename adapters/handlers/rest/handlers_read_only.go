@@ -0,0 +1,37 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+type readOnlyState interface {
+	ReadOnly() bool
+}
+
+// makeAddReadOnlyWriteGuard rejects client writes on read-only nodes, so
+// that clients retry them on another node. Writes replicated by other nodes
+// use the cluster API and are still accepted.
+func makeAddReadOnlyWriteGuard(s readOnlyState) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if s.ReadOnly() && isClientWrite(r) {
+				writePlainError(w, http.StatusServiceUnavailable, cluster.ErrReadOnly)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+// rebalanceHandlers serve the progress of changing the replication factor
+// or the shard count of a class, which is started by updating the class
+type rebalanceHandlers struct {
+	manager *schemaUC.Manager
+}
+
+func (h *rebalanceHandlers) getRebalance(params schema.SchemaObjectsRebalanceGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	job, err := h.manager.Rebalance(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &forbidden):
+			return schema.NewSchemaObjectsRebalanceGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrRebalanceNotFound):
+			return schema.NewSchemaObjectsRebalanceGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsRebalanceGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := &models.ClassRebalance{
+		Class:         job.Class,
+		FromFactor:    job.FromFactor,
+		ToFactor:      job.ToFactor,
+		Status:        job.Status,
+		Error:         job.Error,
+		ReplicasTotal: job.ReplicasTotal,
+		ReplicasDone:  job.ReplicasDone,
+		StartedAt:     strfmt.DateTime(job.StartedAt),
+	}
+	if job.CompletedAt != nil {
+		completedAt := strfmt.DateTime(*job.CompletedAt)
+		payload.CompletedAt = &completedAt
+	}
+	return schema.NewSchemaObjectsRebalanceGetOK().WithPayload(payload)
+}
+
+func (h *rebalanceHandlers) getReshard(params schema.SchemaObjectsReshardGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	job, err := h.manager.Reshard(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &forbidden):
+			return schema.NewSchemaObjectsReshardGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrReshardNotFound):
+			return schema.NewSchemaObjectsReshardGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsReshardGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := &models.ClassReshard{
+		Class:        job.Class,
+		FromCount:    int64(job.FromCount),
+		ToCount:      int64(job.ToCount),
+		Status:       job.Status,
+		Error:        job.Error,
+		ShardsTotal:  job.ShardsTotal,
+		ShardsDone:   job.ShardsDone,
+		ObjectsMoved: job.ObjectsMoved,
+		StartedAt:    strfmt.DateTime(job.StartedAt),
+	}
+	if job.CompletedAt != nil {
+		completedAt := strfmt.DateTime(*job.CompletedAt)
+		payload.CompletedAt = &completedAt
+	}
+	return schema.NewSchemaObjectsReshardGetOK().WithPayload(payload)
+}
+
+func setupRebalanceHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager) {
+	h := &rebalanceHandlers{manager: manager}
+
+	api.SchemaSchemaObjectsRebalanceGetHandler = schema.
+		SchemaObjectsRebalanceGetHandlerFunc(h.getRebalance)
+	api.SchemaSchemaObjectsReshardGetHandler = schema.
+		SchemaObjectsReshardGetHandlerFunc(h.getReshard)
+}
@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/debug"
+	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// recallHandlers evaluate the recall of the vector indexes of a class on the
+// node serving the request, to tune the ef setting against the real data
+type recallHandlers struct {
+	authorizer authorization.Authorizer
+	db         *db.DB
+}
+
+func (h *recallHandlers) evaluate(params debug.DebugRecallEvaluateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	// the evaluation only reads, but it is as expensive as a full scan of the
+	// class, so it requires the permission to manage the shards
+	err := h.authorizer.Authorize(principal, "update", authorization.ShardsMetadata(*params.Body.Class))
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return debug.NewDebugRecallEvaluateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return debug.NewDebugRecallEvaluateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.db == nil {
+		return debug.NewDebugRecallEvaluateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errDatabaseUnavailable))
+	}
+
+	recallParams := db.RecallParams{
+		Class:      *params.Body.Class,
+		Tenant:     params.Body.Tenant,
+		SampleSize: int(params.Body.SampleSize),
+		Limit:      int(params.Body.Limit),
+		EF:         make([]int, len(params.Body.Ef)),
+	}
+	for i, ef := range params.Body.Ef {
+		recallParams.EF[i] = int(ef)
+	}
+
+	report, err := h.db.EvaluateRecall(params.HTTPRequest.Context(), recallParams)
+	if err != nil {
+		switch {
+		case errors.As(err, &objects.ErrNotFound{}):
+			return debug.NewDebugRecallEvaluateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &objects.ErrInvalidUserInput{}), errors.As(err, &objects.ErrMultiTenancy{}):
+			return debug.NewDebugRecallEvaluateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return debug.NewDebugRecallEvaluateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return debug.NewDebugRecallEvaluateOK().WithPayload(recallReportToModel(report))
+}
+
+func recallReportToModel(report *db.RecallReport) *models.RecallReport {
+	out := &models.RecallReport{
+		Class:             report.Class,
+		Shards:            report.Shards,
+		Queries:           int64(report.Queries),
+		Limit:             int64(report.Limit),
+		GroundTruthTookMs: report.GroundTruthTookMs,
+		Points:            make([]*models.RecallPoint, len(report.Points)),
+	}
+	for i, p := range report.Points {
+		out.Points[i] = &models.RecallPoint{
+			Ef:            int64(p.EF),
+			Recall:        p.Recall,
+			MeanLatencyMs: p.MeanLatencyMs,
+			P50LatencyMs:  p.P50LatencyMs,
+			P99LatencyMs:  p.P99LatencyMs,
+		}
+	}
+	return out
+}
+
+func setupRecallHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer, repo *db.DB) {
+	h := &recallHandlers{authorizer: authorizer, db: repo}
+
+	api.DebugDebugRecallEvaluateHandler = debug.DebugRecallEvaluateHandlerFunc(h.evaluate)
+}
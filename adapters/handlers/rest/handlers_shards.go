@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/balancer"
+)
+
+var errShardBalancerUnavailable = fmt.Errorf("shard balancer is not available")
+
+// shardHandlers serve the placement of shard replicas
+type shardHandlers struct {
+	authorizer authorization.Authorizer
+	manager    *balancer.Manager
+}
+
+func (h *shardHandlers) getStatus(params cluster.ClusterShardsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", authorization.ShardPlacement()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return cluster.NewClusterShardsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return cluster.NewClusterShardsGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.manager == nil {
+		return cluster.NewClusterShardsGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errShardBalancerUnavailable))
+	}
+
+	return cluster.NewClusterShardsGetOK().
+		WithPayload(shardBalancerStatusToModel(h.manager.Status()))
+}
+
+func (h *shardHandlers) updateBandwidth(params cluster.ClusterShardsBandwidthUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "update", authorization.ShardPlacement()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return cluster.NewClusterShardsBandwidthUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return cluster.NewClusterShardsBandwidthUpdateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.manager == nil {
+		return cluster.NewClusterShardsBandwidthUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errShardBalancerUnavailable))
+	}
+
+	if err := h.manager.SetBandwidthLimit(*params.Body.BytesPerSecond); err != nil {
+		return cluster.NewClusterShardsBandwidthUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	return cluster.NewClusterShardsBandwidthUpdateOK().
+		WithPayload(shardBalancerStatusToModel(h.manager.Status()))
+}
+
+func (h *shardHandlers) move(params cluster.ClusterShardsMoveParams,
+	principal *models.Principal,
+) middleware.Responder {
+	class := ""
+	if params.Class != nil {
+		class = *params.Class
+	}
+	source := ""
+	if params.Source != nil {
+		source = *params.Source
+	}
+
+	if err := h.authorizer.Authorize(principal, "update", authorization.ShardsMetadata(class)); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return cluster.NewClusterShardsMoveForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return cluster.NewClusterShardsMoveInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.manager == nil {
+		return cluster.NewClusterShardsMoveUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errShardBalancerUnavailable))
+	}
+
+	move, err := h.manager.Move(class, params.ShardName, source, params.Target)
+	if err != nil {
+		switch {
+		case errors.Is(err, balancer.ErrNotFound):
+			return cluster.NewClusterShardsMoveNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, balancer.ErrInvalid):
+			return cluster.NewClusterShardsMoveUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, balancer.ErrRunning):
+			return cluster.NewClusterShardsMoveConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterShardsMoveInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return cluster.NewClusterShardsMoveAccepted().WithPayload(shardMoveToModel(move))
+}
+
+func shardBalancerStatusToModel(status balancer.Status) *models.ShardBalancerStatus {
+	out := &models.ShardBalancerStatus{
+		Enabled:        status.Enabled,
+		Interval:       status.Interval,
+		Threshold:      int64(status.Threshold),
+		BandwidthLimit: status.BandwidthLimit,
+		Replicas:       make(map[string]int64, len(status.Replicas)),
+		Moves:          make([]*models.ShardMove, len(status.Moves)),
+	}
+	for node, replicas := range status.Replicas {
+		out.Replicas[node] = int64(replicas)
+	}
+	for i, move := range status.Moves {
+		out.Moves[i] = shardMoveToModel(move)
+	}
+	return out
+}
+
+func shardMoveToModel(move balancer.Move) *models.ShardMove {
+	out := &models.ShardMove{
+		Class:     move.Class,
+		Shard:     move.Shard,
+		Source:    move.Source,
+		Target:    move.Target,
+		Trigger:   move.Trigger,
+		Status:    move.Status,
+		Error:     move.Error,
+		Bytes:     move.Bytes,
+		StartedAt: strfmt.DateTime(move.StartedAt),
+	}
+	if move.FinishedAt != nil {
+		finishedAt := strfmt.DateTime(*move.FinishedAt)
+		out.FinishedAt = &finishedAt
+	}
+	return out
+}
+
+func setupShardHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer,
+	manager *balancer.Manager,
+) {
+	h := &shardHandlers{authorizer: authorizer, manager: manager}
+
+	api.ClusterClusterShardsGetHandler = cluster.
+		ClusterShardsGetHandlerFunc(h.getStatus)
+	api.ClusterClusterShardsBandwidthUpdateHandler = cluster.
+		ClusterShardsBandwidthUpdateHandlerFunc(h.updateBandwidth)
+	api.ClusterClusterShardsMoveHandler = cluster.
+		ClusterShardsMoveHandlerFunc(h.move)
+}
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/debug"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/slowquery"
+)
+
+var errSlowQueryLogDisabled = fmt.Errorf("slow query log is not enabled")
+
+// slowQueryHandlers export the slow query log of the node serving the
+// request
+type slowQueryHandlers struct {
+	authorizer authorization.Authorizer
+	log        *slowquery.Log
+}
+
+func (h *slowQueryHandlers) getSlowQueries(params debug.SlowQueriesGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", authorization.SlowQueries()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return debug.NewSlowQueriesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return debug.NewSlowQueriesGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.log == nil {
+		return debug.NewSlowQueriesGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errSlowQueryLogDisabled))
+	}
+
+	entries := h.log.Entries()
+	payload := &models.SlowQueriesResponse{Entries: make([]*models.SlowQuery, len(entries))}
+	for i, entry := range entries {
+		shards := make([]interface{}, len(entry.Shards))
+		for j, shard := range entry.Shards {
+			shards[j] = shard
+		}
+		payload.Entries[i] = &models.SlowQuery{
+			Time:   strfmt.DateTime(entry.Time),
+			Type:   entry.Type,
+			Class:  entry.Class,
+			Tenant: entry.Tenant,
+			TookMs: entry.TookMs,
+			Params: entry.Params,
+			Shards: shards,
+			Error:  entry.Error,
+		}
+	}
+	return debug.NewSlowQueriesGetOK().WithPayload(payload)
+}
+
+func (h *slowQueryHandlers) deleteSlowQueries(params debug.SlowQueriesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "delete", authorization.SlowQueries()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return debug.NewSlowQueriesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return debug.NewSlowQueriesDeleteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.log == nil {
+		return debug.NewSlowQueriesDeleteUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errSlowQueryLogDisabled))
+	}
+
+	h.log.Clear()
+	return debug.NewSlowQueriesDeleteNoContent()
+}
+
+func setupSlowQueryHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer,
+	log *slowquery.Log,
+) {
+	h := &slowQueryHandlers{authorizer: authorizer, log: log}
+
+	api.DebugSlowQueriesGetHandler = debug.SlowQueriesGetHandlerFunc(h.getSlowQueries)
+	api.DebugSlowQueriesDeleteHandler = debug.SlowQueriesDeleteHandlerFunc(h.deleteSlowQueries)
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/sql"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	usql "github.com/weaviate/weaviate/usecases/sql"
+)
+
+// sqlHandlers answer SQL queries for analytics tools. The same queries are
+// served with the Postgres wire protocol if its port is set.
+type sqlHandlers struct {
+	executor *usql.Executor
+}
+
+func (h *sqlHandlers) query(params sql.SQLQueryParams,
+	principal *models.Principal,
+) middleware.Responder {
+	res, err := h.executor.Query(params.HTTPRequest.Context(), principal,
+		*params.Body.Query, params.Body.Tenant)
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.Is(err, usql.ErrInvalidQuery):
+			return sql.NewSQLQueryUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &forbidden):
+			return sql.NewSQLQueryForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return sql.NewSQLQueryInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	columns := make([]*models.SQLColumn, len(res.Columns))
+	for i, column := range res.Columns {
+		columns[i] = &models.SQLColumn{Name: column.Name, Type: column.Type}
+	}
+	return sql.NewSQLQueryOK().WithPayload(&models.SQLResult{
+		Columns: columns,
+		Rows:    res.Rows,
+	})
+}
+
+func setupSQLHandlers(api *operations.WeaviateAPI, executor *usql.Executor) {
+	h := &sqlHandlers{executor: executor}
+
+	api.SQLSQLQueryHandler = sql.SQLQueryHandlerFunc(h.query)
+}
@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/standby"
+)
+
+// errNotStandby is returned if a cluster which is not configured as a
+// standby is asked about its standby status
+var errNotStandby = fmt.Errorf("this cluster is not configured as a standby")
+
+type standbyHandlers struct {
+	authorizer authorization.Authorizer
+	standby    *standby.Manager
+}
+
+func (h *standbyHandlers) getStatus(params replication.ReplicationStandbyGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", authorization.Standby()); err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return replication.NewReplicationStandbyGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationStandbyGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	if h.standby == nil {
+		return replication.NewReplicationStandbyGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errNotStandby))
+	}
+
+	return replication.NewReplicationStandbyGetOK().
+		WithPayload(standbyStatusToModel(h.standby.Status()))
+}
+
+func (h *standbyHandlers) promote(params replication.ReplicationStandbyPromoteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "update", authorization.Standby()); err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return replication.NewReplicationStandbyPromoteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationStandbyPromoteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	if h.standby == nil {
+		return replication.NewReplicationStandbyPromoteUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errNotStandby))
+	}
+
+	force := params.Force != nil && *params.Force
+	if err := h.standby.Promote(params.HTTPRequest.Context(), force); err != nil {
+		return replication.NewReplicationStandbyPromoteConflict().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	return replication.NewReplicationStandbyPromoteOK().
+		WithPayload(standbyStatusToModel(h.standby.Status()))
+}
+
+func standbyStatusToModel(status standby.Status) *models.StandbyStatus {
+	out := &models.StandbyStatus{
+		Active:   status.Active,
+		Promoted: status.Promoted,
+		Backend:  status.Backend,
+		Nodes:    make([]*models.StandbyNodeStatus, len(status.Nodes)),
+	}
+	if status.PromotedAt != nil {
+		promotedAt := strfmt.DateTime(*status.PromotedAt)
+		out.PromotedAt = &promotedAt
+	}
+	if status.LastSync != nil {
+		lastSync := strfmt.DateTime(*status.LastSync)
+		out.LastSync = &lastSync
+	}
+	for i, node := range status.Nodes {
+		n := &models.StandbyNodeStatus{
+			Name:       node.Name,
+			LastChange: strfmt.DateTime(node.LastChange),
+			LagSeconds: node.LagSeconds,
+			Error:      node.Error,
+		}
+		if node.SyncedUntil != nil {
+			syncedUntil := strfmt.DateTime(*node.SyncedUntil)
+			n.SyncedUntil = &syncedUntil
+		}
+		out.Nodes[i] = n
+	}
+	return out
+}
+
+func setupStandbyHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer,
+	manager *standby.Manager,
+) {
+	h := &standbyHandlers{authorizer: authorizer, standby: manager}
+
+	api.ReplicationReplicationStandbyGetHandler = replication.
+		ReplicationStandbyGetHandlerFunc(h.getStatus)
+	api.ReplicationReplicationStandbyPromoteHandler = replication.
+		ReplicationStandbyPromoteHandlerFunc(h.promote)
+}
+
+type standbyState interface {
+	Active() bool
+}
+
+// clientWritePaths are the paths of client writes, which a standby rejects
+// since it only applies the changes of its primary. Read-only nodes reject
+// them as well.
+var clientWritePaths = []string{
+	"/v1/objects", "/v1/objects:upload", "/v1/batch", "/v1/imports", "/v1/imports:csv",
+	"/v1/schema", "/v1/classifications", "/v1/transactions",
+}
+
+func makeAddStandbyWriteGuard(s standbyState) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if s.Active() && isClientWrite(r) {
+				writePlainError(w, http.StatusServiceUnavailable, standby.ErrStandby)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isClientWrite(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return false
+	}
+	if r.URL.Path == "/v1/objects/validate" {
+		return false
+	}
+
+	for _, path := range clientWritePaths {
+		if r.URL.Path == path || strings.HasPrefix(r.URL.Path, path+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
+	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+var errDatabaseUnavailable = fmt.Errorf("database is not available")
+
+// startupHandlers report the loading of the shards of the node serving the
+// request, so that a slow restart can be told apart from a hung one
+type startupHandlers struct {
+	authorizer authorization.Authorizer
+	db         *db.DB
+}
+
+func (h *startupHandlers) getProgress(params nodes.StartupGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", authorization.Startup()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return nodes.NewStartupGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return nodes.NewStartupGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.db == nil {
+		return nodes.NewStartupGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errDatabaseUnavailable))
+	}
+
+	return nodes.NewStartupGetOK().WithPayload(startupProgressToModel(h.db.StartupProgress()))
+}
+
+func (h *startupHandlers) updatePriority(params nodes.StartupPriorityUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "update", authorization.Startup()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return nodes.NewStartupPriorityUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return nodes.NewStartupPriorityUpdateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.db == nil {
+		return nodes.NewStartupPriorityUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errDatabaseUnavailable))
+	}
+
+	h.db.PrioritizeStartup(params.Body.Classes)
+	return nodes.NewStartupPriorityUpdateOK().WithPayload(startupProgressToModel(h.db.StartupProgress()))
+}
+
+func startupProgressToModel(progress db.StartupProgress) *models.StartupProgress {
+	out := &models.StartupProgress{
+		StartupComplete: progress.StartupComplete,
+		StartedAt:       strfmt.DateTime(progress.StartedAt),
+		Total:           int64(progress.Total),
+		Pending:         int64(progress.Pending),
+		Loading:         int64(progress.Loading),
+		Loaded:          int64(progress.Loaded),
+		Failed:          int64(progress.Failed),
+		EtaSeconds:      progress.ETASeconds,
+		PriorityClasses: progress.PriorityClasses,
+		Shards:          make([]*models.ShardStartup, len(progress.Shards)),
+	}
+	for i, shard := range progress.Shards {
+		s := &models.ShardStartup{
+			Class:           shard.Class,
+			Shard:           shard.Shard,
+			Status:          shard.Status,
+			Phase:           shard.Phase,
+			WalReplay:       shard.WALReplay,
+			VectorIndexLoad: shard.VectorIndexLoad,
+			EtaSeconds:      shard.ETASeconds,
+			Error:           shard.Error,
+		}
+		if shard.StartedAt != nil {
+			startedAt := strfmt.DateTime(*shard.StartedAt)
+			s.StartedAt = &startedAt
+		}
+		if shard.FinishedAt != nil {
+			finishedAt := strfmt.DateTime(*shard.FinishedAt)
+			s.FinishedAt = &finishedAt
+		}
+		out.Shards[i] = s
+	}
+	return out
+}
+
+func setupStartupHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer, repo *db.DB) {
+	h := &startupHandlers{authorizer: authorizer, db: repo}
+
+	api.NodesStartupGetHandler = nodes.StartupGetHandlerFunc(h.getProgress)
+	api.NodesStartupPriorityUpdateHandler = nodes.StartupPriorityUpdateHandlerFunc(h.updatePriority)
+}
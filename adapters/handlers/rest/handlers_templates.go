@@ -0,0 +1,257 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/templates"
+)
+
+// queryTemplatesHandlers serve the query templates, which are GraphQL
+// queries stored under a name. Executing a template returns the same
+// response as /v1/graphql.
+type queryTemplatesHandlers struct {
+	authorizer  authorization.Authorizer
+	templates   *templates.Store
+	gqlProvider graphQLProvider
+	disabled    bool
+}
+
+func (h *queryTemplatesHandlers) list(params graphql.GraphqlTemplatesListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "list", authorization.QueryTemplates("")); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return graphql.NewGraphqlTemplatesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return graphql.NewGraphqlTemplatesListInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	list := h.templates.List()
+	out := make([]*models.QueryTemplate, len(list))
+	for i, t := range list {
+		out[i] = queryTemplateToModel(t)
+	}
+	return graphql.NewGraphqlTemplatesListOK().WithPayload(out)
+}
+
+func (h *queryTemplatesHandlers) get(params graphql.GraphqlTemplatesGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", authorization.QueryTemplates(params.Name)); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return graphql.NewGraphqlTemplatesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return graphql.NewGraphqlTemplatesGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	t, err := h.templates.Get(params.Name)
+	if err != nil {
+		if errors.Is(err, templates.ErrNotFound) {
+			return graphql.NewGraphqlTemplatesGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return graphql.NewGraphqlTemplatesGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return graphql.NewGraphqlTemplatesGetOK().WithPayload(queryTemplateToModel(t))
+}
+
+func (h *queryTemplatesHandlers) put(params graphql.GraphqlTemplatesPutParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "update", authorization.QueryTemplates(params.Name)); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return graphql.NewGraphqlTemplatesPutForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return graphql.NewGraphqlTemplatesPutInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if params.Body.Name != "" && params.Body.Name != params.Name {
+		return graphql.NewGraphqlTemplatesPutUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(
+				fmt.Errorf("name %q does not match the path", params.Body.Name)))
+	}
+	defaults, ok := params.Body.Defaults.(map[string]interface{})
+	if params.Body.Defaults != nil && !ok {
+		return graphql.NewGraphqlTemplatesPutUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf(
+				"expected defaults to be an object, got %T", params.Body.Defaults)))
+	}
+
+	t, err := h.templates.Put(templates.Template{
+		Name:        params.Name,
+		Description: params.Body.Description,
+		Query:       *params.Body.Query,
+		Defaults:    defaults,
+	})
+	if err != nil {
+		return graphql.NewGraphqlTemplatesPutUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return graphql.NewGraphqlTemplatesPutOK().WithPayload(queryTemplateToModel(t))
+}
+
+func (h *queryTemplatesHandlers) delete(params graphql.GraphqlTemplatesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "delete", authorization.QueryTemplates(params.Name)); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return graphql.NewGraphqlTemplatesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return graphql.NewGraphqlTemplatesDeleteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.templates.Delete(params.Name); err != nil {
+		if errors.Is(err, templates.ErrNotFound) {
+			return graphql.NewGraphqlTemplatesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return graphql.NewGraphqlTemplatesDeleteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return graphql.NewGraphqlTemplatesDeleteNoContent()
+}
+
+func (h *queryTemplatesHandlers) execute(params graphql.GraphqlTemplatesExecuteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizeExecute(principal, params.Name); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return graphql.NewGraphqlTemplatesExecuteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return graphql.NewGraphqlTemplatesExecuteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.disabled {
+		return graphql.NewGraphqlTemplatesExecuteUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("graphql api is disabled")))
+	}
+
+	t, err := h.templates.Get(params.Name)
+	if err != nil {
+		if errors.Is(err, templates.ErrNotFound) {
+			return graphql.NewGraphqlTemplatesExecuteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return graphql.NewGraphqlTemplatesExecuteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	var requested map[string]interface{}
+	if params.Body != nil && params.Body.Variables != nil {
+		var ok bool
+		if requested, ok = params.Body.Variables.(map[string]interface{}); !ok {
+			return graphql.NewGraphqlTemplatesExecuteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf(
+					"expected variables to be an object, got %T", params.Body.Variables)))
+		}
+	}
+	variables, err := t.Bind(requested)
+	if err != nil {
+		return graphql.NewGraphqlTemplatesExecuteUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	graphQL := h.gqlProvider.GetGraphQL()
+	if graphQL == nil {
+		return graphql.NewGraphqlTemplatesExecuteUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("no graphql provider " +
+				"present, this is most likely because no schema is present. Import a schema first!")))
+	}
+
+	ctx := context.WithValue(params.HTTPRequest.Context(), "principal", principal)
+	ctx, partial := search.WithPartialResults(ctx)
+	result := graphQL.Resolve(ctx, t.Query, "", variables)
+
+	// convert the result like the /v1/graphql endpoint
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return graphql.NewGraphqlTemplatesExecuteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("marshal result: %w", err)))
+	}
+	var res models.GraphQLResponse
+	if err := json.Unmarshal(resultJSON, &res); err != nil {
+		return graphql.NewGraphqlTemplatesExecuteInternalServerError().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("unmarshal result: %w", err)))
+	}
+	res.Errors = append(res.Errors, partialResultsErrors(partial)...)
+	return graphql.NewGraphqlTemplatesExecuteOK().WithPayload(&res)
+}
+
+// authorizeExecute checks the permissions to read the template and, like for
+// all GraphQL requests, to read the schema
+func (h *queryTemplatesHandlers) authorizeExecute(principal *models.Principal, name string) error {
+	if err := h.authorizer.Authorize(principal, "get", authorization.QueryTemplates(name)); err != nil {
+		return err
+	}
+	return h.authorizer.Authorize(principal, "list", authorization.CollectionsMetadata(""))
+}
+
+func queryTemplateToModel(t templates.Template) *models.QueryTemplate {
+	query := t.Query
+	out := &models.QueryTemplate{
+		Name:        t.Name,
+		Description: t.Description,
+		Query:       &query,
+		Variables:   t.Variables,
+		CreatedAt:   strfmt.DateTime(t.CreatedAt),
+		UpdatedAt:   strfmt.DateTime(t.UpdatedAt),
+	}
+	// a nil map would be returned as null instead of being omitted
+	if t.Defaults != nil {
+		out.Defaults = t.Defaults
+	}
+	return out
+}
+
+func setupQueryTemplatesHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer,
+	store *templates.Store, gqlProvider graphQLProvider, disabled bool,
+) {
+	h := &queryTemplatesHandlers{
+		authorizer:  authorizer,
+		templates:   store,
+		gqlProvider: gqlProvider,
+		disabled:    disabled,
+	}
+
+	api.GraphqlGraphqlTemplatesListHandler = graphql.GraphqlTemplatesListHandlerFunc(h.list)
+	api.GraphqlGraphqlTemplatesGetHandler = graphql.GraphqlTemplatesGetHandlerFunc(h.get)
+	api.GraphqlGraphqlTemplatesPutHandler = graphql.GraphqlTemplatesPutHandlerFunc(h.put)
+	api.GraphqlGraphqlTemplatesDeleteHandler = graphql.GraphqlTemplatesDeleteHandlerFunc(h.delete)
+	api.GraphqlGraphqlTemplatesExecuteHandler = graphql.GraphqlTemplatesExecuteHandlerFunc(h.execute)
+}
@@ -0,0 +1,322 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	entschema "github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/objects"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+// tenantsHandlers serve the tenant jobs, statistics, export and import.
+// The tenants themselves are served by the schemaHandlers.
+type tenantsHandlers struct {
+	authorizer authorization.Authorizer
+	manager    *schemaUC.Manager
+	stats      tenantStatsGetter
+	batch      *objects.BatchManager
+	logger     logrus.FieldLogger
+}
+
+type tenantStatsGetter interface {
+	TenantStats(ctx context.Context, class, tenant string) (*entschema.TenantStats, error)
+}
+
+func (h *tenantsHandlers) createJob(params schema.TenantsJobsCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	req := schemaUC.TenantsJobRequest{
+		Operation: params.Body.Operation,
+		Tenants:   params.Body.Tenants,
+	}
+	job, err := h.manager.StartTenantsJob(params.HTTPRequest.Context(), principal, params.ClassName, req)
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &forbidden):
+			return schema.NewTenantsJobsCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewTenantsJobsCreateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsJobsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsJobsCreateAccepted().WithPayload(tenantsJobToModel(job))
+}
+
+func (h *tenantsHandlers) listJobs(params schema.TenantsJobsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	jobs, err := h.manager.TenantsJobs(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &forbidden):
+			return schema.NewTenantsJobsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewTenantsJobsListNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsJobsListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := make([]*models.TenantsJob, len(jobs))
+	for i, job := range jobs {
+		payload[i] = tenantsJobToModel(job)
+	}
+	return schema.NewTenantsJobsListOK().WithPayload(payload)
+}
+
+func (h *tenantsHandlers) getJob(params schema.TenantsJobsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	job, err := h.manager.TenantsJob(params.HTTPRequest.Context(), principal, params.ClassName, params.ID)
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &forbidden):
+			return schema.NewTenantsJobsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrTenantsJobNotFound), errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewTenantsJobsGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsJobsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsJobsGetOK().WithPayload(tenantsJobToModel(job))
+}
+
+func (h *tenantsHandlers) getStats(params schema.TenantsStatsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	class := entschema.UppercaseClassName(params.ClassName)
+	err := h.authorizer.Authorize(principal, "get", authorization.TenantsMetadata(class, params.TenantName))
+	if err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return schema.NewTenantsStatsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return schema.NewTenantsStatsGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if err := h.tenantExists(class, params.TenantName); err != nil {
+		return schema.NewTenantsStatsGetNotFound().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	stats, err := h.stats.TenantStats(params.HTTPRequest.Context(), class, params.TenantName)
+	if err != nil {
+		return schema.NewTenantsStatsGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	payload := &models.TenantStats{
+		Class:            stats.Class,
+		Tenant:           stats.Tenant,
+		Node:             stats.Node,
+		ActivityStatus:   stats.ActivityStatus,
+		ObjectCount:      stats.ObjectCount,
+		DiskUsageBytes:   stats.DiskUsageBytes,
+		VectorIndexBytes: stats.VectorIndexBytes,
+	}
+	if stats.LastAccess != nil {
+		lastAccess := strfmt.DateTime(*stats.LastAccess)
+		payload.LastAccess = &lastAccess
+	}
+	return schema.NewTenantsStatsGetOK().WithPayload(payload)
+}
+
+func (h *tenantsHandlers) export(params schema.TenantsExportParams,
+	principal *models.Principal,
+) middleware.Responder {
+	class := entschema.UppercaseClassName(params.ClassName)
+	err := h.authorizer.Authorize(principal, "get", authorization.Objects(class, params.TenantName, ""))
+	if err != nil {
+		return tenantTransferError(err)
+	}
+	if err := h.tenantExists(class, params.TenantName); err != nil {
+		return schema.NewTenantsExportNotFound().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	// the export is streamed, so its status is only known once it wrote its
+	// first bytes, errors after that can only be logged
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		out := &exportWriter{
+			w:           w,
+			contentType: "application/gzip",
+			filename:    fmt.Sprintf("%s-%s.json.gz", class, params.TenantName),
+		}
+		err := h.batch.ExportTenant(params.HTTPRequest.Context(), principal, class, params.TenantName, out)
+		if err == nil {
+			return
+		}
+		if !out.started {
+			writeTenantTransferError(w, err)
+			return
+		}
+		h.logger.WithField("action", "tenant_export").
+			WithField("class", class).
+			WithField("tenant", params.TenantName).
+			WithError(err).Error("export tenant")
+	})
+}
+
+func (h *tenantsHandlers) importTenant(params schema.TenantsImportParams,
+	principal *models.Principal,
+) middleware.Responder {
+	defer params.Body.Close()
+
+	class := entschema.UppercaseClassName(params.ClassName)
+	err := h.authorizer.Authorize(principal, "create", authorization.Objects(class, params.TenantName, ""))
+	if err != nil {
+		return tenantTransferError(err)
+	}
+	if err := h.tenantExists(class, params.TenantName); err != nil {
+		return schema.NewTenantsImportNotFound().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	res, err := h.batch.ImportTenant(params.HTTPRequest.Context(), principal, class, params.TenantName, params.Body)
+	if err != nil {
+		return tenantTransferError(err)
+	}
+
+	return schema.NewTenantsImportOK().WithPayload(&models.TenantImportResult{
+		Class:    res.Class,
+		Tenant:   res.Tenant,
+		Imported: int64(res.Imported),
+		Failed:   int64(res.Failed),
+		Errors:   res.Errors,
+	})
+}
+
+func (h *tenantsHandlers) tenantExists(class, tenant string) error {
+	if _, status := h.manager.TenantShard(class, tenant); status == "" {
+		return fmt.Errorf("tenant %q of class %q not found", tenant, class)
+	}
+	return nil
+}
+
+func tenantsJobToModel(job *schemaUC.TenantsJob) *models.TenantsJob {
+	failed := make([]*models.TenantFailure, len(job.Failed))
+	for i, f := range job.Failed {
+		failed[i] = &models.TenantFailure{Name: f.Name, Error: f.Error}
+	}
+	out := &models.TenantsJob{
+		ID:        job.ID,
+		Class:     job.Class,
+		Operation: job.Operation,
+		Status:    job.Status,
+		Total:     int64(job.Total),
+		Succeeded: int64(job.Succeeded),
+		Failed:    failed,
+		StartedAt: strfmt.DateTime(job.StartedAt),
+	}
+	if job.CompletedAt != nil {
+		completedAt := strfmt.DateTime(*job.CompletedAt)
+		out.CompletedAt = &completedAt
+	}
+	return out
+}
+
+// exportWriter sets the headers of an export when it is first written to
+type exportWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (e *exportWriter) Write(p []byte) (int, error) {
+	e.start()
+	return e.w.Write(p)
+}
+
+func (e *exportWriter) start() {
+	if e.started {
+		return
+	}
+	e.started = true
+	e.w.Header().Set("Content-Type", e.contentType)
+	e.w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=%q", e.filename))
+	e.w.WriteHeader(http.StatusOK)
+}
+
+// tenantTransferError is the response to a failed export or import. Both
+// share their errors, so the responses of the import are used for both.
+func tenantTransferError(err error) middleware.Responder {
+	var (
+		forbidden    autherrs.Forbidden
+		notFound     objects.ErrNotFound
+		invalid      objects.ErrInvalidUserInput
+		multiTenancy objects.ErrMultiTenancy
+	)
+	switch {
+	case errors.As(err, &forbidden):
+		return schema.NewTenantsImportForbidden().WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &notFound):
+		return schema.NewTenantsImportNotFound().WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &invalid), errors.As(err, &multiTenancy):
+		return schema.NewTenantsImportUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+	default:
+		return schema.NewTenantsImportInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
+func writeTenantTransferError(w http.ResponseWriter, err error) {
+	tenantTransferError(err).WriteResponse(w, runtime.JSONProducer())
+}
+
+func setupTenantsHandlers(api *operations.WeaviateAPI, appState *state.State) {
+	h := &tenantsHandlers{
+		authorizer: appState.Authorizer,
+		manager:    appState.SchemaManager,
+		stats:      appState.DB,
+		batch:      appState.BatchManager,
+		logger:     appState.Logger,
+	}
+
+	api.SchemaTenantsJobsCreateHandler = schema.TenantsJobsCreateHandlerFunc(h.createJob)
+	api.SchemaTenantsJobsListHandler = schema.TenantsJobsListHandlerFunc(h.listJobs)
+	api.SchemaTenantsJobsGetHandler = schema.TenantsJobsGetHandlerFunc(h.getJob)
+	api.SchemaTenantsStatsGetHandler = schema.TenantsStatsGetHandlerFunc(h.getStats)
+	api.SchemaTenantsExportHandler = schema.TenantsExportHandlerFunc(h.export)
+	api.SchemaTenantsImportHandler = schema.TenantsImportHandlerFunc(h.importTenant)
+}
@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/quota"
+)
+
+// transactionsHandlers apply several object writes all-or-nothing. If an
+// operation fails, the operations before it are rolled back. This is atomic
+// if all operations fall on one shard and best-effort otherwise, the
+// response tells which applied.
+type transactionsHandlers struct {
+	manager *uco.Manager
+}
+
+func (h *transactionsHandlers) apply(params objects.ObjectsTransactionParams,
+	principal *models.Principal,
+) middleware.Responder {
+	var consistencyLevel *string
+	if params.Body.ConsistencyLevel != "" {
+		consistencyLevel = &params.Body.ConsistencyLevel
+	}
+	repl, err := getReplicationProperties(consistencyLevel, nil)
+	if err != nil {
+		return objects.NewObjectsTransactionBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	ops := make([]uco.TransactionOperation, len(params.Body.Operations))
+	for i, op := range params.Body.Operations {
+		ops[i] = uco.TransactionOperation{
+			Op:        *op.Op,
+			Object:    op.Object,
+			Class:     op.Class,
+			ID:        op.ID,
+			Tenant:    op.Tenant,
+			Property:  op.Property,
+			Reference: op.Reference,
+		}
+	}
+
+	res, err := h.manager.ApplyTransaction(params.HTTPRequest.Context(), principal, ops, repl)
+	if err != nil {
+		return transactionError(err)
+	}
+	return objects.NewObjectsTransactionOK().WithPayload(&models.TransactionResult{
+		Atomic:  res.Atomic,
+		Objects: res.Objects,
+	})
+}
+
+func transactionError(err error) middleware.Responder {
+	var (
+		forbidden    autherrs.Forbidden
+		notFound     uco.ErrNotFound
+		invalid      uco.ErrInvalidUserInput
+		multiTenancy uco.ErrMultiTenancy
+		exceeded     quota.ErrQuotaExceeded
+		rateLimited  quota.ErrRateLimited
+		objErr       *uco.Error
+	)
+	switch {
+	case errors.As(err, &forbidden), errors.As(err, &exceeded):
+		return objects.NewObjectsTransactionForbidden().WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &notFound):
+		return objects.NewObjectsTransactionNotFound().WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &invalid), errors.As(err, &multiTenancy):
+		return objects.NewObjectsTransactionUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &rateLimited):
+		return objects.NewObjectsTransactionTooManyRequests().WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &objErr):
+		switch {
+		case objErr.BadRequest():
+			return objects.NewObjectsTransactionBadRequest().WithPayload(errPayloadFromSingleErr(err))
+		case objErr.Forbidden():
+			return objects.NewObjectsTransactionForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case objErr.NotFound():
+			return objects.NewObjectsTransactionNotFound().WithPayload(errPayloadFromSingleErr(err))
+		case objErr.UnprocessableEntity():
+			return objects.NewObjectsTransactionUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return objects.NewObjectsTransactionInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+}
+
+func setupTransactionsHandlers(api *operations.WeaviateAPI, manager *uco.Manager) {
+	h := &transactionsHandlers{manager: manager}
+
+	api.ObjectsObjectsTransactionHandler = objects.ObjectsTransactionHandlerFunc(h.apply)
+}
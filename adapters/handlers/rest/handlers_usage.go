@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/modules"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/modulecredentials"
+	"github.com/weaviate/weaviate/usecases/usage"
+)
+
+// usageHandlers serve the calls and tokens of the modules of this node since
+// its start, for the chargeback of the costs of the providers
+type usageHandlers struct {
+	authorizer authorization.Authorizer
+	tracker    *usage.Tracker
+}
+
+func (h *usageHandlers) getUsage(params modules.ModulesUsageGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "list", authorization.ModuleUsage()); err != nil {
+		var forbidden autherrs.Forbidden
+		if errors.As(err, &forbidden) {
+			return modules.NewModulesUsageGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return modules.NewModulesUsageGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	filter := usage.Filter{Tenant: getTenant(params.Tenant)}
+	if params.Module != nil {
+		filter.Module = *params.Module
+	}
+	if params.Class != nil {
+		filter.Class = *params.Class
+	}
+	if params.User != nil {
+		filter.User = *params.User
+	}
+	if params.APIKeyID != nil {
+		filter.APIKeyID = *params.APIKeyID
+	}
+
+	return modules.NewModulesUsageGetOK().WithPayload(usageReportToModel(h.tracker.Report(filter)))
+}
+
+func usageReportToModel(report usage.Report) *models.ModuleUsageReport {
+	out := &models.ModuleUsageReport{
+		Since:        strfmt.DateTime(report.Since),
+		Calls:        report.Calls,
+		InputTokens:  report.InputTokens,
+		OutputTokens: report.OutputTokens,
+		Totals:       make([]*models.ModuleUsageTotals, len(report.Totals)),
+	}
+	for i, totals := range report.Totals {
+		out.Totals[i] = &models.ModuleUsageTotals{
+			Module:       totals.Module,
+			Class:        totals.Class,
+			Tenant:       totals.Tenant,
+			User:         totals.User,
+			APIKeyID:     totals.APIKeyID,
+			Calls:        totals.Calls,
+			InputTokens:  totals.InputTokens,
+			OutputTokens: totals.OutputTokens,
+		}
+	}
+	return out
+}
+
+func setupUsageHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer,
+	tracker *usage.Tracker,
+) {
+	h := &usageHandlers{authorizer: authorizer, tracker: tracker}
+
+	api.ModulesModulesUsageGetHandler = modules.ModulesUsageGetHandlerFunc(h.getUsage)
+}
+
+// makeAddUsageAttribution attributes the module calls of requests to the
+// principal and tenant of the request. The principal is only authenticated
+// if the request calls a module, requests which fail authentication are
+// rejected by their handler before calling any.
+func makeAddUsageAttribution(appState *state.State) func(http.Handler) http.Handler {
+	auth := newPlainAuth(appState)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenant := r.Header.Get(modulecredentials.TenantHeader)
+			if tenant == "" {
+				tenant = r.URL.Query().Get("tenant")
+			}
+
+			ctx := usage.WithAttribution(r.Context(), func() usage.Attribution {
+				principal, err := auth.principal(r)
+				if err != nil {
+					return usage.Attribution{Tenant: tenant}
+				}
+				return usage.FromPrincipal(principal, tenant)
+			})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
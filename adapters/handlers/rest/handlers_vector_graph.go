@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/debug"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/entities/models"
+	entschema "github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// vectorGraphHandlers export and import the graph of the vector index of a
+// shard on the node serving the request, so that an index built on one node
+// can be shipped to another
+type vectorGraphHandlers struct {
+	authorizer authorization.Authorizer
+	db         *db.DB
+	logger     logrus.FieldLogger
+}
+
+func (h *vectorGraphHandlers) export(params debug.DebugVectorGraphExportParams,
+	principal *models.Principal,
+) middleware.Responder {
+	class := entschema.UppercaseClassName(params.ClassName)
+	// the export is a copy of the whole index and the import replaces it, so
+	// both require the permission to manage the shards
+	err := h.authorizer.Authorize(principal, "update", authorization.ShardsMetadata(class))
+	if err != nil {
+		return vectorGraphError(err)
+	}
+	if h.db == nil {
+		return debug.NewDebugVectorGraphImportUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errDatabaseUnavailable))
+	}
+
+	// the export is streamed, so its status is only known once it wrote its
+	// first bytes, errors after that can only be logged
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		out := &exportWriter{
+			w:           w,
+			contentType: "application/octet-stream",
+			filename:    fmt.Sprintf("%s-%s.hnsw", class, params.ShardName),
+		}
+		err := h.db.ExportVectorGraph(params.HTTPRequest.Context(), class, params.ShardName, out)
+		if err == nil {
+			return
+		}
+		if !out.started {
+			vectorGraphError(err).WriteResponse(w, runtime.JSONProducer())
+			return
+		}
+		h.logger.WithField("action", "vector_graph_export").
+			WithField("class", class).
+			WithField("shard", params.ShardName).
+			WithError(err).Error("export vector graph")
+	})
+}
+
+func (h *vectorGraphHandlers) importGraph(params debug.DebugVectorGraphImportParams,
+	principal *models.Principal,
+) middleware.Responder {
+	defer params.Body.Close()
+
+	class := entschema.UppercaseClassName(params.ClassName)
+	err := h.authorizer.Authorize(principal, "update", authorization.ShardsMetadata(class))
+	if err != nil {
+		return vectorGraphError(err)
+	}
+	if h.db == nil {
+		return debug.NewDebugVectorGraphImportUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errDatabaseUnavailable))
+	}
+
+	err = h.db.ImportVectorGraph(params.HTTPRequest.Context(), class, params.ShardName, params.Body)
+	if err != nil {
+		return vectorGraphError(err)
+	}
+
+	return debug.NewDebugVectorGraphImportNoContent()
+}
+
+// vectorGraphError is the response to a failed export or import. Both share
+// their errors, so the responses of the import are used for both.
+func vectorGraphError(err error) middleware.Responder {
+	var (
+		forbidden autherrs.Forbidden
+		notFound  objects.ErrNotFound
+		invalid   objects.ErrInvalidUserInput
+	)
+	switch {
+	case errors.As(err, &forbidden):
+		return debug.NewDebugVectorGraphImportForbidden().WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &notFound):
+		return debug.NewDebugVectorGraphImportNotFound().WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &invalid):
+		return debug.NewDebugVectorGraphImportUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+	default:
+		return debug.NewDebugVectorGraphImportInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
+func setupVectorGraphHandlers(api *operations.WeaviateAPI, appState *state.State) {
+	h := &vectorGraphHandlers{
+		authorizer: appState.Authorizer,
+		db:         appState.DB,
+		logger:     appState.Logger,
+	}
+
+	api.DebugDebugVectorGraphExportHandler = debug.DebugVectorGraphExportHandlerFunc(h.export)
+	api.DebugDebugVectorGraphImportHandler = debug.DebugVectorGraphImportHandlerFunc(h.importGraph)
+}
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/quic-go/quic-go/http3"
+	"github.com/sirupsen/logrus"
+)
+
+// serveHTTP3 serves the handler of the https server s over HTTP/3 on the
+// UDP port of addr. The responses of s advertise it with the Alt-Svc
+// header, and it is closed when s shuts down.
+func serveHTTP3(s *http.Server, addr string, logger logrus.FieldLogger) error {
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return fmt.Errorf("listen on udp %s: %w", addr, err)
+	}
+
+	handler := s.Handler
+	h3 := &http3.Server{
+		Handler:        handler,
+		TLSConfig:      http3.ConfigureTLSConfig(s.TLSConfig),
+		MaxHeaderBytes: s.MaxHeaderBytes,
+	}
+	s.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h3.SetQuicHeaders(w.Header())
+		handler.ServeHTTP(w, r)
+	})
+	s.RegisterOnShutdown(func() { h3.Close() })
+
+	go func() {
+		if err := h3.Serve(conn); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.WithField("action", "http3_serve").WithError(err).
+				Error("http3 server stopped")
+		}
+	}()
+	logger.WithField("action", "http3_startup").Infof("Serving weaviate at https://%s over HTTP/3", conn.LocalAddr())
+	return nil
+}
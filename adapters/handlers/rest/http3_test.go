@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go/http3"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeHTTP3(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Proto))
+	})
+	// the test server provides a certificate for 127.0.0.1
+	ts := httptest.NewTLSServer(handler)
+	defer ts.Close()
+
+	s := &http.Server{Handler: handler, TLSConfig: ts.TLS.Clone()}
+	logger, _ := test.NewNullLogger()
+	require.Nil(t, serveHTTP3(s, "127.0.0.1:0", logger))
+
+	// HTTP/3 is advertised as soon as it is served
+	var altSvc []string
+	require.Eventually(t, func() bool {
+		rec := httptest.NewRecorder()
+		s.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, "HTTP/1.1", rec.Body.String())
+		altSvc = regexp.MustCompile(`h3=":(\d+)"`).FindStringSubmatch(rec.Header().Get("Alt-Svc"))
+		return len(altSvc) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ts.Certificate())
+	rt := &http3.RoundTripper{TLSClientConfig: &tls.Config{RootCAs: roots}}
+	defer rt.Close()
+
+	res, err := (&http.Client{Transport: rt}).Get("https://127.0.0.1:" + altSvc[1] + "/")
+	require.Nil(t, err)
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	require.Nil(t, err)
+	assert.Equal(t, "HTTP/3.0", string(body))
+
+	require.Nil(t, s.Shutdown(context.Background()))
+}
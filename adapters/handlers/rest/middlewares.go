@@ -12,7 +12,9 @@
 package rest
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -23,6 +25,8 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/swagger_middleware"
+	"github.com/weaviate/weaviate/entities/session"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -102,9 +106,19 @@ func makeSetupGlobalMiddleware(appState *state.State) func(http.Handler) http.Ha
 		handler = addLiveAndReadyness(appState, handler)
 		handler = addHandleRoot(handler)
 		handler = makeAddModuleHandlers(appState.Modules)(handler)
+		handler = makeAddIdempotency(appState.Idempotency)(handler)
+		handler = makeAddStandbyWriteGuard(appState.Standby)(handler)
+		handler = makeAddReadOnlyWriteGuard(appState.Cluster)(handler)
+		handler = makeAddMemoryPressureImportGuard(appState.MemoryGovernor)(handler)
 		handler = addInjectHeadersIntoContext(handler)
+		handler = makeAddModuleCredentials(appState.ModuleCredentials,
+			newPlainAuth(appState).principal)(handler)
+		handler = makeAddUsageAttribution(appState)(handler)
+		handler = addSessionConsistency(handler)
 		handler = makeCatchPanics(appState.Logger,
 			newPanicsRequestsTotal(appState.Metrics, appState.Logger))(handler)
+		handler = tracing.Handler(appState.Tracer, handler)
+		handler = addRequestTracing(handler)
 
 		return handler
 	}
@@ -115,6 +129,7 @@ func makeAddLogging(logger logrus.FieldLogger) func(http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			logger.
 				WithField("action", "restapi_request").
+				WithField("request_id", tracing.RequestID(r.Context())).
 				WithField("method", r.Method).
 				WithField("url", r.URL).
 				Debug("received HTTP request")
@@ -176,6 +191,122 @@ func addInjectHeadersIntoContext(next http.Handler) http.Handler {
 	})
 }
 
+// addRequestTracing makes sure every request has an id, which is echoed in
+// the response, forwarded to module providers and added to error responses
+func addRequestTracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, requestID := tracing.FromIncoming(r.Context(), r.Header.Get)
+		w.Header().Set(tracing.RequestIDHeader, requestID)
+
+		ew := &errorRequestIDWriter{ResponseWriter: w, requestID: requestID}
+		next.ServeHTTP(ew, r.WithContext(ctx))
+		ew.finish()
+	})
+}
+
+// errorRequestIDWriter buffers error responses to add the request id to
+// their JSON body. Successful responses are passed through unchanged.
+type errorRequestIDWriter struct {
+	http.ResponseWriter
+	requestID string
+	status    int
+	buf       bytes.Buffer
+}
+
+func (w *errorRequestIDWriter) WriteHeader(code int) {
+	if code >= http.StatusBadRequest {
+		w.status = code
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *errorRequestIDWriter) Write(b []byte) (int, error) {
+	if w.status != 0 {
+		return w.buf.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *errorRequestIDWriter) Flush() {
+	if w.status != 0 {
+		return
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *errorRequestIDWriter) finish() {
+	if w.status == 0 {
+		return
+	}
+
+	body := w.buf.Bytes()
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err == nil && payload != nil {
+		payload["requestId"] = w.requestID
+		if withID, err := json.Marshal(payload); err == nil {
+			body = withID
+			w.Header().Del("Content-Length")
+		}
+	}
+
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.Write(body)
+}
+
+// addSessionConsistency reads the session token presented by the client and
+// returns it with the writes of the request added, see package session
+func addSessionConsistency(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, err := session.Parse(r.Header.Get(session.Header))
+		if err != nil {
+			writePlainError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		sw := &sessionTokenWriter{ResponseWriter: w, token: token}
+		next.ServeHTTP(sw, r.WithContext(session.WithToken(r.Context(), token)))
+		sw.setHeader()
+	})
+}
+
+// sessionTokenWriter sets the session token header before the response is
+// written, which is after all writes of the request have completed
+type sessionTokenWriter struct {
+	http.ResponseWriter
+	token       *session.Token
+	wroteHeader bool
+}
+
+func (w *sessionTokenWriter) setHeader() {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if w.token.Len() > 0 {
+		w.Header().Set(session.Header, w.token.String())
+	}
+}
+
+func (w *sessionTokenWriter) WriteHeader(code int) {
+	w.setHeader()
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *sessionTokenWriter) Write(b []byte) (int, error) {
+	w.setHeader()
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *sessionTokenWriter) Flush() {
+	w.setHeader()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 func addLiveAndReadyness(state *state.State, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.String() == "/v1/.well-known/live" {
@@ -0,0 +1,428 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/session"
+	"github.com/weaviate/weaviate/entities/tracing"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/idempotency"
+	"github.com/weaviate/weaviate/usecases/modulecredentials"
+)
+
+func TestAddRequestTracing(t *testing.T) {
+	var seenRequestID string
+	handler := addRequestTracing(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenRequestID = tracing.RequestID(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"error":[{"message":"invalid object"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"ok":true}`))
+	}))
+
+	t.Run("request id provided by the client is echoed", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/ok", nil)
+		req.Header.Set(tracing.RequestIDHeader, "client-id")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, "client-id", seenRequestID)
+		assert.Equal(t, "client-id", rec.Header().Get(tracing.RequestIDHeader))
+		assert.Equal(t, `{"ok":true}`, rec.Body.String())
+	})
+
+	t.Run("request id is generated and added to errors", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/fail", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		requestID := rec.Header().Get(tracing.RequestIDHeader)
+		require.NotEmpty(t, requestID)
+		assert.Equal(t, requestID, seenRequestID)
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+		var body map[string]interface{}
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, requestID, body["requestId"])
+		assert.Len(t, body["error"], 1)
+	})
+}
+
+type fakeStandby bool
+
+func (f fakeStandby) Active() bool { return bool(f) }
+
+func TestStandbyWriteGuard(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		method string
+		path   string
+		active bool
+		code   int
+	}{
+		{http.MethodPost, "/v1/objects", true, http.StatusServiceUnavailable},
+		{http.MethodDelete, "/v1/objects/Article/id", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/batch/objects", true, http.StatusServiceUnavailable},
+		{http.MethodPut, "/v1/schema/Article", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/transactions", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/objects:upload", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/imports", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/imports:csv", true, http.StatusServiceUnavailable},
+		{http.MethodDelete, "/v1/imports/id", true, http.StatusServiceUnavailable},
+		{http.MethodGet, "/v1/imports/id", true, http.StatusOK},
+		{http.MethodGet, "/v1/objects", true, http.StatusOK},
+		{http.MethodPost, "/v1/objects/validate", true, http.StatusOK},
+		{http.MethodPost, "/v1/graphql", true, http.StatusOK},
+		{http.MethodPost, "/v1/objectsfoo", true, http.StatusOK},
+		{http.MethodPost, "/v1/objects", false, http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.method+" "+test.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			makeAddStandbyWriteGuard(fakeStandby(test.active))(next).
+				ServeHTTP(rec, httptest.NewRequest(test.method, test.path, nil))
+			assert.Equal(t, test.code, rec.Code)
+		})
+	}
+}
+
+type fakeReadOnly bool
+
+func (f fakeReadOnly) ReadOnly() bool { return bool(f) }
+
+func TestReadOnlyWriteGuard(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		method   string
+		path     string
+		readOnly bool
+		code     int
+	}{
+		{http.MethodPut, "/v1/objects/Article/id", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/batch/objects", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/schema", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/objects:upload", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/imports", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/imports:csv", true, http.StatusServiceUnavailable},
+		{http.MethodGet, "/v1/objects/Article/id", true, http.StatusOK},
+		{http.MethodPost, "/v1/graphql", true, http.StatusOK},
+		{http.MethodPost, "/v1/nodes/node1/drain", true, http.StatusOK},
+		{http.MethodPost, "/v1/batch/objects", false, http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.method+" "+test.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			makeAddReadOnlyWriteGuard(fakeReadOnly(test.readOnly))(next).
+				ServeHTTP(rec, httptest.NewRequest(test.method, test.path, nil))
+			assert.Equal(t, test.code, rec.Code)
+		})
+	}
+}
+
+func TestAddModuleCredentials(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := logrustest.NewNullLogger()
+	store := modulecredentials.New(logger)
+	require.Nil(t, store.SetMetadataStore(newFakeMetadata()))
+	require.Nil(t, store.Set(ctx, "", map[string]string{"X-Openai-Api-Key": "global"}))
+	require.Nil(t, store.Set(ctx, "tenant1", map[string]string{"X-Openai-Api-Key": "tenant1"}))
+
+	// principals are bound to the tenant named by their token
+	authenticate := func(r *http.Request) (*models.Principal, error) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "invalid" {
+			return nil, errors.New("invalid token")
+		}
+		return &models.Principal{Username: "user", Tenant: token}, nil
+	}
+
+	var seen string
+	handler := makeAddModuleCredentials(store, authenticate)(addInjectHeadersIntoContext(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			seen = ""
+			if value, ok := r.Context().Value("X-Openai-Api-Key").([]string); ok {
+				seen = value[0]
+			}
+		})))
+
+	tests := []struct {
+		name     string
+		target   string
+		header   http.Header
+		expected string
+	}{
+		{"without tenant", "/v1/graphql", nil, "global"},
+		{"principal bound to tenant", "/v1/graphql", http.Header{"Authorization": {"Bearer tenant1"}}, "tenant1"},
+		{"principal bound to other tenant", "/v1/graphql", http.Header{"Authorization": {"Bearer tenant2"}}, "global"},
+		{"invalid token", "/v1/graphql", http.Header{"Authorization": {"Bearer invalid"}}, "global"},
+		{"tenant header", "/v1/graphql", http.Header{"X-Weaviate-Tenant": {"tenant1"}}, "global"},
+		{"tenant query parameter", "/v1/objects/Article/id?tenant=tenant1", nil, "global"},
+		{"request header", "/v1/graphql", http.Header{
+			"Authorization": {"Bearer tenant1"}, "X-Openai-Api-Key": {"request"},
+		}, "request"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, test.target, nil)
+			for name, values := range test.header {
+				req.Header[name] = values
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+			assert.Equal(t, test.expected, seen)
+		})
+	}
+}
+
+// fakeMetadata is the metadata of the schema on a single node
+type fakeMetadata struct {
+	values    map[string][]byte
+	callbacks []func()
+}
+
+func newFakeMetadata() *fakeMetadata {
+	return &fakeMetadata{values: map[string][]byte{}}
+}
+
+func (f *fakeMetadata) PutMetadata(ctx context.Context, key string, value []byte) error {
+	f.values[key] = value
+	f.changed()
+	return nil
+}
+
+func (f *fakeMetadata) DeleteMetadata(ctx context.Context, key string) error {
+	delete(f.values, key)
+	f.changed()
+	return nil
+}
+
+func (f *fakeMetadata) Metadata(prefix string) map[string][]byte {
+	out := map[string][]byte{}
+	for key, value := range f.values {
+		if strings.HasPrefix(key, prefix) {
+			out[key] = value
+		}
+	}
+	return out
+}
+
+func (f *fakeMetadata) RegisterMetadataCallback(prefix string, callback func()) {
+	f.callbacks = append(f.callbacks, callback)
+}
+
+func (f *fakeMetadata) changed() {
+	for _, cb := range f.callbacks {
+		cb()
+	}
+}
+
+type fakeMemoryPressure bool
+
+func (f fakeMemoryPressure) RejectImports() bool { return bool(f) }
+
+func TestMemoryPressureImportGuard(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		method   string
+		path     string
+		pressure bool
+		code     int
+	}{
+		{http.MethodPost, "/v1/objects", true, http.StatusServiceUnavailable},
+		{http.MethodPatch, "/v1/objects/Article/id", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/batch/objects", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/batch/references", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/imports", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/imports:csv", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/transactions", true, http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/objects:upload", true, http.StatusServiceUnavailable},
+		{http.MethodDelete, "/v1/imports/id", true, http.StatusOK},
+		{http.MethodPost, "/v1/objects/validate", true, http.StatusOK},
+		{http.MethodDelete, "/v1/objects/Article/id", true, http.StatusOK},
+		{http.MethodDelete, "/v1/batch/objects", true, http.StatusOK},
+		{http.MethodPost, "/v1/schema", true, http.StatusOK},
+		{http.MethodPost, "/v1/graphql", true, http.StatusOK},
+		{http.MethodPost, "/v1/batch/objects", false, http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.method+" "+test.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			makeAddMemoryPressureImportGuard(fakeMemoryPressure(test.pressure))(next).
+				ServeHTTP(rec, httptest.NewRequest(test.method, test.path, nil))
+			assert.Equal(t, test.code, rec.Code)
+		})
+	}
+}
+
+func TestAddSessionConsistency(t *testing.T) {
+	handler := addSessionConsistency(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			session.Record(r.Context(), session.Write{Class: "Foo", ID: "1", Time: 10})
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	t.Run("writes are returned in the token", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/objects", nil))
+
+		token, err := session.Parse(rec.Header().Get(session.Header))
+		require.Nil(t, err)
+		w, ok := token.Write("Foo", "", "1")
+		require.True(t, ok)
+		assert.Equal(t, int64(10), w.Time)
+	})
+
+	t.Run("presented token is returned", func(t *testing.T) {
+		presented := session.NewToken()
+		presented.Record(session.Write{Class: "Bar", ID: "2", Time: 5})
+		req := httptest.NewRequest(http.MethodPost, "/v1/objects", nil)
+		req.Header.Set(session.Header, presented.String())
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		token, err := session.Parse(rec.Header().Get(session.Header))
+		require.Nil(t, err)
+		assert.Equal(t, 2, token.Len())
+	})
+
+	t.Run("no token without writes", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/objects", nil))
+		assert.Empty(t, rec.Header().Get(session.Header))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/objects", nil)
+		req.Header.Set(session.Header, "not a token")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestAddIdempotency(t *testing.T) {
+	store := idempotency.New(config.Idempotency{
+		Enabled:  true,
+		Window:   time.Hour,
+		MaxBytes: 1024,
+	}, nil)
+
+	executed := 0
+	handler := makeAddIdempotency(store)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		executed++
+		body, _ := io.ReadAll(r.Body)
+		if string(body) == "fail" {
+			writePlainError(w, http.StatusServiceUnavailable, errors.New("unavailable"))
+			return
+		}
+		writePlainJSON(w, http.StatusOK, map[string]int{"executed": executed})
+	}))
+
+	request := func(path, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(idempotencyKeyHeader, key)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("retries receive the first response", func(t *testing.T) {
+		first := request("/v1/objects", "create-1", `{"class":"Article"}`)
+		require.Equal(t, http.StatusOK, first.Code)
+
+		retry := request("/v1/objects", "create-1", `{"class":"Article"}`)
+		assert.Equal(t, http.StatusOK, retry.Code)
+		assert.Equal(t, first.Body.String(), retry.Body.String())
+		assert.Equal(t, "true", retry.Header().Get(idempotencyReplayedHeader))
+		assert.Equal(t, "application/json", retry.Header().Get("Content-Type"))
+		assert.Equal(t, 1, executed)
+	})
+
+	t.Run("reused key with another payload", func(t *testing.T) {
+		rec := request("/v1/objects", "create-1", `{"class":"Product"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	})
+
+	t.Run("keys are scoped to the path", func(t *testing.T) {
+		rec := request("/v1/batch/objects", "create-1", `{"class":"Article"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get(idempotencyReplayedHeader))
+	})
+
+	t.Run("keys are scoped to the query", func(t *testing.T) {
+		rec := request("/v1/objects?consistency_level=ALL", "create-1", `{"class":"Article"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get(idempotencyReplayedHeader))
+
+		rec = request("/v1/objects?tenant=t1&consistency_level=ALL", "create-2", `{"class":"Article"}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		rec = request("/v1/objects?consistency_level=ALL&tenant=t1", "create-2", `{"class":"Article"}`)
+		assert.Equal(t, "true", rec.Header().Get(idempotencyReplayedHeader))
+	})
+
+	t.Run("server errors are executed again", func(t *testing.T) {
+		before := executed
+		assert.Equal(t, http.StatusServiceUnavailable, request("/v1/objects", "fail-1", "fail").Code)
+		assert.Equal(t, http.StatusServiceUnavailable, request("/v1/objects", "fail-1", "fail").Code)
+		assert.Equal(t, before+2, executed)
+	})
+
+	t.Run("requests without key or on other paths are executed", func(t *testing.T) {
+		before := executed
+		request("/v1/objects", "", `{}`)
+		request("/v1/objects", "", `{}`)
+		request("/v1/graphql", "query-1", `{}`)
+		request("/v1/graphql", "query-1", `{}`)
+		assert.Equal(t, before+4, executed)
+	})
+
+	t.Run("key too long", func(t *testing.T) {
+		rec := request("/v1/objects", strings.Repeat("k", maxIdempotencyKeyLength+1), `{}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("body too large", func(t *testing.T) {
+		before := executed
+		rec := request("/v1/batch/objects", "large-1", strings.Repeat("x", maxIdempotentBodyBytes+1))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+		assert.Equal(t, before, executed)
+	})
+}
@@ -44,6 +44,11 @@ type BatchObjectsCreateParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Makes retries of the request safe. The response to the first request with a key is kept and returned to retries with the same key and body instead of writing the objects again.
+	  Max Length: 255
+	  In: header
+	*/
+	IdempotencyKey *string
 	/*
 	  Required: true
 	  In: body
@@ -66,6 +71,10 @@ func (o *BatchObjectsCreateParams) BindRequest(r *http.Request, route *middlewar
 
 	qs := runtime.Values(r.URL.Query())
 
+	if err := o.bindIdempotencyKey(r.Header[http.CanonicalHeaderKey("Idempotency-Key")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body BatchObjectsCreateBody
@@ -104,6 +113,37 @@ func (o *BatchObjectsCreateParams) BindRequest(r *http.Request, route *middlewar
 	return nil
 }
 
+// bindIdempotencyKey binds and validates parameter IdempotencyKey from header.
+func (o *BatchObjectsCreateParams) bindIdempotencyKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IdempotencyKey = &raw
+
+	if err := o.validateIdempotencyKey(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateIdempotencyKey carries on validations for parameter IdempotencyKey
+func (o *BatchObjectsCreateParams) validateIdempotencyKey(formats strfmt.Registry) error {
+
+	if err := validate.MaxLength("Idempotency-Key", "header", *o.IdempotencyKey, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindConsistencyLevel binds and validates parameter ConsistencyLevel from query.
 func (o *BatchObjectsCreateParams) bindConsistencyLevel(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -33,6 +33,10 @@ BatchObjectsCreateOK Request succeeded, see response body to get detailed inform
 swagger:response batchObjectsCreateOK
 */
 type BatchObjectsCreateOK struct {
+	/*Set to true if the response is the kept response to an earlier request with the same Idempotency-Key
+
+	 */
+	IdempotentReplayed string `json:"Idempotent-Replayed"`
 
 	/*
 	  In: Body
@@ -46,6 +50,17 @@ func NewBatchObjectsCreateOK() *BatchObjectsCreateOK {
 	return &BatchObjectsCreateOK{}
 }
 
+// WithIdempotentReplayed adds the idempotentReplayed to the batch objects create o k response
+func (o *BatchObjectsCreateOK) WithIdempotentReplayed(idempotentReplayed string) *BatchObjectsCreateOK {
+	o.IdempotentReplayed = idempotentReplayed
+	return o
+}
+
+// SetIdempotentReplayed sets the idempotentReplayed to the batch objects create o k response
+func (o *BatchObjectsCreateOK) SetIdempotentReplayed(idempotentReplayed string) {
+	o.IdempotentReplayed = idempotentReplayed
+}
+
 // WithPayload adds the payload to the batch objects create o k response
 func (o *BatchObjectsCreateOK) WithPayload(payload []*models.ObjectsGetResponse) *BatchObjectsCreateOK {
 	o.Payload = payload
@@ -60,6 +75,13 @@ func (o *BatchObjectsCreateOK) SetPayload(payload []*models.ObjectsGetResponse)
 // WriteResponse to the client
 func (o *BatchObjectsCreateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Idempotent-Replayed
+
+	idempotentReplayed := o.IdempotentReplayed
+	if idempotentReplayed != "" {
+		rw.Header().Set("Idempotent-Replayed", idempotentReplayed)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -187,11 +209,56 @@ func (o *BatchObjectsCreateForbidden) WriteResponse(rw http.ResponseWriter, prod
 	}
 }
 
+// BatchObjectsCreateConflictCode is the HTTP code returned for type BatchObjectsCreateConflict
+const BatchObjectsCreateConflictCode int = 409
+
+/*
+BatchObjectsCreateConflict A request with the same Idempotency-Key is still in progress
+
+swagger:response batchObjectsCreateConflict
+*/
+type BatchObjectsCreateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchObjectsCreateConflict creates BatchObjectsCreateConflict with default headers values
+func NewBatchObjectsCreateConflict() *BatchObjectsCreateConflict {
+
+	return &BatchObjectsCreateConflict{}
+}
+
+// WithPayload adds the payload to the batch objects create conflict response
+func (o *BatchObjectsCreateConflict) WithPayload(payload *models.ErrorResponse) *BatchObjectsCreateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batch objects create conflict response
+func (o *BatchObjectsCreateConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchObjectsCreateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // BatchObjectsCreateUnprocessableEntityCode is the HTTP code returned for type BatchObjectsCreateUnprocessableEntity
 const BatchObjectsCreateUnprocessableEntityCode int = 422
 
 /*
-BatchObjectsCreateUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?
+BatchObjectsCreateUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file? Also returned if the Idempotency-Key was used before with a different body.
 
 swagger:response batchObjectsCreateUnprocessableEntity
 */
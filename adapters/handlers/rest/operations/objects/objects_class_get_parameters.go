@@ -52,6 +52,10 @@ type ObjectsClassGetParams struct {
 	  In: query
 	*/
 	ConsistencyLevel *string
+	/*Comma separated names of the properties to return, all properties are returned if not set. Vectors are only returned if they are included with the include parameter.
+	  In: query
+	*/
+	Fields *string
 	/*Unique ID of the Object.
 	  Required: true
 	  In: path
@@ -92,6 +96,11 @@ func (o *ObjectsClassGetParams) BindRequest(r *http.Request, route *middleware.M
 		res = append(res, err)
 	}
 
+	qFields, qhkFields, _ := qs.GetOK("fields")
+	if err := o.bindFields(qFields, qhkFields, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
@@ -149,6 +158,24 @@ func (o *ObjectsClassGetParams) bindConsistencyLevel(rawData []string, hasKey bo
 	return nil
 }
 
+// bindFields binds and validates parameter Fields from query.
+func (o *ObjectsClassGetParams) bindFields(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Fields = &raw
+
+	return nil
+}
+
 // bindID binds and validates parameter ID from path.
 func (o *ObjectsClassGetParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -31,6 +31,7 @@ type ObjectsClassGetURL struct {
 	ID        strfmt.UUID
 
 	ConsistencyLevel *string
+	Fields           *string
 	Include          *string
 	NodeName         *string
 	Tenant           *string
@@ -91,6 +92,14 @@ func (o *ObjectsClassGetURL) Build() (*url.URL, error) {
 		qs.Set("consistency_level", consistencyLevelQ)
 	}
 
+	var fieldsQ string
+	if o.Fields != nil {
+		fieldsQ = *o.Fields
+	}
+	if fieldsQ != "" {
+		qs.Set("fields", fieldsQ)
+	}
+
 	var includeQ string
 	if o.Include != nil {
 		includeQ = *o.Include
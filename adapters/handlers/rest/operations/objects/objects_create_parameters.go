@@ -46,6 +46,11 @@ type ObjectsCreateParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Makes retries of the request safe. The response to the first request with a key is kept and returned to retries with the same key and body instead of writing the objects again.
+	  Max Length: 255
+	  In: header
+	*/
+	IdempotencyKey *string
 	/*
 	  Required: true
 	  In: body
@@ -68,6 +73,10 @@ func (o *ObjectsCreateParams) BindRequest(r *http.Request, route *middleware.Mat
 
 	qs := runtime.Values(r.URL.Query())
 
+	if err := o.bindIdempotencyKey(r.Header[http.CanonicalHeaderKey("Idempotency-Key")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.Object
@@ -106,6 +115,37 @@ func (o *ObjectsCreateParams) BindRequest(r *http.Request, route *middleware.Mat
 	return nil
 }
 
+// bindIdempotencyKey binds and validates parameter IdempotencyKey from header.
+func (o *ObjectsCreateParams) bindIdempotencyKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IdempotencyKey = &raw
+
+	if err := o.validateIdempotencyKey(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateIdempotencyKey carries on validations for parameter IdempotencyKey
+func (o *ObjectsCreateParams) validateIdempotencyKey(formats strfmt.Registry) error {
+
+	if err := validate.MaxLength("Idempotency-Key", "header", *o.IdempotencyKey, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindConsistencyLevel binds and validates parameter ConsistencyLevel from query.
 func (o *ObjectsCreateParams) bindConsistencyLevel(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -33,6 +33,10 @@ ObjectsCreateOK Object created.
 swagger:response objectsCreateOK
 */
 type ObjectsCreateOK struct {
+	/*Set to true if the response is the kept response to an earlier request with the same Idempotency-Key
+
+	 */
+	IdempotentReplayed string `json:"Idempotent-Replayed"`
 
 	/*
 	  In: Body
@@ -46,6 +50,17 @@ func NewObjectsCreateOK() *ObjectsCreateOK {
 	return &ObjectsCreateOK{}
 }
 
+// WithIdempotentReplayed adds the idempotentReplayed to the objects create o k response
+func (o *ObjectsCreateOK) WithIdempotentReplayed(idempotentReplayed string) *ObjectsCreateOK {
+	o.IdempotentReplayed = idempotentReplayed
+	return o
+}
+
+// SetIdempotentReplayed sets the idempotentReplayed to the objects create o k response
+func (o *ObjectsCreateOK) SetIdempotentReplayed(idempotentReplayed string) {
+	o.IdempotentReplayed = idempotentReplayed
+}
+
 // WithPayload adds the payload to the objects create o k response
 func (o *ObjectsCreateOK) WithPayload(payload *models.Object) *ObjectsCreateOK {
 	o.Payload = payload
@@ -60,6 +75,13 @@ func (o *ObjectsCreateOK) SetPayload(payload *models.Object) {
 // WriteResponse to the client
 func (o *ObjectsCreateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Idempotent-Replayed
+
+	idempotentReplayed := o.IdempotentReplayed
+	if idempotentReplayed != "" {
+		rw.Header().Set("Idempotent-Replayed", idempotentReplayed)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -184,11 +206,56 @@ func (o *ObjectsCreateForbidden) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// ObjectsCreateConflictCode is the HTTP code returned for type ObjectsCreateConflict
+const ObjectsCreateConflictCode int = 409
+
+/*
+ObjectsCreateConflict A request with the same Idempotency-Key is still in progress
+
+swagger:response objectsCreateConflict
+*/
+type ObjectsCreateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsCreateConflict creates ObjectsCreateConflict with default headers values
+func NewObjectsCreateConflict() *ObjectsCreateConflict {
+
+	return &ObjectsCreateConflict{}
+}
+
+// WithPayload adds the payload to the objects create conflict response
+func (o *ObjectsCreateConflict) WithPayload(payload *models.ErrorResponse) *ObjectsCreateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects create conflict response
+func (o *ObjectsCreateConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsCreateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsCreateUnprocessableEntityCode is the HTTP code returned for type ObjectsCreateUnprocessableEntity
 const ObjectsCreateUnprocessableEntityCode int = 422
 
 /*
-ObjectsCreateUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?
+ObjectsCreateUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file? Also returned if the Idempotency-Key was used before with a different body.
 
 swagger:response objectsCreateUnprocessableEntity
 */
@@ -43,6 +43,10 @@ type ObjectsGetParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Comma separated names of the properties to return, all properties are returned if not set. Vectors are only returned if they are included with the include parameter.
+	  In: query
+	*/
+	Fields *string
 	/*Unique ID of the Object.
 	  Required: true
 	  In: path
@@ -65,6 +69,11 @@ func (o *ObjectsGetParams) BindRequest(r *http.Request, route *middleware.Matche
 
 	qs := runtime.Values(r.URL.Query())
 
+	qFields, qhkFields, _ := qs.GetOK("fields")
+	if err := o.bindFields(qFields, qhkFields, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
@@ -80,6 +89,24 @@ func (o *ObjectsGetParams) BindRequest(r *http.Request, route *middleware.Matche
 	return nil
 }
 
+// bindFields binds and validates parameter Fields from query.
+func (o *ObjectsGetParams) bindFields(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Fields = &raw
+
+	return nil
+}
+
 // bindID binds and validates parameter ID from path.
 func (o *ObjectsGetParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
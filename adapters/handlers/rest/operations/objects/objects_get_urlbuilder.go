@@ -29,6 +29,7 @@ import (
 type ObjectsGetURL struct {
 	ID strfmt.UUID
 
+	Fields  *string
 	Include *string
 
 	_basePath string
@@ -72,6 +73,14 @@ func (o *ObjectsGetURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var fieldsQ string
+	if o.Fields != nil {
+		fieldsQ = *o.Fields
+	}
+	if fieldsQ != "" {
+		qs.Set("fields", fieldsQ)
+	}
+
 	var includeQ string
 	if o.Include != nil {
 		includeQ = *o.Include
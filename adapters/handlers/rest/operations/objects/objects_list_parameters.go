@@ -58,6 +58,10 @@ type ObjectsListParams struct {
 	  In: query
 	*/
 	Class *string
+	/*Comma separated names of the properties to return, all properties are returned if not set. Vectors are only returned if they are included with the include parameter.
+	  In: query
+	*/
+	Fields *string
 	/*Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation
 	  In: query
 	*/
@@ -106,6 +110,11 @@ func (o *ObjectsListParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	qFields, qhkFields, _ := qs.GetOK("fields")
+	if err := o.bindFields(qFields, qhkFields, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qInclude, qhkInclude, _ := qs.GetOK("include")
 	if err := o.bindInclude(qInclude, qhkInclude, route.Formats); err != nil {
 		res = append(res, err)
@@ -177,6 +186,24 @@ func (o *ObjectsListParams) bindClass(rawData []string, hasKey bool, formats str
 	return nil
 }
 
+// bindFields binds and validates parameter Fields from query.
+func (o *ObjectsListParams) bindFields(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Fields = &raw
+
+	return nil
+}
+
 // bindInclude binds and validates parameter Include from query.
 func (o *ObjectsListParams) bindInclude(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
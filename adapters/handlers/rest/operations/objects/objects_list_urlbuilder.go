@@ -28,6 +28,7 @@ import (
 type ObjectsListURL struct {
 	After   *string
 	Class   *string
+	Fields  *string
 	Include *string
 	Limit   *int64
 	Offset  *int64
@@ -85,6 +86,14 @@ func (o *ObjectsListURL) Build() (*url.URL, error) {
 		qs.Set("class", classQ)
 	}
 
+	var fieldsQ string
+	if o.Fields != nil {
+		fieldsQ = *o.Fields
+	}
+	if fieldsQ != "" {
+		qs.Set("fields", fieldsQ)
+	}
+
 	var includeQ string
 	if o.Include != nil {
 		includeQ = *o.Include
@@ -18,6 +18,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -31,14 +32,22 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/apikeys"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/ask"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/backups"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/classifications"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/debug"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/meta"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/modules"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/sql"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/well_known"
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -61,14 +70,29 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 
-		JSONConsumer: runtime.JSONConsumer(),
-		YamlConsumer: yamlpc.YAMLConsumer(),
+		BinConsumer: runtime.ByteStreamConsumer(),
+		GzipConsumer: runtime.ConsumerFunc(func(r io.Reader, target interface{}) error {
+			return errors.NotImplemented("gzip consumer has not yet been implemented")
+		}),
+		JSONConsumer:          runtime.JSONConsumer(),
+		MultipartformConsumer: runtime.DiscardConsumer,
+		YamlConsumer:          yamlpc.YAMLConsumer(),
 
+		ApplicationVndApacheParquetProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("applicationVndApacheParquet producer has not yet been implemented")
+		}),
+		BinProducer: runtime.ByteStreamProducer(),
+		GzipProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("gzip producer has not yet been implemented")
+		}),
 		JSONProducer: runtime.JSONProducer(),
 
 		WellKnownGetWellKnownOpenidConfigurationHandler: well_known.GetWellKnownOpenidConfigurationHandlerFunc(func(params well_known.GetWellKnownOpenidConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation well_known.GetWellKnownOpenidConfiguration has not yet been implemented")
 		}),
+		AskAskAnswerHandler: ask.AskAnswerHandlerFunc(func(params ask.AskAnswerParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation ask.AskAnswer has not yet been implemented")
+		}),
 		BackupsBackupsCreateHandler: backups.BackupsCreateHandlerFunc(func(params backups.BackupsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsCreate has not yet been implemented")
 		}),
@@ -81,6 +105,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BackupsBackupsRestoreStatusHandler: backups.BackupsRestoreStatusHandlerFunc(func(params backups.BackupsRestoreStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsRestoreStatus has not yet been implemented")
 		}),
+		BatchBatchIngestGetHandler: batch.BatchIngestGetHandlerFunc(func(params batch.BatchIngestGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchIngestGet has not yet been implemented")
+		}),
 		BatchBatchObjectsCreateHandler: batch.BatchObjectsCreateHandlerFunc(func(params batch.BatchObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsCreate has not yet been implemented")
 		}),
@@ -96,15 +123,111 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ClassificationsClassificationsPostHandler: classifications.ClassificationsPostHandlerFunc(func(params classifications.ClassificationsPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation classifications.ClassificationsPost has not yet been implemented")
 		}),
+		ClusterClusterShardsBandwidthUpdateHandler: cluster.ClusterShardsBandwidthUpdateHandlerFunc(func(params cluster.ClusterShardsBandwidthUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterShardsBandwidthUpdate has not yet been implemented")
+		}),
+		ClusterClusterShardsGetHandler: cluster.ClusterShardsGetHandlerFunc(func(params cluster.ClusterShardsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterShardsGet has not yet been implemented")
+		}),
+		ClusterClusterShardsMoveHandler: cluster.ClusterShardsMoveHandlerFunc(func(params cluster.ClusterShardsMoveParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterShardsMove has not yet been implemented")
+		}),
+		NodesConfigUpdateHandler: nodes.ConfigUpdateHandlerFunc(func(params nodes.ConfigUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.ConfigUpdate has not yet been implemented")
+		}),
+		DebugDebugBundleGetHandler: debug.DebugBundleGetHandlerFunc(func(params debug.DebugBundleGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation debug.DebugBundleGet has not yet been implemented")
+		}),
+		DebugDebugRecallEvaluateHandler: debug.DebugRecallEvaluateHandlerFunc(func(params debug.DebugRecallEvaluateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation debug.DebugRecallEvaluate has not yet been implemented")
+		}),
+		DebugDebugVectorGraphExportHandler: debug.DebugVectorGraphExportHandlerFunc(func(params debug.DebugVectorGraphExportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation debug.DebugVectorGraphExport has not yet been implemented")
+		}),
+		DebugDebugVectorGraphImportHandler: debug.DebugVectorGraphImportHandlerFunc(func(params debug.DebugVectorGraphImportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation debug.DebugVectorGraphImport has not yet been implemented")
+		}),
 		GraphqlGraphqlBatchHandler: graphql.GraphqlBatchHandlerFunc(func(params graphql.GraphqlBatchParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation graphql.GraphqlBatch has not yet been implemented")
 		}),
+		GraphqlGraphqlExplainHandler: graphql.GraphqlExplainHandlerFunc(func(params graphql.GraphqlExplainParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlExplain has not yet been implemented")
+		}),
 		GraphqlGraphqlPostHandler: graphql.GraphqlPostHandlerFunc(func(params graphql.GraphqlPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation graphql.GraphqlPost has not yet been implemented")
 		}),
+		GraphqlGraphqlTemplatesDeleteHandler: graphql.GraphqlTemplatesDeleteHandlerFunc(func(params graphql.GraphqlTemplatesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlTemplatesDelete has not yet been implemented")
+		}),
+		GraphqlGraphqlTemplatesExecuteHandler: graphql.GraphqlTemplatesExecuteHandlerFunc(func(params graphql.GraphqlTemplatesExecuteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlTemplatesExecute has not yet been implemented")
+		}),
+		GraphqlGraphqlTemplatesGetHandler: graphql.GraphqlTemplatesGetHandlerFunc(func(params graphql.GraphqlTemplatesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlTemplatesGet has not yet been implemented")
+		}),
+		GraphqlGraphqlTemplatesListHandler: graphql.GraphqlTemplatesListHandlerFunc(func(params graphql.GraphqlTemplatesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlTemplatesList has not yet been implemented")
+		}),
+		GraphqlGraphqlTemplatesPutHandler: graphql.GraphqlTemplatesPutHandlerFunc(func(params graphql.GraphqlTemplatesPutParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlTemplatesPut has not yet been implemented")
+		}),
+		BatchImportsCancelHandler: batch.ImportsCancelHandlerFunc(func(params batch.ImportsCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.ImportsCancel has not yet been implemented")
+		}),
+		BatchImportsCreateHandler: batch.ImportsCreateHandlerFunc(func(params batch.ImportsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.ImportsCreate has not yet been implemented")
+		}),
+		BatchImportsCsvUploadHandler: batch.ImportsCsvUploadHandlerFunc(func(params batch.ImportsCsvUploadParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.ImportsCsvUpload has not yet been implemented")
+		}),
+		BatchImportsGetHandler: batch.ImportsGetHandlerFunc(func(params batch.ImportsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.ImportsGet has not yet been implemented")
+		}),
+		BatchImportsListHandler: batch.ImportsListHandlerFunc(func(params batch.ImportsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.ImportsList has not yet been implemented")
+		}),
+		ApikeysKeysCreateHandler: apikeys.KeysCreateHandlerFunc(func(params apikeys.KeysCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.KeysCreate has not yet been implemented")
+		}),
+		ApikeysKeysGetHandler: apikeys.KeysGetHandlerFunc(func(params apikeys.KeysGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.KeysGet has not yet been implemented")
+		}),
+		ApikeysKeysListHandler: apikeys.KeysListHandlerFunc(func(params apikeys.KeysListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.KeysList has not yet been implemented")
+		}),
+		ApikeysKeysRevokeHandler: apikeys.KeysRevokeHandlerFunc(func(params apikeys.KeysRevokeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.KeysRevoke has not yet been implemented")
+		}),
 		MetaMetaGetHandler: meta.MetaGetHandlerFunc(func(params meta.MetaGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation meta.MetaGet has not yet been implemented")
 		}),
+		ModulesModulesCredentialsDeleteHandler: modules.ModulesCredentialsDeleteHandlerFunc(func(params modules.ModulesCredentialsDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation modules.ModulesCredentialsDelete has not yet been implemented")
+		}),
+		ModulesModulesCredentialsListHandler: modules.ModulesCredentialsListHandlerFunc(func(params modules.ModulesCredentialsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation modules.ModulesCredentialsList has not yet been implemented")
+		}),
+		ModulesModulesCredentialsTenantDeleteHandler: modules.ModulesCredentialsTenantDeleteHandlerFunc(func(params modules.ModulesCredentialsTenantDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation modules.ModulesCredentialsTenantDelete has not yet been implemented")
+		}),
+		ModulesModulesCredentialsTenantUpdateHandler: modules.ModulesCredentialsTenantUpdateHandlerFunc(func(params modules.ModulesCredentialsTenantUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation modules.ModulesCredentialsTenantUpdate has not yet been implemented")
+		}),
+		ModulesModulesCredentialsUpdateHandler: modules.ModulesCredentialsUpdateHandlerFunc(func(params modules.ModulesCredentialsUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation modules.ModulesCredentialsUpdate has not yet been implemented")
+		}),
+		ModulesModulesUsageGetHandler: modules.ModulesUsageGetHandlerFunc(func(params modules.ModulesUsageGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation modules.ModulesUsageGet has not yet been implemented")
+		}),
+		NodesNodesDrainCreateHandler: nodes.NodesDrainCreateHandlerFunc(func(params nodes.NodesDrainCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesDrainCreate has not yet been implemented")
+		}),
+		NodesNodesDrainDeleteHandler: nodes.NodesDrainDeleteHandlerFunc(func(params nodes.NodesDrainDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesDrainDelete has not yet been implemented")
+		}),
+		NodesNodesDrainGetHandler: nodes.NodesDrainGetHandlerFunc(func(params nodes.NodesDrainGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesDrainGet has not yet been implemented")
+		}),
 		NodesNodesGetHandler: nodes.NodesGetHandlerFunc(func(params nodes.NodesGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation nodes.NodesGet has not yet been implemented")
 		}),
@@ -135,12 +258,27 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsClassReferencesPutHandler: objects.ObjectsClassReferencesPutHandlerFunc(func(params objects.ObjectsClassReferencesPutParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassReferencesPut has not yet been implemented")
 		}),
+		ObjectsObjectsClassVersionsDiffHandler: objects.ObjectsClassVersionsDiffHandlerFunc(func(params objects.ObjectsClassVersionsDiffParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsClassVersionsDiff has not yet been implemented")
+		}),
+		ObjectsObjectsClassVersionsListHandler: objects.ObjectsClassVersionsListHandlerFunc(func(params objects.ObjectsClassVersionsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsClassVersionsList has not yet been implemented")
+		}),
+		ObjectsObjectsClassVersionsRollbackHandler: objects.ObjectsClassVersionsRollbackHandlerFunc(func(params objects.ObjectsClassVersionsRollbackParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsClassVersionsRollback has not yet been implemented")
+		}),
 		ObjectsObjectsCreateHandler: objects.ObjectsCreateHandlerFunc(func(params objects.ObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsCreate has not yet been implemented")
 		}),
 		ObjectsObjectsDeleteHandler: objects.ObjectsDeleteHandlerFunc(func(params objects.ObjectsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsDelete has not yet been implemented")
 		}),
+		ObjectsObjectsDuplicatesHandler: objects.ObjectsDuplicatesHandlerFunc(func(params objects.ObjectsDuplicatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsDuplicates has not yet been implemented")
+		}),
+		ObjectsObjectsExportHandler: objects.ObjectsExportHandlerFunc(func(params objects.ObjectsExportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsExport has not yet been implemented")
+		}),
 		ObjectsObjectsGetHandler: objects.ObjectsGetHandlerFunc(func(params objects.ObjectsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsGet has not yet been implemented")
 		}),
@@ -162,12 +300,57 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsReferencesUpdateHandler: objects.ObjectsReferencesUpdateHandlerFunc(func(params objects.ObjectsReferencesUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsReferencesUpdate has not yet been implemented")
 		}),
+		ObjectsObjectsTransactionHandler: objects.ObjectsTransactionHandlerFunc(func(params objects.ObjectsTransactionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsTransaction has not yet been implemented")
+		}),
 		ObjectsObjectsUpdateHandler: objects.ObjectsUpdateHandlerFunc(func(params objects.ObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsUpdate has not yet been implemented")
 		}),
+		ObjectsObjectsUploadHandler: objects.ObjectsUploadHandlerFunc(func(params objects.ObjectsUploadParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsUpload has not yet been implemented")
+		}),
 		ObjectsObjectsValidateHandler: objects.ObjectsValidateHandlerFunc(func(params objects.ObjectsValidateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsValidate has not yet been implemented")
 		}),
+		ObjectsObjectsVersionsDiffHandler: objects.ObjectsVersionsDiffHandlerFunc(func(params objects.ObjectsVersionsDiffParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsVersionsDiff has not yet been implemented")
+		}),
+		ObjectsObjectsVersionsListHandler: objects.ObjectsVersionsListHandlerFunc(func(params objects.ObjectsVersionsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsVersionsList has not yet been implemented")
+		}),
+		ObjectsObjectsVersionsRollbackHandler: objects.ObjectsVersionsRollbackHandlerFunc(func(params objects.ObjectsVersionsRollbackParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsVersionsRollback has not yet been implemented")
+		}),
+		ReplicationReplicationAsyncBandwidthUpdateHandler: replication.ReplicationAsyncBandwidthUpdateHandlerFunc(func(params replication.ReplicationAsyncBandwidthUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationAsyncBandwidthUpdate has not yet been implemented")
+		}),
+		ReplicationReplicationAsyncGetHandler: replication.ReplicationAsyncGetHandlerFunc(func(params replication.ReplicationAsyncGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationAsyncGet has not yet been implemented")
+		}),
+		ReplicationReplicationAsyncRepairHandler: replication.ReplicationAsyncRepairHandlerFunc(func(params replication.ReplicationAsyncRepairParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationAsyncRepair has not yet been implemented")
+		}),
+		ReplicationReplicationStandbyGetHandler: replication.ReplicationStandbyGetHandlerFunc(func(params replication.ReplicationStandbyGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationStandbyGet has not yet been implemented")
+		}),
+		ReplicationReplicationStandbyPromoteHandler: replication.ReplicationStandbyPromoteHandlerFunc(func(params replication.ReplicationStandbyPromoteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationStandbyPromote has not yet been implemented")
+		}),
+		AuthzRolesCreateHandler: authz.RolesCreateHandlerFunc(func(params authz.RolesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.RolesCreate has not yet been implemented")
+		}),
+		AuthzRolesDeleteHandler: authz.RolesDeleteHandlerFunc(func(params authz.RolesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.RolesDelete has not yet been implemented")
+		}),
+		AuthzRolesGetHandler: authz.RolesGetHandlerFunc(func(params authz.RolesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.RolesGet has not yet been implemented")
+		}),
+		AuthzRolesListHandler: authz.RolesListHandlerFunc(func(params authz.RolesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.RolesList has not yet been implemented")
+		}),
+		AuthzRolesUpdateHandler: authz.RolesUpdateHandlerFunc(func(params authz.RolesUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.RolesUpdate has not yet been implemented")
+		}),
 		SchemaSchemaClusterStatusHandler: schema.SchemaClusterStatusHandlerFunc(func(params schema.SchemaClusterStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaClusterStatus has not yet been implemented")
 		}),
@@ -186,6 +369,12 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsPropertiesAddHandler: schema.SchemaObjectsPropertiesAddHandlerFunc(func(params schema.SchemaObjectsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaObjectsRebalanceGetHandler: schema.SchemaObjectsRebalanceGetHandlerFunc(func(params schema.SchemaObjectsRebalanceGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsRebalanceGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsReshardGetHandler: schema.SchemaObjectsReshardGetHandlerFunc(func(params schema.SchemaObjectsReshardGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsReshardGet has not yet been implemented")
+		}),
 		SchemaSchemaObjectsShardsGetHandler: schema.SchemaObjectsShardsGetHandlerFunc(func(params schema.SchemaObjectsShardsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsGet has not yet been implemented")
 		}),
@@ -195,15 +384,48 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsUpdateHandler: schema.SchemaObjectsUpdateHandlerFunc(func(params schema.SchemaObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsUpdate has not yet been implemented")
 		}),
+		DebugSlowQueriesDeleteHandler: debug.SlowQueriesDeleteHandlerFunc(func(params debug.SlowQueriesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation debug.SlowQueriesDelete has not yet been implemented")
+		}),
+		DebugSlowQueriesGetHandler: debug.SlowQueriesGetHandlerFunc(func(params debug.SlowQueriesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation debug.SlowQueriesGet has not yet been implemented")
+		}),
+		SQLSQLQueryHandler: sql.SQLQueryHandlerFunc(func(params sql.SQLQueryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation sql.SQLQuery has not yet been implemented")
+		}),
+		NodesStartupGetHandler: nodes.StartupGetHandlerFunc(func(params nodes.StartupGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.StartupGet has not yet been implemented")
+		}),
+		NodesStartupPriorityUpdateHandler: nodes.StartupPriorityUpdateHandlerFunc(func(params nodes.StartupPriorityUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.StartupPriorityUpdate has not yet been implemented")
+		}),
 		SchemaTenantsCreateHandler: schema.TenantsCreateHandlerFunc(func(params schema.TenantsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantsCreate has not yet been implemented")
 		}),
 		SchemaTenantsDeleteHandler: schema.TenantsDeleteHandlerFunc(func(params schema.TenantsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantsDelete has not yet been implemented")
 		}),
+		SchemaTenantsExportHandler: schema.TenantsExportHandlerFunc(func(params schema.TenantsExportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsExport has not yet been implemented")
+		}),
 		SchemaTenantsGetHandler: schema.TenantsGetHandlerFunc(func(params schema.TenantsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantsGet has not yet been implemented")
 		}),
+		SchemaTenantsImportHandler: schema.TenantsImportHandlerFunc(func(params schema.TenantsImportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsImport has not yet been implemented")
+		}),
+		SchemaTenantsJobsCreateHandler: schema.TenantsJobsCreateHandlerFunc(func(params schema.TenantsJobsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsJobsCreate has not yet been implemented")
+		}),
+		SchemaTenantsJobsGetHandler: schema.TenantsJobsGetHandlerFunc(func(params schema.TenantsJobsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsJobsGet has not yet been implemented")
+		}),
+		SchemaTenantsJobsListHandler: schema.TenantsJobsListHandlerFunc(func(params schema.TenantsJobsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsJobsList has not yet been implemented")
+		}),
+		SchemaTenantsStatsGetHandler: schema.TenantsStatsGetHandlerFunc(func(params schema.TenantsStatsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsStatsGet has not yet been implemented")
+		}),
 		SchemaTenantsUpdateHandler: schema.TenantsUpdateHandlerFunc(func(params schema.TenantsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantsUpdate has not yet been implemented")
 		}),
@@ -250,15 +472,34 @@ type WeaviateAPI struct {
 	// It has a default implementation in the security package, however you can replace it for your particular usage.
 	BearerAuthenticator func(string, security.ScopedTokenAuthentication) runtime.Authenticator
 
+	// BinConsumer registers a consumer for the following mime types:
+	//   - application/octet-stream
+	BinConsumer runtime.Consumer
+	// GzipConsumer registers a consumer for the following mime types:
+	//   - application/gzip
+	GzipConsumer runtime.Consumer
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
 	JSONConsumer runtime.Consumer
+	// MultipartformConsumer registers a consumer for the following mime types:
+	//   - multipart/form-data
+	MultipartformConsumer runtime.Consumer
 	// YamlConsumer registers a consumer for the following mime types:
 	//   - application/yaml
 	YamlConsumer runtime.Consumer
 
+	// ApplicationVndApacheParquetProducer registers a producer for the following mime types:
+	//   - application/vnd.apache.parquet
+	ApplicationVndApacheParquetProducer runtime.Producer
+	// BinProducer registers a producer for the following mime types:
+	//   - application/octet-stream
+	BinProducer runtime.Producer
+	// GzipProducer registers a producer for the following mime types:
+	//   - application/gzip
+	GzipProducer runtime.Producer
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
+	//   - application/x-ndjson
 	JSONProducer runtime.Producer
 
 	// OidcAuth registers a function that takes an access token and a collection of required scopes and returns a principal
@@ -270,6 +511,8 @@ type WeaviateAPI struct {
 
 	// WellKnownGetWellKnownOpenidConfigurationHandler sets the operation handler for the get well known openid configuration operation
 	WellKnownGetWellKnownOpenidConfigurationHandler well_known.GetWellKnownOpenidConfigurationHandler
+	// AskAskAnswerHandler sets the operation handler for the ask answer operation
+	AskAskAnswerHandler ask.AskAnswerHandler
 	// BackupsBackupsCreateHandler sets the operation handler for the backups create operation
 	BackupsBackupsCreateHandler backups.BackupsCreateHandler
 	// BackupsBackupsCreateStatusHandler sets the operation handler for the backups create status operation
@@ -278,6 +521,8 @@ type WeaviateAPI struct {
 	BackupsBackupsRestoreHandler backups.BackupsRestoreHandler
 	// BackupsBackupsRestoreStatusHandler sets the operation handler for the backups restore status operation
 	BackupsBackupsRestoreStatusHandler backups.BackupsRestoreStatusHandler
+	// BatchBatchIngestGetHandler sets the operation handler for the batch ingest get operation
+	BatchBatchIngestGetHandler batch.BatchIngestGetHandler
 	// BatchBatchObjectsCreateHandler sets the operation handler for the batch objects create operation
 	BatchBatchObjectsCreateHandler batch.BatchObjectsCreateHandler
 	// BatchBatchObjectsDeleteHandler sets the operation handler for the batch objects delete operation
@@ -288,12 +533,76 @@ type WeaviateAPI struct {
 	ClassificationsClassificationsGetHandler classifications.ClassificationsGetHandler
 	// ClassificationsClassificationsPostHandler sets the operation handler for the classifications post operation
 	ClassificationsClassificationsPostHandler classifications.ClassificationsPostHandler
+	// ClusterClusterShardsBandwidthUpdateHandler sets the operation handler for the cluster shards bandwidth update operation
+	ClusterClusterShardsBandwidthUpdateHandler cluster.ClusterShardsBandwidthUpdateHandler
+	// ClusterClusterShardsGetHandler sets the operation handler for the cluster shards get operation
+	ClusterClusterShardsGetHandler cluster.ClusterShardsGetHandler
+	// ClusterClusterShardsMoveHandler sets the operation handler for the cluster shards move operation
+	ClusterClusterShardsMoveHandler cluster.ClusterShardsMoveHandler
+	// NodesConfigUpdateHandler sets the operation handler for the config update operation
+	NodesConfigUpdateHandler nodes.ConfigUpdateHandler
+	// DebugDebugBundleGetHandler sets the operation handler for the debug bundle get operation
+	DebugDebugBundleGetHandler debug.DebugBundleGetHandler
+	// DebugDebugRecallEvaluateHandler sets the operation handler for the debug recall evaluate operation
+	DebugDebugRecallEvaluateHandler debug.DebugRecallEvaluateHandler
+	// DebugDebugVectorGraphExportHandler sets the operation handler for the debug vector graph export operation
+	DebugDebugVectorGraphExportHandler debug.DebugVectorGraphExportHandler
+	// DebugDebugVectorGraphImportHandler sets the operation handler for the debug vector graph import operation
+	DebugDebugVectorGraphImportHandler debug.DebugVectorGraphImportHandler
 	// GraphqlGraphqlBatchHandler sets the operation handler for the graphql batch operation
 	GraphqlGraphqlBatchHandler graphql.GraphqlBatchHandler
+	// GraphqlGraphqlExplainHandler sets the operation handler for the graphql explain operation
+	GraphqlGraphqlExplainHandler graphql.GraphqlExplainHandler
 	// GraphqlGraphqlPostHandler sets the operation handler for the graphql post operation
 	GraphqlGraphqlPostHandler graphql.GraphqlPostHandler
+	// GraphqlGraphqlTemplatesDeleteHandler sets the operation handler for the graphql templates delete operation
+	GraphqlGraphqlTemplatesDeleteHandler graphql.GraphqlTemplatesDeleteHandler
+	// GraphqlGraphqlTemplatesExecuteHandler sets the operation handler for the graphql templates execute operation
+	GraphqlGraphqlTemplatesExecuteHandler graphql.GraphqlTemplatesExecuteHandler
+	// GraphqlGraphqlTemplatesGetHandler sets the operation handler for the graphql templates get operation
+	GraphqlGraphqlTemplatesGetHandler graphql.GraphqlTemplatesGetHandler
+	// GraphqlGraphqlTemplatesListHandler sets the operation handler for the graphql templates list operation
+	GraphqlGraphqlTemplatesListHandler graphql.GraphqlTemplatesListHandler
+	// GraphqlGraphqlTemplatesPutHandler sets the operation handler for the graphql templates put operation
+	GraphqlGraphqlTemplatesPutHandler graphql.GraphqlTemplatesPutHandler
+	// BatchImportsCancelHandler sets the operation handler for the imports cancel operation
+	BatchImportsCancelHandler batch.ImportsCancelHandler
+	// BatchImportsCreateHandler sets the operation handler for the imports create operation
+	BatchImportsCreateHandler batch.ImportsCreateHandler
+	// BatchImportsCsvUploadHandler sets the operation handler for the imports csv upload operation
+	BatchImportsCsvUploadHandler batch.ImportsCsvUploadHandler
+	// BatchImportsGetHandler sets the operation handler for the imports get operation
+	BatchImportsGetHandler batch.ImportsGetHandler
+	// BatchImportsListHandler sets the operation handler for the imports list operation
+	BatchImportsListHandler batch.ImportsListHandler
+	// ApikeysKeysCreateHandler sets the operation handler for the keys create operation
+	ApikeysKeysCreateHandler apikeys.KeysCreateHandler
+	// ApikeysKeysGetHandler sets the operation handler for the keys get operation
+	ApikeysKeysGetHandler apikeys.KeysGetHandler
+	// ApikeysKeysListHandler sets the operation handler for the keys list operation
+	ApikeysKeysListHandler apikeys.KeysListHandler
+	// ApikeysKeysRevokeHandler sets the operation handler for the keys revoke operation
+	ApikeysKeysRevokeHandler apikeys.KeysRevokeHandler
 	// MetaMetaGetHandler sets the operation handler for the meta get operation
 	MetaMetaGetHandler meta.MetaGetHandler
+	// ModulesModulesCredentialsDeleteHandler sets the operation handler for the modules credentials delete operation
+	ModulesModulesCredentialsDeleteHandler modules.ModulesCredentialsDeleteHandler
+	// ModulesModulesCredentialsListHandler sets the operation handler for the modules credentials list operation
+	ModulesModulesCredentialsListHandler modules.ModulesCredentialsListHandler
+	// ModulesModulesCredentialsTenantDeleteHandler sets the operation handler for the modules credentials tenant delete operation
+	ModulesModulesCredentialsTenantDeleteHandler modules.ModulesCredentialsTenantDeleteHandler
+	// ModulesModulesCredentialsTenantUpdateHandler sets the operation handler for the modules credentials tenant update operation
+	ModulesModulesCredentialsTenantUpdateHandler modules.ModulesCredentialsTenantUpdateHandler
+	// ModulesModulesCredentialsUpdateHandler sets the operation handler for the modules credentials update operation
+	ModulesModulesCredentialsUpdateHandler modules.ModulesCredentialsUpdateHandler
+	// ModulesModulesUsageGetHandler sets the operation handler for the modules usage get operation
+	ModulesModulesUsageGetHandler modules.ModulesUsageGetHandler
+	// NodesNodesDrainCreateHandler sets the operation handler for the nodes drain create operation
+	NodesNodesDrainCreateHandler nodes.NodesDrainCreateHandler
+	// NodesNodesDrainDeleteHandler sets the operation handler for the nodes drain delete operation
+	NodesNodesDrainDeleteHandler nodes.NodesDrainDeleteHandler
+	// NodesNodesDrainGetHandler sets the operation handler for the nodes drain get operation
+	NodesNodesDrainGetHandler nodes.NodesDrainGetHandler
 	// NodesNodesGetHandler sets the operation handler for the nodes get operation
 	NodesNodesGetHandler nodes.NodesGetHandler
 	// NodesNodesGetClassHandler sets the operation handler for the nodes get class operation
@@ -314,10 +623,20 @@ type WeaviateAPI struct {
 	ObjectsObjectsClassReferencesDeleteHandler objects.ObjectsClassReferencesDeleteHandler
 	// ObjectsObjectsClassReferencesPutHandler sets the operation handler for the objects class references put operation
 	ObjectsObjectsClassReferencesPutHandler objects.ObjectsClassReferencesPutHandler
+	// ObjectsObjectsClassVersionsDiffHandler sets the operation handler for the objects class versions diff operation
+	ObjectsObjectsClassVersionsDiffHandler objects.ObjectsClassVersionsDiffHandler
+	// ObjectsObjectsClassVersionsListHandler sets the operation handler for the objects class versions list operation
+	ObjectsObjectsClassVersionsListHandler objects.ObjectsClassVersionsListHandler
+	// ObjectsObjectsClassVersionsRollbackHandler sets the operation handler for the objects class versions rollback operation
+	ObjectsObjectsClassVersionsRollbackHandler objects.ObjectsClassVersionsRollbackHandler
 	// ObjectsObjectsCreateHandler sets the operation handler for the objects create operation
 	ObjectsObjectsCreateHandler objects.ObjectsCreateHandler
 	// ObjectsObjectsDeleteHandler sets the operation handler for the objects delete operation
 	ObjectsObjectsDeleteHandler objects.ObjectsDeleteHandler
+	// ObjectsObjectsDuplicatesHandler sets the operation handler for the objects duplicates operation
+	ObjectsObjectsDuplicatesHandler objects.ObjectsDuplicatesHandler
+	// ObjectsObjectsExportHandler sets the operation handler for the objects export operation
+	ObjectsObjectsExportHandler objects.ObjectsExportHandler
 	// ObjectsObjectsGetHandler sets the operation handler for the objects get operation
 	ObjectsObjectsGetHandler objects.ObjectsGetHandler
 	// ObjectsObjectsHeadHandler sets the operation handler for the objects head operation
@@ -332,10 +651,40 @@ type WeaviateAPI struct {
 	ObjectsObjectsReferencesDeleteHandler objects.ObjectsReferencesDeleteHandler
 	// ObjectsObjectsReferencesUpdateHandler sets the operation handler for the objects references update operation
 	ObjectsObjectsReferencesUpdateHandler objects.ObjectsReferencesUpdateHandler
+	// ObjectsObjectsTransactionHandler sets the operation handler for the objects transaction operation
+	ObjectsObjectsTransactionHandler objects.ObjectsTransactionHandler
 	// ObjectsObjectsUpdateHandler sets the operation handler for the objects update operation
 	ObjectsObjectsUpdateHandler objects.ObjectsUpdateHandler
+	// ObjectsObjectsUploadHandler sets the operation handler for the objects upload operation
+	ObjectsObjectsUploadHandler objects.ObjectsUploadHandler
 	// ObjectsObjectsValidateHandler sets the operation handler for the objects validate operation
 	ObjectsObjectsValidateHandler objects.ObjectsValidateHandler
+	// ObjectsObjectsVersionsDiffHandler sets the operation handler for the objects versions diff operation
+	ObjectsObjectsVersionsDiffHandler objects.ObjectsVersionsDiffHandler
+	// ObjectsObjectsVersionsListHandler sets the operation handler for the objects versions list operation
+	ObjectsObjectsVersionsListHandler objects.ObjectsVersionsListHandler
+	// ObjectsObjectsVersionsRollbackHandler sets the operation handler for the objects versions rollback operation
+	ObjectsObjectsVersionsRollbackHandler objects.ObjectsVersionsRollbackHandler
+	// ReplicationReplicationAsyncBandwidthUpdateHandler sets the operation handler for the replication async bandwidth update operation
+	ReplicationReplicationAsyncBandwidthUpdateHandler replication.ReplicationAsyncBandwidthUpdateHandler
+	// ReplicationReplicationAsyncGetHandler sets the operation handler for the replication async get operation
+	ReplicationReplicationAsyncGetHandler replication.ReplicationAsyncGetHandler
+	// ReplicationReplicationAsyncRepairHandler sets the operation handler for the replication async repair operation
+	ReplicationReplicationAsyncRepairHandler replication.ReplicationAsyncRepairHandler
+	// ReplicationReplicationStandbyGetHandler sets the operation handler for the replication standby get operation
+	ReplicationReplicationStandbyGetHandler replication.ReplicationStandbyGetHandler
+	// ReplicationReplicationStandbyPromoteHandler sets the operation handler for the replication standby promote operation
+	ReplicationReplicationStandbyPromoteHandler replication.ReplicationStandbyPromoteHandler
+	// AuthzRolesCreateHandler sets the operation handler for the roles create operation
+	AuthzRolesCreateHandler authz.RolesCreateHandler
+	// AuthzRolesDeleteHandler sets the operation handler for the roles delete operation
+	AuthzRolesDeleteHandler authz.RolesDeleteHandler
+	// AuthzRolesGetHandler sets the operation handler for the roles get operation
+	AuthzRolesGetHandler authz.RolesGetHandler
+	// AuthzRolesListHandler sets the operation handler for the roles list operation
+	AuthzRolesListHandler authz.RolesListHandler
+	// AuthzRolesUpdateHandler sets the operation handler for the roles update operation
+	AuthzRolesUpdateHandler authz.RolesUpdateHandler
 	// SchemaSchemaClusterStatusHandler sets the operation handler for the schema cluster status operation
 	SchemaSchemaClusterStatusHandler schema.SchemaClusterStatusHandler
 	// SchemaSchemaDumpHandler sets the operation handler for the schema dump operation
@@ -348,18 +697,44 @@ type WeaviateAPI struct {
 	SchemaSchemaObjectsGetHandler schema.SchemaObjectsGetHandler
 	// SchemaSchemaObjectsPropertiesAddHandler sets the operation handler for the schema objects properties add operation
 	SchemaSchemaObjectsPropertiesAddHandler schema.SchemaObjectsPropertiesAddHandler
+	// SchemaSchemaObjectsRebalanceGetHandler sets the operation handler for the schema objects rebalance get operation
+	SchemaSchemaObjectsRebalanceGetHandler schema.SchemaObjectsRebalanceGetHandler
+	// SchemaSchemaObjectsReshardGetHandler sets the operation handler for the schema objects reshard get operation
+	SchemaSchemaObjectsReshardGetHandler schema.SchemaObjectsReshardGetHandler
 	// SchemaSchemaObjectsShardsGetHandler sets the operation handler for the schema objects shards get operation
 	SchemaSchemaObjectsShardsGetHandler schema.SchemaObjectsShardsGetHandler
 	// SchemaSchemaObjectsShardsUpdateHandler sets the operation handler for the schema objects shards update operation
 	SchemaSchemaObjectsShardsUpdateHandler schema.SchemaObjectsShardsUpdateHandler
 	// SchemaSchemaObjectsUpdateHandler sets the operation handler for the schema objects update operation
 	SchemaSchemaObjectsUpdateHandler schema.SchemaObjectsUpdateHandler
+	// DebugSlowQueriesDeleteHandler sets the operation handler for the slow queries delete operation
+	DebugSlowQueriesDeleteHandler debug.SlowQueriesDeleteHandler
+	// DebugSlowQueriesGetHandler sets the operation handler for the slow queries get operation
+	DebugSlowQueriesGetHandler debug.SlowQueriesGetHandler
+	// SQLSQLQueryHandler sets the operation handler for the sql query operation
+	SQLSQLQueryHandler sql.SQLQueryHandler
+	// NodesStartupGetHandler sets the operation handler for the startup get operation
+	NodesStartupGetHandler nodes.StartupGetHandler
+	// NodesStartupPriorityUpdateHandler sets the operation handler for the startup priority update operation
+	NodesStartupPriorityUpdateHandler nodes.StartupPriorityUpdateHandler
 	// SchemaTenantsCreateHandler sets the operation handler for the tenants create operation
 	SchemaTenantsCreateHandler schema.TenantsCreateHandler
 	// SchemaTenantsDeleteHandler sets the operation handler for the tenants delete operation
 	SchemaTenantsDeleteHandler schema.TenantsDeleteHandler
+	// SchemaTenantsExportHandler sets the operation handler for the tenants export operation
+	SchemaTenantsExportHandler schema.TenantsExportHandler
 	// SchemaTenantsGetHandler sets the operation handler for the tenants get operation
 	SchemaTenantsGetHandler schema.TenantsGetHandler
+	// SchemaTenantsImportHandler sets the operation handler for the tenants import operation
+	SchemaTenantsImportHandler schema.TenantsImportHandler
+	// SchemaTenantsJobsCreateHandler sets the operation handler for the tenants jobs create operation
+	SchemaTenantsJobsCreateHandler schema.TenantsJobsCreateHandler
+	// SchemaTenantsJobsGetHandler sets the operation handler for the tenants jobs get operation
+	SchemaTenantsJobsGetHandler schema.TenantsJobsGetHandler
+	// SchemaTenantsJobsListHandler sets the operation handler for the tenants jobs list operation
+	SchemaTenantsJobsListHandler schema.TenantsJobsListHandler
+	// SchemaTenantsStatsGetHandler sets the operation handler for the tenants stats get operation
+	SchemaTenantsStatsGetHandler schema.TenantsStatsGetHandler
 	// SchemaTenantsUpdateHandler sets the operation handler for the tenants update operation
 	SchemaTenantsUpdateHandler schema.TenantsUpdateHandler
 	// WeaviateRootHandler sets the operation handler for the weaviate root operation
@@ -437,13 +812,31 @@ func (o *WeaviateAPI) RegisterFormat(name string, format strfmt.Format, validato
 func (o *WeaviateAPI) Validate() error {
 	var unregistered []string
 
+	if o.BinConsumer == nil {
+		unregistered = append(unregistered, "BinConsumer")
+	}
+	if o.GzipConsumer == nil {
+		unregistered = append(unregistered, "GzipConsumer")
+	}
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
+	if o.MultipartformConsumer == nil {
+		unregistered = append(unregistered, "MultipartformConsumer")
+	}
 	if o.YamlConsumer == nil {
 		unregistered = append(unregistered, "YamlConsumer")
 	}
 
+	if o.ApplicationVndApacheParquetProducer == nil {
+		unregistered = append(unregistered, "ApplicationVndApacheParquetProducer")
+	}
+	if o.BinProducer == nil {
+		unregistered = append(unregistered, "BinProducer")
+	}
+	if o.GzipProducer == nil {
+		unregistered = append(unregistered, "GzipProducer")
+	}
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
@@ -455,6 +848,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.WellKnownGetWellKnownOpenidConfigurationHandler == nil {
 		unregistered = append(unregistered, "well_known.GetWellKnownOpenidConfigurationHandler")
 	}
+	if o.AskAskAnswerHandler == nil {
+		unregistered = append(unregistered, "ask.AskAnswerHandler")
+	}
 	if o.BackupsBackupsCreateHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsCreateHandler")
 	}
@@ -467,6 +863,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BackupsBackupsRestoreStatusHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsRestoreStatusHandler")
 	}
+	if o.BatchBatchIngestGetHandler == nil {
+		unregistered = append(unregistered, "batch.BatchIngestGetHandler")
+	}
 	if o.BatchBatchObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsCreateHandler")
 	}
@@ -482,15 +881,111 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ClassificationsClassificationsPostHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsPostHandler")
 	}
+	if o.ClusterClusterShardsBandwidthUpdateHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterShardsBandwidthUpdateHandler")
+	}
+	if o.ClusterClusterShardsGetHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterShardsGetHandler")
+	}
+	if o.ClusterClusterShardsMoveHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterShardsMoveHandler")
+	}
+	if o.NodesConfigUpdateHandler == nil {
+		unregistered = append(unregistered, "nodes.ConfigUpdateHandler")
+	}
+	if o.DebugDebugBundleGetHandler == nil {
+		unregistered = append(unregistered, "debug.DebugBundleGetHandler")
+	}
+	if o.DebugDebugRecallEvaluateHandler == nil {
+		unregistered = append(unregistered, "debug.DebugRecallEvaluateHandler")
+	}
+	if o.DebugDebugVectorGraphExportHandler == nil {
+		unregistered = append(unregistered, "debug.DebugVectorGraphExportHandler")
+	}
+	if o.DebugDebugVectorGraphImportHandler == nil {
+		unregistered = append(unregistered, "debug.DebugVectorGraphImportHandler")
+	}
 	if o.GraphqlGraphqlBatchHandler == nil {
 		unregistered = append(unregistered, "graphql.GraphqlBatchHandler")
 	}
+	if o.GraphqlGraphqlExplainHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlExplainHandler")
+	}
 	if o.GraphqlGraphqlPostHandler == nil {
 		unregistered = append(unregistered, "graphql.GraphqlPostHandler")
 	}
+	if o.GraphqlGraphqlTemplatesDeleteHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlTemplatesDeleteHandler")
+	}
+	if o.GraphqlGraphqlTemplatesExecuteHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlTemplatesExecuteHandler")
+	}
+	if o.GraphqlGraphqlTemplatesGetHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlTemplatesGetHandler")
+	}
+	if o.GraphqlGraphqlTemplatesListHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlTemplatesListHandler")
+	}
+	if o.GraphqlGraphqlTemplatesPutHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlTemplatesPutHandler")
+	}
+	if o.BatchImportsCancelHandler == nil {
+		unregistered = append(unregistered, "batch.ImportsCancelHandler")
+	}
+	if o.BatchImportsCreateHandler == nil {
+		unregistered = append(unregistered, "batch.ImportsCreateHandler")
+	}
+	if o.BatchImportsCsvUploadHandler == nil {
+		unregistered = append(unregistered, "batch.ImportsCsvUploadHandler")
+	}
+	if o.BatchImportsGetHandler == nil {
+		unregistered = append(unregistered, "batch.ImportsGetHandler")
+	}
+	if o.BatchImportsListHandler == nil {
+		unregistered = append(unregistered, "batch.ImportsListHandler")
+	}
+	if o.ApikeysKeysCreateHandler == nil {
+		unregistered = append(unregistered, "apikeys.KeysCreateHandler")
+	}
+	if o.ApikeysKeysGetHandler == nil {
+		unregistered = append(unregistered, "apikeys.KeysGetHandler")
+	}
+	if o.ApikeysKeysListHandler == nil {
+		unregistered = append(unregistered, "apikeys.KeysListHandler")
+	}
+	if o.ApikeysKeysRevokeHandler == nil {
+		unregistered = append(unregistered, "apikeys.KeysRevokeHandler")
+	}
 	if o.MetaMetaGetHandler == nil {
 		unregistered = append(unregistered, "meta.MetaGetHandler")
 	}
+	if o.ModulesModulesCredentialsDeleteHandler == nil {
+		unregistered = append(unregistered, "modules.ModulesCredentialsDeleteHandler")
+	}
+	if o.ModulesModulesCredentialsListHandler == nil {
+		unregistered = append(unregistered, "modules.ModulesCredentialsListHandler")
+	}
+	if o.ModulesModulesCredentialsTenantDeleteHandler == nil {
+		unregistered = append(unregistered, "modules.ModulesCredentialsTenantDeleteHandler")
+	}
+	if o.ModulesModulesCredentialsTenantUpdateHandler == nil {
+		unregistered = append(unregistered, "modules.ModulesCredentialsTenantUpdateHandler")
+	}
+	if o.ModulesModulesCredentialsUpdateHandler == nil {
+		unregistered = append(unregistered, "modules.ModulesCredentialsUpdateHandler")
+	}
+	if o.ModulesModulesUsageGetHandler == nil {
+		unregistered = append(unregistered, "modules.ModulesUsageGetHandler")
+	}
+	if o.NodesNodesDrainCreateHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesDrainCreateHandler")
+	}
+	if o.NodesNodesDrainDeleteHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesDrainDeleteHandler")
+	}
+	if o.NodesNodesDrainGetHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesDrainGetHandler")
+	}
 	if o.NodesNodesGetHandler == nil {
 		unregistered = append(unregistered, "nodes.NodesGetHandler")
 	}
@@ -521,12 +1016,27 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsClassReferencesPutHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassReferencesPutHandler")
 	}
+	if o.ObjectsObjectsClassVersionsDiffHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsClassVersionsDiffHandler")
+	}
+	if o.ObjectsObjectsClassVersionsListHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsClassVersionsListHandler")
+	}
+	if o.ObjectsObjectsClassVersionsRollbackHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsClassVersionsRollbackHandler")
+	}
 	if o.ObjectsObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsCreateHandler")
 	}
 	if o.ObjectsObjectsDeleteHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsDeleteHandler")
 	}
+	if o.ObjectsObjectsDuplicatesHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsDuplicatesHandler")
+	}
+	if o.ObjectsObjectsExportHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsExportHandler")
+	}
 	if o.ObjectsObjectsGetHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsGetHandler")
 	}
@@ -548,12 +1058,57 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsReferencesUpdateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsReferencesUpdateHandler")
 	}
+	if o.ObjectsObjectsTransactionHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsTransactionHandler")
+	}
 	if o.ObjectsObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsUpdateHandler")
 	}
+	if o.ObjectsObjectsUploadHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsUploadHandler")
+	}
 	if o.ObjectsObjectsValidateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsValidateHandler")
 	}
+	if o.ObjectsObjectsVersionsDiffHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsVersionsDiffHandler")
+	}
+	if o.ObjectsObjectsVersionsListHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsVersionsListHandler")
+	}
+	if o.ObjectsObjectsVersionsRollbackHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsVersionsRollbackHandler")
+	}
+	if o.ReplicationReplicationAsyncBandwidthUpdateHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationAsyncBandwidthUpdateHandler")
+	}
+	if o.ReplicationReplicationAsyncGetHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationAsyncGetHandler")
+	}
+	if o.ReplicationReplicationAsyncRepairHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationAsyncRepairHandler")
+	}
+	if o.ReplicationReplicationStandbyGetHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationStandbyGetHandler")
+	}
+	if o.ReplicationReplicationStandbyPromoteHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationStandbyPromoteHandler")
+	}
+	if o.AuthzRolesCreateHandler == nil {
+		unregistered = append(unregistered, "authz.RolesCreateHandler")
+	}
+	if o.AuthzRolesDeleteHandler == nil {
+		unregistered = append(unregistered, "authz.RolesDeleteHandler")
+	}
+	if o.AuthzRolesGetHandler == nil {
+		unregistered = append(unregistered, "authz.RolesGetHandler")
+	}
+	if o.AuthzRolesListHandler == nil {
+		unregistered = append(unregistered, "authz.RolesListHandler")
+	}
+	if o.AuthzRolesUpdateHandler == nil {
+		unregistered = append(unregistered, "authz.RolesUpdateHandler")
+	}
 	if o.SchemaSchemaClusterStatusHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaClusterStatusHandler")
 	}
@@ -572,6 +1127,12 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaObjectsRebalanceGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsRebalanceGetHandler")
+	}
+	if o.SchemaSchemaObjectsReshardGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsReshardGetHandler")
+	}
 	if o.SchemaSchemaObjectsShardsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsGetHandler")
 	}
@@ -581,15 +1142,48 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsUpdateHandler")
 	}
+	if o.DebugSlowQueriesDeleteHandler == nil {
+		unregistered = append(unregistered, "debug.SlowQueriesDeleteHandler")
+	}
+	if o.DebugSlowQueriesGetHandler == nil {
+		unregistered = append(unregistered, "debug.SlowQueriesGetHandler")
+	}
+	if o.SQLSQLQueryHandler == nil {
+		unregistered = append(unregistered, "sql.SQLQueryHandler")
+	}
+	if o.NodesStartupGetHandler == nil {
+		unregistered = append(unregistered, "nodes.StartupGetHandler")
+	}
+	if o.NodesStartupPriorityUpdateHandler == nil {
+		unregistered = append(unregistered, "nodes.StartupPriorityUpdateHandler")
+	}
 	if o.SchemaTenantsCreateHandler == nil {
 		unregistered = append(unregistered, "schema.TenantsCreateHandler")
 	}
 	if o.SchemaTenantsDeleteHandler == nil {
 		unregistered = append(unregistered, "schema.TenantsDeleteHandler")
 	}
+	if o.SchemaTenantsExportHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsExportHandler")
+	}
 	if o.SchemaTenantsGetHandler == nil {
 		unregistered = append(unregistered, "schema.TenantsGetHandler")
 	}
+	if o.SchemaTenantsImportHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsImportHandler")
+	}
+	if o.SchemaTenantsJobsCreateHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsJobsCreateHandler")
+	}
+	if o.SchemaTenantsJobsGetHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsJobsGetHandler")
+	}
+	if o.SchemaTenantsJobsListHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsJobsListHandler")
+	}
+	if o.SchemaTenantsStatsGetHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsStatsGetHandler")
+	}
 	if o.SchemaTenantsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.TenantsUpdateHandler")
 	}
@@ -641,8 +1235,14 @@ func (o *WeaviateAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Consu
 	result := make(map[string]runtime.Consumer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinConsumer
+		case "application/gzip":
+			result["application/gzip"] = o.GzipConsumer
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "multipart/form-data":
+			result["multipart/form-data"] = o.MultipartformConsumer
 		case "application/yaml":
 			result["application/yaml"] = o.YamlConsumer
 		}
@@ -660,8 +1260,16 @@ func (o *WeaviateAPI) ProducersFor(mediaTypes []string) map[string]runtime.Produ
 	result := make(map[string]runtime.Producer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "application/vnd.apache.parquet":
+			result["application/vnd.apache.parquet"] = o.ApplicationVndApacheParquetProducer
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinProducer
+		case "application/gzip":
+			result["application/gzip"] = o.GzipProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "application/x-ndjson":
+			result["application/x-ndjson"] = o.JSONProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -709,6 +1317,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/ask"] = ask.NewAskAnswer(o.context, o.AskAskAnswerHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/backups/{backend}"] = backups.NewBackupsCreate(o.context, o.BackupsBackupsCreateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -722,6 +1334,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/backups/{backend}/{id}/restore"] = backups.NewBackupsRestoreStatus(o.context, o.BackupsBackupsRestoreStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/batch/ingest"] = batch.NewBatchIngestGet(o.context, o.BatchBatchIngestGetHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -742,6 +1358,38 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/classifications"] = classifications.NewClassificationsPost(o.context, o.ClassificationsClassificationsPostHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/cluster/shards/bandwidth"] = cluster.NewClusterShardsBandwidthUpdate(o.context, o.ClusterClusterShardsBandwidthUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster/shards"] = cluster.NewClusterShardsGet(o.context, o.ClusterClusterShardsGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/cluster/shards/{shardName}/move"] = cluster.NewClusterShardsMove(o.context, o.ClusterClusterShardsMoveHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/config"] = nodes.NewConfigUpdate(o.context, o.NodesConfigUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/debug/bundle"] = debug.NewDebugBundleGet(o.context, o.DebugDebugBundleGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/debug/recall"] = debug.NewDebugRecallEvaluate(o.context, o.DebugDebugRecallEvaluateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/debug/vector-graph/{className}/{shardName}"] = debug.NewDebugVectorGraphExport(o.context, o.DebugDebugVectorGraphExportHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/debug/vector-graph/{className}/{shardName}"] = debug.NewDebugVectorGraphImport(o.context, o.DebugDebugVectorGraphImportHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -749,11 +1397,107 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/graphql/explain"] = graphql.NewGraphqlExplain(o.context, o.GraphqlGraphqlExplainHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/graphql"] = graphql.NewGraphqlPost(o.context, o.GraphqlGraphqlPostHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/templates/{name}"] = graphql.NewGraphqlTemplatesDelete(o.context, o.GraphqlGraphqlTemplatesDeleteHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/templates/{name}/execute"] = graphql.NewGraphqlTemplatesExecute(o.context, o.GraphqlGraphqlTemplatesExecuteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/templates/{name}"] = graphql.NewGraphqlTemplatesGet(o.context, o.GraphqlGraphqlTemplatesGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/templates"] = graphql.NewGraphqlTemplatesList(o.context, o.GraphqlGraphqlTemplatesListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/templates/{name}"] = graphql.NewGraphqlTemplatesPut(o.context, o.GraphqlGraphqlTemplatesPutHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/imports/{id}"] = batch.NewImportsCancel(o.context, o.BatchImportsCancelHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/imports"] = batch.NewImportsCreate(o.context, o.BatchImportsCreateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/imports:csv"] = batch.NewImportsCsvUpload(o.context, o.BatchImportsCsvUploadHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/imports/{id}"] = batch.NewImportsGet(o.context, o.BatchImportsGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/imports"] = batch.NewImportsList(o.context, o.BatchImportsListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/apikeys"] = apikeys.NewKeysCreate(o.context, o.ApikeysKeysCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/apikeys/{id}"] = apikeys.NewKeysGet(o.context, o.ApikeysKeysGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/apikeys"] = apikeys.NewKeysList(o.context, o.ApikeysKeysListHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/apikeys/{id}"] = apikeys.NewKeysRevoke(o.context, o.ApikeysKeysRevokeHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/meta"] = meta.NewMetaGet(o.context, o.MetaMetaGetHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/module-credentials"] = modules.NewModulesCredentialsDelete(o.context, o.ModulesModulesCredentialsDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/module-credentials"] = modules.NewModulesCredentialsList(o.context, o.ModulesModulesCredentialsListHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/module-credentials/{tenant}"] = modules.NewModulesCredentialsTenantDelete(o.context, o.ModulesModulesCredentialsTenantDeleteHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/module-credentials/{tenant}"] = modules.NewModulesCredentialsTenantUpdate(o.context, o.ModulesModulesCredentialsTenantUpdateHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/module-credentials"] = modules.NewModulesCredentialsUpdate(o.context, o.ModulesModulesCredentialsUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/usage"] = modules.NewModulesUsageGet(o.context, o.ModulesModulesUsageGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/nodes/{nodeName}/drain"] = nodes.NewNodesDrainCreate(o.context, o.NodesNodesDrainCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/nodes/{nodeName}/drain"] = nodes.NewNodesDrainDelete(o.context, o.NodesNodesDrainDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/nodes/{nodeName}/drain"] = nodes.NewNodesDrainGet(o.context, o.NodesNodesDrainGetHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -794,6 +1538,18 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/objects/{className}/{id}/references/{propertyName}"] = objects.NewObjectsClassReferencesPut(o.context, o.ObjectsObjectsClassReferencesPutHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/objects/{className}/{id}/versions/diff"] = objects.NewObjectsClassVersionsDiff(o.context, o.ObjectsObjectsClassVersionsDiffHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/objects/{className}/{id}/versions"] = objects.NewObjectsClassVersionsList(o.context, o.ObjectsObjectsClassVersionsListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/objects/{className}/{id}/versions/{version}/rollback"] = objects.NewObjectsClassVersionsRollback(o.context, o.ObjectsObjectsClassVersionsRollbackHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -802,6 +1558,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/objects/{id}"] = objects.NewObjectsDelete(o.context, o.ObjectsObjectsDeleteHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/objects:duplicates"] = objects.NewObjectsDuplicates(o.context, o.ObjectsObjectsDuplicatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/objects:export"] = objects.NewObjectsExport(o.context, o.ObjectsObjectsExportHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -830,6 +1594,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/objects/{id}/references/{propertyName}"] = objects.NewObjectsReferencesUpdate(o.context, o.ObjectsObjectsReferencesUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/transactions"] = objects.NewObjectsTransaction(o.context, o.ObjectsObjectsTransactionHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -837,10 +1605,66 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/objects:upload"] = objects.NewObjectsUpload(o.context, o.ObjectsObjectsUploadHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/objects/validate"] = objects.NewObjectsValidate(o.context, o.ObjectsObjectsValidateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/objects/{id}/versions/diff"] = objects.NewObjectsVersionsDiff(o.context, o.ObjectsObjectsVersionsDiffHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/objects/{id}/versions"] = objects.NewObjectsVersionsList(o.context, o.ObjectsObjectsVersionsListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/objects/{id}/versions/{version}/rollback"] = objects.NewObjectsVersionsRollback(o.context, o.ObjectsObjectsVersionsRollbackHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/replication/async/bandwidth"] = replication.NewReplicationAsyncBandwidthUpdate(o.context, o.ReplicationReplicationAsyncBandwidthUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/replication/async"] = replication.NewReplicationAsyncGet(o.context, o.ReplicationReplicationAsyncGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/replication/async/repair"] = replication.NewReplicationAsyncRepair(o.context, o.ReplicationReplicationAsyncRepairHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/replication/standby"] = replication.NewReplicationStandbyGet(o.context, o.ReplicationReplicationStandbyGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/replication/standby/promote"] = replication.NewReplicationStandbyPromote(o.context, o.ReplicationReplicationStandbyPromoteHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/authz/roles"] = authz.NewRolesCreate(o.context, o.AuthzRolesCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/authz/roles/{name}"] = authz.NewRolesDelete(o.context, o.AuthzRolesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/roles/{name}"] = authz.NewRolesGet(o.context, o.AuthzRolesGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/roles"] = authz.NewRolesList(o.context, o.AuthzRolesListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/authz/roles/{name}"] = authz.NewRolesUpdate(o.context, o.AuthzRolesUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/schema/cluster-status"] = schema.NewSchemaClusterStatus(o.context, o.SchemaSchemaClusterStatusHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -865,6 +1689,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/schema/{className}/rebalance"] = schema.NewSchemaObjectsRebalanceGet(o.context, o.SchemaSchemaObjectsRebalanceGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/reshard"] = schema.NewSchemaObjectsReshardGet(o.context, o.SchemaSchemaObjectsReshardGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/schema/{className}/shards"] = schema.NewSchemaObjectsShardsGet(o.context, o.SchemaSchemaObjectsShardsGetHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -874,6 +1706,26 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}"] = schema.NewSchemaObjectsUpdate(o.context, o.SchemaSchemaObjectsUpdateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/slow-queries"] = debug.NewSlowQueriesDelete(o.context, o.DebugSlowQueriesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/slow-queries"] = debug.NewSlowQueriesGet(o.context, o.DebugSlowQueriesGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/sql"] = sql.NewSQLQuery(o.context, o.SQLSQLQueryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/startup"] = nodes.NewStartupGet(o.context, o.NodesStartupGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/startup/priority"] = nodes.NewStartupPriorityUpdate(o.context, o.NodesStartupPriorityUpdateHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -885,7 +1737,31 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/schema/{className}/tenants/{tenantName}/export"] = schema.NewTenantsExport(o.context, o.SchemaTenantsExportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/schema/{className}/tenants"] = schema.NewTenantsGet(o.context, o.SchemaTenantsGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/tenants/{tenantName}/import"] = schema.NewTenantsImport(o.context, o.SchemaTenantsImportHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/tenants/jobs"] = schema.NewTenantsJobsCreate(o.context, o.SchemaTenantsJobsCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/tenants/jobs/{id}"] = schema.NewTenantsJobsGet(o.context, o.SchemaTenantsJobsGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/tenants/jobs"] = schema.NewTenantsJobsList(o.context, o.SchemaTenantsJobsListHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/tenants/{tenantName}/stats"] = schema.NewTenantsStatsGet(o.context, o.SchemaTenantsStatsGetHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
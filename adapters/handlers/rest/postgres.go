@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/weaviate/weaviate/adapters/handlers/pgwire"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+)
+
+// createPostgresServer returns nil if the Postgres wire protocol is
+// disabled
+func createPostgresServer(appState *state.State) *pgwire.Server {
+	if appState.ServerConfig.Config.Postgres.Port == 0 {
+		return nil
+	}
+
+	auth := appState.ServerConfig.Config.Authentication
+	return pgwire.NewServer(appState.SQL,
+		composer.New(auth, appState.APIKey, appState.OIDC),
+		auth.AnonymousAccess.Enabled, appState.Logger)
+}
+
+func startPostgresServer(server *pgwire.Server, appState *state.State) {
+	if server == nil {
+		return
+	}
+
+	port := appState.ServerConfig.Config.Postgres.Port
+	go func() {
+		l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+		if err == nil {
+			appState.Logger.WithField("action", "postgres_startup").
+				Infof("postgres wire protocol listening on %d", port)
+			err = server.Serve(l)
+		}
+		if err != nil {
+			appState.Logger.WithField("action", "postgres_startup").WithError(err).
+				Fatal("failed to start postgres server")
+		}
+	}()
+}
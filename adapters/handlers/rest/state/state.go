@@ -20,22 +20,47 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/graphql"
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/entities/tracing"
+	"github.com/weaviate/weaviate/usecases/antientropy"
+	"github.com/weaviate/weaviate/usecases/ask"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+	"github.com/weaviate/weaviate/usecases/backpressure"
 	"github.com/weaviate/weaviate/usecases/backup"
+	"github.com/weaviate/weaviate/usecases/balancer"
+	"github.com/weaviate/weaviate/usecases/bulkimport"
+	"github.com/weaviate/weaviate/usecases/cdc"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/idempotency"
+	"github.com/weaviate/weaviate/usecases/ingest"
 	"github.com/weaviate/weaviate/usecases/locks"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/modulecredentials"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/offload"
+	"github.com/weaviate/weaviate/usecases/otlp"
+	"github.com/weaviate/weaviate/usecases/querycache"
+	"github.com/weaviate/weaviate/usecases/quota"
+	"github.com/weaviate/weaviate/usecases/ref2vec"
 	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/retention"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/scrubber"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/slowquery"
+	"github.com/weaviate/weaviate/usecases/sql"
+	"github.com/weaviate/weaviate/usecases/standby"
+	"github.com/weaviate/weaviate/usecases/templates"
 	"github.com/weaviate/weaviate/usecases/traverser"
+	"github.com/weaviate/weaviate/usecases/usage"
 )
 
 // State is the only source of application-wide state
@@ -45,7 +70,31 @@ type State struct {
 	OIDC                  *oidc.Client
 	AnonymousAccess       *anonymous.Client
 	APIKey                *apikey.Client
+	APIKeys               *apikey.KeyStore
+	AuditLog              *audit.Logger
+	ChangeStream          *cdc.Stream
 	Authorizer            authorization.Authorizer
+	Roles                 *rbac.Store
+	Quotas                *quota.Enforcer
+	BatchBackpressure     *backpressure.Controller
+	IngestQueue           *ingest.Queue
+	QueryCache            *querycache.Cache
+	SlowQueryLog          *slowquery.Log
+	Idempotency           *idempotency.Store
+	Tracer                *tracing.Tracer
+	TraceExporter         *otlp.Exporter
+	MemoryGovernor        *memwatch.Governor
+	ConfigReloader        *config.Reloader
+	ModuleCredentials     *modulecredentials.Store
+	Usage                 *usage.Tracker
+	BulkImports           *bulkimport.Manager
+	TenantOffload         *offload.Manager
+	Standby               *standby.Manager
+	AsyncReplication      *antientropy.Manager
+	ShardBalancer         *balancer.Manager
+	PartitionRetention    *retention.Manager
+	Scrubber              *scrubber.Manager
+	Ref2VecRecomputer     *ref2vec.Recomputer
 	ServerConfig          *config.WeaviateConfig
 	Locks                 locks.ConnectorSchemaLock
 	Logger                *logrus.Logger
@@ -59,14 +108,21 @@ type State struct {
 	RemoteNodeIncoming    *sharding.RemoteNodeIncoming
 	RemoteReplicaIncoming *replica.RemoteReplicaIncoming
 	Traverser             *traverser.Traverser
+	SQL                   *sql.Executor
+	Ask                   *ask.Pipeline
+	QueryTemplates        *templates.Store
 
 	ClassificationRepo *classifications.DistributedRepo
 	Metrics            *monitoring.PrometheusMetrics
 	BackupManager      *backup.Handler
 	DB                 *db.DB
 	BatchManager       *objects.BatchManager
+	ObjectsManager     *objects.Manager
 	ClusterHttpClient  *http.Client
 	ReindexCtxCancel   context.CancelFunc
+	// StoreClosers close the stores of the schema, the classifications, the
+	// transactions and the modules once the database was shut down
+	StoreClosers []func() error
 }
 
 // GetGraphQL is the safe way to retrieve GraphQL from the state as it can be
@@ -48,6 +48,10 @@ func (r *Repo) DBPath() string {
 	return fmt.Sprintf("%s/classifications.db", r.baseDir)
 }
 
+func (r *Repo) Close() error {
+	return r.db.Close()
+}
+
 func (r *Repo) keyFromID(id strfmt.UUID) []byte {
 	return []byte(id)
 }
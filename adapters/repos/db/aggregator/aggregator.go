@@ -23,13 +23,25 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 )
 
 type vectorIndex interface {
-	SearchByVectorDistance(vector []float32, targetDistance float32, maxLimit int64,
+	SearchByVectorDistance(ctx context.Context, vector []float32, targetDistance float32, maxLimit int64,
 		allowList helpers.AllowList) ([]uint64, []float32, error)
-	SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVector(ctx context.Context, vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error)
+}
+
+// Statistics are maintained by a shard with every write, they answer
+// eventually consistent aggregations without reading the indexes
+type Statistics interface {
+	// ObjectCount returns the number of objects
+	ObjectCount() int
+	// PropertyBounds returns the smallest and largest value of a property in
+	// the encoding of its filterable index and the number of its values. ok
+	// is false if there are no statistics for the property.
+	PropertyBounds(prop string) (min, max []byte, count int, ok bool, err error)
 }
 
 type Aggregator struct {
@@ -45,6 +57,8 @@ type Aggregator struct {
 	isFallbackToSearchable inverted.IsFallbackToSearchable
 	tenant                 string
 	nestedCrossRefLimit    int64
+	statistics             Statistics
+	decrypter              storobj.PropertyDecrypter
 }
 
 func New(store *lsmkv.Store, params aggregation.Params,
@@ -53,7 +67,8 @@ func New(store *lsmkv.Store, params aggregation.Params,
 	vectorIndex vectorIndex, logger logrus.FieldLogger,
 	propLenTracker *inverted.JsonShardMetaData,
 	isFallbackToSearchable inverted.IsFallbackToSearchable,
-	tenant string, nestedCrossRefLimit int64,
+	tenant string, nestedCrossRefLimit int64, statistics Statistics,
+	decrypter storobj.PropertyDecrypter,
 ) *Aggregator {
 	return &Aggregator{
 		logger:                 logger,
@@ -68,6 +83,8 @@ func New(store *lsmkv.Store, params aggregation.Params,
 		isFallbackToSearchable: isFallbackToSearchable,
 		tenant:                 tenant,
 		nestedCrossRefLimit:    nestedCrossRefLimit,
+		statistics:             statistics,
+		decrypter:              decrypter,
 	}
 }
 
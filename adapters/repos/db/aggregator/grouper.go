@@ -57,7 +57,7 @@ func (g *grouper) Do(ctx context.Context) ([]group, error) {
 }
 
 func (g *grouper) groupAll(ctx context.Context) ([]group, error) {
-	err := ScanAllLSM(g.store, func(prop *models.PropertySchema, docID uint64) (bool, error) {
+	err := ScanAllLSM(g.store, g.decrypter, func(prop *models.PropertySchema, docID uint64) (bool, error) {
 		return true, g.addElementById(prop, docID)
 	})
 	if err != nil {
@@ -90,7 +90,7 @@ func (g *grouper) fetchDocIDs(ctx context.Context) (ids []uint64, err error) {
 	}
 
 	if len(g.params.SearchVector) > 0 {
-		ids, _, err = g.vectorSearch(allowList, g.params.SearchVector)
+		ids, _, err = g.vectorSearch(ctx, allowList, g.params.SearchVector)
 		if err != nil {
 			return nil, fmt.Errorf("failed to perform vector search: %w", err)
 		}
@@ -127,7 +127,7 @@ func (g *grouper) hybrid(ctx context.Context, allowList helpers.AllowList) ([]ui
 	}
 
 	denseSearch := func(vec []float32) ([]*storobj.Object, []float32, error) {
-		res, dists, err := g.objectVectorSearch(vec, allowList)
+		res, dists, err := g.objectVectorSearch(ctx, vec, allowList)
 		if err != nil {
 			return nil, nil, fmt.Errorf("aggregate grouped dense search: %w", err)
 		}
@@ -281,8 +281,11 @@ func ScanAll(tx *bolt.Tx, scan docid.ObjectScanFn) error {
 	return nil
 }
 
-// ScanAllLSM iterates over every row in the object buckets
-func ScanAllLSM(store *lsmkv.Store, scan docid.ObjectScanFn) error {
+// ScanAllLSM iterates over every row in the object buckets, encrypted
+// properties are decrypted with the decrypter if it is set
+func ScanAllLSM(store *lsmkv.Store, decrypter storobj.PropertyDecrypter,
+	scan docid.ObjectScanFn,
+) error {
 	b := store.Bucket(helpers.ObjectsBucketLSM)
 	if b == nil {
 		return fmt.Errorf("objects bucket not found")
@@ -296,6 +299,9 @@ func ScanAllLSM(store *lsmkv.Store, scan docid.ObjectScanFn) error {
 		if err != nil {
 			return errors.Wrapf(err, "unmarshal data object")
 		}
+		if err := storobj.DecryptProperties(decrypter, elem); err != nil {
+			return err
+		}
 
 		// scanAll has no abort, so we can ignore the first arg
 		properties := elem.Properties()
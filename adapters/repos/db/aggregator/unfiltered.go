@@ -63,6 +63,11 @@ func (ua *unfilteredAggregator) Do(ctx context.Context) (*aggregation.Result, er
 func (ua *unfilteredAggregator) addMetaCount(ctx context.Context,
 	out *aggregation.Result,
 ) error {
+	if ua.params.EventuallyConsistent && ua.statistics != nil {
+		out.Groups[0].Count = ua.statistics.ObjectCount()
+		return nil
+	}
+
 	b := ua.store.Bucket(helpers.ObjectsBucketLSM)
 	if b == nil {
 		return errors.Errorf("objects bucket is nil")
@@ -110,6 +115,13 @@ func (ua unfilteredAggregator) property(ctx context.Context,
 		return nil, err
 	}
 
+	if ua.params.EventuallyConsistent && ua.statistics != nil {
+		out, ok, err := ua.propertyFromStatistics(prop, aggType, dt)
+		if err != nil || ok {
+			return out, err
+		}
+	}
+
 	switch aggType {
 	case aggregation.PropertyTypeNumerical:
 		switch dt {
@@ -143,3 +155,59 @@ func (ua unfilteredAggregator) property(ctx context.Context,
 		return nil, fmt.Errorf("aggreation type %s not supported yet", aggType)
 	}
 }
+
+// propertyFromStatistics answers the minimum, maximum and count of a
+// numerical or date property from the statistics of the shard. ok is false
+// if other aggregations are requested or there are no statistics for the
+// property.
+func (ua unfilteredAggregator) propertyFromStatistics(prop aggregation.ParamProperty,
+	aggType aggregation.PropertyType, dt schema.DataType,
+) (*aggregation.Property, bool, error) {
+	if aggType != aggregation.PropertyTypeNumerical && aggType != aggregation.PropertyTypeDate {
+		return nil, false, nil
+	}
+	for _, agg := range prop.Aggregators {
+		switch agg {
+		case aggregation.MinimumAggregator, aggregation.MaximumAggregator,
+			aggregation.CountAggregator:
+		default:
+			return nil, false, nil
+		}
+	}
+
+	min, max, count, ok, err := ua.statistics.PropertyBounds(prop.Name.String())
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	out := &aggregation.Property{Type: aggType}
+	if aggType == aggregation.PropertyTypeDate {
+		agg := newDateAggregator()
+		if count > 0 {
+			if err := agg.AddTimestampRow(min, 1); err != nil {
+				return nil, false, err
+			}
+			if err := agg.AddTimestampRow(max, uint64(count-1)); err != nil {
+				return nil, false, err
+			}
+		}
+		addDateAggregations(out, prop.Aggregators, agg)
+		return out, true, nil
+	}
+
+	agg := newNumericalAggregator()
+	if count > 0 && min != nil {
+		add := agg.AddInt64Row
+		if dt == schema.DataTypeNumber || dt == schema.DataTypeNumberArray {
+			add = agg.AddFloat64Row
+		}
+		if err := add(min, 1); err != nil {
+			return nil, false, err
+		}
+		if err := add(max, uint64(count-1)); err != nil {
+			return nil, false, err
+		}
+	}
+	addNumericalAggregations(out, prop.Aggregators, agg)
+	return out, true, nil
+}
@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// The objects of a shard are split into 2^depth leaves by the leading bits
+// of their uuid. The hash of a leaf combines the uuids and update times of
+// its objects, so replicas only need to compare the objects of leaves whose
+// hashes differ.
+
+// scanChunkSize limits the number of objects read with a single cursor, the
+// cursor blocks flushes of the memtable while it is open
+const scanChunkSize = 1000
+
+// hashTreeLeaves hashes the objects of the shard
+func (s *Shard) hashTreeLeaves(ctx context.Context, depth int) ([]uint64, error) {
+	if err := replica.ValidateHashTreeDepth(depth); err != nil {
+		return nil, err
+	}
+
+	leaves := make([]uint64, 1<<depth)
+	err := s.scanObjects(ctx, nil, nil, func(key, value []byte) error {
+		updateTime, err := storobj.UpdateTimeFromBinary(value)
+		if err != nil {
+			return fmt.Errorf("object %x: %w", key, err)
+		}
+		leaves[leafOf(key, depth)] ^= objectHash(key, updateTime)
+		return nil
+	})
+	return leaves, err
+}
+
+// leafDigests returns the uuids and update times of the objects of a leaf
+func (s *Shard) leafDigests(ctx context.Context, depth, leaf int) ([]replica.RepairResponse, error) {
+	if err := replica.ValidateHashTreeDepth(depth); err != nil {
+		return nil, err
+	}
+	if leaf < 0 || leaf >= 1<<depth {
+		return nil, fmt.Errorf("leaf %d out of range for depth %d", leaf, depth)
+	}
+
+	from, to := leafRange(depth, leaf)
+	var result []replica.RepairResponse
+	err := s.scanObjects(ctx, from, to, func(key, value []byte) error {
+		updateTime, err := storobj.UpdateTimeFromBinary(value)
+		if err != nil {
+			return fmt.Errorf("object %x: %w", key, err)
+		}
+		id, err := uuid.FromBytes(key)
+		if err != nil {
+			return fmt.Errorf("object %x: %w", key, err)
+		}
+		result = append(result, replica.RepairResponse{ID: id.String(), UpdateTime: updateTime})
+		return nil
+	})
+	return result, err
+}
+
+// scanObjects calls fn for every object with a key in [from, to), a nil to
+// means until the end
+func (s *Shard) scanObjects(ctx context.Context, from, to []byte,
+	fn func(key, value []byte) error,
+) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return fmt.Errorf("objects bucket not found")
+	}
+
+	next := from
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var (
+			last []byte
+			n    int
+			err  error
+		)
+		cursor := bucket.Cursor()
+		var k, v []byte
+		if next == nil {
+			k, v = cursor.First()
+		} else {
+			k, v = cursor.Seek(next)
+		}
+		for ; k != nil && n < scanChunkSize; k, v = cursor.Next() {
+			if to != nil && bytes.Compare(k, to) >= 0 {
+				break
+			}
+			if err = fn(k, v); err != nil {
+				break
+			}
+			last = append(last[:0], k...)
+			n++
+		}
+		cursor.Close()
+
+		if err != nil {
+			return err
+		}
+		if n < scanChunkSize {
+			return nil
+		}
+		next = successor(last)
+	}
+}
+
+func leafOf(key []byte, depth int) int {
+	if depth == 0 {
+		return 0
+	}
+	return int(binary.BigEndian.Uint32(key[:4]) >> (32 - depth))
+}
+
+// leafRange returns the first key of the leaf and the first key of the next
+// leaf, which is nil for the last one
+func leafRange(depth, leaf int) (from, to []byte) {
+	from = make([]byte, 4)
+	if depth > 0 {
+		binary.BigEndian.PutUint32(from, uint32(leaf)<<(32-depth))
+	}
+	if leaf+1 < 1<<depth {
+		to = make([]byte, 4)
+		binary.BigEndian.PutUint32(to, uint32(leaf+1)<<(32-depth))
+	}
+	return from, to
+}
+
+// successor is the smallest key larger than key
+func successor(key []byte) []byte {
+	return append(append([]byte{}, key...), 0)
+}
+
+func objectHash(key []byte, updateTime int64) uint64 {
+	buf := make([]byte, len(key)+8)
+	copy(buf, key)
+	binary.BigEndian.PutUint64(buf[len(key):], uint64(updateTime))
+	return xxhash.Sum64(buf)
+}
+
+func (db *DB) HashTreeLeaves(ctx context.Context, class, shardName string,
+	depth int,
+) ([]uint64, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return nil, fmt.Errorf("class %q not found", class)
+	}
+	return index.IncomingHashTreeLeaves(ctx, shardName, depth)
+}
+
+func (i *Index) IncomingHashTreeLeaves(ctx context.Context, shardName string,
+	depth int,
+) ([]uint64, error) {
+	s := i.localShard(shardName)
+	if s == nil {
+		return nil, fmt.Errorf("shard %q not found locally", shardName)
+	}
+	return s.hashTreeLeaves(ctx, depth)
+}
+
+func (db *DB) LeafDigests(ctx context.Context, class, shardName string,
+	depth, leaf int,
+) ([]replica.RepairResponse, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return nil, fmt.Errorf("class %q not found", class)
+	}
+	return index.IncomingLeafDigests(ctx, shardName, depth, leaf)
+}
+
+func (i *Index) IncomingLeafDigests(ctx context.Context, shardName string,
+	depth, leaf int,
+) ([]replica.RepairResponse, error) {
+	s := i.localShard(shardName)
+	if s == nil {
+		return nil, fmt.Errorf("shard %q not found locally", shardName)
+	}
+	return s.leafDigests(ctx, depth, leaf)
+}
+
+// SyncShard compares the replicas of a shard and repairs the objects which
+// differ, see replica.Replicator.SyncShard
+func (db *DB) SyncShard(ctx context.Context, class, shardName string,
+	opts replica.SyncOptions, progress *replica.SyncProgress,
+) error {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return fmt.Errorf("class %q not found", class)
+	}
+	if !index.replicationEnabled() {
+		return fmt.Errorf("class %q is not replicated", class)
+	}
+	return index.replicator.SyncShard(ctx, shardName, opts, progress)
+}
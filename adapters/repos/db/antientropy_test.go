@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestHashTree(t *testing.T) {
+	ctx := context.Background()
+	shard, _ := testShard(t, ctx, "Replicated")
+
+	// with depth 2 the leaf is given by the two leading bits
+	ids := []strfmt.UUID{
+		"10000000-0000-4000-8000-000000000001", // leaf 0
+		"20000000-0000-4000-8000-000000000002", // leaf 0
+		"c0000000-0000-4000-8000-000000000003", // leaf 3
+	}
+	for i, id := range ids {
+		obj := testObject("Replicated")
+		obj.Object.ID = id
+		obj.Object.LastUpdateTimeUnix = int64(100 + i)
+		require.Nil(t, shard.PutObject(ctx, obj))
+	}
+	hash := func(id strfmt.UUID, updateTime int64) uint64 {
+		key, err := uuid.MustParse(id.String()).MarshalBinary()
+		require.Nil(t, err)
+		return objectHash(key, updateTime)
+	}
+
+	t.Run("leaves", func(t *testing.T) {
+		leaves, err := shard.hashTreeLeaves(ctx, 2)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{
+			hash(ids[0], 100) ^ hash(ids[1], 101), 0, 0, hash(ids[2], 102),
+		}, leaves)
+
+		leaves, err = shard.hashTreeLeaves(ctx, 0)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{hash(ids[0], 100) ^ hash(ids[1], 101) ^ hash(ids[2], 102)}, leaves)
+
+		_, err = shard.hashTreeLeaves(ctx, replica.MaxHashTreeDepth+1)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("digests of a leaf", func(t *testing.T) {
+		digests, err := shard.leafDigests(ctx, 2, 0)
+		require.Nil(t, err)
+		assert.Equal(t, []replica.RepairResponse{
+			{ID: ids[0].String(), UpdateTime: 100},
+			{ID: ids[1].String(), UpdateTime: 101},
+		}, digests)
+
+		digests, err = shard.leafDigests(ctx, 2, 3)
+		require.Nil(t, err)
+		assert.Equal(t, []replica.RepairResponse{{ID: ids[2].String(), UpdateTime: 102}}, digests)
+
+		digests, err = shard.leafDigests(ctx, 2, 1)
+		require.Nil(t, err)
+		assert.Empty(t, digests)
+
+		_, err = shard.leafDigests(ctx, 2, 4)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("updates and deletes change the leaf", func(t *testing.T) {
+		before, err := shard.hashTreeLeaves(ctx, 2)
+		require.Nil(t, err)
+
+		obj := testObject("Replicated")
+		obj.Object.ID = ids[2]
+		obj.Object.LastUpdateTimeUnix = 200
+		require.Nil(t, shard.PutObject(ctx, obj))
+		require.Nil(t, shard.DeleteObject(ctx, ids[0]))
+
+		after, err := shard.hashTreeLeaves(ctx, 2)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{hash(ids[1], 101), 0, 0, hash(ids[2], 200)}, after)
+		assert.NotEqual(t, before, after)
+	})
+}
+
+func TestLeafRange(t *testing.T) {
+	from, to := leafRange(2, 1)
+	assert.Equal(t, []byte{0x40, 0, 0, 0}, from)
+	assert.Equal(t, []byte{0x80, 0, 0, 0}, to)
+
+	from, to = leafRange(2, 3)
+	assert.Equal(t, []byte{0xc0, 0, 0, 0}, from)
+	assert.Nil(t, to)
+
+	from, to = leafRange(0, 0)
+	assert.Equal(t, []byte{0, 0, 0, 0}, from)
+	assert.Nil(t, to)
+
+	key := []byte{0x7f, 0xff, 0xff, 0xff, 1}
+	assert.Equal(t, 1, leafOf(key, 2))
+	assert.Equal(t, 0, leafOf(key, 0))
+	assert.Equal(t, []byte{0x7f, 0xff, 0xff, 0xff, 1, 0}, successor(key))
+}
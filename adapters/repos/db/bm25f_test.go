@@ -406,6 +406,14 @@ func TestBM25FJourney(t *testing.T) {
 		require.Contains(t, res[0].Object.Additional, "score")
 		require.Contains(t, res[0].Object.Additional, "explainScore")
 		require.Contains(t, res[0].Object.Additional["explainScore"], "BM25")
+
+		// the score explanation breaks the score into the contributions of the terms
+		explanation := res[0].ScoreExplanation()
+		require.NotNil(t, explanation)
+		require.Len(t, explanation.Terms, 1)
+		assert.Equal(t, "journey", explanation.Terms[0].Term)
+		assert.InDelta(t, res[0].Score(), explanation.Score, 1e-6)
+		assert.InDelta(t, explanation.Score, explanation.Terms[0].Score, 1e-5)
 	})
 
 	t.Run("Array fields text", func(t *testing.T) {
@@ -173,6 +173,10 @@ func (f *fakeSchemaManager) ShardFromUUID(class string, uuid []byte) string {
 	return ss.Shard("", string(uuid))
 }
 
+func (f *fakeSchemaManager) PreviousShardFromUUID(class string, uuid []byte) string {
+	return f.shardState.PreviousPhysicalShard(uuid)
+}
+
 func (f *fakeSchemaManager) RestoreClass(ctx context.Context, d *backup.ClassDescriptor, nodeMapping map[string]string) error {
 	return nil
 }
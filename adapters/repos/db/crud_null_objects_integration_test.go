@@ -137,11 +137,85 @@ func TestNullArrayClass(t *testing.T) {
 			item2Schema := item2.Schema.(map[string]interface{})
 			delete(item1Schema, "id")
 			delete(item2Schema, "id")
+			// the null state is indexed, so explicit nulls are kept and
+			// returned, but otherwise the objects are identical
+			for name, value := range arrayObjNil.Properties.(map[string]interface{}) {
+				prop, ok := item1Schema[name]
+				assert.True(t, ok)
+				assert.Equal(t, value, prop)
+				delete(item1Schema, name)
+			}
 			assert.Equal(t, item1Schema, item2Schema)
 		})
 	}
 }
 
+func TestFilterExplicitNullState(t *testing.T) {
+	class := createClassWithEverything(true, false)
+	migrator, repo, schemaGetter := createRepo(t)
+	defer repo.Shutdown(context.Background())
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	idSet := strfmt.UUID(uuid.New().String())
+	idNull := strfmt.UUID(uuid.New().String())
+	idMissing := strfmt.UUID(uuid.New().String())
+	for _, obj := range []*models.Object{
+		{ID: idSet, Class: class.Class, Properties: map[string]interface{}{"int": int64(1), "ints": []float64{1}}},
+		{ID: idNull, Class: class.Class, Properties: map[string]interface{}{"int": nil, "ints": nil}},
+		{ID: idMissing, Class: class.Class, Properties: map[string]interface{}{}},
+	} {
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1}, nil))
+	}
+
+	search := func(t *testing.T, prop string, op filters.Operator, value bool) []strfmt.UUID {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: op,
+					On:       &filters.Path{Class: schema.ClassName(class.Class), Property: schema.PropertyName(prop)},
+					Value:    &filters.Value{Value: value, Type: schema.DataTypeBoolean},
+				},
+			},
+		})
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	for _, prop := range []string{"int", "ints"} {
+		t.Run(prop, func(t *testing.T) {
+			assert.ElementsMatch(t, []strfmt.UUID{idNull, idMissing}, search(t, prop, filters.OperatorIsNull, true))
+			assert.ElementsMatch(t, []strfmt.UUID{idSet}, search(t, prop, filters.OperatorIsNull, false))
+			assert.ElementsMatch(t, []strfmt.UUID{idMissing}, search(t, prop, filters.OperatorIsMissing, true))
+			assert.ElementsMatch(t, []strfmt.UUID{idSet, idNull}, search(t, prop, filters.OperatorIsMissing, false))
+		})
+	}
+
+	t.Run("explicit nulls are returned", func(t *testing.T) {
+		res, err := repo.ObjectByID(context.Background(), idNull, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		props := res.Schema.(map[string]interface{})
+		value, ok := props["int"]
+		assert.True(t, ok)
+		assert.Nil(t, value)
+
+		res, err = repo.ObjectByID(context.Background(), idMissing, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		_, ok = res.Schema.(map[string]interface{})["int"]
+		assert.False(t, ok)
+	})
+}
+
 func createRepo(t *testing.T) (*Migrator, *DB, *fakeSchemaGetter) {
 	schemaGetter := &fakeSchemaGetter{
 		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
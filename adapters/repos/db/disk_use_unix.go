@@ -30,6 +30,6 @@ func (db *DB) getDiskUse(diskPath string) diskUse {
 	return diskUse{
 		total: fs.Blocks * uint64(fs.Bsize),
 		free:  fs.Bfree * uint64(fs.Bsize),
-		avail: fs.Bfree * uint64(fs.Bsize),
+		avail: fs.Bavail * uint64(fs.Bsize),
 	}
 }
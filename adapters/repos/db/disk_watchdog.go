@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"github.com/weaviate/weaviate/entities/storagestate"
+)
+
+// diskWatchdog sets the loaded shards to read-only while the disk is full,
+// so that writes are rejected before the LSM stores run out of space. Once
+// enough space was freed, the shards it set to read-only return to
+// read-write. Shards which were read-only already are not changed.
+func (db *DB) diskWatchdog(du diskUse) {
+	if du.total == 0 {
+		// the disk use could not be read
+		return
+	}
+	if db.promMetrics != nil {
+		db.promMetrics.DiskFreeBytes.Set(float64(du.avail))
+	}
+
+	state := db.resourceScanState
+	switch {
+	case !state.diskFull && db.diskFull(du):
+		state.diskFull = true
+		db.logger.WithField("action", "disk_watchdog_read_only").
+			WithField("path", db.config.RootPath).
+			Warnf("disk full, setting shards to READONLY: %s", du.String())
+		db.countDiskStatusTransition(storagestate.StatusReadOnly)
+	case state.diskFull && db.diskRecovered(du):
+		state.diskFull = false
+		db.setDiskShardsReadWrite()
+		db.logger.WithField("action", "disk_watchdog_read_write").
+			WithField("path", db.config.RootPath).
+			Infof("disk space freed, setting shards back to READY: %s", du.String())
+		db.countDiskStatusTransition(storagestate.StatusReady)
+	}
+
+	if state.diskFull {
+		// shards which were loaded or created in the meantime are set to
+		// read-only as well
+		db.setDiskShardsReadOnly()
+	}
+	if db.promMetrics != nil {
+		db.promMetrics.DiskReadOnlyShards.Set(float64(len(state.diskReadOnlyShards)))
+	}
+}
+
+func (db *DB) diskFull(du diskUse) bool {
+	cfg := db.config.ResourceUsage.DiskUse
+	if cfg.ReadOnlyPercentage > 0 && du.percentUsed() > float64(cfg.ReadOnlyPercentage) {
+		return true
+	}
+	return cfg.ReadOnlyMinFreeBytes > 0 && du.avail < cfg.ReadOnlyMinFreeBytes
+}
+
+// diskRecovered is true once the usage is below the read-only thresholds by
+// the recovery margin, so that the shards do not flip between read-only and
+// read-write
+func (db *DB) diskRecovered(du diskUse) bool {
+	cfg := db.config.ResourceUsage.DiskUse
+	margin := float64(cfg.RecoveryMarginPercentage)
+	if cfg.ReadOnlyPercentage > 0 && du.percentUsed() > float64(cfg.ReadOnlyPercentage)-margin {
+		return false
+	}
+	if cfg.ReadOnlyMinFreeBytes > 0 &&
+		float64(du.avail) < float64(cfg.ReadOnlyMinFreeBytes)+float64(du.total)*margin/100 {
+		return false
+	}
+	return true
+}
+
+// setDiskShardsReadOnly sets the loaded shards which are ready to read-only,
+// shards are not loaded just to change their status
+func (db *DB) setDiskShardsReadOnly() {
+	db.indexLock.RLock()
+	defer db.indexLock.RUnlock()
+
+	for _, index := range db.indices {
+		index.ForEachShard(func(name string, shard ShardLike) error {
+			if !isLoaded(shard) || shard.GetStatus() != storagestate.StatusReady {
+				return nil
+			}
+			logger := db.logger.WithField("action", "disk_watchdog_read_only").
+				WithField("class", index.Config.ClassName).
+				WithField("shard", name)
+			if err := shard.UpdateStatus(storagestate.StatusReadOnly.String()); err != nil {
+				logger.WithError(err).Error("failed to set shard to READONLY")
+				return nil
+			}
+			db.resourceScanState.diskReadOnlyShards[diskShardKey(index, name)] = struct{}{}
+			logger.Warn("set shard to READONLY, disk full")
+			return nil
+		})
+	}
+}
+
+// setDiskShardsReadWrite sets the shards which were set to read-only by the
+// watchdog back to ready, unless their status was changed in the meantime
+func (db *DB) setDiskShardsReadWrite() {
+	db.indexLock.RLock()
+	defer db.indexLock.RUnlock()
+
+	for _, index := range db.indices {
+		index.ForEachShard(func(name string, shard ShardLike) error {
+			key := diskShardKey(index, name)
+			if _, ok := db.resourceScanState.diskReadOnlyShards[key]; !ok {
+				return nil
+			}
+			if db.resourceScanState.isReadOnly || shard.GetStatus() != storagestate.StatusReadOnly {
+				return nil
+			}
+			logger := db.logger.WithField("action", "disk_watchdog_read_write").
+				WithField("class", index.Config.ClassName).
+				WithField("shard", name)
+			if err := shard.UpdateStatus(storagestate.StatusReady.String()); err != nil {
+				logger.WithError(err).Error("failed to set shard to READY")
+				return nil
+			}
+			logger.Info("set shard back to READY, disk space freed")
+			return nil
+		})
+	}
+	db.resourceScanState.diskReadOnlyShards = map[string]struct{}{}
+}
+
+func (db *DB) countDiskStatusTransition(status storagestate.Status) {
+	if db.promMetrics != nil {
+		db.promMetrics.DiskStatusTransitions.WithLabelValues(status.String()).Inc()
+	}
+}
+
+func diskShardKey(index *Index, shard string) string {
+	return index.ID() + "/" + shard
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type statusShard struct {
+	ShardLike
+	status storagestate.Status
+}
+
+func (s *statusShard) GetStatus() storagestate.Status {
+	return s.status
+}
+
+func (s *statusShard) UpdateStatus(status string) error {
+	s.status = storagestate.Status(status)
+	return nil
+}
+
+func TestDiskWatchdog(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	index := &Index{Config: IndexConfig{ClassName: "Article"}}
+	shard := &statusShard{status: storagestate.StatusReady}
+	index.shards.Store("shard1", shard)
+	db := &DB{
+		logger:  logger,
+		indices: map[string]*Index{index.ID(): index},
+		config: Config{ResourceUsage: config.ResourceUsage{DiskUse: config.DiskUse{
+			ReadOnlyPercentage:       90,
+			ReadOnlyMinFreeBytes:     100,
+			RecoveryMarginPercentage: 5,
+		}}},
+		resourceScanState: newResourceScanState(),
+	}
+	used := func(used uint64) diskUse {
+		return diskUse{total: 1000, free: 1000 - used, avail: 1000 - used}
+	}
+
+	db.diskWatchdog(used(850))
+	assert.Equal(t, storagestate.StatusReady, shard.GetStatus())
+
+	t.Run("read-only above percentage", func(t *testing.T) {
+		db.diskWatchdog(used(950))
+		assert.Equal(t, storagestate.StatusReadOnly, shard.GetStatus())
+	})
+
+	t.Run("stays read-only within the recovery margin", func(t *testing.T) {
+		db.diskWatchdog(used(880))
+		assert.Equal(t, storagestate.StatusReadOnly, shard.GetStatus())
+	})
+
+	t.Run("shards added while read-only", func(t *testing.T) {
+		added := &statusShard{status: storagestate.StatusReady}
+		index.shards.Store("shard2", added)
+		defer index.shards.LoadAndDelete("shard2")
+		db.diskWatchdog(used(880))
+		assert.Equal(t, storagestate.StatusReadOnly, added.GetStatus())
+	})
+
+	t.Run("read-write once space was freed", func(t *testing.T) {
+		db.diskWatchdog(used(800))
+		assert.Equal(t, storagestate.StatusReady, shard.GetStatus())
+	})
+
+	t.Run("read-only below min free bytes", func(t *testing.T) {
+		db.diskWatchdog(diskUse{total: 100000, free: 90, avail: 90})
+		assert.Equal(t, storagestate.StatusReadOnly, shard.GetStatus())
+		db.diskWatchdog(diskUse{total: 100000, free: 50000, avail: 50000})
+		assert.Equal(t, storagestate.StatusReady, shard.GetStatus())
+	})
+
+	t.Run("shards set to read-only before are not changed", func(t *testing.T) {
+		require.Nil(t, shard.UpdateStatus(storagestate.StatusReadOnly.String()))
+		db.diskWatchdog(used(950))
+		db.diskWatchdog(used(800))
+		assert.Equal(t, storagestate.StatusReadOnly, shard.GetStatus())
+	})
+
+	t.Run("disk use could not be read", func(t *testing.T) {
+		require.Nil(t, shard.UpdateStatus(storagestate.StatusReady.String()))
+		db.diskWatchdog(diskUse{})
+		assert.Equal(t, storagestate.StatusReady, shard.GetStatus())
+	})
+}
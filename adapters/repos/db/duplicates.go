@@ -0,0 +1,283 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// duplicatesScanBatchSize is the number of objects which are read from a
+// shard before they are searched, the cursor is closed while searching
+const duplicatesScanBatchSize = 1000
+
+// duplicatesDistanceTolerance covers the rounding errors of the distance of
+// identical vectors
+const duplicatesDistanceTolerance = 1e-6
+
+// duplicateCandidate is an object which is compared to its nearest
+// neighbors
+type duplicateCandidate struct {
+	id      strfmt.UUID
+	created int64
+	vector  []float32
+	props   map[string]interface{}
+}
+
+// FindDuplicates groups the near-duplicate objects of the shards of a class
+// on this node. Every object is searched in the vector index of every
+// shard, so the duplicates do not need to be in the same shard.
+func (db *DB) FindDuplicates(ctx context.Context, params objects.DuplicatesParams,
+) (*objects.DuplicatesResult, error) {
+	idx := db.GetIndex(schema.ClassName(params.Class))
+	if idx == nil {
+		return nil, objects.NewErrNotFound("class %q not found", params.Class)
+	}
+	if err := idx.validateMultiTenancy(params.Tenant); err != nil {
+		return nil, err
+	}
+
+	var shards []ShardLike
+	idx.ForEachShard(func(name string, shard ShardLike) error {
+		if params.Tenant == "" || name == params.Tenant {
+			shards = append(shards, shard)
+		}
+		return nil
+	})
+
+	result := &objects.DuplicatesResult{
+		Class:  idx.Config.ClassName.String(),
+		Groups: []objects.DuplicateGroup{},
+	}
+	if len(shards) == 0 {
+		return result, nil
+	}
+
+	groups := newDuplicateGroups()
+	for _, shard := range shards {
+		var after []byte
+		for {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			batch, last, err := scanDuplicateCandidates(shard, after, duplicatesScanBatchSize)
+			if err != nil {
+				return nil, fmt.Errorf("shard %q: scan objects: %w", shard.Name(), err)
+			}
+			if len(batch) == 0 {
+				break
+			}
+			after = last
+			result.Scanned += len(batch)
+
+			for _, c := range batch {
+				if err := groups.addNeighbors(ctx, shards, c, params); err != nil {
+					return nil, fmt.Errorf("shard %q: search duplicates of %s: %w",
+						shard.Name(), c.id, err)
+				}
+			}
+		}
+	}
+
+	provider := shards[0].VectorIndex().DistancerProvider()
+	result.Groups = groups.list(provider, params.Limit)
+	return result, nil
+}
+
+// scanDuplicateCandidates reads up to n objects with a vector after the key
+// after, and the key of the last object which was read
+func scanDuplicateCandidates(shard ShardLike, after []byte, n int,
+) ([]*duplicateCandidate, []byte, error) {
+	bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return nil, nil, fmt.Errorf("objects bucket not found")
+	}
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	k, v := cursor.First()
+	if after != nil {
+		k, v = cursor.Seek(after)
+		if k != nil && bytes.Equal(k, after) {
+			k, v = cursor.Next()
+		}
+	}
+
+	var last []byte
+	batch := make([]*duplicateCandidate, 0, n)
+	for ; k != nil && len(batch) < n; k, v = cursor.Next() {
+		last = append(last[:0], k...)
+		obj, err := shard.Index().objectFromBinary(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(obj.Vector) == 0 {
+			continue
+		}
+		props, _ := obj.Properties().(map[string]interface{})
+		batch = append(batch, &duplicateCandidate{
+			id:      obj.ID(),
+			created: obj.CreationTimeUnix(),
+			vector:  obj.Vector,
+			props:   props,
+		})
+	}
+	return batch, last, nil
+}
+
+// duplicateGroups is a union-find of the objects which have duplicates
+type duplicateGroups struct {
+	parent  map[strfmt.UUID]strfmt.UUID
+	members map[strfmt.UUID]*duplicateCandidate
+}
+
+func newDuplicateGroups() *duplicateGroups {
+	return &duplicateGroups{
+		parent:  map[strfmt.UUID]strfmt.UUID{},
+		members: map[strfmt.UUID]*duplicateCandidate{},
+	}
+}
+
+// addNeighbors joins the group of c with the groups of its nearest
+// neighbors which are duplicates of it
+func (g *duplicateGroups) addNeighbors(ctx context.Context, shards []ShardLike,
+	c *duplicateCandidate, params objects.DuplicatesParams,
+) error {
+	for _, shard := range shards {
+		objs, dists, err := shard.ObjectVectorSearch(ctx, c.vector, 0, params.Neighbors+1,
+			nil, nil, nil, additional.Properties{Vector: true})
+		if err != nil {
+			return err
+		}
+		for i, obj := range objs {
+			if dists[i] > params.Distance+duplicatesDistanceTolerance {
+				break
+			}
+			if obj.ID() == c.id {
+				continue
+			}
+			props, _ := obj.Properties().(map[string]interface{})
+			if !equalDuplicateProperties(params.Properties, c.props, props) {
+				continue
+			}
+			g.union(c, &duplicateCandidate{
+				id:      obj.ID(),
+				created: obj.CreationTimeUnix(),
+				vector:  obj.Vector,
+				props:   props,
+			})
+		}
+	}
+	return nil
+}
+
+func (g *duplicateGroups) union(a, b *duplicateCandidate) {
+	for _, c := range []*duplicateCandidate{a, b} {
+		if _, ok := g.members[c.id]; !ok {
+			g.members[c.id] = c
+			g.parent[c.id] = c.id
+		}
+	}
+	rootA, rootB := g.find(a.id), g.find(b.id)
+	if rootA != rootB {
+		g.parent[rootB] = rootA
+	}
+}
+
+func (g *duplicateGroups) find(id strfmt.UUID) strfmt.UUID {
+	root := id
+	for g.parent[root] != root {
+		root = g.parent[root]
+	}
+	for id != root {
+		next := g.parent[id]
+		g.parent[id] = root
+		id = next
+	}
+	return root
+}
+
+// list the groups, oldest kept object first. The oldest object of a group
+// is kept, the distances of the duplicates are measured to it.
+func (g *duplicateGroups) list(provider distancer.Provider, limit int) []objects.DuplicateGroup {
+	byRoot := map[strfmt.UUID][]*duplicateCandidate{}
+	for id, c := range g.members {
+		root := g.find(id)
+		byRoot[root] = append(byRoot[root], c)
+	}
+
+	older := func(a, b *duplicateCandidate) bool {
+		if a.created != b.created {
+			return a.created < b.created
+		}
+		return a.id < b.id
+	}
+	normalize := func(vec []float32) []float32 {
+		if provider.Type() == "cosine-dot" {
+			return distancer.Normalize(vec)
+		}
+		return vec
+	}
+
+	sorted := make([][]*duplicateCandidate, 0, len(byRoot))
+	for _, members := range byRoot {
+		sort.Slice(members, func(i, j int) bool { return older(members[i], members[j]) })
+		sorted = append(sorted, members)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return older(sorted[i][0], sorted[j][0]) })
+	if len(sorted) > limit {
+		sorted = sorted[:limit]
+	}
+
+	groups := make([]objects.DuplicateGroup, len(sorted))
+	for i, members := range sorted {
+		keep := normalize(members[0].vector)
+		groups[i] = objects.DuplicateGroup{
+			Keep:       members[0].id,
+			Duplicates: make([]objects.Duplicate, len(members)-1),
+		}
+		for j, m := range members[1:] {
+			dist, _, _ := provider.SingleDist(keep, normalize(m.vector))
+			groups[i].Duplicates[j] = objects.Duplicate{ID: m.id, Distance: dist}
+		}
+	}
+	return groups
+}
+
+// equalDuplicateProperties compares the properties by their JSON
+// representation, a property which is missing on both objects is equal
+func equalDuplicateProperties(names []string, a, b map[string]interface{}) bool {
+	for _, name := range names {
+		aj, err := json.Marshal(a[name])
+		if err != nil {
+			return false
+		}
+		bj, err := json.Marshal(b[name])
+		if err != nil {
+			return false
+		}
+		if !bytes.Equal(aj, bj) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	shard, idx := testShard(t, ctx, "Chunk", func(idx *Index) {
+		cfg := enthnsw.NewDefaultUserConfig()
+		cfg.Distance = "l2-squared"
+		idx.vectorIndexUserConfig = cfg
+	})
+	db := &DB{
+		logger:       logger,
+		indices:      map[string]*Index{idx.ID(): idx},
+		schemaGetter: idx.getSchema,
+	}
+
+	put := func(created int64, source string, vector ...float32) strfmt.UUID {
+		obj := &storobj.Object{
+			MarshallerVersion: 1,
+			Object: models.Object{
+				ID:                 strfmt.UUID(uuid.NewString()),
+				Class:              "Chunk",
+				CreationTimeUnix:   created,
+				LastUpdateTimeUnix: created,
+				Properties:         map[string]interface{}{"source": source},
+			},
+			Vector: vector,
+		}
+		require.Nil(t, shard.PutObject(ctx, obj))
+		return obj.ID()
+	}
+
+	for _, obj := range createRandomObjects(getRandomSeed(), "Chunk", 100) {
+		obj.Vector = append(obj.Vector, 0, 0)
+		require.Nil(t, shard.PutObject(ctx, obj))
+	}
+	// the random objects have no vector in the last two dimensions
+	a1 := put(1, "a", 0, 0, 0, 0, 10, 0)
+	a2 := put(2, "a", 0, 0, 0, 0, 10, 0.001)
+	b1 := put(3, "b", 0, 0, 0, 0, 0, 10)
+	b2 := put(4, "c", 0, 0, 0, 0, 0.001, 10)
+	b3 := put(5, "b", 0, 0, 0, 0, 0.002, 10)
+
+	t.Run("groups by distance", func(t *testing.T) {
+		res, err := db.FindDuplicates(ctx, objects.DuplicatesParams{
+			Class: "Chunk", Distance: 1e-4, Neighbors: 10, Limit: 10,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, 105, res.Scanned)
+		require.Len(t, res.Groups, 2)
+
+		assert.Equal(t, a1, res.Groups[0].Keep)
+		require.Len(t, res.Groups[0].Duplicates, 1)
+		assert.Equal(t, a2, res.Groups[0].Duplicates[0].ID)
+		assert.InDelta(t, 1e-6, res.Groups[0].Duplicates[0].Distance, 1e-7)
+
+		assert.Equal(t, b1, res.Groups[1].Keep)
+		ids := []strfmt.UUID{}
+		for _, d := range res.Groups[1].Duplicates {
+			ids = append(ids, d.ID)
+		}
+		assert.Equal(t, []strfmt.UUID{b2, b3}, ids)
+	})
+
+	t.Run("groups by distance and properties", func(t *testing.T) {
+		res, err := db.FindDuplicates(ctx, objects.DuplicatesParams{
+			Class: "Chunk", Distance: 1e-4, Properties: []string{"source"}, Neighbors: 10, Limit: 10,
+		})
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 2)
+		require.Len(t, res.Groups[1].Duplicates, 1)
+		assert.Equal(t, b3, res.Groups[1].Duplicates[0].ID)
+	})
+
+	t.Run("limits the groups", func(t *testing.T) {
+		res, err := db.FindDuplicates(ctx, objects.DuplicatesParams{
+			Class: "Chunk", Distance: 1e-4, Neighbors: 10, Limit: 1,
+		})
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 1)
+		assert.Equal(t, a1, res.Groups[0].Keep)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := db.FindDuplicates(ctx, objects.DuplicatesParams{Class: "Unknown"})
+		assert.ErrorAs(t, err, &objects.ErrNotFound{})
+	})
+}
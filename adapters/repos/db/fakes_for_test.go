@@ -77,6 +77,10 @@ func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string {
 	return ss.Shard("", string(uuid))
 }
 
+func (f *fakeSchemaGetter) PreviousShardFromUUID(class string, uuid []byte) string {
+	return f.shardState.PreviousPhysicalShard(uuid)
+}
+
 func (f *fakeSchemaGetter) Nodes() []string {
 	return []string{"node1"}
 }
@@ -263,6 +267,16 @@ func (f *fakeRemoteNodeClient) GetNodeStatus(ctx context.Context, hostName, clas
 	return &models.NodeStatus{}, nil
 }
 
+func (f *fakeRemoteNodeClient) GetTenantStats(ctx context.Context, hostName, className, tenant string) (*schema.TenantStats, error) {
+	return &schema.TenantStats{}, nil
+}
+
+func (f *fakeRemoteNodeClient) GetObjectVersions(ctx context.Context, hostName, className, shard string,
+	id strfmt.UUID,
+) ([]*storobj.ObjectVersion, error) {
+	return nil, nil
+}
+
 type fakeReplicationClient struct{}
 
 func (f *fakeReplicationClient) PutObject(ctx context.Context, host, index, shard, requestID string,
@@ -328,6 +342,18 @@ func (*fakeReplicationClient) DigestObjects(ctx context.Context,
 	return nil, nil
 }
 
+func (*fakeReplicationClient) HashTreeLeaves(ctx context.Context,
+	hostName, indexName, shardName string, depth int,
+) ([]uint64, error) {
+	return nil, nil
+}
+
+func (*fakeReplicationClient) LeafDigests(ctx context.Context,
+	hostName, indexName, shardName string, depth, leaf int,
+) ([]replica.RepairResponse, error) {
+	return nil, nil
+}
+
 func (*fakeReplicationClient) FetchObjects(ctx context.Context, host,
 	index, shard string, ids []strfmt.UUID,
 ) ([]objects.Replica, error) {
@@ -300,6 +300,10 @@ func (sg *fakeMigrationSchemaGetter) ShardFromUUID(class string, uuid []byte) st
 	return ""
 }
 
+func (sg *fakeMigrationSchemaGetter) PreviousShardFromUUID(class string, uuid []byte) string {
+	return ""
+}
+
 func (sg *fakeMigrationSchemaGetter) ShardReplicas(class, shard string) ([]string, error) {
 	return nil, nil
 }
@@ -21,8 +21,10 @@ var (
 	ObjectsBucket              = []byte("objects")
 	ObjectsBucketLSM           = "objects"
 	VectorsCompressedBucketLSM = "vectors_compressed"
+	VectorsReductionBucketLSM  = "vectors_reduction"
 	VectorsBucketLSM           = "vectors"
 	DimensionsBucketLSM        = "dimensions"
+	VersionsBucketLSM          = "versions"
 )
 
 // MetaCountProp helps create an internally used propName for meta props that
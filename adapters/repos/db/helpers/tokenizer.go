@@ -98,6 +98,74 @@ func lowercase(terms []string) []string {
 	return terms
 }
 
+// Token is a term and its position in the tokenized text, the offsets are
+// counted in characters and the end is exclusive
+type Token struct {
+	Term       string
+	Start, End int
+}
+
+// TokenizeWithOffsets returns the same terms as Tokenize, with their
+// positions in the text
+func TokenizeWithOffsets(tokenization string, in string) []Token {
+	switch tokenization {
+	case models.PropertyTokenizationWord:
+		return tokenizeRuns(in, func(r rune) bool {
+			return unicode.IsLetter(r) || unicode.IsNumber(r)
+		}, true)
+	case models.PropertyTokenizationLowercase:
+		return tokenizeRuns(in, isNotSpace, true)
+	case models.PropertyTokenizationWhitespace:
+		return tokenizeRuns(in, isNotSpace, false)
+	case models.PropertyTokenizationField:
+		runes := []rune(in)
+		start, end := 0, len(runes)
+		for start < end && unicode.IsSpace(runes[start]) {
+			start++
+		}
+		for end > start && unicode.IsSpace(runes[end-1]) {
+			end--
+		}
+		return []Token{{Term: string(runes[start:end]), Start: start, End: end}}
+	default:
+		return []Token{}
+	}
+}
+
+func isNotSpace(r rune) bool {
+	return !unicode.IsSpace(r)
+}
+
+// tokenizeRuns returns the runs of characters which are part of a term
+func tokenizeRuns(in string, isTermRune func(rune) bool, lower bool) []Token {
+	var tokens []Token
+	start := -1
+	pos := 0
+	for _, r := range in {
+		if isTermRune(r) {
+			if start < 0 {
+				start = pos
+			}
+		} else if start >= 0 {
+			tokens = append(tokens, Token{Start: start, End: pos})
+			start = -1
+		}
+		pos++
+	}
+	if start >= 0 {
+		tokens = append(tokens, Token{Start: start, End: pos})
+	}
+
+	runes := []rune(in)
+	for i := range tokens {
+		tokens[i].Term = string(runes[tokens[i].Start:tokens[i].End])
+		if lower {
+			tokens[i].Term = strings.ToLower(tokens[i].Term)
+		}
+	}
+	return tokens
+}
+
 func TokenizeAndCountDuplicates(tokenization string, in string) ([]string, []int) {
 	counts := map[string]int{}
 	for _, term := range Tokenize(tokenization, in) {
@@ -79,6 +79,31 @@ func TestTokenize(t *testing.T) {
 	})
 }
 
+func TestTokenizeWithOffsets(t *testing.T) {
+	input := " Héllo You*-beautiful_world?! "
+
+	for _, tokenization := range Tokenizations {
+		t.Run(tokenization, func(t *testing.T) {
+			tokens := TokenizeWithOffsets(tokenization, input)
+			runes := []rune(input)
+			terms := make([]string, len(tokens))
+			for i, token := range tokens {
+				terms[i] = token.Term
+				assert.Equal(t, Tokenize(models.PropertyTokenizationLowercase, token.Term),
+					Tokenize(models.PropertyTokenizationLowercase, string(runes[token.Start:token.End])))
+			}
+			assert.Equal(t, Tokenize(tokenization, input), terms)
+		})
+	}
+
+	assert.Equal(t, []Token{
+		{Term: "héllo", Start: 1, End: 6},
+		{Term: "you", Start: 7, End: 10},
+		{Term: "beautiful", Start: 12, End: 21},
+		{Term: "world", Start: 22, End: 27},
+	}, TokenizeWithOffsets(models.PropertyTokenizationWord, input))
+}
+
 func TestTokenizeAndCountDuplicates(t *testing.T) {
 	input := "Hello You Beautiful World! hello you beautiful world!"
 
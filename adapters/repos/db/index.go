@@ -38,17 +38,22 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/autocut"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/session"
 	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/scheduler"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"golang.org/x/sync/errgroup"
@@ -175,8 +180,15 @@ type Index struct {
 	indexCheckpoints *indexcheckpoint.Checkpoints
 
 	partitioningEnabled bool
+	// lastAccess of tenant shards on this node, see localShard
+	lastAccess sync.Map
 
 	cycleCallbacks *indexCycleCallbacks
+	// pausedMaintenance are the cycles stopped under memory pressure which
+	// were not started again yet
+	pausedMaintenance     []*pausedCycle
+	maintenancePaused     bool
+	pausedMaintenanceLock sync.Mutex
 
 	backupMutex backupMutex
 	lastBackup  atomic.Pointer[BackupState]
@@ -287,6 +299,7 @@ func (i *Index) initAndStoreShards(ctx context.Context, shardState *sharding.Sta
 				continue
 			}
 
+			i.Config.Startup.pending(i.Config.ClassName.String(), shardName)
 			shardName := shardName // prevent loop variable capture
 			eg.Go(func() error {
 				shard, err := NewShard(ctx, promMetrics, shardName, i, class, i.centralJobQueue, i.indexCheckpoints, nil)
@@ -309,6 +322,7 @@ func (i *Index) initAndStoreShards(ctx context.Context, shardState *sharding.Sta
 			continue
 		}
 
+		i.Config.Startup.pending(i.Config.ClassName.String(), shardName)
 		shard := NewLazyLoadShard(ctx, promMetrics, shardName, i, class, i.centralJobQueue, i.indexCheckpoints)
 		i.shards.Store(shardName, shard)
 	}
@@ -331,6 +345,9 @@ func (i *Index) initAndStoreShards(ctx context.Context, shardState *sharding.Sta
 					// break loop by returning error
 					return i.closingCtx.Err()
 				default:
+					if err := i.waitForStartupPriority(ticker); err != nil {
+						return err
+					}
 					shard.(*LazyLoadShard).Load(context.Background())
 					return nil
 				}
@@ -371,6 +388,9 @@ func (i *Index) initShard(ctx context.Context, shardName string, class *models.C
 func (i *Index) IterateObjects(ctx context.Context, cb func(index *Index, shard ShardLike, object *storobj.Object) error) (err error) {
 	return i.ForEachShard(func(_ string, shard ShardLike) error {
 		wrapper := func(object *storobj.Object) error {
+			if err := i.decryptObjects(object); err != nil {
+				return err
+			}
 			return cb(i, shard, object)
 		}
 		bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
@@ -460,6 +480,20 @@ func (i *Index) updateVectorIndexConfig(ctx context.Context,
 	return nil
 }
 
+// objectMarshallerVersion is the version the objects of the index are
+// stored with, it follows the vector storage of the index, which is
+// immutable
+func (i *Index) objectMarshallerVersion() uint8 {
+	i.vectorIndexUserConfigLock.Lock()
+	defer i.vectorIndexUserConfigLock.Unlock()
+
+	cfg, ok := i.vectorIndexUserConfig.(enthnsw.UserConfig)
+	if ok && cfg.StorageType() == enthnsw.VectorStorageFloat16 {
+		return storobj.MarshallerVersionFloat16
+	}
+	return 1
+}
+
 func (i *Index) getInvertedIndexConfig() schema.InvertedIndexConfig {
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
@@ -491,9 +525,26 @@ type IndexConfig struct {
 	MemtablesMaxActiveSeconds int
 	ReplicationFactor         int64
 	AvoidMMap                 bool
+	LSMReadMode               diskio.ReadMode
 	DisableLazyLoadShards     bool
+	PropertyEncryption        *encryption.Keyring
+	WALArchive                *walArchive
+	TieredStorage             *tieredStorage
+	// Startup tracks the loading of the shards of indexes which existed at
+	// startup, it is nil for indexes created at runtime
+	Startup *startupTracker
 
 	TrackVectorDimensions bool
+
+	// ShardSearchConcurrency is the number of shards a query searches in
+	// parallel, zero means twice the number of CPUs
+	ShardSearchConcurrency int
+	// MaxShardSearches bounds the searches a shard runs at the same time, zero
+	// means unbounded
+	MaxShardSearches int
+	// Scheduler grants the CPU to searches and imports by their priority, nil
+	// if disabled
+	Scheduler *scheduler.Scheduler
 }
 
 func indexID(class schema.ClassName) string {
@@ -813,8 +864,12 @@ func (i *Index) AddReferencesBatch(ctx context.Context, refs objects.BatchRefere
 
 	byShard := map[string]refsAndPos{}
 	out := make([]error, len(refs))
+	updateTime := time.Now().UnixMilli()
 
 	for pos, ref := range refs {
+		if ref.UpdateTime == 0 {
+			ref.UpdateTime = updateTime
+		}
 		if err := i.validateMultiTenancy(ref.Tenant); err != nil {
 			out[pos] = err
 			continue
@@ -824,6 +879,10 @@ func (i *Index) AddReferencesBatch(ctx context.Context, refs objects.BatchRefere
 			out[pos] = err
 			continue
 		}
+		if err := i.moveReshardedObject(ctx, ref.From.TargetID, ref.Tenant); err != nil {
+			out[pos] = err
+			continue
+		}
 
 		group := byShard[shardName]
 		group.refs = append(group.refs, ref)
@@ -888,8 +947,21 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 		}
 	}
 
-	var obj *storobj.Object
+	obj, err := i.objectByIDFromShard(ctx, shardName, id, props, addl, replProps, tenant)
+	if err != nil || obj != nil {
+		return obj, err
+	}
+	if prev := i.previousObjectShard(id, tenant); prev != "" {
+		// not moved by resharding yet
+		return i.objectByIDFromShard(ctx, prev, id, props, addl, replProps, tenant)
+	}
+	return nil, nil
+}
 
+func (i *Index) objectByIDFromShard(ctx context.Context, shardName string, id strfmt.UUID,
+	props search.SelectProperties, addl additional.Properties,
+	replProps *additional.ReplicationProperties, tenant string,
+) (obj *storobj.Object, err error) {
 	if i.replicationEnabled() {
 		if replProps == nil {
 			replProps = defaultConsistency()
@@ -897,8 +969,19 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 		if replProps.NodeName != "" {
 			obj, err = i.replicator.NodeObject(ctx, replProps.NodeName, shardName, id, props, addl)
 		} else {
-			obj, err = i.replicator.GetOne(ctx,
-				replica.ConsistencyLevel(replProps.ConsistencyLevel), shardName, id, props, addl)
+			l := replica.ConsistencyLevel(replProps.ConsistencyLevel)
+			obj, err = i.replicator.GetOne(ctx, l, shardName, id, props, addl)
+			if err == nil {
+				applied := func(w session.Write) bool {
+					if obj == nil {
+						return w.AppliedTo(false, 0)
+					}
+					return w.AppliedTo(true, obj.LastUpdateTimeUnix())
+				}
+				if sl, stale := i.sessionReadLevel(ctx, tenant, id, l, applied); stale {
+					obj, err = i.replicator.GetOne(ctx, sl, shardName, id, props, addl)
+				}
+			}
 		}
 		return obj, err
 	}
@@ -969,20 +1052,9 @@ func (i *Index) multiObjectByID(ctx context.Context,
 	out := make([]*storobj.Object, len(query))
 
 	for shardName, group := range byShard {
-
-		var objects []*storobj.Object
-		var err error
-
-		if shard := i.localShard(shardName); shard != nil {
-			objects, err = shard.MultiObjectByID(ctx, group.ids)
-			if err != nil {
-				return nil, errors.Wrapf(err, "shard %s", shard.ID())
-			}
-		} else {
-			objects, err = i.remote.MultiGetObjects(ctx, shardName, extractIDsFromMulti(group.ids))
-			if err != nil {
-				return nil, errors.Wrapf(err, "remote shard %s", shardName)
-			}
+		objects, err := i.multiObjectByIDFromShard(ctx, shardName, group.ids)
+		if err != nil {
+			return nil, err
 		}
 
 		for i, obj := range objects {
@@ -991,9 +1063,51 @@ func (i *Index) multiObjectByID(ctx context.Context,
 		}
 	}
 
+	// objects which have not been moved by resharding yet
+	byPrevShard := map[string]idsAndPos{}
+	for pos, obj := range out {
+		if obj != nil {
+			continue
+		}
+		if prev := i.previousObjectShard(strfmt.UUID(query[pos].ID), tenant); prev != "" {
+			group := byPrevShard[prev]
+			group.ids = append(group.ids, query[pos])
+			group.pos = append(group.pos, pos)
+			byPrevShard[prev] = group
+		}
+	}
+	for shardName, group := range byPrevShard {
+		objects, err := i.multiObjectByIDFromShard(ctx, shardName, group.ids)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, obj := range objects {
+			out[group.pos[i]] = obj
+		}
+	}
+
 	return out, nil
 }
 
+func (i *Index) multiObjectByIDFromShard(ctx context.Context, shardName string,
+	ids []multi.Identifier,
+) ([]*storobj.Object, error) {
+	if shard := i.localShard(shardName); shard != nil {
+		objects, err := shard.MultiObjectByID(ctx, ids)
+		if err != nil {
+			return nil, errors.Wrapf(err, "shard %s", shard.ID())
+		}
+		return objects, nil
+	}
+
+	objects, err := i.remote.MultiGetObjects(ctx, shardName, extractIDsFromMulti(ids))
+	if err != nil {
+		return nil, errors.Wrapf(err, "remote shard %s", shardName)
+	}
+	return objects, nil
+}
+
 func extractIDsFromMulti(in []multi.Identifier) []strfmt.UUID {
 	out := make([]strfmt.UUID, len(in))
 
@@ -1031,13 +1145,34 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID,
 		}
 	}
 
-	var exists bool
+	exists, err := i.existsInShard(ctx, shardName, id, replProps, tenant)
+	if err != nil || exists {
+		return exists, err
+	}
+	if prev := i.previousObjectShard(id, tenant); prev != "" {
+		// not moved by resharding yet
+		return i.existsInShard(ctx, prev, id, replProps, tenant)
+	}
+	return false, nil
+}
+
+func (i *Index) existsInShard(ctx context.Context, shardName string, id strfmt.UUID,
+	replProps *additional.ReplicationProperties, tenant string,
+) (exists bool, err error) {
 	if i.replicationEnabled() {
 		if replProps == nil {
 			replProps = defaultConsistency()
 		}
 		cl := replica.ConsistencyLevel(replProps.ConsistencyLevel)
-		return i.replicator.Exists(ctx, cl, shardName, id)
+		exists, err = i.replicator.Exists(ctx, cl, shardName, id)
+		if err == nil {
+			// without the update time only the existence can be checked
+			applied := func(w session.Write) bool { return exists != w.Deleted }
+			if sl, stale := i.sessionReadLevel(ctx, tenant, id, cl, applied); stale {
+				exists, err = i.replicator.Exists(ctx, sl, shardName, id)
+			}
+		}
+		return exists, err
 
 	}
 	if shard := i.localShard(shardName); shard != nil {
@@ -1198,7 +1333,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 	resultObjects, resultScores := objectSearchPreallocate(limit, shards)
 
 	eg := errgroup.Group{}
-	eg.SetLimit(_NUMCPU * 2)
+	eg.SetLimit(i.shardSearchConcurrency())
 	shardResultLock := sync.Mutex{}
 	for _, shardName := range shards {
 		shardName := shardName
@@ -1211,21 +1346,35 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 				err      error
 			)
 
+			shardCtx, cancel := search.ShardContext(ctx)
+			defer cancel()
+
 			if shard := i.localShard(shardName); shard != nil {
 				nodeName = i.getSchema.NodeName()
-				objs, scores, err = shard.ObjectSearch(ctx, limit, filters, keywordRanking, sort, cursor, addlProps)
+				objs, scores, err = shard.ObjectSearch(shardCtx, limit, filters, keywordRanking, sort, cursor, addlProps)
 				if err != nil {
+					if search.SkipShard(shardCtx, shardName, err) {
+						return nil
+					}
 					return fmt.Errorf(
 						"local shard object search %s: %w", shard.ID(), err)
 				}
+			} else if !search.ExplainFromContext(ctx).Execute() {
+				explainRemoteShard(ctx, shardName, "", time.Now(), 0)
+				return nil
 			} else {
+				before := time.Now()
 				objs, scores, nodeName, err = i.remote.SearchShard(
-					ctx, shardName, nil, limit, filters, keywordRanking,
+					shardCtx, shardName, nil, limit, filters, keywordRanking,
 					sort, cursor, nil, addlProps, i.replicationEnabled())
 				if err != nil {
+					if search.SkipShard(shardCtx, shardName, err) {
+						return nil
+					}
 					return fmt.Errorf(
 						"remote shard object search %s: %w", shardName, err)
 				}
+				explainRemoteShard(ctx, shardName, nodeName, before, len(objs))
 			}
 
 			if i.replicationEnabled() {
@@ -1283,6 +1432,14 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 	return resultObjects, resultScores, nil
 }
 
+// shardSearchConcurrency is the number of shards a query searches in parallel
+func (i *Index) shardSearchConcurrency() int {
+	if i.Config.ShardSearchConcurrency > 0 {
+		return i.Config.ShardSearchConcurrency
+	}
+	return _NUMCPU * 2
+}
+
 func (i *Index) sortByID(objects []*storobj.Object, scores []float32,
 ) ([]*storobj.Object, []float32) {
 	return newIDSorter().sort(objects, scores)
@@ -1312,10 +1469,16 @@ func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVe
 	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
 	shardName string,
 ) ([]*storobj.Object, []float32, error) {
+	shardCtx, cancel := search.ShardContext(ctx)
+	defer cancel()
+
 	shard := i.localShard(shardName)
 	res, resDists, err := shard.ObjectVectorSearch(
-		ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+		shardCtx, searchVector, dist, limit, filters, sort, groupBy, additional)
 	if err != nil {
+		if search.SkipShard(shardCtx, shardName, err) {
+			return nil, nil, nil
+		}
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
 
@@ -1369,8 +1532,15 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 		shardCap = len(shardNames) * limit
 	}
 
+	// shards which finish later only load the objects of results which are
+	// competitive with those collected so far
+	var bound *search.DistanceBound
+	if limit > 0 && groupBy == nil && len(sort) == 0 {
+		ctx, bound = search.WithDistanceBound(ctx, limit)
+	}
+
 	eg := &errgroup.Group{}
-	eg.SetLimit(_NUMCPU * 2)
+	eg.SetLimit(i.shardSearchConcurrency())
 	m := &sync.Mutex{}
 
 	out := make([]*storobj.Object, 0, shardCap)
@@ -1385,26 +1555,42 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 				err      error
 			)
 
+			shardCtx, cancel := search.ShardContext(ctx)
+			defer cancel()
+
 			if shard := i.localShard(shardName); shard != nil {
 				nodeName = i.getSchema.NodeName()
 				res, resDists, err = shard.ObjectVectorSearch(
-					ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+					shardCtx, searchVector, dist, limit, filters, sort, groupBy, additional)
 				if err != nil {
+					if search.SkipShard(shardCtx, shardName, err) {
+						return nil
+					}
 					return errors.Wrapf(err, "shard %s", shard.ID())
 				}
 
+			} else if !search.ExplainFromContext(ctx).Execute() {
+				explainRemoteShard(ctx, shardName, "", time.Now(), 0)
+				return nil
 			} else {
-				res, resDists, nodeName, err = i.remote.SearchShard(ctx,
+				before := time.Now()
+				res, resDists, nodeName, err = i.remote.SearchShard(shardCtx,
 					shardName, searchVector, limit, filters,
 					nil, sort, nil, groupBy, additional, i.replicationEnabled())
 				if err != nil {
+					if search.SkipShard(shardCtx, shardName, err) {
+						return nil
+					}
 					return errors.Wrapf(err, "remote shard %s", shardName)
 				}
+				explainRemoteShard(ctx, shardName, nodeName, before, len(res))
 			}
 			if i.replicationEnabled() {
 				storobj.AddOwnership(res, nodeName, shardName)
 			}
 
+			bound.Add(resDists)
+
 			m.Lock()
 			out = append(out, res...)
 			dists = append(dists, resDists...)
@@ -1492,6 +1678,19 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
 	}
 
+	if err := i.deleteObjectFromShard(ctx, shardName, id, replProps); err != nil {
+		return err
+	}
+	if prev := i.previousObjectShard(id, tenant); prev != "" {
+		// otherwise resharding would move the object back
+		return i.deleteObjectFromShard(ctx, prev, id, replProps)
+	}
+	return nil
+}
+
+func (i *Index) deleteObjectFromShard(ctx context.Context, shardName string, id strfmt.UUID,
+	replProps *additional.ReplicationProperties,
+) error {
 	if i.replicationEnabled() {
 		if replProps == nil {
 			replProps = defaultConsistency()
@@ -1514,7 +1713,7 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 	// no replication, local shard
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
-	err = errShardNotFound
+	err := errShardNotFound
 	if shard := i.localShard(shardName); shard != nil {
 		err = shard.DeleteObject(ctx, id)
 	}
@@ -1536,7 +1735,13 @@ func (i *Index) IncomingDeleteObject(ctx context.Context, shardName string,
 	return shard.DeleteObject(ctx, id)
 }
 
+// localShard returns the shard for reading or writing data. For tenants,
+// the access is recorded, which is used to find idle tenants. Status checks
+// and other maintenance should use i.shards directly.
 func (i *Index) localShard(name string) ShardLike {
+	if i.partitioningEnabled {
+		i.lastAccess.Store(name, time.Now())
+	}
 	return i.shards.Load(name)
 }
 
@@ -1551,6 +1756,9 @@ func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 	if err != nil {
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
 	}
+	if err := i.moveReshardedObject(ctx, merge.ID, tenant); err != nil {
+		return err
+	}
 
 	if i.replicationEnabled() {
 		if replProps == nil {
@@ -1716,6 +1924,9 @@ func (i *Index) dropShards(names []string) (commit func(success bool), err error
 		for name := range shards {
 			i.shards.LoadAndDelete(name)
 		}
+		for _, name := range names {
+			i.lastAccess.Delete(name)
+		}
 
 		// drop shards
 		for _, shard := range shards {
@@ -1792,7 +2003,7 @@ func (i *Index) getShardsQueueSize(ctx context.Context, tenant string) (map[stri
 		if !shardState.IsLocalShard(shardName) {
 			size, err = i.remote.GetShardQueueSize(ctx, shardName)
 		} else {
-			shard := i.localShard(shardName)
+			shard := i.shards.Load(shardName)
 			if shard == nil {
 				err = errors.Errorf("shard %s does not exist", shardName)
 			} else {
@@ -1810,7 +2021,7 @@ func (i *Index) getShardsQueueSize(ctx context.Context, tenant string) (map[stri
 }
 
 func (i *Index) IncomingGetShardQueueSize(ctx context.Context, shardName string) (int64, error) {
-	shard := i.localShard(shardName)
+	shard := i.shards.Load(shardName)
 	if shard == nil {
 		return 0, errShardNotFound
 	}
@@ -1832,7 +2043,7 @@ func (i *Index) getShardsStatus(ctx context.Context, tenant string) (map[string]
 		if !shardState.IsLocalShard(shardName) {
 			status, err = i.remote.GetShardStatus(ctx, shardName)
 		} else {
-			shard := i.localShard(shardName)
+			shard := i.shards.Load(shardName)
 			if shard == nil {
 				err = errors.Errorf("shard %s does not exist", shardName)
 			} else {
@@ -1850,7 +2061,7 @@ func (i *Index) getShardsStatus(ctx context.Context, tenant string) (map[string]
 }
 
 func (i *Index) IncomingGetShardStatus(ctx context.Context, shardName string) (string, error) {
-	shard := i.localShard(shardName)
+	shard := i.shards.Load(shardName)
 	if shard == nil {
 		return "", errShardNotFound
 	}
@@ -1858,14 +2069,14 @@ func (i *Index) IncomingGetShardStatus(ctx context.Context, shardName string) (s
 }
 
 func (i *Index) updateShardStatus(ctx context.Context, shardName, targetStatus string) error {
-	if shard := i.localShard(shardName); shard != nil {
+	if shard := i.shards.Load(shardName); shard != nil {
 		return shard.UpdateStatus(targetStatus)
 	}
 	return i.remote.UpdateShardStatus(ctx, shardName, targetStatus)
 }
 
 func (i *Index) IncomingUpdateShardStatus(ctx context.Context, shardName, targetStatus string) error {
-	shard := i.localShard(shardName)
+	shard := i.shards.Load(shardName)
 	if shard == nil {
 		return errShardNotFound
 	}
@@ -2017,7 +2228,7 @@ func objectSearchPreallocate(limit int, shards []string) ([]*storobj.Object, []f
 func (i *Index) addNewShard(ctx context.Context,
 	class *models.Class, shardName string,
 ) error {
-	if shard := i.localShard(shardName); shard != nil {
+	if shard := i.shards.Load(shardName); shard != nil {
 		return fmt.Errorf("shard %q exists already", shardName)
 	}
 
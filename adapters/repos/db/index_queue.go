@@ -88,12 +88,15 @@ type IndexQueueOptions struct {
 	// Maximum number of vectors to use for brute force search
 	// when vectors are not indexed.
 	BruteForceSearchLimit int
+
+	// Metrics observes the depth of the queue, optional.
+	Metrics *Metrics
 }
 
 type batchIndexer interface {
 	AddBatch(ctx context.Context, id []uint64, vector [][]float32) error
-	SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error)
-	SearchByVectorDistance(vector []float32, dist float32,
+	SearchByVector(ctx context.Context, vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVectorDistance(ctx context.Context, vector []float32, dist float32,
 		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
 	DistanceBetweenVectors(x, y []float32) (float32, bool, error)
 	ContainsNode(id uint64) bool
@@ -393,6 +396,8 @@ func (q *IndexQueue) indexer() {
 }
 
 func (q *IndexQueue) pushToWorkers(max int, wait bool) {
+	q.Metrics.IndexQueueDepth(q.Size())
+
 	chunks := q.queue.borrowChunks(max)
 	for i, c := range chunks {
 		select {
@@ -419,24 +424,24 @@ func (q *IndexQueue) pushToWorkers(max int, wait bool) {
 
 // SearchByVector performs the search through the index first, then uses brute force to
 // query unindexed vectors.
-func (q *IndexQueue) SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
-	return q.search(vector, -1, k, allowList)
+func (q *IndexQueue) SearchByVector(ctx context.Context, vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
+	return q.search(ctx, vector, -1, k, allowList)
 }
 
 // SearchByVectorDistance performs the search through the index first, then uses brute force to
 // query unindexed vectors.
-func (q *IndexQueue) SearchByVectorDistance(vector []float32, dist float32, maxLimit int64, allowList helpers.AllowList) ([]uint64, []float32, error) {
-	return q.search(vector, dist, int(maxLimit), allowList)
+func (q *IndexQueue) SearchByVectorDistance(ctx context.Context, vector []float32, dist float32, maxLimit int64, allowList helpers.AllowList) ([]uint64, []float32, error) {
+	return q.search(ctx, vector, dist, int(maxLimit), allowList)
 }
 
-func (q *IndexQueue) search(vector []float32, dist float32, maxLimit int, allowList helpers.AllowList) ([]uint64, []float32, error) {
+func (q *IndexQueue) search(ctx context.Context, vector []float32, dist float32, maxLimit int, allowList helpers.AllowList) ([]uint64, []float32, error) {
 	var indexedResults []uint64
 	var distances []float32
 	var err error
 	if dist == -1 {
-		indexedResults, distances, err = q.Index.SearchByVector(vector, maxLimit, allowList)
+		indexedResults, distances, err = q.Index.SearchByVector(ctx, vector, maxLimit, allowList)
 	} else {
-		indexedResults, distances, err = q.Index.SearchByVectorDistance(vector, dist, int64(maxLimit), allowList)
+		indexedResults, distances, err = q.Index.SearchByVectorDistance(ctx, vector, dist, int64(maxLimit), allowList)
 	}
 	if err != nil {
 		return nil, nil, err
@@ -456,6 +461,9 @@ func (q *IndexQueue) search(vector []float32, dist float32, maxLimit int, allowL
 	var seen map[uint64]struct{}
 
 	err = q.queue.Iterate(allowList, func(objects []vectorDescriptor) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		if results == nil {
 			results = q.pqMaxPool.GetMax(maxLimit)
 			seen = make(map[uint64]struct{}, len(indexedResults))
@@ -502,9 +510,10 @@ func (q *IndexQueue) checkCompressionSettings() {
 		return
 	}
 
+	// the index compresses its vectors in the background, while the queue
+	// keeps indexing into the uncompressed vectors
 	if ci.AlreadyIndexed() > uint64(shouldCompressAt) {
-		q.pauseIndexing()
-		err := ci.TurnOnCompression(q.resumeIndexing)
+		err := ci.TurnOnCompression(func() {})
 		if err != nil {
 			q.Logger.WithError(err).Error("failed to turn on compression")
 		}
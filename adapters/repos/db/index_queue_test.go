@@ -51,7 +51,7 @@ func startWorker(t testing.TB, retryInterval ...time.Duration) chan job {
 	go func() {
 		logger := logrus.New()
 		logger.Level = logrus.ErrorLevel
-		asyncWorker(ch, logger, itv)
+		asyncWorker(ch, nil, logger, itv)
 	}()
 
 	return ch
@@ -243,7 +243,7 @@ func TestIndexQueue(t *testing.T) {
 		<-called
 
 		time.Sleep(500 * time.Millisecond)
-		res, _, err := q.SearchByVector([]float32{1, 2, 3}, 2, nil)
+		res, _, err := q.SearchByVector(context.Background(), []float32{1, 2, 3}, 2, nil)
 		require.NoError(t, err)
 		require.Equal(t, []uint64{1, 4}, res)
 	})
@@ -261,7 +261,7 @@ func TestIndexQueue(t *testing.T) {
 			pushVector(t, ctx, q, uint64(i+1), []float32{float32(i) + 1, float32(i) + 2, float32(i) + 3})
 		}
 
-		res, _, err := q.SearchByVector([]float32{1, 2, 3}, 2, nil)
+		res, _, err := q.SearchByVector(context.Background(), []float32{1, 2, 3}, 2, nil)
 		require.NoError(t, err)
 		require.Equal(t, []uint64{1, 2}, res)
 	})
@@ -371,7 +371,7 @@ func TestIndexQueue(t *testing.T) {
 		pushVector(t, ctx, q, 3, []float32{7, 8, 9})
 		pushVector(t, ctx, q, 4, []float32{1, 2, 3})
 
-		res, _, err := q.SearchByVector([]float32{7, 8, 9}, 2, nil)
+		res, _, err := q.SearchByVector(context.Background(), []float32{7, 8, 9}, 2, nil)
 		require.NoError(t, err)
 		// despite having 4 vectors in the queue
 		// only the first two are used for brute force search
@@ -541,7 +541,7 @@ func TestIndexQueue(t *testing.T) {
 
 		q.pushToWorkers(-1, false)
 
-		_, distances, err := q.SearchByVector(randVector(1536), 10, nil)
+		_, distances, err := q.SearchByVector(context.Background(), randVector(1536), 10, nil)
 		require.NoError(t, err)
 
 		// all distances should be between 0 and 1
@@ -603,14 +603,22 @@ func TestIndexQueue(t *testing.T) {
 		idx.threshold = 4
 		idx.alreadyIndexed = 6
 
-		release := make(chan struct{})
+		// the index compresses in the background and never finishes here,
+		// so the queue asks again on every tick
+		var calledOnce sync.Once
 		idx.onCompressionTurnedOn = func(callback func()) error {
-			go func() {
-				<-release
-				callback()
-			}()
+			calledOnce.Do(func() { close(called) })
+			return nil
+		}
 
-			close(called)
+		indexed := make(chan struct{})
+		var indexedOnce sync.Once
+		idx.addBatchFn = func(ids []uint64, vector [][]float32) error {
+			for _, id := range ids {
+				if id == 3 {
+					indexedOnce.Do(func() { close(indexed) })
+				}
+			}
 			return nil
 		}
 
@@ -627,28 +635,11 @@ func TestIndexQueue(t *testing.T) {
 		// compression requested
 		<-called
 
-		// indexing should be paused
-		require.True(t, q.paused.Load())
-
-		// release the compression
-		idx.compressed = true
-		close(release)
-
-		// indexing should be resumed eventually
-		time.Sleep(100 * time.Millisecond)
-		require.False(t, q.paused.Load())
-
-		indexed := make(chan struct{})
-		idx.addBatchFn = func(id []uint64, vector [][]float32) error {
-			close(indexed)
-			return nil
-		}
-
 		// add more vectors
 		pushVector(t, ctx, q, 3, []float32{7, 8, 9})
 		pushVector(t, ctx, q, 4, []float32{1, 2, 3})
 
-		// indexing should happen
+		// indexing continues while the index compresses
 		<-indexed
 	})
 
@@ -790,7 +781,7 @@ func (m *mockBatchIndexer) AddBatch(ctx context.Context, ids []uint64, vector []
 	return
 }
 
-func (m *mockBatchIndexer) SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
+func (m *mockBatchIndexer) SearchByVector(ctx context.Context, vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
 	m.Lock()
 	defer m.Unlock()
 
@@ -837,7 +828,7 @@ func (m *mockBatchIndexer) SearchByVector(vector []float32, k int, allowList hel
 	return ids, distances, nil
 }
 
-func (m *mockBatchIndexer) SearchByVectorDistance(vector []float32, maxDistance float32, maxLimit int64, allowList helpers.AllowList) ([]uint64, []float32, error) {
+func (m *mockBatchIndexer) SearchByVectorDistance(ctx context.Context, vector []float32, maxDistance float32, maxLimit int64, allowList helpers.AllowList) ([]uint64, []float32, error) {
 	m.Lock()
 	defer m.Unlock()
 
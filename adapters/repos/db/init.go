@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
@@ -52,7 +53,12 @@ func (db *DB) init(ctx context.Context) error {
 
 	objects := db.schemaGetter.GetSchemaSkipAuth().Objects
 	if objects != nil {
-		for _, class := range objects.Classes {
+		classes := make([]*models.Class, len(objects.Classes))
+		copy(classes, objects.Classes)
+		sort.SliceStable(classes, func(i, j int) bool {
+			return db.startup.priorityOf(classes[i].Class) < db.startup.priorityOf(classes[j].Class)
+		})
+		for _, class := range classes {
 			invertedConfig := class.InvertedIndexConfig
 			if invertedConfig == nil {
 				// for backward compatibility, this field was introduced in v1.0.4,
@@ -85,7 +91,15 @@ func (db *DB) init(ctx context.Context) error {
 				MemtablesMaxActiveSeconds: db.config.MemtablesMaxActiveSeconds,
 				TrackVectorDimensions:     db.config.TrackVectorDimensions,
 				AvoidMMap:                 db.config.AvoidMMap,
+				LSMReadMode:               db.config.LSMReadMode,
+				ShardSearchConcurrency:    db.config.ShardSearchConcurrency,
+				MaxShardSearches:          db.config.MaxShardSearches,
+				Scheduler:                 db.config.Scheduler,
 				DisableLazyLoadShards:     db.config.DisableLazyLoadShards,
+				PropertyEncryption:        db.config.PropertyEncryption,
+				WALArchive:                db.walArchive,
+				TieredStorage:             db.tieredStorage,
+				Startup:                   db.startup,
 				ReplicationFactor:         class.ReplicationConfig.Factor,
 			}, db.schemaGetter.CopyShardingState(class.Class),
 				inverted.ConfigFromModel(invertedConfig),
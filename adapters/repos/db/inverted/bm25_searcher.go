@@ -35,11 +35,15 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
+// the context of a search is checked every ctxCheckInterval documents
+const ctxCheckInterval = 1000
+
 type BM25Searcher struct {
 	config         schema.BM25Config
 	store          *lsmkv.Store
@@ -198,7 +202,7 @@ func (b *BM25Searcher) wand(
 				k := i + offset
 
 				eg.Go(func() error {
-					termResult, docIndices, err := b.createTerm(N, filterDocIds, queryTerms[j], propNames,
+					termResult, docIndices, err := b.createTerm(ctx, N, filterDocIds, queryTerms[j], propNames,
 						propertyBoosts, duplicateBoosts[j], params.AdditionalExplanations)
 					if err != nil {
 						return err
@@ -226,8 +230,12 @@ func (b *BM25Searcher) wand(
 	resultsOriginalOrder := make(terms, len(results))
 	copy(resultsOriginalOrder, results)
 
-	topKHeap := b.getTopKHeap(limit, results, averagePropLength)
-	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations)
+	topKHeap, err := b.getTopKHeap(ctx, limit, results, averagePropLength)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, averagePropLength,
+		params.AdditionalExplanations)
 }
 
 func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string, duplicateBoost []int, detector *stopwords.Detector) ([]string, []int) {
@@ -256,7 +264,8 @@ WordLoop:
 }
 
 func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue[any],
-	results terms, indices []map[uint64]int, additionalExplanations bool,
+	results terms, indices []map[uint64]int, averagePropLength float64,
+	additionalExplanations bool,
 ) ([]*storobj.Object, []float32, error) {
 	objectsBucket := b.store.Bucket(helpers.ObjectsBucketLSM)
 	if objectsBucket == nil {
@@ -290,13 +299,23 @@ func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue[any],
 			if obj.AdditionalProperties() == nil {
 				obj.Object.Additional = make(map[string]interface{})
 			}
+			explanation := &additional.ScoreExplanation{Score: res.Dist}
 			for j, result := range results {
 				if termIndice, ok := indices[j][res.ID]; ok {
 					queryTerm := result.queryTerm
-					obj.Object.Additional["BM25F_"+queryTerm+"_frequency"] = result.data[termIndice].frequency
-					obj.Object.Additional["BM25F_"+queryTerm+"_propLength"] = result.data[termIndice].propLength
+					pair := result.data[termIndice]
+					obj.Object.Additional["BM25F_"+queryTerm+"_frequency"] = pair.frequency
+					obj.Object.Additional["BM25F_"+queryTerm+"_propLength"] = pair.propLength
+					explanation.Terms = append(explanation.Terms, additional.TermScore{
+						Term:       queryTerm,
+						Frequency:  pair.frequency,
+						PropLength: pair.propLength,
+						Idf:        result.idf,
+						Score:      result.score(pair, averagePropLength, b.config),
+					})
 				}
 			}
+			obj.Object.Additional["scoreExplanation"] = explanation
 		}
 		objects = append(objects, obj)
 		scores = append(scores, res.Dist)
@@ -305,14 +324,22 @@ func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue[any],
 	return objects, scores, nil
 }
 
-func (b *BM25Searcher) getTopKHeap(limit int, results terms, averagePropLength float64,
-) *priorityqueue.Queue[any] {
+// getTopKHeap stops with the error of the context once it is cancelled, the
+// context is checked every ctxCheckInterval scored documents
+func (b *BM25Searcher) getTopKHeap(ctx context.Context, limit int, results terms,
+	averagePropLength float64,
+) (*priorityqueue.Queue[any], error) {
 	topKHeap := priorityqueue.NewMin[any](limit)
 	worstDist := float64(-10000) // tf score can be negative
 	sort.Sort(results)
-	for {
+	for i := 1; ; i++ {
 		if results.completelyExhausted() || results.pivot(worstDist) {
-			return topKHeap
+			return topKHeap, nil
+		}
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
 		}
 
 		id, score := results.scoreNext(averagePropLength, b.config)
@@ -331,12 +358,15 @@ func (b *BM25Searcher) getTopKHeap(limit int, results terms, averagePropLength f
 	}
 }
 
-func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, query string, propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost int, additionalExplanations bool) (term, map[uint64]int, error) {
+func (b *BM25Searcher) createTerm(ctx context.Context, N float64, filterDocIds helpers.AllowList, query string, propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost int, additionalExplanations bool) (term, map[uint64]int, error) {
 	termResult := term{queryTerm: query}
 	filteredDocIDs := sroar.NewBitmap() // to build the global n if there is a filter
 
 	allMsAndProps := make(AllMapPairsAndPropName, 0, len(propertyNames))
 	for _, propName := range propertyNames {
+		if err := ctx.Err(); err != nil {
+			return termResult, nil, err
+		}
 
 		bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
 		if bucket == nil {
@@ -475,9 +505,7 @@ type term struct {
 
 func (t *term) scoreAndAdvance(averagePropLength float64, config schema.BM25Config) (uint64, float64) {
 	id := t.idPointer
-	pair := t.data[t.posPointer]
-	freq := float64(pair.frequency)
-	tf := freq / (freq + config.K1*(1-config.B+config.B*float64(pair.propLength)/averagePropLength))
+	score := t.score(t.data[t.posPointer], averagePropLength, config)
 
 	// advance
 	t.posPointer++
@@ -487,7 +515,14 @@ func (t *term) scoreAndAdvance(averagePropLength float64, config schema.BM25Conf
 		t.idPointer = t.data[t.posPointer].id
 	}
 
-	return id, tf * t.idf
+	return id, score
+}
+
+// score is the contribution of the term to the bm25 score of a document
+func (t *term) score(pair docPointerWithScore, averagePropLength float64, config schema.BM25Config) float64 {
+	freq := float64(pair.frequency)
+	tf := freq / (freq + config.K1*(1-config.B+config.B*float64(pair.propLength)/averagePropLength))
+	return tf * t.idf
 }
 
 func (t *term) advanceAtLeast(minID uint64) {
@@ -34,7 +34,12 @@ type propValuePair struct {
 
 	// only set if operator=OperatorWithinGeoRange, as that cannot be served by a
 	// byte value from an inverted index
-	valueGeoRange      *filters.GeoRange
+	valueGeoRange *filters.GeoRange
+	// only set if operator=OperatorWithinGeoPolygon
+	valueGeoPolygon *filters.GeoPolygon
+	// only set if operator=OperatorWithinGeoBoundingBox
+	valueGeoBoundingBox *filters.GeoBoundingBox
+
 	docIDs             docBitmap
 	children           []*propValuePair
 	hasFilterableIndex bool
@@ -58,7 +63,8 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 			return errors.Errorf("Property length must be indexed to be filterable! add `IndexPropertyLength: true` to the invertedIndexConfig in %v.  Geo-coordinates, phone numbers and data blobs are not supported by property length.", pv.Class.Class)
 		}
 
-		if pv.operator == filters.OperatorIsNull && !pv.Class.InvertedIndexConfig.IndexNullState {
+		if (pv.operator == filters.OperatorIsNull || pv.operator == filters.OperatorIsMissing) &&
+			!pv.Class.InvertedIndexConfig.IndexNullState {
 			return errors.Errorf("Nullstate must be indexed to be filterable! Add `indexNullState: true` to the invertedIndexConfig")
 		}
 
@@ -80,8 +86,8 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 		b := s.store.Bucket(bucketName)
 
 		// TODO:  I think we can delete this check entirely.  The bucket will never be nill, and routines should now check if their particular feature is active in the schema.  However, not all those routines have checks yet.
-		if b == nil && pv.operator != filters.OperatorWithinGeoRange {
-			// a nil bucket is ok for a WithinGeoRange filter, as this query is not
+		if b == nil && !pv.operator.IsGeo() {
+			// a nil bucket is ok for a geo filter, as this query is not
 			// served by the inverted index, but propagated to a secondary index in
 			// .docPointers()
 			return errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
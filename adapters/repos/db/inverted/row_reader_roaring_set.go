@@ -71,7 +71,7 @@ type RoaringSetReadFn func(k []byte, v *sroar.Bitmap) (bool, error)
 // requests involving cursors
 func (rr *RowReaderRoaringSet) Read(ctx context.Context, readFn RoaringSetReadFn) error {
 	switch rr.operator {
-	case filters.OperatorEqual, filters.OperatorIsNull:
+	case filters.OperatorEqual, filters.OperatorIsNull, filters.OperatorIsMissing:
 		return rr.equal(ctx, readFn)
 	case filters.OperatorNotEqual:
 		return rr.notEqual(ctx, readFn)
@@ -238,7 +238,7 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 		return s.extractReferenceCount(property, filter.Value.Value, filter.Operator, class)
 	}
 
-	if filter.Operator == filters.OperatorIsNull {
+	if filter.Operator == filters.OperatorIsNull || filter.Operator == filters.OperatorIsMissing {
 		return s.extractPropertyNull(property, filter.Value.Type, filter.Value.Value, filter.Operator, class)
 	}
 
@@ -360,21 +360,32 @@ func (s *Searcher) extractGeoFilter(prop *models.Property, value interface{},
 	valueType schema.DataType, operator filters.Operator, class *models.Class,
 ) (*propValuePair, error) {
 	if valueType != schema.DataTypeGeoCoordinates {
-		return nil, fmt.Errorf("prop %q is of type geoCoordinates, it can only"+
-			"be used with geoRange filters", prop.Name)
+		return nil, fmt.Errorf("prop %q is of type geoCoordinates, it can only "+
+			"be used with geo filters", prop.Name)
 	}
 
-	parsed := value.(filters.GeoRange)
-
-	return &propValuePair{
+	pv := &propValuePair{
 		value:              nil, // not going to be served by an inverted index
-		valueGeoRange:      &parsed,
 		prop:               prop.Name,
 		operator:           operator,
 		hasFilterableIndex: HasFilterableIndex(prop),
 		hasSearchableIndex: HasSearchableIndex(prop),
 		Class:              class,
-	}, nil
+	}
+
+	switch parsed := value.(type) {
+	case filters.GeoRange:
+		pv.valueGeoRange = &parsed
+	case filters.GeoPolygon:
+		pv.valueGeoPolygon = &parsed
+	case filters.GeoBoundingBox:
+		pv.valueGeoBoundingBox = &parsed
+	default:
+		return nil, fmt.Errorf("prop %q is of type geoCoordinates, unsupported "+
+			"filter value of type %T", prop.Name, value)
+	}
+
+	return pv, nil
 }
 
 func (s *Searcher) extractUUIDFilter(prop *models.Property, value interface{},
@@ -582,31 +593,54 @@ func (s *Searcher) extractPropertyLength(prop *models.Property, propType schema.
 	}, nil
 }
 
+// extractPropertyNull reads the null state index of a property. Properties
+// which are set to null are matched by IsNull, but not by IsMissing.
 func (s *Searcher) extractPropertyNull(prop *models.Property, propType schema.DataType,
 	value interface{}, operator filters.Operator, class *models.Class,
 ) (*propValuePair, error) {
-	var valResult []byte
+	var isNull bool
 
 	switch propType {
 	case schema.DataTypeBoolean:
-		b, err := s.extractBoolValue(value)
-		if err != nil {
-			return nil, err
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected value to be bool, got %T", value)
 		}
-		valResult = b
+		isNull = b
 	default:
 		return nil, fmt.Errorf(
 			"failed to extract null prop, unsupported type '%T' for null prop '%s'", propType, prop.Name)
 	}
 
-	return &propValuePair{
-		value:              valResult,
-		prop:               helpers.PropNull(prop.Name),
-		operator:           operator,
-		hasFilterableIndex: HasFilterableIndexPropNull, // TODO text_rbm_inverted_index & with settings
-		hasSearchableIndex: HasSearchableIndexPropNull, // TODO text_rbm_inverted_index & with settings
-		Class:              class,
-	}, nil
+	state := func(state int) *propValuePair {
+		return &propValuePair{
+			value:              []byte{uint8(state)},
+			prop:               helpers.PropNull(prop.Name),
+			operator:           operator,
+			hasFilterableIndex: HasFilterableIndexPropNull, // TODO text_rbm_inverted_index & with settings
+			hasSearchableIndex: HasSearchableIndexPropNull, // TODO text_rbm_inverted_index & with settings
+			Class:              class,
+		}
+	}
+	either := func(a, b int) *propValuePair {
+		return &propValuePair{
+			docIDs:   newDocBitmap(),
+			operator: filters.OperatorOr,
+			children: []*propValuePair{state(a), state(b)},
+			Class:    class,
+		}
+	}
+
+	switch {
+	case operator == filters.OperatorIsNull && isNull:
+		return either(filters.InternalNullState, filters.InternalExplicitNullState), nil
+	case operator == filters.OperatorIsNull:
+		return state(filters.InternalNotNullState), nil
+	case isNull:
+		return state(filters.InternalNullState), nil
+	default:
+		return either(filters.InternalNotNullState, filters.InternalExplicitNullState), nil
+	}
 }
 
 func (s *Searcher) extractContains(path *filters.Path, propType schema.DataType, value interface{},
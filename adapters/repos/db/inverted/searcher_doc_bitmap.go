@@ -19,7 +19,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
-	"github.com/weaviate/weaviate/entities/filters"
 )
 
 func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
@@ -28,7 +27,7 @@ func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
 	// geo props cannot be served by the inverted index and they require an
 	// external index. So, instead of trying to serve this chunk of the filter
 	// request internally, we can pass it to an external geo index
-	if pv.operator == filters.OperatorWithinGeoRange {
+	if pv.operator.IsGeo() {
 		return s.docBitmapGeo(ctx, pv)
 	}
 	// all other operators perform operations on the inverted index which we
@@ -143,9 +142,19 @@ func (s *Searcher) docBitmapGeo(ctx context.Context, pv *propValuePair) (docBitm
 		return out, nil
 	}
 
-	res, err := propIndex.GeoIndex.WithinRange(ctx, *pv.valueGeoRange)
+	var res []uint64
+	var err error
+	switch {
+	case pv.valueGeoPolygon != nil:
+		res, err = propIndex.GeoIndex.WithinPolygon(ctx, *pv.valueGeoPolygon)
+	case pv.valueGeoBoundingBox != nil:
+		res, err = propIndex.GeoIndex.WithinBoundingBox(ctx, *pv.valueGeoBoundingBox)
+	default:
+		res, err = propIndex.GeoIndex.WithinRange(ctx, *pv.valueGeoRange)
+	}
 	if err != nil {
-		return out, errors.Wrapf(err, "geo index range search on prop %q", pv.prop)
+		return out, errors.Wrapf(err, "geo index %s search on prop %q",
+			pv.operator.Name(), pv.prop)
 	}
 
 	out.docIDs.SetMany(res)
@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/scheduler"
 )
 
 type ShardInvertedReindexTask interface {
@@ -243,6 +244,9 @@ func (r *ShardInvertedReindexer) reindexProperties(ctx context.Context, reindexa
 
 	i := 0
 	if err := objectsBucket.IterateObjects(ctx, func(object *storobj.Object) error {
+		if err := r.shard.Index().decryptObjects(object); err != nil {
+			return err
+		}
 		// check context expired every 100k objects
 		if i%100_000 == 0 && i != 0 {
 			if err := r.checkContextExpired(ctx, "iterating through objects stopped due to context canceled"); err != nil {
@@ -253,6 +257,12 @@ func (r *ShardInvertedReindexer) reindexProperties(ctx context.Context, reindexa
 				WithField("shard", r.shard.Name()).
 				Debugf("iterating through objects: %d done", i)
 		}
+		release, err := r.shard.Index().Config.Scheduler.Acquire(ctx, scheduler.Bulk)
+		if err != nil {
+			return errors.Wrap(err, "wait for scheduler slot")
+		}
+		defer release()
+
 		docID := object.DocID()
 		properties, nilProperties, err := r.shard.AnalyzeObject(object)
 		if err != nil {
@@ -387,10 +397,7 @@ func (r *ShardInvertedReindexer) handleNilProperty(ctx context.Context, checker
 	}
 
 	if r.shard.Index().invertedIndexConfig.IndexNullState {
-		key, err := r.shard.keyPropertyNull(true)
-		if err != nil {
-			return errors.Wrapf(err, "failed creating key for prop '%s' null", nilProperty.Name)
-		}
+		key := nilProperty.nullStateKey()
 		if checker.isReindexable(nilProperty.Name, IndexTypePropNull) {
 			bucketNull := r.tempBucket(nilProperty.Name, IndexTypePropNull)
 			if bucketNull == nil {
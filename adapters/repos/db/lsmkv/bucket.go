@@ -26,6 +26,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -60,6 +61,9 @@ type Bucket struct {
 	// Optional to avoid syscalls
 	mmapContents bool
 
+	// readMode of segment files if they are not mmapped, see WithReadMode
+	readMode diskio.ReadMode
+
 	// for backward compatibility
 	legacyMapSortingBeforeCompaction bool
 
@@ -102,6 +106,20 @@ type Bucket struct {
 	calcCountNetAdditions bool
 
 	forceCompaction bool
+
+	// compactionConfig is looked up before every compaction, so that changes
+	// apply to running buckets. Nil compacts size-tiered without limits.
+	compactionConfig func() CompactionConfig
+
+	// compression is the codec values are compressed with when segments are
+	// written, empty or "none" writes them uncompressed. Segments written
+	// with a different setting remain readable.
+	compression string
+	compressor  *valueCompressor
+
+	// tiering moves cold segments to a tiered storage, nil keeps all segments
+	// on local disk
+	tiering *segmentTiering
 }
 
 // NewBucket initializes a new bucket. It either loads the state from disk if
@@ -148,6 +166,17 @@ func NewBucket(ctx context.Context, dir, rootDir string, logger logrus.FieldLogg
 		b.memtableThreshold = uint64(b.memtableResizer.Initial())
 	}
 
+	if b.compression != "" && b.compression != CompressionNone {
+		if b.strategy == StrategyRoaringSet {
+			return nil, fmt.Errorf("compression is not supported for strategy %s", b.strategy)
+		}
+		compressor, err := newValueCompressor(b.compression)
+		if err != nil {
+			return nil, err
+		}
+		b.compressor = compressor
+	}
+
 	sg, err := newSegmentGroup(logger, metrics, compactionCallbacks,
 		sgConfig{
 			dir:                   dir,
@@ -155,8 +184,12 @@ func NewBucket(ctx context.Context, dir, rootDir string, logger logrus.FieldLogg
 			mapRequiresSorting:    b.legacyMapSortingBeforeCompaction,
 			monitorCount:          b.monitorCount,
 			mmapContents:          b.mmapContents,
+			readMode:              b.readMode,
 			keepTombstones:        b.keepTombstones,
 			forceCompaction:       b.forceCompaction,
+			compactionConfig:      b.compactionConfig,
+			compressor:            b.compressor,
+			tiering:               b.tiering,
 			useBloomFilter:        b.useBloomFilter,
 			calcCountNetAdditions: b.calcCountNetAdditions,
 		})
@@ -765,6 +798,7 @@ func (b *Bucket) setNewActiveMemtable() error {
 	if err != nil {
 		return err
 	}
+	mt.compressor = b.compressor
 
 	b.active = mt
 	return nil
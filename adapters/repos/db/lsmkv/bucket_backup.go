@@ -68,13 +68,18 @@ func (b *Bucket) FlushMemtable() error {
 
 // ListFiles lists all files that currently exist in the Bucket. The files are only
 // in a stable state if the memtable is empty, and if compactions are paused. If one
-// of those conditions is not given, it errors
+// of those conditions is not given, it errors. Tiered segments are fetched first,
+// so the files contain the whole bucket.
 func (b *Bucket) ListFiles(ctx context.Context, basePath string) ([]string, error) {
 	var (
 		bucketRoot = b.disk.dir
 		files      []string
 	)
 
+	if err := b.disk.fetchTiered(); err != nil {
+		return nil, errors.Wrap(err, "fetch tiered segments")
+	}
+
 	err := filepath.WalkDir(bucketRoot, func(currPath string, d fs.DirEntry, err error) error {
 		if d.IsDir() {
 			return nil
@@ -83,6 +88,11 @@ func (b *Bucket) ListFiles(ctx context.Context, basePath string) ([]string, erro
 		if filepath.Ext(currPath) == ".wal" {
 			return nil
 		}
+		// ignore markers of tiered segments, all of them were fetched, so the
+		// copy is independent of the tiered storage
+		if filepath.Ext(currPath) == ".tiered" {
+			return nil
+		}
 		files = append(files, path.Join(basePath, path.Base(currPath)))
 		return nil
 	})
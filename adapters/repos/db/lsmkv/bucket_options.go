@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 type BucketOption func(b *Bucket) error
@@ -75,6 +76,16 @@ func WithPread(with bool) BucketOption {
 	}
 }
 
+// WithReadMode reads the segments of the bucket with the given mode instead of
+// mmapping them. Modes which are not supported fall back to pread.
+func WithReadMode(mode diskio.ReadMode) BucketOption {
+	return func(b *Bucket) error {
+		b.mmapContents = false
+		b.readMode = mode
+		return nil
+	}
+}
+
 func WithDynamicMemtableSizing(
 	initialMB, maxMB, minActiveSeconds, maxActiveSeconds int,
 ) BucketOption {
@@ -92,6 +103,45 @@ func WithDynamicMemtableSizing(
 	}
 }
 
+// WithCompactionConfig sets the strategy and write rate of compactions, the
+// config is looked up before every compaction
+func WithCompactionConfig(cfg func() CompactionConfig) BucketOption {
+	return func(b *Bucket) error {
+		b.compactionConfig = cfg
+		return nil
+	}
+}
+
+// WithTieredStorage moves segments to the storage once they were neither
+// written nor read for coldAfter, and fetches them again on their next read.
+// Keys of segments are prefixed with keyPrefix.
+func WithTieredStorage(storage TieredStorage, keyPrefix string, coldAfter time.Duration) BucketOption {
+	return func(b *Bucket) error {
+		if coldAfter <= 0 {
+			return errors.Errorf("tiered storage: cold after must be positive")
+		}
+		b.tiering = &segmentTiering{
+			storage:   storage,
+			keyPrefix: keyPrefix,
+			coldAfter: coldAfter,
+		}
+		return nil
+	}
+}
+
+// WithCompression sets the codec values are compressed with when segments
+// are written, see CompressionZstd and CompressionSnappy. It is not supported
+// for the roaringset strategy.
+func WithCompression(name string) BucketOption {
+	return func(b *Bucket) error {
+		if !ValidCompression(name) {
+			return errors.Errorf("unknown compression %q", name)
+		}
+		b.compression = name
+		return nil
+	}
+}
+
 type secondaryIndexKeys [][]byte
 
 type SecondaryKeyOption func(s secondaryIndexKeys) error
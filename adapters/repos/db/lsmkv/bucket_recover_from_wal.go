@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/startup"
 )
 
 func (b *Bucket) recoverFromCommitLogs(ctx context.Context) error {
@@ -41,6 +42,7 @@ func (b *Bucket) recoverFromCommitLogs(ctx context.Context) error {
 	}
 
 	var walFileNames []string
+	var walSizes []int64
 	for _, fileInfo := range list {
 		if filepath.Ext(fileInfo.Name()) != ".wal" {
 			// skip, this could be disk segments, etc.
@@ -53,6 +55,7 @@ func (b *Bucket) recoverFromCommitLogs(ctx context.Context) error {
 		}
 
 		walFileNames = append(walFileNames, fileInfo.Name())
+		walSizes = append(walSizes, walSize(fileInfo))
 	}
 
 	if len(walFileNames) == 0 {
@@ -60,8 +63,13 @@ func (b *Bucket) recoverFromCommitLogs(ctx context.Context) error {
 		return nil
 	}
 
+	progress := startup.FromContext(ctx)
+	for _, size := range walSizes {
+		progress.AddWAL(size)
+	}
+
 	// recover from each log
-	for _, fname := range walFileNames {
+	for i, fname := range walFileNames {
 		b.logger.WithField("action", "lsm_recover_from_active_wal").
 			WithField("path", filepath.Join(b.dir, fname)).
 			Warning("active write-ahead-log found. Did weaviate crash prior to this? Trying to recover...")
@@ -73,6 +81,7 @@ func (b *Bucket) recoverFromCommitLogs(ctx context.Context) error {
 		b.logger.WithField("action", "lsm_recover_from_active_wal_success").
 			WithField("path", filepath.Join(b.dir, fname)).
 			Info("successfully recovered from write-ahead-log")
+		progress.ReplayedWAL(walSizes[i])
 	}
 
 	if b.active.size > 0 {
@@ -92,6 +101,16 @@ func (b *Bucket) recoverFromCommitLogs(ctx context.Context) error {
 	return nil
 }
 
+// walSize is 0 if the file was removed in the meantime, it is only used to
+// report the progress of the recovery
+func walSize(entry os.DirEntry) int64 {
+	info, err := entry.Info()
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
 func (b *Bucket) parseWALIntoMemtable(fname string) error {
 	// pause commit logging while reading the old log to avoid creating a
 	// duplicate of the log
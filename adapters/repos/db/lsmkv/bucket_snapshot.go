@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrSnapshotReleased is returned when a snapshot is read after it was
+// released or after its bucket was shut down
+var ErrSnapshotReleased = errors.New("snapshot was released")
+
+// Snapshot is a consistent point-in-time view of a bucket with the replace
+// strategy, writes to the bucket after the snapshot was taken are not
+// visible through it. It consists of the disk segments at that time and a
+// copy of the memtables.
+//
+// The segments of the bucket are neither compacted nor evicted to tiered
+// storage while a snapshot is open, so it must be released using Release().
+type Snapshot struct {
+	sg        *SegmentGroup
+	segments  []*segment
+	memtables [][]*binarySearchNode
+
+	sync.Mutex
+	released bool
+}
+
+// Snapshot takes a snapshot of a bucket with the replace strategy
+func (b *Bucket) Snapshot() *Snapshot {
+	if b.strategy != StrategyReplace {
+		panic("Snapshot() called on strategy other than 'replace'")
+	}
+
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	// with the flush-RLock neither the segments nor the memtables can be
+	// switched, so the segments and memtables form a consistent state
+	b.disk.maintenanceLock.RLock()
+	segments := make([]*segment, len(b.disk.segments))
+	copy(segments, b.disk.segments)
+	b.disk.snapshots.Add(1)
+	b.disk.maintenanceLock.RUnlock()
+
+	var memtables [][]*binarySearchNode
+	if b.flushing != nil {
+		memtables = append(memtables, b.flushing.snapshot())
+	}
+	memtables = append(memtables, b.active.snapshot())
+
+	return &Snapshot{
+		sg:        b.disk,
+		segments:  segments,
+		memtables: memtables,
+	}
+}
+
+// snapshot copies the nodes of the memtable, the nodes of the tree are
+// updated in place, their keys and values are not
+func (m *Memtable) snapshot() []*binarySearchNode {
+	m.RLock()
+	defer m.RUnlock()
+
+	nodes := m.key.flattenInOrder()
+	out := make([]*binarySearchNode, len(nodes))
+	for i, node := range nodes {
+		out[i] = &binarySearchNode{
+			key:       node.key,
+			value:     node.value,
+			tombstone: node.tombstone,
+		}
+	}
+	return out
+}
+
+// Cursor iterates over the snapshot. The cursor must be closed using Close()
+// before the snapshot is released.
+func (s *Snapshot) Cursor() (*CursorReplace, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.released {
+		return nil, ErrSnapshotReleased
+	}
+
+	// the RLock prevents the segments from being closed by a shutdown of the
+	// bucket while they are read
+	s.sg.maintenanceLock.RLock()
+	if s.sg.segments == nil && len(s.segments) > 0 {
+		s.sg.maintenanceLock.RUnlock()
+		return nil, ErrSnapshotReleased
+	}
+
+	innerCursors := make([]innerCursorReplace, 0, len(s.segments)+len(s.memtables))
+	for _, seg := range s.segments {
+		innerCursors = append(innerCursors, seg.newCursor())
+	}
+	for _, nodes := range s.memtables {
+		innerCursors = append(innerCursors, &memtableCursor{
+			data:   nodes,
+			lock:   func() {},
+			unlock: func() {},
+		})
+	}
+
+	return &CursorReplace{
+		innerCursors: innerCursors,
+		unlock:       s.sg.maintenanceLock.RUnlock,
+	}, nil
+}
+
+// Release releases the snapshot, the segments it held can be compacted
+// again. Releasing a snapshot more than once is a no-op.
+func (s *Snapshot) Release() {
+	s.Lock()
+	defer s.Unlock()
+	if s.released {
+		return
+	}
+	s.released = true
+	s.segments = nil
+	s.memtables = nil
+	s.sg.snapshots.Add(-1)
+}
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestBucketSnapshot(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%02d", i)) }
+	readAll := func(t *testing.T, snap *Snapshot) map[string]string {
+		c, err := snap.Cursor()
+		require.Nil(t, err)
+		defer c.Close()
+		out := map[string]string{}
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			out[string(k)] = string(v)
+		}
+		return out
+	}
+
+	// segments of the snapshot
+	for i := 0; i < 4; i++ {
+		require.Nil(t, b.Put(key(i), []byte("v1")))
+		require.Nil(t, b.FlushAndSwitch())
+	}
+	// memtable of the snapshot
+	require.Nil(t, b.Put(key(4), []byte("v1")))
+	require.Nil(t, b.Delete(key(3)))
+
+	snap := b.Snapshot()
+	expected := map[string]string{
+		"key-00": "v1", "key-01": "v1", "key-02": "v1", "key-04": "v1",
+	}
+
+	t.Run("writes after the snapshot are not visible", func(t *testing.T) {
+		require.Nil(t, b.Put(key(0), []byte("v2")))
+		require.Nil(t, b.Put(key(4), []byte("v2")))
+		require.Nil(t, b.Put(key(5), []byte("v2")))
+		require.Nil(t, b.Delete(key(1)))
+
+		assert.Equal(t, expected, readAll(t, snap))
+	})
+
+	t.Run("flushes after the snapshot", func(t *testing.T) {
+		require.Nil(t, b.FlushAndSwitch())
+
+		assert.Equal(t, expected, readAll(t, snap))
+	})
+
+	t.Run("segments are not compacted while the snapshot is open", func(t *testing.T) {
+		segments := b.disk.Len()
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		assert.False(t, compacted)
+		assert.Equal(t, segments, b.disk.Len())
+
+		assert.Equal(t, expected, readAll(t, snap))
+	})
+
+	t.Run("released snapshot", func(t *testing.T) {
+		snap.Release()
+		snap.Release()
+
+		_, err := snap.Cursor()
+		assert.ErrorIs(t, err, ErrSnapshotReleased)
+
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		assert.True(t, compacted)
+	})
+
+	t.Run("bucket reads the latest state", func(t *testing.T) {
+		v, err := b.Get(key(0))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("v2"), v)
+		v, err = b.Get(key(1))
+		require.Nil(t, err)
+		assert.Nil(t, v)
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 type bucketTest struct {
@@ -39,6 +40,12 @@ func (tests bucketTests) run(ctx context.Context, t *testing.T) {
 			t.Run("pread", func(t *testing.T) {
 				test.f(ctx, t, append([]BucketOption{WithPread(true)}, test.opts...))
 			})
+			t.Run("io_uring", func(t *testing.T) {
+				test.f(ctx, t, append([]BucketOption{WithReadMode(diskio.ReadModeIOUring)}, test.opts...))
+			})
+			t.Run("direct", func(t *testing.T) {
+				test.f(ctx, t, append([]BucketOption{WithReadMode(diskio.ReadModeDirect)}, test.opts...))
+			})
 		})
 	}
 }
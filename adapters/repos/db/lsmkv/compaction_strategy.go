@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"io"
+	"time"
+)
+
+// Segments are compacted pairwise, the compaction strategy decides which
+// pair is compacted next.
+//
+// Size-tiered only compacts two segments of the same level, i.e. of a
+// similar size. Every entry is rewritten once per level, which keeps the
+// write amplification low, but the number of segments a read has to search
+// grows with the number of levels.
+//
+// Leveled additionally compacts the newest segment into the one before it as
+// soon as it has grown to a fraction of its size. This keeps few segments of
+// geometrically growing sizes, reads search fewer segments at the cost of
+// rewriting more data.
+const (
+	CompactionStrategySizeTiered = "sizeTiered"
+	CompactionStrategyLeveled    = "leveled"
+)
+
+// leveledSizeRatio is the ratio of the sizes of adjacent segments the
+// leveled strategy aims for
+const leveledSizeRatio = 10
+
+// CompactionConfig of a bucket, the zero value compacts size-tiered without
+// limiting the write rate
+type CompactionConfig struct {
+	Strategy string
+	// MaxBytesPerSecond limits the rate at which compacted segments are
+	// written, <= 0 disables the limit
+	MaxBytesPerSecond int64
+}
+
+// leveledCandidatePair returns the two newest segments if the newest one has
+// grown to at least 1/leveledSizeRatio of the size of the one before. The
+// first offset segments are tiered and not considered. It must be called
+// while holding the maintenance lock.
+func (sg *SegmentGroup) leveledCandidatePair(offset int) []int {
+	n := len(sg.segments)
+	if n-offset < 2 {
+		return nil
+	}
+	if sg.segments[n-1].size*leveledSizeRatio < sg.segments[n-2].size {
+		return nil
+	}
+	return []int{n - 2, n - 1}
+}
+
+func (sg *SegmentGroup) currentCompactionConfig() CompactionConfig {
+	if sg.compactionConfig == nil {
+		return CompactionConfig{}
+	}
+	return sg.compactionConfig()
+}
+
+// throttledWriter limits the rate at which a compacted segment is written,
+// so that compactions during heavy imports do not starve reads and flushes
+// of disk bandwidth
+type throttledWriter struct {
+	io.WriteSeeker
+	bytesPerSecond int64
+	start          time.Time
+	written        int64
+}
+
+func newThrottledWriter(w io.WriteSeeker, bytesPerSecond int64) *throttledWriter {
+	return &throttledWriter{WriteSeeker: w, bytesPerSecond: bytesPerSecond, start: time.Now()}
+}
+
+func (w *throttledWriter) Write(p []byte) (int, error) {
+	n, err := w.WriteSeeker.Write(p)
+	w.written += int64(n)
+	due := time.Duration(float64(w.written) / float64(w.bytesPerSecond) * float64(time.Second))
+	if ahead := due - time.Since(w.start); ahead > 0 {
+		time.Sleep(ahead)
+	}
+	return n, err
+}
@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactionCandidatePair(t *testing.T) {
+	segments := func(levelsAndSizes ...int64) []*segment {
+		var segs []*segment
+		for i := 0; i < len(levelsAndSizes); i += 2 {
+			segs = append(segs, &segment{level: uint16(levelsAndSizes[i]), size: levelsAndSizes[i+1]})
+		}
+		return segs
+	}
+	config := func(strategy string) func() CompactionConfig {
+		return func() CompactionConfig { return CompactionConfig{Strategy: strategy} }
+	}
+
+	tests := []struct {
+		name     string
+		segments []*segment
+		strategy string
+		expected []int
+	}{
+		{
+			name:     "size-tiered compacts segments of the same level",
+			segments: segments(3, 8000, 1, 2000, 1, 2000),
+			strategy: CompactionStrategySizeTiered,
+			expected: []int{1, 2},
+		},
+		{
+			name:     "size-tiered waits for segments of the same level",
+			segments: segments(3, 8000, 0, 1000),
+			strategy: CompactionStrategySizeTiered,
+		},
+		{
+			name:     "leveled compacts segments of the same level first",
+			segments: segments(3, 8000, 1, 2000, 1, 2000),
+			strategy: CompactionStrategyLeveled,
+			expected: []int{1, 2},
+		},
+		{
+			name:     "leveled compacts the newest segment into the one before",
+			segments: segments(3, 8000, 0, 1000),
+			strategy: CompactionStrategyLeveled,
+			expected: []int{0, 1},
+		},
+		{
+			name:     "leveled waits for the newest segment to grow",
+			segments: segments(3, 80000, 0, 1000),
+			strategy: CompactionStrategyLeveled,
+		},
+		{
+			name:     "no config compacts size-tiered",
+			segments: segments(3, 8000, 0, 1000),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sg := &SegmentGroup{segments: test.segments}
+			if test.strategy != "" {
+				sg.compactionConfig = config(test.strategy)
+			}
+			assert.Equal(t, test.expected, sg.bestCompactionCandidatePair())
+		})
+	}
+}
+
+func TestThrottledWriter(t *testing.T) {
+	f, err := os.Create(filepath.Join(t.TempDir(), "segment.db.tmp"))
+	require.Nil(t, err)
+	defer f.Close()
+
+	w := newThrottledWriter(f, 1000)
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		_, err := w.Write(make([]byte, 50))
+		require.Nil(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+
+	info, err := f.Stat()
+	require.Nil(t, err)
+	assert.Equal(t, int64(200), info.Size())
+}
+
+func TestWriteAmplification(t *testing.T) {
+	sg := &SegmentGroup{}
+	assert.Equal(t, float64(0), sg.writeAmplification())
+
+	sg.flushedBytes = 1000
+	sg.compactedBytes = 2500
+	assert.Equal(t, 3.5, sg.writeAmplification())
+}
@@ -40,6 +40,9 @@ type compactorMap struct {
 
 	scratchSpacePath string
 
+	// compressor is nil if values are written uncompressed
+	compressor *valueCompressor
+
 	// for backward-compatibility with states where the disk state for maps was
 	// not guaranteed to be sorted yet
 	requiresSorting bool
@@ -222,11 +225,14 @@ func (c *compactorMap) writeIndividualNode(offset int, key []byte,
 	keyCopy := make([]byte, len(key))
 	copy(keyCopy, key)
 
-	return segmentCollectionNode{
+	segNode := &segmentCollectionNode{
 		values:     values,
 		primaryKey: keyCopy,
 		offset:     offset,
-	}.KeyIndexAndWriteTo(c.bufw)
+	}
+	segNode.compressValues(c.compressor)
+
+	return segNode.KeyIndexAndWriteTo(c.bufw)
 }
 
 func (c *compactorMap) writeIndices(keys []segmentindex.Key) error {
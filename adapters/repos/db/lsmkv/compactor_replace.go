@@ -39,6 +39,9 @@ type compactorReplace struct {
 	w                io.WriteSeeker
 	bufw             *bufio.Writer
 	scratchSpacePath string
+
+	// compressor is nil if values are written uncompressed
+	compressor *valueCompressor
 }
 
 func newCompactorReplace(w io.WriteSeeker,
@@ -173,6 +176,7 @@ func (c *compactorReplace) writeIndividualNode(offset int, key, value []byte,
 		secondaryIndexCount: c.secondaryIndexCount,
 		secondaryKeys:       secondaryKeys,
 	}
+	segNode.compressValue(c.compressor)
 
 	return segNode.KeyIndexAndWriteTo(c.bufw)
 }
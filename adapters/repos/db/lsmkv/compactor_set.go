@@ -38,6 +38,9 @@ type compactorSet struct {
 	bufw *bufio.Writer
 
 	scratchSpacePath string
+
+	// compressor is nil if values are written uncompressed
+	compressor *valueCompressor
 }
 
 func newCompactorSetCollection(w io.WriteSeeker,
@@ -164,11 +167,14 @@ func (c *compactorSet) writeKeys() ([]segmentindex.Key, error) {
 func (c *compactorSet) writeIndividualNode(offset int, key []byte,
 	values []value,
 ) (segmentindex.Key, error) {
-	return (&segmentCollectionNode{
+	segNode := &segmentCollectionNode{
 		values:     values,
 		primaryKey: key,
 		offset:     offset,
-	}).KeyIndexAndWriteTo(c.bufw)
+	}
+	segNode.compressValues(c.compressor)
+
+	return segNode.KeyIndexAndWriteTo(c.bufw)
 }
 
 func (c *compactorSet) writeIndices(keys []segmentindex.Key) error {
@@ -21,6 +21,7 @@ type segmentCursorCollection struct {
 }
 
 func (s *segment) newCollectionCursor() *segmentCursorCollection {
+	s.mustEnsureLoaded()
 	return &segmentCursorCollection{
 		segment: s,
 	}
@@ -22,6 +22,7 @@ type segmentCursorCollectionReusable struct {
 }
 
 func (s *segment) newCollectionCursorReusable() *segmentCursorCollectionReusable {
+	s.mustEnsureLoaded()
 	return &segmentCursorCollectionReusable{
 		segment: s,
 	}
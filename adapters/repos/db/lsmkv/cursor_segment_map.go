@@ -19,6 +19,7 @@ type segmentCursorMap struct {
 }
 
 func (s *segment) newMapCursor() *segmentCursorMap {
+	s.mustEnsureLoaded()
 	return &segmentCursorMap{
 		segment: s,
 	}
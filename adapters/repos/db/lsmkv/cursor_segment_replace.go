@@ -24,6 +24,7 @@ type segmentCursorReplace struct {
 }
 
 func (s *segment) newCursor() *segmentCursorReplace {
+	s.mustEnsureLoaded()
 	return &segmentCursorReplace{
 		segment:      s,
 		reusableNode: &segmentReplaceNode{},
@@ -17,6 +17,7 @@ import (
 )
 
 func (s *segment) newRoaringSetCursor() *roaringset.SegmentCursor {
+	s.mustEnsureLoaded()
 	return roaringset.NewSegmentCursor(s.contents[s.dataStartPos:s.dataEndPos],
 		&roaringSetSeeker{s.index})
 }
@@ -37,6 +37,7 @@ type Memtable struct {
 	lastWrite          time.Time
 	createdAt          time.Time
 	metrics            *memtableMetrics
+	compressor         *valueCompressor // nil if values are flushed uncompressed
 }
 
 func newMemtable(path string, strategy string,
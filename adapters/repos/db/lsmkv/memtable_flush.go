@@ -19,6 +19,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 func (m *Memtable) flush() error {
@@ -93,6 +94,11 @@ func (m *Memtable) flush() error {
 		return err
 	}
 
+	if err := diskio.WriteBlockChecksums(m.path+".db",
+		checksumsPathFromSegmentPath(m.path+".db")); err != nil {
+		return errors.Wrap(err, "write checksums")
+	}
+
 	// only now that the file has been flushed is it safe to delete the commit log
 	// TODO: there might be an interest in keeping the commit logs around for
 	// longer as they might come in handy for replication
@@ -102,7 +108,19 @@ func (m *Memtable) flush() error {
 func (m *Memtable) flushDataReplace(f io.Writer) ([]segmentindex.Key, error) {
 	flat := m.key.flattenInOrder()
 
+	segNodes := make([]*segmentReplaceNode, len(flat))
 	totalDataLength := totalKeyAndValueSize(flat)
+	for i, node := range flat {
+		segNodes[i] = &segmentReplaceNode{
+			tombstone:           node.tombstone,
+			value:               node.value,
+			primaryKey:          node.key,
+			secondaryKeys:       node.secondaryKeys,
+			secondaryIndexCount: m.secondaryIndices,
+		}
+		totalDataLength -= segNodes[i].compressValue(m.compressor)
+	}
+
 	perObjectAdditions := len(flat) * (1 + 8 + 4 + int(m.secondaryIndices)*4) // 1 byte for the tombstone, 8 bytes value length encoding, 4 bytes key length encoding, + 4 bytes key encoding for every secondary index
 	headerSize := segmentindex.HeaderSize
 	header := segmentindex.Header{
@@ -121,16 +139,8 @@ func (m *Memtable) flushDataReplace(f io.Writer) ([]segmentindex.Key, error) {
 	keys := make([]segmentindex.Key, len(flat))
 
 	totalWritten := headerSize
-	for i, node := range flat {
-		segNode := &segmentReplaceNode{
-			offset:              totalWritten,
-			tombstone:           node.tombstone,
-			value:               node.value,
-			primaryKey:          node.key,
-			secondaryKeys:       node.secondaryKeys,
-			secondaryIndexCount: m.secondaryIndices,
-		}
-
+	for i, segNode := range segNodes {
+		segNode.offset = totalWritten
 		ki, err := segNode.KeyIndexAndWriteTo(f)
 		if err != nil {
 			return nil, errors.Wrapf(err, "write node %d", i)
@@ -181,7 +191,16 @@ func (m *Memtable) flushDataMap(f io.Writer) ([]segmentindex.Key, error) {
 func (m *Memtable) flushDataCollection(f io.Writer,
 	flat []*binarySearchNodeMulti,
 ) ([]segmentindex.Key, error) {
+	segNodes := make([]*segmentCollectionNode, len(flat))
 	totalDataLength := totalValueSizeCollection(flat)
+	for i, node := range flat {
+		segNodes[i] = &segmentCollectionNode{
+			values:     node.values,
+			primaryKey: node.key,
+		}
+		totalDataLength -= segNodes[i].compressValues(m.compressor)
+	}
+
 	header := segmentindex.Header{
 		IndexStart:       uint64(totalDataLength + segmentindex.HeaderSize),
 		Level:            0, // always level zero on a new one
@@ -198,12 +217,9 @@ func (m *Memtable) flushDataCollection(f io.Writer,
 	keys := make([]segmentindex.Key, len(flat))
 
 	totalWritten := headerSize
-	for i, node := range flat {
-		ki, err := (&segmentCollectionNode{
-			values:     node.values,
-			primaryKey: node.key,
-			offset:     totalWritten,
-		}).KeyIndexAndWriteTo(f)
+	for i, segNode := range segNodes {
+		segNode.offset = totalWritten
+		ki, err := segNode.KeyIndexAndWriteTo(f)
 		if err != nil {
 			return nil, errors.Wrapf(err, "write node %d", i)
 		}
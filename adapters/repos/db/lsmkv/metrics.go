@@ -40,6 +40,9 @@ type Metrics struct {
 	memtableDurations    prometheus.ObserverVec
 	memtableSize         *prometheus.GaugeVec
 	DimensionSum         *prometheus.GaugeVec
+	flushedBytes         *prometheus.CounterVec
+	compactedBytes       *prometheus.CounterVec
+	WriteAmplification   *prometheus.GaugeVec
 
 	groupClasses bool
 }
@@ -47,10 +50,10 @@ type Metrics struct {
 func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
 	shardName string,
 ) *Metrics {
-	if promMetrics.Group {
-		className = "n/a"
-		shardName = "n/a"
-	}
+	grouped := promMetrics.ClassLabel(className) != className ||
+		promMetrics.ShardLabel(shardName) != shardName
+	className = promMetrics.ClassLabel(className)
+	shardName = promMetrics.ShardLabel(shardName)
 
 	replace := promMetrics.AsyncOperations.MustCurryWith(prometheus.Labels{
 		"operation":  "compact_lsm_segments_stratreplace",
@@ -77,7 +80,7 @@ func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
 	})
 
 	return &Metrics{
-		groupClasses:         promMetrics.Group,
+		groupClasses:         grouped,
 		CompactionReplace:    replace,
 		CompactionSet:        set,
 		CompactionMap:        stratMap,
@@ -126,6 +129,18 @@ func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
 			"class_name": className,
 			"shard_name": shardName,
 		}),
+		flushedBytes: promMetrics.LSMFlushedBytes.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
+		compactedBytes: promMetrics.LSMCompactedBytes.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
+		WriteAmplification: promMetrics.LSMWriteAmplification.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
 	}
 }
 
@@ -229,3 +244,19 @@ func (m *Metrics) ObjectCount(count int) {
 
 	m.objectCount.Set(float64(count))
 }
+
+func (m *Metrics) TrackFlushedBytes(strategy string, size int64) {
+	if m == nil {
+		return
+	}
+
+	m.flushedBytes.With(prometheus.Labels{"strategy": strategy}).Add(float64(size))
+}
+
+func (m *Metrics) TrackCompactedBytes(strategy string, size int64) {
+	if m == nil {
+		return
+	}
+
+	m.compactedBytes.With(prometheus.Labels{"strategy": strategy}).Add(float64(size))
+}
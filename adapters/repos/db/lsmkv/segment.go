@@ -17,10 +17,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/edsrzf/mmap-go"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/willf/bloom"
 )
@@ -35,7 +37,7 @@ type segment struct {
 	dataStartPos        uint64
 	dataEndPos          uint64
 	contents            []byte
-	contentFile         *os.File
+	contentFile         diskio.ReaderAt
 	strategy            segmentindex.Strategy
 	index               diskIndex
 	secondaryIndices    []diskIndex
@@ -43,6 +45,7 @@ type segment struct {
 	metrics             *Metrics
 	size                int64
 	mmapContents        bool
+	readMode            diskio.ReadMode // how contentFile is read without mmap
 
 	useBloomFilter        bool // see bucket for more datails
 	bloomFilter           *bloom.BloomFilter
@@ -52,6 +55,12 @@ type segment struct {
 	// the net addition this segment adds with respect to all previous segments
 	calcCountNetAdditions bool // see bucket for more datails
 	countNetAdditions     int
+
+	// modTime of the segment file and size of its primary index are kept, as
+	// both are needed while a tiered segment is not on local disk
+	modTime   time.Time
+	indexSize int
+	tier      *segmentTier // nil unless the bucket uses tiered storage
 }
 
 type diskIndex interface {
@@ -71,96 +80,141 @@ type diskIndex interface {
 }
 
 func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
-	existsLower existsOnLowerSegmentsFn, mmapContents bool,
+	existsLower existsOnLowerSegmentsFn, mmapContents bool, readMode diskio.ReadMode,
 	useBloomFilter bool, calcCountNetAdditions bool,
 ) (*segment, error) {
-	file, err := os.Open(path)
+	seg := &segment{
+		path:                  path,
+		logger:                logger,
+		metrics:               metrics,
+		mmapContents:          mmapContents,
+		readMode:              readMode,
+		useBloomFilter:        useBloomFilter,
+		calcCountNetAdditions: calcCountNetAdditions,
+	}
+
+	header, fileInfo, err := seg.mount()
 	if err != nil {
-		return nil, fmt.Errorf("open file: %w", err)
+		return nil, err
+	}
+
+	seg.setHeader(header, fileInfo.Size())
+	seg.modTime = fileInfo.ModTime()
+	seg.indexSize = seg.index.Size()
+
+	if err := seg.initFilters(metrics, existsLower); err != nil {
+		return nil, err
+	}
+
+	return seg, nil
+}
+
+// mount maps the segment file into memory (or keeps it open for pread) and
+// parses its indexes
+func (s *segment) mount() (*segmentindex.Header, os.FileInfo, error) {
+	file, err := os.Open(s.path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open file: %w", err)
 	}
 
 	fileInfo, err := file.Stat()
 	if err != nil {
-		return nil, fmt.Errorf("stat file: %w", err)
+		file.Close()
+		return nil, nil, fmt.Errorf("stat file: %w", err)
 	}
 
 	contents, err := mmap.MapRegion(file, int(fileInfo.Size()), mmap.RDONLY, 0, 0)
 	if err != nil {
-		return nil, fmt.Errorf("mmap file: %w", err)
+		file.Close()
+		return nil, nil, fmt.Errorf("mmap file: %w", err)
+	}
+
+	header, primary, secondaries, err := parseSegmentIndexes(contents)
+	if err != nil {
+		contents.Unmap()
+		file.Close()
+		return nil, nil, err
 	}
 
+	s.contents = contents
+	s.index = primary
+	s.secondaryIndices = secondaries
+
+	// Using pread strategy requires file to remain open for segment lifetime
+	if s.mmapContents {
+		file.Close()
+	} else {
+		s.contentFile = diskio.NewReaderAt(file, s.readMode)
+	}
+
+	return header, fileInfo, nil
+}
+
+func parseSegmentIndexes(contents []byte) (*segmentindex.Header, diskIndex, []diskIndex, error) {
 	header, err := segmentindex.ParseHeader(bytes.NewReader(contents[:segmentindex.HeaderSize]))
 	if err != nil {
-		return nil, fmt.Errorf("parse header: %w", err)
+		return nil, nil, nil, fmt.Errorf("parse header: %w", err)
 	}
 
 	switch header.Strategy {
 	case segmentindex.StrategyReplace, segmentindex.StrategySetCollection,
 		segmentindex.StrategyMapCollection, segmentindex.StrategyRoaringSet:
 	default:
-		return nil, fmt.Errorf("unsupported strategy in segment")
+		return nil, nil, nil, fmt.Errorf("unsupported strategy in segment")
 	}
 
 	primaryIndex, err := header.PrimaryIndex(contents)
 	if err != nil {
-		return nil, fmt.Errorf("extract primary index position: %w", err)
-	}
-
-	primaryDiskIndex := segmentindex.NewDiskTree(primaryIndex)
-
-	seg := &segment{
-		level:                 header.Level,
-		path:                  path,
-		contents:              contents,
-		version:               header.Version,
-		secondaryIndexCount:   header.SecondaryIndices,
-		segmentStartPos:       header.IndexStart,
-		segmentEndPos:         uint64(fileInfo.Size()),
-		strategy:              header.Strategy,
-		dataStartPos:          segmentindex.HeaderSize, // fixed value that's the same for all strategies
-		dataEndPos:            header.IndexStart,
-		index:                 primaryDiskIndex,
-		logger:                logger,
-		metrics:               metrics,
-		size:                  fileInfo.Size(),
-		mmapContents:          mmapContents,
-		useBloomFilter:        useBloomFilter,
-		calcCountNetAdditions: calcCountNetAdditions,
-	}
-
-	// Using pread strategy requires file to remain open for segment lifetime
-	if seg.mmapContents {
-		defer file.Close()
-	} else {
-		seg.contentFile = file
+		return nil, nil, nil, fmt.Errorf("extract primary index position: %w", err)
 	}
 
-	if seg.secondaryIndexCount > 0 {
-		seg.secondaryIndices = make([]diskIndex, seg.secondaryIndexCount)
-		for i := range seg.secondaryIndices {
+	var secondaries []diskIndex
+	if header.SecondaryIndices > 0 {
+		secondaries = make([]diskIndex, header.SecondaryIndices)
+		for i := range secondaries {
 			secondary, err := header.SecondaryIndex(contents, uint16(i))
 			if err != nil {
-				return nil, fmt.Errorf("get position for secondary index at %d: %w", i, err)
+				return nil, nil, nil, fmt.Errorf("get position for secondary index at %d: %w", i, err)
 			}
-			seg.secondaryIndices[i] = segmentindex.NewDiskTree(secondary)
+			secondaries[i] = segmentindex.NewDiskTree(secondary)
 		}
 	}
 
-	if seg.useBloomFilter {
-		if err := seg.initBloomFilters(metrics); err != nil {
-			return nil, err
+	return header, segmentindex.NewDiskTree(primaryIndex), secondaries, nil
+}
+
+func (s *segment) setHeader(header *segmentindex.Header, size int64) {
+	s.level = header.Level
+	s.version = header.Version
+	s.secondaryIndexCount = header.SecondaryIndices
+	s.segmentStartPos = header.IndexStart
+	s.segmentEndPos = uint64(size)
+	s.strategy = header.Strategy
+	s.dataStartPos = segmentindex.HeaderSize // fixed value that's the same for all strategies
+	s.dataEndPos = header.IndexStart
+	s.size = size
+}
+
+func (s *segment) initFilters(metrics *Metrics, existsLower existsOnLowerSegmentsFn) error {
+	if s.useBloomFilter {
+		if err := s.initBloomFilters(metrics); err != nil {
+			return err
 		}
 	}
-	if seg.calcCountNetAdditions {
-		if err := seg.initCountNetAdditions(existsLower); err != nil {
-			return nil, err
+	if s.calcCountNetAdditions {
+		if err := s.initCountNetAdditions(existsLower); err != nil {
+			return err
 		}
 	}
-
-	return seg, nil
+	return nil
 }
 
 func (s *segment) close() error {
+	if s.contents == nil {
+		// tiered segment which is not on local disk
+		return nil
+	}
+
 	var munmapErr, fileCloseErr error
 
 	m := mmap.MMap(s.contents)
@@ -173,6 +227,10 @@ func (s *segment) close() error {
 		return fmt.Errorf("close segment: munmap: %v, close contents file: %w", munmapErr, fileCloseErr)
 	}
 
+	s.contents = nil
+	s.contentFile = nil
+	s.index = nil
+	s.secondaryIndices = nil
 	return nil
 }
 
@@ -195,6 +253,14 @@ func (s *segment) drop() error {
 		return fmt.Errorf("drop count net additions file: %w", err)
 	}
 
+	if err := os.RemoveAll(s.checksumsPath()); err != nil {
+		return fmt.Errorf("drop checksums file: %w", err)
+	}
+
+	if err := os.RemoveAll(s.tieredMarkerPath()); err != nil {
+		return fmt.Errorf("drop tiered segment marker: %w", err)
+	}
+
 	// for the segment itself, we're not using RemoveAll, but Remove. If there
 	// was a NotExists error here, something would be seriously wrong, and we
 	// don't want to ignore it.
@@ -85,6 +85,10 @@ func (s *segment) initBloomFilter() error {
 }
 
 func (s *segment) computeAndStoreBloomFilter(path string) error {
+	if err := s.ensureLoaded(); err != nil {
+		return err
+	}
+
 	keys, err := s.index.AllKeys()
 	if err != nil {
 		return err
@@ -212,6 +216,10 @@ func (s *segment) initSecondaryBloomFilter(pos int) error {
 }
 
 func (s *segment) computeAndStoreSecondaryBloomFilter(path string, pos int) error {
+	if err := s.ensureLoaded(); err != nil {
+		return err
+	}
+
 	keys, err := s.secondaryIndices[pos].AllKeys()
 	if err != nil {
 		return err
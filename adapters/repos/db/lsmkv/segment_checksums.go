@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+// Segments are immutable, so the checksums of their blocks are stored next
+// to them when they are written by a flush or compaction. Segments written
+// before checksums were introduced get theirs on their first scrub.
+
+func (s *segment) checksumsPath() string {
+	return checksumsPathFromSegmentPath(s.path)
+}
+
+func checksumsPathFromSegmentPath(segPath string) string {
+	extless := strings.TrimSuffix(segPath, filepath.Ext(segPath))
+	return fmt.Sprintf("%s.checksums", extless)
+}
+
+// precomputeChecksums of a compacted segment, path is the .tmp segment
+func (s *segment) precomputeChecksums(path string) ([]string, error) {
+	checksumsPath := fmt.Sprintf("%s.tmp", s.checksumsPath())
+	if err := diskio.WriteBlockChecksums(path, checksumsPath); err != nil {
+		return nil, fmt.Errorf("precompute checksums: %w", err)
+	}
+	return []string{checksumsPath}, nil
+}
+
+// scrub compares all segments with their checksums. Segments which are
+// compacted away in the meantime are skipped.
+func (sg *SegmentGroup) scrub(ctx context.Context) ([]diskio.CorruptFile, error) {
+	sg.maintenanceLock.RLock()
+	paths := make([]string, len(sg.segments))
+	for i, seg := range sg.segments {
+		paths[i] = seg.path
+	}
+	sg.maintenanceLock.RUnlock()
+
+	var corrupt []diskio.CorruptFile
+	for _, path := range paths {
+		if err := ctx.Err(); err != nil {
+			return corrupt, err
+		}
+
+		c, err := diskio.VerifyBlockChecksums(path, checksumsPathFromSegmentPath(path))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return corrupt, fmt.Errorf("verify segment %s: %w", path, err)
+		}
+		if c != nil {
+			corrupt = append(corrupt, *c)
+		}
+	}
+	return corrupt, nil
+}
+
+// Scrub compares the segments of the bucket with their checksums and
+// returns the corrupt ones. The memtable and its write-ahead log are not
+// checked.
+func (b *Bucket) Scrub(ctx context.Context) ([]diskio.CorruptFile, error) {
+	return b.disk.scrub(ctx)
+}
+
+// Scrub compares the segments of all buckets with their checksums and
+// returns the corrupt ones, one bucket at a time to limit the load on disk
+func (s *Store) Scrub(ctx context.Context) ([]diskio.CorruptFile, error) {
+	s.bucketAccessLock.RLock()
+	buckets := make([]*Bucket, 0, len(s.bucketsByName))
+	for _, b := range s.bucketsByName {
+		buckets = append(buckets, b)
+	}
+	s.bucketAccessLock.RUnlock()
+
+	var corrupt []diskio.CorruptFile
+	for _, b := range buckets {
+		c, err := b.Scrub(ctx)
+		corrupt = append(corrupt, c...)
+		if err != nil {
+			return corrupt, fmt.Errorf("scrub bucket %s: %w", b.dir, err)
+		}
+	}
+	return corrupt, nil
+}
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestBucketScrub(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+
+	b, err := NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	for segment := 0; segment < 2; segment++ {
+		for i := 0; i < 100; i++ {
+			key := []byte(fmt.Sprintf("key-%d-%03d", segment, i))
+			require.Nil(t, b.Put(key, []byte(fmt.Sprintf("value-%d", i))))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+	}
+
+	checksumFiles := func() []string {
+		files, err := filepath.Glob(filepath.Join(dir, "*.checksums"))
+		require.Nil(t, err)
+		return files
+	}
+
+	t.Run("flushed segments are checksummed", func(t *testing.T) {
+		assert.Len(t, checksumFiles(), 2)
+
+		corrupt, err := b.Scrub(ctx)
+		require.Nil(t, err)
+		assert.Empty(t, corrupt)
+	})
+
+	t.Run("compacted segments are checksummed", func(t *testing.T) {
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		require.True(t, compacted)
+
+		require.Len(t, b.disk.segments, 1)
+		assert.Equal(t, []string{b.disk.segments[0].checksumsPath()}, checksumFiles())
+
+		corrupt, err := b.Scrub(ctx)
+		require.Nil(t, err)
+		assert.Empty(t, corrupt)
+	})
+
+	t.Run("corrupt segments are reported", func(t *testing.T) {
+		path := b.disk.segments[0].path
+		info, err := os.Stat(path)
+		require.Nil(t, err)
+
+		f, err := os.OpenFile(path, os.O_WRONLY, 0o666)
+		require.Nil(t, err)
+		_, err = f.WriteAt([]byte("corrupt"), 100)
+		require.Nil(t, err)
+		require.Nil(t, f.Close())
+		require.Nil(t, os.Chtimes(path, info.ModTime(), info.ModTime()))
+
+		corrupt, err := b.Scrub(ctx)
+		require.Nil(t, err)
+		require.Len(t, corrupt, 1)
+		assert.Equal(t, path, corrupt[0].Path)
+		assert.Equal(t, []int64{0}, corrupt[0].Offsets)
+	})
+}
@@ -30,6 +30,10 @@ func (s *segment) getCollection(key []byte) ([]value, error) {
 		return nil, lsmkv.NotFound
 	}
 
+	if err := s.ensureLoaded(); err != nil {
+		return nil, err
+	}
+
 	node, err := s.index.Get(key)
 	if err != nil {
 		return nil, err
@@ -61,6 +65,20 @@ func (s *segment) collectionStratParseData(in []byte) ([]value, error) {
 		return nil, lsmkv.NotFound
 	}
 
+	if length := binary.LittleEndian.Uint64(in[0:8]); length&compressedFlag != 0 {
+		plain, err := decompressValue(in[8 : 8+length&^compressedFlag])
+		if err != nil {
+			return nil, err
+		}
+		return parseCollectionValues(plain), nil
+	}
+
+	return parseCollectionValues(in), nil
+}
+
+// parseCollectionValues parses the values of a collection node, starting with
+// their count. The values share the memory of in.
+func parseCollectionValues(in []byte) []value {
 	offset := 0
 
 	valuesLen := binary.LittleEndian.Uint64(in[offset : offset+8])
@@ -81,5 +99,5 @@ func (s *segment) collectionStratParseData(in []byte) ([]value, error) {
 		valueIndex++
 	}
 
-	return values, nil
+	return values
 }
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"fmt"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Values of segments can be compressed. Compression is self-describing: the
+// highest bit of the length of a compressed value is set, and the value
+// starts with a byte identifying the codec. Segments can therefore mix
+// compressed and uncompressed values, and segments written before
+// compression was enabled, or with another codec, remain readable.
+//
+// For "replace" buckets every value is compressed on its own. For "set" and
+// "map" buckets, whose values are typically tiny, all values of a key are
+// compressed together. "roaringset" buckets hold compressed bitmaps already
+// and do not support compression.
+const (
+	CompressionNone   = "none"
+	CompressionZstd   = "zstd"
+	CompressionSnappy = "snappy"
+)
+
+const (
+	// compressedFlag is set on the length of compressed values
+	compressedFlag = uint64(1) << 63
+
+	codecZstd   byte = 1
+	codecSnappy byte = 2
+
+	// minCompressedSize is the minimum size of values worth compressing
+	minCompressedSize = 64
+)
+
+// the encoder and decoder are safe for concurrent use of EncodeAll and
+// DecodeAll
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+)
+
+// ValidCompression returns whether compression names a supported codec
+func ValidCompression(compression string) bool {
+	switch compression {
+	case "", CompressionNone, CompressionZstd, CompressionSnappy:
+		return true
+	default:
+		return false
+	}
+}
+
+// valueCompressor compresses the values of segments written by flushes and
+// compactions. A nil compressor leaves values uncompressed.
+type valueCompressor struct {
+	codec byte
+}
+
+func newValueCompressor(compression string) (*valueCompressor, error) {
+	switch compression {
+	case "", CompressionNone:
+		return nil, nil
+	case CompressionZstd:
+		return &valueCompressor{codec: codecZstd}, nil
+	case CompressionSnappy:
+		return &valueCompressor{codec: codecSnappy}, nil
+	default:
+		return nil, fmt.Errorf("unrecognized compression %q", compression)
+	}
+}
+
+// compress returns the codec byte followed by the compressed value, and
+// false if the value is not worth compressing
+func (c *valueCompressor) compress(in []byte) ([]byte, bool) {
+	if c == nil || len(in) < minCompressedSize {
+		return nil, false
+	}
+
+	out := []byte{c.codec}
+	switch c.codec {
+	case codecZstd:
+		out = zstdEncoder.EncodeAll(in, out)
+	case codecSnappy:
+		out = append(out, snappy.Encode(nil, in)...)
+	}
+	if len(out) >= len(in) {
+		return nil, false
+	}
+	return out, true
+}
+
+// decompressValue decompresses a value written by valueCompressor.compress
+func decompressValue(in []byte) ([]byte, error) {
+	if len(in) == 0 {
+		return nil, fmt.Errorf("decompress value: missing codec")
+	}
+
+	switch in[0] {
+	case codecZstd:
+		out, err := zstdDecoder.DecodeAll(in[1:], nil)
+		if err != nil {
+			return nil, fmt.Errorf("decompress value: %w", err)
+		}
+		return out, nil
+	case codecSnappy:
+		out, err := snappy.Decode(nil, in[1:])
+		if err != nil {
+			return nil, fmt.Errorf("decompress value: %w", err)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("decompress value: unknown codec %d", in[0])
+	}
+}
@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestValueCompressor(t *testing.T) {
+	compressible := bytes.Repeat([]byte("weaviate"), 100)
+
+	for _, compression := range []string{CompressionZstd, CompressionSnappy} {
+		t.Run(compression, func(t *testing.T) {
+			c, err := newValueCompressor(compression)
+			require.Nil(t, err)
+
+			compressed, ok := c.compress(compressible)
+			require.True(t, ok)
+			assert.Less(t, len(compressed), len(compressible))
+
+			decompressed, err := decompressValue(compressed)
+			require.Nil(t, err)
+			assert.Equal(t, compressible, decompressed)
+
+			_, ok = c.compress([]byte("too small"))
+			assert.False(t, ok)
+		})
+	}
+
+	t.Run("none", func(t *testing.T) {
+		c, err := newValueCompressor(CompressionNone)
+		require.Nil(t, err)
+		assert.Nil(t, c)
+
+		_, ok := c.compress(compressible)
+		assert.False(t, ok)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := newValueCompressor("lz4")
+		assert.NotNil(t, err)
+	})
+}
+
+func TestBucketCompression(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	value := func(i int) []byte {
+		return bytes.Repeat([]byte(fmt.Sprintf("value-%d-", i)), 20)
+	}
+	newBucket := func(t *testing.T, dir, strategy, compression string, pread bool) *Bucket {
+		b, err := NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(strategy), WithCompression(compression), WithPread(pread))
+		require.Nil(t, err)
+		return b
+	}
+	// the first segment is written uncompressed, the second compressed, so
+	// both formats have to be read and compacted together
+	writeSegments := func(t *testing.T, dir, strategy, compression string,
+		put func(b *Bucket, i int),
+	) *Bucket {
+		b := newBucket(t, dir, strategy, CompressionNone, false)
+		for i := 0; i < 50; i++ {
+			put(b, i)
+		}
+		require.Nil(t, b.Shutdown(ctx))
+
+		b = newBucket(t, dir, strategy, compression, false)
+		for i := 50; i < 100; i++ {
+			put(b, i)
+		}
+		require.Nil(t, b.FlushAndSwitch())
+		return b
+	}
+	compact := func(t *testing.T, b *Bucket) {
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		require.True(t, compacted)
+	}
+
+	for _, compression := range []string{CompressionZstd, CompressionSnappy} {
+		t.Run("replace "+compression, func(t *testing.T) {
+			dir := t.TempDir()
+			b := writeSegments(t, dir, StrategyReplace, compression, func(b *Bucket, i int) {
+				require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%03d", i)), value(i)))
+			})
+			assertValues := func(t *testing.T, b *Bucket) {
+				for i := 0; i < 100; i++ {
+					v, err := b.Get([]byte(fmt.Sprintf("key-%03d", i)))
+					require.Nil(t, err)
+					assert.Equal(t, value(i), v)
+				}
+
+				c := b.Cursor()
+				defer c.Close()
+				count := 0
+				for k, v := c.First(); k != nil; k, v = c.Next() {
+					assert.Equal(t, value(count), v)
+					count++
+				}
+				assert.Equal(t, 100, count)
+			}
+
+			assertValues(t, b)
+			compact(t, b)
+			assertValues(t, b)
+			require.Nil(t, b.Shutdown(ctx))
+
+			b = newBucket(t, dir, StrategyReplace, compression, true)
+			defer b.Shutdown(ctx)
+			assertValues(t, b)
+			assert.Equal(t, 100, b.Count())
+		})
+
+		t.Run("set "+compression, func(t *testing.T) {
+			dir := t.TempDir()
+			b := writeSegments(t, dir, StrategySetCollection, compression, func(b *Bucket, i int) {
+				require.Nil(t, b.SetAdd([]byte(fmt.Sprintf("key-%03d", i%10)), [][]byte{value(i)}))
+			})
+			assertValues := func(t *testing.T, b *Bucket) {
+				for k := 0; k < 10; k++ {
+					values, err := b.SetList([]byte(fmt.Sprintf("key-%03d", k)))
+					require.Nil(t, err)
+					require.Len(t, values, 10)
+					for j, v := range values {
+						assert.Equal(t, value(k+j*10), v)
+					}
+				}
+			}
+
+			assertValues(t, b)
+			compact(t, b)
+			assertValues(t, b)
+			require.Nil(t, b.Shutdown(ctx))
+
+			b = newBucket(t, dir, StrategySetCollection, compression, true)
+			defer b.Shutdown(ctx)
+			assertValues(t, b)
+		})
+
+		t.Run("map "+compression, func(t *testing.T) {
+			dir := t.TempDir()
+			b := writeSegments(t, dir, StrategyMapCollection, compression, func(b *Bucket, i int) {
+				require.Nil(t, b.MapSet([]byte(fmt.Sprintf("key-%03d", i%10)), MapPair{
+					Key:   []byte(fmt.Sprintf("map-key-%03d", i)),
+					Value: value(i),
+				}))
+			})
+			assertValues := func(t *testing.T, b *Bucket) {
+				for k := 0; k < 10; k++ {
+					pairs, err := b.MapList([]byte(fmt.Sprintf("key-%03d", k)))
+					require.Nil(t, err)
+					require.Len(t, pairs, 10)
+					for j, pair := range pairs {
+						assert.Equal(t, []byte(fmt.Sprintf("map-key-%03d", k+j*10)), pair.Key)
+						assert.Equal(t, value(k+j*10), pair.Value)
+					}
+				}
+			}
+
+			assertValues(t, b)
+			compact(t, b)
+			assertValues(t, b)
+			require.Nil(t, b.Shutdown(ctx))
+
+			b = newBucket(t, dir, StrategyMapCollection, compression, true)
+			defer b.Shutdown(ctx)
+			assertValues(t, b)
+		})
+	}
+
+	t.Run("compressed segments are smaller", func(t *testing.T) {
+		sizes := map[string]int64{}
+		for _, compression := range []string{CompressionNone, CompressionZstd} {
+			b := newBucket(t, t.TempDir(), StrategyReplace, compression, false)
+			for i := 0; i < 100; i++ {
+				require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%03d", i)), value(i)))
+			}
+			require.Nil(t, b.FlushAndSwitch())
+			sizes[compression] = b.disk.segments[0].size
+			require.Nil(t, b.Shutdown(ctx))
+		}
+		assert.Less(t, sizes[CompressionZstd], sizes[CompressionNone]/2)
+	})
+
+	t.Run("roaringset is not supported", func(t *testing.T) {
+		_, err := NewBucket(ctx, t.TempDir(), "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyRoaringSet), WithCompression(CompressionZstd))
+		assert.NotNil(t, err)
+	})
+}
@@ -20,10 +20,12 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/entities/storagestate"
 )
@@ -60,6 +62,20 @@ type SegmentGroup struct {
 	useBloomFilter          bool // see bucket for more datails
 	calcCountNetAdditions   bool // see bucket for more datails
 	compactLeftOverSegments bool // see bucket for more datails
+
+	compactionConfig func() CompactionConfig // see bucket for more details
+	compressor       *valueCompressor        // see bucket for more details
+	tiering          *segmentTiering         // see bucket for more details
+	readMode         diskio.ReadMode         // see bucket for more details
+
+	// bytes of the segments written by flushes and compactions since the
+	// segment group was loaded, their ratio is the write amplification
+	flushedBytes   int64
+	compactedBytes int64
+
+	// the number of open snapshots of the bucket, its segments are neither
+	// compacted nor evicted while there are any
+	snapshots atomic.Int32
 }
 
 type sgConfig struct {
@@ -68,10 +84,14 @@ type sgConfig struct {
 	mapRequiresSorting    bool
 	monitorCount          bool
 	mmapContents          bool
+	readMode              diskio.ReadMode
 	keepTombstones        bool
 	useBloomFilter        bool
 	calcCountNetAdditions bool
 	forceCompaction       bool
+	compactionConfig      func() CompactionConfig
+	compressor            *valueCompressor
+	tiering               *segmentTiering
 }
 
 func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
@@ -91,14 +111,49 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		mapRequiresSorting:      cfg.mapRequiresSorting,
 		strategy:                cfg.strategy,
 		mmapContents:            cfg.mmapContents,
+		readMode:                cfg.readMode,
 		keepTombstones:          cfg.keepTombstones,
 		useBloomFilter:          cfg.useBloomFilter,
 		calcCountNetAdditions:   cfg.calcCountNetAdditions,
 		compactLeftOverSegments: cfg.forceCompaction,
+		compactionConfig:        cfg.compactionConfig,
+		compressor:              cfg.compressor,
+		tiering:                 cfg.tiering,
 	}
 
 	segmentIndex := 0
 	for _, entry := range list {
+		switch filepath.Ext(entry.Name()) {
+		case ".fetch":
+			// interrupted fetch of a tiered segment
+			if err := os.Remove(filepath.Join(sg.dir, entry.Name())); err != nil {
+				return nil, fmt.Errorf("delete partially fetched segment %s: %w", entry.Name(), err)
+			}
+			continue
+		case ".tiered":
+			segmentPath := segmentPathFromTieredMarkerPath(filepath.Join(sg.dir, entry.Name()))
+			ok, err := fileExists(segmentPath)
+			if err != nil {
+				return nil, fmt.Errorf("check for local copy of tiered segment %s: %w",
+					entry.Name(), err)
+			}
+			if ok {
+				// fetched before, initialized with the .db file
+				continue
+			}
+
+			segment, err := newTieredSegment(filepath.Join(sg.dir, entry.Name()), sg.tiering,
+				logger, metrics, sg.makeExistsOnLower(segmentIndex),
+				sg.mmapContents, sg.readMode, sg.useBloomFilter, sg.calcCountNetAdditions)
+			if err != nil {
+				return nil, fmt.Errorf("init tiered segment %s: %w", entry.Name(), err)
+			}
+
+			sg.segments[segmentIndex] = segment
+			segmentIndex++
+			continue
+		}
+
 		if filepath.Ext(entry.Name()) != ".db" {
 			// skip, this could be commit log, etc.
 			continue
@@ -139,10 +194,13 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 
 		segment, err := newSegment(filepath.Join(sg.dir, entry.Name()), logger,
 			metrics, sg.makeExistsOnLower(segmentIndex),
-			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions)
+			sg.mmapContents, sg.readMode, sg.useBloomFilter, sg.calcCountNetAdditions)
 		if err != nil {
 			return nil, fmt.Errorf("init segment %s: %w", entry.Name(), err)
 		}
+		if err := sg.initTier(segment); err != nil {
+			return nil, fmt.Errorf("init tier of segment %s: %w", entry.Name(), err)
+		}
 
 		sg.segments[segmentIndex] = segment
 		segmentIndex++
@@ -185,12 +243,17 @@ func (sg *SegmentGroup) add(path string) error {
 	newSegmentIndex := len(sg.segments)
 	segment, err := newSegment(path, sg.logger,
 		sg.metrics, sg.makeExistsOnLower(newSegmentIndex),
-		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions)
+		sg.mmapContents, sg.readMode, sg.useBloomFilter, sg.calcCountNetAdditions)
 	if err != nil {
 		return fmt.Errorf("init segment %s: %w", path, err)
 	}
+	if err := sg.initTier(segment); err != nil {
+		return fmt.Errorf("init tier of segment %s: %w", path, err)
+	}
 
 	sg.segments = append(sg.segments, segment)
+	sg.flushedBytes += segment.size
+	sg.metrics.TrackFlushedBytes(sg.strategy, segment.size)
 	return nil
 }
 
@@ -219,6 +282,10 @@ func (sg *SegmentGroup) getWithUpperSegmentBoundary(key []byte, topMostSegment i
 				return nil, nil
 			}
 
+			if errors.Is(err, errFetchTiered) {
+				return nil, err
+			}
+
 			panic(fmt.Sprintf("unsupported error in segmentGroup.get(): %v", err))
 		}
 
@@ -247,6 +314,10 @@ func (sg *SegmentGroup) getBySecondaryIntoMemory(pos int, key []byte, buffer []b
 				return nil, nil, nil
 			}
 
+			if errors.Is(err, errFetchTiered) {
+				return nil, nil, err
+			}
+
 			panic(fmt.Sprintf("unsupported error in segmentGroup.get(): %v", err))
 		}
 
@@ -13,6 +13,7 @@ package lsmkv
 
 import (
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"path/filepath"
@@ -32,12 +33,16 @@ func (sg *SegmentGroup) bestCompactionCandidatePair() []int {
 	// entered an immutable state. During this time, the
 	// SegmentGroup should refrain from flushing until its
 	// shard indicates otherwise
-	if sg.isReadyOnly() {
+	if sg.isReadyOnly() || sg.snapshots.Load() > 0 {
 		return nil
 	}
 
+	// tiered segments are never compacted, they are the oldest segments
+	offset := sg.tieredPrefixLen()
+	segments := sg.segments[offset:]
+
 	// Nothing to compact
-	if len(sg.segments) < 2 {
+	if len(segments) < 2 {
 		return nil
 	}
 
@@ -49,7 +54,7 @@ func (sg *SegmentGroup) bestCompactionCandidatePair() []int {
 	secondLowestIndex := -1
 	pairExists := false
 
-	for ind, seg := range sg.segments {
+	for ind, seg := range segments {
 		levels[seg.level]++
 		val := levels[seg.level]
 		if val > 1 {
@@ -62,7 +67,7 @@ func (sg *SegmentGroup) bestCompactionCandidatePair() []int {
 		if seg.level < lowestLevel {
 			secondLowestIndex = lowestIndex
 			lowestLevel = seg.level
-			lowestIndex = ind
+			lowestIndex = ind + offset
 		}
 	}
 
@@ -70,13 +75,13 @@ func (sg *SegmentGroup) bestCompactionCandidatePair() []int {
 		// now pick any two segments which match the level
 		var res []int
 
-		for i, segment := range sg.segments {
+		for i, segment := range segments {
 			if len(res) >= 2 {
 				break
 			}
 
 			if segment.level == lowestPairLevel {
-				res = append(res, i)
+				res = append(res, i+offset)
 			}
 		}
 
@@ -87,6 +92,8 @@ func (sg *SegmentGroup) bestCompactionCandidatePair() []int {
 			// Merge the two lowest segments
 
 			return []int{secondLowestIndex, lowestIndex}
+		} else if sg.currentCompactionConfig().Strategy == CompactionStrategyLeveled {
+			return sg.leveledCandidatePair(offset)
 		} else {
 			// No segments of the same level exist, and we are not allowed to merge the lowest segments
 			// This means we cannot compact.  Set COMPACT_LEFTOVER_SEGMENTS to true to compact the remaining segments
@@ -122,6 +129,11 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 		return false, err
 	}
 
+	var w io.WriteSeeker = f
+	if limit := sg.currentCompactionConfig().MaxBytesPerSecond; limit > 0 {
+		w = newThrottledWriter(f, limit)
+	}
+
 	scratchSpacePath := sg.segmentAtPos(pair[1]).path + "compaction.scratch.d"
 
 	// the assumption is that the first element is older, and/or a higher level
@@ -144,8 +156,9 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 	// TODO: call metrics just once with variable strategy label
 
 	case segmentindex.StrategyReplace:
-		c := newCompactorReplace(f, sg.segmentAtPos(pair[0]).newCursor(),
+		c := newCompactorReplace(w, sg.segmentAtPos(pair[0]).newCursor(),
 			sg.segmentAtPos(pair[1]).newCursor(), level, secondaryIndices, scratchSpacePath, cleanupTombstones)
+		c.compressor = sg.compressor
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionReplace.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -156,9 +169,10 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 			return false, err
 		}
 	case segmentindex.StrategySetCollection:
-		c := newCompactorSetCollection(f, sg.segmentAtPos(pair[0]).newCollectionCursor(),
+		c := newCompactorSetCollection(w, sg.segmentAtPos(pair[0]).newCollectionCursor(),
 			sg.segmentAtPos(pair[1]).newCollectionCursor(), level, secondaryIndices,
 			scratchSpacePath, cleanupTombstones)
+		c.compressor = sg.compressor
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionSet.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -169,10 +183,11 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 			return false, err
 		}
 	case segmentindex.StrategyMapCollection:
-		c := newCompactorMapCollection(f,
+		c := newCompactorMapCollection(w,
 			sg.segmentAtPos(pair[0]).newCollectionCursorReusable(),
 			sg.segmentAtPos(pair[1]).newCollectionCursorReusable(),
 			level, secondaryIndices, scratchSpacePath, sg.mapRequiresSorting, cleanupTombstones)
+		c.compressor = sg.compressor
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionMap.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -189,7 +204,7 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 		leftCursor := leftSegment.newRoaringSetCursor()
 		rightCursor := rightSegment.newRoaringSetCursor()
 
-		c := roaringset.NewCompactor(f, leftCursor, rightCursor,
+		c := roaringset.NewCompactor(w, leftCursor, rightCursor,
 			level, scratchSpacePath, cleanupTombstones)
 
 		if sg.metrics != nil {
@@ -234,6 +249,17 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	sg.maintenanceLock.Lock()
 	defer sg.maintenanceLock.Unlock()
 
+	if sg.snapshots.Load() > 0 {
+		// a snapshot was taken during the compaction, it still reads the old
+		// segments, the pair is compacted again once it was released
+		for _, path := range precomputedFiles {
+			if err := os.Remove(path); err != nil {
+				return errors.Wrap(err, "remove compacted segment")
+			}
+		}
+		return nil
+	}
+
 	if err := sg.segments[old1].close(); err != nil {
 		return errors.Wrap(err, "close disk segment")
 	}
@@ -270,15 +296,21 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	}
 
 	seg, err := newSegment(newPath, sg.logger, sg.metrics, nil,
-		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions)
+		sg.mmapContents, sg.readMode, sg.useBloomFilter, sg.calcCountNetAdditions)
 	if err != nil {
 		return errors.Wrap(err, "create new segment")
 	}
+	if err := sg.initTier(seg); err != nil {
+		return errors.Wrap(err, "init tier of new segment")
+	}
 
 	sg.segments[old2] = seg
 
 	sg.segments = append(sg.segments[:old1], sg.segments[old1+1:]...)
 
+	sg.compactedBytes += seg.size
+	sg.metrics.TrackCompactedBytes(sg.strategy, seg.size)
+
 	return nil
 }
 
@@ -309,6 +341,18 @@ func (sg *SegmentGroup) compactIfLevelsMatch(shouldAbort cyclemanager.ShouldAbor
 
 	if compacted {
 		return true
+	}
+
+	tiered, err := sg.tierColdSegments()
+	if err != nil {
+		sg.logger.WithField("action", "lsm_tiered_segment_upload").
+			WithField("path", sg.dir).
+			WithError(err).
+			Errorf("tiering cold segments failed")
+	}
+
+	if tiered {
+		return true
 	} else {
 		sg.logger.WithField("action", "lsm_compaction").
 			WithField("path", sg.dir).
@@ -337,6 +381,23 @@ func (sg *SegmentGroup) monitorSegments() {
 	stats := sg.segmentLevelStats()
 	stats.fillMissingLevels()
 	stats.report(sg.metrics, sg.strategy, sg.dir)
+
+	sg.metrics.WriteAmplification.With(prometheus.Labels{
+		"strategy": sg.strategy,
+		"path":     sg.dir,
+	}).Set(sg.writeAmplification())
+}
+
+// writeAmplification is the number of bytes written by flushes and
+// compactions per byte flushed, 0 if nothing was flushed yet
+func (sg *SegmentGroup) writeAmplification() float64 {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	if sg.flushedBytes == 0 {
+		return 0
+	}
+	return float64(sg.flushedBytes+sg.compactedBytes) / float64(sg.flushedBytes)
 }
 
 type segmentLevelStats struct {
@@ -363,7 +424,7 @@ func (sg *SegmentGroup) segmentLevelStats() segmentLevelStats {
 		stats.count[seg.level]++
 
 		cur := stats.indexes[seg.level]
-		cur += seg.index.Size()
+		cur += seg.indexSize
 		stats.indexes[seg.level] = cur
 
 		cur = stats.payloads[seg.level]
@@ -92,7 +92,7 @@ func (e *bufferedKeyAndTombstoneExtractor) readSingleEntry() bool {
 	e.offset++
 	e.outputBufferOffset++
 
-	valueLen := binary.LittleEndian.Uint64(e.rawSegment[e.offset : e.offset+8])
+	valueLen := binary.LittleEndian.Uint64(e.rawSegment[e.offset:e.offset+8]) &^ compressedFlag
 	e.offset += 8
 
 	// we're not actually interested in the value, so we can skip it entirely
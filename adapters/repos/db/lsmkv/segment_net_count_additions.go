@@ -84,6 +84,10 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn) error {
 		}
 	}
 
+	if err := s.ensureLoaded(); err != nil {
+		return err
+	}
+
 	extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
 		s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
 
@@ -125,5 +125,11 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		out = append(out, files...)
 	}
 
+	files, err := seg.precomputeChecksums(path)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, files...)
+
 	return out, nil
 }
@@ -94,12 +94,13 @@ func precomputeSegmentMeta_Replace(ctx context.Context, t *testing.T, opts []Buc
 	fileNames, err := preComputeSegmentMeta(segmentTmp, 1, logger, true, true)
 	require.Nil(t, err)
 
-	// there should be 4 files and they should all have a .tmp suffix:
+	// there should be 5 files and they should all have a .tmp suffix:
 	// segment.db.tmp
 	// segment.cna.tmp
 	// segment.bloom.tmp
 	// segment.secondary.0.bloom.tmp
-	assert.Len(t, fileNames, 4)
+	// segment.checksums.tmp
+	assert.Len(t, fileNames, 5)
 	for _, fName := range fileNames {
 		assert.True(t, strings.HasSuffix(fName, ".tmp"))
 	}
@@ -151,10 +152,11 @@ func precomputeSegmentMeta_Set(ctx context.Context, t *testing.T, opts []BucketO
 	fileNames, err := preComputeSegmentMeta(segmentTmp, 1, logger, true, true)
 	require.Nil(t, err)
 
-	// there should be 2 files and they should all have a .tmp suffix:
+	// there should be 3 files and they should all have a .tmp suffix:
 	// segment.db.tmp
 	// segment.bloom.tmp
-	assert.Len(t, fileNames, 2)
+	// segment.checksums.tmp
+	assert.Len(t, fileNames, 3)
 	for _, fName := range fileNames {
 		assert.True(t, strings.HasSuffix(fName, ".tmp"))
 	}
@@ -33,6 +33,10 @@ func (s *segment) get(key []byte) ([]byte, error) {
 		return nil, lsmkv.NotFound
 	}
 
+	if err := s.ensureLoaded(); err != nil {
+		return nil, err
+	}
+
 	node, err := s.index.Get(key)
 	if err != nil {
 		if errors.Is(err, lsmkv.NotFound) {
@@ -75,7 +79,7 @@ func (s *segment) getBySecondaryIntoMemory(pos int, key []byte, buffer []byte) (
 		return nil, fmt.Errorf("get only possible for strategy %q", StrategyReplace), nil
 	}
 
-	if pos > len(s.secondaryIndices) || s.secondaryIndices[pos] == nil {
+	if pos >= int(s.secondaryIndexCount) {
 		return nil, fmt.Errorf("no secondary index at pos %d", pos), nil
 	}
 
@@ -83,6 +87,14 @@ func (s *segment) getBySecondaryIntoMemory(pos int, key []byte, buffer []byte) (
 		return nil, lsmkv.NotFound, nil
 	}
 
+	if err := s.ensureLoaded(); err != nil {
+		return nil, err, nil
+	}
+
+	if s.secondaryIndices[pos] == nil {
+		return nil, fmt.Errorf("no secondary index at pos %d", pos), nil
+	}
+
 	node, err := s.secondaryIndices[pos].Get(key)
 	if err != nil {
 		return nil, err, nil
@@ -128,6 +140,9 @@ func (s *segment) replaceStratParseData(in []byte) ([]byte, error) {
 	}
 
 	valueLength := binary.LittleEndian.Uint64(in[1:9])
+	if valueLength&compressedFlag != 0 {
+		return decompressValue(in[9 : 9+valueLength&^compressedFlag])
+	}
 
 	return in[9 : 9+valueLength], nil
 }
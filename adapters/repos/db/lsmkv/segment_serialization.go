@@ -12,6 +12,7 @@
 package lsmkv
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -29,6 +30,22 @@ type segmentReplaceNode struct {
 	secondaryIndexCount uint16
 	secondaryKeys       [][]byte
 	offset              int
+
+	// compressed is set if value holds the compressed value, see
+	// compressValue
+	compressed bool
+}
+
+// compressValue replaces the value with its compressed form if it is worth
+// compressing, it returns the number of bytes saved
+func (s *segmentReplaceNode) compressValue(c *valueCompressor) int {
+	compressed, ok := c.compress(s.value)
+	if !ok {
+		return 0
+	}
+	saved := len(s.value) - len(compressed)
+	s.value, s.compressed = compressed, true
+	return saved
 }
 
 func (s *segmentReplaceNode) KeyIndexAndWriteTo(w io.Writer) (segmentindex.Key, error) {
@@ -43,6 +60,9 @@ func (s *segmentReplaceNode) KeyIndexAndWriteTo(w io.Writer) (segmentindex.Key,
 	}
 
 	valueLength := uint64(len(s.value))
+	if s.compressed {
+		valueLength |= compressedFlag
+	}
 	binary.LittleEndian.PutUint64(buf[1:9], valueLength)
 	if _, err := w.Write(buf); err != nil {
 		return out, err
@@ -117,12 +137,19 @@ func ParseReplaceNode(r io.Reader, secondaryIndexCount uint16) (segmentReplaceNo
 
 	out.tombstone = tmpBuf[0] == 0x1
 	valueLength := binary.LittleEndian.Uint64(tmpBuf[1:9])
-	out.value = make([]byte, valueLength)
+	out.value = make([]byte, valueLength&^compressedFlag)
 	if n, err := io.ReadFull(r, out.value); err != nil {
 		return out, errors.Wrap(err, "read value")
 	} else {
 		out.offset += n
 	}
+	if valueLength&compressedFlag != 0 {
+		value, err := decompressValue(out.value)
+		if err != nil {
+			return out, err
+		}
+		out.value = value
+	}
 
 	if n, err := io.ReadFull(r, tmpBuf[0:4]); err != nil {
 		return out, errors.Wrap(err, "read key length encoding")
@@ -178,6 +205,8 @@ func ParseReplaceNodeIntoPread(r io.Reader, secondaryIndexCount uint16, out *seg
 	}
 	out.offset += 8
 
+	compressed := valueLength&compressedFlag != 0
+	valueLength &^= compressedFlag
 	if int(valueLength) > cap(out.value) {
 		out.value = make([]byte, valueLength)
 	} else {
@@ -189,6 +218,13 @@ func ParseReplaceNodeIntoPread(r io.Reader, secondaryIndexCount uint16, out *seg
 	} else {
 		out.offset += n
 	}
+	if compressed {
+		value, err := decompressValue(out.value)
+		if err != nil {
+			return err
+		}
+		out.value = value
+	}
 
 	var keyLength uint32
 	if err := binary.Read(r, binary.LittleEndian, &keyLength); err != nil {
@@ -232,6 +268,8 @@ func ParseReplaceNodeIntoPread(r io.Reader, secondaryIndexCount uint16, out *seg
 func ParseReplaceNodeIntoMMAP(r *byteops.ReadWriter, secondaryIndexCount uint16, out *segmentReplaceNode) error {
 	out.tombstone = r.ReadUint8() == 0x01
 	valueLength := r.ReadUint64()
+	compressed := valueLength&compressedFlag != 0
+	valueLength &^= compressedFlag
 
 	if int(valueLength) > cap(out.value) {
 		out.value = make([]byte, valueLength)
@@ -242,6 +280,13 @@ func ParseReplaceNodeIntoMMAP(r *byteops.ReadWriter, secondaryIndexCount uint16,
 	if _, err := r.CopyBytesFromBuffer(valueLength, out.value); err != nil {
 		return err
 	}
+	if compressed {
+		value, err := decompressValue(out.value)
+		if err != nil {
+			return err
+		}
+		out.value = value
+	}
 
 	// Note: In a previous version (prior to
 	// https://github.com/weaviate/weaviate/pull/3660) this was a copy. The
@@ -278,36 +323,58 @@ type segmentCollectionNode struct {
 	values     []value
 	primaryKey []byte
 	offset     int
+
+	// compressedValues holds all values compressed together, see
+	// compressValues
+	compressedValues []byte
+}
+
+// compressValues compresses all values of the node together if they are
+// worth compressing, it returns the number of bytes saved
+func (s *segmentCollectionNode) compressValues(c *valueCompressor) int {
+	if c == nil {
+		return 0
+	}
+	plain := bytes.NewBuffer(make([]byte, 0, s.valuesSize()))
+	if _, err := s.writeValuesTo(plain); err != nil {
+		return 0
+	}
+	compressed, ok := c.compress(plain.Bytes())
+	if !ok || len(compressed)+8 >= plain.Len() {
+		return 0
+	}
+	s.compressedValues = compressed
+	return plain.Len() - 8 - len(compressed)
+}
+
+// valuesSize is the size of the uncompressed values including their count
+func (s *segmentCollectionNode) valuesSize() int {
+	size := 8
+	for _, v := range s.values {
+		size += 9 + len(v.value)
+	}
+	return size
 }
 
 func (s segmentCollectionNode) KeyIndexAndWriteTo(w io.Writer) (segmentindex.Key, error) {
 	out := segmentindex.Key{}
+	buf := make([]byte, 8)
 	written := 0
-	valueLen := uint64(len(s.values))
-	buf := make([]byte, 9)
-	binary.LittleEndian.PutUint64(buf, valueLen)
-	if _, err := w.Write(buf[0:8]); err != nil {
-		return out, errors.Wrapf(err, "write values len for node")
-	}
-	written += 8
 
-	for i, value := range s.values {
-		if value.tombstone {
-			buf[0] = 0x01
-		} else {
-			buf[0] = 0x00
+	if s.compressedValues != nil {
+		binary.LittleEndian.PutUint64(buf, uint64(len(s.compressedValues))|compressedFlag)
+		if _, err := w.Write(buf); err != nil {
+			return out, errors.Wrapf(err, "write compressed values len for node")
 		}
-
-		valueLen := uint64(len(value.value))
-		binary.LittleEndian.PutUint64(buf[1:9], valueLen)
-		if _, err := w.Write(buf[0:9]); err != nil {
-			return out, errors.Wrapf(err, "write len of value %d", i)
+		n, err := w.Write(s.compressedValues)
+		if err != nil {
+			return out, errors.Wrapf(err, "write compressed values")
 		}
-		written += 9
-
-		n, err := w.Write(value.value)
+		written += 8 + n
+	} else {
+		n, err := s.writeValuesTo(w)
 		if err != nil {
-			return out, errors.Wrapf(err, "write value %d", i)
+			return out, err
 		}
 		written += n
 	}
@@ -334,6 +401,40 @@ func (s segmentCollectionNode) KeyIndexAndWriteTo(w io.Writer) (segmentindex.Key
 	return out, nil
 }
 
+func (s segmentCollectionNode) writeValuesTo(w io.Writer) (int, error) {
+	written := 0
+	valueLen := uint64(len(s.values))
+	buf := make([]byte, 9)
+	binary.LittleEndian.PutUint64(buf, valueLen)
+	if _, err := w.Write(buf[0:8]); err != nil {
+		return written, errors.Wrapf(err, "write values len for node")
+	}
+	written += 8
+
+	for i, value := range s.values {
+		if value.tombstone {
+			buf[0] = 0x01
+		} else {
+			buf[0] = 0x00
+		}
+
+		valueLen := uint64(len(value.value))
+		binary.LittleEndian.PutUint64(buf[1:9], valueLen)
+		if _, err := w.Write(buf[0:9]); err != nil {
+			return written, errors.Wrapf(err, "write len of value %d", i)
+		}
+		written += 9
+
+		n, err := w.Write(value.value)
+		if err != nil {
+			return written, errors.Wrapf(err, "write value %d", i)
+		}
+		written += n
+	}
+
+	return written, nil
+}
+
 // ParseCollectionNode reads from r and parses the collection values into a segmentCollectionNode
 //
 // When only given an offset, r is constructed as a *bufio.Reader to avoid first reading the
@@ -356,8 +457,18 @@ func ParseCollectionNode(r io.Reader) (segmentCollectionNode, error) {
 	}
 
 	valuesLen := binary.LittleEndian.Uint64(tmpBuf[0:8])
-	out.values = make([]value, valuesLen)
-	for i := range out.values {
+	if valuesLen&compressedFlag != 0 {
+		values, n, err := readCompressedValues(r, valuesLen)
+		if err != nil {
+			return out, err
+		}
+		out.offset += n
+		out.values = values
+		valuesLen = 0
+	} else {
+		out.values = make([]value, valuesLen)
+	}
+	for i := 0; i < int(valuesLen); i++ {
 		if n, err := io.ReadFull(r, tmpBuf[0:9]); err != nil {
 			return out, errors.Wrap(err, "read value tombstone and len")
 		} else {
@@ -421,8 +532,18 @@ func ParseCollectionNodeInto(r io.Reader, node *segmentCollectionNode) error {
 	valuesLen := binary.LittleEndian.Uint64(buf[0:8])
 	offset += 8
 
-	resizeValuesOfCollectionNode(node, valuesLen)
-	for i := range node.values {
+	if valuesLen&compressedFlag != 0 {
+		values, n, err := readCompressedValues(r, valuesLen)
+		if err != nil {
+			return err
+		}
+		offset += n
+		node.values = values
+		valuesLen = 0
+	} else {
+		resizeValuesOfCollectionNode(node, valuesLen)
+	}
+	for i := 0; i < int(valuesLen); i++ {
 		_, err = io.ReadFull(r, buf)
 		if err != nil {
 			return fmt.Errorf("read values len: %w", err)
@@ -462,6 +583,21 @@ func ParseCollectionNodeInto(r io.Reader, node *segmentCollectionNode) error {
 	return nil
 }
 
+// readCompressedValues reads and parses the values of a collection node
+// which were compressed together, length is the length encoding read before
+func readCompressedValues(r io.Reader, length uint64) ([]value, int, error) {
+	compressed := make([]byte, length&^compressedFlag)
+	n, err := io.ReadFull(r, compressed)
+	if err != nil {
+		return nil, n, errors.Wrap(err, "read compressed values")
+	}
+	plain, err := decompressValue(compressed)
+	if err != nil {
+		return nil, n, err
+	}
+	return parseCollectionValues(plain), n, nil
+}
+
 func resizeValuesOfCollectionNode(node *segmentCollectionNode, size uint64) {
 	if cap(node.values) >= int(size) {
 		node.values = node.values[:size]
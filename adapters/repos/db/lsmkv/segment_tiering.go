@@ -0,0 +1,454 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+// Segments are immutable, so segments which were neither written nor read
+// for a while can be moved to a slower, cheaper storage, e.g. an object
+// storage, and fetched back on their next read. The header of a tiered
+// segment is kept in a local marker file next to its bloom filters and net
+// additions, so lookups of keys not in the segment never fetch it.
+
+// TieredStorage stores the segments which were moved off the local disk.
+// Keys are slash-separated paths.
+type TieredStorage interface {
+	Upload(ctx context.Context, key string, r io.Reader) error
+	Download(ctx context.Context, key string, w io.Writer) error
+	// Delete a stored segment, deleting a missing segment is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+type segmentTiering struct {
+	storage   TieredStorage
+	keyPrefix string
+	coldAfter time.Duration
+}
+
+// segmentTier is the tiering state of a segment of a bucket with tiered
+// storage
+type segmentTier struct {
+	tiering *segmentTiering
+	// key of the segment on the tiered storage, it is stored in the marker, so
+	// segments are found after their bucket was renamed
+	key string
+	// uploaded segments have a marker and may be removed from local disk,
+	// protected by the maintenanceLock of the segment group
+	uploaded bool
+	// loaded segments are on local disk and mounted
+	loaded     atomic.Bool
+	lastAccess atomic.Int64
+	loadLock   sync.Mutex
+}
+
+func newSegmentTier(tiering *segmentTiering, segmentPath string) *segmentTier {
+	t := &segmentTier{
+		tiering: tiering,
+		key:     path.Join(tiering.keyPrefix, filepath.Base(segmentPath)),
+	}
+	t.loaded.Store(true)
+	return t
+}
+
+func (t *segmentTier) cold(cutoff time.Time) bool {
+	return time.Unix(0, t.lastAccess.Load()).Before(cutoff)
+}
+
+// tieredSegmentMarker replaces a segment on local disk once it was uploaded
+type tieredSegmentMarker struct {
+	Key       string    `json:"key"`
+	Header    []byte    `json:"header"`
+	Size      int64     `json:"size"`
+	ModTime   time.Time `json:"modTime"`
+	IndexSize int       `json:"indexSize"`
+}
+
+func (s *segment) tieredMarkerPath() string {
+	return tieredMarkerPathFromSegmentPath(s.path)
+}
+
+func tieredMarkerPathFromSegmentPath(segPath string) string {
+	extless := strings.TrimSuffix(segPath, filepath.Ext(segPath))
+	return fmt.Sprintf("%s.tiered", extless)
+}
+
+func segmentPathFromTieredMarkerPath(markerPath string) string {
+	extless := strings.TrimSuffix(markerPath, filepath.Ext(markerPath))
+	return fmt.Sprintf("%s.db", extless)
+}
+
+func readTieredSegmentMarker(markerPath string) (*tieredSegmentMarker, error) {
+	data, err := os.ReadFile(markerPath)
+	if err != nil {
+		return nil, err
+	}
+	var marker tieredSegmentMarker
+	if err := json.Unmarshal(data, &marker); err != nil {
+		return nil, fmt.Errorf("unmarshal tiered segment marker %s: %w", markerPath, err)
+	}
+	return &marker, nil
+}
+
+// newTieredSegment initializes a segment which is not on local disk from its
+// marker. Its bloom filters and net additions are loaded from disk as usual.
+func newTieredSegment(markerPath string, tiering *segmentTiering,
+	logger logrus.FieldLogger, metrics *Metrics, existsLower existsOnLowerSegmentsFn,
+	mmapContents bool, readMode diskio.ReadMode, useBloomFilter bool, calcCountNetAdditions bool,
+) (*segment, error) {
+	if tiering == nil {
+		return nil, fmt.Errorf("segment %s is tiered, but the bucket has no tiered storage",
+			segmentPathFromTieredMarkerPath(markerPath))
+	}
+
+	marker, err := readTieredSegmentMarker(markerPath)
+	if err != nil {
+		return nil, err
+	}
+	header, err := segmentindex.ParseHeader(bytes.NewReader(marker.Header))
+	if err != nil {
+		return nil, fmt.Errorf("parse header of tiered segment: %w", err)
+	}
+
+	seg := &segment{
+		path:                  segmentPathFromTieredMarkerPath(markerPath),
+		logger:                logger,
+		metrics:               metrics,
+		mmapContents:          mmapContents,
+		readMode:              readMode,
+		useBloomFilter:        useBloomFilter,
+		calcCountNetAdditions: calcCountNetAdditions,
+		modTime:               marker.ModTime,
+		indexSize:             marker.IndexSize,
+		tier:                  &segmentTier{tiering: tiering, key: marker.Key, uploaded: true},
+	}
+	seg.setHeader(header, marker.Size)
+
+	if err := seg.initFilters(metrics, existsLower); err != nil {
+		return nil, err
+	}
+	return seg, nil
+}
+
+// initTier of a segment on local disk, which may have been uploaded and
+// fetched again before
+func (s *segment) initTier(tiering *segmentTiering) error {
+	s.tier = newSegmentTier(tiering, s.path)
+
+	marker, err := readTieredSegmentMarker(s.tieredMarkerPath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	s.tier.key = marker.Key
+	s.tier.uploaded = true
+	return nil
+}
+
+// errFetchTiered is returned by reads if a tiered segment could not be
+// fetched, e.g. because the tiered storage is unavailable
+var errFetchTiered = errors.New("fetch tiered segment")
+
+func (sg *SegmentGroup) initTier(seg *segment) error {
+	if sg.tiering == nil {
+		return nil
+	}
+	return seg.initTier(sg.tiering)
+}
+
+// ensureLoaded fetches a tiered segment which is not on local disk. It must
+// be called before the contents or indexes of a segment are read.
+func (s *segment) ensureLoaded() error {
+	t := s.tier
+	if t == nil {
+		return nil
+	}
+
+	t.lastAccess.Store(time.Now().UnixNano())
+	if t.loaded.Load() {
+		return nil
+	}
+
+	t.loadLock.Lock()
+	defer t.loadLock.Unlock()
+	if t.loaded.Load() {
+		return nil
+	}
+
+	if err := s.fetch(context.Background()); err != nil {
+		return fmt.Errorf("%w %s: %w", errFetchTiered, s.path, err)
+	}
+	t.loaded.Store(true)
+	return nil
+}
+
+// mustEnsureLoaded is used by cursors, which cannot return errors on
+// creation. As with any other unexpected error of a cursor, it panics.
+func (s *segment) mustEnsureLoaded() {
+	if err := s.ensureLoaded(); err != nil {
+		panic(err)
+	}
+}
+
+// fetch downloads the segment to a temporary file first, so an interrupted
+// download never leaves a partial segment behind. The modification time of
+// the segment is restored, so it can be verified against its checksums.
+func (s *segment) fetch(ctx context.Context) error {
+	before := time.Now()
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.fetch")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := s.tier.tiering.storage.Download(ctx, s.tier.key, tmp); err != nil {
+		tmp.Close()
+		return fmt.Errorf("download: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	info, err := os.Stat(tmp.Name())
+	if err != nil {
+		return err
+	}
+	if info.Size() != s.size {
+		return fmt.Errorf("downloaded %d bytes, expected %d", info.Size(), s.size)
+	}
+	if err := os.Chtimes(tmp.Name(), s.modTime, s.modTime); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+
+	corrupt, err := diskio.VerifyBlockChecksums(s.path, s.checksumsPath())
+	if err != nil {
+		return fmt.Errorf("verify checksums: %w", err)
+	}
+	if corrupt != nil {
+		os.Remove(s.path)
+		return fmt.Errorf("downloaded segment does not match its checksums at offsets %v",
+			corrupt.Offsets)
+	}
+
+	if _, _, err := s.mount(); err != nil {
+		return err
+	}
+
+	s.logger.WithField("action", "lsm_tiered_segment_fetch").
+		WithField("path", s.path).
+		WithField("took", time.Since(before)).
+		Debug("fetched tiered segment")
+	return nil
+}
+
+// upload the segment and write its marker, the segment is kept on local
+// disk until it is evicted
+func (s *segment) upload(ctx context.Context) error {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	header := make([]byte, segmentindex.HeaderSize)
+	if _, err := f.ReadAt(header, 0); err != nil {
+		return fmt.Errorf("read header: %w", err)
+	}
+
+	if err := s.tier.tiering.storage.Upload(ctx, s.tier.key, f); err != nil {
+		return fmt.Errorf("upload: %w", err)
+	}
+
+	data, err := json.Marshal(tieredSegmentMarker{
+		Key:       s.tier.key,
+		Header:    header,
+		Size:      s.size,
+		ModTime:   s.modTime,
+		IndexSize: s.indexSize,
+	})
+	if err != nil {
+		return err
+	}
+	tmpPath := s.tieredMarkerPath() + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o666); err != nil {
+		return fmt.Errorf("write marker: %w", err)
+	}
+	return os.Rename(tmpPath, s.tieredMarkerPath())
+}
+
+// evict removes the local copy of an uploaded segment, the maintenanceLock
+// of the segment group must be held
+func (s *segment) evict() error {
+	s.tier.loaded.Store(false)
+	if err := s.close(); err != nil {
+		return err
+	}
+	return os.Remove(s.path)
+}
+
+// tieredPrefixLen is the number of the oldest segments which were uploaded.
+// Segments are only tiered in order, and tiered segments are never compacted,
+// so compactions still only merge adjacent segments. The maintenanceLock
+// must be held.
+func (sg *SegmentGroup) tieredPrefixLen() int {
+	for i, seg := range sg.segments {
+		if seg.tier == nil || !seg.tier.uploaded {
+			return i
+		}
+	}
+	return len(sg.segments)
+}
+
+// tierColdSegments uploads the oldest segment which is not tiered yet once it
+// was neither written nor read for the configured time, and evicts the local
+// copies of uploaded segments which were not read for that long. It runs in
+// the compaction cycle of the segment group, so it never runs concurrently
+// with a compaction.
+func (sg *SegmentGroup) tierColdSegments() (bool, error) {
+	if sg.tiering == nil || sg.isReadyOnly() {
+		return false, nil
+	}
+	cutoff := time.Now().Add(-sg.tiering.coldAfter)
+
+	sg.maintenanceLock.RLock()
+	var candidate *segment
+	evict := 0
+	for _, seg := range sg.segments {
+		if seg.tier.uploaded {
+			if seg.tier.loaded.Load() && seg.tier.cold(cutoff) {
+				evict++
+			}
+			continue
+		}
+		if seg.modTime.Before(cutoff) && seg.tier.cold(cutoff) {
+			candidate = seg
+		}
+		break
+	}
+	sg.maintenanceLock.RUnlock()
+
+	if candidate == nil && evict == 0 {
+		return false, nil
+	}
+
+	if candidate != nil {
+		if err := candidate.upload(context.Background()); err != nil {
+			return false, fmt.Errorf("tier segment %s: %w", candidate.path, err)
+		}
+		sg.logger.WithField("action", "lsm_tiered_segment_upload").
+			WithField("path", candidate.path).
+			WithField("size", candidate.size).
+			Debug("uploaded cold segment to tiered storage")
+	}
+
+	sg.maintenanceLock.Lock()
+	defer sg.maintenanceLock.Unlock()
+
+	if candidate != nil {
+		candidate.tier.uploaded = true
+	}
+	if sg.snapshots.Load() > 0 {
+		// open snapshots read the local copies
+		return true, nil
+	}
+	for _, seg := range sg.segments {
+		if seg.tier.uploaded && seg.tier.loaded.Load() && seg.tier.cold(cutoff) {
+			if err := seg.evict(); err != nil {
+				return true, fmt.Errorf("evict tiered segment %s: %w", seg.path, err)
+			}
+		}
+	}
+	return true, nil
+}
+
+// fetchTiered fetches all tiered segments, e.g. so that a backup contains
+// the whole bucket
+func (sg *SegmentGroup) fetchTiered() error {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	for _, seg := range sg.segments {
+		if err := seg.ensureLoaded(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteTieredSegments deletes the stored segments of all markers below dir,
+// the segments of a removed bucket would be orphaned otherwise
+func deleteTieredSegments(ctx context.Context, storage TieredStorage, dir string) error {
+	return filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || filepath.Ext(p) != ".tiered" {
+			return nil
+		}
+
+		marker, err := readTieredSegmentMarker(p)
+		if err != nil {
+			return err
+		}
+		if err := storage.Delete(ctx, marker.Key); err != nil {
+			return fmt.Errorf("delete tiered segment %s: %w", marker.Key, err)
+		}
+		return nil
+	})
+}
+
+// DeleteTieredSegments deletes the segments of the store which were moved to
+// the tiered storage, it must be called before the store is removed from disk
+func (s *Store) DeleteTieredSegments(ctx context.Context) error {
+	if s.tiering == nil {
+		return nil
+	}
+	return deleteTieredSegments(ctx, s.tiering.storage, s.dir)
+}
+
+// SetTieredStorage moves the segments of the buckets created from now on to
+// the storage once they were neither written nor read for coldAfter. Keys
+// of segments are prefixed with keyPrefix and the name of their bucket.
+func (s *Store) SetTieredStorage(storage TieredStorage, keyPrefix string, coldAfter time.Duration) {
+	s.tiering = &segmentTiering{
+		storage:   storage,
+		keyPrefix: keyPrefix,
+		coldAfter: coldAfter,
+	}
+}
@@ -0,0 +1,253 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+type fakeTieredStorage struct {
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func newFakeTieredStorage() *fakeTieredStorage {
+	return &fakeTieredStorage{objects: map[string][]byte{}}
+}
+
+func (f *fakeTieredStorage) Upload(ctx context.Context, key string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.objects[key] = data
+	return nil
+}
+
+func (f *fakeTieredStorage) Download(ctx context.Context, key string, w io.Writer) error {
+	f.Lock()
+	data, ok := f.objects[key]
+	f.Unlock()
+	if !ok {
+		return fmt.Errorf("%s: %w", key, os.ErrNotExist)
+	}
+	_, err := io.Copy(w, bytes.NewReader(data))
+	return err
+}
+
+func (f *fakeTieredStorage) Delete(ctx context.Context, key string) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.objects, key)
+	return nil
+}
+
+func (f *fakeTieredStorage) keys() []string {
+	f.Lock()
+	defer f.Unlock()
+	var keys []string
+	for key := range f.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestBucketTieredStorage(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+	storage := newFakeTieredStorage()
+
+	newBucket := func(opts ...BucketOption) (*Bucket, error) {
+		return NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			append([]BucketOption{WithStrategy(StrategyReplace), WithSecondaryIndices(1)}, opts...)...)
+	}
+	withTiering := WithTieredStorage(storage, "node1/objects", time.Hour)
+
+	b, err := newBucket(withTiering)
+	require.Nil(t, err)
+
+	for segment := 0; segment < 3; segment++ {
+		for i := 0; i < 100; i++ {
+			key := []byte(fmt.Sprintf("key-%d-%03d", segment, i))
+			require.Nil(t, b.Put(key, []byte(fmt.Sprintf("value-%d-%d", segment, i)),
+				WithSecondaryKey(0, []byte(fmt.Sprintf("secondary-%d-%03d", segment, i)))))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+	}
+	require.Len(t, b.disk.segments, 3)
+
+	localSegments := func() []string {
+		files, err := filepath.Glob(filepath.Join(dir, "*.db"))
+		require.Nil(t, err)
+		return files
+	}
+	markers := func() []string {
+		files, err := filepath.Glob(filepath.Join(dir, "*.tiered"))
+		require.Nil(t, err)
+		return files
+	}
+	// makeCold ages the segment files like segments which were written
+	// before, their checksums are rewritten as they include the mod time
+	makeCold := func(segments ...*segment) {
+		for _, seg := range segments {
+			if !seg.tier.uploaded {
+				seg.modTime = time.Now().Add(-2 * time.Hour)
+				require.Nil(t, os.Chtimes(seg.path, seg.modTime, seg.modTime))
+				require.Nil(t, diskio.WriteBlockChecksums(seg.path, seg.checksumsPath()))
+			}
+			seg.tier.lastAccess.Store(0)
+		}
+	}
+	assertReadable := func(t *testing.T, b *Bucket) {
+		for segment := 0; segment < 3; segment++ {
+			v, err := b.Get([]byte(fmt.Sprintf("key-%d-%03d", segment, 42)))
+			require.Nil(t, err)
+			assert.Equal(t, fmt.Sprintf("value-%d-42", segment), string(v))
+
+			v, err = b.GetBySecondary(0, []byte(fmt.Sprintf("secondary-%d-%03d", segment, 7)))
+			require.Nil(t, err)
+			assert.Equal(t, fmt.Sprintf("value-%d-7", segment), string(v))
+		}
+
+		c := b.Cursor()
+		defer c.Close()
+		count := 0
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			count++
+		}
+		assert.Equal(t, 300, count)
+	}
+
+	t.Run("recent segments are not tiered", func(t *testing.T) {
+		tiered, err := b.disk.tierColdSegments()
+		require.Nil(t, err)
+		assert.False(t, tiered)
+		assert.Empty(t, storage.keys())
+	})
+
+	t.Run("only the oldest segments are tiered", func(t *testing.T) {
+		// the newest segment is cold, but the ones before are not
+		makeCold(b.disk.segments[2])
+		tiered, err := b.disk.tierColdSegments()
+		require.Nil(t, err)
+		assert.False(t, tiered)
+
+		makeCold(b.disk.segments[0], b.disk.segments[1])
+		for i := 0; i < 2; i++ {
+			tiered, err := b.disk.tierColdSegments()
+			require.Nil(t, err)
+			assert.True(t, tiered)
+		}
+
+		assert.Len(t, storage.keys(), 2)
+		assert.Len(t, markers(), 2)
+		assert.Equal(t, []string{b.disk.segments[2].path}, localSegments())
+		assert.Equal(t, 2, b.disk.tieredPrefixLen())
+	})
+
+	t.Run("tiered segments are not compacted", func(t *testing.T) {
+		assert.Nil(t, b.disk.bestCompactionCandidatePair())
+	})
+
+	t.Run("lookups of missing keys do not fetch segments", func(t *testing.T) {
+		_, err := b.Get([]byte("missing"))
+		require.Nil(t, err)
+		assert.Len(t, localSegments(), 1)
+	})
+
+	t.Run("tiered segments are fetched on read", func(t *testing.T) {
+		assertReadable(t, b)
+		assert.Len(t, localSegments(), 3)
+	})
+
+	t.Run("fetched segments are evicted once cold again", func(t *testing.T) {
+		makeCold(b.disk.segments[0])
+		tiered, err := b.disk.tierColdSegments()
+		require.Nil(t, err)
+		assert.True(t, tiered)
+
+		assert.Equal(t, []string{b.disk.segments[1].path, b.disk.segments[2].path}, localSegments())
+		assert.Len(t, markers(), 2)
+		assert.Len(t, storage.keys(), 2)
+	})
+
+	t.Run("tiered segments are loaded on restart", func(t *testing.T) {
+		require.Nil(t, b.Shutdown(ctx))
+
+		_, err := newBucket()
+		assert.ErrorContains(t, err, "no tiered storage")
+
+		b, err = newBucket(withTiering)
+		require.Nil(t, err)
+		require.Len(t, b.disk.segments, 3)
+		assert.Equal(t, 2, b.disk.tieredPrefixLen())
+		assertReadable(t, b)
+	})
+
+	t.Run("backups contain the whole bucket", func(t *testing.T) {
+		makeCold(b.disk.segments[0], b.disk.segments[1])
+		_, err := b.disk.tierColdSegments()
+		require.Nil(t, err)
+		require.Len(t, localSegments(), 1)
+
+		files, err := b.ListFiles(ctx, "")
+		require.Nil(t, err)
+		dbFiles := 0
+		for _, file := range files {
+			assert.NotEqual(t, ".tiered", filepath.Ext(file))
+			if filepath.Ext(file) == ".db" {
+				dbFiles++
+			}
+		}
+		assert.Equal(t, 3, dbFiles)
+	})
+
+	t.Run("corrupt downloads are rejected", func(t *testing.T) {
+		makeCold(b.disk.segments[0])
+		_, err := b.disk.tierColdSegments()
+		require.Nil(t, err)
+
+		key := b.disk.segments[0].tier.key
+		storage.objects[key][200] ^= 0xff
+
+		_, err = b.Get([]byte(fmt.Sprintf("key-%d-%03d", 0, 42)))
+		assert.ErrorContains(t, err, "does not match its checksums")
+		storage.objects[key][200] ^= 0xff
+	})
+
+	require.Nil(t, b.Shutdown(ctx))
+
+	t.Run("deleted with the store", func(t *testing.T) {
+		require.Nil(t, deleteTieredSegments(ctx, storage, dir))
+		assert.Empty(t, storage.keys())
+	})
+}
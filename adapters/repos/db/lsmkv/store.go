@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/storagestate"
 )
@@ -41,6 +42,17 @@ type Store struct {
 	// Prevent concurrent manipulations to the bucketsByNameMap, most notably
 	// when initializing buckets in parallel
 	bucketAccessLock sync.RWMutex
+
+	// compactionConfig is passed to all buckets created by the store, see
+	// WithCompactionConfig
+	compactionConfig func() CompactionConfig
+
+	// tiering is passed to all buckets created by the store, see
+	// SetTieredStorage
+	tiering *segmentTiering
+
+	// readMode is passed to all buckets created by the store, see SetReadMode
+	readMode diskio.ReadMode
 }
 
 // New initializes a new [Store] based on the root dir. If state is present on
@@ -61,6 +73,19 @@ func New(dir, rootDir string, logger logrus.FieldLogger, metrics *Metrics,
 	return s, s.init()
 }
 
+// SetCompactionConfig sets the compaction config of the buckets created from
+// now on, options passed to CreateOrLoadBucket take precedence
+func (s *Store) SetCompactionConfig(cfg func() CompactionConfig) {
+	s.compactionConfig = cfg
+}
+
+// SetReadMode makes all buckets created from now on read their segments with
+// the given mode instead of mmapping them, an empty mode keeps the options
+// passed to CreateOrLoadBucket
+func (s *Store) SetReadMode(mode diskio.ReadMode) {
+	s.readMode = mode
+}
+
 func (s *Store) Bucket(name string) *Bucket {
 	s.bucketAccessLock.RLock()
 	defer s.bucketAccessLock.RUnlock()
@@ -120,7 +145,7 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	}
 
 	b, err := NewBucket(ctx, s.bucketDir(bucketName), s.rootDir, s.logger, s.metrics,
-		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.bucketOptions(bucketName, opts)...)
 	if err != nil {
 		return err
 	}
@@ -129,6 +154,22 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	return nil
 }
 
+// bucketOptions prepends the options shared by all buckets of the store
+func (s *Store) bucketOptions(bucketName string, opts []BucketOption) []BucketOption {
+	var shared []BucketOption
+	if s.compactionConfig != nil {
+		shared = append(shared, WithCompactionConfig(s.compactionConfig))
+	}
+	if s.tiering != nil {
+		shared = append(shared, WithTieredStorage(s.tiering.storage,
+			path.Join(s.tiering.keyPrefix, bucketName), s.tiering.coldAfter))
+	}
+	if s.readMode != "" {
+		shared = append(shared, WithReadMode(s.readMode))
+	}
+	return append(shared, opts...)
+}
+
 func (s *Store) setBucket(name string, b *Bucket) {
 	s.bucketAccessLock.Lock()
 	defer s.bucketAccessLock.Unlock()
@@ -287,7 +328,7 @@ func (s *Store) CreateBucket(ctx context.Context, bucketName string,
 	}
 
 	b, err := NewBucket(ctx, bucketDir, s.rootDir, s.logger, s.metrics,
-		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.bucketOptions(bucketName, opts)...)
 	if err != nil {
 		return err
 	}
@@ -336,6 +377,11 @@ func (s *Store) ReplaceBuckets(ctx context.Context, bucketName, replacementBucke
 	if err := bucket.Shutdown(ctx); err != nil {
 		return errors.Wrapf(err, "failed shutting down bucket old '%s'", bucketName)
 	}
+	if s.tiering != nil {
+		if err := deleteTieredSegments(ctx, s.tiering.storage, newBucketDir); err != nil {
+			return errors.Wrapf(err, "failed deleting tiered segments of old bucket '%s'", bucketName)
+		}
+	}
 	if err := os.RemoveAll(newBucketDir); err != nil {
 		return errors.Wrapf(err, "failed removing dir '%s'", newBucketDir)
 	}
@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+// cacheFractionUnderPressure is the share of the configured size of the
+// vector caches which is kept while the node is under memory pressure
+const cacheFractionUnderPressure = 0.25
+
+// vectorCacheShrinker is implemented by vector indexes whose cache can be
+// shrunk under memory pressure
+type vectorCacheShrinker interface {
+	ShrinkVectorCache(fraction float64)
+}
+
+// ShedLoad shrinks the vector caches and pauses the compactions and
+// tombstone cleanups of all indexes depending on the memory pressure, see
+// [memwatch.Governor]. Imports are rejected by the API handlers.
+func (db *DB) ShedLoad(pressure memwatch.Pressure) {
+	fraction := 1.0
+	if pressure >= memwatch.PressureShrinkCaches {
+		fraction = cacheFractionUnderPressure
+	}
+	pause := pressure >= memwatch.PressurePauseMaintenance
+
+	db.indexLock.RLock()
+	defer db.indexLock.RUnlock()
+
+	for _, index := range db.indices {
+		index.shrinkVectorCaches(fraction)
+		if pause {
+			index.pauseMaintenance()
+		} else {
+			index.resumeMaintenance()
+		}
+	}
+}
+
+// shrinkVectorCaches of the loaded shards, shards which are not loaded yet
+// have no cache which could be shrunk
+func (i *Index) shrinkVectorCaches(fraction float64) {
+	i.ForEachShard(func(name string, shard ShardLike) error {
+		if !isLoaded(shard) {
+			return nil
+		}
+		if shrinker, ok := shard.VectorIndex().(vectorCacheShrinker); ok {
+			shrinker.ShrinkVectorCache(fraction)
+		}
+		return nil
+	})
+}
+
+type pausedCycle struct {
+	cycle   cyclemanager.CycleManager
+	stopped bool
+}
+
+// pauseMaintenance stops the running compaction and tombstone cleanup
+// cycles, ongoing cycles are completed. They are started again by
+// resumeMaintenance.
+func (i *Index) pauseMaintenance() {
+	i.pausedMaintenanceLock.Lock()
+	defer i.pausedMaintenanceLock.Unlock()
+
+	if i.maintenancePaused {
+		return
+	}
+	i.maintenancePaused = true
+
+	for _, cycle := range []cyclemanager.CycleManager{
+		i.cycleCallbacks.compactionCycle,
+		i.cycleCallbacks.vectorTombstoneCleanupCycle,
+		i.cycleCallbacks.geoPropsTombstoneCleanupCycle,
+	} {
+		if i.isPaused(cycle) || !cycle.Running() {
+			continue
+		}
+
+		paused := &pausedCycle{cycle: cycle}
+		i.pausedMaintenance = append(i.pausedMaintenance, paused)
+		stopped := cycle.Stop(context.Background())
+		go func() {
+			<-stopped
+			i.pausedMaintenanceLock.Lock()
+			defer i.pausedMaintenanceLock.Unlock()
+			paused.stopped = true
+			if !i.maintenancePaused {
+				i.startPaused()
+			}
+		}()
+	}
+}
+
+// resumeMaintenance starts the paused cycles which stopped already, the
+// others are started once they stopped
+func (i *Index) resumeMaintenance() {
+	i.pausedMaintenanceLock.Lock()
+	defer i.pausedMaintenanceLock.Unlock()
+
+	if !i.maintenancePaused {
+		return
+	}
+	i.maintenancePaused = false
+	i.startPaused()
+}
+
+func (i *Index) isPaused(cycle cyclemanager.CycleManager) bool {
+	for _, paused := range i.pausedMaintenance {
+		if paused.cycle == cycle {
+			return true
+		}
+	}
+	return false
+}
+
+// startPaused starts the stopped cycles, pausedMaintenanceLock must be held
+func (i *Index) startPaused() {
+	stopping := i.pausedMaintenance[:0]
+	for _, paused := range i.pausedMaintenance {
+		if paused.stopped {
+			paused.cycle.Start()
+		} else {
+			stopping = append(stopping, paused)
+		}
+	}
+	i.pausedMaintenance = stopping
+}
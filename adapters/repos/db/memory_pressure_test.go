@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestIndexPauseMaintenance(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	index := &Index{
+		Config: IndexConfig{ClassName: schema.ClassName("Article")},
+		logger: logger,
+	}
+	index.initCycleCallbacks()
+	index.cycleCallbacks.compactionCycle.Start()
+	index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
+	defer index.cycleCallbacks.compactionCycle.StopAndWait(context.Background())
+	defer index.cycleCallbacks.vectorTombstoneCleanupCycle.StopAndWait(context.Background())
+
+	index.pauseMaintenance()
+	index.pauseMaintenance()
+	require.Eventually(t, func() bool {
+		return !index.cycleCallbacks.compactionCycle.Running() &&
+			!index.cycleCallbacks.vectorTombstoneCleanupCycle.Running()
+	}, time.Second, 10*time.Millisecond)
+
+	index.resumeMaintenance()
+	require.Eventually(t, func() bool {
+		return index.cycleCallbacks.compactionCycle.Running() &&
+			index.cycleCallbacks.vectorTombstoneCleanupCycle.Running()
+	}, time.Second, 10*time.Millisecond)
+	// cycles which were not running before are not started
+	assert.False(t, index.cycleCallbacks.geoPropsTombstoneCleanupCycle.Running())
+
+	t.Run("paused again before resumed", func(t *testing.T) {
+		index.pauseMaintenance()
+		index.resumeMaintenance()
+		index.pauseMaintenance()
+		time.Sleep(50 * time.Millisecond)
+		assert.False(t, index.cycleCallbacks.compactionCycle.Running())
+		assert.False(t, index.cycleCallbacks.vectorTombstoneCleanupCycle.Running())
+		index.resumeMaintenance()
+		require.Eventually(t, func() bool {
+			return index.cycleCallbacks.compactionCycle.Running()
+		}, time.Second, 10*time.Millisecond)
+	})
+}
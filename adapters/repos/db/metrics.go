@@ -30,7 +30,8 @@ type Metrics struct {
 	filteredVectorVector  prometheus.Observer
 	filteredVectorObjects prometheus.Observer
 	filteredVectorSort    prometheus.Observer
-	grouped               bool
+	filterSelectivity     prometheus.Observer
+	indexQueueDepth       prometheus.Observer
 	baseMetrics           *monitoring.PrometheusMetrics
 }
 
@@ -48,11 +49,8 @@ func NewMetrics(
 
 	m.baseMetrics = prom
 
-	if prom.Group {
-		className = "n/a"
-		shardName = "n/a"
-		m.grouped = true
-	}
+	className = prom.ClassLabel(className)
+	shardName = prom.ShardLabel(shardName)
 
 	m.monitoring = true
 	m.batchTime = prom.BatchTime.MustCurryWith(prometheus.Labels{
@@ -96,16 +94,46 @@ func NewMetrics(
 		"operation":  "sort",
 	})
 
+	m.filterSelectivity = prom.FilterSelectivity.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
+	m.indexQueueDepth = prom.IndexQueueDepth.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
 	return m
 }
 
+// DeleteShardLabels deletes the metrics of the shard, the shared labels of
+// aggregated metrics are never deleted, only individual ones
 func (m *Metrics) DeleteShardLabels(class, shard string) {
-	if m.grouped {
-		// never delete the shared label, only individual ones
+	m.baseMetrics.DeleteShard(class, shard)
+}
+
+// FilterSelectivity observes the ratio of the objects of the shard which
+// matched a filter
+func (m *Metrics) FilterSelectivity(matches, objects int) {
+	if m == nil || !m.monitoring || objects <= 0 {
 		return
 	}
 
-	m.baseMetrics.DeleteShard(class, shard)
+	ratio := float64(matches) / float64(objects)
+	if ratio > 1 {
+		// the object count is tracked asynchronously and may lag behind
+		ratio = 1
+	}
+	m.filterSelectivity.Observe(ratio)
+}
+
+func (m *Metrics) IndexQueueDepth(size int64) {
+	if m == nil || !m.monitoring {
+		return
+	}
+
+	m.indexQueueDepth.Observe(float64(size))
 }
 
 func (m *Metrics) BatchObject(start time.Time, size int) {
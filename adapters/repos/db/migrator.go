@@ -57,7 +57,14 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			MemtablesMaxActiveSeconds: m.db.config.MemtablesMaxActiveSeconds,
 			TrackVectorDimensions:     m.db.config.TrackVectorDimensions,
 			AvoidMMap:                 m.db.config.AvoidMMap,
+			LSMReadMode:               m.db.config.LSMReadMode,
+			ShardSearchConcurrency:    m.db.config.ShardSearchConcurrency,
+			MaxShardSearches:          m.db.config.MaxShardSearches,
+			Scheduler:                 m.db.config.Scheduler,
 			DisableLazyLoadShards:     m.db.config.DisableLazyLoadShards,
+			PropertyEncryption:        m.db.config.PropertyEncryption,
+			WALArchive:                m.db.walArchive,
+			TieredStorage:             m.db.tieredStorage,
 			ReplicationFactor:         class.ReplicationConfig.Factor,
 		},
 		shardState,
@@ -220,6 +227,7 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 
 	shardsToHot := make([]string, 0, len(updates))
 	shardsToCold := make([]string, 0, len(updates))
+	shardsToFreeze := make([]string, 0, len(updates))
 	shardsHotted := make(map[string]ShardLike)
 	shardsColded := make(map[string]ShardLike)
 
@@ -274,6 +282,16 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 		}
 		eg.Wait()
 	}
+	// frozen shards are moved to the offload backend once they are shut down,
+	// if the upload fails they are kept on disk and stay usable
+	commitFrozen := func() {
+		for _, name := range shardsToFreeze {
+			if err := m.db.offloadShard(ctx, idx, name); err != nil {
+				idx.logger.WithField("action", "offload_shard").
+					WithField("shard", name).Error(err)
+			}
+		}
+	}
 	commit = func(success bool) {
 		if !success {
 			rollback()
@@ -281,6 +299,7 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 		}
 		commitHotted()
 		commitColded()
+		commitFrozen()
 	}
 
 	applyHot := func() error {
@@ -290,6 +309,14 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 				continue
 			}
 
+			// the schema is updated after the commit, so it still holds the
+			// previous status
+			if _, status := m.db.schemaGetter.TenantShard(class.Class, name); status == models.TenantActivityStatusFROZEN {
+				if err := m.db.restoreOffloadedShard(ctx, idx, name); err != nil {
+					return fmt.Errorf("cannot activate shard '%s': %w", name, err)
+				}
+			}
+
 			shard, err := idx.initShard(ctx, name, class, m.db.promMetrics)
 			if err != nil {
 				return fmt.Errorf("cannot activate shard '%s': %w", name, err)
@@ -321,6 +348,9 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 			shardsToHot = append(shardsToHot, tu.Name)
 		case models.TenantActivityStatusCOLD:
 			shardsToCold = append(shardsToCold, tu.Name)
+		case models.TenantActivityStatusFROZEN:
+			shardsToCold = append(shardsToCold, tu.Name)
+			shardsToFreeze = append(shardsToFreeze, tu.Name)
 		}
 	}
 
@@ -350,6 +380,21 @@ func (m *Migrator) DeleteTenants(ctx context.Context, class *models.Class, tenan
 	return idx.dropShards(tenants)
 }
 
+// DropShards deletes the local replicas of shards, which have been removed
+// from this node by lowering the replication factor of their class
+func (m *Migrator) DropShards(ctx context.Context, className string, shards []string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil
+	}
+	commit, err := idx.dropShards(shards)
+	if err != nil {
+		return err
+	}
+	commit(true)
+	return nil
+}
+
 func NewMigrator(db *DB, logger logrus.FieldLogger) *Migrator {
 	return &Migrator{db: db, logger: logger}
 }
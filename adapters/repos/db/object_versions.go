@@ -0,0 +1,213 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// Every change of an object of a class with versioning enabled is kept in
+// the versions bucket of its shard. Keys are the uuid of the object followed
+// by the big endian version, so that the versions of an object are adjacent
+// and sorted from old to new. Values start with the kind of the version,
+// puts are followed by the object as it was stored.
+const (
+	versionPut byte = iota
+	versionDelete
+)
+
+func (s *Shard) addVersionsBucket(ctx context.Context) error {
+	return s.store.CreateOrLoadBucket(ctx, helpers.VersionsBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.dynamicMemtableSizing(),
+		s.memtableIdleConfig(),
+	)
+}
+
+// recordPutVersion keeps the stored object as a version, identified by its
+// update time. It must be called while holding the lock of the object.
+func (s *Shard) recordPutVersion(id, data []byte) error {
+	bucket := s.store.Bucket(helpers.VersionsBucketLSM)
+	if bucket == nil {
+		return nil
+	}
+
+	version, err := storobj.UpdateTimeFromBinary(data)
+	if err != nil {
+		return fmt.Errorf("record version: %w", err)
+	}
+	value := make([]byte, 1+len(data))
+	value[0] = versionPut
+	copy(value[1:], data)
+	return s.recordVersion(bucket, id, version, value)
+}
+
+// recordDeleteVersion keeps the deletion of an object as a version. It must
+// be called while holding the lock of the object.
+func (s *Shard) recordDeleteVersion(id []byte) error {
+	bucket := s.store.Bucket(helpers.VersionsBucketLSM)
+	if bucket == nil {
+		return nil
+	}
+
+	return s.recordVersion(bucket, id, time.Now().UnixMilli(), []byte{versionDelete})
+}
+
+func (s *Shard) recordVersion(bucket *lsmkv.Bucket, id []byte, version int64, value []byte) error {
+	if err := bucket.Put(versionKey(id, version), value); err != nil {
+		return fmt.Errorf("record version: %w", err)
+	}
+
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(s.index.Config.ClassName)
+	keys := versionKeys(bucket, id)
+	for i := 0; i < len(keys)-schema.MaxVersions(class); i++ {
+		if err := bucket.Delete(keys[i]); err != nil {
+			return fmt.Errorf("remove old version: %w", err)
+		}
+	}
+	return nil
+}
+
+func versionKey(id []byte, version int64) []byte {
+	key := make([]byte, len(id)+8)
+	copy(key, id)
+	binary.BigEndian.PutUint64(key[len(id):], uint64(version))
+	return key
+}
+
+// versionKeys of an object, oldest first
+func versionKeys(bucket *lsmkv.Bucket, id []byte) [][]byte {
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	var keys [][]byte
+	for k, _ := cursor.Seek(id); k != nil && bytes.HasPrefix(k, id); k, _ = cursor.Next() {
+		keys = append(keys, append([]byte{}, k...))
+	}
+	return keys
+}
+
+// ObjectVersions of an object, newest first. Versions are read from the
+// local replica if possible, otherwise from the first replica which
+// responds.
+func (db *DB) ObjectVersions(ctx context.Context, class string, id strfmt.UUID,
+	tenant string,
+) ([]*storobj.ObjectVersion, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, objects.NewErrNotFound("class %q not found", class)
+	}
+	if err := idx.validateMultiTenancy(tenant); err != nil {
+		return nil, err
+	}
+	shard, err := idx.determineObjectShard(id, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, err := db.schemaGetter.ShardReplicas(idx.Config.ClassName.String(), shard)
+	if err != nil {
+		return nil, fmt.Errorf("replicas of shard %q: %w", shard, err)
+	}
+	nodes := append([]string{}, replicas...)
+	local := db.schemaGetter.NodeName()
+	for i, node := range nodes {
+		if node == local {
+			nodes[0], nodes[i] = nodes[i], nodes[0]
+			break
+		}
+	}
+
+	var errs []error
+	for _, node := range nodes {
+		var versions []*storobj.ObjectVersion
+		if node == local {
+			versions, err = db.IncomingGetObjectVersions(ctx, class, shard, id)
+		} else {
+			versions, err = db.remoteNode.GetObjectVersions(ctx, node, class, shard, id)
+		}
+		if err == nil {
+			return versions, nil
+		}
+		errs = append(errs, fmt.Errorf("node %q: %w", node, err))
+	}
+	return nil, fmt.Errorf("versions of object %s: %w", id, errors.Join(errs...))
+}
+
+// IncomingGetObjectVersions reads the versions of an object from a shard
+// stored on this node
+func (db *DB) IncomingGetObjectVersions(ctx context.Context, class, shardName string,
+	id strfmt.UUID,
+) ([]*storobj.ObjectVersion, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", class))
+	}
+	shard := idx.shards.Load(shardName)
+	if shard == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("shard %q not found", shardName))
+	}
+	bucket := shard.Store().Bucket(helpers.VersionsBucketLSM)
+	if bucket == nil {
+		return nil, fmt.Errorf("versioning is not enabled for class %q", class)
+	}
+
+	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	keys := versionKeys(bucket, idBytes)
+	versions := make([]*storobj.ObjectVersion, 0, len(keys))
+	for i := len(keys) - 1; i >= 0; i-- {
+		value, err := bucket.Get(keys[i])
+		if err != nil {
+			return nil, fmt.Errorf("read version: %w", err)
+		}
+		if len(value) == 0 {
+			// removed in the meantime
+			continue
+		}
+
+		version := &storobj.ObjectVersion{
+			Version: int64(binary.BigEndian.Uint64(keys[i][len(idBytes):])),
+		}
+		if value[0] == versionDelete {
+			version.Deleted = true
+		} else {
+			obj, err := idx.objectFromBinary(value[1:])
+			if err != nil {
+				return nil, fmt.Errorf("unmarshal version: %w", err)
+			}
+			object := obj.Object
+			object.Vector = obj.Vector
+			version.Object = &object
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
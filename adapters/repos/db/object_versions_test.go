@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestObjectVersions(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	shard, idx := testShard(t, ctx, "Versioned", func(idx *Index) {
+		class := idx.getSchema.GetSchemaSkipAuth().Objects.Classes[0]
+		class.VersioningConfig = &models.VersioningConfig{Enabled: true, MaxVersions: 3}
+	})
+	db := &DB{
+		logger:       logger,
+		indices:      map[string]*Index{idx.ID(): idx},
+		schemaGetter: idx.getSchema,
+	}
+
+	obj := testObject("Versioned")
+	for i := int64(1); i <= 4; i++ {
+		obj.Object.LastUpdateTimeUnix = i
+		obj.Object.Properties = map[string]interface{}{"revision": float64(i)}
+		require.Nil(t, shard.PutObject(ctx, obj))
+	}
+
+	t.Run("only the latest versions are kept", func(t *testing.T) {
+		versions, err := db.ObjectVersions(ctx, "Versioned", obj.ID(), "")
+		require.Nil(t, err)
+		require.Len(t, versions, 3)
+		for i, v := range versions {
+			assert.Equal(t, int64(4-i), v.Version)
+			assert.False(t, v.Deleted)
+			assert.Equal(t, float64(4-i), v.Object.Properties.(map[string]interface{})["revision"])
+			assert.Equal(t, models.C11yVector(obj.Vector), v.Object.Vector)
+		}
+	})
+
+	t.Run("deletes are kept as versions", func(t *testing.T) {
+		require.Nil(t, shard.DeleteObject(ctx, obj.ID()))
+
+		versions, err := db.ObjectVersions(ctx, "Versioned", obj.ID(), "")
+		require.Nil(t, err)
+		require.Len(t, versions, 3)
+		assert.True(t, versions[0].Deleted)
+		assert.Nil(t, versions[0].Object)
+		assert.Equal(t, int64(4), versions[1].Version)
+		assert.Equal(t, int64(3), versions[2].Version)
+	})
+
+	t.Run("versions of other objects are separate", func(t *testing.T) {
+		other := testObject("Versioned")
+		other.Object.LastUpdateTimeUnix = 10
+		require.Nil(t, shard.PutObject(ctx, other))
+
+		versions, err := db.ObjectVersions(ctx, "Versioned", other.ID(), "")
+		require.Nil(t, err)
+		require.Len(t, versions, 1)
+		assert.Equal(t, int64(10), versions[0].Version)
+	})
+}
+
+func TestObjectVersionsDisabled(t *testing.T) {
+	ctx := context.Background()
+	shard, idx := testShard(t, ctx, "Unversioned")
+	db := &DB{indices: map[string]*Index{idx.ID(): idx}, schemaGetter: idx.getSchema}
+
+	obj := testObject("Unversioned")
+	require.Nil(t, shard.PutObject(ctx, obj))
+
+	_, err := db.ObjectVersions(ctx, "Unversioned", obj.ID(), "")
+	assert.ErrorContains(t, err, "versioning is not enabled")
+}
@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/quota"
+)
+
+// QuotaUsage sums up the objects and vector bytes of the shards of a class
+// on this node. If a tenant is set, only the shard of the tenant is counted.
+// Vectors are stored as float32, so every dimension takes four bytes.
+func (db *DB) QuotaUsage(ctx context.Context, class, tenant string) (quota.Usage, error) {
+	var usage quota.Usage
+
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return usage, nil
+	}
+
+	err := idx.ForEachShard(func(name string, shard ShardLike) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if tenant != "" && name != tenant {
+			return nil
+		}
+		usage.Objects += int64(shard.ObjectCount())
+		usage.VectorBytes += int64(shard.Dimensions()) * 4
+		return nil
+	})
+	return usage, err
+}
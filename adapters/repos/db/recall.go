@@ -0,0 +1,330 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	defaultRecallSampleSize = 100
+	maxRecallSampleSize     = 10000
+	defaultRecallLimit      = 10
+	maxRecallLimit          = 1000
+)
+
+// DefaultRecallEF are the ef settings which are evaluated if none are given
+var DefaultRecallEF = []int{16, 32, 64, 128, 256, 512}
+
+// RecallParams configure the evaluation of the recall of the vector indexes
+// of the local shards of a class. The vectors of a random sample of the
+// objects of each shard are used as queries, the ground truth is the result
+// of a flat search over all vectors of the shard.
+type RecallParams struct {
+	Class  string `json:"class"`
+	Tenant string `json:"tenant,omitempty"`
+	// SampleSize is the number of queries per shard
+	SampleSize int `json:"sampleSize"`
+	// Limit is the number of nearest neighbors each query searches for
+	Limit int   `json:"limit"`
+	EF    []int `json:"ef"`
+}
+
+// RecallReport is the recall and latency of the vector indexes of a class
+// for each evaluated ef setting
+type RecallReport struct {
+	Class             string        `json:"class"`
+	Shards            []string      `json:"shards"`
+	Queries           int           `json:"queries"`
+	Limit             int           `json:"limit"`
+	GroundTruthTookMs float64       `json:"groundTruthTookMs"`
+	Points            []RecallPoint `json:"points"`
+}
+
+// RecallPoint is the share of the true nearest neighbors which were found
+// with an ef setting, and the latencies of the queries
+type RecallPoint struct {
+	EF            int     `json:"ef"`
+	Recall        float64 `json:"recall"`
+	MeanLatencyMs float64 `json:"meanLatencyMs"`
+	P50LatencyMs  float64 `json:"p50LatencyMs"`
+	P99LatencyMs  float64 `json:"p99LatencyMs"`
+}
+
+// efSearcher is a vector index whose search quality is tuned with ef
+type efSearcher interface {
+	SearchByVectorWithEF(ctx context.Context, vector []float32, k, ef int,
+		allowList helpers.AllowList) ([]uint64, []float32, error)
+}
+
+// recallQuery is a sampled object, which is excluded from the results of
+// its own query
+type recallQuery struct {
+	docID  uint64
+	vector []float32
+	truth  map[uint64]struct{}
+}
+
+// EvaluateRecall measures the recall of the vector indexes of the shards of
+// a class on this node. Only the local shards are evaluated, the ground
+// truth is computed with a full scan of each shard.
+func (db *DB) EvaluateRecall(ctx context.Context, params RecallParams) (*RecallReport, error) {
+	if err := params.setDefaults(); err != nil {
+		return nil, err
+	}
+	idx := db.GetIndex(schema.ClassName(params.Class))
+	if idx == nil {
+		return nil, objects.NewErrNotFound("class %q not found", params.Class)
+	}
+	if err := idx.validateMultiTenancy(params.Tenant); err != nil {
+		return nil, err
+	}
+
+	report := &RecallReport{
+		Class:  idx.Config.ClassName.String(),
+		Shards: []string{},
+		Limit:  params.Limit,
+		Points: make([]RecallPoint, len(params.EF)),
+	}
+	latencies := make([][]time.Duration, len(params.EF))
+	found := make([]int, len(params.EF))
+	expected := 0
+	err := idx.ForEachShard(func(name string, shard ShardLike) error {
+		if params.Tenant != "" && name != params.Tenant {
+			return nil
+		}
+		searcher, ok := shard.VectorIndex().(efSearcher)
+		if !ok {
+			return objects.NewErrInvalidUserInput(
+				"the vector index of shard %q does not support ef settings", name)
+		}
+
+		queries, err := sampleRecallQueries(shard, params.SampleSize)
+		if err != nil {
+			return fmt.Errorf("shard %q: sample queries: %w", name, err)
+		}
+		if len(queries) == 0 {
+			return nil
+		}
+
+		before := time.Now()
+		if err := recallGroundTruth(ctx, shard, queries, params.Limit); err != nil {
+			return fmt.Errorf("shard %q: ground truth: %w", name, err)
+		}
+		report.GroundTruthTookMs += float64(time.Since(before)) / float64(time.Millisecond)
+
+		for i, ef := range params.EF {
+			for _, q := range queries {
+				before := time.Now()
+				ids, _, err := searcher.SearchByVectorWithEF(ctx, q.vector, params.Limit+1, ef, nil)
+				if err != nil {
+					return fmt.Errorf("shard %q: search with ef %d: %w", name, ef, err)
+				}
+				latencies[i] = append(latencies[i], time.Since(before))
+
+				n := 0
+				for _, id := range ids {
+					if id == q.docID {
+						continue
+					}
+					if n == params.Limit {
+						break
+					}
+					n++
+					if _, ok := q.truth[id]; ok {
+						found[i]++
+					}
+				}
+			}
+		}
+		for _, q := range queries {
+			expected += len(q.truth)
+		}
+		report.Shards = append(report.Shards, name)
+		report.Queries += len(queries)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, ef := range params.EF {
+		report.Points[i] = RecallPoint{EF: ef, Recall: 1}
+		if expected > 0 {
+			report.Points[i].Recall = float64(found[i]) / float64(expected)
+		}
+		report.Points[i].setLatencies(latencies[i])
+	}
+	return report, nil
+}
+
+func (p *RecallParams) setDefaults() error {
+	if p.SampleSize == 0 {
+		p.SampleSize = defaultRecallSampleSize
+	}
+	if p.SampleSize < 0 || p.SampleSize > maxRecallSampleSize {
+		return objects.NewErrInvalidUserInput(
+			"sampleSize must be between 1 and %d", maxRecallSampleSize)
+	}
+	if p.Limit == 0 {
+		p.Limit = defaultRecallLimit
+	}
+	if p.Limit < 0 || p.Limit > maxRecallLimit {
+		return objects.NewErrInvalidUserInput("limit must be between 1 and %d", maxRecallLimit)
+	}
+	if len(p.EF) == 0 {
+		p.EF = DefaultRecallEF
+	}
+	for _, ef := range p.EF {
+		if ef < 1 {
+			return objects.NewErrInvalidUserInput("ef must be positive, got %d", ef)
+		}
+	}
+	return nil
+}
+
+func (p *RecallPoint) setLatencies(latencies []time.Duration) {
+	if len(latencies) == 0 {
+		return
+	}
+	sort.Slice(latencies, func(a, b int) bool { return latencies[a] < latencies[b] })
+	var total time.Duration
+	for _, l := range latencies {
+		total += l
+	}
+	ms := func(d time.Duration) float64 { return float64(d) / float64(time.Millisecond) }
+	p.MeanLatencyMs = ms(total / time.Duration(len(latencies)))
+	p.P50LatencyMs = ms(latencies[len(latencies)/2])
+	p.P99LatencyMs = ms(latencies[len(latencies)*99/100])
+}
+
+// sampleRecallQueries picks a uniform sample of the objects with a vector
+// in a single scan of the objects bucket
+func sampleRecallQueries(shard ShardLike, size int) ([]*recallQuery, error) {
+	bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return nil, fmt.Errorf("objects bucket not found")
+	}
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	queries := make([]*recallQuery, 0, size)
+	seen := 0
+	buf := []float32{}
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		vector, err := storobj.VectorFromBinary(v, buf)
+		if err != nil {
+			return nil, err
+		}
+		if len(vector) == 0 {
+			continue
+		}
+		buf = vector
+		seen++
+		pos := len(queries)
+		if pos == size {
+			if pos = r.Intn(seen); pos >= size {
+				continue
+			}
+		}
+		docID, err := storobj.DocIDFromBinary(v)
+		if err != nil {
+			return nil, err
+		}
+		q := &recallQuery{docID: docID, vector: append([]float32{}, vector...)}
+		if pos == len(queries) {
+			queries = append(queries, q)
+		} else {
+			queries[pos] = q
+		}
+	}
+	return queries, nil
+}
+
+// recallGroundTruth finds the exact nearest neighbors of all queries in a
+// single scan of the objects bucket
+func recallGroundTruth(ctx context.Context, shard ShardLike, queries []*recallQuery, k int) error {
+	provider := shard.VectorIndex().DistancerProvider()
+	normalize := func(vec []float32) []float32 {
+		if provider.Type() == "cosine-dot" {
+			return distancer.Normalize(vec)
+		}
+		return vec
+	}
+
+	heaps := make([]*priorityqueue.Queue[any], len(queries))
+	normalized := make([][]float32, len(queries))
+	for i, q := range queries {
+		heaps[i] = priorityqueue.NewMax[any](k + 1)
+		normalized[i] = normalize(q.vector)
+	}
+
+	bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return fmt.Errorf("objects bucket not found")
+	}
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	buf := []float32{}
+	for key, v := cursor.First(); key != nil; key, v = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		vector, err := storobj.VectorFromBinary(v, buf)
+		if err != nil {
+			return err
+		}
+		if len(vector) == 0 {
+			continue
+		}
+		buf = vector
+		docID, err := storobj.DocIDFromBinary(v)
+		if err != nil {
+			return err
+		}
+		vector = normalize(vector)
+		for i, q := range queries {
+			if q.docID == docID {
+				continue
+			}
+			dist, _, err := provider.SingleDist(normalized[i], vector)
+			if err != nil {
+				return err
+			}
+			heaps[i].Insert(docID, dist)
+			if heaps[i].Len() > k {
+				heaps[i].Pop()
+			}
+		}
+	}
+
+	for i, q := range queries {
+		q.truth = make(map[uint64]struct{}, heaps[i].Len())
+		for heaps[i].Len() > 0 {
+			q.truth[heaps[i].Pop().ID] = struct{}{}
+		}
+	}
+	return nil
+}
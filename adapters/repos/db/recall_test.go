@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestEvaluateRecall(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	shard, idx := testShard(t, ctx, "Recall", func(idx *Index) {
+		idx.vectorIndexUserConfig = enthnsw.NewDefaultUserConfig()
+	})
+	db := &DB{
+		logger:       logger,
+		indices:      map[string]*Index{idx.ID(): idx},
+		schemaGetter: idx.getSchema,
+	}
+
+	for _, obj := range createRandomObjects(getRandomSeed(), "Recall", 200) {
+		require.Nil(t, shard.PutObject(ctx, obj))
+	}
+
+	t.Run("recall for each ef", func(t *testing.T) {
+		report, err := db.EvaluateRecall(ctx, RecallParams{
+			Class: "Recall", SampleSize: 20, Limit: 5, EF: []int{5, 500},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "Recall", report.Class)
+		assert.Equal(t, []string{shard.Name()}, report.Shards)
+		assert.Equal(t, 20, report.Queries)
+		require.Len(t, report.Points, 2)
+		for _, p := range report.Points {
+			assert.Greater(t, p.Recall, 0.0)
+			assert.LessOrEqual(t, p.Recall, 1.0)
+			assert.LessOrEqual(t, p.P50LatencyMs, p.P99LatencyMs)
+		}
+		// with an ef larger than the shard the search is exhaustive
+		assert.Equal(t, 1.0, report.Points[1].Recall)
+	})
+
+	t.Run("default settings", func(t *testing.T) {
+		report, err := db.EvaluateRecall(ctx, RecallParams{Class: "Recall"})
+		require.Nil(t, err)
+		assert.Equal(t, 100, report.Queries)
+		assert.Equal(t, defaultRecallLimit, report.Limit)
+		assert.Len(t, report.Points, len(DefaultRecallEF))
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		_, err := db.EvaluateRecall(ctx, RecallParams{Class: "Recall", EF: []int{0}})
+		assert.ErrorAs(t, err, &objects.ErrInvalidUserInput{})
+
+		_, err = db.EvaluateRecall(ctx, RecallParams{Class: "Recall", Limit: maxRecallLimit + 1})
+		assert.ErrorAs(t, err, &objects.ErrInvalidUserInput{})
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := db.EvaluateRecall(ctx, RecallParams{Class: "Unknown"})
+		assert.ErrorAs(t, err, &objects.ErrNotFound{})
+	})
+
+	t.Run("index without ef", func(t *testing.T) {
+		_, idx := testShard(t, ctx, "Flat")
+		db := &DB{
+			logger:       logger,
+			indices:      map[string]*Index{idx.ID(): idx},
+			schemaGetter: idx.getSchema,
+		}
+		_, err := db.EvaluateRecall(ctx, RecallParams{Class: "Flat"})
+		assert.ErrorAs(t, err, &objects.ErrInvalidUserInput{})
+	})
+}
@@ -23,6 +23,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/encryption"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -30,6 +33,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/scheduler"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -49,6 +53,10 @@ type DB struct {
 	startupComplete   atomic.Bool
 	resourceScanState *resourceScanState
 	memMonitor        *memwatch.Monitor
+	offloadBackend    modulecapabilities.BackupBackend
+	walArchive        *walArchive
+	tieredStorage     *tieredStorage
+	startup           *startupTracker
 
 	// indexLock is an RWMutex which allows concurrent access to various indexes,
 	// but only one modification at a time. R/W can be a bit confusing here,
@@ -144,6 +152,9 @@ func New(logger logrus.FieldLogger, config Config,
 		maxNumberGoroutines:     int(math.Round(config.MaxImportGoroutinesFactor * float64(runtime.GOMAXPROCS(0)))),
 		resourceScanState:       newResourceScanState(),
 		memMonitor:              memwatch.NewMonitor(memwatch.LiveHeapReader, debug.SetMemoryLimit, 0.97),
+		walArchive:              newWALArchive(config.RootPath, logger),
+		tieredStorage:           newTieredStorage(config.TieredStorageColdAfter),
+		startup:                 newStartupTracker(config.StartupPriorityClasses),
 	}
 
 	// make sure memMonitor has an initial state
@@ -167,7 +178,7 @@ func New(logger logrus.FieldLogger, config Config,
 			go func() {
 				defer db.shutDownWg.Done()
 
-				asyncWorker(db.jobQueueCh, db.logger, db.asyncIndexRetryInterval)
+				asyncWorker(db.jobQueueCh, db.config.Scheduler, db.logger, db.asyncIndexRetryInterval)
 			}()
 		}
 	}
@@ -191,8 +202,19 @@ type Config struct {
 	ServerVersion             string
 	GitHash                   string
 	AvoidMMap                 bool
+	LSMReadMode               diskio.ReadMode
 	DisableLazyLoadShards     bool
 	Replication               replication.GlobalConfig
+	PropertyEncryption        *encryption.Keyring
+	StartupPriorityClasses    []string
+	// TieredStorageColdAfter moves segments to the tiered storage backend once
+	// they were neither written nor read for this long, zero disables it
+	TieredStorageColdAfter time.Duration
+	// ShardSearchConcurrency and MaxShardSearches, see IndexConfig
+	ShardSearchConcurrency int
+	MaxShardSearches       int
+	// Scheduler shares the CPU between searches and imports, nil if disabled
+	Scheduler *scheduler.Scheduler
 }
 
 // GetIndex returns the index if it exists or nil if it doesn't
@@ -253,6 +275,7 @@ func (db *DB) DeleteIndex(className schema.ClassName) error {
 	}
 	delete(db.indices, id)
 
+	db.startup.forgetClass(className.String())
 	db.promMetrics.DeleteClass(className.String())
 	return nil
 }
@@ -288,6 +311,10 @@ func (db *DB) Shutdown(ctx context.Context) error {
 		db.indexCheckpoints.Close()
 	}
 
+	if err := db.walArchive.shutdown(ctx); err != nil {
+		return errors.Wrap(err, "ship archived changes")
+	}
+
 	return nil
 }
 
@@ -299,7 +326,13 @@ func (db *DB) worker(first bool) {
 			db.shutDownWg.Done()
 			return
 		}
-		jobToAdd.batcher.storeSingleObjectInAdditionalStorage(jobToAdd.ctx, jobToAdd.object, jobToAdd.status, jobToAdd.index)
+		release, err := db.config.Scheduler.Acquire(jobToAdd.ctx, scheduler.Bulk)
+		if err != nil {
+			jobToAdd.batcher.setErrorAtIndex(err, jobToAdd.index)
+		} else {
+			jobToAdd.batcher.storeSingleObjectInAdditionalStorage(jobToAdd.ctx, jobToAdd.object, jobToAdd.status, jobToAdd.index)
+			release()
+		}
 		jobToAdd.batcher.wg.Done()
 		objectCounter += 1
 		if first && time.Now().After(checkTime) { // only have one worker report the rate per second
@@ -326,7 +359,9 @@ type job struct {
 	queue   *vectorQueue
 }
 
-func asyncWorker(ch chan job, logger logrus.FieldLogger, retryInterval time.Duration) {
+func asyncWorker(ch chan job, sched *scheduler.Scheduler, logger logrus.FieldLogger,
+	retryInterval time.Duration,
+) {
 	var ids []uint64
 	var vectors [][]float32
 	var deleted []uint64
@@ -347,7 +382,12 @@ func asyncWorker(ch chan job, logger logrus.FieldLogger, retryInterval time.Dura
 		if len(ids) > 0 {
 		LOOP:
 			for {
-				err = job.indexer.AddBatch(job.ctx, ids, vectors)
+				var release func()
+				release, err = sched.Acquire(job.ctx, scheduler.Bulk)
+				if err == nil {
+					err = job.indexer.AddBatch(job.ctx, ids, vectors)
+					release()
+				}
 				if err == nil {
 					break LOOP
 				}
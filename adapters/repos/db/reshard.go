@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// reshardBatchSize is the number of objects listed at once when moving the
+// objects of a shard after resharding
+const reshardBatchSize = 100
+
+// AddShards creates the local shards which have been added to a class by
+// resharding
+func (m *Migrator) AddShards(ctx context.Context, class *models.Class, shards []string) error {
+	idx := m.db.GetIndex(schema.ClassName(class.Class))
+	if idx == nil {
+		return fmt.Errorf("cannot find index for %q", class.Class)
+	}
+	for _, name := range shards {
+		if idx.shards.Load(name) != nil {
+			continue
+		}
+		if err := idx.addNewShard(ctx, class, name); err != nil {
+			return fmt.Errorf("add shard %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// MoveReshardedObjects moves the objects of a shard which belong to other
+// shards after resharding. moved is increased by the number of moved
+// objects.
+func (m *Migrator) MoveReshardedObjects(ctx context.Context, className, shard string,
+	moved *atomic.Int64,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("cannot find index for %q", className)
+	}
+	return idx.moveReshardedObjects(ctx, shard, moved)
+}
+
+// moveReshardedObjects lists the objects of a shard in the order of their
+// ids and moves the ones which belong to other shards
+func (i *Index) moveReshardedObjects(ctx context.Context, shard string, moved *atomic.Int64) error {
+	cursor := &filters.Cursor{Limit: reshardBatchSize}
+	addl := additional.Properties{Vector: true}
+	for {
+		objs, _, err := i.objectSearchByShard(ctx, reshardBatchSize, nil, nil, nil,
+			cursor, addl, []string{shard})
+		if err != nil {
+			return fmt.Errorf("list objects of shard %q: %w", shard, err)
+		}
+		if len(objs) == 0 {
+			return nil
+		}
+
+		for _, obj := range objs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			target, err := i.determineObjectShard(obj.ID(), "")
+			if err != nil {
+				return err
+			}
+			if target == shard {
+				continue
+			}
+			if err := i.moveObject(ctx, obj, shard, target); err != nil {
+				return fmt.Errorf("move object %s: %w", obj.ID(), err)
+			}
+			moved.Add(1)
+		}
+		cursor.After = objs[len(objs)-1].ID().String()
+	}
+}
+
+// moveReshardedObject moves an object to the shard it belongs to, if it is
+// still held by the shard it is moving from
+func (i *Index) moveReshardedObject(ctx context.Context, id strfmt.UUID, tenant string) error {
+	prev := i.previousObjectShard(id, tenant)
+	if prev == "" {
+		return nil
+	}
+
+	obj, err := i.objectByIDFromShard(ctx, prev, id, nil,
+		additional.Properties{Vector: true}, nil, tenant)
+	if err != nil {
+		return fmt.Errorf("get resharded object: %w", err)
+	}
+	if obj == nil {
+		return nil
+	}
+	target, err := i.determineObjectShard(id, tenant)
+	if err != nil {
+		return err
+	}
+	return i.moveObject(ctx, obj, prev, target)
+}
+
+// moveObject writes an object to the target shard, unless it has been
+// written there since resharding started, and deletes it from the source.
+// Until it is deleted, searches may find the object in both shards.
+func (i *Index) moveObject(ctx context.Context, obj *storobj.Object, source, target string) error {
+	current, err := i.objectByIDFromShard(ctx, target, obj.ID(), nil,
+		additional.Properties{}, nil, "")
+	if err != nil {
+		return err
+	}
+	if current == nil || current.LastUpdateTimeUnix() < obj.LastUpdateTimeUnix() {
+		if err := i.putObject(ctx, obj, nil); err != nil {
+			return err
+		}
+	}
+	return i.deleteObjectFromShard(ctx, source, obj.ID(), nil)
+}
+
+// previousObjectShard returns the shard which may still hold an object while
+// the class is being resharded, otherwise an empty string
+func (i *Index) previousObjectShard(id strfmt.UUID, tenant string) string {
+	if tenant != "" {
+		return ""
+	}
+	parsed, err := uuid.Parse(id.String())
+	if err != nil {
+		return ""
+	}
+	uuidBytes, err := parsed.MarshalBinary()
+	if err != nil {
+		return ""
+	}
+	return i.getSchema.PreviousShardFromUUID(i.Config.ClassName.String(), uuidBytes)
+}
@@ -49,10 +49,11 @@ func (d *DB) scanResourceUsage() {
 			case <-d.shutdown:
 				return
 			case <-t.C:
+				du := d.getDiskUse(d.config.RootPath)
+				d.resourceUseWarn(d.memMonitor, du)
+				d.diskWatchdog(du)
 				if !d.resourceScanState.isReadOnly {
-					du := d.getDiskUse(d.config.RootPath)
-					d.resourceUseWarn(d.memMonitor, du)
-					d.resourceUseReadonly(d.memMonitor, du)
+					d.memUseReadonly(d.memMonitor)
 				}
 			}
 		}
@@ -60,9 +61,15 @@ func (d *DB) scanResourceUsage() {
 }
 
 type resourceScanState struct {
-	disk       *scanState
-	mem        *scanState
+	disk *scanState
+	mem  *scanState
+	// isReadOnly is set once the shards were set to read-only because of the
+	// memory usage, they are not set back to read-write
 	isReadOnly bool
+	// diskFull is set while the shards are read-only because of the disk
+	// usage, diskReadOnlyShards are the shards set to read-only then
+	diskFull           bool
+	diskReadOnlyShards map[string]struct{}
 }
 
 type scanState struct {
@@ -98,8 +105,9 @@ func newResourceScanState() *resourceScanState {
 	}
 
 	return &resourceScanState{
-		disk: &scanState{backoffs: backoffs},
-		mem:  &scanState{backoffs: backoffs},
+		disk:               &scanState{backoffs: backoffs},
+		mem:                &scanState{backoffs: backoffs},
+		diskReadOnlyShards: map[string]struct{}{},
 	}
 }
 
@@ -150,25 +158,7 @@ func (db *DB) memUseWarn(mon *memwatch.Monitor) {
 	}
 }
 
-// sets the shard to readonly if user-set threshold is surpassed
-func (db *DB) resourceUseReadonly(mon *memwatch.Monitor, du diskUse) {
-	db.diskUseReadonly(du)
-	db.memUseReadonly(mon)
-}
-
-func (db *DB) diskUseReadonly(du diskUse) {
-	diskROPercent := db.config.ResourceUsage.DiskUse.ReadOnlyPercentage
-	if diskROPercent > 0 {
-		if pu := du.percentUsed(); pu > float64(diskROPercent) {
-			db.setShardsReadOnly()
-			db.logger.WithField("action", "set_shard_read_only").
-				WithField("path", db.config.RootPath).
-				Warnf("Set READONLY, disk usage currently at %.2f%%, threshold set to %.2f%%",
-					pu, float64(diskROPercent))
-		}
-	}
-}
-
+// sets the shards to readonly if user-set threshold is surpassed
 func (db *DB) memUseReadonly(mon *memwatch.Monitor) {
 	memROPercent := db.config.ResourceUsage.MemUse.ReadOnlyPercentage
 	if memROPercent > 0 {
@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// scrub compares the segments of the buckets and the commit logs of the
+// vector index with their checksums and returns the corrupt ones
+func (s *Shard) scrub(ctx context.Context) ([]diskio.CorruptFile, error) {
+	corrupt, err := s.store.Scrub(ctx)
+	if err != nil {
+		return corrupt, fmt.Errorf("scrub store: %w", err)
+	}
+
+	c, err := s.vectorIndex.Scrub(ctx)
+	corrupt = append(corrupt, c...)
+	if err != nil {
+		return corrupt, fmt.Errorf("scrub vector index: %w", err)
+	}
+	return corrupt, nil
+}
+
+// ScrubShard returns the corrupt files of a shard of this node. Shards
+// which are not loaded are skipped, they are scrubbed once they are used.
+func (db *DB) ScrubShard(ctx context.Context, class, shardName string) ([]diskio.CorruptFile, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return nil, fmt.Errorf("class %q not found", class)
+	}
+	shard := index.shards.Load(shardName)
+	if shard == nil {
+		return nil, nil
+	}
+	return shard.scrub(ctx)
+}
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/session"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// sessionReadLevel checks a read made at level l against the session of the
+// request. If the session wrote the object and the replicas read have not
+// applied the write yet, it returns the level at which the read has to be
+// repeated to be served by a replica which did.
+func (i *Index) sessionReadLevel(ctx context.Context, tenant string, id strfmt.UUID,
+	l replica.ConsistencyLevel, applied func(session.Write) bool,
+) (replica.ConsistencyLevel, bool) {
+	w, ok := session.Lookup(ctx, i.Config.ClassName.String(), tenant, id)
+	if !ok || applied(w) {
+		return l, false
+	}
+
+	wl := replica.ConsistencyLevel(w.Level)
+	if wl == "" {
+		wl = replica.Quorum
+	}
+	rl := replica.ReadLevelAfter(wl)
+	if !rl.Stronger(l) {
+		return l, false
+	}
+
+	i.logger.WithField("action", "session_read").
+		WithField("class", i.Config.ClassName).
+		WithField("id", id).
+		WithField("level", rl).
+		Debug("replica has not applied the write of the session yet, read again")
+	return rl, true
+}
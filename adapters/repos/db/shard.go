@@ -37,12 +37,15 @@ import (
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/startup"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/entities/vectorindex"
@@ -119,6 +122,10 @@ type ShardLike interface {
 
 	commitReplication(context.Context, string, *backupMutex) interface{}
 	abortReplication(context.Context, string) replica.SimpleResponse
+	hashTreeLeaves(ctx context.Context, depth int) ([]uint64, error)
+	leafDigests(ctx context.Context, depth, leaf int) ([]replica.RepairResponse, error)
+	scrub(ctx context.Context) ([]diskio.CorruptFile, error)
+	reencryptProperties(ctx context.Context, keyring *encryption.Keyring) (int, error)
 	reinit(context.Context) error
 	filePutter(context.Context, string) (io.WriteCloser, error)
 
@@ -169,6 +176,10 @@ type Shard struct {
 	propertyIndicesLock sync.RWMutex
 	stopMetrics         chan struct{}
 
+	// searchSlots bounds the concurrent searches of the shard, nil if they are
+	// not bounded
+	searchSlots chan struct{}
+
 	centralJobQueue chan job // reference to queue used by all shards
 
 	docIdLock []sync.Mutex
@@ -187,6 +198,11 @@ type Shard struct {
 	fallbackToSearchable bool
 
 	cycleCallbacks *shardCycleCallbacks
+
+	// snapshots of the objects bucket read by cursors
+	objectSnapshots objectSnapshots
+
+	statistics *shardStatistics
 }
 
 func (s *Shard) initShard(ctx context.Context) (*Shard, error) {
@@ -218,15 +234,21 @@ func (s *Shard) initShard(ctx context.Context) (*Shard, error) {
 		s.propLenTracker = tracker
 	}
 
+	progress := startup.FromContext(ctx)
+	progress.SetPhase(startup.PhaseLSMRecovery)
 	if err := s.initNonVector(ctx, s.class); err != nil {
 		return nil, errors.Wrapf(err, "init shard %q", s.ID())
 	}
 
+	progress.SetPhase(startup.PhaseVectorIndex)
 	if err := s.initVector(ctx); err != nil {
 		return nil, err
 	}
 
-	s.queue, err = NewIndexQueue(s.ID(), s, s.VectorIndex(), s.centralJobQueue, s.indexCheckpoints, IndexQueueOptions{Logger: s.index.logger})
+	s.queue, err = NewIndexQueue(s.ID(), s, s.VectorIndex(), s.centralJobQueue, s.indexCheckpoints, IndexQueueOptions{
+		Logger:  s.index.logger,
+		Metrics: s.metrics,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -278,7 +300,14 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		propLenTracker:   propLengths,
 		class:            class,
 	}
-	return s.initShard(ctx)
+	if max := index.Config.MaxShardSearches; max > 0 {
+		s.searchSlots = make(chan struct{}, max)
+	}
+
+	ctx = index.Config.Startup.start(ctx, index.Config.ClassName.String(), shardName)
+	shard, err := s.initShard(ctx)
+	index.Config.Startup.finish(index.Config.ClassName.String(), shardName, err)
+	return shard, err
 }
 
 func (s *Shard) initVector(ctx context.Context) error {
@@ -330,6 +359,7 @@ func (s *Shard) initVector(ctx context.Context) error {
 				ShardName:            s.name,
 				ClassName:            s.index.Config.ClassName.String(),
 				PrometheusMetrics:    s.promMetrics,
+				StartupProgress:      startup.FromContext(ctx).SetVectorIndex,
 				VectorForIDThunk:     s.vectorByIndexID,
 				TempVectorForIDThunk: s.readVectorByIndexIDIntoSlice,
 				DistanceProvider:     distProv,
@@ -441,9 +471,16 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "init lsmkv store at %s", s.pathLSM())
 	}
+	store.SetCompactionConfig(s.compactionConfig)
+	// the read mode also applies to the buckets of the vector index
+	store.SetReadMode(s.index.Config.LSMReadMode)
+	s.index.Config.TieredStorage.setOnStore(store, s.index.getSchema.NodeName(),
+		s.index.ID(), s.name)
 
+	objectsCompression, _ := s.segmentCompression()
 	err = store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM,
 		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		objectsCompression,
 		lsmkv.WithSecondaryIndices(1),
 		lsmkv.WithMonitorCount(),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
@@ -456,6 +493,7 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 	}
 
 	s.store = store
+	s.statistics = newShardStatistics(store, s.index.logger)
 
 	return nil
 }
@@ -499,6 +537,14 @@ func (s *Shard) drop() error {
 		return errors.Wrap(err, "stop lsmkv store")
 	}
 
+	// the shard is dropped anyway, segments which could not be deleted only
+	// take up space on the tiered storage
+	if err := s.store.DeleteTieredSegments(ctx); err != nil {
+		s.index.logger.WithField("action", "drop_shard").
+			WithField("shard", s.name).WithError(err).
+			Warn("could not delete tiered segments")
+	}
+
 	if _, err := os.Stat(s.pathLSM()); err == nil {
 		err := os.RemoveAll(s.pathLSM())
 		if err != nil {
@@ -612,6 +658,37 @@ func (s *Shard) memtableIdleConfig() lsmkv.BucketOption {
 		time.Duration(s.index.Config.MemtablesFlushIdleAfter) * time.Second)
 }
 
+// compactionConfig of the class is looked up before every compaction, so
+// that changes apply to loaded shards
+func (s *Shard) compactionConfig() lsmkv.CompactionConfig {
+	if s.index.getSchema == nil {
+		return lsmkv.CompactionConfig{}
+	}
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(s.index.Config.ClassName)
+	cfg := lsmkv.CompactionConfig{
+		Strategy:          lsmkv.CompactionStrategySizeTiered,
+		MaxBytesPerSecond: schema.CompactionMaxBytesPerSecond(class),
+	}
+	if schema.CompactionStrategy(class) == schema.CompactionStrategyLeveled {
+		cfg.Strategy = lsmkv.CompactionStrategyLeveled
+	}
+	return cfg
+}
+
+// segmentCompression of the objects and the searchable inverted buckets. It
+// cannot be changed once a class was created, so it is only looked up when
+// the buckets are loaded.
+func (s *Shard) segmentCompression() (objects, inverted lsmkv.BucketOption) {
+	var class *models.Class
+	if s.index.getSchema != nil {
+		sch := s.index.getSchema.GetSchemaSkipAuth()
+		class = sch.GetClass(s.index.Config.ClassName)
+	}
+	return lsmkv.WithCompression(schema.ObjectsCompression(class)),
+		lsmkv.WithCompression(schema.InvertedCompression(class))
+}
+
 func (s *Shard) dynamicMemtableSizing() lsmkv.BucketOption {
 	return lsmkv.WithDynamicMemtableSizing(
 		s.index.Config.MemtablesInitialSizeMB,
@@ -687,8 +764,10 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 	}
 
 	if inverted.HasSearchableIndex(prop) {
+		_, invertedCompression := s.segmentCompression()
 		searchableBucketOpts := append(bucketOpts,
-			lsmkv.WithStrategy(lsmkv.StrategyMapCollection), lsmkv.WithPread(s.index.Config.AvoidMMap))
+			lsmkv.WithStrategy(lsmkv.StrategyMapCollection), lsmkv.WithPread(s.index.Config.AvoidMMap),
+			invertedCompression)
 		if s.versioner.Version() < 2 {
 			searchableBucketOpts = append(searchableBucketOpts, lsmkv.WithLegacyMapSorting())
 		}
@@ -787,6 +866,8 @@ func (s *Shard) Shutdown(ctx context.Context) error {
 		return err
 	}
 
+	s.objectSnapshots.releaseAll()
+
 	if err := s.store.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "stop lsmkv store")
 	}
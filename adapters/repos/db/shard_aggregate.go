@@ -21,8 +21,13 @@ import (
 func (s *Shard) Aggregate(ctx context.Context,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
+	var statistics aggregator.Statistics
+	if s.statistics != nil {
+		statistics = s.statistics
+	}
 	return aggregator.New(s.store, params, s.index.getSchema, s.index.classSearcher,
 		s.index.stopwords, s.versioner.Version(), s.queue, s.index.logger, s.GetPropertyLengthTracker(),
-		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit).
+		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, statistics,
+		s.index.propertyDecrypter()).
 		Do(ctx)
 }
@@ -0,0 +1,268 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/encryption"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// reencryptScanBatchSize is the number of objects which are read from a
+// shard before the ones on a retired key are rewritten, the cursor is closed
+// while writing
+const reencryptScanBatchSize = 1000
+
+// marshalObjectForStorage serializes the object for the objects bucket. The
+// vector is stored in the precision of the vector storage of the index. If
+// property encryption is configured, the values of all properties marked as
+// encrypted are replaced with their encrypted envelope. The passed in object
+// is not modified, callers can continue to work with the plain text values,
+// e.g. to return them to the user.
+func (s *Shard) marshalObjectForStorage(object *storobj.Object) ([]byte, error) {
+	// shallow copy, only the version and the properties differ from the
+	// original
+	toStore := *object
+	toStore.MarshallerVersion = s.index.objectMarshallerVersion()
+
+	keyring := s.index.Config.PropertyEncryption
+	if keyring == nil {
+		return toStore.MarshalBinary()
+	}
+
+	names := s.index.encryptedPropNames()
+	if len(names) == 0 {
+		return toStore.MarshalBinary()
+	}
+
+	props, ok := object.Object.Properties.(map[string]interface{})
+	if !ok {
+		return toStore.MarshalBinary()
+	}
+
+	encrypted, err := keyring.EncryptProperties(props, names)
+	if err != nil {
+		return nil, errors.Wrapf(err, "object %s", object.ID())
+	}
+
+	toStore.Object.Properties = encrypted
+	return toStore.MarshalBinary()
+}
+
+// encryptedPropNames returns the names of the properties of the class of the
+// index which are marked as encrypted
+func (i *Index) encryptedPropNames() []string {
+	sch := i.getSchema.GetSchemaSkipAuth()
+	return encryption.EncryptedPropNames(sch.GetClass(i.Config.ClassName))
+}
+
+// propertyDecrypter decrypts the properties of the class of the index which
+// are marked as encrypted, it is nil if property encryption is turned off or
+// the class has no encrypted properties
+func (i *Index) propertyDecrypter() storobj.PropertyDecrypter {
+	if i.Config.PropertyEncryption == nil {
+		return nil
+	}
+	names := i.encryptedPropNames()
+	if len(names) == 0 {
+		return nil
+	}
+	return i.Config.PropertyEncryption.PropertyDecrypter(names)
+}
+
+// decryptObjects restores the plain text of the encrypted properties of
+// objects read from the objects bucket in place
+func (i *Index) decryptObjects(objs ...*storobj.Object) error {
+	return storobj.DecryptProperties(i.propertyDecrypter(), objs...)
+}
+
+// objectFromBinary unmarshals an object as stored in the objects bucket and
+// decrypts its properties
+func (i *Index) objectFromBinary(data []byte) (*storobj.Object, error) {
+	obj, err := storobj.FromBinary(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.decryptObjects(obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// ReencryptProperties rewrites the objects of all local shards which still
+// contain values encrypted with a retired key. Once it has completed, the
+// retired keys can be removed from the configuration. It returns the number
+// of objects which were rewritten.
+func (db *DB) ReencryptProperties(ctx context.Context) (int, error) {
+	keyring := db.config.PropertyEncryption
+	if keyring == nil {
+		return 0, nil
+	}
+
+	db.indexLock.RLock()
+	indices := make([]*Index, 0, len(db.indices))
+	for _, idx := range db.indices {
+		indices = append(indices, idx)
+	}
+	db.indexLock.RUnlock()
+
+	total := 0
+	for _, idx := range indices {
+		err := idx.ForEachShard(func(name string, shard ShardLike) error {
+			count, err := shard.reencryptProperties(ctx, keyring)
+			total += count
+			if err != nil {
+				return errors.Wrapf(err, "shard %q", name)
+			}
+			return nil
+		})
+		if err != nil {
+			return total, errors.Wrapf(err, "class %q", idx.Config.ClassName)
+		}
+	}
+
+	return total, nil
+}
+
+// reencryptProperties rewrites all objects of the shard which contain values
+// encrypted with a retired key. Only the objects bucket holds ciphertext, the
+// inverted and vector indexes are not affected. Previous versions of an
+// object and archived write-ahead log entries keep their original key until
+// they expire.
+func (s *Shard) reencryptProperties(ctx context.Context,
+	keyring *encryption.Keyring,
+) (int, error) {
+	if s.isReadOnly() {
+		return 0, storagestate.ErrStatusReadOnly
+	}
+
+	names := s.index.encryptedPropNames()
+	if len(names) == 0 {
+		return 0, nil
+	}
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	count := 0
+	var after []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+
+		ids, last, err := scanRetiredEnvelopes(bucket, keyring, names, after, reencryptScanBatchSize)
+		if err != nil {
+			return count, errors.Wrap(err, "scan objects")
+		}
+		for _, id := range ids {
+			rewritten, err := s.reencryptObject(bucket, keyring, names, id)
+			if err != nil {
+				return count, err
+			}
+			if rewritten {
+				count++
+			}
+		}
+		if last == nil {
+			return count, nil
+		}
+		after = last
+	}
+}
+
+// scanRetiredEnvelopes reads up to n objects after the key after and returns
+// the ids of those whose named properties contain values encrypted with a
+// retired key, as well
+// as the key of the last object which was read. The last key is nil once the
+// bucket is exhausted.
+func scanRetiredEnvelopes(bucket *lsmkv.Bucket, keyring *encryption.Keyring,
+	names []string, after []byte, n int,
+) ([][]byte, []byte, error) {
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	k, v := cursor.First()
+	if after != nil {
+		k, v = cursor.Seek(after)
+		if k != nil && bytes.Equal(k, after) {
+			k, v = cursor.Next()
+		}
+	}
+
+	var ids [][]byte
+	var last []byte
+	for read := 0; k != nil && read < n; k, v = cursor.Next() {
+		read++
+		last = append(last[:0], k...)
+
+		obj, err := storobj.FromBinary(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		props, ok := obj.Object.Properties.(map[string]interface{})
+		if ok && keyring.NeedsReencryption(props, names) {
+			ids = append(ids, append([]byte{}, k...))
+		}
+	}
+
+	if k == nil {
+		return ids, nil, nil
+	}
+	return ids, last, nil
+}
+
+// reencryptObject rewrites a single object under its id lock, so concurrent
+// writes are not lost
+func (s *Shard) reencryptObject(bucket *lsmkv.Bucket, keyring *encryption.Keyring,
+	names []string, id []byte,
+) (bool, error) {
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(id)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	data, err := bucket.Get(id)
+	if err != nil || data == nil {
+		// deleted in the meantime
+		return false, err
+	}
+
+	obj, err := storobj.FromBinary(data)
+	if err != nil {
+		return false, err
+	}
+	props, ok := obj.Object.Properties.(map[string]interface{})
+	if !ok {
+		return false, nil
+	}
+	changed, err := keyring.ReencryptProperties(props, names)
+	if err != nil || !changed {
+		return false, errors.Wrapf(err, "object %s", obj.ID())
+	}
+
+	data, err = obj.MarshalBinary()
+	if err != nil {
+		return false, errors.Wrapf(err, "marshal object %s", obj.ID())
+	}
+	docID := make([]byte, 8)
+	binary.LittleEndian.PutUint64(docID, obj.DocID())
+	if err := bucket.Put(id, data, lsmkv.WithSecondaryKey(0, docID)); err != nil {
+		return false, errors.Wrapf(err, "store object %s", obj.ID())
+	}
+
+	return true, nil
+}
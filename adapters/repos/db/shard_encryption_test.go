@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/encryption"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestShard_PropertyEncryption(t *testing.T) {
+	ctx := testCtx()
+	className := "Patient"
+	vFalse := false
+
+	keyring, err := encryption.NewKeyring([]encryption.Key{
+		{ID: "k1", Material: bytes.Repeat([]byte{1}, 32)},
+	})
+	require.Nil(t, err)
+
+	class := &models.Class{
+		Class: className,
+		Properties: []*models.Property{
+			{
+				Name:            "diagnosis",
+				DataType:        schema.DataTypeText.PropString(),
+				IndexFilterable: &vFalse,
+				IndexSearchable: &vFalse,
+				Encrypted:       true,
+			},
+		},
+	}
+
+	shd, idx := testShard(t, ctx, className, func(idx *Index) {
+		idx.Config.PropertyEncryption = keyring
+		idx.getSchema = &fakeSchemaGetter{
+			shardState: singleShardState(),
+			schema: schema.Schema{
+				Objects: &models.Schema{Classes: []*models.Class{class}},
+			},
+		}
+	})
+
+	obj := testObject(className)
+	obj.Object.Properties = map[string]interface{}{"diagnosis": "very secret"}
+	require.Nil(t, shd.PutObject(ctx, obj))
+
+	t.Run("the caller's object is not modified", func(t *testing.T) {
+		assert.Equal(t, "very secret", obj.Object.Properties.(map[string]interface{})["diagnosis"])
+	})
+
+	t.Run("stored bytes do not contain the plain text", func(t *testing.T) {
+		id, err := uuid.MustParse(obj.ID().String()).MarshalBinary()
+		require.Nil(t, err)
+		raw, err := shd.Store().Bucket(helpers.ObjectsBucketLSM).Get(id)
+		require.Nil(t, err)
+		require.NotNil(t, raw)
+		assert.False(t, bytes.Contains(raw, []byte("very secret")))
+	})
+
+	t.Run("reading the object returns the plain text", func(t *testing.T) {
+		res, err := shd.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "very secret", res.Object.Properties.(map[string]interface{})["diagnosis"])
+	})
+
+	t.Run("stored objects are unmarshalled without decryption", func(t *testing.T) {
+		id, err := uuid.MustParse(obj.ID().String()).MarshalBinary()
+		require.Nil(t, err)
+		raw, err := shd.Store().Bucket(helpers.ObjectsBucketLSM).Get(id)
+		require.Nil(t, err)
+		stored, err := storobj.FromBinary(raw)
+		require.Nil(t, err)
+		diagnosis := stored.Object.Properties.(map[string]interface{})["diagnosis"].(string)
+		assert.True(t, encryption.IsEncrypted(diagnosis))
+	})
+
+	t.Run("rotating the key re-encrypts stored values", func(t *testing.T) {
+		rotated, err := encryption.NewKeyring([]encryption.Key{
+			{ID: "k2", Material: bytes.Repeat([]byte{2}, 32)},
+			{ID: "k1", Material: bytes.Repeat([]byte{1}, 32)},
+		})
+		require.Nil(t, err)
+		idx.Config.PropertyEncryption = rotated
+
+		count, err := shd.reencryptProperties(ctx, rotated)
+		require.Nil(t, err)
+		assert.Equal(t, 1, count)
+
+		id, err := uuid.MustParse(obj.ID().String()).MarshalBinary()
+		require.Nil(t, err)
+		raw, err := shd.Store().Bucket(helpers.ObjectsBucketLSM).Get(id)
+		require.Nil(t, err)
+		stored, err := storobj.FromBinary(raw)
+		require.Nil(t, err)
+		keyID, ok := encryption.KeyID(stored.Object.Properties.(map[string]interface{})["diagnosis"].(string))
+		require.True(t, ok)
+		assert.Equal(t, "k2", keyID)
+
+		count, err = shd.reencryptProperties(ctx, rotated)
+		require.Nil(t, err)
+		assert.Equal(t, 0, count, "nothing left on the retired key")
+
+		onlyNew, err := encryption.NewKeyring([]encryption.Key{
+			{ID: "k2", Material: bytes.Repeat([]byte{2}, 32)},
+		})
+		require.Nil(t, err)
+		idx.Config.PropertyEncryption = onlyNew
+		res, err := shd.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "very secret", res.Object.Properties.(map[string]interface{})["diagnosis"])
+	})
+
+	require.Nil(t, idx.drop())
+}
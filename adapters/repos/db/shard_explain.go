@@ -0,0 +1,166 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/tracing"
+)
+
+// flatSearchCutoff is implemented by vector indexes which search the allowed
+// vectors of filters matching few objects without the index
+type flatSearchCutoff interface {
+	FlatSearchCutoff() int
+}
+
+// shardPlan records the plan and the stage timings of a search on a shard
+// of an explained query. The stages of traced queries are recorded as spans
+// of the shard search. It is nil if the query is neither explained nor
+// traced, all of its methods are nil-safe.
+type shardPlan struct {
+	explain *search.Explain
+	span    *tracing.Span
+	plan    search.ShardPlan
+	last    time.Time
+}
+
+func (s *Shard) startPlan(ctx context.Context, operation string, limit int) (context.Context, *shardPlan) {
+	explain := search.ExplainFromContext(ctx)
+	ctx, span := tracing.Start(ctx, "shard."+operation)
+	if explain == nil && span == nil {
+		return ctx, nil
+	}
+
+	span.SetAttribute("class", s.index.Config.ClassName.String())
+	span.SetAttribute("shard", s.name)
+	span.SetAttribute("limit", limit)
+	p := &shardPlan{
+		explain: explain,
+		span:    span,
+		plan: search.ShardPlan{
+			Shard: s.name,
+			Node:  s.index.getSchema.NodeName(),
+			Limit: limit,
+		},
+		last: time.Now(),
+	}
+	if explain != nil {
+		p.plan.Objects = s.ObjectCount()
+	}
+	return ctx, p
+}
+
+// execute is false if the search must stop once it is planned
+func (p *shardPlan) execute() bool {
+	return p == nil || p.explain.Execute()
+}
+
+// stage records the time since the previous stage
+func (p *shardPlan) stage(name string) {
+	if p == nil {
+		return
+	}
+	now := time.Now()
+	p.plan.Stages = append(p.plan.Stages, search.NewStage(name, now.Sub(p.last)))
+	p.span.RecordChild(name, p.last, now)
+	p.last = now
+}
+
+func (p *shardPlan) filtered(allowList helpers.AllowList) {
+	if p == nil || allowList == nil {
+		return
+	}
+	matches := allowList.Len()
+	p.plan.FilterMatches = &matches
+	p.span.SetAttribute("filter_matches", matches)
+}
+
+// vectorStrategy plans a vector search the way the vector index executes it
+func (p *shardPlan) vectorStrategy(index VectorIndex, allowList helpers.AllowList) {
+	if p == nil {
+		return
+	}
+
+	cutoff, ok := index.(flatSearchCutoff)
+	switch {
+	case !ok:
+		p.plan.Strategy = search.StrategyFlatIndex
+	case allowList == nil:
+		p.plan.Strategy = search.StrategyVectorIndex
+	case allowList.Len() < cutoff.FlatSearchCutoff():
+		p.plan.Strategy = search.StrategyPreFilterFlatSearch
+	default:
+		p.plan.Strategy = search.StrategyPreFilterVectorIndex
+	}
+	if ok {
+		p.plan.FlatSearchCutoff = cutoff.FlatSearchCutoff()
+	}
+	p.expectCandidates()
+}
+
+func (p *shardPlan) strategy(strategy string) {
+	if p == nil {
+		return
+	}
+	p.plan.Strategy = strategy
+	p.expectCandidates()
+}
+
+func (p *shardPlan) expectCandidates() {
+	p.span.SetAttribute("strategy", p.plan.Strategy)
+	p.plan.ExpectedCandidates = p.plan.Objects
+	if p.plan.FilterMatches != nil {
+		p.plan.ExpectedCandidates = *p.plan.FilterMatches
+	}
+}
+
+// finish adds the plan to the explained query, results is the number of
+// results of an executed search
+func (p *shardPlan) finish(results int) {
+	if p == nil {
+		return
+	}
+	if p.explain.Execute() {
+		p.plan.Results = &results
+		p.span.SetAttribute("results", results)
+	}
+	p.explain.AddShard(p.plan)
+}
+
+// end finishes the span of the shard search, also if the search failed
+func (p *shardPlan) end() {
+	if p == nil {
+		return
+	}
+	p.span.End()
+}
+
+// explainRemoteShard records the search of a remote shard of an explained
+// query, only its duration and results are known on this node. Remote
+// shards are not searched if the query is only planned.
+func explainRemoteShard(ctx context.Context, shard, node string, before time.Time, results int) {
+	explain := search.ExplainFromContext(ctx)
+	if explain == nil {
+		return
+	}
+
+	plan := search.ShardPlan{Shard: shard, Node: node, Strategy: search.StrategyRemote}
+	if explain.Execute() {
+		plan.Stages = []search.Stage{search.NewStage("remote", time.Since(before))}
+		plan.Results = &results
+	}
+	explain.AddShard(plan)
+}
@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/tracing"
+)
+
+type cutoffIndex struct {
+	VectorIndex
+	cutoff int
+}
+
+func (i cutoffIndex) FlatSearchCutoff() int {
+	return i.cutoff
+}
+
+func TestShardPlan(t *testing.T) {
+	newPlan := func(execute bool) (*shardPlan, *search.Explain) {
+		_, explain := search.WithExplain(context.Background(), execute)
+		return &shardPlan{
+			explain: explain,
+			plan:    search.ShardPlan{Shard: "shard1", Objects: 1000, Limit: 10},
+		}, explain
+	}
+	hnsw := cutoffIndex{cutoff: 40}
+
+	t.Run("vector strategies", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			index     VectorIndex
+			allowList helpers.AllowList
+			strategy  string
+			expected  int
+		}{
+			{"unfiltered", hnsw, nil, search.StrategyVectorIndex, 1000},
+			{"below cutoff", hnsw, helpers.NewAllowList(1, 2, 3), search.StrategyPreFilterFlatSearch, 3},
+			{"above cutoff", hnsw, helpers.NewAllowList(makeIDs(50)...), search.StrategyPreFilterVectorIndex, 50},
+			{"flat index", noop.NewIndex(), helpers.NewAllowList(1, 2), search.StrategyFlatIndex, 2},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				p, explain := newPlan(true)
+				p.filtered(test.allowList)
+				p.vectorStrategy(test.index, test.allowList)
+				p.stage("vector")
+				p.finish(7)
+
+				shards := explain.Shards()
+				require.Len(t, shards, 1)
+				assert.Equal(t, test.strategy, shards[0].Strategy)
+				assert.Equal(t, test.expected, shards[0].ExpectedCandidates)
+				assert.Equal(t, 7, *shards[0].Results)
+				assert.Equal(t, "vector", shards[0].Stages[0].Name)
+			})
+		}
+	})
+
+	t.Run("plan only", func(t *testing.T) {
+		p, explain := newPlan(false)
+		assert.False(t, p.execute())
+		p.strategy(search.StrategyList)
+		p.finish(0)
+		shards := explain.Shards()
+		require.Len(t, shards, 1)
+		assert.Nil(t, shards[0].Results)
+	})
+
+	t.Run("traced", func(t *testing.T) {
+		exporter := &spanRecorder{}
+		ctx, _ := tracing.NewTracer(exporter, 1).StartServer(context.Background(), "request")
+		_, span := tracing.Start(ctx, "shard.ObjectVectorSearch")
+		p := &shardPlan{span: span, plan: search.ShardPlan{Shard: "shard1"}}
+		allowList := helpers.NewAllowList(1, 2, 3)
+		p.filtered(allowList)
+		p.stage("filter")
+		p.vectorStrategy(hnsw, allowList)
+		p.stage("vector")
+		assert.True(t, p.execute())
+		p.finish(3)
+		p.end()
+
+		require.Len(t, exporter.spans, 3)
+		assert.Equal(t, "filter", exporter.spans[0].Name)
+		assert.Equal(t, "vector", exporter.spans[1].Name)
+		shard := exporter.spans[2]
+		for _, stage := range exporter.spans[:2] {
+			assert.Equal(t, shard.Context.SpanID, stage.Parent)
+		}
+		assert.Equal(t, map[string]interface{}{
+			"filter_matches": 3,
+			"strategy":       search.StrategyPreFilterFlatSearch,
+			"results":        3,
+		}, shard.Attributes)
+	})
+
+	t.Run("not explained", func(t *testing.T) {
+		var p *shardPlan
+		assert.True(t, p.execute())
+		p.stage("vector")
+		p.vectorStrategy(hnsw, nil)
+		p.finish(1)
+		p.end()
+	})
+}
+
+type spanRecorder struct {
+	spans []tracing.SpanData
+}
+
+func (r *spanRecorder) ExportSpan(span tracing.SpanData) {
+	r.spans = append(r.spans, span)
+}
+
+func makeIDs(n int) []uint64 {
+	ids := make([]uint64, n)
+	for i := range ids {
+		ids[i] = uint64(i)
+	}
+	return ids
+}
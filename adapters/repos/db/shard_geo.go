@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// geoClause returns the clause of a filter consisting of a single geo range
+// or shape on a property of the class itself.
+func geoClause(filter *filters.LocalFilter) (*filters.Clause, bool) {
+	if filter == nil || filter.Root == nil {
+		return nil, false
+	}
+	clause := filter.Root
+	if !clause.Operator.IsGeo() || clause.On == nil || clause.On.Child != nil ||
+		clause.Value == nil {
+		return nil, false
+	}
+	return clause, true
+}
+
+// geoAllowList builds the allow list of a geo clause straight from the geo
+// index of its property. Searches such as "nearest matches within 10km" thus
+// pass all coordinates of the range as candidates into the vector index.
+func (s *Shard) geoAllowList(ctx context.Context, clause *filters.Clause) (helpers.AllowList, error) {
+	propName := clause.On.Property.String()
+	propIndex, ok := s.propertyIndices.ByProp(propName)
+	if !ok || propIndex.GeoIndex == nil {
+		return helpers.NewAllowList(), nil
+	}
+
+	list, err := propIndex.GeoIndex.AllowList(ctx, clause.Value.Value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "geo index %s search on prop %q",
+			clause.Operator.Name(), propName)
+	}
+
+	s.metrics.FilterSelectivity(list.Len(), s.ObjectCount())
+	return list, nil
+}
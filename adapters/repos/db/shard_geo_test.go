@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestShardGeoClause(t *testing.T) {
+	lat, lon := float32(48.13743), float32(11.57549)
+	within := &filters.Clause{
+		Operator: filters.OperatorWithinGeoRange,
+		On:       &filters.Path{Class: "Store", Property: "location"},
+		Value: &filters.Value{
+			Value: filters.GeoRange{
+				GeoCoordinates: &models.GeoCoordinates{Latitude: &lat, Longitude: &lon},
+				Distance:       10000,
+			},
+			Type: schema.DataTypeGeoCoordinates,
+		},
+	}
+
+	t.Run("single geo clause", func(t *testing.T) {
+		clause, ok := geoClause(&filters.LocalFilter{Root: within})
+		require.True(t, ok)
+		assert.Equal(t, within, clause)
+	})
+
+	t.Run("geo clause combined with other clauses", func(t *testing.T) {
+		_, ok := geoClause(&filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{*within, *within},
+		}})
+		assert.False(t, ok)
+	})
+
+	t.Run("geo clause on a referenced class", func(t *testing.T) {
+		_, ok := geoClause(&filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorWithinGeoRange,
+			On: &filters.Path{
+				Class: "Order", Property: "store",
+				Child: &filters.Path{Class: "Store", Property: "location"},
+			},
+			Value: within.Value,
+		}})
+		assert.False(t, ok)
+	})
+
+	t.Run("no filter", func(t *testing.T) {
+		_, ok := geoClause(nil)
+		assert.False(t, ok)
+	})
+}
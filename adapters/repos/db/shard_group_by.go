@@ -44,7 +44,8 @@ func (s *Shard) groupResults(ctx context.Context, ids []uint64,
 			err, groupBy.Property)
 	}
 
-	return newGrouper(ids, dists, groupBy, objsBucket, dt, additional).Do(ctx)
+	return newGrouper(ids, dists, groupBy, objsBucket, dt, additional,
+		s.index.propertyDecrypter()).Do(ctx)
 }
 
 type grouper struct {
@@ -54,12 +55,13 @@ type grouper struct {
 	additional       additional.Properties
 	propertyDataType schema.PropertyDataType
 	objBucket        *lsmkv.Bucket
+	decrypter        storobj.PropertyDecrypter
 }
 
 func newGrouper(ids []uint64, dists []float32,
 	groupBy *searchparams.GroupBy, objBucket *lsmkv.Bucket,
 	propertyDataType schema.PropertyDataType,
-	additional additional.Properties,
+	additional additional.Properties, decrypter storobj.PropertyDecrypter,
 ) *grouper {
 	return &grouper{
 		ids:              ids,
@@ -68,6 +70,7 @@ func newGrouper(ids []uint64, dists []float32,
 		objBucket:        objBucket,
 		propertyDataType: propertyDataType,
 		additional:       additional,
+		decrypter:        decrypter,
 	}
 }
 
@@ -122,6 +125,9 @@ DOCS_LOOP:
 				if err != nil {
 					return nil, nil, fmt.Errorf("%w: unmarshal data object at position %d", err, i)
 				}
+				if err := storobj.DecryptProperties(g.decrypter, unmarshalled); err != nil {
+					return nil, nil, err
+				}
 				docIDObject[docID] = unmarshalled
 				docIDDistance[docID] = g.dists[i]
 			}
@@ -201,6 +207,9 @@ func (g *grouper) getUnmarshalled(docID uint64,
 		if err != nil {
 			return nil, fmt.Errorf("%w: unmarshal data object doc id %d", err, docID)
 		}
+		if err := storobj.DecryptProperties(g.decrypter, unmarshalled); err != nil {
+			return nil, err
+		}
 		return unmarshalled, nil
 	}
 	return docIDObject[docID], nil
@@ -17,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -56,6 +57,15 @@ func (s *Shard) initProperties(class *models.Class) error {
 		})
 	}
 
+	if schema.VersioningEnabled(class) {
+		eg.Go(func() error {
+			if err := s.addVersionsBucket(context.TODO()); err != nil {
+				return errors.Wrap(err, "create versions bucket")
+			}
+			return nil
+		})
+	}
+
 	if err := eg.Wait(); err != nil {
 		return errors.Wrapf(err, "init properties on shard '%s'", s.ID())
 	}
@@ -28,6 +28,8 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
@@ -421,6 +423,36 @@ func (l *LazyLoadShard) WasDeleted(ctx context.Context, id strfmt.UUID) (bool, e
 	return l.shard.WasDeleted(ctx, id)
 }
 
+func (l *LazyLoadShard) hashTreeLeaves(ctx context.Context, depth int) ([]uint64, error) {
+	if err := l.Load(ctx); err != nil {
+		return nil, err
+	}
+	return l.shard.hashTreeLeaves(ctx, depth)
+}
+
+func (l *LazyLoadShard) leafDigests(ctx context.Context, depth, leaf int) ([]replica.RepairResponse, error) {
+	if err := l.Load(ctx); err != nil {
+		return nil, err
+	}
+	return l.shard.leafDigests(ctx, depth, leaf)
+}
+
+func (l *LazyLoadShard) scrub(ctx context.Context) ([]diskio.CorruptFile, error) {
+	if !l.isLoaded() {
+		return nil, nil
+	}
+	return l.shard.scrub(ctx)
+}
+
+func (l *LazyLoadShard) reencryptProperties(ctx context.Context,
+	keyring *encryption.Keyring,
+) (int, error) {
+	if err := l.Load(ctx); err != nil {
+		return 0, err
+	}
+	return l.shard.reencryptProperties(ctx, keyring)
+}
+
 func (l *LazyLoadShard) VectorIndex() VectorIndex {
 	l.mustLoad()
 	return l.shard.VectorIndex()
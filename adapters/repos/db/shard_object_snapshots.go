@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// objectSnapshotIdleTimeout is the time after which a snapshot of the
+// objects bucket is released if it was not read anymore
+var objectSnapshotIdleTimeout = time.Minute
+
+// objectSnapshots are the snapshots of the objects bucket of a shard which
+// are read by cursors, by the snapshot id of the cursor. A snapshot is
+// released once a cursor reached the end of the shard, when it was not read
+// for objectSnapshotIdleTimeout or when the shard is shut down. The id of a
+// snapshot which reached the end is kept until it was not read for
+// objectSnapshotIdleTimeout, cursors only move forward so its remaining
+// pages are empty rather than read from a new snapshot.
+type objectSnapshots struct {
+	sync.Mutex
+	snapshots map[string]*objectSnapshot
+}
+
+type objectSnapshot struct {
+	*lsmkv.Snapshot // nil once the end was reached
+	timer           *time.Timer
+}
+
+// get returns the snapshot with the given id, it is taken if it does not
+// exist yet. It returns nil if a cursor reached the end of the snapshot.
+func (o *objectSnapshots) get(id string, bucket *lsmkv.Bucket) *lsmkv.Snapshot {
+	o.Lock()
+	defer o.Unlock()
+
+	if snap, ok := o.snapshots[id]; ok {
+		snap.timer.Reset(objectSnapshotIdleTimeout)
+		return snap.Snapshot
+	}
+
+	if o.snapshots == nil {
+		o.snapshots = map[string]*objectSnapshot{}
+	}
+	snap := &objectSnapshot{Snapshot: bucket.Snapshot()}
+	snap.timer = time.AfterFunc(objectSnapshotIdleTimeout, func() {
+		o.Lock()
+		defer o.Unlock()
+		if o.snapshots[id] == snap {
+			o.releaseLocked(id)
+		}
+	})
+	o.snapshots[id] = snap
+	return snap.Snapshot
+}
+
+// exhaust releases the snapshot with the given id but keeps its id, a
+// cursor reached the end of the snapshot
+func (o *objectSnapshots) exhaust(id string) {
+	o.Lock()
+	defer o.Unlock()
+	if snap, ok := o.snapshots[id]; ok && snap.Snapshot != nil {
+		snap.Release()
+		snap.Snapshot = nil
+	}
+}
+
+func (o *objectSnapshots) releaseAll() {
+	o.Lock()
+	defer o.Unlock()
+	for id := range o.snapshots {
+		o.releaseLocked(id)
+	}
+}
+
+func (o *objectSnapshots) releaseLocked(id string) {
+	if snap, ok := o.snapshots[id]; ok {
+		snap.timer.Stop()
+		if snap.Snapshot != nil {
+			snap.Release()
+		}
+		delete(o.snapshots, id)
+	}
+}
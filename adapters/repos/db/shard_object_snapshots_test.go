@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+func TestObjectListSnapshot(t *testing.T) {
+	ctx := context.Background()
+	shard, _ := testShard(t, ctx, "Snapshotted")
+
+	ids := []strfmt.UUID{
+		"a0b55b05-bc5b-4cc9-b646-1452d1390a62",
+		"b0b55b05-bc5b-4cc9-b646-1452d1390a62",
+		"c0b55b05-bc5b-4cc9-b646-1452d1390a62",
+		"d0b55b05-bc5b-4cc9-b646-1452d1390a62",
+	}
+	put := func(id strfmt.UUID) {
+		obj := testObject("Snapshotted")
+		obj.Object.ID = id
+		require.Nil(t, shard.PutObject(ctx, obj))
+	}
+	list := func(c *filters.Cursor) []strfmt.UUID {
+		objs, err := shard.ObjectList(ctx, c.Limit, nil, c, additional.Properties{}, "Snapshotted")
+		require.Nil(t, err)
+		out := make([]strfmt.UUID, len(objs))
+		for i, obj := range objs {
+			out[i] = obj.ID()
+		}
+		return out
+	}
+	for _, id := range ids[:3] {
+		put(id)
+	}
+
+	cursor := &filters.Cursor{Limit: 2, Snapshot: "export-1"}
+	assert.Equal(t, ids[:2], list(cursor))
+
+	// writes while the cursor is read
+	require.Nil(t, shard.DeleteObject(ctx, ids[2]))
+	put(ids[3])
+	require.Nil(t, shard.Store().Bucket(helpers.ObjectsBucketLSM).FlushAndSwitch())
+
+	t.Run("following pages read the snapshot", func(t *testing.T) {
+		cursor.After = ids[1].String()
+		assert.Equal(t, ids[2:3], list(cursor))
+	})
+
+	t.Run("pages after the end are empty", func(t *testing.T) {
+		cursor.After = ids[2].String()
+		assert.Empty(t, list(cursor))
+	})
+
+	t.Run("without snapshot", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{ids[0], ids[1], ids[3]},
+			list(&filters.Cursor{Limit: 10}))
+	})
+
+	t.Run("released on shutdown", func(t *testing.T) {
+		snapshots := &shard.(*LazyLoadShard).shard.objectSnapshots
+		list(&filters.Cursor{Limit: 10, Snapshot: "export-2"})
+		require.Len(t, snapshots.snapshots, 2)
+
+		snapshots.releaseAll()
+		assert.Empty(t, snapshots.snapshots)
+	})
+}
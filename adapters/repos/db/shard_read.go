@@ -22,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -31,6 +32,7 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/scheduler"
 )
 
 func (s *Shard) ObjectByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties, additional additional.Properties) (*storobj.Object, error) {
@@ -48,7 +50,7 @@ func (s *Shard) ObjectByID(ctx context.Context, id strfmt.UUID, props search.Sel
 		return nil, nil
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := s.index.objectFromBinary(bytes)
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal object")
 	}
@@ -80,7 +82,7 @@ func (s *Shard) MultiObjectByID(ctx context.Context, query []multi.Identifier) (
 			continue
 		}
 
-		obj, err := storobj.FromBinary(bytes)
+		obj, err := s.index.objectFromBinary(bytes)
 		if err != nil {
 			return nil, errors.Wrap(err, "unmarshal kind object")
 		}
@@ -128,7 +130,7 @@ func (s *Shard) objectByIndexID(ctx context.Context, indexID uint64, acceptDelet
 			"uuid found for docID, but object is nil")
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := s.index.objectFromBinary(bytes)
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal kind object")
 	}
@@ -160,6 +162,14 @@ func (s *Shard) readVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64
 }
 
 func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties) ([]*storobj.Object, []float32, error) {
+	release, err := s.acquireSearchSlot(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	ctx, plan := s.startPlan(ctx, "ObjectSearch", limit)
+	defer plan.end()
 	if keywordRanking != nil {
 		if v := s.versioner.Version(); v < 2 {
 			return nil, nil, errors.Errorf(
@@ -184,6 +194,15 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 			}
 
 			filterDocIds = objs
+			plan.stage("filter")
+			plan.filtered(filterDocIds)
+			plan.strategy(search.StrategyPreFilterBM25)
+		} else {
+			plan.strategy(search.StrategyBM25)
+		}
+		if !plan.execute() {
+			plan.finish(0)
+			return nil, nil, nil
 		}
 
 		className := s.index.Config.ClassName
@@ -195,20 +214,49 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 		if err != nil {
 			return nil, nil, err
 		}
+		plan.stage("bm25")
+		plan.finish(len(bm25objs))
 
-		return bm25objs, bm25count, nil
+		return bm25objs, bm25count, s.index.decryptObjects(bm25objs...)
 	}
 
 	if filters == nil {
+		plan.strategy(search.StrategyList)
+		if !plan.execute() {
+			plan.finish(0)
+			return nil, nil, nil
+		}
 		objs, err := s.ObjectList(ctx, limit, sort,
 			cursor, additional, s.index.Config.ClassName)
+		plan.stage("list")
+		plan.finish(len(objs))
 		return objs, nil, err
 	}
+
+	if !plan.execute() {
+		// the filter is evaluated to count the candidates
+		allowList, err := s.buildAllowList(ctx, filters, additional)
+		if err != nil {
+			return nil, nil, err
+		}
+		plan.stage("filter")
+		plan.filtered(allowList)
+		plan.strategy(search.StrategyFilter)
+		plan.finish(0)
+		return nil, nil, nil
+	}
+
+	plan.strategy(search.StrategyFilter)
 	objs, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.GetSchemaSkipAuth(),
 		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit).
 		Objects(ctx, limit, filters, sort, additional, s.index.Config.ClassName)
-	return objs, nil, err
+	if err != nil {
+		return nil, nil, err
+	}
+	plan.stage("filter")
+	plan.finish(len(objs))
+	return objs, nil, s.index.decryptObjects(objs...)
 }
 
 func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties) ([]*storobj.Object, []float32, error) {
@@ -219,6 +267,14 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32,
 		allowList helpers.AllowList
 	)
 
+	release, err := s.acquireSearchSlot(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	ctx, plan := s.startPlan(ctx, "ObjectVectorSearch", limit)
+	defer plan.end()
 	if filters != nil {
 		beforeFilter := time.Now()
 		list, err := s.buildAllowList(ctx, filters, additional)
@@ -227,22 +283,36 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32,
 		}
 		allowList = list
 		s.metrics.FilteredVectorFilter(time.Since(beforeFilter))
+		plan.stage("filter")
+		plan.filtered(allowList)
+	}
+
+	plan.vectorStrategy(s.vectorIndex, allowList)
+	if !plan.execute() {
+		plan.finish(0)
+		return nil, nil, nil
 	}
 
 	beforeVector := time.Now()
 	if limit < 0 {
 		ids, dists, err = s.queue.SearchByVectorDistance(
-			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
+			ctx, searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search by distance")
 		}
 	} else {
-		ids, dists, err = s.queue.SearchByVector(searchVector, limit, allowList)
+		ids, dists, err = s.queue.SearchByVector(ctx, searchVector, limit, allowList)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search")
 		}
+		// results which can not make it into the merged results of the query
+		// are dropped before their objects are loaded
+		n := search.CompetitiveResults(ctx, dists)
+		ids, dists = ids[:n], dists[:n]
 	}
+	plan.stage("vector")
 	if len(ids) == 0 {
+		plan.finish(0)
 		return nil, nil, nil
 	}
 
@@ -251,7 +321,10 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32,
 	}
 
 	if groupBy != nil {
-		return s.groupResults(ctx, ids, dists, groupBy, additional)
+		objs, dists, err := s.groupResults(ctx, ids, dists, groupBy, additional)
+		plan.stage("group")
+		plan.finish(len(objs))
+		return objs, dists, err
 	}
 
 	if len(sort) > 0 {
@@ -264,6 +337,7 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32,
 		if filters != nil {
 			s.metrics.FilteredVectorSort(time.Since(beforeSort))
 		}
+		plan.stage("sort")
 	}
 
 	beforeObjects := time.Now()
@@ -273,10 +347,15 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32,
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := s.index.decryptObjects(objs...); err != nil {
+		return nil, nil, err
+	}
 
 	if filters != nil {
 		s.metrics.FilteredVectorObjects(time.Since(beforeObjects))
 	}
+	plan.stage("objects")
+	plan.finish(len(objs))
 
 	return objs, dists, nil
 }
@@ -288,7 +367,11 @@ func (s *Shard) ObjectList(ctx context.Context, limit int, sort []filters.Sort,
 			return nil, err
 		}
 		bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-		return storobj.ObjectsByDocID(bucket, docIDs, additional)
+		objs, err := storobj.ObjectsByDocID(bucket, docIDs, additional)
+		if err != nil {
+			return nil, err
+		}
+		return objs, s.index.decryptObjects(objs...)
 	}
 
 	if cursor == nil {
@@ -301,7 +384,21 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 	additional additional.Properties,
 	className schema.ClassName,
 ) ([]*storobj.Object, error) {
-	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	var cursor *lsmkv.CursorReplace
+	if c.Snapshot != "" {
+		snap := s.objectSnapshots.get(c.Snapshot, bucket)
+		if snap == nil {
+			return nil, nil
+		}
+		var err error
+		cursor, err = snap.Cursor()
+		if err != nil {
+			return nil, errors.Wrapf(err, "snapshot %q", c.Snapshot)
+		}
+	} else {
+		cursor = bucket.Cursor()
+	}
 	defer cursor.Close()
 
 	var key, val []byte
@@ -323,7 +420,7 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 	out := make([]*storobj.Object, c.Limit)
 
 	for ; key != nil && i < c.Limit; key, val = cursor.Next() {
-		obj, err := storobj.FromBinary(val)
+		obj, err := s.index.objectFromBinary(val)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarhsal item %d", i)
 		}
@@ -332,6 +429,10 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 		i++
 	}
 
+	if c.Snapshot != "" && i < c.Limit {
+		s.objectSnapshots.exhaust(c.Snapshot)
+	}
+
 	return out[:i], nil
 }
 
@@ -360,6 +461,10 @@ func (s *Shard) sortDocIDsAndDists(ctx context.Context, limit int, sort []filter
 }
 
 func (s *Shard) buildAllowList(ctx context.Context, filters *filters.LocalFilter, addl additional.Properties) (helpers.AllowList, error) {
+	if clause, ok := geoClause(filters); ok {
+		return s.geoAllowList(ctx, clause)
+	}
+
 	list, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.GetSchemaSkipAuth(),
 		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit).
@@ -368,6 +473,7 @@ func (s *Shard) buildAllowList(ctx context.Context, filters *filters.LocalFilter
 		return nil, errors.Wrap(err, "build inverted filter allow list")
 	}
 
+	s.metrics.FilterSelectivity(list.Len(), s.ObjectCount())
 	return list, nil
 }
 
@@ -422,6 +528,10 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 	if err != nil {
 		return errors.Wrap(err, "delete object from bucket")
 	}
+	s.archiveDelete(idBytes)
+	if err := s.recordDeleteVersion(idBytes); err != nil {
+		return err
+	}
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
@@ -444,3 +554,28 @@ func (s *Shard) WasDeleted(ctx context.Context, id strfmt.UUID) (bool, error) {
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	return bucket.WasDeleted(idBytes)
 }
+
+// acquireSearchSlot blocks until the shard runs less searches than the
+// configured maximum, if there is one, and the scheduler granted a slot to
+// the class of the search. The returned func releases both slots.
+func (s *Shard) acquireSearchSlot(ctx context.Context) (func(), error) {
+	releaseShard := func() {}
+	if s.searchSlots != nil {
+		select {
+		case s.searchSlots <- struct{}{}:
+			releaseShard = func() { <-s.searchSlots }
+		case <-ctx.Done():
+			return nil, errors.Wrapf(ctx.Err(), "wait for search slot of shard %s", s.ID())
+		}
+	}
+
+	release, err := s.index.Config.Scheduler.Acquire(ctx, scheduler.ClassFrom(ctx))
+	if err != nil {
+		releaseShard()
+		return nil, errors.Wrapf(err, "wait for scheduler slot of shard %s", s.ID())
+	}
+	return func() {
+		release()
+		releaseShard()
+	}, nil
+}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/scheduler"
+)
+
+func TestShardSearchSlots(t *testing.T) {
+	t.Run("unbounded", func(t *testing.T) {
+		s := &Shard{index: &Index{}}
+		release, err := s.acquireSearchSlot(context.Background())
+		require.Nil(t, err)
+		release()
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		s := &Shard{
+			name:        "shard",
+			index:       &Index{Config: IndexConfig{ClassName: "Class"}},
+			searchSlots: make(chan struct{}, 1),
+		}
+
+		release, err := s.acquireSearchSlot(context.Background())
+		require.Nil(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = s.acquireSearchSlot(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		release()
+		release, err = s.acquireSearchSlot(context.Background())
+		require.Nil(t, err)
+		release()
+	})
+
+	t.Run("scheduled", func(t *testing.T) {
+		sched := scheduler.New(config.PriorityScheduler{
+			Enabled: true, Slots: 1, InteractiveShares: 80, BulkShares: 20,
+		}, nil)
+		s := &Shard{
+			name:        "shard",
+			index:       &Index{Config: IndexConfig{ClassName: "Class", Scheduler: sched}},
+			searchSlots: make(chan struct{}, 2),
+		}
+
+		releaseBulk, err := sched.Acquire(context.Background(), scheduler.Bulk)
+		require.Nil(t, err)
+
+		// the shard slot is released again if the search is not scheduled
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = s.acquireSearchSlot(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Len(t, s.searchSlots, 0)
+
+		releaseBulk()
+		release, err := s.acquireSearchSlot(context.Background())
+		require.Nil(t, err)
+		release()
+		assert.Len(t, s.searchSlots, 0)
+	})
+}
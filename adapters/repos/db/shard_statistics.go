@@ -0,0 +1,238 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// statisticsReloadAfter is the age after which the statistics of a shard
+// are reloaded from its buckets in the background
+var statisticsReloadAfter = 5 * time.Minute
+
+// shardStatistics are maintained with every write to a shard, so that
+// Aggregate can answer the object count and the minimum, maximum and count
+// of a property from its filterable index without reading the indexes.
+//
+// They are eventually consistent. The object count is loaded from the
+// objects bucket and the bounds of a property from its filterable index
+// when they are read first, writes which happen while they are loaded may
+// be counted twice or not at all. Deleting the current minimum or maximum
+// of a property marks its bounds stale. Stale and old statistics are
+// reloaded in the background, until then they are served as they are.
+type shardStatistics struct {
+	store  *lsmkv.Store
+	logger logrus.FieldLogger
+
+	sync.Mutex
+	count    int
+	loadedAt time.Time // zero until the count was loaded
+	counting bool
+	props    map[string]*propertyBounds
+}
+
+// propertyBounds are the smallest and largest value of a property in the
+// encoding of its filterable index and the number of its values
+type propertyBounds struct {
+	min, max []byte
+	count    int
+	loadedAt time.Time
+	stale    bool
+
+	// the values added while the bounds are reloaded, they may be missing
+	// from the reloaded bounds
+	loading            bool
+	addedMin, addedMax []byte
+}
+
+func newShardStatistics(store *lsmkv.Store, logger logrus.FieldLogger) *shardStatistics {
+	return &shardStatistics{
+		store:  store,
+		logger: logger,
+		props:  map[string]*propertyBounds{},
+	}
+}
+
+// addObjects changes the object count by delta once it was loaded
+func (s *shardStatistics) addObjects(delta int) {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	if !s.loadedAt.IsZero() {
+		s.count += delta
+	}
+}
+
+// addValue widens the bounds of a property if they are maintained
+func (s *shardStatistics) addValue(prop string, value []byte) {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	b, ok := s.props[prop]
+	if !ok {
+		return
+	}
+	b.count++
+	b.min, b.max = widen(b.min, b.max, value)
+	if b.loading {
+		b.addedMin, b.addedMax = widen(b.addedMin, b.addedMax, value)
+	}
+}
+
+// removeValue marks the bounds of a property stale if the value was one of
+// them, the bounds can not be narrowed without reading the index
+func (s *shardStatistics) removeValue(prop string, value []byte) {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	b, ok := s.props[prop]
+	if !ok {
+		return
+	}
+	b.count--
+	if bytes.Equal(value, b.min) || bytes.Equal(value, b.max) {
+		b.stale = true
+	}
+}
+
+func widen(min, max, value []byte) ([]byte, []byte) {
+	if min == nil || bytes.Compare(value, min) < 0 {
+		min = value
+	}
+	if max == nil || bytes.Compare(value, max) > 0 {
+		max = value
+	}
+	return min, max
+}
+
+// ObjectCount returns the number of objects of the shard, it is loaded from
+// the objects bucket when it is read first
+func (s *shardStatistics) ObjectCount() int {
+	s.Lock()
+	if s.loadedAt.IsZero() {
+		// the count is loaded under the lock, so that no write is counted
+		// before it was loaded
+		s.count = s.store.Bucket(helpers.ObjectsBucketLSM).Count()
+		s.loadedAt = time.Now()
+	} else if !s.counting && time.Since(s.loadedAt) > statisticsReloadAfter {
+		s.counting = true
+		go s.reloadCount()
+	}
+	count := s.count
+	s.Unlock()
+	return count
+}
+
+func (s *shardStatistics) reloadCount() {
+	count := s.store.Bucket(helpers.ObjectsBucketLSM).Count()
+
+	s.Lock()
+	defer s.Unlock()
+	s.count = count
+	s.loadedAt = time.Now()
+	s.counting = false
+}
+
+// PropertyBounds returns the bounds of a property in the encoding of its
+// filterable index and the number of its values. ok is false if the
+// property has no roaring set filterable index.
+func (s *shardStatistics) PropertyBounds(prop string) (min, max []byte, count int, ok bool, err error) {
+	bucket := s.store.Bucket(helpers.BucketFromPropNameLSM(prop))
+	if bucket == nil || bucket.Strategy() != lsmkv.StrategyRoaringSet {
+		return nil, nil, 0, false, nil
+	}
+
+	s.Lock()
+	b, exists := s.props[prop]
+	if !exists {
+		// writes from now on are tracked, those during the load are merged
+		// into the loaded bounds
+		b = &propertyBounds{loading: true}
+		s.props[prop] = b
+		s.Unlock()
+
+		if err := s.reloadBounds(prop, bucket); err != nil {
+			s.Lock()
+			delete(s.props, prop)
+			s.Unlock()
+			return nil, nil, 0, false, err
+		}
+		s.Lock()
+	} else if !b.loading && (b.stale || time.Since(b.loadedAt) > statisticsReloadAfter) {
+		b.loading = true
+		go func() {
+			if err := s.reloadBounds(prop, bucket); err != nil {
+				s.logger.WithField("action", "reload_shard_statistics").
+					WithField("property", prop).
+					WithError(err).Warn("reload bounds of property")
+				s.Lock()
+				b.loading = false
+				s.Unlock()
+			}
+		}()
+	}
+	min, max, count = b.min, b.max, b.count
+	s.Unlock()
+
+	return min, max, count, true, nil
+}
+
+// reloadBounds reads the bounds of a property from its filterable index,
+// the bounds must be marked as loading
+func (s *shardStatistics) reloadBounds(prop string, bucket *lsmkv.Bucket) error {
+	var min, max []byte
+	count := 0
+
+	c := bucket.CursorRoaringSet()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if v == nil || v.IsEmpty() {
+			continue
+		}
+		if min == nil {
+			min = append([]byte{}, k...)
+		}
+		max = k
+		count += v.GetCardinality()
+	}
+	max = append([]byte(nil), max...)
+	c.Close()
+
+	s.Lock()
+	defer s.Unlock()
+	b, ok := s.props[prop]
+	if !ok {
+		return errors.Errorf("bounds of property %q were dropped", prop)
+	}
+	if b.addedMin != nil {
+		min, max = widen(min, max, b.addedMin)
+		min, max = widen(min, max, b.addedMax)
+	}
+	b.min, b.max, b.count = min, max, count
+	b.loadedAt = time.Now()
+	b.stale = false
+	b.loading = false
+	b.addedMin, b.addedMax = nil, nil
+	return nil
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestShardStatistics(t *testing.T) {
+	ctx := context.Background()
+	shard, _ := testShard(t, ctx, "Counted", func(idx *Index) {
+		class := idx.getSchema.GetSchemaSkipAuth().Objects.Classes[0]
+		class.Properties = []*models.Property{
+			{Name: "size", DataType: schema.DataTypeInt.PropString()},
+		}
+	})
+
+	put := func(size int64) *storobj.Object {
+		obj := testObject("Counted")
+		obj.Object.Properties = map[string]interface{}{"size": size}
+		require.Nil(t, shard.PutObject(ctx, obj))
+		return obj
+	}
+	var stats *shardStatistics
+	bounds := func(t *testing.T) (int64, int64, int) {
+		min, max, count, ok, err := stats.PropertyBounds("size")
+		require.Nil(t, err)
+		require.True(t, ok)
+		minParsed, err := inverted.ParseLexicographicallySortableInt64(min)
+		require.Nil(t, err)
+		maxParsed, err := inverted.ParseLexicographicallySortableInt64(max)
+		require.Nil(t, err)
+		return minParsed, maxParsed, count
+	}
+
+	for _, size := range []int64{5, 1, 9} {
+		put(size)
+	}
+	stats = shard.(*LazyLoadShard).shard.statistics
+
+	t.Run("loaded when read first", func(t *testing.T) {
+		assert.Equal(t, 3, stats.ObjectCount())
+		min, max, count := bounds(t)
+		assert.Equal(t, int64(1), min)
+		assert.Equal(t, int64(9), max)
+		assert.Equal(t, 3, count)
+	})
+
+	largest := put(12)
+
+	t.Run("maintained with writes", func(t *testing.T) {
+		assert.Equal(t, 4, stats.ObjectCount())
+		min, max, count := bounds(t)
+		assert.Equal(t, int64(1), min)
+		assert.Equal(t, int64(12), max)
+		assert.Equal(t, 4, count)
+	})
+
+	t.Run("reloaded after deleting a bound", func(t *testing.T) {
+		require.Nil(t, shard.DeleteObject(ctx, largest.ID()))
+		assert.Equal(t, 3, stats.ObjectCount())
+
+		assert.Eventually(t, func() bool {
+			_, max, count := bounds(t)
+			return max == 9 && count == 3
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("eventually consistent aggregation", func(t *testing.T) {
+		res, err := shard.Aggregate(ctx, aggregation.Params{
+			ClassName:            "Counted",
+			IncludeMetaCount:     true,
+			EventuallyConsistent: true,
+			Properties: []aggregation.ParamProperty{{
+				Name: "size",
+				Aggregators: []aggregation.Aggregator{
+					aggregation.MinimumAggregator, aggregation.MaximumAggregator,
+					aggregation.CountAggregator,
+				},
+			}},
+		})
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 1)
+		assert.Equal(t, 3, res.Groups[0].Count)
+		assert.Equal(t, map[string]interface{}{
+			"minimum": float64(1), "maximum": float64(9), "count": float64(3),
+		}, res.Groups[0].Properties["size"].NumericalAggregations)
+	})
+}
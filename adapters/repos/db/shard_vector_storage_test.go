@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+func TestShard_Float16VectorStorage(t *testing.T) {
+	ctx := testCtx()
+	className := "HalfPrecision"
+
+	shd, _ := testShard(t, ctx, className, func(idx *Index) {
+		cfg := enthnsw.NewDefaultUserConfig()
+		cfg.VectorStorage = enthnsw.VectorStorageFloat16
+		idx.vectorIndexUserConfig = cfg
+	})
+
+	r := rand.New(rand.NewSource(1))
+	objs := make([]*storobj.Object, 20)
+	for i := range objs {
+		obj := testObject(className)
+		obj.Vector = make([]float32, 16)
+		for j := range obj.Vector {
+			obj.Vector[j] = r.Float32()*2 - 1
+		}
+		objs[i] = obj
+		require.Nil(t, shd.PutObject(ctx, obj))
+	}
+
+	t.Run("the vectors are stored in half precision", func(t *testing.T) {
+		for _, obj := range objs {
+			found, err := shd.ObjectByID(ctx, obj.ID(), nil, additional.Properties{Vector: true})
+			require.Nil(t, err)
+			require.NotNil(t, found)
+			assert.Equal(t, uint8(storobj.MarshallerVersionFloat16), found.MarshallerVersion)
+			assert.Equal(t, byteops.RoundToFloat16(obj.Vector), found.Vector)
+		}
+	})
+
+	t.Run("vector search finds the objects", func(t *testing.T) {
+		for _, obj := range objs {
+			found, dists, err := shd.ObjectVectorSearch(ctx, obj.Vector, 0, 1, nil, nil, nil, additional.Properties{})
+			require.Nil(t, err)
+			require.Len(t, found, 1)
+			assert.Equal(t, obj.ID(), found[0].ID())
+			assert.InDelta(t, 0, dists[0], 1e-3)
+		}
+	})
+}
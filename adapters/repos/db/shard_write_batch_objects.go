@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/scheduler"
 )
 
 // return value map[int]error gives the error for the index as it received it
@@ -170,6 +171,15 @@ func (ob *objectsBatcher) storeSingleBatchInLSM(ctx context.Context,
 				<-concurrencyLimit
 			}()
 
+			release, err := ob.shard.Index().Config.Scheduler.Acquire(ctx, scheduler.Bulk)
+			if err != nil {
+				errLock.Lock()
+				errs[index] = errors.Wrap(err, "wait for scheduler slot")
+				errLock.Unlock()
+				return
+			}
+			defer release()
+
 			if err := ob.storeObjectOfBatchInLSM(ctx, index, object); err != nil {
 				errLock.Lock()
 				errs[index] = err
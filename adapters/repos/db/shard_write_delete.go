@@ -56,6 +56,10 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID) error {
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
+	s.archiveDelete(idBytes)
+	if err := s.recordDeleteVersion(idBytes); err != nil {
+		return err
+	}
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
@@ -113,6 +117,10 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
+	s.archiveDelete(idBytes)
+	if err := s.recordDeleteVersion(idBytes); err != nil {
+		return err
+	}
 
 	err = s.cleanupInvertedIndexOnDelete(obj, docID)
 	if err != nil {
@@ -135,7 +143,7 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 }
 
 func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) error {
-	previousObject, err := storobj.FromBinary(previous)
+	previousObject, err := s.index.objectFromBinary(previous)
 	if err != nil {
 		return fmt.Errorf("unmarshal previous object: %w", err)
 	}
@@ -149,6 +157,7 @@ func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) erro
 	if err = s.subtractPropLengths(previousInvertProps); err != nil {
 		return fmt.Errorf("subtract prop lengths: %w", err)
 	}
+	s.statistics.addObjects(-1)
 
 	err = s.deleteFromInvertedIndicesLSM(previousInvertProps, docID)
 	if err != nil {
@@ -23,6 +23,18 @@ import (
 type nilProp struct {
 	Name                string
 	AddToPropertyLength bool
+	// Explicit is set for properties which are present on the object, but
+	// set to null, as opposed to properties which are missing entirely.
+	Explicit bool
+}
+
+// nullStateKey is the key under which the property is added to the null
+// state index.
+func (p nilProp) nullStateKey() []byte {
+	if p.Explicit {
+		return []byte{uint8(filters.InternalExplicitNullState)}
+	}
+	return []byte{uint8(filters.InternalNullState)}
 }
 
 func isPropertyForLength(dt schema.DataType) bool {
@@ -65,13 +77,14 @@ func (s *Shard) AnalyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 			}
 
 			// Add props as nil props if
-			// 1. They are not in the schema map ( == nil)
+			// 1. They are not in the schema map or explicitly set to null
 			// 2. Their inverted index is enabled
-			_, ok := schemaMap[prop.Name]
-			if !ok && inverted.HasInvertedIndex(prop) {
+			value, ok := schemaMap[prop.Name]
+			if (!ok || value == nil) && inverted.HasInvertedIndex(prop) {
 				nilProps = append(nilProps, nilProp{
 					Name:                prop.Name,
 					AddToPropertyLength: isPropertyForLength(dt),
+					Explicit:            ok,
 				})
 			}
 		}
@@ -44,7 +44,11 @@ func (s *Shard) extendInvertedIndicesLSM(props []inverted.Property, nilProps []n
 		}
 
 		if s.index.invertedIndexConfig.IndexNullState {
-			if err := s.addToPropertyNullIndex(prop.Name, docID, prop.Length == 0); err != nil {
+			key, err := s.keyPropertyNull(prop.Length == 0)
+			if err != nil {
+				return errors.Wrapf(err, "failed creating key for prop '%s' null", prop.Name)
+			}
+			if err := s.addToPropertyNullIndex(prop.Name, docID, key); err != nil {
 				return errors.Wrap(err, "add indexed null state")
 			}
 		}
@@ -59,7 +63,7 @@ func (s *Shard) extendInvertedIndicesLSM(props []inverted.Property, nilProps []n
 		}
 
 		if s.index.invertedIndexConfig.IndexNullState {
-			if err := s.addToPropertyNullIndex(nilProperty.Name, docID, true); err != nil {
+			if err := s.addToPropertyNullIndex(nilProperty.Name, docID, nilProperty.nullStateKey()); err != nil {
 				return errors.Wrap(err, "add indexed null state")
 			}
 		}
@@ -80,6 +84,7 @@ func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property
 			if err := s.addToPropertySetBucket(bucketValue, docID, key); err != nil {
 				return errors.Wrapf(err, "failed adding to prop '%s' value bucket", property.Name)
 			}
+			s.statistics.addValue(property.Name, key)
 		}
 	}
 
@@ -118,16 +123,12 @@ func (s *Shard) addToPropertyLengthIndex(propName string, docID uint64, length i
 	return nil
 }
 
-func (s *Shard) addToPropertyNullIndex(propName string, docID uint64, isNull bool) error {
+func (s *Shard) addToPropertyNullIndex(propName string, docID uint64, key []byte) error {
 	bucketNull := s.store.Bucket(helpers.BucketFromPropNameNullLSM(propName))
 	if bucketNull == nil {
 		return errors.Errorf("no bucket for prop '%s' null found", propName)
 	}
 
-	key, err := s.keyPropertyNull(isNull)
-	if err != nil {
-		return errors.Wrapf(err, "failed creating key for prop '%s' null", propName)
-	}
 	if err := s.addToPropertySetBucket(bucketNull, docID, key); err != nil {
 		return errors.Wrapf(err, "failed adding to prop '%s' null bucket", propName)
 	}
@@ -37,6 +37,7 @@ func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property,
 					return errors.Wrapf(err, "delete item '%s' from index",
 						string(item.Data))
 				}
+				s.statistics.removeValue(prop.Name, item.Data)
 			}
 		}
 
@@ -96,7 +96,7 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 	}
 
 	nextObj.SetDocID(status.docID)
-	nextBytes, err := nextObj.MarshalBinary()
+	nextBytes, err := s.marshalObjectForStorage(nextObj)
 	if err != nil {
 		lock.Unlock()
 		return nil, status, errors.Wrapf(err, "marshal object %s to binary", nextObj.ID())
@@ -165,7 +165,7 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 	out.status = status
 
 	nextObj.SetDocID(status.docID) // is not changed
-	nextBytes, err := nextObj.MarshalBinary()
+	nextBytes, err := s.marshalObjectForStorage(nextObj)
 	if err != nil {
 		return out, errors.Wrapf(err, "marshal object %s to binary", nextObj.ID())
 	}
@@ -197,7 +197,7 @@ func (s *Shard) mergeObjectData(previous []byte,
 		previousObj.SetClass(merge.Class)
 		previousObj.SetID(merge.ID)
 	} else {
-		p, err := storobj.FromBinary(previous)
+		p, err := s.index.objectFromBinary(previous)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "unmarshal previous")
 		}
@@ -147,7 +147,7 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 	s.metrics.PutObjectDetermineStatus(before)
 
 	object.SetDocID(status.docID)
-	data, err := object.MarshalBinary()
+	data, err := s.marshalObjectForStorage(object)
 	if err != nil {
 		lock.Unlock()
 		return status, errors.Wrapf(err, "marshal object %s to binary", object.ID())
@@ -160,6 +160,9 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 	}
 	lock.Unlock()
 	s.metrics.PutObjectUpsertObject(before)
+	if previous_object_bytes == nil {
+		s.statistics.addObjects(1)
+	}
 
 	before = time.Now()
 	if err := s.updateInvertedIndexLSM(object, status, previous_object_bytes); err != nil {
@@ -249,7 +252,11 @@ func (s *Shard) upsertObjectDataLSM(bucket *lsmkv.Bucket, id []byte, data []byte
 	binary.Write(keyBuf, binary.LittleEndian, &docID)
 	docIDBytes := keyBuf.Bytes()
 
-	return bucket.Put(id, data, lsmkv.WithSecondaryKey(0, docIDBytes))
+	if err := bucket.Put(id, data, lsmkv.WithSecondaryKey(0, docIDBytes)); err != nil {
+		return err
+	}
+	s.archivePut(id, data)
+	return s.recordPutVersion(id, data)
 }
 
 func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
@@ -261,7 +268,7 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 	}
 
 	if status.docIDChanged {
-		oldObject, err := storobj.FromBinary(previous)
+		oldObject, err := s.index.objectFromBinary(previous)
 		if err == nil {
 
 			oldProps, _, err := s.AnalyzeObject(oldObject)
@@ -357,7 +364,7 @@ func (s *Shard) updateInvertedIndexCleanupOldLSM(status objectInsertStatus,
 	// NOTE: Since Doc IDs are immutable, there is no need to use a
 	// DeltaAnalyzer. docIDChanged==true, therefore the old docID is
 	// "worthless" and can be cleaned up in the inverted index fully.
-	previousObject, err := storobj.FromBinary(previous)
+	previousObject, err := s.index.objectFromBinary(previous)
 	if err != nil {
 		return errors.Wrap(err, "unmarshal previous object")
 	}
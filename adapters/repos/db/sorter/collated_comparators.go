@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sorter
+
+import (
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// provideCollated returns a locale-aware comparator for text types. All other
+// data types are not affected by collation and fall back to the basic
+// comparators.
+func (bcp *basicComparatorProvider) provideCollated(dataType schema.DataType, order string,
+	collation *filters.Collation,
+) basicComparator {
+	switch dataType {
+	case schema.DataTypeText:
+		return newCollatedStringComparator(order, collation)
+	case schema.DataTypeTextArray:
+		return newCollatedStringArrayComparator(order, collation)
+	default:
+		return bcp.provide(dataType, order)
+	}
+}
+
+func newCollator(collation *filters.Collation) *collate.Collator {
+	// locale is validated before the query reaches the sorter, fall back to
+	// the root collation (language.Und) in case of an unparsable tag
+	tag, err := language.Parse(collation.Locale)
+	if err != nil {
+		tag = language.Und
+	}
+
+	var opts []collate.Option
+	if !collation.CaseSensitive {
+		opts = append(opts, collate.IgnoreCase)
+	}
+	if collation.Numeric {
+		opts = append(opts, collate.Numeric)
+	}
+	return collate.New(tag, opts...)
+}
+
+// collatedStringComparator is not safe for concurrent use, as the underlying
+// collator reuses internal buffers. A new comparator is created per sort.
+type collatedStringComparator struct {
+	lessValue int
+	collator  *collate.Collator
+}
+
+func newCollatedStringComparator(order string, collation *filters.Collation) *collatedStringComparator {
+	return &collatedStringComparator{lessValue(order), newCollator(collation)}
+}
+
+func (csc *collatedStringComparator) compare(a, b interface{}) int {
+	a, b = csc.untypedNil(a), csc.untypedNil(b)
+	if a != nil && b != nil {
+		return csc.compareStrings(*(a.(*string)), *(b.(*string)))
+	}
+	return handleNils(a == nil, b == nil, csc.lessValue)
+}
+
+func (csc *collatedStringComparator) compareStrings(a, b string) int {
+	switch csc.collator.CompareString(a, b) {
+	case 0:
+		return 0
+	case -1:
+		return csc.lessValue
+	default:
+		return -csc.lessValue
+	}
+}
+
+func (csc *collatedStringComparator) untypedNil(x interface{}) interface{} {
+	if x == (*string)(nil) {
+		return nil
+	}
+	return x
+}
+
+type collatedStringArrayComparator struct {
+	csc *collatedStringComparator
+	ic  *intComparator
+}
+
+func newCollatedStringArrayComparator(order string, collation *filters.Collation) *collatedStringArrayComparator {
+	return &collatedStringArrayComparator{
+		newCollatedStringComparator(order, collation),
+		newIntComparator(order),
+	}
+}
+
+func (csac *collatedStringArrayComparator) compare(a, b interface{}) int {
+	a, b = csac.untypedNil(a), csac.untypedNil(b)
+	if a != nil && b != nil {
+		aArr, bArr := *(a.(*[]string)), *(b.(*[]string))
+		aLen, bLen := len(aArr), len(bArr)
+
+		for i := 0; i < aLen && i < bLen; i++ {
+			if res := csac.csc.compareStrings(aArr[i], bArr[i]); res != 0 {
+				return res
+			}
+		}
+		return csac.ic.compareInts(aLen, bLen)
+	}
+	return handleNils(a == nil, b == nil, csac.csc.lessValue)
+}
+
+func (csac *collatedStringArrayComparator) untypedNil(x interface{}) interface{} {
+	if x == (*[]string)(nil) {
+		return nil
+	}
+	return x
+}
@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sorter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+func TestCollatedComparator_String(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	t.Run("swedish places å after z", func(t *testing.T) {
+		comp := newCollatedStringComparator("asc", &filters.Collation{Locale: "sv"})
+		assert.Equal(t, -1, comp.compare(strPtr("zebra"), strPtr("åsna")))
+
+		// binary comparison would get this right by accident, make sure the
+		// german collation disagrees with swedish
+		comp = newCollatedStringComparator("asc", &filters.Collation{Locale: "de"})
+		assert.Equal(t, 1, comp.compare(strPtr("zebra"), strPtr("åsna")))
+	})
+
+	t.Run("accented letters are sorted next to their base letter", func(t *testing.T) {
+		comp := newCollatedStringComparator("asc", &filters.Collation{Locale: "fr"})
+		assert.Equal(t, -1, comp.compare(strPtr("élan"), strPtr("fable")))
+	})
+
+	t.Run("case sensitivity", func(t *testing.T) {
+		insensitive := newCollatedStringComparator("asc", &filters.Collation{Locale: "en"})
+		assert.Equal(t, 0, insensitive.compare(strPtr("Orange"), strPtr("orange")))
+
+		sensitive := newCollatedStringComparator("asc", &filters.Collation{Locale: "en", CaseSensitive: true})
+		assert.NotEqual(t, 0, sensitive.compare(strPtr("Orange"), strPtr("orange")))
+	})
+
+	t.Run("numeric ordering", func(t *testing.T) {
+		lexical := newCollatedStringComparator("asc", &filters.Collation{Locale: "en"})
+		assert.Equal(t, 1, lexical.compare(strPtr("item 2"), strPtr("item 10")))
+
+		numeric := newCollatedStringComparator("asc", &filters.Collation{Locale: "en", Numeric: true})
+		assert.Equal(t, -1, numeric.compare(strPtr("item 2"), strPtr("item 10")))
+	})
+
+	t.Run("desc and nils", func(t *testing.T) {
+		comp := newCollatedStringComparator("desc", &filters.Collation{Locale: "en"})
+
+		params := []struct {
+			a        *string
+			b        *string
+			expected int
+		}{
+			{strPtr("apple"), strPtr("orange"), 1},
+			{strPtr("orange"), strPtr("apple"), -1},
+			{nil, strPtr("apple"), 1},
+			{strPtr("orange"), nil, -1},
+			{nil, nil, 0},
+		}
+
+		for i, p := range params {
+			t.Run(fmt.Sprintf("data #%d", i), func(t *testing.T) {
+				assert.Equal(t, p.expected, comp.compare(p.a, p.b))
+			})
+		}
+	})
+}
+
+func TestCollatedComparator_StringArray(t *testing.T) {
+	comp := newCollatedStringArrayComparator("asc", &filters.Collation{Locale: "sv"})
+
+	a := []string{"zebra", "åsna"}
+	b := []string{"zebra", "zoo"}
+	short := []string{"zebra"}
+
+	assert.Equal(t, 1, comp.compare(&a, &b))
+	assert.Equal(t, -1, comp.compare(&short, &a))
+	assert.Equal(t, 0, comp.compare(&a, &a))
+	assert.Equal(t, -1, comp.compare(nil, &a))
+}
+
+func TestCollatedComparatorProvider_FallsBackForNonText(t *testing.T) {
+	provider := &basicComparatorProvider{}
+	collation := &filters.Collation{Locale: "en"}
+
+	assert.IsType(t, &collatedStringComparator{}, provider.provideCollated("text", "asc", collation))
+	assert.IsType(t, &collatedStringArrayComparator{}, provider.provideCollated("text[]", "asc", collation))
+	assert.IsType(t, &float64Comparator{}, provider.provideCollated("number", "asc", collation))
+}
@@ -94,7 +94,7 @@ func (e *comparableValueExtractor) extractFromObject(object *storobj.Object, pro
 		return nil
 	}
 	value, ok := propertiesMap[propName]
-	if !ok {
+	if !ok || value == nil {
 		return nil
 	}
 
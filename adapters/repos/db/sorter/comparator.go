@@ -11,15 +11,30 @@
 
 package sorter
 
+import (
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
 type comparator struct {
 	comparators []basicComparator
 }
 
-func newComparator(dataTypesHelper *dataTypesHelper, propNames []string, orders []string) *comparator {
+func newComparator(dataTypesHelper *dataTypesHelper, propNames []string, orders []string,
+	collations []*filters.Collation, geoPoints []*models.GeoCoordinates,
+) *comparator {
 	provider := &basicComparatorProvider{}
 	comparators := make([]basicComparator, len(propNames))
 	for level, propName := range propNames {
 		dataType := dataTypesHelper.getType(propName)
+		if collations[level] != nil {
+			comparators[level] = provider.provideCollated(dataType, orders[level], collations[level])
+			continue
+		}
+		if geoPoints[level] != nil {
+			comparators[level] = provider.provideGeoDistance(dataType, orders[level], geoPoints[level])
+			continue
+		}
 		comparators[level] = provider.provide(dataType, orders[level])
 	}
 	return &comparator{comparators}
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sorter
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// provideGeoDistance returns a comparator ordering geoCoordinates by their
+// distance from the given point. All other data types cannot be measured
+// against a point and fall back to the basic comparators.
+func (bcp *basicComparatorProvider) provideGeoDistance(dataType schema.DataType, order string,
+	geoPoint *models.GeoCoordinates,
+) basicComparator {
+	if dataType != schema.DataTypeGeoCoordinates {
+		return bcp.provide(dataType, order)
+	}
+	return newGeoDistanceComparator(order, geoPoint)
+}
+
+type geoDistanceComparator struct {
+	fc    *float64Comparator
+	point []float32
+	geo   distancer.Provider
+}
+
+func newGeoDistanceComparator(order string, geoPoint *models.GeoCoordinates) *geoDistanceComparator {
+	var point []float32
+	if geoPoint != nil && geoPoint.Latitude != nil && geoPoint.Longitude != nil {
+		point = []float32{*geoPoint.Latitude, *geoPoint.Longitude}
+	}
+	return &geoDistanceComparator{newFloat64Comparator(order), point, distancer.NewGeoProvider()}
+}
+
+func (gdc *geoDistanceComparator) compare(a, b interface{}) int {
+	a, b = gdc.untypedNil(a), gdc.untypedNil(b)
+	if a != nil && b != nil {
+		return gdc.fc.compareFloats64(gdc.distance(*(a.(*[]float64))), gdc.distance(*(b.(*[]float64))))
+	}
+	return handleNils(a == nil, b == nil, gdc.fc.lessValue)
+}
+
+// distance expects the values in the form of the comparableValueExtractor,
+// which is [longitude, latitude]
+func (gdc *geoDistanceComparator) distance(value []float64) float64 {
+	if gdc.point == nil || len(value) != 2 {
+		return 0
+	}
+	dist, _, err := gdc.geo.SingleDist(gdc.point, []float32{float32(value[1]), float32(value[0])})
+	if err != nil {
+		return 0
+	}
+	return float64(dist)
+}
+
+func (gdc *geoDistanceComparator) untypedNil(x interface{}) interface{} {
+	if x == (*[]float64)(nil) {
+		return nil
+	}
+	return x
+}
@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sorter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestGeoDistanceComparator(t *testing.T) {
+	ptFloat32 := func(f float32) *float32 { return &f }
+	// the extractor provides geoCoordinates as [longitude, latitude]
+	munich := []float64{11.57549, 48.13743}
+	stuttgart := []float64{9.17702, 48.78232}
+	berlin := []float64{13.40495, 52.52001}
+	nuremberg := &models.GeoCoordinates{Latitude: ptFloat32(49.45203), Longitude: ptFloat32(11.07675)}
+
+	t.Run("asc orders by nearest first", func(t *testing.T) {
+		comp := newGeoDistanceComparator("asc", nuremberg)
+		// munich ~150km, stuttgart ~160km, berlin ~380km
+		assert.Equal(t, -1, comp.compare(&munich, &stuttgart))
+		assert.Equal(t, -1, comp.compare(&stuttgart, &berlin))
+		assert.Equal(t, 1, comp.compare(&berlin, &munich))
+		assert.Equal(t, 0, comp.compare(&munich, &munich))
+	})
+
+	t.Run("desc orders by farthest first", func(t *testing.T) {
+		comp := newGeoDistanceComparator("desc", nuremberg)
+		assert.Equal(t, -1, comp.compare(&berlin, &munich))
+		assert.Equal(t, 1, comp.compare(&munich, &stuttgart))
+	})
+
+	t.Run("nils", func(t *testing.T) {
+		comp := newGeoDistanceComparator("asc", nuremberg)
+		assert.Equal(t, -1, comp.compare(nil, &munich))
+		assert.Equal(t, 1, comp.compare(&munich, (*[]float64)(nil)))
+		assert.Equal(t, 0, comp.compare(nil, nil))
+	})
+
+	t.Run("provider falls back for non geo types", func(t *testing.T) {
+		provider := &basicComparatorProvider{}
+		assert.IsType(t, &geoDistanceComparator{}, provider.provideGeoDistance("geoCoordinates", "asc", nuremberg))
+		assert.IsType(t, &float64Comparator{}, provider.provideGeoDistance("number", "asc", nuremberg))
+	})
+}
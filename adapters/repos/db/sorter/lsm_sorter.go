@@ -84,7 +84,8 @@ func (s *lsmSorter) createHelper(sort []filters.Sort, limit int) (*lsmSorterHelp
 		return nil, err
 	}
 
-	comparator := newComparator(s.dataTypesHelper, propNames, orders)
+	comparator := newComparator(s.dataTypesHelper, propNames, orders, extractCollations(sort),
+		extractGeoPoints(sort))
 	creator := newComparableCreator(s.valueExtractor, propNames)
 	return newLsmSorterHelper(s.bucket, comparator, creator, limit), nil
 }
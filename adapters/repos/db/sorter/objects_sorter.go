@@ -47,7 +47,8 @@ func (s objectsSorter) Sort(objects []*storobj.Object,
 	class := s.schema.GetClass(objects[0].Class())
 	dataTypesHelper := newDataTypesHelper(class)
 	valueExtractor := newComparableValueExtractor(dataTypesHelper)
-	comparator := newComparator(dataTypesHelper, propNames, orders)
+	comparator := newComparator(dataTypesHelper, propNames, orders, extractCollations(sort),
+		extractGeoPoints(sort))
 	creator := newComparableCreator(valueExtractor, propNames)
 
 	return newObjectsSorterHelper(comparator, creator, limit).
@@ -14,6 +14,7 @@ package sorter
 import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
 func extractPropNamesAndOrders(sort []filters.Sort) ([]string, []string, error) {
@@ -33,6 +34,22 @@ func extractPropNamesAndOrders(sort []filters.Sort) ([]string, []string, error)
 	return propNames, orders, nil
 }
 
+func extractCollations(sort []filters.Sort) []*filters.Collation {
+	collations := make([]*filters.Collation, len(sort))
+	for i, srt := range sort {
+		collations[i] = srt.Collation
+	}
+	return collations
+}
+
+func extractGeoPoints(sort []filters.Sort) []*models.GeoCoordinates {
+	geoPoints := make([]*models.GeoCoordinates, len(sort))
+	for i, srt := range sort {
+		geoPoints[i] = srt.GeoPoint
+	}
+	return geoPoints
+}
+
 func validateLimit(limit, elementsCount int) int {
 	if limit > elementsCount {
 		return elementsCount
@@ -0,0 +1,355 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/startup"
+	"github.com/weaviate/weaviate/entities/storagestate"
+)
+
+// load statuses of the shards reported by the startup progress
+const (
+	ShardLoadPending = "PENDING"
+	ShardLoadLoading = "LOADING"
+	ShardLoadLoaded  = "LOADED"
+	ShardLoadFailed  = "FAILED"
+)
+
+// StartupProgress reports the loading of the shards which existed when the
+// node started. Lazily loaded shards are loaded in the background after the
+// startup completed.
+type StartupProgress struct {
+	StartupComplete bool      `json:"startupComplete"`
+	StartedAt       time.Time `json:"startedAt"`
+	Total           int       `json:"total"`
+	Pending         int       `json:"pending"`
+	Loading         int       `json:"loading"`
+	Loaded          int       `json:"loaded"`
+	Failed          int       `json:"failed"`
+	// ETASeconds is a rough estimate of the time until all shards are loaded,
+	// based on the average duration of the shards loaded so far
+	ETASeconds      float64        `json:"etaSeconds,omitempty"`
+	PriorityClasses []string       `json:"priorityClasses"`
+	Shards          []ShardStartup `json:"shards"`
+}
+
+// ShardStartup is the progress of loading a single shard
+type ShardStartup struct {
+	Class  string `json:"class"`
+	Shard  string `json:"shard"`
+	Status string `json:"status"`
+	// Phase is the phase of loading a shard which is LOADING, see the phases
+	// of [startup.Progress]
+	Phase string `json:"phase,omitempty"`
+	// WALReplay and VectorIndexLoad are the ratios of the write-ahead logs of
+	// the LSM stores and the commit logs of the vector index replayed so far
+	WALReplay       float64    `json:"walReplay"`
+	VectorIndexLoad float64    `json:"vectorIndexLoad"`
+	StartedAt       *time.Time `json:"startedAt,omitempty"`
+	FinishedAt      *time.Time `json:"finishedAt,omitempty"`
+	ETASeconds      float64    `json:"etaSeconds,omitempty"`
+	Error           string     `json:"error,omitempty"`
+}
+
+// startupTracker tracks the loading of the shards which existed at startup,
+// and orders the lazy loading of them by the priority of their classes. A nil
+// tracker is valid, shards of indexes created at runtime are not tracked.
+type startupTracker struct {
+	now     func() time.Time
+	started time.Time
+
+	sync.Mutex
+	priority []string
+	shards   map[string]*shardStartup
+}
+
+type shardStartup struct {
+	class, shard string
+	status       string
+	progress     *startup.Progress
+	started      time.Time
+	finished     time.Time
+	err          error
+}
+
+func newStartupTracker(priority []string) *startupTracker {
+	return &startupTracker{
+		now:      time.Now,
+		started:  time.Now(),
+		priority: priority,
+		shards:   map[string]*shardStartup{},
+	}
+}
+
+func startupKey(class, shard string) string {
+	return class + "/" + shard
+}
+
+// pending registers a shard which will be loaded
+func (t *startupTracker) pending(class, shard string) {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	if _, ok := t.shards[startupKey(class, shard)]; !ok {
+		t.shards[startupKey(class, shard)] = &shardStartup{
+			class: class, shard: shard, status: ShardLoadPending,
+		}
+	}
+}
+
+// start returns a context which reports the progress of loading a pending
+// shard, see [startup.FromContext]
+func (t *startupTracker) start(ctx context.Context, class, shard string) context.Context {
+	if t == nil {
+		return ctx
+	}
+	t.Lock()
+	defer t.Unlock()
+	s, ok := t.shards[startupKey(class, shard)]
+	if !ok || s.status == ShardLoadLoaded {
+		return ctx
+	}
+	s.status = ShardLoadLoading
+	s.progress = &startup.Progress{}
+	s.started = t.now()
+	s.err = nil
+	return startup.NewContext(ctx, s.progress)
+}
+
+func (t *startupTracker) finish(class, shard string, err error) {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	s, ok := t.shards[startupKey(class, shard)]
+	if !ok || s.status != ShardLoadLoading {
+		return
+	}
+	s.finished = t.now()
+	s.err = err
+	s.status = ShardLoadLoaded
+	if err != nil {
+		s.status = ShardLoadFailed
+	}
+}
+
+// classLoaded is false while shards of the class are pending, loading or
+// failed to load
+func (t *startupTracker) classLoaded(class string) bool {
+	if t == nil {
+		return true
+	}
+	t.Lock()
+	defer t.Unlock()
+	for _, s := range t.shards {
+		if s.class == class && s.status != ShardLoadLoaded {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *startupTracker) forgetClass(class string) {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	for key, s := range t.shards {
+		if s.class == class {
+			delete(t.shards, key)
+		}
+	}
+}
+
+// prioritize loads the shards of the classes first, in the given order
+func (t *startupTracker) prioritize(classes []string) {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	t.priority = classes
+}
+
+// mayLoad is false while the shards of classes with a higher priority are
+// not loaded yet, the lazy loading of the shards of the class waits then
+func (t *startupTracker) mayLoad(class string) bool {
+	if t == nil {
+		return true
+	}
+	t.Lock()
+	defer t.Unlock()
+
+	for _, prioritized := range t.priority {
+		if prioritized == class {
+			return true
+		}
+		for _, s := range t.shards {
+			if s.class == prioritized &&
+				(s.status == ShardLoadPending || s.status == ShardLoadLoading) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// priorityOf orders classes for loading, prioritized classes come first
+func (t *startupTracker) priorityOf(class string) int {
+	if t == nil {
+		return 0
+	}
+	t.Lock()
+	defer t.Unlock()
+	for i, prioritized := range t.priority {
+		if prioritized == class {
+			return i
+		}
+	}
+	return len(t.priority)
+}
+
+func (t *startupTracker) progress() StartupProgress {
+	if t == nil {
+		return StartupProgress{PriorityClasses: []string{}, Shards: []ShardStartup{}}
+	}
+	t.Lock()
+	defer t.Unlock()
+
+	now := t.now()
+	p := StartupProgress{
+		StartedAt:       t.started,
+		Total:           len(t.shards),
+		PriorityClasses: append([]string{}, t.priority...),
+		Shards:          make([]ShardStartup, 0, len(t.shards)),
+	}
+	var loadTime time.Duration
+	for _, s := range t.shards {
+		shard := ShardStartup{Class: s.class, Shard: s.shard, Status: s.status}
+		if !s.started.IsZero() {
+			started := s.started
+			shard.StartedAt = &started
+		}
+		switch s.status {
+		case ShardLoadPending:
+			p.Pending++
+		case ShardLoadLoading:
+			p.Loading++
+			shard.Phase = s.progress.Phase()
+			shard.WALReplay = s.progress.WAL()
+			shard.VectorIndexLoad = s.progress.VectorIndex()
+			shard.ETASeconds = eta(now.Sub(s.started), shardRatio(shard))
+		case ShardLoadLoaded, ShardLoadFailed:
+			finished := s.finished
+			shard.FinishedAt = &finished
+			shard.WALReplay, shard.VectorIndexLoad = 1, 1
+			if s.err != nil {
+				p.Failed++
+				shard.Error = s.err.Error()
+				shard.WALReplay, shard.VectorIndexLoad = 0, 0
+			} else {
+				p.Loaded++
+				loadTime += s.finished.Sub(s.started)
+			}
+		}
+		p.Shards = append(p.Shards, shard)
+	}
+	sort.Slice(p.Shards, func(i, j int) bool {
+		if p.Shards[i].Class != p.Shards[j].Class {
+			return p.Shards[i].Class < p.Shards[j].Class
+		}
+		return p.Shards[i].Shard < p.Shards[j].Shard
+	})
+
+	if remaining := p.Pending + p.Loading; remaining > 0 && p.Loaded > 0 {
+		p.ETASeconds = (loadTime / time.Duration(p.Loaded) * time.Duration(remaining)).Seconds()
+	}
+	return p
+}
+
+// shardRatio weighs both phases of loading a shard equally
+func shardRatio(s ShardStartup) float64 {
+	if s.Phase == startup.PhaseVectorIndex {
+		return 0.5 + s.VectorIndexLoad/2
+	}
+	return s.WALReplay / 2
+}
+
+func eta(elapsed time.Duration, ratio float64) float64 {
+	if ratio <= 0 || ratio >= 1 {
+		return 0
+	}
+	return elapsed.Seconds() * (1 - ratio) / ratio
+}
+
+// StartupProgress of loading the shards of this node
+func (db *DB) StartupProgress() StartupProgress {
+	p := db.startup.progress()
+	p.StartupComplete = db.StartupComplete()
+	return p
+}
+
+// ClassServing reports whether the shards of the class on this node serve
+// requests, which they do not while they are loaded at startup, failed to
+// load or are read-only. Lazily loaded shards are not loaded by it. ok is
+// false if the class does not exist on this node.
+func (db *DB) ClassServing(class string) (serving, ok bool) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return false, false
+	}
+	if !db.startup.classLoaded(index.Config.ClassName.String()) {
+		return false, true
+	}
+
+	serving = true
+	index.ForEachShard(func(name string, shard ShardLike) error {
+		if lazy, isLazy := shard.(*LazyLoadShard); isLazy && !lazy.isLoaded() {
+			return nil
+		}
+		if shard.GetStatus() == storagestate.StatusReadOnly {
+			serving = false
+		}
+		return nil
+	})
+	return serving, true
+}
+
+// PrioritizeStartup loads the shards of the classes before the shards of
+// other classes which are not loaded yet
+func (db *DB) PrioritizeStartup(classes []string) {
+	db.startup.prioritize(classes)
+}
+
+// waitForStartupPriority waits until the shards of the classes with a higher
+// priority were loaded, the lazy loading of the shards of the index continues
+// afterwards
+func (i *Index) waitForStartupPriority(ticker *time.Ticker) error {
+	for !i.Config.Startup.mayLoad(i.Config.ClassName.String()) {
+		select {
+		case <-i.closingCtx.Done():
+			return i.closingCtx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/startup"
+)
+
+func TestStartupTracker(t *testing.T) {
+	tracker := newStartupTracker([]string{"Priority"})
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker.now = func() time.Time { return now }
+	tracker.started = now
+
+	for _, shard := range []string{"s1", "s2"} {
+		tracker.pending("Article", shard)
+		tracker.pending("Priority", shard)
+	}
+
+	t.Run("prioritized classes load first", func(t *testing.T) {
+		assert.True(t, tracker.mayLoad("Priority"))
+		assert.False(t, tracker.mayLoad("Article"))
+		assert.Equal(t, 0, tracker.priorityOf("Priority"))
+		assert.Equal(t, 1, tracker.priorityOf("Article"))
+	})
+
+	t.Run("progress of loading shards", func(t *testing.T) {
+		ctx := tracker.start(context.Background(), "Priority", "s1")
+		now = now.Add(10 * time.Second)
+		tracker.finish("Priority", "s1", nil)
+
+		ctx = tracker.start(context.Background(), "Priority", "s2")
+		progress := startup.FromContext(ctx)
+		require.NotNil(t, progress)
+		progress.SetPhase(startup.PhaseVectorIndex)
+		progress.SetVectorIndex(0.5)
+		now = now.Add(15 * time.Second)
+
+		p := tracker.progress()
+		assert.Equal(t, 4, p.Total)
+		assert.Equal(t, 1, p.Loaded)
+		assert.Equal(t, 1, p.Loading)
+		assert.Equal(t, 2, p.Pending)
+		// 3 shards left, which took 10s each so far
+		assert.Equal(t, float64(30), p.ETASeconds)
+		assert.Equal(t, []string{"Priority"}, p.PriorityClasses)
+
+		require.Len(t, p.Shards, 4)
+		loading := p.Shards[3]
+		assert.Equal(t, "Priority", loading.Class)
+		assert.Equal(t, "s2", loading.Shard)
+		assert.Equal(t, ShardLoadLoading, loading.Status)
+		assert.Equal(t, startup.PhaseVectorIndex, loading.Phase)
+		assert.Equal(t, 0.5, loading.VectorIndexLoad)
+		// 75% done after 15s
+		assert.Equal(t, float64(5), loading.ETASeconds)
+		assert.Equal(t, ShardLoadLoaded, p.Shards[2].Status)
+	})
+
+	t.Run("failed shards do not block other classes", func(t *testing.T) {
+		tracker.finish("Priority", "s2", errors.New("corrupt segment"))
+		assert.True(t, tracker.mayLoad("Article"))
+
+		p := tracker.progress()
+		assert.Equal(t, 1, p.Failed)
+		assert.Equal(t, "corrupt segment", p.Shards[3].Error)
+		assert.False(t, tracker.classLoaded("Priority"))
+		assert.False(t, tracker.classLoaded("Article"))
+		assert.True(t, tracker.classLoaded("Created"))
+	})
+
+	t.Run("prioritize at runtime", func(t *testing.T) {
+		tracker.prioritize([]string{"Article"})
+		assert.True(t, tracker.mayLoad("Article"))
+		assert.False(t, tracker.mayLoad("Priority"))
+	})
+
+	t.Run("untracked shards", func(t *testing.T) {
+		ctx := context.Background()
+		assert.Equal(t, ctx, tracker.start(ctx, "Created", "s1"))
+		tracker.finish("Created", "s1", nil)
+		assert.Equal(t, 4, tracker.progress().Total)
+	})
+
+	t.Run("nil tracker", func(t *testing.T) {
+		var tracker *startupTracker
+		tracker.pending("Article", "s1")
+		assert.True(t, tracker.mayLoad("Article"))
+		assert.True(t, tracker.classLoaded("Article"))
+		assert.Empty(t, tracker.progress().Shards)
+	})
+}
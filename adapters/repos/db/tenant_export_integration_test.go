@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// TestTenantExportRebuildsVectorIndex checks that a tenant export, which
+// only contains objects and vectors, results in an equivalent vector index
+// once it is imported
+func TestTenantExportRebuildsVectorIndex(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	className := "TenantExport"
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+		Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+
+	shardState, err := sharding.InitState(className, sharding.Config{},
+		fakeNodes{[]string{"node1"}}, 1, true)
+	require.Nil(t, err)
+	shardState.AddPartition("source", []string{"node1"}, models.TenantActivityStatusHOT)
+	shardState.AddPartition("target", []string{"node1"}, models.TenantActivityStatusHOT)
+
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: shardState,
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       1000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, shardState))
+
+	const (
+		objectCount = 200
+		dims        = 16
+	)
+	randomVector := func(r *rand.Rand) []float32 {
+		vec := make([]float32, dims)
+		for i := range vec {
+			vec[i] = r.Float32()
+		}
+		return vec
+	}
+
+	r := rand.New(rand.NewSource(7))
+	for i := 0; i < objectCount; i++ {
+		obj := &models.Object{
+			Class:      className,
+			ID:         strfmt.UUID(uuid.NewString()),
+			Tenant:     "source",
+			Properties: map[string]interface{}{"name": fmt.Sprintf("object %d", i)},
+		}
+		require.Nil(t, repo.PutObject(ctx, obj, randomVector(r), nil))
+	}
+
+	manager := objects.NewBatchManager(repo, &tenantExportModules{}, &tenantExportLocks{},
+		&tenantExportSchema{schemaGetter}, &config.WeaviateConfig{}, logger,
+		&tenantExportAuthorizer{}, nil, nil)
+
+	var export bytes.Buffer
+	require.Nil(t, manager.ExportTenant(ctx, nil, className, "source", &export))
+	res, err := manager.ImportTenant(ctx, nil, className, "target", &export)
+	require.Nil(t, err)
+	require.Equal(t, objectCount, res.Imported, res.Errors)
+
+	nearest := func(tenant string, vec []float32) []strfmt.UUID {
+		res, err := repo.VectorSearch(ctx, dto.GetParams{
+			ClassName:    className,
+			Tenant:       tenant,
+			SearchVector: vec,
+			Pagination:   &filters.Pagination{Limit: 10},
+		})
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	for i := 0; i < 20; i++ {
+		query := randomVector(r)
+		source := nearest("source", query)
+		require.Len(t, source, 10)
+		assert.Equal(t, source, nearest("target", query))
+	}
+}
+
+type tenantExportModules struct{}
+
+func (m *tenantExportModules) GetObjectAdditionalExtend(ctx context.Context,
+	in *search.Result, moduleParams map[string]interface{},
+) (*search.Result, error) {
+	return in, nil
+}
+
+func (m *tenantExportModules) ListObjectsAdditionalExtend(ctx context.Context,
+	in search.Results, moduleParams map[string]interface{},
+) (search.Results, error) {
+	return in, nil
+}
+
+func (m *tenantExportModules) UsingRef2Vec(className string) bool {
+	return false
+}
+
+func (m *tenantExportModules) EnrichObject(ctx context.Context, object *models.Object,
+	class *models.Class,
+) error {
+	return nil
+}
+
+func (m *tenantExportModules) UpdateVector(ctx context.Context, object *models.Object,
+	class *models.Class, objectDiff *moduletools.ObjectDiff,
+	repo modulecapabilities.FindObjectFn, logger logrus.FieldLogger,
+) error {
+	return nil
+}
+
+func (m *tenantExportModules) VectorizerName(className string) (string, error) {
+	return "", nil
+}
+
+type tenantExportLocks struct{}
+
+func (l *tenantExportLocks) LockConnector() (func() error, error) {
+	return func() error { return nil }, nil
+}
+
+func (l *tenantExportLocks) LockSchema() (func() error, error) {
+	return func() error { return nil }, nil
+}
+
+type tenantExportAuthorizer struct{}
+
+func (a *tenantExportAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return nil
+}
+
+type tenantExportSchema struct {
+	*fakeSchemaGetter
+}
+
+func (s *tenantExportSchema) GetSchema(principal *models.Principal) (schema.Schema, error) {
+	return s.schema, nil
+}
+
+func (s *tenantExportSchema) AddClass(ctx context.Context, principal *models.Principal,
+	class *models.Class,
+) error {
+	return fmt.Errorf("not supported")
+}
+
+func (s *tenantExportSchema) GetClass(ctx context.Context, principal *models.Principal,
+	name string,
+) (*models.Class, error) {
+	return s.schema.GetClass(schema.ClassName(name)), nil
+}
+
+func (s *tenantExportSchema) AddClassProperty(ctx context.Context, principal *models.Principal,
+	class string, property *models.Property,
+) error {
+	return fmt.Errorf("not supported")
+}
+
+func (s *tenantExportSchema) MergeClassObjectProperty(ctx context.Context,
+	principal *models.Principal, class string, property *models.Property,
+) error {
+	return fmt.Errorf("not supported")
+}
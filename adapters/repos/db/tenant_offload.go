@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// offloadBackupID groups the files of all frozen tenants on the backend, the
+// files of a shard are stored below node/index/shard
+const offloadBackupID = "offloaded-tenants"
+
+const offloadManifest = "manifest.json"
+
+// SetOffloadBackend sets the cloud storage the files of FROZEN tenants are
+// moved to. Without a backend, FROZEN tenants are kept on disk like COLD ones.
+func (db *DB) SetOffloadBackend(backend modulecapabilities.BackupBackend) {
+	db.offloadBackend = backend
+}
+
+func (db *DB) offloadKey(idx *Index, shardName string, file string) string {
+	return path.Join(db.schemaGetter.NodeName(), idx.ID(), shardName, file)
+}
+
+// offloadShard uploads the files of a shut down shard and removes them from
+// disk afterwards. The manifest is written last, so a shard is only
+// considered offloaded if all of its files were uploaded.
+func (db *DB) offloadShard(ctx context.Context, idx *Index, shardName string) error {
+	if db.offloadBackend == nil {
+		return nil
+	}
+
+	dir := shardPath(idx.path(), shardName)
+	var files []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		if _, err := db.offloadBackend.Write(ctx, offloadBackupID,
+			db.offloadKey(idx, shardName, rel), f); err != nil {
+			return fmt.Errorf("upload %s: %w", rel, err)
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("offload shard %q: %w", shardName, err)
+	}
+
+	manifest, err := json.Marshal(files)
+	if err != nil {
+		return fmt.Errorf("marshal manifest: %w", err)
+	}
+	if err := db.offloadBackend.PutObject(ctx, offloadBackupID,
+		db.offloadKey(idx, shardName, offloadManifest), manifest); err != nil {
+		return fmt.Errorf("offload shard %q: upload manifest: %w", shardName, err)
+	}
+
+	return os.RemoveAll(dir)
+}
+
+// restoreOffloadedShard downloads the files of an offloaded shard. Shards
+// which are still on disk, e.g. because the upload failed, are kept as is.
+// The files are downloaded to a temporary directory first, so an interrupted
+// download does not leave a partial shard behind.
+func (db *DB) restoreOffloadedShard(ctx context.Context, idx *Index, shardName string) error {
+	if db.offloadBackend == nil {
+		return nil
+	}
+
+	dir := shardPath(idx.path(), shardName)
+	if _, err := os.Stat(dir); err == nil {
+		return nil
+	}
+
+	manifest, err := db.offloadBackend.GetObject(ctx, offloadBackupID,
+		db.offloadKey(idx, shardName, offloadManifest))
+	if err != nil {
+		return fmt.Errorf("restore shard %q: get manifest: %w", shardName, err)
+	}
+	var files []string
+	if err := json.Unmarshal(manifest, &files); err != nil {
+		return fmt.Errorf("restore shard %q: unmarshal manifest: %w", shardName, err)
+	}
+
+	tmp := dir + ".restoring"
+	if err := os.RemoveAll(tmp); err != nil {
+		return fmt.Errorf("restore shard %q: %w", shardName, err)
+	}
+	for _, file := range files {
+		dest := filepath.Join(tmp, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(dest), os.ModePerm); err != nil {
+			return fmt.Errorf("restore shard %q: %w", shardName, err)
+		}
+		f, err := os.Create(dest)
+		if err != nil {
+			return fmt.Errorf("restore shard %q: %w", shardName, err)
+		}
+		if _, err := db.offloadBackend.Read(ctx, offloadBackupID,
+			db.offloadKey(idx, shardName, file), f); err != nil {
+			return fmt.Errorf("restore shard %q: download %s: %w", shardName, file, err)
+		}
+	}
+
+	return os.Rename(tmp, dir)
+}
+
+// TenantLastAccess returns when data of the tenant was last read or written
+// on this node. Accesses are only tracked in memory, so there is no access
+// for tenants which were not used since the node started.
+func (db *DB) TenantLastAccess(class, tenant string) (time.Time, bool) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return time.Time{}, false
+	}
+	last, ok := idx.lastAccess.Load(tenant)
+	if !ok {
+		return time.Time{}, false
+	}
+	return last.(time.Time), true
+}
@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type offloadBackend struct {
+	modulecapabilities.BackupBackend
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func (b *offloadBackend) PutObject(ctx context.Context, backupID, key string, data []byte) error {
+	b.Lock()
+	defer b.Unlock()
+	b.objects[backupID+"/"+key] = data
+	return nil
+}
+
+func (b *offloadBackend) GetObject(ctx context.Context, backupID, key string) ([]byte, error) {
+	b.Lock()
+	defer b.Unlock()
+	data, ok := b.objects[backupID+"/"+key]
+	if !ok {
+		return nil, fmt.Errorf("object %q not found", key)
+	}
+	return data, nil
+}
+
+func (b *offloadBackend) Write(ctx context.Context, backupID, key string, r io.ReadCloser) (int64, error) {
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(data)), b.PutObject(ctx, backupID, key, data)
+}
+
+func (b *offloadBackend) Read(ctx context.Context, backupID, key string, w io.WriteCloser) (int64, error) {
+	defer w.Close()
+	data, err := b.GetObject(ctx, backupID, key)
+	if err != nil {
+		return 0, err
+	}
+	return io.Copy(w, bytes.NewReader(data))
+}
+
+type offloadSchemaGetter struct {
+	schemaUC.SchemaGetter
+}
+
+func (offloadSchemaGetter) NodeName() string { return "node1" }
+
+func TestOffloadShard(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		backend = &offloadBackend{objects: map[string][]byte{}}
+		db      = &DB{schemaGetter: offloadSchemaGetter{}, offloadBackend: backend}
+		idx     = &Index{Config: IndexConfig{RootPath: t.TempDir(), ClassName: "Article"}}
+		dir     = shardPath(idx.path(), "tenant1")
+	)
+
+	require.Nil(t, os.MkdirAll(filepath.Join(dir, "lsm", "objects"), os.ModePerm))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "indexcount"), []byte("1"), 0o644))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "lsm", "objects", "segment.db"), []byte("data"), 0o644))
+
+	require.Nil(t, db.offloadShard(ctx, idx, "tenant1"))
+	assert.NoDirExists(t, dir)
+	assert.Equal(t, []byte("data"),
+		backend.objects["offloaded-tenants/node1/article/tenant1/lsm/objects/segment.db"])
+	assert.Contains(t, backend.objects, "offloaded-tenants/node1/article/tenant1/manifest.json")
+
+	require.Nil(t, db.restoreOffloadedShard(ctx, idx, "tenant1"))
+	assert.NoDirExists(t, dir+".restoring")
+	data, err := os.ReadFile(filepath.Join(dir, "lsm", "objects", "segment.db"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("data"), data)
+	data, err = os.ReadFile(filepath.Join(dir, "indexcount"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("1"), data)
+
+	t.Run("shard still on disk", func(t *testing.T) {
+		backend.objects = map[string][]byte{}
+		require.Nil(t, db.restoreOffloadedShard(ctx, idx, "tenant1"))
+		assert.DirExists(t, dir)
+	})
+
+	t.Run("missing manifest", func(t *testing.T) {
+		err := db.restoreOffloadedShard(ctx, idx, "tenant2")
+		assert.ErrorContains(t, err, "get manifest")
+	})
+}
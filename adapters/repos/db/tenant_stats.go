@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// TenantStats of a tenant, computed by the nodes storing it. Object count and
+// sizes are taken from the first replica which responds, the local one if
+// possible. The last access is the latest of all replicas, since every
+// replica only sees the accesses it served itself.
+func (db *DB) TenantStats(ctx context.Context, class, tenant string) (*schema.TenantStats, error) {
+	replicas, err := db.schemaGetter.ShardReplicas(class, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("replicas of tenant %q: %w", tenant, err)
+	}
+
+	nodes := append([]string{}, replicas...)
+	local := db.schemaGetter.NodeName()
+	for i, node := range nodes {
+		if node == local {
+			nodes[0], nodes[i] = nodes[i], nodes[0]
+			break
+		}
+	}
+
+	var (
+		stats *schema.TenantStats
+		errs  []error
+	)
+	for _, node := range nodes {
+		var replica *schema.TenantStats
+		if node == local {
+			replica, err = db.IncomingGetTenantStats(ctx, class, tenant)
+		} else {
+			replica, err = db.remoteNode.GetTenantStats(ctx, node, class, tenant)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("node %q: %w", node, err))
+			continue
+		}
+
+		if stats == nil {
+			stats = replica
+		} else if replica.LastAccess != nil &&
+			(stats.LastAccess == nil || replica.LastAccess.After(*stats.LastAccess)) {
+			stats.LastAccess = replica.LastAccess
+		}
+	}
+	if stats == nil {
+		return nil, fmt.Errorf("tenant %q: %w", tenant, errors.Join(errs...))
+	}
+	return stats, nil
+}
+
+// IncomingGetTenantStats computes the stats of a tenant stored on this node.
+// Only metadata is read, so inactive tenants are not loaded.
+func (db *DB) IncomingGetTenantStats(ctx context.Context, class, tenant string) (*schema.TenantStats, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", class))
+	}
+	_, status := db.schemaGetter.TenantShard(idx.Config.ClassName.String(), tenant)
+	if status == "" {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("tenant %q not found", tenant))
+	}
+
+	stats := &schema.TenantStats{
+		Class:          idx.Config.ClassName.String(),
+		Tenant:         tenant,
+		Node:           db.schemaGetter.NodeName(),
+		ActivityStatus: status,
+	}
+	if last, ok := db.TenantLastAccess(stats.Class, tenant); ok {
+		stats.LastAccess = &last
+	}
+
+	dir := shardPath(idx.path(), tenant)
+	if _, err := os.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			// the files of frozen tenants are offloaded
+			return stats, nil
+		}
+		return nil, err
+	}
+
+	if shard := idx.shards.Load(tenant); shard != nil && isLoaded(shard) {
+		stats.ObjectCount = int64(shard.ObjectCount())
+	} else {
+		tracker, err := inverted.NewJsonShardMetaData(path.Join(dir, "proplengths"), db.logger)
+		if err != nil {
+			return nil, fmt.Errorf("read object count: %w", err)
+		}
+		stats.ObjectCount = int64(tracker.ObjectTally())
+	}
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// removed by a compaction in the meantime
+				return nil
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		stats.DiskUsageBytes += info.Size()
+		if isVectorIndexFile(dir, p) {
+			stats.VectorIndexBytes += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("disk usage of tenant %q: %w", tenant, err)
+	}
+	return stats, nil
+}
+
+func isLoaded(shard ShardLike) bool {
+	if lazy, ok := shard.(*LazyLoadShard); ok {
+		return lazy.isLoaded()
+	}
+	return true
+}
+
+// isVectorIndexFile checks if the file belongs to the commit log of an HNSW
+// index or to the buckets of a flat index
+func isVectorIndexFile(shardDir, file string) bool {
+	rel, err := filepath.Rel(shardDir, file)
+	if err != nil {
+		return false
+	}
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	if strings.HasSuffix(parts[0], ".hnsw.commitlog.d") {
+		return true
+	}
+	return len(parts) > 2 && parts[0] == "lsm" &&
+		(parts[1] == helpers.VectorsBucketLSM || parts[1] == helpers.VectorsCompressedBucketLSM)
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type statsSchemaGetter struct {
+	schemaUC.SchemaGetter
+	statuses map[string]string
+}
+
+func (statsSchemaGetter) NodeName() string { return "node1" }
+
+func (f statsSchemaGetter) TenantShard(class, tenant string) (string, string) {
+	if status, ok := f.statuses[tenant]; ok {
+		return tenant, status
+	}
+	return "", ""
+}
+
+func (f statsSchemaGetter) ShardReplicas(class, shard string) ([]string, error) {
+	return []string{"node1"}, nil
+}
+
+func TestTenantStats(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		idx       = &Index{Config: IndexConfig{RootPath: t.TempDir(), ClassName: "Article"}}
+		db        = &DB{
+			logger:  logger,
+			indices: map[string]*Index{idx.ID(): idx},
+			schemaGetter: statsSchemaGetter{statuses: map[string]string{
+				"cold":   models.TenantActivityStatusCOLD,
+				"frozen": models.TenantActivityStatusFROZEN,
+			}},
+		}
+		dir = shardPath(idx.path(), "cold")
+	)
+
+	write := func(file string, size int) {
+		p := filepath.Join(dir, file)
+		require.Nil(t, os.MkdirAll(filepath.Dir(p), os.ModePerm))
+		require.Nil(t, os.WriteFile(p, make([]byte, size), 0o644))
+	}
+	write("main.hnsw.commitlog.d/1", 100)
+	write("lsm/vectors/segment-1.db", 20)
+	write("lsm/objects/segment-1.db", 50)
+	tracker, err := inverted.NewJsonShardMetaData(filepath.Join(dir, "proplengths"), logger)
+	require.Nil(t, err)
+	require.Nil(t, tracker.TrackObjects(3))
+	require.Nil(t, tracker.Flush(false))
+	// the tracker keeps a backup of the same size
+	info, err := os.Stat(filepath.Join(dir, "proplengths"))
+	require.Nil(t, err)
+
+	lastAccess := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	idx.lastAccess.Store("cold", lastAccess)
+
+	t.Run("inactive tenant", func(t *testing.T) {
+		stats, err := db.TenantStats(ctx, "Article", "cold")
+		require.Nil(t, err)
+		assert.Equal(t, "Article", stats.Class)
+		assert.Equal(t, "node1", stats.Node)
+		assert.Equal(t, models.TenantActivityStatusCOLD, stats.ActivityStatus)
+		assert.Equal(t, int64(3), stats.ObjectCount)
+		assert.Equal(t, int64(170)+2*info.Size(), stats.DiskUsageBytes)
+		assert.Equal(t, int64(120), stats.VectorIndexBytes)
+		assert.Equal(t, &lastAccess, stats.LastAccess)
+	})
+
+	t.Run("offloaded tenant", func(t *testing.T) {
+		stats, err := db.TenantStats(ctx, "Article", "frozen")
+		require.Nil(t, err)
+		assert.Equal(t, models.TenantActivityStatusFROZEN, stats.ActivityStatus)
+		assert.Zero(t, stats.DiskUsageBytes)
+		assert.Nil(t, stats.LastAccess)
+	})
+
+	t.Run("unknown tenant", func(t *testing.T) {
+		_, err := db.IncomingGetTenantStats(ctx, "Article", "unknown")
+		assert.ErrorAs(t, err, &enterrors.ErrNotFound{})
+	})
+}
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+// tieredStorageBackupID groups the tiered segments of all shards on the
+// backend, the segments of a bucket are stored below node/index/shard/bucket
+const tieredStorageBackupID = "tiered-segments"
+
+// tieredStorage moves the cold segments of all shards to a backup backend.
+// The backends are modules, which are initialized after the shards were
+// loaded, so tiered segments cannot be fetched until the backend is set.
+type tieredStorage struct {
+	coldAfter time.Duration
+
+	sync.RWMutex
+	backend modulecapabilities.BackupBackend
+}
+
+// newTieredStorage returns nil if segments are kept on local disk
+func newTieredStorage(coldAfter time.Duration) *tieredStorage {
+	if coldAfter <= 0 {
+		return nil
+	}
+	return &tieredStorage{coldAfter: coldAfter}
+}
+
+// SetTieredStorageBackend sets the backend cold segments are moved to. It
+// has no effect unless tiered storage was enabled in the config of the DB.
+func (db *DB) SetTieredStorageBackend(backend modulecapabilities.BackupBackend) {
+	if db.tieredStorage == nil {
+		return
+	}
+
+	db.tieredStorage.Lock()
+	defer db.tieredStorage.Unlock()
+	db.tieredStorage.backend = backend
+}
+
+func (t *tieredStorage) getBackend() (modulecapabilities.BackupBackend, error) {
+	t.RLock()
+	defer t.RUnlock()
+
+	if t.backend == nil {
+		return nil, fmt.Errorf("tiered storage backend is not initialized yet")
+	}
+	return t.backend, nil
+}
+
+// setOnStore moves the cold segments of the store of a shard to this storage
+func (t *tieredStorage) setOnStore(store *lsmkv.Store, node, index, shard string) {
+	if t == nil {
+		return
+	}
+	store.SetTieredStorage(t, path.Join(node, index, shard), t.coldAfter)
+}
+
+func (t *tieredStorage) Upload(ctx context.Context, key string, r io.Reader) error {
+	backend, err := t.getBackend()
+	if err != nil {
+		return err
+	}
+	_, err = backend.Write(ctx, tieredStorageBackupID, key, io.NopCloser(r))
+	return err
+}
+
+func (t *tieredStorage) Download(ctx context.Context, key string, w io.Writer) error {
+	backend, err := t.getBackend()
+	if err != nil {
+		return err
+	}
+	_, err = backend.Read(ctx, tieredStorageBackupID, key, nopWriteCloser{w})
+	return err
+}
+
+// Delete is a no-op for backends which cannot delete objects
+func (t *tieredStorage) Delete(ctx context.Context, key string) error {
+	backend, err := t.getBackend()
+	if err != nil {
+		return err
+	}
+	deleter, ok := backend.(modulecapabilities.BackupObjectDeleter)
+	if !ok {
+		return nil
+	}
+	return deleter.DeleteObject(ctx, tieredStorageBackupID, key)
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
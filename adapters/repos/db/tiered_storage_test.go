@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type deletingOffloadBackend struct {
+	*offloadBackend
+}
+
+func (b deletingOffloadBackend) DeleteObject(ctx context.Context, backupID, key string) error {
+	b.Lock()
+	defer b.Unlock()
+	delete(b.objects, backupID+"/"+key)
+	return nil
+}
+
+func TestTieredStorage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disabled", func(t *testing.T) {
+		db := &DB{tieredStorage: newTieredStorage(0)}
+		assert.Nil(t, db.tieredStorage)
+		db.SetTieredStorageBackend(&offloadBackend{})
+	})
+
+	t.Run("backend not initialized", func(t *testing.T) {
+		storage := newTieredStorage(time.Hour)
+		err := storage.Download(ctx, "node1/article/shard1/objects/segment-1.db", &bytes.Buffer{})
+		assert.ErrorContains(t, err, "not initialized")
+	})
+
+	t.Run("upload, download and delete", func(t *testing.T) {
+		backend := &offloadBackend{objects: map[string][]byte{}}
+		db := &DB{tieredStorage: newTieredStorage(time.Hour)}
+		db.SetTieredStorageBackend(deletingOffloadBackend{backend})
+
+		key := "node1/article/shard1/objects/segment-1.db"
+		require.Nil(t, db.tieredStorage.Upload(ctx, key, strings.NewReader("segment")))
+		assert.Equal(t, []byte("segment"), backend.objects[tieredStorageBackupID+"/"+key])
+
+		var buf bytes.Buffer
+		require.Nil(t, db.tieredStorage.Download(ctx, key, &buf))
+		assert.Equal(t, "segment", buf.String())
+
+		require.Nil(t, db.tieredStorage.Delete(ctx, key))
+		assert.Empty(t, backend.objects)
+	})
+
+	t.Run("backends which cannot delete keep segments", func(t *testing.T) {
+		backend := &offloadBackend{objects: map[string][]byte{}}
+		db := &DB{tieredStorage: newTieredStorage(time.Hour)}
+		db.SetTieredStorageBackend(backend)
+
+		key := "node1/article/shard1/objects/segment-1.db"
+		require.Nil(t, db.tieredStorage.Upload(ctx, key, strings.NewReader("segment")))
+		require.Nil(t, db.tieredStorage.Delete(ctx, key))
+		assert.Len(t, backend.objects, 1)
+	})
+}
@@ -19,31 +19,86 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 )
 
+// bqThresholds are the thresholds of each bit of a dimension, relative to
+// the root mean square of the vector. They split a normally distributed
+// dimension into bits+1 equally likely levels, and a bit is set if the value
+// is below its threshold. The hamming distance of two codes then counts the
+// levels between their values, summed over all dimensions.
+var bqThresholds = map[int][]float32{
+	1: {0},
+	2: {-0.4307, 0.4307},
+	4: {-0.8416, -0.2533, 0.2533, 0.8416},
+}
+
+func validBQBits(bits int) bool {
+	_, ok := bqThresholds[bits]
+	return ok
+}
+
 type BinaryQuantizer struct {
 	distancer distancer.Provider
+	bits      int
 }
 
 func NewBinaryQuantizer(distancer distancer.Provider) BinaryQuantizer {
+	return NewMultiBitBinaryQuantizer(distancer, 1)
+}
+
+// NewMultiBitBinaryQuantizer encodes every dimension with 1, 2 or 4 bits.
+// More bits lose less recall, at the cost of larger codes.
+func NewMultiBitBinaryQuantizer(distancer distancer.Provider, bits int) BinaryQuantizer {
+	if !validBQBits(bits) {
+		bits = 1
+	}
 	return BinaryQuantizer{
 		distancer: distancer,
+		bits:      bits,
+	}
+}
+
+func (bq BinaryQuantizer) Bits() int {
+	if bq.bits == 0 {
+		return 1
 	}
+	return bq.bits
 }
 
+// Encode stores the bits of the dimensions in planes, the i-th bit of all
+// dimensions is stored in the i-th plane of len(vec)/64 segments. A 1-bit
+// code is the sign of each dimension.
 func (bq BinaryQuantizer) Encode(vec []float32) []uint64 {
-	total := len(vec) / 64
+	segments := len(vec) / 64
 	if len(vec)%64 != 0 {
-		total++
+		segments++
 	}
-	code := make([]uint64, total)
+	thresholds := bqThresholds[bq.Bits()]
+	scale := float32(1)
+	if len(thresholds) > 1 {
+		scale = rootMeanSquare(vec)
+	}
+
+	code := make([]uint64, segments*len(thresholds))
 	for j := 0; j < len(vec); j++ {
-		if vec[j] < 0 {
-			segment := j / 64
-			code[segment] += uint64(math.Pow(2, float64(j%64)))
+		for plane, threshold := range thresholds {
+			if vec[j] < threshold*scale {
+				code[plane*segments+j/64] |= 1 << (j % 64)
+			}
 		}
 	}
 	return code
 }
 
+func rootMeanSquare(vec []float32) float32 {
+	if len(vec) == 0 {
+		return 0
+	}
+	sum := float64(0)
+	for _, v := range vec {
+		sum += float64(v) * float64(v)
+	}
+	return float32(math.Sqrt(sum / float64(len(vec))))
+}
+
 func (bq BinaryQuantizer) DistanceBetweenCompressedVectors(x, y []uint64) (float32, error) {
 	if len(x) != len(y) {
 		return 0, errors.New("BinaryQuantizer.DistanceBetweenCompressedVectors: Both vectors should have the same len")
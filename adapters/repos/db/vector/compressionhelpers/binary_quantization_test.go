@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
@@ -84,3 +85,99 @@ func TestBinaryQuantizerChecksSize(t *testing.T) {
 	_, err := bq.DistanceBetweenCompressedVectors(make([]uint64, 3), make([]uint64, 4))
 	assert.NotNil(t, err)
 }
+
+func TestMultiBitBinaryQuantizer(t *testing.T) {
+	vec := make([]float32, 100)
+	for i := range vec {
+		vec[i] = float32(i - 50)
+	}
+
+	t.Run("a single bit encodes the sign", func(t *testing.T) {
+		code := compressionhelpers.NewMultiBitBinaryQuantizer(nil, 1).Encode(vec)
+		require.Len(t, code, 2)
+		assert.Equal(t, uint64(1<<50-1), code[0])
+		assert.Equal(t, uint64(0), code[1])
+		assert.Equal(t, compressionhelpers.NewBinaryQuantizer(nil).Encode(vec), code)
+	})
+
+	t.Run("more bits are stored in planes", func(t *testing.T) {
+		for _, bits := range []int{2, 4} {
+			bq := compressionhelpers.NewMultiBitBinaryQuantizer(nil, bits)
+			code := bq.Encode(vec)
+			assert.Len(t, code, 2*bits)
+			assert.Equal(t, bits, bq.Bits())
+
+			d, err := bq.DistanceBetweenCompressedVectors(code, code)
+			require.Nil(t, err)
+			assert.Equal(t, float32(0), d)
+		}
+	})
+
+	t.Run("invalid bits fall back to a single bit", func(t *testing.T) {
+		assert.Equal(t, 1, compressionhelpers.NewMultiBitBinaryQuantizer(nil, 3).Bits())
+	})
+
+	t.Run("distances grow with the levels between values", func(t *testing.T) {
+		bq := compressionhelpers.NewMultiBitBinaryQuantizer(nil, 4)
+		x := bq.Encode([]float32{1, 1, 1, 1})
+		near := bq.Encode([]float32{1, 1, 1, 0.9})
+		far := bq.Encode([]float32{1, 1, 1, -1})
+
+		dNear, err := bq.DistanceBetweenCompressedVectors(x, near)
+		require.Nil(t, err)
+		dFar, err := bq.DistanceBetweenCompressedVectors(x, far)
+		require.Nil(t, err)
+		assert.Less(t, dNear, dFar)
+	})
+}
+
+func TestMultiBitBinaryQuantizerRecall(t *testing.T) {
+	k := 10
+	distanceProvider := distancer.NewCosineDistanceProvider()
+	vectors, queryVecs := testinghelpers.RandomVecs(2_000, 50, 256)
+	for i := range vectors {
+		vectors[i] = distancer.Normalize(vectors[i])
+	}
+	for i := range queryVecs {
+		queryVecs[i] = distancer.Normalize(queryVecs[i])
+	}
+	neighbors := make([][]uint64, len(queryVecs))
+	for i := range queryVecs {
+		neighbors[i], _ = testinghelpers.BruteForce(vectors, queryVecs[i], k, func(f1, f2 []float32) float32 {
+			d, _, _ := distanceProvider.SingleDist(f1, f2)
+			return d
+		})
+	}
+
+	recall := func(bits int) float32 {
+		bq := compressionhelpers.NewMultiBitBinaryQuantizer(nil, bits)
+		codes := make([][]uint64, len(vectors))
+		for i := range vectors {
+			codes[i] = bq.Encode(vectors[i])
+		}
+		hits := uint64(0)
+		for i := range queryVecs {
+			query := bq.Encode(queryVecs[i])
+			heap := priorityqueue.NewMax[any](k)
+			for j := range codes {
+				d, _ := bq.DistanceBetweenCompressedVectors(codes[j], query)
+				if heap.Len() < k || heap.Top().Dist > d {
+					if heap.Len() == k {
+						heap.Pop()
+					}
+					heap.Insert(uint64(j), d)
+				}
+			}
+			ids := make([]uint64, heap.Len())
+			for j := range ids {
+				ids[j] = heap.Pop().ID
+			}
+			hits += testinghelpers.MatchesInLists(neighbors[i], ids)
+		}
+		return float32(hits) / float32(k*len(queryVecs))
+	}
+
+	oneBit, twoBits, fourBits := recall(1), recall(2), recall(4)
+	assert.Greater(t, twoBits, oneBit)
+	assert.Greater(t, fourBits, twoBits)
+}
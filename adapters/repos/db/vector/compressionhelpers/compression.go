@@ -52,7 +52,7 @@ type VectorCompressor interface {
 	ExposeFields() PQData
 }
 
-type quantizedVectorsCompressor[T byte | uint64] struct {
+type quantizedVectorsCompressor[T byte | uint64 | float32] struct {
 	cache           cache.Cache[T]
 	compressedStore *lsmkv.Store
 	quantizer       quantizer[T]
@@ -264,7 +264,17 @@ func NewBQCompressor(
 	logger logrus.FieldLogger,
 	store *lsmkv.Store,
 ) (VectorCompressor, error) {
-	quantizer := NewBinaryQuantizer(distance)
+	return NewMultiBitBQCompressor(distance, 1, vectorCacheMaxObjects, logger, store)
+}
+
+func NewMultiBitBQCompressor(
+	distance distancer.Provider,
+	bits int,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+) (VectorCompressor, error) {
+	quantizer := NewMultiBitBinaryQuantizer(distance, bits)
 	bqVectorsCompressor := &quantizedVectorsCompressor[uint64]{
 		quantizer:       &quantizer,
 		compressedStore: store,
@@ -274,7 +284,96 @@ func NewBQCompressor(
 	return bqVectorsCompressor, nil
 }
 
-type quantizedCompressorDistancer[T byte | uint64] struct {
+// reductionKey is the key of the principal components in the reduction
+// bucket
+var reductionKey = []byte("pca")
+
+// NewReductionCompressor indexes the vectors with reduced dimensions. The
+// principal components are learned from data, they are persisted once the
+// index switched to the reduced vectors by PersistReduction.
+func NewReductionCompressor(
+	cfg hnsw.ReductionConfig,
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data [][]float32,
+	store *lsmkv.Store,
+) (VectorCompressor, error) {
+	reducer, err := NewDimensionReducer(cfg, distance, data)
+	if err != nil {
+		return nil, err
+	}
+	return newReductionCompressor(reducer, vectorCacheMaxObjects, logger, store)
+}
+
+// RestoreReductionCompressor is nil if the principal components of a pca
+// reduction were not persisted yet
+func RestoreReductionCompressor(
+	cfg hnsw.ReductionConfig,
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+) (VectorCompressor, error) {
+	if cfg.ReductionMethod() != hnsw.ReductionMethodPCA {
+		return NewReductionCompressor(cfg, distance, vectorCacheMaxObjects, logger, nil, store)
+	}
+
+	if err := store.CreateOrLoadBucket(context.Background(), helpers.VectorsReductionBucketLSM); err != nil {
+		return nil, errors.Wrapf(err, "Create or load bucket (reduction store)")
+	}
+	projection, err := store.Bucket(helpers.VectorsReductionBucketLSM).Get(reductionKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "Getting principal components")
+	}
+	if len(projection) == 0 {
+		return nil, nil
+	}
+
+	reducer := &DimensionReducer{distancer: distance, dimensions: cfg.Dimensions}
+	if err := reducer.restoreProjection(projection); err != nil {
+		return nil, err
+	}
+	return newReductionCompressor(reducer, vectorCacheMaxObjects, logger, store)
+}
+
+// PersistReduction stores the principal components of a reduction
+// compressor, other compressors are ignored
+func PersistReduction(compressor VectorCompressor) error {
+	c, ok := compressor.(*quantizedVectorsCompressor[float32])
+	if !ok {
+		return nil
+	}
+	reducer, ok := c.quantizer.(*DimensionReducer)
+	if !ok || !reducer.Trained() {
+		return nil
+	}
+
+	if err := c.compressedStore.CreateOrLoadBucket(context.Background(), helpers.VectorsReductionBucketLSM); err != nil {
+		return errors.Wrapf(err, "Create or load bucket (reduction store)")
+	}
+	return c.compressedStore.Bucket(helpers.VectorsReductionBucketLSM).Put(reductionKey, reducer.projectionBytes())
+}
+
+func newReductionCompressor(
+	reducer *DimensionReducer,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+) (VectorCompressor, error) {
+	reductionVectorsCompressor := &quantizedVectorsCompressor[float32]{
+		quantizer:       reducer,
+		compressedStore: store,
+	}
+	if err := reductionVectorsCompressor.initCompressedStore(); err != nil {
+		return nil, err
+	}
+	reductionVectorsCompressor.cache = cache.NewShardedFloat32LockCache(reductionVectorsCompressor.getCompressedVectorForID,
+		vectorCacheMaxObjects, logger, false, 0)
+	return reductionVectorsCompressor, nil
+}
+
+type quantizedCompressorDistancer[T byte | uint64 | float32] struct {
 	compressor *quantizedVectorsCompressor[T]
 	distancer  quantizerDistancer[T]
 }
@@ -21,7 +21,7 @@ type CompressionDistanceBag interface {
 	Distance(x, y uint64) (float32, error)
 }
 
-type quantizedDistanceBag[T byte | uint64] struct {
+type quantizedDistanceBag[T byte | uint64 | float32] struct {
 	elements   map[uint64][]T
 	compressor *quantizedVectorsCompressor[T]
 }
@@ -0,0 +1,236 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"gonum.org/v1/gonum/mat"
+)
+
+// DimensionReducer indexes vectors with fewer dimensions. It either keeps
+// the first dimensions, which suits Matryoshka embeddings, or projects the
+// vectors onto their principal components.
+type DimensionReducer struct {
+	distancer  distancer.Provider
+	dimensions int
+	// projection holds one row of inputDims weights per reduced dimension,
+	// it is nil if the vectors are truncated
+	projection []float32
+	inputDims  int
+}
+
+func NewDimensionReducer(cfg hnsw.ReductionConfig, distance distancer.Provider,
+	data [][]float32,
+) (*DimensionReducer, error) {
+	if cfg.Dimensions <= 0 {
+		return nil, fmt.Errorf("dimension reduction requires positive dimensions, got %d", cfg.Dimensions)
+	}
+	reducer := &DimensionReducer{
+		distancer:  distance,
+		dimensions: cfg.Dimensions,
+	}
+	if cfg.ReductionMethod() != hnsw.ReductionMethodPCA {
+		return reducer, nil
+	}
+
+	if err := reducer.fitPCA(data); err != nil {
+		return nil, err
+	}
+	return reducer, nil
+}
+
+// fitPCA uses the eigenvectors of the largest eigenvalues of the second
+// moment matrix of the data. The data is not centered, so that the
+// projection keeps the dot products as well as the distances.
+func (r *DimensionReducer) fitPCA(data [][]float32) error {
+	if len(data) == 0 {
+		return errors.New("principal components need at least one vector")
+	}
+	inputDims := len(data[0])
+	if r.dimensions >= inputDims {
+		return fmt.Errorf("cannot reduce %d dimensions to %d", inputDims, r.dimensions)
+	}
+
+	x := mat.NewDense(len(data), inputDims, nil)
+	for i, vec := range data {
+		if len(vec) != inputDims {
+			return fmt.Errorf("vector %d has %d dimensions, expected %d", i, len(vec), inputDims)
+		}
+		for j, v := range vec {
+			x.Set(i, j, float64(v))
+		}
+	}
+
+	moment := mat.NewSymDense(inputDims, nil)
+	moment.SymRankK(moment, 1/float64(len(data)), x.T())
+
+	var eigen mat.EigenSym
+	if ok := eigen.Factorize(moment, true); !ok {
+		return errors.New("eigen decomposition of the training data failed")
+	}
+	var vectors mat.Dense
+	eigen.VectorsTo(&vectors)
+
+	// the eigenvalues are in ascending order
+	r.inputDims = inputDims
+	r.projection = make([]float32, r.dimensions*inputDims)
+	for i := 0; i < r.dimensions; i++ {
+		col := inputDims - 1 - i
+		for j := 0; j < inputDims; j++ {
+			r.projection[i*inputDims+j] = float32(vectors.At(j, col))
+		}
+	}
+	return nil
+}
+
+func (r *DimensionReducer) Dimensions() int {
+	return r.dimensions
+}
+
+// Trained is true if the reducer projects onto principal components
+func (r *DimensionReducer) Trained() bool {
+	return r.projection != nil
+}
+
+func (r *DimensionReducer) Encode(vec []float32) []float32 {
+	var reduced []float32
+	if r.projection == nil {
+		n := r.dimensions
+		if n > len(vec) {
+			n = len(vec)
+		}
+		reduced = make([]float32, n)
+		copy(reduced, vec)
+	} else {
+		n := r.inputDims
+		if n > len(vec) {
+			n = len(vec)
+		}
+		reduced = make([]float32, r.dimensions)
+		for i := range reduced {
+			row := r.projection[i*r.inputDims : i*r.inputDims+n]
+			var sum float32
+			for j, w := range row {
+				sum += w * vec[j]
+			}
+			reduced[i] = sum
+		}
+	}
+
+	if r.distancer.Type() == "cosine-dot" {
+		// the reduced vector of a normalized vector is not normalized
+		return distancer.Normalize(reduced)
+	}
+	return reduced
+}
+
+func (r *DimensionReducer) DistanceBetweenCompressedVectors(x, y []float32) (float32, error) {
+	dist, _, err := r.distancer.SingleDist(x, y)
+	return dist, err
+}
+
+func (r *DimensionReducer) DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []float32) (float32, error) {
+	return r.DistanceBetweenCompressedVectors(r.Encode(x), encoded)
+}
+
+func (r *DimensionReducer) NewQuantizerDistancer(vec []float32) quantizerDistancer[float32] {
+	return &reducedDistancer{
+		x:       vec,
+		reducer: r,
+		reduced: r.Encode(vec),
+	}
+}
+
+func (r *DimensionReducer) NewCompressedQuantizerDistancer(a []float32) quantizerDistancer[float32] {
+	return &reducedDistancer{
+		reducer: r,
+		reduced: a,
+	}
+}
+
+func (r *DimensionReducer) ReturnQuantizerDistancer(distancer quantizerDistancer[float32]) {}
+
+func (r *DimensionReducer) CompressedBytes(compressed []float32) []byte {
+	return float32sToBytes(compressed)
+}
+
+func (r *DimensionReducer) FromCompressedBytes(compressed []byte) []float32 {
+	return float32sFromBytes(compressed)
+}
+
+func (r *DimensionReducer) ExposeFields() PQData {
+	return PQData{}
+}
+
+// projectionBytes serializes the principal components as the input
+// dimensions followed by the weights
+func (r *DimensionReducer) projectionBytes() []byte {
+	out := make([]byte, 4, 4+len(r.projection)*4)
+	binary.LittleEndian.PutUint32(out, uint32(r.inputDims))
+	return append(out, float32sToBytes(r.projection)...)
+}
+
+func (r *DimensionReducer) restoreProjection(in []byte) error {
+	if len(in) < 4 {
+		return errors.New("principal components are corrupt")
+	}
+	inputDims := int(binary.LittleEndian.Uint32(in))
+	projection := float32sFromBytes(in[4:])
+	if len(projection) != inputDims*r.dimensions {
+		return fmt.Errorf("principal components have %d weights, expected %d",
+			len(projection), inputDims*r.dimensions)
+	}
+	r.inputDims = inputDims
+	r.projection = projection
+	return nil
+}
+
+func float32sToBytes(in []float32) []byte {
+	out := make([]byte, len(in)*4)
+	for i, v := range in {
+		binary.LittleEndian.PutUint32(out[i*4:], math.Float32bits(v))
+	}
+	return out
+}
+
+func float32sFromBytes(in []byte) []float32 {
+	out := make([]float32, len(in)/4)
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[i*4:]))
+	}
+	return out
+}
+
+type reducedDistancer struct {
+	x       []float32
+	reducer *DimensionReducer
+	reduced []float32
+}
+
+func (d *reducedDistancer) Distance(x []float32) (float32, bool, error) {
+	dist, err := d.reducer.DistanceBetweenCompressedVectors(d.reduced, x)
+	return dist, err == nil, err
+}
+
+func (d *reducedDistancer) DistanceToFloat(x []float32) (float32, bool, error) {
+	if len(d.x) > 0 {
+		return d.reducer.distancer.SingleDist(d.x, x)
+	}
+	dist, err := d.reducer.DistanceBetweenCompressedVectors(d.reduced, d.reducer.Encode(x))
+	return dist, err == nil, err
+}
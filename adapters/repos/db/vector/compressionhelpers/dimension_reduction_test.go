@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	testinghelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// planeVectors are 8-d vectors which lie in a plane
+func planeVectors(r *rand.Rand, n int) [][]float32 {
+	u := []float32{1, 2, 0, -1, 0, 3, 1, 0}
+	v := []float32{0, 1, 1, 1, -2, 0, 0, 1}
+	vectors := make([][]float32, n)
+	for i := range vectors {
+		a, b := r.Float32()*2-1, r.Float32()*2-1
+		vectors[i] = make([]float32, len(u))
+		for j := range u {
+			vectors[i][j] = a*u[j] + b*v[j]
+		}
+	}
+	return vectors
+}
+
+func TestDimensionReducer(t *testing.T) {
+	t.Run("truncate keeps the first dimensions", func(t *testing.T) {
+		reducer, err := compressionhelpers.NewDimensionReducer(hnsw.ReductionConfig{
+			Enabled:    true,
+			Dimensions: 2,
+		}, distancer.NewL2SquaredProvider(), nil)
+		require.Nil(t, err)
+		assert.Equal(t, []float32{3, 4}, reducer.Encode([]float32{3, 4, 5, 6}))
+	})
+
+	t.Run("truncate normalizes for cosine", func(t *testing.T) {
+		reducer, err := compressionhelpers.NewDimensionReducer(hnsw.ReductionConfig{
+			Enabled:    true,
+			Dimensions: 2,
+		}, distancer.NewCosineDistanceProvider(), nil)
+		require.Nil(t, err)
+		reduced := reducer.Encode([]float32{3, 4, 5, 6})
+		assert.InDelta(t, 0.6, reduced[0], 1e-6)
+		assert.InDelta(t, 0.8, reduced[1], 1e-6)
+	})
+
+	t.Run("pca keeps the distances within the principal plane", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		data := planeVectors(r, 200)
+		l2 := distancer.NewL2SquaredProvider()
+		reducer, err := compressionhelpers.NewDimensionReducer(hnsw.ReductionConfig{
+			Enabled:    true,
+			Method:     hnsw.ReductionMethodPCA,
+			Dimensions: 2,
+		}, l2, data)
+		require.Nil(t, err)
+		assert.True(t, reducer.Trained())
+
+		for i := 1; i < len(data); i++ {
+			expected, _, err := l2.SingleDist(data[0], data[i])
+			require.Nil(t, err)
+			x, y := reducer.Encode(data[0]), reducer.Encode(data[i])
+			require.Len(t, x, 2)
+			actual, err := reducer.DistanceBetweenCompressedVectors(x, y)
+			require.Nil(t, err)
+			assert.InDelta(t, expected, actual, float64(1e-3*expected+1e-4))
+		}
+	})
+
+	t.Run("pca cannot increase the dimensions", func(t *testing.T) {
+		_, err := compressionhelpers.NewDimensionReducer(hnsw.ReductionConfig{
+			Enabled:    true,
+			Method:     hnsw.ReductionMethodPCA,
+			Dimensions: 8,
+		}, distancer.NewL2SquaredProvider(), planeVectors(rand.New(rand.NewSource(1)), 10))
+		assert.NotNil(t, err)
+	})
+}
+
+func TestReductionCompressorRestore(t *testing.T) {
+	cfg := hnsw.ReductionConfig{
+		Enabled:    true,
+		Method:     hnsw.ReductionMethodPCA,
+		Dimensions: 2,
+	}
+	l2 := distancer.NewL2SquaredProvider()
+	store := testinghelpers.NewDummyStore(t)
+	data := planeVectors(rand.New(rand.NewSource(2)), 100)
+
+	restored, err := compressionhelpers.RestoreReductionCompressor(cfg, l2, 1e12, nil, store)
+	require.Nil(t, err)
+	assert.Nil(t, restored, "principal components were not computed yet")
+
+	compressor, err := compressionhelpers.NewReductionCompressor(cfg, l2, 1e12, nil, data, store)
+	require.Nil(t, err)
+	for i, vec := range data {
+		compressor.Preload(uint64(i), vec)
+	}
+	expected, err := compressor.DistanceBetweenCompressedAndUncompressedVectorsFromID(context.Background(), 1, data[2])
+	require.Nil(t, err)
+	require.Nil(t, compressionhelpers.PersistReduction(compressor))
+
+	restored, err = compressionhelpers.RestoreReductionCompressor(cfg, l2, 1e12, nil, store)
+	require.Nil(t, err)
+	require.NotNil(t, restored)
+	actual, err := restored.DistanceBetweenCompressedAndUncompressedVectorsFromID(context.Background(), 1, data[2])
+	require.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+// Float16Quantizer keeps the vectors in half precision, two bytes per
+// dimension. The distances are those of the decoded vectors, so unlike the
+// other quantizers the results need no rescoring.
+type Float16Quantizer struct {
+	distancer distancer.Provider
+}
+
+func NewFloat16Quantizer(distance distancer.Provider) *Float16Quantizer {
+	return &Float16Quantizer{distancer: distance}
+}
+
+func (q *Float16Quantizer) Encode(vec []float32) []byte {
+	return byteops.Float16ToByteVector(vec)
+}
+
+func (q *Float16Quantizer) Decode(encoded []byte) []float32 {
+	return byteops.Float32FromFloat16ByteVector(encoded)
+}
+
+func (q *Float16Quantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	dist, _, err := q.distancer.SingleDist(q.Decode(x), q.Decode(y))
+	return dist, err
+}
+
+func (q *Float16Quantizer) DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) (float32, error) {
+	dist, _, err := q.distancer.SingleDist(x, q.Decode(encoded))
+	return dist, err
+}
+
+func (q *Float16Quantizer) NewQuantizerDistancer(vec []float32) quantizerDistancer[byte] {
+	return &float16Distancer{quantizer: q, x: vec}
+}
+
+func (q *Float16Quantizer) NewCompressedQuantizerDistancer(a []byte) quantizerDistancer[byte] {
+	return &float16Distancer{quantizer: q, x: q.Decode(a)}
+}
+
+func (q *Float16Quantizer) ReturnQuantizerDistancer(distancer quantizerDistancer[byte]) {}
+
+func (q *Float16Quantizer) CompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (q *Float16Quantizer) FromCompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (q *Float16Quantizer) ExposeFields() PQData {
+	return PQData{}
+}
+
+type float16Distancer struct {
+	quantizer *Float16Quantizer
+	x         []float32
+}
+
+func (d *float16Distancer) Distance(x []byte) (float32, bool, error) {
+	dist, err := d.quantizer.DistanceBetweenCompressedAndUncompressedVectors(d.x, x)
+	return dist, err == nil, err
+}
+
+func (d *float16Distancer) DistanceToFloat(x []float32) (float32, bool, error) {
+	return d.quantizer.distancer.SingleDist(d.x, x)
+}
+
+// NewFloat16Compressor keeps the vectors of the index in half precision. It
+// needs no training, so the index uses it from the start.
+func NewFloat16Compressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+) (VectorCompressor, error) {
+	float16VectorsCompressor := &quantizedVectorsCompressor[byte]{
+		quantizer:       NewFloat16Quantizer(distance),
+		compressedStore: store,
+	}
+	if err := float16VectorsCompressor.initCompressedStore(); err != nil {
+		return nil, err
+	}
+	float16VectorsCompressor.cache = cache.NewShardedByteLockCache(float16VectorsCompressor.getCompressedVectorForID,
+		vectorCacheMaxObjects, logger, 0)
+	return float16VectorsCompressor, nil
+}
@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	testinghelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+func TestFloat16Compressor(t *testing.T) {
+	l2 := distancer.NewL2SquaredProvider()
+	r := rand.New(rand.NewSource(3))
+	data := make([][]float32, 50)
+	for i := range data {
+		data[i] = make([]float32, 32)
+		for j := range data[i] {
+			data[i][j] = r.Float32()*2 - 1
+		}
+	}
+
+	compressor, err := compressionhelpers.NewFloat16Compressor(l2, 1e12, nil, testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	for i, vec := range data {
+		compressor.Preload(uint64(i), vec)
+	}
+
+	t.Run("distances are those of the rounded vectors", func(t *testing.T) {
+		for i := 1; i < len(data); i++ {
+			expected, _, err := l2.SingleDist(byteops.RoundToFloat16(data[0]), byteops.RoundToFloat16(data[i]))
+			require.Nil(t, err)
+			actual, err := compressor.DistanceBetweenCompressedVectorsFromIDs(context.Background(), 0, uint64(i))
+			require.Nil(t, err)
+			assert.Equal(t, expected, actual)
+		}
+	})
+
+	t.Run("distances are close to the full precision ones", func(t *testing.T) {
+		distancer, returnFn := compressor.NewDistancer(data[0])
+		defer returnFn()
+		for i := range data {
+			expected, _, err := l2.SingleDist(data[0], data[i])
+			require.Nil(t, err)
+			actual, ok, err := distancer.DistanceToNode(uint64(i))
+			require.Nil(t, err)
+			require.True(t, ok)
+			assert.InDelta(t, expected, actual, 1e-2)
+		}
+	})
+}
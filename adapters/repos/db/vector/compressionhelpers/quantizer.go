@@ -13,12 +13,12 @@ package compressionhelpers
 
 import "encoding/binary"
 
-type quantizerDistancer[T byte | uint64] interface {
+type quantizerDistancer[T byte | uint64 | float32] interface {
 	Distance(x []T) (float32, bool, error)
 	DistanceToFloat(x []float32) (float32, bool, error)
 }
 
-type quantizer[T byte | uint64] interface {
+type quantizer[T byte | uint64 | float32] interface {
 	DistanceBetweenCompressedVectors(x, y []T) (float32, error)
 	DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []T) (float32, error)
 	Encode(vec []float32) []T
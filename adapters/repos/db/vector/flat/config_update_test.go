@@ -47,6 +47,14 @@ func TestFlatUserConfigUpdates(t *testing.T) {
 					"bq is immutable: " +
 						"attempted change from \"true\" to \"false\""),
 			},
+			{
+				name:    "attempting to change bq bits",
+				initial: ent.UserConfig{BQ: ent.CompressionUserConfig{Enabled: true, Bits: 4}},
+				update:  ent.UserConfig{BQ: ent.CompressionUserConfig{Enabled: true, Bits: 2}},
+				expectedError: errors.Errorf(
+					"bq.bits is immutable: " +
+						"attempted change from \"4\" to \"2\""),
+			},
 			{
 				name:    "attempting to change distance",
 				initial: ent.UserConfig{Distance: "cosine"},
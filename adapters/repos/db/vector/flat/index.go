@@ -30,7 +30,10 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/tracing"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
@@ -39,6 +42,9 @@ const (
 	compressionBQ   = "bq"
 	compressionPQ   = "pq"
 	compressionNone = "none"
+
+	// the context of a search is checked every ctxCheckInterval vectors
+	ctxCheckInterval = 1000
 )
 
 type flat struct {
@@ -82,6 +88,7 @@ func New(cfg Config, uc flatent.UserConfig, store *lsmkv.Store) (*flat, error) {
 		compression:       extractCompression(uc),
 		pool:              newPools(),
 		store:             store,
+		bq:                compressionhelpers.NewMultiBitBinaryQuantizer(nil, uc.BQ.Bits),
 	}
 	index.initBuckets(context.Background())
 	if uc.BQ.Enabled && uc.BQ.Cache {
@@ -228,10 +235,6 @@ func float32SliceFromByteSlice(vector []byte, slice []float32) []float32 {
 func (index *flat) Add(id uint64, vector []float32) error {
 	index.trackDimensionsOnce.Do(func() {
 		atomic.StoreInt32(&index.dims, int32(len(vector)))
-
-		if index.isBQ() {
-			index.bq = compressionhelpers.NewBinaryQuantizer(nil)
-		}
 	})
 	if len(vector) != int(index.dims) {
 		return errors.Errorf("insert called with a vector of the wrong size")
@@ -273,7 +276,10 @@ func (index *flat) Delete(ids ...uint64) error {
 	return nil
 }
 
-func (index *flat) searchTimeRescore(k int) int {
+func (index *flat) searchTimeRescore(ctx context.Context, k int) int {
+	if rescore, ok := search.RescoreLimit(ctx, k); ok {
+		return rescore
+	}
 	// load atomically, so we can get away with concurrent updates of the
 	// userconfig without having to set a lock each time we try to read - which
 	// can be so common that it would cause considerable overhead
@@ -283,25 +289,34 @@ func (index *flat) searchTimeRescore(k int) int {
 	return k
 }
 
-func (index *flat) SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+func (index *flat) SearchByVector(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	ctx, span := tracing.Start(ctx, "flat.SearchByVector")
+	defer span.End()
+	span.SetAttribute("k", k)
+	span.SetAttribute("compression", index.compression)
+
 	switch index.compression {
 	case compressionBQ:
-		return index.searchByVectorBQ(vector, k, allow)
+		return index.searchByVectorBQ(ctx, vector, k, allow)
 	case compressionPQ:
 		// use uncompressed for now
 		fallthrough
 	default:
-		return index.searchByVector(vector, k, allow)
+		return index.searchByVector(ctx, vector, k, allow)
 	}
 }
 
-func (index *flat) searchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+func (index *flat) searchByVector(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
 	heap := index.pqResults.GetMax(k)
 	defer index.pqResults.Put(heap)
 
 	vector = index.normalized(vector)
 
-	if err := index.findTopVectors(heap, allow, k,
+	if err := index.findTopVectors(ctx, heap, allow, k,
 		index.store.Bucket(helpers.VectorsBucketLSM).Cursor,
 		index.createDistanceCalc(vector),
 	); err != nil {
@@ -323,8 +338,10 @@ func (index *flat) createDistanceCalc(vector []float32) distanceCalc {
 	}
 }
 
-func (index *flat) searchByVectorBQ(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
-	rescore := index.searchTimeRescore(k)
+func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	rescore := index.searchTimeRescore(ctx, k)
 	heap := index.pqResults.GetMax(rescore)
 	defer index.pqResults.Put(heap)
 
@@ -332,11 +349,11 @@ func (index *flat) searchByVectorBQ(vector []float32, k int, allow helpers.Allow
 	vectorBQ := index.bq.Encode(vector)
 
 	if index.isBQCached() {
-		if err := index.findTopVectorsCached(heap, allow, rescore, vectorBQ); err != nil {
+		if err := index.findTopVectorsCached(ctx, heap, allow, rescore, vectorBQ); err != nil {
 			return nil, nil, err
 		}
 	} else {
-		if err := index.findTopVectors(heap, allow, rescore,
+		if err := index.findTopVectors(ctx, heap, allow, rescore,
 			index.store.Bucket(helpers.VectorsCompressedBucketLSM).Cursor,
 			index.createDistanceCalcBQ(vectorBQ),
 		); err != nil {
@@ -387,7 +404,7 @@ func (index *flat) vectorById(id uint64) ([]byte, error) {
 
 // populates given heap with smallest distances and corresponding ids calculated by
 // distanceCalc
-func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
+func (index *flat) findTopVectors(ctx context.Context, heap *priorityqueue.Queue[any],
 	allow helpers.AllowList, limit int, cursorFn func() *lsmkv.CursorReplace,
 	distanceCalc distanceCalc,
 ) error {
@@ -417,7 +434,14 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 
 	// since keys are sorted, once key/id get greater than max allowed one
 	// further search can be stopped
-	for ; key != nil && (allow == nil || id <= allowMax); key, v = cursor.Next() {
+	for i := 1; key != nil && (allow == nil || id <= allowMax); key, v = cursor.Next() {
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		i++
+
 		id = binary.BigEndian.Uint64(key)
 		if allow == nil || allow.Contains(id) {
 			distance, err := distanceCalc(v)
@@ -432,7 +456,7 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 
 // populates given heap with smallest distances and corresponding ids calculated by
 // distanceCalc
-func (index *flat) findTopVectorsCached(heap *priorityqueue.Queue[any],
+func (index *flat) findTopVectorsCached(ctx context.Context, heap *priorityqueue.Queue[any],
 	allow helpers.AllowList, limit int, vectorBQ []uint64,
 ) error {
 	var id uint64
@@ -455,6 +479,12 @@ func (index *flat) findTopVectorsCached(heap *priorityqueue.Queue[any],
 	// since keys are sorted, once key/id get greater than max allowed one
 	// further search can be stopped
 	for ; id < uint64(all) && (allow == nil || id <= allowMax); id++ {
+		if id%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+
 		if allow == nil || allow.Contains(id) {
 			vec, err := index.bqCache.Get(context.Background(), id)
 			if err != nil {
@@ -507,7 +537,9 @@ func (index *flat) normalized(vector []float32) []float32 {
 	return vector
 }
 
-func (index *flat) SearchByVectorDistance(vector []float32, targetDistance float32, maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error) {
+func (index *flat) SearchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
 	var (
 		searchParams = newSearchByDistParams(maxLimit)
 
@@ -517,7 +549,7 @@ func (index *flat) SearchByVectorDistance(vector []float32, targetDistance float
 
 	recursiveSearch := func() (bool, error) {
 		totalLimit := searchParams.TotalLimit()
-		ids, dist, err := index.SearchByVector(vector, totalLimit, allow)
+		ids, dist, err := index.SearchByVector(ctx, vector, totalLimit, allow)
 		if err != nil {
 			return false, errors.Wrap(err, "vector search")
 		}
@@ -651,6 +683,12 @@ func (index *flat) DistancerProvider() distancer.Provider {
 	return index.distancerProvider
 }
 
+// Scrub has nothing to check, the vectors are stored in the buckets of the
+// shard, which are scrubbed with the shard
+func (index *flat) Scrub(ctx context.Context) ([]diskio.CorruptFile, error) {
+	return nil, nil
+}
+
 func newSearchByDistParams(maxLimit int64) *common.SearchByDistParams {
 	initialOffset := 0
 	initialLimit := common.DefaultSearchByDistInitialLimit
@@ -708,6 +746,10 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 			name:     "bq",
 			accessor: func(c flatent.UserConfig) interface{} { return c.BQ.Enabled },
 		},
+		{
+			name:     "bq.bits",
+			accessor: func(c flatent.UserConfig) interface{} { return c.BQ.Bits },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -14,6 +14,7 @@
 package flat
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -35,6 +36,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/search"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 )
 
@@ -116,7 +118,7 @@ func run(dirName string, logger *logrus.Logger, compression string, vectorCache
 	err = nil
 	compressionhelpers.Concurrently(uint64(len(queries)), func(i uint64) {
 		before := time.Now()
-		results, _, _ := index.SearchByVector(queries[i], k, allowList)
+		results, _, _ := index.SearchByVector(context.Background(), queries[i], k, allowList)
 
 		since := time.Since(before)
 		len := len(results)
@@ -245,3 +247,16 @@ func Test_NoRaceFlatIndex(t *testing.T) {
 		fmt.Println(err)
 	}
 }
+
+func TestFlatSearchTimeRescore(t *testing.T) {
+	index := &flat{rescore: 50}
+
+	assert.Equal(t, 50, index.searchTimeRescore(context.Background(), 10))
+	assert.Equal(t, 60, index.searchTimeRescore(context.Background(), 60))
+
+	ctx := search.WithOversampling(context.Background(), 2.5)
+	assert.Equal(t, 25, index.searchTimeRescore(ctx, 10))
+
+	ctx = search.WithOversampling(context.Background(), 0.5)
+	assert.Equal(t, 50, index.searchTimeRescore(ctx, 10), "keeps the configured limit")
+}
@@ -26,6 +26,19 @@ import (
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
+const (
+	// defaultEF is the ef of the first range search. Ranges holding fewer
+	// coordinates are answered by a single search.
+	defaultEF = 800
+	// maximumEF bounds the ef to which a range search is widened
+	maximumEF = 1 << 17
+)
+
+// ErrTooManyCoordinates is returned for ranges and shapes containing more
+// coordinates than a search can return, instead of an incomplete result
+var ErrTooManyCoordinates = errors.Errorf("geo range or shape contains "+
+	"more than %d coordinates, narrow it down", maximumEF)
+
 // Index wraps another index to provide geo searches. This allows us to reuse
 // the hnsw vector index, without making geo searches dependent on
 // hnsw-specific features.
@@ -133,7 +146,116 @@ func (i *Index) WithinRange(ctx context.Context,
 		return nil, errors.Wrap(err, "invalid arguments")
 	}
 
-	return i.vectorIndex.KnnSearchByVectorMaxDist(query, geoRange.Distance, 800, nil)
+	return i.withinDistance(query, geoRange.Distance)
+}
+
+// withinDistance searches the coordinates within dist of the query. If all
+// candidates of a search are within the distance, the range may hold more
+// coordinates than the search could return, so it is repeated with a doubled
+// ef. The first search is the single search ranges used to be answered with,
+// widening therefore never finds fewer coordinates.
+func (i *Index) withinDistance(query []float32, dist float32) ([]uint64, error) {
+	for ef := defaultEF; ; ef *= 2 {
+		if ef > maximumEF {
+			ef = maximumEF
+		}
+
+		results, err := i.vectorIndex.KnnSearchByVectorMaxDist(query, dist, ef, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// fewer results than candidates means that the search already reached
+		// coordinates outside of the range
+		if len(results) < ef {
+			return results, nil
+		}
+		if ef == maximumEF {
+			return nil, ErrTooManyCoordinates
+		}
+	}
+}
+
+// Within searches the index for all coordinates inside of value, which is a
+// filters.GeoRange, filters.GeoPolygon or filters.GeoBoundingBox. It is
+// thread-safe and can be called concurrently.
+func (i *Index) Within(ctx context.Context, value interface{}) ([]uint64, error) {
+	switch v := value.(type) {
+	case filters.GeoRange:
+		return i.WithinRange(ctx, v)
+	case filters.GeoPolygon:
+		return i.WithinPolygon(ctx, v)
+	case filters.GeoBoundingBox:
+		return i.WithinBoundingBox(ctx, v)
+	default:
+		return nil, fmt.Errorf("invalid arguments: unsupported geo value %T", value)
+	}
+}
+
+// AllowList returns the coordinates inside of value as an allow list. A
+// vector search passed the list only considers candidates inside of the geo
+// range or shape, instead of filtering its results afterwards.
+func (i *Index) AllowList(ctx context.Context, value interface{}) (helpers.AllowList, error) {
+	ids, err := i.Within(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+	return helpers.NewAllowList(ids...), nil
+}
+
+// WithinPolygon searches the index for all coordinates inside the specified
+// polygon. It is thread-safe and can be called concurrently.
+func (i *Index) WithinPolygon(ctx context.Context,
+	polygon filters.GeoPolygon,
+) ([]uint64, error) {
+	if err := polygon.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid arguments")
+	}
+
+	return i.withinShape(ctx, polygon.BoundingBox(), polygon.Contains)
+}
+
+// WithinBoundingBox searches the index for all coordinates inside the
+// specified box. It is thread-safe and can be called concurrently.
+func (i *Index) WithinBoundingBox(ctx context.Context,
+	box filters.GeoBoundingBox,
+) ([]uint64, error) {
+	if err := box.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid arguments")
+	}
+
+	return i.withinShape(ctx, box, box.Contains)
+}
+
+// withinShape uses the smallest circle around the bounding box of the shape
+// to narrow down the candidates with a range search. The candidates are then
+// checked against the exact shape, as the circle contains areas outside of
+// it.
+func (i *Index) withinShape(ctx context.Context, box filters.GeoBoundingBox,
+	contains func(lat, lon float32) bool,
+) ([]uint64, error) {
+	center, radius := enclosingCircle(box)
+	candidates, err := i.withinDistance(center, radius)
+	if err != nil {
+		return nil, err
+	}
+
+	out := candidates[:0]
+	for _, id := range candidates {
+		coordinates, err := i.config.CoordinatesForID(ctx, id)
+		if err != nil {
+			// the object may have been deleted in the meantime
+			continue
+		}
+		if coordinates == nil || coordinates.Latitude == nil || coordinates.Longitude == nil {
+			continue
+		}
+		if contains(*coordinates.Latitude, *coordinates.Longitude) {
+			out = append(out, id)
+		}
+	}
+
+	return out, nil
 }
 
 func (i *Index) Delete(id uint64) error {
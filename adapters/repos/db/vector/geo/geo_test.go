@@ -13,10 +13,13 @@ package geo
 
 import (
 	"context"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
@@ -112,6 +115,304 @@ func TestGeoJourney(t *testing.T) {
 	})
 }
 
+func TestGeoShapes(t *testing.T) {
+	elements := []models.GeoCoordinates{
+		coordinates(48.13743, 11.57549),  // munich
+		coordinates(48.78232, 9.17702),   // stuttgart
+		coordinates(52.52001, 13.40495),  // berlin
+		coordinates(53.55108, 9.99368),   // hamburg
+		coordinates(-17.71337, 178.0650), // suva, fiji
+		coordinates(-13.83333, -171.750), // apia, samoa
+	}
+
+	getCoordinates := func(ctx context.Context, id uint64) (*models.GeoCoordinates, error) {
+		return &elements[id], nil
+	}
+
+	geoIndex, err := NewIndex(Config{
+		ID:                 "unit-test",
+		CoordinatesForID:   getCoordinates,
+		DisablePersistence: true,
+		RootPath:           "doesnt-matter-persistence-is-off",
+	},
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+
+	for id, coordinates := range elements {
+		require.Nil(t, geoIndex.Add(uint64(id), &coordinates))
+	}
+
+	t.Run("bounding box around southern germany", func(t *testing.T) {
+		results, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft:     ptCoordinates(coordinates(49.5, 8.5)),
+			BottomRight: ptCoordinates(coordinates(47.5, 12.5)),
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 1}, results)
+	})
+
+	t.Run("bounding box spanning the antimeridian", func(t *testing.T) {
+		results, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft:     ptCoordinates(coordinates(-10, 175)),
+			BottomRight: ptCoordinates(coordinates(-20, -170)),
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{4, 5}, results)
+	})
+
+	t.Run("polygon around hamburg, berlin and munich", func(t *testing.T) {
+		// stuttgart lies within the bounding box of the polygon, but west of
+		// its western edge
+		results, err := geoIndex.WithinPolygon(context.Background(), filters.GeoPolygon{
+			Points: []models.GeoCoordinates{
+				coordinates(54.0, 9.0),
+				coordinates(54.0, 14.0),
+				coordinates(47.5, 12.5),
+				coordinates(47.5, 11.0),
+			},
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 2, 3}, results)
+	})
+
+	t.Run("invalid polygon", func(t *testing.T) {
+		_, err := geoIndex.WithinPolygon(context.Background(), filters.GeoPolygon{
+			Points: []models.GeoCoordinates{coordinates(53.6, 9.9), coordinates(52.6, 13.5)},
+		})
+		assert.EqualError(t, err, "invalid arguments: a polygon needs at least 3 points, got 2")
+	})
+
+	t.Run("invalid bounding box", func(t *testing.T) {
+		_, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft:     ptCoordinates(coordinates(47.5, 8.5)),
+			BottomRight: ptCoordinates(coordinates(49.5, 12.5)),
+		})
+		assert.EqualError(t, err, "invalid arguments: latitude of topLeft (47.5) "+
+			"must not be smaller than latitude of bottomRight (49.5)")
+	})
+}
+
+func TestGeoRangeInDenseArea(t *testing.T) {
+	// more coordinates within the range than a single search returns
+	var elements []models.GeoCoordinates
+	for i := 0; i < 2000; i++ {
+		elements = append(elements, models.GeoCoordinates{
+			Latitude:  ptFloat32(48.1 + float32(i%50)*0.0005),
+			Longitude: ptFloat32(11.5 + float32(i/50)*0.0005),
+		})
+	}
+	// far away from the dense area
+	elements = append(elements, models.GeoCoordinates{
+		Latitude:  ptFloat32(52.52001),
+		Longitude: ptFloat32(13.40495),
+	})
+
+	geoIndex := newTestIndex(t, elements)
+
+	results, err := geoIndex.WithinRange(context.Background(), filters.GeoRange{
+		GeoCoordinates: &models.GeoCoordinates{
+			Latitude:  ptFloat32(48.11),
+			Longitude: ptFloat32(11.51),
+		},
+		Distance: 10000,
+	})
+	require.Nil(t, err)
+	assert.Len(t, results, 2000)
+	assert.NotContains(t, results, uint64(2000))
+}
+
+func TestGeoRangeRecall(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+
+	// coordinates scattered around munich and a dense cluster in its center
+	var elements []models.GeoCoordinates
+	for i := 0; i < 3000; i++ {
+		elements = append(elements, coordinates(
+			47.9+r.Float32()*0.5, 11.3+r.Float32()*0.6))
+	}
+	for i := 0; i < 1500; i++ {
+		elements = append(elements, coordinates(
+			48.13+r.Float32()*0.02, 11.56+r.Float32()*0.03))
+	}
+
+	geoIndex := newTestIndex(t, elements)
+	distancer := distancer.NewGeoProvider()
+
+	var found, relevant int
+	for q := 0; q < 40; q++ {
+		center := coordinates(47.9+r.Float32()*0.5, 11.3+r.Float32()*0.6)
+		if q%4 == 0 {
+			center = coordinates(48.14, 11.575)
+		}
+		dist := 1000 + r.Float32()*19000
+		query, err := geoCoordiantesToVector(&center)
+		require.Nil(t, err)
+
+		// brute force scan of all coordinates
+		expected := map[uint64]struct{}{}
+		for id := range elements {
+			vec, err := geoCoordiantesToVector(&elements[id])
+			require.Nil(t, err)
+			d, _, err := distancer.SingleDist(query, vec)
+			require.Nil(t, err)
+			if d <= dist {
+				expected[uint64(id)] = struct{}{}
+			}
+		}
+
+		results, err := geoIndex.WithinRange(context.Background(), filters.GeoRange{
+			GeoCoordinates: &center,
+			Distance:       dist,
+		})
+		require.Nil(t, err)
+
+		// a single search with the default ef is how ranges used to be
+		// searched, widening must not find fewer coordinates
+		single, err := geoIndex.vectorIndex.KnnSearchByVectorMaxDist(query, dist, defaultEF, nil)
+		require.Nil(t, err)
+		assert.GreaterOrEqual(t, matches(results, expected), matches(single, expected))
+
+		for _, id := range results {
+			_, ok := expected[id]
+			assert.True(t, ok, "result %d is outside of the range", id)
+		}
+		found += matches(results, expected)
+		relevant += len(expected)
+	}
+
+	recall := float32(found) / float32(relevant)
+	assert.GreaterOrEqual(t, recall, float32(0.99))
+}
+
+func TestGeoRangeSearchEF(t *testing.T) {
+	query := []float32{48.13743, 11.57549}
+
+	t.Run("few matches are found with a single search", func(t *testing.T) {
+		vi := &fakeVectorIndex{matches: 3}
+		geoIndex := &Index{vectorIndex: vi}
+
+		results, err := geoIndex.withinDistance(query, 10000)
+		require.Nil(t, err)
+		assert.Len(t, results, 3)
+		assert.Equal(t, []int{defaultEF}, vi.efs)
+	})
+
+	t.Run("the search is widened while all candidates match", func(t *testing.T) {
+		vi := &fakeVectorIndex{matches: 3000}
+		geoIndex := &Index{vectorIndex: vi}
+
+		results, err := geoIndex.withinDistance(query, 10000)
+		require.Nil(t, err)
+		assert.Len(t, results, 3000)
+		assert.Equal(t, []int{800, 1600, 3200}, vi.efs)
+	})
+
+	t.Run("ranges exceeding the maximum ef are not cut off", func(t *testing.T) {
+		vi := &fakeVectorIndex{matches: 2 * maximumEF}
+		geoIndex := &Index{vectorIndex: vi}
+
+		_, err := geoIndex.withinDistance(query, 10000)
+		assert.Equal(t, ErrTooManyCoordinates, err)
+		assert.Equal(t, maximumEF, vi.efs[len(vi.efs)-1])
+	})
+
+	t.Run("shapes exceeding the maximum ef are not cut off", func(t *testing.T) {
+		vi := &fakeVectorIndex{matches: 2 * maximumEF}
+		geoIndex := &Index{vectorIndex: vi}
+
+		_, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft:     ptCoordinates(coordinates(49.5, 8.5)),
+			BottomRight: ptCoordinates(coordinates(47.5, 12.5)),
+		})
+		assert.Equal(t, ErrTooManyCoordinates, err)
+	})
+}
+
+func TestGeoAllowList(t *testing.T) {
+	elements := []models.GeoCoordinates{
+		coordinates(48.13743, 11.57549), // munich
+		coordinates(48.19597, 11.81799), // munich airport
+		coordinates(52.52001, 13.40495), // berlin
+	}
+	geoIndex := newTestIndex(t, elements)
+
+	list, err := geoIndex.AllowList(context.Background(), filters.GeoRange{
+		GeoCoordinates: ptCoordinates(coordinates(48.13743, 11.57549)),
+		Distance:       30000,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, 2, list.Len())
+	assert.True(t, list.Contains(0))
+	assert.True(t, list.Contains(1))
+	assert.False(t, list.Contains(2))
+
+	_, err = geoIndex.AllowList(context.Background(), "munich")
+	assert.EqualError(t, err, "invalid arguments: unsupported geo value string")
+}
+
+func newTestIndex(t *testing.T, elements []models.GeoCoordinates) *Index {
+	getCoordinates := func(ctx context.Context, id uint64) (*models.GeoCoordinates, error) {
+		return &elements[id], nil
+	}
+
+	geoIndex, err := NewIndex(Config{
+		ID:                 "unit-test",
+		CoordinatesForID:   getCoordinates,
+		DisablePersistence: true,
+		RootPath:           "doesnt-matter-persistence-is-off",
+	},
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+
+	for id, coordinates := range elements {
+		require.Nil(t, geoIndex.Add(uint64(id), &coordinates))
+	}
+	return geoIndex
+}
+
+func matches(ids []uint64, expected map[uint64]struct{}) int {
+	n := 0
+	for _, id := range ids {
+		if _, ok := expected[id]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// fakeVectorIndex has matches coordinates within any distance and records
+// the ef of each search
+type fakeVectorIndex struct {
+	vectorIndex
+	matches int
+	efs     []int
+}
+
+func (f *fakeVectorIndex) KnnSearchByVectorMaxDist(query []float32, dist float32,
+	ef int, allowList helpers.AllowList,
+) ([]uint64, error) {
+	f.efs = append(f.efs, ef)
+	n := f.matches
+	if n > ef {
+		n = ef
+	}
+	out := make([]uint64, n)
+	for i := range out {
+		out[i] = uint64(i)
+	}
+	return out, nil
+}
+
+func coordinates(lat, lon float32) models.GeoCoordinates {
+	return models.GeoCoordinates{Latitude: &lat, Longitude: &lon}
+}
+
+func ptCoordinates(in models.GeoCoordinates) *models.GeoCoordinates {
+	return &in
+}
+
 func ptFloat32(in float32) *float32 {
 	return &in
 }
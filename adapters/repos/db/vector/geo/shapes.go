@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package geo
+
+import (
+	"math"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// edgeSamples is the number of points per edge of a bounding box which are
+// measured to find the radius of the enclosing circle. Parallels are not
+// great circles, so the point farthest from the center is not necessarily a
+// corner.
+const edgeSamples = 16
+
+// enclosingCircle returns the center of the box and a radius in meters which
+// covers the whole box. The circle is not minimal, but it is never too small,
+// which is all that's required to prefilter candidates.
+func enclosingCircle(box filters.GeoBoundingBox) ([]float32, float32) {
+	top, bottom := *box.TopLeft.Latitude, *box.BottomRight.Latitude
+	left, right := *box.TopLeft.Longitude, *box.BottomRight.Longitude
+	width := right - left
+	if width < 0 {
+		// box spans the antimeridian
+		width += 360
+	}
+
+	centerLon := left + width/2
+	if centerLon > 180 {
+		centerLon -= 360
+	}
+	center := []float32{bottom + (top-bottom)/2, centerLon}
+
+	geo := distancer.NewGeoProvider()
+	var radius float32
+	measure := func(lat, lon float32) {
+		if dist, _, err := geo.SingleDist(center, []float32{lat, lon}); err == nil {
+			radius = max(radius, dist)
+		}
+	}
+	for i := 0; i <= edgeSamples; i++ {
+		frac := float32(i) / edgeSamples
+		measure(top, left+frac*width)
+		measure(bottom, left+frac*width)
+		measure(bottom+frac*(top-bottom), left)
+		measure(bottom+frac*(top-bottom), left+width)
+	}
+
+	// leave some headroom for rounding errors, but never exceed half the
+	// circumference of the earth
+	return center, float32(math.Min(float64(radius)*1.01+1, math.Pi*6371e3))
+}
@@ -17,6 +17,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 // SwitchCommitLogs makes sure that the previously writeable commitlog is
@@ -47,6 +48,11 @@ func (h *hnsw) ListFiles(ctx context.Context, basePath string) ([]string, error)
 			return nil
 		}
 
+		// checksums are stored again on the first scrub after a restore
+		if strings.HasSuffix(pth, ".checksums") {
+			return nil
+		}
+
 		st, statErr := os.Stat(pth)
 		if statErr != nil {
 			return statErr
@@ -12,6 +12,7 @@
 package hnsw
 
 import (
+	"context"
 	"flag"
 	"io"
 	"net/http"
@@ -122,7 +123,7 @@ Ex: go test -v -benchmem -bench ^BenchmarkHnswNeurips23$ -download`, step.Datase
 								}
 
 								compressionhelpers.Concurrently(uint64(len(queryVectors)), func(i uint64) {
-									_, _, err := index.SearchByVector(queryVectors[i], 0, nil)
+									_, _, err := index.SearchByVector(context.Background(), queryVectors[i], 0, nil)
 									require.NoError(b, err)
 								})
 							default:
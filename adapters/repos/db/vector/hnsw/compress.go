@@ -12,8 +12,15 @@
 package hnsw
 
 import (
+	"context"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/entities/storobj"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -30,55 +37,222 @@ func (h *hnsw) calculateOptimalSegments(dims int) int {
 	return dims
 }
 
+// compressionBatchSize is the number of existing vectors which are
+// compressed between checks whether the compression was cancelled
+const compressionBatchSize = 10_000
+
+var errCompressionRunning = errors.New("compression is already running")
+
+// pendingCompression is the compressor of a compression which runs in the
+// background. Inserts keep using the uncompressed vectors, but add their
+// vectors to the pending compressor as well, so that none are missing once
+// the index switches to the compressed vectors.
+type pendingCompression struct {
+	compressor compressionhelpers.VectorCompressor
+}
+
+// compress trains the compressor on a sample of the vectors and compresses
+// the existing vectors in batches, while inserts and searches continue on the
+// uncompressed vectors. Only the switch to the compressed vectors blocks
+// them.
 func (h *hnsw) compress(cfg ent.UserConfig) error {
-	if !cfg.PQ.Enabled && !cfg.BQ.Enabled {
+	if !cfg.PQ.Enabled && !cfg.BQ.Enabled && !cfg.DimensionReduction.Enabled {
 		return nil
 	}
 
+	if !h.compressing.CompareAndSwap(false, true) {
+		return errCompressionRunning
+	}
+	defer h.compressing.Store(false)
+
+	ctx := h.compressCtx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	compressor, err := h.newCompressor(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	// the vectors inserted from now on are added to the compressor by the
+	// inserts, all the others are part of the nodes below
+	h.pendingCompression.Store(&pendingCompression{compressor: compressor})
+	defer h.pendingCompression.Store(nil)
+
+	if err := h.compressVectors(ctx, compressor, h.nodeIDs(true)); err != nil {
+		compressor.Drop()
+		return errors.Wrap(err, "compress vectors")
+	}
+
 	h.compressActionLock.Lock()
 	defer h.compressActionLock.Unlock()
-	data := h.cache.All()
 	if cfg.PQ.Enabled {
-		if h.isEmpty() {
-			return errors.New("Compress command cannot be executed before inserting some data. Please, insert your data first.")
-		}
-		dims := int(h.dims)
+		h.commitLog.AddPQ(compressor.ExposeFields())
+	}
+	if err := compressionhelpers.PersistReduction(compressor); err != nil {
+		compressor.Drop()
+		return errors.Wrap(err, "persist dimension reduction")
+	}
+	h.compressor = compressor
+	h.compressed.Store(true)
+	h.cache.Drop()
+	return nil
+}
 
-		if cfg.PQ.Segments <= 0 {
-			cfg.PQ.Segments = h.calculateOptimalSegments(dims)
-			h.pqConfig.Segments = cfg.PQ.Segments
-		}
+func (h *hnsw) newCompressor(ctx context.Context, cfg ent.UserConfig,
+) (compressionhelpers.VectorCompressor, error) {
+	if cfg.DimensionReduction.Enabled {
+		return h.newReductionCompressor(ctx, cfg.DimensionReduction)
+	}
+	if !cfg.PQ.Enabled {
+		return compressionhelpers.NewMultiBitBQCompressor(h.distancerProvider, cfg.BQ.Bits, 1e12, h.logger, h.store)
+	}
 
-		cleanData := make([][]float32, 0, len(data))
-		for _, point := range data {
-			if point == nil {
-				continue
-			}
-			cleanData = append(cleanData, point)
-		}
+	if h.isEmpty() {
+		return nil, errors.New("Compress command cannot be executed before inserting some data. Please, insert your data first.")
+	}
+	dims := int(atomic.LoadInt32(&h.dims))
 
+	if cfg.PQ.Segments <= 0 {
+		cfg.PQ.Segments = h.calculateOptimalSegments(dims)
+		h.pqConfig.Segments = cfg.PQ.Segments
+	}
+
+	sample, err := h.trainingSample(ctx, cfg.PQ.TrainingLimit)
+	if err != nil {
+		return nil, errors.Wrap(err, "sample training data")
+	}
+
+	compressor, err := compressionhelpers.NewPQCompressor(cfg.PQ, h.distancerProvider, dims, 1e12, h.logger, sample, h.store)
+	if err != nil {
+		return nil, errors.Wrap(err, "Compressing vectors.")
+	}
+	return compressor, nil
+}
+
+func (h *hnsw) newReductionCompressor(ctx context.Context, cfg ent.ReductionConfig,
+) (compressionhelpers.VectorCompressor, error) {
+	var sample [][]float32
+	if cfg.NeedsTraining() {
+		if h.isEmpty() {
+			return nil, errors.New("principal components cannot be computed before inserting some data")
+		}
 		var err error
-		h.compressor, err = compressionhelpers.NewPQCompressor(cfg.PQ, h.distancerProvider, dims, 1e12, h.logger, cleanData, h.store)
+		sample, err = h.trainingSample(ctx, cfg.Limit())
 		if err != nil {
-			return errors.Wrap(err, "Compressing vectors.")
+			return nil, errors.Wrap(err, "sample training data")
 		}
-		h.commitLog.AddPQ(h.compressor.ExposeFields())
-	} else {
-		var err error
-		h.compressor, err = compressionhelpers.NewBQCompressor(h.distancerProvider, 1e12, h.logger, h.store)
+	}
+
+	compressor, err := compressionhelpers.NewReductionCompressor(cfg, h.distancerProvider, 1e12, h.logger, sample, h.store)
+	if err != nil {
+		return nil, errors.Wrap(err, "reduce vector dimensions")
+	}
+	return compressor, nil
+}
+
+// trainingSample picks up to limit random vectors of the index, all of them
+// if limit is not positive
+func (h *hnsw) trainingSample(ctx context.Context, limit int) ([][]float32, error) {
+	ids := h.nodeIDs(false)
+	if limit <= 0 || limit > len(ids) {
+		limit = len(ids)
+	}
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	sample := make([][]float32, 0, limit)
+	for _, i := range r.Perm(len(ids)) {
+		if len(sample) == limit {
+			break
+		}
+		vector, err := h.compressionVector(ctx, ids[i])
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if vector != nil {
+			sample = append(sample, vector)
 		}
 	}
-	compressionhelpers.Concurrently(uint64(len(data)),
-		func(index uint64) {
-			if data[index] == nil {
+	return sample, nil
+}
+
+func (h *hnsw) compressVectors(ctx context.Context,
+	compressor compressionhelpers.VectorCompressor, ids []uint64,
+) error {
+	for start := 0; start < len(ids); start += compressionBatchSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		end := start + compressionBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batch := ids[start:end]
+
+		var once sync.Once
+		var batchErr error
+		compressionhelpers.Concurrently(uint64(len(batch)), func(i uint64) {
+			vector, err := h.compressionVector(ctx, batch[i])
+			if err != nil {
+				once.Do(func() { batchErr = err })
 				return
 			}
-			h.compressor.Preload(index, data[index])
+			if vector != nil {
+				compressor.Preload(batch[i], vector)
+			}
 		})
+		if batchErr != nil {
+			return batchErr
+		}
 
-	h.compressed.Store(true)
-	h.cache.Drop()
+		h.logger.WithField("action", "compress").
+			WithField("compressed", end).
+			WithField("total", len(ids)).
+			Debug("compressed batch of vectors")
+	}
 	return nil
 }
+
+// compressionVector is nil if the object of the node was deleted in the
+// meantime
+func (h *hnsw) compressionVector(ctx context.Context, id uint64) ([]float32, error) {
+	vector, err := h.cache.Get(ctx, id)
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(vector) == 0 {
+		return nil, nil
+	}
+	return vector, nil
+}
+
+// nodeIDs are the ids of the nodes of the graph, optionally including those
+// with a tombstone
+func (h *hnsw) nodeIDs(withTombstones bool) []uint64 {
+	h.RLock()
+	defer h.RUnlock()
+
+	ids := make([]uint64, 0, len(h.nodes))
+	for i := range h.nodes {
+		id := uint64(i)
+		h.shardedNodeLocks.RLock(id)
+		exists := h.nodes[i] != nil
+		h.shardedNodeLocks.RUnlock(id)
+		if exists && (withTombstones || !h.hasTombstone(id)) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (h *hnsw) cancelCompression() {
+	if h.compressCancel != nil {
+		h.compressCancel()
+	}
+}
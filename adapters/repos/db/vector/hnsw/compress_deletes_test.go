@@ -85,7 +85,7 @@ func Test_NoRaceCompressDoesNotCrash(t *testing.T) {
 	uc.PQ = cfg
 	index.compress(uc)
 	for _, v := range queries {
-		_, _, err := index.SearchByVector(v, k, nil)
+		_, _, err := index.SearchByVector(context.Background(), v, k, nil)
 		assert.Nil(t, err)
 	}
 }
@@ -173,3 +173,77 @@ func TestHnswPqNilVectors(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+
+func Test_NoRaceCompressWhileInserting(t *testing.T) {
+	dimensions := 16
+	vectors, queries := testinghelpers.RandomVecs(4_000, 10, dimensions)
+	userConfig := ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    32,
+		VectorCacheMaxObjects: 1000000,
+	}
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "compress-while-inserting-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+	}, userConfig, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.NoError(t, err)
+	defer index.Shutdown(context.Background())
+
+	half := uint64(len(vectors) / 2)
+	compressionhelpers.Concurrently(half, func(id uint64) {
+		require.Nil(t, index.Add(id, vectors[id]))
+	})
+
+	t.Run("training sample", func(t *testing.T) {
+		sample, err := index.trainingSample(context.Background(), 100)
+		require.Nil(t, err)
+		assert.Len(t, sample, 100)
+
+		sample, err = index.trainingSample(context.Background(), 0)
+		require.Nil(t, err)
+		assert.Len(t, sample, int(half))
+	})
+
+	userConfig.PQ = ent.PQConfig{
+		Enabled: true,
+		Encoder: ent.PQEncoder{
+			Type:         ent.PQEncoderTypeKMeans,
+			Distribution: ent.PQEncoderDistributionLogNormal,
+		},
+		Segments:      dimensions / 2,
+		Centroids:     256,
+		TrainingLimit: 1000,
+	}
+
+	compressed := make(chan struct{})
+	require.Nil(t, index.UpdateUserConfig(userConfig, func() {
+		close(compressed)
+	}))
+
+	// inserts continue while the vectors are compressed in the background
+	compressionhelpers.Concurrently(half, func(i uint64) {
+		require.Nil(t, index.Add(half+i, vectors[half+i]))
+	})
+	<-compressed
+	require.True(t, index.Compressed())
+
+	for id := range vectors {
+		_, err := index.compressor.DistanceBetweenCompressedAndUncompressedVectorsFromID(
+			context.Background(), uint64(id), vectors[id])
+		require.Nil(t, err, "compressed vector of %d", id)
+	}
+
+	for _, query := range queries {
+		ids, _, err := index.SearchByVector(context.Background(), query, 10, nil)
+		require.Nil(t, err)
+		assert.Len(t, ids, 10)
+	}
+}
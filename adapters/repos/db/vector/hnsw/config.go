@@ -32,6 +32,9 @@ type Config struct {
 	Logger                logrus.FieldLogger
 	DistanceProvider      distancer.Provider
 	PrometheusMetrics     *monitoring.PrometheusMetrics
+	// StartupProgress is called with the ratio of the commit logs which were
+	// replayed while the index is loaded, it is optional
+	StartupProgress func(ratio float64)
 
 	// metadata for monitoring
 	ShardName string
@@ -53,6 +53,26 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			// the codes of the compressed vectors are only comparable if they
+			// are encoded with the same number of bits
+			name:     "bq.bits",
+			accessor: func(c ent.UserConfig) interface{} { return c.BQ.Bits },
+		},
+		{
+			// the reduced vectors of different reductions are not comparable
+			name:     "dimensionReduction.method",
+			accessor: func(c ent.UserConfig) interface{} { return c.DimensionReduction.ReductionMethod() },
+		},
+		{
+			name:     "dimensionReduction.dimensions",
+			accessor: func(c ent.UserConfig) interface{} { return c.DimensionReduction.Dimensions },
+		},
+		{
+			// the stored vectors are not converted
+			name:     "vectorStorage",
+			accessor: func(c ent.UserConfig) interface{} { return c.StorageType() },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -96,13 +116,16 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.efMax, int64(parsed.DynamicEFMax))
 	atomic.StoreInt64(&h.efFactor, int64(parsed.DynamicEFFactor))
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+	h.efTuner.setTarget(parsed.EFTarget)
 
-	if !parsed.PQ.Enabled && !parsed.BQ.Enabled {
+	if !parsed.PQ.Enabled && !parsed.BQ.Enabled && !parsed.DimensionReduction.Enabled {
 		callback()
 		return nil
 	}
 
 	h.pqConfig = parsed.PQ
+	h.bqConfig = parsed.BQ
+	h.reductionConfig = parsed.DimensionReduction
 	if asyncEnabled() {
 		callback()
 		return nil
@@ -112,6 +135,7 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 		// the compression will fire the callback once it's complete
 		return h.TurnOnCompression(callback)
 	} else {
+		atomic.StoreInt64(&h.vectorCacheMaxObjects, int64(parsed.VectorCacheMaxObjects))
 		h.compressor.SetCacheMaxSize(int64(parsed.VectorCacheMaxObjects))
 		callback()
 		return nil
@@ -122,7 +146,15 @@ func asyncEnabled() bool {
 	return config.Enabled(os.Getenv("ASYNC_INDEXING"))
 }
 
+// TurnOnCompression compresses the vectors in the background, the callback
+// fires once the index switched to the compressed vectors. Inserts and
+// searches continue on the uncompressed vectors in the meantime.
 func (h *hnsw) TurnOnCompression(callback func()) error {
+	if h.compressing.Load() {
+		callback()
+		return nil
+	}
+
 	h.logger.WithField("action", "compress").Info("switching to compressed vectors")
 
 	err := ent.ValidatePQConfig(h.pqConfig)
@@ -142,10 +174,15 @@ func (h *hnsw) compressThenCallback(callback func()) {
 	uc := ent.UserConfig{
 		PQ: h.pqConfig,
 		BQ: ent.BQConfig{
-			Enabled: !h.pqConfig.Enabled,
+			Enabled: !h.pqConfig.Enabled && !h.reductionConfig.Enabled,
+			Bits:    h.bqConfig.Bits,
 		},
+		DimensionReduction: h.reductionConfig,
 	}
 	if err := h.compress(uc); err != nil {
+		if errors.Is(err, errCompressionRunning) {
+			return
+		}
 		h.logger.Error(err)
 		return
 	}
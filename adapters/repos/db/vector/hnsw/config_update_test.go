@@ -63,6 +63,38 @@ func TestUserConfigUpdates(t *testing.T) {
 					"distance is immutable: " +
 						"attempted change from \"cosine\" to \"l2-squared\""),
 			},
+			{
+				name:    "attempting to change bq bits",
+				initial: ent.UserConfig{BQ: ent.BQConfig{Enabled: true, Bits: 1}},
+				update:  ent.UserConfig{BQ: ent.BQConfig{Enabled: true, Bits: 2}},
+				expectedError: errors.Errorf(
+					"bq.bits is immutable: " +
+						"attempted change from \"1\" to \"2\""),
+			},
+			{
+				name:    "attempting to change the reduction method",
+				initial: ent.UserConfig{DimensionReduction: ent.ReductionConfig{Enabled: true, Dimensions: 256}},
+				update:  ent.UserConfig{DimensionReduction: ent.ReductionConfig{Enabled: true, Method: "pca", Dimensions: 256}},
+				expectedError: errors.Errorf(
+					"dimensionReduction.method is immutable: " +
+						"attempted change from \"truncate\" to \"pca\""),
+			},
+			{
+				name:    "attempting to change the reduced dimensions",
+				initial: ent.UserConfig{DimensionReduction: ent.ReductionConfig{Enabled: true, Dimensions: 256}},
+				update:  ent.UserConfig{DimensionReduction: ent.ReductionConfig{Enabled: true, Dimensions: 512}},
+				expectedError: errors.Errorf(
+					"dimensionReduction.dimensions is immutable: " +
+						"attempted change from \"256\" to \"512\""),
+			},
+			{
+				name:    "attempting to change the vector storage",
+				initial: ent.UserConfig{},
+				update:  ent.UserConfig{VectorStorage: "float16"},
+				expectedError: errors.Errorf(
+					"vectorStorage is immutable: " +
+						"attempted change from \"float32\" to \"float16\""),
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
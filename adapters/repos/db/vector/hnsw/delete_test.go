@@ -86,7 +86,7 @@ func TestDelete_WithoutCleaningUpTombstones(t *testing.T) {
 			allowList.Insert(uint64(i))
 		}
 
-		res, _, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, allowList)
+		res, _, err := vectorIndex.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, 20, allowList)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 		control = res
@@ -109,7 +109,7 @@ func TestDelete_WithoutCleaningUpTombstones(t *testing.T) {
 	})
 
 	t.Run("start a search that should only contain the remaining elements", func(t *testing.T) {
-		res, _, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, nil)
+		res, _, err := vectorIndex.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, 20, nil)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 
@@ -180,7 +180,7 @@ func TestDelete_WithCleaningUpTombstonesOnce(t *testing.T) {
 			allowList.Insert(uint64(i))
 		}
 
-		res, _, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, allowList)
+		res, _, err := vectorIndex.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, 20, allowList)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 		require.Len(t, res, 20)
@@ -226,7 +226,7 @@ func TestDelete_WithCleaningUpTombstonesOnce(t *testing.T) {
 	})
 
 	t.Run("start a search that should only contain the remaining elements", func(t *testing.T) {
-		res, _, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, nil)
+		res, _, err := vectorIndex.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, 20, nil)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 
@@ -298,7 +298,7 @@ func TestDelete_WithCleaningUpTombstonesInBetween(t *testing.T) {
 			allowList.Insert(uint64(i))
 		}
 
-		res, _, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, allowList)
+		res, _, err := vectorIndex.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, 20, allowList)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 
@@ -327,7 +327,7 @@ func TestDelete_WithCleaningUpTombstonesInBetween(t *testing.T) {
 	})
 
 	t.Run("start a search that should only contain the remaining elements", func(t *testing.T) {
-		res, _, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, nil)
+		res, _, err := vectorIndex.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, 20, nil)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 
@@ -364,7 +364,7 @@ func TestDelete_WithCleaningUpTombstonesInBetween(t *testing.T) {
 			require.Nil(t, err)
 		}
 
-		res, _, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, nil)
+		res, _, err := vectorIndex.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, 20, nil)
 		require.Nil(t, err)
 		assert.ElementsMatch(t, []uint64{0, 1, 2, 3, 4}, res)
 	})
@@ -416,7 +416,7 @@ func createIndexImportAllVectorsAndDeleteEven(t *testing.T, vectors [][]float32,
 		require.Nil(t, err)
 	}
 
-	res, _, err := index.SearchByVector([]float32{0.1, 0.1, 0.1}, len(vectors), nil)
+	res, _, err := index.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, len(vectors), nil)
 	require.Nil(t, err)
 	require.True(t, len(res) > 0)
 
@@ -475,7 +475,7 @@ func TestDelete_WithCleaningUpTombstonesStopped(t *testing.T) {
 	})
 
 	t.Run("search remaining elements after cleanup", func(t *testing.T) {
-		res, _, err := index.SearchByVector([]float32{0.1, 0.1, 0.1}, len(vectors), nil)
+		res, _, err := index.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, len(vectors), nil)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 
@@ -499,7 +499,7 @@ func TestDelete_WithCleaningUpTombstonesStopped(t *testing.T) {
 		})
 
 		t.Run("search remaining elements after partial cleanup", func(t *testing.T) {
-			res, _, err := index.SearchByVector([]float32{0.1, 0.1, 0.1}, len(vectors), nil)
+			res, _, err := index.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, len(vectors), nil)
 			require.Nil(t, err)
 			require.Subset(t, controlRemainingResult, res)
 			require.Subset(t, res, controlRemainingResultAfterCleanup)
@@ -510,7 +510,7 @@ func TestDelete_WithCleaningUpTombstonesStopped(t *testing.T) {
 		})
 
 		t.Run("search remaining elements after complete cleanup", func(t *testing.T) {
-			res, _, err := index.SearchByVector([]float32{0.1, 0.1, 0.1}, len(vectors), nil)
+			res, _, err := index.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, len(vectors), nil)
 			require.Nil(t, err)
 			require.Subset(t, controlRemainingResult, res)
 			require.Subset(t, res, controlRemainingResultAfterCleanup)
@@ -593,7 +593,7 @@ func TestDelete_InCompressedIndex_WithCleaningUpTombstonesOnce(t *testing.T) {
 			allowList.Insert(uint64(i))
 		}
 
-		res, _, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, allowList)
+		res, _, err := vectorIndex.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, 20, allowList)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 		require.Len(t, res, 20)
@@ -641,7 +641,7 @@ func TestDelete_InCompressedIndex_WithCleaningUpTombstonesOnce(t *testing.T) {
 	})
 
 	t.Run("start a search that should only contain the remaining elements", func(t *testing.T) {
-		res, _, err := vectorIndex.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, nil)
+		res, _, err := vectorIndex.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, 20, nil)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 
@@ -983,7 +983,7 @@ func TestDelete_EntrypointIssues(t *testing.T) {
 
 	t.Run("verify that the results are correct", func(t *testing.T) {
 		position := 3
-		res, _, err := index.knnSearchByVector(testVectors[position], 50, 36, nil)
+		res, _, err := index.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResults, res)
 	})
@@ -1083,7 +1083,7 @@ func TestDelete_MoreEntrypointIssues(t *testing.T) {
 
 	t.Run("verify that the results are correct", func(t *testing.T) {
 		position := 3
-		res, _, err := index.knnSearchByVector(testVectors[position], 50, 36, nil)
+		res, _, err := index.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResults, res)
 	})
@@ -1126,7 +1126,7 @@ func TestDelete_TombstonedEntrypoint(t *testing.T) {
 	require.Nil(t, index.Delete(0))
 	require.Nil(t, index.Add(1, objVec))
 
-	res, _, err := index.SearchByVector(searchVec, 100, nil)
+	res, _, err := index.SearchByVector(context.Background(), searchVec, 100, nil)
 	require.Nil(t, err)
 	assert.Equal(t, []uint64{1}, res, "should contain the only result")
 
@@ -1160,7 +1160,7 @@ func TestDelete_Flakyness_gh_1369(t *testing.T) {
 			allowList.Insert(uint64(i))
 		}
 
-		res, _, err := index.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, allowList)
+		res, _, err := index.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, 20, allowList)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 
@@ -1175,7 +1175,7 @@ func TestDelete_Flakyness_gh_1369(t *testing.T) {
 	})
 
 	t.Run("verify against control BEFORE Tombstone Cleanup", func(t *testing.T) {
-		res, _, err := index.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, nil)
+		res, _, err := index.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, 20, nil)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 		assert.Equal(t, control, res)
@@ -1186,7 +1186,7 @@ func TestDelete_Flakyness_gh_1369(t *testing.T) {
 	})
 
 	t.Run("verify against control AFTER Tombstone Cleanup", func(t *testing.T) {
-		res, _, err := index.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, nil)
+		res, _, err := index.SearchByVector(context.Background(), []float32{0.1, 0.1, 0.1}, 20, nil)
 		require.Nil(t, err)
 		require.True(t, len(res) > 0)
 		assert.Equal(t, control, res)
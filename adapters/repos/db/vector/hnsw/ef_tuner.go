@@ -0,0 +1,350 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	// efTuningLimit is the number of neighbors the sampled queries search
+	// for, the tuned ef is scaled for searches with a higher limit
+	efTuningLimit = 10
+
+	// efLatencyWindow is the number of recent search latencies which are
+	// compared with the latency target, at least efMinLatencies are needed
+	efLatencyWindow = 1000
+	efMinLatencies  = 20
+)
+
+// efTuner picks the ef of searches for the targets of an
+// ent.EFTargetConfig, if ef is -1. Searches record their latency, the
+// recall is estimated in the background at most once per sample interval.
+// Until the first estimate the dynamic ef is used. A nil tuner is disabled.
+type efTuner struct {
+	sync.Mutex
+	target     ent.EFTargetConfig
+	latencies  []time.Duration
+	next       int
+	lastSample time.Time
+	running    bool
+
+	enabled atomic.Bool
+	ef      atomic.Int64
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newEFTuner(target ent.EFTargetConfig) *efTuner {
+	t := &efTuner{}
+	t.ctx, t.cancel = context.WithCancel(context.Background())
+	t.setTarget(target)
+	return t
+}
+
+func (t *efTuner) setTarget(target ent.EFTargetConfig) {
+	if t == nil {
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	t.target = target
+	t.latencies = t.latencies[:0]
+	t.next = 0
+	t.lastSample = time.Time{}
+	t.enabled.Store(target.Enabled())
+	if !target.Enabled() {
+		t.ef.Store(0)
+	}
+}
+
+// searchEF is the tuned ef for a search with limit k, ok is false if the
+// tuner is disabled or has no estimate yet
+func (t *efTuner) searchEF(k, max int) (ef int, ok bool) {
+	if t == nil || !t.enabled.Load() {
+		return 0, false
+	}
+	ef = int(t.ef.Load())
+	if ef == 0 {
+		return 0, false
+	}
+	if k > efTuningLimit {
+		ef = ef * k / efTuningLimit
+		if ef > max {
+			ef = max
+		}
+	}
+	if ef < k {
+		ef = k
+	}
+	return ef, true
+}
+
+func (t *efTuner) setEF(ef int) {
+	t.Lock()
+	defer t.Unlock()
+
+	// the latencies were observed with the previous ef
+	t.latencies = t.latencies[:0]
+	t.next = 0
+	t.ef.Store(int64(ef))
+}
+
+func (t *efTuner) observe(latency time.Duration) {
+	if t == nil || !t.enabled.Load() {
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if len(t.latencies) < efLatencyWindow {
+		t.latencies = append(t.latencies, latency)
+		return
+	}
+	t.latencies[t.next] = latency
+	t.next = (t.next + 1) % efLatencyWindow
+}
+
+// startSample reports whether an estimate of the recall is due, in that case
+// finishSample must be called once it is done
+func (t *efTuner) startSample(now time.Time) bool {
+	if t == nil || !t.enabled.Load() {
+		return false
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.running || now.Sub(t.lastSample) < t.target.Interval() {
+		return false
+	}
+	t.running = true
+	t.lastSample = now
+	t.wg.Add(1)
+	return true
+}
+
+func (t *efTuner) finishSample() {
+	t.Lock()
+	t.running = false
+	t.Unlock()
+	t.wg.Done()
+}
+
+// stop cancels a running estimate and waits for it
+func (t *efTuner) stop() {
+	if t == nil {
+		return
+	}
+	t.cancel()
+	t.wg.Wait()
+}
+
+// latencyExceeded is true if the 95th percentile of the recent search
+// latencies is above the latency target
+func (t *efTuner) latencyExceeded() bool {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.target.LatencyMs <= 0 || len(t.latencies) < efMinLatencies {
+		return false
+	}
+	sorted := append([]time.Duration{}, t.latencies...)
+	sort.Slice(sorted, func(a, b int) bool { return sorted[a] < sorted[b] })
+	p95 := sorted[len(sorted)*95/100]
+	return p95 > time.Duration(t.target.LatencyMs*float64(time.Millisecond))
+}
+
+// nextEF is a single step of the tuning. The ef is lowered while the latency
+// target is exceeded. Otherwise it is raised while the estimated recall is
+// below the recall target, or lowered if the recall target is met with a
+// lower ef as well. Without a recall target the ef is raised up to the
+// latency target.
+func (t *efTuner) nextEF(current, min, max int, recall func(ef int) (float64, error)) (int, error) {
+	clamp := func(ef int) int {
+		if ef < min {
+			return min
+		}
+		if ef > max {
+			return max
+		}
+		return ef
+	}
+
+	lower := clamp(current * 3 / 4)
+	if t.latencyExceeded() {
+		return lower, nil
+	}
+
+	t.Lock()
+	target := t.target.Recall
+	t.Unlock()
+
+	higher := clamp(current*3/2 + 1)
+	if target <= 0 {
+		return higher, nil
+	}
+
+	r, err := recall(current)
+	if err != nil {
+		return current, err
+	}
+	if r < target {
+		return higher, nil
+	}
+	if lower < current {
+		r, err := recall(lower)
+		if err != nil {
+			return current, err
+		}
+		if r >= target {
+			return lower, nil
+		}
+	}
+	return current, nil
+}
+
+// efTuningQuery is a sampled node, which is excluded from the results of
+// its own query
+type efTuningQuery struct {
+	id     uint64
+	vector []float32
+	truth  map[uint64]struct{}
+}
+
+// maybeTuneEF starts an estimate of the recall in the background, if one is
+// due
+func (h *hnsw) maybeTuneEF() {
+	if !h.efTuner.startSample(time.Now()) {
+		return
+	}
+
+	go func() {
+		defer h.efTuner.finishSample()
+		if err := h.tuneEF(h.efTuner.ctx); err != nil {
+			h.logger.WithField("action", "hnsw_tune_ef").WithError(err).
+				Warn("estimate recall of ef")
+		}
+	}()
+}
+
+// tuneEF estimates the recall of the current ef with a sample of the nodes
+// as queries, the ground truth is a flat search over all nodes
+func (h *hnsw) tuneEF(ctx context.Context) error {
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	queries, allow, err := h.efTuningQueries(ctx)
+	if err != nil || len(queries) == 0 {
+		return err
+	}
+
+	for _, q := range queries {
+		ids, _, err := h.flatSearch(ctx, q.vector, efTuningLimit+1, allow)
+		if err != nil {
+			return err
+		}
+		q.truth = make(map[uint64]struct{}, len(ids))
+		for _, id := range ids {
+			if id != q.id && len(q.truth) < efTuningLimit {
+				q.truth[id] = struct{}{}
+			}
+		}
+	}
+
+	recall := func(ef int) (float64, error) {
+		found, expected := 0, 0
+		for _, q := range queries {
+			ids, _, err := h.knnSearchByVector(ctx, q.vector, efTuningLimit+1, ef, nil)
+			if err != nil {
+				return 0, err
+			}
+			n := 0
+			for _, id := range ids {
+				if id == q.id {
+					continue
+				}
+				if n == efTuningLimit {
+					break
+				}
+				n++
+				if _, ok := q.truth[id]; ok {
+					found++
+				}
+			}
+			expected += len(q.truth)
+		}
+		if expected == 0 {
+			return 1, nil
+		}
+		return float64(found) / float64(expected), nil
+	}
+
+	current := int(h.efTuner.ef.Load())
+	if current == 0 {
+		current = h.autoEfFromK(efTuningLimit)
+	}
+	next, err := h.efTuner.nextEF(current, int(atomic.LoadInt64(&h.efMin)),
+		int(atomic.LoadInt64(&h.efMax)), recall)
+	if err != nil {
+		return err
+	}
+	if next != current || h.efTuner.ef.Load() == 0 {
+		h.logger.WithField("action", "hnsw_tune_ef").
+			WithField("class", h.className).WithField("shard", h.shardName).
+			WithField("ef", next).WithField("previous_ef", current).
+			Debug("tuned ef for the targets")
+		h.efTuner.setEF(next)
+	}
+	return nil
+}
+
+// efTuningQueries picks a random sample of the nodes as queries, the allow
+// list contains all nodes
+func (h *hnsw) efTuningQueries(ctx context.Context) ([]*efTuningQuery, helpers.AllowList, error) {
+	ids := h.nodeIDs(false)
+
+	h.efTuner.Lock()
+	samples := h.efTuner.target.Samples()
+	h.efTuner.Unlock()
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	queries := make([]*efTuningQuery, 0, samples)
+	for _, i := range r.Perm(len(ids)) {
+		if len(queries) == samples {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		vector, err := h.VectorForIDThunk(ctx, ids[i])
+		if err != nil || len(vector) == 0 {
+			// deleted in the meantime
+			continue
+		}
+		queries = append(queries, &efTuningQuery{id: ids[i], vector: h.normalizeVec(vector)})
+	}
+	return queries, helpers.NewAllowList(ids...), nil
+}
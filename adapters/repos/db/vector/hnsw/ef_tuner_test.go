@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestEFTuner(t *testing.T) {
+	recallOf := func(recalls map[int]float64) func(ef int) (float64, error) {
+		return func(ef int) (float64, error) {
+			return recalls[ef], nil
+		}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		tuner := newEFTuner(ent.EFTargetConfig{})
+		tuner.setEF(50)
+		_, ok := tuner.searchEF(10, 500)
+		assert.False(t, ok)
+		assert.False(t, tuner.startSample(time.Now()))
+
+		var nilTuner *efTuner
+		_, ok = nilTuner.searchEF(10, 500)
+		assert.False(t, ok)
+		nilTuner.observe(time.Second)
+		nilTuner.stop()
+	})
+
+	t.Run("search ef", func(t *testing.T) {
+		tuner := newEFTuner(ent.EFTargetConfig{Recall: 0.9})
+		_, ok := tuner.searchEF(10, 500)
+		assert.False(t, ok, "no estimate yet")
+
+		tuner.setEF(100)
+		ef, ok := tuner.searchEF(10, 500)
+		assert.True(t, ok)
+		assert.Equal(t, 100, ef)
+
+		ef, _ = tuner.searchEF(30, 500)
+		assert.Equal(t, 300, ef, "scaled with the limit")
+		ef, _ = tuner.searchEF(100, 500)
+		assert.Equal(t, 500, ef, "at most the maximum")
+		ef, _ = tuner.searchEF(600, 500)
+		assert.Equal(t, 600, ef, "at least the limit")
+	})
+
+	t.Run("samples once per interval", func(t *testing.T) {
+		tuner := newEFTuner(ent.EFTargetConfig{Recall: 0.9, SampleIntervalSeconds: 60})
+		now := time.Now()
+		require.True(t, tuner.startSample(now))
+		assert.False(t, tuner.startSample(now.Add(2*time.Minute)), "already running")
+		tuner.finishSample()
+		assert.False(t, tuner.startSample(now.Add(30*time.Second)))
+		assert.True(t, tuner.startSample(now.Add(2*time.Minute)))
+		tuner.finishSample()
+	})
+
+	t.Run("raise ef below the recall target", func(t *testing.T) {
+		tuner := newEFTuner(ent.EFTargetConfig{Recall: 0.95})
+		ef, err := tuner.nextEF(100, 50, 500, recallOf(map[int]float64{100: 0.9}))
+		require.Nil(t, err)
+		assert.Equal(t, 151, ef)
+
+		ef, err = tuner.nextEF(400, 50, 500, recallOf(map[int]float64{400: 0.9}))
+		require.Nil(t, err)
+		assert.Equal(t, 500, ef)
+	})
+
+	t.Run("lower ef above the recall target", func(t *testing.T) {
+		tuner := newEFTuner(ent.EFTargetConfig{Recall: 0.95})
+		ef, err := tuner.nextEF(100, 50, 500, recallOf(map[int]float64{100: 0.99, 75: 0.96}))
+		require.Nil(t, err)
+		assert.Equal(t, 75, ef)
+
+		ef, err = tuner.nextEF(100, 50, 500, recallOf(map[int]float64{100: 0.99, 75: 0.9}))
+		require.Nil(t, err)
+		assert.Equal(t, 100, ef)
+	})
+
+	t.Run("lower ef above the latency target", func(t *testing.T) {
+		tuner := newEFTuner(ent.EFTargetConfig{Recall: 0.95, LatencyMs: 10})
+		for i := 0; i < efMinLatencies; i++ {
+			tuner.observe(20 * time.Millisecond)
+		}
+		ef, err := tuner.nextEF(100, 50, 500, recallOf(map[int]float64{100: 0.5}))
+		require.Nil(t, err)
+		assert.Equal(t, 75, ef)
+
+		// a new ef starts a new latency window
+		tuner.setEF(ef)
+		ef, err = tuner.nextEF(75, 50, 500, recallOf(map[int]float64{75: 0.5}))
+		require.Nil(t, err)
+		assert.Equal(t, 113, ef)
+	})
+
+	t.Run("raise ef up to the latency target", func(t *testing.T) {
+		tuner := newEFTuner(ent.EFTargetConfig{LatencyMs: 10})
+		for i := 0; i < efMinLatencies; i++ {
+			tuner.observe(time.Millisecond)
+		}
+		ef, err := tuner.nextEF(100, 50, 500, nil)
+		require.Nil(t, err)
+		assert.Equal(t, 151, ef)
+	})
+}
+
+func TestTuneEF(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	vectors := make([][]float32, 500)
+	for i := range vectors {
+		vectors[i] = []float32{r.Float32(), r.Float32(), r.Float32(), r.Float32()}
+	}
+	logger, _ := test.NewNullLogger()
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "tune-ef-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		Logger:                logger,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		VectorCacheMaxObjects: 10000,
+		EF:                    -1,
+		DynamicEFMin:          10,
+		DynamicEFMax:          500,
+		DynamicEFFactor:       1,
+		EFTarget:              ent.EFTargetConfig{Recall: 0.99},
+	}, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	defer index.Drop(context.Background())
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	assert.Equal(t, 10, index.searchTimeEF(10), "dynamic ef before the first estimate")
+
+	// every estimate moves the ef towards the target, until it stays
+	previous := 0
+	for i := 0; i < 20; i++ {
+		require.Nil(t, index.tuneEF(context.Background()))
+		ef := int(index.efTuner.ef.Load())
+		if ef == previous {
+			break
+		}
+		previous = ef
+	}
+	ef := index.searchTimeEF(10)
+	assert.Equal(t, previous, ef)
+	assert.Greater(t, ef, 10)
+}
@@ -12,17 +12,23 @@
 package hnsw
 
 import (
+	"context"
+
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
 )
 
-func (h *hnsw) flatSearch(queryVector []float32, limit int,
+func (h *hnsw) flatSearch(ctx context.Context, queryVector []float32, limit int,
 	allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
 	results := priorityqueue.NewMax[any](limit)
 
 	it := allowList.Iterator()
 	for candidate, ok := it.Next(); ok; candidate, ok = it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
 		h.RLock()
 		// Hot fix for https://github.com/weaviate/weaviate/issues/1937
 		// this if statement mitigates the problem but it doesn't resolve the issue
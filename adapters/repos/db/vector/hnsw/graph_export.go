@@ -0,0 +1,544 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
+)
+
+// The portable graph format allows building an index on one machine and
+// shipping it to another. Unlike the commit log, which is an append-only
+// journal of mutations, the portable format is a snapshot of the final graph
+// state. The vectors themselves are not part of the export, they are read
+// from the object store of the importing shard.
+//
+// All integers are little endian. Version 1 of the format is laid out as:
+//
+//	magic           [8]byte  "WVHNSWGX"
+//	version         uint16   currently 1
+//	flags           uint8    bit 0: PQ codebook section is present
+//	distancerLen    uint16
+//	distancer       []byte   e.g. "cosine-dot", must match the importing index
+//	dimensions      uint32
+//	entrypoint      uint64
+//	maxLevel        uint16
+//	nodeSlots       uint64   length of the node list including empty slots
+//	nodeCount       uint64   number of node records that follow
+//	node records:
+//	  id            uint64
+//	  level         uint16
+//	  for each level 0..level:
+//	    connCount   uint32
+//	    conns       []uint64
+//	tombstoneCount  uint64
+//	tombstones      []uint64
+//	pq section (only if flag bit 0 is set), identical to the payload of an
+//	AddPQ commit log entry:
+//	  dimensions    uint16
+//	  encoderType   uint8
+//	  ks            uint16
+//	  m             uint16
+//	  distribution  uint8
+//	  bitsEncoding  uint8
+//	  encoders      encoder specific, see Deserializer.ReadPQ
+//
+// An import rejects input that violates any of the following:
+//
+//   - no flags other than bit 0 are set
+//   - distancerLen is at most 64 bytes
+//   - dimensions fit into an int32
+//   - maxLevel is at most 255 and no node has a level above maxLevel
+//   - nodeSlots is at most 2^32 and nodeCount is at most nodeSlots
+//   - node ids, connections and tombstones are below nodeSlots
+//   - every node id appears only once
+//   - connCount does not exceed the maximum connections of the importing
+//     index for that level (2*maxConnections on level 0)
+//   - if there are nodes, the entrypoint is one of them
+//   - tombstoneCount is at most nodeSlots
+const (
+	portableGraphMagic   = "WVHNSWGX"
+	portableGraphVersion = uint16(1)
+
+	portableGraphFlagPQ = uint8(1 << 0)
+
+	maxPortableGraphDistancerLen = 64
+	maxPortableGraphLevel        = 255
+	maxPortableGraphSlots        = 1 << 32
+)
+
+// ErrGraphRejected is wrapped by the errors of ImportGraph which are caused by
+// the input or the state of the index rather than by a failure while writing
+var ErrGraphRejected = errors.New("graph rejected")
+
+// ExportGraph writes a snapshot of the graph in the portable format to w.
+// Concurrent inserts and deletes are blocked for the duration of the export.
+func (h *hnsw) ExportGraph(w io.Writer) error {
+	h.deleteVsInsertLock.Lock()
+	defer h.deleteVsInsertLock.Unlock()
+
+	h.RLock()
+	nodes := h.nodes
+	entrypoint := h.entryPointID
+	maxLevel := h.currentMaximumLayer
+	h.RUnlock()
+
+	h.tombstoneLock.RLock()
+	tombstones := make([]uint64, 0, len(h.tombstones))
+	for id := range h.tombstones {
+		tombstones = append(tombstones, id)
+	}
+	h.tombstoneLock.RUnlock()
+
+	var pqData *compressionhelpers.PQData
+	if h.compressed.Load() {
+		fields := h.compressor.ExposeFields()
+		if len(fields.Encoders) == 0 {
+			return errors.New("export graph: only uncompressed and PQ-compressed indexes can be exported")
+		}
+		pqData = &fields
+	}
+
+	bufw := bufio.NewWriter(w)
+	ew := &errWriter{w: bufw}
+
+	flags := uint8(0)
+	if pqData != nil {
+		flags |= portableGraphFlagPQ
+	}
+	distancer := h.distancerProvider.Type()
+
+	ew.write([]byte(portableGraphMagic))
+	ew.writeUint16(portableGraphVersion)
+	ew.write([]byte{flags})
+	ew.writeUint16(uint16(len(distancer)))
+	ew.write([]byte(distancer))
+	ew.writeUint32(uint32(h.dims))
+	ew.writeUint64(entrypoint)
+	ew.writeUint16(uint16(maxLevel))
+	ew.writeUint64(uint64(len(nodes)))
+
+	count := uint64(0)
+	for _, node := range nodes {
+		if node != nil {
+			count++
+		}
+	}
+	ew.writeUint64(count)
+
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+
+		node.Lock()
+		ew.writeUint64(node.id)
+		ew.writeUint16(uint16(node.level))
+		for level := 0; level <= node.level; level++ {
+			var conns []uint64
+			if level < len(node.connections) {
+				conns = node.connections[level]
+			}
+			ew.writeUint32(uint32(len(conns)))
+			for _, conn := range conns {
+				ew.writeUint64(conn)
+			}
+		}
+		node.Unlock()
+	}
+
+	ew.writeUint64(uint64(len(tombstones)))
+	for _, id := range tombstones {
+		ew.writeUint64(id)
+	}
+
+	if pqData != nil {
+		ew.write(serializePQData(*pqData))
+	}
+
+	if ew.err != nil {
+		return errors.Wrap(ew.err, "export graph")
+	}
+
+	return bufw.Flush()
+}
+
+// ImportGraph replaces the contents of an empty index with a graph previously
+// written by ExportGraph. The imported state is written to the commit log, so
+// it survives restarts like any other graph modification. The vectors of all
+// imported nodes must already be present in the object store.
+func (h *hnsw) ImportGraph(ctx context.Context, r io.Reader) error {
+	h.deleteVsInsertLock.Lock()
+	defer h.deleteVsInsertLock.Unlock()
+
+	if !h.isEmpty() {
+		return fmt.Errorf("import graph: %w: index is not empty", ErrGraphRejected)
+	}
+
+	res, dims, err := h.readPortableGraph(bufio.NewReader(r))
+	if err != nil {
+		return fmt.Errorf("import graph: %w: %w", ErrGraphRejected, err)
+	}
+
+	if err := h.persistImportedGraph(res); err != nil {
+		return errors.Wrap(err, "import graph: write commit log")
+	}
+
+	h.Lock()
+	h.shardedNodeLocks.LockAll()
+	h.nodes = res.Nodes
+	h.shardedNodeLocks.UnlockAll()
+	h.currentMaximumLayer = int(res.Level)
+	h.entryPointID = res.Entrypoint
+	h.dims = int32(dims)
+	h.Unlock()
+
+	h.tombstoneLock.Lock()
+	h.tombstones = res.Tombstones
+	h.tombstoneLock.Unlock()
+
+	if res.Compressed {
+		if h.pqConfig.Segments == 0 {
+			h.pqConfig.Segments = int(res.PQData.Dimensions)
+		}
+		h.compressor, err = compressionhelpers.RestorePQCompressor(
+			h.pqConfig,
+			h.distancerProvider,
+			int(res.PQData.Dimensions),
+			1e12,
+			h.logger,
+			res.PQData.Encoders,
+			h.store,
+		)
+		if err != nil {
+			return errors.Wrap(err, "import graph: restore compressor")
+		}
+		h.compressor.GrowCache(uint64(len(res.Nodes)))
+
+		for _, node := range res.Nodes {
+			if node == nil {
+				continue
+			}
+			vec, err := h.vectorForID(ctx, node.id)
+			if err != nil {
+				return errors.Wrapf(err, "import graph: get vector for node %d", node.id)
+			}
+			h.compressor.Preload(node.id, vec)
+		}
+
+		h.compressed.Store(true)
+		h.cache.Drop()
+	} else {
+		h.cache.Grow(uint64(len(res.Nodes)))
+	}
+
+	h.pools.visitedLists.Destroy()
+	h.pools.visitedLists = nil
+	h.pools.visitedLists = visited.NewPool(1, len(res.Nodes)+512)
+
+	return nil
+}
+
+// readPortableGraph never trusts the lengths of the input: every length is
+// checked against the limits of the format (or of the importing index) before
+// anything is allocated, and every id must lie within the node slots, so that
+// a corrupt or hostile export can neither exhaust memory nor produce a graph
+// with dangling references
+func (h *hnsw) readPortableGraph(r io.Reader) (*DeserializationResult, uint32, error) {
+	er := &errReader{r: r}
+
+	magic := make([]byte, len(portableGraphMagic))
+	er.read(magic)
+	if er.err != nil {
+		return nil, 0, er.err
+	}
+	if string(magic) != portableGraphMagic {
+		return nil, 0, errors.New("not a portable hnsw graph")
+	}
+
+	version := er.readUint16()
+	if er.err == nil && version != portableGraphVersion {
+		return nil, 0, errors.Errorf("unsupported portable graph version %d", version)
+	}
+
+	flags := er.readByte()
+	distancerLen := er.readUint16()
+	if er.err != nil {
+		return nil, 0, er.err
+	}
+	if flags&^portableGraphFlagPQ != 0 {
+		return nil, 0, errors.Errorf("unknown portable graph flags %#x", flags)
+	}
+	if distancerLen > maxPortableGraphDistancerLen {
+		return nil, 0, errors.Errorf("distancer name of %d bytes exceeds the maximum of %d",
+			distancerLen, maxPortableGraphDistancerLen)
+	}
+	distancer := make([]byte, distancerLen)
+	er.read(distancer)
+	if er.err != nil {
+		return nil, 0, er.err
+	}
+	if string(distancer) != h.distancerProvider.Type() {
+		return nil, 0, errors.Errorf("graph was built with distance %q, index uses %q",
+			distancer, h.distancerProvider.Type())
+	}
+
+	dims := er.readUint32()
+	res := &DeserializationResult{
+		Entrypoint: er.readUint64(),
+		Level:      er.readUint16(),
+		Nodes:      make([]*vertex, cache.InitialSize),
+		Tombstones: map[uint64]struct{}{},
+	}
+
+	slots := er.readUint64()
+	count := er.readUint64()
+	if er.err != nil {
+		return nil, 0, er.err
+	}
+	if dims > math.MaxInt32 {
+		return nil, 0, errors.Errorf("dimensions %d out of range", dims)
+	}
+	if res.Level > maxPortableGraphLevel {
+		return nil, 0, errors.Errorf("max level %d exceeds the maximum of %d",
+			res.Level, maxPortableGraphLevel)
+	}
+	if slots > maxPortableGraphSlots {
+		return nil, 0, errors.Errorf("node slots %d exceed the maximum of %d",
+			slots, uint64(maxPortableGraphSlots))
+	}
+	if count > slots {
+		return nil, 0, errors.Errorf("node count %d exceeds node slots %d", count, slots)
+	}
+	if count > 0 && res.Entrypoint >= slots {
+		return nil, 0, errors.Errorf("entrypoint %d out of range", res.Entrypoint)
+	}
+
+	// the node list is grown as the records come in rather than allocated
+	// from nodeSlots, so its size is driven by the ids actually present
+	for i := uint64(0); i < count; i++ {
+		id := er.readUint64()
+		level := er.readUint16()
+		if er.err != nil {
+			return nil, 0, er.err
+		}
+		if id >= slots {
+			return nil, 0, errors.Errorf("node id %d out of range", id)
+		}
+		if level > res.Level {
+			return nil, 0, errors.Errorf("node %d has level %d above the max level %d",
+				id, level, res.Level)
+		}
+
+		node := &vertex{id: id, level: int(level), connections: make([][]uint64, level+1)}
+		for l := 0; l <= int(level); l++ {
+			connCount := er.readUint32()
+			if er.err != nil {
+				return nil, 0, er.err
+			}
+			maxConns := h.maximumConnections
+			if l == 0 {
+				maxConns = h.maximumConnectionsLayerZero
+			}
+			if uint64(connCount) > uint64(maxConns) {
+				return nil, 0, errors.Errorf("node %d has %d connections at level %d, the index allows %d",
+					id, connCount, l, maxConns)
+			}
+
+			conns := make([]uint64, connCount)
+			for j := range conns {
+				conns[j] = er.readUint64()
+				if er.err == nil && conns[j] >= slots {
+					return nil, 0, errors.Errorf("node %d links to node %d, which is out of range",
+						id, conns[j])
+				}
+			}
+			node.connections[l] = conns
+		}
+		if er.err != nil {
+			return nil, 0, er.err
+		}
+
+		newNodes, changed, err := growIndexToAccomodateNode(res.Nodes, id, h.logger)
+		if err != nil {
+			return nil, 0, err
+		}
+		if changed {
+			res.Nodes = newNodes
+		}
+		if res.Nodes[id] != nil {
+			return nil, 0, errors.Errorf("duplicate record for node %d", id)
+		}
+		res.Nodes[id] = node
+	}
+
+	if count > 0 && (res.Entrypoint >= uint64(len(res.Nodes)) || res.Nodes[res.Entrypoint] == nil) {
+		return nil, 0, errors.Errorf("entrypoint %d is not part of the graph", res.Entrypoint)
+	}
+
+	tombstones := er.readUint64()
+	if er.err != nil {
+		return nil, 0, er.err
+	}
+	if tombstones > slots {
+		return nil, 0, errors.Errorf("tombstone count %d exceeds node slots %d", tombstones, slots)
+	}
+	for i := uint64(0); i < tombstones; i++ {
+		id := er.readUint64()
+		if er.err != nil {
+			return nil, 0, er.err
+		}
+		if id >= slots {
+			return nil, 0, errors.Errorf("tombstone %d out of range", id)
+		}
+		res.Tombstones[id] = struct{}{}
+	}
+
+	if flags&portableGraphFlagPQ != 0 {
+		if err := NewDeserializer(h.logger).ReadPQ(r, res); err != nil {
+			return nil, 0, errors.Wrap(err, "read pq section")
+		}
+	}
+
+	return res, dims, nil
+}
+
+func (h *hnsw) persistImportedGraph(res *DeserializationResult) error {
+	if res.Compressed {
+		if err := h.commitLog.AddPQ(res.PQData); err != nil {
+			return err
+		}
+	}
+
+	for _, node := range res.Nodes {
+		if node == nil {
+			continue
+		}
+		if err := h.commitLog.AddNode(node); err != nil {
+			return err
+		}
+		for level, conns := range node.connections {
+			if err := h.commitLog.ReplaceLinksAtLevel(node.id, level, conns); err != nil {
+				return err
+			}
+		}
+	}
+
+	for id := range res.Tombstones {
+		if err := h.commitLog.AddTombstone(id); err != nil {
+			return err
+		}
+	}
+
+	if err := h.commitLog.SetEntryPointWithMaxLayer(res.Entrypoint, int(res.Level)); err != nil {
+		return err
+	}
+
+	return h.commitLog.Flush()
+}
+
+// serializePQData mirrors the layout of the AddPQ commit log entry without
+// the leading commit type, so that Deserializer.ReadPQ can be reused
+func serializePQData(data compressionhelpers.PQData) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, 9))
+	binary.Write(buf, binary.LittleEndian, data.Dimensions)
+	buf.WriteByte(byte(data.EncoderType))
+	binary.Write(buf, binary.LittleEndian, data.Ks)
+	binary.Write(buf, binary.LittleEndian, data.M)
+	buf.WriteByte(data.EncoderDistribution)
+	if data.UseBitsEncoding {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	for _, encoder := range data.Encoders {
+		buf.Write(encoder.ExposeDataForRestore())
+	}
+	return buf.Bytes()
+}
+
+// errWriter keeps the first error, so the many small writes of the export
+// don't each need their own error check
+type errWriter struct {
+	w   io.Writer
+	err error
+	buf [8]byte
+}
+
+func (ew *errWriter) write(p []byte) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = ew.w.Write(p)
+}
+
+func (ew *errWriter) writeUint16(v uint16) {
+	binary.LittleEndian.PutUint16(ew.buf[:2], v)
+	ew.write(ew.buf[:2])
+}
+
+func (ew *errWriter) writeUint32(v uint32) {
+	binary.LittleEndian.PutUint32(ew.buf[:4], v)
+	ew.write(ew.buf[:4])
+}
+
+func (ew *errWriter) writeUint64(v uint64) {
+	binary.LittleEndian.PutUint64(ew.buf[:8], v)
+	ew.write(ew.buf[:8])
+}
+
+type errReader struct {
+	r   io.Reader
+	err error
+	buf [8]byte
+}
+
+func (er *errReader) read(p []byte) {
+	if er.err != nil {
+		// zero the buffer, so that callers never act on stale values
+		for i := range p {
+			p[i] = 0
+		}
+		return
+	}
+	_, er.err = io.ReadFull(er.r, p)
+}
+
+func (er *errReader) readByte() uint8 {
+	er.read(er.buf[:1])
+	return er.buf[0]
+}
+
+func (er *errReader) readUint16() uint16 {
+	er.read(er.buf[:2])
+	return binary.LittleEndian.Uint16(er.buf[:2])
+}
+
+func (er *errReader) readUint32() uint32 {
+	er.read(er.buf[:4])
+	return binary.LittleEndian.Uint32(er.buf[:4])
+}
+
+func (er *errReader) readUint64() uint64 {
+	er.read(er.buf[:8])
+	return binary.LittleEndian.Uint64(er.buf[:8])
+}
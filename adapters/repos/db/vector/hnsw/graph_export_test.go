@@ -0,0 +1,316 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestGraphExportImport(t *testing.T) {
+	ctx := context.Background()
+	vectors, queries := testinghelpers.RandomVecs(500, 10, 16)
+
+	newIndex := func(t *testing.T, dirName string, provider distancer.Provider) *hnsw {
+		idx, err := New(Config{
+			RootPath:         dirName,
+			ID:               "graph-export-test",
+			Logger:           logrus.New(),
+			DistanceProvider: provider,
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+				copy(container.Slice, vectors[int(id)])
+				return container.Slice, nil
+			},
+			MakeCommitLoggerThunk: func() (CommitLogger, error) {
+				return NewCommitLogger(dirName, "graph-export-test", logrus.New(),
+					cyclemanager.NewCallbackGroupNoop())
+			},
+		}, enthnsw.NewDefaultUserConfig(), cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), nil)
+		require.Nil(t, err)
+		idx.PostStartup()
+		return idx
+	}
+
+	source := newIndex(t, t.TempDir(), distancer.NewL2SquaredProvider())
+	for i, vec := range vectors {
+		require.Nil(t, source.Add(uint64(i), vec))
+	}
+	require.Nil(t, source.Delete(3))
+
+	exported := &bytes.Buffer{}
+	require.Nil(t, source.ExportGraph(exported))
+
+	targetDir := t.TempDir()
+	target := newIndex(t, targetDir, distancer.NewL2SquaredProvider())
+
+	t.Run("import into empty index", func(t *testing.T) {
+		require.Nil(t, target.ImportGraph(ctx, bytes.NewReader(exported.Bytes())))
+		assert.Equal(t, source.entryPointID, target.entryPointID)
+		assert.Equal(t, source.currentMaximumLayer, target.currentMaximumLayer)
+		assert.Equal(t, source.tombstones, target.tombstones)
+	})
+
+	t.Run("search results are identical", func(t *testing.T) {
+		for _, query := range queries {
+			expected, _, err := source.SearchByVector(context.Background(), query, 10, nil)
+			require.Nil(t, err)
+			actual, _, err := target.SearchByVector(context.Background(), query, 10, nil)
+			require.Nil(t, err)
+			assert.Equal(t, expected, actual)
+		}
+	})
+
+	t.Run("imported graph survives a restart", func(t *testing.T) {
+		require.Nil(t, target.Flush())
+		require.Nil(t, target.Shutdown(ctx))
+
+		restarted := newIndex(t, targetDir, distancer.NewL2SquaredProvider())
+		for _, query := range queries {
+			expected, _, err := source.SearchByVector(context.Background(), query, 10, nil)
+			require.Nil(t, err)
+			actual, _, err := restarted.SearchByVector(context.Background(), query, 10, nil)
+			require.Nil(t, err)
+			assert.Equal(t, expected, actual)
+		}
+		require.Nil(t, restarted.Shutdown(ctx))
+	})
+
+	t.Run("import into non-empty index fails", func(t *testing.T) {
+		err := source.ImportGraph(ctx, bytes.NewReader(exported.Bytes()))
+		assert.ErrorContains(t, err, "not empty")
+		assert.ErrorIs(t, err, ErrGraphRejected)
+	})
+
+	t.Run("import with mismatching distance fails", func(t *testing.T) {
+		idx := newIndex(t, t.TempDir(), distancer.NewDotProductProvider())
+		err := idx.ImportGraph(ctx, bytes.NewReader(exported.Bytes()))
+		assert.ErrorContains(t, err, "distance")
+		require.Nil(t, idx.Shutdown(ctx))
+	})
+
+	t.Run("import of invalid data fails", func(t *testing.T) {
+		idx := newIndex(t, t.TempDir(), distancer.NewL2SquaredProvider())
+		err := idx.ImportGraph(ctx, bytes.NewReader([]byte("definitely not a graph")))
+		assert.ErrorContains(t, err, "not a portable hnsw graph")
+
+		truncated := exported.Bytes()[:exported.Len()/2]
+		err = idx.ImportGraph(ctx, bytes.NewReader(truncated))
+		assert.ErrorIs(t, err, ErrGraphRejected)
+		require.Nil(t, idx.Shutdown(ctx))
+	})
+
+	require.Nil(t, source.Shutdown(ctx))
+}
+
+func TestGraphImportRejectsMalformedInput(t *testing.T) {
+	h := &hnsw{
+		distancerProvider:           distancer.NewL2SquaredProvider(),
+		maximumConnections:          2,
+		maximumConnectionsLayerZero: 4,
+		logger:                      logrus.New(),
+	}
+
+	type node struct {
+		id    uint64
+		level uint16
+		conns [][]uint64
+	}
+	type graph struct {
+		flags        uint8
+		distancer    string
+		dims         uint32
+		entrypoint   uint64
+		maxLevel     uint16
+		slots        uint64
+		count        *uint64
+		nodes        []node
+		tombstones   []uint64
+		tombstoneLen *uint64
+	}
+	valid := func() graph {
+		return graph{
+			distancer:  "l2-squared",
+			dims:       2,
+			entrypoint: 1,
+			maxLevel:   1,
+			slots:      3,
+			nodes: []node{
+				{id: 0, level: 0, conns: [][]uint64{{1}}},
+				{id: 1, level: 1, conns: [][]uint64{{0, 2}, {}}},
+				{id: 2, level: 0, conns: [][]uint64{{1}}},
+			},
+			tombstones: []uint64{2},
+		}
+	}
+	encode := func(g graph) []byte {
+		buf := &bytes.Buffer{}
+		ew := &errWriter{w: buf}
+		ew.write([]byte(portableGraphMagic))
+		ew.writeUint16(portableGraphVersion)
+		ew.write([]byte{g.flags})
+		ew.writeUint16(uint16(len(g.distancer)))
+		ew.write([]byte(g.distancer))
+		ew.writeUint32(g.dims)
+		ew.writeUint64(g.entrypoint)
+		ew.writeUint16(g.maxLevel)
+		ew.writeUint64(g.slots)
+		count := uint64(len(g.nodes))
+		if g.count != nil {
+			count = *g.count
+		}
+		ew.writeUint64(count)
+		for _, n := range g.nodes {
+			ew.writeUint64(n.id)
+			ew.writeUint16(n.level)
+			for _, conns := range n.conns {
+				ew.writeUint32(uint32(len(conns)))
+				for _, conn := range conns {
+					ew.writeUint64(conn)
+				}
+			}
+		}
+		tombstoneLen := uint64(len(g.tombstones))
+		if g.tombstoneLen != nil {
+			tombstoneLen = *g.tombstoneLen
+		}
+		ew.writeUint64(tombstoneLen)
+		for _, id := range g.tombstones {
+			ew.writeUint64(id)
+		}
+		require.Nil(t, ew.err)
+		return buf.Bytes()
+	}
+	ptr := func(v uint64) *uint64 { return &v }
+
+	t.Run("valid graph", func(t *testing.T) {
+		res, dims, err := h.readPortableGraph(bytes.NewReader(encode(valid())))
+		require.Nil(t, err)
+		assert.Equal(t, uint32(2), dims)
+		assert.Equal(t, uint64(1), res.Entrypoint)
+		assert.Equal(t, []uint64{0, 2}, res.Nodes[1].connections[0])
+		assert.Equal(t, map[uint64]struct{}{2: {}}, res.Tombstones)
+	})
+
+	tests := []struct {
+		name        string
+		mutate      func(g *graph)
+		expectedErr string
+	}{
+		{
+			name:        "unknown flags",
+			mutate:      func(g *graph) { g.flags = 1 << 3 },
+			expectedErr: "unknown portable graph flags",
+		},
+		{
+			name:        "oversized distancer",
+			mutate:      func(g *graph) { g.distancer = string(make([]byte, 1000)) },
+			expectedErr: "distancer name",
+		},
+		{
+			name:        "dimensions out of range",
+			mutate:      func(g *graph) { g.dims = math.MaxUint32 },
+			expectedErr: "dimensions",
+		},
+		{
+			name:        "max level too high",
+			mutate:      func(g *graph) { g.maxLevel = math.MaxUint16 },
+			expectedErr: "max level",
+		},
+		{
+			name:        "too many node slots",
+			mutate:      func(g *graph) { g.slots = math.MaxUint64 },
+			expectedErr: "node slots",
+		},
+		{
+			name:        "node count exceeds slots",
+			mutate:      func(g *graph) { g.count = ptr(4) },
+			expectedErr: "exceeds node slots",
+		},
+		{
+			name:        "entrypoint out of range",
+			mutate:      func(g *graph) { g.entrypoint = 3 },
+			expectedErr: "entrypoint 3 out of range",
+		},
+		{
+			name: "entrypoint is not a node",
+			mutate: func(g *graph) {
+				g.slots = 4
+				g.entrypoint = 3
+			},
+			expectedErr: "not part of the graph",
+		},
+		{
+			name:        "node id out of range",
+			mutate:      func(g *graph) { g.nodes[2].id = 3 },
+			expectedErr: "node id 3 out of range",
+		},
+		{
+			name:        "node level above max level",
+			mutate:      func(g *graph) { g.nodes[0].level, g.nodes[0].conns = 2, [][]uint64{{}, {}, {}} },
+			expectedErr: "above the max level",
+		},
+		{
+			name:        "duplicate node",
+			mutate:      func(g *graph) { g.nodes[2].id = 0 },
+			expectedErr: "duplicate record",
+		},
+		{
+			name:        "connection out of range",
+			mutate:      func(g *graph) { g.nodes[0].conns[0] = []uint64{7} },
+			expectedErr: "links to node 7",
+		},
+		{
+			name:        "too many connections on level 0",
+			mutate:      func(g *graph) { g.nodes[0].conns[0] = []uint64{1, 2, 1, 2, 1} },
+			expectedErr: "5 connections at level 0",
+		},
+		{
+			name:        "too many connections on upper levels",
+			mutate:      func(g *graph) { g.nodes[1].conns[1] = []uint64{0, 2, 0} },
+			expectedErr: "3 connections at level 1",
+		},
+		{
+			name:        "tombstone count exceeds slots",
+			mutate:      func(g *graph) { g.tombstoneLen = ptr(math.MaxUint64) },
+			expectedErr: "tombstone count",
+		},
+		{
+			name:        "tombstone out of range",
+			mutate:      func(g *graph) { g.tombstones = []uint64{9} },
+			expectedErr: "tombstone 9 out of range",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := valid()
+			test.mutate(&g)
+			_, _, err := h.readPortableGraph(bytes.NewReader(encode(g)))
+			assert.ErrorContains(t, err, test.expectedErr)
+		})
+	}
+}
@@ -95,7 +95,7 @@ Ex: go test -v -run TestHnswStress . -download
 				go func() {
 					for i := 0; i < vectorsPerGoroutine; i++ {
 						for j := 0; j < 5; j++ { // try a couple of times to delete if found
-							_, dists, err := index.SearchByVector(vectors[goroutineIndex+i], 0, nil)
+							_, dists, err := index.SearchByVector(context.Background(), vectors[goroutineIndex+i], 0, nil)
 							require.Nil(t, err)
 
 							if len(dists) > 0 && dists[0] == 0 {
@@ -275,7 +275,7 @@ Ex: go test -v -run TestHnswStress . -download
 
 					id := rand.Intn(len(vectors))
 
-					_, _, err := index.SearchByVector(vectors[id], 0, nil)
+					_, _, err := index.SearchByVector(context.Background(), vectors[id], 0, nil)
 					require.Nil(t, err)
 				},
 			}
@@ -85,6 +85,8 @@ type hnsw struct {
 	efMin    int64
 	efMax    int64
 	efFactor int64
+	// picks the ef for the efTarget, only used if ef=-1
+	efTuner *efTuner
 
 	// on filtered searches with less than n elements, perform flat search
 	flatSearchCutoff int64
@@ -128,6 +130,9 @@ type hnsw struct {
 
 	metrics       *Metrics
 	insertMetrics *insertMetrics
+	// startupProgress is called while the commit logs are replayed, see
+	// Config.StartupProgress
+	startupProgress func(ratio float64)
 
 	randFunc func() float64 // added to temporarily get rid on flakiness in tombstones related tests. to be removed after fixing WEAVIATE-179
 
@@ -156,6 +161,22 @@ type hnsw struct {
 
 	compressor compressionhelpers.VectorCompressor
 	pqConfig   ent.PQConfig
+	bqConfig   ent.BQConfig
+	// reductionConfig indexes vectors with fewer dimensions, the full vectors
+	// rescore the results
+	reductionConfig ent.ReductionConfig
+
+	// compressing is set while the vectors are compressed in the background,
+	// pendingCompression once its compressor is trained. compressCtx cancels
+	// it when the index is dropped or shut down.
+	compressing        atomic.Bool
+	pendingCompression atomic.Pointer[pendingCompression]
+	compressCtx        context.Context
+	compressCancel     context.CancelFunc
+
+	// vectorCacheMaxObjects is the configured size of the vector cache, which
+	// is reduced temporarily by ShrinkVectorCache under memory pressure
+	vectorCacheMaxObjects int64
 
 	compressActionLock *sync.RWMutex
 	className          string
@@ -221,6 +242,7 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCallbacks, shardCompactionCallb
 		cfg.Logger, normalizeOnRead, cache.DefaultDeletionInterval)
 
 	resetCtx, resetCtxCancel := context.WithCancel(context.Background())
+	compressCtx, compressCancel := context.WithCancel(context.Background())
 	index := &hnsw{
 		maximumConnections: uc.MaxConnections,
 
@@ -245,15 +267,19 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCallbacks, shardCompactionCallb
 		resetLock:         &sync.Mutex{},
 		resetCtx:          resetCtx,
 		resetCtxCancel:    resetCtxCancel,
+		compressCtx:       compressCtx,
+		compressCancel:    compressCancel,
 		initialInsertOnce: &sync.Once{},
 
 		ef:       int64(uc.EF),
 		efMin:    int64(uc.DynamicEFMin),
 		efMax:    int64(uc.DynamicEFMax),
 		efFactor: int64(uc.DynamicEFFactor),
+		efTuner:  newEFTuner(uc.EFTarget),
 
-		metrics:   NewMetrics(cfg.PrometheusMetrics, cfg.ClassName, cfg.ShardName),
-		shardName: cfg.ShardName,
+		metrics:         NewMetrics(cfg.PrometheusMetrics, cfg.ClassName, cfg.ShardName),
+		startupProgress: cfg.StartupProgress,
+		shardName:       cfg.ShardName,
 
 		randFunc:             rand.Float64,
 		compressActionLock:   &sync.RWMutex{},
@@ -261,16 +287,19 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCallbacks, shardCompactionCallb
 		VectorForIDThunk:     cfg.VectorForIDThunk,
 		TempVectorForIDThunk: cfg.TempVectorForIDThunk,
 		pqConfig:             uc.PQ,
+		bqConfig:             uc.BQ,
+		reductionConfig:      uc.DimensionReduction,
 		shardedNodeLocks:     common.NewDefaultShardedLocks(),
 
 		shardCompactionCallbacks: shardCompactionCallbacks,
 		shardFlushCallbacks:      shardFlushCallbacks,
 		store:                    store,
+		vectorCacheMaxObjects:    int64(uc.VectorCacheMaxObjects),
 	}
 
 	if uc.BQ.Enabled {
 		var err error
-		index.compressor, err = compressionhelpers.NewBQCompressor(index.distancerProvider, uc.VectorCacheMaxObjects, cfg.Logger, store)
+		index.compressor, err = compressionhelpers.NewMultiBitBQCompressor(index.distancerProvider, uc.BQ.Bits, uc.VectorCacheMaxObjects, cfg.Logger, store)
 		if err != nil {
 			return nil, err
 		}
@@ -279,6 +308,36 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCallbacks, shardCompactionCallb
 		index.cache = nil
 	}
 
+	if uc.StorageType() == ent.VectorStorageFloat16 {
+		var err error
+		index.compressor, err = compressionhelpers.NewFloat16Compressor(index.distancerProvider, uc.VectorCacheMaxObjects, cfg.Logger, store)
+		if err != nil {
+			return nil, err
+		}
+		// the distances of the half precision vectors are exact, the stored
+		// objects hold the same vectors
+		index.doNotRescore = true
+		index.compressed.Store(true)
+		index.cache.Drop()
+		index.cache = nil
+	}
+
+	if uc.DimensionReduction.Enabled {
+		// a pca reduction is restored once its principal components were
+		// computed, until then the vectors are indexed uncompressed
+		compressor, err := compressionhelpers.RestoreReductionCompressor(uc.DimensionReduction,
+			index.distancerProvider, uc.VectorCacheMaxObjects, cfg.Logger, store)
+		if err != nil {
+			return nil, err
+		}
+		if compressor != nil {
+			index.compressor = compressor
+			index.compressed.Store(true)
+			index.cache.Drop()
+			index.cache = nil
+		}
+	}
+
 	if err := index.init(cfg); err != nil {
 		return nil, errors.Wrapf(err, "init index %q", index.id)
 	}
@@ -417,7 +476,7 @@ func (h *hnsw) findBestEntrypointForNode(currentMaxLevel, targetLevel int,
 		}
 
 		eps.Insert(entryPointID, dist)
-		res, err := h.searchLayerByVectorWithDistancer(nodeVec, eps, 1, level, nil, distancer)
+		res, err := h.searchLayerByVectorWithDistancer(context.Background(), nodeVec, eps, 1, level, nil, distancer)
 		if err != nil {
 			return 0,
 				errors.Wrapf(err, "update candidate: search layer at level %d", level)
@@ -603,6 +662,9 @@ func (h *hnsw) nodeByID(id uint64) *vertex {
 }
 
 func (h *hnsw) Drop(ctx context.Context) error {
+	h.efTuner.stop()
+	h.cancelCompression()
+
 	// cancel tombstone cleanup goroutine
 	if err := h.tombstoneCleanupCallbackCtrl.Unregister(ctx); err != nil {
 		return errors.Wrap(err, "hnsw drop")
@@ -629,6 +691,9 @@ func (h *hnsw) Drop(ctx context.Context) error {
 }
 
 func (h *hnsw) Shutdown(ctx context.Context) error {
+	h.efTuner.stop()
+	h.cancelCompression()
+
 	if err := h.commitLog.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "hnsw shutdown")
 	}
@@ -677,12 +742,42 @@ func (h *hnsw) DistancerProvider() distancer.Provider {
 	return h.distancerProvider
 }
 
+// ShrinkVectorCache limits the vector cache to a fraction of its configured
+// size, a fraction of 1 restores the configured size. A cache which holds
+// more vectors than the limit is emptied by its deletion cycle.
+func (h *hnsw) ShrinkVectorCache(fraction float64) {
+	size := int64(float64(atomic.LoadInt64(&h.vectorCacheMaxObjects)) * fraction)
+
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+	if h.compressed.Load() {
+		h.compressor.SetCacheMaxSize(size)
+	} else {
+		h.cache.UpdateMaxSize(size)
+	}
+}
+
+// FlatSearchCutoff is the number of objects matching a filter below which
+// the allowed vectors are searched without the graph
+func (h *hnsw) FlatSearchCutoff() int {
+	if h.forbidFlat {
+		return 0
+	}
+	return int(atomic.LoadInt64(&h.flatSearchCutoff))
+}
+
 func (h *hnsw) ShouldCompress() (bool, int) {
+	if h.reductionConfig.NeedsTraining() {
+		return true, h.reductionConfig.Limit()
+	}
 	return h.pqConfig.Enabled, h.pqConfig.TrainingLimit
 }
 
 func (h *hnsw) ShouldCompressFromConfig(config schema.VectorIndexConfig) (bool, int) {
 	hnswConfig := config.(ent.UserConfig)
+	if hnswConfig.DimensionReduction.NeedsTraining() {
+		return true, hnswConfig.DimensionReduction.Limit()
+	}
 	return hnswConfig.PQ.Enabled, hnswConfig.PQ.TrainingLimit
 }
 
@@ -169,6 +169,9 @@ func (h *hnsw) addOne(vector []float32, node *vertex) error {
 		h.compressor.Preload(node.id, vector)
 	} else {
 		h.cache.Preload(node.id, vector)
+		if pending := h.pendingCompression.Load(); pending != nil {
+			pending.compressor.Preload(node.id, vector)
+		}
 	}
 
 	h.insertMetrics.prepareAndInsertNode(before)
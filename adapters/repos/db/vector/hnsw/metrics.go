@@ -28,6 +28,7 @@ type Metrics struct {
 	deleteTime       prometheus.ObserverVec
 	cleaned          prometheus.Counter
 	size             prometheus.Gauge
+	searchEf         prometheus.Observer
 	grow             prometheus.Observer
 	startupProgress  prometheus.Gauge
 	startupDurations prometheus.ObserverVec
@@ -41,10 +42,8 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 		return &Metrics{enabled: false}
 	}
 
-	if prom.Group {
-		className = "n/a"
-		shardName = "n/a"
-	}
+	className = prom.ClassLabel(className)
+	shardName = prom.ShardLabel(shardName)
 
 	tombstones := prom.VectorIndexTombstones.With(prometheus.Labels{
 		"class_name": className,
@@ -90,6 +89,11 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 		"shard_name": shardName,
 	})
 
+	searchEf := prom.VectorIndexSearchEf.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
 	grow := prom.VectorIndexMaintenanceDurations.With(prometheus.Labels{
 		"class_name": className,
 		"shard_name": shardName,
@@ -122,6 +126,7 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 		delete:           del,
 		deleteTime:       deleteTime,
 		size:             size,
+		searchEf:         searchEf,
 		grow:             grow,
 		startupProgress:  startupProgress,
 		startupDurations: startupDurations,
@@ -129,6 +134,14 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 	}
 }
 
+func (m *Metrics) SearchEf(ef int) {
+	if !m.enabled {
+		return
+	}
+
+	m.searchEf.Observe(float64(ef))
+}
+
 func (m *Metrics) AddTombstone() {
 	if !m.enabled {
 		return
@@ -80,7 +80,7 @@ func (n *neighborFinderConnector) doAtLevel(level int) error {
 	eps := priorityqueue.NewMin[any](1)
 	eps.Insert(n.entryPointID, n.entryPointDist)
 
-	results, err := n.graph.searchLayerByVectorWithDistancer(n.nodeVec, eps, n.graph.efConstruction,
+	results, err := n.graph.searchLayerByVectorWithDistancer(context.Background(), n.nodeVec, eps, n.graph.efConstruction,
 		level, nil, n.distancer)
 	if err != nil {
 		return errors.Wrapf(err, "search layer at level %d", level)
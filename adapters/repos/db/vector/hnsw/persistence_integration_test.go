@@ -71,7 +71,7 @@ func TestHnswPersistence(t *testing.T) {
 
 	t.Run("verify that the results match originally", func(t *testing.T) {
 		position := 3
-		res, _, err := index.knnSearchByVector(testVectors[position], 50, 36, nil)
+		res, _, err := index.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResults, res)
 	})
@@ -96,7 +96,7 @@ func TestHnswPersistence(t *testing.T) {
 	t.Run("verify that the results match after rebuilding from disk",
 		func(t *testing.T) {
 			position := 3
-			res, _, err := secondIndex.knnSearchByVector(testVectors[position], 50, 36, nil)
+			res, _, err := secondIndex.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 			require.Nil(t, err)
 			assert.Equal(t, expectedResults, res)
 		})
@@ -141,7 +141,7 @@ func TestHnswPersistence_CorruptWAL(t *testing.T) {
 
 	t.Run("verify that the results match originally", func(t *testing.T) {
 		position := 3
-		res, _, err := index.knnSearchByVector(testVectors[position], 50, 36, nil)
+		res, _, err := index.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResults, res)
 	})
@@ -204,7 +204,7 @@ func TestHnswPersistence_CorruptWAL(t *testing.T) {
 	t.Run("verify that the results match after rebuilding from disk",
 		func(t *testing.T) {
 			position := 3
-			res, _, err := secondIndex.knnSearchByVector(testVectors[position], 50, 36, nil)
+			res, _, err := secondIndex.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 			require.Nil(t, err)
 			assert.Equal(t, expectedResults, res)
 		})
@@ -255,7 +255,7 @@ func TestHnswPersistence_WithDeletion_WithoutTombstoneCleanup(t *testing.T) {
 
 	t.Run("verify that the results match originally", func(t *testing.T) {
 		position := 3
-		res, _, err := index.knnSearchByVector(testVectors[position], 50, 36, nil)
+		res, _, err := index.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResults, res)
 	})
@@ -283,7 +283,7 @@ func TestHnswPersistence_WithDeletion_WithoutTombstoneCleanup(t *testing.T) {
 	t.Run("verify that the results match after rebuilding from disk",
 		func(t *testing.T) {
 			position := 3
-			res, _, err := secondIndex.knnSearchByVector(testVectors[position], 50, 36, nil)
+			res, _, err := secondIndex.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 			require.Nil(t, err)
 			assert.Equal(t, expectedResults, res)
 		})
@@ -342,7 +342,7 @@ func TestHnswPersistence_WithDeletion_WithTombstoneCleanup(t *testing.T) {
 
 	t.Run("verify that the results match originally", func(t *testing.T) {
 		position := 3
-		res, _, err := index.knnSearchByVector(testVectors[position], 50, 36, nil)
+		res, _, err := index.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResults, res)
 	})
@@ -369,7 +369,7 @@ func TestHnswPersistence_WithDeletion_WithTombstoneCleanup(t *testing.T) {
 	t.Run("verify that the results match after rebuilding from disk",
 		func(t *testing.T) {
 			position := 3
-			res, _, err := secondIndex.knnSearchByVector(testVectors[position], 50, 36, nil)
+			res, _, err := secondIndex.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 			require.Nil(t, err)
 			assert.Equal(t, expectedResults, res)
 		})
@@ -415,7 +415,7 @@ func TestHnswPersistence_WithDeletion_WithTombstoneCleanup(t *testing.T) {
 	t.Run("verify that the results match after rebuilding from disk",
 		func(t *testing.T) {
 			position := 3
-			res, _, err := thirdIndex.knnSearchByVector(testVectors[position], 50, 36, nil)
+			res, _, err := thirdIndex.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 			require.Nil(t, err)
 			assert.Equal(t, []uint64{3}, res)
 		})
@@ -464,7 +464,7 @@ func TestHnswPersistence_WithDeletion_WithTombstoneCleanup(t *testing.T) {
 			2, 1, 0, // cluster 1
 		}
 		position := 3
-		res, _, err := fourthIndex.knnSearchByVector(testVectors[position], 50, 36, nil)
+		res, _, err := fourthIndex.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResults, res)
 	})
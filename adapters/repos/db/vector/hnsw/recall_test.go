@@ -108,7 +108,7 @@ func TestRecall(t *testing.T) {
 		hasDuplicates := 0
 
 		for _, vec := range queries {
-			results, _, err := vectorIndex.SearchByVector(vec, k, nil)
+			results, _, err := vectorIndex.SearchByVector(context.Background(), vec, k, nil)
 			require.Nil(t, err)
 			if containsDuplicates(results) {
 				hasDuplicates++
@@ -126,7 +126,7 @@ func TestRecall(t *testing.T) {
 		var retrieved int
 
 		for i := 0; i < len(queries); i++ {
-			results, _, err := vectorIndex.SearchByVector(queries[i], k, nil)
+			results, _, err := vectorIndex.SearchByVector(context.Background(), queries[i], k, nil)
 			require.Nil(t, err)
 
 			retrieved += k
@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// subspaceVecs are vectors of the given dimensions, which lie close to a
+// random subspace with rank dimensions
+func subspaceVecs(r *rand.Rand, size, dimensions, rank int) [][]float32 {
+	basis := make([][]float32, rank)
+	for i := range basis {
+		basis[i] = make([]float32, dimensions)
+		for j := range basis[i] {
+			basis[i][j] = float32(r.NormFloat64())
+		}
+	}
+
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = make([]float32, dimensions)
+		for j := range vectors[i] {
+			vectors[i][j] = 0.01 * float32(r.NormFloat64())
+		}
+		for _, b := range basis {
+			w := float32(r.NormFloat64())
+			for j := range b {
+				vectors[i][j] += w * b[j]
+			}
+		}
+	}
+	return vectors
+}
+
+func newReductionTestIndex(t *testing.T, vectors [][]float32, cfg ent.ReductionConfig,
+	store *lsmkv.Store,
+) *hnsw {
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "dimension-reduction-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: 1000000,
+		DimensionReduction:    cfg,
+	}, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), store)
+	require.NoError(t, err)
+	return index
+}
+
+func Test_NoRaceDimensionReduction(t *testing.T) {
+	l2 := distancer.NewL2SquaredProvider()
+	distance := func(x, y []float32) float32 {
+		dist, _, _ := l2.SingleDist(x, y)
+		return dist
+	}
+
+	t.Run("truncate", func(t *testing.T) {
+		vectors, _ := testinghelpers.RandomVecs(500, 0, 16)
+		index := newReductionTestIndex(t, vectors, ent.ReductionConfig{
+			Enabled:    true,
+			Dimensions: 8,
+		}, testinghelpers.NewDummyStore(t))
+		defer index.Shutdown(context.Background())
+		require.True(t, index.Compressed())
+
+		compressionhelpers.Concurrently(uint64(len(vectors)), func(id uint64) {
+			require.Nil(t, index.Add(id, vectors[id]))
+		})
+
+		// the results are rescored with the full vectors
+		for id := 0; id < len(vectors); id += 50 {
+			ids, dists, err := index.SearchByVector(context.Background(), vectors[id], 1, nil)
+			require.Nil(t, err)
+			require.Len(t, ids, 1)
+			assert.Equal(t, uint64(id), ids[0])
+			assert.Equal(t, float32(0), dists[0])
+		}
+	})
+
+	t.Run("pca", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		vectors := subspaceVecs(r, 1000, 32, 8)
+		queries := subspaceVecs(r, 20, 32, 8)
+		cfg := ent.ReductionConfig{
+			Enabled:       true,
+			Method:        ent.ReductionMethodPCA,
+			Dimensions:    8,
+			TrainingLimit: 500,
+		}
+		store := testinghelpers.NewDummyStore(t)
+		index := newReductionTestIndex(t, vectors, cfg, store)
+		defer index.Shutdown(context.Background())
+
+		compress, at := index.ShouldCompress()
+		assert.True(t, compress)
+		assert.Equal(t, 500, at)
+		require.False(t, index.Compressed(), "principal components need data")
+
+		compressionhelpers.Concurrently(uint64(len(vectors)), func(id uint64) {
+			require.Nil(t, index.Add(id, vectors[id]))
+		})
+
+		compressed := make(chan struct{})
+		require.Nil(t, index.TurnOnCompression(func() { close(compressed) }))
+		<-compressed
+		require.True(t, index.Compressed())
+
+		var matches uint64
+		for _, query := range queries {
+			truth, _ := testinghelpers.BruteForce(vectors, query, 10, distance)
+			ids, _, err := index.SearchByVector(context.Background(), query, 10, nil)
+			require.Nil(t, err)
+			matches += testinghelpers.MatchesInLists(truth, ids)
+		}
+		recall := float32(matches) / float32(10*len(queries))
+		assert.GreaterOrEqual(t, recall, float32(0.9))
+
+		restored := newReductionTestIndex(t, vectors, cfg, store)
+		defer restored.Shutdown(context.Background())
+		assert.True(t, restored.Compressed(), "principal components are restored")
+	})
+}
@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+// Commit logs are checksummed once they are no longer written to. The
+// checksums are stored in hidden files next to them, which are ignored when
+// the commit logs are read. Condensing and combining rewrite commit logs
+// under the names of their sources, the checksums of rewritten logs are
+// stored again on their next scrub.
+
+// commitLogSettleTime is the time since their last modification after which
+// commit logs are considered complete, the condensor and combiner may still
+// be writing more recent ones
+const commitLogSettleTime = time.Minute
+
+func commitLogChecksumsPath(dir, fileName string) string {
+	return filepath.Join(dir, "."+fileName+".checksums")
+}
+
+// Scrub compares the commit logs which are no longer written to with their
+// checksums and returns the corrupt ones
+func (h *hnsw) Scrub(ctx context.Context) ([]diskio.CorruptFile, error) {
+	dir := commitLogDirectory(h.rootPath, h.id)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("browse commit log directory: %w", err)
+	}
+
+	var logs []os.DirEntry
+	checksums := map[string]struct{}{}
+	for _, entry := range entries {
+		name := entry.Name()
+		switch {
+		case strings.HasPrefix(name, "."):
+			if strings.HasSuffix(name, ".checksums") {
+				checksums[name] = struct{}{}
+			}
+		case strings.HasSuffix(name, ".tmp"):
+			// scratch files and logs being combined
+		default:
+			if _, err := asTimeStamp(name); err == nil {
+				logs = append(logs, entry)
+			}
+		}
+	}
+	sort.Slice(logs, func(a, b int) bool {
+		ts1, _ := asTimeStamp(logs[a].Name())
+		ts2, _ := asTimeStamp(logs[b].Name())
+		return ts1 < ts2
+	})
+
+	var corrupt []diskio.CorruptFile
+	for i, entry := range logs {
+		checksumsPath := commitLogChecksumsPath(dir, entry.Name())
+		delete(checksums, filepath.Base(checksumsPath))
+
+		if i == len(logs)-1 {
+			// the latest commit log is still written to
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return corrupt, err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return corrupt, fmt.Errorf("stat commit log %s: %w", entry.Name(), err)
+		}
+		if time.Since(info.ModTime()) < commitLogSettleTime {
+			continue
+		}
+
+		c, err := diskio.VerifyBlockChecksums(filepath.Join(dir, entry.Name()), checksumsPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue // condensed or combined in the meantime
+			}
+			return corrupt, fmt.Errorf("verify commit log %s: %w", entry.Name(), err)
+		}
+		if c != nil {
+			corrupt = append(corrupt, *c)
+		}
+	}
+
+	// the checksums of logs which were condensed or combined away
+	for name := range checksums {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil && !os.IsNotExist(err) {
+			return corrupt, fmt.Errorf("remove checksums of deleted commit log: %w", err)
+		}
+	}
+
+	return corrupt, nil
+}
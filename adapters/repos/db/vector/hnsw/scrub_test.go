@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScrubCommitLogs(t *testing.T) {
+	ctx := context.Background()
+	h := &hnsw{rootPath: t.TempDir(), id: "main"}
+	dir := commitLogDirectory(h.rootPath, h.id)
+	require.Nil(t, os.MkdirAll(dir, 0o777))
+
+	settled := time.Now().Add(-time.Hour)
+	writeLog := func(name string, modTime time.Time) string {
+		path := filepath.Join(dir, name)
+		require.Nil(t, os.WriteFile(path, []byte("commit log "+name), 0o666))
+		require.Nil(t, os.Chtimes(path, modTime, modTime))
+		return path
+	}
+	writeLog("1000.condensed", settled)
+	second := writeLog("2000", settled)
+	writeLog("3000", settled)
+	writeLog("2500", time.Now())
+
+	t.Run("first scrub stores checksums of complete logs", func(t *testing.T) {
+		corrupt, err := h.Scrub(ctx)
+		require.Nil(t, err)
+		assert.Empty(t, corrupt)
+
+		assert.FileExists(t, commitLogChecksumsPath(dir, "1000.condensed"))
+		assert.FileExists(t, commitLogChecksumsPath(dir, "2000"))
+		// still written by the condensor
+		assert.NoFileExists(t, commitLogChecksumsPath(dir, "2500"))
+		// still written by the commit logger
+		assert.NoFileExists(t, commitLogChecksumsPath(dir, "3000"))
+	})
+
+	t.Run("logs are checksummed once a new log is started", func(t *testing.T) {
+		writeLog("4000", settled)
+
+		corrupt, err := h.Scrub(ctx)
+		require.Nil(t, err)
+		assert.Empty(t, corrupt)
+		assert.FileExists(t, commitLogChecksumsPath(dir, "3000"))
+		assert.NoFileExists(t, commitLogChecksumsPath(dir, "4000"))
+	})
+
+	t.Run("corrupt logs are reported", func(t *testing.T) {
+		require.Nil(t, os.WriteFile(second, []byte("commit log 2001"), 0o666))
+		require.Nil(t, os.Chtimes(second, settled, settled))
+
+		corrupt, err := h.Scrub(ctx)
+		require.Nil(t, err)
+		require.Len(t, corrupt, 1)
+		assert.Equal(t, second, corrupt[0].Path)
+		assert.Equal(t, []int64{0}, corrupt[0].Offsets)
+	})
+
+	t.Run("checksums of deleted logs are removed", func(t *testing.T) {
+		require.Nil(t, os.Remove(second))
+
+		corrupt, err := h.Scrub(ctx)
+		require.Nil(t, err)
+		assert.Empty(t, corrupt)
+		assert.NoFileExists(t, commitLogChecksumsPath(dir, "2000"))
+	})
+}
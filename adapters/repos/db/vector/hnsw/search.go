@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"math"
 	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
@@ -23,7 +24,9 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
 
@@ -33,6 +36,9 @@ func (h *hnsw) searchTimeEF(k int) int {
 	// can be so common that it would cause considerable overhead
 	ef := int(atomic.LoadInt64(&h.ef))
 	if ef < 1 {
+		if tuned, ok := h.efTuner.searchEF(k, int(atomic.LoadInt64(&h.efMax))); ok {
+			return tuned
+		}
 		return h.autoEfFromK(k)
 	}
 
@@ -61,16 +67,51 @@ func (h *hnsw) autoEfFromK(k int) int {
 	return ef
 }
 
-func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
+func (h *hnsw) SearchByVector(ctx context.Context, vector []float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	ctx, span := tracing.Start(ctx, "hnsw.SearchByVector")
+	defer span.End()
+	span.SetAttribute("k", k)
+
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
 
 	vector = h.normalizeVec(vector)
 	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
 	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
-		return h.flatSearch(vector, k, allowList)
+		span.SetAttribute("flat_search", true)
+		return h.flatSearch(ctx, vector, k, allowList)
+	}
+	ef := h.searchTimeEF(k)
+	if rescore, ok := search.RescoreLimit(ctx, k); ok && h.shouldRescore() && rescore > ef {
+		// the oversampled candidates must be part of the search results
+		ef = rescore
+	}
+	span.SetAttribute("ef", ef)
+	h.metrics.SearchEf(ef)
+	before := time.Now()
+	ids, dists, err := h.knnSearchByVector(ctx, vector, k, ef, allowList)
+	if err == nil {
+		h.efTuner.observe(time.Since(before))
+		h.maybeTuneEF()
+	}
+	return ids, dists, err
+}
+
+// SearchByVectorWithEF searches the graph with the given ef instead of the
+// configured one and never falls back to a flat search. It is used to
+// measure the recall of different ef settings.
+func (h *hnsw) SearchByVectorWithEF(ctx context.Context, vector []float32, k, ef int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	if ef < k {
+		ef = k
 	}
-	return h.knnSearchByVector(vector, k, h.searchTimeEF(k), allowList)
+	return h.knnSearchByVector(ctx, h.normalizeVec(vector), k, ef, allowList)
 }
 
 // SearchByVectorDistance wraps SearchByVector, and calls it recursively until
@@ -82,7 +123,8 @@ func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowLi
 // eventually turned into objects, for example, a Get query. If the caller just
 // needs ids for sake of something like aggregation, a maxLimit of -1 can be
 // passed in to truly obtain all results from the vector index.
-func (h *hnsw) SearchByVectorDistance(vector []float32, targetDistance float32, maxLimit int64,
+func (h *hnsw) SearchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64,
 	allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
 	var (
@@ -95,7 +137,7 @@ func (h *hnsw) SearchByVectorDistance(vector []float32, targetDistance float32,
 	recursiveSearch := func() (bool, error) {
 		shouldContinue := false
 
-		ids, dist, err := h.SearchByVector(vector, searchParams.totalLimit, allowList)
+		ids, dist, err := h.SearchByVector(ctx, vector, searchParams.totalLimit, allowList)
 		if err != nil {
 			return false, errors.Wrap(err, "vector search")
 		}
@@ -156,7 +198,7 @@ func (h *hnsw) shouldRescore() bool {
 	return h.compressed.Load() && !h.doNotRescore
 }
 
-func (h *hnsw) searchLayerByVector(queryVector []float32,
+func (h *hnsw) searchLayerByVector(ctx context.Context, queryVector []float32,
 	entrypoints *priorityqueue.Queue[any], ef int, level int,
 	allowList helpers.AllowList,
 ) (*priorityqueue.Queue[any], error,
@@ -167,10 +209,12 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 		compressorDistancer, returnFn = h.compressor.NewDistancer(queryVector)
 		defer returnFn()
 	}
-	return h.searchLayerByVectorWithDistancer(queryVector, entrypoints, ef, level, allowList, compressorDistancer)
+	return h.searchLayerByVectorWithDistancer(ctx, queryVector, entrypoints, ef, level, allowList, compressorDistancer)
 }
 
-func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
+// searchLayerByVectorWithDistancer stops with the error of the context once
+// it is cancelled, e.g. because the deadline of the query passed
+func (h *hnsw) searchLayerByVectorWithDistancer(ctx context.Context, queryVector []float32,
 	entrypoints *priorityqueue.Queue[any], ef int, level int,
 	allowList helpers.AllowList, compressorDistancer compressionhelpers.CompressorDistancer) (*priorityqueue.Queue[any], error,
 ) {
@@ -207,6 +251,16 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	connectionsReusable := make([]uint64, h.maximumConnectionsLayerZero)
 
 	for candidates.Len() > 0 {
+		// checking for every candidate is cheap compared to calculating the
+		// distances of all its connections
+		if err := ctx.Err(); err != nil {
+			h.pools.pqCandidates.Put(candidates)
+			h.pools.visitedListsLock.Lock()
+			h.pools.visitedLists.Return(visited)
+			h.pools.visitedListsLock.Unlock()
+			return nil, err
+		}
+
 		var dist float32
 		candidate := candidates.Pop()
 		dist = candidate.Dist
@@ -476,7 +530,7 @@ func (h *hnsw) handleDeletedNode(docID uint64) {
 			"tombstone was added", docID)
 }
 
-func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
+func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int,
 	ef int, allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
 	if h.isEmpty() {
@@ -513,7 +567,7 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 		eps := priorityqueue.NewMin[any](10)
 		eps.Insert(entryPointID, entryPointDistance)
 
-		res, err := h.searchLayerByVectorWithDistancer(searchVec, eps, 1, level, nil, compressorDistancer)
+		res, err := h.searchLayerByVectorWithDistancer(ctx, searchVec, eps, 1, level, nil, compressorDistancer)
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", level)
 		}
@@ -556,7 +610,7 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 
 	eps := priorityqueue.NewMin[any](10)
 	eps.Insert(entryPointID, entryPointDistance)
-	res, err := h.searchLayerByVectorWithDistancer(searchVec, eps, ef, 0, allowList, compressorDistancer)
+	res, err := h.searchLayerByVectorWithDistancer(ctx, searchVec, eps, ef, 0, allowList, compressorDistancer)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
 	}
@@ -570,6 +624,10 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 			i--
 		}
 		res.Reset()
+		if rescore, ok := search.RescoreLimit(ctx, k); ok && rescore < len(ids) {
+			// only rescore the closest candidates by compressed distance
+			ids = ids[:rescore]
+		}
 		for _, id := range ids {
 			dist, _, _ := h.distanceFromBytesToFloatNode(compressorDistancer, id)
 			res.Insert(id, dist)
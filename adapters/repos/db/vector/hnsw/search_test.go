@@ -80,7 +80,7 @@ func TestNilCheckOnPartiallyCleanedNode(t *testing.T) {
 	})
 
 	t.Run("run a search that would typically find the new ep", func(t *testing.T) {
-		res, _, err := vectorIndex.SearchByVector([]float32{1.7, 1.7}, 20, nil)
+		res, _, err := vectorIndex.SearchByVector(context.Background(), []float32{1.7, 1.7}, 20, nil)
 		require.Nil(t, err)
 		assert.Equal(t, []uint64{2, 0}, res, "right results are found")
 	})
@@ -90,3 +90,38 @@ func TestNilCheckOnPartiallyCleanedNode(t *testing.T) {
 		assert.True(t, ok)
 	})
 }
+
+func TestSearchCancelled(t *testing.T) {
+	vectors := [][]float32{{1, 1}, {2, 2}, {3, 3}, {4, 4}}
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "search-cancelled",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        128,
+		VectorCacheMaxObjects: 100000,
+	}, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err = index.SearchByVector(ctx, []float32{1, 1}, 2, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, _, err = index.SearchByVectorDistance(ctx, []float32{1, 1}, 10, 10, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	res, _, err := index.SearchByVector(context.Background(), []float32{1, 1}, 2, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{0, 1}, res)
+}
@@ -111,6 +111,9 @@ func (h *hnsw) restoreFromDisk() error {
 		}
 
 		h.metrics.StartupProgress(float64(i+1) / float64(len(fileNames)))
+		if h.startupProgress != nil {
+			h.startupProgress(float64(i+1) / float64(len(fileNames)))
+		}
 		h.metrics.TrackStartupIndividual(beforeIndividual)
 	}
 
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+func Test_NoRaceFloat16VectorStorage(t *testing.T) {
+	l2 := distancer.NewL2SquaredProvider()
+	distance := func(x, y []float32) float32 {
+		dist, _, _ := l2.SingleDist(x, y)
+		return dist
+	}
+
+	vectors, queries := testinghelpers.RandomVecs(1000, 20, 32)
+	// the object store holds the rounded vectors
+	stored := make([][]float32, len(vectors))
+	for i, vec := range vectors {
+		stored[i] = byteops.RoundToFloat16(vec)
+	}
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "float16-storage-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      l2,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return stored[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(stored),
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: 1000000,
+		VectorStorage:         ent.VectorStorageFloat16,
+	}, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	defer index.Shutdown(context.Background())
+	require.True(t, index.Compressed(), "the vectors are kept in half precision from the start")
+
+	compressionhelpers.Concurrently(uint64(len(vectors)), func(id uint64) {
+		require.Nil(t, index.Add(id, vectors[id]))
+	})
+
+	t.Run("finds the rounded vectors", func(t *testing.T) {
+		for id := 0; id < len(stored); id += 50 {
+			ids, dists, err := index.SearchByVector(context.Background(), stored[id], 1, nil)
+			require.Nil(t, err)
+			require.Len(t, ids, 1)
+			assert.Equal(t, uint64(id), ids[0])
+			assert.Equal(t, float32(0), dists[0])
+		}
+	})
+
+	t.Run("recall", func(t *testing.T) {
+		var matches uint64
+		for _, query := range queries {
+			truth, _ := testinghelpers.BruteForce(vectors, query, 10, distance)
+			ids, _, err := index.SearchByVector(context.Background(), query, 10, nil)
+			require.Nil(t, err)
+			matches += testinghelpers.MatchesInLists(truth, ids)
+		}
+		recall := float32(matches) / float32(10*len(queries))
+		assert.GreaterOrEqual(t, recall, float32(0.9))
+	})
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// graphPorter is a vector index whose graph can be exported and imported in
+// the portable format, see hnsw.ExportGraph
+type graphPorter interface {
+	ExportGraph(w io.Writer) error
+	ImportGraph(ctx context.Context, r io.Reader) error
+}
+
+// ExportVectorGraph writes the graph of the vector index of a local shard in
+// the portable format to w
+func (db *DB) ExportVectorGraph(ctx context.Context, class, shardName string, w io.Writer) error {
+	porter, err := db.localGraphPorter(class, shardName)
+	if err != nil {
+		return err
+	}
+	return porter.ExportGraph(w)
+}
+
+// ImportVectorGraph replaces the empty vector index of a local shard with a
+// graph in the portable format. The objects of the graph must already be
+// part of the shard, their vectors are read from it.
+func (db *DB) ImportVectorGraph(ctx context.Context, class, shardName string, r io.Reader) error {
+	porter, err := db.localGraphPorter(class, shardName)
+	if err != nil {
+		return err
+	}
+	if err := porter.ImportGraph(ctx, r); err != nil {
+		if errors.Is(err, hnsw.ErrGraphRejected) {
+			return objects.NewErrInvalidUserInput("shard %q: %v", shardName, err)
+		}
+		return fmt.Errorf("shard %q: %w", shardName, err)
+	}
+	return nil
+}
+
+func (db *DB) localGraphPorter(class, shardName string) (graphPorter, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, objects.NewErrNotFound("class %q not found", class)
+	}
+	shard := idx.shards.Load(shardName)
+	if shard == nil {
+		return nil, objects.NewErrNotFound("shard %q of class %q not found on this node", shardName, class)
+	}
+	porter, ok := shard.VectorIndex().(graphPorter)
+	if !ok {
+		return nil, objects.NewErrInvalidUserInput(
+			"the vector index of shard %q does not support graph export", shardName)
+	}
+	return porter, nil
+}
@@ -0,0 +1,569 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// walArchiveID groups the archived changes of all nodes on the backend. The
+// segments of a node are stored below node/day, every day has an index of
+// the segments containing changes of that day.
+const walArchiveID = "wal-archive"
+
+const (
+	walArchiveDir     = ".wal-archive"
+	walArchiveCurrent = "current.log"
+	walArchiveIndex   = "segments.json"
+	walArchiveHead    = "head.json"
+	walArchiveSchema  = "schema.json"
+	walArchiveDay     = "2006-01-02"
+
+	walOpPut    = "put"
+	walOpDelete = "delete"
+)
+
+// walEntry is a single change of an object. Puts contain the object as it
+// was stored, so an entry can be applied without knowing the previous state
+// of the object.
+type walEntry struct {
+	Time   int64       `json:"time"`
+	Op     string      `json:"op"`
+	Class  string      `json:"class"`
+	Shard  string      `json:"shard"`
+	ID     strfmt.UUID `json:"id"`
+	Object []byte      `json:"object,omitempty"`
+}
+
+// walSegment is a shipped file of entries, First and Last are the times of
+// its first and last entry
+type walSegment struct {
+	Key   string `json:"key"`
+	First int64  `json:"first"`
+	Last  int64  `json:"last"`
+}
+
+// walArchive records all changes of the objects stored on this node and
+// ships them to a backup backend in segments, so that a backup can be
+// restored to any point in time after it was taken. Changes are written to
+// a local file first and shipped every interval, changes which were not
+// shipped yet are shipped on the next start.
+//
+// The archive is created with the DB, but only records changes once it is
+// started, since the backend is provided by a module.
+type walArchive struct {
+	dir    string
+	logger logrus.FieldLogger
+
+	sync.Mutex
+	backend     modulecapabilities.BackupBackend
+	node        string
+	file        *os.File
+	first, last int64
+
+	// shipping is serialized, since the day indexes are read-modify-write
+	shipLock sync.Mutex
+	indexes  map[string][]walSegment
+
+	// schema is shipped along with the changes if set, whenever its version
+	// changes
+	schema        func() (*backup.ArchivedSchema, error)
+	schemaVersion string
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newWALArchive(rootPath string, logger logrus.FieldLogger) *walArchive {
+	return &walArchive{
+		dir:     filepath.Join(rootPath, walArchiveDir),
+		logger:  logger,
+		indexes: map[string][]walSegment{},
+	}
+}
+
+// StartWALArchive records all changes from now on and ships them to the
+// backend every interval
+func (db *DB) StartWALArchive(backend modulecapabilities.BackupBackend, interval time.Duration) error {
+	db.walArchive.schema = db.archivedSchema
+	return db.walArchive.start(backend, db.schemaGetter.NodeName(), interval)
+}
+
+// WALArchiveEnabled reports if changes are archived, which is required to
+// restore a backup to a point in time
+func (db *DB) WALArchiveEnabled() bool {
+	return db.walArchive.enabled()
+}
+
+func (a *walArchive) start(backend modulecapabilities.BackupBackend, node string, interval time.Duration) error {
+	if err := os.MkdirAll(a.dir, os.ModePerm); err != nil {
+		return fmt.Errorf("wal archive: %w", err)
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	a.backend = backend
+	a.node = node
+	// a current file left behind by a crash is shipped like any other segment
+	if err := a.closeSegment(); err != nil {
+		return fmt.Errorf("wal archive: %w", err)
+	}
+
+	a.stop = make(chan struct{})
+	a.done = make(chan struct{})
+	go func() {
+		defer close(a.done)
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			if err := a.ship(context.Background()); err != nil {
+				a.logger.WithField("action", "wal_archive").WithError(err).
+					Error("ship archived changes")
+			}
+			select {
+			case <-a.stop:
+				return
+			case <-t.C:
+			}
+		}
+	}()
+	return nil
+}
+
+func (a *walArchive) enabled() bool {
+	if a == nil {
+		return false
+	}
+	a.Lock()
+	defer a.Unlock()
+	return a.backend != nil
+}
+
+// shutdown ships the remaining changes
+func (a *walArchive) shutdown(ctx context.Context) error {
+	if !a.enabled() {
+		return nil
+	}
+	close(a.stop)
+	<-a.done
+	return a.ship(ctx)
+}
+
+func (a *walArchive) record(entry walEntry) {
+	if a == nil {
+		return
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	if a.backend == nil {
+		return
+	}
+
+	entry.Time = time.Now().UnixNano()
+	if err := a.write(entry); err != nil {
+		// the change itself succeeded, so it is not failed because of the
+		// archive, but it can not be replayed
+		a.logger.WithField("action", "wal_archive").
+			WithField("class", entry.Class).
+			WithField("shard", entry.Shard).
+			WithField("id", entry.ID).
+			WithError(err).Error("archive change")
+	}
+}
+
+func (a *walArchive) write(entry walEntry) error {
+	if a.file == nil {
+		f, err := os.OpenFile(filepath.Join(a.dir, walArchiveCurrent),
+			os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return err
+		}
+		a.file = f
+		a.first = entry.Time
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := a.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	a.last = entry.Time
+	return nil
+}
+
+// closeSegment renames the current file to a segment which is ready to be
+// shipped. Must be called with the lock held.
+func (a *walArchive) closeSegment() error {
+	current := filepath.Join(a.dir, walArchiveCurrent)
+	if a.file != nil {
+		if err := a.file.Close(); err != nil {
+			return err
+		}
+		a.file = nil
+	} else {
+		// left behind by a crash, the times are read from its entries
+		first, last, err := segmentTimes(current)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		a.first, a.last = first, last
+	}
+
+	if a.last == 0 {
+		return os.Remove(current)
+	}
+	name := fmt.Sprintf("%020d-%020d.log", a.first, a.last)
+	a.first, a.last = 0, 0
+	return os.Rename(current, filepath.Join(a.dir, name))
+}
+
+// ship uploads all closed segments and adds them to the index of every day
+// they contain changes of. Segments are only removed locally once they are
+// part of the indexes. The head is written last, so it only promises changes
+// which were shipped.
+func (a *walArchive) ship(ctx context.Context) error {
+	a.shipLock.Lock()
+	defer a.shipLock.Unlock()
+
+	a.Lock()
+	err := a.closeSegment()
+	backend, node := a.backend, a.node
+	closedAt := time.Now()
+	a.Unlock()
+	if err != nil {
+		return fmt.Errorf("close segment: %w", err)
+	}
+
+	entries, err := os.ReadDir(a.dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || name == walArchiveCurrent || !strings.HasSuffix(name, ".log") {
+			continue
+		}
+		var seg walSegment
+		if _, err := fmt.Sscanf(name, "%020d-%020d.log", &seg.First, &seg.Last); err != nil {
+			continue
+		}
+
+		p := filepath.Join(a.dir, name)
+		seg.Key = path.Join(node, time.Unix(0, seg.First).UTC().Format(walArchiveDay), name)
+		if err := backend.PutFile(ctx, walArchiveID, seg.Key, p); err != nil {
+			return fmt.Errorf("upload %s: %w", name, err)
+		}
+		for _, day := range segmentDays(seg.First, seg.Last) {
+			if err := a.addToIndex(ctx, backend, node, day, seg); err != nil {
+				return err
+			}
+		}
+		if err := os.Remove(p); err != nil {
+			return err
+		}
+	}
+	return a.shipHead(ctx, backend, node, closedAt)
+}
+
+// shipHead ships the schema if it changed and marks all changes made before
+// closedAt as shipped. The schema is taken after the changes were shipped,
+// so it contains every class and tenant the changes belong to.
+func (a *walArchive) shipHead(ctx context.Context, backend modulecapabilities.BackupBackend,
+	node string, closedAt time.Time,
+) error {
+	if a.schema != nil {
+		s, err := a.schema()
+		if err != nil {
+			return fmt.Errorf("get schema: %w", err)
+		}
+		data, err := json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		if version := fmt.Sprintf("%x", sha256.Sum256(data)); version != a.schemaVersion {
+			if err := backend.PutObject(ctx, walArchiveID, path.Join(node, walArchiveSchema), data); err != nil {
+				return fmt.Errorf("upload schema: %w", err)
+			}
+			a.schemaVersion = version
+		}
+	}
+
+	data, err := json.Marshal(backup.ArchiveHead{ShippedAt: closedAt, SchemaVersion: a.schemaVersion})
+	if err != nil {
+		return err
+	}
+	if err := backend.PutObject(ctx, walArchiveID, path.Join(node, walArchiveHead), data); err != nil {
+		return fmt.Errorf("upload head: %w", err)
+	}
+	return nil
+}
+
+func (a *walArchive) addToIndex(ctx context.Context, backend modulecapabilities.BackupBackend,
+	node, day string, seg walSegment,
+) error {
+	segments, ok := a.indexes[day]
+	if !ok {
+		var err error
+		if segments, err = loadWALIndex(ctx, backend, node, day); err != nil {
+			return err
+		}
+	}
+	for _, s := range segments {
+		if s.Key == seg.Key {
+			// shipped before, but not removed locally
+			return nil
+		}
+	}
+
+	segments = append(segments, seg)
+	data, err := json.Marshal(segments)
+	if err != nil {
+		return err
+	}
+	if err := backend.PutObject(ctx, walArchiveID, path.Join(node, day, walArchiveIndex), data); err != nil {
+		return fmt.Errorf("update index of %s: %w", day, err)
+	}
+	// only the index of the current day is still growing
+	a.indexes = map[string][]walSegment{day: segments}
+	return nil
+}
+
+func loadWALIndex(ctx context.Context, backend modulecapabilities.BackupBackend,
+	node, day string,
+) ([]walSegment, error) {
+	data, err := backend.GetObject(ctx, walArchiveID, path.Join(node, day, walArchiveIndex))
+	if err != nil {
+		if errors.As(err, &backup.ErrNotFound{}) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get index of %s: %w", day, err)
+	}
+	var segments []walSegment
+	if err := json.Unmarshal(data, &segments); err != nil {
+		return nil, fmt.Errorf("unmarshal index of %s: %w", day, err)
+	}
+	return segments, nil
+}
+
+// segmentDays returns the days between first and last
+func segmentDays(first, last int64) []string {
+	var days []string
+	end := time.Unix(0, last).UTC().Format(walArchiveDay)
+	for t := time.Unix(0, first).UTC(); ; t = t.Add(24 * time.Hour) {
+		day := t.Format(walArchiveDay)
+		days = append(days, day)
+		if day >= end {
+			return days
+		}
+	}
+}
+
+func segmentTimes(file string) (first, last int64, err error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	err = decodeWALEntries(f, func(e walEntry) error {
+		if first == 0 {
+			first = e.Time
+		}
+		last = e.Time
+		return nil
+	})
+	if err != nil && !isTruncatedEntry(err) {
+		return 0, 0, err
+	}
+	return first, last, nil
+}
+
+// isTruncatedEntry checks if decoding failed because the last entry of a
+// segment is incomplete, which happens if it was left behind by a crash
+func isTruncatedEntry(err error) bool {
+	var syntaxErr *json.SyntaxError
+	return errors.As(err, &syntaxErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func decodeWALEntries(r io.Reader, fn func(walEntry) error) error {
+	dec := json.NewDecoder(r)
+	for {
+		var e walEntry
+		if err := dec.Decode(&e); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+}
+
+// ReplayWALArchive applies the archived changes of the given classes made
+// between from and until, as they were recorded by node. It is used to
+// restore a backup to a point in time, in which case node is the name of the
+// node the backup was taken on. Changes of shards which are not loaded on
+// this node are skipped.
+func (db *DB) ReplayWALArchive(ctx context.Context, node string, classes []string,
+	from, until time.Time,
+) error {
+	a := db.walArchive
+	if !a.enabled() {
+		return fmt.Errorf("wal archive is not enabled")
+	}
+	// changes made right before the restore might not have been shipped yet
+	if err := a.ship(ctx); err != nil {
+		return fmt.Errorf("ship archived changes: %w", err)
+	}
+
+	a.Lock()
+	backend := a.backend
+	a.Unlock()
+
+	seen := map[string]struct{}{}
+	var segments []walSegment
+	for _, day := range segmentDays(from.UnixNano(), until.UnixNano()) {
+		index, err := loadWALIndex(ctx, backend, node, day)
+		if err != nil {
+			return err
+		}
+		for _, seg := range index {
+			if _, ok := seen[seg.Key]; ok ||
+				seg.Last < from.UnixNano() || seg.First > until.UnixNano() {
+				continue
+			}
+			seen[seg.Key] = struct{}{}
+			segments = append(segments, seg)
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i].First < segments[j].First })
+
+	replay := make(map[string]struct{}, len(classes))
+	for _, class := range classes {
+		replay[class] = struct{}{}
+	}
+
+	var applied, skipped int
+	for _, seg := range segments {
+		data, err := backend.GetObject(ctx, walArchiveID, seg.Key)
+		if err != nil {
+			return fmt.Errorf("get segment %s: %w", seg.Key, err)
+		}
+		err = decodeWALEntries(bytes.NewReader(data), func(e walEntry) error {
+			if _, ok := replay[e.Class]; !ok ||
+				e.Time < from.UnixNano() || e.Time > until.UnixNano() {
+				return nil
+			}
+			ok, err := db.applyWALEntry(ctx, e)
+			if err != nil {
+				return fmt.Errorf("%s object %s: %w", e.Op, e.ID, err)
+			}
+			if ok {
+				applied++
+			} else {
+				skipped++
+			}
+			return nil
+		})
+		if err != nil && !isTruncatedEntry(err) {
+			return fmt.Errorf("replay segment %s: %w", seg.Key, err)
+		}
+	}
+
+	db.logger.WithField("action", "wal_archive_replay").
+		WithField("from", from).
+		WithField("until", until).
+		WithField("applied", applied).
+		WithField("skipped", skipped).
+		Info("replayed archived changes")
+	return nil
+}
+
+func (db *DB) applyWALEntry(ctx context.Context, e walEntry) (bool, error) {
+	idx := db.GetIndex(schema.ClassName(e.Class))
+	if idx == nil {
+		return false, nil
+	}
+
+	var err error
+	switch e.Op {
+	case walOpPut:
+		var obj *storobj.Object
+		if obj, err = idx.objectFromBinary(e.Object); err != nil {
+			return false, err
+		}
+		err = idx.IncomingPutObject(ctx, e.Shard, obj)
+	case walOpDelete:
+		err = idx.IncomingDeleteObject(ctx, e.Shard, e.ID)
+	default:
+		return false, fmt.Errorf("unknown operation %q", e.Op)
+	}
+	if errors.Is(err, errShardNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *Shard) archivePut(id, data []byte) {
+	s.archive(walOpPut, id, data)
+}
+
+func (s *Shard) archiveDelete(id []byte) {
+	s.archive(walOpDelete, id, nil)
+}
+
+func (s *Shard) archive(op string, id, data []byte) {
+	a := s.index.Config.WALArchive
+	if a == nil {
+		return
+	}
+	parsed, err := uuid.FromBytes(id)
+	if err != nil {
+		return
+	}
+	a.record(walEntry{
+		Op:     op,
+		Class:  s.index.Config.ClassName.String(),
+		Shard:  s.name,
+		ID:     strfmt.UUID(parsed.String()),
+		Object: data,
+	})
+}
@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+type walBackend struct {
+	offloadBackend
+}
+
+func (b *walBackend) GetObject(ctx context.Context, backupID, key string) ([]byte, error) {
+	data, err := b.offloadBackend.GetObject(ctx, backupID, key)
+	if err != nil {
+		return nil, backup.NewErrNotFound(err)
+	}
+	return data, nil
+}
+
+func (b *walBackend) PutFile(ctx context.Context, backupID, key, srcPath string) error {
+	data, err := os.ReadFile(srcPath)
+	if err != nil {
+		return err
+	}
+	return b.PutObject(ctx, backupID, key, data)
+}
+
+func TestWALArchive(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		backend   = &walBackend{offloadBackend{objects: map[string][]byte{}}}
+		root      = t.TempDir()
+		a         = newWALArchive(root, logger)
+	)
+
+	index := func(day string) []walSegment {
+		data, ok := backend.objects[path.Join(walArchiveID, "node1", day, walArchiveIndex)]
+		require.True(t, ok, "index of %s", day)
+		var segments []walSegment
+		require.Nil(t, json.Unmarshal(data, &segments))
+		return segments
+	}
+
+	t.Run("not started", func(t *testing.T) {
+		assert.False(t, a.enabled())
+		a.record(walEntry{Op: walOpDelete, Class: "Article", ID: "id1"})
+		assert.NoFileExists(t, filepath.Join(a.dir, walArchiveCurrent))
+	})
+
+	t.Run("left behind by a crash", func(t *testing.T) {
+		first := time.Date(2023, 1, 1, 23, 0, 0, 0, time.UTC).UnixNano()
+		last := time.Date(2023, 1, 2, 1, 0, 0, 0, time.UTC).UnixNano()
+		var buf bytes.Buffer
+		for _, e := range []walEntry{
+			{Time: first, Op: walOpPut, Class: "Article", ID: "id1", Object: []byte("obj")},
+			{Time: last, Op: walOpDelete, Class: "Article", ID: "id1"},
+		} {
+			line, err := json.Marshal(e)
+			require.Nil(t, err)
+			buf.Write(append(line, '\n'))
+		}
+		buf.WriteString(`{"time":`)
+		require.Nil(t, os.MkdirAll(a.dir, os.ModePerm))
+		require.Nil(t, os.WriteFile(filepath.Join(a.dir, walArchiveCurrent), buf.Bytes(), 0o644))
+
+		require.Nil(t, a.start(backend, "node1", time.Hour))
+		require.Nil(t, a.ship(ctx))
+		assert.True(t, a.enabled())
+
+		key := path.Join("node1", "2023-01-01", fmt.Sprintf("%020d-%020d.log", first, last))
+		assert.Contains(t, backend.objects, path.Join(walArchiveID, key))
+		want := []walSegment{{Key: key, First: first, Last: last}}
+		assert.Equal(t, want, index("2023-01-01"))
+		assert.Equal(t, want, index("2023-01-02"))
+
+		entries, err := os.ReadDir(a.dir)
+		require.Nil(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("record and ship", func(t *testing.T) {
+		before := time.Now().UnixNano()
+		a.record(walEntry{Op: walOpPut, Class: "Article", Shard: "s1", ID: "id2", Object: []byte("obj")})
+		a.record(walEntry{Op: walOpDelete, Class: "Article", Shard: "s1", ID: "id2"})
+		require.Nil(t, a.ship(ctx))
+
+		day := time.Unix(0, before).UTC().Format(walArchiveDay)
+		segments := index(day)
+		require.Len(t, segments, 1)
+		data := backend.objects[path.Join(walArchiveID, segments[0].Key)]
+
+		var entries []walEntry
+		require.Nil(t, decodeWALEntries(bytes.NewReader(data), func(e walEntry) error {
+			entries = append(entries, e)
+			return nil
+		}))
+		require.Len(t, entries, 2)
+		assert.Equal(t, walOpPut, entries[0].Op)
+		assert.Equal(t, []byte("obj"), entries[0].Object)
+		assert.Equal(t, walOpDelete, entries[1].Op)
+		assert.GreaterOrEqual(t, entries[0].Time, before)
+		assert.Equal(t, entries[0].Time, segments[0].First)
+		assert.Equal(t, entries[1].Time, segments[0].Last)
+	})
+
+	t.Run("nothing to ship", func(t *testing.T) {
+		n := len(backend.objects)
+		require.Nil(t, a.ship(ctx))
+		assert.Len(t, backend.objects, n)
+	})
+
+	t.Run("replay without the class", func(t *testing.T) {
+		db := &DB{logger: logger, walArchive: a, indices: map[string]*Index{}}
+		err := db.ReplayWALArchive(ctx, "node1", []string{"Article"},
+			time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Now())
+		assert.Nil(t, err)
+	})
+
+	require.Nil(t, a.shutdown(ctx))
+}
+
+func TestSegmentDays(t *testing.T) {
+	at := func(day, hour int) int64 {
+		return time.Date(2023, 1, day, hour, 0, 0, 0, time.UTC).UnixNano()
+	}
+	assert.Equal(t, []string{"2023-01-01"}, segmentDays(at(1, 1), at(1, 23)))
+	assert.Equal(t, []string{"2023-01-01", "2023-01-02", "2023-01-03"},
+		segmentDays(at(1, 23), at(3, 1)))
+}
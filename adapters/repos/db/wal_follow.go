@@ -0,0 +1,191 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// archivedSchema is the schema shipped with the archived changes
+func (db *DB) archivedSchema() (*backup.ArchivedSchema, error) {
+	sch := db.schemaGetter.GetSchemaSkipAuth()
+	out := &backup.ArchivedSchema{Tenants: map[string][]*models.Tenant{}}
+	if sch.Objects == nil {
+		return out, nil
+	}
+
+	for _, class := range sch.Objects.Classes {
+		out.Classes = append(out.Classes, class)
+		if !schema.MultiTenancyEnabled(class) {
+			continue
+		}
+		st := db.schemaGetter.CopyShardingState(class.Class)
+		if st == nil {
+			continue
+		}
+		tenants := make([]*models.Tenant, 0, len(st.Physical))
+		for name, physical := range st.Physical {
+			tenants = append(tenants, &models.Tenant{Name: name, ActivityStatus: physical.ActivityStatus()})
+		}
+		sort.Slice(tenants, func(i, j int) bool { return tenants[i].Name < tenants[j].Name })
+		out.Tenants[class.Class] = tenants
+	}
+	return out, nil
+}
+
+// ArchiveHead returns the head of the changes archived by node, which might
+// be a node of another cluster
+func (db *DB) ArchiveHead(ctx context.Context, backend modulecapabilities.BackupBackend,
+	node string,
+) (*backup.ArchiveHead, error) {
+	var head backup.ArchiveHead
+	if err := getArchiveObject(ctx, backend, path.Join(node, walArchiveHead), &head); err != nil {
+		return nil, err
+	}
+	return &head, nil
+}
+
+// ArchivedSchema returns the schema last shipped by node
+func (db *DB) ArchivedSchema(ctx context.Context, backend modulecapabilities.BackupBackend,
+	node string,
+) (*backup.ArchivedSchema, error) {
+	var s backup.ArchivedSchema
+	if err := getArchiveObject(ctx, backend, path.Join(node, walArchiveSchema), &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func getArchiveObject(ctx context.Context, backend modulecapabilities.BackupBackend,
+	key string, dest interface{},
+) error {
+	data, err := backend.GetObject(ctx, walArchiveID, key)
+	if err != nil {
+		return fmt.Errorf("get %s: %w", key, err)
+	}
+	if err := json.Unmarshal(data, dest); err != nil {
+		return fmt.Errorf("unmarshal %s: %w", key, err)
+	}
+	return nil
+}
+
+// ApplyArchivedChanges applies the changes archived by node after the given
+// time, which are usually the changes of another cluster. Objects are
+// stored in the shards this cluster assigns them to. Changes of classes
+// which do not exist and of tenants which are not active are skipped. It
+// returns the time of the last applied change, which is after if nothing
+// was applied, and the number of applied changes.
+func (db *DB) ApplyArchivedChanges(ctx context.Context, backend modulecapabilities.BackupBackend,
+	node string, after time.Time,
+) (time.Time, int, error) {
+	if after.IsZero() {
+		return after, 0, fmt.Errorf("start of the changes is required")
+	}
+
+	from := after.UnixNano()
+	seen := map[string]struct{}{}
+	var segments []walSegment
+	for _, day := range segmentDays(from, time.Now().UnixNano()) {
+		index, err := loadWALIndex(ctx, backend, node, day)
+		if err != nil {
+			return after, 0, err
+		}
+		for _, seg := range index {
+			if _, ok := seen[seg.Key]; ok || seg.Last <= from {
+				continue
+			}
+			seen[seg.Key] = struct{}{}
+			segments = append(segments, seg)
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i].First < segments[j].First })
+
+	last, applied, skipped := from, 0, 0
+	for _, seg := range segments {
+		data, err := backend.GetObject(ctx, walArchiveID, seg.Key)
+		if err != nil {
+			return time.Unix(0, last), applied, fmt.Errorf("get segment %s: %w", seg.Key, err)
+		}
+		err = decodeWALEntries(bytes.NewReader(data), func(e walEntry) error {
+			if e.Time <= last {
+				return nil
+			}
+			ok, err := db.followWALEntry(ctx, e)
+			if err != nil {
+				return fmt.Errorf("%s object %s: %w", e.Op, e.ID, err)
+			}
+			if ok {
+				applied++
+			} else {
+				skipped++
+			}
+			last = e.Time
+			return nil
+		})
+		if err != nil && !isTruncatedEntry(err) {
+			return time.Unix(0, last), applied, fmt.Errorf("apply segment %s: %w", seg.Key, err)
+		}
+	}
+
+	if applied+skipped > 0 {
+		db.logger.WithField("action", "wal_archive_follow").
+			WithField("node", node).
+			WithField("applied", applied).
+			WithField("skipped", skipped).
+			Debug("applied archived changes")
+	}
+	return time.Unix(0, last), applied, nil
+}
+
+// followWALEntry applies a change like a client request would, since the
+// shards of the archiving cluster are not the shards of this cluster
+func (db *DB) followWALEntry(ctx context.Context, e walEntry) (bool, error) {
+	idx := db.GetIndex(schema.ClassName(e.Class))
+	if idx == nil {
+		return false, nil
+	}
+
+	tenant := ""
+	if idx.partitioningEnabled {
+		// the shards of multi-tenant classes are their tenants
+		tenant = e.Shard
+		if shard, status := idx.getSchema.TenantShard(e.Class, tenant); shard == "" ||
+			status != models.TenantActivityStatusHOT {
+			return false, nil
+		}
+	}
+
+	switch e.Op {
+	case walOpPut:
+		obj, err := idx.objectFromBinary(e.Object)
+		if err != nil {
+			return false, err
+		}
+		obj.Object.Tenant = tenant
+		return true, idx.putObject(ctx, obj, nil)
+	case walOpDelete:
+		return true, idx.deleteObject(ctx, e.ID, nil, tenant)
+	default:
+		return false, fmt.Errorf("unknown operation %q", e.Op)
+	}
+}
@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestFollowWALArchive(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		backend   = &walBackend{offloadBackend{objects: map[string][]byte{}}}
+		a         = newWALArchive(t.TempDir(), logger)
+		db        = &DB{logger: logger, indices: map[string]*Index{}}
+		schemaKey = path.Join(walArchiveID, "node1", walArchiveSchema)
+	)
+	a.schema = func() (*backup.ArchivedSchema, error) {
+		return &backup.ArchivedSchema{Classes: []*models.Class{{Class: "Article"}}}, nil
+	}
+	require.Nil(t, a.start(backend, "node1", time.Hour))
+	defer a.shutdown(ctx)
+
+	before := time.Now()
+	a.record(walEntry{Op: walOpPut, Class: "Article", Shard: "s1", ID: "id1", Object: []byte("obj")})
+	a.record(walEntry{Op: walOpDelete, Class: "Article", Shard: "s1", ID: "id1"})
+	require.Nil(t, a.ship(ctx))
+
+	t.Run("head and schema", func(t *testing.T) {
+		head, err := db.ArchiveHead(ctx, backend, "node1")
+		require.Nil(t, err)
+		assert.True(t, head.ShippedAt.After(before))
+		assert.NotEmpty(t, head.SchemaVersion)
+
+		s, err := db.ArchivedSchema(ctx, backend, "node1")
+		require.Nil(t, err)
+		require.Len(t, s.Classes, 1)
+		assert.Equal(t, "Article", s.Classes[0].Class)
+
+		// the schema is only shipped again once it changes
+		delete(backend.objects, schemaKey)
+		require.Nil(t, a.ship(ctx))
+		assert.NotContains(t, backend.objects, schemaKey)
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		_, err := db.ArchiveHead(ctx, backend, "node2")
+		assert.ErrorAs(t, err, &backup.ErrNotFound{})
+	})
+
+	t.Run("apply without the class", func(t *testing.T) {
+		last, applied, err := db.ApplyArchivedChanges(ctx, backend, "node1", before)
+		require.Nil(t, err)
+		assert.Equal(t, 0, applied)
+		assert.True(t, last.After(before))
+
+		// nothing after the last change
+		again, applied, err := db.ApplyArchivedChanges(ctx, backend, "node1", last)
+		require.Nil(t, err)
+		assert.Equal(t, 0, applied)
+		assert.Equal(t, last, again)
+	})
+
+	t.Run("start is required", func(t *testing.T) {
+		_, _, err := db.ApplyArchivedChanges(ctx, backend, "node1", time.Time{})
+		assert.ErrorContains(t, err, "start")
+	})
+}
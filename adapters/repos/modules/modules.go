@@ -65,6 +65,10 @@ type storageBucket struct {
 	repo      *Repo
 }
 
+func (r *Repo) Close() error {
+	return r.db.Close()
+}
+
 func (r *Repo) Storage(bucketName string) (moduletools.Storage, error) {
 	storage := &storageBucket{
 		bucketKey: []byte(bucketName),
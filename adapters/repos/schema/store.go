@@ -44,6 +44,7 @@ const (
 	eTypeClass        byte = 2
 	eTypeShard        byte = 4
 	eTypeMeta         byte = 5
+	eTypeMetadata     byte = 6
 	eTypeSharingState byte = 15
 )
 
@@ -60,6 +61,7 @@ It ensures that each class has a dedicated bucket, which includes metadata, and
 Schema Structure:
   - Config: contains metadata related to parsing the schema
   - Nested buckets for each class
+  - Metadata of other usecases, one entry per key
 
 Schema Structure for a class Bucket:
   - Metadata contains models.Class
@@ -332,6 +334,24 @@ func (r *store) DeleteShards(_ context.Context, class string, shards []string) e
 	return r.db.Update(f)
 }
 
+// PutMetadata sets the value of a metadata key
+func (r *store) PutMetadata(_ context.Context, key string, value []byte) error {
+	f := func(tx *bolt.Tx) error {
+		return tx.Bucket(schemaBucket).Put(encodeMetadataKey(key), value)
+	}
+	return r.db.Update(f)
+}
+
+// DeleteMetadata deletes a metadata key
+//
+//	If the key does not exist then nothing is done and a nil error is returned
+func (r *store) DeleteMetadata(_ context.Context, key string) error {
+	f := func(tx *bolt.Tx) error {
+		return tx.Bucket(schemaBucket).Delete(encodeMetadataKey(key))
+	}
+	return r.db.Update(f)
+}
+
 // Load loads the complete schema from the persistent storage
 func (r *store) Load(ctx context.Context) (ucs.State, error) {
 	state := ucs.NewState(32)
@@ -363,9 +383,32 @@ func (r *store) Load(ctx context.Context) (ucs.State, error) {
 		state.ObjectSchema.Classes = append(state.ObjectSchema.Classes, &cls)
 		state.ShardingState[cls.Class] = &ss
 	}
+
+	metadata, err := r.loadMetadata()
+	if err != nil {
+		return state, fmt.Errorf("load metadata: %w", err)
+	}
+	state.Metadata = metadata
 	return state, nil
 }
 
+func (r *store) loadMetadata() (map[string][]byte, error) {
+	var metadata map[string][]byte
+	f := func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(schemaBucket).Cursor()
+		prefix := []byte{eTypeMetadata}
+		for key, value := cursor.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, value = cursor.Next() {
+			if metadata == nil {
+				metadata = map[string][]byte{}
+			}
+			// values are only valid for the life of the transaction
+			metadata[string(key[1:])] = append([]byte{}, value...)
+		}
+		return nil
+	}
+	return metadata, r.db.View(f)
+}
+
 func (r *store) load(ctx context.Context) <-chan ucs.ClassPayload {
 	ch := make(chan ucs.ClassPayload, 1)
 	f := func(tx *bolt.Tx) (err error) {
@@ -415,14 +458,12 @@ func (r *store) load(ctx context.Context) <-chan ucs.ClassPayload {
 
 // Save saves the complete schema to the persistent storage
 func (r *store) Save(ctx context.Context, ss ucs.State) error {
-	if (ss.ObjectSchema == nil || len(ss.ObjectSchema.Classes) == 0) &&
-		len(ss.ShardingState) == 0 {
+	noClasses := ss.ObjectSchema == nil || len(ss.ObjectSchema.Classes) == 0
+	if noClasses && len(ss.ShardingState) == 0 && len(ss.Metadata) == 0 {
 		return nil // empty schema nothing to store
 	}
 
-	if ss.ObjectSchema == nil ||
-		len(ss.ObjectSchema.Classes) == 0 ||
-		len(ss.ShardingState) == 0 {
+	if noClasses != (len(ss.ShardingState) == 0) {
 		return fmt.Errorf("inconsistent schema: missing required fields")
 	}
 
@@ -445,6 +486,12 @@ func (r *store) saveAllTx(ctx context.Context, root *bolt.Bucket, ss ucs.State)
 			}
 			cls, _ = rootCursor.Next()
 		}
+		if err := saveMetadata(root, ss.Metadata); err != nil {
+			return err
+		}
+		if ss.ObjectSchema == nil {
+			return nil
+		}
 		for _, cls := range ss.ObjectSchema.Classes {
 			if err := ctx.Err(); err != nil {
 				return fmt.Errorf("context for class %q: %w", cls.Class, err)
@@ -478,6 +525,27 @@ func saveConfig(root *bolt.Bucket, cfg config) error {
 	return nil
 }
 
+// saveMetadata replaces all metadata entries
+func saveMetadata(root *bolt.Bucket, metadata map[string][]byte) error {
+	var stale [][]byte
+	cursor := root.Cursor()
+	prefix := []byte{eTypeMetadata}
+	for key, _ := cursor.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, _ = cursor.Next() {
+		stale = append(stale, append([]byte{}, key...))
+	}
+	for _, key := range stale {
+		if err := root.Delete(key); err != nil {
+			return fmt.Errorf("delete metadata %q: %w", key[1:], err)
+		}
+	}
+	for key, value := range metadata {
+		if err := root.Put(encodeMetadataKey(key), value); err != nil {
+			return fmt.Errorf("write metadata %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
 func existShards(b *bolt.Bucket, shards []ucs.KeyValuePair, keyBuf []byte) bool {
 	keyBuf[0] = eTypeShard
 	for _, pair := range shards {
@@ -525,6 +593,10 @@ func encodeClassName(name string) []byte {
 	return buf[:len]
 }
 
+func encodeMetadataKey(key string) []byte {
+	return append([]byte{eTypeMetadata}, key...)
+}
+
 func copyFile(dst, src string) error {
 	data, err := os.ReadFile(src)
 	if err != nil {
@@ -255,6 +255,50 @@ func TestRepositoryUpdateShards(t *testing.T) {
 	}
 }
 
+func TestRepositoryMetadata(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		dirName   = t.TempDir()
+	)
+	repo, err := newRepo(dirName, -1, logger)
+	if err != nil {
+		t.Fatalf("create new repo: %v", err)
+	}
+
+	schema := ucs.NewState(1)
+	schema.Metadata = map[string][]byte{"roles/reader": []byte(`{"name":"reader"}`)}
+	if err := repo.Save(ctx, schema); err != nil {
+		t.Fatalf("save schema without classes: %v", err)
+	}
+	repo.asserEqualSchema(t, schema, "save metadata")
+
+	addClass(&schema, "C1", 0, 2, 1)
+	if err := repo.PutMetadata(ctx, "roles/writer", []byte(`{"name":"writer"}`)); err != nil {
+		t.Fatalf("put metadata: %v", err)
+	}
+	if err := repo.DeleteMetadata(ctx, "roles/reader"); err != nil {
+		t.Fatalf("delete metadata: %v", err)
+	}
+	if err := repo.DeleteMetadata(ctx, "roles/unknown"); err != nil {
+		t.Fatalf("delete unknown metadata: %v", err)
+	}
+	schema.Metadata = map[string][]byte{"roles/writer": []byte(`{"name":"writer"}`)}
+	payload, err := ucs.CreateClassPayload(schema.ObjectSchema.Classes[0], schema.ShardingState["C1"])
+	require.Nil(t, err)
+	if err := repo.NewClass(ctx, payload); err != nil {
+		t.Fatalf("new class: %v", err)
+	}
+	repo.asserEqualSchema(t, schema, "update metadata")
+
+	// saving the complete schema replaces the metadata
+	schema.Metadata = nil
+	if err := repo.Save(ctx, schema); err != nil {
+		t.Fatalf("save schema: %v", err)
+	}
+	repo.asserEqualSchema(t, schema, "replace metadata")
+}
+
 func createClass(name string, start, nProps, nShards int) (models.Class, sharding.State) {
 	cls := models.Class{Class: name}
 	for i := start; i < start+nProps; i++ {
@@ -114,7 +114,10 @@ func (s *Store) IterateAll(ctx context.Context,
 }
 
 func (s *Store) Close() error {
-	return nil
+	if s.db == nil {
+		return nil
+	}
+	return s.db.Close()
 }
 
 func initBoltDB(filePath string) (*bbolt.DB, error) {
@@ -41,15 +41,66 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	BatchIngestGet(params *BatchIngestGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchIngestGetOK, error)
+
 	BatchObjectsCreate(params *BatchObjectsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsCreateOK, error)
 
 	BatchObjectsDelete(params *BatchObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsDeleteOK, error)
 
 	BatchReferencesCreate(params *BatchReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchReferencesCreateOK, error)
 
+	ImportsCancel(params *ImportsCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsCancelNoContent, error)
+
+	ImportsCreate(params *ImportsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsCreateAccepted, error)
+
+	ImportsCsvUpload(params *ImportsCsvUploadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsCsvUploadAccepted, error)
+
+	ImportsGet(params *ImportsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsGetOK, error)
+
+	ImportsList(params *ImportsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsListOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+BatchIngestGet Returns the indexing lag of the ingest queue of the node serving the request. Clients can wait for an acknowledged batch to become visible to queries until appliedSeq reaches the acceptedSeq they observed after the batch.
+*/
+func (a *Client) BatchIngestGet(params *BatchIngestGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchIngestGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchIngestGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "batch.ingest.get",
+		Method:             "GET",
+		PathPattern:        "/batch/ingest",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchIngestGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchIngestGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batch.ingest.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 BatchObjectsCreate creates new objects based on a object template as a batch
 
@@ -173,6 +224,201 @@ func (a *Client) BatchReferencesCreate(params *BatchReferencesCreateParams, auth
 	panic(msg)
 }
 
+/*
+ImportsCancel Cancels an import job. Objects which were already imported are kept.
+*/
+func (a *Client) ImportsCancel(params *ImportsCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsCancelNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImportsCancelParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "imports.cancel",
+		Method:             "DELETE",
+		PathPattern:        "/imports/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ImportsCancelReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ImportsCancelNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for imports.cancel: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ImportsCreate Starts a job which imports files of objects from a backup backend into a class. The files are imported in the background one after another on the node which received the request, the job is not visible on other nodes.
+*/
+func (a *Client) ImportsCreate(params *ImportsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsCreateAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImportsCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "imports.create",
+		Method:             "POST",
+		PathPattern:        "/imports",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ImportsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ImportsCreateAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for imports.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ImportsCsvUpload Starts a job which imports an uploaded CSV file into a class. The columns of the file are the properties of the objects, auto schema creates the class or adds the properties of columns missing from it before the file is imported.
+*/
+func (a *Client) ImportsCsvUpload(params *ImportsCsvUploadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsCsvUploadAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImportsCsvUploadParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "imports.csv.upload",
+		Method:             "POST",
+		PathPattern:        "/imports:csv",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"multipart/form-data"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ImportsCsvUploadReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ImportsCsvUploadAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for imports.csv.upload: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ImportsGet Returns the progress of an import job per file.
+*/
+func (a *Client) ImportsGet(params *ImportsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImportsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "imports.get",
+		Method:             "GET",
+		PathPattern:        "/imports/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ImportsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ImportsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for imports.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ImportsList Lists the import jobs of the node serving the request.
+*/
+func (a *Client) ImportsList(params *ImportsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportsListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImportsListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "imports.list",
+		Method:             "GET",
+		PathPattern:        "/imports",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ImportsListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ImportsListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for imports.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
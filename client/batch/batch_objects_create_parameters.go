@@ -72,6 +72,12 @@ BatchObjectsCreateParams contains all the parameters to send to the API endpoint
 */
 type BatchObjectsCreateParams struct {
 
+	/* IdempotencyKey.
+
+	   Makes retries of the request safe. The response to the first request with a key is kept and returned to retries with the same key and body instead of writing the objects again.
+	*/
+	IdempotencyKey *string
+
 	// Body.
 	Body BatchObjectsCreateBody
 
@@ -134,6 +140,17 @@ func (o *BatchObjectsCreateParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIdempotencyKey adds the idempotencyKey to the batch objects create params
+func (o *BatchObjectsCreateParams) WithIdempotencyKey(idempotencyKey *string) *BatchObjectsCreateParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the batch objects create params
+func (o *BatchObjectsCreateParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
 // WithBody adds the body to the batch objects create params
 func (o *BatchObjectsCreateParams) WithBody(body BatchObjectsCreateBody) *BatchObjectsCreateParams {
 	o.SetBody(body)
@@ -163,6 +180,14 @@ func (o *BatchObjectsCreateParams) WriteToRequest(r runtime.ClientRequest, reg s
 		return err
 	}
 	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
 	if err := r.SetBodyParam(o.Body); err != nil {
 		return err
 	}
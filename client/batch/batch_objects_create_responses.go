@@ -64,6 +64,12 @@ func (o *BatchObjectsCreateReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewBatchObjectsCreateConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewBatchObjectsCreateUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -92,6 +98,11 @@ BatchObjectsCreateOK describes a response with status code 200, with default hea
 Request succeeded, see response body to get detailed information about each batched item.
 */
 type BatchObjectsCreateOK struct {
+
+	/* Set to true if the response is the kept response to an earlier request with the same Idempotency-Key
+	 */
+	IdempotentReplayed string
+
 	Payload []*models.ObjectsGetResponse
 }
 
@@ -139,6 +150,13 @@ func (o *BatchObjectsCreateOK) GetPayload() []*models.ObjectsGetResponse {
 
 func (o *BatchObjectsCreateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header Idempotent-Replayed
+	hdrIdempotentReplayed := response.GetHeader("Idempotent-Replayed")
+
+	if hdrIdempotentReplayed != "" {
+		o.IdempotentReplayed = hdrIdempotentReplayed
+	}
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
@@ -339,6 +357,74 @@ func (o *BatchObjectsCreateForbidden) readResponse(response runtime.ClientRespon
 	return nil
 }
 
+// NewBatchObjectsCreateConflict creates a BatchObjectsCreateConflict with default headers values
+func NewBatchObjectsCreateConflict() *BatchObjectsCreateConflict {
+	return &BatchObjectsCreateConflict{}
+}
+
+/*
+BatchObjectsCreateConflict describes a response with status code 409, with default header values.
+
+A request with the same Idempotency-Key is still in progress
+*/
+type BatchObjectsCreateConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this batch objects create conflict response has a 2xx status code
+func (o *BatchObjectsCreateConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this batch objects create conflict response has a 3xx status code
+func (o *BatchObjectsCreateConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this batch objects create conflict response has a 4xx status code
+func (o *BatchObjectsCreateConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this batch objects create conflict response has a 5xx status code
+func (o *BatchObjectsCreateConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this batch objects create conflict response a status code equal to that given
+func (o *BatchObjectsCreateConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the batch objects create conflict response
+func (o *BatchObjectsCreateConflict) Code() int {
+	return 409
+}
+
+func (o *BatchObjectsCreateConflict) Error() string {
+	return fmt.Sprintf("[POST /batch/objects][%d] batchObjectsCreateConflict  %+v", 409, o.Payload)
+}
+
+func (o *BatchObjectsCreateConflict) String() string {
+	return fmt.Sprintf("[POST /batch/objects][%d] batchObjectsCreateConflict  %+v", 409, o.Payload)
+}
+
+func (o *BatchObjectsCreateConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchObjectsCreateConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewBatchObjectsCreateUnprocessableEntity creates a BatchObjectsCreateUnprocessableEntity with default headers values
 func NewBatchObjectsCreateUnprocessableEntity() *BatchObjectsCreateUnprocessableEntity {
 	return &BatchObjectsCreateUnprocessableEntity{}
@@ -347,7 +433,7 @@ func NewBatchObjectsCreateUnprocessableEntity() *BatchObjectsCreateUnprocessable
 /*
 BatchObjectsCreateUnprocessableEntity describes a response with status code 422, with default header values.
 
-Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file? Also returned if the Idempotency-Key was used before with a different body.
 */
 type BatchObjectsCreateUnprocessableEntity struct {
 	Payload *models.ErrorResponse
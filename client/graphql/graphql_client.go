@@ -43,8 +43,20 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	GraphqlBatch(params *GraphqlBatchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlBatchOK, error)
 
+	GraphqlExplain(params *GraphqlExplainParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlExplainOK, error)
+
 	GraphqlPost(params *GraphqlPostParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlPostOK, error)
 
+	GraphqlTemplatesDelete(params *GraphqlTemplatesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlTemplatesDeleteNoContent, error)
+
+	GraphqlTemplatesExecute(params *GraphqlTemplatesExecuteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlTemplatesExecuteOK, error)
+
+	GraphqlTemplatesGet(params *GraphqlTemplatesGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlTemplatesGetOK, error)
+
+	GraphqlTemplatesList(params *GraphqlTemplatesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlTemplatesListOK, error)
+
+	GraphqlTemplatesPut(params *GraphqlTemplatesPutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlTemplatesPutOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -89,6 +101,45 @@ func (a *Client) GraphqlBatch(params *GraphqlBatchParams, authInfo runtime.Clien
 	panic(msg)
 }
 
+/*
+GraphqlExplain Plans the Get queries of a GraphQL request without executing them. The filters of the queries are evaluated to count the objects matching them, the vector and keyword searches are not executed and no results are returned.
+*/
+func (a *Client) GraphqlExplain(params *GraphqlExplainParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlExplainOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGraphqlExplainParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "graphql.explain",
+		Method:             "POST",
+		PathPattern:        "/graphql/explain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GraphqlExplainReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GraphqlExplainOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for graphql.explain: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GraphqlPost gets a response based on graph q l
 
@@ -130,6 +181,201 @@ func (a *Client) GraphqlPost(params *GraphqlPostParams, authInfo runtime.ClientA
 	panic(msg)
 }
 
+/*
+GraphqlTemplatesDelete Deletes a query template.
+*/
+func (a *Client) GraphqlTemplatesDelete(params *GraphqlTemplatesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlTemplatesDeleteNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGraphqlTemplatesDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "graphql.templates.delete",
+		Method:             "DELETE",
+		PathPattern:        "/templates/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GraphqlTemplatesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GraphqlTemplatesDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for graphql.templates.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GraphqlTemplatesExecute Executes a query template with the given variables, the defaults of the template are used for the variables which are not set. The response is the same as the response of /graphql.
+*/
+func (a *Client) GraphqlTemplatesExecute(params *GraphqlTemplatesExecuteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlTemplatesExecuteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGraphqlTemplatesExecuteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "graphql.templates.execute",
+		Method:             "POST",
+		PathPattern:        "/templates/{name}/execute",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GraphqlTemplatesExecuteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GraphqlTemplatesExecuteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for graphql.templates.execute: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GraphqlTemplatesGet Returns a query template.
+*/
+func (a *Client) GraphqlTemplatesGet(params *GraphqlTemplatesGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlTemplatesGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGraphqlTemplatesGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "graphql.templates.get",
+		Method:             "GET",
+		PathPattern:        "/templates/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GraphqlTemplatesGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GraphqlTemplatesGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for graphql.templates.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GraphqlTemplatesList Lists the query templates, which are GraphQL queries stored under a name.
+*/
+func (a *Client) GraphqlTemplatesList(params *GraphqlTemplatesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlTemplatesListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGraphqlTemplatesListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "graphql.templates.list",
+		Method:             "GET",
+		PathPattern:        "/templates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GraphqlTemplatesListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GraphqlTemplatesListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for graphql.templates.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GraphqlTemplatesPut Creates or replaces a query template. The variables declared by its query are derived from the query.
+*/
+func (a *Client) GraphqlTemplatesPut(params *GraphqlTemplatesPutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlTemplatesPutOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGraphqlTemplatesPutParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "graphql.templates.put",
+		Method:             "PUT",
+		PathPattern:        "/templates/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GraphqlTemplatesPutReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GraphqlTemplatesPutOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for graphql.templates.put: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
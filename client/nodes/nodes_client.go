@@ -41,13 +41,181 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ConfigUpdate(params *ConfigUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ConfigUpdateOK, error)
+
+	NodesDrainCreate(params *NodesDrainCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDrainCreateAccepted, error)
+
+	NodesDrainDelete(params *NodesDrainDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDrainDeleteOK, error)
+
+	NodesDrainGet(params *NodesDrainGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDrainGetOK, error)
+
 	NodesGet(params *NodesGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetOK, error)
 
 	NodesGetClass(params *NodesGetClassParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetClassOK, error)
 
+	StartupGet(params *StartupGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*StartupGetOK, error)
+
+	StartupPriorityUpdate(params *StartupPriorityUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*StartupPriorityUpdateOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+ConfigUpdate Changes settings of the node serving the request at runtime, like sending SIGHUP to it. The body is a partial configuration with the same keys as the configuration file, e.g. `{"log_level": "debug"}`. The response lists the changed settings which were applied and those which require a restart.
+*/
+func (a *Client) ConfigUpdate(params *ConfigUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ConfigUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewConfigUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "config.update",
+		Method:             "PUT",
+		PathPattern:        "/config",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ConfigUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ConfigUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for config.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesDrainCreate Stops placing shards on a node and moves its replicas to the other nodes. The node can be removed safely once no replicas are left on it.
+*/
+func (a *Client) NodesDrainCreate(params *NodesDrainCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDrainCreateAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesDrainCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.drain.create",
+		Method:             "POST",
+		PathPattern:        "/nodes/{nodeName}/drain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesDrainCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesDrainCreateAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.drain.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesDrainDelete Places shards on a drained node again. Replicas which were already moved stay on their new nodes.
+*/
+func (a *Client) NodesDrainDelete(params *NodesDrainDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDrainDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesDrainDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.drain.delete",
+		Method:             "DELETE",
+		PathPattern:        "/nodes/{nodeName}/drain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesDrainDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesDrainDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.drain.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesDrainGet Returns the replicas left on a node and whether the node can be removed safely.
+*/
+func (a *Client) NodesDrainGet(params *NodesDrainGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDrainGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesDrainGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.drain.get",
+		Method:             "GET",
+		PathPattern:        "/nodes/{nodeName}/drain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesDrainGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesDrainGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.drain.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 NodesGet Returns status of Weaviate DB.
 */
@@ -126,6 +294,84 @@ func (a *Client) NodesGetClass(params *NodesGetClassParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+StartupGet Returns the progress of loading the shards of the node serving the request with an estimate of the remaining time, so that a slow restart can be told apart from a hung one.
+*/
+func (a *Client) StartupGet(params *StartupGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*StartupGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewStartupGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "startup.get",
+		Method:             "GET",
+		PathPattern:        "/startup",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &StartupGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*StartupGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for startup.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+StartupPriorityUpdate Loads the shards of the given classes before the shards of the other classes which are still pending.
+*/
+func (a *Client) StartupPriorityUpdate(params *StartupPriorityUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*StartupPriorityUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewStartupPriorityUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "startup.priority.update",
+		Method:             "PUT",
+		PathPattern:        "/startup/priority",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &StartupPriorityUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*StartupPriorityUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for startup.priority.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
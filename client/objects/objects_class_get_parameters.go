@@ -81,6 +81,12 @@ type ObjectsClassGetParams struct {
 	*/
 	ConsistencyLevel *string
 
+	/* Fields.
+
+	   Comma separated names of the properties to return, all properties are returned if not set. Vectors are only returned if they are included with the include parameter.
+	*/
+	Fields *string
+
 	/* ID.
 
 	   Unique ID of the Object.
@@ -182,6 +188,17 @@ func (o *ObjectsClassGetParams) SetConsistencyLevel(consistencyLevel *string) {
 	o.ConsistencyLevel = consistencyLevel
 }
 
+// WithFields adds the fields to the objects class get params
+func (o *ObjectsClassGetParams) WithFields(fields *string) *ObjectsClassGetParams {
+	o.SetFields(fields)
+	return o
+}
+
+// SetFields adds the fields to the objects class get params
+func (o *ObjectsClassGetParams) SetFields(fields *string) {
+	o.Fields = fields
+}
+
 // WithID adds the id to the objects class get params
 func (o *ObjectsClassGetParams) WithID(id strfmt.UUID) *ObjectsClassGetParams {
 	o.SetID(id)
@@ -256,6 +273,23 @@ func (o *ObjectsClassGetParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		}
 	}
 
+	if o.Fields != nil {
+
+		// query param fields
+		var qrFields string
+
+		if o.Fields != nil {
+			qrFields = *o.Fields
+		}
+		qFields := qrFields
+		if qFields != "" {
+
+			if err := r.SetQueryParam("fields", qFields); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", o.ID.String()); err != nil {
 		return err
@@ -18,6 +18,7 @@ package objects
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
@@ -57,10 +58,20 @@ type ClientService interface {
 
 	ObjectsClassReferencesPut(params *ObjectsClassReferencesPutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassReferencesPutOK, error)
 
+	ObjectsClassVersionsDiff(params *ObjectsClassVersionsDiffParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassVersionsDiffOK, error)
+
+	ObjectsClassVersionsList(params *ObjectsClassVersionsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassVersionsListOK, error)
+
+	ObjectsClassVersionsRollback(params *ObjectsClassVersionsRollbackParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassVersionsRollbackOK, error)
+
 	ObjectsCreate(params *ObjectsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsCreateOK, error)
 
 	ObjectsDelete(params *ObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsDeleteNoContent, error)
 
+	ObjectsDuplicates(params *ObjectsDuplicatesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsDuplicatesOK, error)
+
+	ObjectsExport(params *ObjectsExportParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ObjectsExportOK, error)
+
 	ObjectsGet(params *ObjectsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsGetOK, error)
 
 	ObjectsHead(params *ObjectsHeadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsHeadNoContent, error)
@@ -75,10 +86,20 @@ type ClientService interface {
 
 	ObjectsReferencesUpdate(params *ObjectsReferencesUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsReferencesUpdateOK, error)
 
+	ObjectsTransaction(params *ObjectsTransactionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsTransactionOK, error)
+
 	ObjectsUpdate(params *ObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsUpdateOK, error)
 
+	ObjectsUpload(params *ObjectsUploadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsUploadOK, error)
+
 	ObjectsValidate(params *ObjectsValidateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsValidateOK, error)
 
+	ObjectsVersionsDiff(params *ObjectsVersionsDiffParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsVersionsDiffOK, error)
+
+	ObjectsVersionsList(params *ObjectsVersionsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsVersionsListOK, error)
+
+	ObjectsVersionsRollback(params *ObjectsVersionsRollbackParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsVersionsRollbackOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -410,6 +431,123 @@ func (a *Client) ObjectsClassReferencesPut(params *ObjectsClassReferencesPutPara
 	panic(msg)
 }
 
+/*
+ObjectsClassVersionsDiff Lists the properties which differ between two versions of an object.
+*/
+func (a *Client) ObjectsClassVersionsDiff(params *ObjectsClassVersionsDiffParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassVersionsDiffOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsClassVersionsDiffParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.class.versions.diff",
+		Method:             "GET",
+		PathPattern:        "/objects/{className}/{id}/versions/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsClassVersionsDiffReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsClassVersionsDiffOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.class.versions.diff: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ObjectsClassVersionsList Lists the kept versions of an object of a class with versioning enabled, newest first.
+*/
+func (a *Client) ObjectsClassVersionsList(params *ObjectsClassVersionsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassVersionsListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsClassVersionsListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.class.versions.list",
+		Method:             "GET",
+		PathPattern:        "/objects/{className}/{id}/versions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsClassVersionsListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsClassVersionsListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.class.versions.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ObjectsClassVersionsRollback Replaces an object with one of its kept versions. The rollback itself is kept as a new version.
+*/
+func (a *Client) ObjectsClassVersionsRollback(params *ObjectsClassVersionsRollbackParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassVersionsRollbackOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsClassVersionsRollbackParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.class.versions.rollback",
+		Method:             "POST",
+		PathPattern:        "/objects/{className}/{id}/versions/{version}/rollback",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsClassVersionsRollbackReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsClassVersionsRollbackOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.class.versions.rollback: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsCreate creates objects between two objects object and subject
 
@@ -492,6 +630,84 @@ func (a *Client) ObjectsDelete(params *ObjectsDeleteParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+ObjectsDuplicates Finds groups of near-duplicate objects of a class and optionally merges or deletes the duplicates. Without an action the groups are only reported. The objects of the shards on the node serving the request are compared.
+*/
+func (a *Client) ObjectsDuplicates(params *ObjectsDuplicatesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsDuplicatesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsDuplicatesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.duplicates",
+		Method:             "POST",
+		PathPattern:        "/objects:duplicates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsDuplicatesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsDuplicatesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.duplicates: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ObjectsExport Streams all objects of a class including their vectors as JSONL or Parquet, so a class can be copied into a data lake without paginating through the objects. The objects are ordered by id. The trailers `X-Weaviate-Export-Cursor` and `X-Weaviate-Export-Done` of the response contain the id of the last exported object and whether the class was exported completely, the next export continues after that id. An interrupted JSONL export can be resumed after the last complete line.
+*/
+func (a *Client) ObjectsExport(params *ObjectsExportParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ObjectsExportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsExportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.export",
+		Method:             "GET",
+		PathPattern:        "/objects:export",
+		ProducesMediaTypes: []string{"application/json", "application/vnd.apache.parquet", "application/x-ndjson"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsExportReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsExportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.export: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsGet gets a specific object based on its UUID and a object UUID also available as websocket bus
 
@@ -779,6 +995,45 @@ func (a *Client) ObjectsReferencesUpdate(params *ObjectsReferencesUpdateParams,
 	panic(msg)
 }
 
+/*
+ObjectsTransaction Applies several object writes all-or-nothing. If an operation fails, the operations before it are rolled back. This is atomic if all operations fall on one shard and best-effort otherwise, the response tells which applied.
+*/
+func (a *Client) ObjectsTransaction(params *ObjectsTransactionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsTransactionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsTransactionParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.transaction",
+		Method:             "POST",
+		PathPattern:        "/transactions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsTransactionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsTransactionOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.transaction: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsUpdate updates an object based on its UUID
 
@@ -820,6 +1075,45 @@ func (a *Client) ObjectsUpdate(params *ObjectsUpdateParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+ObjectsUpload Creates an object of a class from every uploaded file. The text and metadata of the files are extracted by the enabled File2Text module, which maps them to the properties of the class. More files are uploaded by repeating the field file, all files of an upload are limited to 64 MiB. A file which can't be extracted or stored is reported in the response without failing the other files.
+*/
+func (a *Client) ObjectsUpload(params *ObjectsUploadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsUploadOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsUploadParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.upload",
+		Method:             "POST",
+		PathPattern:        "/objects:upload",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"multipart/form-data"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsUploadReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsUploadOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.upload: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsValidate validates an object based on a schema
 
@@ -861,6 +1155,123 @@ func (a *Client) ObjectsValidate(params *ObjectsValidateParams, authInfo runtime
 	panic(msg)
 }
 
+/*
+ObjectsVersionsDiff Lists the properties which differ between two versions of an object. The object is looked up by its id only, this is deprecated in favor of the class-specific endpoint.
+*/
+func (a *Client) ObjectsVersionsDiff(params *ObjectsVersionsDiffParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsVersionsDiffOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsVersionsDiffParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.versions.diff",
+		Method:             "GET",
+		PathPattern:        "/objects/{id}/versions/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsVersionsDiffReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsVersionsDiffOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.versions.diff: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ObjectsVersionsList Lists the kept versions of an object of a class with versioning enabled, newest first. The object is looked up by its id only, this is deprecated in favor of the class-specific endpoint.
+*/
+func (a *Client) ObjectsVersionsList(params *ObjectsVersionsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsVersionsListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsVersionsListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.versions.list",
+		Method:             "GET",
+		PathPattern:        "/objects/{id}/versions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsVersionsListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsVersionsListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.versions.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ObjectsVersionsRollback Replaces an object with one of its kept versions. The rollback itself is kept as a new version. The object is looked up by its id only, this is deprecated in favor of the class-specific endpoint.
+*/
+func (a *Client) ObjectsVersionsRollback(params *ObjectsVersionsRollbackParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsVersionsRollbackOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsVersionsRollbackParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.versions.rollback",
+		Method:             "POST",
+		PathPattern:        "/objects/{id}/versions/{version}/rollback",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsVersionsRollbackReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsVersionsRollbackOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.versions.rollback: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
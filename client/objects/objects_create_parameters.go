@@ -74,6 +74,12 @@ ObjectsCreateParams contains all the parameters to send to the API endpoint
 */
 type ObjectsCreateParams struct {
 
+	/* IdempotencyKey.
+
+	   Makes retries of the request safe. The response to the first request with a key is kept and returned to retries with the same key and body instead of writing the objects again.
+	*/
+	IdempotencyKey *string
+
 	// Body.
 	Body *models.Object
 
@@ -136,6 +142,17 @@ func (o *ObjectsCreateParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIdempotencyKey adds the idempotencyKey to the objects create params
+func (o *ObjectsCreateParams) WithIdempotencyKey(idempotencyKey *string) *ObjectsCreateParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the objects create params
+func (o *ObjectsCreateParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
 // WithBody adds the body to the objects create params
 func (o *ObjectsCreateParams) WithBody(body *models.Object) *ObjectsCreateParams {
 	o.SetBody(body)
@@ -165,6 +182,14 @@ func (o *ObjectsCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		return err
 	}
 	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
 	if o.Body != nil {
 		if err := r.SetBodyParam(o.Body); err != nil {
 			return err
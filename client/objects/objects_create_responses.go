@@ -58,6 +58,12 @@ func (o *ObjectsCreateReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewObjectsCreateConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewObjectsCreateUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -86,6 +92,11 @@ ObjectsCreateOK describes a response with status code 200, with default header v
 Object created.
 */
 type ObjectsCreateOK struct {
+
+	/* Set to true if the response is the kept response to an earlier request with the same Idempotency-Key
+	 */
+	IdempotentReplayed string
+
 	Payload *models.Object
 }
 
@@ -133,6 +144,13 @@ func (o *ObjectsCreateOK) GetPayload() *models.Object {
 
 func (o *ObjectsCreateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header Idempotent-Replayed
+	hdrIdempotentReplayed := response.GetHeader("Idempotent-Replayed")
+
+	if hdrIdempotentReplayed != "" {
+		o.IdempotentReplayed = hdrIdempotentReplayed
+	}
+
 	o.Payload = new(models.Object)
 
 	// response payload
@@ -335,6 +353,74 @@ func (o *ObjectsCreateForbidden) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewObjectsCreateConflict creates a ObjectsCreateConflict with default headers values
+func NewObjectsCreateConflict() *ObjectsCreateConflict {
+	return &ObjectsCreateConflict{}
+}
+
+/*
+ObjectsCreateConflict describes a response with status code 409, with default header values.
+
+A request with the same Idempotency-Key is still in progress
+*/
+type ObjectsCreateConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects create conflict response has a 2xx status code
+func (o *ObjectsCreateConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects create conflict response has a 3xx status code
+func (o *ObjectsCreateConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects create conflict response has a 4xx status code
+func (o *ObjectsCreateConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects create conflict response has a 5xx status code
+func (o *ObjectsCreateConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects create conflict response a status code equal to that given
+func (o *ObjectsCreateConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the objects create conflict response
+func (o *ObjectsCreateConflict) Code() int {
+	return 409
+}
+
+func (o *ObjectsCreateConflict) Error() string {
+	return fmt.Sprintf("[POST /objects][%d] objectsCreateConflict  %+v", 409, o.Payload)
+}
+
+func (o *ObjectsCreateConflict) String() string {
+	return fmt.Sprintf("[POST /objects][%d] objectsCreateConflict  %+v", 409, o.Payload)
+}
+
+func (o *ObjectsCreateConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsCreateConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsCreateUnprocessableEntity creates a ObjectsCreateUnprocessableEntity with default headers values
 func NewObjectsCreateUnprocessableEntity() *ObjectsCreateUnprocessableEntity {
 	return &ObjectsCreateUnprocessableEntity{}
@@ -343,7 +429,7 @@ func NewObjectsCreateUnprocessableEntity() *ObjectsCreateUnprocessableEntity {
 /*
 ObjectsCreateUnprocessableEntity describes a response with status code 422, with default header values.
 
-Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file? Also returned if the Idempotency-Key was used before with a different body.
 */
 type ObjectsCreateUnprocessableEntity struct {
 	Payload *models.ErrorResponse
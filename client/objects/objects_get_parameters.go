@@ -72,6 +72,12 @@ ObjectsGetParams contains all the parameters to send to the API endpoint
 */
 type ObjectsGetParams struct {
 
+	/* Fields.
+
+	   Comma separated names of the properties to return, all properties are returned if not set. Vectors are only returned if they are included with the include parameter.
+	*/
+	Fields *string
+
 	/* ID.
 
 	   Unique ID of the Object.
@@ -139,6 +145,17 @@ func (o *ObjectsGetParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithFields adds the fields to the objects get params
+func (o *ObjectsGetParams) WithFields(fields *string) *ObjectsGetParams {
+	o.SetFields(fields)
+	return o
+}
+
+// SetFields adds the fields to the objects get params
+func (o *ObjectsGetParams) SetFields(fields *string) {
+	o.Fields = fields
+}
+
 // WithID adds the id to the objects get params
 func (o *ObjectsGetParams) WithID(id strfmt.UUID) *ObjectsGetParams {
 	o.SetID(id)
@@ -169,6 +186,23 @@ func (o *ObjectsGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 	}
 	var res []error
 
+	if o.Fields != nil {
+
+		// query param fields
+		var qrFields string
+
+		if o.Fields != nil {
+			qrFields = *o.Fields
+		}
+		qFields := qrFields
+		if qFields != "" {
+
+			if err := r.SetQueryParam("fields", qFields); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", o.ID.String()); err != nil {
 		return err
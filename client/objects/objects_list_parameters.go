@@ -85,6 +85,12 @@ type ObjectsListParams struct {
 	*/
 	Class *string
 
+	/* Fields.
+
+	   Comma separated names of the properties to return, all properties are returned if not set. Vectors are only returned if they are included with the include parameter.
+	*/
+	Fields *string
+
 	/* Include.
 
 	   Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation
@@ -211,6 +217,17 @@ func (o *ObjectsListParams) SetClass(class *string) {
 	o.Class = class
 }
 
+// WithFields adds the fields to the objects list params
+func (o *ObjectsListParams) WithFields(fields *string) *ObjectsListParams {
+	o.SetFields(fields)
+	return o
+}
+
+// SetFields adds the fields to the objects list params
+func (o *ObjectsListParams) SetFields(fields *string) {
+	o.Fields = fields
+}
+
 // WithInclude adds the include to the objects list params
 func (o *ObjectsListParams) WithInclude(include *string) *ObjectsListParams {
 	o.SetInclude(include)
@@ -319,6 +336,23 @@ func (o *ObjectsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		}
 	}
 
+	if o.Fields != nil {
+
+		// query param fields
+		var qrFields string
+
+		if o.Fields != nil {
+			qrFields = *o.Fields
+		}
+		qFields := qrFields
+		if qFields != "" {
+
+			if err := r.SetQueryParam("fields", qFields); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Include != nil {
 
 		// query param include
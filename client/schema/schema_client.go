@@ -18,6 +18,7 @@ package schema
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
@@ -53,6 +54,10 @@ type ClientService interface {
 
 	SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesAddOK, error)
 
+	SchemaObjectsRebalanceGet(params *SchemaObjectsRebalanceGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRebalanceGetOK, error)
+
+	SchemaObjectsReshardGet(params *SchemaObjectsReshardGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsReshardGetOK, error)
+
 	SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error)
 
 	SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error)
@@ -63,8 +68,20 @@ type ClientService interface {
 
 	TenantsDelete(params *TenantsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsDeleteOK, error)
 
+	TenantsExport(params *TenantsExportParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*TenantsExportOK, error)
+
 	TenantsGet(params *TenantsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsGetOK, error)
 
+	TenantsImport(params *TenantsImportParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsImportOK, error)
+
+	TenantsJobsCreate(params *TenantsJobsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsJobsCreateAccepted, error)
+
+	TenantsJobsGet(params *TenantsJobsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsJobsGetOK, error)
+
+	TenantsJobsList(params *TenantsJobsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsJobsListOK, error)
+
+	TenantsStatsGet(params *TenantsStatsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsStatsGetOK, error)
+
 	TenantsUpdate(params *TenantsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsUpdateOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -304,6 +321,84 @@ func (a *Client) SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddPa
 	panic(msg)
 }
 
+/*
+SchemaObjectsRebalanceGet Returns the progress of the latest change of the replication factor of a class, which is started by updating the class. It is only known to the node which received the update.
+*/
+func (a *Client) SchemaObjectsRebalanceGet(params *SchemaObjectsRebalanceGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRebalanceGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsRebalanceGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.rebalance.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/rebalance",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsRebalanceGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsRebalanceGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.rebalance.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsReshardGet Returns the progress of the latest increase of the shard count of a class, which is started by updating the class. It is only known to the node which received the update.
+*/
+func (a *Client) SchemaObjectsReshardGet(params *SchemaObjectsReshardGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsReshardGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsReshardGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.reshard.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/reshard",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsReshardGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsReshardGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.reshard.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsShardsGet gets the shards status of an object class
 */
@@ -501,6 +596,45 @@ func (a *Client) TenantsDelete(params *TenantsDeleteParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+TenantsExport Exports all objects of a tenant including their vectors as a gzip compressed stream of JSON documents. The first document is a header containing the class, followed by one document per object. The indexes are not exported, they are rebuilt when the tenant is imported.
+*/
+func (a *Client) TenantsExport(params *TenantsExportParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*TenantsExportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsExportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.export",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/tenants/{tenantName}/export",
+		ProducesMediaTypes: []string{"application/gzip", "application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsExportReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsExportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.export: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 TenantsGet get all tenants from a specific class
 */
@@ -540,6 +674,201 @@ func (a *Client) TenantsGet(params *TenantsGetParams, authInfo runtime.ClientAut
 	panic(msg)
 }
 
+/*
+TenantsImport Imports a tenant export into an existing tenant. The objects are imported like a batch, so their vector and inverted indexes are rebuilt.
+*/
+func (a *Client) TenantsImport(params *TenantsImportParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsImportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsImportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.import",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/tenants/{tenantName}/import",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/gzip"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsImportReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsImportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.import: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsJobsCreate Starts a job which creates, activates, deactivates or deletes many tenants of a class at once. The tenants are changed in the background in chunks, tenants which cannot be changed are reported as failed by the job.
+*/
+func (a *Client) TenantsJobsCreate(params *TenantsJobsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsJobsCreateAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsJobsCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.jobs.create",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/tenants/jobs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsJobsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsJobsCreateAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.jobs.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsJobsGet Returns the progress of a tenants job. Jobs are kept in memory of the node which received the request and can be looked up for 24 hours after they finished.
+*/
+func (a *Client) TenantsJobsGet(params *TenantsJobsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsJobsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsJobsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.jobs.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/tenants/jobs/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsJobsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsJobsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.jobs.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsJobsList Lists the tenants jobs of a class which are known to the node serving the request.
+*/
+func (a *Client) TenantsJobsList(params *TenantsJobsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsJobsListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsJobsListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.jobs.list",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/tenants/jobs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsJobsListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsJobsListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.jobs.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsStatsGet Returns the object count and disk usage of a tenant.
+*/
+func (a *Client) TenantsStatsGet(params *TenantsStatsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsStatsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsStatsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.stats.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/tenants/{tenantName}/stats",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsStatsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsStatsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.stats.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 TenantsUpdate Update tenant of a specific class
 */
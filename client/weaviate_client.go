@@ -21,15 +21,23 @@ import (
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/weaviate/weaviate/client/apikeys"
+	"github.com/weaviate/weaviate/client/ask"
+	"github.com/weaviate/weaviate/client/authz"
 	"github.com/weaviate/weaviate/client/backups"
 	"github.com/weaviate/weaviate/client/batch"
 	"github.com/weaviate/weaviate/client/classifications"
+	"github.com/weaviate/weaviate/client/cluster"
+	"github.com/weaviate/weaviate/client/debug"
 	"github.com/weaviate/weaviate/client/graphql"
 	"github.com/weaviate/weaviate/client/meta"
+	"github.com/weaviate/weaviate/client/modules"
 	"github.com/weaviate/weaviate/client/nodes"
 	"github.com/weaviate/weaviate/client/objects"
 	"github.com/weaviate/weaviate/client/operations"
+	"github.com/weaviate/weaviate/client/replication"
 	"github.com/weaviate/weaviate/client/schema"
+	"github.com/weaviate/weaviate/client/sql"
 	"github.com/weaviate/weaviate/client/well_known"
 )
 
@@ -75,15 +83,23 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Weaviate {
 
 	cli := new(Weaviate)
 	cli.Transport = transport
+	cli.Apikeys = apikeys.New(transport, formats)
+	cli.Ask = ask.New(transport, formats)
+	cli.Authz = authz.New(transport, formats)
 	cli.Backups = backups.New(transport, formats)
 	cli.Batch = batch.New(transport, formats)
 	cli.Classifications = classifications.New(transport, formats)
+	cli.Cluster = cluster.New(transport, formats)
+	cli.Debug = debug.New(transport, formats)
 	cli.Graphql = graphql.New(transport, formats)
 	cli.Meta = meta.New(transport, formats)
+	cli.Modules = modules.New(transport, formats)
 	cli.Nodes = nodes.New(transport, formats)
 	cli.Objects = objects.New(transport, formats)
 	cli.Operations = operations.New(transport, formats)
+	cli.Replication = replication.New(transport, formats)
 	cli.Schema = schema.New(transport, formats)
+	cli.SQL = sql.New(transport, formats)
 	cli.WellKnown = well_known.New(transport, formats)
 	return cli
 }
@@ -129,24 +145,40 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 
 // Weaviate is a client for weaviate
 type Weaviate struct {
+	Apikeys apikeys.ClientService
+
+	Ask ask.ClientService
+
+	Authz authz.ClientService
+
 	Backups backups.ClientService
 
 	Batch batch.ClientService
 
 	Classifications classifications.ClientService
 
+	Cluster cluster.ClientService
+
+	Debug debug.ClientService
+
 	Graphql graphql.ClientService
 
 	Meta meta.ClientService
 
+	Modules modules.ClientService
+
 	Nodes nodes.ClientService
 
 	Objects objects.ClientService
 
 	Operations operations.ClientService
 
+	Replication replication.ClientService
+
 	Schema schema.ClientService
 
+	SQL sql.ClientService
+
 	WellKnown well_known.ClientService
 
 	Transport runtime.ClientTransport
@@ -155,14 +187,22 @@ type Weaviate struct {
 // SetTransport changes the transport on the client and all its subresources
 func (c *Weaviate) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
+	c.Apikeys.SetTransport(transport)
+	c.Ask.SetTransport(transport)
+	c.Authz.SetTransport(transport)
 	c.Backups.SetTransport(transport)
 	c.Batch.SetTransport(transport)
 	c.Classifications.SetTransport(transport)
+	c.Cluster.SetTransport(transport)
+	c.Debug.SetTransport(transport)
 	c.Graphql.SetTransport(transport)
 	c.Meta.SetTransport(transport)
+	c.Modules.SetTransport(transport)
 	c.Nodes.SetTransport(transport)
 	c.Objects.SetTransport(transport)
 	c.Operations.SetTransport(transport)
+	c.Replication.SetTransport(transport)
 	c.Schema.SetTransport(transport)
+	c.SQL.SetTransport(transport)
 	c.WellKnown.SetTransport(transport)
 }
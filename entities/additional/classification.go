@@ -33,8 +33,11 @@ type Properties struct {
 	Distance           bool                   `json:"distance"`
 	Score              bool                   `json:"score"`
 	ExplainScore       bool                   `json:"explainScore"`
+	Highlights         bool                   `json:"highlights"`
+	ScoreExplanation   bool                   `json:"scoreExplanation"`
 	IsConsistent       bool                   `json:"isConsistent"`
 	Group              bool                   `json:"group"`
+	Tenant             bool                   `json:"tenant"`
 
 	// The User is not interested in returning props, we can skip any costly
 	// operation that isn't required.
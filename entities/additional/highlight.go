@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+// Highlight are the terms of a keyword query which were found in a text
+// property, for text[] properties there is one highlight per value. Offsets
+// are counted in characters.
+type Highlight struct {
+	Property string `json:"property"`
+	// Index of the value in a text[] property
+	Index int `json:"index"`
+	// Snippet is an excerpt of the value around the first matches, which
+	// starts at SnippetStart
+	Snippet      string           `json:"snippet"`
+	SnippetStart int              `json:"snippetStart"`
+	Matches      []HighlightMatch `json:"matches"`
+}
+
+// HighlightMatch is a matched term in the value of a property
+type HighlightMatch struct {
+	Term  string `json:"term"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+// ScoreExplanation breaks the score of a bm25 or hybrid search result into
+// its components. Only the components of the search which produced the
+// result are set.
+type ScoreExplanation struct {
+	Score float32 `json:"score"`
+	// Terms are the contributions of the query terms to the bm25 score
+	Terms []TermScore `json:"terms,omitempty"`
+	// Distance of the result to the search vector
+	Distance *float32 `json:"distance,omitempty"`
+	// FusionAlgorithm and Components are set for hybrid searches, there is
+	// one component for every result set the result was found in
+	FusionAlgorithm string           `json:"fusionAlgorithm,omitempty"`
+	Components      []ScoreComponent `json:"components,omitempty"`
+	// RerankerScore is the score of the reranker module, if the results
+	// were reranked
+	RerankerScore *float64 `json:"rerankerScore,omitempty"`
+}
+
+// TermScore is the contribution of a single query term to a bm25 score
+type TermScore struct {
+	Term       string  `json:"term"`
+	Frequency  float32 `json:"frequency"`
+	PropLength float32 `json:"propLength"`
+	Idf        float64 `json:"idf"`
+	Score      float64 `json:"score"`
+}
+
+// ScoreComponent is the contribution of a result set of a hybrid search to
+// the fused score
+type ScoreComponent struct {
+	Name   string  `json:"name"`
+	Weight float64 `json:"weight"`
+	// Rank of the result in the result set, starting at 1
+	Rank            int     `json:"rank"`
+	OriginalScore   float32 `json:"originalScore"`
+	NormalizedScore float32 `json:"normalizedScore"`
+	Contribution    float32 `json:"contribution"`
+}
@@ -13,6 +13,7 @@ package aggregation
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -34,6 +35,13 @@ type Params struct {
 	NearVector       *searchparams.NearVector   `json:"nearVector"`
 	NearObject       *searchparams.NearObject   `json:"nearObject"`
 	Hybrid           *searchparams.HybridSearch `json:"hybrid"`
+	Timeout          time.Duration              `json:"timeout"`
+
+	// EventuallyConsistent allows answering the meta count and the minimum,
+	// maximum and count of numerical and date properties of an unfiltered
+	// aggregation from statistics which the shards maintain with every write
+	// instead of reading their indexes
+	EventuallyConsistent bool `json:"eventuallyConsistent"`
 }
 
 type ParamProperty struct {
@@ -0,0 +1,35 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ArchiveHead is written by a node every time it ships its archived
+// changes. All changes made by the node before ShippedAt are part of the
+// archive.
+type ArchiveHead struct {
+	ShippedAt time.Time `json:"shippedAt"`
+	// SchemaVersion changes whenever the archived schema changes
+	SchemaVersion string `json:"schemaVersion"`
+}
+
+// ArchivedSchema is the schema of the cluster at the time changes were
+// shipped, so that another cluster can follow the archived changes
+type ArchivedSchema struct {
+	Classes []*models.Class `json:"classes"`
+	// Tenants of multi-tenant classes
+	Tenants map[string][]*models.Tenant `json:"tenants,omitempty"`
+}
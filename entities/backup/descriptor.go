@@ -27,11 +27,14 @@ type NodeDescriptor struct {
 type DistributedBackupDescriptor struct {
 	StartedAt     time.Time                  `json:"startedAt"`
 	CompletedAt   time.Time                  `json:"completedAt"`
-	ID            string                     `json:"id"` // User created backup id
+	PointInTime   time.Time                  `json:"pointInTime,omitempty"` // restore target, zero for the state of the backup
+	ID            string                     `json:"id"`                    // User created backup id
 	Nodes         map[string]*NodeDescriptor `json:"nodes"`
 	NodeMapping   map[string]string          `json:"node_mapping"`
-	Status        Status                     `json:"status"`  //
-	Version       string                     `json:"version"` //
+	ClassMapping  map[string]string          `json:"classMapping,omitempty"` // names classes are restored as
+	Tenants       map[string][]string        `json:"tenants,omitempty"`      // tenants restored per class
+	Status        Status                     `json:"status"`                 //
+	Version       string                     `json:"version"`                //
 	ServerVersion string                     `json:"serverVersion"`
 	Error         string                     `json:"error"`
 }
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNext(t *testing.T) {
+	// a wednesday
+	from := time.Date(2023, 3, 15, 10, 30, 20, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2023, 3, 15, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2023, 3, 15, 10, 45, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2023, 3, 15, 10, 45, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2023, 3, 16, 2, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2023, 3, 16, 0, 0, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2023, 3, 16, 10, 30, 0, 0, time.UTC)},
+		{"0 9-17 * * 1-5", time.Date(2023, 3, 15, 11, 0, 0, 0, time.UTC)},
+		{"0 0 * * 0", time.Date(2023, 3, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2023, 3, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,20 * *", time.Date(2023, 3, 20, 0, 0, 0, 0, time.UTC)},
+		// restricted day of month and day of week match if either matches
+		{"0 0 1 * 5", time.Date(2023, 3, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			s, err := Parse(test.expr)
+			require.Nil(t, err)
+			assert.Equal(t, test.want, s.Next(from))
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@every",
+	} {
+		_, err := Parse(expr)
+		assert.Error(t, err, expr)
+	}
+}
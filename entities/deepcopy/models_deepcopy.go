@@ -38,18 +38,55 @@ func Class(c *models.Class) *models.Class {
 	if c.ReplicationConfig != nil {
 		replicationConf = &models.ReplicationConfig{Factor: c.ReplicationConfig.Factor}
 	}
+	var versioningConf *models.VersioningConfig = nil
+	if c.VersioningConfig != nil {
+		versioningConf = &models.VersioningConfig{
+			Enabled:     c.VersioningConfig.Enabled,
+			MaxVersions: c.VersioningConfig.MaxVersions,
+		}
+	}
+	var chunkingConf *models.ChunkingConfig = nil
+	if c.ChunkingConfig != nil {
+		cc := *c.ChunkingConfig
+		chunkingConf = &cc
+	}
+	var partitioningConf *models.TemporalPartitioningConfig = nil
+	if c.TemporalPartitioningConfig != nil {
+		pc := *c.TemporalPartitioningConfig
+		partitioningConf = &pc
+	}
+	var compactionConf *models.CompactionConfig = nil
+	if c.CompactionConfig != nil {
+		cc := *c.CompactionConfig
+		compactionConf = &cc
+	}
+	var compressionConf *models.SegmentCompressionConfig = nil
+	if c.SegmentCompressionConfig != nil {
+		sc := *c.SegmentCompressionConfig
+		compressionConf = &sc
+	}
+	var queryConf *models.QueryConfig = nil
+	if c.QueryConfig != nil {
+		queryConf = &models.QueryConfig{TimeoutMilliseconds: c.QueryConfig.TimeoutMilliseconds}
+	}
 
 	return &models.Class{
-		Class:               c.Class,
-		Description:         c.Description,
-		ModuleConfig:        c.ModuleConfig,
-		ShardingConfig:      c.ShardingConfig,
-		VectorIndexConfig:   c.VectorIndexConfig,
-		VectorIndexType:     c.VectorIndexType,
-		ReplicationConfig:   replicationConf,
-		Vectorizer:          c.Vectorizer,
-		InvertedIndexConfig: InvertedIndexConfig(c.InvertedIndexConfig),
-		Properties:          properties,
+		Class:                      c.Class,
+		Description:                c.Description,
+		ModuleConfig:               c.ModuleConfig,
+		ShardingConfig:             c.ShardingConfig,
+		VectorIndexConfig:          c.VectorIndexConfig,
+		VectorIndexType:            c.VectorIndexType,
+		ReplicationConfig:          replicationConf,
+		Vectorizer:                 c.Vectorizer,
+		VersioningConfig:           versioningConf,
+		QueryConfig:                queryConf,
+		ChunkingConfig:             chunkingConf,
+		TemporalPartitioningConfig: partitioningConf,
+		CompactionConfig:           compactionConf,
+		SegmentCompressionConfig:   compressionConf,
+		InvertedIndexConfig:        InvertedIndexConfig(c.InvertedIndexConfig),
+		Properties:                 properties,
 	}
 }
 
@@ -57,8 +94,10 @@ func Prop(p *models.Property) *models.Property {
 	return &models.Property{
 		DataType:        p.DataType,
 		Description:     p.Description,
+		Encrypted:       p.Encrypted,
 		ModuleConfig:    p.ModuleConfig,
 		Name:            p.Name,
+		Sensitive:       p.Sensitive,
 		Tokenization:    p.Tokenization,
 		IndexFilterable: ptrBoolCopy(p.IndexFilterable),
 		IndexSearchable: ptrBoolCopy(p.IndexSearchable),
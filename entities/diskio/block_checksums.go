@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskio
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+// ChecksumBlockSize is the size of the blocks of a file which are
+// checksummed individually, so that corruption can be narrowed down
+const ChecksumBlockSize = 64 * 1024
+
+// header of a checksums file: checksum of the remaining file, block size,
+// size and modification time of the checksummed file
+const checksumsHeaderSize = 4 + 4 + 8 + 8
+
+var errInvalidChecksums = errors.New("invalid checksums file")
+
+// CorruptFile is a file some of whose blocks do not match their checksums
+type CorruptFile struct {
+	Path string
+	// Offsets of the corrupt blocks
+	Offsets []int64
+}
+
+type blockChecksums struct {
+	blockSize int64
+	size      int64
+	modTime   int64
+	sums      []uint32
+}
+
+// WriteBlockChecksums stores the checksums of the blocks of the file at path
+// in a file at checksumsPath. The file at path must not be modified anymore.
+func WriteBlockChecksums(path, checksumsPath string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open file: %w", err)
+	}
+	defer f.Close()
+
+	_, err = writeBlockChecksums(f, checksumsPath)
+	return err
+}
+
+// VerifyBlockChecksums compares the blocks of the file at path with the
+// checksums stored at checksumsPath. If there are no checksums yet, or the
+// file was rewritten since they were stored, the checksums are stored
+// instead. It returns nil if the file is intact.
+func VerifyBlockChecksums(path, checksumsPath string) (*CorruptFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("stat file: %w", err)
+	}
+
+	stored, err := loadBlockChecksums(checksumsPath)
+	if err != nil || stored.modTime != info.ModTime().UnixNano() {
+		if err != nil && !os.IsNotExist(err) && !errors.Is(err, errInvalidChecksums) {
+			return nil, err
+		}
+		_, err := writeBlockChecksums(f, checksumsPath)
+		return nil, err
+	}
+
+	actual, err := computeBlockChecksums(f, info, stored.blockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var offsets []int64
+	for i := 0; i < len(stored.sums) || i < len(actual.sums); i++ {
+		if i >= len(stored.sums) || i >= len(actual.sums) ||
+			stored.sums[i] != actual.sums[i] {
+			offsets = append(offsets, int64(i)*stored.blockSize)
+		}
+	}
+	if len(offsets) == 0 && stored.size == actual.size {
+		return nil, nil
+	}
+	if len(offsets) == 0 {
+		// the size changed within the last block
+		offsets = append(offsets, int64(len(actual.sums)-1)*stored.blockSize)
+	}
+	return &CorruptFile{Path: path, Offsets: offsets}, nil
+}
+
+func writeBlockChecksums(f *os.File, checksumsPath string) (*blockChecksums, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("stat file: %w", err)
+	}
+
+	c, err := computeBlockChecksums(f, info, ChecksumBlockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// write to a temporary file first, so that a crash can not leave behind
+	// checksums which do not match the file
+	tmpPath := checksumsPath + ".tmp"
+	if err := os.WriteFile(tmpPath, c.marshal(), 0o666); err != nil {
+		return nil, fmt.Errorf("write checksums: %w", err)
+	}
+	if err := os.Rename(tmpPath, checksumsPath); err != nil {
+		return nil, fmt.Errorf("rename checksums: %w", err)
+	}
+	return c, nil
+}
+
+func computeBlockChecksums(f *os.File, info os.FileInfo, blockSize int64) (*blockChecksums, error) {
+	c := &blockChecksums{
+		blockSize: blockSize,
+		modTime:   info.ModTime().UnixNano(),
+	}
+
+	r := io.NewSectionReader(f, 0, 1<<62)
+	block := make([]byte, blockSize)
+	for {
+		n, err := io.ReadFull(r, block)
+		if n > 0 {
+			c.sums = append(c.sums, crc32.ChecksumIEEE(block[:n]))
+			c.size += int64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return c, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read block at %d: %w", c.size, err)
+		}
+	}
+}
+
+func (c *blockChecksums) marshal() []byte {
+	buf := make([]byte, checksumsHeaderSize+4*len(c.sums))
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(c.blockSize))
+	binary.LittleEndian.PutUint64(buf[8:16], uint64(c.size))
+	binary.LittleEndian.PutUint64(buf[16:24], uint64(c.modTime))
+	for i, sum := range c.sums {
+		binary.LittleEndian.PutUint32(buf[checksumsHeaderSize+4*i:], sum)
+	}
+	binary.LittleEndian.PutUint32(buf[0:4], crc32.ChecksumIEEE(buf[4:]))
+	return buf
+}
+
+func loadBlockChecksums(path string) (*blockChecksums, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < checksumsHeaderSize || (len(data)-checksumsHeaderSize)%4 != 0 ||
+		binary.LittleEndian.Uint32(data[0:4]) != crc32.ChecksumIEEE(data[4:]) {
+		return nil, errInvalidChecksums
+	}
+
+	c := &blockChecksums{
+		blockSize: int64(binary.LittleEndian.Uint32(data[4:8])),
+		size:      int64(binary.LittleEndian.Uint64(data[8:16])),
+		modTime:   int64(binary.LittleEndian.Uint64(data[16:24])),
+		sums:      make([]uint32, (len(data)-checksumsHeaderSize)/4),
+	}
+	if c.blockSize == 0 {
+		return nil, errInvalidChecksums
+	}
+	for i := range c.sums {
+		c.sums[i] = binary.LittleEndian.Uint32(data[checksumsHeaderSize+4*i:])
+	}
+	return c, nil
+}
@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskio
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockChecksums(t *testing.T) {
+	setup := func(t *testing.T) (string, string) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "segment.db")
+		data := make([]byte, 3*ChecksumBlockSize+100)
+		for i := range data {
+			data[i] = byte(i)
+		}
+		require.Nil(t, os.WriteFile(path, data, 0o666))
+		return path, filepath.Join(dir, "segment.checksums")
+	}
+	// modify changes the file without changing its modification time, like
+	// silent corruption would
+	modify := func(t *testing.T, path string, change func(data []byte) []byte) {
+		info, err := os.Stat(path)
+		require.Nil(t, err)
+		data, err := os.ReadFile(path)
+		require.Nil(t, err)
+		require.Nil(t, os.WriteFile(path, change(data), 0o666))
+		require.Nil(t, os.Chtimes(path, info.ModTime(), info.ModTime()))
+	}
+
+	t.Run("intact file", func(t *testing.T) {
+		path, checksumsPath := setup(t)
+		require.Nil(t, WriteBlockChecksums(path, checksumsPath))
+
+		corrupt, err := VerifyBlockChecksums(path, checksumsPath)
+		require.Nil(t, err)
+		assert.Nil(t, corrupt)
+	})
+
+	t.Run("corrupt blocks", func(t *testing.T) {
+		path, checksumsPath := setup(t)
+		require.Nil(t, WriteBlockChecksums(path, checksumsPath))
+		modify(t, path, func(data []byte) []byte {
+			data[ChecksumBlockSize+10]++
+			data[3*ChecksumBlockSize+10]++
+			return data
+		})
+
+		corrupt, err := VerifyBlockChecksums(path, checksumsPath)
+		require.Nil(t, err)
+		require.NotNil(t, corrupt)
+		assert.Equal(t, path, corrupt.Path)
+		assert.Equal(t, []int64{ChecksumBlockSize, 3 * ChecksumBlockSize}, corrupt.Offsets)
+	})
+
+	t.Run("truncated file", func(t *testing.T) {
+		path, checksumsPath := setup(t)
+		require.Nil(t, WriteBlockChecksums(path, checksumsPath))
+		modify(t, path, func(data []byte) []byte {
+			return data[:2*ChecksumBlockSize]
+		})
+
+		corrupt, err := VerifyBlockChecksums(path, checksumsPath)
+		require.Nil(t, err)
+		require.NotNil(t, corrupt)
+		assert.Equal(t, []int64{2 * ChecksumBlockSize, 3 * ChecksumBlockSize}, corrupt.Offsets)
+	})
+
+	t.Run("missing checksums are stored", func(t *testing.T) {
+		path, checksumsPath := setup(t)
+
+		corrupt, err := VerifyBlockChecksums(path, checksumsPath)
+		require.Nil(t, err)
+		assert.Nil(t, corrupt)
+		assert.FileExists(t, checksumsPath)
+	})
+
+	t.Run("invalid checksums are stored again", func(t *testing.T) {
+		path, checksumsPath := setup(t)
+		require.Nil(t, os.WriteFile(checksumsPath, []byte("garbage"), 0o666))
+
+		corrupt, err := VerifyBlockChecksums(path, checksumsPath)
+		require.Nil(t, err)
+		assert.Nil(t, corrupt)
+
+		_, err = loadBlockChecksums(checksumsPath)
+		assert.Nil(t, err)
+	})
+
+	t.Run("rewritten files are checksummed again", func(t *testing.T) {
+		path, checksumsPath := setup(t)
+		require.Nil(t, WriteBlockChecksums(path, checksumsPath))
+		require.Nil(t, os.WriteFile(path, []byte("rewritten"), 0o666))
+		later := time.Now().Add(time.Minute)
+		require.Nil(t, os.Chtimes(path, later, later))
+
+		corrupt, err := VerifyBlockChecksums(path, checksumsPath)
+		require.Nil(t, err)
+		assert.Nil(t, corrupt)
+
+		c, err := loadBlockChecksums(checksumsPath)
+		require.Nil(t, err)
+		assert.Equal(t, int64(len("rewritten")), c.size)
+	})
+}
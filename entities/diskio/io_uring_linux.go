@@ -0,0 +1,292 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build linux
+
+package diskio
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// The parts of the io_uring ABI which are needed to submit reads, see
+// include/uapi/linux/io_uring.h
+const (
+	ioUringEntries = 256
+
+	ioringOffSQRing = 0
+	ioringOffCQRing = 0x8000000
+	ioringOffSQEs   = 0x10000000
+
+	ioringFeatSingleMmap = 1 << 0
+	ioringEnterGetEvents = 1 << 0
+
+	// ioringOpRead is supported since Linux 5.6
+	ioringOpRead = 22
+)
+
+type ioUringParams struct {
+	sqEntries    uint32
+	cqEntries    uint32
+	flags        uint32
+	sqThreadCPU  uint32
+	sqThreadIdle uint32
+	features     uint32
+	wqFd         uint32
+	resv         [3]uint32
+	sqOff        ioSQRingOffsets
+	cqOff        ioCQRingOffsets
+}
+
+type ioSQRingOffsets struct {
+	head        uint32
+	tail        uint32
+	ringMask    uint32
+	ringEntries uint32
+	flags       uint32
+	dropped     uint32
+	array       uint32
+	resv1       uint32
+	userAddr    uint64
+}
+
+type ioCQRingOffsets struct {
+	head        uint32
+	tail        uint32
+	ringMask    uint32
+	ringEntries uint32
+	overflow    uint32
+	cqes        uint32
+	flags       uint32
+	resv1       uint32
+	userAddr    uint64
+}
+
+type ioUringSQE struct {
+	opcode      uint8
+	flags       uint8
+	ioprio      uint16
+	fd          int32
+	off         uint64
+	addr        uint64
+	len         uint32
+	rwFlags     uint32
+	userData    uint64
+	bufIndex    uint16
+	personality uint16
+	spliceFdIn  int32
+	addr3       uint64
+	pad         uint64
+}
+
+type ioUringCQE struct {
+	userData uint64
+	res      int32
+	flags    uint32
+}
+
+// ioUring submits reads of concurrent callers to a single ring. Submissions
+// are serialized, completions are reaped by a single goroutine and handed to
+// the waiting caller through its slot. The number of slots bounds the reads
+// in flight, so neither the submission nor the completion queue can overflow.
+type ioUring struct {
+	fd int
+
+	submitLock sync.Mutex
+	sqTail     *uint32
+	sqMask     uint32
+	sqArray    []uint32
+	sqes       []ioUringSQE
+
+	cqHead *uint32
+	cqTail *uint32
+	cqMask uint32
+	cqes   []ioUringCQE
+
+	slots []chan int32
+	free  chan uint32
+}
+
+func newIOUring(entries uint32) (*ioUring, error) {
+	var params ioUringParams
+	fd, _, errno := unix.Syscall(unix.SYS_IO_URING_SETUP, uintptr(entries),
+		uintptr(unsafe.Pointer(&params)), 0)
+	if errno != 0 {
+		return nil, fmt.Errorf("io_uring_setup: %w", errno)
+	}
+
+	r, err := mapIOUring(int(fd), &params)
+	if err != nil {
+		unix.Close(int(fd))
+		return nil, err
+	}
+	go r.reap()
+
+	if err := r.probe(); err != nil {
+		// the ring can not be closed, as the reaper keeps waiting on it, but
+		// it is never used
+		return nil, err
+	}
+	return r, nil
+}
+
+func mapIOUring(fd int, params *ioUringParams) (*ioUring, error) {
+	sqSize := int(params.sqOff.array + params.sqEntries*4)
+	cqSize := int(params.cqOff.cqes + params.cqEntries*uint32(unsafe.Sizeof(ioUringCQE{})))
+	singleMmap := params.features&ioringFeatSingleMmap != 0
+	if singleMmap && cqSize > sqSize {
+		sqSize = cqSize
+	}
+
+	prot := unix.PROT_READ | unix.PROT_WRITE
+	flags := unix.MAP_SHARED | unix.MAP_POPULATE
+	sqRing, err := unix.Mmap(fd, ioringOffSQRing, sqSize, prot, flags)
+	if err != nil {
+		return nil, fmt.Errorf("mmap submission queue: %w", err)
+	}
+	cqRing := sqRing
+	if !singleMmap {
+		if cqRing, err = unix.Mmap(fd, ioringOffCQRing, cqSize, prot, flags); err != nil {
+			unix.Munmap(sqRing)
+			return nil, fmt.Errorf("mmap completion queue: %w", err)
+		}
+	}
+	sqes, err := unix.Mmap(fd, ioringOffSQEs,
+		int(params.sqEntries)*int(unsafe.Sizeof(ioUringSQE{})), prot, flags)
+	if err != nil {
+		unix.Munmap(sqRing)
+		if !singleMmap {
+			unix.Munmap(cqRing)
+		}
+		return nil, fmt.Errorf("mmap submission queue entries: %w", err)
+	}
+
+	r := &ioUring{
+		fd:      fd,
+		sqTail:  (*uint32)(unsafe.Pointer(&sqRing[params.sqOff.tail])),
+		sqMask:  *(*uint32)(unsafe.Pointer(&sqRing[params.sqOff.ringMask])),
+		sqArray: unsafe.Slice((*uint32)(unsafe.Pointer(&sqRing[params.sqOff.array])), params.sqEntries),
+		sqes:    unsafe.Slice((*ioUringSQE)(unsafe.Pointer(&sqes[0])), params.sqEntries),
+		cqHead:  (*uint32)(unsafe.Pointer(&cqRing[params.cqOff.head])),
+		cqTail:  (*uint32)(unsafe.Pointer(&cqRing[params.cqOff.tail])),
+		cqMask:  *(*uint32)(unsafe.Pointer(&cqRing[params.cqOff.ringMask])),
+		cqes:    unsafe.Slice((*ioUringCQE)(unsafe.Pointer(&cqRing[params.cqOff.cqes])), params.cqEntries),
+		slots:   make([]chan int32, params.sqEntries),
+		free:    make(chan uint32, params.sqEntries),
+	}
+	for i := range r.slots {
+		r.slots[i] = make(chan int32, 1)
+		r.free <- uint32(i)
+	}
+	return r, nil
+}
+
+// probe makes sure the kernel supports the read operation, older kernels
+// accept the ring but fail the operation with EINVAL
+func (r *ioUring) probe() error {
+	f, err := os.Open(os.DevNull)
+	if err != nil {
+		return fmt.Errorf("open file to probe io_uring: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := r.read(int(f.Fd()), make([]byte, 1), 0); err != nil {
+		return fmt.Errorf("probe io_uring read: %w", err)
+	}
+	return nil
+}
+
+// read submits a single read and waits for its completion. The result is the
+// number of bytes read, which may be less than len(p).
+func (r *ioUring) read(fd int, p []byte, off int64) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	slot := <-r.free
+	defer func() { r.free <- slot }()
+
+	// the kernel writes to p asynchronously, it must not be moved meanwhile
+	var pinner runtime.Pinner
+	pinner.Pin(&p[0])
+	defer pinner.Unpin()
+
+	r.submitLock.Lock()
+	tail := *r.sqTail
+	idx := tail & r.sqMask
+	r.sqes[idx] = ioUringSQE{
+		opcode:   ioringOpRead,
+		fd:       int32(fd),
+		off:      uint64(off),
+		addr:     uint64(uintptr(unsafe.Pointer(&p[0]))),
+		len:      uint32(len(p)),
+		userData: uint64(slot),
+	}
+	r.sqArray[idx] = idx
+	atomic.StoreUint32(r.sqTail, tail+1)
+	if err := r.enter(1, 0, 0); err != nil {
+		// nothing was submitted, take the entry back, so it is not submitted
+		// with the next read
+		atomic.StoreUint32(r.sqTail, tail)
+		r.submitLock.Unlock()
+		return 0, fmt.Errorf("io_uring_enter: %w", err)
+	}
+	r.submitLock.Unlock()
+
+	res := <-r.slots[slot]
+	if res < 0 {
+		return 0, syscall.Errno(-res)
+	}
+	return int(res), nil
+}
+
+// reap waits for completions and hands them to the slots of their reads
+func (r *ioUring) reap() {
+	for {
+		head := *r.cqHead
+		tail := atomic.LoadUint32(r.cqTail)
+		if head == tail {
+			if err := r.enter(0, 1, ioringEnterGetEvents); err != nil {
+				// reads waiting for their completion would hang forever
+				panic(fmt.Sprintf("wait for io_uring completions: %v", err))
+			}
+			continue
+		}
+
+		for ; head != tail; head++ {
+			cqe := r.cqes[head&r.cqMask]
+			r.slots[cqe.userData] <- cqe.res
+		}
+		atomic.StoreUint32(r.cqHead, head)
+	}
+}
+
+func (r *ioUring) enter(toSubmit, minComplete, flags uint32) error {
+	for {
+		_, _, errno := unix.Syscall6(unix.SYS_IO_URING_ENTER, uintptr(r.fd),
+			uintptr(toSubmit), uintptr(minComplete), uintptr(flags), 0, 0)
+		if errno == unix.EINTR {
+			continue
+		}
+		if errno != 0 {
+			return errno
+		}
+		return nil
+	}
+}
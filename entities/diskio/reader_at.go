@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskio
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// directIOAlignment is the alignment of offsets, lengths and memory of
+// O_DIRECT reads. It is the page size, as the logical block size of the
+// underlying device can not be more than that.
+const directIOAlignment = 4096
+
+// ReadMode selects how files opened with [NewReaderAt] are read
+type ReadMode string
+
+const (
+	// ReadModePread reads with regular pread syscalls through the page cache
+	ReadModePread ReadMode = "pread"
+	// ReadModeIOUring submits reads to a shared io_uring, which is only
+	// available on Linux 5.6+ and may be disabled by seccomp profiles
+	ReadModeIOUring ReadMode = "io_uring"
+	// ReadModeDirect opens files with O_DIRECT, so reads bypass the page cache.
+	// Not all file systems support it, e.g. tmpfs does not.
+	ReadModeDirect ReadMode = "direct"
+)
+
+// ParseReadMode parses the name of a read mode, an empty name is pread
+func ParseReadMode(name string) (ReadMode, error) {
+	switch mode := ReadMode(name); mode {
+	case "":
+		return ReadModePread, nil
+	case ReadModePread, ReadModeIOUring, ReadModeDirect:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unsupported read mode %q", name)
+	}
+}
+
+// ReaderAt is an [io.ReaderAt] which owns the file it reads from
+type ReaderAt interface {
+	io.ReaderAt
+	io.Closer
+}
+
+// NewReaderAt takes ownership of f and returns a reader for it using the
+// given mode. If the mode is not supported by the platform, the kernel or the
+// file system of f, it falls back to reading f with pread. Use
+// [CheckReadMode] to find out upfront whether that is the case.
+func NewReaderAt(f *os.File, mode ReadMode) ReaderAt {
+	switch mode {
+	case ReadModeIOUring:
+		if r, err := newIOUringReaderAt(f); err == nil {
+			return r
+		}
+	case ReadModeDirect:
+		if r, err := newDirectReaderAt(f); err == nil {
+			return r
+		}
+	}
+	return f
+}
+
+// CheckReadMode returns an error if files in dir can not be read using the
+// given mode, in which case readers fall back to pread
+func CheckReadMode(dir string, mode ReadMode) error {
+	switch mode {
+	case ReadModeIOUring:
+		return checkIOUring()
+	case ReadModeDirect:
+		f, err := os.CreateTemp(dir, ".direct-io-check-*")
+		if err != nil {
+			return fmt.Errorf("create file to check direct IO: %w", err)
+		}
+		defer os.Remove(f.Name())
+		if _, err := f.Write(make([]byte, directIOAlignment)); err != nil {
+			f.Close()
+			return fmt.Errorf("write file to check direct IO: %w", err)
+		}
+		r, err := newDirectReaderAt(f)
+		if err != nil {
+			f.Close()
+			return err
+		}
+		defer r.Close()
+		if _, err := r.ReadAt(make([]byte, 1), 1); err != nil {
+			return fmt.Errorf("read with direct IO: %w", err)
+		}
+		return nil
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build linux
+
+package diskio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// directIOBufferSize is the size of pooled aligned buffers, which fits the
+// aligned span of regular reads through a bufio.Reader
+const directIOBufferSize = 64 * 1024
+
+var directIOBuffers = sync.Pool{
+	New: func() any {
+		b := alignedBuffer(directIOBufferSize)
+		return &b
+	},
+}
+
+type directReaderAt struct {
+	fd   int
+	path string
+}
+
+func newDirectReaderAt(f *os.File) (ReaderAt, error) {
+	fd, err := unix.Open(f.Name(), unix.O_RDONLY|unix.O_DIRECT|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, &os.PathError{Op: "open with O_DIRECT", Path: f.Name(), Err: err}
+	}
+	f.Close()
+	return &directReaderAt{fd: fd, path: f.Name()}, nil
+}
+
+// ReadAt reads the aligned span around p into an aligned buffer and copies
+// the requested part out of it
+func (r *directReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, &os.PathError{Op: "read", Path: r.path, Err: errors.New("negative offset")}
+	}
+
+	start := off &^ (directIOAlignment - 1)
+	end := alignUp(off + int64(len(p)))
+	size := int(end - start)
+
+	var buf []byte
+	if size <= directIOBufferSize {
+		pooled := directIOBuffers.Get().(*[]byte)
+		defer directIOBuffers.Put(pooled)
+		buf = (*pooled)[:size]
+	} else {
+		buf = alignedBuffer(size)
+	}
+
+	read := 0
+	for read < size {
+		n, err := unix.Pread(r.fd, buf[read:], start+int64(read))
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			return 0, &os.PathError{Op: "read", Path: r.path, Err: err}
+		}
+		read += n
+		if n == 0 || read%directIOAlignment != 0 {
+			// a short read is only possible at the end of the file
+			break
+		}
+	}
+
+	skip := int(off - start)
+	if read <= skip {
+		return 0, io.EOF
+	}
+	n := copy(p, buf[skip:read])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (r *directReaderAt) Close() error {
+	if err := unix.Close(r.fd); err != nil {
+		return &os.PathError{Op: "close", Path: r.path, Err: err}
+	}
+	return nil
+}
+
+func alignUp(n int64) int64 {
+	return (n + directIOAlignment - 1) &^ (directIOAlignment - 1)
+}
+
+func alignedBuffer(size int) []byte {
+	b := make([]byte, size+directIOAlignment)
+	shift := 0
+	if rem := int(uintptr(unsafe.Pointer(&b[0])) & (directIOAlignment - 1)); rem != 0 {
+		shift = directIOAlignment - rem
+	}
+	return b[shift : shift+size : shift+size]
+}
+
+type ioUringReaderAt struct {
+	f    *os.File
+	fd   int
+	ring *ioUring
+}
+
+func newIOUringReaderAt(f *os.File) (ReaderAt, error) {
+	ring, err := sharedRing()
+	if err != nil {
+		return nil, err
+	}
+	return &ioUringReaderAt{f: f, fd: int(f.Fd()), ring: ring}, nil
+}
+
+func (r *ioUringReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, &os.PathError{Op: "read", Path: r.f.Name(), Err: errors.New("negative offset")}
+	}
+
+	read := 0
+	for read < len(p) {
+		n, err := r.ring.read(r.fd, p[read:], off+int64(read))
+		if err == unix.EINTR || err == unix.EAGAIN {
+			continue
+		}
+		if err != nil {
+			return read, &os.PathError{Op: "read", Path: r.f.Name(), Err: err}
+		}
+		if n == 0 {
+			return read, io.EOF
+		}
+		read += n
+	}
+	return read, nil
+}
+
+func (r *ioUringReaderAt) Close() error {
+	return r.f.Close()
+}
+
+func checkIOUring() error {
+	_, err := sharedRing()
+	return err
+}
+
+var (
+	sharedRingOnce sync.Once
+	sharedRingInst *ioUring
+	sharedRingErr  error
+)
+
+// sharedRing is set up once and used by all readers of the process. It is
+// never closed.
+func sharedRing() (*ioUring, error) {
+	sharedRingOnce.Do(func() {
+		sharedRingInst, sharedRingErr = newIOUring(ioUringEntries)
+		if sharedRingErr != nil {
+			sharedRingErr = fmt.Errorf("set up io_uring: %w", sharedRingErr)
+		}
+	})
+	return sharedRingInst, sharedRingErr
+}
@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !linux
+
+package diskio
+
+import (
+	"errors"
+	"os"
+)
+
+var errReadModeUnsupported = errors.New("read mode is only supported on linux")
+
+func newIOUringReaderAt(f *os.File) (ReaderAt, error) {
+	return nil, errReadModeUnsupported
+}
+
+func newDirectReaderAt(f *os.File) (ReaderAt, error) {
+	return nil, errReadModeUnsupported
+}
+
+func checkIOUring() error {
+	return errReadModeUnsupported
+}
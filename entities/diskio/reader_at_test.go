@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskio
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReaderAt(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "segment.db")
+	data := make([]byte, 3*directIOBufferSize+100)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	require.Nil(t, os.WriteFile(path, data, 0o666))
+
+	for _, mode := range []ReadMode{ReadModePread, ReadModeIOUring, ReadModeDirect} {
+		t.Run(string(mode), func(t *testing.T) {
+			if err := CheckReadMode(dir, mode); err != nil {
+				t.Skipf("read mode not supported, readers fall back to pread: %v", err)
+			}
+
+			f, err := os.Open(path)
+			require.Nil(t, err)
+			r := NewReaderAt(f, mode)
+			defer r.Close()
+
+			t.Run("within the file", func(t *testing.T) {
+				for _, tc := range []struct{ off, length int }{
+					{0, 1},
+					{1, 4096},
+					{4095, 2},
+					{8192, 4096},
+					{100, directIOBufferSize},
+					{17, 2*directIOBufferSize + 50},
+				} {
+					p := make([]byte, tc.length)
+					n, err := r.ReadAt(p, int64(tc.off))
+					require.Nil(t, err)
+					assert.Equal(t, tc.length, n)
+					assert.Equal(t, data[tc.off:tc.off+tc.length], p)
+				}
+			})
+
+			t.Run("across the end of the file", func(t *testing.T) {
+				p := make([]byte, 200)
+				n, err := r.ReadAt(p, int64(len(data)-50))
+				assert.ErrorIs(t, err, io.EOF)
+				assert.Equal(t, 50, n)
+				assert.Equal(t, data[len(data)-50:], p[:n])
+			})
+
+			t.Run("after the end of the file", func(t *testing.T) {
+				n, err := r.ReadAt(make([]byte, 10), int64(len(data)+4096))
+				assert.ErrorIs(t, err, io.EOF)
+				assert.Equal(t, 0, n)
+			})
+
+			t.Run("concurrently", func(t *testing.T) {
+				wg := sync.WaitGroup{}
+				for i := 0; i < 64; i++ {
+					wg.Add(1)
+					go func(off int) {
+						defer wg.Done()
+						for j := 0; j < 50; j++ {
+							p := make([]byte, 300)
+							_, err := r.ReadAt(p, int64(off+j))
+							assert.Nil(t, err)
+							assert.Equal(t, data[off+j:off+j+300], p)
+						}
+					}(i * 1000)
+				}
+				wg.Wait()
+			})
+		})
+	}
+}
+
+func TestParseReadMode(t *testing.T) {
+	mode, err := ParseReadMode("")
+	require.Nil(t, err)
+	assert.Equal(t, ReadModePread, mode)
+
+	mode, err = ParseReadMode("io_uring")
+	require.Nil(t, err)
+	assert.Equal(t, ReadModeIOUring, mode)
+
+	_, err = ParseReadMode("aio")
+	assert.NotNil(t, err)
+}
@@ -12,6 +12,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/search"
@@ -32,6 +34,10 @@ type GetParams struct {
 	Properties            search.SelectProperties
 	NearVector            *searchparams.NearVector
 	NearObject            *searchparams.NearObject
+	Recommend             *searchparams.Recommend
+	Diversity             *searchparams.Diversity
+	DedupBy               []string // keep only the first result of every combination of these property values
+	Facets                []searchparams.Facet
 	KeywordRanking        *searchparams.KeywordRanking
 	HybridSearch          *searchparams.HybridSearch
 	GroupBy               *searchparams.GroupBy
@@ -41,5 +47,10 @@ type GetParams struct {
 	AdditionalProperties  additional.Properties
 	ReplicationProperties *additional.ReplicationProperties
 	Tenant                string
-	IsRefOrigin           bool // is created by ref filter
+	Tenants               []string // search across tenants, "*" for all active ones
+	IsRefOrigin           bool     // is created by ref filter
+	Timeout               time.Duration
+	PartialResults        bool    // return the results of the shards which finished before the timeout
+	Explain               bool    // report the plan of the shard searches next to the results
+	Oversampling          float64 // multiplies the limit to get the candidates which compressed indexes rescore
 }
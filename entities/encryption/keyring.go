@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package encryption implements the at-rest encryption of individual
+// property values. Values are encrypted with AES-GCM and stored as a
+// self-describing envelope, which contains the id of the key that was used.
+// This allows rotating keys without re-encrypting existing data: New writes
+// always use the active key, while all previously configured keys remain
+// available for decryption.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// envelopePrefix marks a property value as encrypted. The full envelope is
+// "wvenc:v1:<key id>:<base64(nonce|ciphertext)>".
+const envelopePrefix = "wvenc:v1:"
+
+// Key is a named AES key. The material must be 16, 24 or 32 bytes long to
+// select AES-128, AES-192 or AES-256 respectively.
+type Key struct {
+	ID       string
+	Material []byte
+}
+
+// Keyring holds all keys known to this node. It is safe for concurrent use.
+type Keyring struct {
+	activeID string
+	aeads    map[string]cipher.AEAD
+}
+
+// NewKeyring creates a keyring from the given keys. The first key is the
+// active key which is used for all new encryptions.
+func NewKeyring(keys []Key) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one key is required")
+	}
+
+	kr := &Keyring{
+		activeID: keys[0].ID,
+		aeads:    make(map[string]cipher.AEAD, len(keys)),
+	}
+
+	for _, key := range keys {
+		if key.ID == "" || strings.Contains(key.ID, ":") {
+			return nil, errors.Errorf("invalid key id %q: must be non-empty and not contain ':'", key.ID)
+		}
+		if _, ok := kr.aeads[key.ID]; ok {
+			return nil, errors.Errorf("duplicate key id %q", key.ID)
+		}
+
+		block, err := aes.NewCipher(key.Material)
+		if err != nil {
+			return nil, errors.Wrapf(err, "key %q", key.ID)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, errors.Wrapf(err, "key %q", key.ID)
+		}
+		kr.aeads[key.ID] = aead
+	}
+
+	return kr, nil
+}
+
+// ParseKeys parses a comma-separated list of "<id>:<base64 key>" pairs, as
+// used in the PROPERTY_ENCRYPTION_KEYS environment variable.
+func ParseKeys(in string) ([]Key, error) {
+	var keys []Key
+	for _, pair := range strings.Split(in, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("key %q must have the format <id>:<base64 key>", pair)
+		}
+		material, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Wrapf(err, "decode key %q", id)
+		}
+		keys = append(keys, Key{ID: id, Material: material})
+	}
+
+	return keys, nil
+}
+
+// ActiveKeyID is the id of the key used for new encryptions
+func (kr *Keyring) ActiveKeyID() string {
+	return kr.activeID
+}
+
+// Encrypt wraps the plain text value into an encrypted envelope using the
+// active key
+func (kr *Keyring) Encrypt(plain string) (string, error) {
+	aead := kr.aeads[kr.activeID]
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plain)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Wrap(err, "generate nonce")
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(plain), []byte(kr.activeID))
+	return envelopePrefix + kr.activeID + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt unwraps an envelope created by Encrypt. Values which are not
+// encrypted are returned unchanged, so data written before encryption was
+// turned on for a property remains readable.
+func (kr *Keyring) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	id, encoded, ok := strings.Cut(value[len(envelopePrefix):], ":")
+	if !ok {
+		return "", errors.New("malformed encryption envelope")
+	}
+
+	aead, ok := kr.aeads[id]
+	if !ok {
+		return "", errors.Errorf("value was encrypted with unknown key %q", id)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", errors.Wrap(err, "decode encryption envelope")
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("malformed encryption envelope")
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plain, err := aead.Open(nil, nonce, ciphertext, []byte(id))
+	if err != nil {
+		return "", errors.Wrapf(err, "decrypt value with key %q", id)
+	}
+
+	return string(plain), nil
+}
+
+// IsEncrypted checks whether a value is an encryption envelope
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, envelopePrefix)
+}
+
+// KeyID returns the id of the key the envelope was encrypted with. This can
+// be used to find values which still need to be re-encrypted after a key
+// rotation.
+func KeyID(value string) (string, bool) {
+	if !IsEncrypted(value) {
+		return "", false
+	}
+	id, _, ok := strings.Cut(value[len(envelopePrefix):], ":")
+	return id, ok
+}
@@ -0,0 +1,253 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func testKey(id string, b byte) Key {
+	return Key{ID: id, Material: bytes.Repeat([]byte{b}, 32)}
+}
+
+func TestKeyring(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		kr, err := NewKeyring([]Key{testKey("k1", 1)})
+		require.Nil(t, err)
+
+		encrypted, err := kr.Encrypt("my secret")
+		require.Nil(t, err)
+		assert.True(t, IsEncrypted(encrypted))
+		assert.NotContains(t, encrypted, "my secret")
+
+		id, ok := KeyID(encrypted)
+		require.True(t, ok)
+		assert.Equal(t, "k1", id)
+
+		plain, err := kr.Decrypt(encrypted)
+		require.Nil(t, err)
+		assert.Equal(t, "my secret", plain)
+	})
+
+	t.Run("same value encrypts differently each time", func(t *testing.T) {
+		kr, err := NewKeyring([]Key{testKey("k1", 1)})
+		require.Nil(t, err)
+
+		first, err := kr.Encrypt("value")
+		require.Nil(t, err)
+		second, err := kr.Encrypt("value")
+		require.Nil(t, err)
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("plain text passes through", func(t *testing.T) {
+		kr, err := NewKeyring([]Key{testKey("k1", 1)})
+		require.Nil(t, err)
+
+		plain, err := kr.Decrypt("not encrypted")
+		require.Nil(t, err)
+		assert.Equal(t, "not encrypted", plain)
+	})
+
+	t.Run("rotation", func(t *testing.T) {
+		old, err := NewKeyring([]Key{testKey("k1", 1)})
+		require.Nil(t, err)
+		encryptedWithOld, err := old.Encrypt("value")
+		require.Nil(t, err)
+
+		rotated, err := NewKeyring([]Key{testKey("k2", 2), testKey("k1", 1)})
+		require.Nil(t, err)
+		assert.Equal(t, "k2", rotated.ActiveKeyID())
+
+		plain, err := rotated.Decrypt(encryptedWithOld)
+		require.Nil(t, err)
+		assert.Equal(t, "value", plain)
+
+		encryptedWithNew, err := rotated.Encrypt("value")
+		require.Nil(t, err)
+		id, _ := KeyID(encryptedWithNew)
+		assert.Equal(t, "k2", id)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		kr1, err := NewKeyring([]Key{testKey("k1", 1)})
+		require.Nil(t, err)
+		kr2, err := NewKeyring([]Key{testKey("k2", 2)})
+		require.Nil(t, err)
+
+		encrypted, err := kr1.Encrypt("value")
+		require.Nil(t, err)
+		_, err = kr2.Decrypt(encrypted)
+		assert.ErrorContains(t, err, `unknown key "k1"`)
+	})
+
+	t.Run("tampered value", func(t *testing.T) {
+		kr, err := NewKeyring([]Key{testKey("k1", 1), testKey("k2", 2)})
+		require.Nil(t, err)
+
+		encrypted, err := kr.Encrypt("value")
+		require.Nil(t, err)
+
+		// claiming a different key id must fail, as the id is authenticated
+		_, err = kr.Decrypt(strings.Replace(encrypted, ":k1:", ":k2:", 1))
+		assert.NotNil(t, err)
+
+		_, err = kr.Decrypt(envelopePrefix + "k1:AAAA")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("invalid keys", func(t *testing.T) {
+		_, err := NewKeyring(nil)
+		assert.NotNil(t, err)
+		_, err = NewKeyring([]Key{{ID: "k1", Material: []byte("short")}})
+		assert.NotNil(t, err)
+		_, err = NewKeyring([]Key{testKey("", 1)})
+		assert.NotNil(t, err)
+		_, err = NewKeyring([]Key{testKey("a:b", 1)})
+		assert.NotNil(t, err)
+		_, err = NewKeyring([]Key{testKey("k1", 1), testKey("k1", 2)})
+		assert.ErrorContains(t, err, "duplicate")
+	})
+}
+
+func TestParseKeys(t *testing.T) {
+	keys, err := ParseKeys("k2:AgICAgICAgICAgICAgICAg==, k1:AQEBAQEBAQEBAQEBAQEBAQ==")
+	require.Nil(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "k2", keys[0].ID)
+	assert.Equal(t, bytes.Repeat([]byte{2}, 16), keys[0].Material)
+	assert.Equal(t, "k1", keys[1].ID)
+
+	_, err = ParseKeys("missing-separator")
+	assert.NotNil(t, err)
+	_, err = ParseKeys("k1:not-base64!")
+	assert.NotNil(t, err)
+}
+
+func TestEncryptDecryptProperties(t *testing.T) {
+	kr, err := NewKeyring([]Key{testKey("k1", 1)})
+	require.Nil(t, err)
+
+	class := &models.Class{
+		Class: "Patient",
+		Properties: []*models.Property{
+			{Name: "name", DataType: []string{"text"}},
+			{Name: "diagnosis", DataType: []string{"text"}, Encrypted: true},
+			{Name: "notes", DataType: []string{"text[]"}, Encrypted: true},
+		},
+	}
+	names := EncryptedPropNames(class)
+	assert.Equal(t, []string{"diagnosis", "notes"}, names)
+
+	props := map[string]interface{}{
+		"name":      "John Doe",
+		"diagnosis": "flu",
+		"notes":     []string{"first", "second"},
+	}
+
+	encrypted, err := kr.EncryptProperties(props, names)
+	require.Nil(t, err)
+	assert.Equal(t, "flu", props["diagnosis"], "input must not be modified")
+	assert.Equal(t, "John Doe", encrypted["name"])
+	assert.True(t, IsEncrypted(encrypted["diagnosis"].(string)))
+	for _, note := range encrypted["notes"].([]string) {
+		assert.True(t, IsEncrypted(note))
+	}
+
+	t.Run("values which look like an envelope are encrypted", func(t *testing.T) {
+		literal := encrypted["diagnosis"].(string)
+		again, err := kr.EncryptProperties(map[string]interface{}{"diagnosis": literal}, names)
+		require.Nil(t, err)
+		assert.NotEqual(t, literal, again["diagnosis"])
+
+		require.Nil(t, kr.DecryptProperties(again, names))
+		assert.Equal(t, literal, again["diagnosis"])
+	})
+
+	t.Run("decrypt values as read from disk", func(t *testing.T) {
+		notes := encrypted["notes"].([]string)
+		fromDisk := map[string]interface{}{
+			"name":      "John Doe",
+			"diagnosis": encrypted["diagnosis"],
+			"notes":     []interface{}{notes[0], notes[1]},
+		}
+		require.Nil(t, kr.DecryptProperties(fromDisk, names))
+		assert.Equal(t, "John Doe", fromDisk["name"])
+		assert.Equal(t, "flu", fromDisk["diagnosis"])
+		assert.Equal(t, []interface{}{"first", "second"}, fromDisk["notes"])
+	})
+
+	t.Run("only properties marked as encrypted are decrypted", func(t *testing.T) {
+		fromDisk := map[string]interface{}{
+			"name":      encrypted["diagnosis"],
+			"diagnosis": encrypted["diagnosis"],
+		}
+		require.Nil(t, kr.PropertyDecrypter(names).DecryptProperties(fromDisk))
+		assert.Equal(t, encrypted["diagnosis"], fromDisk["name"])
+		assert.Equal(t, "flu", fromDisk["diagnosis"])
+	})
+
+	t.Run("non text values can not be encrypted", func(t *testing.T) {
+		_, err := kr.EncryptProperties(map[string]interface{}{"diagnosis": 17.0}, names)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestReencryptProperties(t *testing.T) {
+	old, err := NewKeyring([]Key{testKey("k1", 1)})
+	require.Nil(t, err)
+	encrypted, err := old.EncryptProperties(map[string]interface{}{
+		"name":      "John Doe",
+		"diagnosis": "flu",
+	}, []string{"diagnosis"})
+	require.Nil(t, err)
+
+	rotated, err := NewKeyring([]Key{testKey("k2", 2), testKey("k1", 1)})
+	require.Nil(t, err)
+
+	names := []string{"diagnosis"}
+	props := map[string]interface{}{
+		"name":      encrypted["name"],
+		"diagnosis": encrypted["diagnosis"],
+	}
+	assert.True(t, rotated.NeedsReencryption(props, names))
+
+	changed, err := rotated.ReencryptProperties(props, names)
+	require.Nil(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "John Doe", props["name"])
+	id, ok := KeyID(props["diagnosis"].(string))
+	require.True(t, ok)
+	assert.Equal(t, "k2", id)
+	assert.False(t, rotated.NeedsReencryption(props, names))
+
+	t.Run("values on the active key are left alone", func(t *testing.T) {
+		before := props["diagnosis"]
+		changed, err := rotated.ReencryptProperties(props, names)
+		require.Nil(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, before, props["diagnosis"])
+	})
+
+	t.Run("the retired key is no longer needed", func(t *testing.T) {
+		onlyNew, err := NewKeyring([]Key{testKey("k2", 2)})
+		require.Nil(t, err)
+		require.Nil(t, onlyNew.DecryptProperties(props, names))
+		assert.Equal(t, "flu", props["diagnosis"])
+	})
+}
@@ -0,0 +1,219 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// EncryptedPropNames returns the names of all properties of the class which
+// are marked for encryption
+func EncryptedPropNames(class *models.Class) []string {
+	if class == nil {
+		return nil
+	}
+
+	var names []string
+	for _, prop := range class.Properties {
+		if prop.Encrypted {
+			names = append(names, prop.Name)
+		}
+	}
+	return names
+}
+
+// EncryptProperties returns a copy of props in which the values of the named
+// properties are encrypted. Every value is encrypted, even one which already
+// looks like an envelope, as it was written by the user and must not be
+// mistaken for ciphertext when read back. The input map is not modified, as
+// it is usually shared with the object that is returned to the user.
+func (kr *Keyring) EncryptProperties(props map[string]interface{},
+	names []string,
+) (map[string]interface{}, error) {
+	if len(names) == 0 || len(props) == 0 {
+		return props, nil
+	}
+
+	out := make(map[string]interface{}, len(props))
+	for key, value := range props {
+		out[key] = value
+	}
+
+	for _, name := range names {
+		value, ok := out[name]
+		if !ok || value == nil {
+			continue
+		}
+
+		encrypted, err := kr.transformValue(value, kr.Encrypt)
+		if err != nil {
+			return nil, errors.Wrapf(err, "encrypt property %q", name)
+		}
+		out[name] = encrypted
+	}
+
+	return out, nil
+}
+
+// DecryptProperties replaces the encrypted values of the named properties in
+// props with their plain text in place. Values of all other properties are
+// never decrypted, even if they look like an envelope.
+func (kr *Keyring) DecryptProperties(props map[string]interface{},
+	names []string,
+) error {
+	if kr == nil {
+		return nil
+	}
+
+	for _, name := range names {
+		value, ok := props[name]
+		if !ok || value == nil {
+			continue
+		}
+
+		decrypted, err := kr.transformValue(value, kr.Decrypt)
+		if err != nil {
+			return errors.Wrapf(err, "decrypt property %q", name)
+		}
+		props[name] = decrypted
+	}
+
+	return nil
+}
+
+// NeedsReencryption checks whether any value of the named properties in props
+// was encrypted with a key other than the active one
+func (kr *Keyring) NeedsReencryption(props map[string]interface{},
+	names []string,
+) bool {
+	for _, name := range names {
+		if containsEnvelope(props[name], kr.retired) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReencryptProperties re-encrypts all values of the named properties in props
+// which were encrypted with a retired key with the active key in place. It
+// reports whether any value was changed. Once no stored value references a
+// retired key anymore, that key can be removed from the keyring.
+func (kr *Keyring) ReencryptProperties(props map[string]interface{},
+	names []string,
+) (bool, error) {
+	changed := false
+	for _, name := range names {
+		value := props[name]
+		if !containsEnvelope(value, kr.retired) {
+			continue
+		}
+
+		reencrypted, err := kr.transformValue(value, kr.reencryptIfRetired)
+		if err != nil {
+			return false, errors.Wrapf(err, "re-encrypt property %q", name)
+		}
+		props[name] = reencrypted
+		changed = true
+	}
+
+	return changed, nil
+}
+
+// PropertyDecrypter decrypts the named properties of objects, it is the
+// keyring bound to the encrypted properties of a single class
+type PropertyDecrypter struct {
+	keyring *Keyring
+	names   []string
+}
+
+// PropertyDecrypter returns a decrypter for the named properties
+func (kr *Keyring) PropertyDecrypter(names []string) *PropertyDecrypter {
+	return &PropertyDecrypter{keyring: kr, names: names}
+}
+
+// DecryptProperties replaces the encrypted values of the properties of the
+// decrypter in props with their plain text in place
+func (d *PropertyDecrypter) DecryptProperties(props map[string]interface{}) error {
+	return d.keyring.DecryptProperties(props, d.names)
+}
+
+func (kr *Keyring) retired(value string) bool {
+	id, ok := KeyID(value)
+	return ok && id != kr.activeID
+}
+
+func (kr *Keyring) reencryptIfRetired(value string) (string, error) {
+	if !kr.retired(value) {
+		return value, nil
+	}
+	plain, err := kr.Decrypt(value)
+	if err != nil {
+		return "", err
+	}
+	return kr.Encrypt(plain)
+}
+
+func (kr *Keyring) transformValue(value interface{},
+	fn func(string) (string, error),
+) (interface{}, error) {
+	switch typed := value.(type) {
+	case string:
+		return fn(typed)
+	case []string:
+		out := make([]string, len(typed))
+		for i := range typed {
+			res, err := fn(typed[i])
+			if err != nil {
+				return nil, err
+			}
+			out[i] = res
+		}
+		return out, nil
+	case []interface{}:
+		out := make([]interface{}, len(typed))
+		for i := range typed {
+			str, ok := typed[i].(string)
+			if !ok {
+				return nil, errors.Errorf("expected string array element, got %T", typed[i])
+			}
+			res, err := fn(str)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = res
+		}
+		return out, nil
+	default:
+		return nil, errors.Errorf("only text and text[] values can be encrypted, got %T", value)
+	}
+}
+
+func containsEnvelope(value interface{}, match func(string) bool) bool {
+	switch typed := value.(type) {
+	case string:
+		return match(typed)
+	case []interface{}:
+		for i := range typed {
+			if str, ok := typed[i].(string); ok && match(str) {
+				return true
+			}
+		}
+	case []string:
+		for i := range typed {
+			if match(typed[i]) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -21,7 +21,10 @@ const (
 )
 
 // NotNullState is encoded as 0, so it can be read with the IsNull operator and value false.
+// NullState marks properties which are not set and ExplicitNullState properties which are
+// set to null, IsNull matches both of them and IsMissing only the former.
 const (
 	InternalNotNullState = iota
 	InternalNullState
+	InternalExplicitNullState
 )
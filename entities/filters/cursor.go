@@ -14,6 +14,11 @@ package filters
 type Cursor struct {
 	After string `json:"after"`
 	Limit int    `json:"limit"`
+
+	// Snapshot is the id of a snapshot of the shards which is read instead of
+	// their current state, so that the pages of a cursor are consistent. The
+	// shards take the snapshot when it is read for the first time.
+	Snapshot string `json:"snapshot,omitempty"`
 }
 
 // ExtractCursorFromArgs gets the limit key out of a map. Not specific to
@@ -34,6 +34,9 @@ const (
 	OperatorIsNull
 	ContainsAny
 	ContainsAll
+	OperatorWithinGeoPolygon
+	OperatorWithinGeoBoundingBox
+	OperatorIsMissing
 )
 
 func (o Operator) OnValue() bool {
@@ -48,7 +51,10 @@ func (o Operator) OnValue() bool {
 		OperatorLike,
 		OperatorIsNull,
 		ContainsAny,
-		ContainsAll:
+		ContainsAll,
+		OperatorWithinGeoPolygon,
+		OperatorWithinGeoBoundingBox,
+		OperatorIsMissing:
 		return true
 	default:
 		return false
@@ -83,6 +89,12 @@ func (o Operator) Name() string {
 		return "ContainsAny"
 	case ContainsAll:
 		return "ContainsAll"
+	case OperatorWithinGeoPolygon:
+		return "WithinGeoPolygon"
+	case OperatorWithinGeoBoundingBox:
+		return "WithinGeoBoundingBox"
+	case OperatorIsMissing:
+		return "IsMissing"
 	default:
 		panic("Unknown operator")
 	}
@@ -116,14 +128,11 @@ func (v *Value) UnmarshalJSON(data []byte) error {
 	}
 
 	if v.Type == schema.DataTypeGeoCoordinates {
-		temp := struct {
-			Value GeoRange `json:"value"`
-		}{}
-
-		if err := json.Unmarshal(data, &temp); err != nil {
+		value, err := unmarshalGeoValue(data)
+		if err != nil {
 			return err
 		}
-		v.Value = temp.Value
+		v.Value = value
 	}
 
 	return nil
@@ -142,3 +151,17 @@ type GeoRange struct {
 	*models.GeoCoordinates
 	Distance float32 `json:"distance"`
 }
+
+// GeoPolygon to be used with fields of type GeoCoordinates. Identifies an
+// area by its outline, the polygon is implicitly closed.
+type GeoPolygon struct {
+	Points []models.GeoCoordinates `json:"points"`
+}
+
+// GeoBoundingBox to be used with fields of type GeoCoordinates. Identifies
+// an area by its north-west and south-east corners. A box whose left edge is
+// east of its right edge spans the antimeridian.
+type GeoBoundingBox struct {
+	TopLeft     *models.GeoCoordinates `json:"topLeft"`
+	BottomRight *models.GeoCoordinates `json:"bottomRight"`
+}
@@ -75,6 +75,47 @@ func TestSerializeValue(t *testing.T) {
 
 		assert.Equal(t, before, after)
 	})
+
+	t.Run("with a geo polygon value", func(t *testing.T) {
+		before := Value{
+			Value: GeoPolygon{
+				Points: []models.GeoCoordinates{
+					{Latitude: ptFloat32(51.51), Longitude: ptFloat32(-0.09)},
+					{Latitude: ptFloat32(51.52), Longitude: ptFloat32(-0.09)},
+					{Latitude: ptFloat32(51.52), Longitude: ptFloat32(-0.1)},
+				},
+			},
+			Type: schema.DataTypeGeoCoordinates,
+		}
+
+		bytes, err := json.Marshal(before)
+		require.Nil(t, err)
+
+		var after Value
+		err = json.Unmarshal(bytes, &after)
+		require.Nil(t, err)
+
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("with a geo bounding box value", func(t *testing.T) {
+		before := Value{
+			Value: GeoBoundingBox{
+				TopLeft:     &models.GeoCoordinates{Latitude: ptFloat32(51.52), Longitude: ptFloat32(-0.1)},
+				BottomRight: &models.GeoCoordinates{Latitude: ptFloat32(51.51), Longitude: ptFloat32(-0.09)},
+			},
+			Type: schema.DataTypeGeoCoordinates,
+		}
+
+		bytes, err := json.Marshal(before)
+		require.Nil(t, err)
+
+		var after Value
+		err = json.Unmarshal(bytes, &after)
+		require.Nil(t, err)
+
+		assert.Equal(t, before, after)
+	})
 }
 
 func ptFloat32(v float32) *float32 {
@@ -33,6 +33,9 @@ func TestOperators(t *testing.T) {
 		{op: OperatorLessThan, expectedName: "LessThan", expectedOnValue: true},
 		{op: OperatorWithinGeoRange, expectedName: "WithinGeoRange", expectedOnValue: true},
 		{op: OperatorLike, expectedName: "Like", expectedOnValue: true},
+		{op: OperatorWithinGeoPolygon, expectedName: "WithinGeoPolygon", expectedOnValue: true},
+		{op: OperatorWithinGeoBoundingBox, expectedName: "WithinGeoBoundingBox", expectedOnValue: true},
+		{op: OperatorIsMissing, expectedName: "IsMissing", expectedOnValue: true},
 		{op: OperatorAnd, expectedName: "And", expectedOnValue: false},
 		{op: OperatorOr, expectedName: "Or", expectedOnValue: false},
 	}
@@ -82,10 +82,10 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 		return err
 	}
 
-	if cw.getOperator() == OperatorIsNull {
+	if op := cw.getOperator(); op == OperatorIsNull || op == OperatorIsMissing {
 		if !cw.isType(schema.DataTypeBoolean) {
-			return errors.Errorf("operator IsNull requires a booleanValue, got %q instead",
-				cw.getValueNameFromType())
+			return errors.Errorf("operator %s requires a booleanValue, got %q instead",
+				op.Name(), cw.getValueNameFromType())
 		}
 		return nil
 	}
@@ -139,6 +139,10 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 			valueNameFromDataType(schema.DataType(prop.DataType[0])))
 	}
 
+	if cw.getOperator().IsGeo() || isGeoShape(cw.getValue()) {
+		return validateGeoClause(propName, cw)
+	}
+
 	return nil
 }
 
@@ -212,6 +216,48 @@ func validateUUIDOperators(propName schema.PropertyName, cw *clauseWrapper) erro
 	}
 }
 
+func isGeoShape(value interface{}) bool {
+	switch value.(type) {
+	case GeoPolygon, GeoBoundingBox:
+		return true
+	default:
+		return false
+	}
+}
+
+func validateGeoClause(propName schema.PropertyName, cw *clauseWrapper) error {
+	op := cw.getOperator()
+	if !cw.isType(schema.DataTypeGeoCoordinates) {
+		return errors.Errorf("operator %q can only be used on geoCoordinates props, "+
+			"property %q is not", op.Name(), propName)
+	}
+
+	var err error
+	switch value := cw.getValue().(type) {
+	case GeoRange:
+		if op != OperatorWithinGeoRange {
+			return errors.Errorf("valueGeoRange requires operator %q, got %q instead",
+				OperatorWithinGeoRange.Name(), op.Name())
+		}
+	case GeoPolygon:
+		if op != OperatorWithinGeoPolygon {
+			return errors.Errorf("valueGeoPolygon requires operator %q, got %q instead",
+				OperatorWithinGeoPolygon.Name(), op.Name())
+		}
+		err = errors.Wrap(value.Validate(), "valueGeoPolygon")
+	case GeoBoundingBox:
+		if op != OperatorWithinGeoBoundingBox {
+			return errors.Errorf("valueGeoBoundingBox requires operator %q, got %q instead",
+				OperatorWithinGeoBoundingBox.Name(), op.Name())
+		}
+		err = errors.Wrap(value.Validate(), "valueGeoBoundingBox")
+	default:
+		return errors.Errorf("unsupported value of type %T on geoCoordinates prop %q",
+			value, propName)
+	}
+	return err
+}
+
 type clauseWrapper struct {
 	clause    *Clause
 	origType  schema.DataType
@@ -20,7 +20,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
-func TestValidateIsNullOperator(t *testing.T) {
+func TestValidateNullStateOperators(t *testing.T) {
 	tests := []struct {
 		name       string
 		schemaType schema.DataType
@@ -43,6 +43,94 @@ func TestValidateIsNullOperator(t *testing.T) {
 		},
 	}
 
+	for _, op := range []Operator{OperatorIsNull, OperatorIsMissing} {
+		for _, tt := range tests {
+			t.Run(op.Name()+" "+tt.name, func(t *testing.T) {
+				sch := schema.Schema{Objects: &models.Schema{
+					Classes: []*models.Class{
+						{
+							Class: "Car",
+							Properties: []*models.Property{
+								{Name: "modelName", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+								{Name: "manufacturerName", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+								{Name: "horsepower", DataType: []string{"int"}},
+							},
+						},
+					},
+				}}
+				cl := Clause{
+					Operator: op,
+					Value:    &Value{Value: true, Type: tt.schemaType},
+					On:       &Path{Class: "Car", Property: "horsepower"},
+				}
+				err := validateClause(sch, newClauseWrapper(&cl))
+				if tt.valid {
+					require.Nil(t, err)
+				} else {
+					require.NotNil(t, err)
+				}
+			})
+		}
+	}
+}
+
+func TestValidateGeoFilter(t *testing.T) {
+	lat, lon := float32(52.5), float32(13.4)
+	geoRange := GeoRange{
+		GeoCoordinates: &models.GeoCoordinates{Latitude: &lat, Longitude: &lon},
+		Distance:       2000,
+	}
+	box := GeoBoundingBox{
+		TopLeft:     &models.GeoCoordinates{Latitude: &lat, Longitude: &lon},
+		BottomRight: &models.GeoCoordinates{Latitude: &lat, Longitude: &lon},
+	}
+
+	tests := []struct {
+		name     string
+		operator Operator
+		prop     string
+		value    *Value
+		valid    bool
+	}{
+		{
+			name:     "geo range",
+			operator: OperatorWithinGeoRange,
+			prop:     "location",
+			value:    &Value{Value: geoRange, Type: schema.DataTypeGeoCoordinates},
+			valid:    true,
+		},
+		{
+			name:     "bounding box",
+			operator: OperatorWithinGeoBoundingBox,
+			prop:     "location",
+			value:    &Value{Value: box, Type: schema.DataTypeGeoCoordinates},
+			valid:    true,
+		},
+		{
+			name:     "bounding box with geo range operator",
+			operator: OperatorWithinGeoRange,
+			prop:     "location",
+			value:    &Value{Value: box, Type: schema.DataTypeGeoCoordinates},
+			valid:    false,
+		},
+		{
+			name:     "polygon with too few points",
+			operator: OperatorWithinGeoPolygon,
+			prop:     "location",
+			value: &Value{Value: GeoPolygon{Points: []models.GeoCoordinates{
+				*geoRange.GeoCoordinates,
+			}}, Type: schema.DataTypeGeoCoordinates},
+			valid: false,
+		},
+		{
+			name:     "geo operator on text prop",
+			operator: OperatorWithinGeoPolygon,
+			prop:     "modelName",
+			value:    &Value{Value: "foo", Type: schema.DataTypeText},
+			valid:    false,
+		},
+	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			sch := schema.Schema{Objects: &models.Schema{
@@ -51,16 +139,15 @@ func TestValidateIsNullOperator(t *testing.T) {
 						Class: "Car",
 						Properties: []*models.Property{
 							{Name: "modelName", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
-							{Name: "manufacturerName", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
-							{Name: "horsepower", DataType: []string{"int"}},
+							{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
 						},
 					},
 				},
 			}}
 			cl := Clause{
-				Operator: OperatorIsNull,
-				Value:    &Value{Value: true, Type: tt.schemaType},
-				On:       &Path{Class: "Car", Property: "horsepower"},
+				Operator: tt.operator,
+				Value:    tt.value,
+				On:       &Path{Class: "Car", Property: schema.PropertyName(tt.prop)},
 			}
 			err := validateClause(sch, newClauseWrapper(&cl))
 			if tt.valid {
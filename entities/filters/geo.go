@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// IsGeo is true for all operators that are served by the geo index instead of
+// the inverted index
+func (o Operator) IsGeo() bool {
+	switch o {
+	case OperatorWithinGeoRange, OperatorWithinGeoPolygon, OperatorWithinGeoBoundingBox:
+		return true
+	default:
+		return false
+	}
+}
+
+// unmarshalGeoValue picks the concrete geo value by the fields present in the
+// serialized value, as all of them share the GeoCoordinates data type
+func unmarshalGeoValue(data []byte) (interface{}, error) {
+	keys := struct {
+		Value map[string]json.RawMessage `json:"value"`
+	}{}
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case keys.Value["points"] != nil:
+		temp := struct {
+			Value GeoPolygon `json:"value"`
+		}{}
+		if err := json.Unmarshal(data, &temp); err != nil {
+			return nil, err
+		}
+		return temp.Value, nil
+	case keys.Value["topLeft"] != nil || keys.Value["bottomRight"] != nil:
+		temp := struct {
+			Value GeoBoundingBox `json:"value"`
+		}{}
+		if err := json.Unmarshal(data, &temp); err != nil {
+			return nil, err
+		}
+		return temp.Value, nil
+	default:
+		temp := struct {
+			Value GeoRange `json:"value"`
+		}{}
+		if err := json.Unmarshal(data, &temp); err != nil {
+			return nil, err
+		}
+		return temp.Value, nil
+	}
+}
+
+// Validate checks that the polygon has at least three points, all of them
+// being valid coordinates
+func (p GeoPolygon) Validate() error {
+	if len(p.Points) < 3 {
+		return fmt.Errorf("a polygon needs at least 3 points, got %d", len(p.Points))
+	}
+	for i := range p.Points {
+		if err := validateGeoCoordinates(&p.Points[i]); err != nil {
+			return errors.Wrapf(err, "point at position %d", i)
+		}
+	}
+	return nil
+}
+
+// Contains uses the even-odd rule on the plane spanned by latitude and
+// longitude. Polygons spanning the antimeridian are not supported, split
+// them into two polygons instead.
+func (p GeoPolygon) Contains(lat, lon float32) bool {
+	inside := false
+	for i, j := 0, len(p.Points)-1; i < len(p.Points); j, i = i, i+1 {
+		latI, lonI := *p.Points[i].Latitude, *p.Points[i].Longitude
+		latJ, lonJ := *p.Points[j].Latitude, *p.Points[j].Longitude
+		if (latI > lat) != (latJ > lat) &&
+			lon < (lonJ-lonI)*(lat-latI)/(latJ-latI)+lonI {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// BoundingBox is the smallest box enclosing all points of the polygon
+func (p GeoPolygon) BoundingBox() GeoBoundingBox {
+	top, bottom := *p.Points[0].Latitude, *p.Points[0].Latitude
+	left, right := *p.Points[0].Longitude, *p.Points[0].Longitude
+	for _, point := range p.Points[1:] {
+		top = max(top, *point.Latitude)
+		bottom = min(bottom, *point.Latitude)
+		left = min(left, *point.Longitude)
+		right = max(right, *point.Longitude)
+	}
+	return GeoBoundingBox{
+		TopLeft:     &models.GeoCoordinates{Latitude: &top, Longitude: &left},
+		BottomRight: &models.GeoCoordinates{Latitude: &bottom, Longitude: &right},
+	}
+}
+
+// Validate checks that both corners are set and that the top edge is not
+// south of the bottom edge
+func (b GeoBoundingBox) Validate() error {
+	if b.TopLeft == nil {
+		return fmt.Errorf("topLeft must be set")
+	}
+	if err := validateGeoCoordinates(b.TopLeft); err != nil {
+		return errors.Wrap(err, "topLeft")
+	}
+	if b.BottomRight == nil {
+		return fmt.Errorf("bottomRight must be set")
+	}
+	if err := validateGeoCoordinates(b.BottomRight); err != nil {
+		return errors.Wrap(err, "bottomRight")
+	}
+	if *b.TopLeft.Latitude < *b.BottomRight.Latitude {
+		return fmt.Errorf("latitude of topLeft (%v) must not be smaller than "+
+			"latitude of bottomRight (%v)", *b.TopLeft.Latitude, *b.BottomRight.Latitude)
+	}
+	return nil
+}
+
+// Contains checks whether the coordinates lie within the box, including its
+// edges
+func (b GeoBoundingBox) Contains(lat, lon float32) bool {
+	if lat > *b.TopLeft.Latitude || lat < *b.BottomRight.Latitude {
+		return false
+	}
+	left, right := *b.TopLeft.Longitude, *b.BottomRight.Longitude
+	if left <= right {
+		return lon >= left && lon <= right
+	}
+	// box spans the antimeridian
+	return lon >= left || lon <= right
+}
+
+func validateGeoCoordinates(in *models.GeoCoordinates) error {
+	if in.Latitude == nil {
+		return fmt.Errorf("latitude must be set")
+	}
+	if in.Longitude == nil {
+		return fmt.Errorf("longitude must be set")
+	}
+	if lat := *in.Latitude; lat < -90 || lat > 90 {
+		return fmt.Errorf("latitude must be between -90 and 90, got %v", lat)
+	}
+	if lon := *in.Longitude; lon < -180 || lon > 180 {
+		return fmt.Errorf("longitude must be between -180 and 180, got %v", lon)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestGeoPolygon(t *testing.T) {
+	point := func(lat, lon float32) models.GeoCoordinates {
+		return models.GeoCoordinates{Latitude: &lat, Longitude: &lon}
+	}
+	// an L-shaped area, the upper right quarter is cut out
+	polygon := GeoPolygon{Points: []models.GeoCoordinates{
+		point(0, 0), point(0, 2), point(1, 2), point(1, 1), point(2, 1), point(2, 0),
+	}}
+
+	t.Run("contains", func(t *testing.T) {
+		assert.True(t, polygon.Contains(0.5, 0.5))
+		assert.True(t, polygon.Contains(0.5, 1.5))
+		assert.True(t, polygon.Contains(1.5, 0.5))
+		assert.False(t, polygon.Contains(1.5, 1.5), "cut out quarter")
+		assert.False(t, polygon.Contains(3, 0.5))
+		assert.False(t, polygon.Contains(-0.5, 0.5))
+	})
+
+	t.Run("bounding box", func(t *testing.T) {
+		box := polygon.BoundingBox()
+		assert.Equal(t, float32(2), *box.TopLeft.Latitude)
+		assert.Equal(t, float32(0), *box.TopLeft.Longitude)
+		assert.Equal(t, float32(0), *box.BottomRight.Latitude)
+		assert.Equal(t, float32(2), *box.BottomRight.Longitude)
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		assert.Nil(t, polygon.Validate())
+		assert.EqualError(t, GeoPolygon{Points: polygon.Points[:2]}.Validate(),
+			"a polygon needs at least 3 points, got 2")
+		assert.EqualError(t, GeoPolygon{Points: []models.GeoCoordinates{
+			point(0, 0), point(0, 200), point(1, 1),
+		}}.Validate(), "point at position 1: longitude must be between -180 and 180, got 200")
+		assert.EqualError(t, GeoPolygon{Points: []models.GeoCoordinates{
+			point(0, 0), {Latitude: ptFloat32(1)}, point(1, 1),
+		}}.Validate(), "point at position 1: longitude must be set")
+	})
+}
+
+func TestGeoBoundingBox(t *testing.T) {
+	box := func(top, left, bottom, right float32) GeoBoundingBox {
+		return GeoBoundingBox{
+			TopLeft:     &models.GeoCoordinates{Latitude: &top, Longitude: &left},
+			BottomRight: &models.GeoCoordinates{Latitude: &bottom, Longitude: &right},
+		}
+	}
+
+	t.Run("contains", func(t *testing.T) {
+		b := box(10, -10, -10, 10)
+		assert.True(t, b.Contains(0, 0))
+		assert.True(t, b.Contains(10, 10), "edges are included")
+		assert.False(t, b.Contains(11, 0))
+		assert.False(t, b.Contains(0, -11))
+	})
+
+	t.Run("contains across the antimeridian", func(t *testing.T) {
+		b := box(10, 170, -10, -170)
+		assert.True(t, b.Contains(0, 175))
+		assert.True(t, b.Contains(0, -175))
+		assert.False(t, b.Contains(0, 0))
+		assert.False(t, b.Contains(0, 165))
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		assert.Nil(t, box(10, -10, -10, 10).Validate())
+		assert.EqualError(t, box(-10, -10, 10, 10).Validate(),
+			"latitude of topLeft (-10) must not be smaller than latitude of bottomRight (10)")
+		assert.EqualError(t, box(95, -10, -10, 10).Validate(),
+			"topLeft: latitude must be between -90 and 90, got 95")
+		assert.EqualError(t, GeoBoundingBox{}.Validate(), "topLeft must be set")
+	})
+}
@@ -11,10 +11,29 @@
 
 package filters
 
+import "github.com/weaviate/weaviate/entities/models"
+
 // Sort contains path and order (asc, desc) information
 type Sort struct {
-	Path  []string `json:"path"`
-	Order string   `json:"order"`
+	Path      []string   `json:"path"`
+	Order     string     `json:"order"`
+	Collation *Collation `json:"collation,omitempty"`
+	// GeoPoint orders a geoCoordinates property by the distance of its
+	// coordinates from this point, nearest first for ascending order
+	GeoPoint *models.GeoCoordinates `json:"geoPoint,omitempty"`
+}
+
+// Collation controls the locale-aware ordering of text values. If no
+// collation is set, text values are compared case-insensitively by their
+// binary representation.
+type Collation struct {
+	// Locale is a BCP 47 language tag, such as "de" or "sv-SE"
+	Locale string `json:"locale"`
+	// CaseSensitive makes upper and lower case variants of a letter distinct
+	CaseSensitive bool `json:"caseSensitive"`
+	// Numeric orders digit sequences by their numeric value, so that "2"
+	// sorts before "10"
+	Numeric bool `json:"numeric"`
 }
 
 // ExtractSortFromArgs gets the sort parameters
@@ -37,9 +56,48 @@ func ExtractSortFromArgs(in []interface{}) []Sort {
 			if ok {
 				order = orderParam.(string)
 			}
-			args = append(args, Sort{path, order})
+			var collation *Collation
+			collationParam, ok := sortFilter["collation"].(map[string]interface{})
+			if ok {
+				collation = extractCollation(collationParam)
+			}
+			var geoPoint *models.GeoCoordinates
+			geoPointParam, ok := sortFilter["geoPoint"].(map[string]interface{})
+			if ok {
+				geoPoint = extractGeoPoint(geoPointParam)
+			}
+			args = append(args, Sort{
+				Path: path, Order: order, Collation: collation, GeoPoint: geoPoint,
+			})
 		}
 	}
 
 	return args
 }
+
+func extractCollation(in map[string]interface{}) *Collation {
+	collation := &Collation{}
+	if locale, ok := in["locale"].(string); ok {
+		collation.Locale = locale
+	}
+	if caseSensitive, ok := in["caseSensitive"].(bool); ok {
+		collation.CaseSensitive = caseSensitive
+	}
+	if numeric, ok := in["numeric"].(bool); ok {
+		collation.Numeric = numeric
+	}
+	return collation
+}
+
+func extractGeoPoint(in map[string]interface{}) *models.GeoCoordinates {
+	geoPoint := &models.GeoCoordinates{}
+	if lat, ok := in["latitude"].(float64); ok {
+		latitude := float32(lat)
+		geoPoint.Latitude = &latitude
+	}
+	if lon, ok := in["longitude"].(float64); ok {
+		longitude := float32(lon)
+		geoPoint.Longitude = &longitude
+	}
+	return geoPoint
+}
@@ -15,7 +15,9 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"golang.org/x/text/language"
 )
 
 func ValidateSort(sch schema.Schema, className schema.ClassName, sort []Sort) error {
@@ -57,6 +59,10 @@ func validateSortClause(sch schema.Schema, className schema.ClassName, sort Sort
 		}
 		propName := schema.PropertyName(path[0])
 		if IsInternalProperty(propName) {
+			if sort.GeoPoint != nil {
+				return errors.Errorf("geoPoint is only supported for geoCoordinates "+
+					"properties, %q is an internal property", propName)
+			}
 			// handle internal properties
 			return nil
 		}
@@ -71,13 +77,50 @@ func validateSortClause(sch schema.Schema, className schema.ClassName, sort Sort
 				"please open a feature request on github.com/weaviate/weaviate", prop.Name)
 		}
 
+		if prop.Encrypted {
+			return errors.Errorf("sorting by encrypted property %q is not supported",
+				propName)
+		}
+
 		if schema.IsRefDataType(prop.DataType) {
 			return errors.Errorf("sorting by reference not supported, "+
 				"property %q is a ref prop to the class %q", propName, prop.DataType[0])
 		}
+
+		if sort.Collation != nil {
+			return validateCollation(prop, sort.Collation)
+		}
+		if sort.GeoPoint != nil {
+			return validateGeoPoint(prop, sort.GeoPoint)
+		}
 		return nil
 	default:
 		return errors.New("sorting by reference not supported, " +
 			"path must have exactly one argument")
 	}
 }
+
+func validateCollation(prop *models.Property, collation *Collation) error {
+	dt := schema.DataType(prop.DataType[0])
+	if dt != schema.DataTypeText && dt != schema.DataTypeTextArray {
+		return errors.Errorf("collation is only supported for text/text[] properties, "+
+			"property %q is of type %q", prop.Name, dt)
+	}
+
+	if collation.Locale == "" {
+		return errors.New("collation locale cannot be empty")
+	}
+	if _, err := language.Parse(collation.Locale); err != nil {
+		return errors.Errorf("invalid collation locale %q: %v", collation.Locale, err)
+	}
+	return nil
+}
+
+func validateGeoPoint(prop *models.Property, geoPoint *models.GeoCoordinates) error {
+	dt := schema.DataType(prop.DataType[0])
+	if dt != schema.DataTypeGeoCoordinates {
+		return errors.Errorf("geoPoint is only supported for geoCoordinates properties, "+
+			"property %q is of type %q", prop.Name, dt)
+	}
+	return errors.Wrap(validateGeoCoordinates(geoPoint), "geoPoint")
+}
@@ -21,9 +21,11 @@ import (
 
 func TestSortValidation(t *testing.T) {
 	tests := []struct {
-		name  string
-		prop  string
-		valid bool
+		name      string
+		prop      string
+		collation *Collation
+		geoPoint  *models.GeoCoordinates
+		valid     bool
 	}{
 		{
 			name:  "existing prop - string",
@@ -50,6 +52,48 @@ func TestSortValidation(t *testing.T) {
 			valid: false,
 			prop:  "my_idz",
 		},
+		{
+			name:      "collation on text prop",
+			valid:     true,
+			prop:      "modelName",
+			collation: &Collation{Locale: "de-DE", Numeric: true},
+		},
+		{
+			name:      "collation on int prop",
+			valid:     false,
+			prop:      "horsepower",
+			collation: &Collation{Locale: "de"},
+		},
+		{
+			name:      "collation without locale",
+			valid:     false,
+			prop:      "modelName",
+			collation: &Collation{},
+		},
+		{
+			name:      "collation with invalid locale",
+			valid:     false,
+			prop:      "modelName",
+			collation: &Collation{Locale: "not a tag"},
+		},
+		{
+			name:     "geo point on geo prop",
+			valid:    true,
+			prop:     "location",
+			geoPoint: &models.GeoCoordinates{Latitude: ptFloat32(52.5), Longitude: ptFloat32(13.4)},
+		},
+		{
+			name:     "geo point on int prop",
+			valid:    false,
+			prop:     "horsepower",
+			geoPoint: &models.GeoCoordinates{Latitude: ptFloat32(52.5), Longitude: ptFloat32(13.4)},
+		},
+		{
+			name:     "geo point out of range",
+			valid:    false,
+			prop:     "location",
+			geoPoint: &models.GeoCoordinates{Latitude: ptFloat32(152.5), Longitude: ptFloat32(13.4)},
+		},
 	}
 
 	for _, tt := range tests {
@@ -64,14 +108,17 @@ func TestSortValidation(t *testing.T) {
 							{Name: "horsepower", DataType: []string{"int"}},
 							{Name: "my_id", DataType: []string{"uuid"}},
 							{Name: "my_idz", DataType: []string{"uuid[]"}},
+							{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
 						},
 					},
 				},
 			}}
 
 			sort := []Sort{{
-				Path:  []string{tt.prop},
-				Order: "asc",
+				Path:      []string{tt.prop},
+				Order:     "asc",
+				Collation: tt.collation,
+				GeoPoint:  tt.geoPoint,
 			}}
 
 			err := ValidateSort(sch, schema.ClassName("Car"), sort)
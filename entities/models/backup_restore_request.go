@@ -28,6 +28,9 @@ import (
 // swagger:model BackupRestoreRequest
 type BackupRestoreRequest struct {
 
+	// Restores classes under a different name. The key is the name of the class in the backup, the value the name it is restored as.
+	ClassMapping map[string]string `json:"classMapping,omitempty"`
+
 	// Custom configuration for the backup restoration process
 	Config interface{} `json:"config,omitempty"`
 
@@ -39,6 +42,12 @@ type BackupRestoreRequest struct {
 
 	// Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.
 	NodeMapping map[string]string `json:"node_mapping,omitempty"`
+
+	// Restores the state at this time (RFC3339) by replaying the archived changes made after the backup. Requires the WAL archive to be enabled.
+	PointInTime string `json:"pointInTime,omitempty"`
+
+	// Restores only these tenants of multi-tenant classes. The key is the name of the class in the backup. If the class already exists, the tenants are added to it.
+	Tenants map[string][]string `json:"tenants,omitempty"`
 }
 
 // Validate validates this backup restore request
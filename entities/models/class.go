@@ -30,9 +30,15 @@ import (
 // swagger:model Class
 type Class struct {
 
+	// chunking config
+	ChunkingConfig *ChunkingConfig `json:"chunkingConfig,omitempty"`
+
 	// Name of the class as URI relative to the schema URL.
 	Class string `json:"class,omitempty"`
 
+	// compaction config
+	CompactionConfig *CompactionConfig `json:"compactionConfig,omitempty"`
+
 	// Description of the class.
 	Description string `json:"description,omitempty"`
 
@@ -48,12 +54,21 @@ type Class struct {
 	// The properties of the class.
 	Properties []*Property `json:"properties"`
 
+	// query config
+	QueryConfig *QueryConfig `json:"queryConfig,omitempty"`
+
 	// replication config
 	ReplicationConfig *ReplicationConfig `json:"replicationConfig,omitempty"`
 
+	// segment compression config
+	SegmentCompressionConfig *SegmentCompressionConfig `json:"segmentCompressionConfig,omitempty"`
+
 	// Manage how the index should be sharded and distributed in the cluster
 	ShardingConfig interface{} `json:"shardingConfig,omitempty"`
 
+	// temporal partitioning config
+	TemporalPartitioningConfig *TemporalPartitioningConfig `json:"temporalPartitioningConfig,omitempty"`
+
 	// Vector-index config, that is specific to the type of index selected in vectorIndexType
 	VectorIndexConfig interface{} `json:"vectorIndexConfig,omitempty"`
 
@@ -62,12 +77,23 @@ type Class struct {
 
 	// Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.
 	Vectorizer string `json:"vectorizer,omitempty"`
+
+	// versioning config
+	VersioningConfig *VersioningConfig `json:"versioningConfig,omitempty"`
 }
 
 // Validate validates this class
 func (m *Class) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateChunkingConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCompactionConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateInvertedIndexConfig(formats); err != nil {
 		res = append(res, err)
 	}
@@ -80,16 +106,70 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateQueryConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateReplicationConfig(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateSegmentCompressionConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTemporalPartitioningConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVersioningConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Class) validateChunkingConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.ChunkingConfig) { // not required
+		return nil
+	}
+
+	if m.ChunkingConfig != nil {
+		if err := m.ChunkingConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("chunkingConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("chunkingConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) validateCompactionConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.CompactionConfig) { // not required
+		return nil
+	}
+
+	if m.CompactionConfig != nil {
+		if err := m.CompactionConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("compactionConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("compactionConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateInvertedIndexConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.InvertedIndexConfig) { // not required
 		return nil
@@ -154,6 +234,25 @@ func (m *Class) validateProperties(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateQueryConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.QueryConfig) { // not required
+		return nil
+	}
+
+	if m.QueryConfig != nil {
+		if err := m.QueryConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("queryConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("queryConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateReplicationConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.ReplicationConfig) { // not required
 		return nil
@@ -173,10 +272,75 @@ func (m *Class) validateReplicationConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateSegmentCompressionConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.SegmentCompressionConfig) { // not required
+		return nil
+	}
+
+	if m.SegmentCompressionConfig != nil {
+		if err := m.SegmentCompressionConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("segmentCompressionConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("segmentCompressionConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) validateTemporalPartitioningConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.TemporalPartitioningConfig) { // not required
+		return nil
+	}
+
+	if m.TemporalPartitioningConfig != nil {
+		if err := m.TemporalPartitioningConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("temporalPartitioningConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("temporalPartitioningConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) validateVersioningConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.VersioningConfig) { // not required
+		return nil
+	}
+
+	if m.VersioningConfig != nil {
+		if err := m.VersioningConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("versioningConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("versioningConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this class based on the context it is used
 func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateChunkingConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateCompactionConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateInvertedIndexConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -189,16 +353,64 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateQueryConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateReplicationConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSegmentCompressionConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTemporalPartitioningConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVersioningConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Class) contextValidateChunkingConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ChunkingConfig != nil {
+		if err := m.ChunkingConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("chunkingConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("chunkingConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) contextValidateCompactionConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.CompactionConfig != nil {
+		if err := m.CompactionConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("compactionConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("compactionConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateInvertedIndexConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.InvertedIndexConfig != nil {
@@ -251,6 +463,22 @@ func (m *Class) contextValidateProperties(ctx context.Context, formats strfmt.Re
 	return nil
 }
 
+func (m *Class) contextValidateQueryConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.QueryConfig != nil {
+		if err := m.QueryConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("queryConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("queryConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateReplicationConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.ReplicationConfig != nil {
@@ -267,6 +495,54 @@ func (m *Class) contextValidateReplicationConfig(ctx context.Context, formats st
 	return nil
 }
 
+func (m *Class) contextValidateSegmentCompressionConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.SegmentCompressionConfig != nil {
+		if err := m.SegmentCompressionConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("segmentCompressionConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("segmentCompressionConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) contextValidateTemporalPartitioningConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TemporalPartitioningConfig != nil {
+		if err := m.TemporalPartitioningConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("temporalPartitioningConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("temporalPartitioningConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) contextValidateVersioningConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.VersioningConfig != nil {
+		if err := m.VersioningConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("versioningConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("versioningConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Class) MarshalBinary() ([]byte, error) {
 	if m == nil {
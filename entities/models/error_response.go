@@ -32,6 +32,9 @@ type ErrorResponse struct {
 
 	// error
 	Error []*ErrorResponseErrorItems0 `json:"error"`
+
+	// The id of the request that caused the error. It is also returned in the X-Request-Id response header and forwarded to module providers, so it can be used to correlate a failure across systems.
+	RequestID string `json:"requestId,omitempty"`
 }
 
 // Validate validates this error response
@@ -35,6 +35,12 @@ type GraphQLResponse struct {
 
 	// Array with errors.
 	Errors []*GraphQLError `json:"errors,omitempty"`
+
+	// Execution plans of the explained queries.
+	Explain []interface{} `json:"explain,omitempty"`
+
+	// Value counts of the facet properties of the queries requesting them.
+	Facets []interface{} `json:"facets,omitempty"`
 }
 
 // Validate validates this graph q l response
@@ -28,9 +28,18 @@ import (
 // swagger:model Principal
 type Principal struct {
 
+	// The id of the managed API key the principal authenticated with, if any
+	APIKeyID string `json:"apiKeyId,omitempty"`
+
 	// groups
 	Groups []string `json:"groups"`
 
+	// Roles assigned to the principal by the authentication provider, e.g. through OIDC claims
+	Roles []string `json:"roles"`
+
+	// The tenant all requests of the principal are restricted to, if any
+	Tenant string `json:"tenant,omitempty"`
+
 	// The username that was extracted either from the authentication information
 	Username string `json:"username,omitempty"`
 }
@@ -38,6 +38,9 @@ type Property struct {
 	// Description of the property.
 	Description string `json:"description,omitempty"`
 
+	// Optional. Should the values of this property be encrypted at rest. Defaults to false. Applicable only to properties of data type text and text[], requires property encryption to be configured on the server. Encrypted properties can not be indexed in the inverted index.
+	Encrypted bool `json:"encrypted,omitempty"`
+
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules
 	IndexFilterable *bool `json:"indexFilterable,omitempty"`
 
@@ -56,6 +59,9 @@ type Property struct {
 	// The properties of the nested object(s). Applies to object and object[] data types.
 	NestedProperties []*NestedProperty `json:"nestedProperties,omitempty"`
 
+	// Optional. Marks the property as containing sensitive data, e.g. personally identifiable information. Values of sensitive properties are redacted from responses unless the requesting user holds the sensitive data reader role. Defaults to false.
+	Sensitive bool `json:"sensitive,omitempty"`
+
 	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims). Not supported for remaining data types
 	// Enum: [word lowercase whitespace field]
 	Tokenization string `json:"tokenization,omitempty"`
@@ -37,7 +37,7 @@ type WhereFilter struct {
 
 	// operator to use
 	// Example: GreaterThanEqual
-	// Enum: [And Or Equal Like NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull ContainsAny ContainsAll]
+	// Enum: [And Or Equal Like NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull ContainsAny ContainsAll WithinGeoPolygon WithinGeoBoundingBox IsMissing]
 	Operator string `json:"operator,omitempty"`
 
 	// path to the property currently being filtered
@@ -60,6 +60,12 @@ type WhereFilter struct {
 	// Example: TODO
 	ValueDateArray []string `json:"valueDateArray,omitempty"`
 
+	// value as the corners of a box
+	ValueGeoBoundingBox *WhereFilterGeoBoundingBox `json:"valueGeoBoundingBox,omitempty"`
+
+	// value as the outline of an area
+	ValueGeoPolygon *WhereFilterGeoPolygon `json:"valueGeoPolygon,omitempty"`
+
 	// value as geo coordinates and distance
 	ValueGeoRange *WhereFilterGeoRange `json:"valueGeoRange,omitempty"`
 
@@ -108,6 +114,14 @@ func (m *WhereFilter) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateValueGeoBoundingBox(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValueGeoPolygon(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateValueGeoRange(formats); err != nil {
 		res = append(res, err)
 	}
@@ -148,7 +162,7 @@ var whereFilterTypeOperatorPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull","ContainsAny","ContainsAll"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull","ContainsAny","ContainsAll","WithinGeoPolygon","WithinGeoBoundingBox","IsMissing"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -196,6 +210,15 @@ const (
 
 	// WhereFilterOperatorContainsAll captures enum value "ContainsAll"
 	WhereFilterOperatorContainsAll string = "ContainsAll"
+
+	// WhereFilterOperatorWithinGeoPolygon captures enum value "WithinGeoPolygon"
+	WhereFilterOperatorWithinGeoPolygon string = "WithinGeoPolygon"
+
+	// WhereFilterOperatorWithinGeoBoundingBox captures enum value "WithinGeoBoundingBox"
+	WhereFilterOperatorWithinGeoBoundingBox string = "WithinGeoBoundingBox"
+
+	// WhereFilterOperatorIsMissing captures enum value "IsMissing"
+	WhereFilterOperatorIsMissing string = "IsMissing"
 )
 
 // prop value enum
@@ -219,6 +242,44 @@ func (m *WhereFilter) validateOperator(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *WhereFilter) validateValueGeoBoundingBox(formats strfmt.Registry) error {
+	if swag.IsZero(m.ValueGeoBoundingBox) { // not required
+		return nil
+	}
+
+	if m.ValueGeoBoundingBox != nil {
+		if err := m.ValueGeoBoundingBox.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoBoundingBox")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoBoundingBox")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *WhereFilter) validateValueGeoPolygon(formats strfmt.Registry) error {
+	if swag.IsZero(m.ValueGeoPolygon) { // not required
+		return nil
+	}
+
+	if m.ValueGeoPolygon != nil {
+		if err := m.ValueGeoPolygon.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoPolygon")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoPolygon")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *WhereFilter) validateValueGeoRange(formats strfmt.Registry) error {
 	if swag.IsZero(m.ValueGeoRange) { // not required
 		return nil
@@ -246,6 +307,14 @@ func (m *WhereFilter) ContextValidate(ctx context.Context, formats strfmt.Regist
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateValueGeoBoundingBox(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateValueGeoPolygon(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateValueGeoRange(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -276,6 +345,38 @@ func (m *WhereFilter) contextValidateOperands(ctx context.Context, formats strfm
 	return nil
 }
 
+func (m *WhereFilter) contextValidateValueGeoBoundingBox(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ValueGeoBoundingBox != nil {
+		if err := m.ValueGeoBoundingBox.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoBoundingBox")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoBoundingBox")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *WhereFilter) contextValidateValueGeoPolygon(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ValueGeoPolygon != nil {
+		if err := m.ValueGeoPolygon.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoPolygon")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoPolygon")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *WhereFilter) contextValidateValueGeoRange(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.ValueGeoRange != nil {
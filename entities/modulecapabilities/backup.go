@@ -45,3 +45,17 @@ type BackupBackend interface {
 	Write(ctx context.Context, backupID, key string, r io.ReadCloser) (int64, error)
 	Read(ctx context.Context, backupID, key string, w io.WriteCloser) (int64, error)
 }
+
+// BackupObjectDeleter is implemented by backends which can delete objects,
+// which is required to prune backups
+type BackupObjectDeleter interface {
+	// DeleteObject deletes the object with key `key`, deleting a missing
+	// object is not an error
+	DeleteObject(ctx context.Context, backupID, key string) error
+}
+
+// BackupCredentialsReloader is implemented by backends which cache their
+// credentials, so that rotated credentials are used without a restart
+type BackupCredentialsReloader interface {
+	ReloadCredentials(ctx context.Context) error
+}
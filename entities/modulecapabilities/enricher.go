@@ -0,0 +1,27 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modulecapabilities
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// ObjectEnricher derives properties from the content of an object and sets
+// them on the object before it is stored. Modules are only asked to enrich
+// objects of classes which list them in their moduleConfig.
+type ObjectEnricher interface {
+	EnrichObject(ctx context.Context, object *models.Object,
+		class *models.Class, cfg moduletools.ClassConfig) error
+}
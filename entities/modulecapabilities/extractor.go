@@ -0,0 +1,32 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modulecapabilities
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// File is an uploaded document, such as a PDF, HTML or DOCX file
+type File struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+// FileExtractor extracts the text and metadata of a file and maps them to
+// the properties of an object of the class cfg belongs to
+type FileExtractor interface {
+	ExtractFile(ctx context.Context, file File,
+		cfg moduletools.ClassConfig) (map[string]interface{}, error)
+}
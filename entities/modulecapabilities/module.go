@@ -23,6 +23,7 @@ type ModuleType string
 const (
 	Backup              ModuleType = "Backup"
 	Extension           ModuleType = "Extension"
+	File2Text           ModuleType = "File2Text"
 	Img2Vec             ModuleType = "Img2Vec"
 	Multi2Vec           ModuleType = "Multi2Vec"
 	Ref2Vec             ModuleType = "Ref2Vec"
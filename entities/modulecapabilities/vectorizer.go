@@ -13,6 +13,7 @@ package modulecapabilities
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -42,6 +43,14 @@ type ReferenceVectorizer interface {
 		cfg moduletools.ClassConfig, findObjectFn FindObjectFn) error
 }
 
+// ReferenceVectorRecomputer is implemented by ref2vec modules whose vectors
+// change without a write to the object, e.g. because they depend on the age
+// of the references. The vectors of all objects of a class are recomputed in
+// the returned interval, an interval of 0 disables recomputing.
+type ReferenceVectorRecomputer interface {
+	RecomputeInterval(cfg moduletools.ClassConfig) time.Duration
+}
+
 type InputVectorizer interface {
 	VectorizeInput(ctx context.Context, input string,
 		cfg moduletools.ClassConfig) ([]float32, error)
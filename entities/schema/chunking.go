@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import "github.com/weaviate/weaviate/entities/models"
+
+const (
+	ChunkingSplitterSentence  = "sentence"
+	ChunkingSplitterToken     = "token"
+	ChunkingSplitterRecursive = "recursive"
+
+	// DefaultChunkSize is the maximum number of tokens per chunk if the chunk
+	// size is not set
+	DefaultChunkSize = 200
+	// DefaultChunkReferenceProperty is the name of the reference property of
+	// the chunks pointing to their parent if none is set
+	DefaultChunkReferenceProperty = "parent"
+	// ChunkIndexProperty holds the position of a chunk in its parent's text
+	ChunkIndexProperty = "chunkIndex"
+)
+
+func ChunkingEnabled(class *models.Class) bool {
+	return class != nil && class.ChunkingConfig != nil &&
+		class.ChunkingConfig.Property != ""
+}
+
+// ChunkReferenceProperty is the reference property of the chunk class which
+// points to the parent object
+func ChunkReferenceProperty(cfg *models.ChunkingConfig) string {
+	if cfg.ReferenceProperty == "" {
+		return DefaultChunkReferenceProperty
+	}
+	return cfg.ReferenceProperty
+}
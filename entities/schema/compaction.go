@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// Compaction strategies of the segments of a class, see
+// models.CompactionConfig
+const (
+	CompactionStrategySizeTiered = "sizeTiered"
+	CompactionStrategyLeveled    = "leveled"
+
+	DefaultCompactionStrategy = CompactionStrategySizeTiered
+)
+
+func ValidCompactionStrategy(strategy string) bool {
+	switch strategy {
+	case CompactionStrategySizeTiered, CompactionStrategyLeveled:
+		return true
+	default:
+		return false
+	}
+}
+
+// CompactionStrategy of the class, DefaultCompactionStrategy if it is not
+// configured
+func CompactionStrategy(class *models.Class) string {
+	if class == nil || class.CompactionConfig == nil || class.CompactionConfig.Strategy == "" {
+		return DefaultCompactionStrategy
+	}
+	return class.CompactionConfig.Strategy
+}
+
+// CompactionMaxBytesPerSecond limits the rate at which compactions of the
+// class write to disk, 0 if they are not limited
+func CompactionMaxBytesPerSecond(class *models.Class) int64 {
+	if class == nil || class.CompactionConfig == nil || class.CompactionConfig.MaxBytesPerSecond <= 0 {
+		return 0
+	}
+	return class.CompactionConfig.MaxBytesPerSecond
+}
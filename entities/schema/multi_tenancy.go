@@ -11,7 +11,11 @@
 
 package schema
 
-import "github.com/weaviate/weaviate/entities/models"
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
 
 func MultiTenancyEnabled(class *models.Class) bool {
 	if class.MultiTenancyConfig != nil {
@@ -26,3 +30,15 @@ func ActivityStatus(status string) string {
 	}
 	return status
 }
+
+// TenantStats are the resources used by a tenant on the node it is stored on
+type TenantStats struct {
+	Class            string     `json:"class"`
+	Tenant           string     `json:"tenant"`
+	Node             string     `json:"node"`
+	ActivityStatus   string     `json:"activityStatus"`
+	ObjectCount      int64      `json:"objectCount"`
+	DiskUsageBytes   int64      `json:"diskUsageBytes"`
+	VectorIndexBytes int64      `json:"vectorIndexBytes"`
+	LastAccess       *time.Time `json:"lastAccess,omitempty"`
+}
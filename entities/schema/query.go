@@ -0,0 +1,27 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// QueryTimeout is the maximum duration of queries on the class, 0 if they
+// are not limited
+func QueryTimeout(class *models.Class) time.Duration {
+	if class == nil || class.QueryConfig == nil || class.QueryConfig.TimeoutMilliseconds <= 0 {
+		return 0
+	}
+	return time.Duration(class.QueryConfig.TimeoutMilliseconds) * time.Millisecond
+}
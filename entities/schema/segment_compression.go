@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// Codecs the segments of a class can be compressed with, see
+// models.SegmentCompressionConfig
+const (
+	SegmentCompressionNone   = "none"
+	SegmentCompressionZstd   = "zstd"
+	SegmentCompressionSnappy = "snappy"
+)
+
+// ValidSegmentCompression returns whether compression names a supported codec
+func ValidSegmentCompression(compression string) bool {
+	switch compression {
+	case SegmentCompressionNone, SegmentCompressionZstd, SegmentCompressionSnappy:
+		return true
+	default:
+		return false
+	}
+}
+
+// ObjectsCompression is the codec the objects of the class are compressed
+// with, SegmentCompressionNone if it is not configured
+func ObjectsCompression(class *models.Class) string {
+	if class == nil || class.SegmentCompressionConfig == nil ||
+		class.SegmentCompressionConfig.Objects == "" {
+		return SegmentCompressionNone
+	}
+	return class.SegmentCompressionConfig.Objects
+}
+
+// InvertedCompression is the codec the searchable inverted indexes of the
+// class are compressed with, SegmentCompressionNone if it is not configured
+func InvertedCompression(class *models.Class) string {
+	if class == nil || class.SegmentCompressionConfig == nil ||
+		class.SegmentCompressionConfig.Inverted == "" {
+		return SegmentCompressionNone
+	}
+	return class.SegmentCompressionConfig.Inverted
+}
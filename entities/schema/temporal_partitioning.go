@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	TemporalPartitioningIntervalDay   = "day"
+	TemporalPartitioningIntervalMonth = "month"
+	TemporalPartitioningIntervalYear  = "year"
+
+	// DefaultTemporalPartitioningInterval is the time range of a partition if
+	// no interval is set
+	DefaultTemporalPartitioningInterval = TemporalPartitioningIntervalMonth
+)
+
+func TemporalPartitioningEnabled(class *models.Class) bool {
+	return class != nil && class.TemporalPartitioningConfig != nil &&
+		class.TemporalPartitioningConfig.Property != ""
+}
+
+// partitionLayout is the layout of the names of the partitions of the
+// interval, it is empty for unknown intervals
+func partitionLayout(interval string) string {
+	switch interval {
+	case TemporalPartitioningIntervalDay:
+		return "2006-01-02"
+	case "", TemporalPartitioningIntervalMonth:
+		return "2006-01"
+	case TemporalPartitioningIntervalYear:
+		return "2006"
+	default:
+		return ""
+	}
+}
+
+func ValidTemporalPartitioningInterval(interval string) bool {
+	return partitionLayout(interval) != ""
+}
+
+// TemporalPartition is the name of the partition containing t. Partitions
+// are aligned to UTC.
+func TemporalPartition(cfg *models.TemporalPartitioningConfig, t time.Time) string {
+	return t.UTC().Format(partitionLayout(cfg.Interval))
+}
+
+// TemporalPartitionOf is the name of the partition of a value of the
+// partition property, which is either a RFC3339 string or a time.
+func TemporalPartitionOf(cfg *models.TemporalPartitioningConfig, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return TemporalPartition(cfg, v), nil
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return "", fmt.Errorf("partition property %q: %w", cfg.Property, err)
+		}
+		return TemporalPartition(cfg, t), nil
+	case nil:
+		return "", fmt.Errorf("partition property %q must be set", cfg.Property)
+	default:
+		return "", fmt.Errorf("partition property %q must be a date, got %T",
+			cfg.Property, value)
+	}
+}
+
+// TemporalPartitionRange is the time range [start, end) of the partition
+// with the given name. It is false if the name is not the name of a
+// partition, e.g. because the tenant was created manually.
+func TemporalPartitionRange(cfg *models.TemporalPartitioningConfig, name string,
+) (start, end time.Time, ok bool) {
+	start, err := time.Parse(partitionLayout(cfg.Interval), name)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	switch cfg.Interval {
+	case TemporalPartitioningIntervalDay:
+		end = start.AddDate(0, 0, 1)
+	case TemporalPartitioningIntervalYear:
+		end = start.AddDate(1, 0, 0)
+	default:
+		end = start.AddDate(0, 1, 0)
+	}
+	return start, end, true
+}
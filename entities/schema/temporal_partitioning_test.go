@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestTemporalPartitions(t *testing.T) {
+	ts := time.Date(2023, 10, 15, 23, 30, 0, 0, time.FixedZone("", -2*3600))
+
+	tests := []struct {
+		interval string
+		name     string
+		start    time.Time
+		end      time.Time
+	}{
+		{
+			interval: TemporalPartitioningIntervalDay,
+			name:     "2023-10-16",
+			start:    time.Date(2023, 10, 16, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2023, 10, 17, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			interval: "",
+			name:     "2023-10",
+			start:    time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			interval: TemporalPartitioningIntervalYear,
+			name:     "2023",
+			start:    time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &models.TemporalPartitioningConfig{Property: "timestamp", Interval: tt.interval}
+
+			name, err := TemporalPartitionOf(cfg, ts.Format(time.RFC3339))
+			require.Nil(t, err)
+			assert.Equal(t, tt.name, name)
+			assert.Equal(t, tt.name, TemporalPartition(cfg, ts))
+
+			start, end, ok := TemporalPartitionRange(cfg, name)
+			require.True(t, ok)
+			assert.Equal(t, tt.start, start)
+			assert.Equal(t, tt.end, end)
+		})
+	}
+
+	t.Run("invalid values", func(t *testing.T) {
+		cfg := &models.TemporalPartitioningConfig{Property: "timestamp"}
+
+		_, err := TemporalPartitionOf(cfg, nil)
+		assert.EqualError(t, err, `partition property "timestamp" must be set`)
+		_, err = TemporalPartitionOf(cfg, 17)
+		assert.EqualError(t, err, `partition property "timestamp" must be a date, got int`)
+		_, err = TemporalPartitionOf(cfg, "yesterday")
+		assert.ErrorContains(t, err, `partition property "timestamp"`)
+	})
+
+	t.Run("tenants which are no partitions", func(t *testing.T) {
+		cfg := &models.TemporalPartitioningConfig{Property: "timestamp"}
+
+		_, _, ok := TemporalPartitionRange(cfg, "archive")
+		assert.False(t, ok)
+	})
+}
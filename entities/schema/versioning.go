@@ -0,0 +1,37 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// DefaultMaxVersions is the number of versions kept per object if versioning
+// is enabled without a limit
+const DefaultMaxVersions = 10
+
+func VersioningEnabled(class *models.Class) bool {
+	if class != nil && class.VersioningConfig != nil {
+		return class.VersioningConfig.Enabled
+	}
+	return false
+}
+
+// MaxVersions is the number of versions kept per object of the class,
+// including the current one
+func MaxVersions(class *models.Class) int {
+	if !VersioningEnabled(class) {
+		return 0
+	}
+	if class.VersioningConfig.MaxVersions <= 0 {
+		return DefaultMaxVersions
+	}
+	return int(class.VersioningConfig.MaxVersions)
+}
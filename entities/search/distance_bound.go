@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+type distanceBoundKey struct{}
+
+// DistanceBound collects the distances of the results of the shards of a
+// vector search with a limit. Once it has seen limit results, results of the
+// remaining shards which are further away than all of them can not make it
+// into the merged results anymore.
+type DistanceBound struct {
+	limit int
+
+	sync.Mutex
+	best []float32 // sorted, at most limit
+
+	bound atomic.Uint32 // float32 bits of the worst of best once it is full
+}
+
+// WithDistanceBound is used for queries whose shard results are merged by
+// distance and cut at limit, i.e. not for grouped or sorted searches
+func WithDistanceBound(ctx context.Context, limit int) (context.Context, *DistanceBound) {
+	b := &DistanceBound{limit: limit}
+	b.bound.Store(math.Float32bits(float32(math.Inf(1))))
+	return context.WithValue(ctx, distanceBoundKey{}, b), b
+}
+
+// Add the distances of the results of a shard
+func (b *DistanceBound) Add(dists []float32) {
+	if b == nil || b.limit <= 0 || len(dists) == 0 {
+		return
+	}
+
+	sorted := make([]float32, len(dists))
+	copy(sorted, dists)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	b.Lock()
+	defer b.Unlock()
+
+	merged := make([]float32, 0, len(b.best)+len(sorted))
+	i, j := 0, 0
+	for len(merged) < b.limit && (i < len(b.best) || j < len(sorted)) {
+		if j == len(sorted) || (i < len(b.best) && b.best[i] <= sorted[j]) {
+			merged = append(merged, b.best[i])
+			i++
+		} else {
+			merged = append(merged, sorted[j])
+			j++
+		}
+	}
+	b.best = merged
+
+	if len(b.best) == b.limit {
+		b.bound.Store(math.Float32bits(b.best[len(b.best)-1]))
+	}
+}
+
+// Bound is the distance results must not exceed to still be competitive,
+// +Inf until limit results were added
+func (b *DistanceBound) Bound() float32 {
+	if b == nil {
+		return float32(math.Inf(1))
+	}
+	return math.Float32frombits(b.bound.Load())
+}
+
+// CompetitiveResults is the number of leading results, sorted by distance,
+// which are not further away than the bound of the query. It is len(dists)
+// if the query has no bound.
+func CompetitiveResults(ctx context.Context, dists []float32) int {
+	b, ok := ctx.Value(distanceBoundKey{}).(*DistanceBound)
+	if !ok {
+		return len(dists)
+	}
+	bound := b.Bound()
+	for i, dist := range dists {
+		if dist > bound {
+			return i
+		}
+	}
+	return len(dists)
+}
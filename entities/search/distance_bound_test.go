@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package search
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistanceBound(t *testing.T) {
+	t.Run("without bound", func(t *testing.T) {
+		assert.Equal(t, 3, CompetitiveResults(context.Background(), []float32{1, 2, 3}))
+	})
+
+	t.Run("bound once limit results were added", func(t *testing.T) {
+		ctx, b := WithDistanceBound(context.Background(), 3)
+		dists := []float32{0.1, 0.4, 0.5, 0.9}
+
+		b.Add([]float32{0.3, 0.6})
+		assert.True(t, math.IsInf(float64(b.Bound()), 1))
+		assert.Equal(t, 4, CompetitiveResults(ctx, dists))
+
+		b.Add([]float32{0.7, 0.2})
+		assert.Equal(t, float32(0.6), b.Bound())
+		assert.Equal(t, 3, CompetitiveResults(ctx, dists))
+
+		b.Add([]float32{0.05, 0.4})
+		assert.Equal(t, float32(0.3), b.Bound())
+		assert.Equal(t, 1, CompetitiveResults(ctx, dists))
+	})
+
+	t.Run("results at the bound are competitive", func(t *testing.T) {
+		ctx, b := WithDistanceBound(context.Background(), 1)
+		b.Add([]float32{0.5})
+		assert.Equal(t, 2, CompetitiveResults(ctx, []float32{0.4, 0.5, 0.6}))
+	})
+
+	t.Run("nil bound", func(t *testing.T) {
+		var b *DistanceBound
+		b.Add([]float32{1})
+		assert.True(t, math.IsInf(float64(b.Bound()), 1))
+	})
+}
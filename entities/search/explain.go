@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package search
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+type (
+	explainKey    struct{}
+	queryPlansKey struct{}
+)
+
+// Strategies of shard searches reported in their plans
+const (
+	StrategyVectorIndex          = "vector index"
+	StrategyPreFilterVectorIndex = "pre-filter, vector index"
+	StrategyPreFilterFlatSearch  = "pre-filter, flat search"
+	StrategyFlatIndex            = "flat index"
+	StrategyBM25                 = "bm25"
+	StrategyPreFilterBM25        = "pre-filter, bm25"
+	StrategyFilter               = "filter"
+	StrategyList                 = "list"
+	StrategyRemote               = "remote"
+)
+
+// Stage is the duration of a stage of a shard search
+type Stage struct {
+	Name       string  `json:"name"`
+	DurationMs float64 `json:"durationMs"`
+}
+
+func NewStage(name string, took time.Duration) Stage {
+	return Stage{Name: name, DurationMs: float64(took.Microseconds()) / 1000}
+}
+
+// ShardPlan is the execution strategy of the search of a shard. The counts
+// are estimations made before searching, the stages are only reported if the
+// search was executed.
+type ShardPlan struct {
+	Shard    string `json:"shard"`
+	Node     string `json:"node,omitempty"`
+	Strategy string `json:"strategy"`
+	// Objects in the shard
+	Objects int `json:"objects"`
+	// FilterMatches are the objects matching the filter, nil without filter
+	FilterMatches *int `json:"filterMatches,omitempty"`
+	// FlatSearchCutoff is the number of filter matches below which the vector
+	// index switches to a flat search, 0 if it does not
+	FlatSearchCutoff int `json:"flatSearchCutoff,omitempty"`
+	// ExpectedCandidates are the objects which can be part of the results
+	// before the limit is applied
+	ExpectedCandidates int     `json:"expectedCandidates"`
+	Limit              int     `json:"limit"`
+	Stages             []Stage `json:"stages,omitempty"`
+	Results            *int    `json:"results,omitempty"`
+}
+
+// Explain collects the plans of the shard searches of an explained query
+type Explain struct {
+	execute bool
+
+	sync.Mutex
+	shards []ShardPlan
+}
+
+// WithExplain explains the query of ctx. Shards only plan their searches
+// without executing them unless execute is set.
+func WithExplain(ctx context.Context, execute bool) (context.Context, *Explain) {
+	e := &Explain{execute: execute}
+	return context.WithValue(ctx, explainKey{}, e), e
+}
+
+// ExplainFromContext is nil if the query is not explained
+func ExplainFromContext(ctx context.Context) *Explain {
+	e, _ := ctx.Value(explainKey{}).(*Explain)
+	return e
+}
+
+// Execute is false if the searches must only be planned
+func (e *Explain) Execute() bool {
+	return e == nil || e.execute
+}
+
+func (e *Explain) AddShard(plan ShardPlan) {
+	if e == nil {
+		return
+	}
+
+	e.Lock()
+	defer e.Unlock()
+	e.shards = append(e.shards, plan)
+}
+
+// Shards are the plans of the searched shards sorted by shard name, a
+// shard appears once per search for queries with multiple searches, such
+// as hybrid ones
+func (e *Explain) Shards() []ShardPlan {
+	e.Lock()
+	defer e.Unlock()
+	shards := make([]ShardPlan, len(e.shards))
+	copy(shards, e.shards)
+	sort.SliceStable(shards, func(i, j int) bool {
+		return shards[i].Shard < shards[j].Shard
+	})
+	return shards
+}
+
+// QueryPlan is the plan of an explained query of a request
+type QueryPlan struct {
+	Class    string      `json:"class"`
+	Executed bool        `json:"executed"`
+	TookMs   float64     `json:"tookMs"`
+	Shards   []ShardPlan `json:"shards"`
+}
+
+// QueryPlans collects the plans of the explained queries of a request
+type QueryPlans struct {
+	planOnly bool
+
+	sync.Mutex
+	plans []QueryPlan
+}
+
+// WithQueryPlans collects the plans of the explained queries of the request
+// of ctx. With planOnly every query is explained without being executed.
+func WithQueryPlans(ctx context.Context, planOnly bool) (context.Context, *QueryPlans) {
+	p := &QueryPlans{planOnly: planOnly}
+	return context.WithValue(ctx, queryPlansKey{}, p), p
+}
+
+// PlanOnly is true if the queries of the request of ctx must only be planned
+func PlanOnly(ctx context.Context) bool {
+	p, ok := ctx.Value(queryPlansKey{}).(*QueryPlans)
+	return ok && p.planOnly
+}
+
+// RecordQueryPlan reports the plan of a query to the collector of the
+// request, it returns false if the request does not collect them
+func RecordQueryPlan(ctx context.Context, plan QueryPlan) bool {
+	p, ok := ctx.Value(queryPlansKey{}).(*QueryPlans)
+	if !ok {
+		return false
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.plans = append(p.plans, plan)
+	return true
+}
+
+func (p *QueryPlans) Plans() []QueryPlan {
+	p.Lock()
+	defer p.Unlock()
+	plans := make([]QueryPlan, len(p.plans))
+	copy(plans, p.plans)
+	return plans
+}
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package search
+
+import (
+	"context"
+	"sync"
+)
+
+type facetsKey struct{}
+
+// FacetValue is a value of a facet property and the number of objects with
+// it
+type FacetValue struct {
+	Value interface{} `json:"value"`
+	Count int         `json:"count"`
+}
+
+// FacetCounts are the top values of a facet property
+type FacetCounts struct {
+	Property string       `json:"property"`
+	Values   []FacetValue `json:"values"`
+}
+
+// QueryFacets are the facets of a query, counted over the Objects it matched
+type QueryFacets struct {
+	Class   string        `json:"class"`
+	Tenant  string        `json:"tenant,omitempty"`
+	Objects int           `json:"objects"`
+	Facets  []FacetCounts `json:"facets"`
+}
+
+// Facets collects the facets of the queries of a request
+type Facets struct {
+	sync.Mutex
+	queries []QueryFacets
+}
+
+func WithFacets(ctx context.Context) (context.Context, *Facets) {
+	f := &Facets{}
+	return context.WithValue(ctx, facetsKey{}, f), f
+}
+
+// RecordFacets reports the facets of a query to the collector of the
+// request, it returns false if the request does not collect them
+func RecordFacets(ctx context.Context, facets QueryFacets) bool {
+	f, ok := ctx.Value(facetsKey{}).(*Facets)
+	if !ok {
+		return false
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	f.queries = append(f.queries, facets)
+	return true
+}
+
+func (f *Facets) Queries() []QueryFacets {
+	f.Lock()
+	defer f.Unlock()
+	queries := make([]QueryFacets, len(f.queries))
+	copy(queries, f.queries)
+	return queries
+}
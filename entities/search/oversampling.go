@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package search
+
+import (
+	"context"
+	"math"
+)
+
+type oversamplingKey struct{}
+
+// WithOversampling sets the factor by which the vector searches of the
+// query multiply their limit to get the number of candidates which compressed
+// indexes rescore with the uncompressed vectors. Factors below 1 keep the
+// rescoring configured for the index.
+func WithOversampling(ctx context.Context, factor float64) context.Context {
+	if factor < 1 {
+		return ctx
+	}
+	return context.WithValue(ctx, oversamplingKey{}, factor)
+}
+
+// RescoreLimit is the number of candidates to rescore for a vector search
+// with limit k, false if the query does not set an oversampling factor
+func RescoreLimit(ctx context.Context, k int) (int, bool) {
+	factor, ok := ctx.Value(oversamplingKey{}).(float64)
+	if !ok {
+		return 0, false
+	}
+	return int(math.Ceil(float64(k) * factor)), true
+}
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package search
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+type (
+	shardDeadlineKey  struct{}
+	partialResultsKey struct{}
+)
+
+// ShardDeadline bounds the searches of the shards of a query which accepts
+// partial results. Shards which do not finish before the deadline are
+// skipped instead of failing the whole query.
+type ShardDeadline struct {
+	deadline time.Time
+
+	sync.Mutex
+	skipped []string
+}
+
+func WithShardDeadline(ctx context.Context, deadline time.Time) (context.Context, *ShardDeadline) {
+	d := &ShardDeadline{deadline: deadline}
+	return context.WithValue(ctx, shardDeadlineKey{}, d), d
+}
+
+// ShardContext bounds the search of a shard by the shard deadline of the
+// query, if there is one
+func ShardContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	d, ok := ctx.Value(shardDeadlineKey{}).(*ShardDeadline)
+	if !ok {
+		return ctx, func() {}
+	}
+	return context.WithDeadline(ctx, d.deadline)
+}
+
+// SkipShard records a shard whose search failed with err, because its
+// context returned by ShardContext passed the deadline. It returns false if
+// the error must fail the query.
+func SkipShard(ctx context.Context, shard string, err error) bool {
+	d, ok := ctx.Value(shardDeadlineKey{}).(*ShardDeadline)
+	if !ok || err == nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return false
+	}
+
+	d.Lock()
+	defer d.Unlock()
+	d.skipped = append(d.skipped, shard)
+	return true
+}
+
+// Skipped are the shards which did not finish before the deadline, sorted
+// by name
+func (d *ShardDeadline) Skipped() []string {
+	if d == nil {
+		return nil
+	}
+
+	d.Lock()
+	defer d.Unlock()
+	skipped := make([]string, len(d.skipped))
+	copy(skipped, d.skipped)
+	sort.Strings(skipped)
+	return skipped
+}
+
+// PartialQuery is a query which returned the results of some shards of its
+// class only, because the other shards did not finish in time or because
+// they are offloaded tenants the query did not name
+type PartialQuery struct {
+	Class  string
+	Shards []string
+	// Offloaded are the tenants which were skipped since they are offloaded,
+	// they are only activated by queries which name them
+	Offloaded []string
+}
+
+// PartialResults collects the partial queries of a request, so that they can
+// be reported next to the results
+type PartialResults struct {
+	sync.Mutex
+	queries []PartialQuery
+}
+
+func WithPartialResults(ctx context.Context) (context.Context, *PartialResults) {
+	p := &PartialResults{}
+	return context.WithValue(ctx, partialResultsKey{}, p), p
+}
+
+// RecordPartialQuery reports a partial query to the collector of the
+// request, it returns false if the request does not collect them
+func RecordPartialQuery(ctx context.Context, query PartialQuery) bool {
+	p, ok := ctx.Value(partialResultsKey{}).(*PartialResults)
+	if !ok {
+		return false
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.queries = append(p.queries, query)
+	return true
+}
+
+func (p *PartialResults) Queries() []PartialQuery {
+	p.Lock()
+	defer p.Unlock()
+	queries := make([]PartialQuery, len(p.queries))
+	copy(queries, p.queries)
+	return queries
+}
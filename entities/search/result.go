@@ -13,6 +13,7 @@ package search
 
 import (
 	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -25,6 +26,7 @@ type Result struct {
 	Score                float32
 	SecondarySortValue   float32
 	ExplainScore         string
+	ScoreExplanation     *additional.ScoreExplanation
 	Dist                 float32
 	Vector               []float32
 	Beacon               string
@@ -12,10 +12,20 @@
 package searchparams
 
 type NearVector struct {
-	Vector       []float32 `json:"vector"`
-	Certainty    float64   `json:"certainty"`
-	Distance     float64   `json:"distance"`
-	WithDistance bool      `json:"-"`
+	Vector []float32 `json:"vector"`
+	// Vectors are composed into the search vector as their weighted sum,
+	// they are set instead of Vector
+	Vectors      []WeightedVector `json:"vectors"`
+	Certainty    float64          `json:"certainty"`
+	Distance     float64          `json:"distance"`
+	WithDistance bool             `json:"-"`
+}
+
+// WeightedVector is a summand of a composed near vector, a negative weight
+// subtracts the vector
+type WeightedVector struct {
+	Vector []float32 `json:"vector"`
+	Weight float64   `json:"weight"` // 0 means 1
 }
 
 type KeywordRanking struct {
@@ -49,6 +59,42 @@ type NearObject struct {
 	WithDistance bool    `json:"-"`
 }
 
+// Recommend composes the vectors of positive and negative examples into a
+// single search vector, the weighted mean of the positive examples minus
+// NegativeWeight times the weighted mean of the negative examples
+type Recommend struct {
+	Positive       []RecommendExample `json:"positive"`
+	Negative       []RecommendExample `json:"negative"`
+	NegativeWeight float64            `json:"negativeWeight"` // 0 means DefaultRecommendNegativeWeight
+	Certainty      float64            `json:"certainty"`
+	Distance       float64            `json:"distance"`
+	WithDistance   bool               `json:"-"`
+}
+
+const DefaultRecommendNegativeWeight = 0.5
+
+// RecommendExample is either an object, referenced by id or beacon, or a
+// vector
+type RecommendExample struct {
+	ID     string    `json:"id"`
+	Beacon string    `json:"beacon"`
+	Vector []float32 `json:"vector"`
+	Weight float64   `json:"weight"` // 0 means 1
+}
+
+// HasObjects is true if any example is an object, the search vector then
+// depends on the current vectors of those objects
+func (r *Recommend) HasObjects() bool {
+	for _, examples := range [][]RecommendExample{r.Positive, r.Negative} {
+		for _, ex := range examples {
+			if len(ex.Vector) == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 type ObjectMove struct {
 	ID     string
 	Beacon string
@@ -73,6 +119,22 @@ type NearTextParams struct {
 	Autocorrect  bool
 }
 
+// Diversity re-ranks the results of a vector search with Maximal Marginal
+// Relevance, Lambda trades the relevance (1) against the dissimilarity to the
+// results selected before (0)
+type Diversity struct {
+	Lambda     float64 `json:"lambda"`
+	Candidates int     `json:"candidates"` // 0 means a multiple of offset plus limit
+}
+
+// Facet counts the top values of a property over the results of a query
+type Facet struct {
+	Property string `json:"property"`
+	Limit    int    `json:"limit"` // 0 means DefaultFacetLimit
+}
+
+const DefaultFacetLimit = 10
+
 type GroupBy struct {
 	Property        string
 	Groups          int
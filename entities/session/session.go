@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package session provides read-your-writes consistency for clients of a
+// replicated cluster. Every write made within a request is recorded in the
+// session token carried by its context, which is returned to the client. A
+// client presenting the token with later requests is guaranteed to read
+// objects by id from replicas which have applied these writes. Reads are
+// served at the requested consistency level as long as the replica is up to
+// date, only stale reads are repeated at a higher level.
+package session
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+)
+
+const (
+	// Header carries the token in requests and responses
+	Header = "X-Weaviate-Session-Token"
+
+	// MaxWrites is the number of writes kept in a token, the oldest writes
+	// are dropped first. Their replication has most likely completed.
+	MaxWrites = 64
+
+	// maxTokenLength limits the size of tokens accepted from clients
+	maxTokenLength = 16 * 1024
+)
+
+// Write of a single object. Level is the consistency level the write was
+// acknowledged at, it determines the level needed to read it back. An empty
+// level stands for the default level QUORUM.
+type Write struct {
+	Class   string      `json:"c"`
+	Tenant  string      `json:"t,omitempty"`
+	ID      strfmt.UUID `json:"i"`
+	Time    int64       `json:"u"`
+	Deleted bool        `json:"d,omitempty"`
+	Level   string      `json:"l,omitempty"`
+}
+
+// AppliedTo tells if a replica has applied the write, given whether it holds
+// the object and the last update time of its copy
+func (w Write) AppliedTo(exists bool, updateTime int64) bool {
+	if w.Deleted {
+		return !exists || updateTime > w.Time
+	}
+	return exists && updateTime >= w.Time
+}
+
+type key struct {
+	class, tenant string
+	id            strfmt.UUID
+}
+
+// Token holds the latest writes of a session. It is safe for concurrent use.
+type Token struct {
+	mu     sync.Mutex
+	writes map[key]Write
+}
+
+// NewToken creates an empty token
+func NewToken() *Token {
+	return &Token{writes: map[key]Write{}}
+}
+
+// Parse a token returned by a previous request. An empty string results in
+// an empty token.
+func Parse(value string) (*Token, error) {
+	t := NewToken()
+	if value == "" {
+		return t, nil
+	}
+	if len(value) > maxTokenLength {
+		return nil, fmt.Errorf("session token exceeds %d bytes", maxTokenLength)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session token: %w", err)
+	}
+	var writes []Write
+	if err := json.Unmarshal(raw, &writes); err != nil {
+		return nil, fmt.Errorf("invalid session token: %w", err)
+	}
+	for _, w := range writes {
+		t.add(w)
+	}
+	return t, nil
+}
+
+// String encodes the token to be returned to the client
+func (t *Token) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	writes := make([]Write, 0, len(t.writes))
+	for _, w := range t.writes {
+		writes = append(writes, w)
+	}
+	sort.Slice(writes, func(i, j int) bool { return writes[i].Time < writes[j].Time })
+
+	raw, _ := json.Marshal(writes)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Len returns the number of writes in the token
+func (t *Token) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.writes)
+}
+
+// Record a write. A later write of the same object replaces the former one.
+func (t *Token) Record(w Write) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.add(w)
+}
+
+func (t *Token) add(w Write) {
+	k := key{w.Class, w.Tenant, w.ID}
+	if prev, ok := t.writes[k]; ok && prev.Time > w.Time {
+		return
+	}
+	t.writes[k] = w
+
+	for len(t.writes) > MaxWrites {
+		var oldest key
+		first := true
+		for k, w := range t.writes {
+			if first || w.Time < t.writes[oldest].Time {
+				oldest, first = k, false
+			}
+		}
+		delete(t.writes, oldest)
+	}
+}
+
+// Write returns the latest write of an object in this session
+func (t *Token) Write(class, tenant string, id strfmt.UUID) (Write, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	w, ok := t.writes[key{class, tenant, id}]
+	return w, ok
+}
+
+type contextKey struct{}
+
+// WithToken returns a copy of ctx which carries the token
+func WithToken(ctx context.Context, t *Token) context.Context {
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
+// FromContext returns the token carried by ctx or nil if there is none
+func FromContext(ctx context.Context) *Token {
+	if ctx == nil {
+		return nil
+	}
+	t, _ := ctx.Value(contextKey{}).(*Token)
+	return t
+}
+
+// Record a write in the token carried by ctx, if any
+func Record(ctx context.Context, w Write) {
+	if t := FromContext(ctx); t != nil {
+		t.Record(w)
+	}
+}
+
+// Lookup the write of an object in the token carried by ctx, if any
+func Lookup(ctx context.Context, class, tenant string, id strfmt.UUID) (Write, bool) {
+	t := FromContext(ctx)
+	if t == nil {
+		return Write{}, false
+	}
+	return t.Write(class, tenant, id)
+}
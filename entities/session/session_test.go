@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package session
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToken(t *testing.T) {
+	t.Run("encode and parse", func(t *testing.T) {
+		token := NewToken()
+		token.Record(Write{Class: "Foo", ID: "1", Time: 10, Level: "ONE"})
+		token.Record(Write{Class: "Foo", Tenant: "t1", ID: "1", Time: 11, Deleted: true})
+
+		parsed, err := Parse(token.String())
+		require.Nil(t, err)
+		assert.Equal(t, 2, parsed.Len())
+		w, ok := parsed.Write("Foo", "t1", "1")
+		require.True(t, ok)
+		assert.Equal(t, Write{Class: "Foo", Tenant: "t1", ID: "1", Time: 11, Deleted: true}, w)
+	})
+
+	t.Run("empty and invalid tokens", func(t *testing.T) {
+		token, err := Parse("")
+		require.Nil(t, err)
+		assert.Equal(t, 0, token.Len())
+
+		_, err = Parse("!!")
+		assert.NotNil(t, err)
+		_, err = Parse("bm90IGpzb24")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("latest write of an object is kept", func(t *testing.T) {
+		token := NewToken()
+		token.Record(Write{Class: "Foo", ID: "1", Time: 10})
+		token.Record(Write{Class: "Foo", ID: "1", Time: 5})
+		w, _ := token.Write("Foo", "", "1")
+		assert.Equal(t, int64(10), w.Time)
+
+		token.Record(Write{Class: "Foo", ID: "1", Time: 12, Deleted: true})
+		w, _ = token.Write("Foo", "", "1")
+		assert.True(t, w.Deleted)
+	})
+
+	t.Run("oldest writes are dropped", func(t *testing.T) {
+		token := NewToken()
+		for i := 0; i < MaxWrites+2; i++ {
+			token.Record(Write{Class: "Foo", ID: strfmt.UUID(fmt.Sprint(i)), Time: int64(i)})
+		}
+		assert.Equal(t, MaxWrites, token.Len())
+		_, ok := token.Write("Foo", "", "1")
+		assert.False(t, ok)
+		_, ok = token.Write("Foo", "", "2")
+		assert.True(t, ok)
+	})
+}
+
+func TestAppliedTo(t *testing.T) {
+	put := Write{Time: 10}
+	assert.True(t, put.AppliedTo(true, 10))
+	assert.True(t, put.AppliedTo(true, 11))
+	assert.False(t, put.AppliedTo(true, 9))
+	assert.False(t, put.AppliedTo(false, 0))
+
+	del := Write{Time: 10, Deleted: true}
+	assert.True(t, del.AppliedTo(false, 0))
+	assert.True(t, del.AppliedTo(true, 11))
+	assert.False(t, del.AppliedTo(true, 9))
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	Record(ctx, Write{Class: "Foo", ID: "1"})
+	_, ok := Lookup(ctx, "Foo", "", "1")
+	assert.False(t, ok)
+
+	ctx = WithToken(ctx, NewToken())
+	Record(ctx, Write{Class: "Foo", ID: "1", Time: 1})
+	_, ok = Lookup(ctx, "Foo", "", "1")
+	assert.True(t, ok)
+}
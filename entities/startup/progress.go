@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package startup reports the progress of loading a shard from disk, so that
+// a slow startup can be told apart from a hung one
+package startup
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	// PhaseLSMRecovery replays the write-ahead logs of the LSM stores
+	PhaseLSMRecovery = "lsm_recovery"
+	// PhaseVectorIndex replays the commit logs of the vector index
+	PhaseVectorIndex = "vector_index"
+)
+
+type progressKey struct{}
+
+// Progress of loading a single shard. A nil Progress is valid and reports
+// nothing.
+type Progress struct {
+	sync.Mutex
+	phase            string
+	walBytes         int64
+	walReplayedBytes int64
+	vectorIndex      float64
+}
+
+// NewContext returns a context which reports to p while the shard is loaded
+func NewContext(ctx context.Context, p *Progress) context.Context {
+	return context.WithValue(ctx, progressKey{}, p)
+}
+
+// FromContext returns nil if the context does not report progress
+func FromContext(ctx context.Context) *Progress {
+	p, _ := ctx.Value(progressKey{}).(*Progress)
+	return p
+}
+
+func (p *Progress) SetPhase(phase string) {
+	if p == nil {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	p.phase = phase
+}
+
+// AddWAL adds a write-ahead log of the given size which will be replayed
+func (p *Progress) AddWAL(bytes int64) {
+	if p == nil {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	p.walBytes += bytes
+}
+
+// ReplayedWAL marks a write-ahead log added with AddWAL as replayed
+func (p *Progress) ReplayedWAL(bytes int64) {
+	if p == nil {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	p.walReplayedBytes += bytes
+}
+
+// SetVectorIndex sets the ratio of the commit logs of the vector index which
+// were replayed
+func (p *Progress) SetVectorIndex(ratio float64) {
+	if p == nil {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	p.vectorIndex = ratio
+}
+
+func (p *Progress) Phase() string {
+	if p == nil {
+		return ""
+	}
+	p.Lock()
+	defer p.Unlock()
+	return p.phase
+}
+
+// WAL is the ratio of the bytes of the write-ahead logs found so far which
+// were replayed, it is 1 if there were none
+func (p *Progress) WAL() float64 {
+	if p == nil {
+		return 0
+	}
+	p.Lock()
+	defer p.Unlock()
+	if p.walBytes == 0 {
+		return 1
+	}
+	return float64(p.walReplayedBytes) / float64(p.walBytes)
+}
+
+func (p *Progress) VectorIndex() float64 {
+	if p == nil {
+		return 0
+	}
+	p.Lock()
+	defer p.Unlock()
+	return p.vectorIndex
+}
@@ -150,14 +150,14 @@ func extractTimeUnix(data []byte, propertyName string) ([]string, bool, error) {
 }
 
 func extractPropsBytes(data []byte) ([]byte, error) {
-	version := uint8(data[0])
-	if version != 1 {
-		return nil, errors.Errorf("unsupported binary marshaller version %d", version)
+	elementSize, err := vectorElementSize(data[0])
+	if err != nil {
+		return nil, err
 	}
 
 	vecLen := binary.LittleEndian.Uint16(data[discardBytesPreVector : discardBytesPreVector+2])
 
-	classNameStart := int64(discardBytesPreVector) + 2 + int64(vecLen)*4
+	classNameStart := int64(discardBytesPreVector) + 2 + int64(vecLen)*int64(elementSize)
 
 	classNameLen := binary.LittleEndian.Uint16(data[classNameStart : classNameStart+2])
 
@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package storobj
+
+import "github.com/pkg/errors"
+
+// PropertyDecrypter restores the plain text of encrypted property values.
+// Objects are unmarshalled exactly as they are stored, the shard which owns
+// the keys decrypts them before they leave it.
+type PropertyDecrypter interface {
+	DecryptProperties(props map[string]interface{}) error
+}
+
+// DecryptProperties replaces the encrypted property values of the objects
+// with their plain text in place. A nil decrypter leaves the objects
+// unchanged.
+func DecryptProperties(d PropertyDecrypter, objs ...*Object) error {
+	if d == nil {
+		return nil
+	}
+
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		props, ok := obj.Object.Properties.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if err := d.DecryptProperties(props); err != nil {
+			return errors.Wrapf(err, "object %s", obj.ID())
+		}
+	}
+
+	return nil
+}
@@ -52,6 +52,37 @@ type Object struct {
 	docID uint64
 }
 
+// MarshallerVersionFloat16 stores the vector in half precision, see
+// MarshalBinary
+const MarshallerVersionFloat16 = 2
+
+// vectorElementSize is the number of bytes per dimension of the vector of a
+// marshaller version
+func vectorElementSize(version uint8) (uint64, error) {
+	switch version {
+	case 1:
+		return 4, nil
+	case MarshallerVersionFloat16:
+		return 2, nil
+	default:
+		return 0, errors.Errorf("unsupported binary marshaller version %d", version)
+	}
+}
+
+// decodeVector fills out with the vector of a marshaller version, in holds
+// len(out) dimensions
+func decodeVector(version uint8, in []byte, out []float32) {
+	if version == MarshallerVersionFloat16 {
+		for i := range out {
+			out[i] = byteops.Float16ToFloat32(binary.LittleEndian.Uint16(in[i*2:]))
+		}
+		return
+	}
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[i*4:]))
+	}
+}
+
 func New(docID uint64) *Object {
 	return &Object{
 		MarshallerVersion: 1,
@@ -59,18 +90,10 @@ func New(docID uint64) *Object {
 	}
 }
 
+// FromObject wraps the object for storage. Properties explicitly set to nil
+// are kept, validation only lets them through if the class indexes the null
+// state, so they can be told apart from properties which are not set.
 func FromObject(object *models.Object, vector []float32) *Object {
-	// clear out nil entries of properties to make sure leaving a property out and setting it nil is identical
-	properties, ok := object.Properties.(map[string]interface{})
-	if ok {
-		for key, prop := range properties {
-			if prop == nil {
-				delete(properties, key)
-			}
-		}
-		object.Properties = properties
-	}
-
 	return &Object{
 		Object:            *object,
 		Vector:            vector,
@@ -93,8 +116,9 @@ func FromBinaryUUIDOnly(data []byte) (*Object, error) {
 
 	rw := byteops.NewReadWriter(data)
 	version := rw.ReadUint8()
-	if version != 1 {
-		return nil, errors.Errorf("unsupported binary marshaller version %d", version)
+	elementSize, err := vectorElementSize(version)
+	if err != nil {
+		return nil, err
 	}
 
 	ko.MarshallerVersion = version
@@ -110,7 +134,7 @@ func FromBinaryUUIDOnly(data []byte) (*Object, error) {
 	rw.MoveBufferPositionForward(16)
 
 	vecLen := rw.ReadUint16()
-	rw.MoveBufferPositionForward(uint64(vecLen * 4))
+	rw.MoveBufferPositionForward(uint64(vecLen) * elementSize)
 	classNameLen := rw.ReadUint16()
 
 	ko.Object.Class = string(rw.ReadBytesFromBuffer(uint64(classNameLen)))
@@ -125,8 +149,9 @@ func FromBinaryOptional(data []byte,
 
 	rw := byteops.NewReadWriter(data)
 	ko.MarshallerVersion = rw.ReadUint8()
-	if ko.MarshallerVersion != 1 {
-		return nil, errors.Errorf("unsupported binary marshaller version %d", ko.MarshallerVersion)
+	elementSize, err := vectorElementSize(ko.MarshallerVersion)
+	if err != nil {
+		return nil, err
 	}
 	ko.docID = rw.ReadUint64()
 	rw.MoveBufferPositionForward(1) // ignore kind-byte
@@ -143,13 +168,10 @@ func FromBinaryOptional(data []byte,
 	ko.VectorLen = int(vectorLength)
 	if addProp.Vector {
 		ko.Object.Vector = make([]float32, vectorLength)
-		vectorBytes := rw.ReadBytesFromBuffer(uint64(vectorLength) * 4)
-		for i := 0; i < int(vectorLength); i++ {
-			bits := binary.LittleEndian.Uint32(vectorBytes[i*4 : (i+1)*4])
-			ko.Object.Vector[i] = math.Float32frombits(bits)
-		}
+		vectorBytes := rw.ReadBytesFromBuffer(uint64(vectorLength) * elementSize)
+		decodeVector(ko.MarshallerVersion, vectorBytes, ko.Object.Vector)
 	} else {
-		rw.MoveBufferPositionForward(uint64(vectorLength) * 4)
+		rw.MoveBufferPositionForward(uint64(vectorLength) * elementSize)
 		ko.Object.Vector = nil
 	}
 	ko.Vector = ko.Object.Vector
@@ -294,6 +316,16 @@ func (ko *Object) ExplainScore() string {
 	return ""
 }
 
+func (ko *Object) ScoreExplanation() *additional.ScoreExplanation {
+	props := ko.AdditionalProperties()
+	if props != nil {
+		if exp, ok := props["scoreExplanation"].(*additional.ScoreExplanation); ok {
+			return exp
+		}
+	}
+	return nil
+}
+
 func (ko *Object) ID() strfmt.UUID {
 	return ko.Object.ID
 }
@@ -398,6 +430,7 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		AdditionalProperties: additionalProperties,
 		Score:                ko.Score(),
 		ExplainScore:         ko.ExplainScore(),
+		ScoreExplanation:     ko.ScoreExplanation(),
 		IsConsistent:         ko.IsConsistent,
 		Tenant:               tenant, // not part of the binary
 		// TODO: Beacon?
@@ -446,8 +479,8 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 		return 0, err
 	}
 
-	if version != 1 {
-		return 0, errors.Errorf("unsupported binary marshaller version %d", version)
+	if _, err := vectorElementSize(version); err != nil {
+		return 0, err
 	}
 
 	var docID uint64
@@ -455,6 +488,21 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 	return docID, err
 }
 
+// UpdateTimeFromBinary reads the update time of a marshalled object without
+// unmarshalling the rest of it
+func UpdateTimeFromBinary(in []byte) (int64, error) {
+	// version, doc id, kind, uuid and create time precede the update time
+	const offset = 1 + 8 + 1 + 16 + 8
+	if len(in) < offset+8 {
+		return 0, errors.Errorf("object binary too short: %d bytes", len(in))
+	}
+	if _, err := vectorElementSize(in[0]); err != nil {
+		return 0, err
+	}
+
+	return int64(binary.LittleEndian.Uint64(in[offset:])), nil
+}
+
 // MarshalBinary creates the binary representation of a kind object. Regardless
 // of the marshaller version the first byte is a uint8 indicating the version
 // followed by the payload which depends on the specific version
@@ -478,8 +526,16 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 // n          | []byte    | meta as json
 // 2          | uint32    | length of vectorweights json
 // n          | []byte    | vectorweights as json
+//
+// Version 2
+// Same as version 1, except for the vector which is stored in half precision
+// No. of B   | Type      | Content
+// ------------------------------------------------
+// 2          | uint16    | VectorLength
+// n*2        | []float16 | vector of length n
 func (ko *Object) MarshalBinary() ([]byte, error) {
-	if ko.MarshallerVersion != 1 {
+	elementSize, err := vectorElementSize(ko.MarshallerVersion)
+	if err != nil {
 		return nil, errors.Errorf("unsupported marshaller version %d", ko.MarshallerVersion)
 	}
 
@@ -514,7 +570,7 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	}
 	vectorWeightsLength := uint32(len(vectorWeights))
 
-	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*4 + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength
+	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*uint32(elementSize) + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength
 	byteBuffer := make([]byte, totalBufferLength)
 	rw := byteops.NewReadWriter(byteBuffer)
 	rw.WriteByte(ko.MarshallerVersion)
@@ -528,7 +584,11 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	rw.WriteUint16(uint16(vectorLength))
 
 	for j := uint32(0); j < vectorLength; j++ {
-		rw.WriteUint32(math.Float32bits(ko.Vector[j]))
+		if ko.MarshallerVersion == MarshallerVersionFloat16 {
+			rw.WriteUint16(byteops.Float32ToFloat16(ko.Vector[j]))
+		} else {
+			rw.WriteUint32(math.Float32bits(ko.Vector[j]))
+		}
 	}
 
 	rw.WriteUint16(uint16(classNameLength))
@@ -561,8 +621,9 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 //
 // Check MarshalBinary for the order of elements in the input array
 func UnmarshalPropertiesFromObject(data []byte, properties *map[string]interface{}, aggregationProperties []string, propStrings [][]string) error {
-	if data[0] != uint8(1) {
-		return errors.Errorf("unsupported binary marshaller version %d", data[0])
+	elementSize, err := vectorElementSize(data[0])
+	if err != nil {
+		return err
 	}
 
 	// clear out old values in case an object misses values. This should NOT shrink the capacity of the map, eg there
@@ -573,9 +634,9 @@ func UnmarshalPropertiesFromObject(data []byte, properties *map[string]interface
 
 	startPos := uint64(1 + 8 + 1 + 16 + 8 + 8) // elements at the start
 	rw := byteops.NewReadWriter(data, byteops.WithPosition(startPos))
-	// get the length of the vector, each element is a float32 (4 bytes)
+	// get the length of the vector, the size of the elements depends on the version
 	vectorLength := uint64(rw.ReadUint16())
-	rw.MoveBufferPositionForward(vectorLength * 4)
+	rw.MoveBufferPositionForward(vectorLength * elementSize)
 
 	classnameLength := uint64(rw.ReadUint16())
 	rw.MoveBufferPositionForward(classnameLength)
@@ -647,8 +708,9 @@ func parseValues(dt jsonparser.ValueType, value []byte) (interface{}, error) {
 // see MarshalBinary for the exact contents of each version
 func (ko *Object) UnmarshalBinary(data []byte) error {
 	version := data[0]
-	if version != 1 {
-		return errors.Errorf("unsupported binary marshaller version %d", version)
+	elementSize, err := vectorElementSize(version)
+	if err != nil {
+		return err
 	}
 	ko.MarshallerVersion = version
 
@@ -668,9 +730,7 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 	vectorLength := rw.ReadUint16()
 	ko.VectorLen = int(vectorLength)
 	ko.Vector = make([]float32, vectorLength)
-	for j := 0; j < int(vectorLength); j++ {
-		ko.Vector[j] = math.Float32frombits(rw.ReadUint32())
-	}
+	decodeVector(version, rw.ReadBytesFromBuffer(uint64(vectorLength)*elementSize), ko.Vector)
 
 	classNameLength := uint64(rw.ReadUint16())
 	className, err := rw.CopyBytesFromBuffer(classNameLength, nil)
@@ -713,7 +773,8 @@ func VectorFromBinary(in []byte, buffer []float32) ([]float32, error) {
 	}
 
 	version := in[0]
-	if version != 1 {
+	elementSize, err := vectorElementSize(version)
+	if err != nil {
 		return nil, errors.Errorf("unsupported marshaller version %d", version)
 	}
 
@@ -730,14 +791,8 @@ func VectorFromBinary(in []byte, buffer []float32) ([]float32, error) {
 		out = make([]float32, vecLen)
 	}
 	vecStart := 44
-	vecEnd := vecStart + int(vecLen*4)
-
-	i := 0
-	for start := vecStart; start < vecEnd; start += 4 {
-		asUint := binary.LittleEndian.Uint32(in[start : start+4])
-		out[i] = math.Float32frombits(asUint)
-		i++
-	}
+	vecEnd := vecStart + int(vecLen)*int(elementSize)
+	decodeVector(version, in[vecStart:vecEnd], out)
 
 	return out, nil
 }
@@ -775,6 +830,21 @@ func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className
 			}
 		}
 
+		if prop, ok := additionalProperties["scoreExplanation"]; ok {
+			if explanationMap, ok := prop.(map[string]interface{}); ok {
+				marshalled, err := json.Marshal(explanationMap)
+				if err != nil {
+					return err
+				}
+				var explanation additional.ScoreExplanation
+				err = json.Unmarshal(marshalled, &explanation)
+				if err != nil {
+					return err
+				}
+				additionalProperties["scoreExplanation"] = &explanation
+			}
+		}
+
 		if prop, ok := additionalProperties["group"]; ok {
 			if groupMap, ok := prop.(map[string]interface{}); ok {
 				marshalled, err := json.Marshal(groupMap)
@@ -13,6 +13,7 @@ package storobj
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/byteops"
 )
 
 func TestStorageObjectMarshalling(t *testing.T) {
@@ -35,6 +37,10 @@ func TestStorageObjectMarshalling(t *testing.T) {
 				"classification": &additional.Classification{
 					BasedOn: []string{"some", "fields"},
 				},
+				"scoreExplanation": &additional.ScoreExplanation{
+					Score: 1.5,
+					Terms: []additional.TermScore{{Term: "foo", Frequency: 1, PropLength: 2, Idf: 1.2, Score: 1.5}},
+				},
 				"interpretation": map[string]interface{}{
 					"Source": []interface{}{
 						map[string]interface{}{
@@ -86,14 +92,88 @@ func TestStorageObjectMarshalling(t *testing.T) {
 	})
 }
 
-func TestFilteringNilProperty(t *testing.T) {
+func TestStorageObjectMarshallingFloat16(t *testing.T) {
+	vector := []float32{1, -0.5, 0.1, 1000}
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+		},
+		vector,
+	)
+	before.MarshallerVersion = MarshallerVersionFloat16
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+	full := *before
+	full.MarshallerVersion = 1
+	asFloat32Binary, err := full.MarshalBinary()
+	require.Nil(t, err)
+	assert.Equal(t, len(asFloat32Binary)-2*len(vector), len(asBinary))
+
+	rounded := byteops.RoundToFloat16(vector)
+
+	t.Run("unmarshal", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, uint8(MarshallerVersionFloat16), after.MarshallerVersion)
+		assert.Equal(t, rounded, after.Vector)
+		assert.Equal(t, before.Object.Properties, after.Object.Properties)
+	})
+
+	t.Run("unmarshal optional", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{Vector: true})
+		require.Nil(t, err)
+		assert.Equal(t, rounded, after.Vector)
+		assert.Equal(t, "MyFavoriteClass", after.Object.Class)
+		assert.Equal(t, before.Object.Properties, after.Object.Properties)
+	})
+
+	t.Run("vector only", func(t *testing.T) {
+		vec, err := VectorFromBinary(asBinary, nil)
+		require.Nil(t, err)
+		assert.Equal(t, rounded, vec)
+	})
+
+	t.Run("uuid only", func(t *testing.T) {
+		after, err := FromBinaryUUIDOnly(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before.ID(), after.ID())
+		assert.Equal(t, "MyFavoriteClass", after.Object.Class)
+	})
+
+	t.Run("extract single text prop", func(t *testing.T) {
+		prop, ok, err := ParseAndExtractTextProp(asBinary, "name")
+		require.Nil(t, err)
+		require.True(t, ok)
+		require.NotEmpty(t, prop)
+		assert.Equal(t, "MyName", prop[0])
+	})
+
+	t.Run("doc id and update time", func(t *testing.T) {
+		id, err := DocIDFromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(7), id)
+		updated, err := UpdateTimeFromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, int64(56789), updated)
+	})
+}
+
+func TestKeepingNilProperty(t *testing.T) {
 	object := FromObject(
 		&models.Object{
 			Class: "MyFavoriteClass",
 			ID:    "73f2eb5f-5abf-447a-81ca-74b1dd168247",
 			Properties: map[string]interface{}{
-				"IWillBeRemoved": nil,
-				"IWillStay":      float64(17),
+				"IAmNull":   nil,
+				"IWillStay": float64(17),
 			},
 		},
 		[]float32{1, 2, 0.7},
@@ -103,8 +183,16 @@ func TestFilteringNilProperty(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, propsTyped["IWillStay"], float64(17))
 
-	elem, ok := propsTyped["IWillBeRemoved"]
-	require.False(t, ok)
+	elem, ok := propsTyped["IAmNull"]
+	require.True(t, ok)
+	require.Nil(t, elem)
+
+	asBinary, err := object.MarshalBinary()
+	require.Nil(t, err)
+	after, err := FromBinary(asBinary)
+	require.Nil(t, err)
+	elem, ok = after.Properties().(map[string]interface{})["IAmNull"]
+	require.True(t, ok)
 	require.Nil(t, elem)
 }
 
@@ -600,3 +688,44 @@ func TestStorageMaxVectorDimensionsObjectMarshalling(t *testing.T) {
 		})
 	}
 }
+
+type upperDecrypter struct{}
+
+func (upperDecrypter) DecryptProperties(props map[string]interface{}) error {
+	for name, value := range props {
+		if str, ok := value.(string); ok {
+			props[name] = strings.ToUpper(str)
+		}
+	}
+	return nil
+}
+
+func TestDecryptProperties(t *testing.T) {
+	newObj := func() *Object {
+		return FromObject(&models.Object{
+			Class:      "Patient",
+			ID:         "73f2eb5f-5abf-447a-81ca-74b1dd168247",
+			Properties: map[string]interface{}{"name": "john"},
+		}, nil)
+	}
+
+	t.Run("without decrypter", func(t *testing.T) {
+		obj := newObj()
+		require.Nil(t, DecryptProperties(nil, obj))
+		assert.Equal(t, "john", obj.Properties().(map[string]interface{})["name"])
+	})
+
+	t.Run("with decrypter", func(t *testing.T) {
+		obj := newObj()
+		require.Nil(t, DecryptProperties(upperDecrypter{}, obj, nil))
+		assert.Equal(t, "JOHN", obj.Properties().(map[string]interface{})["name"])
+	})
+
+	t.Run("unmarshalling does not decrypt", func(t *testing.T) {
+		data, err := newObj().MarshalBinary()
+		require.Nil(t, err)
+		obj, err := FromBinary(data)
+		require.Nil(t, err)
+		assert.Equal(t, "john", obj.Properties().(map[string]interface{})["name"])
+	})
+}
@@ -0,0 +1,24 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package storobj
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// ObjectVersion is a version of an object of a class with versioning
+// enabled. Versions are identified by the update time of the object in
+// milliseconds, which is the same on all replicas. A deletion is kept as a
+// version without object, identified by the time of the deletion.
+type ObjectVersion struct {
+	Version int64          `json:"version"`
+	Deleted bool           `json:"deleted,omitempty"`
+	Object  *models.Object `json:"object,omitempty"`
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package tokens counts the tokens which modules send to and receive from
+// the providers they call. Modules report the counts returned by the
+// provider to the meter in the context of the module call, the caller of the
+// module attributes them.
+package tokens
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Counts of tokens of one or more provider calls
+type Counts struct {
+	Input  int64 `json:"input"`
+	Output int64 `json:"output"`
+}
+
+// Meter adds up the tokens reported during a module call. Modules may call
+// their provider concurrently, reporting is safe for concurrent use.
+type Meter struct {
+	input  atomic.Int64
+	output atomic.Int64
+}
+
+type meterKey struct{}
+
+// WithMeter returns a context which the tokens reported by the module calls
+// made with it are added to the returned meter
+func WithMeter(ctx context.Context) (context.Context, *Meter) {
+	m := &Meter{}
+	return context.WithValue(ctx, meterKey{}, m), m
+}
+
+// Counts returns the tokens reported so far
+func (m *Meter) Counts() Counts {
+	if m == nil {
+		return Counts{}
+	}
+
+	return Counts{Input: m.input.Load(), Output: m.output.Load()}
+}
+
+// Report adds the tokens sent to and received from a provider to the meter
+// of the context, it does nothing if the context has no meter
+func Report(ctx context.Context, input, output int64) {
+	m, ok := ctx.Value(meterKey{}).(*Meter)
+	if !ok {
+		return
+	}
+
+	m.input.Add(input)
+	m.output.Add(output)
+}
@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tokens
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeter(t *testing.T) {
+	ctx, m := WithMeter(context.Background())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Report(ctx, 3, 1)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, Counts{Input: 30, Output: 10}, m.Counts())
+
+	// without a meter reports are dropped
+	Report(context.Background(), 3, 1)
+	assert.Equal(t, Counts{}, (*Meter)(nil).Counts())
+}
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tracing
+
+import (
+	"net/http"
+)
+
+// Handler starts a server span for every request. The trace context of the
+// request must have been read with FromIncoming before.
+func Handler(tracer *Tracer, next http.Handler) http.Handler {
+	if tracer == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracer.StartServer(r.Context(), "HTTP "+r.Method)
+		span.SetAttribute("http.method", r.Method)
+		span.SetAttribute("http.target", r.URL.Path)
+
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r.WithContext(ctx))
+
+		span.SetAttribute("http.status_code", sw.status)
+		if sw.status >= http.StatusInternalServerError {
+			span.RecordError(errorStatus(sw.status))
+		}
+		span.End()
+	})
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	w.status = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+type errorStatus int
+
+func (s errorStatus) Error() string {
+	return http.StatusText(int(s))
+}
+
+// Transport starts a client span for every request of traced requests and
+// sets the headers of the request id and trace context
+type Transport struct {
+	Base http.RoundTripper
+}
+
+func (t Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx, span := StartClient(r.Context(), "HTTP "+r.Method)
+	span.SetAttribute("http.method", r.Method)
+	span.SetAttribute("http.target", r.URL.Path)
+	span.SetAttribute("net.peer.name", r.URL.Host)
+
+	// a round tripper must not modify the request
+	r = r.Clone(ctx)
+	Inject(r)
+
+	resp, err := t.Base.RoundTrip(r)
+	if err != nil {
+		span.RecordError(err)
+	} else {
+		span.SetAttribute("http.status_code", resp.StatusCode)
+		if resp.StatusCode >= http.StatusInternalServerError {
+			span.RecordError(errorStatus(resp.StatusCode))
+		}
+	}
+	span.End()
+	return resp, err
+}
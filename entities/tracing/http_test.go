@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHTTPPropagation traces a request of a coordinator to a shard node,
+// both share the exporter
+func TestHTTPPropagation(t *testing.T) {
+	exporter := &recordingExporter{}
+	tracer := NewTracer(exporter, 1)
+
+	var requestID string
+	shard := Handler(tracer, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, span := Start(r.Context(), "shard")
+		span.End()
+		requestID = RequestID(r.Context())
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	shardNode := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, _ := FromIncoming(r.Context(), r.Header.Get)
+		shard.ServeHTTP(w, r.WithContext(ctx))
+	}))
+	defer shardNode.Close()
+	client := &http.Client{Transport: Transport{Base: http.DefaultTransport}}
+
+	ctx := WithRequestID(context.Background(), "my-request")
+	ctx, root := tracer.StartServer(ctx, "coordinator")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, shardNode.URL+"/indices/Article", nil)
+	require.Nil(t, err)
+	res, err := client.Do(req)
+	require.Nil(t, err)
+	io.Copy(io.Discard, res.Body)
+	res.Body.Close()
+	root.End()
+
+	assert.Equal(t, "my-request", requestID)
+	assert.Empty(t, req.Header, "the request of the caller is not modified")
+
+	require.Len(t, exporter.spans, 4)
+	spans := map[SpanKind]SpanData{}
+	var shardSpan SpanData
+	for _, span := range exporter.spans {
+		assert.Equal(t, root.Context().TraceID, span.Context.TraceID, span.Name)
+		switch span.Name {
+		case "shard":
+			shardSpan = span
+		case "HTTP POST":
+			spans[span.Kind] = span
+		}
+	}
+	assert.Equal(t, root.Context().SpanID, spans[SpanKindClient].Parent)
+	assert.Equal(t, spans[SpanKindClient].Context.SpanID, spans[SpanKindServer].Parent)
+	assert.Equal(t, spans[SpanKindServer].Context.SpanID, shardSpan.Parent)
+	assert.Equal(t, "/indices/Article", spans[SpanKindClient].Attributes["http.target"])
+	assert.Equal(t, http.StatusTeapot, spans[SpanKindServer].Attributes["http.status_code"])
+}
@@ -0,0 +1,290 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tracing
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+type (
+	TraceID [16]byte
+	SpanID  [8]byte
+)
+
+func (id TraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+func (id SpanID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// SpanContext identifies a span across processes, it is propagated in the
+// W3C traceparent header
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+// ParseTraceparent parses a W3C traceparent header. Headers of future
+// versions are parsed as version 00, as the spec requires.
+func ParseTraceparent(value string) (SpanContext, bool) {
+	var c SpanContext
+	if len(value) < 55 || value[2] != '-' || value[35] != '-' || value[52] != '-' {
+		return c, false
+	}
+	if value[:2] == "ff" || (value[:2] == "00" && len(value) != 55) ||
+		(len(value) > 55 && value[55] != '-') {
+		return c, false
+	}
+
+	var version, flags [1]byte
+	if _, err := hex.Decode(version[:], []byte(value[:2])); err != nil {
+		return c, false
+	}
+	if _, err := hex.Decode(c.TraceID[:], []byte(value[3:35])); err != nil {
+		return c, false
+	}
+	if _, err := hex.Decode(c.SpanID[:], []byte(value[36:52])); err != nil {
+		return c, false
+	}
+	if _, err := hex.Decode(flags[:], []byte(value[53:55])); err != nil {
+		return c, false
+	}
+	if c.TraceID == (TraceID{}) || c.SpanID == (SpanID{}) {
+		return c, false
+	}
+
+	c.Sampled = flags[0]&1 == 1
+	return c, true
+}
+
+func (c SpanContext) Traceparent() string {
+	flags := "00"
+	if c.Sampled {
+		flags = "01"
+	}
+	return "00-" + c.TraceID.String() + "-" + c.SpanID.String() + "-" + flags
+}
+
+// SpanKind has the values of the OTLP span kinds
+type SpanKind int
+
+const (
+	SpanKindInternal SpanKind = iota + 1
+	SpanKindServer
+	SpanKindClient
+)
+
+// SpanData is a finished span as it is exported
+type SpanData struct {
+	Name       string
+	Kind       SpanKind
+	Context    SpanContext
+	Parent     SpanID // zero for root spans
+	Start      time.Time
+	End        time.Time
+	Attributes map[string]interface{}
+	Error      string
+}
+
+// Exporter sends finished spans to a tracing backend. ExportSpan is called
+// on the request path and must not block.
+type Exporter interface {
+	ExportSpan(span SpanData)
+}
+
+// Tracer starts the spans of the requests served by this node. The spans
+// of the work done for a request are started from its context, so code
+// which traces its work only needs the context of the request. A nil tracer
+// starts no spans.
+type Tracer struct {
+	exporter    Exporter
+	sampleRatio float64
+	random      func() float64
+}
+
+// NewTracer samples the given ratio of the requests which are not part of
+// a trace yet. Requests which continue a trace follow the sampling decision
+// of their caller.
+func NewTracer(exporter Exporter, sampleRatio float64) *Tracer {
+	return &Tracer{exporter: exporter, sampleRatio: sampleRatio, random: rand.Float64}
+}
+
+// StartServer starts the span of a request, continuing the trace of the
+// caller if its trace context was read with FromIncoming
+func (t *Tracer) StartServer(ctx context.Context, name string) (context.Context, *Span) {
+	if t == nil {
+		return ctx, nil
+	}
+
+	var parent SpanContext
+	traceparent, _ := ctx.Value(traceparentKey).(string)
+	if remote, ok := ParseTraceparent(traceparent); ok {
+		parent = remote
+	} else {
+		parent.TraceID = newTraceID()
+		parent.Sampled = t.sampleRatio >= 1 || t.random() < t.sampleRatio
+	}
+
+	span := t.newSpan(name, SpanKindServer, parent)
+	return ContextWithSpan(ctx, span), span
+}
+
+func (t *Tracer) newSpan(name string, kind SpanKind, parent SpanContext) *Span {
+	return &Span{
+		tracer: t,
+		data: SpanData{
+			Name: name,
+			Kind: kind,
+			Context: SpanContext{
+				TraceID: parent.TraceID,
+				SpanID:  newSpanID(),
+				Sampled: parent.Sampled,
+			},
+			Parent: parent.SpanID,
+			Start:  time.Now(),
+		},
+	}
+}
+
+// Span is an operation of a traced request. Spans of requests which are not
+// sampled propagate the trace context but are not exported. All methods are
+// nil-safe, a nil span is returned for requests which are not traced.
+type Span struct {
+	tracer *Tracer
+
+	sync.Mutex
+	data  SpanData
+	ended bool
+}
+
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	return context.WithValue(ctx, spanKey, span)
+}
+
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	span, _ := ctx.Value(spanKey).(*Span)
+	return span
+}
+
+// Start starts a child of the span of the context. Nothing is started if
+// the request is not traced.
+func Start(ctx context.Context, name string) (context.Context, *Span) {
+	return start(ctx, name, SpanKindInternal)
+}
+
+// StartClient starts a child span for a request to another service
+func StartClient(ctx context.Context, name string) (context.Context, *Span) {
+	return start(ctx, name, SpanKindClient)
+}
+
+func start(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	parent := SpanFromContext(ctx)
+	if parent == nil {
+		return ctx, nil
+	}
+
+	span := parent.tracer.newSpan(name, kind, parent.data.Context)
+	return ContextWithSpan(ctx, span), span
+}
+
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.data.Context
+}
+
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil || !s.data.Context.Sampled {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if s.data.Attributes == nil {
+		s.data.Attributes = map[string]interface{}{}
+	}
+	s.data.Attributes[key] = value
+}
+
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.data.Error = err.Error()
+}
+
+// RecordChild exports a finished child span, for operations which are
+// only timed once they are done
+func (s *Span) RecordChild(name string, start, end time.Time) {
+	if s == nil || !s.data.Context.Sampled {
+		return
+	}
+
+	child := s.tracer.newSpan(name, SpanKindInternal, s.data.Context)
+	child.data.Start = start
+	child.data.End = end
+	s.tracer.exporter.ExportSpan(child.data)
+}
+
+// End finishes the span, it is exported if the request is sampled. Only
+// the first call has an effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.Lock()
+	if s.ended {
+		s.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.End = time.Now()
+	data := s.data
+	s.Unlock()
+
+	if data.Context.Sampled {
+		s.tracer.exporter.ExportSpan(data)
+	}
+}
+
+func newTraceID() TraceID {
+	var id TraceID
+	for id == (TraceID{}) {
+		binary.LittleEndian.PutUint64(id[:8], rand.Uint64())
+		binary.LittleEndian.PutUint64(id[8:], rand.Uint64())
+	}
+	return id
+}
+
+func newSpanID() SpanID {
+	var id SpanID
+	for id == (SpanID{}) {
+		binary.LittleEndian.PutUint64(id[:], rand.Uint64())
+	}
+	return id
+}
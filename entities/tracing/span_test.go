@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingExporter struct {
+	sync.Mutex
+	spans []SpanData
+}
+
+func (e *recordingExporter) ExportSpan(span SpanData) {
+	e.Lock()
+	defer e.Unlock()
+	e.spans = append(e.spans, span)
+}
+
+func (e *recordingExporter) byName() map[string]SpanData {
+	e.Lock()
+	defer e.Unlock()
+	spans := map[string]SpanData{}
+	for _, span := range e.spans {
+		spans[span.Name] = span
+	}
+	return spans
+}
+
+const traceparent = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+
+func TestParseTraceparent(t *testing.T) {
+	c, ok := ParseTraceparent(traceparent)
+	require.True(t, ok)
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", c.TraceID.String())
+	assert.Equal(t, "b7ad6b7169203331", c.SpanID.String())
+	assert.True(t, c.Sampled)
+	assert.Equal(t, traceparent, c.Traceparent())
+
+	c, ok = ParseTraceparent("01-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-00-future")
+	require.True(t, ok)
+	assert.False(t, c.Sampled)
+
+	for _, invalid := range []string{
+		"",
+		"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331",
+		"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01-extra",
+		"ff-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+		"00-00000000000000000000000000000000-b7ad6b7169203331-01",
+		"00-0af7651916cd43dd8448eb211c80319c-0000000000000000-01",
+		"00-0af7651916cd43dd8448eb211c80319x-b7ad6b7169203331-01",
+	} {
+		_, ok := ParseTraceparent(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
+func TestTracer(t *testing.T) {
+	t.Run("continues the trace of the caller", func(t *testing.T) {
+		exporter := &recordingExporter{}
+		tracer := NewTracer(exporter, 0)
+		ctx := WithTraceContext(context.Background(), traceparent, "")
+
+		ctx, root := tracer.StartServer(ctx, "request")
+		childCtx, child := Start(ctx, "search")
+		child.SetAttribute("class", "Article")
+		child.RecordError(errors.New("shard down"))
+		before := time.Now()
+		child.RecordChild("filter", before.Add(-time.Millisecond), before)
+		child.End()
+		child.End()
+
+		req, err := http.NewRequestWithContext(childCtx, http.MethodPost, "http://provider", nil)
+		require.Nil(t, err)
+		Inject(req)
+		assert.Equal(t, child.Context().Traceparent(), req.Header.Get(TraceparentHeader))
+		root.End()
+
+		spans := exporter.byName()
+		require.Len(t, exporter.spans, 3)
+		remote, _ := ParseTraceparent(traceparent)
+		assert.Equal(t, remote.TraceID, spans["request"].Context.TraceID)
+		assert.Equal(t, remote.SpanID, spans["request"].Parent)
+		assert.Equal(t, SpanKindServer, spans["request"].Kind)
+		assert.Equal(t, spans["request"].Context.SpanID, spans["search"].Parent)
+		assert.Equal(t, spans["search"].Context.SpanID, spans["filter"].Parent)
+		assert.Equal(t, remote.TraceID, spans["filter"].Context.TraceID)
+		assert.Equal(t, map[string]interface{}{"class": "Article"}, spans["search"].Attributes)
+		assert.Equal(t, "shard down", spans["search"].Error)
+		assert.Equal(t, time.Millisecond, spans["filter"].End.Sub(spans["filter"].Start))
+	})
+
+	t.Run("samples new traces", func(t *testing.T) {
+		exporter := &recordingExporter{}
+		tracer := NewTracer(exporter, 0.5)
+
+		tracer.random = func() float64 { return 0.4 }
+		_, sampled := tracer.StartServer(context.Background(), "sampled")
+		sampled.End()
+
+		tracer.random = func() float64 { return 0.6 }
+		ctx, notSampled := tracer.StartServer(context.Background(), "not sampled")
+		_, child := Start(ctx, "child")
+		child.End()
+		notSampled.End()
+
+		require.Len(t, exporter.spans, 1)
+		assert.Equal(t, "sampled", exporter.spans[0].Name)
+		assert.Equal(t, SpanID{}, exporter.spans[0].Parent)
+		// the decision is propagated
+		assert.False(t, child.Context().Sampled)
+		assert.Equal(t, notSampled.Context().TraceID, child.Context().TraceID)
+	})
+
+	t.Run("untraced requests", func(t *testing.T) {
+		var tracer *Tracer
+		ctx, span := tracer.StartServer(context.Background(), "request")
+		assert.Nil(t, span)
+		_, child := Start(ctx, "search")
+		assert.Nil(t, child)
+		child.SetAttribute("class", "Article")
+		child.RecordError(errors.New("failed"))
+		child.RecordChild("filter", time.Now(), time.Now())
+		child.End()
+	})
+}
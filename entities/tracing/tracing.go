@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package tracing carries the id of the request and the W3C trace context
+// of an incoming API call through the context, so it can be forwarded to
+// module providers and returned with errors. This makes it possible to follow
+// a single request across Weaviate, its logs and third-party APIs.
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// RequestIDHeader is read from incoming requests, echoed in every
+	// response and forwarded to module providers
+	RequestIDHeader = "X-Request-Id"
+	// TraceparentHeader and TracestateHeader are the W3C trace context
+	// headers. The traceparent of traced requests is replaced by the one of
+	// the current span, otherwise both are forwarded unchanged.
+	TraceparentHeader = "Traceparent"
+	TracestateHeader  = "Tracestate"
+
+	// maxHeaderLength limits the size of user-provided values, which end up
+	// in logs and outgoing requests
+	maxHeaderLength = 256
+)
+
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota
+	traceparentKey
+	tracestateKey
+	spanKey
+)
+
+// NewRequestID generates a random request id
+func NewRequestID() string {
+	return uuid.NewString()
+}
+
+// WithRequestID returns a copy of ctx which carries the given request id
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestID returns the id of the request ctx belongs to or an empty
+// string if there is none
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// WithTraceContext returns a copy of ctx which carries the W3C trace context
+func WithTraceContext(ctx context.Context, traceparent, tracestate string) context.Context {
+	if traceparent != "" {
+		ctx = context.WithValue(ctx, traceparentKey, traceparent)
+	}
+	if tracestate != "" {
+		ctx = context.WithValue(ctx, tracestateKey, tracestate)
+	}
+	return ctx
+}
+
+// FromIncoming extracts the request id and trace context provided by the
+// client. If the client did not send a (valid) request id, a new one is
+// generated. The request id is returned for convenience, so it can be echoed
+// to the client.
+func FromIncoming(ctx context.Context, get func(key string) string) (context.Context, string) {
+	id := get(RequestIDHeader)
+	if !valid(id) {
+		id = NewRequestID()
+	}
+	ctx = WithRequestID(ctx, id)
+
+	traceparent, tracestate := get(TraceparentHeader), get(TracestateHeader)
+	if !valid(traceparent) {
+		traceparent = ""
+	}
+	if !valid(tracestate) {
+		tracestate = ""
+	}
+
+	return WithTraceContext(ctx, traceparent, tracestate), id
+}
+
+// Inject sets the request id and trace context carried by the context of
+// the outgoing request as headers. It is meant to be called by module
+// clients before sending a request to their provider.
+func Inject(req *http.Request) {
+	ctx := req.Context()
+	if id := RequestID(ctx); id != "" {
+		req.Header.Set(RequestIDHeader, id)
+	}
+	if span := SpanFromContext(ctx); span != nil {
+		req.Header.Set(TraceparentHeader, span.Context().Traceparent())
+	} else if traceparent, ok := ctx.Value(traceparentKey).(string); ok {
+		req.Header.Set(TraceparentHeader, traceparent)
+	}
+	if tracestate, ok := ctx.Value(tracestateKey).(string); ok {
+		req.Header.Set(TracestateHeader, tracestate)
+	}
+}
+
+// valid rejects empty and overly long values as well as values containing
+// control characters, which could be used to forge log lines
+func valid(value string) bool {
+	if value == "" || len(value) > maxHeaderLength {
+		return false
+	}
+	for _, r := range value {
+		if r < 0x20 || r == 0x7f {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromIncoming(t *testing.T) {
+	t.Run("uses the id provided by the client", func(t *testing.T) {
+		header := http.Header{}
+		header.Set(RequestIDHeader, "my-request")
+		header.Set(TraceparentHeader, "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+
+		ctx, id := FromIncoming(context.Background(), header.Get)
+		assert.Equal(t, "my-request", id)
+		assert.Equal(t, "my-request", RequestID(ctx))
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://provider", nil)
+		require.Nil(t, err)
+		Inject(req)
+		assert.Equal(t, "my-request", req.Header.Get(RequestIDHeader))
+		assert.Equal(t, "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+			req.Header.Get(TraceparentHeader))
+		assert.Empty(t, req.Header.Get(TracestateHeader))
+	})
+
+	t.Run("generates an id if none is provided", func(t *testing.T) {
+		ctx, id := FromIncoming(context.Background(), http.Header{}.Get)
+		assert.NotEmpty(t, id)
+		assert.Equal(t, id, RequestID(ctx))
+	})
+
+	t.Run("replaces invalid ids", func(t *testing.T) {
+		for _, invalid := range []string{"line\nbreak", strings.Repeat("a", 300)} {
+			header := http.Header{}
+			header.Set(RequestIDHeader, invalid)
+			_, id := FromIncoming(context.Background(), header.Get)
+			assert.NotEqual(t, invalid, id)
+			assert.NotEmpty(t, id)
+		}
+	})
+}
+
+func TestInjectWithoutRequestID(t *testing.T) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://provider", nil)
+	require.Nil(t, err)
+	Inject(req)
+	assert.Empty(t, req.Header)
+}
@@ -67,6 +67,33 @@ func OptionalIntFromMap(in map[string]interface{}, name string,
 	return nil
 }
 
+func OptionalFloatFromMap(in map[string]interface{}, name string,
+	setFn func(v float64),
+) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	var asFloat64 float64
+	var err error
+
+	// depending on whether we get the results from disk or from the REST API,
+	// numbers may be represented slightly differently
+	switch typed := value.(type) {
+	case json.Number:
+		asFloat64, err = typed.Float64()
+	case float64:
+		asFloat64 = typed
+	}
+	if err != nil {
+		return errors.Wrapf(err, "json.Number to float64 for %q", name)
+	}
+
+	setFn(asFloat64)
+	return nil
+}
+
 func OptionalBoolFromMap(in map[string]interface{}, name string,
 	setFn func(v bool),
 ) error {
@@ -100,3 +127,14 @@ func OptionalStringFromMap(in map[string]interface{}, name string,
 	setFn(asString)
 	return nil
 }
+
+// ValidateBQBits checks the number of bits binary quantization encodes every
+// dimension with, 0 picks the default of a single bit
+func ValidateBQBits(bits int) error {
+	switch bits {
+	case 0, 1, 2, 4:
+		return nil
+	default:
+		return errors.Errorf("bq bits must be one of 1, 2 or 4, got %d", bits)
+	}
+}
@@ -30,6 +30,8 @@ type CompressionUserConfig struct {
 	Enabled      bool `json:"enabled"`
 	RescoreLimit int  `json:"rescoreLimit"`
 	Cache        bool `json:"cache"`
+	// Bits encode every dimension with bq, 1, 2 or 4. 0 picks a single bit.
+	Bits int `json:"bits,omitempty"`
 }
 
 type UserConfig struct {
@@ -149,6 +151,12 @@ func parseCompressionMap(in map[string]interface{}, uc *UserConfig) error {
 			return err
 		}
 
+		if err := vectorindexcommon.OptionalIntFromMap(bqConfigMap, "bits", func(v int) {
+			uc.BQ.Bits = v
+		}); err != nil {
+			return err
+		}
+
 	}
 	// TODO: remove once PQ is supported
 	if uc.PQ.Enabled {
@@ -163,6 +171,9 @@ func parseCompressionMap(in map[string]interface{}, uc *UserConfig) error {
 	if uc.PQ.Enabled && uc.BQ.Enabled {
 		return errors.New("cannot activate dual compression. Select either PQ or BQ please")
 	}
+	if err := vectorindexcommon.ValidateBQBits(uc.BQ.Bits); err != nil {
+		return err
+	}
 	return nil
 }
 
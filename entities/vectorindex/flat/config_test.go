@@ -73,6 +73,41 @@ func Test_FlatUserConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "bq with 2 bits",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{
+					"enabled": true,
+					"bits":    float64(2),
+				},
+			},
+			expected: UserConfig{
+				VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+				Distance:              common.DefaultDistanceMetric,
+				PQ: CompressionUserConfig{
+					Enabled:      false,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      true,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+					Bits:         2,
+				},
+			},
+		},
+		{
+			name: "bq with invalid bits",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{
+					"enabled": true,
+					"bits":    float64(3),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "bq bits must be one of 1, 2 or 4, got 3",
+		},
 		{
 			name: "pq enabled",
 			input: map[string]interface{}{
@@ -19,6 +19,8 @@ const (
 
 type BQConfig struct {
 	Enabled bool `json:"enabled"`
+	// Bits encode every dimension, 1, 2 or 4. 0 picks a single bit.
+	Bits int `json:"bits"`
 }
 
 func parseBQMap(in map[string]interface{}, bq *BQConfig) error {
@@ -38,5 +40,11 @@ func parseBQMap(in map[string]interface{}, bq *BQConfig) error {
 		return err
 	}
 
+	if err := common.OptionalIntFromMap(bqConfigMap, "bits", func(v int) {
+		bq.Bits = v
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
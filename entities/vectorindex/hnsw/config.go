@@ -39,19 +39,22 @@ const (
 
 // UserConfig bundles all values settable by a user in the per-class settings
 type UserConfig struct {
-	Skip                   bool     `json:"skip"`
-	CleanupIntervalSeconds int      `json:"cleanupIntervalSeconds"`
-	MaxConnections         int      `json:"maxConnections"`
-	EFConstruction         int      `json:"efConstruction"`
-	EF                     int      `json:"ef"`
-	DynamicEFMin           int      `json:"dynamicEfMin"`
-	DynamicEFMax           int      `json:"dynamicEfMax"`
-	DynamicEFFactor        int      `json:"dynamicEfFactor"`
-	VectorCacheMaxObjects  int      `json:"vectorCacheMaxObjects"`
-	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
-	Distance               string   `json:"distance"`
-	PQ                     PQConfig `json:"pq"`
-	BQ                     BQConfig `json:"bq"`
+	Skip                   bool            `json:"skip"`
+	CleanupIntervalSeconds int             `json:"cleanupIntervalSeconds"`
+	MaxConnections         int             `json:"maxConnections"`
+	EFConstruction         int             `json:"efConstruction"`
+	EF                     int             `json:"ef"`
+	DynamicEFMin           int             `json:"dynamicEfMin"`
+	DynamicEFMax           int             `json:"dynamicEfMax"`
+	DynamicEFFactor        int             `json:"dynamicEfFactor"`
+	VectorCacheMaxObjects  int             `json:"vectorCacheMaxObjects"`
+	FlatSearchCutoff       int             `json:"flatSearchCutoff"`
+	Distance               string          `json:"distance"`
+	PQ                     PQConfig        `json:"pq"`
+	BQ                     BQConfig        `json:"bq"`
+	EFTarget               EFTargetConfig  `json:"efTarget"`
+	DimensionReduction     ReductionConfig `json:"dimensionReduction"`
+	VectorStorage          string          `json:"vectorStorage"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -182,6 +185,18 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := parseEFTargetMap(asMap, &uc.EFTarget); err != nil {
+		return uc, err
+	}
+
+	if err := parseReductionMap(asMap, &uc.DimensionReduction); err != nil {
+		return uc, err
+	}
+
+	if err := parseVectorStorage(asMap, &uc); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -210,6 +225,22 @@ func (u *UserConfig) validate() error {
 		return fmt.Errorf("invalid hnsw config: two compression methods enabled: PQ and BQ")
 	}
 
+	if err := vectorIndexCommon.ValidateBQBits(u.BQ.Bits); err != nil {
+		return fmt.Errorf("invalid hnsw config: %w", err)
+	}
+
+	if err := validateEFTarget(u); err != nil {
+		return fmt.Errorf("invalid hnsw config: %w", err)
+	}
+
+	if err := validateReduction(u); err != nil {
+		return fmt.Errorf("invalid hnsw config: %w", err)
+	}
+
+	if err := validateVectorStorage(u); err != nil {
+		return fmt.Errorf("invalid hnsw config: %w", err)
+	}
+
 	return nil
 }
 
@@ -507,6 +507,238 @@ func Test_UserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "invalid hnsw config: two compression methods enabled: PQ and BQ",
 		},
+		{
+			name: "with bq bits",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{
+					"enabled": true,
+					"bits":    float64(4),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				BQ: BQConfig{
+					Enabled: true,
+					Bits:    4,
+				},
+			},
+		},
+		{
+			name: "with invalid bq bits",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{
+					"enabled": true,
+					"bits":    float64(8),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: bq bits must be one of 1, 2 or 4, got 8",
+		},
+		{
+			name: "with ef target",
+			input: map[string]interface{}{
+				"ef": float64(-1),
+				"efTarget": map[string]interface{}{
+					"recall":                json.Number("0.95"),
+					"latencyMs":             float64(20),
+					"sampleIntervalSeconds": float64(60),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     -1,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				EFTarget: EFTargetConfig{
+					Recall:                0.95,
+					LatencyMs:             20,
+					SampleIntervalSeconds: 60,
+				},
+			},
+		},
+		{
+			name: "with ef target and fixed ef",
+			input: map[string]interface{}{
+				"ef": float64(100),
+				"efTarget": map[string]interface{}{
+					"recall": float64(0.9),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: efTarget requires ef to be -1",
+		},
+		{
+			name: "with invalid ef target recall",
+			input: map[string]interface{}{
+				"efTarget": map[string]interface{}{
+					"recall": float64(95),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "efTarget.recall must be between 0 and 1",
+		},
+		{
+			name: "with pca dimension reduction",
+			input: map[string]interface{}{
+				"dimensionReduction": map[string]interface{}{
+					"enabled":       true,
+					"method":        "pca",
+					"dimensions":    float64(512),
+					"trainingLimit": float64(5000),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				DimensionReduction: ReductionConfig{
+					Enabled:       true,
+					Method:        ReductionMethodPCA,
+					Dimensions:    512,
+					TrainingLimit: 5000,
+				},
+			},
+		},
+		{
+			name: "with dimension reduction without dimensions",
+			input: map[string]interface{}{
+				"dimensionReduction": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "dimensionReduction.dimensions must be set if dimensionReduction is enabled",
+		},
+		{
+			name: "with invalid dimension reduction method",
+			input: map[string]interface{}{
+				"dimensionReduction": map[string]interface{}{
+					"enabled":    true,
+					"method":     "random",
+					"dimensions": float64(256),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: `dimensionReduction.method must be "truncate" or "pca", got "random"`,
+		},
+		{
+			name: "with dimension reduction and bq",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+				"dimensionReduction": map[string]interface{}{
+					"enabled":    true,
+					"dimensions": float64(256),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "two compression methods enabled: dimensionReduction and PQ or BQ",
+		},
+		{
+			name: "with float16 vector storage",
+			input: map[string]interface{}{
+				"vectorStorage": "float16",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				VectorStorage: VectorStorageFloat16,
+			},
+		},
+		{
+			name: "with invalid vector storage",
+			input: map[string]interface{}{
+				"vectorStorage": "int4",
+			},
+			expectErr:    true,
+			expectErrMsg: `vectorStorage must be "float32" or "float16", got "int4"`,
+		},
+		{
+			name: "with float16 vector storage and pq",
+			input: map[string]interface{}{
+				"vectorStorage": "float16",
+				"pq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: `vectorStorage "float16" cannot be combined with PQ, BQ or dimensionReduction`,
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultEFTargetSampleIntervalSeconds = 5 * 60
+	DefaultEFTargetSampleSize            = 20
+)
+
+// EFTargetConfig lets Weaviate pick the ef at query time if ef is -1. The ef
+// is raised until the recall, which is estimated by comparing the results of
+// a sample of queries with a flat search, reaches Recall. While the 95th
+// percentile latency of the searches exceeds LatencyMs, the ef is lowered
+// instead. The ef stays between dynamicEfMin and dynamicEfMax. Zero values
+// disable the targets or use the defaults.
+type EFTargetConfig struct {
+	Recall                float64 `json:"recall"`
+	LatencyMs             float64 `json:"latencyMs"`
+	SampleIntervalSeconds int     `json:"sampleIntervalSeconds"`
+	SampleSize            int     `json:"sampleSize"`
+}
+
+// Enabled is true if any target is set
+func (c EFTargetConfig) Enabled() bool {
+	return c.Recall > 0 || c.LatencyMs > 0
+}
+
+func (c EFTargetConfig) Interval() time.Duration {
+	if c.SampleIntervalSeconds <= 0 {
+		return DefaultEFTargetSampleIntervalSeconds * time.Second
+	}
+	return time.Duration(c.SampleIntervalSeconds) * time.Second
+}
+
+func (c EFTargetConfig) Samples() int {
+	if c.SampleSize <= 0 {
+		return DefaultEFTargetSampleSize
+	}
+	return c.SampleSize
+}
+
+func parseEFTargetMap(in map[string]interface{}, cfg *EFTargetConfig) error {
+	value, ok := in["efTarget"]
+	if !ok {
+		return nil
+	}
+
+	asMap, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalFloatFromMap(asMap, "recall", func(v float64) {
+		cfg.Recall = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalFloatFromMap(asMap, "latencyMs", func(v float64) {
+		cfg.LatencyMs = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "sampleIntervalSeconds", func(v int) {
+		cfg.SampleIntervalSeconds = v
+	}); err != nil {
+		return err
+	}
+
+	return common.OptionalIntFromMap(asMap, "sampleSize", func(v int) {
+		cfg.SampleSize = v
+	})
+}
+
+func validateEFTarget(u *UserConfig) error {
+	c := u.EFTarget
+	if c.Recall < 0 || c.Recall > 1 {
+		return fmt.Errorf("efTarget.recall must be between 0 and 1")
+	}
+	if c.LatencyMs < 0 {
+		return fmt.Errorf("efTarget.latencyMs must not be negative")
+	}
+	if c.SampleIntervalSeconds < 0 || c.SampleSize < 0 {
+		return fmt.Errorf("efTarget.sampleIntervalSeconds and efTarget.sampleSize must not be negative")
+	}
+	if c.Enabled() && u.EF != -1 {
+		return fmt.Errorf("efTarget requires ef to be -1")
+	}
+	return nil
+}
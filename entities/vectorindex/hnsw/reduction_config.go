@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	ReductionMethodTruncate = "truncate"
+	ReductionMethodPCA      = "pca"
+
+	DefaultReductionTrainingLimit = 10_000
+)
+
+// ReductionConfig indexes the vectors with fewer dimensions, while the full
+// vectors are kept to rescore the results. "truncate" keeps the first
+// Dimensions dimensions, which suits Matryoshka embeddings. "pca" projects
+// the vectors onto their Dimensions principal components, learned from up to
+// TrainingLimit vectors once the index holds data. Zero values use the
+// defaults.
+type ReductionConfig struct {
+	Enabled       bool   `json:"enabled"`
+	Method        string `json:"method"`
+	Dimensions    int    `json:"dimensions"`
+	TrainingLimit int    `json:"trainingLimit"`
+}
+
+func (c ReductionConfig) ReductionMethod() string {
+	if c.Method == "" {
+		return ReductionMethodTruncate
+	}
+	return c.Method
+}
+
+func (c ReductionConfig) Limit() int {
+	if c.TrainingLimit <= 0 {
+		return DefaultReductionTrainingLimit
+	}
+	return c.TrainingLimit
+}
+
+// NeedsTraining is true if the reduction can only be applied once the index
+// holds vectors
+func (c ReductionConfig) NeedsTraining() bool {
+	return c.Enabled && c.ReductionMethod() == ReductionMethodPCA
+}
+
+func parseReductionMap(in map[string]interface{}, cfg *ReductionConfig) error {
+	value, ok := in["dimensionReduction"]
+	if !ok {
+		return nil
+	}
+
+	asMap, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalBoolFromMap(asMap, "enabled", func(v bool) {
+		cfg.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalStringFromMap(asMap, "method", func(v string) {
+		cfg.Method = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "dimensions", func(v int) {
+		cfg.Dimensions = v
+	}); err != nil {
+		return err
+	}
+
+	return common.OptionalIntFromMap(asMap, "trainingLimit", func(v int) {
+		cfg.TrainingLimit = v
+	})
+}
+
+func validateReduction(u *UserConfig) error {
+	c := u.DimensionReduction
+	switch c.ReductionMethod() {
+	case ReductionMethodTruncate, ReductionMethodPCA:
+	default:
+		return fmt.Errorf("dimensionReduction.method must be %q or %q, got %q",
+			ReductionMethodTruncate, ReductionMethodPCA, c.Method)
+	}
+	if c.Dimensions < 0 || c.TrainingLimit < 0 {
+		return fmt.Errorf("dimensionReduction.dimensions and dimensionReduction.trainingLimit must not be negative")
+	}
+	if !c.Enabled {
+		return nil
+	}
+	if c.Dimensions == 0 {
+		return fmt.Errorf("dimensionReduction.dimensions must be set if dimensionReduction is enabled")
+	}
+	if u.PQ.Enabled || u.BQ.Enabled {
+		return fmt.Errorf("two compression methods enabled: dimensionReduction and PQ or BQ")
+	}
+	return nil
+}
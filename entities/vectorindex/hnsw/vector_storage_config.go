@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	VectorStorageFloat32 = "float32"
+	VectorStorageFloat16 = "float16"
+)
+
+// StorageType is the precision the vectors are kept in, both in the vector
+// cache of the index and in the object store. "float16" halves the memory
+// and the disk space of the vectors, they are rounded to half precision when
+// they are imported.
+func (u UserConfig) StorageType() string {
+	if u.VectorStorage == "" {
+		return VectorStorageFloat32
+	}
+	return u.VectorStorage
+}
+
+func parseVectorStorage(in map[string]interface{}, u *UserConfig) error {
+	return common.OptionalStringFromMap(in, "vectorStorage", func(v string) {
+		u.VectorStorage = v
+	})
+}
+
+func validateVectorStorage(u *UserConfig) error {
+	switch u.StorageType() {
+	case VectorStorageFloat32:
+		return nil
+	case VectorStorageFloat16:
+	default:
+		return fmt.Errorf("vectorStorage must be %q or %q, got %q",
+			VectorStorageFloat32, VectorStorageFloat16, u.VectorStorage)
+	}
+	if u.PQ.Enabled || u.BQ.Enabled || u.DimensionReduction.Enabled {
+		return fmt.Errorf("vectorStorage %q cannot be combined with PQ, BQ or dimensionReduction",
+			VectorStorageFloat16)
+	}
+	return nil
+}
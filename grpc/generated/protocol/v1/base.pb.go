@@ -70,6 +70,59 @@ func (ConsistencyLevel) EnumDescriptor() ([]byte, []int) {
 	return file_v1_base_proto_rawDescGZIP(), []int{0}
 }
 
+// VectorEncoding is the layout of vector_bytes
+type VectorEncoding int32
+
+const (
+	// defaults to VECTOR_ENCODING_FLOAT32
+	VectorEncoding_VECTOR_ENCODING_UNSPECIFIED VectorEncoding = 0
+	// little endian float32, four bytes per dimension
+	VectorEncoding_VECTOR_ENCODING_FLOAT32 VectorEncoding = 1
+	// little endian IEEE 754 half precision floats, two bytes per dimension
+	VectorEncoding_VECTOR_ENCODING_FLOAT16 VectorEncoding = 2
+)
+
+// Enum value maps for VectorEncoding.
+var (
+	VectorEncoding_name = map[int32]string{
+		0: "VECTOR_ENCODING_UNSPECIFIED",
+		1: "VECTOR_ENCODING_FLOAT32",
+		2: "VECTOR_ENCODING_FLOAT16",
+	}
+	VectorEncoding_value = map[string]int32{
+		"VECTOR_ENCODING_UNSPECIFIED": 0,
+		"VECTOR_ENCODING_FLOAT32":     1,
+		"VECTOR_ENCODING_FLOAT16":     2,
+	}
+)
+
+func (x VectorEncoding) Enum() *VectorEncoding {
+	p := new(VectorEncoding)
+	*p = x
+	return p
+}
+
+func (x VectorEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VectorEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_base_proto_enumTypes[1].Descriptor()
+}
+
+func (VectorEncoding) Type() protoreflect.EnumType {
+	return &file_v1_base_proto_enumTypes[1]
+}
+
+func (x VectorEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VectorEncoding.Descriptor instead.
+func (VectorEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_v1_base_proto_rawDescGZIP(), []int{1}
+}
+
 type NumberArrayProperties struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -594,15 +647,21 @@ var file_v1_base_proto_rawDesc = []byte{
 	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x53, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x43,
 	0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x51, 0x55, 0x4f, 0x52, 0x55, 0x4d, 0x10, 0x02,
 	0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x53, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f,
-	0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x03, 0x42, 0x6e, 0x0a, 0x23, 0x69,
-	0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
-	0x76, 0x31, 0x42, 0x11, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x42, 0x61, 0x73, 0x65, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69,
-	0x61, 0x74, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x64, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x03, 0x2a, 0x6b, 0x0a, 0x0e, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a,
+	0x1b, 0x56, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b,
+	0x0a, 0x17, 0x56, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x33, 0x32, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x56,
+	0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x46,
+	0x4c, 0x4f, 0x41, 0x54, 0x31, 0x36, 0x10, 0x02, 0x42, 0x6e, 0x0a, 0x23, 0x69, 0x6f, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x42,
+	0x11, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x61,
+	0x73, 0x65, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -617,29 +676,30 @@ func file_v1_base_proto_rawDescGZIP() []byte {
 	return file_v1_base_proto_rawDescData
 }
 
-var file_v1_base_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_base_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_v1_base_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_v1_base_proto_goTypes = []interface{}{
 	(ConsistencyLevel)(0),          // 0: weaviate.v1.ConsistencyLevel
-	(*NumberArrayProperties)(nil),  // 1: weaviate.v1.NumberArrayProperties
-	(*IntArrayProperties)(nil),     // 2: weaviate.v1.IntArrayProperties
-	(*TextArrayProperties)(nil),    // 3: weaviate.v1.TextArrayProperties
-	(*BooleanArrayProperties)(nil), // 4: weaviate.v1.BooleanArrayProperties
-	(*ObjectPropertiesValue)(nil),  // 5: weaviate.v1.ObjectPropertiesValue
-	(*ObjectArrayProperties)(nil),  // 6: weaviate.v1.ObjectArrayProperties
-	(*ObjectProperties)(nil),       // 7: weaviate.v1.ObjectProperties
-	(*structpb.Struct)(nil),        // 8: google.protobuf.Struct
+	(VectorEncoding)(0),            // 1: weaviate.v1.VectorEncoding
+	(*NumberArrayProperties)(nil),  // 2: weaviate.v1.NumberArrayProperties
+	(*IntArrayProperties)(nil),     // 3: weaviate.v1.IntArrayProperties
+	(*TextArrayProperties)(nil),    // 4: weaviate.v1.TextArrayProperties
+	(*BooleanArrayProperties)(nil), // 5: weaviate.v1.BooleanArrayProperties
+	(*ObjectPropertiesValue)(nil),  // 6: weaviate.v1.ObjectPropertiesValue
+	(*ObjectArrayProperties)(nil),  // 7: weaviate.v1.ObjectArrayProperties
+	(*ObjectProperties)(nil),       // 8: weaviate.v1.ObjectProperties
+	(*structpb.Struct)(nil),        // 9: google.protobuf.Struct
 }
 var file_v1_base_proto_depIdxs = []int32{
-	8, // 0: weaviate.v1.ObjectPropertiesValue.non_ref_properties:type_name -> google.protobuf.Struct
-	1, // 1: weaviate.v1.ObjectPropertiesValue.number_array_properties:type_name -> weaviate.v1.NumberArrayProperties
-	2, // 2: weaviate.v1.ObjectPropertiesValue.int_array_properties:type_name -> weaviate.v1.IntArrayProperties
-	3, // 3: weaviate.v1.ObjectPropertiesValue.text_array_properties:type_name -> weaviate.v1.TextArrayProperties
-	4, // 4: weaviate.v1.ObjectPropertiesValue.boolean_array_properties:type_name -> weaviate.v1.BooleanArrayProperties
-	7, // 5: weaviate.v1.ObjectPropertiesValue.object_properties:type_name -> weaviate.v1.ObjectProperties
-	6, // 6: weaviate.v1.ObjectPropertiesValue.object_array_properties:type_name -> weaviate.v1.ObjectArrayProperties
-	5, // 7: weaviate.v1.ObjectArrayProperties.values:type_name -> weaviate.v1.ObjectPropertiesValue
-	5, // 8: weaviate.v1.ObjectProperties.value:type_name -> weaviate.v1.ObjectPropertiesValue
+	9, // 0: weaviate.v1.ObjectPropertiesValue.non_ref_properties:type_name -> google.protobuf.Struct
+	2, // 1: weaviate.v1.ObjectPropertiesValue.number_array_properties:type_name -> weaviate.v1.NumberArrayProperties
+	3, // 2: weaviate.v1.ObjectPropertiesValue.int_array_properties:type_name -> weaviate.v1.IntArrayProperties
+	4, // 3: weaviate.v1.ObjectPropertiesValue.text_array_properties:type_name -> weaviate.v1.TextArrayProperties
+	5, // 4: weaviate.v1.ObjectPropertiesValue.boolean_array_properties:type_name -> weaviate.v1.BooleanArrayProperties
+	8, // 5: weaviate.v1.ObjectPropertiesValue.object_properties:type_name -> weaviate.v1.ObjectProperties
+	7, // 6: weaviate.v1.ObjectPropertiesValue.object_array_properties:type_name -> weaviate.v1.ObjectArrayProperties
+	6, // 7: weaviate.v1.ObjectArrayProperties.values:type_name -> weaviate.v1.ObjectPropertiesValue
+	6, // 8: weaviate.v1.ObjectProperties.value:type_name -> weaviate.v1.ObjectPropertiesValue
 	9, // [9:9] is the sub-list for method output_type
 	9, // [9:9] is the sub-list for method input_type
 	9, // [9:9] is the sub-list for extension type_name
@@ -743,7 +803,7 @@ func file_v1_base_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_base_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
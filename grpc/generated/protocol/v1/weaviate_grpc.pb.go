@@ -21,6 +21,18 @@ const _ = grpc.SupportPackageIsVersion7
 type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
 	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
+	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaReply, error)
+	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*CollectionReply, error)
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CollectionReply, error)
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*CollectionReply, error)
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionReply, error)
+	AddProperty(ctx context.Context, in *AddPropertyRequest, opts ...grpc.CallOption) (*AddPropertyReply, error)
+	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsReply, error)
+	TenantsCreate(ctx context.Context, in *TenantsCreateRequest, opts ...grpc.CallOption) (*TenantsReply, error)
+	TenantsUpdate(ctx context.Context, in *TenantsUpdateRequest, opts ...grpc.CallOption) (*TenantsReply, error)
+	TenantsDelete(ctx context.Context, in *TenantsDeleteRequest, opts ...grpc.CallOption) (*TenantsDeleteReply, error)
+	NodesStatus(ctx context.Context, in *NodesStatusRequest, opts ...grpc.CallOption) (*NodesStatusReply, error)
+	Ask(ctx context.Context, in *AskRequest, opts ...grpc.CallOption) (*AskReply, error)
 }
 
 type weaviateClient struct {
@@ -49,12 +61,132 @@ func (c *weaviateClient) BatchObjects(ctx context.Context, in *BatchObjectsReque
 	return out, nil
 }
 
+func (c *weaviateClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaReply, error) {
+	out := new(GetSchemaReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/GetSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*CollectionReply, error) {
+	out := new(CollectionReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/GetCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CollectionReply, error) {
+	out := new(CollectionReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/CreateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*CollectionReply, error) {
+	out := new(CollectionReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/UpdateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionReply, error) {
+	out := new(DeleteCollectionReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/DeleteCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) AddProperty(ctx context.Context, in *AddPropertyRequest, opts ...grpc.CallOption) (*AddPropertyReply, error) {
+	out := new(AddPropertyReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/AddProperty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsReply, error) {
+	out := new(TenantsReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/TenantsGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsCreate(ctx context.Context, in *TenantsCreateRequest, opts ...grpc.CallOption) (*TenantsReply, error) {
+	out := new(TenantsReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/TenantsCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsUpdate(ctx context.Context, in *TenantsUpdateRequest, opts ...grpc.CallOption) (*TenantsReply, error) {
+	out := new(TenantsReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/TenantsUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsDelete(ctx context.Context, in *TenantsDeleteRequest, opts ...grpc.CallOption) (*TenantsDeleteReply, error) {
+	out := new(TenantsDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/TenantsDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) NodesStatus(ctx context.Context, in *NodesStatusRequest, opts ...grpc.CallOption) (*NodesStatusReply, error) {
+	out := new(NodesStatusReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/NodesStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) Ask(ctx context.Context, in *AskRequest, opts ...grpc.CallOption) (*AskReply, error) {
+	out := new(AskReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/Ask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
 type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
 	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
+	GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaReply, error)
+	GetCollection(context.Context, *GetCollectionRequest) (*CollectionReply, error)
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CollectionReply, error)
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*CollectionReply, error)
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionReply, error)
+	AddProperty(context.Context, *AddPropertyRequest) (*AddPropertyReply, error)
+	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsReply, error)
+	TenantsCreate(context.Context, *TenantsCreateRequest) (*TenantsReply, error)
+	TenantsUpdate(context.Context, *TenantsUpdateRequest) (*TenantsReply, error)
+	TenantsDelete(context.Context, *TenantsDeleteRequest) (*TenantsDeleteReply, error)
+	NodesStatus(context.Context, *NodesStatusRequest) (*NodesStatusReply, error)
+	Ask(context.Context, *AskRequest) (*AskReply, error)
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -68,6 +200,42 @@ func (UnimplementedWeaviateServer) Search(context.Context, *SearchRequest) (*Sea
 func (UnimplementedWeaviateServer) BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchObjects not implemented")
 }
+func (UnimplementedWeaviateServer) GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
+func (UnimplementedWeaviateServer) GetCollection(context.Context, *GetCollectionRequest) (*CollectionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollection not implemented")
+}
+func (UnimplementedWeaviateServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CollectionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedWeaviateServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*CollectionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedWeaviateServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedWeaviateServer) AddProperty(context.Context, *AddPropertyRequest) (*AddPropertyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProperty not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsGet(context.Context, *TenantsGetRequest) (*TenantsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsGet not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsCreate(context.Context, *TenantsCreateRequest) (*TenantsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsCreate not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsUpdate(context.Context, *TenantsUpdateRequest) (*TenantsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsUpdate not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsDelete(context.Context, *TenantsDeleteRequest) (*TenantsDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsDelete not implemented")
+}
+func (UnimplementedWeaviateServer) NodesStatus(context.Context, *NodesStatusRequest) (*NodesStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodesStatus not implemented")
+}
+func (UnimplementedWeaviateServer) Ask(context.Context, *AskRequest) (*AskReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ask not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -117,6 +285,222 @@ func _Weaviate_BatchObjects_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).GetSchema(ctx, req.(*GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).GetCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/GetCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).GetCollection(ctx, req.(*GetCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/CreateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/UpdateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/DeleteCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_AddProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPropertyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).AddProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/AddProperty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).AddProperty(ctx, req.(*AddPropertyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/TenantsGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsGet(ctx, req.(*TenantsGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/TenantsCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsCreate(ctx, req.(*TenantsCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/TenantsUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsUpdate(ctx, req.(*TenantsUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/TenantsDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsDelete(ctx, req.(*TenantsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_NodesStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodesStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).NodesStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/NodesStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).NodesStatus(ctx, req.(*NodesStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_Ask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).Ask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/Ask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).Ask(ctx, req.(*AskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -132,6 +516,54 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchObjects",
 			Handler:    _Weaviate_BatchObjects_Handler,
 		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _Weaviate_GetSchema_Handler,
+		},
+		{
+			MethodName: "GetCollection",
+			Handler:    _Weaviate_GetCollection_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _Weaviate_CreateCollection_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _Weaviate_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _Weaviate_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "AddProperty",
+			Handler:    _Weaviate_AddProperty_Handler,
+		},
+		{
+			MethodName: "TenantsGet",
+			Handler:    _Weaviate_TenantsGet_Handler,
+		},
+		{
+			MethodName: "TenantsCreate",
+			Handler:    _Weaviate_TenantsCreate_Handler,
+		},
+		{
+			MethodName: "TenantsUpdate",
+			Handler:    _Weaviate_TenantsUpdate_Handler,
+		},
+		{
+			MethodName: "TenantsDelete",
+			Handler:    _Weaviate_TenantsDelete_Handler,
+		},
+		{
+			MethodName: "NodesStatus",
+			Handler:    _Weaviate_NodesStatus_Handler,
+		},
+		{
+			MethodName: "Ask",
+			Handler:    _Weaviate_Ask_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/weaviate.proto",
@@ -18,6 +18,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/backup"
@@ -204,6 +205,22 @@ func (m *Module) Read(ctx context.Context, backupID, key string, w io.WriteClose
 	return read, err
 }
 
+// DeleteObject removes the file and the directories which are left empty,
+// up to the backup directory
+func (m *Module) DeleteObject(ctx context.Context, backupID, key string) error {
+	objPath := filepath.Join(m.backupsPath, backupID, key)
+	if err := os.Remove(objPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", objPath))
+	}
+	for dir := filepath.Dir(objPath); strings.HasPrefix(dir, m.backupsPath+string(filepath.Separator)); dir = filepath.Dir(dir) {
+		// fails if the directory is not empty
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+	return nil
+}
+
 func (m *Module) SourceDataPath() string {
 	return m.dataPath
 }
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBackend_StoreBackup(t *testing.T) {
@@ -52,3 +53,23 @@ func TestBackend_StoreBackup(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestBackend_DeleteObject(t *testing.T) {
+	ctx := context.Background()
+	module := New()
+	require.Nil(t, module.initBackupBackend(ctx, t.TempDir()))
+
+	require.Nil(t, module.PutObject(ctx, "backup1", "node1/Article/chunk-1", []byte("chunk")))
+	require.Nil(t, module.PutObject(ctx, "backup1", "node1/backup.json", []byte("{}")))
+
+	require.Nil(t, module.DeleteObject(ctx, "backup1", "node1/Article/chunk-1"))
+	assert.NoDirExists(t, filepath.Join(module.backupsPath, "backup1", "node1", "Article"))
+	assert.FileExists(t, filepath.Join(module.backupsPath, "backup1", "node1", "backup.json"))
+
+	require.Nil(t, module.DeleteObject(ctx, "backup1", "node1/backup.json"))
+	assert.NoDirExists(t, filepath.Join(module.backupsPath, "backup1"))
+	assert.DirExists(t, module.backupsPath)
+
+	// deleting a missing object succeeds
+	assert.Nil(t, module.DeleteObject(ctx, "backup1", "node1/backup.json"))
+}
@@ -18,6 +18,7 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"cloud.google.com/go/storage"
@@ -30,13 +31,30 @@ import (
 )
 
 type gcsClient struct {
-	client    *storage.Client
 	config    clientConfig
 	projectID string
 	dataPath  string
+
+	lock   sync.RWMutex
+	client *storage.Client
 }
 
 func newClient(ctx context.Context, config *clientConfig, dataPath string) (*gcsClient, error) {
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	if len(projectID) == 0 {
+		projectID = os.Getenv("GCLOUD_PROJECT")
+		if len(projectID) == 0 {
+			projectID = os.Getenv("GCP_PROJECT")
+		}
+	}
+	client, err := newStorageClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &gcsClient{config: *config, projectID: projectID, dataPath: dataPath, client: client}, nil
+}
+
+func newStorageClient(ctx context.Context) (*storage.Client, error) {
 	options := []option.ClientOption{}
 	useAuth := strings.ToLower(os.Getenv("BACKUP_GCS_USE_AUTH")) != "false"
 	if useAuth {
@@ -51,13 +69,6 @@ func newClient(ctx context.Context, config *clientConfig, dataPath string) (*gcs
 	} else {
 		options = append(options, option.WithoutAuthentication())
 	}
-	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
-	if len(projectID) == 0 {
-		projectID = os.Getenv("GCLOUD_PROJECT")
-		if len(projectID) == 0 {
-			projectID = os.Getenv("GCP_PROJECT")
-		}
-	}
 	client, err := storage.NewClient(ctx, options...)
 	if err != nil {
 		return nil, errors.Wrap(err, "create client")
@@ -70,7 +81,22 @@ func newClient(ctx context.Context, config *clientConfig, dataPath string) (*gcs
 	}),
 		storage.WithPolicy(storage.RetryAlways),
 	)
-	return &gcsClient{client, *config, projectID, dataPath}, nil
+	return client, nil
+}
+
+// ReloadCredentials creates a new client with the default credentials, so
+// that a rotated credentials file is used by the next request. The previous
+// client is not closed, as running backups may still use it.
+func (g *gcsClient) ReloadCredentials(ctx context.Context) error {
+	client, err := newStorageClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.client = client
+	return nil
 }
 
 func (g *gcsClient) getObject(ctx context.Context, bucket *storage.BucketHandle,
@@ -104,7 +130,9 @@ func (g *gcsClient) HomeDir(backupID string) string {
 }
 
 func (g *gcsClient) findBucket(ctx context.Context) (*storage.BucketHandle, error) {
+	g.lock.RLock()
 	bucket := g.client.Bucket(g.config.Bucket)
+	g.lock.RUnlock()
 
 	if _, err := bucket.Attrs(ctx); err != nil {
 		return nil, err
@@ -236,6 +264,18 @@ func (g *gcsClient) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
 
+func (g *gcsClient) DeleteObject(ctx context.Context, backupID, key string) error {
+	objectName := g.makeObjectName(backupID, key)
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", objectName))
+	}
+	if err := bucket.Object(objectName).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", objectName))
+	}
+	return nil
+}
+
 // WriteToFile downloads an object and store its content in destPath
 // The file destPath will be created if it doesn't exit
 func (g *gcsClient) WriteToFile(ctx context.Context, backupID, key, destPath string) (err error) {
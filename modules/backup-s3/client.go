@@ -22,6 +22,7 @@ import (
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
@@ -33,6 +34,8 @@ type s3Client struct {
 	config   *clientConfig
 	logger   logrus.FieldLogger
 	dataPath string
+	sse      encrypt.ServerSide
+	creds    *credentials.Credentials
 }
 
 func newClient(config *clientConfig, logger logrus.FieldLogger, dataPath string) (*s3Client, error) {
@@ -42,7 +45,12 @@ func newClient(config *clientConfig, logger logrus.FieldLogger, dataPath string)
 	}
 
 	var creds *credentials.Credentials
-	if (os.Getenv("AWS_ACCESS_KEY_ID") != "" || os.Getenv("AWS_ACCESS_KEY") != "") &&
+	if config.RoleARN != "" {
+		var err error
+		if creds, err = assumeRole(config, region); err != nil {
+			return nil, errors.Wrap(err, "assume role")
+		}
+	} else if (os.Getenv("AWS_ACCESS_KEY_ID") != "" || os.Getenv("AWS_ACCESS_KEY") != "") &&
 		(os.Getenv("AWS_SECRET_ACCESS_KEY") != "" || os.Getenv("AWS_SECRET_KEY") != "") {
 		creds = credentials.NewEnvAWS()
 	} else {
@@ -53,15 +61,58 @@ func newClient(config *clientConfig, logger logrus.FieldLogger, dataPath string)
 		}
 	}
 
+	lookup := minio.BucketLookupAuto
+	if config.ForcePathStyle {
+		lookup = minio.BucketLookupPath
+	}
+	sse, err := config.serverSide()
+	if err != nil {
+		return nil, errors.Wrap(err, "server side encryption")
+	}
+
 	client, err := minio.New(config.Endpoint, &minio.Options{
-		Creds:  creds,
-		Region: region,
-		Secure: config.UseSSL,
+		Creds:        creds,
+		Region:       region,
+		Secure:       config.UseSSL,
+		BucketLookup: lookup,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "create client")
 	}
-	return &s3Client{client, config, logger, dataPath}, nil
+	return &s3Client{client, config, logger, dataPath, sse, creds}, nil
+}
+
+// ReloadCredentials expires the cached credentials, so that they are
+// retrieved again by the next request. This picks up rotated instance
+// credentials and assumes the configured role again.
+func (s *s3Client) ReloadCredentials(ctx context.Context) error {
+	s.creds.Expire()
+	return nil
+}
+
+// assumeRole returns credentials of the configured role, which is assumed
+// with the access keys of the environment
+func assumeRole(config *clientConfig, region string) (*credentials.Credentials, error) {
+	env, err := credentials.NewEnvAWS().Get()
+	if err != nil || env.AccessKeyID == "" || env.SecretAccessKey == "" {
+		return nil, errors.Errorf("'%s' requires AWS access keys in the environment", s3RoleARN)
+	}
+
+	endpoint := config.STSEndpoint
+	if endpoint == "" {
+		endpoint = "https://sts.amazonaws.com"
+	}
+	sessionName := config.RoleSessionName
+	if sessionName == "" {
+		sessionName = "weaviate-backup"
+	}
+	return credentials.NewSTSAssumeRole(endpoint, credentials.STSAssumeRoleOptions{
+		AccessKey:       env.AccessKeyID,
+		SecretKey:       env.SecretAccessKey,
+		Location:        region,
+		RoleARN:         config.RoleARN,
+		RoleSessionName: sessionName,
+	})
 }
 
 func (s *s3Client) makeObjectName(parts ...string) string {
@@ -105,7 +156,7 @@ func (s *s3Client) GetObject(ctx context.Context, backupID, key string) ([]byte,
 func (s *s3Client) PutFile(ctx context.Context, backupID, key string, srcPath string) error {
 	objectName := s.makeObjectName(backupID, key)
 	srcPath = path.Join(s.dataPath, srcPath)
-	opt := minio.PutObjectOptions{ContentType: "application/octet-stream"}
+	opt := minio.PutObjectOptions{ContentType: "application/octet-stream", ServerSideEncryption: s.sse}
 
 	_, err := s.client.FPutObject(ctx, s.config.Bucket, objectName, srcPath, opt)
 	if err != nil {
@@ -129,7 +180,7 @@ func (s *s3Client) PutFile(ctx context.Context, backupID, key string, srcPath st
 
 func (s *s3Client) PutObject(ctx context.Context, backupID, key string, byes []byte) error {
 	objectName := s.makeObjectName(backupID, key)
-	opt := minio.PutObjectOptions{ContentType: "application/octet-stream"}
+	opt := minio.PutObjectOptions{ContentType: "application/octet-stream", ServerSideEncryption: s.sse}
 	reader := bytes.NewReader(byes)
 	objectSize := int64(len(byes))
 
@@ -162,6 +213,15 @@ func (s *s3Client) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
 
+func (s *s3Client) DeleteObject(ctx context.Context, backupID, key string) error {
+	objectName := s.makeObjectName(backupID, key)
+	// removing a missing object succeeds
+	if err := s.client.RemoveObject(ctx, s.config.Bucket, objectName, minio.RemoveObjectOptions{}); err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", objectName))
+	}
+	return nil
+}
+
 // WriteFile downloads contents of an object to a local file destPath
 func (s *s3Client) WriteToFile(ctx context.Context, backupID, key, destPath string) error {
 	object := s.makeObjectName(backupID, key)
@@ -183,8 +243,9 @@ func (s *s3Client) Write(ctx context.Context, backupID, key string, r io.ReadClo
 	defer r.Close()
 	path := s.makeObjectName(backupID, key)
 	opt := minio.PutObjectOptions{
-		ContentType:      "application/octet-stream",
-		DisableMultipart: false,
+		ContentType:          "application/octet-stream",
+		DisableMultipart:     false,
+		ServerSideEncryption: s.sse,
 	}
 
 	info, err := s.client.PutObject(ctx, s.config.Bucket, path, r, -1, opt)
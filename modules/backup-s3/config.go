@@ -11,6 +11,18 @@
 
 package modstgs3
 
+import (
+	"fmt"
+
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// server side encryption types
+const (
+	sseS3  = "AES256"
+	sseKMS = "aws:kms"
+)
+
 type clientConfig struct {
 	Endpoint string
 	Bucket   string
@@ -20,6 +32,23 @@ type clientConfig struct {
 	// the backup to be stored in a specific
 	// directory inside the provided bucket
 	BackupPath string
+
+	// ForcePathStyle addresses the bucket as part of the path instead of
+	// the host name, as required by most S3-compatible stores like MinIO
+	// or Ceph
+	ForcePathStyle bool
+
+	// SSE is the server side encryption of stored objects, either AES256
+	// or aws:kms. Objects are encrypted with KMSKeyID if set, with the
+	// default key of the bucket otherwise.
+	SSE      string
+	KMSKeyID string
+
+	// RoleARN is the role assumed to access the bucket, using the
+	// credentials of the environment
+	RoleARN         string
+	RoleSessionName string
+	STSEndpoint     string
 }
 
 func newConfig(endpoint, bucket, path string, useSSL bool) *clientConfig {
@@ -27,5 +56,31 @@ func newConfig(endpoint, bucket, path string, useSSL bool) *clientConfig {
 	if endpoint == "" {
 		endpoint = DEFAULT_ENDPOINT
 	}
-	return &clientConfig{endpoint, bucket, useSSL, path}
+	return &clientConfig{Endpoint: endpoint, Bucket: bucket, UseSSL: useSSL, BackupPath: path}
+}
+
+func (c *clientConfig) validate() error {
+	switch c.SSE {
+	case "", sseKMS:
+	case sseS3:
+		if c.KMSKeyID != "" {
+			return fmt.Errorf("'%s' requires '%s' to be %s", s3KMSKeyID, s3SSE, sseKMS)
+		}
+	default:
+		return fmt.Errorf("'%s' must be %s or %s, got %q", s3SSE, sseS3, sseKMS, c.SSE)
+	}
+	return nil
+}
+
+// serverSide returns the encryption of stored objects, nil if objects are
+// not encrypted explicitly
+func (c *clientConfig) serverSide() (encrypt.ServerSide, error) {
+	switch {
+	case c.SSE == sseKMS || c.KMSKeyID != "":
+		return encrypt.NewSSEKMS(c.KMSKeyID, nil)
+	case c.SSE == sseS3:
+		return encrypt.NewSSE(), nil
+	default:
+		return nil, nil
+	}
 }
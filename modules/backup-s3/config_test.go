@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modstgs3
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerSideEncryption(t *testing.T) {
+	tests := []struct {
+		name    string
+		sse     string
+		keyID   string
+		want    http.Header
+		wantErr string
+	}{
+		{
+			name: "not encrypted",
+		},
+		{
+			name: "s3 managed keys",
+			sse:  sseS3,
+			want: http.Header{encrypt.SseGenericHeader: {"AES256"}},
+		},
+		{
+			name: "default kms key",
+			sse:  sseKMS,
+			want: http.Header{encrypt.SseGenericHeader: {"aws:kms"}},
+		},
+		{
+			name:  "customer managed kms key",
+			keyID: "key-1",
+			want: http.Header{
+				encrypt.SseGenericHeader: {"aws:kms"},
+				encrypt.SseKmsKeyID:      {"key-1"},
+			},
+		},
+		{
+			name:    "kms key with s3 managed keys",
+			sse:     sseS3,
+			keyID:   "key-1",
+			wantErr: "requires",
+		},
+		{
+			name:    "unknown encryption",
+			sse:     "aes",
+			wantErr: "must be",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := newConfig("", "bucket", "", true)
+			config.SSE, config.KMSKeyID = test.sse, test.keyID
+			err := config.validate()
+			if test.wantErr != "" {
+				assert.ErrorContains(t, err, test.wantErr)
+				return
+			}
+			require.Nil(t, err)
+
+			sse, err := config.serverSide()
+			require.Nil(t, err)
+			if test.want == nil {
+				assert.Nil(t, sse)
+				return
+			}
+			h := http.Header{}
+			sse.Marshal(h)
+			assert.Equal(t, test.want, h)
+		})
+	}
+}
+
+func TestAssumeRole(t *testing.T) {
+	config := newConfig("", "bucket", "", true)
+	config.RoleARN = "arn:aws:iam::123456789012:role/backup"
+
+	t.Run("without access keys", func(t *testing.T) {
+		for _, env := range []string{"AWS_ACCESS_KEY_ID", "AWS_ACCESS_KEY", "AWS_SECRET_ACCESS_KEY", "AWS_SECRET_KEY"} {
+			t.Setenv(env, "")
+		}
+		_, err := assumeRole(config, "")
+		assert.ErrorContains(t, err, s3RoleARN)
+	})
+
+	t.Run("with access keys", func(t *testing.T) {
+		t.Setenv("AWS_ACCESS_KEY_ID", "id")
+		t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+		creds, err := assumeRole(config, "eu-west-1")
+		require.Nil(t, err)
+		assert.NotNil(t, creds)
+	})
+}
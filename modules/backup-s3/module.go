@@ -38,6 +38,19 @@ const (
 	// be stored directly in the root of the
 	// bucket.
 	s3Path = "BACKUP_S3_PATH"
+
+	// required by most S3-compatible stores
+	s3ForcePathStyle = "BACKUP_S3_FORCE_PATH_STYLE"
+
+	// server side encryption of the stored objects, either AES256 or
+	// aws:kms. Setting the KMS key implies aws:kms.
+	s3SSE      = "BACKUP_S3_SSE"
+	s3KMSKeyID = "BACKUP_S3_SSE_KMS_KEY_ID"
+
+	// role assumed with the credentials of the environment
+	s3RoleARN         = "BACKUP_S3_ROLE_ARN"
+	s3RoleSessionName = "BACKUP_S3_ROLE_SESSION_NAME"
+	s3STSEndpoint     = "BACKUP_S3_STS_ENDPOINT"
 )
 
 type Module struct {
@@ -78,6 +91,15 @@ func (m *Module) Init(ctx context.Context,
 	// SSL on by default
 	useSSL := strings.ToLower(os.Getenv(s3UseSSL)) != "false"
 	config := newConfig(os.Getenv(s3Endpoint), bucket, os.Getenv(s3Path), useSSL)
+	config.ForcePathStyle = strings.ToLower(os.Getenv(s3ForcePathStyle)) == "true"
+	config.SSE = os.Getenv(s3SSE)
+	config.KMSKeyID = os.Getenv(s3KMSKeyID)
+	config.RoleARN = os.Getenv(s3RoleARN)
+	config.RoleSessionName = os.Getenv(s3RoleSessionName)
+	config.STSEndpoint = os.Getenv(s3STSEndpoint)
+	if err := config.validate(); err != nil {
+		return errors.Wrap(err, "backup init")
+	}
 	client, err := newClient(config, m.logger, m.dataPath)
 	if err != nil {
 		return errors.Wrap(err, "initialize S3 backup module")
@@ -99,6 +121,13 @@ func (m *Module) MetaInfo() (map[string]interface{}, error) {
 		metaInfo["rootName"] = root
 	}
 	metaInfo["useSSL"] = m.config.UseSSL
+	metaInfo["forcePathStyle"] = m.config.ForcePathStyle
+	if sse, _ := m.config.serverSide(); sse != nil {
+		metaInfo["serverSideEncryption"] = string(sse.Type())
+	}
+	if m.config.RoleARN != "" {
+		metaInfo["roleARN"] = m.config.RoleARN
+	}
 	return metaInfo, nil
 }
 
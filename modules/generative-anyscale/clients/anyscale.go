@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
 	"github.com/pkg/errors"
@@ -29,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/generative-anyscale/config"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 var compile, _ = regexp.Compile(`{([\w\s]*?)}`)
@@ -41,11 +43,9 @@ type anyscale struct {
 
 func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *anyscale {
 	return &anyscale{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		logger: logger,
+		apiKey:     apiKey,
+		httpClient: httpclient.New(timeout),
+		logger:     logger,
 	}
 }
 
@@ -89,6 +89,7 @@ func (v *anyscale) Generate(ctx context.Context, cfg moduletools.ClassConfig, pr
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 	apiKey, err := v.getApiKey(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Anyscale (OpenAI) API Key")
@@ -25,8 +25,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/modules/generative-aws/config"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 var compile, _ = regexp.Compile(`{([\w\s]*?)}`)
@@ -52,11 +54,9 @@ type aws struct {
 
 func New(awsAccessKey string, awsSecretKey string, timeout time.Duration, logger logrus.FieldLogger) *aws {
 	return &aws{
-		awsAccessKey: awsAccessKey,
-		awsSecretKey: awsSecretKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
+		awsAccessKey:        awsAccessKey,
+		awsSecretKey:        awsSecretKey,
+		httpClient:          httpclient.New(timeout),
 		buildBedrockUrlFn:   buildBedrockUrl,
 		buildSagemakerUrlFn: buildSagemakerUrl,
 		logger:              logger,
@@ -181,6 +181,7 @@ func (v *aws) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 
 	for k, v := range headers {
 		req.Header.Set(k, v)
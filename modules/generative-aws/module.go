@@ -72,7 +72,7 @@ func (m *GenerativeAWSModule) initAdditional(ctx context.Context, timeout time.D
 
 	m.generative = client
 
-	m.additionalPropertiesProvider = additionalprovider.NewGenerativeProvider(m.generative)
+	m.additionalPropertiesProvider = additionalprovider.NewGenerativeProvider(Name, m.generative)
 
 	return nil
 }
@@ -23,13 +23,16 @@ import (
 	"strings"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/tokens"
 	"github.com/weaviate/weaviate/modules/generative-cohere/config"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 var compile, _ = regexp.Compile(`{([\w\s]*?)}`)
@@ -42,11 +45,9 @@ type cohere struct {
 
 func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *cohere {
 	return &cohere{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		logger: logger,
+		apiKey:     apiKey,
+		httpClient: httpclient.New(timeout),
+		logger:     logger,
 	}
 }
 
@@ -93,6 +94,7 @@ func (v *cohere) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 	apiKey, err := v.getApiKey(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Cohere API Key")
@@ -123,6 +125,10 @@ func (v *cohere) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 		return nil, errors.Errorf("connection to Cohere API failed with status: %d", res.StatusCode)
 	}
 
+	if resBody.Meta != nil {
+		tokens.Report(ctx, resBody.Meta.BilledUnits.InputTokens, resBody.Meta.BilledUnits.OutputTokens)
+	}
+
 	textResponse := resBody.Generations[0].Text
 
 	return &generativemodels.GenerateResponse{
@@ -199,9 +205,18 @@ type generateInput struct {
 
 type generateResponse struct {
 	Generations []generation
+	Meta        *cohereMeta     `json:"meta,omitempty"`
 	Error       *cohereApiError `json:"error,omitempty"`
 }
 
+// cohereMeta carries the tokens billed for a call
+type cohereMeta struct {
+	BilledUnits struct {
+		InputTokens  int64 `json:"input_tokens"`
+		OutputTokens int64 `json:"output_tokens"`
+	} `json:"billed_units"`
+}
+
 type generation struct {
 	Text string `json:"text"`
 }
@@ -23,13 +23,16 @@ import (
 	"strings"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/tokens"
 	"github.com/weaviate/weaviate/modules/generative-openai/config"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 var compile, _ = regexp.Compile(`{([\w\s]*?)}`)
@@ -62,11 +65,9 @@ func New(openAIApiKey, openAIOrganization, azureApiKey string, timeout time.Dura
 		openAIApiKey:       openAIApiKey,
 		openAIOrganization: openAIOrganization,
 		azureApiKey:        azureApiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		buildUrl: buildUrlFn,
-		logger:   logger,
+		httpClient:         httpclient.New(timeout),
+		buildUrl:           buildUrlFn,
+		logger:             logger,
 	}
 }
 
@@ -109,6 +110,7 @@ func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 	apiKey, err := v.getApiKey(ctx, settings.IsAzure())
 	if err != nil {
 		return nil, errors.Wrapf(err, "OpenAI API Key")
@@ -138,6 +140,9 @@ func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	if res.StatusCode != 200 || resBody.Error != nil {
 		return nil, v.getError(res.StatusCode, resBody.Error, settings.IsAzure())
 	}
+	if resBody.Usage != nil {
+		tokens.Report(ctx, resBody.Usage.PromptTokens, resBody.Usage.CompletionTokens)
+	}
 
 	textResponse := resBody.Choices[0].Text
 	if len(resBody.Choices) > 0 && textResponse != "" {
@@ -262,29 +267,26 @@ func (v *openai) generateForPrompt(textProperties map[string]string, prompt stri
 }
 
 func (v *openai) getApiKey(ctx context.Context, isAzure bool) (string, error) {
-	var apiKey, envVar string
+	var apiKey, envVar, envValue string
 
 	if isAzure {
 		apiKey = "X-Azure-Api-Key"
 		envVar = "AZURE_APIKEY"
-		if len(v.azureApiKey) > 0 {
-			return v.azureApiKey, nil
-		}
+		envValue = v.azureApiKey
 	} else {
 		apiKey = "X-Openai-Api-Key"
 		envVar = "OPENAI_APIKEY"
-		if len(v.openAIApiKey) > 0 {
-			return v.openAIApiKey, nil
-		}
+		envValue = v.openAIApiKey
 	}
 
-	return v.getApiKeyFromContext(ctx, apiKey, envVar)
-}
-
-func (v *openai) getApiKeyFromContext(ctx context.Context, apiKey, envVar string) (string, error) {
+	// keys passed with the request, or set at runtime, take precedence over
+	// the key set at startup, so that keys can be rotated
 	if apiKeyValue := v.getValueFromContext(ctx, apiKey); apiKeyValue != "" {
 		return apiKeyValue, nil
 	}
+	if len(envValue) > 0 {
+		return envValue, nil
+	}
 	return "", fmt.Errorf("no api key found neither in request header: %s nor in environment variable under %s", apiKey, envVar)
 }
 
@@ -329,9 +331,16 @@ type message struct {
 
 type generateResponse struct {
 	Choices []choice
+	Usage   *openAIUsage    `json:"usage,omitempty"`
 	Error   *openAIApiError `json:"error,omitempty"`
 }
 
+// openAIUsage are the tokens billed for a call
+type openAIUsage struct {
+	PromptTokens     int64 `json:"prompt_tokens"`
+	CompletionTokens int64 `json:"completion_tokens"`
+}
+
 type choice struct {
 	FinishReason string
 	Index        float32
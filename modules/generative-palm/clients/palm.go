@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
 	"github.com/pkg/errors"
@@ -29,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/generative-palm/config"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type harmCategory string
@@ -113,10 +115,8 @@ type palm struct {
 
 func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *palm {
 	return &palm{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
+		apiKey:     apiKey,
+		httpClient: httpclient.New(timeout),
 		buildUrlFn: buildURL,
 		logger:     logger,
 	}
@@ -160,6 +160,7 @@ func (v *palm) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 
 	apiKey, err := v.getApiKey(ctx)
 	if err != nil {
@@ -71,7 +71,7 @@ func (m *GenerativePaLMModule) initAdditional(ctx context.Context, timeout time.
 
 	m.generative = client
 
-	m.additionalPropertiesProvider = additionalprovider.NewGenerativeProvider(m.generative)
+	m.additionalPropertiesProvider = additionalprovider.NewGenerativeProvider(Name, m.generative)
 
 	return nil
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/img2vec-neural/clients"
 	"github.com/weaviate/weaviate/modules/img2vec-neural/vectorizer"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/imageurl"
 )
 
 func New() *ImageModule {
@@ -79,7 +80,13 @@ func (m *ImageModule) initVectorizer(ctx context.Context, timeout time.Duration,
 		return errors.Wrap(err, "init remote vectorizer")
 	}
 
-	m.vectorizer = vectorizer.New(client)
+	imagesCfg, err := imageurl.ConfigFromEnv()
+	if err != nil {
+		return errors.Wrap(err, "image urls")
+	}
+	images := imageurl.NewFetcher(imagesCfg)
+
+	m.vectorizer = vectorizer.New(client, images)
 
 	return nil
 }
@@ -19,16 +19,20 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/img2vec-neural/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/imageurl"
 	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
 )
 
 type Vectorizer struct {
 	client Client
+	// images downloads images given as URL, a nil fetcher rejects them
+	images *imageurl.Fetcher
 }
 
-func New(client Client) *Vectorizer {
+func New(client Client, images *imageurl.Fetcher) *Vectorizer {
 	return &Vectorizer{
 		client: client,
+		images: images,
 	}
 }
 
@@ -54,6 +58,10 @@ func (v *Vectorizer) Object(ctx context.Context, object *models.Object,
 }
 
 func (v *Vectorizer) VectorizeImage(ctx context.Context, id, image string) ([]float32, error) {
+	image, err := v.images.Resolve(ctx, image)
+	if err != nil {
+		return nil, err
+	}
 	res, err := v.client.Vectorize(ctx, id, image)
 	if err != nil {
 		return nil, err
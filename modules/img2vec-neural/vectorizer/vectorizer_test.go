@@ -27,7 +27,7 @@ func TestVectorizer(t *testing.T) {
 	t.Run("should vectorize image", func(t *testing.T) {
 		// given
 		client := &fakeClient{}
-		vectorizer := &Vectorizer{client}
+		vectorizer := &Vectorizer{client: client}
 		config := newConfigBuilder().addSetting("imageFields", []interface{}{"image"}).build()
 		settings := NewClassSettings(config)
 		object := &models.Object{
@@ -45,10 +45,25 @@ func TestVectorizer(t *testing.T) {
 		assert.NotNil(t, object.Vector)
 	})
 
+	t.Run("should not download image URLs without fetcher", func(t *testing.T) {
+		client := &fakeClient{}
+		vectorizer := &Vectorizer{client: client}
+		config := newConfigBuilder().addSetting("imageFields", []interface{}{"image"}).build()
+		object := &models.Object{
+			ID: "some-uuid",
+			Properties: map[string]interface{}{
+				"image": "https://example.com/image.png",
+			},
+		}
+
+		err := vectorizer.Object(context.Background(), object, nil, NewClassSettings(config))
+		assert.ErrorContains(t, err, "image URLs are not supported")
+	})
+
 	t.Run("should vectorize 2 image fields", func(t *testing.T) {
 		// given
 		client := &fakeClient{}
-		vectorizer := &Vectorizer{client}
+		vectorizer := &Vectorizer{client: client}
 		config := newConfigBuilder().addSetting("imageFields", []interface{}{"image1", "image2"}).build()
 		settings := NewClassSettings(config)
 		object := &models.Object{
@@ -134,7 +149,7 @@ func TestVectorizerWithDiff(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			client := &fakeClient{}
-			vectorizer := &Vectorizer{client}
+			vectorizer := &Vectorizer{client: client}
 			config := newConfigBuilder().addSetting("imageFields", []interface{}{"image"}).build()
 			settings := NewClassSettings(config)
 
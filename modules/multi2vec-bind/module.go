@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/multi2vec-bind/clients"
 	"github.com/weaviate/weaviate/modules/multi2vec-bind/vectorizer"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/imageurl"
 )
 
 const Name = "multi2vec-bind"
@@ -151,8 +152,14 @@ func (m *BindModule) initVectorizer(ctx context.Context, timeout time.Duration,
 		return errors.Wrap(err, "init remote vectorizer")
 	}
 
-	m.bindVectorizer = vectorizer.New(client)
-	m.textVectorizer = vectorizer.New(client)
+	imagesCfg, err := imageurl.ConfigFromEnv()
+	if err != nil {
+		return errors.Wrap(err, "image urls")
+	}
+	images := imageurl.NewFetcher(imagesCfg)
+
+	m.bindVectorizer = vectorizer.New(client, images)
+	m.textVectorizer = vectorizer.New(client, images)
 	m.metaClient = client
 
 	return nil
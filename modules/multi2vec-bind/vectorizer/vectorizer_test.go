@@ -27,7 +27,7 @@ func TestVectorizer(t *testing.T) {
 	t.Run("should vectorize image", func(t *testing.T) {
 		// given
 		client := &fakeClient{}
-		vectorizer := &Vectorizer{client}
+		vectorizer := &Vectorizer{client: client}
 		config := newConfigBuilder().addSetting("imageFields", []interface{}{"image"}).build()
 		settings := NewClassSettings(config)
 		object := &models.Object{
@@ -48,7 +48,7 @@ func TestVectorizer(t *testing.T) {
 	t.Run("should vectorize 2 image fields", func(t *testing.T) {
 		// given
 		client := &fakeClient{}
-		vectorizer := &Vectorizer{client}
+		vectorizer := &Vectorizer{client: client}
 		config := newConfigBuilder().addSetting("imageFields", []interface{}{"image1", "image2"}).build()
 		settings := NewClassSettings(config)
 		object := &models.Object{
@@ -153,7 +153,7 @@ func TestVectorizerWithDiff(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			client := &fakeClient{}
-			vectorizer := &Vectorizer{client}
+			vectorizer := &Vectorizer{client: client}
 			config := newConfigBuilder().
 				addSetting("imageFields", []interface{}{"image"}).
 				addSetting("textFields", []interface{}{"text"}).
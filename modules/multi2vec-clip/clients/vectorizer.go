@@ -22,7 +22,9 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/modules/multi2vec-clip/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type vectorizer struct {
@@ -33,11 +35,9 @@ type vectorizer struct {
 
 func New(origin string, timeout time.Duration, logger logrus.FieldLogger) *vectorizer {
 	return &vectorizer{
-		origin: origin,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		logger: logger,
+		origin:     origin,
+		httpClient: httpclient.New(timeout),
+		logger:     logger,
 	}
 }
 
@@ -57,6 +57,7 @@ func (v *vectorizer) Vectorize(ctx context.Context,
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 
 	res, err := v.httpClient.Do(req)
 	if err != nil {
@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/multi2vec-clip/clients"
 	"github.com/weaviate/weaviate/modules/multi2vec-clip/vectorizer"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/imageurl"
 )
 
 func New() *ClipModule {
@@ -114,8 +115,14 @@ func (m *ClipModule) initVectorizer(ctx context.Context, timeout time.Duration,
 		return errors.Wrap(err, "init remote vectorizer")
 	}
 
-	m.imageVectorizer = vectorizer.New(client)
-	m.textVectorizer = vectorizer.New(client)
+	imagesCfg, err := imageurl.ConfigFromEnv()
+	if err != nil {
+		return errors.Wrap(err, "image urls")
+	}
+	images := imageurl.NewFetcher(imagesCfg)
+
+	m.imageVectorizer = vectorizer.New(client, images)
+	m.textVectorizer = vectorizer.New(client, images)
 	m.metaClient = client
 
 	return nil
@@ -20,16 +20,20 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/multi2vec-clip/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/imageurl"
 	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
 )
 
 type Vectorizer struct {
 	client Client
+	// images downloads images given as URL, a nil fetcher rejects them
+	images *imageurl.Fetcher
 }
 
-func New(client Client) *Vectorizer {
+func New(client Client, images *imageurl.Fetcher) *Vectorizer {
 	return &Vectorizer{
 		client: client,
+		images: images,
 	}
 }
 
@@ -58,6 +62,10 @@ func (v *Vectorizer) Object(ctx context.Context, object *models.Object,
 }
 
 func (v *Vectorizer) VectorizeImage(ctx context.Context, image string) ([]float32, error) {
+	image, err := v.images.Resolve(ctx, image)
+	if err != nil {
+		return nil, err
+	}
 	res, err := v.client.Vectorize(ctx, []string{}, []string{image})
 	if err != nil {
 		return nil, err
@@ -111,6 +119,11 @@ func (v *Vectorizer) object(ctx context.Context, id strfmt.UUID,
 		return objDiff.GetVec(), nil
 	}
 
+	images, err := v.images.ResolveAll(ctx, images)
+	if err != nil {
+		return nil, err
+	}
+
 	vectors := [][]float32{}
 	if len(texts) > 0 || len(images) > 0 {
 		res, err := v.client.Vectorize(ctx, texts, images)
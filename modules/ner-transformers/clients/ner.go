@@ -22,7 +22,9 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/modules/ner-transformers/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type ner struct {
@@ -54,7 +56,7 @@ type nerResponse struct {
 func New(origin string, timeout time.Duration, logger logrus.FieldLogger) *ner {
 	return &ner{
 		origin:     origin,
-		httpClient: &http.Client{Timeout: timeout},
+		httpClient: httpclient.New(timeout),
 		logger:     logger,
 	}
 }
@@ -74,6 +76,7 @@ func (n *ner) GetTokens(ctx context.Context, property,
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 
 	res, err := n.httpClient.Do(req)
 	if err != nil {
@@ -33,7 +33,12 @@ func (m *NERModule) PropertyConfigDefaults(
 func (m *NERModule) ValidateClass(ctx context.Context,
 	class *models.Class, cfg moduletools.ClassConfig,
 ) error {
-	return nil
+	settings := newEntitiesSettings(cfg)
+	if settings.target == "" {
+		return nil
+	}
+	_, _, err := settings.validate(class)
+	return err
 }
 
 var _ = modulecapabilities.ClassConfigurator(New())
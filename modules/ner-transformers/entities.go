@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	// entitiesPropertyConfig names the object[] property the entities are
+	// stored in, entities are only stored if it is set
+	entitiesPropertyConfig = "entitiesProperty"
+	// sourcePropertiesConfig optionally limits the text properties entities
+	// are extracted from, all text properties are used otherwise
+	sourcePropertiesConfig = "properties"
+)
+
+// entityFields are the nested properties a stored entity can have. Only
+// the ones declared on the entities property are written.
+var entityFields = map[string]schema.DataType{
+	"text":          schema.DataTypeText,
+	"type":          schema.DataTypeText,
+	"property":      schema.DataTypeText,
+	"startPosition": schema.DataTypeInt,
+	"endPosition":   schema.DataTypeInt,
+	"certainty":     schema.DataTypeNumber,
+}
+
+type entitiesSettings struct {
+	target  string
+	sources []string
+}
+
+func newEntitiesSettings(cfg moduletools.ClassConfig) entitiesSettings {
+	var settings entitiesSettings
+	classCfg := cfg.Class()
+	if target, ok := classCfg[entitiesPropertyConfig].(string); ok {
+		settings.target = target
+	}
+	switch sources := classCfg[sourcePropertiesConfig].(type) {
+	case []interface{}:
+		for _, source := range sources {
+			if name, ok := source.(string); ok {
+				settings.sources = append(settings.sources, name)
+			}
+		}
+	case []string:
+		settings.sources = sources
+	}
+	return settings
+}
+
+// validate checks the entities property can hold the extracted entities
+// and returns the names of the source properties and the entity fields
+func (s entitiesSettings) validate(class *models.Class) ([]string, []string, error) {
+	target, err := schema.GetPropertyByName(class, s.target)
+	if err != nil {
+		return nil, nil, errors.Errorf("%s: property %q not found", entitiesPropertyConfig, s.target)
+	}
+	if len(target.DataType) != 1 || target.DataType[0] != string(schema.DataTypeObjectArray) {
+		return nil, nil, errors.Errorf("%s: property %q must be of type %s",
+			entitiesPropertyConfig, s.target, schema.DataTypeObjectArray)
+	}
+
+	fields := make([]string, 0, len(target.NestedProperties))
+	for _, nested := range target.NestedProperties {
+		dataType, ok := entityFields[nested.Name]
+		if !ok {
+			return nil, nil, errors.Errorf("%s: unsupported nested property %q, supported are %v",
+				entitiesPropertyConfig, nested.Name, entityFieldNames())
+		}
+		if len(nested.DataType) != 1 || nested.DataType[0] != string(dataType) {
+			return nil, nil, errors.Errorf("%s: nested property %q must be of type %s",
+				entitiesPropertyConfig, nested.Name, dataType)
+		}
+		fields = append(fields, nested.Name)
+	}
+	if !containsString(fields, "text") || !containsString(fields, "type") {
+		return nil, nil, errors.Errorf("%s: property %q needs the nested properties \"text\" and \"type\"",
+			entitiesPropertyConfig, s.target)
+	}
+
+	sources := s.sources
+	if len(sources) == 0 {
+		for _, prop := range class.Properties {
+			if prop.Name != s.target && isText(prop) {
+				sources = append(sources, prop.Name)
+			}
+		}
+		sort.Strings(sources)
+	}
+	for _, name := range sources {
+		if name == s.target {
+			return nil, nil, errors.Errorf("%s: property %q cannot be its own source",
+				sourcePropertiesConfig, name)
+		}
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			return nil, nil, errors.Errorf("%s: property %q not found", sourcePropertiesConfig, name)
+		}
+		if !isText(prop) {
+			return nil, nil, errors.Errorf("%s: property %q must be of type %s",
+				sourcePropertiesConfig, name, schema.DataTypeText)
+		}
+	}
+
+	return sources, fields, nil
+}
+
+// EnrichObject stores the entities found in the object's text properties
+// on the configured entities property, so they can be filtered and
+// aggregated on like any other nested property
+func (m *NERModule) EnrichObject(ctx context.Context, object *models.Object,
+	class *models.Class, cfg moduletools.ClassConfig,
+) error {
+	settings := newEntitiesSettings(cfg)
+	if settings.target == "" {
+		return nil
+	}
+
+	sources, fields, err := settings.validate(class)
+	if err != nil {
+		return err
+	}
+
+	props, ok := object.Properties.(map[string]interface{})
+	if !ok {
+		if object.Properties != nil {
+			return fmt.Errorf("expected properties to be a map, but got %T", object.Properties)
+		}
+		props = map[string]interface{}{}
+	}
+
+	var entities []interface{}
+	for _, source := range sources {
+		text, ok := props[source].(string)
+		if !ok || text == "" {
+			continue
+		}
+
+		tokens, err := m.ner.GetTokens(ctx, source, text)
+		if err != nil {
+			return errors.Wrapf(err, "extract entities of property %q", source)
+		}
+
+		for _, token := range tokens {
+			values := map[string]interface{}{
+				"text":          token.Word,
+				"type":          token.Entity,
+				"property":      token.Property,
+				"startPosition": int64(token.StartPosition),
+				"endPosition":   int64(token.EndPosition),
+				"certainty":     token.Certainty,
+			}
+			entity := make(map[string]interface{}, len(fields))
+			for _, field := range fields {
+				entity[field] = values[field]
+			}
+			entities = append(entities, entity)
+		}
+	}
+
+	if len(entities) == 0 {
+		// don't keep entities of a previous version of the object
+		delete(props, settings.target)
+	} else {
+		props[settings.target] = entities
+	}
+	object.Properties = props
+	return nil
+}
+
+func isText(prop *models.Property) bool {
+	return len(prop.DataType) == 1 && prop.DataType[0] == string(schema.DataTypeText)
+}
+
+func containsString(list []string, value string) bool {
+	for i := range list {
+		if list[i] == value {
+			return true
+		}
+	}
+	return false
+}
+
+func entityFieldNames() []string {
+	names := make([]string, 0, len(entityFields))
+	for name := range entityFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+var _ = modulecapabilities.ObjectEnricher(New())
@@ -0,0 +1,203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/modules/ner-transformers/ent"
+)
+
+func TestEnrichObject(t *testing.T) {
+	entitiesProp := func(nested ...*models.NestedProperty) *models.Property {
+		return &models.Property{
+			Name:             "entities",
+			DataType:         []string{"object[]"},
+			NestedProperties: nested,
+		}
+	}
+	nested := func(name, dataType string) *models.NestedProperty {
+		return &models.NestedProperty{Name: name, DataType: []string{dataType}}
+	}
+	class := func(entities *models.Property) *models.Class {
+		return &models.Class{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "body", DataType: []string{"text"}},
+				{Name: "views", DataType: []string{"int"}},
+				entities,
+			},
+		}
+	}
+
+	t.Run("stores entities of all text properties", func(t *testing.T) {
+		m := &NERModule{ner: &fakeNERClient{}}
+		c := class(entitiesProp(nested("text", "text"), nested("type", "text"),
+			nested("property", "text"), nested("startPosition", "int")))
+		obj := &models.Object{Class: "Article", Properties: map[string]interface{}{
+			"title": "Apple",
+			"body":  "Berlin",
+			"views": int64(3),
+		}}
+
+		err := m.EnrichObject(context.Background(), obj, c,
+			fakeClassConfig{"entitiesProperty": "entities"})
+		require.Nil(t, err)
+
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"text": "Berlin", "type": "LOC", "property": "body", "startPosition": int64(0)},
+			map[string]interface{}{"text": "Apple", "type": "ORG", "property": "title", "startPosition": int64(0)},
+		}, obj.Properties.(map[string]interface{})["entities"])
+	})
+
+	t.Run("only uses the configured source properties", func(t *testing.T) {
+		m := &NERModule{ner: &fakeNERClient{}}
+		c := class(entitiesProp(nested("text", "text"), nested("type", "text")))
+		obj := &models.Object{Class: "Article", Properties: map[string]interface{}{
+			"title": "Apple",
+			"body":  "Berlin",
+		}}
+
+		err := m.EnrichObject(context.Background(), obj, c, fakeClassConfig{
+			"entitiesProperty": "entities",
+			"properties":       []interface{}{"title"},
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"text": "Apple", "type": "ORG"},
+		}, obj.Properties.(map[string]interface{})["entities"])
+	})
+
+	t.Run("removes stale entities", func(t *testing.T) {
+		m := &NERModule{ner: &fakeNERClient{}}
+		c := class(entitiesProp(nested("text", "text"), nested("type", "text")))
+		obj := &models.Object{Class: "Article", Properties: map[string]interface{}{
+			"title":    "nothing here",
+			"entities": []interface{}{map[string]interface{}{"text": "Apple", "type": "ORG"}},
+		}}
+
+		err := m.EnrichObject(context.Background(), obj, c,
+			fakeClassConfig{"entitiesProperty": "entities"})
+		require.Nil(t, err)
+
+		assert.NotContains(t, obj.Properties.(map[string]interface{}), "entities")
+	})
+
+	t.Run("does nothing without an entities property", func(t *testing.T) {
+		ner := &fakeNERClient{}
+		m := &NERModule{ner: ner}
+		obj := &models.Object{Class: "Article", Properties: map[string]interface{}{"title": "Apple"}}
+
+		err := m.EnrichObject(context.Background(), obj, class(entitiesProp()), fakeClassConfig{})
+		require.Nil(t, err)
+		assert.Equal(t, 0, ner.calls)
+	})
+
+	t.Run("validates the entities property", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			class    *models.Class
+			settings fakeClassConfig
+			err      string
+		}{
+			{
+				name:     "missing property",
+				class:    class(entitiesProp(nested("text", "text"), nested("type", "text"))),
+				settings: fakeClassConfig{"entitiesProperty": "missing"},
+				err:      "not found",
+			},
+			{
+				name:     "wrong data type",
+				class:    class(entitiesProp(nested("text", "text"), nested("type", "text"))),
+				settings: fakeClassConfig{"entitiesProperty": "title"},
+				err:      "must be of type object[]",
+			},
+			{
+				name:     "missing type",
+				class:    class(entitiesProp(nested("text", "text"))),
+				settings: fakeClassConfig{"entitiesProperty": "entities"},
+				err:      "needs the nested properties",
+			},
+			{
+				name:     "wrong nested data type",
+				class:    class(entitiesProp(nested("text", "text"), nested("type", "text"), nested("certainty", "int"))),
+				settings: fakeClassConfig{"entitiesProperty": "entities"},
+				err:      "must be of type number",
+			},
+			{
+				name:  "source is not text",
+				class: class(entitiesProp(nested("text", "text"), nested("type", "text"))),
+				settings: fakeClassConfig{
+					"entitiesProperty": "entities",
+					"properties":       []interface{}{"views"},
+				},
+				err: "must be of type text",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := New().ValidateClass(context.Background(), tt.class, tt.settings)
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+			})
+		}
+	})
+}
+
+type fakeNERClient struct {
+	calls int
+}
+
+func (c *fakeNERClient) GetTokens(ctx context.Context, property, text string,
+) ([]ent.TokenResult, error) {
+	c.calls++
+	entities := map[string]string{"Apple": "ORG", "Berlin": "LOC"}
+	entity, ok := entities[text]
+	if !ok {
+		return nil, nil
+	}
+	return []ent.TokenResult{{
+		Property:    property,
+		Word:        text,
+		Entity:      entity,
+		Certainty:   0.9,
+		EndPosition: len(text),
+	}}, nil
+}
+
+func (c *fakeNERClient) MetaInfo() (map[string]interface{}, error) {
+	return nil, nil
+}
+
+type fakeClassConfig map[string]interface{}
+
+func (cfg fakeClassConfig) Class() map[string]interface{} {
+	return cfg
+}
+
+func (cfg fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return cfg
+}
+
+func (cfg fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func (cfg fakeClassConfig) Tenant() string {
+	return ""
+}
@@ -22,13 +22,16 @@ import (
 	"strings"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/tokens"
 	"github.com/weaviate/weaviate/modules/qna-openai/config"
 	"github.com/weaviate/weaviate/modules/qna-openai/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 func buildUrl(baseURL, resourceName, deploymentID string) (string, error) {
@@ -58,7 +61,7 @@ func New(openAIApiKey, openAIOrganization, azureApiKey string, timeout time.Dura
 		openAIApiKey:       openAIApiKey,
 		openAIOrganization: openAIOrganization,
 		azureApiKey:        azureApiKey,
-		httpClient:         &http.Client{Timeout: timeout},
+		httpClient:         httpclient.New(timeout),
 		buildUrlFn:         buildUrl,
 		logger:             logger,
 	}
@@ -93,6 +96,7 @@ func (v *qna) Answer(ctx context.Context, text, question string, cfg moduletools
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 	apiKey, err := v.getApiKey(ctx, settings.IsAzure())
 	if err != nil {
 		return nil, errors.Wrapf(err, "OpenAI API Key")
@@ -122,6 +126,9 @@ func (v *qna) Answer(ctx context.Context, text, question string, cfg moduletools
 	if res.StatusCode != 200 || resBody.Error != nil {
 		return nil, v.getError(res.StatusCode, resBody.Error, settings.IsAzure())
 	}
+	if resBody.Usage != nil {
+		tokens.Report(ctx, resBody.Usage.PromptTokens, resBody.Usage.CompletionTokens)
+	}
 
 	if len(resBody.Choices) > 0 && resBody.Choices[0].Text != "" {
 		return &ent.AnswerResult{
@@ -174,29 +181,26 @@ A:`, strings.ReplaceAll(text, "\n", " "), question)
 }
 
 func (v *qna) getApiKey(ctx context.Context, isAzure bool) (string, error) {
-	var apiKey, envVar string
+	var apiKey, envVar, envValue string
 
 	if isAzure {
 		apiKey = "X-Azure-Api-Key"
 		envVar = "AZURE_APIKEY"
-		if len(v.azureApiKey) > 0 {
-			return v.azureApiKey, nil
-		}
+		envValue = v.azureApiKey
 	} else {
 		apiKey = "X-Openai-Api-Key"
 		envVar = "OPENAI_APIKEY"
-		if len(v.openAIApiKey) > 0 {
-			return v.openAIApiKey, nil
-		}
+		envValue = v.openAIApiKey
 	}
 
-	return v.getApiKeyFromContext(ctx, apiKey, envVar)
-}
-
-func (v *qna) getApiKeyFromContext(ctx context.Context, apiKey, envVar string) (string, error) {
+	// keys passed with the request, or set at runtime, take precedence over
+	// the key set at startup, so that keys can be rotated
 	if apiKeyValue := v.getValueFromContext(ctx, apiKey); apiKeyValue != "" {
 		return apiKeyValue, nil
 	}
+	if len(envValue) > 0 {
+		return envValue, nil
+	}
 	return "", fmt.Errorf("no api key found neither in request header: %s nor in environment variable under %s", apiKey, envVar)
 }
 
@@ -233,9 +237,16 @@ type answersInput struct {
 
 type answersResponse struct {
 	Choices []choice
+	Usage   *openAIUsage    `json:"usage,omitempty"`
 	Error   *openAIApiError `json:"error,omitempty"`
 }
 
+// openAIUsage are the tokens billed for a call
+type openAIUsage struct {
+	PromptTokens     int64 `json:"prompt_tokens"`
+	CompletionTokens int64 `json:"completion_tokens"`
+}
+
 type choice struct {
 	FinishReason string
 	Index        float32
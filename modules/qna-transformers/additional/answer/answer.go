@@ -26,7 +26,7 @@ type Params struct{}
 
 type qnaClient interface {
 	Answer(ctx context.Context,
-		text, question string) (*ent.AnswerResult, error)
+		text, question string, opts ent.AnswerOptions) (*ent.AnswerResult, error)
 }
 
 type paramsHelper interface {
@@ -35,6 +35,8 @@ type paramsHelper interface {
 	GetCertainty(params interface{}) float64
 	GetDistance(params interface{}) float64
 	GetRerank(params interface{}) bool
+	GetModel(params interface{}) string
+	GetMaxAnswers(params interface{}) int
 }
 
 type AnswerProvider struct {
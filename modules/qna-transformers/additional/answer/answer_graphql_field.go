@@ -29,6 +29,17 @@ func (p *AnswerProvider) additionalAnswerField(classname string) *graphql.Field
 				"certainty":     &graphql.Field{Type: graphql.Float},
 				"distance":      &graphql.Field{Type: graphql.Float},
 				"hasAnswer":     &graphql.Field{Type: graphql.Boolean},
+				"answers": &graphql.Field{Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+					Name: fmt.Sprintf("%sAdditionalAnswerCandidate", classname),
+					Fields: graphql.Fields{
+						"result":        &graphql.Field{Type: graphql.String},
+						"startPosition": &graphql.Field{Type: graphql.Int},
+						"endPosition":   &graphql.Field{Type: graphql.Int},
+						"property":      &graphql.Field{Type: graphql.String},
+						"certainty":     &graphql.Field{Type: graphql.Float},
+						"distance":      &graphql.Field{Type: graphql.Float},
+					},
+				}))},
 			},
 		}),
 	}
@@ -15,6 +15,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tailor-inc/graphql"
 )
 
@@ -37,6 +38,7 @@ func TestAnswerField(t *testing.T) {
 		//     distance: 0.2
 		//     property: "propName"
 		//     hasAnswer: true
+		//     answers: [{result, startPosition, endPosition, property, certainty, distance}]
 		//   }
 		// }
 		assert.NotNil(t, answer)
@@ -44,7 +46,7 @@ func TestAnswerField(t *testing.T) {
 		assert.NotNil(t, answer.Type)
 		answerObject, answerObjectOK := answer.Type.(*graphql.Object)
 		assert.True(t, answerObjectOK)
-		assert.Equal(t, 7, len(answerObject.Fields()))
+		assert.Equal(t, 8, len(answerObject.Fields()))
 		assert.NotNil(t, answerObject.Fields()["result"])
 		assert.NotNil(t, answerObject.Fields()["startPosition"])
 		assert.NotNil(t, answerObject.Fields()["endPosition"])
@@ -52,5 +54,9 @@ func TestAnswerField(t *testing.T) {
 		assert.NotNil(t, answerObject.Fields()["certainty"])
 		assert.NotNil(t, answerObject.Fields()["distance"])
 		assert.NotNil(t, answerObject.Fields()["hasAnswer"])
+		require.NotNil(t, answerObject.Fields()["answers"])
+		answersList, answersListOK := answerObject.Fields()["answers"].Type.(*graphql.List)
+		require.True(t, answersListOK)
+		assert.Equal(t, "ClassAdditionalAnswerCandidate", answersList.OfType.Name())
 	})
 }
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
@@ -33,6 +34,10 @@ func (p *AnswerProvider) findAnswer(ctx context.Context,
 			return in, errors.New("empty question")
 		}
 		properties := p.paramsHelper.GetProperties(argumentModuleParams["ask"])
+		opts := ent.AnswerOptions{
+			Model: p.paramsHelper.GetModel(argumentModuleParams["ask"]),
+			Limit: p.paramsHelper.GetMaxAnswers(argumentModuleParams["ask"]),
+		}
 
 		for i := range in {
 			textProperties := map[string]string{}
@@ -45,16 +50,12 @@ func (p *AnswerProvider) findAnswer(ctx context.Context,
 				}
 			}
 
-			texts := []string{}
-			for _, value := range textProperties {
-				texts = append(texts, value)
-			}
-			text := strings.Join(texts, " ")
+			text, segments := joinTextProperties(textProperties)
 			if len(text) == 0 {
 				return in, errors.New("empty content")
 			}
 
-			answer, err := p.qna.Answer(ctx, text, question)
+			answer, err := p.qna.Answer(ctx, text, question, opts)
 			if err != nil {
 				return in, err
 			}
@@ -64,16 +65,19 @@ func (p *AnswerProvider) findAnswer(ctx context.Context,
 				ap = models.AdditionalProperties{}
 			}
 
-			if answerMeetsSimilarityThreshold(argumentModuleParams["ask"], p.paramsHelper, answer) {
-				propertyName, startPos, endPos := p.findProperty(answer.Answer, textProperties)
+			candidates := p.answerCandidates(argumentModuleParams["ask"], answer,
+				textProperties, segments)
+			if len(candidates) > 0 {
+				best := candidates[0]
 				ap["answer"] = &qnamodels.Answer{
-					Result:        answer.Answer,
-					Property:      propertyName,
-					StartPosition: startPos,
-					EndPosition:   endPos,
-					Certainty:     answer.Certainty,
-					Distance:      answer.Distance,
-					HasAnswer:     answer.Answer != nil,
+					Result:        best.Result,
+					Property:      best.Property,
+					StartPosition: best.StartPosition,
+					EndPosition:   best.EndPosition,
+					Certainty:     best.Certainty,
+					Distance:      best.Distance,
+					HasAnswer:     true,
+					Answers:       candidates,
 				}
 			} else {
 				ap["answer"] = &qnamodels.Answer{
@@ -92,14 +96,96 @@ func (p *AnswerProvider) findAnswer(ctx context.Context,
 	return in, nil
 }
 
-func answerMeetsSimilarityThreshold(params interface{}, helper paramsHelper, ans *ent.AnswerResult) bool {
-	certainty := helper.GetCertainty(params)
-	if certainty > 0 && ans.Certainty != nil && *ans.Certainty < certainty {
+// textSegment locates a property's value inside the joined text
+// sent to the inference container, offsets are in runes
+type textSegment struct {
+	property string
+	start    int
+	end      int
+}
+
+// joinTextProperties concatenates the values in a stable order, so that
+// the offsets of an answer span can be mapped back onto a property
+func joinTextProperties(textProperties map[string]string) (string, []textSegment) {
+	names := make([]string, 0, len(textProperties))
+	for property := range textProperties {
+		names = append(names, property)
+	}
+	sort.Strings(names)
+
+	texts := make([]string, len(names))
+	segments := make([]textSegment, len(names))
+	offset := 0
+	for i, property := range names {
+		texts[i] = textProperties[property]
+		length := utf8.RuneCountInString(texts[i])
+		segments[i] = textSegment{property: property, start: offset, end: offset + length}
+		offset += length + 1 // separator
+	}
+	return strings.Join(texts, " "), segments
+}
+
+func (p *AnswerProvider) answerCandidates(params interface{}, answer *ent.AnswerResult,
+	textProperties map[string]string, segments []textSegment,
+) []*qnamodels.AnswerCandidate {
+	spans := answer.Answers
+	if len(spans) == 0 && answer.Answer != nil {
+		spans = []ent.AnswerSpan{{
+			Answer:    *answer.Answer,
+			Certainty: answer.Certainty,
+			Distance:  answer.Distance,
+		}}
+	}
+
+	candidates := make([]*qnamodels.AnswerCandidate, 0, len(spans))
+	for i := range spans {
+		span := spans[i]
+		if !answerMeetsSimilarityThreshold(params, p.paramsHelper, span.Certainty, span.Distance) {
+			continue
+		}
+		propertyName, startPos, endPos := p.locateSpan(span, textProperties, segments)
+		candidates = append(candidates, &qnamodels.AnswerCandidate{
+			Result:        &span.Answer,
+			Property:      propertyName,
+			StartPosition: startPos,
+			EndPosition:   endPos,
+			Certainty:     span.Certainty,
+			Distance:      span.Distance,
+		})
+	}
+	return candidates
+}
+
+// locateSpan uses the offsets reported by the inference container if
+// present and falls back to searching the answer in the properties
+func (p *AnswerProvider) locateSpan(span ent.AnswerSpan,
+	textProperties map[string]string, segments []textSegment,
+) (*string, int, int) {
+	if span.Start != nil && span.End != nil {
+		start, end := *span.Start, *span.End
+		for _, segment := range segments {
+			if start >= segment.start && end <= segment.end && start <= end {
+				value := []rune(textProperties[segment.property])
+				property := segment.property
+				startPos := len(string(value[:start-segment.start]))
+				endPos := len(string(value[:end-segment.start]))
+				return &property, startPos, endPos
+			}
+		}
+	}
+	return p.findProperty(&span.Answer, textProperties)
+}
+
+func answerMeetsSimilarityThreshold(params interface{}, helper paramsHelper,
+	certainty, distance *float64,
+) bool {
+	minCertainty := helper.GetCertainty(params)
+	if minCertainty > 0 && certainty != nil && *certainty < minCertainty {
 		return false
 	}
 
-	distance := helper.GetDistance(params)
-	if distance > 0 && ans.Distance != nil && *ans.Distance > distance {
+	maxDistance := helper.GetDistance(params)
+	if maxDistance > 0 && distance != nil && *distance > maxDistance {
 		return false
 	}
 
@@ -120,7 +206,7 @@ func (p *AnswerProvider) getAnswerCertainty(result search.Result) float64 {
 	if ok {
 		answer, ok := answerObj.(*qnamodels.Answer)
 		if ok {
-			if answer.HasAnswer {
+			if answer.HasAnswer && answer.Certainty != nil {
 				return *answer.Certainty
 			}
 		}
@@ -153,6 +153,54 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 		assert.Equal(t, true, answerAdditional.HasAnswer)
 	})
 
+	t.Run("should return multiple candidates mapped onto their properties", func(t *testing.T) {
+		// given
+		qnaClient := &fakeQnAClient{}
+		fakeHelper := &fakeParamsHelper{}
+		answerProvider := New(qnaClient, fakeHelper)
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"city":  "It is Berlin",
+					"other": "or maybe Paris Rome",
+				},
+			},
+		}
+		fakeParams := &Params{}
+		limit := 1
+		argumentModuleParams := map[string]interface{}{
+			"ask": map[string]interface{}{
+				"question":   "multiple",
+				"certainty":  0.5,
+				"maxAnswers": 3,
+			},
+		}
+
+		// when
+		out, err := answerProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, argumentModuleParams, nil)
+
+		// then
+		require.Nil(t, err)
+		require.Len(t, out, 1)
+		answer, ok := out[0].AdditionalProperties["answer"].(*qnamodels.Answer)
+		require.True(t, ok)
+		assert.True(t, answer.HasAnswer)
+		assert.Equal(t, "Berlin", *answer.Result)
+		assert.Equal(t, "city", *answer.Property)
+		assert.Equal(t, 6, answer.StartPosition)
+		assert.Equal(t, 12, answer.EndPosition)
+		assert.Equal(t, 0.9, *answer.Certainty)
+
+		require.Len(t, answer.Answers, 2)
+		assert.Equal(t, "Berlin", *answer.Answers[0].Result)
+		assert.Equal(t, "Paris", *answer.Answers[1].Result)
+		assert.Equal(t, "other", *answer.Answers[1].Property)
+		assert.Equal(t, 9, answer.Answers[1].StartPosition)
+		assert.Equal(t, 14, answer.Answers[1].EndPosition)
+		assert.Equal(t, 0.6, *answer.Answers[1].Certainty)
+	})
+
 	t.Run("should answer with similarity set above ask distance", func(t *testing.T) {
 		// given
 		qnaClient := &fakeQnAClient{}
@@ -501,8 +549,19 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 type fakeQnAClient struct{}
 
 func (c *fakeQnAClient) Answer(ctx context.Context,
-	text, question string,
+	text, question string, opts ent.AnswerOptions,
 ) (*ent.AnswerResult, error) {
+	if question == "multiple" {
+		return &ent.AnswerResult{
+			Text:     text,
+			Question: question,
+			Answers: []ent.AnswerSpan{
+				{Answer: "Berlin", Certainty: ptFloat(0.9), Start: ptInt(6), End: ptInt(12)},
+				{Answer: "Paris", Certainty: ptFloat(0.6), Start: ptInt(22), End: ptInt(27)},
+				{Answer: "Rome", Certainty: ptFloat(0.1), Start: ptInt(28), End: ptInt(32)},
+			},
+		}, nil
+	}
 	if text == "rerank 0.9" {
 		return c.getAnswer(question, "rerank 0.9", 0.9), nil
 	}
@@ -563,6 +622,24 @@ func (h *fakeParamsHelper) GetDistance(params interface{}) float64 {
 	return 0
 }
 
+func (h *fakeParamsHelper) GetModel(params interface{}) string {
+	if fakeParamsMap, ok := params.(map[string]interface{}); ok {
+		if model, ok := fakeParamsMap["model"].(string); ok {
+			return model
+		}
+	}
+	return ""
+}
+
+func (h *fakeParamsHelper) GetMaxAnswers(params interface{}) int {
+	if fakeParamsMap, ok := params.(map[string]interface{}); ok {
+		if maxAnswers, ok := fakeParamsMap["maxAnswers"].(int); ok {
+			return maxAnswers
+		}
+	}
+	return 0
+}
+
 func (h *fakeParamsHelper) GetRerank(params interface{}) bool {
 	if fakeParamsMap, ok := params.(map[string]interface{}); ok {
 		if rerank, ok := fakeParamsMap["rerank"].(bool); ok {
@@ -575,3 +652,7 @@ func (h *fakeParamsHelper) GetRerank(params interface{}) bool {
 func ptFloat(f float64) *float64 {
 	return &f
 }
+
+func ptInt(i int) *int {
+	return &i
+}
@@ -21,4 +21,18 @@ type Answer struct {
 	Certainty     *float64 `json:"certainty,omitempty"`
 	Distance      *float64 `json:"distance,omitempty"`
 	HasAnswer     bool     `json:"hasAnswer,omitempty"`
+	// Answers holds all candidates which meet the similarity threshold,
+	// ordered by descending certainty. The first one equals Result.
+	Answers []*AnswerCandidate `json:"answers,omitempty"`
+}
+
+// AnswerCandidate is a single answer span found in one of
+// the object's text properties
+type AnswerCandidate struct {
+	Result        *string  `json:"result,omitempty"`
+	Property      *string  `json:"property,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+	EndPosition   int      `json:"endPosition,omitempty"`
+	Certainty     *float64 `json:"certainty,omitempty"`
+	Distance      *float64 `json:"distance,omitempty"`
 }
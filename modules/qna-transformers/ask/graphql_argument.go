@@ -65,6 +65,14 @@ func (g *GraphQLArgumentsProvider) askFields(prefix string) graphql.InputObjectC
 			Description: "Arranges the results by certainty",
 			Type:        graphql.Boolean,
 		},
+		"model": &graphql.InputObjectFieldConfig{
+			Description: "Model used by the inference container to answer this question",
+			Type:        graphql.String,
+		},
+		"maxAnswers": &graphql.InputObjectFieldConfig{
+			Description: "Maximum number of candidate answers per object",
+			Type:        graphql.Int,
+		},
 	}
 	if g.askTransformer != nil {
 		askFields["autocorrect"] = &graphql.InputObjectFieldConfig{
@@ -39,7 +39,7 @@ func TestAskGraphQLArgument(t *testing.T) {
 		askFields, ok := ask.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, askFields)
-		assert.Equal(t, 5, len(askFields.Fields()))
+		assert.Equal(t, 7, len(askFields.Fields()))
 		fields := askFields.Fields()
 		question := fields["question"]
 		questionNonNull, questionNonNullOK := question.Type.(*graphql.NonNull)
@@ -78,7 +78,7 @@ func TestAskGraphQLArgumentWithAutocorrect(t *testing.T) {
 		askFields, ok := ask.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, askFields)
-		assert.Equal(t, 6, len(askFields.Fields()))
+		assert.Equal(t, 8, len(askFields.Fields()))
 		fields := askFields.Fields()
 		question := fields["question"]
 		questionNonNull, questionNonNullOK := question.Type.(*graphql.NonNull)
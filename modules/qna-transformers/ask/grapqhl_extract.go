@@ -58,5 +58,15 @@ func (g *GraphQLArgumentsProvider) extractAskFn(source map[string]interface{}) i
 		args.Rerank = rerank.(bool)
 	}
 
+	model, ok := source["model"].(string)
+	if ok {
+		args.Model = model
+	}
+
+	maxAnswers, ok := source["maxAnswers"].(int)
+	if ok {
+		args.MaxAnswers = maxAnswers
+	}
+
 	return &args
 }
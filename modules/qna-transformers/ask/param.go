@@ -23,6 +23,10 @@ type AskParams struct {
 	Properties   []string
 	Autocorrect  bool
 	Rerank       bool
+	// Model overrides the model of the inference container for this query
+	Model string
+	// MaxAnswers is the number of candidate answers returned per object
+	MaxAnswers int
 }
 
 func (n AskParams) GetCertainty() float64 {
@@ -52,5 +56,9 @@ func (g *GraphQLArgumentsProvider) validateAskFn(param interface{}) error {
 			"nearText cannot provide both distance and certainty")
 	}
 
+	if ask.MaxAnswers < 0 {
+		return errors.Errorf("'ask.maxAnswers' cannot be negative")
+	}
+
 	return nil
 }
@@ -45,6 +45,20 @@ func (p *ParamsHelper) GetDistance(params interface{}) float64 {
 	return 0
 }
 
+func (p *ParamsHelper) GetModel(params interface{}) string {
+	if parameters, ok := params.(*AskParams); ok {
+		return parameters.Model
+	}
+	return ""
+}
+
+func (p *ParamsHelper) GetMaxAnswers(params interface{}) int {
+	if parameters, ok := params.(*AskParams); ok {
+		return parameters.MaxAnswers
+	}
+	return 0
+}
+
 func (p *ParamsHelper) GetRerank(params interface{}) bool {
 	if parameters, ok := params.(*AskParams); ok {
 		return parameters.Rerank
@@ -56,6 +56,26 @@ func Test_validateAskFn(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "should validate with model and max answers",
+			args: args{
+				param: &AskParams{
+					Question:   "question",
+					Model:      "deepset/roberta-base-squad2",
+					MaxAnswers: 3,
+				},
+			},
+		},
+		{
+			name: "should not validate when max answers is negative",
+			args: args{
+				param: &AskParams{
+					Question:   "question",
+					MaxAnswers: -1,
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "should not validate when param passed is struct, not a pointer to struct",
 			args: args{
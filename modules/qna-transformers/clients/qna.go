@@ -18,12 +18,15 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/modules/qna-transformers/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type qna struct {
@@ -35,17 +38,19 @@ type qna struct {
 func New(origin string, timeout time.Duration, logger logrus.FieldLogger) *qna {
 	return &qna{
 		origin:     origin,
-		httpClient: &http.Client{Timeout: timeout},
+		httpClient: httpclient.New(timeout),
 		logger:     logger,
 	}
 }
 
 func (q *qna) Answer(ctx context.Context,
-	text, question string,
+	text, question string, opts ent.AnswerOptions,
 ) (*ent.AnswerResult, error) {
 	body, err := json.Marshal(answersInput{
 		Text:     text,
 		Question: question,
+		Model:    opts.Model,
+		Limit:    opts.Limit,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "marshal body")
@@ -56,6 +61,7 @@ func (q *qna) Answer(ctx context.Context,
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 
 	res, err := q.httpClient.Do(req)
 	if err != nil {
@@ -78,13 +84,62 @@ func (q *qna) Answer(ctx context.Context,
 			resBody.Error)
 	}
 
-	return &ent.AnswerResult{
+	result := &ent.AnswerResult{
 		Text:      resBody.Text,
 		Question:  resBody.Question,
 		Answer:    resBody.Answer,
 		Certainty: resBody.Certainty,
 		Distance:  additional.CertaintyToDistPtr(resBody.Certainty),
-	}, nil
+		Answers:   q.answerSpans(resBody),
+	}
+	if len(result.Answers) > 0 && result.Answer == nil {
+		// inference containers which only return the candidates list
+		result.Answer = &result.Answers[0].Answer
+		result.Certainty = result.Answers[0].Certainty
+		result.Distance = result.Answers[0].Distance
+	}
+	return result, nil
+}
+
+// answerSpans returns the candidates sorted by descending certainty. Older
+// inference containers only return a single answer, which is then turned
+// into a candidate without offsets.
+func (q *qna) answerSpans(resBody answersResponse) []ent.AnswerSpan {
+	if len(resBody.Answers) == 0 {
+		if resBody.Answer == nil {
+			return nil
+		}
+		return []ent.AnswerSpan{{
+			Answer:    *resBody.Answer,
+			Certainty: resBody.Certainty,
+			Distance:  additional.CertaintyToDistPtr(resBody.Certainty),
+		}}
+	}
+
+	spans := make([]ent.AnswerSpan, 0, len(resBody.Answers))
+	for _, answer := range resBody.Answers {
+		if answer.Answer == "" {
+			continue
+		}
+		spans = append(spans, ent.AnswerSpan{
+			Answer:    answer.Answer,
+			Certainty: answer.Certainty,
+			Distance:  additional.CertaintyToDistPtr(answer.Certainty),
+			Start:     answer.Start,
+			End:       answer.End,
+		})
+	}
+	sort.SliceStable(spans, func(i, j int) bool {
+		return certainty(spans[i]) > certainty(spans[j])
+	})
+	return spans
+}
+
+func certainty(span ent.AnswerSpan) float64 {
+	if span.Certainty == nil {
+		return 0
+	}
+	return *span.Certainty
 }
 
 func (q *qna) url(path string) string {
@@ -94,12 +149,22 @@ func (q *qna) url(path string) string {
 type answersInput struct {
 	Text     string `json:"text"`
 	Question string `json:"question"`
+	Model    string `json:"model,omitempty"`
+	Limit    int    `json:"limit,omitempty"`
+}
+
+type answerSpan struct {
+	Answer    string   `json:"answer"`
+	Certainty *float64 `json:"certainty"`
+	Start     *int     `json:"start"`
+	End       *int     `json:"end"`
 }
 
 type answersResponse struct {
 	answersInput `json:"answersInput"`
-	Answer       *string  `json:"answer"`
-	Certainty    *float64 `json:"certainty"`
-	Distance     *float64 `json:"distance"`
-	Error        string   `json:"error"`
+	Answer       *string      `json:"answer"`
+	Certainty    *float64     `json:"certainty"`
+	Distance     *float64     `json:"distance"`
+	Answers      []answerSpan `json:"answers"`
+	Error        string       `json:"error"`
 }
@@ -41,7 +41,7 @@ func TestGetAnswer(t *testing.T) {
 		defer server.Close()
 		c := New(server.URL, 0, nullLogger())
 		res, err := c.Answer(context.Background(), "My name is John",
-			"What is my name?")
+			"What is my name?", ent.AnswerOptions{})
 		assert.Nil(t, err)
 
 		expectedResult := ent.AnswerResult{
@@ -74,7 +74,7 @@ func TestGetAnswer(t *testing.T) {
 		defer server.Close()
 		c := New(server.URL, 0, nullLogger())
 		res, err := c.Answer(context.Background(), "My name is John",
-			"What is my name?")
+			"What is my name?", ent.AnswerOptions{})
 
 		assert.Nil(t, err)
 		assert.Equal(t, &ent.AnswerResult{
@@ -83,9 +83,50 @@ func TestGetAnswer(t *testing.T) {
 			Answer:    ptString("John"),
 			Certainty: ptFloat(0.7),
 			Distance:  additional.CertaintyToDistPtr(ptFloat(0.7)),
+			Answers: []ent.AnswerSpan{{
+				Answer:    "John",
+				Certainty: ptFloat(0.7),
+				Distance:  additional.CertaintyToDistPtr(ptFloat(0.7)),
+			}},
 		}, res)
 	})
 
+	t.Run("when the server returns multiple answers", func(t *testing.T) {
+		server := httptest.NewServer(&testAnswerHandler{
+			t: t,
+			answer: answersResponse{
+				answersInput: answersInput{
+					Text:     "My name is John, or maybe Jack",
+					Question: "What is my name?",
+				},
+				Answers: []answerSpan{
+					{Answer: "Jack", Certainty: ptFloat(0.4), Start: ptInt(26), End: ptInt(30)},
+					{Answer: "John", Certainty: ptFloat(0.8), Start: ptInt(11), End: ptInt(15)},
+				},
+			},
+			expectedInput: &answersInput{
+				Text:     "My name is John, or maybe Jack",
+				Question: "What is my name?",
+				Model:    "some-model",
+				Limit:    2,
+			},
+		})
+		defer server.Close()
+		c := New(server.URL, 0, nullLogger())
+		res, err := c.Answer(context.Background(), "My name is John, or maybe Jack",
+			"What is my name?", ent.AnswerOptions{Model: "some-model", Limit: 2})
+
+		require.Nil(t, err)
+		assert.Equal(t, "John", *res.Answer)
+		assert.Equal(t, 0.8, *res.Certainty)
+		require.Len(t, res.Answers, 2)
+		assert.Equal(t, "John", res.Answers[0].Answer)
+		assert.Equal(t, 11, *res.Answers[0].Start)
+		assert.Equal(t, 15, *res.Answers[0].End)
+		assert.Equal(t, "Jack", res.Answers[1].Answer)
+		assert.InDelta(t, 1.2, *res.Answers[1].Distance, 1e-9)
+	})
+
 	t.Run("when the server has a an error", func(t *testing.T) {
 		server := httptest.NewServer(&testAnswerHandler{
 			t: t,
@@ -96,7 +137,7 @@ func TestGetAnswer(t *testing.T) {
 		defer server.Close()
 		c := New(server.URL, 0, nullLogger())
 		_, err := c.Answer(context.Background(), "My name is John",
-			"What is my name?")
+			"What is my name?", ent.AnswerOptions{})
 
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "some error from the server")
@@ -107,11 +148,18 @@ type testAnswerHandler struct {
 	t *testing.T
 	// the test handler will report as not ready before the time has passed
 	answer answersResponse
+	// if set, the request body is checked against it
+	expectedInput *answersInput
 }
 
 func (f *testAnswerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	assert.Equal(f.t, "/answers/", r.URL.String())
 	assert.Equal(f.t, http.MethodPost, r.Method)
+	if f.expectedInput != nil {
+		var input answersInput
+		require.Nil(f.t, json.NewDecoder(r.Body).Decode(&input))
+		assert.Equal(f.t, *f.expectedInput, input)
+	}
 
 	if f.answer.Error != "" {
 		w.WriteHeader(500)
@@ -127,3 +175,7 @@ func ptFloat(in float64) *float64 {
 func ptString(in string) *string {
 	return &in
 }
+
+func ptInt(in int) *int {
+	return &in
+}
@@ -17,4 +17,27 @@ type AnswerResult struct {
 	Answer    *string
 	Certainty *float64
 	Distance  *float64
+	// Answers holds all candidate spans returned by the inference
+	// container, ordered by descending certainty. Answer and Certainty
+	// always reflect the first candidate.
+	Answers []AnswerSpan
+}
+
+// AnswerSpan is a single candidate answer. Start and End are character
+// offsets into the text that was sent, they are nil if the inference
+// container did not report them.
+type AnswerSpan struct {
+	Answer    string
+	Certainty *float64
+	Distance  *float64
+	Start     *int
+	End       *int
+}
+
+// AnswerOptions are per-query settings passed to the inference container
+type AnswerOptions struct {
+	// Model overrides the model used by the inference container
+	Model string
+	// Limit is the maximum number of candidate answers to return
+	Limit int
 }
@@ -44,7 +44,7 @@ type QnAModule struct {
 
 type qnaClient interface {
 	Answer(ctx context.Context,
-		text, question string) (*ent.AnswerResult, error)
+		text, question string, opts ent.AnswerOptions) (*ent.AnswerResult, error)
 	MetaInfo() (map[string]interface{}, error)
 }
 
@@ -11,16 +11,30 @@
 
 package config
 
-import "github.com/weaviate/weaviate/entities/moduletools"
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
 
 const (
 	MethodMean    = "mean"
 	MethodDefault = MethodMean
 )
 
+// timestamps of the referenced objects a decay can be based on
+const (
+	DecayLastUpdateTime = "lastUpdateTime"
+	DecayCreationTime   = "creationTime"
+	DecayDefault        = DecayLastUpdateTime
+)
+
 const (
 	calculationMethodField   = "method"
 	referencePropertiesField = "referenceProperties"
+	decayHalfLifeField       = "decayHalfLife"
+	decayTimestampField      = "decayTimestamp"
+	recomputeIntervalField   = "recomputeInterval"
 )
 
 func Default() map[string]interface{} {
@@ -54,3 +68,36 @@ func (c *Config) CalculationMethod() string {
 	calcMethod := props[calculationMethodField].(string)
 	return calcMethod
 }
+
+// DecayHalfLife is the age at which a referenced object weighs half as
+// much as a new one, 0 means all references weigh the same
+func (c *Config) DecayHalfLife() time.Duration {
+	return c.duration(decayHalfLifeField)
+}
+
+// DecayTimestamp is the timestamp of a referenced object its age is
+// calculated from
+func (c *Config) DecayTimestamp() string {
+	if ts, ok := c.class.Class()[decayTimestampField].(string); ok && ts != "" {
+		return ts
+	}
+	return DecayDefault
+}
+
+// RecomputeInterval is the interval in which the vectors of all objects of
+// the class are recomputed in the background, 0 disables it
+func (c *Config) RecomputeInterval() time.Duration {
+	return c.duration(recomputeIntervalField)
+}
+
+func (c *Config) duration(field string) time.Duration {
+	value, ok := c.class.Class()[field].(string)
+	if !ok {
+		return 0
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0
+	}
+	return d
+}
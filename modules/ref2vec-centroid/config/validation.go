@@ -14,6 +14,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 var errInvalidConfig = errors.New("invalid config")
@@ -46,5 +47,40 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	for _, field := range []string{decayHalfLifeField, recomputeIntervalField} {
+		if err := validateDuration(class, field); err != nil {
+			return err
+		}
+	}
+
+	if ts, ok := class[decayTimestampField]; ok {
+		switch ts {
+		case DecayLastUpdateTime, DecayCreationTime:
+		default:
+			return fmt.Errorf("%w: expected %q to be %q or %q, got %v",
+				errInvalidConfig, decayTimestampField, DecayLastUpdateTime, DecayCreationTime, ts)
+		}
+	}
+
+	return nil
+}
+
+// validateDuration checks that field, if set, is a positive duration
+// such as "720h"
+func validateDuration(class map[string]interface{}, field string) error {
+	value, ok := class[field]
+	if !ok {
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("%w: expected duration string for field %q, got %T",
+			errInvalidConfig, field, value)
+	}
+	d, err := time.ParseDuration(str)
+	if err != nil || d <= 0 {
+		return fmt.Errorf("%w: expected positive duration such as \"24h\" for field %q, got %q",
+			errInvalidConfig, field, str)
+	}
 	return nil
 }
@@ -83,6 +83,49 @@ func TestConfigValidator(t *testing.T) {
 				"to contain strings, found int: [someRef 123]",
 				class.Class),
 		},
+		{
+			name:  "valid config - decay and recomputation",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"decayHalfLife":       "720h",
+				"decayTimestamp":      "creationTime",
+				"recomputeInterval":   "1h",
+			},
+		},
+		{
+			name:  "invalid config - unparsable decayHalfLife",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"decayHalfLife":       "a month",
+			},
+			expectedErr: fmt.Errorf("validate %q: invalid config: expected positive duration "+
+				"such as \"24h\" for field \"decayHalfLife\", got \"a month\"",
+				class.Class),
+		},
+		{
+			name:  "invalid config - negative recomputeInterval",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"recomputeInterval":   "-1h",
+			},
+			expectedErr: fmt.Errorf("validate %q: invalid config: expected positive duration "+
+				"such as \"24h\" for field \"recomputeInterval\", got \"-1h\"",
+				class.Class),
+		},
+		{
+			name:  "invalid config - unknown decayTimestamp",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"decayTimestamp":      "deletionTime",
+			},
+			expectedErr: fmt.Errorf("validate %q: invalid config: expected \"decayTimestamp\" "+
+				"to be \"lastUpdateTime\" or \"creationTime\", got deletionTime",
+				class.Class),
+		},
 	}
 
 	for _, test := range tests {
@@ -14,11 +14,13 @@ package modcentroid
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/ref2vec-centroid/config"
 	"github.com/weaviate/weaviate/modules/ref2vec-centroid/vectorizer"
 )
 
@@ -64,9 +66,14 @@ func (m *CentroidModule) VectorizeObject(ctx context.Context,
 	return vzr.Object(ctx, obj)
 }
 
+func (m *CentroidModule) RecomputeInterval(cfg moduletools.ClassConfig) time.Duration {
+	return config.New(cfg).RecomputeInterval()
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.ReferenceVectorizer(New())
+	_ = modulecapabilities.ReferenceVectorRecomputer(New())
 	_ = modulecapabilities.MetaProvider(New())
 )
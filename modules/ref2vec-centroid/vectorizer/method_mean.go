@@ -39,3 +39,42 @@ func calculateMean(refVecs ...[]float32) ([]float32, error) {
 
 	return meanVec, nil
 }
+
+// calculateWeightedMean weighs every vector with the weight at the same
+// index, vectors with a weight of 0 are ignored
+func calculateWeightedMean(refVecs [][]float32, weights []float32) ([]float32, error) {
+	if len(refVecs) == 0 || len(refVecs[0]) == 0 {
+		return nil, nil
+	}
+	if len(refVecs) != len(weights) {
+		return nil, fmt.Errorf("calculate weighted mean: got %d vectors but %d weights",
+			len(refVecs), len(weights))
+	}
+
+	targetVecLen := len(refVecs[0])
+	meanVec := make([]float32, targetVecLen)
+
+	var total float32
+	for i, vec := range refVecs {
+		if len(vec) != targetVecLen {
+			return nil, fmt.Errorf("calculate weighted mean: found vectors of different length: %d and %d",
+				targetVecLen, len(vec))
+		}
+
+		for j, val := range vec {
+			meanVec[j] += val * weights[i]
+		}
+		total += weights[i]
+	}
+
+	if total == 0 {
+		// all references are too old to count, treat them equally
+		return calculateMean(refVecs...)
+	}
+
+	for i := range meanVec {
+		meanVec[i] /= total
+	}
+
+	return meanVec, nil
+}
@@ -14,6 +14,8 @@ package vectorizer
 import (
 	"context"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -31,12 +33,14 @@ type Vectorizer struct {
 	config       *config.Config
 	calcFn       calcFn
 	findObjectFn modulecapabilities.FindObjectFn
+	now          func() time.Time
 }
 
 func New(cfg moduletools.ClassConfig, findFn modulecapabilities.FindObjectFn) *Vectorizer {
 	v := &Vectorizer{
 		config:       config.New(cfg),
 		findObjectFn: findFn,
+		now:          time.Now,
 	}
 
 	switch v.config.CalculationMethod() {
@@ -52,17 +56,27 @@ func New(cfg moduletools.ClassConfig, findFn modulecapabilities.FindObjectFn) *V
 func (v *Vectorizer) Object(ctx context.Context, obj *models.Object) error {
 	props := v.config.ReferenceProperties()
 
-	refVecs, err := v.referenceVectorSearch(ctx, obj, props)
+	refs, err := v.referenceVectorSearch(ctx, obj, props)
 	if err != nil {
 		return err
 	}
 
-	if len(refVecs) == 0 {
+	if len(refs) == 0 {
 		obj.Vector = nil
 		return nil
 	}
 
-	vec, err := v.calcFn(refVecs...)
+	refVecs := make([][]float32, len(refs))
+	for i := range refs {
+		refVecs[i] = refs[i].Vector
+	}
+
+	var vec []float32
+	if halfLife := v.config.DecayHalfLife(); halfLife > 0 {
+		vec, err = calculateWeightedMean(refVecs, v.decayWeights(refs, halfLife))
+	} else {
+		vec, err = v.calcFn(refVecs...)
+	}
 	if err != nil {
 		return fmt.Errorf("calculate vector: %w", err)
 	}
@@ -71,10 +85,31 @@ func (v *Vectorizer) Object(ctx context.Context, obj *models.Object) error {
 	return nil
 }
 
+// decayWeights halves the weight of a referenced object with every half
+// life of its age. Objects from the future weigh as much as new ones.
+func (v *Vectorizer) decayWeights(refs []*search.Result, halfLife time.Duration) []float32 {
+	now := v.now()
+	useCreation := v.config.DecayTimestamp() == config.DecayCreationTime
+
+	weights := make([]float32, len(refs))
+	for i, ref := range refs {
+		ts := ref.Updated
+		if useCreation {
+			ts = ref.Created
+		}
+		age := now.Sub(time.UnixMilli(ts))
+		if age < 0 {
+			age = 0
+		}
+		weights[i] = float32(math.Pow(0.5, float64(age)/float64(halfLife)))
+	}
+	return weights
+}
+
 func (v *Vectorizer) referenceVectorSearch(ctx context.Context,
 	obj *models.Object, refProps map[string]struct{},
-) ([][]float32, error) {
-	var refVecs [][]float32
+) ([]*search.Result, error) {
+	var refVecs []*search.Result
 	props := obj.Properties.(map[string]interface{})
 
 	// use the ids from parent's beacons to find the referenced objects
@@ -89,7 +124,7 @@ func (v *Vectorizer) referenceVectorSearch(ctx context.Context,
 		// these will be used to compute the parent's
 		// vector eventually
 		if res.Vector != nil {
-			refVecs = append(refVecs, res.Vector)
+			refVecs = append(refVecs, res)
 		}
 	}
 
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
@@ -168,6 +169,76 @@ func TestVectorizer_Object(t *testing.T) {
 	})
 }
 
+func TestVectorizer_Decay(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name     string
+		cfg      fakeClassConfig
+		refs     []*search.Result
+		expected []float32
+	}{
+		{
+			name: "newer references weigh more",
+			cfg:  fakeClassConfig{"decayHalfLife": "24h"},
+			refs: []*search.Result{
+				{Vector: []float32{0, 4}, Updated: now.UnixMilli()},
+				{Vector: []float32{6, 4}, Updated: now.Add(-day).UnixMilli()},
+			},
+			// weights 1 and 0.5
+			expected: []float32{2, 4},
+		},
+		{
+			name: "decay based on creation time",
+			cfg:  fakeClassConfig{"decayHalfLife": "24h", "decayTimestamp": "creationTime"},
+			refs: []*search.Result{
+				{Vector: []float32{0, 4}, Created: now.Add(-2 * day).UnixMilli(), Updated: now.UnixMilli()},
+				{Vector: []float32{5, 4}, Created: now.UnixMilli()},
+			},
+			// weights 0.25 and 1
+			expected: []float32{4, 4},
+		},
+		{
+			name: "without decay all references weigh the same",
+			cfg:  fakeClassConfig{},
+			refs: []*search.Result{
+				{Vector: []float32{0, 4}, Updated: now.UnixMilli()},
+				{Vector: []float32{6, 4}, Updated: now.Add(-day).UnixMilli()},
+			},
+			expected: []float32{3, 4},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &fakeObjectsRepo{}
+			test.cfg["method"] = "mean"
+			test.cfg["referenceProperties"] = []interface{}{"toRef"}
+
+			modelRefs := make(models.MultipleRef, len(test.refs))
+			for i, res := range test.refs {
+				crossRef := crossref.New("localhost", "SomeClass",
+					strfmt.UUID(uuid.NewString()))
+				modelRefs[i] = crossRef.SingleRef()
+				repo.On("Object", ctx, crossRef.Class, crossRef.TargetID, "").
+					Return(res, nil)
+			}
+
+			obj := &models.Object{
+				Properties: map[string]interface{}{"toRef": modelRefs},
+			}
+
+			vzr := New(test.cfg, repo.Object)
+			vzr.now = func() time.Time { return now }
+			err := vzr.Object(ctx, obj)
+			assert.Nil(t, err)
+			assert.InDeltaSlice(t, test.expected, obj.Vector, 1e-6)
+		})
+	}
+}
+
 func TestVectorizer_Tenant(t *testing.T) {
 	objectSearchResults := search.Result{Vector: []float32{}}
 	ctx := context.Background()
@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
 	"github.com/pkg/errors"
@@ -30,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/reranker-cohere/config"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -48,7 +50,7 @@ type client struct {
 func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *client {
 	return &client{
 		apiKey:       apiKey,
-		httpClient:   &http.Client{Timeout: timeout},
+		httpClient:   httpclient.New(timeout),
 		host:         "https://api.cohere.ai",
 		path:         "/v1/rerank",
 		maxDocuments: 1000,
@@ -115,6 +117,7 @@ func (c *client) performRank(ctx context.Context, query string, documents []stri
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 
 	apiKey, err := c.getApiKey(ctx)
 	if err != nil {
@@ -191,9 +194,6 @@ func (c *client) toRankResult(query string, results [][]ent.DocumentScore) *ent.
 }
 
 func (c *client) getApiKey(ctx context.Context) (string, error) {
-	if len(c.apiKey) > 0 {
-		return c.apiKey, nil
-	}
 	key := "X-Cohere-Api-Key"
 
 	apiKey := ctx.Value(key)
@@ -205,6 +205,11 @@ func (c *client) getApiKey(ctx context.Context) (string, error) {
 		len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
 		return apiKeyHeader[0], nil
 	}
+	// like the other cohere modules, keys passed with the request take
+	// precedence, so that keys can be rotated at runtime
+	if len(c.apiKey) > 0 {
+		return c.apiKey, nil
+	}
 	return "", errors.New("no api key found " +
 		"neither in request header: X-Cohere-Api-Key " +
 		"nor in environment variable under COHERE_APIKEY")
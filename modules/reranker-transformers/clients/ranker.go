@@ -25,7 +25,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -42,7 +44,7 @@ type client struct {
 func New(origin string, timeout time.Duration, logger logrus.FieldLogger) *client {
 	return &client{
 		origin:       origin,
-		httpClient:   &http.Client{Timeout: timeout},
+		httpClient:   httpclient.New(timeout),
 		maxDocuments: 32,
 		logger:       logger,
 	}
@@ -114,6 +116,7 @@ func (c *client) performRank(ctx context.Context,
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 
 	res, err := c.httpClient.Do(req)
 	if err != nil {
@@ -23,15 +23,19 @@ import (
 )
 
 type sumClient interface {
-	GetSummary(ctx context.Context, property, text string) ([]ent.SummaryResult, error)
+	GetSummary(ctx context.Context, property, text string,
+		opts ent.SummaryOptions) ([]ent.SummaryResult, error)
 }
 
 type SummaryProvider struct {
-	sum sumClient
+	sum   sumClient
+	cache *summaryCache
 }
 
-func New(sum sumClient) *SummaryProvider {
-	return &SummaryProvider{sum}
+// New creates a summary provider which keeps up to cacheSize summaries in
+// memory, a cacheSize of 0 disables caching
+func New(sum sumClient, cacheSize int) *SummaryProvider {
+	return &SummaryProvider{sum: sum, cache: newSummaryCache(cacheSize)}
 }
 
 func (p *SummaryProvider) AdditionalPropertyDefaultValue() interface{} {
@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package summary
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/modules/sum-transformers/ent"
+)
+
+// summaryCache is a size-bounded LRU cache of summaries. Keys contain the
+// object's last update time, so an update makes previous entries
+// unreachable and they age out eventually.
+type summaryCache struct {
+	sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List
+}
+
+type cacheEntry struct {
+	key     string
+	summary []ent.SummaryResult
+}
+
+func newSummaryCache(maxEntries int) *summaryCache {
+	if maxEntries <= 0 {
+		return nil
+	}
+	return &summaryCache{
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		order:      list.New(),
+	}
+}
+
+func cacheKey(result search.Result, property string, opts ent.SummaryOptions) string {
+	if result.Updated == 0 {
+		// without a version an entry could outlive an update
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s/%d/%s/%d/%d/%s", result.ClassName, result.Tenant,
+		result.ID, result.Updated, property, opts.MaxSentences, opts.MaxTokens, opts.Style)
+}
+
+func (c *summaryCache) get(key string) ([]ent.SummaryResult, bool) {
+	if c == nil || key == "" {
+		return nil, false
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+
+	// callers may modify the results, so hand out a copy
+	summary := elem.Value.(*cacheEntry).summary
+	return append([]ent.SummaryResult(nil), summary...), true
+}
+
+func (c *summaryCache) put(key string, summary []ent.SummaryResult) {
+	if c == nil || key == "" {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	stored := append([]ent.SummaryResult(nil), summary...)
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*cacheEntry).summary = stored
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, summary: stored})
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
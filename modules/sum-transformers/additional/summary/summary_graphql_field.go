@@ -25,6 +25,21 @@ func (p *SummaryProvider) additionalSummaryField(classname string) *graphql.Fiel
 				Type:         graphql.NewList(graphql.String),
 				DefaultValue: nil,
 			},
+			"maxSentences": &graphql.ArgumentConfig{
+				Description:  "Maximum number of sentences per summary",
+				Type:         graphql.Int,
+				DefaultValue: nil,
+			},
+			"maxTokens": &graphql.ArgumentConfig{
+				Description:  "Maximum number of tokens per summary",
+				Type:         graphql.Int,
+				DefaultValue: nil,
+			},
+			"style": &graphql.ArgumentConfig{
+				Description:  "Summary style, either prose or bullets",
+				Type:         graphql.String,
+				DefaultValue: nil,
+			},
 		},
 		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
 			Name: fmt.Sprintf("%sAdditionalSummary", classname),
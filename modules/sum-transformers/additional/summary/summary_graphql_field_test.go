@@ -38,6 +38,9 @@ func Test_additionalSummaryField(t *testing.T) {
 	assert.NotNil(t, summaryObject.Fields()["result"])
 
 	assert.NotNil(t, summary.Args)
-	assert.Equal(t, 1, len(summary.Args))
+	assert.Equal(t, 4, len(summary.Args))
 	assert.NotNil(t, summary.Args["properties"])
+	assert.NotNil(t, summary.Args["maxSentences"])
+	assert.NotNil(t, summary.Args["maxTokens"])
+	assert.NotNil(t, summary.Args["style"])
 }
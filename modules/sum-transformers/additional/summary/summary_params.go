@@ -11,10 +11,52 @@
 
 package summary
 
+import (
+	"errors"
+	"fmt"
+
+	"github.com/weaviate/weaviate/modules/sum-transformers/ent"
+)
+
+const (
+	StyleProse   = "prose"
+	StyleBullets = "bullets"
+)
+
 type Params struct {
-	Properties []string
+	Properties   []string
+	MaxSentences int
+	MaxTokens    int
+	Style        string
 }
 
 func (n Params) GetProperties() []string {
 	return n.Properties
 }
+
+func (n Params) options() ent.SummaryOptions {
+	return ent.SummaryOptions{
+		MaxSentences: n.MaxSentences,
+		MaxTokens:    n.MaxTokens,
+		Style:        n.Style,
+	}
+}
+
+func (n Params) validate() error {
+	if len(n.Properties) == 0 {
+		return errors.New("no properties provided")
+	}
+	if n.MaxSentences < 0 {
+		return errors.New("maxSentences cannot be negative")
+	}
+	if n.MaxTokens < 0 {
+		return errors.New("maxTokens cannot be negative")
+	}
+	switch n.Style {
+	case "", StyleProse, StyleBullets:
+		return nil
+	default:
+		return fmt.Errorf("unsupported style %q, use %q or %q",
+			n.Style, StyleProse, StyleBullets)
+	}
+}
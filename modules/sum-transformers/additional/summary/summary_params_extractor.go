@@ -13,6 +13,7 @@ package summary
 
 import (
 	"log"
+	"strconv"
 
 	"github.com/tailor-inc/graphql/language/ast"
 )
@@ -30,6 +31,15 @@ func (p *SummaryProvider) parseSummaryArguments(args []*ast.Argument) *Params {
 				out.Properties[i] = value.(*ast.StringValue).Value
 			}
 
+		case "maxSentences":
+			asInt, _ := strconv.Atoi(arg.Value.GetValue().(string))
+			out.MaxSentences = asInt
+		case "maxTokens":
+			asInt, _ := strconv.Atoi(arg.Value.GetValue().(string))
+			out.MaxTokens = asInt
+		case "style":
+			out.Style = arg.Value.(*ast.StringValue).Value
+
 		default:
 			// ignore what we don't recognize
 			log.Printf("Igonore not recognized value: %v", arg.Name.Value)
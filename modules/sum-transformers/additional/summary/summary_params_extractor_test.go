@@ -34,7 +34,7 @@ func Test_parseSummaryArguments(t *testing.T) {
 			want: &Params{},
 		},
 		{
-			name: "Should create with all params",
+			name: "Should create with properties",
 			args: args{
 				args: []*ast.Argument{
 					createListArg("properties", []string{"prop1", "prop2"}),
@@ -44,6 +44,23 @@ func Test_parseSummaryArguments(t *testing.T) {
 				Properties: []string{"prop1", "prop2"},
 			},
 		},
+		{
+			name: "Should create with all params",
+			args: args{
+				args: []*ast.Argument{
+					createListArg("properties", []string{"prop1", "prop2"}),
+					createIntArg("maxSentences", "3"),
+					createIntArg("maxTokens", "50"),
+					createStringArg("style", "bullets"),
+				},
+			},
+			want: &Params{
+				Properties:   []string{"prop1", "prop2"},
+				MaxSentences: 3,
+				MaxTokens:    50,
+				Style:        "bullets",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -81,3 +98,25 @@ func createListArg(name string, valuesIn []string) *ast.Argument {
 	a := ast.NewArgument(&arg)
 	return a
 }
+
+func createIntArg(name, value string) *ast.Argument {
+	n := ast.Name{
+		Value: name,
+	}
+	return &ast.Argument{
+		Name:  ast.NewName(&n),
+		Kind:  "Kind",
+		Value: &ast.IntValue{Kind: "Kind", Value: value},
+	}
+}
+
+func createStringArg(name, value string) *ast.Argument {
+	n := ast.Name{
+		Value: name,
+	}
+	return &ast.Argument{
+		Name:  ast.NewName(&n),
+		Kind:  "Kind",
+		Value: &ast.StringValue{Kind: "Kind", Value: value},
+	}
+}
@@ -13,12 +13,13 @@ package summary
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/modules/sum-transformers/ent"
+	"github.com/weaviate/weaviate/usecases/objects/chunking"
 )
 
 func (p *SummaryProvider) findSummary(ctx context.Context,
@@ -31,13 +32,14 @@ func (p *SummaryProvider) findSummary(ctx context.Context,
 			return nil, fmt.Errorf("no params provided")
 		}
 
-		properties := params.GetProperties()
-
 		// check if user parameter values are valid
-		if len(properties) == 0 {
-			return in, errors.New("no properties provided")
+		if err := params.validate(); err != nil {
+			return in, err
 		}
 
+		properties := params.GetProperties()
+		opts := params.options()
+
 		for i := range in { // for each result of the general GraphQL Query
 			ap := in[i].AdditionalProperties
 			if ap == nil {
@@ -45,21 +47,18 @@ func (p *SummaryProvider) findSummary(ctx context.Context,
 			}
 
 			// check if the schema of the GraphQL data object contains the properties and they are text or string values
-			textProperties := map[string]string{}
 			schema := in[i].Object().Properties.(map[string]interface{})
-			for property, value := range schema {
-				if p.containsProperty(property, properties) {
-					if valueString, ok := value.(string); ok && len(valueString) > 0 {
-						textProperties[property] = valueString
-					}
-				}
-			}
-
 			summaryList := []ent.SummaryResult{}
 
-			// for each text property result, call the SUM function and add to additional result
-			for property, value := range textProperties {
-				summary, err := p.sum.GetSummary(ctx, property, value)
+			// for each text property, in the requested order, call the SUM
+			// function and add to additional result
+			for _, property := range properties {
+				value, ok := schema[property].(string)
+				if !ok || len(value) == 0 {
+					continue
+				}
+
+				summary, err := p.summarize(ctx, in[i], property, value, opts)
 				if err != nil {
 					return in, err
 				}
@@ -75,14 +74,58 @@ func (p *SummaryProvider) findSummary(ctx context.Context,
 	return in, nil
 }
 
-func (p *SummaryProvider) containsProperty(property string, properties []string) bool {
-	if len(properties) == 0 {
-		return true
+// summarize serves summaries from the cache as long as the object has
+// not been updated since they were created
+func (p *SummaryProvider) summarize(ctx context.Context, result search.Result,
+	property, text string, opts ent.SummaryOptions,
+) ([]ent.SummaryResult, error) {
+	key := cacheKey(result, property, opts)
+	if summary, ok := p.cache.get(key); ok {
+		return summary, nil
+	}
+
+	summary, err := p.sum.GetSummary(ctx, property, text, opts)
+	if err != nil {
+		return nil, err
+	}
+	for i := range summary {
+		summary[i].Result = shape(summary[i].Result, opts)
 	}
-	for i := range properties {
-		if properties[i] == property {
-			return true
+
+	p.cache.put(key, summary)
+	return summary, nil
+}
+
+// shape enforces the requested length and style on the summary, inference
+// containers which do not know about the options return it unchanged
+func shape(summary string, opts ent.SummaryOptions) string {
+	if opts.MaxSentences == 0 && opts.MaxTokens == 0 && opts.Style != StyleBullets {
+		return summary
+	}
+
+	sentences := chunking.Sentences(summary)
+	if opts.MaxSentences > 0 && len(sentences) > opts.MaxSentences {
+		sentences = sentences[:opts.MaxSentences]
+	}
+
+	if opts.MaxTokens > 0 {
+		remaining := opts.MaxTokens
+		for i, sentence := range sentences {
+			words := strings.Fields(sentence)
+			if len(words) >= remaining {
+				sentences[i] = strings.Join(words[:remaining], " ")
+				sentences = sentences[:i+1]
+				break
+			}
+			remaining -= len(words)
+		}
+	}
+
+	if opts.Style == StyleBullets {
+		for i := range sentences {
+			sentences[i] = "- " + sentences[i]
 		}
+		return strings.Join(sentences, "\n")
 	}
-	return false
+	return strings.Join(sentences, " ")
 }
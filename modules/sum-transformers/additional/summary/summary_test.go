@@ -25,7 +25,7 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 	t.Run("should fail with empty content", func(t *testing.T) {
 		// given
 		sumClient := &fakeSUMClient{}
-		summaryProvider := New(sumClient)
+		summaryProvider := New(sumClient, 0)
 		in := []search.Result{
 			{
 				ID: "some-uuid",
@@ -47,7 +47,7 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 	t.Run("should fail with empty params", func(t *testing.T) {
 		// given
 		sumClient := &fakeSUMClient{}
-		summaryProvider := New(sumClient)
+		summaryProvider := New(sumClient, 0)
 		in := []search.Result{
 			{
 				ID: "some-uuid",
@@ -71,7 +71,7 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 
 	t.Run("should summarize", func(t *testing.T) {
 		sumClient := &fakeSUMClient{}
-		summaryProvider := New(sumClient)
+		summaryProvider := New(sumClient, 0)
 		in := []search.Result{
 			{
 				ID: "some-uuid",
@@ -98,18 +98,126 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 		assert.Equal(t, "this is the summary", answerAdditional[0].Result)
 		assert.Equal(t, "content", answerAdditional[0].Property)
 	})
+
+	t.Run("should summarize multiple properties in the requested order", func(t *testing.T) {
+		sumClient := &fakeSUMClient{}
+		summaryProvider := New(sumClient, 0)
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "this is the content",
+					"title":   "this is the title",
+					"count":   3,
+				},
+			},
+		}
+		fakeParams := &Params{Properties: []string{"title", "content", "count", "missing"}}
+
+		out, err := summaryProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, nil, nil, nil)
+
+		require.Nil(t, err)
+		summary := out[0].AdditionalProperties["summary"].([]ent.SummaryResult)
+		require.Len(t, summary, 2)
+		assert.Equal(t, "title", summary[0].Property)
+		assert.Equal(t, "content", summary[1].Property)
+	})
+
+	t.Run("should fail with unsupported style", func(t *testing.T) {
+		summaryProvider := New(&fakeSUMClient{}, 0)
+		in := []search.Result{{ID: "some-uuid"}}
+		fakeParams := &Params{Properties: []string{"content"}, Style: "haiku"}
+
+		_, err := summaryProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, nil, nil, nil)
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unsupported style")
+	})
+
+	t.Run("should cache summaries until the object is updated", func(t *testing.T) {
+		sumClient := &fakeSUMClient{}
+		summaryProvider := New(sumClient, 10)
+		result := func(updated int64) []search.Result {
+			return []search.Result{{
+				ID:      "some-uuid",
+				Updated: updated,
+				Schema:  map[string]interface{}{"content": "this is the content"},
+			}}
+		}
+		fakeParams := &Params{Properties: []string{"content"}}
+
+		for i := 0; i < 3; i++ {
+			_, err := summaryProvider.AdditionalPropertyFn(context.Background(), result(1), fakeParams, nil, nil, nil)
+			require.Nil(t, err)
+		}
+		assert.Equal(t, 1, sumClient.calls)
+
+		_, err := summaryProvider.AdditionalPropertyFn(context.Background(), result(2), fakeParams, nil, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, 2, sumClient.calls)
+
+		// other options are cached separately
+		fakeParams.MaxSentences = 1
+		_, err = summaryProvider.AdditionalPropertyFn(context.Background(), result(2), fakeParams, nil, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, 3, sumClient.calls)
+	})
 }
 
-type fakeSUMClient struct{}
+func TestShapeSummary(t *testing.T) {
+	summary := "Weaviate is a database. It stores vectors. It is open source."
+
+	tests := []struct {
+		name     string
+		opts     ent.SummaryOptions
+		expected string
+	}{
+		{
+			name:     "unchanged without options",
+			expected: summary,
+		},
+		{
+			name:     "max sentences",
+			opts:     ent.SummaryOptions{MaxSentences: 2},
+			expected: "Weaviate is a database. It stores vectors.",
+		},
+		{
+			name:     "max tokens",
+			opts:     ent.SummaryOptions{MaxTokens: 6},
+			expected: "Weaviate is a database. It stores",
+		},
+		{
+			name:     "bullets",
+			opts:     ent.SummaryOptions{MaxSentences: 2, Style: StyleBullets},
+			expected: "- Weaviate is a database.\n- It stores vectors.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, shape(summary, tt.opts))
+		})
+	}
+}
+
+type fakeSUMClient struct {
+	calls   int
+	summary string
+}
 
 func (c *fakeSUMClient) GetSummary(ctx context.Context, property, text string,
+	opts ent.SummaryOptions,
 ) ([]ent.SummaryResult, error) {
+	c.calls++
 	return c.getSummary(property), nil
 }
 
 func (c *fakeSUMClient) getSummary(property string) []ent.SummaryResult {
+	summary := c.summary
+	if summary == "" {
+		summary = "this is the summary"
+	}
 	return []ent.SummaryResult{{
 		Property: property,
-		Result:   "this is the summary",
+		Result:   summary,
 	}}
 }
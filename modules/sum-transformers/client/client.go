@@ -22,7 +22,9 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/modules/sum-transformers/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type client struct {
@@ -32,7 +34,10 @@ type client struct {
 }
 
 type sumInput struct {
-	Text string `json:"text"`
+	Text         string `json:"text"`
+	MaxSentences int    `json:"maxSentences,omitempty"`
+	MaxTokens    int    `json:"maxTokens,omitempty"`
+	Style        string `json:"style,omitempty"`
 }
 
 type summaryResponse struct {
@@ -48,18 +53,20 @@ type sumResponse struct {
 
 func New(origin string, timeout time.Duration, logger logrus.FieldLogger) *client {
 	return &client{
-		origin: origin,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		logger: logger,
+		origin:     origin,
+		httpClient: httpclient.New(timeout),
+		logger:     logger,
 	}
 }
 
 func (c *client) GetSummary(ctx context.Context, property, text string,
+	opts ent.SummaryOptions,
 ) ([]ent.SummaryResult, error) {
 	body, err := json.Marshal(sumInput{
-		Text: text,
+		Text:         text,
+		MaxSentences: opts.MaxSentences,
+		MaxTokens:    opts.MaxTokens,
+		Style:        opts.Style,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "marshal body")
@@ -70,6 +77,7 @@ func (c *client) GetSummary(ctx context.Context, property, text string,
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 
 	res, err := c.httpClient.Do(req)
 	if err != nil {
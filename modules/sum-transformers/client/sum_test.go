@@ -41,7 +41,7 @@ func TestGetAnswer(t *testing.T) {
 		defer server.Close()
 		c := New(server.URL, 0, nullLogger())
 		res, err := c.GetSummary(context.Background(), "prop",
-			"I work at Apple")
+			"I work at Apple", ent.SummaryOptions{})
 
 		assert.Nil(t, err)
 		assert.Equal(t, []ent.SummaryResult{
@@ -52,6 +52,28 @@ func TestGetAnswer(t *testing.T) {
 		}, res)
 	})
 
+	t.Run("when length and style are requested", func(t *testing.T) {
+		server := httptest.NewServer(&testSUMHandler{
+			t: t,
+			res: sumResponse{
+				Summary: []summaryResponse{{Result: "Apple"}},
+			},
+			expectedInput: &sumInput{
+				Text:         "I work at Apple",
+				MaxSentences: 2,
+				MaxTokens:    20,
+				Style:        "bullets",
+			},
+		})
+		defer server.Close()
+		c := New(server.URL, 0, nullLogger())
+		res, err := c.GetSummary(context.Background(), "prop", "I work at Apple",
+			ent.SummaryOptions{MaxSentences: 2, MaxTokens: 20, Style: "bullets"})
+
+		require.Nil(t, err)
+		assert.Equal(t, []ent.SummaryResult{{Result: "Apple", Property: "prop"}}, res)
+	})
+
 	t.Run("when the server has a an error", func(t *testing.T) {
 		server := httptest.NewServer(&testSUMHandler{
 			t: t,
@@ -62,7 +84,7 @@ func TestGetAnswer(t *testing.T) {
 		defer server.Close()
 		c := New(server.URL, 0, nullLogger())
 		_, err := c.GetSummary(context.Background(), "prop",
-			"I work at Apple")
+			"I work at Apple", ent.SummaryOptions{})
 
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "some error from the server")
@@ -73,11 +95,18 @@ type testSUMHandler struct {
 	t *testing.T
 	// the test handler will report as not ready before the time has passed
 	res sumResponse
+	// if set, the request body is checked against it
+	expectedInput *sumInput
 }
 
 func (f *testSUMHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	assert.Equal(f.t, "/sum/", r.URL.String())
 	assert.Equal(f.t, http.MethodPost, r.Method)
+	if f.expectedInput != nil {
+		var input sumInput
+		require.Nil(f.t, json.NewDecoder(r.Body).Decode(&input))
+		assert.Equal(f.t, *f.expectedInput, input)
+	}
 
 	if f.res.Error != "" {
 		w.WriteHeader(500)
@@ -19,3 +19,11 @@ type SummaryResult struct {
 type SumResult struct {
 	Summary []SummaryResult
 }
+
+// SummaryOptions control the length and the style of a summary. Zero
+// values leave the decision to the inference container.
+type SummaryOptions struct {
+	MaxSentences int
+	MaxTokens    int
+	Style        string
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
@@ -27,6 +28,10 @@ import (
 	"github.com/weaviate/weaviate/modules/sum-transformers/ent"
 )
 
+// defaultCacheSize is the number of summaries kept in memory unless
+// overridden with SUM_CACHE_SIZE
+const defaultCacheSize = 10000
+
 func New() *SUMModule {
 	return &SUMModule{}
 }
@@ -37,7 +42,8 @@ type SUMModule struct {
 }
 
 type sumClient interface {
-	GetSummary(ctx context.Context, property, text string) ([]ent.SummaryResult, error)
+	GetSummary(ctx context.Context, property, text string,
+		opts ent.SummaryOptions) ([]ent.SummaryResult, error)
 	MetaInfo() (map[string]interface{}, error)
 }
 
@@ -73,7 +79,16 @@ func (m *SUMModule) initAdditional(ctx context.Context, timeout time.Duration,
 
 	m.sum = client
 
-	tokenProvider := sumadditionalsummary.New(m.sum)
+	cacheSize := defaultCacheSize
+	if v := os.Getenv("SUM_CACHE_SIZE"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil || asInt < 0 {
+			return errors.Errorf("invalid SUM_CACHE_SIZE %q, must be a non-negative integer", v)
+		}
+		cacheSize = asInt
+	}
+
+	tokenProvider := sumadditionalsummary.New(m.sum, cacheSize)
 	m.additionalPropertiesProvider = sumadditional.New(tokenProvider)
 
 	return nil
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/tracing"
+	"github.com/weaviate/weaviate/modules/text-extraction/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
+)
+
+type extractor struct {
+	origin     string
+	httpClient *http.Client
+	logger     logrus.FieldLogger
+}
+
+type extractInput struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"contentType"`
+	// Content is encoded as base64 in JSON
+	Content []byte `json:"content"`
+}
+
+type extractResponse struct {
+	Error    string                 `json:"error"`
+	Text     string                 `json:"text"`
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+func New(origin string, timeout time.Duration, logger logrus.FieldLogger) *extractor {
+	return &extractor{
+		origin:     origin,
+		httpClient: httpclient.New(timeout),
+		logger:     logger,
+	}
+}
+
+func (e *extractor) Extract(ctx context.Context, filename, contentType string,
+	content []byte,
+) (*ent.ExtractResult, error) {
+	body, err := json.Marshal(extractInput{
+		Filename:    filename,
+		ContentType: contentType,
+		Content:     content,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", e.url("/extract/"),
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	tracing.Inject(req)
+
+	res, err := e.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody extractResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode > 399 {
+		return nil, errors.Errorf("fail with status %d: %s", res.StatusCode, resBody.Error)
+	}
+
+	return &ent.ExtractResult{
+		Text:     resBody.Text,
+		Metadata: resBody.Metadata,
+	}, nil
+}
+
+func (e *extractor) url(path string) string {
+	return fmt.Sprintf("%s%s", e.origin, path)
+}
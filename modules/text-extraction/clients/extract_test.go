@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/text-extraction/ent"
+)
+
+func TestExtract(t *testing.T) {
+	t.Run("when the server has a successful answer", func(t *testing.T) {
+		server := httptest.NewServer(&testExtractHandler{
+			t: t,
+			res: extractResponse{
+				Text:     "Lorem ipsum",
+				Metadata: map[string]interface{}{"title": "Lorem", "pages": float64(2)},
+			},
+		})
+		defer server.Close()
+		c := New(server.URL, 0, nullLogger())
+		res, err := c.Extract(context.Background(), "lorem.pdf",
+			"application/pdf", []byte("%PDF-1.4"))
+
+		require.Nil(t, err)
+		assert.Equal(t, &ent.ExtractResult{
+			Text:     "Lorem ipsum",
+			Metadata: map[string]interface{}{"title": "Lorem", "pages": float64(2)},
+		}, res)
+	})
+
+	t.Run("when the server has an error", func(t *testing.T) {
+		server := httptest.NewServer(&testExtractHandler{
+			t: t,
+			res: extractResponse{
+				Error: "unsupported file type",
+			},
+		})
+		defer server.Close()
+		c := New(server.URL, 0, nullLogger())
+		_, err := c.Extract(context.Background(), "lorem.pdf",
+			"application/pdf", []byte("%PDF-1.4"))
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unsupported file type")
+	})
+}
+
+type testExtractHandler struct {
+	t   *testing.T
+	res extractResponse
+}
+
+func (f *testExtractHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/extract/", r.URL.String())
+	assert.Equal(f.t, http.MethodPost, r.Method)
+
+	var in extractInput
+	require.Nil(f.t, json.NewDecoder(r.Body).Decode(&in))
+	assert.Equal(f.t, extractInput{
+		Filename:    "lorem.pdf",
+		ContentType: "application/pdf",
+		Content:     []byte("%PDF-1.4"),
+	}, in)
+
+	if f.res.Error != "" {
+		w.WriteHeader(500)
+	}
+
+	jsonBytes, _ := json.Marshal(f.res)
+	w.Write(jsonBytes)
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modtextextraction
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// Fields which are extracted from every file, the metadata returned by the
+// inference container, e.g. "title", "author" or "pages", can be mapped too
+const (
+	FieldText        = "text"
+	FieldFilename    = "filename"
+	FieldContentType = "contentType"
+)
+
+// defaultPropertyMapping is used if a class has no "propertyMapping" from
+// extracted fields to properties
+var defaultPropertyMapping = map[string]string{
+	FieldText:     "text",
+	FieldFilename: "filename",
+}
+
+func (m *ExtractionModule) ClassConfigDefaults() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (m *ExtractionModule) PropertyConfigDefaults(dt *schema.DataType,
+) map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (m *ExtractionModule) ValidateClass(ctx context.Context,
+	class *models.Class, cfg moduletools.ClassConfig,
+) error {
+	_, err := propertyMapping(cfg)
+	return err
+}
+
+func propertyMapping(cfg moduletools.ClassConfig) (map[string]string, error) {
+	if cfg == nil {
+		return defaultPropertyMapping, nil
+	}
+	raw, ok := cfg.Class()["propertyMapping"]
+	if !ok || raw == nil {
+		return defaultPropertyMapping, nil
+	}
+	asMap, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("propertyMapping must be an object, got %T", raw)
+	}
+
+	mapping := make(map[string]string, len(asMap))
+	mapped := map[string]string{}
+	for field, value := range asMap {
+		prop, ok := value.(string)
+		if !ok || prop == "" {
+			return nil, errors.Errorf("propertyMapping.%s must be a property name, got %v",
+				field, value)
+		}
+		if other, ok := mapped[prop]; ok {
+			return nil, errors.Errorf("propertyMapping: fields %q and %q are both mapped to property %q",
+				other, field, prop)
+		}
+		mapped[prop] = field
+		mapping[field] = prop
+	}
+	return mapping, nil
+}
+
+var _ = modulecapabilities.ClassConfigurator(New())
@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modtextextraction
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/text-extraction/clients"
+	"github.com/weaviate/weaviate/modules/text-extraction/ent"
+)
+
+const Name = "text-extraction"
+
+func New() *ExtractionModule {
+	return &ExtractionModule{}
+}
+
+// ExtractionModule extracts the text and metadata of uploaded files, such
+// as PDF, HTML or DOCX documents, with an inference container and maps them
+// to the properties of the objects created from the files
+type ExtractionModule struct {
+	extractor extractorClient
+	logger    logrus.FieldLogger
+}
+
+type extractorClient interface {
+	Extract(ctx context.Context, filename, contentType string,
+		content []byte) (*ent.ExtractResult, error)
+	MetaInfo() (map[string]interface{}, error)
+}
+
+func (m *ExtractionModule) Name() string {
+	return Name
+}
+
+func (m *ExtractionModule) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.File2Text
+}
+
+func (m *ExtractionModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	m.logger = params.GetLogger()
+
+	uri := os.Getenv("EXTRACTION_INFERENCE_API")
+	if uri == "" {
+		return errors.Errorf("required variable EXTRACTION_INFERENCE_API is not set")
+	}
+
+	client := clients.New(uri, params.GetConfig().ModuleHttpClientTimeout, m.logger)
+	if err := client.WaitForStartup(ctx, 1*time.Second); err != nil {
+		return errors.Wrap(err, "init remote extraction module")
+	}
+	m.extractor = client
+
+	return nil
+}
+
+func (m *ExtractionModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *ExtractionModule) MetaInfo() (map[string]interface{}, error) {
+	return m.extractor.MetaInfo()
+}
+
+func (m *ExtractionModule) ExtractFile(ctx context.Context,
+	file modulecapabilities.File, cfg moduletools.ClassConfig,
+) (map[string]interface{}, error) {
+	mapping, err := propertyMapping(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := m.extractor.Extract(ctx, file.Name, file.ContentType, file.Content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "extract text of %q", file.Name)
+	}
+
+	fields := map[string]interface{}{}
+	for key, value := range res.Metadata {
+		fields[key] = value
+	}
+	fields[FieldText] = res.Text
+	fields[FieldFilename] = file.Name
+	fields[FieldContentType] = file.ContentType
+
+	props := map[string]interface{}{}
+	for field, prop := range mapping {
+		if value, ok := fields[field]; ok && value != nil {
+			props[prop] = value
+		}
+	}
+	return props, nil
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.FileExtractor(New())
+)
@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modtextextraction
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/modules/text-extraction/ent"
+)
+
+func TestExtractFile(t *testing.T) {
+	m := &ExtractionModule{extractor: &fakeExtractor{}}
+	file := modulecapabilities.File{
+		Name:        "lorem.pdf",
+		ContentType: "application/pdf",
+		Content:     []byte("%PDF-1.4"),
+	}
+
+	t.Run("default mapping", func(t *testing.T) {
+		props, err := m.ExtractFile(context.Background(), file, fakeClassConfig{})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"text":     "Lorem ipsum",
+			"filename": "lorem.pdf",
+		}, props)
+	})
+
+	t.Run("custom mapping", func(t *testing.T) {
+		cfg := fakeClassConfig{"propertyMapping": map[string]interface{}{
+			"text":        "content",
+			"title":       "title",
+			"contentType": "mimeType",
+			"author":      "author",
+		}}
+		props, err := m.ExtractFile(context.Background(), file, cfg)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"content":  "Lorem ipsum",
+			"title":    "Lorem",
+			"mimeType": "application/pdf",
+		}, props)
+	})
+
+	t.Run("invalid mapping", func(t *testing.T) {
+		for _, cfg := range []fakeClassConfig{
+			{"propertyMapping": "text"},
+			{"propertyMapping": map[string]interface{}{"text": 1}},
+			{"propertyMapping": map[string]interface{}{"text": ""}},
+			{"propertyMapping": map[string]interface{}{"text": "a", "title": "a"}},
+		} {
+			assert.NotNil(t, m.ValidateClass(context.Background(), nil, cfg), "%v", cfg)
+		}
+	})
+}
+
+type fakeExtractor struct{}
+
+func (f *fakeExtractor) Extract(ctx context.Context, filename, contentType string,
+	content []byte,
+) (*ent.ExtractResult, error) {
+	return &ent.ExtractResult{
+		Text:     "Lorem ipsum",
+		Metadata: map[string]interface{}{"title": "Lorem", "author": nil},
+	}, nil
+}
+
+func (f *fakeExtractor) MetaInfo() (map[string]interface{}, error) {
+	return nil, nil
+}
+
+type fakeClassConfig map[string]interface{}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
@@ -22,7 +22,9 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/modules/text-spellcheck/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type spellCheckInput struct {
@@ -47,11 +49,9 @@ type spellCheck struct {
 
 func New(origin string, timeout time.Duration, logger logrus.FieldLogger) *spellCheck {
 	return &spellCheck{
-		origin: origin,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		logger: logger,
+		origin:     origin,
+		httpClient: httpclient.New(timeout),
+		logger:     logger,
 	}
 }
 
@@ -68,6 +68,7 @@ func (s *spellCheck) Check(ctx context.Context, text []string) (*ent.SpellCheckR
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 
 	res, err := s.httpClient.Do(req)
 	if err != nil {
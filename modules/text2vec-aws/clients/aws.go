@@ -25,7 +25,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/modules/text2vec-aws/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type operationType string
@@ -60,11 +62,9 @@ type aws struct {
 
 func New(awsAccessKey string, awsSecret string, timeout time.Duration, logger logrus.FieldLogger) *aws {
 	return &aws{
-		awsAccessKey: awsAccessKey,
-		awsSecret:    awsSecret,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
+		awsAccessKey:        awsAccessKey,
+		awsSecret:           awsSecret,
+		httpClient:          httpclient.New(timeout),
 		buildBedrockUrlFn:   buildBedrockUrl,
 		buildSagemakerUrlFn: buildSagemakerUrl,
 		logger:              logger,
@@ -153,6 +153,7 @@ func (v *aws) vectorize(ctx context.Context, input []string, operation operation
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 
 	for k, v := range headers {
 		req.Header.Set(k, v)
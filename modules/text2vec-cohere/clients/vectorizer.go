@@ -20,11 +20,14 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/tokens"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/modules/text2vec-cohere/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type embeddingsRequest struct {
@@ -36,9 +39,18 @@ type embeddingsRequest struct {
 
 type embeddingsResponse struct {
 	Embeddings [][]float32 `json:"embeddings,omitempty"`
+	Meta       *cohereMeta `json:"meta,omitempty"`
 	Message    string      `json:"message,omitempty"`
 }
 
+// cohereMeta carries the tokens billed for a call
+type cohereMeta struct {
+	BilledUnits struct {
+		InputTokens  int64 `json:"input_tokens"`
+		OutputTokens int64 `json:"output_tokens"`
+	} `json:"billed_units"`
+}
+
 type vectorizer struct {
 	apiKey     string
 	httpClient *http.Client
@@ -55,10 +67,8 @@ const (
 
 func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *vectorizer {
 	return &vectorizer{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
+		apiKey:     apiKey,
+		httpClient: httpclient.New(timeout),
 		urlBuilder: newCohereUrlBuilder(),
 		logger:     logger,
 	}
@@ -95,6 +105,7 @@ func (v *vectorizer) vectorize(ctx context.Context, input []string,
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 	apiKey, err := v.getApiKey(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Cohere API Key")
@@ -124,6 +135,10 @@ func (v *vectorizer) vectorize(ctx context.Context, input []string,
 		return nil, errors.Errorf(errorMessage)
 	}
 
+	if resBody.Meta != nil {
+		tokens.Report(ctx, resBody.Meta.BilledUnits.InputTokens, resBody.Meta.BilledUnits.OutputTokens)
+	}
+
 	if len(resBody.Embeddings) == 0 {
 		return nil, errors.Errorf("empty embeddings response")
 	}
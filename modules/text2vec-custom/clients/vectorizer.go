@@ -0,0 +1,214 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package clients talks to any embedding endpoint implementing the following
+// contract:
+//
+// The module sends a POST request with a JSON body to the configured
+// endpoint:
+//
+//	{"texts": ["first text", "second text"], "model": "optional-model-name"}
+//
+// and expects a 200 response with exactly one vector per text, in order:
+//
+//	{"vectors": [[0.1, 0.2, 0.3], [0.4, 0.5, 0.6]]}
+//
+// Any other status code is treated as a failure, the server may describe it
+// with a body of the form {"error": "message"}. If an authorization value is
+// available, either from the "X-Custom-Authorization" request header or the
+// CUSTOM_VECTORIZER_AUTHORIZATION environment variable, it is passed to the
+// endpoint verbatim as the "Authorization" header. The value of the
+// environment variable is a secret of the operator, so it is only sent to the
+// endpoint of the CUSTOM_VECTORIZER_ENDPOINT environment variable and never
+// to an endpoint set in the module config of a class.
+//
+// Only HTTP(S) endpoints are supported, there is no gRPC variant of the
+// contract.
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/tracing"
+	"github.com/weaviate/weaviate/usecases/modulecomponents"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/modules/text2vec-custom/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
+)
+
+const authorizationHeader = "X-Custom-Authorization"
+
+type vectorizeRequest struct {
+	Texts []string `json:"texts"`
+	Model string   `json:"model,omitempty"`
+}
+
+type vectorizeResponse struct {
+	Vectors [][]float32 `json:"vectors,omitempty"`
+	Error   string      `json:"error,omitempty"`
+}
+
+type vectorizer struct {
+	defaultEndpoint string
+	authorization   string
+	httpClient      *http.Client
+	logger          logrus.FieldLogger
+}
+
+// New creates a client for the contract described in the package docs.
+// defaultEndpoint is used for all classes which do not set their own
+// endpoint, authorization is the fallback if a request carries none.
+func New(defaultEndpoint, authorization string, timeout time.Duration,
+	logger logrus.FieldLogger,
+) *vectorizer {
+	return &vectorizer{
+		defaultEndpoint: defaultEndpoint,
+		authorization:   authorization,
+		httpClient:      httpclient.New(timeout),
+		logger:          logger,
+	}
+}
+
+func (v *vectorizer) Vectorize(ctx context.Context, input string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, []string{input}, config)
+}
+
+func (v *vectorizer) VectorizeQuery(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, config)
+}
+
+func (v *vectorizer) vectorize(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	endpoint := v.getEndpoint(config)
+	if endpoint == "" {
+		return nil, errors.New("no endpoint configured: set 'endpoint' in the " +
+			"module config of the class or the CUSTOM_VECTORIZER_ENDPOINT " +
+			"environment variable")
+	}
+
+	body, err := json.Marshal(vectorizeRequest{Texts: input, Model: config.Model})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	tracing.Inject(req)
+	if authorization := v.getAuthorization(ctx, endpoint); authorization != "" {
+		req.Header.Add("Authorization", authorization)
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody vectorizeResponse
+	if res.StatusCode != http.StatusOK {
+		// the error body is optional, so a body which can't be parsed must not
+		// hide the status code
+		_ = json.Unmarshal(bodyBytes, &resBody)
+		return nil, v.getError(endpoint, res.StatusCode, resBody.Error)
+	}
+
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if len(resBody.Vectors) != len(input) {
+		return nil, errors.Errorf("endpoint returned %d vectors for %d texts",
+			len(resBody.Vectors), len(input))
+	}
+	if len(resBody.Vectors) == 0 {
+		return nil, errors.New("endpoint returned no vectors")
+	}
+
+	dims := len(resBody.Vectors[0])
+	for i := range resBody.Vectors {
+		if len(resBody.Vectors[i]) != dims {
+			return nil, errors.Errorf("endpoint returned vectors of different "+
+				"dimensions: %d at position 0, %d at position %d",
+				dims, len(resBody.Vectors[i]), i)
+		}
+	}
+
+	return &ent.VectorizationResult{
+		Text:       input,
+		Dimensions: dims,
+		Vector:     resBody.Vectors,
+	}, nil
+}
+
+func (v *vectorizer) getEndpoint(config ent.VectorizationConfig) string {
+	if config.Endpoint != "" {
+		return config.Endpoint
+	}
+	return v.defaultEndpoint
+}
+
+func (v *vectorizer) getError(endpoint string, statusCode int, message string) error {
+	if message != "" {
+		return fmt.Errorf("connection to: %s failed with status: %d error: %v",
+			endpoint, statusCode, message)
+	}
+	return fmt.Errorf("connection to: %s failed with status: %d", endpoint, statusCode)
+}
+
+// getAuthorization prefers the value passed along with the request over the
+// one configured for the whole module. The latter is only sent to the
+// endpoint configured for the whole module, as the endpoint of a class can be
+// set by any user who can create classes.
+func (v *vectorizer) getAuthorization(ctx context.Context, endpoint string) string {
+	if value := v.getValueFromContext(ctx, authorizationHeader); value != "" {
+		return value
+	}
+	if endpoint != v.defaultEndpoint {
+		return ""
+	}
+	return v.authorization
+}
+
+func (v *vectorizer) getValueFromContext(ctx context.Context, key string) string {
+	if value := ctx.Value(key); value != nil {
+		if keyHeader, ok := value.([]string); ok && len(keyHeader) > 0 && len(keyHeader[0]) > 0 {
+			return keyHeader[0]
+		}
+	}
+	// try getting header from GRPC if not successful
+	if apiKey := modulecomponents.GetValueFromGRPC(ctx, key); len(apiKey) > 0 && len(apiKey[0]) > 0 {
+		return apiKey[0]
+	}
+
+	return ""
+}
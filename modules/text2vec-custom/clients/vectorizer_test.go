@@ -0,0 +1,256 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/text2vec-custom/ent"
+)
+
+func TestClient(t *testing.T) {
+	t.Run("when all is fine", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New(server.URL, "", 0, nullLogger())
+
+		expected := &ent.VectorizationResult{
+			Text:       []string{"This is my text"},
+			Vector:     [][]float32{{0.1, 0.2, 0.3}},
+			Dimensions: 3,
+		}
+		res, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{
+				Model: "my-Model",
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+		assert.Equal(t, "my-Model", handler.lastRequest.Model)
+		assert.Equal(t, "", handler.lastAuthorization)
+	})
+
+	t.Run("when vectorizing a batch of texts", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+
+		c := New(server.URL, "", 0, nullLogger())
+
+		expected := &ent.VectorizationResult{
+			Text:       []string{"first", "second"},
+			Vector:     [][]float32{{0.1, 0.2, 0.3}, {1.1, 1.2, 1.3}},
+			Dimensions: 3,
+		}
+		res, err := c.VectorizeQuery(context.Background(),
+			[]string{"first", "second"}, ent.VectorizationConfig{})
+
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("when the class overrides the default endpoint", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+
+		c := New("http://127.0.0.1:1/unreachable", "", 0, nullLogger())
+
+		_, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{Endpoint: server.URL})
+
+		require.Nil(t, err)
+	})
+
+	t.Run("when no endpoint is configured", func(t *testing.T) {
+		c := New("", "", 0, nullLogger())
+
+		_, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "no endpoint configured")
+	})
+
+	t.Run("when the context is expired", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := New(server.URL, "", 0, nullLogger())
+
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now())
+		defer cancel()
+
+		_, err := c.Vectorize(ctx, "This is my text", ent.VectorizationConfig{})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{
+			t:           t,
+			serverError: errors.Errorf("nope, not gonna happen"),
+		})
+		defer server.Close()
+		c := New(server.URL, "", 0, nullLogger())
+
+		_, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to: "+server.URL+
+			" failed with status: 500 error: nope, not gonna happen")
+	})
+
+	t.Run("when the server returns an error without a body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+			}))
+		defer server.Close()
+		c := New(server.URL, "", 0, nullLogger())
+
+		_, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to: "+server.URL+
+			" failed with status: 502")
+	})
+
+	t.Run("when the server returns the wrong number of vectors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"vectors":[]}`))
+			}))
+		defer server.Close()
+		c := New(server.URL, "", 0, nullLogger())
+
+		_, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "endpoint returned 0 vectors for 1 texts")
+	})
+
+	t.Run("when the authorization is configured for the module", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New(server.URL, "Bearer module-token", 0, nullLogger())
+
+		_, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{})
+
+		require.Nil(t, err)
+		assert.Equal(t, "Bearer module-token", handler.lastAuthorization)
+	})
+
+	t.Run("when the class overrides the endpoint of the module authorization", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("http://127.0.0.1:1/unreachable", "Bearer module-token", 0, nullLogger())
+
+		_, err := c.Vectorize(context.Background(), "This is my text",
+			ent.VectorizationConfig{Endpoint: server.URL})
+
+		require.Nil(t, err)
+		assert.Equal(t, "", handler.lastAuthorization)
+	})
+
+	t.Run("when no texts are vectorized", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := New(server.URL, "", 0, nullLogger())
+
+		_, err := c.VectorizeQuery(context.Background(), []string{},
+			ent.VectorizationConfig{})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "endpoint returned no vectors")
+	})
+
+	t.Run("when the authorization is passed using X-Custom-Authorization header", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New(server.URL, "Bearer module-token", 0, nullLogger())
+
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Custom-Authorization", []string{"Basic dXNlcjpwYXNz"})
+
+		_, err := c.Vectorize(ctxWithValue, "This is my text",
+			ent.VectorizationConfig{})
+
+		require.Nil(t, err)
+		assert.Equal(t, "Basic dXNlcjpwYXNz", handler.lastAuthorization)
+	})
+}
+
+type fakeHandler struct {
+	t                 *testing.T
+	serverError       error
+	lastRequest       vectorizeRequest
+	lastAuthorization string
+}
+
+func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, http.MethodPost, r.Method)
+
+	if f.serverError != nil {
+		outBytes, err := json.Marshal(map[string]interface{}{
+			"error": f.serverError.Error(),
+		})
+		require.Nil(f.t, err)
+
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(outBytes)
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &f.lastRequest))
+	f.lastAuthorization = r.Header.Get("Authorization")
+
+	vectors := make([][]float32, len(f.lastRequest.Texts))
+	for i, text := range f.lastRequest.Texts {
+		assert.Greater(f.t, len(text), 0)
+		vectors[i] = []float32{float32(i) + 0.1, float32(i) + 0.2, float32(i) + 0.3}
+	}
+
+	outBytes, err := json.Marshal(map[string]interface{}{
+		"vectors": vectors,
+	})
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
@@ -22,7 +22,9 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/modules/text2vec-gpt4all/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type client struct {
@@ -33,11 +35,9 @@ type client struct {
 
 func New(origin string, timeout time.Duration, logger logrus.FieldLogger) *client {
 	return &client{
-		origin: origin,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		logger: logger,
+		origin:     origin,
+		httpClient: httpclient.New(timeout),
+		logger:     logger,
 	}
 }
 
@@ -52,6 +52,7 @@ func (c *client) Vectorize(ctx context.Context, text string) (*ent.Vectorization
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 
 	res, err := c.httpClient.Do(req)
 	if err != nil {
@@ -20,11 +20,13 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/modules/text2vec-huggingface/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 const (
@@ -66,10 +68,8 @@ type vectorizer struct {
 
 func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *vectorizer {
 	return &vectorizer{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
+		apiKey:                apiKey,
+		httpClient:            httpclient.New(timeout),
 		bertEmbeddingsDecoder: newBertEmbeddingsDecoder(),
 		logger:                logger,
 	}
@@ -103,6 +103,7 @@ func (v *vectorizer) vectorize(ctx context.Context, url string,
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 	if apiKey := v.getApiKey(ctx); apiKey != "" {
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiKey))
 	}
@@ -21,11 +21,13 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/modules/text2vec-jinaai/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type embeddingsRequest struct {
@@ -68,11 +70,9 @@ type vectorizer struct {
 func New(jinaAIApiKey string, timeout time.Duration, logger logrus.FieldLogger) *vectorizer {
 	return &vectorizer{
 		jinaAIApiKey: jinaAIApiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		buildUrlFn: buildUrl,
-		logger:     logger,
+		httpClient:   httpclient.New(timeout),
+		buildUrlFn:   buildUrl,
+		logger:       logger,
 	}
 }
 
@@ -104,6 +104,7 @@ func (v *vectorizer) vectorize(ctx context.Context, input []string, model string
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 	apiKey, err := v.getApiKey(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "API Key")
@@ -21,11 +21,14 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/tokens"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type embeddingsRequest struct {
@@ -36,9 +39,16 @@ type embeddingsRequest struct {
 type embedding struct {
 	Object string          `json:"object"`
 	Data   []embeddingData `json:"data,omitempty"`
+	Usage  *openAIUsage    `json:"usage,omitempty"`
 	Error  *openAIApiError `json:"error,omitempty"`
 }
 
+// openAIUsage are the tokens billed for a call
+type openAIUsage struct {
+	PromptTokens     int64 `json:"prompt_tokens"`
+	CompletionTokens int64 `json:"completion_tokens"`
+}
+
 type embeddingData struct {
 	Object    string    `json:"object"`
 	Index     int       `json:"index"`
@@ -79,11 +89,9 @@ func New(openAIApiKey, openAIOrganization, azureApiKey string, timeout time.Dura
 		openAIApiKey:       openAIApiKey,
 		openAIOrganization: openAIOrganization,
 		azureApiKey:        azureApiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		buildUrlFn: buildUrl,
-		logger:     logger,
+		httpClient:         httpclient.New(timeout),
+		buildUrlFn:         buildUrl,
+		logger:             logger,
 	}
 }
 
@@ -115,6 +123,7 @@ func (v *vectorizer) vectorize(ctx context.Context, input []string, model string
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 	apiKey, err := v.getApiKey(ctx, config.IsAzure)
 	if err != nil {
 		return nil, errors.Wrap(err, "API Key")
@@ -144,6 +153,9 @@ func (v *vectorizer) vectorize(ctx context.Context, input []string, model string
 	if res.StatusCode != 200 || resBody.Error != nil {
 		return nil, v.getError(res.StatusCode, resBody.Error, config.IsAzure)
 	}
+	if resBody.Usage != nil {
+		tokens.Report(ctx, resBody.Usage.PromptTokens, 0)
+	}
 
 	texts := make([]string, len(resBody.Data))
 	embeddings := make([][]float32, len(resBody.Data))
@@ -200,29 +212,26 @@ func (v *vectorizer) getOpenAIOrganization(ctx context.Context) string {
 }
 
 func (v *vectorizer) getApiKey(ctx context.Context, isAzure bool) (string, error) {
-	var apiKey, envVar string
+	var apiKey, envVar, envValue string
 
 	if isAzure {
 		apiKey = "X-Azure-Api-Key"
 		envVar = "AZURE_APIKEY"
-		if len(v.azureApiKey) > 0 {
-			return v.azureApiKey, nil
-		}
+		envValue = v.azureApiKey
 	} else {
 		apiKey = "X-Openai-Api-Key"
 		envVar = "OPENAI_APIKEY"
-		if len(v.openAIApiKey) > 0 {
-			return v.openAIApiKey, nil
-		}
+		envValue = v.openAIApiKey
 	}
 
-	return v.getApiKeyFromContext(ctx, apiKey, envVar)
-}
-
-func (v *vectorizer) getApiKeyFromContext(ctx context.Context, apiKey, envVar string) (string, error) {
+	// keys passed with the request, or set at runtime, take precedence over
+	// the key set at startup, so that keys can be rotated
 	if apiKeyValue := v.getValueFromContext(ctx, apiKey); apiKeyValue != "" {
 		return apiKeyValue, nil
 	}
+	if len(envValue) > 0 {
+		return envValue, nil
+	}
 	return "", fmt.Errorf("no api key found neither in request header: %s nor in environment variable under %s", apiKey, envVar)
 }
 
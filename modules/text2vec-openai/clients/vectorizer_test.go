@@ -25,6 +25,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/tokens"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
 )
 
@@ -99,6 +100,23 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, expected, res)
 	})
 
+	t.Run("reports the tokens of the call", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+
+		c := New("apiKey", "", "", 0, nullLogger())
+		c.buildUrlFn = func(baseURL, resourceName, deploymentID string, isAzure bool) (string, error) {
+			return server.URL, nil
+		}
+
+		ctx, meter := tokens.WithMeter(context.Background())
+		_, err := c.Vectorize(ctx, "This is my text",
+			ent.VectorizationConfig{Type: "text", Model: "ada"})
+
+		require.Nil(t, err)
+		assert.Equal(t, tokens.Counts{Input: 4}, meter.Counts())
+	})
+
 	t.Run("when the context is expired", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
@@ -160,6 +178,21 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, expected, res)
 	})
 
+	t.Run("when the X-Openai-Api-Key header overrides the key of the environment", func(t *testing.T) {
+		c := New("env-key", "", "env-azure-key", 0, nullLogger())
+
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Openai-Api-Key", []string{"rotated-key"})
+
+		apiKey, err := c.getApiKey(ctxWithValue, false)
+		require.Nil(t, err)
+		assert.Equal(t, "rotated-key", apiKey)
+
+		apiKey, err = c.getApiKey(ctxWithValue, true)
+		require.Nil(t, err)
+		assert.Equal(t, "env-azure-key", apiKey)
+	})
+
 	t.Run("when OpenAI key is empty", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
@@ -269,6 +302,7 @@ func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	embedding := map[string]interface{}{
 		"object": "list",
 		"data":   []interface{}{embeddingData},
+		"usage":  map[string]interface{}{"prompt_tokens": 4, "total_tokens": 4},
 	}
 
 	outBytes, err := json.Marshal(embedding)
@@ -21,11 +21,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/modules/text2vec-palm/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type taskType string
@@ -58,10 +60,8 @@ type palm struct {
 
 func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *palm {
 	return &palm{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
+		apiKey:       apiKey,
+		httpClient:   httpclient.New(timeout),
 		urlBuilderFn: buildURL,
 		logger:       logger,
 	}
@@ -98,6 +98,7 @@ func (v *palm) vectorize(ctx context.Context, input []string, taskType taskType,
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 
 	apiKey, err := v.getApiKey(ctx)
 	if err != nil {
@@ -22,7 +22,9 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/tracing"
 	"github.com/weaviate/weaviate/modules/text2vec-transformers/ent"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type vectorizer struct {
@@ -36,10 +38,8 @@ func New(originPassage, originQuery string, timeout time.Duration, logger logrus
 	return &vectorizer{
 		originPassage: originPassage,
 		originQuery:   originQuery,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		logger: logger,
+		httpClient:    httpclient.New(timeout),
+		logger:        logger,
 	}
 }
 
@@ -73,6 +73,7 @@ func (v *vectorizer) vectorize(ctx context.Context, input string,
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
+	tracing.Inject(req)
 
 	res, err := v.httpClient.Do(req)
 	if err != nil {
@@ -0,0 +1,240 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package embedded runs a single Weaviate node inside the process of the
+// caller. The use cases are called directly instead of through the HTTP or
+// gRPC APIs, which are not served, e.g. for tests and edge deployments:
+//
+//	w, err := embedded.Start(ctx, embedded.Options{DataPath: dir})
+//	if err != nil {
+//		return err
+//	}
+//	defer w.Close(ctx)
+//	err = w.Schema().AddClass(ctx, nil, &models.Class{Class: "Article"})
+//
+// The principal of the calls is nil, which is allowed as long as the
+// environment does not enable authorization.
+package embedded
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+// Options configure an embedded node. Settings which are not options are
+// read from the environment like the settings of the server.
+type Options struct {
+	// DataPath is the directory of the data of the node, it is required
+	DataPath string
+	// Hostname is the name of the node, it defaults to the hostname of the
+	// machine
+	Hostname string
+	// GossipPort is the port of the member list of the node, a free port is
+	// picked if it is not set
+	GossipPort int
+	// DataPort is the port of the data of the member list of the node, a
+	// free port is picked if it is not set
+	DataPort int
+	// Modules are the names of the modules to enable
+	Modules []string
+	// Logger defaults to a logger of warnings and errors to stderr. The node
+	// logs to its own logger with the output, formatter, level and hooks of
+	// this one, the logger itself is not modified.
+	Logger *logrus.Logger
+	// Configure overrides the settings before they are validated
+	Configure func(*config.Config)
+}
+
+// Weaviate is a running embedded node
+type Weaviate struct {
+	state *state.State
+}
+
+// Start starts a node and waits until its shards are loaded. A single node
+// can use a data path at a time.
+func Start(ctx context.Context, opts Options) (*Weaviate, error) {
+	if opts.DataPath == "" {
+		return nil, errors.New("data path is required")
+	}
+	gossipPort, dataPort, err := clusterPorts(opts.GossipPort, opts.DataPort)
+	if err != nil {
+		return nil, err
+	}
+
+	serverConfig := &config.WeaviateConfig{}
+	if err := serverConfig.LoadEmbeddedConfig(func(c *config.Config) {
+		c.Persistence.DataPath = opts.DataPath
+		if opts.Hostname != "" {
+			c.Cluster.Hostname = opts.Hostname
+		}
+		c.Cluster.GossipBindPort = gossipPort
+		c.Cluster.DataBindPort = dataPort
+		c.EnableModules = strings.Join(opts.Modules, ",")
+		// the calls are not authenticated, as the APIs are not served
+		if !c.Authentication.OIDC.Enabled && !c.Authentication.APIKey.Enabled {
+			c.Authentication.AnonymousAccess.Enabled = true
+		}
+		if opts.Configure != nil {
+			opts.Configure(c)
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	appState, err := makeAppState(ctx, serverConfig, nodeLogger(opts.Logger))
+	if err != nil {
+		return nil, err
+	}
+	return &Weaviate{state: appState}, nil
+}
+
+// startupExit is the panic which replaces exiting the process
+type startupExit struct {
+	code int
+}
+
+// lastError records the message of the last error which was logged before
+// the startup exited
+type lastError struct {
+	message string
+}
+
+func (h *lastError) Levels() []logrus.Level {
+	return []logrus.Level{logrus.FatalLevel, logrus.ErrorLevel}
+}
+
+func (h *lastError) Fire(entry *logrus.Entry) error {
+	h.message = entry.Message
+	if err, ok := entry.Data[logrus.ErrorKey]; ok {
+		h.message = fmt.Sprintf("%s: %v", entry.Message, err)
+	}
+	return nil
+}
+
+// nodeLogger returns the logger of the node, which writes like the logger of
+// the caller. The process of the caller must never exit, so fatal errors
+// panic instead.
+func nodeLogger(caller *logrus.Logger) *logrus.Logger {
+	logger := logrus.New()
+	logger.SetLevel(logrus.WarnLevel)
+	if caller != nil {
+		logger.SetOutput(caller.Out)
+		logger.SetFormatter(caller.Formatter)
+		logger.SetReportCaller(caller.ReportCaller)
+		logger.SetLevel(caller.GetLevel())
+		for level, hooks := range caller.Hooks {
+			logger.Hooks[level] = append(logger.Hooks[level], hooks...)
+		}
+	}
+	logger.ExitFunc = func(code int) { panic(startupExit{code: code}) }
+	return logger
+}
+
+// makeAppState turns the exits of the startup of the server into errors,
+// since the process of the caller must not exit
+func makeAppState(ctx context.Context, serverConfig *config.WeaviateConfig,
+	logger *logrus.Logger,
+) (_ *state.State, err error) {
+	hooks := logrus.LevelHooks{}
+	for level, levelHooks := range logger.Hooks {
+		hooks[level] = append(hooks[level], levelHooks...)
+	}
+	last := &lastError{}
+	logger.AddHook(last)
+
+	defer func() {
+		logger.ReplaceHooks(hooks)
+		if r := recover(); r != nil {
+			if _, ok := r.(startupExit); !ok {
+				panic(r)
+			}
+			err = fmt.Errorf("start embedded weaviate: %s", last.message)
+		}
+	}()
+
+	return rest.MakeEmbeddedAppState(ctx, serverConfig, logger), nil
+}
+
+// clusterPorts returns the gossip and data port of the member list. Ports
+// which are not set are picked from the free ones, ports which are set must
+// be free.
+func clusterPorts(gossipPort, dataPort int) (int, int, error) {
+	if gossipPort != 0 && gossipPort == dataPort {
+		return 0, 0, errors.Errorf("gossip and data port must differ, both are %d", gossipPort)
+	}
+
+	// the listeners are held until both ports are picked, so the same port
+	// is not picked twice
+	var listeners []net.Listener
+	defer func() {
+		for _, l := range listeners {
+			l.Close()
+		}
+	}()
+	reserve := func(name string, port int) (int, error) {
+		l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+		if err != nil {
+			return 0, errors.Wrapf(err, "%s port", name)
+		}
+		listeners = append(listeners, l)
+		return l.Addr().(*net.TCPAddr).Port, nil
+	}
+
+	gossipPort, err := reserve("gossip", gossipPort)
+	if err != nil {
+		return 0, 0, err
+	}
+	dataPort, err = reserve("data", dataPort)
+	if err != nil {
+		return 0, 0, err
+	}
+	return gossipPort, dataPort, nil
+}
+
+// Schema manages the classes and tenants
+func (w *Weaviate) Schema() *schemaUC.Manager {
+	return w.state.SchemaManager
+}
+
+// Objects adds, updates, deletes and reads single objects
+func (w *Weaviate) Objects() *objects.Manager {
+	return w.state.ObjectsManager
+}
+
+// Batch adds and deletes objects in batches and exports them
+func (w *Weaviate) Batch() *objects.BatchManager {
+	return w.state.BatchManager
+}
+
+// Traverser searches and aggregates objects
+func (w *Weaviate) Traverser() *traverser.Traverser {
+	return w.state.Traverser
+}
+
+// Close stops the node, the data is flushed to disk
+func (w *Weaviate) Close(ctx context.Context) error {
+	w.state.ReindexCtxCancel()
+	if err := rest.ShutdownAppState(ctx, w.state); err != nil {
+		return err
+	}
+	return w.state.Cluster.Shutdown()
+}
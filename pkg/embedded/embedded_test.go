@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package embedded
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestEmbedded(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	dir := t.TempDir()
+	id := strfmt.UUID("00000000-0000-0000-0000-000000000001")
+
+	w, err := Start(ctx, Options{DataPath: dir})
+	require.Nil(t, err)
+
+	require.Nil(t, w.Schema().AddClass(ctx, nil, &models.Class{
+		Class:      "Article",
+		Vectorizer: "none",
+		Properties: []*models.Property{{Name: "title", DataType: []string{"text"}}},
+	}))
+	_, err = w.Objects().AddObject(ctx, nil, &models.Object{
+		Class:      "Article",
+		ID:         id,
+		Properties: map[string]interface{}{"title": "hello"},
+		Vector:     []float32{1, 0},
+	}, nil)
+	require.Nil(t, err)
+
+	res, err := w.Traverser().GetClass(ctx, nil, dto.GetParams{
+		ClassName:  "Article",
+		Pagination: &filters.Pagination{Limit: 10},
+		NearVector: &searchparams.NearVector{Vector: []float32{1, 0}},
+	})
+	require.Nil(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "hello", res[0].(map[string]interface{})["title"])
+	require.Nil(t, w.Close(ctx))
+
+	t.Run("restart", func(t *testing.T) {
+		w, err := Start(ctx, Options{DataPath: dir})
+		require.Nil(t, err)
+		defer w.Close(ctx)
+
+		obj, err := w.Objects().GetObject(ctx, nil, "Article", id, additional.Properties{}, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"title": "hello"}, obj.Properties)
+	})
+
+	t.Run("startup errors are returned", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		_, err := Start(ctx, Options{
+			DataPath: t.TempDir(),
+			Logger:   logger,
+			Configure: func(c *config.Config) {
+				c.DefaultVectorizerModule = "text2vec-unknown"
+			},
+		})
+		assert.ErrorContains(t, err, "invalid config")
+		assert.NotEmpty(t, hook.AllEntries(), "the hooks of the logger are called")
+		assert.Len(t, logger.Hooks[logrus.ErrorLevel], 1, "the logger is not modified")
+	})
+
+	t.Run("gossip and data port must differ", func(t *testing.T) {
+		_, err := Start(ctx, Options{DataPath: t.TempDir(), GossipPort: 7946, DataPort: 7946})
+		assert.ErrorContains(t, err, "must differ")
+	})
+
+	t.Run("ports in use", func(t *testing.T) {
+		l, err := net.Listen("tcp", ":0")
+		require.Nil(t, err)
+		defer l.Close()
+
+		_, err = Start(ctx, Options{DataPath: t.TempDir(), DataPort: l.Addr().(*net.TCPAddr).Port})
+		assert.ErrorContains(t, err, "data port")
+	})
+
+	t.Run("missing data path", func(t *testing.T) {
+		_, err := Start(ctx, Options{})
+		assert.ErrorContains(t, err, "data path is required")
+	})
+}
@@ -14,7 +14,11 @@ rm -fr $OUT_DIR && mkdir -p $OUT_DIR && cd $GEN_DIR && protoc \
     --proto_path=../proto \
     --go_out=paths=source_relative:protocol \
     --go-grpc_out=paths=source_relative:protocol \
-    ../proto/v1/*.proto
+    ../proto/v1/*.proto && protoc \
+    --proto_path=../proto \
+    --go_out=paths=source_relative:protocol \
+    --go-grpc_out=paths=source_relative:protocol \
+    ../proto/flight/*.proto
 
 cd - && sed -i '' '/versions:/, /source: .*/d' ./grpc/generated/protocol/**/*.go
 
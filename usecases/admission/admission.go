@@ -0,0 +1,256 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package admission limits the queries of each class which run at the same
+// time on a node, so that a class with expensive queries, e.g. with a huge
+// ef or heavy filters, can not starve the queries of other classes. Queries
+// which find all slots of their class taken wait a bounded time for one.
+// A circuit breaker per class fails queries fast, once too many queries of
+// the class in a row were rejected or timed out.
+package admission
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// ErrOverloaded indicates that a query did not get one of the slots of its
+// class within the max queue wait. Clients should retry later.
+type ErrOverloaded struct {
+	Class  string
+	Limit  int
+	Waited time.Duration
+}
+
+func (e ErrOverloaded) Error() string {
+	return fmt.Sprintf("class %q is overloaded: all %d query slots are in use, waited %s",
+		e.Class, e.Limit, e.Waited.Round(time.Millisecond))
+}
+
+// ErrCircuitOpen indicates that queries of a class are failed fast, because
+// its recent queries were rejected or timed out
+type ErrCircuitOpen struct {
+	Class      string
+	RetryAfter time.Duration
+}
+
+func (e ErrCircuitOpen) Error() string {
+	return fmt.Sprintf("queries of class %q are rejected after repeated overload, "+
+		"retry after %s", e.Class, e.RetryAfter.Round(time.Second))
+}
+
+type waiter struct {
+	ch      chan struct{}
+	granted bool
+}
+
+type class struct {
+	name     string
+	limit    int
+	inFlight int
+	waiters  list.List
+
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// Controller admits the queries of each class. A nil Controller admits all
+// queries right away.
+type Controller struct {
+	config  config.QueryAdmission
+	metrics *Metrics
+	now     func() time.Time
+
+	sync.Mutex
+	classes map[string]*class
+}
+
+// New creates a controller with the limits of the config, it returns nil if
+// admission control is disabled
+func New(cfg config.QueryAdmission, metrics *Metrics) *Controller {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	return &Controller{
+		config:  cfg,
+		metrics: metrics,
+		now:     time.Now,
+		classes: map[string]*class{},
+	}
+}
+
+// Admit blocks until a slot of the class is free, the max queue wait passed
+// or ctx is done. The returned func must be called with the result of the
+// query, it releases the slot and feeds the circuit breaker.
+func (c *Controller) Admit(ctx context.Context, className string) (func(error), error) {
+	if c == nil {
+		return func(error) {}, nil
+	}
+
+	before := c.now()
+
+	c.Lock()
+	cl := c.class(schema.UppercaseClassName(className))
+	probe, err := c.checkBreaker(cl, before)
+	if err != nil {
+		c.Unlock()
+		c.metrics.Rejected(cl.name, "circuit_open")
+		return nil, err
+	}
+	if cl.inFlight < cl.limit {
+		// a free slot means that no query is waiting
+		c.grant(cl)
+		c.Unlock()
+		c.metrics.Waited(cl.name, 0)
+		return c.doneFunc(cl, probe), nil
+	}
+	w := &waiter{ch: make(chan struct{})}
+	elem := cl.waiters.PushBack(w)
+	c.Unlock()
+
+	timer := time.NewTimer(c.config.MaxQueueWait)
+	defer timer.Stop()
+
+	select {
+	case <-w.ch:
+		c.metrics.Waited(cl.name, c.now().Sub(before))
+		return c.doneFunc(cl, probe), nil
+	case <-timer.C:
+		waited := c.now().Sub(before)
+		c.Lock()
+		if w.granted {
+			// the slot was granted at the same time
+			c.Unlock()
+			c.metrics.Waited(cl.name, waited)
+			return c.doneFunc(cl, probe), nil
+		}
+		cl.waiters.Remove(elem)
+		c.record(cl, probe, true)
+		c.Unlock()
+		c.metrics.Waited(cl.name, waited)
+		c.metrics.Rejected(cl.name, "overloaded")
+		return nil, ErrOverloaded{Class: cl.name, Limit: cl.limit, Waited: waited}
+	case <-ctx.Done():
+		c.Lock()
+		if w.granted {
+			// the slot was granted at the same time, pass it on
+			c.release(cl)
+		} else {
+			cl.waiters.Remove(elem)
+		}
+		if probe {
+			cl.probing = false
+		}
+		c.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// class returns the state of the class, it must be called with the lock held
+func (c *Controller) class(name string) *class {
+	cl, ok := c.classes[name]
+	if !ok {
+		cl = &class{name: name, limit: c.limit(name)}
+		c.classes[name] = cl
+	}
+	return cl
+}
+
+// limit of the class, the configured class names may use a lowercase first
+// letter
+func (c *Controller) limit(name string) int {
+	for configured, limit := range c.config.Classes {
+		if schema.UppercaseClassName(configured) == name {
+			return limit
+		}
+	}
+	return c.config.MaxInFlight
+}
+
+// checkBreaker returns an error while the breaker of the class is open. Once
+// the cooldown passed, a single query is admitted as a probe, it closes the
+// breaker if it succeeds. It must be called with the lock held.
+func (c *Controller) checkBreaker(cl *class, now time.Time) (bool, error) {
+	if cl.openUntil.IsZero() {
+		return false, nil
+	}
+	if now.Before(cl.openUntil) {
+		return false, ErrCircuitOpen{Class: cl.name, RetryAfter: cl.openUntil.Sub(now)}
+	}
+	if cl.probing {
+		return false, ErrCircuitOpen{Class: cl.name, RetryAfter: c.config.BreakerCooldown}
+	}
+	cl.probing = true
+	return true, nil
+}
+
+func (c *Controller) doneFunc(cl *class, probe bool) func(error) {
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() {
+			c.Lock()
+			defer c.Unlock()
+			c.release(cl)
+			c.record(cl, probe, errors.Is(err, context.DeadlineExceeded))
+		})
+	}
+}
+
+// record the outcome of a query of the class and open or close its breaker,
+// it must be called with the lock held
+func (c *Controller) record(cl *class, probe, failed bool) {
+	if probe {
+		cl.probing = false
+	}
+	if !failed {
+		cl.failures = 0
+		if probe {
+			cl.openUntil = time.Time{}
+			c.metrics.BreakerOpen(cl.name, false)
+		}
+		return
+	}
+
+	cl.failures++
+	if probe || cl.failures >= c.config.BreakerThreshold {
+		cl.failures = 0
+		cl.openUntil = c.now().Add(c.config.BreakerCooldown)
+		c.metrics.BreakerOpen(cl.name, true)
+	}
+}
+
+// grant a slot of the class, it must be called with the lock held
+func (c *Controller) grant(cl *class) {
+	cl.inFlight++
+	c.metrics.InFlight(cl.name, 1)
+}
+
+// release a slot of the class and pass it on to the longest waiting query,
+// it must be called with the lock held
+func (c *Controller) release(cl *class) {
+	if front := cl.waiters.Front(); front != nil {
+		w := cl.waiters.Remove(front).(*waiter)
+		w.granted = true
+		close(w.ch)
+		return
+	}
+	cl.inFlight--
+	c.metrics.InFlight(cl.name, -1)
+}
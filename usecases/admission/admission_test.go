@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package admission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func newTestController(maxInFlight int, wait time.Duration) *Controller {
+	return New(config.QueryAdmission{
+		Enabled:          true,
+		MaxInFlight:      maxInFlight,
+		MaxQueueWait:     wait,
+		Classes:          map[string]int{"expensive": 1},
+		BreakerThreshold: 2,
+		BreakerCooldown:  time.Minute,
+	}, nil)
+}
+
+func inFlight(c *Controller, name string) int {
+	c.Lock()
+	defer c.Unlock()
+	return c.class(name).inFlight
+}
+
+func TestController(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disabled", func(t *testing.T) {
+		c := New(config.QueryAdmission{}, nil)
+		require.Nil(t, c)
+		done, err := c.Admit(ctx, "Article")
+		require.Nil(t, err)
+		done(nil)
+	})
+
+	t.Run("limits are per class", func(t *testing.T) {
+		c := newTestController(2, time.Millisecond)
+
+		done, err := c.Admit(ctx, "Expensive")
+		require.Nil(t, err)
+		_, err = c.Admit(ctx, "Expensive")
+		assert.ErrorAs(t, err, &ErrOverloaded{})
+
+		for i := 0; i < 2; i++ {
+			_, err := c.Admit(ctx, "Article")
+			require.Nil(t, err)
+		}
+		assert.Equal(t, 2, inFlight(c, "Article"))
+		done(nil)
+		assert.Equal(t, 0, inFlight(c, "Expensive"))
+	})
+
+	t.Run("a released slot goes to the waiting query", func(t *testing.T) {
+		c := newTestController(1, time.Minute)
+
+		done, err := c.Admit(ctx, "Article")
+		require.Nil(t, err)
+
+		admitted := make(chan error)
+		go func() {
+			_, err := c.Admit(ctx, "Article")
+			admitted <- err
+		}()
+		require.Eventually(t, func() bool {
+			c.Lock()
+			defer c.Unlock()
+			return c.class("Article").waiters.Len() == 1
+		}, time.Second, time.Millisecond)
+
+		done(nil)
+		require.Nil(t, <-admitted)
+		assert.Equal(t, 1, inFlight(c, "Article"))
+	})
+
+	t.Run("canceled wait", func(t *testing.T) {
+		c := newTestController(1, time.Minute)
+		_, err := c.Admit(ctx, "Article")
+		require.Nil(t, err)
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err = c.Admit(canceled, "Article")
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, inFlight(c, "Article"))
+	})
+
+	t.Run("breaker opens after repeated timeouts", func(t *testing.T) {
+		c := newTestController(2, time.Minute)
+		now := time.Now()
+		c.now = func() time.Time { return now }
+
+		for i := 0; i < 2; i++ {
+			done, err := c.Admit(ctx, "Article")
+			require.Nil(t, err)
+			done(fmt.Errorf("query timed out: %w", context.DeadlineExceeded))
+		}
+
+		_, err := c.Admit(ctx, "Article")
+		var open ErrCircuitOpen
+		require.True(t, errors.As(err, &open))
+		assert.Equal(t, time.Minute, open.RetryAfter)
+
+		// other classes are not affected
+		_, err = c.Admit(ctx, "Product")
+		require.Nil(t, err)
+
+		// after the cooldown a single probe is admitted
+		now = now.Add(time.Minute)
+		probe, err := c.Admit(ctx, "Article")
+		require.Nil(t, err)
+		_, err = c.Admit(ctx, "Article")
+		assert.ErrorAs(t, err, &ErrCircuitOpen{})
+
+		probe(nil)
+		done, err := c.Admit(ctx, "Article")
+		require.Nil(t, err)
+		done(nil)
+	})
+
+	t.Run("failed probe opens the breaker again", func(t *testing.T) {
+		c := newTestController(2, time.Minute)
+		now := time.Now()
+		c.now = func() time.Time { return now }
+
+		for i := 0; i < 2; i++ {
+			done, err := c.Admit(ctx, "Article")
+			require.Nil(t, err)
+			done(context.DeadlineExceeded)
+		}
+
+		now = now.Add(time.Minute)
+		probe, err := c.Admit(ctx, "Article")
+		require.Nil(t, err)
+		probe(context.DeadlineExceeded)
+
+		_, err = c.Admit(ctx, "Article")
+		assert.ErrorAs(t, err, &ErrCircuitOpen{})
+	})
+
+	t.Run("errors other than timeouts reset the breaker", func(t *testing.T) {
+		c := newTestController(2, time.Minute)
+
+		for i := 0; i < 3; i++ {
+			done, err := c.Admit(ctx, "Article")
+			require.Nil(t, err)
+			done(context.DeadlineExceeded)
+
+			done, err = c.Admit(ctx, "Article")
+			require.Nil(t, err)
+			done(errors.New("invalid filter"))
+		}
+	})
+
+	t.Run("queries waiting too long count as failures", func(t *testing.T) {
+		c := newTestController(1, time.Millisecond)
+		_, err := c.Admit(ctx, "Expensive")
+		require.Nil(t, err)
+
+		for i := 0; i < 2; i++ {
+			_, err = c.Admit(ctx, "Expensive")
+			assert.ErrorAs(t, err, &ErrOverloaded{})
+		}
+		_, err = c.Admit(ctx, "Expensive")
+		assert.ErrorAs(t, err, &ErrCircuitOpen{})
+	})
+}
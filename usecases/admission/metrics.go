@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package admission
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Metrics of the admission control
+type Metrics struct {
+	inFlight    *prometheus.GaugeVec
+	waits       *prometheus.HistogramVec
+	rejections  *prometheus.CounterVec
+	breakerOpen *prometheus.GaugeVec
+	classLabel  func(string) string
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		inFlight:    prom.QueryAdmissionInFlight,
+		waits:       prom.QueryAdmissionWaitDurations,
+		rejections:  prom.QueryAdmissionRejections,
+		breakerOpen: prom.QueryAdmissionBreakerOpen,
+		classLabel:  prom.ClassLabel,
+	}
+}
+
+// InFlight adds delta to the queries of the class which hold a slot, the
+// gauge is not set so that it can be aggregated across classes
+func (m *Metrics) InFlight(class string, delta int) {
+	if m == nil {
+		return
+	}
+
+	m.inFlight.With(m.labels(class)).Add(float64(delta))
+}
+
+func (m *Metrics) Waited(class string, took time.Duration) {
+	if m == nil {
+		return
+	}
+
+	m.waits.With(m.labels(class)).Observe(took.Seconds())
+}
+
+func (m *Metrics) Rejected(class, reason string) {
+	if m == nil {
+		return
+	}
+
+	labels := m.labels(class)
+	labels["reason"] = reason
+	m.rejections.With(labels).Inc()
+}
+
+func (m *Metrics) BreakerOpen(class string, open bool) {
+	if m == nil || m.classLabel(class) != class {
+		// the state of different classes can not be aggregated in a gauge
+		return
+	}
+
+	value := 0.0
+	if open {
+		value = 1
+	}
+	m.breakerOpen.With(m.labels(class)).Set(value)
+}
+
+func (m *Metrics) labels(class string) prometheus.Labels {
+	return prometheus.Labels{"class_name": m.classLabel(class)}
+}
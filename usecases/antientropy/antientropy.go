@@ -0,0 +1,378 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package antientropy repairs the replicas of replicated shards in the
+// background. Read repair only fixes the objects which are read, objects
+// missed by a replica during a node outage stay stale until then. Every
+// interval each node compares the shards it leads with their replicas and
+// copies the latest version of the objects which differ. Repairs can also
+// be triggered by operators, who can tune the bandwidth used on the fly.
+package antientropy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+var (
+	// ErrNotFound indicates an unknown class or shard
+	ErrNotFound = errors.New("not found")
+	// ErrNotReplicated indicates a class without replicas
+	ErrNotReplicated = errors.New("class is not replicated")
+	// ErrRunning indicates that a shard is being repaired already
+	ErrRunning = errors.New("repair is running already")
+)
+
+const (
+	TriggerManual    = "manual"
+	TriggerScheduled = "scheduled"
+
+	StatusRunning = "RUNNING"
+	StatusSuccess = "SUCCESS"
+	StatusFailed  = "FAILED"
+)
+
+type schemaManager interface {
+	GetSchemaSkipAuth() schema.Schema
+	NodeName() string
+	CopyShardingState(class string) *sharding.State
+}
+
+type syncer interface {
+	SyncShard(ctx context.Context, class, shard string,
+		opts replica.SyncOptions, progress *replica.SyncProgress) error
+}
+
+// Job is the latest repair of a shard
+type Job struct {
+	Class      string            `json:"class"`
+	Shard      string            `json:"shard"`
+	Trigger    string            `json:"trigger"`
+	Status     string            `json:"status"`
+	Error      string            `json:"error,omitempty"`
+	StartedAt  time.Time         `json:"startedAt"`
+	FinishedAt *time.Time        `json:"finishedAt,omitempty"`
+	Progress   replica.SyncStats `json:"progress"`
+}
+
+// Status of anti-entropy on this node
+type Status struct {
+	Enabled            bool   `json:"enabled"`
+	Interval           string `json:"interval"`
+	HashTreeDepth      int    `json:"hashTreeDepth"`
+	BandwidthLimit     int64  `json:"bandwidthLimit"`
+	ConflictResolution string `json:"conflictResolution"`
+	Jobs               []Job  `json:"jobs"`
+}
+
+type key struct {
+	class string
+	shard string
+}
+
+type job struct {
+	Job
+	progress *replica.SyncProgress
+}
+
+// Manager repairs the replicas of the shards led by this node in the
+// configured interval, and the shards requested by operators. A nil Manager
+// is valid and does nothing.
+type Manager struct {
+	config  config.AsyncReplication
+	schema  schemaManager
+	db      syncer
+	limiter *replica.Limiter
+	metrics *Metrics
+	logger  logrus.FieldLogger
+	now     func() time.Time
+
+	sync.Mutex
+	jobs map[key]*job
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+func NewManager(cfg config.AsyncReplication, schema schemaManager, db syncer,
+	metrics *Metrics, logger logrus.FieldLogger,
+) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		config:  cfg,
+		schema:  schema,
+		db:      db,
+		limiter: replica.NewLimiter(cfg.BandwidthLimit),
+		metrics: metrics,
+		logger:  logger.WithField("action", "async_replication"),
+		now:     time.Now,
+		jobs:    map[key]*job{},
+		ctx:     ctx,
+		cancel:  cancel,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start repairing the shards led by this node in the configured interval,
+// unless the background repair is disabled
+func (m *Manager) Start() {
+	if m == nil {
+		return
+	}
+	if !m.config.Enabled {
+		close(m.done)
+		return
+	}
+
+	go func() {
+		defer close(m.done)
+
+		ticker := time.NewTicker(m.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.syncLeading()
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Shutdown stops the background repair and cancels running repairs
+func (m *Manager) Shutdown(ctx context.Context) error {
+	if m == nil {
+		return nil
+	}
+
+	close(m.stop)
+	m.cancel()
+	finished := make(chan struct{})
+	go func() {
+		<-m.done
+		m.wg.Wait()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Status returns the configuration and the latest repair of every shard
+func (m *Manager) Status() Status {
+	if m == nil {
+		return Status{}
+	}
+
+	m.Lock()
+	jobs := make([]Job, 0, len(m.jobs))
+	for _, j := range m.jobs {
+		jobs = append(jobs, j.snapshot())
+	}
+	m.Unlock()
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].Class != jobs[j].Class {
+			return jobs[i].Class < jobs[j].Class
+		}
+		return jobs[i].Shard < jobs[j].Shard
+	})
+
+	return Status{
+		Enabled:            m.config.Enabled,
+		Interval:           m.config.Interval.String(),
+		HashTreeDepth:      m.config.HashTreeDepth,
+		BandwidthLimit:     m.limiter.Limit(),
+		ConflictResolution: m.config.ConflictResolution,
+		Jobs:               jobs,
+	}
+}
+
+// SetBandwidthLimit changes the bytes per second used by repairs, including
+// running ones. Zero removes the limit.
+func (m *Manager) SetBandwidthLimit(bytesPerSecond int64) error {
+	if m == nil {
+		return nil
+	}
+	if bytesPerSecond < 0 {
+		return fmt.Errorf("bandwidth limit must not be negative")
+	}
+	m.limiter.SetLimit(bytesPerSecond)
+	m.logger.WithField("bandwidth_limit", bytesPerSecond).Info("bandwidth limit changed")
+	return nil
+}
+
+// Trigger the repair of a shard of a replicated class, or of all its shards
+// if shard is empty. The repairs run in the background, their progress is
+// reported by Status.
+func (m *Manager) Trigger(class, shard string) ([]Job, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	class = schema.UppercaseClassName(class)
+	sch := m.schema.GetSchemaSkipAuth()
+	c := sch.FindClassByName(schema.ClassName(class))
+	if c == nil {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if c.ReplicationConfig == nil || c.ReplicationConfig.Factor < 2 {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotReplicated)
+	}
+	st := m.schema.CopyShardingState(class)
+	if st == nil {
+		return nil, fmt.Errorf("sharding state of class %q: %w", class, ErrNotFound)
+	}
+
+	shards := st.AllPhysicalShards()
+	if shard != "" {
+		if _, ok := st.Physical[shard]; !ok {
+			return nil, fmt.Errorf("shard %q of class %q: %w", shard, class, ErrNotFound)
+		}
+		shards = []string{shard}
+	}
+
+	jobs, err := m.startJobs(class, shards, TriggerManual)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Job, len(jobs))
+	for i, j := range jobs {
+		result[i] = j.snapshot()
+	}
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		for _, j := range jobs {
+			m.run(m.ctx, j)
+		}
+	}()
+	return result, nil
+}
+
+// startJobs registers a job for each shard, unless one of them is running
+func (m *Manager) startJobs(class string, shards []string, trigger string) ([]*job, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, shard := range shards {
+		if j, ok := m.jobs[key{class, shard}]; ok && j.Status == StatusRunning {
+			return nil, fmt.Errorf("shard %q of class %q: %w", shard, class, ErrRunning)
+		}
+	}
+
+	jobs := make([]*job, len(shards))
+	for i, shard := range shards {
+		jobs[i] = &job{
+			Job: Job{
+				Class:     class,
+				Shard:     shard,
+				Trigger:   trigger,
+				Status:    StatusRunning,
+				StartedAt: m.now(),
+			},
+			progress: &replica.SyncProgress{},
+		}
+		m.jobs[key{class, shard}] = jobs[i]
+	}
+	return jobs, nil
+}
+
+// syncLeading repairs the replicated shards led by this node. A shard is
+// led by the first of its nodes, so each shard is repaired by one node.
+func (m *Manager) syncLeading() {
+	node := m.schema.NodeName()
+	for _, c := range m.schema.GetSchemaSkipAuth().Objects.Classes {
+		if c.ReplicationConfig == nil || c.ReplicationConfig.Factor < 2 {
+			continue
+		}
+		st := m.schema.CopyShardingState(c.Class)
+		if st == nil {
+			continue
+		}
+
+		for _, shard := range st.AllPhysicalShards() {
+			nodes := append([]string{}, st.Physical[shard].BelongsToNodes...)
+			sort.Strings(nodes)
+			if len(nodes) < 2 || nodes[0] != node {
+				continue
+			}
+
+			jobs, err := m.startJobs(c.Class, []string{shard}, TriggerScheduled)
+			if err != nil {
+				continue // triggered manually in the meantime
+			}
+			m.run(m.ctx, jobs[0])
+			if m.ctx.Err() != nil {
+				return
+			}
+		}
+	}
+}
+
+func (m *Manager) run(ctx context.Context, j *job) {
+	opts := replica.SyncOptions{
+		Depth:     m.config.HashTreeDepth,
+		Conflicts: replica.ConflictResolution(m.config.ConflictResolution),
+		Limiter:   m.limiter,
+	}
+	err := m.db.SyncShard(ctx, j.Class, j.Shard, opts, j.progress)
+
+	m.Lock()
+	finished := m.now()
+	j.FinishedAt = &finished
+	j.Status = StatusSuccess
+	if err != nil {
+		j.Status = StatusFailed
+		j.Error = err.Error()
+	}
+	m.Unlock()
+
+	stats := j.progress.Stats()
+	m.metrics.Synced(j.Class, finished.Sub(j.StartedAt), stats, err)
+	logger := m.logger.WithField("class", j.Class).WithField("shard", j.Shard).
+		WithField("trigger", j.Trigger)
+	if err != nil {
+		logger.WithError(err).Error("could not repair replicas")
+		return
+	}
+	logger.WithField("diverged_leaves", stats.LeavesDiverged).
+		WithField("repaired_objects", stats.ObjectsRepaired).
+		WithField("conflicts", stats.Conflicts).
+		Debug("repaired replicas")
+}
+
+// snapshot must be called with the lock of the manager held, unless the
+// job has not been started yet
+func (j *job) snapshot() Job {
+	s := j.Job
+	s.Progress = j.progress.Stats()
+	return s
+}
@@ -0,0 +1,244 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package antientropy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeSchema struct {
+	states map[string]*sharding.State
+}
+
+func (f *fakeSchema) GetSchemaSkipAuth() schema.Schema {
+	classes := []*models.Class{{Class: "Plain"}}
+	for class := range f.states {
+		classes = append(classes, &models.Class{
+			Class:             class,
+			ReplicationConfig: &models.ReplicationConfig{Factor: 2},
+		})
+	}
+	return schema.Schema{Objects: &models.Schema{Classes: classes}}
+}
+
+func (f *fakeSchema) NodeName() string { return "node1" }
+
+func (f *fakeSchema) CopyShardingState(class string) *sharding.State {
+	return f.states[class]
+}
+
+type syncCall struct {
+	class, shard string
+	opts         replica.SyncOptions
+}
+
+type fakeSyncer struct {
+	sync.Mutex
+	calls []syncCall
+	block chan struct{}
+	err   error
+}
+
+func (f *fakeSyncer) SyncShard(ctx context.Context, class, shard string,
+	opts replica.SyncOptions, progress *replica.SyncProgress,
+) error {
+	progress.LeavesTotal.Store(4)
+	progress.LeavesDiverged.Add(1)
+	if f.block != nil {
+		select {
+		case <-f.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	progress.LeavesCompared.Store(4)
+	progress.ObjectsRepaired.Add(2)
+
+	f.Lock()
+	defer f.Unlock()
+	f.calls = append(f.calls, syncCall{class, shard, opts})
+	return f.err
+}
+
+func (f *fakeSyncer) shards() []string {
+	f.Lock()
+	defer f.Unlock()
+	var shards []string
+	for _, c := range f.calls {
+		shards = append(shards, c.class+"/"+c.shard)
+	}
+	return shards
+}
+
+func newTestManager(db *fakeSyncer) *Manager {
+	logger, _ := test.NewNullLogger()
+	sm := &fakeSchema{states: map[string]*sharding.State{
+		"Article": {Physical: map[string]sharding.Physical{
+			"led":      {Name: "led", BelongsToNodes: []string{"node2", "node1"}},
+			"followed": {Name: "followed", BelongsToNodes: []string{"node3", "node2"}},
+			"single":   {Name: "single", BelongsToNodes: []string{"node1"}},
+		}},
+	}}
+	return NewManager(config.AsyncReplication{
+		Enabled:            true,
+		Interval:           time.Hour,
+		HashTreeDepth:      2,
+		BandwidthLimit:     1024,
+		ConflictResolution: "delete",
+	}, sm, db, nil, logger)
+}
+
+func waitForJobs(t *testing.T, m *Manager) []Job {
+	var jobs []Job
+	require.Eventually(t, func() bool {
+		jobs = m.Status().Jobs
+		for _, j := range jobs {
+			if j.Status == StatusRunning {
+				return false
+			}
+		}
+		return true
+	}, time.Second, 5*time.Millisecond)
+	return jobs
+}
+
+func TestSyncLeading(t *testing.T) {
+	db := &fakeSyncer{}
+	m := newTestManager(db)
+
+	m.syncLeading()
+	assert.Equal(t, []string{"Article/led"}, db.shards())
+	assert.Equal(t, replica.SyncOptions{
+		Depth:     2,
+		Conflicts: replica.ConflictDelete,
+		Limiter:   m.limiter,
+	}, db.calls[0].opts)
+
+	jobs := m.Status().Jobs
+	require.Len(t, jobs, 1)
+	assert.Equal(t, TriggerScheduled, jobs[0].Trigger)
+	assert.Equal(t, StatusSuccess, jobs[0].Status)
+	assert.Equal(t, replica.SyncStats{
+		LeavesTotal:     4,
+		LeavesCompared:  4,
+		LeavesDiverged:  1,
+		ObjectsRepaired: 2,
+	}, jobs[0].Progress)
+}
+
+func TestTrigger(t *testing.T) {
+	t.Run("single shard", func(t *testing.T) {
+		db := &fakeSyncer{}
+		m := newTestManager(db)
+
+		jobs, err := m.Trigger("article", "followed")
+		require.Nil(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, StatusRunning, jobs[0].Status)
+
+		jobs = waitForJobs(t, m)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, StatusSuccess, jobs[0].Status)
+		assert.Equal(t, TriggerManual, jobs[0].Trigger)
+		assert.Equal(t, []string{"Article/followed"}, db.shards())
+	})
+
+	t.Run("all shards", func(t *testing.T) {
+		db := &fakeSyncer{err: errors.New("replica unreachable")}
+		m := newTestManager(db)
+
+		_, err := m.Trigger("Article", "")
+		require.Nil(t, err)
+		jobs := waitForJobs(t, m)
+		require.Len(t, jobs, 3)
+		for _, j := range jobs {
+			assert.Equal(t, StatusFailed, j.Status)
+			assert.Equal(t, "replica unreachable", j.Error)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		m := newTestManager(&fakeSyncer{})
+
+		_, err := m.Trigger("Unknown", "")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = m.Trigger("Plain", "")
+		assert.ErrorIs(t, err, ErrNotReplicated)
+		_, err = m.Trigger("Article", "unknown")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("running", func(t *testing.T) {
+		db := &fakeSyncer{block: make(chan struct{})}
+		m := newTestManager(db)
+
+		_, err := m.Trigger("Article", "led")
+		require.Nil(t, err)
+		_, err = m.Trigger("Article", "")
+		assert.ErrorIs(t, err, ErrRunning)
+
+		m.syncLeading()
+		assert.Empty(t, db.shards())
+
+		close(db.block)
+		waitForJobs(t, m)
+		assert.Equal(t, []string{"Article/led"}, db.shards())
+	})
+
+	t.Run("shutdown cancels running repairs", func(t *testing.T) {
+		db := &fakeSyncer{block: make(chan struct{})}
+		m := newTestManager(db)
+		m.Start()
+
+		_, err := m.Trigger("Article", "led")
+		require.Nil(t, err)
+		require.Nil(t, m.Shutdown(context.Background()))
+
+		jobs := m.Status().Jobs
+		require.Len(t, jobs, 1)
+		assert.Equal(t, StatusFailed, jobs[0].Status)
+		assert.Equal(t, context.Canceled.Error(), jobs[0].Error)
+	})
+}
+
+func TestBandwidthLimit(t *testing.T) {
+	m := newTestManager(&fakeSyncer{})
+	assert.Equal(t, int64(1024), m.Status().BandwidthLimit)
+
+	require.Nil(t, m.SetBandwidthLimit(0))
+	assert.Equal(t, int64(0), m.Status().BandwidthLimit)
+	assert.NotNil(t, m.SetBandwidthLimit(-1))
+}
+
+func TestNilManager(t *testing.T) {
+	var m *Manager
+	m.Start()
+	assert.Nil(t, m.Shutdown(context.Background()))
+	assert.Equal(t, Status{}, m.Status())
+	assert.Nil(t, m.SetBandwidthLimit(10))
+	jobs, err := m.Trigger("Article", "")
+	assert.Nil(t, err)
+	assert.Nil(t, jobs)
+}
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package antientropy
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// Metrics of shard repairs
+type Metrics struct {
+	syncs           *prometheus.CounterVec
+	syncDurations   *prometheus.HistogramVec
+	divergedLeaves  *prometheus.CounterVec
+	repairedObjects *prometheus.CounterVec
+	conflicts       *prometheus.CounterVec
+	bytes           *prometheus.CounterVec
+	classLabel      func(string) string
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		syncs:           prom.AsyncReplicationSyncs,
+		syncDurations:   prom.AsyncReplicationSyncDurations,
+		divergedLeaves:  prom.AsyncReplicationDivergedLeaves,
+		repairedObjects: prom.AsyncReplicationRepairedObjects,
+		conflicts:       prom.AsyncReplicationConflicts,
+		bytes:           prom.AsyncReplicationBytes,
+		classLabel:      prom.ClassLabel,
+	}
+}
+
+func (m *Metrics) Synced(class string, took time.Duration, stats replica.SyncStats, err error) {
+	if m == nil {
+		return
+	}
+
+	class = m.classLabel(class)
+	labels := prometheus.Labels{"class_name": class}
+	m.syncs.With(prometheus.Labels{
+		"class_name": class,
+		"status":     status(err),
+	}).Inc()
+	m.syncDurations.With(labels).Observe(took.Seconds())
+	m.divergedLeaves.With(labels).Add(float64(stats.LeavesDiverged))
+	m.repairedObjects.With(labels).Add(float64(stats.ObjectsRepaired))
+	m.conflicts.With(labels).Add(float64(stats.Conflicts))
+	m.bytes.With(labels).Add(float64(stats.Bytes))
+}
+
+func status(err error) string {
+	if err != nil {
+		return "failed"
+	}
+	return "success"
+}
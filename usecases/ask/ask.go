@@ -0,0 +1,408 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package ask answers questions from the objects of a class in one call:
+// the objects are retrieved with a hybrid search, optionally reranked, and
+// the answer is generated from them with citations of the objects it is
+// based on.
+package ask
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
+	additionalmodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/rank"
+)
+
+const (
+	DefaultLimit = 10
+	MaxLimit     = 100
+)
+
+// ErrInvalidRequest is wrapped by the errors of requests which do not match
+// the schema
+var ErrInvalidRequest = errors.New("invalid request")
+
+func invalidf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRequest, fmt.Sprintf(format, args...))
+}
+
+type traverser interface {
+	GetClass(ctx context.Context, principal *models.Principal,
+		params dto.GetParams) ([]interface{}, error)
+}
+
+type schemaGetter interface {
+	GetSchema(principal *models.Principal) (schema.Schema, error)
+}
+
+type modulesProvider interface {
+	GetExploreAdditionalExtend(ctx context.Context, in []search.Result,
+		moduleParams map[string]interface{}, searchVector []float32,
+		argumentModuleParams map[string]interface{}) ([]search.Result, error)
+}
+
+// Request asks a question about the objects of a class
+type Request struct {
+	Class    string `json:"class"`
+	Tenant   string `json:"tenant,omitempty"`
+	Question string `json:"question"`
+	// Properties are the text properties the answer is generated from, all
+	// text properties of the class if empty
+	Properties []string `json:"properties,omitempty"`
+	// Limit of the retrieved objects, DefaultLimit if zero
+	Limit int `json:"limit,omitempty"`
+	// Alpha weighs the vector search against the keyword search, the default
+	// of hybrid searches if nil
+	Alpha *float64 `json:"alpha,omitempty"`
+	// Rerank reranks the retrieved objects with the reranker module of the
+	// class, if set
+	Rerank *Rerank `json:"rerank,omitempty"`
+}
+
+type Rerank struct {
+	// Property is the text property which is ranked
+	Property string `json:"property"`
+	// Query the objects are ranked by, the question if empty
+	Query string `json:"query,omitempty"`
+	// Limit of the objects kept after reranking, all if zero
+	Limit int `json:"limit,omitempty"`
+}
+
+// Source is an object the answer was generated from, sources are cited by
+// their position in the list of sources, starting at 1
+type Source struct {
+	ID          strfmt.UUID            `json:"id"`
+	Class       string                 `json:"class"`
+	Score       float32                `json:"score"`
+	RerankScore *float64               `json:"rerankScore,omitempty"`
+	Properties  map[string]interface{} `json:"properties"`
+}
+
+// Citation of a source in the answer. Start and End are the byte offsets of
+// the citation marker, e.g. "[2]", in the answer.
+type Citation struct {
+	Source int         `json:"source"`
+	ID     strfmt.UUID `json:"id"`
+	Start  int         `json:"start"`
+	End    int         `json:"end"`
+}
+
+// Timings of the stages, Rerank is zero if the objects were not reranked
+type Timings struct {
+	Retrieve time.Duration
+	Rerank   time.Duration
+	Generate time.Duration
+	Total    time.Duration
+}
+
+// MarshalJSON renders the timings in milliseconds
+func (t Timings) MarshalJSON() ([]byte, error) {
+	ms := func(d time.Duration) float64 { return float64(d.Microseconds()) / 1000 }
+	return json.Marshal(map[string]float64{
+		"retrieveMs": ms(t.Retrieve),
+		"rerankMs":   ms(t.Rerank),
+		"generateMs": ms(t.Generate),
+		"totalMs":    ms(t.Total),
+	})
+}
+
+type Answer struct {
+	Answer    string     `json:"answer"`
+	Citations []Citation `json:"citations"`
+	Sources   []Source   `json:"sources"`
+	Timings   Timings    `json:"timings"`
+}
+
+// Pipeline runs the stages of questions with the traverser and the modules
+type Pipeline struct {
+	traverser traverser
+	schema    schemaGetter
+	modules   modulesProvider
+}
+
+func NewPipeline(traverser traverser, schema schemaGetter, modules modulesProvider) *Pipeline {
+	return &Pipeline{traverser: traverser, schema: schema, modules: modules}
+}
+
+// Ask answers the question of the request. The retrieval is authorized like
+// a Get query of the class, reranking and generation only see the retrieved
+// objects.
+func (p *Pipeline) Ask(ctx context.Context, principal *models.Principal,
+	req Request,
+) (*Answer, error) {
+	start := time.Now()
+
+	properties, err := p.validate(principal, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := p.retrieve(ctx, principal, req, properties)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve: %w", err)
+	}
+	answer := &Answer{Citations: []Citation{}, Sources: []Source{}}
+	answer.Timings.Retrieve = time.Since(start)
+	if len(results) == 0 {
+		answer.Timings.Total = time.Since(start)
+		return answer, nil
+	}
+
+	var rerankScores []*float64
+	if req.Rerank != nil {
+		before := time.Now()
+		results, rerankScores, err = p.rerank(ctx, req, results)
+		if err != nil {
+			return nil, fmt.Errorf("rerank: %w", err)
+		}
+		answer.Timings.Rerank = time.Since(before)
+	}
+
+	before := time.Now()
+	answer.Answer, err = p.generate(ctx, req.Question, properties, results)
+	if err != nil {
+		return nil, fmt.Errorf("generate: %w", err)
+	}
+	answer.Timings.Generate = time.Since(before)
+
+	for i, res := range results {
+		// the results may have been replaced by the reranker, which is not
+		// required to keep their properties
+		props, ok := res.Schema.(map[string]interface{})
+		if !ok {
+			props = map[string]interface{}{}
+		}
+		source := Source{
+			ID:         res.ID,
+			Class:      res.ClassName,
+			Score:      res.Score,
+			Properties: props,
+		}
+		if rerankScores != nil {
+			source.RerankScore = rerankScores[i]
+		}
+		answer.Sources = append(answer.Sources, source)
+	}
+	answer.Citations = citations(answer.Answer, answer.Sources)
+	answer.Timings.Total = time.Since(start)
+	return answer, nil
+}
+
+// validate returns the properties the answer is generated from
+func (p *Pipeline) validate(principal *models.Principal, req *Request) ([]string, error) {
+	if req.Question == "" {
+		return nil, invalidf("question is required")
+	}
+	if req.Limit == 0 {
+		req.Limit = DefaultLimit
+	}
+	if req.Limit < 0 || req.Limit > MaxLimit {
+		return nil, invalidf("limit must be between 1 and %d", MaxLimit)
+	}
+	if req.Alpha != nil && (*req.Alpha < 0 || *req.Alpha > 1) {
+		return nil, invalidf("alpha must be between 0 and 1")
+	}
+
+	sch, err := p.schema.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+	class := sch.FindClassByName(schema.ClassName(req.Class))
+	if class == nil {
+		return nil, invalidf("class %q not found", req.Class)
+	}
+
+	isText := func(prop *models.Property) bool {
+		return len(prop.DataType) == 1 && (prop.DataType[0] == schema.DataTypeText.String() ||
+			prop.DataType[0] == schema.DataTypeString.String())
+	}
+	properties := req.Properties
+	if len(properties) == 0 {
+		for _, prop := range class.Properties {
+			if isText(prop) {
+				properties = append(properties, prop.Name)
+			}
+		}
+		if len(properties) == 0 {
+			return nil, invalidf("class %q has no text properties", req.Class)
+		}
+	}
+	for _, name := range properties {
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil || !isText(prop) {
+			return nil, invalidf("%q is not a text property of class %q", name, req.Class)
+		}
+	}
+	if req.Rerank != nil {
+		if req.Rerank.Property == "" {
+			return nil, invalidf("rerank property is required")
+		}
+		if _, err := schema.GetPropertyByName(class, req.Rerank.Property); err != nil {
+			return nil, invalidf("rerank property %q not found in class %q",
+				req.Rerank.Property, req.Class)
+		}
+		if req.Rerank.Limit < 0 {
+			return nil, invalidf("rerank limit must not be negative")
+		}
+	}
+	return properties, nil
+}
+
+func (p *Pipeline) retrieve(ctx context.Context, principal *models.Principal,
+	req Request, properties []string,
+) ([]search.Result, error) {
+	alpha := common_filters.DefaultAlpha
+	if req.Alpha != nil {
+		alpha = *req.Alpha
+	}
+
+	selectProperties := make(search.SelectProperties, 0, len(properties)+1)
+	for _, name := range properties {
+		selectProperties = append(selectProperties, search.SelectProperty{Name: name, IsPrimitive: true})
+	}
+	if req.Rerank != nil && !contains(properties, req.Rerank.Property) {
+		selectProperties = append(selectProperties,
+			search.SelectProperty{Name: req.Rerank.Property, IsPrimitive: true})
+	}
+
+	res, err := p.traverser.GetClass(ctx, principal, dto.GetParams{
+		ClassName:  req.Class,
+		Tenant:     req.Tenant,
+		Pagination: &filters.Pagination{Limit: req.Limit},
+		Properties: selectProperties,
+		HybridSearch: &searchparams.HybridSearch{
+			Type:            "hybrid",
+			Query:           req.Question,
+			Alpha:           alpha,
+			FusionAlgorithm: common_filters.HybridFusionDefault,
+		},
+		AdditionalProperties: additional.Properties{ID: true, Score: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]search.Result, 0, len(res))
+	for _, r := range res {
+		obj, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		props := map[string]interface{}{}
+		result := search.Result{ClassName: req.Class, Tenant: req.Tenant, Schema: props}
+		for name, value := range obj {
+			if name != "_additional" {
+				props[name] = value
+				continue
+			}
+			add, _ := value.(map[string]interface{})
+			result.ID, _ = add["id"].(strfmt.UUID)
+			result.Score, _ = add["score"].(float32)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// rerank returns the results sorted by the reranker and their scores
+func (p *Pipeline) rerank(ctx context.Context, req Request,
+	results []search.Result,
+) ([]search.Result, []*float64, error) {
+	query := req.Rerank.Query
+	if query == "" {
+		query = req.Question
+	}
+
+	results, err := p.modules.GetExploreAdditionalExtend(ctx, results,
+		map[string]interface{}{
+			"rerank": &rank.Params{Property: &req.Rerank.Property, Query: &query},
+		}, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type ranked struct {
+		result search.Result
+		score  *float64
+	}
+	all := make([]ranked, len(results))
+	for i := range results {
+		all[i].result = results[i]
+		if scores, ok := results[i].AdditionalProperties["rerank"].([]*additionalmodels.RankResult); ok && len(scores) > 0 {
+			all[i].score = scores[0].Score
+		}
+		delete(all[i].result.AdditionalProperties, "rerank")
+	}
+	// the reranker modules sort the results already, but are not required to
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].score != nil && (all[j].score == nil || *all[i].score > *all[j].score)
+	})
+	if req.Rerank.Limit > 0 && req.Rerank.Limit < len(all) {
+		all = all[:req.Rerank.Limit]
+	}
+
+	results = make([]search.Result, len(all))
+	scores := make([]*float64, len(all))
+	for i := range all {
+		results[i], scores[i] = all[i].result, all[i].score
+	}
+	return results, scores, nil
+}
+
+func (p *Pipeline) generate(ctx context.Context, question string,
+	properties []string, results []search.Result,
+) (string, error) {
+	task := prompt(question)
+	results, err := p.modules.GetExploreAdditionalExtend(ctx, results,
+		map[string]interface{}{
+			"generate": &generate.Params{Task: &task, Properties: properties},
+		}, nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	generated, ok := results[0].AdditionalProperties["generate"].(*additionalmodels.GenerateResult)
+	if !ok {
+		return "", fmt.Errorf("no answer generated")
+	}
+	if generated.Error != nil {
+		return "", generated.Error
+	}
+	if generated.GroupedResult == nil {
+		return "", fmt.Errorf("no answer generated")
+	}
+	return *generated.GroupedResult, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
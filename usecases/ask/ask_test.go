@@ -0,0 +1,202 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ask
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
+	additionalmodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/rank"
+)
+
+const (
+	id1 = strfmt.UUID("00000000-0000-0000-0000-000000000001")
+	id2 = strfmt.UUID("00000000-0000-0000-0000-000000000002")
+	id3 = strfmt.UUID("00000000-0000-0000-0000-000000000003")
+)
+
+type fakeTraverser struct {
+	params dto.GetParams
+}
+
+func (f *fakeTraverser) GetClass(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) ([]interface{}, error) {
+	f.params = params
+	out := []interface{}{}
+	for i, id := range []strfmt.UUID{id1, id2, id3} {
+		out = append(out, map[string]interface{}{
+			"title": "title " + string(id),
+			"_additional": map[string]interface{}{
+				"id":    id,
+				"score": float32(3 - i),
+			},
+		})
+	}
+	return out, nil
+}
+
+type fakeSchemaGetter struct{}
+
+func (fakeSchemaGetter) GetSchema(principal *models.Principal) (schema.Schema, error) {
+	return schema.Schema{Objects: &models.Schema{Classes: []*models.Class{{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "wordCount", DataType: []string{"int"}},
+		},
+	}}}}, nil
+}
+
+type fakeModules struct {
+	answer  string
+	err     error
+	task    string
+	sources []strfmt.UUID
+	// dropSchema makes the reranker return results without properties
+	dropSchema bool
+}
+
+func (f *fakeModules) GetExploreAdditionalExtend(ctx context.Context, in []search.Result,
+	moduleParams map[string]interface{}, searchVector []float32,
+	argumentModuleParams map[string]interface{},
+) ([]search.Result, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if _, ok := moduleParams["rerank"].(*rank.Params); ok {
+		// ranks the results in reverse order
+		for i := range in {
+			score := float64(i)
+			if f.dropSchema {
+				in[i].Schema = nil
+			}
+			in[i].AdditionalProperties = models.AdditionalProperties{
+				"rerank": []*additionalmodels.RankResult{{Score: &score}},
+			}
+		}
+		return in, nil
+	}
+
+	params := moduleParams["generate"].(*generate.Params)
+	f.task = *params.Task
+	f.sources = nil
+	for _, res := range in {
+		f.sources = append(f.sources, res.ID)
+	}
+	in[0].AdditionalProperties = models.AdditionalProperties{
+		"generate": &additionalmodels.GenerateResult{GroupedResult: &f.answer},
+	}
+	return in, nil
+}
+
+func TestAsk(t *testing.T) {
+	t.Run("retrieve and generate", func(t *testing.T) {
+		tr := &fakeTraverser{}
+		mods := &fakeModules{answer: "It is about titles [1], see also [2, 9]."}
+		p := NewPipeline(tr, fakeSchemaGetter{}, mods)
+
+		answer, err := p.Ask(context.Background(), nil, Request{
+			Class:    "Article",
+			Tenant:   "tenant1",
+			Question: "What is it about?",
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, "What is it about?", tr.params.HybridSearch.Query)
+		assert.Equal(t, DefaultLimit, tr.params.Pagination.Limit)
+		assert.Equal(t, "tenant1", tr.params.Tenant)
+		assert.Contains(t, mods.task, "Question: What is it about?")
+
+		assert.Equal(t, mods.answer, answer.Answer)
+		require.Len(t, answer.Sources, 3)
+		assert.Equal(t, id1, answer.Sources[0].ID)
+		assert.Equal(t, float32(3), answer.Sources[0].Score)
+		assert.Equal(t, "title "+string(id1), answer.Sources[0].Properties["title"])
+		assert.Nil(t, answer.Sources[0].RerankScore)
+		assert.Equal(t, []Citation{
+			{Source: 1, ID: id1, Start: 19, End: 22},
+			{Source: 2, ID: id2, Start: 33, End: 39},
+		}, answer.Citations)
+		assert.Zero(t, answer.Timings.Rerank)
+		assert.GreaterOrEqual(t, answer.Timings.Total, answer.Timings.Generate)
+	})
+
+	t.Run("rerank", func(t *testing.T) {
+		mods := &fakeModules{answer: "See [1]."}
+		p := NewPipeline(&fakeTraverser{}, fakeSchemaGetter{}, mods)
+
+		answer, err := p.Ask(context.Background(), nil, Request{
+			Class:    "Article",
+			Question: "What is it about?",
+			Rerank:   &Rerank{Property: "title", Limit: 2},
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []strfmt.UUID{id3, id2}, mods.sources)
+		require.Len(t, answer.Sources, 2)
+		assert.Equal(t, id3, answer.Sources[0].ID)
+		assert.Equal(t, float64(2), *answer.Sources[0].RerankScore)
+		assert.Equal(t, []Citation{{Source: 1, ID: id3, Start: 4, End: 7}}, answer.Citations)
+	})
+
+	t.Run("results without properties", func(t *testing.T) {
+		mods := &fakeModules{answer: "See [1].", dropSchema: true}
+		p := NewPipeline(&fakeTraverser{}, fakeSchemaGetter{}, mods)
+
+		answer, err := p.Ask(context.Background(), nil, Request{
+			Class:    "Article",
+			Question: "What is it about?",
+			Rerank:   &Rerank{Property: "title"},
+		})
+		require.Nil(t, err)
+
+		require.Len(t, answer.Sources, 3)
+		for _, source := range answer.Sources {
+			assert.Equal(t, map[string]interface{}{}, source.Properties)
+		}
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		p := NewPipeline(&fakeTraverser{}, fakeSchemaGetter{}, &fakeModules{})
+		alpha := 1.5
+		for _, req := range []Request{
+			{Class: "Article"},
+			{Class: "Unknown", Question: "q"},
+			{Class: "Article", Question: "q", Limit: MaxLimit + 1},
+			{Class: "Article", Question: "q", Alpha: &alpha},
+			{Class: "Article", Question: "q", Properties: []string{"wordCount"}},
+			{Class: "Article", Question: "q", Rerank: &Rerank{}},
+		} {
+			_, err := p.Ask(context.Background(), nil, req)
+			assert.ErrorIs(t, err, ErrInvalidRequest)
+		}
+	})
+
+	t.Run("generation fails", func(t *testing.T) {
+		p := NewPipeline(&fakeTraverser{}, fakeSchemaGetter{},
+			&fakeModules{err: errors.New("unknown capability: generate")})
+
+		_, err := p.Ask(context.Background(), nil, Request{Class: "Article", Question: "q"})
+		assert.ErrorContains(t, err, "generate: unknown capability")
+	})
+}
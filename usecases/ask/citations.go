@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ask
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// prompt is the task of the grouped generation. The generative modules list
+// the properties of the objects in the order of the sources.
+func prompt(question string) string {
+	return fmt.Sprintf("Answer the question using only the sources below, they are "+
+		"numbered from 1 in the order they are listed. Cite the sources the answer "+
+		"is based on with their number in square brackets, e.g. [1] or [1, 3]. If the "+
+		"sources do not contain the answer, say so.\n\nQuestion: %s", question)
+}
+
+var citationMarker = regexp.MustCompile(`\[(\d+(?:\s*,\s*\d+)*)\]`)
+
+// citations finds the citation markers of the answer, markers of numbers
+// which are not sources are ignored
+func citations(answer string, sources []Source) []Citation {
+	out := []Citation{}
+	for _, match := range citationMarker.FindAllStringSubmatchIndex(answer, -1) {
+		for _, number := range strings.Split(answer[match[2]:match[3]], ",") {
+			n, err := strconv.Atoi(strings.TrimSpace(number))
+			if err != nil || n < 1 || n > len(sources) {
+				continue
+			}
+			out = append(out, Citation{
+				Source: n,
+				ID:     sources[n-1].ID,
+				Start:  match[0],
+				End:    match[1],
+			})
+		}
+	}
+	return out
+}
@@ -0,0 +1,248 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package audit records who changed which data or schema, when and where, so
+// that changes can be traced back to a principal and a request. Events are
+// written asynchronously to one or more sinks, e.g. for compliance evidence.
+package audit
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/tracing"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeDenied  = "denied"
+	OutcomeFailure = "failure"
+)
+
+const (
+	bufferSize    = 1024
+	batchSize     = 100
+	flushInterval = time.Second
+)
+
+// Event is a single audited operation on a resource
+type Event struct {
+	Time      time.Time `json:"time"`
+	RequestID string    `json:"requestId,omitempty"`
+	Node      string    `json:"node"`
+	User      string    `json:"user,omitempty"`
+	Groups    []string  `json:"groups,omitempty"`
+	APIKeyID  string    `json:"apiKeyId,omitempty"`
+	Anonymous bool      `json:"anonymous,omitempty"`
+	Action    string    `json:"action"`
+	Resource  string    `json:"resource"`
+	Outcome   string    `json:"outcome"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Sink persists or forwards events. Write is only called from a single
+// goroutine and must not retain the slice of events.
+type Sink interface {
+	Name() string
+	Write(events []Event) error
+	Close() error
+}
+
+// Logger collects events and writes them to the sinks in the background. A
+// nil Logger is valid and records nothing.
+type Logger struct {
+	node       string
+	sinks      []Sink
+	sampleRate float64
+	random     func() float64
+	now        func() time.Time
+	logger     logrus.FieldLogger
+
+	events    chan Event
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// New creates the sinks of the config and starts writing events
+func New(cfg Config, node string, logger logrus.FieldLogger) (*Logger, error) {
+	sinks, err := newSinks(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithSinks(sinks, cfg.SampleRate, node, logger), nil
+}
+
+// NewWithSinks starts writing events to the given sinks
+func NewWithSinks(sinks []Sink, sampleRate float64, node string,
+	logger logrus.FieldLogger,
+) *Logger {
+	l := &Logger{
+		node:       node,
+		sinks:      sinks,
+		sampleRate: sampleRate,
+		random:     rand.Float64,
+		now:        time.Now,
+		logger:     logger,
+		events:     make(chan Event, bufferSize),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	go l.run()
+	return l
+}
+
+// Record an operation of the principal on the given resources. err is the
+// outcome of the operation, authorization errors are recorded as denied.
+// Recording blocks if the sinks can not keep up, so events are not lost.
+func (l *Logger) Record(ctx context.Context, principal *models.Principal,
+	action string, err error, resources ...string,
+) {
+	if l == nil {
+		return
+	}
+	select {
+	case <-l.stop:
+		return
+	default:
+	}
+
+	event := Event{
+		Time:      l.now().UTC(),
+		RequestID: tracing.RequestID(ctx),
+		Node:      l.node,
+		Action:    action,
+		Outcome:   outcome(err),
+	}
+	if principal == nil {
+		event.Anonymous = true
+	} else {
+		event.User = principal.Username
+		event.Groups = principal.Groups
+		event.APIKeyID = principal.APIKeyID
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	for _, resource := range resources {
+		if !l.sampled(event.Outcome, resource) {
+			continue
+		}
+		event.Resource = resource
+		select {
+		case l.events <- event:
+		case <-l.stop:
+			return
+		}
+	}
+}
+
+// Close writes all pending events and closes the sinks
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+
+	l.closeOnce.Do(func() { close(l.stop) })
+	<-l.done
+
+	var errs []error
+	for _, sink := range l.sinks {
+		if err := sink.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sampled decides whether an event is recorded. Only successful data
+// mutations are sampled, they make up the bulk of all events.
+func (l *Logger) sampled(outcome, resource string) bool {
+	if outcome != OutcomeSuccess || l.sampleRate >= 1 {
+		return true
+	}
+	if strings.HasPrefix(resource, "schema/") {
+		return true
+	}
+	return l.random() < l.sampleRate
+}
+
+func (l *Logger) run() {
+	defer close(l.done)
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Event, 0, batchSize)
+	for {
+		select {
+		case event := <-l.events:
+			batch = append(batch, event)
+			if len(batch) >= batchSize {
+				batch = l.flush(batch)
+			}
+		case <-ticker.C:
+			batch = l.flush(batch)
+		case <-l.stop:
+			for {
+				select {
+				case event := <-l.events:
+					batch = append(batch, event)
+				default:
+					l.flush(batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+func (l *Logger) flush(batch []Event) []Event {
+	if len(batch) == 0 {
+		return batch
+	}
+
+	for _, sink := range l.sinks {
+		if err := sink.Write(batch); err != nil {
+			l.logger.WithField("action", "audit_log_write").
+				WithField("sink", sink.Name()).
+				WithField("events", len(batch)).
+				WithError(err).
+				Error("could not write audit events")
+		}
+	}
+	return batch[:0]
+}
+
+func outcome(err error) string {
+	if err == nil {
+		return OutcomeSuccess
+	}
+
+	var forbidden autherrs.Forbidden
+	if errors.As(err, &forbidden) {
+		return OutcomeDenied
+	}
+	// usecase errors such as objects.Error carry their status
+	var withStatus interface{ Forbidden() bool }
+	if errors.As(err, &withStatus) && withStatus.Forbidden() {
+		return OutcomeDenied
+	}
+	return OutcomeFailure
+}
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/tracing"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+type memorySink struct {
+	sync.Mutex
+	events []Event
+	closed bool
+}
+
+func (s *memorySink) Name() string {
+	return "memory"
+}
+
+func (s *memorySink) Write(events []Event) error {
+	s.Lock()
+	defer s.Unlock()
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	s.closed = true
+	return nil
+}
+
+func newTestLogger(sink Sink, sampleRate float64) *Logger {
+	logger, _ := test.NewNullLogger()
+	l := NewWithSinks([]Sink{sink}, sampleRate, "node-1", logger)
+	l.now = func() time.Time { return time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC) }
+	return l
+}
+
+func TestLogger_Record(t *testing.T) {
+	sink := &memorySink{}
+	l := newTestLogger(sink, 1)
+
+	ctx := tracing.WithRequestID(context.Background(), "request-1")
+	principal := &models.Principal{Username: "alice", Groups: []string{"ops"}, APIKeyID: "key-1"}
+	l.Record(ctx, principal, "create", nil, "data/collections/Article/tenants/*/objects/id-1")
+	l.Record(context.Background(), nil, "delete",
+		autherrs.NewForbidden(&models.Principal{}, "delete", "schema/collections/Article"),
+		"schema/collections/Article")
+	l.Record(ctx, principal, "update", fmt.Errorf("conflict"),
+		"schema/collections/Article/tenants/customer-a",
+		"schema/collections/Article/tenants/customer-b")
+	require.Nil(t, l.Close())
+
+	now := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, []Event{
+		{
+			Time: now, RequestID: "request-1", Node: "node-1",
+			User: "alice", Groups: []string{"ops"}, APIKeyID: "key-1",
+			Action: "create", Resource: "data/collections/Article/tenants/*/objects/id-1",
+			Outcome: OutcomeSuccess,
+		},
+		{
+			Time: now, Node: "node-1", Anonymous: true,
+			Action: "delete", Resource: "schema/collections/Article",
+			Outcome: OutcomeDenied,
+			Error:   "forbidden: user '' has insufficient permissions to delete schema/collections/Article",
+		},
+		{
+			Time: now, RequestID: "request-1", Node: "node-1",
+			User: "alice", Groups: []string{"ops"}, APIKeyID: "key-1",
+			Action: "update", Resource: "schema/collections/Article/tenants/customer-a",
+			Outcome: OutcomeFailure, Error: "conflict",
+		},
+		{
+			Time: now, RequestID: "request-1", Node: "node-1",
+			User: "alice", Groups: []string{"ops"}, APIKeyID: "key-1",
+			Action: "update", Resource: "schema/collections/Article/tenants/customer-b",
+			Outcome: OutcomeFailure, Error: "conflict",
+		},
+	}, sink.events)
+	assert.True(t, sink.closed)
+
+	t.Run("recording after close is a no-op", func(t *testing.T) {
+		l.Record(ctx, principal, "create", nil, "data/collections/Article/tenants/*/objects/id-2")
+		assert.Len(t, sink.events, 4)
+	})
+}
+
+func TestLogger_Sampling(t *testing.T) {
+	sink := &memorySink{}
+	l := newTestLogger(sink, 0.5)
+	random := 0.0
+	l.random = func() float64 {
+		random += 0.3
+		return random
+	}
+
+	for i := 0; i < 3; i++ {
+		l.Record(context.Background(), nil, "create", nil,
+			"data/collections/Article/tenants/*/objects/*")
+	}
+	l.Record(context.Background(), nil, "create", fmt.Errorf("invalid"),
+		"data/collections/Article/tenants/*/objects/*")
+	l.Record(context.Background(), nil, "create", nil, "schema/collections/Article")
+	require.Nil(t, l.Close())
+
+	require.Len(t, sink.events, 3)
+	assert.Equal(t, OutcomeSuccess, sink.events[0].Outcome, "only the first data event is sampled")
+	assert.Equal(t, OutcomeFailure, sink.events[1].Outcome, "failures are never sampled")
+	assert.Equal(t, "schema/collections/Article", sink.events[2].Resource,
+		"schema changes are never sampled")
+}
+
+func TestLogger_Nil(t *testing.T) {
+	var l *Logger
+	l.Record(context.Background(), nil, "create", nil, "schema/collections/Article")
+	assert.Nil(t, l.Close())
+}
+
+func TestConfig_Validate(t *testing.T) {
+	valid := Config{
+		Enabled:    true,
+		Sinks:      []string{SinkFile, SinkSyslog, SinkWebhook, SinkKafka},
+		SampleRate: 1,
+		File:       FileConfig{Path: "/var/log/weaviate/audit.log"},
+		Webhook:    WebhookConfig{URL: "https://audit.example.com/events"},
+		Kafka:      KafkaConfig{RESTProxyURL: "http://kafka-rest:8082", Topic: "audit"},
+	}
+	assert.Nil(t, valid.Validate())
+	assert.Nil(t, Config{}.Validate(), "disabled config is not validated")
+
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		err    string
+	}{
+		{"no sinks", func(c *Config) { c.Sinks = nil }, "at least one sink"},
+		{"unknown sink", func(c *Config) { c.Sinks = []string{"stdout"} }, "unknown sink"},
+		{"sample rate too low", func(c *Config) { c.SampleRate = 0 }, "sample rate"},
+		{"sample rate too high", func(c *Config) { c.SampleRate = 1.5 }, "sample rate"},
+		{"file without path", func(c *Config) { c.File.Path = "" }, "requires a path"},
+		{"syslog without address", func(c *Config) { c.Syslog.Network = "udp" }, "network and address"},
+		{"webhook without url", func(c *Config) { c.Webhook.URL = "" }, "url is required"},
+		{"webhook with invalid scheme", func(c *Config) { c.Webhook.URL = "ftp://audit" }, "scheme"},
+		{"kafka without topic", func(c *Config) { c.Kafka.Topic = "" }, "requires a topic"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := valid
+			cfg.Sinks = append([]string{}, valid.Sinks...)
+			test.modify(&cfg)
+			assert.ErrorContains(t, cfg.Validate(), test.err)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	SinkFile    = "file"
+	SinkSyslog  = "syslog"
+	SinkWebhook = "webhook"
+	SinkKafka   = "kafka"
+)
+
+// Config of the audit log. Every mutation of data or the schema is written
+// to all configured sinks.
+type Config struct {
+	Enabled bool     `json:"enabled" yaml:"enabled"`
+	Sinks   []string `json:"sinks" yaml:"sinks"`
+	// SampleRate is the fraction of successful data mutations which are
+	// recorded. Schema changes as well as denied and failed requests are
+	// always recorded.
+	SampleRate float64       `json:"sample_rate" yaml:"sample_rate"`
+	File       FileConfig    `json:"file" yaml:"file"`
+	Syslog     SyslogConfig  `json:"syslog" yaml:"syslog"`
+	Webhook    WebhookConfig `json:"webhook" yaml:"webhook"`
+	Kafka      KafkaConfig   `json:"kafka" yaml:"kafka"`
+}
+
+// FileConfig appends events as JSON lines to a local file
+type FileConfig struct {
+	Path string `json:"path" yaml:"path"`
+}
+
+// SyslogConfig sends events to a syslog daemon. Without a network and
+// address the local daemon is used.
+type SyslogConfig struct {
+	Network string `json:"network" yaml:"network"`
+	Address string `json:"address" yaml:"address"`
+	Tag     string `json:"tag" yaml:"tag"`
+}
+
+// WebhookConfig posts batches of events as a JSON array
+type WebhookConfig struct {
+	URL   string `json:"url" yaml:"url"`
+	Token string `json:"-" yaml:"-"`
+}
+
+// KafkaConfig publishes events to a topic through a Kafka REST proxy, so no
+// Kafka client is required inside Weaviate
+type KafkaConfig struct {
+	RESTProxyURL string `json:"rest_proxy_url" yaml:"rest_proxy_url"`
+	Topic        string `json:"topic" yaml:"topic"`
+}
+
+// Validate the audit log config, can be called from the central config
+// package
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if len(c.Sinks) == 0 {
+		return fmt.Errorf("audit_log: at least one sink is required")
+	}
+
+	if c.SampleRate <= 0 || c.SampleRate > 1 {
+		return fmt.Errorf("audit_log: sample rate must be greater than 0 and at most 1, got %v",
+			c.SampleRate)
+	}
+
+	for _, sink := range c.Sinks {
+		switch sink {
+		case SinkFile:
+			if c.File.Path == "" {
+				return fmt.Errorf("audit_log: file sink requires a path")
+			}
+		case SinkSyslog:
+			if (c.Syslog.Network == "") != (c.Syslog.Address == "") {
+				return fmt.Errorf("audit_log: syslog sink requires both network and address " +
+					"or neither of them")
+			}
+		case SinkWebhook:
+			if err := validateURL(c.Webhook.URL); err != nil {
+				return fmt.Errorf("audit_log: webhook sink: %w", err)
+			}
+		case SinkKafka:
+			if err := validateURL(c.Kafka.RESTProxyURL); err != nil {
+				return fmt.Errorf("audit_log: kafka sink: %w", err)
+			}
+			if c.Kafka.Topic == "" {
+				return fmt.Errorf("audit_log: kafka sink requires a topic")
+			}
+		default:
+			return fmt.Errorf("audit_log: unknown sink %q, possible values are: "+
+				"file, syslog, webhook, kafka", sink)
+		}
+	}
+
+	return nil
+}
+
+func validateURL(in string) error {
+	if in == "" {
+		return fmt.Errorf("url is required")
+	}
+	u, err := url.Parse(in)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid url %q: scheme must be http or https", in)
+	}
+	return nil
+}
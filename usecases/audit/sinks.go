@@ -0,0 +1,228 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/syslog"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const sinkTimeout = 10 * time.Second
+
+func newSinks(cfg Config) ([]Sink, error) {
+	sinks := make([]Sink, 0, len(cfg.Sinks))
+	for _, name := range cfg.Sinks {
+		var (
+			sink Sink
+			err  error
+		)
+		switch name {
+		case SinkFile:
+			sink, err = NewFileSink(cfg.File.Path)
+		case SinkSyslog:
+			sink, err = NewSyslogSink(cfg.Syslog)
+		case SinkWebhook:
+			sink = NewWebhookSink(cfg.Webhook)
+		case SinkKafka:
+			sink = NewKafkaSink(cfg.Kafka)
+		default:
+			err = fmt.Errorf("unknown sink %q", name)
+		}
+		if err != nil {
+			for _, created := range sinks {
+				created.Close()
+			}
+			return nil, fmt.Errorf("audit log: %w", err)
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
+// FileSink appends events as JSON lines, one event per line
+type FileSink struct {
+	file *os.File
+}
+
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log file: %w", err)
+	}
+	return &FileSink{file: file}, nil
+}
+
+func (s *FileSink) Name() string {
+	return SinkFile
+}
+
+func (s *FileSink) Write(events []Event) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, event := range events {
+		if err := enc.Encode(event); err != nil {
+			return fmt.Errorf("marshal audit event: %w", err)
+		}
+	}
+	if _, err := s.file.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("write audit log file: %w", err)
+	}
+	return nil
+}
+
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+// SyslogSink writes every event as a JSON message with the auth facility
+type SyslogSink struct {
+	writer *syslog.Writer
+}
+
+func NewSyslogSink(cfg SyslogConfig) (*SyslogSink, error) {
+	tag := cfg.Tag
+	if tag == "" {
+		tag = "weaviate"
+	}
+	writer, err := syslog.Dial(cfg.Network, cfg.Address, syslog.LOG_INFO|syslog.LOG_AUTH, tag)
+	if err != nil {
+		return nil, fmt.Errorf("connect to syslog: %w", err)
+	}
+	return &SyslogSink{writer: writer}, nil
+}
+
+func (s *SyslogSink) Name() string {
+	return SinkSyslog
+}
+
+func (s *SyslogSink) Write(events []Event) error {
+	for _, event := range events {
+		msg, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("marshal audit event: %w", err)
+		}
+		if err := s.writer.Info(string(msg)); err != nil {
+			return fmt.Errorf("write to syslog: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *SyslogSink) Close() error {
+	return s.writer.Close()
+}
+
+// WebhookSink posts every batch of events as a JSON array. If a token is
+// configured, it is sent as bearer token.
+type WebhookSink struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func NewWebhookSink(cfg WebhookConfig) *WebhookSink {
+	return &WebhookSink{
+		url:    cfg.URL,
+		token:  cfg.Token,
+		client: &http.Client{Timeout: sinkTimeout},
+	}
+}
+
+func (s *WebhookSink) Name() string {
+	return SinkWebhook
+}
+
+func (s *WebhookSink) Write(events []Event) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return fmt.Errorf("marshal audit events: %w", err)
+	}
+
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	if s.token != "" {
+		header.Set("Authorization", "Bearer "+s.token)
+	}
+	return post(s.client, s.url, header, body)
+}
+
+func (s *WebhookSink) Close() error {
+	return nil
+}
+
+// KafkaSink publishes events to a topic through the v2 API of a Kafka REST
+// proxy. The request id is used as record key, so all events of a request
+// end up in the same partition.
+type KafkaSink struct {
+	url    string
+	client *http.Client
+}
+
+type kafkaRecord struct {
+	Key   string `json:"key,omitempty"`
+	Value Event  `json:"value"`
+}
+
+func NewKafkaSink(cfg KafkaConfig) *KafkaSink {
+	return &KafkaSink{
+		url:    strings.TrimSuffix(cfg.RESTProxyURL, "/") + "/topics/" + cfg.Topic,
+		client: &http.Client{Timeout: sinkTimeout},
+	}
+}
+
+func (s *KafkaSink) Name() string {
+	return SinkKafka
+}
+
+func (s *KafkaSink) Write(events []Event) error {
+	records := make([]kafkaRecord, len(events))
+	for i, event := range events {
+		records[i] = kafkaRecord{Key: event.RequestID, Value: event}
+	}
+	body, err := json.Marshal(map[string]interface{}{"records": records})
+	if err != nil {
+		return fmt.Errorf("marshal audit events: %w", err)
+	}
+
+	header := http.Header{"Content-Type": []string{"application/vnd.kafka.json.v2+json"}}
+	return post(s.client, s.url, header, body)
+}
+
+func (s *KafkaSink) Close() error {
+	return nil
+}
+
+func post(client *http.Client, url string, header http.Header, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header = header
+
+	res, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send audit events: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("send audit events: status %d: %s", res.StatusCode, msg)
+	}
+	return nil
+}
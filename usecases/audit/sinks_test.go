@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEvents = []Event{
+	{
+		Time: time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC), RequestID: "request-1", Node: "node-1",
+		User: "alice", Action: "create", Resource: "schema/collections/Article", Outcome: OutcomeSuccess,
+	},
+	{
+		Time: time.Date(2023, 9, 1, 12, 0, 1, 0, time.UTC), Node: "node-1", Anonymous: true,
+		Action: "delete", Resource: "schema/collections/Article", Outcome: OutcomeDenied,
+	},
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	sink, err := NewFileSink(path)
+	require.Nil(t, err)
+	require.Nil(t, sink.Write(testEvents[:1]))
+	require.Nil(t, sink.Close())
+
+	// reopening appends to the existing log
+	sink, err = NewFileSink(path)
+	require.Nil(t, err)
+	require.Nil(t, sink.Write(testEvents[1:]))
+	require.Nil(t, sink.Close())
+
+	contents, err := os.ReadFile(path)
+	require.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	require.Len(t, lines, 2)
+	for i, line := range lines {
+		var event Event
+		require.Nil(t, json.Unmarshal([]byte(line), &event))
+		assert.Equal(t, testEvents[i], event)
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received []Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(WebhookConfig{URL: server.URL, Token: "secret"})
+	require.Nil(t, sink.Write(testEvents))
+	assert.Equal(t, testEvents, received)
+
+	t.Run("unsuccessful status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			io.WriteString(w, "try again later")
+		}))
+		defer server.Close()
+
+		sink := NewWebhookSink(WebhookConfig{URL: server.URL})
+		assert.EqualError(t, sink.Write(testEvents),
+			"send audit events: status 503: try again later")
+	})
+}
+
+func TestKafkaSink(t *testing.T) {
+	var received struct {
+		Records []kafkaRecord `json:"records"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/audit", r.URL.Path)
+		assert.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	sink := NewKafkaSink(KafkaConfig{RESTProxyURL: server.URL + "/", Topic: "audit"})
+	require.Nil(t, sink.Write(testEvents))
+	assert.Equal(t, []kafkaRecord{
+		{Key: "request-1", Value: testEvents[0]},
+		{Value: testEvents[1]},
+	}, received.Records)
+}
@@ -14,6 +14,7 @@ package apikey
 import (
 	"crypto/sha256"
 	"crypto/subtle"
+	stderrors "errors"
 	"fmt"
 
 	errors "github.com/go-openapi/errors"
@@ -24,6 +25,7 @@ import (
 type Client struct {
 	config     config.APIKey
 	keystorage [][sha256.Size]byte
+	managed    *KeyStore
 }
 
 func New(cfg config.Config) (*Client, error) {
@@ -40,6 +42,12 @@ func New(cfg config.Config) (*Client, error) {
 	return c, nil
 }
 
+// SetKeyStore enables authentication with keys which are managed at runtime,
+// in addition to the statically configured ones
+func (c *Client) SetKeyStore(store *KeyStore) {
+	c.managed = store
+}
+
 func (c *Client) parseKeys() {
 	c.keystorage = make([][sha256.Size]byte, len(c.config.AllowedKeys))
 	for i, rawKey := range c.config.AllowedKeys {
@@ -53,6 +61,12 @@ func (c *Client) validateConfig() error {
 		return nil
 	}
 
+	if c.config.ManagementEnabled && len(c.config.AllowedKeys) == 0 &&
+		len(c.config.Users) == 0 {
+		// all keys are managed at runtime
+		return nil
+	}
+
 	if len(c.config.AllowedKeys) < 1 {
 		return fmt.Errorf("need at least one valid allowed key")
 	}
@@ -87,7 +101,7 @@ func (c *Client) ValidateAndExtract(token string, scopes []string) (*models.Prin
 
 	tokenPos, ok := c.isTokenAllowed(token)
 	if !ok {
-		return nil, errors.New(401, "invalid api key, please provide a valid api key")
+		return c.validateManaged(token)
 	}
 
 	return &models.Principal{
@@ -115,3 +129,22 @@ func (c *Client) getUser(pos int) string {
 
 	return c.config.Users[pos]
 }
+
+func (c *Client) validateManaged(token string) (*models.Principal, error) {
+	if c.managed == nil {
+		return nil, errors.New(401, "invalid api key, please provide a valid api key")
+	}
+
+	key, err := c.managed.Validate(token)
+	if err != nil {
+		if stderrors.Is(err, ErrKeyExpired) {
+			return nil, errors.New(401, "api key has expired, please provide a valid api key")
+		}
+		return nil, errors.New(401, "invalid api key, please provide a valid api key")
+	}
+
+	return &models.Principal{
+		Username: key.User,
+		APIKeyID: key.ID,
+	}, nil
+}
@@ -0,0 +1,314 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ErrKeyNotFound = errors.New("api key not found")
+	ErrKeyExpired  = errors.New("api key expired")
+
+	errNoMetadataStore = errors.New("api keys can not be changed before the schema is loaded")
+)
+
+const (
+	// keysPrefix is the prefix of the metadata keys of managed keys
+	keysPrefix = "apikeys/"
+	// keyPrefix makes managed keys recognizable, e.g. for secret scanners
+	keyPrefix = "wvk"
+)
+
+// Verbs which can be granted to a managed key. They are the verbs passed to
+// the authorizer.
+var validVerbs = map[string]struct{}{
+	"get": {}, "list": {}, "head": {}, "validate": {},
+	"create": {}, "update": {}, "delete": {},
+}
+
+// Scopes limit what a managed key can be used for, on top of the permissions
+// of the user the key belongs to. Empty lists are not restricted. Classes and
+// tenants are patterns following the syntax of path.Match.
+type Scopes struct {
+	Classes []string `json:"classes,omitempty"`
+	Tenants []string `json:"tenants,omitempty"`
+	Verbs   []string `json:"verbs,omitempty"`
+}
+
+// Key is the metadata of a managed key. The key itself is never stored, only
+// its hash.
+type Key struct {
+	ID        string     `json:"id"`
+	User      string     `json:"user"`
+	Scopes    Scopes     `json:"scopes"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Hash      string     `json:"hash,omitempty"`
+}
+
+// Expired checks whether the key can no longer be used
+func (k Key) Expired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// CreateRequest describes a new managed key
+type CreateRequest struct {
+	User      string     `json:"user"`
+	Scopes    Scopes     `json:"scopes"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+func (r CreateRequest) validate(now time.Time) error {
+	if r.User == "" {
+		return fmt.Errorf("user is required")
+	}
+	if r.ExpiresAt != nil && !r.ExpiresAt.After(now) {
+		return fmt.Errorf("expiresAt must be in the future")
+	}
+	for _, verb := range r.Scopes.Verbs {
+		if _, ok := validVerbs[verb]; !ok {
+			return fmt.Errorf("invalid verb %q, possible values are: "+
+				"get, list, head, validate, create, update, delete", verb)
+		}
+	}
+	for _, pattern := range append(append([]string{}, r.Scopes.Classes...), r.Scopes.Tenants...) {
+		if _, err := path.Match(pattern, ""); err != nil || pattern == "" {
+			return fmt.Errorf("invalid pattern %q", pattern)
+		}
+	}
+	return nil
+}
+
+// metadataStore persists the keys and replicates them to all nodes in the
+// cluster. It is implemented by the schema manager.
+type metadataStore interface {
+	PutMetadata(ctx context.Context, key string, value []byte) error
+	DeleteMetadata(ctx context.Context, key string) error
+	Metadata(prefix string) map[string][]byte
+	RegisterMetadataCallback(prefix string, callback func())
+}
+
+// KeyStore manages keys created at runtime. Keys are stored in the metadata
+// of the schema, so they survive restarts, are valid on every node and can
+// be rotated without changing the configuration.
+type KeyStore struct {
+	sync.RWMutex
+	// writeLock serializes the changes made on this node
+	writeLock sync.Mutex
+	metadata  metadataStore
+	keys      map[string]Key
+	byHash    map[string]string
+	now       func() time.Time
+	logger    logrus.FieldLogger
+}
+
+func NewKeyStore(logger logrus.FieldLogger) *KeyStore {
+	return &KeyStore{
+		keys:   map[string]Key{},
+		byHash: map[string]string{},
+		now:    time.Now,
+		logger: logger,
+	}
+}
+
+// SetMetadataStore loads the keys from the metadata store and keeps them up
+// to date. Keys can not be created or revoked before it is set.
+func (s *KeyStore) SetMetadataStore(metadata metadataStore) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	s.metadata = metadata
+	metadata.RegisterMetadataCallback(keysPrefix, func() {
+		if err := s.load(); err != nil {
+			s.logger.WithField("action", "apikey_load_keys").WithError(err).
+				Error("api keys could not be loaded")
+		}
+	})
+	return s.load()
+}
+
+func (s *KeyStore) load() error {
+	keys := map[string]Key{}
+	byHash := map[string]string{}
+	for name, value := range s.metadata.Metadata(keysPrefix) {
+		var key Key
+		if err := json.Unmarshal(value, &key); err != nil {
+			return fmt.Errorf("parse api key %q: %w", strings.TrimPrefix(name, keysPrefix), err)
+		}
+		keys[key.ID] = key
+		byHash[key.Hash] = key.ID
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.keys = keys
+	s.byHash = byHash
+	return nil
+}
+
+// Create a new key. The returned secret is the only time the plain key is
+// available, it can not be recovered afterwards.
+func (s *KeyStore) Create(ctx context.Context, req CreateRequest) (Key, string, error) {
+	now := s.now().UTC()
+	if err := req.validate(now); err != nil {
+		return Key{}, "", err
+	}
+
+	id, err := randomString(12)
+	if err != nil {
+		return Key{}, "", err
+	}
+	secret, err := randomString(32)
+	if err != nil {
+		return Key{}, "", err
+	}
+	token := fmt.Sprintf("%s_%s_%s", keyPrefix, id, secret)
+
+	key := Key{
+		ID:        id,
+		User:      req.User,
+		Scopes:    req.Scopes,
+		CreatedAt: now,
+		ExpiresAt: req.ExpiresAt,
+		Hash:      hashToken(token),
+	}
+
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	if s.metadata == nil {
+		return Key{}, "", errNoMetadataStore
+	}
+	value, err := json.Marshal(key)
+	if err != nil {
+		return Key{}, "", fmt.Errorf("marshal api key: %w", err)
+	}
+	if err := s.metadata.PutMetadata(ctx, keysPrefix+key.ID, value); err != nil {
+		return Key{}, "", err
+	}
+
+	return key.withoutHash(), token, nil
+}
+
+// Revoke a key, it can no longer be used immediately
+func (s *KeyStore) Revoke(ctx context.Context, id string) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	if _, err := s.Get(id); err != nil {
+		return err
+	}
+	if s.metadata == nil {
+		return errNoMetadataStore
+	}
+	return s.metadata.DeleteMetadata(ctx, keysPrefix+id)
+}
+
+// Get the metadata of a key
+func (s *KeyStore) Get(id string) (Key, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	key, ok := s.keys[id]
+	if !ok {
+		return Key{}, fmt.Errorf("%w: %q", ErrKeyNotFound, id)
+	}
+	return key.withoutHash(), nil
+}
+
+// List the metadata of all keys sorted by creation time
+func (s *KeyStore) List() []Key {
+	s.RLock()
+	defer s.RUnlock()
+
+	keys := s.sortedKeys()
+	for i := range keys {
+		keys[i] = keys[i].withoutHash()
+	}
+	return keys
+}
+
+// Active returns the key with the given id if it has neither been revoked
+// nor expired
+func (s *KeyStore) Active(id string) (Key, error) {
+	key, err := s.Get(id)
+	if err != nil {
+		return Key{}, err
+	}
+	if key.Expired(s.now()) {
+		return Key{}, fmt.Errorf("%w: %q", ErrKeyExpired, id)
+	}
+	return key, nil
+}
+
+// Validate looks up the key for the given token
+func (s *KeyStore) Validate(token string) (Key, error) {
+	if !strings.HasPrefix(token, keyPrefix+"_") {
+		return Key{}, ErrKeyNotFound
+	}
+
+	s.RLock()
+	id, ok := s.byHash[hashToken(token)]
+	s.RUnlock()
+	if !ok {
+		return Key{}, ErrKeyNotFound
+	}
+
+	return s.Active(id)
+}
+
+func (s *KeyStore) sortedKeys() []Key {
+	keys := make([]Key, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].CreatedAt.Equal(keys[j].CreatedAt) {
+			return keys[i].ID < keys[j].ID
+		}
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+	return keys
+}
+
+func (k Key) withoutHash() Key {
+	k.Hash = ""
+	return k
+}
+
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate api key: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikey
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_KeyStore(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	metadata := newFakeMetadata()
+	store := NewKeyStore(logger)
+
+	t.Run("keys can not be created before the metadata store is set", func(t *testing.T) {
+		_, _, err := store.Create(ctx, CreateRequest{User: "ingest-service"})
+		assert.ErrorIs(t, err, errNoMetadataStore)
+	})
+
+	require.Nil(t, store.SetMetadataStore(metadata))
+
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	key, token, err := store.Create(ctx, CreateRequest{
+		User:      "ingest-service",
+		Scopes:    Scopes{Classes: []string{"Article"}, Verbs: []string{"create", "update"}},
+		ExpiresAt: &expiresAt,
+	})
+	require.Nil(t, err)
+
+	t.Run("the key is only returned once and stored hashed", func(t *testing.T) {
+		assert.True(t, strings.HasPrefix(token, "wvk_"))
+		assert.Empty(t, key.Hash)
+
+		contents := metadata.values[keysPrefix+key.ID]
+		require.NotNil(t, contents)
+		assert.NotContains(t, string(contents), token)
+		assert.Contains(t, string(contents), hashToken(token))
+	})
+
+	t.Run("validate the key", func(t *testing.T) {
+		validated, err := store.Validate(token)
+		require.Nil(t, err)
+		assert.Equal(t, key, validated)
+
+		_, err = store.Validate(token + "x")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+		_, err = store.Validate("some-static-key")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("keys are loaded from the metadata store", func(t *testing.T) {
+		restarted := NewKeyStore(logger)
+		require.Nil(t, restarted.SetMetadataStore(metadata))
+		assert.Equal(t, store.List(), restarted.List())
+
+		_, err := restarted.Validate(token)
+		assert.Nil(t, err)
+	})
+
+	t.Run("expired keys are rejected", func(t *testing.T) {
+		store.now = func() time.Time { return expiresAt.Add(time.Second) }
+		defer func() { store.now = time.Now }()
+
+		_, err := store.Validate(token)
+		assert.ErrorIs(t, err, ErrKeyExpired)
+		_, err = store.Active(key.ID)
+		assert.ErrorIs(t, err, ErrKeyExpired)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour)
+		for _, req := range []CreateRequest{
+			{},
+			{User: "someone", ExpiresAt: &past},
+			{User: "someone", Scopes: Scopes{Verbs: []string{"everything"}}},
+			{User: "someone", Scopes: Scopes{Classes: []string{"["}}},
+		} {
+			_, _, err := store.Create(ctx, req)
+			assert.NotNil(t, err)
+		}
+		assert.Len(t, store.List(), 1)
+	})
+
+	t.Run("revoke the key on another node", func(t *testing.T) {
+		other := NewKeyStore(logger)
+		require.Nil(t, other.SetMetadataStore(metadata))
+		require.Nil(t, other.Revoke(ctx, key.ID))
+		assert.ErrorIs(t, store.Revoke(ctx, key.ID), ErrKeyNotFound)
+
+		_, err := store.Validate(token)
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+		assert.Len(t, store.List(), 0)
+	})
+}
+
+func Test_APIKeyClient_ManagedKeys(t *testing.T) {
+	c, err := New(config.Config{Authentication: config.Authentication{
+		APIKey: config.APIKey{Enabled: true, ManagementEnabled: true},
+	}})
+	require.Nil(t, err, "static keys are optional with key management")
+
+	logger, _ := test.NewNullLogger()
+	store := NewKeyStore(logger)
+	require.Nil(t, store.SetMetadataStore(newFakeMetadata()))
+	c.SetKeyStore(store)
+
+	key, token, err := store.Create(context.Background(), CreateRequest{User: "ingest-service"})
+	require.Nil(t, err)
+
+	principal, err := c.ValidateAndExtract(token, nil)
+	require.Nil(t, err)
+	assert.Equal(t, "ingest-service", principal.Username)
+	assert.Equal(t, key.ID, principal.APIKeyID)
+
+	require.Nil(t, store.Revoke(context.Background(), key.ID))
+	_, err = c.ValidateAndExtract(token, nil)
+	assert.NotNil(t, err)
+}
@@ -120,9 +120,16 @@ func (c *Client) ValidateAndExtract(token string, scopes []string) (*models.Prin
 
 	groups := c.extractGroups(claims)
 
+	tenant, err := c.extractTenant(claims)
+	if err != nil {
+		return nil, errors.New(401, fmt.Sprintf("oidc: %v", err))
+	}
+
 	return &models.Principal{
 		Username: username,
 		Groups:   groups,
+		Roles:    c.extractRoles(claims),
+		Tenant:   tenant,
 	}, nil
 }
 
@@ -173,3 +180,61 @@ func (c *Client) extractGroups(claims map[string]interface{}) []string {
 
 	return groups
 }
+
+// extractRoles evaluates the configured role mappings. Like groups, claims
+// used in mappings are optional.
+func (c *Client) extractRoles(claims map[string]interface{}) []string {
+	var roles []string
+	seen := map[string]struct{}{}
+	for _, mapping := range c.config.RoleMappings {
+		claim := mapping.Claim
+		if claim == "" {
+			claim = c.config.GroupsClaim
+		}
+		if !claimContains(claims[claim], mapping.Value) {
+			continue
+		}
+		for _, role := range mapping.Roles {
+			if _, ok := seen[role]; !ok {
+				seen[role] = struct{}{}
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	return roles
+}
+
+// extractTenant is required if a tenant claim is configured. Otherwise a
+// token without the claim would not be restricted to any tenant.
+func (c *Client) extractTenant(claims map[string]interface{}) (string, error) {
+	if c.config.TenantClaim == "" {
+		return "", nil
+	}
+
+	tenantUntyped, ok := claims[c.config.TenantClaim]
+	if !ok {
+		return "", fmt.Errorf("token doesn't contain required claim '%s'", c.config.TenantClaim)
+	}
+
+	tenant, ok := tenantUntyped.(string)
+	if !ok || tenant == "" {
+		return "", fmt.Errorf("claim '%s' is not a non-empty string", c.config.TenantClaim)
+	}
+
+	return tenant, nil
+}
+
+func claimContains(claim interface{}, value string) bool {
+	switch typed := claim.(type) {
+	case string:
+		return typed == value
+	case []interface{}:
+		for _, untyped := range typed {
+			if str, ok := untyped.(string); ok && str == value {
+				return true
+			}
+		}
+	}
+	return false
+}
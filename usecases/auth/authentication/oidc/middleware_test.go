@@ -58,8 +58,10 @@ func Test_Middleware_IncompleteConfiguration(t *testing.T) {
 
 type claims struct {
 	jwt.StandardClaims
-	Email  string   `json:"email"`
-	Groups []string `json:"groups"`
+	Email      string   `json:"email"`
+	Groups     []string `json:"groups"`
+	Department string   `json:"department,omitempty"`
+	Tenant     string   `json:"tenant,omitempty"`
 }
 
 func Test_Middleware_WithValidToken(t *testing.T) {
@@ -140,6 +142,69 @@ func Test_Middleware_WithValidToken(t *testing.T) {
 		assert.Equal(t, "best-user", principal.Username)
 		assert.Equal(t, []string{"group1", "group2"}, principal.Groups)
 	})
+
+	t.Run("with role mappings", func(t *testing.T) {
+		server := newOIDCServer(t)
+		defer server.Close()
+
+		cfg := config.Config{
+			Authentication: config.Authentication{
+				OIDC: config.OIDC{
+					Enabled:       true,
+					Issuer:        server.URL,
+					ClientID:      "best_client",
+					UsernameClaim: "sub",
+					GroupsClaim:   "groups",
+					RoleMappings: []config.OIDCRoleMapping{
+						{Value: "group1", Roles: []string{"reader"}},
+						{Value: "group3", Roles: []string{"admin"}},
+						{Claim: "department", Value: "sales", Roles: []string{"sales", "reader"}},
+					},
+				},
+			},
+		}
+
+		token := tokenWithClaims(t, "best-user", server.URL, "best_client", claims{
+			Groups:     []string{"group1", "group2"},
+			Department: "sales",
+		})
+		client, err := New(cfg)
+		require.Nil(t, err)
+
+		principal, err := client.ValidateAndExtract(token, []string{})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"reader", "sales"}, principal.Roles)
+		assert.Empty(t, principal.Tenant)
+	})
+
+	t.Run("with tenant claim", func(t *testing.T) {
+		server := newOIDCServer(t)
+		defer server.Close()
+
+		cfg := config.Config{
+			Authentication: config.Authentication{
+				OIDC: config.OIDC{
+					Enabled:       true,
+					Issuer:        server.URL,
+					ClientID:      "best_client",
+					UsernameClaim: "sub",
+					TenantClaim:   "tenant",
+				},
+			},
+		}
+
+		client, err := New(cfg)
+		require.Nil(t, err)
+
+		token := tokenWithClaims(t, "best-user", server.URL, "best_client", claims{Tenant: "customer-a"})
+		principal, err := client.ValidateAndExtract(token, []string{})
+		require.Nil(t, err)
+		assert.Equal(t, "customer-a", principal.Tenant)
+
+		token = tokenWithClaims(t, "best-user", server.URL, "best_client", claims{})
+		_, err = client.ValidateAndExtract(token, []string{})
+		assert.ErrorContains(t, err, "doesn't contain required claim 'tenant'")
+	})
 }
 
 func token(t *testing.T, subject string, issuer string, aud string) string {
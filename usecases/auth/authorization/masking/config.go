@@ -0,0 +1,20 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package masking
+
+// Config lists the subjects which hold the sensitive data reader role. Only
+// these subjects can read the values of properties marked as sensitive, for
+// everyone else those values are redacted from responses.
+type Config struct {
+	Users  []string `json:"users" yaml:"users"`
+	Groups []string `json:"groups" yaml:"groups"`
+}
@@ -0,0 +1,223 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package masking redacts the values of sensitive properties from responses
+// for principals which do not hold the sensitive data reader role, and
+// rejects their requests which would reveal these values otherwise.
+package masking
+
+import (
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+type schemaGetter interface {
+	GetSchemaSkipAuth() schema.Schema
+}
+
+// Masker decides whether a principal may read sensitive properties and
+// removes them from responses otherwise. A nil Masker never redacts
+// anything.
+type Masker struct {
+	enabled bool
+	users   map[string]struct{}
+	groups  map[string]struct{}
+}
+
+// New Masker. Masking only takes place if authorization is enabled, without
+// authorization every subject is allowed to do everything, so there is
+// nobody to hide sensitive data from.
+func New(authorizationEnabled bool, cfg Config) *Masker {
+	m := &Masker{
+		enabled: authorizationEnabled,
+		users:   make(map[string]struct{}, len(cfg.Users)),
+		groups:  make(map[string]struct{}, len(cfg.Groups)),
+	}
+	for _, user := range cfg.Users {
+		m.users[user] = struct{}{}
+	}
+	for _, group := range cfg.Groups {
+		m.groups[group] = struct{}{}
+	}
+	return m
+}
+
+// CanReadSensitive checks whether the principal holds the sensitive data
+// reader role
+func (m *Masker) CanReadSensitive(principal *models.Principal) bool {
+	if m == nil || !m.enabled {
+		return true
+	}
+	if principal == nil {
+		return false
+	}
+
+	if _, ok := m.users[principal.Username]; ok {
+		return true
+	}
+	for _, group := range principal.Groups {
+		if _, ok := m.groups[group]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Masked checks whether the property of the class is sensitive and
+// redacted for the principal
+func (m *Masker) Masked(principal *models.Principal, schemaGetter schemaGetter,
+	className, propName string,
+) bool {
+	if m.CanReadSensitive(principal) {
+		return false
+	}
+
+	return isSensitive(schemaGetter.GetSchemaSkipAuth(), className, propName)
+}
+
+// Restricted checks whether the values of prop may only be read by the
+// principals holding the sensitive data reader role. Encrypted properties
+// are restricted as well, the shards decrypt them for every reader.
+func Restricted(prop *models.Property) bool {
+	return prop.Sensitive || prop.Encrypted
+}
+
+func isSensitive(sch schema.Schema, className, propName string) bool {
+	class := sch.GetClass(schema.ClassName(className))
+	if class == nil {
+		return false
+	}
+	// filters on the length of a property are given as len(name)
+	if strings.HasPrefix(propName, "len(") && strings.HasSuffix(propName, ")") {
+		propName = propName[len("len(") : len(propName)-1]
+	}
+	for _, prop := range class.Properties {
+		if prop.Name == propName {
+			return Restricted(prop)
+		}
+	}
+	return false
+}
+
+// MaskedCopy returns obj without the sensitive properties the principal is
+// not allowed to see. Unlike MaskObjects it leaves obj untouched, so that
+// objects which are still in use, such as the object just written, can be
+// echoed in responses.
+func (m *Masker) MaskedCopy(principal *models.Principal, schemaGetter schemaGetter,
+	obj *models.Object,
+) *models.Object {
+	if obj == nil || m.CanReadSensitive(principal) {
+		return obj
+	}
+	props, ok := obj.Properties.(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	masked := *obj
+	copied := make(map[string]interface{}, len(props))
+	for name, value := range props {
+		copied[name] = value
+	}
+	maskProperties(schemaGetter.GetSchemaSkipAuth(), obj.Class, copied)
+	masked.Properties = copied
+	return &masked
+}
+
+// MaskObjects removes the sensitive properties from all objects the
+// principal is not allowed to see in full
+func (m *Masker) MaskObjects(principal *models.Principal, schemaGetter schemaGetter,
+	objects ...*models.Object,
+) {
+	if m.CanReadSensitive(principal) {
+		return
+	}
+
+	sch := schemaGetter.GetSchemaSkipAuth()
+	for _, obj := range objects {
+		if obj == nil {
+			continue
+		}
+		if props, ok := obj.Properties.(map[string]interface{}); ok {
+			maskProperties(sch, obj.Class, props)
+		}
+	}
+}
+
+// MaskGetResults removes the sensitive properties from the results of a
+// GraphQL Get query, including the properties of resolved references
+func (m *Masker) MaskGetResults(principal *models.Principal, schemaGetter schemaGetter,
+	className string, results []interface{},
+) {
+	if m.CanReadSensitive(principal) {
+		return
+	}
+
+	sch := schemaGetter.GetSchemaSkipAuth()
+	for _, res := range results {
+		if props, ok := res.(map[string]interface{}); ok {
+			maskProperties(sch, className, props)
+		}
+	}
+}
+
+func maskProperties(sch schema.Schema, className string, props map[string]interface{}) {
+	class := sch.GetClass(schema.ClassName(className))
+	if class == nil {
+		return
+	}
+
+	sensitive := map[string]struct{}{}
+	for _, prop := range class.Properties {
+		if Restricted(prop) {
+			sensitive[prop.Name] = struct{}{}
+			delete(props, prop.Name)
+			continue
+		}
+
+		if !schema.IsRefDataType(prop.DataType) {
+			continue
+		}
+		refs, ok := props[prop.Name].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, ref := range refs {
+			if localRef, ok := ref.(search.LocalRef); ok {
+				maskProperties(sch, localRef.Class, localRef.Fields)
+			}
+		}
+	}
+
+	if addl, ok := props["_additional"].(map[string]interface{}); ok && len(sensitive) > 0 {
+		if highlights, ok := addl["highlights"].([]additional.Highlight); ok {
+			addl["highlights"] = maskHighlights(sensitive, highlights)
+		}
+	}
+}
+
+// maskHighlights removes the highlights of sensitive properties, their
+// snippets are excerpts of the redacted values
+func maskHighlights(sensitive map[string]struct{},
+	highlights []additional.Highlight,
+) []additional.Highlight {
+	out := make([]additional.Highlight, 0, len(highlights))
+	for _, highlight := range highlights {
+		if _, ok := sensitive[highlight.Property]; !ok {
+			out = append(out, highlight)
+		}
+	}
+	return out
+}
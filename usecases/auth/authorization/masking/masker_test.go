@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package masking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+type fakeSchemaGetter struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
+func testSchema() *fakeSchemaGetter {
+	return &fakeSchemaGetter{schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Patient",
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+						{Name: "ssn", DataType: schema.DataTypeText.PropString(), Sensitive: true},
+						{Name: "treatedBy", DataType: []string{"Doctor"}},
+					},
+				},
+				{
+					Class: "Doctor",
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+						{Name: "phone", DataType: schema.DataTypeText.PropString(), Sensitive: true},
+					},
+				},
+			},
+		},
+	}}
+}
+
+func TestMasker_CanReadSensitive(t *testing.T) {
+	cfg := Config{Users: []string{"alice"}, Groups: []string{"compliance"}}
+
+	t.Run("without authorization everyone can read", func(t *testing.T) {
+		m := New(false, cfg)
+		assert.True(t, m.CanReadSensitive(nil))
+		assert.True(t, m.CanReadSensitive(&models.Principal{Username: "bob"}))
+	})
+
+	t.Run("nil masker allows everything", func(t *testing.T) {
+		var m *Masker
+		assert.True(t, m.CanReadSensitive(&models.Principal{Username: "bob"}))
+	})
+
+	t.Run("with authorization", func(t *testing.T) {
+		m := New(true, cfg)
+		assert.False(t, m.CanReadSensitive(nil))
+		assert.False(t, m.CanReadSensitive(&models.Principal{Username: "bob"}))
+		assert.True(t, m.CanReadSensitive(&models.Principal{Username: "alice"}))
+		assert.True(t, m.CanReadSensitive(&models.Principal{
+			Username: "bob", Groups: []string{"staff", "compliance"},
+		}))
+	})
+}
+
+func TestMasker_Masked(t *testing.T) {
+	m := New(true, Config{Users: []string{"alice"}})
+	sch := testSchema()
+	bob := &models.Principal{Username: "bob"}
+
+	assert.True(t, m.Masked(bob, sch, "Patient", "ssn"))
+	assert.True(t, m.Masked(nil, sch, "Patient", "ssn"))
+	assert.False(t, m.Masked(bob, sch, "Patient", "name"))
+	assert.False(t, m.Masked(bob, sch, "Patient", "missing"))
+	assert.False(t, m.Masked(bob, sch, "Missing", "ssn"))
+	assert.False(t, m.Masked(&models.Principal{Username: "alice"}, sch, "Patient", "ssn"))
+}
+
+func TestMasker_MaskObjects(t *testing.T) {
+	m := New(true, Config{Users: []string{"alice"}})
+
+	newObj := func() *models.Object {
+		return &models.Object{
+			Class: "Patient",
+			Properties: map[string]interface{}{
+				"name": "John Doe",
+				"ssn":  "123-45-6789",
+			},
+		}
+	}
+
+	t.Run("principal without role", func(t *testing.T) {
+		obj := newObj()
+		m.MaskObjects(&models.Principal{Username: "bob"}, testSchema(), obj, nil)
+		assert.Equal(t, map[string]interface{}{"name": "John Doe"}, obj.Properties)
+	})
+
+	t.Run("principal with role", func(t *testing.T) {
+		obj := newObj()
+		m.MaskObjects(&models.Principal{Username: "alice"}, testSchema(), obj)
+		assert.Equal(t, newObj().Properties, obj.Properties)
+	})
+}
+
+func TestMasker_MaskGetResults(t *testing.T) {
+	m := New(true, Config{Users: []string{"alice"}})
+
+	newResults := func() []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"name": "John Doe",
+				"ssn":  "123-45-6789",
+				"treatedBy": []interface{}{
+					search.LocalRef{
+						Class: "Doctor",
+						Fields: map[string]interface{}{
+							"name":  "Dr. Who",
+							"phone": "555-1234",
+						},
+					},
+				},
+				"_additional": map[string]interface{}{
+					"id": "some-id",
+					"highlights": []additional.Highlight{
+						{Property: "name", Snippet: "John Doe"},
+						{Property: "ssn", Snippet: "123-45-6789"},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("principal without role", func(t *testing.T) {
+		res := newResults()
+		m.MaskGetResults(&models.Principal{Username: "bob"}, testSchema(), "Patient", res)
+
+		expected := []interface{}{
+			map[string]interface{}{
+				"name": "John Doe",
+				"treatedBy": []interface{}{
+					search.LocalRef{
+						Class:  "Doctor",
+						Fields: map[string]interface{}{"name": "Dr. Who"},
+					},
+				},
+				"_additional": map[string]interface{}{
+					"id": "some-id",
+					"highlights": []additional.Highlight{
+						{Property: "name", Snippet: "John Doe"},
+					},
+				},
+			},
+		}
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("principal with role", func(t *testing.T) {
+		res := newResults()
+		m.MaskGetResults(&models.Principal{Username: "alice"}, testSchema(), "Patient", res)
+		assert.Equal(t, newResults(), res)
+	})
+}
+
+func TestMasker_EncryptedPropertiesAreRestricted(t *testing.T) {
+	m := New(true, Config{Users: []string{"alice"}})
+	sch := testSchema()
+	patient := sch.schema.GetClass("Patient")
+	patient.Properties = append(patient.Properties, &models.Property{
+		Name: "diagnosis", DataType: schema.DataTypeText.PropString(), Encrypted: true,
+	})
+
+	assert.True(t, m.Masked(&models.Principal{Username: "bob"}, sch, "Patient", "diagnosis"))
+	assert.False(t, m.Masked(&models.Principal{Username: "alice"}, sch, "Patient", "diagnosis"))
+
+	obj := &models.Object{
+		Class:      "Patient",
+		Properties: map[string]interface{}{"name": "John Doe", "diagnosis": "flu"},
+	}
+	m.MaskObjects(&models.Principal{Username: "bob"}, sch, obj)
+	assert.Equal(t, map[string]interface{}{"name": "John Doe"}, obj.Properties)
+}
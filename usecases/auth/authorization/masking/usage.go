@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package masking
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// ErrSensitive is returned if a principal, which may not read a sensitive
+// property, uses it in a way that would reveal its values. Filtering or
+// sorting by a redacted property could otherwise be used to probe them.
+type ErrSensitive struct {
+	Class    string
+	Property string
+	// Use describes how the property was used, e.g. "in filters"
+	Use string
+}
+
+func (e ErrSensitive) Error() string {
+	return fmt.Sprintf("property '%s' of class '%s' is sensitive and can not be used %s",
+		e.Property, e.Class, e.Use)
+}
+
+// CheckFilter returns ErrSensitive if the filter, including the paths of
+// filters on references, uses a property the principal may not read
+func (m *Masker) CheckFilter(principal *models.Principal, schemaGetter schemaGetter,
+	filter *filters.LocalFilter,
+) error {
+	if filter == nil || filter.Root == nil || m.CanReadSensitive(principal) {
+		return nil
+	}
+	return checkClause(schemaGetter.GetSchemaSkipAuth(), filter.Root)
+}
+
+func checkClause(sch schema.Schema, clause *filters.Clause) error {
+	for path := clause.On; path != nil; path = path.Child {
+		if isSensitive(sch, path.Class.String(), path.Property.String()) {
+			return ErrSensitive{
+				Class: path.Class.String(), Property: path.Property.String(), Use: "in filters",
+			}
+		}
+	}
+	for i := range clause.Operands {
+		if err := checkClause(sch, &clause.Operands[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckProperties returns ErrSensitive if one of the properties of the class
+// is sensitive and the principal may not read it. use describes how the
+// properties are used and is part of the error.
+func (m *Masker) CheckProperties(principal *models.Principal, schemaGetter schemaGetter,
+	className, use string, propNames ...string,
+) error {
+	if m.CanReadSensitive(principal) {
+		return nil
+	}
+
+	sch := schemaGetter.GetSchemaSkipAuth()
+	for _, name := range propNames {
+		if isSensitive(sch, className, name) {
+			return ErrSensitive{Class: className, Property: name, Use: use}
+		}
+	}
+	return nil
+}
+
+// CheckModuleAdditional returns ErrSensitive if a module additional property,
+// such as _additional { generate }, is requested for a class with properties
+// the principal may not read. The modules see the results before they are
+// masked, so their output could contain the redacted values.
+func (m *Masker) CheckModuleAdditional(principal *models.Principal, schemaGetter schemaGetter,
+	className string, moduleParams map[string]interface{},
+) error {
+	if len(moduleParams) == 0 || m.CanReadSensitive(principal) {
+		return nil
+	}
+
+	sch := schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(className))
+	if class == nil {
+		return nil
+	}
+	for _, prop := range class.Properties {
+		if !Restricted(prop) {
+			continue
+		}
+		names := make([]string, 0, len(moduleParams))
+		for name := range moduleParams {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return ErrSensitive{
+			Class: className, Property: prop.Name,
+			Use: fmt.Sprintf("by _additional { %s }", strings.Join(names, " ")),
+		}
+	}
+	return nil
+}
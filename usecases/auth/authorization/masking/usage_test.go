@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package masking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestMasker_CheckFilter(t *testing.T) {
+	m := New(true, Config{Users: []string{"alice"}})
+	bob := &models.Principal{Username: "bob"}
+	byPath := func(path *filters.Path) *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{{Operator: filters.OperatorEqual, On: path}},
+		}}
+	}
+
+	t.Run("property of the class", func(t *testing.T) {
+		err := m.CheckFilter(bob, testSchema(), byPath(&filters.Path{Class: "Patient", Property: "ssn"}))
+		assert.Equal(t, ErrSensitive{Class: "Patient", Property: "ssn", Use: "in filters"}, err)
+	})
+
+	t.Run("property of a referenced class", func(t *testing.T) {
+		err := m.CheckFilter(bob, testSchema(), byPath(&filters.Path{
+			Class: "Patient", Property: "treatedBy",
+			Child: &filters.Path{Class: "Doctor", Property: "phone"},
+		}))
+		assert.EqualError(t, err, "property 'phone' of class 'Doctor' is sensitive "+
+			"and can not be used in filters")
+	})
+
+	t.Run("properties which are not sensitive", func(t *testing.T) {
+		assert.Nil(t, m.CheckFilter(bob, testSchema(), byPath(&filters.Path{
+			Class: "Patient", Property: "treatedBy",
+			Child: &filters.Path{Class: "Doctor", Property: "name"},
+		})))
+		assert.Nil(t, m.CheckFilter(bob, testSchema(), nil))
+	})
+
+	t.Run("principal with role", func(t *testing.T) {
+		err := m.CheckFilter(&models.Principal{Username: "alice"}, testSchema(),
+			byPath(&filters.Path{Class: "Patient", Property: "ssn"}))
+		assert.Nil(t, err)
+	})
+}
+
+func TestMasker_CheckProperties(t *testing.T) {
+	m := New(true, Config{Users: []string{"alice"}})
+
+	err := m.CheckProperties(&models.Principal{Username: "bob"}, testSchema(),
+		"Patient", "for sorting", "name", "len(ssn)")
+	assert.EqualError(t, err, "property 'len(ssn)' of class 'Patient' is sensitive "+
+		"and can not be used for sorting")
+
+	err = m.CheckProperties(&models.Principal{Username: "alice"}, testSchema(),
+		"Patient", "for sorting", "ssn")
+	assert.Nil(t, err)
+}
+
+func TestMasker_CheckModuleAdditional(t *testing.T) {
+	m := New(true, Config{Users: []string{"alice"}})
+	bob := &models.Principal{Username: "bob"}
+	params := map[string]interface{}{"summary": nil, "generate": nil}
+
+	err := m.CheckModuleAdditional(bob, testSchema(), "Patient", params)
+	assert.EqualError(t, err, "property 'ssn' of class 'Patient' is sensitive "+
+		"and can not be used by _additional { generate summary }")
+
+	assert.Nil(t, m.CheckModuleAdditional(bob, testSchema(), "Patient", nil))
+	assert.Nil(t, m.CheckModuleAdditional(&models.Principal{Username: "alice"}, testSchema(),
+		"Patient", params))
+}
+
+func TestMasker_MaskedCopy(t *testing.T) {
+	m := New(true, Config{Users: []string{"alice"}})
+	obj := &models.Object{
+		Class:      "Patient",
+		ID:         "some-id",
+		Properties: map[string]interface{}{"name": "John Doe", "ssn": "123-45-6789"},
+	}
+
+	masked := m.MaskedCopy(&models.Principal{Username: "bob"}, testSchema(), obj)
+	assert.Equal(t, map[string]interface{}{"name": "John Doe"}, masked.Properties)
+	assert.Equal(t, obj.ID, masked.ID)
+	assert.Equal(t, "123-45-6789", obj.Properties.(map[string]interface{})["ssn"])
+
+	assert.Same(t, obj, m.MaskedCopy(&models.Principal{Username: "alice"}, testSchema(), obj))
+	assert.Nil(t, m.MaskedCopy(nil, testSchema(), nil))
+}
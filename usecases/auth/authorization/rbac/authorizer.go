@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+const anonymousUsername = "anonymous"
+
+// Authorizer grants access based on the roles assigned to a principal
+type Authorizer struct {
+	store       *Store
+	adminUsers  map[string]struct{}
+	adminGroups map[string]struct{}
+}
+
+func New(cfg Config, store *Store) *Authorizer {
+	a := &Authorizer{
+		store:       store,
+		adminUsers:  make(map[string]struct{}, len(cfg.AdminUsers)),
+		adminGroups: make(map[string]struct{}, len(cfg.AdminGroups)),
+	}
+	for _, user := range cfg.AdminUsers {
+		a.adminUsers[user] = struct{}{}
+	}
+	for _, group := range cfg.AdminGroups {
+		a.adminGroups[group] = struct{}{}
+	}
+	return a
+}
+
+func (a *Authorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if principal == nil {
+		principal = &models.Principal{Username: anonymousUsername}
+	}
+
+	if a.isAdmin(principal) {
+		return nil
+	}
+
+	req, ok := parseResource(verb, resource)
+	if ok {
+		roles := a.store.rolesFor(principal.Username, principal.Groups, principal.Roles)
+		for _, role := range roles {
+			for _, permission := range role.Permissions {
+				if req.allowedBy(permission) {
+					return nil
+				}
+			}
+		}
+	}
+
+	return errors.NewForbidden(principal, verb, resource)
+}
+
+func (a *Authorizer) isAdmin(principal *models.Principal) bool {
+	if _, ok := a.adminUsers[principal.Username]; ok {
+		return true
+	}
+	for _, group := range principal.Groups {
+		if _, ok := a.adminGroups[group]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// request is a resource and verb translated into the terms of a permission
+type request struct {
+	action     string
+	collection string
+	tenant     string
+	// anyCollection is set for listing the schema. Every subject which can
+	// read at least one collection needs to see the schema, for example to
+	// run GraphQL queries.
+	anyCollection bool
+}
+
+func (r request) allowedBy(p Permission) bool {
+	if r.anyCollection {
+		return p.Action == r.action
+	}
+	return p.allows(r.action, r.collection, r.tenant)
+}
+
+// parseResource translates the resources described in the authorization
+// package. Resources which are not scoped to a collection, such as backups
+// or role management, are reserved for admins and therefore not parsed.
+func parseResource(verb, resource string) (request, bool) {
+	parts := strings.Split(resource, "/")
+
+	switch {
+	case len(parts) >= 3 && parts[0] == "schema" && parts[1] == "collections":
+		req := request{action: schemaAction(verb), collection: parts[2], tenant: "*"}
+		if len(parts) == 5 && parts[3] == "tenants" {
+			req.tenant = parts[4]
+		}
+		if verb == "list" && len(parts) == 3 && parts[2] == "*" {
+			req.anyCollection = true
+		}
+		return req, true
+
+	case len(parts) == 7 && parts[0] == "data" && parts[1] == "collections" && parts[3] == "tenants":
+		return request{action: dataAction(verb), collection: parts[2], tenant: parts[4]}, true
+
+	case resource == "nodes" || strings.HasPrefix(resource, "classifications/"):
+		// cluster-wide information, granted to subjects which can access all
+		// collections
+		return request{action: dataAction(verb), collection: "*", tenant: "*"}, true
+
+	default:
+		return request{}, false
+	}
+}
+
+func schemaAction(verb string) string {
+	switch verb {
+	case "get", "list":
+		return ActionRead
+	default:
+		return ActionSchema
+	}
+}
+
+func dataAction(verb string) string {
+	switch verb {
+	case "get", "list", "head", "validate":
+		return ActionRead
+	case "delete":
+		return ActionDelete
+	default:
+		return ActionWrite
+	}
+}
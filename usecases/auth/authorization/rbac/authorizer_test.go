@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+func Test_RBAC_Authorizer(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	store := NewStore(logger)
+	require.Nil(t, store.SetMetadataStore(newFakeMetadata()))
+
+	require.Nil(t, store.Create(ctx, Role{
+		Name: "articles-reader",
+		Permissions: []Permission{
+			{Action: ActionRead, Collection: "Article*"},
+		},
+		Users: []string{"alice"},
+	}))
+	require.Nil(t, store.Create(ctx, Role{
+		Name: "tenant-writer",
+		Permissions: []Permission{
+			{Action: ActionRead, Collection: "Document", Tenant: "customer-a"},
+			{Action: ActionWrite, Collection: "Document", Tenant: "customer-a"},
+		},
+		Groups: []string{"customer-a"},
+	}))
+
+	authorizer := New(Config{Enabled: true, AdminUsers: []string{"root"}}, store)
+
+	alice := &models.Principal{Username: "alice"}
+	carol := &models.Principal{Username: "carol", Roles: []string{"articles-reader"}}
+	bob := &models.Principal{Username: "bob", Groups: []string{"customer-a"}}
+	root := &models.Principal{Username: "root"}
+
+	tests := []struct {
+		name      string
+		principal *models.Principal
+		verb      string
+		resource  string
+		allowed   bool
+	}{
+		{
+			name:      "admin can manage roles",
+			principal: root,
+			verb:      "create",
+			resource:  "authz/roles/some-role",
+			allowed:   true,
+		},
+		{
+			name:      "non-admin can not manage roles",
+			principal: alice,
+			verb:      "list",
+			resource:  "authz/roles/*",
+		},
+		{
+			name:      "read object of matching collection",
+			principal: alice,
+			verb:      "get",
+			resource:  "data/collections/ArticleV2/tenants/*/objects/some-id",
+			allowed:   true,
+		},
+		{
+			name:      "tenant pattern is optional",
+			principal: alice,
+			verb:      "list",
+			resource:  "data/collections/Article/tenants/some-tenant/objects/*",
+			allowed:   true,
+		},
+		{
+			name:      "write object of read-only collection",
+			principal: alice,
+			verb:      "create",
+			resource:  "data/collections/Article/tenants/*/objects/*",
+		},
+		{
+			name:      "read object of other collection",
+			principal: alice,
+			verb:      "get",
+			resource:  "data/collections/Document/tenants/*/objects/some-id",
+		},
+		{
+			name:      "read objects of all collections",
+			principal: alice,
+			verb:      "get",
+			resource:  "data/collections/*/tenants/*/objects/*",
+		},
+		{
+			name:      "read schema of matching collection",
+			principal: alice,
+			verb:      "list",
+			resource:  "schema/collections/Article",
+			allowed:   true,
+		},
+		{
+			name:      "change schema without schema permission",
+			principal: alice,
+			verb:      "update",
+			resource:  "schema/collections/Article",
+		},
+		{
+			name:      "list schema with any read permission",
+			principal: alice,
+			verb:      "list",
+			resource:  "schema/collections/*",
+			allowed:   true,
+		},
+		{
+			name:      "write object of permitted tenant via group",
+			principal: bob,
+			verb:      "create",
+			resource:  "data/collections/Document/tenants/customer-a/objects/*",
+			allowed:   true,
+		},
+		{
+			name:      "write object of other tenant",
+			principal: bob,
+			verb:      "create",
+			resource:  "data/collections/Document/tenants/customer-b/objects/*",
+		},
+		{
+			name:      "read objects across all tenants",
+			principal: bob,
+			verb:      "list",
+			resource:  "data/collections/Document/tenants/*/objects/*",
+		},
+		{
+			name:      "delete without delete permission",
+			principal: bob,
+			verb:      "delete",
+			resource:  "data/collections/Document/tenants/customer-a/objects/some-id",
+		},
+		{
+			name:      "role assigned by the authentication provider",
+			principal: carol,
+			verb:      "get",
+			resource:  "data/collections/Article/tenants/*/objects/some-id",
+			allowed:   true,
+		},
+		{
+			name:      "role assigned by the authentication provider is limited to its permissions",
+			principal: carol,
+			verb:      "delete",
+			resource:  "data/collections/Article/tenants/*/objects/some-id",
+		},
+		{
+			name:      "anonymous without roles",
+			principal: nil,
+			verb:      "get",
+			resource:  "data/collections/Article/tenants/*/objects/*",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := authorizer.Authorize(test.principal, test.verb, test.resource)
+			if test.allowed {
+				assert.Nil(t, err)
+				return
+			}
+
+			principal := test.principal
+			if principal == nil {
+				principal = &models.Principal{Username: anonymousUsername}
+			}
+			assert.Equal(t, errors.NewForbidden(principal, test.verb, test.resource), err)
+		})
+	}
+}
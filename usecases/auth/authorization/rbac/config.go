@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import "fmt"
+
+// Config enables role based access control. Admins can do everything,
+// including managing roles, all other subjects are limited to the
+// permissions of the roles assigned to them.
+type Config struct {
+	Enabled     bool     `json:"enabled" yaml:"enabled"`
+	AdminUsers  []string `json:"admin_users" yaml:"admin_users"`
+	AdminGroups []string `json:"admin_groups" yaml:"admin_groups"`
+}
+
+// Validate rbac config for viability, can be called from the central config
+// package
+func (c Config) Validate() error {
+	if len(c.AdminUsers) == 0 && len(c.AdminGroups) == 0 {
+		return fmt.Errorf("rbac: at least one admin user or group is required, " +
+			"otherwise roles can not be managed")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"context"
+	"strings"
+)
+
+// fakeMetadata is the metadata of the schema on a single node
+type fakeMetadata struct {
+	values    map[string][]byte
+	callbacks []func()
+}
+
+func newFakeMetadata() *fakeMetadata {
+	return &fakeMetadata{values: map[string][]byte{}}
+}
+
+func (f *fakeMetadata) PutMetadata(ctx context.Context, key string, value []byte) error {
+	f.values[key] = value
+	f.changed()
+	return nil
+}
+
+func (f *fakeMetadata) DeleteMetadata(ctx context.Context, key string) error {
+	delete(f.values, key)
+	f.changed()
+	return nil
+}
+
+func (f *fakeMetadata) Metadata(prefix string) map[string][]byte {
+	out := map[string][]byte{}
+	for key, value := range f.values {
+		if strings.HasPrefix(key, prefix) {
+			out[key] = value
+		}
+	}
+	return out
+}
+
+func (f *fakeMetadata) RegisterMetadataCallback(prefix string, callback func()) {
+	f.callbacks = append(f.callbacks, callback)
+}
+
+func (f *fakeMetadata) changed() {
+	for _, cb := range f.callbacks {
+		cb()
+	}
+}
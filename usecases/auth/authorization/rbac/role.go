@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+)
+
+// Actions which can be granted on collections and tenants
+const (
+	// ActionRead allows reading objects as well as the schema of a collection
+	ActionRead = "read"
+	// ActionWrite allows creating and updating objects and their references
+	ActionWrite = "write"
+	// ActionSchema allows changing the definition of a collection and
+	// managing its tenants
+	ActionSchema = "schema"
+	// ActionDelete allows deleting objects
+	ActionDelete = "delete"
+)
+
+var validActions = map[string]struct{}{
+	ActionRead:   {},
+	ActionWrite:  {},
+	ActionSchema: {},
+	ActionDelete: {},
+}
+
+var validRoleName = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_\-]{0,63}$`)
+
+// Role bundles permissions and the subjects they are granted to
+type Role struct {
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions"`
+	Users       []string     `json:"users,omitempty"`
+	Groups      []string     `json:"groups,omitempty"`
+}
+
+// Permission grants an action on all collections and tenants matching the
+// patterns. Patterns follow the syntax of path.Match, e.g. "*" or "Article*".
+// An empty pattern is the same as "*".
+type Permission struct {
+	Action     string `json:"action"`
+	Collection string `json:"collection,omitempty"`
+	Tenant     string `json:"tenant,omitempty"`
+}
+
+// Validate the role before it is stored
+func (r Role) Validate() error {
+	if !validRoleName.MatchString(r.Name) {
+		return fmt.Errorf("invalid role name %q: must start with a letter and "+
+			"contain only letters, numbers, '_' and '-' (max 64 characters)", r.Name)
+	}
+
+	if len(r.Permissions) == 0 {
+		return fmt.Errorf("role %q: at least one permission is required", r.Name)
+	}
+
+	for i, p := range r.Permissions {
+		if _, ok := validActions[p.Action]; !ok {
+			return fmt.Errorf("role %q: permission %d: invalid action %q, "+
+				"possible values are: read, write, schema, delete", r.Name, i, p.Action)
+		}
+		for _, pattern := range []string{p.Collection, p.Tenant} {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("role %q: permission %d: invalid pattern %q: %w",
+					r.Name, i, pattern, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// allows checks whether the permission grants the action on the given
+// collection and tenant
+func (p Permission) allows(action, collection, tenant string) bool {
+	return p.Action == action &&
+		matches(p.Collection, collection) &&
+		matches(p.Tenant, tenant)
+}
+
+// matches a pattern against a value taken from a resource. A wildcard value
+// means the resource covers all collections or tenants, so it is only matched
+// by an unrestricted pattern.
+func matches(pattern, value string) bool {
+	if pattern == "" || pattern == "*" {
+		return true
+	}
+	if value == "*" {
+		return false
+	}
+	ok, _ := path.Match(pattern, value)
+	return ok
+}
+
+func (r Role) appliesTo(username string, groups []string) bool {
+	if contains(r.Users, username) {
+		return true
+	}
+	for _, group := range r.Groups {
+		if contains(groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ErrRoleNotFound      = errors.New("role not found")
+	ErrRoleAlreadyExists = errors.New("role already exists")
+
+	errNoMetadataStore = errors.New("roles can not be changed before the schema is loaded")
+)
+
+// rolesPrefix is the prefix of the metadata keys of roles
+const rolesPrefix = "roles/"
+
+// metadataStore persists the roles and replicates them to all nodes in the
+// cluster. It is implemented by the schema manager.
+type metadataStore interface {
+	PutMetadata(ctx context.Context, key string, value []byte) error
+	DeleteMetadata(ctx context.Context, key string) error
+	Metadata(prefix string) map[string][]byte
+	RegisterMetadataCallback(prefix string, callback func())
+}
+
+// Store keeps all roles in memory. The roles are stored in the metadata of
+// the schema, so they are the same on every node and changes made on any
+// node are applied to the in-memory copy.
+type Store struct {
+	sync.RWMutex
+	// writeLock serializes the changes made on this node, so the checks
+	// before a change see the result of the previous one
+	writeLock sync.Mutex
+	metadata  metadataStore
+	roles     map[string]Role
+	logger    logrus.FieldLogger
+}
+
+func NewStore(logger logrus.FieldLogger) *Store {
+	return &Store{
+		roles:  map[string]Role{},
+		logger: logger,
+	}
+}
+
+// SetMetadataStore loads the roles from the metadata store and keeps them up
+// to date. Roles can not be changed before it is set.
+func (s *Store) SetMetadataStore(metadata metadataStore) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	s.metadata = metadata
+	metadata.RegisterMetadataCallback(rolesPrefix, func() {
+		if err := s.load(); err != nil {
+			s.logger.WithField("action", "rbac_load_roles").WithError(err).
+				Error("roles could not be loaded")
+		}
+	})
+	return s.load()
+}
+
+func (s *Store) load() error {
+	roles := map[string]Role{}
+	for key, value := range s.metadata.Metadata(rolesPrefix) {
+		var role Role
+		if err := json.Unmarshal(value, &role); err != nil {
+			return fmt.Errorf("parse role %q: %w", strings.TrimPrefix(key, rolesPrefix), err)
+		}
+		roles[role.Name] = role
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.roles = roles
+	return nil
+}
+
+// List all roles sorted by name
+func (s *Store) List() []Role {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.sortedRoles()
+}
+
+// Get a single role by name
+func (s *Store) Get(name string) (Role, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	role, ok := s.roles[name]
+	if !ok {
+		return Role{}, fmt.Errorf("%w: %q", ErrRoleNotFound, name)
+	}
+	return role, nil
+}
+
+// Create a new role, fails if a role with the same name exists
+func (s *Store) Create(ctx context.Context, role Role) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	if _, err := s.Get(role.Name); err == nil {
+		return fmt.Errorf("%w: %q", ErrRoleAlreadyExists, role.Name)
+	}
+	return s.put(ctx, role)
+}
+
+// Update replaces an existing role
+func (s *Store) Update(ctx context.Context, role Role) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	if _, err := s.Get(role.Name); err != nil {
+		return err
+	}
+	return s.put(ctx, role)
+}
+
+// Delete a role
+func (s *Store) Delete(ctx context.Context, name string) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	if _, err := s.Get(name); err != nil {
+		return err
+	}
+	if s.metadata == nil {
+		return errNoMetadataStore
+	}
+	return s.metadata.DeleteMetadata(ctx, rolesPrefix+name)
+}
+
+func (s *Store) put(ctx context.Context, role Role) error {
+	if s.metadata == nil {
+		return errNoMetadataStore
+	}
+
+	value, err := json.Marshal(role)
+	if err != nil {
+		return fmt.Errorf("marshal role: %w", err)
+	}
+	return s.metadata.PutMetadata(ctx, rolesPrefix+role.Name, value)
+}
+
+// rolesFor returns all roles which apply to the subject, either through
+// the assignments of the role or because the authentication provider assigned
+// the role by name
+func (s *Store) rolesFor(username string, groups, roleNames []string) []Role {
+	s.RLock()
+	defer s.RUnlock()
+
+	var out []Role
+	for _, role := range s.roles {
+		if role.appliesTo(username, groups) || contains(roleNames, role.Name) {
+			out = append(out, role)
+		}
+	}
+	return out
+}
+
+func (s *Store) sortedRoles() []Role {
+	roles := make([]Role, 0, len(s.roles))
+	for _, role := range s.roles {
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+	return roles
+}
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RBAC_Store(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	metadata := newFakeMetadata()
+	store := NewStore(logger)
+
+	t.Run("roles can not be changed before the metadata store is set", func(t *testing.T) {
+		err := store.Create(ctx, Role{Name: "reader", Permissions: []Permission{{Action: ActionRead}}})
+		assert.ErrorIs(t, err, errNoMetadataStore)
+	})
+
+	require.Nil(t, store.SetMetadataStore(metadata))
+
+	reader := Role{
+		Name:        "reader",
+		Permissions: []Permission{{Action: ActionRead}},
+		Users:       []string{"alice"},
+	}
+
+	t.Run("create and get a role", func(t *testing.T) {
+		require.Nil(t, store.Create(ctx, reader))
+
+		role, err := store.Get("reader")
+		require.Nil(t, err)
+		assert.Equal(t, reader, role)
+	})
+
+	t.Run("create a duplicate role", func(t *testing.T) {
+		err := store.Create(ctx, reader)
+		assert.ErrorIs(t, err, ErrRoleAlreadyExists)
+	})
+
+	t.Run("create an invalid role", func(t *testing.T) {
+		err := store.Create(ctx, Role{Name: "1-invalid", Permissions: []Permission{{Action: ActionRead}}})
+		assert.ErrorContains(t, err, "invalid role name")
+
+		err = store.Create(ctx, Role{Name: "writer", Permissions: []Permission{{Action: "everything"}}})
+		assert.ErrorContains(t, err, "invalid action")
+
+		err = store.Create(ctx, Role{Name: "writer", Permissions: []Permission{{Action: ActionWrite, Collection: "["}}})
+		assert.ErrorContains(t, err, "invalid pattern")
+
+		_, err = store.Get("writer")
+		assert.ErrorIs(t, err, ErrRoleNotFound)
+	})
+
+	t.Run("update a role", func(t *testing.T) {
+		reader.Groups = []string{"readers"}
+		require.Nil(t, store.Update(ctx, reader))
+
+		assert.Len(t, store.rolesFor("bob", []string{"readers"}, nil), 1)
+		assert.Len(t, store.rolesFor("bob", nil, nil), 0)
+
+		err := store.Update(ctx, Role{Name: "unknown", Permissions: []Permission{{Action: ActionRead}}})
+		assert.ErrorIs(t, err, ErrRoleNotFound)
+	})
+
+	t.Run("roles are loaded from the metadata store", func(t *testing.T) {
+		require.Nil(t, store.Create(ctx, Role{
+			Name:        "deleter",
+			Permissions: []Permission{{Action: ActionDelete, Collection: "Article"}},
+		}))
+
+		restarted := NewStore(logger)
+		require.Nil(t, restarted.SetMetadataStore(metadata))
+		assert.Equal(t, store.List(), restarted.List())
+		assert.Equal(t, "deleter", restarted.List()[0].Name)
+	})
+
+	t.Run("changes made on another node are applied", func(t *testing.T) {
+		require.Nil(t, metadata.PutMetadata(ctx, "roles/writer",
+			[]byte(`{"name":"writer","permissions":[{"action":"write"}]}`)))
+
+		role, err := store.Get("writer")
+		require.Nil(t, err)
+		assert.Equal(t, []Permission{{Action: ActionWrite}}, role.Permissions)
+
+		require.Nil(t, metadata.DeleteMetadata(ctx, "roles/writer"))
+		_, err = store.Get("writer")
+		assert.ErrorIs(t, err, ErrRoleNotFound)
+	})
+
+	t.Run("delete a role", func(t *testing.T) {
+		require.Nil(t, store.Delete(ctx, "deleter"))
+		assert.ErrorIs(t, store.Delete(ctx, "deleter"), ErrRoleNotFound)
+		assert.Len(t, store.List(), 1)
+	})
+}
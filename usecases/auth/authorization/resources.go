@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authorization
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// All is the wildcard used for any part of a resource which is not known or
+// not restricted, e.g. the id when listing objects
+const All = "*"
+
+// Resources are described as paths, so that authorizers which do not care
+// about individual collections (e.g. the admin list) can ignore them, while
+// fine-grained authorizers can parse them:
+//
+//	schema/collections/{class}
+//	schema/collections/{class}/shards
+//	schema/collections/{class}/tenants/{tenant}
+//	data/collections/{class}/tenants/{tenant}/objects/{id}
+//	authz/roles/{name}
+//	apikeys/{id}
+//	templates/{name}
+//	replication/standby
+//	replication/async
+//	cluster/shards
+//	monitoring/slow-queries
+//	monitoring/ingest-queue
+//	monitoring/debug-bundle
+//
+// Empty parts are replaced with the wildcard, class names are normalized the
+// same way as in the schema.
+
+// CollectionsMetadata is the schema definition of a collection
+func CollectionsMetadata(class string) string {
+	return fmt.Sprintf("schema/collections/%s", orAllClass(class))
+}
+
+// ShardsMetadata is the status of the shards of a collection
+func ShardsMetadata(class string) string {
+	return fmt.Sprintf("schema/collections/%s/shards", orAllClass(class))
+}
+
+// TenantsMetadata is the definition of a tenant of a collection
+func TenantsMetadata(class, tenant string) string {
+	return fmt.Sprintf("schema/collections/%s/tenants/%s", orAllClass(class), orAll(tenant))
+}
+
+// Objects are the data objects of a tenant of a collection
+func Objects(class, tenant string, id strfmt.UUID) string {
+	return fmt.Sprintf("data/collections/%s/tenants/%s/objects/%s",
+		orAllClass(class), orAll(tenant), orAll(id.String()))
+}
+
+// Roles are the roles used for role based access control
+func Roles(name string) string {
+	return fmt.Sprintf("authz/roles/%s", orAll(name))
+}
+
+// APIKeys are the keys which are managed at runtime
+func APIKeys(id string) string {
+	return fmt.Sprintf("apikeys/%s", orAll(id))
+}
+
+// QueryTemplates are the named queries which clients execute with variables
+func QueryTemplates(name string) string {
+	return fmt.Sprintf("templates/%s", orAll(name))
+}
+
+// Standby is the replication of this cluster from a primary cluster
+func Standby() string {
+	return "replication/standby"
+}
+
+// AsyncReplication is the background repair of replicated shards
+func AsyncReplication() string {
+	return "replication/async"
+}
+
+// ShardPlacement is the placement of shard replicas on the nodes
+func ShardPlacement() string {
+	return "cluster/shards"
+}
+
+// SlowQueries are the queries kept by the slow query log of a node
+func SlowQueries() string {
+	return "monitoring/slow-queries"
+}
+
+// IngestQueue is the queue of batches which are accepted, but not yet
+// written and indexed by a node
+func IngestQueue() string {
+	return "monitoring/ingest-queue"
+}
+
+// Startup is the loading of the shards of a node after it started
+func Startup() string {
+	return "monitoring/startup"
+}
+
+// DebugBundle is the diagnostics bundle of a node, which contains its
+// profiles, config and schema
+func DebugBundle() string {
+	return "monitoring/debug-bundle"
+}
+
+// NodeConfig are the settings of a node which can be changed at runtime
+func NodeConfig() string {
+	return "cluster/config"
+}
+
+// ModuleCredentials are the credentials of modules which are set at runtime,
+// for a single tenant or, if tenant is empty, for all tenants
+func ModuleCredentials(tenant string) string {
+	return fmt.Sprintf("modules/credentials/%s", orAll(tenant))
+}
+
+// ModuleUsage are the calls and tokens of the modules, attributed to
+// classes, tenants, users and API keys
+func ModuleUsage() string {
+	return "modules/usage"
+}
+
+// CollectionAndTenant extracts the collection and tenant of a resource which
+// is scoped to a collection. ok is false for all other resources.
+func CollectionAndTenant(resource string) (class, tenant string, ok bool) {
+	parts := strings.Split(resource, "/")
+	if len(parts) < 3 || parts[1] != "collections" {
+		return "", "", false
+	}
+
+	switch parts[0] {
+	case "schema":
+		if len(parts) == 5 && parts[3] == "tenants" {
+			return parts[2], parts[4], true
+		}
+		return parts[2], All, true
+	case "data":
+		if len(parts) == 7 && parts[3] == "tenants" {
+			return parts[2], parts[4], true
+		}
+	}
+	return "", "", false
+}
+
+func orAllClass(class string) string {
+	if class == "" {
+		return All
+	}
+	return schema.UppercaseClassName(class)
+}
+
+func orAll(part string) string {
+	if part == "" {
+		return All
+	}
+	return part
+}
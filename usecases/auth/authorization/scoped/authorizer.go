@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package scoped restricts principals which authenticated with a managed API
+// key to the scopes of that key. It wraps the configured authorizer, so a
+// key can never grant more than the user it belongs to is allowed to do.
+package scoped
+
+import (
+	"path"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+type keyStore interface {
+	Active(id string) (apikey.Key, error)
+}
+
+type Authorizer struct {
+	next authorization.Authorizer
+	keys keyStore
+}
+
+func New(next authorization.Authorizer, keys keyStore) *Authorizer {
+	return &Authorizer{next: next, keys: keys}
+}
+
+func (a *Authorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if principal == nil || principal.APIKeyID == "" {
+		return a.next.Authorize(principal, verb, resource)
+	}
+
+	// the key is looked up again, so revocations and expirations take effect
+	// for requests which are already authenticated
+	key, err := a.keys.Active(principal.APIKeyID)
+	if err != nil || !allows(key.Scopes, verb, resource) {
+		return errors.NewForbidden(principal, verb, resource)
+	}
+
+	return a.next.Authorize(principal, verb, resource)
+}
+
+func allows(scopes apikey.Scopes, verb, resource string) bool {
+	if len(scopes.Verbs) > 0 && !contains(scopes.Verbs, verb) {
+		return false
+	}
+
+	if len(scopes.Classes) == 0 && len(scopes.Tenants) == 0 {
+		return true
+	}
+
+	if verb == "list" && resource == authorization.CollectionsMetadata("") {
+		// listing the schema is required to use the collections in scope,
+		// e.g. for GraphQL
+		return true
+	}
+
+	class, tenant, ok := authorization.CollectionAndTenant(resource)
+	if !ok {
+		// not scoped to a collection, e.g. backups or key management
+		return false
+	}
+
+	return matchesAny(scopes.Classes, class) && matchesAny(scopes.Tenants, tenant)
+}
+
+// matchesAny checks the value of a resource against the patterns of a scope.
+// A wildcard value covers all collections or tenants, so it is only allowed
+// if the scope is not restricted.
+func matchesAny(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	if value == authorization.All {
+		return false
+	}
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
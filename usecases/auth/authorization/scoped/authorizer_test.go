@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scoped
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+type fakeKeys map[string]apikey.Key
+
+func (f fakeKeys) Active(id string) (apikey.Key, error) {
+	key, ok := f[id]
+	if !ok {
+		return apikey.Key{}, apikey.ErrKeyNotFound
+	}
+	return key, nil
+}
+
+type recordingAuthorizer struct {
+	calls int
+	err   error
+}
+
+func (r *recordingAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	r.calls++
+	return r.err
+}
+
+func Test_Scoped_Authorizer(t *testing.T) {
+	keys := fakeKeys{
+		"unrestricted": {ID: "unrestricted"},
+		"ingest": {ID: "ingest", Scopes: apikey.Scopes{
+			Classes: []string{"Article*"},
+			Tenants: []string{"customer-a"},
+			Verbs:   []string{"create", "update"},
+		}},
+		"reader": {ID: "reader", Scopes: apikey.Scopes{
+			Classes: []string{"Article"},
+			Verbs:   []string{"get", "list"},
+		}},
+	}
+
+	tests := []struct {
+		name     string
+		keyID    string
+		verb     string
+		resource string
+		allowed  bool
+	}{
+		{
+			name:     "principal without managed key",
+			verb:     "delete",
+			resource: authorization.CollectionsMetadata("Article"),
+			allowed:  true,
+		},
+		{
+			name:     "unrestricted key",
+			keyID:    "unrestricted",
+			verb:     "create",
+			resource: authorization.Roles("some-role"),
+			allowed:  true,
+		},
+		{
+			name:     "revoked key",
+			keyID:    "revoked",
+			verb:     "get",
+			resource: authorization.Objects("Article", "", ""),
+		},
+		{
+			name:     "write into class and tenant in scope",
+			keyID:    "ingest",
+			verb:     "create",
+			resource: authorization.Objects("ArticleV2", "customer-a", ""),
+			allowed:  true,
+		},
+		{
+			name:     "write into tenant out of scope",
+			keyID:    "ingest",
+			verb:     "create",
+			resource: authorization.Objects("Article", "customer-b", ""),
+		},
+		{
+			name:     "verb out of scope",
+			keyID:    "ingest",
+			verb:     "delete",
+			resource: authorization.Objects("Article", "customer-a", "some-id"),
+		},
+		{
+			name:     "read class in scope across tenants",
+			keyID:    "reader",
+			verb:     "list",
+			resource: authorization.Objects("Article", "", ""),
+			allowed:  true,
+		},
+		{
+			name:     "read all classes",
+			keyID:    "reader",
+			verb:     "get",
+			resource: authorization.Objects("", "", ""),
+		},
+		{
+			name:     "list the schema",
+			keyID:    "reader",
+			verb:     "list",
+			resource: authorization.CollectionsMetadata(""),
+			allowed:  true,
+		},
+		{
+			name:     "resource without collection",
+			keyID:    "reader",
+			verb:     "list",
+			resource: authorization.APIKeys(""),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			next := &recordingAuthorizer{}
+			principal := &models.Principal{Username: "someone", APIKeyID: test.keyID}
+
+			err := New(next, keys).Authorize(principal, test.verb, test.resource)
+			if test.allowed {
+				assert.Nil(t, err)
+				assert.Equal(t, 1, next.calls, "configured authorizer must be called")
+				return
+			}
+			assert.Equal(t, errors.NewForbidden(principal, test.verb, test.resource), err)
+			assert.Equal(t, 0, next.calls)
+		})
+	}
+
+	t.Run("the configured authorizer has the final say", func(t *testing.T) {
+		next := &recordingAuthorizer{err: fmt.Errorf("denied")}
+		principal := &models.Principal{Username: "someone", APIKeyID: "unrestricted"}
+
+		err := New(next, keys).Authorize(principal, "get", authorization.Objects("", "", ""))
+		assert.EqualError(t, err, "denied")
+	})
+}
@@ -0,0 +1,24 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authorization
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// TenantFor returns the tenant a request is made for. Principals which are
+// bound to a tenant, e.g. through an OIDC claim, default to their own tenant,
+// so they do not need to specify it on every request.
+func TenantFor(principal *models.Principal, tenant string) string {
+	if tenant == "" && principal != nil {
+		return principal.Tenant
+	}
+	return tenant
+}
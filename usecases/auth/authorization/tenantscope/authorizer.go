@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package tenantscope restricts principals whose token is bound to a tenant,
+// e.g. through an OIDC claim, to the data of that tenant. It wraps the
+// configured authorizer, so the tenant can only narrow down the permissions
+// a principal has.
+package tenantscope
+
+import (
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// readVerbs are the verbs a tenant bound principal can use on the schema, it
+// needs to see the collections to use them, but can not change them
+var readVerbs = map[string]struct{}{"get": {}, "list": {}}
+
+type Authorizer struct {
+	next authorization.Authorizer
+}
+
+func New(next authorization.Authorizer) *Authorizer {
+	return &Authorizer{next: next}
+}
+
+func (a *Authorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if principal == nil || principal.Tenant == "" {
+		return a.next.Authorize(principal, verb, resource)
+	}
+
+	if !allows(principal.Tenant, verb, resource) {
+		return errors.NewForbidden(principal, verb, resource)
+	}
+
+	return a.next.Authorize(principal, verb, resource)
+}
+
+func allows(own, verb, resource string) bool {
+	class, tenant, ok := authorization.CollectionAndTenant(resource)
+	if !ok {
+		// not scoped to a collection, e.g. backups or key management
+		return false
+	}
+
+	if strings.HasPrefix(resource, "data/") {
+		return tenant == own
+	}
+
+	if _, ok := readVerbs[verb]; !ok {
+		return false
+	}
+	switch resource {
+	case authorization.CollectionsMetadata(class):
+		return true
+	case authorization.TenantsMetadata(class, tenant):
+		return tenant == own
+	default:
+		return false
+	}
+}
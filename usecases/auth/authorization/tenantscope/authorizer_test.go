@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tenantscope
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+type recordingAuthorizer struct {
+	calls int
+	err   error
+}
+
+func (r *recordingAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	r.calls++
+	return r.err
+}
+
+func Test_TenantScope_Authorizer(t *testing.T) {
+	tests := []struct {
+		name     string
+		tenant   string
+		verb     string
+		resource string
+		allowed  bool
+	}{
+		{
+			name:     "principal without tenant",
+			verb:     "delete",
+			resource: authorization.CollectionsMetadata("Article"),
+			allowed:  true,
+		},
+		{
+			name:     "objects of the own tenant",
+			tenant:   "customer-a",
+			verb:     "create",
+			resource: authorization.Objects("Article", "customer-a", ""),
+			allowed:  true,
+		},
+		{
+			name:     "objects of another tenant",
+			tenant:   "customer-a",
+			verb:     "get",
+			resource: authorization.Objects("Article", "customer-b", "some-id"),
+		},
+		{
+			name:     "objects across tenants",
+			tenant:   "customer-a",
+			verb:     "get",
+			resource: authorization.Objects("Article", "", ""),
+		},
+		{
+			name:     "read the schema",
+			tenant:   "customer-a",
+			verb:     "list",
+			resource: authorization.CollectionsMetadata(""),
+			allowed:  true,
+		},
+		{
+			name:     "change the schema",
+			tenant:   "customer-a",
+			verb:     "update",
+			resource: authorization.CollectionsMetadata("Article"),
+		},
+		{
+			name:     "read the own tenant",
+			tenant:   "customer-a",
+			verb:     "get",
+			resource: authorization.TenantsMetadata("Article", "customer-a"),
+			allowed:  true,
+		},
+		{
+			name:     "list all tenants",
+			tenant:   "customer-a",
+			verb:     "get",
+			resource: authorization.TenantsMetadata("Article", ""),
+		},
+		{
+			name:     "delete the own tenant",
+			tenant:   "customer-a",
+			verb:     "delete",
+			resource: authorization.TenantsMetadata("Article", "customer-a"),
+		},
+		{
+			name:     "shards of a collection",
+			tenant:   "customer-a",
+			verb:     "get",
+			resource: authorization.ShardsMetadata("Article"),
+		},
+		{
+			name:     "resource without collection",
+			tenant:   "customer-a",
+			verb:     "list",
+			resource: authorization.Roles(""),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			next := &recordingAuthorizer{}
+			principal := &models.Principal{Username: "someone", Tenant: test.tenant}
+
+			err := New(next).Authorize(principal, test.verb, test.resource)
+			if test.allowed {
+				assert.Nil(t, err)
+				assert.Equal(t, 1, next.calls, "configured authorizer must be called")
+				return
+			}
+			assert.Equal(t, errors.NewForbidden(principal, test.verb, test.resource), err)
+			assert.Equal(t, 0, next.calls)
+		})
+	}
+
+	t.Run("the configured authorizer has the final say", func(t *testing.T) {
+		next := &recordingAuthorizer{err: fmt.Errorf("denied")}
+		principal := &models.Principal{Username: "someone", Tenant: "customer-a"}
+
+		err := New(next).Authorize(principal, "get", authorization.Objects("Article", "customer-a", ""))
+		assert.EqualError(t, err, "denied")
+	})
+}
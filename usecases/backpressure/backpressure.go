@@ -0,0 +1,189 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package backpressure bounds the objects which are added in batch at the
+// same time. Instead of accepting batches which later time out, batches are
+// rejected with a hint when to retry, and all responses carry hints which let
+// clients adapt their batch sizes to the current load.
+package backpressure
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const (
+	// maxRetryAfter caps the retry hint of rejected batches
+	maxRetryAfter = time.Minute
+	// ewmaWeight of the latest batch in the observed rate and latency
+	ewmaWeight = 0.2
+)
+
+// ErrOverloaded indicates that the objects of a batch would exceed the queued
+// objects allowed on this node. Clients should retry after RetryAfter.
+type ErrOverloaded struct {
+	Queued     int
+	Requested  int
+	Limit      int
+	RetryAfter time.Duration
+}
+
+func (e ErrOverloaded) Error() string {
+	return fmt.Sprintf("batch of %d objects rejected, %d objects are queued, limit %d: retry after %s",
+		e.Requested, e.Queued, e.Limit, e.RetryAfter)
+}
+
+// ErrBatchTooLarge indicates a batch which is never accepted, it needs to be
+// split up by the client
+type ErrBatchTooLarge struct {
+	Size  int
+	Limit int
+}
+
+func (e ErrBatchTooLarge) Error() string {
+	return fmt.Sprintf("batch of %d objects exceeds the maximum batch size of %d", e.Size, e.Limit)
+}
+
+// Hints about the load of this node for clients adding objects in batch
+type Hints struct {
+	// QueueDepth is the number of objects currently being added in batch
+	QueueDepth int
+	// SuggestedBatchSize is expected to be added within the target latency
+	SuggestedBatchSize int
+}
+
+// Controller admits batches as long as the queued objects stay within the
+// limit. A nil Controller is valid and admits everything.
+type Controller struct {
+	config  config.BatchBackpressure
+	metrics *Metrics
+	now     func() time.Time
+
+	sync.Mutex
+	queued int
+	// rate is the ewma of the objects per second a single batch is added with,
+	// latency the ewma of the time batches take
+	rate    float64
+	latency time.Duration
+}
+
+// NewController returns nil if backpressure is disabled
+func NewController(config config.BatchBackpressure, metrics *Metrics) *Controller {
+	if !config.Enabled {
+		return nil
+	}
+	return &Controller{config: config, metrics: metrics, now: time.Now}
+}
+
+// Admit queues the objects of a batch of the given size. The returned func
+// must be called once the batch is done, it is nil if the batch is rejected.
+// A batch is always admitted if nothing else is queued, so batches up to the
+// maximum batch size can not starve.
+func (c *Controller) Admit(size int) (done func(), err error) {
+	if c == nil {
+		return func() {}, nil
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if size > c.config.MaxBatchSize {
+		c.metrics.Rejected("too_large")
+		return nil, ErrBatchTooLarge{Size: size, Limit: c.config.MaxBatchSize}
+	}
+	if c.queued > 0 && c.queued+size > c.config.MaxQueuedObjects {
+		c.metrics.Rejected("overloaded")
+		return nil, ErrOverloaded{
+			Queued:     c.queued,
+			Requested:  size,
+			Limit:      c.config.MaxQueuedObjects,
+			RetryAfter: c.retryAfter(c.queued + size - c.config.MaxQueuedObjects),
+		}
+	}
+
+	c.queued += size
+	c.metrics.Queued(c.queued)
+	started := c.now()
+	return func() { c.done(size, c.now().Sub(started)) }, nil
+}
+
+func (c *Controller) done(size int, took time.Duration) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.queued -= size
+	c.metrics.Queued(c.queued)
+	if took <= 0 || size == 0 {
+		return
+	}
+
+	rate := float64(size) / took.Seconds()
+	if c.latency == 0 {
+		c.rate, c.latency = rate, took
+	} else {
+		c.rate = ewmaWeight*rate + (1-ewmaWeight)*c.rate
+		c.latency = time.Duration(ewmaWeight*float64(took) + (1-ewmaWeight)*float64(c.latency))
+	}
+	c.metrics.SuggestedBatchSize(c.suggestedBatchSize())
+}
+
+// Hints about the current load, the zero value if c is nil
+func (c *Controller) Hints() Hints {
+	if c == nil {
+		return Hints{}
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	return Hints{QueueDepth: c.queued, SuggestedBatchSize: c.suggestedBatchSize()}
+}
+
+// suggestedBatchSize is the size a single batch is added with in the target
+// latency at the observed rate, bounded by the free capacity of the queue
+func (c *Controller) suggestedBatchSize() int {
+	size := c.config.MaxBatchSize
+	if c.latency > 0 {
+		size = int(c.rate * c.config.TargetLatency.Seconds())
+	}
+	if free := c.config.MaxQueuedObjects - c.queued; size > free {
+		size = free
+	}
+	if size > c.config.MaxBatchSize {
+		size = c.config.MaxBatchSize
+	}
+	if size < 1 {
+		size = 1
+	}
+	return size
+}
+
+// retryAfter estimates how long it takes until the excess objects are
+// drained. By Little's law, the queue drains queued objects per batch
+// latency.
+func (c *Controller) retryAfter(excess int) time.Duration {
+	latency := c.latency
+	if latency == 0 {
+		latency = c.config.TargetLatency
+	}
+	wait := time.Duration(float64(latency) * float64(excess) / float64(c.queued))
+	wait = time.Duration(math.Ceil(wait.Seconds())) * time.Second
+	if wait < time.Second {
+		return time.Second
+	}
+	if wait > maxRetryAfter {
+		return maxRetryAfter
+	}
+	return wait
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backpressure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestController(t *testing.T) {
+	newController := func() (*Controller, *time.Time) {
+		c := NewController(config.BatchBackpressure{
+			Enabled:          true,
+			MaxQueuedObjects: 1000,
+			MaxBatchSize:     500,
+			TargetLatency:    10 * time.Second,
+		}, nil)
+		now := time.Unix(0, 0)
+		c.now = func() time.Time { return now }
+		return c, &now
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		c := NewController(config.BatchBackpressure{}, nil)
+		require.Nil(t, c)
+		done, err := c.Admit(1_000_000)
+		require.Nil(t, err)
+		done()
+		assert.Equal(t, Hints{}, c.Hints())
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		c, _ := newController()
+		_, err := c.Admit(501)
+		assert.ErrorAs(t, err, &ErrBatchTooLarge{})
+	})
+
+	t.Run("overloaded", func(t *testing.T) {
+		c, now := newController()
+		done1, err := c.Admit(500)
+		require.Nil(t, err)
+		done2, err := c.Admit(400)
+		require.Nil(t, err)
+		assert.Equal(t, Hints{QueueDepth: 900, SuggestedBatchSize: 100}, c.Hints())
+
+		_, err = c.Admit(300)
+		var overloaded ErrOverloaded
+		require.ErrorAs(t, err, &overloaded)
+		assert.Equal(t, 900, overloaded.Queued)
+		// without observed batches, the queue is expected to drain within the
+		// target latency: 200 of 900 queued objects take 2.2s
+		assert.Equal(t, 3*time.Second, overloaded.RetryAfter)
+
+		*now = now.Add(2 * time.Second)
+		done1()
+		done2()
+		_, err = c.Admit(300)
+		assert.Nil(t, err)
+	})
+
+	t.Run("suggested batch size adapts to the observed rate", func(t *testing.T) {
+		c, now := newController()
+		assert.Equal(t, 500, c.Hints().SuggestedBatchSize)
+
+		// 100 objects per second, 1000 within the target latency
+		done, err := c.Admit(200)
+		require.Nil(t, err)
+		*now = now.Add(2 * time.Second)
+		done()
+		assert.Equal(t, Hints{QueueDepth: 0, SuggestedBatchSize: 500}, c.Hints())
+
+		// 10 objects per second lower the rate to 82
+		done, err = c.Admit(100)
+		require.Nil(t, err)
+		*now = now.Add(10 * time.Second)
+		done()
+		assert.Equal(t, 820, int(c.rate*c.config.TargetLatency.Seconds()))
+		assert.Equal(t, 500, c.Hints().SuggestedBatchSize)
+
+		for i := 0; i < 10; i++ {
+			done, err = c.Admit(100)
+			require.Nil(t, err)
+			*now = now.Add(10 * time.Second)
+			done()
+		}
+		assert.Less(t, c.Hints().SuggestedBatchSize, 200)
+	})
+}
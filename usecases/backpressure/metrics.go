@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backpressure
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Metrics of the batch backpressure
+type Metrics struct {
+	queued        prometheus.Gauge
+	suggestedSize prometheus.Gauge
+	rejections    *prometheus.CounterVec
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		queued:        prom.BatchQueuedObjects,
+		suggestedSize: prom.BatchSuggestedSize,
+		rejections:    prom.BatchBackpressureRejections,
+	}
+}
+
+func (m *Metrics) Queued(objects int) {
+	if m == nil {
+		return
+	}
+
+	m.queued.Set(float64(objects))
+}
+
+func (m *Metrics) SuggestedBatchSize(size int) {
+	if m == nil {
+		return
+	}
+
+	m.suggestedSize.Set(float64(size))
+}
+
+func (m *Metrics) Rejected(reason string) {
+	if m == nil {
+		return
+	}
+
+	m.rejections.With(prometheus.Labels{"reason": reason}).Inc()
+}
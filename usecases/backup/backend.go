@@ -19,6 +19,7 @@ import (
 	"os"
 	"path"
 	"runtime"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -388,6 +389,11 @@ type fileWriter struct {
 	movedFiles []string // files successfully moved to destination folder
 	compressed bool
 	GoPoolSize int
+
+	// class directory the files are moved to, the one of the backup if empty
+	classDir string
+	// shards moved to the class directory, all if nil
+	shards map[string]struct{}
 }
 
 func newFileWriter(sourcer Sourcer, backend nodeStore,
@@ -410,6 +416,20 @@ func (fw *fileWriter) WithPoolPercentage(p int) *fileWriter {
 	return fw
 }
 
+// WithSelection moves the files to the directory of class and, if shards
+// are given, only the files of these shards. The directory of the class may
+// exist already.
+func (fw *fileWriter) WithSelection(class string, shards []string) *fileWriter {
+	fw.classDir = strings.ToLower(class)
+	if len(shards) > 0 {
+		fw.shards = make(map[string]struct{}, len(shards))
+		for _, shard := range shards {
+			fw.shards[shard] = struct{}{}
+		}
+	}
+	return fw
+}
+
 // Write downloads files and put them in the destination directory
 func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor) (rollback func() error, err error) {
 	if len(desc.Shards) == 0 { // nothing to copy
@@ -510,6 +530,12 @@ func (fw *fileWriter) moveAll(classTempDir string) (err error) {
 	destDir := fw.destDir
 	for _, key := range files {
 		from := path.Join(classTempDir, key.Name())
+		if fw.classDir != "" && key.IsDir() {
+			if err := fw.moveShards(from, path.Join(destDir, fw.classDir)); err != nil {
+				return err
+			}
+			continue
+		}
 		to := path.Join(destDir, key.Name())
 		if err := os.Rename(from, to); err != nil {
 			return fmt.Errorf("move %s %s: %w", from, to, err)
@@ -520,6 +546,36 @@ func (fw *fileWriter) moveAll(classTempDir string) (err error) {
 	return nil
 }
 
+// moveShards moves the selected shards of a class directory to destClassDir
+func (fw *fileWriter) moveShards(classDir, destClassDir string) error {
+	shards, err := os.ReadDir(classDir)
+	if err != nil {
+		return fmt.Errorf("read %s", classDir)
+	}
+	if _, err := os.Stat(destClassDir); os.IsNotExist(err) {
+		if err := os.MkdirAll(destClassDir, os.ModePerm); err != nil {
+			return fmt.Errorf("create folder %s: %w", destClassDir, err)
+		}
+		fw.movedFiles = append(fw.movedFiles, destClassDir)
+	}
+
+	for _, shard := range shards {
+		if _, ok := fw.shards[shard.Name()]; fw.shards != nil && !ok {
+			continue
+		}
+		from := path.Join(classDir, shard.Name())
+		to := path.Join(destClassDir, shard.Name())
+		if _, err := os.Stat(to); err == nil {
+			return fmt.Errorf("move %s %s: destination exists", from, to)
+		}
+		if err := os.Rename(from, to); err != nil {
+			return fmt.Errorf("move %s %s: %w", from, to, err)
+		}
+		fw.movedFiles = append(fw.movedFiles, to)
+	}
+	return nil
+}
+
 // rollBack successfully written files
 func (fw *fileWriter) rollBack(classTempDir string) (err error) {
 	// rollback successfully moved files
@@ -290,12 +290,15 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 			reqChan <- pair{
 				nodeHost{node, host},
 				&Request{
-					Method:      method,
-					ID:          id,
-					Backend:     backend,
-					Classes:     gr.Classes,
-					NodeMapping: nodeMapping,
-					Duration:    _BookingPeriod,
+					Method:       method,
+					ID:           id,
+					Backend:      backend,
+					Classes:      gr.Classes,
+					NodeMapping:  nodeMapping,
+					Duration:     _BookingPeriod,
+					PointInTime:  c.descriptor.PointInTime,
+					ClassMapping: c.descriptor.ClassMapping,
+					Tenants:      c.descriptor.Tenants,
 				},
 			}
 		}
@@ -18,6 +18,7 @@ import (
 	"io"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/weaviate/weaviate/entities/backup"
@@ -77,6 +78,18 @@ func (s *fakeSourcer) ClassExists(name string) bool {
 	return args.Bool(0)
 }
 
+func (s *fakeSourcer) WALArchiveEnabled() bool {
+	args := s.Called()
+	return args.Bool(0)
+}
+
+func (s *fakeSourcer) ReplayWALArchive(ctx context.Context, node string, classes []string,
+	from, until time.Time,
+) error {
+	args := s.Called(ctx, node, classes, from, until)
+	return args.Error(0)
+}
+
 type fakeBackend struct {
 	mock.Mock
 	sync.RWMutex
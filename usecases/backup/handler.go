@@ -46,6 +46,7 @@ type authorizer interface {
 
 type schemaManger interface {
 	RestoreClass(ctx context.Context, d *backup.ClassDescriptor, nodeMapping map[string]string) error
+	RestoreTenants(ctx context.Context, d *backup.ClassDescriptor, nodeMapping map[string]string) error
 	NodeName() string
 }
 
@@ -113,6 +114,18 @@ type BackupRequest struct {
 	// NodeMapping is a map of node name replacement where key is the old name and value is the new name
 	// No effect if the map is empty
 	NodeMapping map[string]string
+
+	// PointInTime restores the state at this time by replaying the archived
+	// changes made after the backup was taken. No effect if zero.
+	PointInTime time.Time
+
+	// ClassMapping restores classes under a different name, the key is the
+	// name of the class in the backup
+	ClassMapping map[string]string
+
+	// Tenants restores only these tenants of multi-tenant classes, the key is
+	// the name of the class in the backup
+	Tenants map[string][]string
 }
 
 // OnCanCommit will be triggered when coordinator asks the node to participate
@@ -124,12 +137,7 @@ func (m *Handler) OnCanCommit(ctx context.Context, req *Request) *CanCommitRespo
 	// If we are doing a restore and have a nodeMapping specified, ensure we use the "old" node name from the backup to retrieve/store the
 	// backup information.
 	if req.Method == OpRestore {
-		for oldNodeName, newNodeName := range req.NodeMapping {
-			if nodeName == newNodeName {
-				nodeName = oldNodeName
-				break
-			}
-		}
+		nodeName = backupNodeName(nodeName, req.NodeMapping)
 	}
 	store, err := nodeBackend(nodeName, m.backends, req.Backend, req.ID)
 	if err != nil {
@@ -173,6 +181,16 @@ func (m *Handler) OnCanCommit(ctx context.Context, req *Request) *CanCommitRespo
 	return ret
 }
 
+// backupNodeName returns the name node had when the backup was taken
+func backupNodeName(node string, nodeMapping map[string]string) string {
+	for oldNodeName, newNodeName := range nodeMapping {
+		if node == newNodeName {
+			return oldNodeName
+		}
+	}
+	return node
+}
+
 // OnCommit will be triggered when the coordinator confirms the execution of a previous operation
 func (m *Handler) OnCommit(ctx context.Context, req *StatusRequest) (err error) {
 	switch req.Method {
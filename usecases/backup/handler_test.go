@@ -73,6 +73,11 @@ func (m *Handler) Restore(ctx context.Context, pr *models.Principal,
 		Backend:     req.Backend,
 		Classes:     cs,
 		NodeMapping: req.NodeMapping,
+		PointInTime: req.PointInTime,
+		Tenants:     req.Tenants,
+	}
+	if rreq.ClassMapping, err = validateSelection(cs, req); err != nil {
+		return nil, backup.NewErrUnprocessable(err)
 	}
 	data, err := m.restorer.Restore(ctx, &rreq, meta, store)
 	if err != nil {
@@ -83,7 +88,9 @@ func (m *Handler) Restore(ctx context.Context, pr *models.Principal,
 }
 
 func (m *Handler) validateRestoreRequest(ctx context.Context, store nodeStore, req *BackupRequest) (*backup.BackupDescriptor, error) {
-	meta, cs, err := m.restorer.validate(ctx, &store, &Request{ID: req.ID, Classes: req.Include})
+	meta, cs, err := m.restorer.validate(ctx, &store, &Request{
+		ID: req.ID, Classes: req.Include, PointInTime: req.PointInTime,
+	})
 	if err != nil {
 		if errors.Is(err, errMetaNotFound) {
 			return nil, backup.NewErrNotFound(err)
@@ -101,10 +108,19 @@ func (m *Handler) validateRestoreRequest(ctx context.Context, store nodeStore, r
 type fakeSchemaManger struct {
 	errRestoreClass error
 	nodeName        string
+	restored        []*backup.ClassDescriptor
+	restoredTenants []*backup.ClassDescriptor
+}
+
+func (f *fakeSchemaManger) RestoreClass(_ context.Context, d *backup.ClassDescriptor, _ map[string]string,
+) error {
+	f.restored = append(f.restored, d)
+	return f.errRestoreClass
 }
 
-func (f *fakeSchemaManger) RestoreClass(context.Context, *backup.ClassDescriptor, map[string]string,
+func (f *fakeSchemaManger) RestoreTenants(_ context.Context, d *backup.ClassDescriptor, _ map[string]string,
 ) error {
+	f.restoredTenants = append(f.restoredTenants, d)
 	return f.errRestoreClass
 }
 
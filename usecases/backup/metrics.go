@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Metrics of scheduled backups
+type Metrics struct {
+	runs        *prometheus.CounterVec
+	lastSuccess *prometheus.GaugeVec
+	pruned      *prometheus.CounterVec
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		runs:        prom.BackupScheduleRuns,
+		lastSuccess: prom.BackupScheduleLastSuccess,
+		pruned:      prom.BackupSchedulePruned,
+	}
+}
+
+func (m *Metrics) Ran(backend string, err error) {
+	if m == nil {
+		return
+	}
+
+	m.runs.With(prometheus.Labels{
+		"backend_name": backend,
+		"status":       status(err),
+	}).Inc()
+}
+
+func (m *Metrics) Succeeded(backend string, completedAt time.Time) {
+	if m == nil {
+		return
+	}
+
+	m.lastSuccess.With(prometheus.Labels{
+		"backend_name": backend,
+	}).Set(float64(completedAt.Unix()))
+}
+
+func (m *Metrics) Pruned(backend string, err error) {
+	if m == nil {
+		return
+	}
+
+	m.pruned.With(prometheus.Labels{
+		"backend_name": backend,
+		"status":       status(err),
+	}).Inc()
+}
+
+func status(err error) string {
+	if err != nil {
+		return "failed"
+	}
+	return "success"
+}
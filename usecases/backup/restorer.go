@@ -105,9 +105,20 @@ func (r *restorer) restore(ctx context.Context,
 			return
 		}
 
-		err = r.restoreAll(context.Background(), desc, req.CPUPercentage, store, req.NodeMapping)
+		err = r.restoreAll(context.Background(), desc, req, store)
 		if err != nil {
 			r.logger.WithField("action", "restore").WithField("backup_id", desc.ID).Error(err)
+			return
+		}
+
+		if !req.PointInTime.IsZero() {
+			// the changes were archived by the node the backup was taken on
+			err = r.sourcer.ReplayWALArchive(context.Background(),
+				backupNodeName(r.node, req.NodeMapping), desc.List(), desc.StartedAt, req.PointInTime)
+			if err != nil {
+				err = fmt.Errorf("replay changes until %s: %w", req.PointInTime.Format(time.RFC3339), err)
+				r.logger.WithField("action", "restore").WithField("backup_id", desc.ID).Error(err)
+			}
 		}
 	}()
 
@@ -115,13 +126,12 @@ func (r *restorer) restore(ctx context.Context,
 }
 
 func (r *restorer) restoreAll(ctx context.Context,
-	desc *backup.BackupDescriptor, cpuPercentage int,
-	store nodeStore, nodeMapping map[string]string,
+	desc *backup.BackupDescriptor, req *Request, store nodeStore,
 ) (err error) {
 	compressed := desc.Version > version1
 	r.lastOp.set(backup.Transferring)
 	for _, cdesc := range desc.Classes {
-		if err := r.restoreOne(ctx, desc.ID, &cdesc, compressed, cpuPercentage, store, nodeMapping); err != nil {
+		if err := r.restoreOne(ctx, desc.ID, &cdesc, compressed, req, store); err != nil {
 			return fmt.Errorf("restore class %s: %w", cdesc.Name, err)
 		}
 		r.logger.WithField("action", "restore").
@@ -139,9 +149,11 @@ func getType(myvar interface{}) string {
 	}
 }
 
+// restoreOne restores the class described by desc. If only some tenants are
+// restored, they are added to the class if it exists already.
 func (r *restorer) restoreOne(ctx context.Context,
 	backupID string, desc *backup.ClassDescriptor,
-	compressed bool, cpuPercentage int, store nodeStore, nodeMapping map[string]string,
+	compressed bool, req *Request, store nodeStore,
 ) (err error) {
 	metric, err := monitoring.GetMetrics().BackupRestoreDurations.GetMetricWithLabelValues(getType(store.b), desc.Name)
 	if err != nil {
@@ -149,17 +161,35 @@ func (r *restorer) restoreOne(ctx context.Context,
 		defer timer.ObserveDuration()
 	}
 
-	if r.sourcer.ClassExists(desc.Name) {
+	target, tenants := desc.Name, req.Tenants[desc.Name]
+	if name, ok := req.ClassMapping[desc.Name]; ok {
+		target = name
+	}
+	exists := r.sourcer.ClassExists(target)
+	if exists && len(tenants) == 0 {
 		return fmt.Errorf("already exists")
 	}
+	sel, err := selectClass(desc, target, tenants)
+	if err != nil {
+		return err
+	}
+
 	fw := newFileWriter(r.sourcer, store, backupID, compressed).
-		WithPoolPercentage(cpuPercentage)
+		WithPoolPercentage(req.CPUPercentage)
+	if sel != desc {
+		fw = fw.WithSelection(target, tenants)
+	}
 
-	rollback, err := fw.Write(ctx, desc)
+	rollback, err := fw.Write(ctx, sel)
 	if err != nil {
 		return fmt.Errorf("write files: %w", err)
 	}
-	if err := r.schema.RestoreClass(ctx, desc, nodeMapping); err != nil {
+	if exists {
+		err = r.schema.RestoreTenants(ctx, sel, req.NodeMapping)
+	} else {
+		err = r.schema.RestoreClass(ctx, sel, req.NodeMapping)
+	}
+	if err != nil {
 		if rerr := rollback(); rerr != nil {
 			r.logger.WithField("className", desc.Name).WithField("action", "rollback").Error(rerr)
 		}
@@ -208,6 +238,9 @@ func (r *restorer) validate(ctx context.Context, store *nodeStore, req *Request)
 	if v := meta.Version; v > Version {
 		return nil, nil, fmt.Errorf("%s: %s > %s", errMsgHigherVersion, v, Version)
 	}
+	if !req.PointInTime.IsZero() && !r.sourcer.WALArchiveEnabled() {
+		return nil, nil, fmt.Errorf("restore to a point in time: wal archive is not enabled")
+	}
 	cs := meta.List()
 	if len(req.Classes) > 0 {
 		if first := meta.AllExist(req.Classes); first != "" {
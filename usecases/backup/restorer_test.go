@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -432,6 +433,84 @@ func TestManagerRestoreBackup(t *testing.T) {
 		assert.Equal(t, backup.Success, lastStatus.Status)
 	})
 
+	t.Run("ClassMapping", func(t *testing.T) {
+		req1 := BackupRequest{
+			ID:           backupID,
+			Include:      []string{cls},
+			Backend:      backendName,
+			ClassMapping: map[string]string{cls: "DemoCopy"},
+		}
+		backend := newFakeBackend()
+		sourcer := &fakeSourcer{}
+		schema := fakeSchemaManger{nodeName: nodeName}
+		sourcer.On("ClassExists", "DemoCopy").Return(false)
+		meta := meta2
+		meta.Classes = []backup.ClassDescriptor{meta2.Classes[0]}
+		meta.Classes[0].Schema = []byte(`{"class":"DemoClass"}`)
+		meta.Classes[0].ShardingState = []byte(`{"indexID":"DemoClass"}`)
+		bytes := marshalMeta(meta)
+		backend.On("GetObject", ctx, nodeHome, BackupFile).Return(bytes, nil)
+		backend.On("HomeDir", mock.Anything).Return(path)
+		backend.On("SourceDataPath").Return(t.TempDir())
+		backend.On("Read", any, nodeHome, mock.Anything, mock.Anything).Return(any, nil)
+		m := createManager(sourcer, &schema, backend, nil)
+		_, err := m.Restore(ctx, nil, &req1)
+		assert.Nil(t, err)
+		lastStatus := m.restorer.waitForCompletion(req1.Backend, req1.ID, 10, 50)
+		assert.Equal(t, backup.Success, lastStatus.Status)
+		require.Len(t, schema.restored, 1)
+		assert.Contains(t, string(schema.restored[0].Schema), `"class":"DemoCopy"`)
+	})
+
+	t.Run("PointInTime", func(t *testing.T) {
+		var (
+			until = timept.Add(time.Hour)
+			req1  = BackupRequest{
+				ID:          backupID,
+				Include:     []string{cls},
+				Backend:     backendName,
+				PointInTime: until,
+			}
+			backend = newFakeBackend()
+			sourcer = &fakeSourcer{}
+		)
+		sourcer.On("ClassExists", cls).Return(false)
+		sourcer.On("WALArchiveEnabled").Return(true)
+		sourcer.On("ReplayWALArchive", any, nodeName, []string{cls},
+			mock.MatchedBy(timept.Equal), until).Return(nil)
+		bytes := marshalMeta(meta2)
+		backend.On("GetObject", ctx, nodeHome, BackupFile).Return(bytes, nil)
+		backend.On("HomeDir", mock.Anything).Return(path)
+		backend.On("SourceDataPath").Return(t.TempDir())
+		backend.On("Read", any, nodeHome, mock.Anything, mock.Anything).Return(any, nil)
+		m := createManager(sourcer, nil, backend, nil)
+		_, err := m.Restore(ctx, nil, &req1)
+		assert.Nil(t, err)
+		lastStatus := m.restorer.waitForCompletion(req1.Backend, req1.ID, 10, 50)
+		assert.Equal(t, backup.Success, lastStatus.Status)
+		sourcer.AssertExpectations(t)
+	})
+
+	t.Run("PointInTimeWithoutArchive", func(t *testing.T) {
+		req1 := BackupRequest{
+			ID:          backupID,
+			Include:     []string{cls},
+			Backend:     backendName,
+			PointInTime: timept.Add(time.Hour),
+		}
+		backend := newFakeBackend()
+		sourcer := &fakeSourcer{}
+		sourcer.On("WALArchiveEnabled").Return(false)
+		bytes := marshalMeta(meta2)
+		backend.On("GetObject", ctx, nodeHome, BackupFile).Return(bytes, nil)
+		backend.On("HomeDir", mock.Anything).Return(path)
+		m := createManager(sourcer, nil, backend, nil)
+		_, err := m.Restore(ctx, nil, &req1)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "wal archive is not enabled")
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+
 	readSourceFile := func(t *testing.T, newVerion bool) {
 		req1 := BackupRequest{
 			ID:      backupID,
@@ -0,0 +1,353 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/cron"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const (
+	// scheduleIndexID is where the successful scheduled backups are recorded,
+	// since backends cannot list backups
+	scheduleIndexID   = "scheduled-backups"
+	scheduleIndexFile = "index.json"
+
+	scheduleIDPrefix     = "scheduled-"
+	defaultSchedulePoll  = 10 * time.Second
+	scheduleIDTimeLayout = "20060102-150405"
+)
+
+type clusterNodes interface {
+	AllNames() []string
+	LocalName() string
+}
+
+// scheduledBackup is a successful scheduled backup
+type scheduledBackup struct {
+	ID          string    `json:"id"`
+	CompletedAt time.Time `json:"completedAt"`
+}
+
+type scheduleIndex struct {
+	Backups []scheduledBackup `json:"backups"`
+}
+
+// Schedule takes full backups whenever its cron expression matches and
+// deletes the backups which are no longer retained. Changes between two
+// backups can be captured by the WAL archive. Backups are only taken by the
+// first node of the cluster in name order. A nil Schedule is valid and does
+// nothing.
+type Schedule struct {
+	config    config.BackupSchedule
+	cron      *cron.Schedule
+	scheduler *Scheduler
+	backends  BackupBackendProvider
+	nodes     clusterNodes
+	metrics   *Metrics
+	logger    logrus.FieldLogger
+	now       func() time.Time
+	poll      time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewSchedule(cfg config.BackupSchedule, scheduler *Scheduler,
+	backends BackupBackendProvider, nodes clusterNodes, metrics *Metrics,
+	logger logrus.FieldLogger,
+) (*Schedule, error) {
+	c, err := cron.Parse(cfg.Cron)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schedule{
+		config:    cfg,
+		cron:      c,
+		scheduler: scheduler,
+		backends:  backends,
+		nodes:     nodes,
+		metrics:   metrics,
+		logger:    logger.WithField("action", "backup_schedule"),
+		now:       time.Now,
+		poll:      defaultSchedulePoll,
+		done:      make(chan struct{}),
+	}, nil
+}
+
+// Start taking backups whenever the cron expression matches
+func (s *Schedule) Start() {
+	if s == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	go func() {
+		defer close(s.done)
+
+		if s.isLeader() {
+			s.reportLastSuccess(ctx)
+		}
+		for {
+			next := s.cron.Next(s.now())
+			if next.IsZero() {
+				s.logger.Error("cron expression never matches, no backups are taken")
+				return
+			}
+
+			timer := time.NewTimer(next.Sub(s.now()))
+			select {
+			case <-timer.C:
+				if s.isLeader() {
+					s.run(ctx, next)
+				}
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			}
+		}
+	}()
+}
+
+// Shutdown stops taking backups. A running backup is no longer waited for,
+// it completes in the background.
+func (s *Schedule) Shutdown(ctx context.Context) error {
+	if s == nil || s.cancel == nil {
+		return nil
+	}
+
+	s.cancel()
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Schedule) isLeader() bool {
+	names := s.nodes.AllNames()
+	if len(names) == 0 {
+		return false
+	}
+	sort.Strings(names)
+	return names[0] == s.nodes.LocalName()
+}
+
+// run takes the backup scheduled at the given time and prunes the backups
+// which are no longer retained
+func (s *Schedule) run(ctx context.Context, at time.Time) {
+	id := scheduleIDPrefix + at.UTC().Format(scheduleIDTimeLayout)
+	logger := s.logger.WithField("backup_id", id)
+
+	st, err := s.backup(ctx, id)
+	s.metrics.Ran(s.config.Backend, err)
+	if err != nil {
+		logger.WithError(err).Error("scheduled backup failed")
+		return
+	}
+	logger.Info("scheduled backup completed")
+	s.metrics.Succeeded(s.config.Backend, st.CompletedAt)
+
+	if err := s.record(ctx, scheduledBackup{ID: id, CompletedAt: st.CompletedAt}); err != nil {
+		logger.WithError(err).Error("could not record scheduled backup, it is not pruned")
+	}
+}
+
+// backup takes a backup and waits for it to complete
+func (s *Schedule) backup(ctx context.Context, id string) (*Status, error) {
+	_, err := s.scheduler.backup(ctx, &BackupRequest{
+		ID:      id,
+		Backend: s.config.Backend,
+		Include: s.config.Include,
+		Exclude: s.config.Exclude,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(s.poll)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		st, err := s.scheduler.backupStatus(ctx, s.config.Backend, id)
+		if err != nil {
+			return nil, err
+		}
+		switch st.Status {
+		case backup.Success:
+			if st.CompletedAt.IsZero() {
+				st.CompletedAt = s.now()
+			}
+			return st, nil
+		case backup.Failed:
+			return nil, errors.New(st.Err)
+		}
+	}
+}
+
+// record adds a successful backup to the index and prunes the index
+func (s *Schedule) record(ctx context.Context, b scheduledBackup) error {
+	store, err := s.indexStore()
+	if err != nil {
+		return err
+	}
+	var index scheduleIndex
+	if err := store.meta(ctx, scheduleIndexFile, &index); err != nil {
+		if _, ok := err.(backup.ErrNotFound); !ok {
+			return fmt.Errorf("read index: %w", err)
+		}
+	}
+	index.Backups = append(index.Backups, b)
+	index.Backups = s.prune(ctx, index.Backups)
+	if err := store.putMeta(ctx, scheduleIndexFile, &index); err != nil {
+		return fmt.Errorf("write index: %w", err)
+	}
+	return nil
+}
+
+// prune deletes the backups which are no longer retained and returns the
+// remaining ones. The most recent backup is always retained.
+func (s *Schedule) prune(ctx context.Context, backups []scheduledBackup) []scheduledBackup {
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].CompletedAt.After(backups[j].CompletedAt)
+	})
+
+	var expired []scheduledBackup
+	keep, now := make([]scheduledBackup, 0, len(backups)), s.now()
+	for i, b := range backups {
+		if i > 0 && ((s.config.KeepLast > 0 && i >= s.config.KeepLast) ||
+			(s.config.MaxAge > 0 && now.Sub(b.CompletedAt) > s.config.MaxAge)) {
+			expired = append(expired, b)
+			continue
+		}
+		keep = append(keep, b)
+	}
+	if len(expired) == 0 {
+		return keep
+	}
+
+	caps, err := s.backends.BackupBackend(s.config.Backend)
+	if err != nil {
+		s.logger.WithError(err).Error("could not prune scheduled backups")
+		return backups
+	}
+	deleter, ok := caps.(modulecapabilities.BackupObjectDeleter)
+	if !ok {
+		s.logger.Warnf("backend %q cannot delete backups, scheduled backups are not pruned", s.config.Backend)
+		return backups
+	}
+
+	for _, b := range expired {
+		err := deleteBackup(ctx, caps, deleter, b.ID)
+		s.metrics.Pruned(s.config.Backend, err)
+		if err != nil {
+			s.logger.WithField("backup_id", b.ID).WithError(err).
+				Error("could not prune scheduled backup")
+			keep = append(keep, b)
+			continue
+		}
+		s.logger.WithField("backup_id", b.ID).Info("pruned scheduled backup")
+	}
+	return keep
+}
+
+// reportLastSuccess sets the last success metric from the index, so it
+// survives restarts
+func (s *Schedule) reportLastSuccess(ctx context.Context) {
+	store, err := s.indexStore()
+	if err != nil {
+		return
+	}
+	var index scheduleIndex
+	if err := store.meta(ctx, scheduleIndexFile, &index); err != nil {
+		return
+	}
+	var last time.Time
+	for _, b := range index.Backups {
+		if b.CompletedAt.After(last) {
+			last = b.CompletedAt
+		}
+	}
+	if !last.IsZero() {
+		s.metrics.Succeeded(s.config.Backend, last)
+	}
+}
+
+func (s *Schedule) indexStore() (objStore, error) {
+	caps, err := s.backends.BackupBackend(s.config.Backend)
+	if err != nil {
+		return objStore{}, fmt.Errorf("no backup backend %q: %w", s.config.Backend, err)
+	}
+	return objStore{b: caps, BasePath: scheduleIndexID}, nil
+}
+
+// deleteBackup deletes the chunks and metadata of all nodes, and the
+// metadata of the coordinator last. A backup which was deleted partially
+// can be deleted again.
+func deleteBackup(ctx context.Context, caps modulecapabilities.BackupBackend,
+	deleter modulecapabilities.BackupObjectDeleter, id string,
+) error {
+	coord := coordStore{objStore{b: caps, BasePath: id}}
+	meta, err := coord.Meta(ctx, GlobalBackupFile)
+	if err != nil {
+		if _, ok := err.(backup.ErrNotFound); ok {
+			return nil
+		}
+		return fmt.Errorf("get backup: %w", err)
+	}
+
+	for node := range meta.Nodes {
+		basePath := fmt.Sprintf("%s/%s", id, node)
+		store := nodeStore{objStore{b: caps, BasePath: basePath}}
+		var desc backup.BackupDescriptor
+		if err := store.meta(ctx, BackupFile, &desc); err != nil {
+			if _, ok := err.(backup.ErrNotFound); ok {
+				continue
+			}
+			return fmt.Errorf("get backup of node %s: %w", node, err)
+		}
+		for _, class := range desc.Classes {
+			for n := range class.Chunks {
+				if err := deleter.DeleteObject(ctx, basePath, chunkKey(class.Name, n)); err != nil {
+					return err
+				}
+			}
+		}
+		if err := deleter.DeleteObject(ctx, basePath, BackupFile); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range []string{GlobalRestoreFile, BackupFile, GlobalBackupFile} {
+		if err := deleter.DeleteObject(ctx, id, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
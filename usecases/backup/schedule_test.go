@@ -0,0 +1,219 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// memBackend keeps objects in memory and can delete them
+type memBackend struct {
+	modulecapabilities.BackupBackend
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func (b *memBackend) GetObject(ctx context.Context, backupID, key string) ([]byte, error) {
+	b.Lock()
+	defer b.Unlock()
+	data, ok := b.objects[backupID+"/"+key]
+	if !ok {
+		return nil, backup.NewErrNotFound(fmt.Errorf("object %q not found", key))
+	}
+	return data, nil
+}
+
+func (b *memBackend) PutObject(ctx context.Context, backupID, key string, data []byte) error {
+	b.Lock()
+	defer b.Unlock()
+	b.objects[backupID+"/"+key] = data
+	return nil
+}
+
+func (b *memBackend) DeleteObject(ctx context.Context, backupID, key string) error {
+	b.Lock()
+	defer b.Unlock()
+	delete(b.objects, backupID+"/"+key)
+	return nil
+}
+
+func (b *memBackend) keys() []string {
+	b.Lock()
+	defer b.Unlock()
+	keys := make([]string, 0, len(b.objects))
+	for key := range b.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// noDeleteBackend hides the DeleteObject method of its backend
+type noDeleteBackend struct {
+	modulecapabilities.BackupBackend
+}
+
+type fakeNodes struct {
+	names []string
+	local string
+}
+
+func (n fakeNodes) AllNames() []string { return n.names }
+func (n fakeNodes) LocalName() string  { return n.local }
+
+// putBackup stores the metadata and chunks of a backup of class Article
+// taken by node1
+func putBackup(t *testing.T, b *memBackend, id string) {
+	put := func(key string, v interface{}) {
+		data, err := json.Marshal(v)
+		require.Nil(t, err)
+		require.Nil(t, b.PutObject(context.Background(), id, key, data))
+	}
+	put(GlobalBackupFile, backup.DistributedBackupDescriptor{
+		ID:     id,
+		Nodes:  map[string]*backup.NodeDescriptor{"node1": {Classes: []string{"Article"}}},
+		Status: backup.Success,
+	})
+	put("node1/"+BackupFile, backup.BackupDescriptor{
+		ID:      id,
+		Classes: []backup.ClassDescriptor{{Name: "Article", Chunks: map[int32][]string{1: {"s1"}, 2: {"s2"}}}},
+	})
+	put("node1/"+chunkKey("Article", 1), "chunk")
+	put("node1/"+chunkKey("Article", 2), "chunk")
+}
+
+func TestSchedulePrune(t *testing.T) {
+	var (
+		ctx = context.Background()
+		now = time.Date(2023, 6, 10, 2, 0, 0, 0, time.UTC)
+		day = 24 * time.Hour
+	)
+
+	newSchedule := func(cfg config.BackupSchedule, backend modulecapabilities.BackupBackend) *Schedule {
+		cfg.Backend, cfg.Cron = "mem", "0 2 * * *"
+		logger, _ := test.NewNullLogger()
+		s, err := NewSchedule(cfg, nil, &fakeBackupBackendProvider{backend: backend}, fakeNodes{}, nil, logger)
+		require.Nil(t, err)
+		s.now = func() time.Time { return now }
+		return s
+	}
+
+	// backups taken on the last four days
+	backups := func(b *memBackend) []scheduledBackup {
+		var sbs []scheduledBackup
+		for i := 3; i >= 0; i-- {
+			id := fmt.Sprintf("scheduled-%d", i)
+			putBackup(t, b, id)
+			sbs = append(sbs, scheduledBackup{ID: id, CompletedAt: now.Add(-time.Duration(i) * day)})
+		}
+		return sbs
+	}
+	ids := func(sbs []scheduledBackup) []string {
+		var ids []string
+		for _, b := range sbs {
+			ids = append(ids, b.ID)
+		}
+		return ids
+	}
+
+	t.Run("keep last", func(t *testing.T) {
+		b := &memBackend{objects: map[string][]byte{}}
+		s := newSchedule(config.BackupSchedule{KeepLast: 2}, b)
+		kept := s.prune(ctx, backups(b))
+		assert.Equal(t, []string{"scheduled-0", "scheduled-1"}, ids(kept))
+		assert.NotContains(t, b.keys(), "scheduled-2/"+GlobalBackupFile)
+		assert.NotContains(t, b.keys(), "scheduled-3/node1/Article/chunk-1")
+		assert.Len(t, b.keys(), 8)
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		b := &memBackend{objects: map[string][]byte{}}
+		s := newSchedule(config.BackupSchedule{MaxAge: 36 * time.Hour}, b)
+		kept := s.prune(ctx, backups(b))
+		assert.Equal(t, []string{"scheduled-0", "scheduled-1"}, ids(kept))
+	})
+
+	t.Run("latest backup is always kept", func(t *testing.T) {
+		b := &memBackend{objects: map[string][]byte{}}
+		s := newSchedule(config.BackupSchedule{MaxAge: time.Hour}, b)
+		s.now = func() time.Time { return now.Add(30 * day) }
+		kept := s.prune(ctx, backups(b))
+		assert.Equal(t, []string{"scheduled-0"}, ids(kept))
+		assert.Len(t, b.keys(), 4)
+	})
+
+	t.Run("no retention", func(t *testing.T) {
+		b := &memBackend{objects: map[string][]byte{}}
+		s := newSchedule(config.BackupSchedule{}, b)
+		kept := s.prune(ctx, backups(b))
+		assert.Len(t, kept, 4)
+		assert.Len(t, b.keys(), 16)
+	})
+
+	t.Run("backend cannot delete", func(t *testing.T) {
+		b := &memBackend{objects: map[string][]byte{}}
+		sbs := backups(b)
+		s := newSchedule(config.BackupSchedule{KeepLast: 1}, noDeleteBackend{b})
+		kept := s.prune(ctx, sbs)
+		assert.Len(t, kept, 4)
+		assert.Len(t, b.keys(), 16)
+	})
+
+	t.Run("record", func(t *testing.T) {
+		b := &memBackend{objects: map[string][]byte{}}
+		s := newSchedule(config.BackupSchedule{KeepLast: 2}, b)
+		for _, sb := range backups(b) {
+			require.Nil(t, s.record(ctx, sb))
+		}
+
+		var index scheduleIndex
+		data, err := b.GetObject(ctx, scheduleIndexID, scheduleIndexFile)
+		require.Nil(t, err)
+		require.Nil(t, json.Unmarshal(data, &index))
+		assert.Equal(t, []string{"scheduled-0", "scheduled-1"}, ids(index.Backups))
+		assert.Len(t, b.keys(), 9)
+	})
+}
+
+func TestScheduleLeader(t *testing.T) {
+	for _, tc := range []struct {
+		nodes fakeNodes
+		want  bool
+	}{
+		{fakeNodes{[]string{"node1"}, "node1"}, true},
+		{fakeNodes{[]string{"node2", "node1"}, "node1"}, true},
+		{fakeNodes{[]string{"node2", "node1"}, "node2"}, false},
+		{fakeNodes{nil, "node1"}, false},
+	} {
+		s := &Schedule{nodes: tc.nodes}
+		assert.Equal(t, tc.want, s.isLeader(), tc.nodes)
+	}
+}
+
+func TestScheduleNil(t *testing.T) {
+	var s *Schedule
+	s.Start()
+	assert.Nil(t, s.Shutdown(context.Background()))
+}
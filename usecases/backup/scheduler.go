@@ -76,6 +76,12 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 	if err := s.authorizer.Authorize(pr, "add", path); err != nil {
 		return nil, err
 	}
+	return s.backup(ctx, req)
+}
+
+// backup starts a backup on behalf of the server itself, it is not
+// authorized
+func (s *Scheduler) backup(ctx context.Context, req *BackupRequest) (*models.BackupCreateResponse, error) {
 	store, err := coordBackend(s.backends, req.Backend, req.ID)
 	if err != nil {
 		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", req.Backend, err)
@@ -161,6 +167,10 @@ func (s *Scheduler) BackupStatus(ctx context.Context, principal *models.Principa
 	if err := s.authorizer.Authorize(principal, "get", path); err != nil {
 		return nil, err
 	}
+	return s.backupStatus(ctx, backend, backupID)
+}
+
+func (s *Scheduler) backupStatus(ctx context.Context, backend, backupID string) (*Status, error) {
 	store, err := coordBackend(s.backends, backend, backupID)
 	if err != nil {
 		err = fmt.Errorf("no backup provider %q: %w, did you enable the right module?", backend, err)
@@ -294,6 +304,25 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 		meta.NodeMapping = req.NodeMapping
 		meta.ApplyNodeMapping()
 	}
+	if !req.PointInTime.IsZero() {
+		// changes made while the backup was taken might be part of it
+		if req.PointInTime.Before(meta.CompletedAt) {
+			return nil, fmt.Errorf("point in time %s is before the backup completed at %s",
+				req.PointInTime.Format(time.RFC3339), meta.CompletedAt.Format(time.RFC3339))
+		}
+		if req.PointInTime.After(time.Now()) {
+			return nil, fmt.Errorf("point in time %s is in the future", req.PointInTime.Format(time.RFC3339))
+		}
+		meta.PointInTime = req.PointInTime
+	}
+	mapping, err := validateSelection(meta.Classes(), req)
+	if err != nil {
+		return nil, err
+	}
+	meta.ClassMapping = mapping
+	if len(req.Tenants) > 0 {
+		meta.Tenants = req.Tenants
+	}
 	return meta, nil
 }
 
@@ -682,6 +682,34 @@ func TestSchedulerRestoreRequestValidation(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), cls)
 	})
+
+	t.Run("PointInTimeBeforeBackup", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		meta := meta
+		meta.CompletedAt = timePt.Add(time.Minute)
+
+		bytes := marshalCoordinatorMeta(meta)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(bytes, nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		_, err := fs.scheduler().Restore(ctx, nil, &BackupRequest{
+			ID: id, Include: []string{cls}, PointInTime: timePt,
+		})
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), "before the backup completed")
+	})
+
+	t.Run("PointInTimeInFuture", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+
+		bytes := marshalCoordinatorMeta(meta)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(bytes, nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		_, err := fs.scheduler().Restore(ctx, nil, &BackupRequest{
+			ID: id, Include: []string{cls}, PointInTime: time.Now().Add(time.Hour),
+		})
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), "in the future")
+	})
 }
 
 type fakeScheduler struct {
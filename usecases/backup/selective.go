@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// validateSelection checks the class mapping and the selected tenants of a
+// restore request against the classes to be restored. It returns the class
+// mapping with normalized class names.
+func validateSelection(classes []string, req *BackupRequest) (map[string]string, error) {
+	if len(req.ClassMapping) == 0 && len(req.Tenants) == 0 {
+		return nil, nil
+	}
+	if !req.PointInTime.IsZero() {
+		return nil, fmt.Errorf("a point in time restore can neither rename classes nor select tenants")
+	}
+
+	restored := make(map[string]struct{}, len(classes))
+	for _, cls := range classes {
+		restored[cls] = struct{}{}
+	}
+
+	mapping := make(map[string]string, len(req.ClassMapping))
+	for from, to := range req.ClassMapping {
+		if _, ok := restored[from]; !ok {
+			return nil, fmt.Errorf("class mapping: class %s is not restored", from)
+		}
+		name, err := schema.ValidateClassName(schema.UppercaseClassName(to))
+		if err != nil {
+			return nil, fmt.Errorf("class mapping: %w", err)
+		}
+		mapping[from] = name.String()
+	}
+
+	// two classes must not end up as the same class
+	targets := make(map[string]string, len(classes))
+	sort.Strings(classes)
+	for _, cls := range classes {
+		to := cls
+		if name, ok := mapping[cls]; ok {
+			to = name
+		}
+		if other, ok := targets[to]; ok {
+			return nil, fmt.Errorf("class mapping: classes %s and %s would both be restored as %s", other, cls, to)
+		}
+		targets[to] = cls
+	}
+
+	for cls, tenants := range req.Tenants {
+		if _, ok := restored[cls]; !ok {
+			return nil, fmt.Errorf("tenants: class %s is not restored", cls)
+		}
+		if len(tenants) == 0 {
+			return nil, fmt.Errorf("tenants: empty tenant list for class %s", cls)
+		}
+		if dup := findDuplicate(tenants); dup != "" {
+			return nil, fmt.Errorf("tenants: tenant list of class %s contains duplicate: %s", cls, dup)
+		}
+	}
+	return mapping, nil
+}
+
+// selectClass returns the descriptor of desc restored as class target. If
+// tenants are given, only these tenants are part of the returned descriptor.
+// The name of the returned descriptor is still the name of the class in the
+// backup, since it determines where the files are stored.
+func selectClass(desc *backup.ClassDescriptor, target string, tenants []string,
+) (*backup.ClassDescriptor, error) {
+	if target == desc.Name && len(tenants) == 0 {
+		return desc, nil
+	}
+
+	var class models.Class
+	if err := json.Unmarshal(desc.Schema, &class); err != nil {
+		return nil, fmt.Errorf("unmarshal class schema: %w", err)
+	}
+	var state sharding.State
+	if err := json.Unmarshal(desc.ShardingState, &state); err != nil {
+		return nil, fmt.Errorf("unmarshal sharding state: %w", err)
+	}
+
+	class.Class = target
+	for _, prop := range class.Properties {
+		// references of a class to itself follow the rename
+		for i, dt := range prop.DataType {
+			if dt == desc.Name {
+				prop.DataType[i] = target
+			}
+		}
+	}
+	state.IndexID = target
+
+	sel := *desc
+	if len(tenants) > 0 {
+		if !state.PartitioningEnabled {
+			return nil, fmt.Errorf("tenants can only be selected for multi-tenant classes")
+		}
+		physical := make(map[string]sharding.Physical, len(tenants))
+		for _, tenant := range tenants {
+			p, ok := state.Physical[tenant]
+			if !ok {
+				return nil, fmt.Errorf("tenant %s is not part of the backup", tenant)
+			}
+			physical[tenant] = p
+		}
+		state.Physical = physical
+		sel.Shards, sel.Chunks = selectShards(desc, physical)
+	}
+
+	var err error
+	if sel.Schema, err = json.Marshal(class); err != nil {
+		return nil, fmt.Errorf("marshal class schema: %w", err)
+	}
+	if sel.ShardingState, err = json.Marshal(state); err != nil {
+		return nil, fmt.Errorf("marshal sharding state: %w", err)
+	}
+	return &sel, nil
+}
+
+// selectShards returns the shards of desc which are part of physical and the
+// chunks containing them. Chunks might contain other shards as well.
+func selectShards(desc *backup.ClassDescriptor, physical map[string]sharding.Physical,
+) ([]*backup.ShardDescriptor, map[int32][]string) {
+	shards := make([]*backup.ShardDescriptor, 0, len(physical))
+	for _, shard := range desc.Shards {
+		if _, ok := physical[shard.Name]; ok {
+			shards = append(shards, shard)
+		}
+	}
+	if desc.Chunks == nil {
+		return shards, nil
+	}
+
+	chunks := make(map[int32][]string, len(shards))
+	for id, names := range desc.Chunks {
+		for _, name := range names {
+			if _, ok := physical[name]; ok {
+				chunks[id] = names
+				break
+			}
+		}
+	}
+	return shards, chunks
+}
@@ -0,0 +1,203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestValidateSelection(t *testing.T) {
+	classes := []string{"Article", "Author"}
+
+	tests := []struct {
+		name    string
+		req     BackupRequest
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "nothing selected",
+		},
+		{
+			name: "rename",
+			req:  BackupRequest{ClassMapping: map[string]string{"Article": "articleCopy"}},
+			want: map[string]string{"Article": "ArticleCopy"},
+		},
+		{
+			name:    "rename unknown class",
+			req:     BackupRequest{ClassMapping: map[string]string{"Unknown": "Other"}},
+			wantErr: "class Unknown is not restored",
+		},
+		{
+			name:    "invalid class name",
+			req:     BackupRequest{ClassMapping: map[string]string{"Article": "Not-Valid"}},
+			wantErr: "class mapping",
+		},
+		{
+			name:    "rename to restored class",
+			req:     BackupRequest{ClassMapping: map[string]string{"Article": "Author"}},
+			wantErr: "both be restored as Author",
+		},
+		{
+			name: "swap classes",
+			req:  BackupRequest{ClassMapping: map[string]string{"Article": "Author", "Author": "Article"}},
+			want: map[string]string{"Article": "Author", "Author": "Article"},
+		},
+		{
+			name: "tenants",
+			req:  BackupRequest{Tenants: map[string][]string{"Article": {"t1", "t2"}}},
+			want: map[string]string{},
+		},
+		{
+			name:    "tenants of unknown class",
+			req:     BackupRequest{Tenants: map[string][]string{"Unknown": {"t1"}}},
+			wantErr: "class Unknown is not restored",
+		},
+		{
+			name:    "empty tenants",
+			req:     BackupRequest{Tenants: map[string][]string{"Article": {}}},
+			wantErr: "empty tenant list",
+		},
+		{
+			name:    "duplicate tenants",
+			req:     BackupRequest{Tenants: map[string][]string{"Article": {"t1", "t1"}}},
+			wantErr: "duplicate: t1",
+		},
+		{
+			name: "point in time",
+			req: BackupRequest{
+				Tenants:     map[string][]string{"Article": {"t1"}},
+				PointInTime: time.Now(),
+			},
+			wantErr: "point in time",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := validateSelection(append([]string{}, classes...), &test.req)
+			if test.wantErr != "" {
+				assert.ErrorContains(t, err, test.wantErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestSelectClass(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "related", DataType: []string{"Article", "Author"}},
+		},
+	}
+	schemaBytes, err := json.Marshal(class)
+	require.Nil(t, err)
+	state := sharding.State{IndexID: "Article", Physical: map[string]sharding.Physical{}, PartitioningEnabled: true}
+	for _, tenant := range []string{"t1", "t2", "t3"} {
+		state.AddPartition(tenant, []string{"node1"}, models.TenantActivityStatusHOT)
+	}
+	stateBytes, err := state.JSON()
+	require.Nil(t, err)
+	desc := &backup.ClassDescriptor{
+		Name:          "Article",
+		Schema:        schemaBytes,
+		ShardingState: stateBytes,
+		Shards:        []*backup.ShardDescriptor{{Name: "t1"}, {Name: "t2"}, {Name: "t3"}},
+		Chunks:        map[int32][]string{1: {"t1", "t2"}, 2: {"t3"}},
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		sel, err := selectClass(desc, "Article", nil)
+		require.Nil(t, err)
+		assert.Same(t, desc, sel)
+	})
+
+	t.Run("rename and select tenants", func(t *testing.T) {
+		sel, err := selectClass(desc, "ArticleCopy", []string{"t2"})
+		require.Nil(t, err)
+		assert.Equal(t, "Article", sel.Name)
+		assert.Equal(t, []*backup.ShardDescriptor{{Name: "t2"}}, sel.Shards)
+		assert.Equal(t, map[int32][]string{1: {"t1", "t2"}}, sel.Chunks)
+
+		var got models.Class
+		require.Nil(t, json.Unmarshal(sel.Schema, &got))
+		assert.Equal(t, "ArticleCopy", got.Class)
+		assert.Equal(t, []string{"ArticleCopy", "Author"}, got.Properties[0].DataType)
+
+		var gotState sharding.State
+		require.Nil(t, json.Unmarshal(sel.ShardingState, &gotState))
+		assert.Equal(t, "ArticleCopy", gotState.IndexID)
+		assert.Len(t, gotState.Physical, 1)
+		assert.Contains(t, gotState.Physical, "t2")
+
+		// the original descriptor is not changed
+		assert.Len(t, desc.Shards, 3)
+	})
+
+	t.Run("unknown tenant", func(t *testing.T) {
+		_, err := selectClass(desc, "Article", []string{"t4"})
+		assert.ErrorContains(t, err, "tenant t4 is not part of the backup")
+	})
+
+	t.Run("not multi-tenant", func(t *testing.T) {
+		state := sharding.State{IndexID: "Article"}
+		stateBytes, err := state.JSON()
+		require.Nil(t, err)
+		desc := &backup.ClassDescriptor{Name: "Article", Schema: schemaBytes, ShardingState: stateBytes}
+		_, err = selectClass(desc, "Article", []string{"t1"})
+		assert.ErrorContains(t, err, "multi-tenant")
+	})
+}
+
+func TestFileWriterSelection(t *testing.T) {
+	var (
+		destDir      = t.TempDir()
+		classTempDir = t.TempDir()
+		write        = func(dir, file string) {
+			require.Nil(t, os.MkdirAll(path.Dir(path.Join(dir, file)), os.ModePerm))
+			require.Nil(t, os.WriteFile(path.Join(dir, file), []byte(file), 0o644))
+		}
+	)
+	write(classTempDir, "article/t1/indexcount")
+	write(classTempDir, "article/t2/indexcount")
+	write(destDir, "articlecopy/existing/indexcount")
+
+	fw := &fileWriter{destDir: destDir}
+	fw = fw.WithSelection("ArticleCopy", []string{"t2"})
+	require.Nil(t, fw.moveAll(classTempDir))
+
+	assert.FileExists(t, path.Join(destDir, "articlecopy", "t2", "indexcount"))
+	assert.NoDirExists(t, path.Join(destDir, "articlecopy", "t1"))
+	assert.NoDirExists(t, path.Join(destDir, "article"))
+
+	require.Nil(t, fw.rollBack(classTempDir))
+	assert.NoDirExists(t, path.Join(destDir, "articlecopy", "t2"))
+	assert.FileExists(t, path.Join(destDir, "articlecopy", "existing", "indexcount"))
+
+	t.Run("shard exists", func(t *testing.T) {
+		write(classTempDir, "article/existing/indexcount")
+		fw := (&fileWriter{destDir: destDir}).WithSelection("ArticleCopy", nil)
+		assert.ErrorContains(t, fw.moveAll(classTempDir), "destination exists")
+	})
+}
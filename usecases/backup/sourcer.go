@@ -13,6 +13,7 @@ package backup
 
 import (
 	"context"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/backup"
 )
@@ -42,4 +43,12 @@ type Sourcer interface { // implemented by the index
 	//
 	// A class cannot be backed up either if it doesn't exist or if it has more than one physical shard.
 	ListBackupable() []string
+
+	// WALArchiveEnabled returns whether all changes are archived, which is
+	// required to restore a backup to a point in time
+	WALArchiveEnabled() bool
+
+	// ReplayWALArchive applies the archived changes of the given classes which
+	// were made on node between from and until
+	ReplayWALArchive(_ context.Context, node string, classes []string, from, until time.Time) error
 }
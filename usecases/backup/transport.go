@@ -54,6 +54,15 @@ type Request struct {
 	CPUPercentage int
 
 	CompressionLevel int
+
+	// PointInTime the restored classes are rolled forward to, if set
+	PointInTime time.Time
+
+	// ClassMapping specifies the names classes are restored as
+	ClassMapping map[string]string
+
+	// Tenants specifies the tenants restored of multi-tenant classes
+	Tenants map[string][]string
 }
 
 type CanCommitResponse struct {
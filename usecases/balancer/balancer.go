@@ -0,0 +1,461 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package balancer moves the replicas of shards between the nodes of the
+// cluster. Shards stay on the nodes they were created on, so nodes which
+// join later stay nearly empty. The coordinator of the cluster, which is the
+// first of its nodes by name, moves replicas in the configured interval from
+// the nodes holding the most replicas to the ones holding the fewest.
+// Operators can also move single replicas, or drain all of them off a node
+// before removing it.
+package balancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+var (
+	// ErrNotFound indicates an unknown class, shard or node
+	ErrNotFound = errors.New("not found")
+	// ErrInvalid indicates a move which cannot be made
+	ErrInvalid = errors.New("invalid move")
+	// ErrRunning indicates that a replica of the shard is being moved already
+	ErrRunning = errors.New("shard is being moved already")
+	// ErrDraining indicates that the node is being drained already
+	ErrDraining = errors.New("node is being drained already")
+)
+
+const (
+	TriggerManual    = "manual"
+	TriggerScheduled = "scheduled"
+	TriggerDrain     = "drain"
+
+	StatusRunning = "RUNNING"
+	StatusSuccess = "SUCCESS"
+	StatusFailed  = "FAILED"
+)
+
+type schemaManager interface {
+	GetSchemaSkipAuth() schema.Schema
+	CopyShardingState(class string) *sharding.State
+	CommitShardMove(ctx context.Context, className, shard, source, target string) error
+}
+
+type copier interface {
+	CopyReplica(ctx context.Context, className, shard, source, target string,
+		progress *scaler.Progress) error
+	BandwidthLimit() int64
+	SetBandwidthLimit(bytesPerSecond int64)
+}
+
+type cluster interface {
+	AllNames() []string
+	Candidates() []string
+	LocalName() string
+	NodeHostname(nodeName string) (string, bool)
+	SetDraining(draining bool) error
+	Draining(nodeName string) bool
+}
+
+// nodeClient marks other nodes as draining
+type nodeClient interface {
+	SetDraining(ctx context.Context, hostName string, draining bool) error
+}
+
+// Move of a shard replica from the source to the target node
+type Move struct {
+	Class      string     `json:"class"`
+	Shard      string     `json:"shard"`
+	Source     string     `json:"source"`
+	Target     string     `json:"target"`
+	Trigger    string     `json:"trigger"`
+	Status     string     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	Bytes      int64      `json:"bytes"` // copied by this node so far
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// Status of the balancer and the moves started by this node
+type Status struct {
+	Enabled        bool           `json:"enabled"`
+	Interval       string         `json:"interval"`
+	Threshold      int            `json:"threshold"`
+	BandwidthLimit int64          `json:"bandwidthLimit"`
+	Replicas       map[string]int `json:"replicas"` // active replicas per node
+	Moves          []Move         `json:"moves"`
+}
+
+type key struct {
+	class string
+	shard string
+}
+
+type move struct {
+	Move
+	progress *scaler.Progress
+}
+
+// Manager moves shard replicas, either to balance the nodes or on request.
+// A nil Manager is valid and does nothing.
+type Manager struct {
+	config  config.ShardBalancer
+	schema  schemaManager
+	copier  copier
+	cluster cluster
+	client  nodeClient
+	metrics *Metrics
+	logger  logrus.FieldLogger
+	now     func() time.Time
+
+	sync.Mutex
+	moves  map[key]*move
+	drains map[string]*drain
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+func NewManager(cfg config.ShardBalancer, schema schemaManager, copier copier,
+	cluster cluster, client nodeClient, metrics *Metrics, logger logrus.FieldLogger,
+) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	copier.SetBandwidthLimit(cfg.BandwidthLimit)
+	return &Manager{
+		config:  cfg,
+		schema:  schema,
+		copier:  copier,
+		cluster: cluster,
+		client:  client,
+		metrics: metrics,
+		logger:  logger.WithField("action", "shard_balancer"),
+		now:     time.Now,
+		moves:   map[key]*move{},
+		drains:  map[string]*drain{},
+		ctx:     ctx,
+		cancel:  cancel,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start balancing the nodes in the configured interval, unless the balancer
+// is disabled
+func (m *Manager) Start() {
+	if m == nil {
+		return
+	}
+	if !m.config.Enabled {
+		close(m.done)
+		return
+	}
+
+	go func() {
+		defer close(m.done)
+
+		ticker := time.NewTicker(m.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.balance()
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Shutdown stops the balancer and cancels running moves
+func (m *Manager) Shutdown(ctx context.Context) error {
+	if m == nil {
+		return nil
+	}
+
+	close(m.stop)
+	m.cancel()
+	finished := make(chan struct{})
+	go func() {
+		<-m.done
+		m.wg.Wait()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Status returns the configuration, the replicas per node and the latest
+// move of every shard started by this node
+func (m *Manager) Status() Status {
+	if m == nil {
+		return Status{}
+	}
+
+	m.Lock()
+	moves := make([]Move, 0, len(m.moves))
+	for _, mv := range m.moves {
+		moves = append(moves, mv.snapshot())
+	}
+	m.Unlock()
+	sort.Slice(moves, func(i, j int) bool {
+		return moves[i].StartedAt.After(moves[j].StartedAt)
+	})
+
+	nodes, placements := m.placements()
+	return Status{
+		Enabled:        m.config.Enabled,
+		Interval:       m.config.Interval.String(),
+		Threshold:      m.config.Threshold,
+		BandwidthLimit: m.copier.BandwidthLimit(),
+		Replicas:       countReplicas(nodes, placements),
+		Moves:          moves,
+	}
+}
+
+// SetBandwidthLimit changes the bytes per second used by this node to copy
+// shards, including running copies. Zero removes the limit.
+func (m *Manager) SetBandwidthLimit(bytesPerSecond int64) error {
+	if m == nil {
+		return nil
+	}
+	if bytesPerSecond < 0 {
+		return fmt.Errorf("bandwidth limit must not be negative")
+	}
+	m.copier.SetBandwidthLimit(bytesPerSecond)
+	m.logger.WithField("bandwidth_limit", bytesPerSecond).Info("bandwidth limit changed")
+	return nil
+}
+
+// Move the replica of a shard from the source to the target node in the
+// background, its progress is reported by Status. The class may be omitted
+// if the shard name is unique, the source if the shard has a single replica.
+func (m *Manager) Move(class, shard, source, target string) (Move, error) {
+	if m == nil {
+		return Move{}, nil
+	}
+
+	class, nodes, err := m.findShard(class, shard)
+	if err != nil {
+		return Move{}, err
+	}
+	if source == "" {
+		if len(nodes) != 1 {
+			return Move{}, fmt.Errorf("%w: shard %q has %d replicas, the source node is required",
+				ErrInvalid, shard, len(nodes))
+		}
+		source = nodes[0]
+	}
+	if !contains(nodes, source) {
+		return Move{}, fmt.Errorf("%w: shard %q has no replica on node %q", ErrInvalid, shard, source)
+	}
+	if contains(nodes, target) {
+		return Move{}, fmt.Errorf("%w: shard %q has a replica on node %q already", ErrInvalid, shard, target)
+	}
+	if !contains(m.cluster.Candidates(), target) {
+		return Move{}, fmt.Errorf("node %q: %w", target, ErrNotFound)
+	}
+
+	mv, err := m.startMove(class, shard, source, target, TriggerManual)
+	if err != nil {
+		return Move{}, err
+	}
+	started := mv.snapshot()
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.run(m.ctx, mv)
+	}()
+	return started, nil
+}
+
+// findShard returns the class and the replicas of a shard
+func (m *Manager) findShard(class, shard string) (string, []string, error) {
+	var classes []string
+	if class != "" {
+		classes = []string{schema.UppercaseClassName(class)}
+	} else {
+		for _, c := range m.schema.GetSchemaSkipAuth().Objects.Classes {
+			classes = append(classes, c.Class)
+		}
+	}
+
+	var found string
+	var nodes []string
+	for _, c := range classes {
+		st := m.schema.CopyShardingState(c)
+		if st == nil {
+			continue
+		}
+		physical, ok := st.Physical[shard]
+		if !ok {
+			continue
+		}
+		if found != "" {
+			return "", nil, fmt.Errorf("%w: shard %q exists in classes %q and %q, the class is required",
+				ErrInvalid, shard, found, c)
+		}
+		found, nodes = c, physical.BelongsToNodes
+	}
+	if found == "" {
+		return "", nil, fmt.Errorf("shard %q: %w", shard, ErrNotFound)
+	}
+	return found, nodes, nil
+}
+
+// startMove registers a move, unless the shard is being moved already
+func (m *Manager) startMove(class, shard, source, target, trigger string) (*move, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	k := key{class, shard}
+	if mv, ok := m.moves[k]; ok && mv.Status == StatusRunning {
+		return nil, fmt.Errorf("shard %q of class %q: %w", shard, class, ErrRunning)
+	}
+	mv := &move{
+		Move: Move{
+			Class:     class,
+			Shard:     shard,
+			Source:    source,
+			Target:    target,
+			Trigger:   trigger,
+			Status:    StatusRunning,
+			StartedAt: m.now(),
+		},
+		progress: &scaler.Progress{},
+	}
+	m.moves[k] = mv
+	return mv, nil
+}
+
+// balance moves replicas until the nodes are balanced. Only the coordinator
+// balances, so that nodes do not move the same replicas.
+func (m *Manager) balance() {
+	nodes, placements := m.placements()
+	if len(nodes) < 2 || nodes[0] != m.cluster.LocalName() {
+		return
+	}
+
+	for _, p := range plan(nodes, placements, m.config.Threshold) {
+		mv, err := m.startMove(p.class, p.shard, p.source, p.target, TriggerScheduled)
+		if err != nil {
+			// moved manually in the meantime, the next round plans again
+			return
+		}
+		if err := m.run(m.ctx, mv); err != nil {
+			return
+		}
+	}
+}
+
+func (m *Manager) run(ctx context.Context, mv *move) error {
+	err := m.copier.CopyReplica(ctx, mv.Class, mv.Shard, mv.Source, mv.Target, mv.progress)
+	if err == nil {
+		err = m.schema.CommitShardMove(ctx, mv.Class, mv.Shard, mv.Source, mv.Target)
+	}
+
+	m.Lock()
+	finished := m.now()
+	mv.FinishedAt = &finished
+	mv.Status = StatusSuccess
+	if err != nil {
+		mv.Status = StatusFailed
+		mv.Error = err.Error()
+	}
+	m.Unlock()
+
+	bytes := mv.progress.Bytes.Load()
+	m.metrics.Moved(mv.Class, mv.Trigger, finished.Sub(mv.StartedAt), bytes, err)
+	logger := m.logger.WithField("class", mv.Class).WithField("shard", mv.Shard).
+		WithField("source", mv.Source).WithField("target", mv.Target).
+		WithField("trigger", mv.Trigger)
+	if err != nil {
+		logger.WithError(err).Error("could not move shard replica")
+		return err
+	}
+	logger.WithField("bytes", bytes).Info("moved shard replica")
+	return nil
+}
+
+// placements returns the sorted candidate nodes and the replicas of all
+// shards which can be moved. Inactive tenants are not loaded and are
+// therefore left in place.
+func (m *Manager) placements() ([]string, []placement) {
+	nodes := append([]string{}, m.cluster.Candidates()...)
+	sort.Strings(nodes)
+	return nodes, m.replicas(false)
+}
+
+// replicas returns the sorted replicas of all shards, inactive tenants are
+// only included if requested
+func (m *Manager) replicas(inactive bool) []placement {
+	var placements []placement
+	for _, c := range m.schema.GetSchemaSkipAuth().Objects.Classes {
+		st := m.schema.CopyShardingState(c.Class)
+		if st == nil {
+			continue
+		}
+		for _, name := range st.AllPhysicalShards() {
+			physical := st.Physical[name]
+			if !inactive && physical.ActivityStatus() == models.TenantActivityStatusCOLD {
+				continue
+			}
+			placements = append(placements, placement{
+				class: c.Class,
+				shard: name,
+				nodes: append([]string{}, physical.BelongsToNodes...),
+			})
+		}
+	}
+	sort.Slice(placements, func(i, j int) bool {
+		if placements[i].class != placements[j].class {
+			return placements[i].class < placements[j].class
+		}
+		return placements[i].shard < placements[j].shard
+	})
+	return placements
+}
+
+// snapshot must be called with the lock of the manager held, unless the
+// move has not been started yet
+func (mv *move) snapshot() Move {
+	s := mv.Move
+	s.Bytes = mv.progress.Bytes.Load()
+	return s
+}
+
+func contains(xs []string, x string) bool {
+	for _, y := range xs {
+		if x == y {
+			return true
+		}
+	}
+	return false
+}
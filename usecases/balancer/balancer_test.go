@@ -0,0 +1,478 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package balancer
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeSchema struct {
+	sync.Mutex
+	states map[string]*sharding.State
+}
+
+func (f *fakeSchema) GetSchemaSkipAuth() schema.Schema {
+	f.Lock()
+	defer f.Unlock()
+	classes := []*models.Class{}
+	for class := range f.states {
+		classes = append(classes, &models.Class{Class: class})
+	}
+	return schema.Schema{Objects: &models.Schema{Classes: classes}}
+}
+
+func (f *fakeSchema) CopyShardingState(class string) *sharding.State {
+	f.Lock()
+	defer f.Unlock()
+	st, ok := f.states[class]
+	if !ok {
+		return nil
+	}
+	c := st.DeepCopy()
+	return &c
+}
+
+func (f *fakeSchema) CommitShardMove(ctx context.Context, class, shard, source, target string) error {
+	f.Lock()
+	defer f.Unlock()
+	physical := f.states[class].Physical[shard]
+	for i, node := range physical.BelongsToNodes {
+		if node == source {
+			physical.BelongsToNodes[i] = target
+		}
+	}
+	f.states[class].Physical[shard] = physical
+	return nil
+}
+
+func (f *fakeSchema) nodes(class, shard string) []string {
+	f.Lock()
+	defer f.Unlock()
+	return f.states[class].Physical[shard].BelongsToNodes
+}
+
+type fakeCopier struct {
+	sync.Mutex
+	limit int64
+	block chan struct{}
+	err   error
+	calls []string
+}
+
+func (f *fakeCopier) CopyReplica(ctx context.Context, class, shard, source, target string,
+	progress *scaler.Progress,
+) error {
+	progress.Bytes.Add(100)
+	if f.block != nil {
+		select {
+		case <-f.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.calls = append(f.calls, class+"/"+shard+":"+source+"->"+target)
+	return f.err
+}
+
+func (f *fakeCopier) BandwidthLimit() int64 {
+	f.Lock()
+	defer f.Unlock()
+	return f.limit
+}
+
+func (f *fakeCopier) SetBandwidthLimit(limit int64) {
+	f.Lock()
+	defer f.Unlock()
+	f.limit = limit
+}
+
+func (f *fakeCopier) copied() []string {
+	f.Lock()
+	defer f.Unlock()
+	return append([]string{}, f.calls...)
+}
+
+type fakeCluster struct {
+	sync.Mutex
+	local    string
+	nodes    []string
+	draining map[string]bool
+}
+
+func (f *fakeCluster) AllNames() []string { return f.nodes }
+func (f *fakeCluster) LocalName() string  { return f.local }
+
+func (f *fakeCluster) Candidates() []string {
+	f.Lock()
+	defer f.Unlock()
+	var names []string
+	for _, node := range f.nodes {
+		if !f.draining[node] {
+			names = append(names, node)
+		}
+	}
+	return names
+}
+
+func (f *fakeCluster) NodeHostname(node string) (string, bool) {
+	return node + ":7001", contains(f.nodes, node)
+}
+
+func (f *fakeCluster) SetDraining(draining bool) error {
+	f.setDraining(f.local, draining)
+	return nil
+}
+
+func (f *fakeCluster) Draining(node string) bool {
+	f.Lock()
+	defer f.Unlock()
+	return f.draining[node]
+}
+
+func (f *fakeCluster) setDraining(node string, draining bool) {
+	f.Lock()
+	defer f.Unlock()
+	if f.draining == nil {
+		f.draining = map[string]bool{}
+	}
+	f.draining[node] = draining
+}
+
+// fakeNodeClient marks nodes of the fake cluster as draining
+type fakeNodeClient struct {
+	cluster *fakeCluster
+	hosts   []string
+	err     error
+}
+
+func (f *fakeNodeClient) SetDraining(ctx context.Context, host string, draining bool) error {
+	f.hosts = append(f.hosts, host)
+	if f.err != nil {
+		return f.err
+	}
+	f.cluster.setDraining(strings.TrimSuffix(host, ":7001"), draining)
+	return nil
+}
+
+func newTestManager(copier *fakeCopier, local string) (*Manager, *fakeSchema) {
+	m, sm, _ := newTestCluster(copier, local)
+	return m, sm
+}
+
+func newTestCluster(copier *fakeCopier, local string) (*Manager, *fakeSchema, *fakeNodeClient) {
+	logger, _ := test.NewNullLogger()
+	sm := &fakeSchema{states: map[string]*sharding.State{
+		"Article": {Physical: map[string]sharding.Physical{
+			"s1": {Name: "s1", BelongsToNodes: []string{"node1"}},
+			"s2": {Name: "s2", BelongsToNodes: []string{"node1"}},
+			"s3": {Name: "s3", BelongsToNodes: []string{"node2", "node1"}},
+			"cold": {
+				Name: "cold", BelongsToNodes: []string{"node1"},
+				Status: models.TenantActivityStatusCOLD,
+			},
+		}},
+		"Author": {Physical: map[string]sharding.Physical{
+			"s1": {Name: "s1", BelongsToNodes: []string{"node2"}},
+		}},
+	}}
+	cl := &fakeCluster{local: local, nodes: []string{"node3", "node2", "node1"}}
+	client := &fakeNodeClient{cluster: cl}
+	return NewManager(config.ShardBalancer{
+		Enabled:        true,
+		Interval:       time.Hour,
+		Threshold:      1,
+		BandwidthLimit: 1024,
+	}, sm, copier, cl, client, nil, logger), sm, client
+}
+
+func waitForMoves(t *testing.T, m *Manager) []Move {
+	var moves []Move
+	require.Eventually(t, func() bool {
+		moves = m.Status().Moves
+		for _, mv := range moves {
+			if mv.Status == StatusRunning {
+				return false
+			}
+		}
+		return true
+	}, time.Second, 5*time.Millisecond)
+	return moves
+}
+
+func TestBalance(t *testing.T) {
+	t.Run("coordinator", func(t *testing.T) {
+		copier := &fakeCopier{}
+		m, sm := newTestManager(copier, "node1")
+		assert.Equal(t, map[string]int{"node1": 3, "node2": 2, "node3": 0}, m.Status().Replicas)
+
+		m.balance()
+		assert.Equal(t, []string{"Article/s1:node1->node3"}, copier.copied())
+		assert.Equal(t, []string{"node3"}, sm.nodes("Article", "s1"))
+		assert.Equal(t, map[string]int{"node1": 2, "node2": 2, "node3": 1}, m.Status().Replicas)
+
+		moves := m.Status().Moves
+		require.Len(t, moves, 1)
+		for _, mv := range moves {
+			assert.Equal(t, TriggerScheduled, mv.Trigger)
+			assert.Equal(t, StatusSuccess, mv.Status)
+			assert.Equal(t, int64(100), mv.Bytes)
+		}
+
+		// balanced now
+		m.balance()
+		assert.Len(t, copier.copied(), 1)
+	})
+
+	t.Run("other nodes", func(t *testing.T) {
+		copier := &fakeCopier{}
+		m, _ := newTestManager(copier, "node2")
+		m.balance()
+		assert.Empty(t, copier.copied())
+	})
+
+	t.Run("failed move stops the round", func(t *testing.T) {
+		copier := &fakeCopier{err: errors.New("node3 unreachable")}
+		m, sm := newTestManager(copier, "node1")
+		m.balance()
+		assert.Len(t, copier.copied(), 1)
+		assert.Equal(t, []string{"node1"}, sm.nodes("Article", "s1"))
+
+		moves := m.Status().Moves
+		require.Len(t, moves, 1)
+		assert.Equal(t, StatusFailed, moves[0].Status)
+		assert.Equal(t, "node3 unreachable", moves[0].Error)
+	})
+}
+
+func TestMove(t *testing.T) {
+	t.Run("single replica", func(t *testing.T) {
+		copier := &fakeCopier{}
+		m, sm := newTestManager(copier, "node2")
+
+		started, err := m.Move("", "s2", "", "node3")
+		require.Nil(t, err)
+		assert.Equal(t, "Article", started.Class)
+		assert.Equal(t, "node1", started.Source)
+		assert.Equal(t, StatusRunning, started.Status)
+
+		moves := waitForMoves(t, m)
+		require.Len(t, moves, 1)
+		assert.Equal(t, StatusSuccess, moves[0].Status)
+		assert.Equal(t, TriggerManual, moves[0].Trigger)
+		assert.Equal(t, []string{"node3"}, sm.nodes("Article", "s2"))
+	})
+
+	t.Run("replicated", func(t *testing.T) {
+		copier := &fakeCopier{}
+		m, sm := newTestManager(copier, "node1")
+
+		_, err := m.Move("article", "s3", "", "node3")
+		assert.ErrorIs(t, err, ErrInvalid)
+		_, err = m.Move("article", "s3", "node1", "node3")
+		require.Nil(t, err)
+		waitForMoves(t, m)
+		assert.Equal(t, []string{"node2", "node3"}, sm.nodes("Article", "s3"))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		m, _ := newTestManager(&fakeCopier{}, "node1")
+
+		_, err := m.Move("", "s1", "", "node3")
+		assert.ErrorIs(t, err, ErrInvalid)
+		assert.ErrorContains(t, err, "class is required")
+		_, err = m.Move("", "unknown", "", "node3")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = m.Move("Article", "s1", "", "node4")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = m.Move("Article", "s1", "node2", "node3")
+		assert.ErrorIs(t, err, ErrInvalid)
+		_, err = m.Move("Article", "s3", "node1", "node2")
+		assert.ErrorIs(t, err, ErrInvalid)
+	})
+
+	t.Run("running", func(t *testing.T) {
+		copier := &fakeCopier{block: make(chan struct{})}
+		m, _ := newTestManager(copier, "node1")
+
+		_, err := m.Move("Article", "s1", "", "node3")
+		require.Nil(t, err)
+		_, err = m.Move("Article", "s1", "", "node2")
+		assert.ErrorIs(t, err, ErrRunning)
+
+		close(copier.block)
+		waitForMoves(t, m)
+	})
+
+	t.Run("shutdown cancels running moves", func(t *testing.T) {
+		copier := &fakeCopier{block: make(chan struct{})}
+		m, sm := newTestManager(copier, "node1")
+		m.Start()
+
+		_, err := m.Move("Article", "s1", "", "node3")
+		require.Nil(t, err)
+		require.Nil(t, m.Shutdown(context.Background()))
+
+		moves := m.Status().Moves
+		require.Len(t, moves, 1)
+		assert.Equal(t, StatusFailed, moves[0].Status)
+		assert.Equal(t, context.Canceled.Error(), moves[0].Error)
+		assert.Equal(t, []string{"node1"}, sm.nodes("Article", "s1"))
+	})
+}
+
+func TestDrain(t *testing.T) {
+	ctx := context.Background()
+	waitForDrain := func(t *testing.T, m *Manager, node string) Drain {
+		var status Drain
+		require.Eventually(t, func() bool {
+			var err error
+			status, err = m.DrainStatus(node)
+			require.Nil(t, err)
+			return status.Status != StatusRunning
+		}, time.Second, 5*time.Millisecond)
+		return status
+	}
+
+	t.Run("remote node", func(t *testing.T) {
+		copier := &fakeCopier{}
+		m, sm, client := newTestCluster(copier, "node2")
+
+		started, err := m.Drain(ctx, "node1")
+		require.Nil(t, err)
+		assert.True(t, started.Draining)
+		assert.Equal(t, []string{"node1:7001"}, client.hosts)
+		assert.Equal(t, []string{"node3", "node2"}, m.cluster.Candidates())
+
+		status := waitForDrain(t, m, "node1")
+		assert.Equal(t, StatusSuccess, status.Status)
+		assert.Equal(t, []string{
+			"Article/s1:node1->node3",
+			"Article/s2:node1->node3",
+			"Article/s3:node1->node3",
+		}, copier.copied())
+		assert.Equal(t, []string{"node2", "node3"}, sm.nodes("Article", "s3"))
+		// inactive tenants are left in place
+		assert.Equal(t, []string{"Article/cold"}, status.Replicas)
+		assert.False(t, status.Safe)
+		for _, mv := range m.Status().Moves {
+			assert.Equal(t, TriggerDrain, mv.Trigger)
+		}
+
+		status, err = m.Undrain(ctx, "node1")
+		require.Nil(t, err)
+		assert.False(t, status.Draining)
+		assert.Equal(t, []string{"node3", "node2", "node1"}, m.cluster.Candidates())
+	})
+
+	t.Run("safe to decommission", func(t *testing.T) {
+		m, _, client := newTestCluster(&fakeCopier{}, "node3")
+
+		_, err := m.Drain(ctx, "node3")
+		require.Nil(t, err)
+		assert.Empty(t, client.hosts)
+		status := waitForDrain(t, m, "node3")
+		assert.True(t, status.Draining)
+		assert.Empty(t, status.Replicas)
+		assert.True(t, status.Safe)
+	})
+
+	t.Run("failed moves", func(t *testing.T) {
+		copier := &fakeCopier{err: errors.New("node3 unreachable")}
+		m, _ := newTestManager(copier, "node2")
+
+		_, err := m.Drain(ctx, "node1")
+		require.Nil(t, err)
+		status := waitForDrain(t, m, "node1")
+		assert.Equal(t, StatusFailed, status.Status)
+		assert.Len(t, copier.copied(), 3, "all replicas are tried")
+		assert.Contains(t, status.Error, "node3 unreachable")
+		assert.Len(t, status.Replicas, 4)
+	})
+
+	t.Run("running", func(t *testing.T) {
+		copier := &fakeCopier{block: make(chan struct{})}
+		m, sm := newTestManager(copier, "node2")
+
+		_, err := m.Drain(ctx, "node1")
+		require.Nil(t, err)
+		_, err = m.Drain(ctx, "node1")
+		assert.ErrorIs(t, err, ErrDraining)
+
+		// undraining cancels the remaining moves
+		_, err = m.Undrain(ctx, "node1")
+		require.Nil(t, err)
+		status := waitForDrain(t, m, "node1")
+		assert.Equal(t, StatusFailed, status.Status)
+		assert.False(t, status.Draining)
+		assert.Equal(t, []string{"node1"}, sm.nodes("Article", "s1"))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		m, _, client := newTestCluster(&fakeCopier{}, "node2")
+
+		_, err := m.Drain(ctx, "node4")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = m.Undrain(ctx, "node4")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = m.DrainStatus("node4")
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		client.err = errors.New("connection refused")
+		_, err = m.Drain(ctx, "node1")
+		assert.ErrorContains(t, err, "connection refused")
+		status, err := m.DrainStatus("node1")
+		require.Nil(t, err)
+		assert.Equal(t, StatusFailed, status.Status)
+	})
+}
+
+func TestBandwidthLimit(t *testing.T) {
+	copier := &fakeCopier{}
+	m, _ := newTestManager(copier, "node1")
+	assert.Equal(t, int64(1024), m.Status().BandwidthLimit)
+
+	require.Nil(t, m.SetBandwidthLimit(0))
+	assert.Equal(t, int64(0), m.Status().BandwidthLimit)
+	assert.NotNil(t, m.SetBandwidthLimit(-1))
+}
+
+func TestNilManager(t *testing.T) {
+	var m *Manager
+	m.Start()
+	assert.Nil(t, m.Shutdown(context.Background()))
+	assert.Equal(t, Status{}, m.Status())
+	assert.Nil(t, m.SetBandwidthLimit(10))
+	_, err := m.Move("Article", "s1", "", "node2")
+	assert.Nil(t, err)
+	_, err = m.Drain(context.Background(), "node1")
+	assert.Nil(t, err)
+	_, err = m.Undrain(context.Background(), "node1")
+	assert.Nil(t, err)
+	_, err = m.DrainStatus("node1")
+	assert.Nil(t, err)
+}
@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package balancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Drain of a node. Draining nodes are not considered for new shards, their
+// replicas are moved to the other nodes. A node can be decommissioned safely
+// once no replicas are left on it.
+type Drain struct {
+	Node     string `json:"node"`
+	Draining bool   `json:"draining"`
+	// Status of the moves started by this node, empty if there are none
+	Status     string     `json:"status,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	// Replicas left on the node as "class/shard". Inactive tenants are not
+	// moved, they need to be activated first.
+	Replicas []string `json:"replicas"`
+	Safe     bool     `json:"safe"`
+}
+
+type drain struct {
+	status     string
+	err        error
+	startedAt  time.Time
+	finishedAt *time.Time
+	cancel     context.CancelFunc
+}
+
+// Drain marks a node as draining and moves all of its replicas to the other
+// nodes in the background. Draining a node again moves the replicas which
+// could not be moved before.
+func (m *Manager) Drain(ctx context.Context, node string) (Drain, error) {
+	if m == nil {
+		return Drain{}, nil
+	}
+	if !contains(m.cluster.AllNames(), node) {
+		return Drain{}, fmt.Errorf("node %q: %w", node, ErrNotFound)
+	}
+
+	m.Lock()
+	if d, ok := m.drains[node]; ok && d.status == StatusRunning {
+		m.Unlock()
+		return Drain{}, fmt.Errorf("node %q: %w", node, ErrDraining)
+	}
+	jobCtx, cancel := context.WithCancel(m.ctx)
+	d := &drain{status: StatusRunning, startedAt: m.now(), cancel: cancel}
+	m.drains[node] = d
+	m.Unlock()
+
+	if err := m.setDraining(ctx, node, true); err != nil {
+		cancel()
+		m.finishDrain(node, d, err)
+		return Drain{}, fmt.Errorf("mark node %q as draining: %w", node, err)
+	}
+
+	m.logger.WithField("node", node).Info("draining node")
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer cancel()
+		m.finishDrain(node, d, m.relocate(jobCtx, node))
+	}()
+	return m.drainStatus(node, true), nil
+}
+
+// Undrain stops moving the replicas of a node, which is considered for new
+// shards again. Replicas which have been moved already are not moved back.
+func (m *Manager) Undrain(ctx context.Context, node string) (Drain, error) {
+	if m == nil {
+		return Drain{}, nil
+	}
+	if !contains(m.cluster.AllNames(), node) {
+		return Drain{}, fmt.Errorf("node %q: %w", node, ErrNotFound)
+	}
+
+	m.Lock()
+	if d, ok := m.drains[node]; ok {
+		d.cancel()
+	}
+	m.Unlock()
+
+	if err := m.setDraining(ctx, node, false); err != nil {
+		return Drain{}, fmt.Errorf("unmark node %q as draining: %w", node, err)
+	}
+	m.logger.WithField("node", node).Info("stopped draining node")
+	return m.drainStatus(node, false), nil
+}
+
+// DrainStatus returns whether a node is draining and which replicas are
+// left on it
+func (m *Manager) DrainStatus(node string) (Drain, error) {
+	if m == nil {
+		return Drain{}, nil
+	}
+
+	m.Lock()
+	_, started := m.drains[node]
+	m.Unlock()
+	if !started && !contains(m.cluster.AllNames(), node) {
+		return Drain{}, fmt.Errorf("node %q: %w", node, ErrNotFound)
+	}
+	return m.drainStatus(node, m.cluster.Draining(node)), nil
+}
+
+// drainStatus is given whether the node is draining, since marks of other
+// nodes take a moment to be gossiped back to this node
+func (m *Manager) drainStatus(node string, draining bool) Drain {
+	status := Drain{Node: node, Draining: draining, Replicas: []string{}}
+	for _, p := range m.replicas(true) {
+		if contains(p.nodes, node) {
+			status.Replicas = append(status.Replicas, p.class+"/"+p.shard)
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if d, ok := m.drains[node]; ok {
+		startedAt := d.startedAt
+		status.Status = d.status
+		status.StartedAt = &startedAt
+		status.FinishedAt = d.finishedAt
+		if d.err != nil {
+			status.Error = d.err.Error()
+		}
+	}
+	status.Safe = draining && len(status.Replicas) == 0 && status.Status != StatusRunning
+	return status
+}
+
+func (m *Manager) setDraining(ctx context.Context, node string, draining bool) error {
+	if node == m.cluster.LocalName() {
+		return m.cluster.SetDraining(draining)
+	}
+	host, ok := m.cluster.NodeHostname(node)
+	if !ok {
+		return fmt.Errorf("node %q: %w", node, ErrNotFound)
+	}
+	return m.client.SetDraining(ctx, host, draining)
+}
+
+// relocate moves the replicas off the node one after another. Replicas
+// which cannot be moved are skipped, all errors are returned.
+func (m *Manager) relocate(ctx context.Context, node string) error {
+	nodes, placements := m.placements()
+	// the mark may not have been gossiped back yet
+	targets := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		if n != node {
+			targets = append(targets, n)
+		}
+	}
+
+	var errs []error
+	for _, p := range planDrain(node, targets, placements) {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		mv, err := m.startMove(p.class, p.shard, p.source, p.target, TriggerDrain)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := m.run(ctx, mv); err != nil {
+			errs = append(errs, fmt.Errorf("shard %q of class %q: %w", p.shard, p.class, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *Manager) finishDrain(node string, d *drain, err error) {
+	m.Lock()
+	finished := m.now()
+	d.finishedAt = &finished
+	d.status = StatusSuccess
+	if err != nil {
+		d.status = StatusFailed
+		d.err = err
+	}
+	m.Unlock()
+
+	logger := m.logger.WithField("node", node)
+	if err != nil {
+		logger.WithError(err).Error("could not move all replicas off the draining node")
+		return
+	}
+	logger.WithField("took", finished.Sub(d.startedAt)).Info("moved replicas off the draining node")
+}
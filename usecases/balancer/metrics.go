@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package balancer
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Metrics of shard moves
+type Metrics struct {
+	moves      *prometheus.CounterVec
+	durations  *prometheus.HistogramVec
+	bytes      *prometheus.CounterVec
+	classLabel func(string) string
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		moves:      prom.ShardMoves,
+		durations:  prom.ShardMoveDurations,
+		bytes:      prom.ShardMoveBytes,
+		classLabel: prom.ClassLabel,
+	}
+}
+
+func (m *Metrics) Moved(class, trigger string, took time.Duration, bytes int64, err error) {
+	if m == nil {
+		return
+	}
+
+	status := "success"
+	if err != nil {
+		status = "failed"
+	}
+	class = m.classLabel(class)
+	m.moves.With(prometheus.Labels{
+		"class_name": class,
+		"trigger":    trigger,
+		"status":     status,
+	}).Inc()
+	labels := prometheus.Labels{"class_name": class}
+	m.durations.With(labels).Observe(took.Seconds())
+	m.bytes.With(labels).Add(float64(bytes))
+}
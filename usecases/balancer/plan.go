@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package balancer
+
+// placement of the replicas of a shard
+type placement struct {
+	class string
+	shard string
+	nodes []string
+}
+
+type plannedMove struct {
+	class  string
+	shard  string
+	source string
+	target string
+}
+
+// plan returns the moves which balance the nodes, so that the number of
+// replicas of any two nodes differs by no more than threshold. Each move
+// takes a replica from the node holding the most replicas to the one holding
+// the fewest. Nodes must be sorted, ties are broken by name.
+func plan(nodes []string, placements []placement, threshold int) []plannedMove {
+	if len(nodes) < 2 {
+		return nil
+	}
+	counts := countReplicas(nodes, placements)
+	placed := make([]placement, len(placements))
+	for i, p := range placements {
+		placed[i] = placement{p.class, p.shard, append([]string{}, p.nodes...)}
+	}
+
+	var moves []plannedMove
+	for {
+		source, target := nodes[0], nodes[0]
+		for _, node := range nodes {
+			if counts[node] > counts[source] {
+				source = node
+			}
+			if counts[node] < counts[target] {
+				target = node
+			}
+		}
+		if counts[source]-counts[target] <= threshold {
+			return moves
+		}
+
+		i := movable(placed, source, target)
+		if i < 0 {
+			return moves
+		}
+		for j, node := range placed[i].nodes {
+			if node == source {
+				placed[i].nodes[j] = target
+			}
+		}
+		counts[source]--
+		counts[target]++
+		moves = append(moves, plannedMove{placed[i].class, placed[i].shard, source, target})
+	}
+}
+
+// planDrain returns the moves of all replicas off the drained node. Each
+// replica is moved to the target holding the fewest replicas which does not
+// hold the shard yet. Replicas without such a target are left in place.
+func planDrain(drained string, targets []string, placements []placement) []plannedMove {
+	counts := countReplicas(targets, placements)
+
+	var moves []plannedMove
+	for _, p := range placements {
+		if !contains(p.nodes, drained) {
+			continue
+		}
+		target := ""
+		for _, node := range targets {
+			if contains(p.nodes, node) {
+				continue
+			}
+			if target == "" || counts[node] < counts[target] {
+				target = node
+			}
+		}
+		if target == "" {
+			continue
+		}
+		counts[target]++
+		moves = append(moves, plannedMove{p.class, p.shard, drained, target})
+	}
+	return moves
+}
+
+// movable returns the first shard with a replica on the source but none on
+// the target, or -1 if there is none
+func movable(placements []placement, source, target string) int {
+	for i, p := range placements {
+		if contains(p.nodes, source) && !contains(p.nodes, target) {
+			return i
+		}
+	}
+	return -1
+}
+
+// countReplicas returns the number of replicas of each node, replicas on
+// other nodes than the given ones are ignored
+func countReplicas(nodes []string, placements []placement) map[string]int {
+	counts := make(map[string]int, len(nodes))
+	for _, node := range nodes {
+		counts[node] = 0
+	}
+	for _, p := range placements {
+		for _, node := range p.nodes {
+			if _, ok := counts[node]; ok {
+				counts[node]++
+			}
+		}
+	}
+	return counts
+}
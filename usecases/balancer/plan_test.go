@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package balancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlan(t *testing.T) {
+	placements := []placement{
+		{"A", "s1", []string{"n1", "n2"}},
+		{"A", "s2", []string{"n2", "n1"}},
+		{"A", "s3", []string{"n1", "n2"}},
+		{"B", "s1", []string{"n1"}},
+	}
+
+	t.Run("new node", func(t *testing.T) {
+		moves := plan([]string{"n1", "n2", "n3"}, placements, 1)
+		assert.Equal(t, []plannedMove{
+			{"A", "s1", "n1", "n3"},
+			{"A", "s2", "n1", "n3"},
+		}, moves)
+		// the placements are not changed
+		assert.Equal(t, []string{"n1", "n2"}, placements[0].nodes)
+	})
+
+	t.Run("threshold", func(t *testing.T) {
+		assert.Len(t, plan([]string{"n1", "n2", "n3"}, placements, 2), 1)
+		assert.Empty(t, plan([]string{"n1", "n2", "n3"}, placements, 4))
+	})
+
+	t.Run("balanced", func(t *testing.T) {
+		assert.Empty(t, plan([]string{"n1", "n2"}, placements, 1))
+		assert.Empty(t, plan([]string{"n1"}, placements, 1))
+	})
+
+	t.Run("no movable replica", func(t *testing.T) {
+		// every shard on n1 has a replica on n2 already
+		moves := plan([]string{"n1", "n2"}, []placement{
+			{"A", "s1", []string{"n1", "n2"}},
+			{"A", "s2", []string{"n1", "n2"}},
+			{"A", "s3", []string{"n1"}},
+			{"A", "s4", []string{"n1"}},
+			{"A", "s5", []string{"n1"}},
+		}, 1)
+		assert.Equal(t, []plannedMove{{"A", "s3", "n1", "n2"}}, moves)
+	})
+
+	t.Run("replica counts", func(t *testing.T) {
+		assert.Equal(t, map[string]int{"n1": 4, "n2": 3},
+			countReplicas([]string{"n1", "n2"}, placements))
+	})
+}
+
+func TestPlanDrain(t *testing.T) {
+	placements := []placement{
+		{"A", "s1", []string{"n1"}},
+		{"A", "s2", []string{"n1", "n2"}},
+		{"A", "s3", []string{"n1"}},
+		{"A", "s4", []string{"n2"}},
+		{"B", "s1", []string{"n1", "n2", "n3"}},
+	}
+
+	moves := planDrain("n1", []string{"n2", "n3"}, placements)
+	assert.Equal(t, []plannedMove{
+		{"A", "s1", "n1", "n3"},
+		{"A", "s2", "n1", "n3"},
+		{"A", "s3", "n1", "n2"},
+	}, moves, "B/s1 has replicas on all targets")
+
+	assert.Empty(t, planDrain("n1", nil, placements))
+	assert.Empty(t, planDrain("n4", []string{"n2", "n3"}, placements))
+}
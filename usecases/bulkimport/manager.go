@@ -0,0 +1,647 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package bulkimport imports files of objects from object storage within
+// the cluster, so that large imports are not bound by the network of the
+// client sending batches.
+package bulkimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tracing"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/export"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/scheduler"
+)
+
+// Statuses of jobs and of their files
+const (
+	StatusPending  = "PENDING"
+	StatusStarted  = "STARTED"
+	StatusFinished = "FINISHED"
+	StatusFailed   = "FAILED"
+	StatusCanceled = "CANCELED"
+)
+
+var (
+	// defaultBatchSize is the number of objects added at once if the
+	// request does not set it
+	defaultBatchSize = 100
+	maxBatchSize     = 10000
+
+	// defaultSampleRows are the rows of a CSV file whose values determine
+	// the data types of its columns if the request does not set them
+	defaultSampleRows = 100
+	maxSampleRows     = 10000
+
+	// maxErrorSamples are the errors of objects kept per file
+	maxErrorSamples = 10
+
+	// jobRetention is how long finished jobs can be looked up
+	jobRetention = 24 * time.Hour
+)
+
+var ErrJobNotFound = errors.New("import job not found")
+
+// Request imports files from a backup backend into a class. The files are
+// looked up by the backend like the files of a backup whose id is the path,
+// e.g. below s3://<bucket>/<path>/ for the S3 backend.
+type Request struct {
+	Backend string   `json:"backend"`
+	Path    string   `json:"path"`
+	Files   []string `json:"files"`
+	Class   string   `json:"class"`
+	Tenant  string   `json:"tenant,omitempty"`
+	// Format of all files, derived from their extensions if empty
+	Format    string `json:"format,omitempty"`
+	BatchSize int    `json:"batchSize,omitempty"`
+	// AutoSchema creates the class, or adds the properties of columns
+	// missing from it, before each file is imported. Only CSV files can be
+	// imported with auto schema.
+	AutoSchema *AutoSchema `json:"autoSchema,omitempty"`
+}
+
+// AutoSchema derives the properties of a class from the header and the
+// first rows of CSV files
+type AutoSchema struct {
+	// SampleRows are the rows whose values determine the data types of the
+	// columns
+	SampleRows int `json:"sampleRows,omitempty"`
+	// Types override the data types of columns, e.g. {"zipCode": "text"}
+	Types map[string]string `json:"types,omitempty"`
+}
+
+// File is the progress of a single file of a job
+type File struct {
+	Name     string `json:"name"`
+	Format   string `json:"format"`
+	Status   string `json:"status"`
+	Imported int    `json:"imported"`
+	Failed   int    `json:"failed"`
+	// Errors are samples of the errors of objects which could not be
+	// imported, or the error which stopped reading the file
+	Errors []string `json:"errors"`
+}
+
+// Job tracks the progress of a Request, whose files are imported in the
+// background one after another
+type Job struct {
+	ID          string      `json:"id"`
+	Backend     string      `json:"backend,omitempty"`
+	Path        string      `json:"path,omitempty"`
+	Class       string      `json:"class"`
+	Tenant      string      `json:"tenant,omitempty"`
+	AutoSchema  *AutoSchema `json:"autoSchema,omitempty"`
+	Status      string      `json:"status"`
+	Files       []*File     `json:"files"`
+	StartedAt   time.Time   `json:"startedAt"`
+	CompletedAt *time.Time  `json:"completedAt,omitempty"`
+	Error       string      `json:"error,omitempty"`
+}
+
+type backends interface {
+	BackupBackend(backend string) (modulecapabilities.BackupBackend, error)
+}
+
+type schemaGetter interface {
+	GetClass(ctx context.Context, principal *models.Principal, name string) (*models.Class, error)
+}
+
+type batchManager interface {
+	AddObjects(ctx context.Context, principal *models.Principal, objects []*models.Object,
+		fields []*string, repl *additional.ReplicationProperties) (objects.BatchObjects, error)
+	CSVSchema(ctx context.Context, principal *models.Principal, className string,
+		header []string, rows [][]string, types map[string]string) (*models.Class, error)
+}
+
+// source provides the files of a job
+type source interface {
+	// local returns the path of a local copy of the file, which is removed
+	// after the file was imported
+	local(ctx context.Context, dir, name string) (string, error)
+	// close removes what is left of the source once the job completed
+	close()
+}
+
+// backendSource downloads the files of a job from a backup backend, since
+// parquet files must be read from their end
+type backendSource struct {
+	backend modulecapabilities.BackupBackend
+	path    string
+}
+
+func (s *backendSource) local(ctx context.Context, dir, name string) (string, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", fmt.Errorf("create import directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, "import-*"+filepath.Ext(name))
+	if err != nil {
+		return "", fmt.Errorf("create import file: %w", err)
+	}
+	tmp.Close()
+
+	if err := s.backend.WriteToFile(ctx, s.path, name, tmp.Name()); err != nil {
+		os.Remove(tmp.Name())
+		return "", fmt.Errorf("download: %w", err)
+	}
+	return tmp.Name(), nil
+}
+
+func (s *backendSource) close() {}
+
+// uploadSource is a file uploaded with the request starting the job
+type uploadSource struct {
+	path string
+}
+
+func (s *uploadSource) local(ctx context.Context, dir, name string) (string, error) {
+	return s.path, nil
+}
+
+func (s *uploadSource) close() {
+	os.Remove(s.path)
+}
+
+// Manager runs the import jobs received by this node, the jobs are kept in
+// memory only and do not survive a restart
+type Manager struct {
+	authorizer authorization.Authorizer
+	backends   backends
+	schema     schemaGetter
+	batch      batchManager
+	// tmpDir holds the files while they are imported
+	tmpDir string
+	logger logrus.FieldLogger
+
+	sync.Mutex
+	jobs    map[string]*Job
+	cancels map[string]context.CancelFunc
+	closed  bool
+	wg      sync.WaitGroup
+}
+
+func NewManager(authorizer authorization.Authorizer, backends backends, schema schemaGetter,
+	batch batchManager, tmpDir string, logger logrus.FieldLogger,
+) *Manager {
+	return &Manager{
+		authorizer: authorizer,
+		backends:   backends,
+		schema:     schema,
+		batch:      batch,
+		tmpDir:     tmpDir,
+		logger:     logger,
+		jobs:       map[string]*Job{},
+		cancels:    map[string]context.CancelFunc{},
+	}
+}
+
+// Start validates the request and imports its files in the background.
+// Objects are added with the permissions of the principal, objects without
+// a vector are vectorized by the vectorizer of the class.
+func (m *Manager) Start(ctx context.Context, principal *models.Principal,
+	req Request,
+) (*Job, error) {
+	job, class, err := m.prepare(ctx, principal, &req)
+	if err != nil {
+		return nil, err
+	}
+	if req.Backend == "" {
+		return nil, objects.NewErrInvalidUserInput("no backend given")
+	}
+	backend, err := m.backends.BackupBackend(req.Backend)
+	if err != nil {
+		return nil, objects.NewErrInvalidUserInput("%v", err)
+	}
+	return m.launch(ctx, principal, job, class, &backendSource{backend, req.Path}, req.BatchSize)
+}
+
+// StartUpload imports a CSV file uploaded with the request, like Start
+// imports the files of a backend. The file is stored in the data path
+// until it was imported.
+func (m *Manager) StartUpload(ctx context.Context, principal *models.Principal,
+	req Request, name string, r io.Reader,
+) (*Job, error) {
+	req.Backend, req.Path, req.Files, req.Format = "", "", []string{name}, export.FormatCSV
+	job, class, err := m.prepare(ctx, principal, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(m.tmpDir, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("create import directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(m.tmpDir, "upload-*.csv")
+	if err != nil {
+		return nil, fmt.Errorf("create import file: %w", err)
+	}
+	src := &uploadSource{path: tmp.Name()}
+	_, err = io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		src.close()
+		return nil, fmt.Errorf("store upload: %w", err)
+	}
+
+	started, err := m.launch(ctx, principal, job, class, src, req.BatchSize)
+	if err != nil {
+		src.close()
+	}
+	return started, err
+}
+
+// prepare validates the request and sets its defaults. The class is nil if
+// it does not exist yet and is created by auto schema.
+func (m *Manager) prepare(ctx context.Context, principal *models.Principal,
+	req *Request,
+) (*Job, *models.Class, error) {
+	if err := m.authorizer.Authorize(principal, "create",
+		authorization.Objects(req.Class, req.Tenant, "")); err != nil {
+		return nil, nil, err
+	}
+	if req.Class == "" {
+		return nil, nil, objects.NewErrInvalidUserInput("no class given")
+	}
+	if len(req.Files) == 0 {
+		return nil, nil, objects.NewErrInvalidUserInput("no files given")
+	}
+	if req.BatchSize == 0 {
+		req.BatchSize = defaultBatchSize
+	}
+	if req.BatchSize < 0 || req.BatchSize > maxBatchSize {
+		return nil, nil, objects.NewErrInvalidUserInput("batchSize must be between 1 and %d", maxBatchSize)
+	}
+	if auto := req.AutoSchema; auto != nil {
+		if auto.SampleRows == 0 {
+			auto.SampleRows = defaultSampleRows
+		}
+		if auto.SampleRows < 0 || auto.SampleRows > maxSampleRows {
+			return nil, nil, objects.NewErrInvalidUserInput("sampleRows must be between 1 and %d", maxSampleRows)
+		}
+	}
+
+	files := make([]*File, len(req.Files))
+	for i, name := range req.Files {
+		format := req.Format
+		if format == "" {
+			var err error
+			if format, err = export.FormatFromName(name); err != nil {
+				return nil, nil, objects.NewErrInvalidUserInput("%v", err)
+			}
+		}
+		switch format {
+		case export.FormatJSONL, export.FormatParquet, export.FormatCSV:
+		default:
+			return nil, nil, objects.NewErrInvalidUserInput("unsupported format %q, use %q, %q or %q",
+				format, export.FormatJSONL, export.FormatParquet, export.FormatCSV)
+		}
+		if req.AutoSchema != nil && format != export.FormatCSV {
+			return nil, nil, objects.NewErrInvalidUserInput(
+				"file %q: only CSV files can be imported with auto schema", name)
+		}
+		files[i] = &File{Name: name, Format: format, Status: StatusPending, Errors: []string{}}
+	}
+
+	class, err := m.schema.GetClass(ctx, principal, req.Class)
+	if err != nil {
+		return nil, nil, err
+	}
+	className := schema.UppercaseClassName(req.Class)
+	switch {
+	case class == nil && req.AutoSchema == nil:
+		return nil, nil, objects.NewErrNotFound("class %q not found", req.Class)
+	case class == nil && req.Tenant != "":
+		return nil, nil, objects.NewErrMultiTenancy(fmt.Errorf(
+			"class %s does not exist, classes created by auto schema do not have multi-tenancy enabled",
+			className))
+	case class != nil && schema.MultiTenancyEnabled(class) != (req.Tenant != ""):
+		return nil, nil, objects.NewErrMultiTenancy(fmt.Errorf(
+			"class %s has multi-tenancy enabled: %v, but the request has tenant %q",
+			class.Class, schema.MultiTenancyEnabled(class), req.Tenant))
+	case class != nil:
+		className = class.Class
+	}
+
+	job := &Job{
+		ID:         uuid.New().String(),
+		Backend:    req.Backend,
+		Path:       req.Path,
+		Class:      className,
+		Tenant:     req.Tenant,
+		AutoSchema: req.AutoSchema,
+		Status:     StatusStarted,
+		Files:      files,
+		StartedAt:  time.Now(),
+	}
+	return job, class, nil
+}
+
+func (m *Manager) launch(ctx context.Context, principal *models.Principal, job *Job,
+	class *models.Class, src source, batchSize int,
+) (*Job, error) {
+	// the job outlives the request, but keeps its id for the audit log. It
+	// is scheduled as bulk work, so that it doesn't slow down queries.
+	jobCtx, cancel := context.WithCancel(scheduler.WithClass(
+		tracing.WithRequestID(context.Background(), tracing.RequestID(ctx)), scheduler.Bulk))
+
+	m.Lock()
+	defer m.Unlock()
+	if m.closed {
+		cancel()
+		return nil, errors.New("import jobs are shut down")
+	}
+	for id, other := range m.jobs {
+		if other.CompletedAt != nil && time.Since(*other.CompletedAt) > jobRetention {
+			delete(m.jobs, id)
+		}
+	}
+	m.jobs[job.ID] = job
+	m.cancels[job.ID] = cancel
+	started := job.copy()
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer src.close()
+		m.run(jobCtx, principal, src, class, job, batchSize)
+	}()
+	return started, nil
+}
+
+// Job returns the current state of a job
+func (m *Manager) Job(ctx context.Context, principal *models.Principal, id string) (*Job, error) {
+	m.Lock()
+	job, ok := m.jobs[id]
+	if ok {
+		job = job.copy()
+	}
+	m.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrJobNotFound, id)
+	}
+	if err := m.authorizer.Authorize(principal, "get",
+		authorization.Objects(job.Class, job.Tenant, "")); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// Jobs returns the jobs the principal may read, the most recent one first
+func (m *Manager) Jobs(ctx context.Context, principal *models.Principal) ([]*Job, error) {
+	m.Lock()
+	all := make([]*Job, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		all = append(all, job.copy())
+	}
+	m.Unlock()
+
+	jobs := []*Job{}
+	for _, job := range all {
+		if m.authorizer.Authorize(principal, "get",
+			authorization.Objects(job.Class, job.Tenant, "")) == nil {
+			jobs = append(jobs, job)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].StartedAt.After(jobs[j].StartedAt)
+	})
+	return jobs, nil
+}
+
+// Cancel stops a running job after the current batch, the objects imported
+// so far are kept
+func (m *Manager) Cancel(ctx context.Context, principal *models.Principal, id string) error {
+	job, err := m.Job(ctx, principal, id)
+	if err != nil {
+		return err
+	}
+	if err := m.authorizer.Authorize(principal, "create",
+		authorization.Objects(job.Class, job.Tenant, "")); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if cancel, ok := m.cancels[id]; ok {
+		cancel()
+	}
+	return nil
+}
+
+// Close cancels the running jobs and waits for them to stop
+func (m *Manager) Close() error {
+	if m == nil {
+		return nil
+	}
+
+	m.Lock()
+	m.closed = true
+	for _, cancel := range m.cancels {
+		cancel()
+	}
+	m.Unlock()
+
+	m.wg.Wait()
+	return nil
+}
+
+// copy must be called with the jobs locked
+func (j *Job) copy() *Job {
+	c := *j
+	if j.AutoSchema != nil {
+		auto := *j.AutoSchema
+		c.AutoSchema = &auto
+	}
+	c.Files = make([]*File, len(j.Files))
+	for i, f := range j.Files {
+		file := *f
+		file.Errors = append([]string{}, f.Errors...)
+		c.Files[i] = &file
+	}
+	return &c
+}
+
+func (m *Manager) run(ctx context.Context, principal *models.Principal, src source,
+	class *models.Class, job *Job, batchSize int,
+) {
+	logger := m.logger.WithField("action", "bulk_import").WithField("id", job.ID).
+		WithField("class", job.Class)
+	logger.Info("import job started")
+
+	status, jobErr := StatusFinished, ""
+	for _, file := range job.Files {
+		if ctx.Err() != nil {
+			break
+		}
+		m.update(func() { file.Status = StatusStarted })
+
+		err := m.importFile(ctx, principal, src, class, job, file, batchSize)
+		fileStatus := StatusFinished
+		switch {
+		case ctx.Err() != nil:
+			fileStatus = StatusCanceled
+		case err != nil:
+			fileStatus, status, jobErr = StatusFailed, StatusFailed, "some files could not be imported"
+			logger.WithField("file", file.Name).WithError(err).Error("could not import file")
+		}
+		m.update(func() {
+			file.Status = fileStatus
+			if err != nil && ctx.Err() == nil {
+				file.Errors = append(file.Errors, err.Error())
+			}
+		})
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	now := time.Now()
+	job.Status, job.Error = status, jobErr
+	if ctx.Err() != nil {
+		job.Status, job.Error = StatusCanceled, ""
+		for _, file := range job.Files {
+			if file.Status == StatusPending {
+				file.Status = StatusCanceled
+			}
+		}
+	}
+	job.CompletedAt = &now
+	m.cancels[job.ID]()
+	delete(m.cancels, job.ID)
+	logger.WithField("status", job.Status).Info("import job completed")
+}
+
+func (m *Manager) update(change func()) {
+	m.Lock()
+	defer m.Unlock()
+	change()
+}
+
+// importFile adds the objects of the file batch by batch. With auto schema
+// the class is updated from the sample of each file before it is imported.
+func (m *Manager) importFile(ctx context.Context, principal *models.Principal, src source,
+	class *models.Class, job *Job, file *File, batchSize int,
+) error {
+	path, err := src.local(ctx, m.tmpDir, file.Name)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path)
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if auto := job.AutoSchema; auto != nil {
+		header, rows, err := export.SampleCSV(io.NewSectionReader(f, 0, info.Size()), auto.SampleRows)
+		if err != nil {
+			return err
+		}
+		class, err = m.batch.CSVSchema(ctx, principal, job.Class, header, rows, auto.Types)
+		if err != nil {
+			return fmt.Errorf("auto schema: %w", err)
+		}
+	}
+	r, err := export.NewReader(file.Format, f, info.Size(), class)
+	if err != nil {
+		return err
+	}
+
+	batch := make([]*models.Object, 0, batchSize)
+	lines := make([]int, 0, batchSize)
+	for {
+		obj, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var objErr *export.ObjectError
+		if errors.As(err, &objErr) {
+			m.recordErrors(file, 0, objErr.Error())
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		obj.Class = job.Class
+		obj.Tenant = job.Tenant
+		batch = append(batch, obj)
+		lines = append(lines, r.Line())
+		if len(batch) == batchSize {
+			if err := m.addBatch(ctx, principal, file, batch, lines); err != nil {
+				return err
+			}
+			batch, lines = batch[:0], lines[:0]
+		}
+	}
+	if len(batch) > 0 {
+		return m.addBatch(ctx, principal, file, batch, lines)
+	}
+	return nil
+}
+
+// addBatch returns an error if the whole batch failed, e.g. because the
+// principal is not allowed to add objects anymore
+func (m *Manager) addBatch(ctx context.Context, principal *models.Principal, file *File,
+	batch []*models.Object, lines []int,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	res, err := m.batch.AddObjects(ctx, principal, batch, nil, nil)
+	if err != nil {
+		m.update(func() { file.Failed += len(batch) })
+		return fmt.Errorf("add batch: %w", err)
+	}
+
+	var errs []string
+	for _, obj := range res {
+		if obj.Err != nil {
+			errs = append(errs, fmt.Sprintf("line %d: %v", lines[obj.OriginalIndex], obj.Err))
+		}
+	}
+	m.recordErrors(file, len(res)-len(errs), errs...)
+	return nil
+}
+
+func (m *Manager) recordErrors(file *File, imported int, errs ...string) {
+	m.update(func() {
+		file.Imported += imported
+		file.Failed += len(errs)
+		for _, err := range errs {
+			if len(file.Errors) < maxErrorSamples {
+				file.Errors = append(file.Errors, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,318 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bulkimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// memBackend serves files from memory
+type memBackend struct {
+	modulecapabilities.BackupBackend
+	files map[string]string
+}
+
+func (b *memBackend) WriteToFile(ctx context.Context, backupID, key, destPath string) error {
+	data, ok := b.files[backupID+"/"+key]
+	if !ok {
+		return fmt.Errorf("object %q not found", key)
+	}
+	return os.WriteFile(destPath, []byte(data), 0o600)
+}
+
+type fakeBackends struct {
+	backend *memBackend
+}
+
+func (f fakeBackends) BackupBackend(name string) (modulecapabilities.BackupBackend, error) {
+	if name != "s3" {
+		return nil, fmt.Errorf("backup backend %q not found", name)
+	}
+	return f.backend, nil
+}
+
+type fakeSchema struct{}
+
+func (fakeSchema) GetClass(ctx context.Context, principal *models.Principal,
+	name string,
+) (*models.Class, error) {
+	if name != "Article" {
+		return nil, nil
+	}
+	return &models.Class{
+		Class:      "Article",
+		Properties: []*models.Property{{Name: "wordCount", DataType: []string{"int"}}},
+	}, nil
+}
+
+// fakeBatch rejects objects with a negative word count
+type fakeBatch struct {
+	sync.Mutex
+	added   []*models.Object
+	batches int
+	block   chan struct{}
+	samples [][][]string
+}
+
+func (f *fakeBatch) AddObjects(ctx context.Context, principal *models.Principal,
+	objs []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+) (objects.BatchObjects, error) {
+	if f.block != nil {
+		<-f.block
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.batches++
+	res := make(objects.BatchObjects, len(objs))
+	for i, obj := range objs {
+		res[i] = objects.BatchObject{OriginalIndex: i, Object: obj}
+		props, _ := obj.Properties.(map[string]interface{})
+		if count, _ := props["wordCount"].(float64); count < 0 {
+			res[i].Err = errors.New("negative word count")
+			continue
+		}
+		f.added = append(f.added, obj)
+	}
+	return res, nil
+}
+
+// CSVSchema records the sample and derives int properties from all columns
+func (f *fakeBatch) CSVSchema(ctx context.Context, principal *models.Principal,
+	className string, header []string, rows [][]string, types map[string]string,
+) (*models.Class, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.samples = append(f.samples, rows)
+	class := &models.Class{Class: className}
+	for _, column := range header {
+		dataType := "int"
+		if t, ok := types[column]; ok {
+			dataType = t
+		}
+		class.Properties = append(class.Properties,
+			&models.Property{Name: column, DataType: []string{dataType}})
+	}
+	return class, nil
+}
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (f fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return f.err
+}
+
+func newTestManager(t *testing.T, batch *fakeBatch, files map[string]string) *Manager {
+	logger, _ := test.NewNullLogger()
+	m := NewManager(fakeAuthorizer{}, fakeBackends{&memBackend{files: files}}, fakeSchema{},
+		batch, t.TempDir(), logger)
+	t.Cleanup(func() { m.Close() })
+	return m
+}
+
+func waitForJob(t *testing.T, m *Manager, id string) *Job {
+	var job *Job
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = m.Job(context.Background(), nil, id)
+		require.Nil(t, err)
+		return job.CompletedAt != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	return job
+}
+
+func TestImportJob(t *testing.T) {
+	ctx := context.Background()
+	batch := &fakeBatch{}
+	m := newTestManager(t, batch, map[string]string{
+		"imports/a.jsonl": `{"id":"00000000-0000-0000-0000-000000000001","properties":{"wordCount":1}}
+{"id":"00000000-0000-0000-0000-000000000002","properties":{"wordCount":-1}}
+not json
+
+{"id":"00000000-0000-0000-0000-000000000003","vector":[0.1,0.2]}
+`,
+		"imports/b.csv": "id,wordCount\n00000000-0000-0000-0000-000000000004,12\n",
+	})
+
+	started, err := m.Start(ctx, nil, Request{
+		Backend:   "s3",
+		Path:      "imports",
+		Files:     []string{"a.jsonl", "b.csv", "missing.jsonl"},
+		Class:     "Article",
+		BatchSize: 2,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, StatusStarted, started.Status)
+
+	job := waitForJob(t, m, started.ID)
+	assert.Equal(t, StatusFailed, job.Status)
+	require.Len(t, job.Files, 3)
+
+	a := job.Files[0]
+	assert.Equal(t, StatusFinished, a.Status)
+	assert.Equal(t, 2, a.Imported)
+	assert.Equal(t, 2, a.Failed)
+	require.Len(t, a.Errors, 2)
+	// the first batch is added before the third line is read
+	assert.Equal(t, "line 2: negative word count", a.Errors[0])
+	assert.Contains(t, a.Errors[1], "line 3")
+
+	b := job.Files[1]
+	assert.Equal(t, StatusFinished, b.Status)
+	assert.Equal(t, addedCount(batch), 3)
+	assert.Equal(t, 1, b.Imported)
+
+	missing := job.Files[2]
+	assert.Equal(t, StatusFailed, missing.Status)
+	require.Len(t, missing.Errors, 1)
+	assert.Contains(t, missing.Errors[0], "not found")
+
+	for _, obj := range batch.added {
+		assert.Equal(t, "Article", obj.Class)
+	}
+	assert.Equal(t, float64(12), batch.added[2].Properties.(map[string]interface{})["wordCount"])
+
+	jobs, err := m.Jobs(ctx, nil)
+	require.Nil(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, started.ID, jobs[0].ID)
+}
+
+func addedCount(batch *fakeBatch) int {
+	batch.Lock()
+	defer batch.Unlock()
+	return len(batch.added)
+}
+
+func TestImportJobCancel(t *testing.T) {
+	ctx := context.Background()
+	batch := &fakeBatch{block: make(chan struct{})}
+	m := newTestManager(t, batch, map[string]string{
+		"imports/a.jsonl": strings.Repeat(`{"properties":{"wordCount":1}}`+"\n", 10),
+		"imports/b.jsonl": `{"properties":{"wordCount":1}}`,
+	})
+
+	started, err := m.Start(ctx, nil, Request{
+		Backend: "s3", Path: "imports", Files: []string{"a.jsonl", "b.jsonl"},
+		Class: "Article", BatchSize: 2,
+	})
+	require.Nil(t, err)
+	require.Nil(t, m.Cancel(ctx, nil, started.ID))
+	close(batch.block)
+
+	job := waitForJob(t, m, started.ID)
+	assert.Equal(t, StatusCanceled, job.Status)
+	assert.Equal(t, StatusCanceled, job.Files[0].Status)
+	assert.Equal(t, StatusCanceled, job.Files[1].Status)
+	assert.LessOrEqual(t, batch.batches, 1)
+}
+
+func TestImportJobAutoSchema(t *testing.T) {
+	ctx := context.Background()
+	batch := &fakeBatch{}
+	m := newTestManager(t, batch, map[string]string{
+		"imports/a.csv": "count,zip\n1,01234\n2,05678\n3,09999\n",
+	})
+
+	started, err := m.Start(ctx, nil, Request{
+		Backend: "s3", Path: "imports", Files: []string{"a.csv"}, Class: "new",
+		AutoSchema: &AutoSchema{SampleRows: 2, Types: map[string]string{"zip": "text"}},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "New", started.Class)
+
+	job := waitForJob(t, m, started.ID)
+	assert.Equal(t, StatusFinished, job.Status)
+	assert.Equal(t, 3, job.Files[0].Imported)
+	assert.Equal(t, [][][]string{{{"1", "01234"}, {"2", "05678"}}}, batch.samples)
+	assert.Equal(t, map[string]interface{}{"count": float64(1), "zip": "01234"},
+		batch.added[0].Properties)
+
+	t.Run("upload", func(t *testing.T) {
+		started, err := m.StartUpload(ctx, nil, Request{Class: "New", AutoSchema: &AutoSchema{}},
+			"data.csv", strings.NewReader("count\n4\n"))
+		require.Nil(t, err)
+		job := waitForJob(t, m, started.ID)
+		assert.Equal(t, StatusFinished, job.Status)
+		assert.Equal(t, "data.csv", job.Files[0].Name)
+		assert.Equal(t, 1, job.Files[0].Imported)
+
+		entries, err := os.ReadDir(m.tmpDir)
+		require.Nil(t, err)
+		assert.Empty(t, entries)
+	})
+}
+
+func TestImportJobValidation(t *testing.T) {
+	ctx := context.Background()
+	m := newTestManager(t, &fakeBatch{}, nil)
+	valid := Request{Backend: "s3", Files: []string{"a.jsonl"}, Class: "Article"}
+
+	for name, change := range map[string]func(r *Request){
+		"no files":       func(r *Request) { r.Files = nil },
+		"unknown format": func(r *Request) { r.Files = []string{"a.txt"} },
+		"bad format":     func(r *Request) { r.Format = "xml" },
+		"batch size":     func(r *Request) { r.BatchSize = -1 },
+		"backend":        func(r *Request) { r.Backend = "unknown" },
+		"tenant":         func(r *Request) { r.Tenant = "t1" },
+		"no class":       func(r *Request) { r.Class = "" },
+		"auto schema json": func(r *Request) {
+			r.AutoSchema = &AutoSchema{}
+		},
+		"sample rows": func(r *Request) {
+			r.Files = []string{"a.csv"}
+			r.AutoSchema = &AutoSchema{SampleRows: -1}
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := valid
+			change(&req)
+			_, err := m.Start(ctx, nil, req)
+			assert.NotNil(t, err)
+		})
+	}
+
+	t.Run("class not found", func(t *testing.T) {
+		req := valid
+		req.Class = "Other"
+		_, err := m.Start(ctx, nil, req)
+		var notFound objects.ErrNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	t.Run("job not found", func(t *testing.T) {
+		_, err := m.Job(ctx, nil, "unknown")
+		assert.ErrorIs(t, err, ErrJobNotFound)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		m.authorizer = fakeAuthorizer{err: errors.New("forbidden")}
+		_, err := m.Start(ctx, nil, valid)
+		assert.ErrorContains(t, err, "forbidden")
+	})
+}
@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package byteops
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// Float32ToFloat16 converts to the bits of an IEEE 754 half precision float,
+// rounding to the nearest even value. Values too large for half precision
+// become infinite.
+func Float32ToFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	switch {
+	case exp == 0xff:
+		if mant != 0 {
+			// keep nan a quiet nan
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	case exp-127 > 15:
+		return sign | 0x7c00
+	case exp-127 >= -14:
+		half := uint32(exp-127+15)<<10 | mant>>13
+		// rounding may carry into the exponent, which is still correct
+		half += roundingIncrement(mant, 13)
+		if half >= 0x7c00 {
+			return sign | 0x7c00
+		}
+		return sign | uint16(half)
+	case exp-127 >= -25:
+		// subnormal half, the implicit leading bit becomes explicit
+		mant |= 0x800000
+		shift := uint32(-14-(exp-127)) + 13
+		half := mant>>shift + roundingIncrement(mant, shift)
+		return sign | uint16(half)
+	default:
+		return sign
+	}
+}
+
+// roundingIncrement is 1 if dropping the lowest shift bits of mant has to
+// round up to the nearest even value
+func roundingIncrement(mant, shift uint32) uint32 {
+	halfway := uint32(1) << (shift - 1)
+	rest := mant & (1<<shift - 1)
+	if rest > halfway || (rest == halfway && mant&(1<<shift) != 0) {
+		return 1
+	}
+	return 0
+}
+
+// Float16ToFloat32 converts the bits of an IEEE 754 half precision float,
+// the conversion is exact.
+func Float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch {
+	case exp == 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case exp == 0 && mant == 0:
+		return math.Float32frombits(sign)
+	case exp == 0:
+		// normalize the subnormal half
+		exp = 127 - 14
+		for mant&0x400 == 0 {
+			mant <<= 1
+			exp--
+		}
+		return math.Float32frombits(sign | exp<<23 | (mant&0x3ff)<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
+
+// Float16ToByteVector encodes the vector with two bytes per dimension
+func Float16ToByteVector(vec []float32) []byte {
+	byteVec := make([]byte, len(vec)*uint16Len)
+	for i := 0; i < len(vec); i++ {
+		binary.LittleEndian.PutUint16(byteVec[i*uint16Len:], Float32ToFloat16(vec[i]))
+	}
+	return byteVec
+}
+
+// Float32FromFloat16ByteVector decodes a vector of Float16ToByteVector
+func Float32FromFloat16ByteVector(vecByte []byte) []float32 {
+	vector := make([]float32, len(vecByte)/uint16Len)
+	for i := 0; i < len(vector); i++ {
+		vector[i] = Float16ToFloat32(binary.LittleEndian.Uint16(vecByte[i*uint16Len:]))
+	}
+	return vector
+}
+
+// RoundToFloat16 rounds every dimension to the nearest value that half
+// precision can represent, so the vector equals its float16 encoding once
+// decoded again
+func RoundToFloat16(vec []float32) []float32 {
+	rounded := make([]float32, len(vec))
+	for i, v := range vec {
+		rounded[i] = Float16ToFloat32(Float32ToFloat16(v))
+	}
+	return rounded
+}
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package byteops
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFloat32ToFloat16(t *testing.T) {
+	tests := []struct {
+		name string
+		in   float32
+		out  uint16
+	}{
+		{name: "zero", in: 0, out: 0x0000},
+		{name: "negative zero", in: float32(math.Copysign(0, -1)), out: 0x8000},
+		{name: "one", in: 1, out: 0x3c00},
+		{name: "minus two", in: -2, out: 0xc000},
+		{name: "inexact", in: 0.1, out: 0x2e66},
+		{name: "largest", in: 65504, out: 0x7bff},
+		{name: "rounds to infinity", in: 65520, out: 0x7c00},
+		{name: "overflows", in: 1e10, out: 0x7c00},
+		{name: "smallest normal", in: float32(math.Ldexp(1, -14)), out: 0x0400},
+		{name: "smallest subnormal", in: float32(math.Ldexp(1, -24)), out: 0x0001},
+		{name: "half of smallest subnormal rounds to even", in: float32(math.Ldexp(1, -25)), out: 0x0000},
+		{name: "underflows", in: 1e-10, out: 0x0000},
+		{name: "halfway rounds down to even", in: 1 + float32(math.Ldexp(1, -11)), out: 0x3c00},
+		{name: "halfway rounds up to even", in: 1 + float32(math.Ldexp(3, -11)), out: 0x3c02},
+		{name: "infinity", in: float32(math.Inf(-1)), out: 0xfc00},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.out, Float32ToFloat16(test.in))
+		})
+	}
+
+	t.Run("nan", func(t *testing.T) {
+		assert.True(t, math.IsNaN(float64(Float16ToFloat32(Float32ToFloat16(float32(math.NaN()))))))
+	})
+}
+
+func TestFloat16RoundTrip(t *testing.T) {
+	for h := 0; h <= math.MaxUint16; h++ {
+		f := Float16ToFloat32(uint16(h))
+		if math.IsNaN(float64(f)) {
+			continue
+		}
+		require.Equal(t, uint16(h), Float32ToFloat16(f), "half 0x%04x", h)
+	}
+}
+
+func TestFloat16ByteVector(t *testing.T) {
+	vec := []float32{1, -0.5, 0.1, 1000}
+	encoded := Float16ToByteVector(vec)
+	require.Len(t, encoded, 8)
+
+	decoded := Float32FromFloat16ByteVector(encoded)
+	assert.Equal(t, RoundToFloat16(vec), decoded)
+	assert.InDeltaSlice(t, vec, decoded, 1e-3)
+}
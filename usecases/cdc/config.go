@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cdc
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	SinkWebhook = "webhook"
+	SinkKafka   = "kafka"
+	SinkNATS    = "nats"
+
+	// ClassPlaceholder is replaced with the name of the class in topics
+	ClassPlaceholder = "{class}"
+	DefaultTopic     = "weaviate." + ClassPlaceholder
+)
+
+// Config of the change stream. The changes of objects are published to a
+// single sink, every class to its own topic.
+type Config struct {
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+	Sink    string `json:"sink" yaml:"sink"`
+	// Topic of all classes without an entry in ClassTopics, it may contain
+	// the class placeholder
+	Topic       string            `json:"topic" yaml:"topic"`
+	ClassTopics map[string]string `json:"class_topics" yaml:"class_topics"`
+	// Classes whose changes are published, all classes if empty
+	Classes        []string      `json:"classes" yaml:"classes"`
+	IncludeVectors bool          `json:"include_vectors" yaml:"include_vectors"`
+	Webhook        WebhookConfig `json:"webhook" yaml:"webhook"`
+	Kafka          KafkaConfig   `json:"kafka" yaml:"kafka"`
+	NATS           NATSConfig    `json:"nats" yaml:"nats"`
+}
+
+// WebhookConfig posts batches of events as a JSON array, the topic is sent
+// in the X-Weaviate-Topic header
+type WebhookConfig struct {
+	URL   string `json:"url" yaml:"url"`
+	Token string `json:"-" yaml:"-"`
+}
+
+// KafkaConfig publishes events through a Kafka REST proxy, so no Kafka
+// client is required inside Weaviate
+type KafkaConfig struct {
+	RESTProxyURL string `json:"rest_proxy_url" yaml:"rest_proxy_url"`
+}
+
+// NATSConfig publishes events to the subject of their topic. With JetStream
+// every event is acknowledged by the stream it is stored in, otherwise only
+// by the server.
+type NATSConfig struct {
+	URL       string `json:"url" yaml:"url"`
+	Token     string `json:"-" yaml:"-"`
+	JetStream bool   `json:"jetstream" yaml:"jetstream"`
+}
+
+// Validate the change stream config, can be called from the central config
+// package
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch c.Sink {
+	case SinkWebhook:
+		if err := validateURL(c.Webhook.URL, "http", "https"); err != nil {
+			return fmt.Errorf("change_stream: webhook sink: %w", err)
+		}
+	case SinkKafka:
+		if err := validateURL(c.Kafka.RESTProxyURL, "http", "https"); err != nil {
+			return fmt.Errorf("change_stream: kafka sink: %w", err)
+		}
+	case SinkNATS:
+		if err := validateURL(c.NATS.URL, "nats"); err != nil {
+			return fmt.Errorf("change_stream: nats sink: %w", err)
+		}
+	default:
+		return fmt.Errorf("change_stream: unknown sink %q, possible values are: "+
+			"webhook, kafka, nats", c.Sink)
+	}
+
+	if c.Topic == "" {
+		return fmt.Errorf("change_stream: topic is required")
+	}
+	for class, topic := range c.ClassTopics {
+		if topic == "" {
+			return fmt.Errorf("change_stream: topic of class %q is empty", class)
+		}
+	}
+	return nil
+}
+
+// topic of the events of a class
+func (c Config) topic(class string) string {
+	if topic, ok := c.ClassTopics[class]; ok {
+		return topic
+	}
+	return strings.ReplaceAll(c.Topic, ClassPlaceholder, class)
+}
+
+func validateURL(in string, schemes ...string) error {
+	if in == "" {
+		return fmt.Errorf("url is required")
+	}
+	u, err := url.Parse(in)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	for _, scheme := range schemes {
+		if u.Scheme == scheme {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid url %q: scheme must be %s", in, strings.Join(schemes, " or "))
+}
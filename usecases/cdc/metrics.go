@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cdc
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics of published changes. The monitoring package depends on the
+// config, which depends on this package, so the collectors are passed in.
+type Metrics struct {
+	published *prometheus.CounterVec
+}
+
+func NewMetrics(published *prometheus.CounterVec) *Metrics {
+	return &Metrics{
+		published: published,
+	}
+}
+
+func (m *Metrics) Published(sink, topic string, events int, err error) {
+	if m == nil {
+		return
+	}
+
+	status := "success"
+	if err != nil {
+		status = "failed"
+	}
+	m.published.With(prometheus.Labels{
+		"sink":   sink,
+		"topic":  topic,
+		"status": status,
+	}).Add(float64(events))
+}
@@ -0,0 +1,383 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cdc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const sinkTimeout = 10 * time.Second
+
+func newSink(cfg Config) (Sink, error) {
+	switch cfg.Sink {
+	case SinkWebhook:
+		return NewWebhookSink(cfg.Webhook), nil
+	case SinkKafka:
+		return NewKafkaSink(cfg.Kafka), nil
+	case SinkNATS:
+		return NewNATSSink(cfg.NATS)
+	default:
+		return nil, fmt.Errorf("change stream: unknown sink %q", cfg.Sink)
+	}
+}
+
+// WebhookSink posts every batch of events as a JSON array. If a token is
+// configured, it is sent as bearer token.
+type WebhookSink struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func NewWebhookSink(cfg WebhookConfig) *WebhookSink {
+	return &WebhookSink{
+		url:    cfg.URL,
+		token:  cfg.Token,
+		client: &http.Client{Timeout: sinkTimeout},
+	}
+}
+
+func (s *WebhookSink) Name() string {
+	return SinkWebhook
+}
+
+func (s *WebhookSink) Publish(topic string, events []Event) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return fmt.Errorf("marshal events: %w", err)
+	}
+
+	header := http.Header{
+		"Content-Type":     []string{"application/json"},
+		"X-Weaviate-Topic": []string{topic},
+	}
+	if s.token != "" {
+		header.Set("Authorization", "Bearer "+s.token)
+	}
+	return post(s.client, s.url, header, body)
+}
+
+func (s *WebhookSink) Close() error {
+	return nil
+}
+
+// KafkaSink publishes events to their topic through the v2 API of a Kafka
+// REST proxy. The id of the object is used as record key, so the changes of
+// an object are consumed in order.
+type KafkaSink struct {
+	url    string
+	client *http.Client
+}
+
+type kafkaRecord struct {
+	Key   string `json:"key"`
+	Value Event  `json:"value"`
+}
+
+func NewKafkaSink(cfg KafkaConfig) *KafkaSink {
+	return &KafkaSink{
+		url:    strings.TrimSuffix(cfg.RESTProxyURL, "/") + "/topics/",
+		client: &http.Client{Timeout: sinkTimeout},
+	}
+}
+
+func (s *KafkaSink) Name() string {
+	return SinkKafka
+}
+
+func (s *KafkaSink) Publish(topic string, events []Event) error {
+	records := make([]kafkaRecord, len(events))
+	for i, event := range events {
+		records[i] = kafkaRecord{Key: event.ObjectID.String(), Value: event}
+	}
+	body, err := json.Marshal(map[string]interface{}{"records": records})
+	if err != nil {
+		return fmt.Errorf("marshal events: %w", err)
+	}
+
+	header := http.Header{"Content-Type": []string{"application/vnd.kafka.json.v2+json"}}
+	return post(s.client, s.url+url.PathEscape(topic), header, body)
+}
+
+func (s *KafkaSink) Close() error {
+	return nil
+}
+
+func post(client *http.Client, url string, header http.Header, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header = header
+
+	res, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send events: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("send events: status %d: %s", res.StatusCode, msg)
+	}
+	return nil
+}
+
+// NATSSink publishes events to the subject of their topic using the text
+// protocol of NATS, so no NATS client is required inside Weaviate. A batch
+// is accepted once the server answered a ping sent after it, or with
+// JetStream once every event is acknowledged by its stream. The connection
+// is reestablished on the next batch after any error.
+type NATSSink struct {
+	addr      string
+	token     string
+	jetStream bool
+
+	conn  net.Conn
+	r     *bufio.Reader
+	inbox string
+}
+
+func NewNATSSink(cfg NATSConfig) (*NATSSink, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parse nats url: %w", err)
+	}
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), "4222")
+	}
+	token := cfg.Token
+	if token == "" && u.User != nil {
+		token = u.User.Username()
+	}
+	return &NATSSink{addr: addr, token: token, jetStream: cfg.JetStream}, nil
+}
+
+func (s *NATSSink) Name() string {
+	return SinkNATS
+}
+
+func (s *NATSSink) Publish(topic string, events []Event) error {
+	if s.conn == nil {
+		if err := s.connect(); err != nil {
+			return fmt.Errorf("connect to nats: %w", err)
+		}
+	}
+
+	if err := s.publish(topic, events); err != nil {
+		s.conn.Close()
+		s.conn = nil
+		return err
+	}
+	return nil
+}
+
+func (s *NATSSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+func (s *NATSSink) connect() error {
+	conn, err := net.DialTimeout("tcp", s.addr, sinkTimeout)
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(sinkTimeout))
+	r := bufio.NewReader(conn)
+
+	line, err := readNATSLine(r)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		conn.Close()
+		return fmt.Errorf("unexpected greeting %q", line)
+	}
+
+	opts := map[string]interface{}{
+		"verbose":       false,
+		"pedantic":      false,
+		"name":          "weaviate-change-stream",
+		"lang":          "go",
+		"protocol":      1,
+		"headers":       true,
+		"no_responders": true,
+	}
+	if s.token != "" {
+		opts["auth_token"] = s.token
+	}
+	connect, err := json.Marshal(opts)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	cmds := fmt.Sprintf("CONNECT %s\r\nPING\r\n", connect)
+	inbox := ""
+	if s.jetStream {
+		inbox = "_INBOX." + strings.ReplaceAll(uuid.NewString(), "-", "")
+		cmds += fmt.Sprintf("SUB %s.* 1\r\n", inbox)
+	}
+	if _, err := io.WriteString(conn, cmds); err != nil {
+		conn.Close()
+		return err
+	}
+
+	s.conn, s.r, s.inbox = conn, r, inbox
+	if err := s.awaitPong(); err != nil {
+		conn.Close()
+		s.conn = nil
+		return err
+	}
+	return nil
+}
+
+func (s *NATSSink) publish(subject string, events []Event) error {
+	s.conn.SetDeadline(time.Now().Add(sinkTimeout))
+
+	var buf bytes.Buffer
+	for i, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("marshal event: %w", err)
+		}
+		if s.jetStream {
+			fmt.Fprintf(&buf, "PUB %s %s.%d %d\r\n", subject, s.inbox, i, len(data))
+		} else {
+			fmt.Fprintf(&buf, "PUB %s %d\r\n", subject, len(data))
+		}
+		buf.Write(data)
+		buf.WriteString("\r\n")
+	}
+	if !s.jetStream {
+		buf.WriteString("PING\r\n")
+	}
+	if _, err := s.conn.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("publish to nats: %w", err)
+	}
+
+	if !s.jetStream {
+		return s.awaitPong()
+	}
+	return s.awaitAcks(len(events))
+}
+
+// awaitPong reads until the server answered a ping, which it does only
+// after it processed all previous messages
+func (s *NATSSink) awaitPong() error {
+	for {
+		line, err := readNATSLine(s.r)
+		if err != nil {
+			return err
+		}
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := io.WriteString(s.conn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+// jetStreamAck is the reply of a stream to a published message
+type jetStreamAck struct {
+	Stream string `json:"stream"`
+	Error  *struct {
+		Description string `json:"description"`
+	} `json:"error"`
+}
+
+func (s *NATSSink) awaitAcks(n int) error {
+	for acked := 0; acked < n; {
+		line, err := readNATSLine(s.r)
+		if err != nil {
+			return err
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "PING":
+			if _, err := io.WriteString(s.conn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case "-ERR":
+			return fmt.Errorf("nats: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		case "MSG":
+			// MSG <subject> <sid> [reply-to] <#bytes>
+			payload, err := readNATSPayload(s.r, fields[len(fields)-1])
+			if err != nil {
+				return err
+			}
+			var ack jetStreamAck
+			if err := json.Unmarshal(payload, &ack); err != nil {
+				return fmt.Errorf("parse jetstream ack: %w", err)
+			}
+			if ack.Error != nil {
+				return fmt.Errorf("jetstream: %s", ack.Error.Description)
+			}
+			acked++
+		case "HMSG":
+			// HMSG <subject> <sid> [reply-to] <#header bytes> <#total bytes>,
+			// only sent without a stream for the subject
+			payload, err := readNATSPayload(s.r, fields[len(fields)-1])
+			if err != nil {
+				return err
+			}
+			status := strings.SplitN(string(payload), "\r\n", 2)[0]
+			return fmt.Errorf("jetstream: no stream for subject (%s)", status)
+		}
+	}
+	return nil
+}
+
+func readNATSLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("read from nats: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func readNATSPayload(r *bufio.Reader, size string) ([]byte, error) {
+	n, err := strconv.Atoi(size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid nats message size %q", size)
+	}
+	payload := make([]byte, n+2)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, fmt.Errorf("read from nats: %w", err)
+	}
+	return payload[:n], nil
+}
@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cdc
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEvents = []Event{
+	{
+		ID: "event-1", Time: time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC), Node: "node-1",
+		Op: OpCreate, Class: "Article", ObjectID: "id-1",
+		Properties: map[string]interface{}{"title": "hello"},
+	},
+	{
+		ID: "event-2", Time: time.Date(2023, 9, 1, 12, 0, 1, 0, time.UTC), Node: "node-1",
+		Op: OpDelete, Class: "Article", ObjectID: "id-2",
+	},
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received []Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, "articles", r.Header.Get("X-Weaviate-Topic"))
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(WebhookConfig{URL: server.URL, Token: "secret"})
+	require.Nil(t, sink.Publish("articles", testEvents))
+	assert.Equal(t, testEvents, received)
+
+	t.Run("unsuccessful status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			io.WriteString(w, "try again later")
+		}))
+		defer server.Close()
+
+		sink := NewWebhookSink(WebhookConfig{URL: server.URL})
+		assert.EqualError(t, sink.Publish("articles", testEvents),
+			"send events: status 503: try again later")
+	})
+}
+
+func TestKafkaSink(t *testing.T) {
+	var received struct {
+		Records []kafkaRecord `json:"records"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/weaviate.Article", r.URL.Path)
+		assert.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	sink := NewKafkaSink(KafkaConfig{RESTProxyURL: server.URL + "/"})
+	require.Nil(t, sink.Publish("weaviate.Article", testEvents))
+	assert.Equal(t, []kafkaRecord{
+		{Key: "id-1", Value: testEvents[0]},
+		{Key: "id-2", Value: testEvents[1]},
+	}, received.Records)
+}
+
+// natsServer speaks enough of the NATS protocol to accept publishes. With
+// a stream it acknowledges every message published with a reply subject.
+type natsServer struct {
+	listener net.Listener
+	stream   string
+
+	sync.Mutex
+	connect   map[string]interface{}
+	published map[string][]Event
+}
+
+func newNATSServer(t *testing.T, stream string) *natsServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	s := &natsServer{listener: l, stream: stream, published: map[string][]Event{}}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	t.Cleanup(func() { l.Close() })
+	return s
+}
+
+func (s *natsServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	fmt.Fprint(conn, "INFO {\"server_id\":\"test\",\"headers\":true}\r\n")
+
+	seq := 0
+	for {
+		line, err := readNATSLine(r)
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "CONNECT":
+			s.Lock()
+			json.Unmarshal([]byte(strings.TrimPrefix(line, "CONNECT ")), &s.connect)
+			s.Unlock()
+		case "PING":
+			fmt.Fprint(conn, "PONG\r\n")
+		case "PUB":
+			size, _ := strconv.Atoi(fields[len(fields)-1])
+			payload := make([]byte, size+2)
+			io.ReadFull(r, payload)
+			var event Event
+			json.Unmarshal(payload[:size], &event)
+			s.Lock()
+			s.published[fields[1]] = append(s.published[fields[1]], event)
+			s.Unlock()
+
+			if len(fields) == 4 {
+				if s.stream == "" {
+					hdr := "NATS/1.0 503\r\n\r\n"
+					fmt.Fprintf(conn, "HMSG %s 1 %d %d\r\n%s\r\n", fields[2], len(hdr), len(hdr), hdr)
+					continue
+				}
+				seq++
+				ack := fmt.Sprintf(`{"stream":%q,"seq":%d}`, s.stream, seq)
+				fmt.Fprintf(conn, "MSG %s 1 %d\r\n%s\r\n", fields[2], len(ack), ack)
+			}
+		}
+	}
+}
+
+func TestNATSSink(t *testing.T) {
+	t.Run("core", func(t *testing.T) {
+		server := newNATSServer(t, "")
+		sink, err := NewNATSSink(NATSConfig{URL: "nats://secret@" + server.listener.Addr().String()})
+		require.Nil(t, err)
+		defer sink.Close()
+
+		require.Nil(t, sink.Publish("weaviate.Article", testEvents))
+		require.Nil(t, sink.Publish("weaviate.Article", testEvents[:1]))
+
+		server.Lock()
+		defer server.Unlock()
+		assert.Equal(t, "secret", server.connect["auth_token"])
+		assert.Equal(t, append(testEvents, testEvents[0]), server.published["weaviate.Article"])
+	})
+
+	t.Run("jetstream", func(t *testing.T) {
+		server := newNATSServer(t, "CHANGES")
+		sink, err := NewNATSSink(NATSConfig{
+			URL:       "nats://" + server.listener.Addr().String(),
+			JetStream: true,
+		})
+		require.Nil(t, err)
+		defer sink.Close()
+
+		require.Nil(t, sink.Publish("weaviate.Article", testEvents))
+		server.Lock()
+		defer server.Unlock()
+		assert.Equal(t, testEvents, server.published["weaviate.Article"])
+	})
+
+	t.Run("jetstream without stream", func(t *testing.T) {
+		server := newNATSServer(t, "")
+		sink, err := NewNATSSink(NATSConfig{
+			URL:       "nats://" + server.listener.Addr().String(),
+			JetStream: true,
+		})
+		require.Nil(t, err)
+		defer sink.Close()
+
+		assert.ErrorContains(t, sink.Publish("weaviate.Article", testEvents), "no stream")
+		// the connection is reestablished
+		assert.ErrorContains(t, sink.Publish("weaviate.Article", testEvents), "no stream")
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.Nil(t, err)
+		addr := l.Addr().String()
+		l.Close()
+
+		sink, err := NewNATSSink(NATSConfig{URL: "nats://" + addr})
+		require.Nil(t, err)
+		assert.ErrorContains(t, sink.Publish("weaviate.Article", testEvents), "connect to nats")
+	})
+}
@@ -0,0 +1,361 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package cdc publishes the changes of objects (change data capture), so
+// that downstream systems such as caches or analytics can subscribe to them.
+// Changes are appended to a local spool first and published to the sink in
+// the background. A spooled change is only removed once the sink accepted
+// it, so every change is delivered at least once, also across restarts.
+// Consumers can use the id of an event to skip duplicates.
+package cdc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tracing"
+)
+
+const (
+	OpCreate = "create"
+	OpUpdate = "update"
+	OpDelete = "delete"
+)
+
+const (
+	spoolCurrent  = "current.log"
+	spoolSuffix   = ".log"
+	batchSize     = 100
+	flushInterval = time.Second
+	maxBackoff    = time.Minute
+)
+
+// Event is a single change of an object. Creates and updates contain the
+// properties of the object after the change.
+type Event struct {
+	ID         string      `json:"id"`
+	Time       time.Time   `json:"time"`
+	Node       string      `json:"node"`
+	RequestID  string      `json:"requestId,omitempty"`
+	Op         string      `json:"op"`
+	Class      string      `json:"class"`
+	Tenant     string      `json:"tenant,omitempty"`
+	ObjectID   strfmt.UUID `json:"objectId"`
+	Properties interface{} `json:"properties,omitempty"`
+	Vector     []float32   `json:"vector,omitempty"`
+}
+
+// Sink publishes events to a topic. Publish is only called from a single
+// goroutine and must only return once all events are accepted.
+type Sink interface {
+	Name() string
+	Publish(topic string, events []Event) error
+	Close() error
+}
+
+// Stream records the changes of objects and publishes them in the
+// background. A nil Stream is valid and records nothing.
+type Stream struct {
+	config  Config
+	dir     string
+	node    string
+	sink    Sink
+	classes map[string]struct{}
+	metrics *Metrics
+	logger  logrus.FieldLogger
+	now     func() time.Time
+
+	sync.Mutex
+	file *os.File
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// New creates the sink of the config and starts publishing the changes
+// spooled in dir, including the ones left from a previous run
+func New(cfg Config, dir, node string, metrics *Metrics,
+	logger logrus.FieldLogger,
+) (*Stream, error) {
+	sink, err := newSink(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithSink(cfg, sink, dir, node, metrics, logger)
+}
+
+// NewWithSink starts publishing the changes spooled in dir to the sink
+func NewWithSink(cfg Config, sink Sink, dir, node string, metrics *Metrics,
+	logger logrus.FieldLogger,
+) (*Stream, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create change stream spool: %w", err)
+	}
+
+	s := &Stream{
+		config:  cfg,
+		dir:     dir,
+		node:    node,
+		sink:    sink,
+		metrics: metrics,
+		logger:  logger.WithField("action", "change_stream"),
+		now:     time.Now,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	if len(cfg.Classes) > 0 {
+		s.classes = make(map[string]struct{}, len(cfg.Classes))
+		for _, class := range cfg.Classes {
+			s.classes[schema.UppercaseClassName(class)] = struct{}{}
+		}
+	}
+	go s.run()
+	return s, nil
+}
+
+// Record a change of an object. Deletes only need the class, tenant and id
+// of the object. The change is spooled before Record returns, a change
+// which can not be spooled is logged, since the change itself succeeded.
+func (s *Stream) Record(ctx context.Context, op string, obj *models.Object) {
+	if s == nil || obj == nil {
+		return
+	}
+	class := schema.UppercaseClassName(obj.Class)
+	if s.classes != nil {
+		if _, ok := s.classes[class]; !ok {
+			return
+		}
+	}
+
+	event := Event{
+		ID:        uuid.NewString(),
+		Time:      s.now().UTC(),
+		Node:      s.node,
+		RequestID: tracing.RequestID(ctx),
+		Op:        op,
+		Class:     class,
+		Tenant:    obj.Tenant,
+		ObjectID:  obj.ID,
+	}
+	if op != OpDelete {
+		event.Properties = obj.Properties
+		if s.config.IncludeVectors {
+			event.Vector = obj.Vector
+		}
+	}
+
+	if err := s.spool(event); err != nil {
+		s.logger.WithField("class", class).
+			WithField("id", obj.ID).
+			WithError(err).Error("could not record change")
+	}
+}
+
+func (s *Stream) spool(event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if s.file == nil {
+		f, err := os.OpenFile(filepath.Join(s.dir, spoolCurrent),
+			os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return err
+		}
+		s.file = f
+	}
+	_, err = s.file.Write(append(line, '\n'))
+	return err
+}
+
+// Close publishes the pending changes once more and closes the sink.
+// Changes which could not be published remain spooled.
+func (s *Stream) Close() error {
+	if s == nil {
+		return nil
+	}
+
+	s.closeOnce.Do(func() { close(s.stop) })
+	<-s.done
+
+	s.Lock()
+	defer s.Unlock()
+	var errs []error
+	if s.file != nil {
+		errs = append(errs, s.file.Close())
+		s.file = nil
+	}
+	errs = append(errs, s.sink.Close())
+	return errors.Join(errs...)
+}
+
+func (s *Stream) run() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	var backoff time.Duration
+	var retryAt time.Time
+	for {
+		select {
+		case <-ticker.C:
+			if s.now().Before(retryAt) {
+				continue
+			}
+			if err := s.publish(); err != nil {
+				backoff = nextBackoff(backoff)
+				retryAt = s.now().Add(backoff)
+				s.logger.WithField("retry_in", backoff).WithError(err).
+					Warn("could not publish changes")
+				continue
+			}
+			backoff, retryAt = 0, time.Time{}
+		case <-s.stop:
+			if err := s.publish(); err != nil {
+				s.logger.WithError(err).
+					Warn("could not publish changes, they are published after the next start")
+			}
+			return
+		}
+	}
+}
+
+// publish closes the current spool file and publishes all spooled files in
+// the order they were written. A file is removed once all its events are
+// published, a file which was published partially is published again.
+func (s *Stream) publish() error {
+	if err := s.rotate(); err != nil {
+		return fmt.Errorf("rotate spool: %w", err)
+	}
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("read spool: %w", err)
+	}
+	var files []string
+	for _, entry := range entries {
+		if name := entry.Name(); name != spoolCurrent && strings.HasSuffix(name, spoolSuffix) {
+			files = append(files, name)
+		}
+	}
+	sort.Strings(files)
+
+	for _, name := range files {
+		path := filepath.Join(s.dir, name)
+		if err := s.publishFile(path); err != nil {
+			return err
+		}
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("remove published spool file: %w", err)
+		}
+	}
+	return nil
+}
+
+// rotate renames the current spool file, so that new changes are spooled
+// to a new one
+func (s *Stream) rotate() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.file != nil {
+		if err := s.file.Close(); err != nil {
+			return err
+		}
+		s.file = nil
+	}
+	// spool files are named by the time they were closed, so their names
+	// sort in the order they were written
+	name := fmt.Sprintf("%020d%s", time.Now().UnixNano(), spoolSuffix)
+	err := os.Rename(filepath.Join(s.dir, spoolCurrent), filepath.Join(s.dir, name))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *Stream) publishFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read spool file: %w", err)
+	}
+
+	// events are published per topic, keeping their order within a topic
+	var topics []string
+	byTopic := map[string][]Event{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			// the last line is incomplete if the node crashed while writing
+			s.logger.WithField("file", filepath.Base(path)).WithError(err).
+				Warn("skipping unreadable change")
+			continue
+		}
+		topic := s.config.topic(event.Class)
+		if _, ok := byTopic[topic]; !ok {
+			topics = append(topics, topic)
+		}
+		byTopic[topic] = append(byTopic[topic], event)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read spool file: %w", err)
+	}
+
+	for _, topic := range topics {
+		events := byTopic[topic]
+		for len(events) > 0 {
+			n := len(events)
+			if n > batchSize {
+				n = batchSize
+			}
+			err := s.sink.Publish(topic, events[:n])
+			s.metrics.Published(s.sink.Name(), topic, n, err)
+			if err != nil {
+				return fmt.Errorf("publish to %s: %w", topic, err)
+			}
+			events = events[n:]
+		}
+	}
+	return nil
+}
+
+func nextBackoff(backoff time.Duration) time.Duration {
+	switch {
+	case backoff == 0:
+		return flushInterval
+	case 2*backoff > maxBackoff:
+		return maxBackoff
+	default:
+		return 2 * backoff
+	}
+}
@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cdc
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/tracing"
+)
+
+type memorySink struct {
+	sync.Mutex
+	topics  []string
+	events  map[string][]Event
+	failing bool
+	closed  bool
+}
+
+func (s *memorySink) Name() string {
+	return "memory"
+}
+
+func (s *memorySink) Publish(topic string, events []Event) error {
+	s.Lock()
+	defer s.Unlock()
+	if s.failing {
+		return errors.New("unavailable")
+	}
+	if s.events == nil {
+		s.events = map[string][]Event{}
+	}
+	if _, ok := s.events[topic]; !ok {
+		s.topics = append(s.topics, topic)
+	}
+	s.events[topic] = append(s.events[topic], events...)
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	s.closed = true
+	return nil
+}
+
+func newTestStream(t *testing.T, cfg Config, sink Sink, dir string) *Stream {
+	logger, _ := test.NewNullLogger()
+	s, err := NewWithSink(cfg, sink, dir, "node-1", nil, logger)
+	require.Nil(t, err)
+	s.now = func() time.Time { return time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC) }
+	return s
+}
+
+func TestStream_Record(t *testing.T) {
+	sink := &memorySink{}
+	s := newTestStream(t, Config{
+		Topic:       DefaultTopic,
+		ClassTopics: map[string]string{"Author": "authors"},
+		Classes:     []string{"article", "Author"},
+	}, sink, t.TempDir())
+
+	ctx := tracing.WithRequestID(context.Background(), "request-1")
+	props := map[string]interface{}{"title": "hello"}
+	s.Record(ctx, OpCreate, &models.Object{
+		Class: "Article", ID: "id-1", Tenant: "t1", Properties: props, Vector: []float32{1, 2},
+	})
+	s.Record(ctx, OpCreate, &models.Object{Class: "Author", ID: "id-2"})
+	s.Record(ctx, OpDelete, &models.Object{Class: "Article", ID: "id-1", Tenant: "t1", Properties: props})
+	s.Record(ctx, OpCreate, &models.Object{Class: "Ignored", ID: "id-3"})
+	require.Nil(t, s.Close())
+
+	assert.True(t, sink.closed)
+	assert.Equal(t, []string{"weaviate.Article", "authors"}, sink.topics)
+	articles := sink.events["weaviate.Article"]
+	require.Len(t, articles, 2)
+	assert.NotEmpty(t, articles[0].ID)
+	assert.NotEqual(t, articles[0].ID, articles[1].ID)
+	assert.Equal(t, Event{
+		ID:         articles[0].ID,
+		Time:       time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC),
+		Node:       "node-1",
+		RequestID:  "request-1",
+		Op:         OpCreate,
+		Class:      "Article",
+		Tenant:     "t1",
+		ObjectID:   "id-1",
+		Properties: props,
+	}, articles[0])
+	// deletes have no properties
+	assert.Equal(t, OpDelete, articles[1].Op)
+	assert.Nil(t, articles[1].Properties)
+}
+
+func TestStream_Vectors(t *testing.T) {
+	sink := &memorySink{}
+	s := newTestStream(t, Config{Topic: "changes", IncludeVectors: true}, sink, t.TempDir())
+	s.Record(context.Background(), OpUpdate, &models.Object{Class: "Article", ID: "id-1", Vector: []float32{1, 2}})
+	require.Nil(t, s.Close())
+
+	require.Len(t, sink.events["changes"], 1)
+	assert.Equal(t, []float32{1, 2}, sink.events["changes"][0].Vector)
+}
+
+func TestStream_AtLeastOnce(t *testing.T) {
+	dir := t.TempDir()
+	failing := &memorySink{failing: true}
+	s := newTestStream(t, Config{Topic: "changes"}, failing, dir)
+	s.Record(context.Background(), OpCreate, &models.Object{Class: "Article", ID: "id-1"})
+	s.Record(context.Background(), OpCreate, &models.Object{Class: "Article", ID: "id-2"})
+	require.Nil(t, s.Close())
+
+	// the changes remain spooled and are published after a restart
+	entries, err := os.ReadDir(dir)
+	require.Nil(t, err)
+	assert.Len(t, entries, 1)
+
+	sink := &memorySink{}
+	s = newTestStream(t, Config{Topic: "changes"}, sink, dir)
+	s.Record(context.Background(), OpCreate, &models.Object{Class: "Article", ID: "id-3"})
+	require.Nil(t, s.Close())
+
+	require.Len(t, sink.events["changes"], 3)
+	for i, id := range []string{"id-1", "id-2", "id-3"} {
+		assert.Equal(t, id, sink.events["changes"][i].ObjectID.String())
+	}
+	entries, err = os.ReadDir(dir)
+	require.Nil(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestStream_Nil(t *testing.T) {
+	var s *Stream
+	s.Record(context.Background(), OpCreate, &models.Object{Class: "Article"})
+	assert.Nil(t, s.Close())
+}
+
+func TestConfig_Validate(t *testing.T) {
+	valid := Config{Enabled: true, Sink: SinkNATS, Topic: DefaultTopic,
+		NATS: NATSConfig{URL: "nats://localhost:4222"}}
+	assert.Nil(t, valid.Validate())
+	assert.Nil(t, Config{}.Validate())
+
+	tests := []struct {
+		name   string
+		change func(c *Config)
+		err    string
+	}{
+		{"unknown sink", func(c *Config) { c.Sink = "pulsar" }, "unknown sink"},
+		{"webhook without url", func(c *Config) { c.Sink = SinkWebhook }, "url is required"},
+		{"kafka with nats url", func(c *Config) {
+			c.Sink = SinkKafka
+			c.Kafka.RESTProxyURL = "nats://localhost"
+		}, "scheme must be http or https"},
+		{"nats with http url", func(c *Config) { c.NATS.URL = "http://localhost" }, "scheme must be nats"},
+		{"without topic", func(c *Config) { c.Topic = "" }, "topic is required"},
+		{"empty class topic", func(c *Config) { c.ClassTopics = map[string]string{"Article": ""} }, "Article"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := valid
+			test.change(&cfg)
+			assert.ErrorContains(t, cfg.Validate(), test.err)
+		})
+	}
+}
@@ -80,6 +80,10 @@ func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string {
 	return ss.Shard("", string(uuid))
 }
 
+func (f *fakeSchemaGetter) PreviousShardFromUUID(class string, uuid []byte) string {
+	return f.shardState.PreviousPhysicalShard(uuid)
+}
+
 func (f *fakeSchemaGetter) Nodes() []string {
 	return []string{"node1"}
 }
@@ -495,6 +499,16 @@ func (f *fakeRemoteNodeClient) GetNodeStatus(ctx context.Context, hostName, clas
 	return &models.NodeStatus{}, nil
 }
 
+func (f *fakeRemoteNodeClient) GetTenantStats(ctx context.Context, hostName, className, tenant string) (*schema.TenantStats, error) {
+	return &schema.TenantStats{}, nil
+}
+
+func (f *fakeRemoteNodeClient) GetObjectVersions(ctx context.Context, hostName, className, shard string,
+	id strfmt.UUID,
+) ([]*storobj.ObjectVersion, error) {
+	return nil, nil
+}
+
 type fakeReplicationClient struct{}
 
 func (f *fakeReplicationClient) PutObject(ctx context.Context, host, index, shard, requestID string,
@@ -566,6 +580,18 @@ func (c *fakeReplicationClient) DigestObjects(ctx context.Context,
 	return nil, nil
 }
 
+func (c *fakeReplicationClient) HashTreeLeaves(ctx context.Context,
+	host, index, shard string, depth int,
+) ([]uint64, error) {
+	return nil, nil
+}
+
+func (c *fakeReplicationClient) LeafDigests(ctx context.Context,
+	host, index, shard string, depth, leaf int,
+) ([]replica.RepairResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeReplicationClient) OverwriteObjects(ctx context.Context,
 	host, index, shard string, vobjects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
@@ -14,6 +14,7 @@ package cluster
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"sync"
@@ -65,6 +66,21 @@ type NodeInfo struct {
 	LastTimeMilli int64 // last update time in milliseconds
 }
 
+// nodeMeta is gossiped as part of the membership of a node
+type nodeMeta struct {
+	// Draining nodes are not considered for new shards
+	Draining bool `json:"draining,omitempty"`
+}
+
+// parseNodeMeta returns the zero value for nodes which do not send meta data
+func parseNodeMeta(data []byte) nodeMeta {
+	var meta nodeMeta
+	if len(data) > 0 {
+		json.Unmarshal(data, &meta)
+	}
+	return meta
+}
+
 func (d *spaceMsg) marshal() (data []byte, err error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 24+len(d.Node)))
 	if err := binary.Write(buf, binary.BigEndian, d.header); err != nil {
@@ -116,6 +132,7 @@ type delegate struct {
 
 	mutex    sync.Mutex
 	hostInfo NodeInfo
+	meta     nodeMeta
 }
 
 func (d *delegate) setOwnSpace(x DiskUsage) {
@@ -124,6 +141,12 @@ func (d *delegate) setOwnSpace(x DiskUsage) {
 	d.mutex.Unlock()
 }
 
+func (d *delegate) setDraining(draining bool) {
+	d.mutex.Lock()
+	d.meta.Draining = draining
+	d.mutex.Unlock()
+}
+
 func (d *delegate) ownInfo() NodeInfo {
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
@@ -153,7 +176,19 @@ func (d *delegate) init(diskSpace func(path string) (DiskUsage, error)) error {
 // when broadcasting an alive message. It's length is limited to
 // the given byte size. This metadata is available in the Node structure.
 func (d *delegate) NodeMeta(limit int) (meta []byte) {
-	return nil
+	d.mutex.Lock()
+	m := d.meta
+	d.mutex.Unlock()
+	if m == (nodeMeta{}) {
+		return nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil || len(data) > limit {
+		d.log.WithField("action", "delegate.node_meta").
+			WithField("limit", limit).Error("could not encode node meta data")
+		return nil
+	}
+	return data
 }
 
 // LocalState is used for a TCP Push/Pull. This is sent to
@@ -211,6 +211,22 @@ func TestDelegateSort(t *testing.T) {
 	}
 }
 
+func TestDelegateNodeMeta(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	d := delegate{Name: "N0", log: logger}
+	assert.Nil(t, d.NodeMeta(512))
+	assert.False(t, parseNodeMeta(nil).Draining)
+
+	d.setDraining(true)
+	meta := d.NodeMeta(512)
+	assert.True(t, parseNodeMeta(meta).Draining)
+	assert.Nil(t, d.NodeMeta(len(meta)-1), "meta data exceeds the limit")
+
+	d.setDraining(false)
+	assert.Nil(t, d.NodeMeta(512))
+	assert.False(t, parseNodeMeta([]byte("invalid")).Draining)
+}
+
 func TestDelegateCleanUp(t *testing.T) {
 	st := State{
 		delegate: delegate{
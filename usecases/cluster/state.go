@@ -21,6 +21,9 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// ErrReadOnly is returned for client writes to a read-only node
+var ErrReadOnly = errors.New("this node is read-only, writes must be sent to another node")
+
 type State struct {
 	config   Config
 	list     *memberlist.Memberlist
@@ -35,6 +38,10 @@ type Config struct {
 	IgnoreStartupSchemaSync bool       `json:"ignoreStartupSchemaSync" yaml:"ignoreStartupSchemaSync"`
 	SkipSchemaSyncRepair    bool       `json:"skipSchemaSyncRepair" yaml:"skipSchemaSyncRepair"`
 	AuthConfig              AuthConfig `json:"auth" yaml:"auth"`
+	// ReadOnly nodes serve queries only. They hold shard replicas, which
+	// are kept up to date by the writes of the other nodes, but reject
+	// writes and schema changes of clients.
+	ReadOnly bool `json:"readOnly" yaml:"readOnly"`
 }
 
 type AuthConfig struct {
@@ -157,9 +164,34 @@ func (s *State) AllNames() []string {
 }
 
 // Candidates returns list of nodes (names) sorted by the
-// free amount of disk space in descending order
+// free amount of disk space in descending order. Draining nodes
+// are excluded.
 func (s *State) Candidates() []string {
-	return s.delegate.sortCandidates(s.AllNames())
+	mem := s.list.Members()
+	names := make([]string, 0, len(mem))
+	for _, m := range mem {
+		if !parseNodeMeta(m.Meta).Draining {
+			names = append(names, m.Name)
+		}
+	}
+	return s.delegate.sortCandidates(names)
+}
+
+// SetDraining marks this node as draining, so that no new shards are
+// placed on it. The mark is gossiped to the other members.
+func (s *State) SetDraining(draining bool) error {
+	s.delegate.setDraining(draining)
+	return s.list.UpdateNode(_ProtoTTL)
+}
+
+// Draining returns whether a member is marked as draining
+func (s *State) Draining(nodeName string) bool {
+	for _, m := range s.list.Members() {
+		if m.Name == nodeName {
+			return parseNodeMeta(m.Meta).Draining
+		}
+	}
+	return false
 }
 
 // All node names (not their hostnames!) for live members, including self.
@@ -191,6 +223,11 @@ func (s *State) SchemaSyncIgnored() bool {
 	return s.config.IgnoreStartupSchemaSync
 }
 
+// ReadOnly returns whether this node rejects client writes
+func (s *State) ReadOnly() bool {
+	return s.config.ReadOnly
+}
+
 func (s *State) SkipSchemaRepair() bool {
 	return s.config.SkipSchemaSyncRepair
 }
@@ -198,3 +235,9 @@ func (s *State) SkipSchemaRepair() bool {
 func (s *State) NodeInfo(node string) (NodeInfo, bool) {
 	return s.delegate.get(node)
 }
+
+// Shutdown stops gossiping without leaving the cluster, so that the ports
+// are released
+func (s *State) Shutdown() error {
+	return s.list.Shutdown()
+}
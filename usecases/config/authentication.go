@@ -11,7 +11,10 @@
 
 package config
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // Authentication configuration
 type Authentication struct {
@@ -52,10 +55,54 @@ type OIDC struct {
 	UsernameClaim     string   `yaml:"username_claim" json:"username_claim"`
 	GroupsClaim       string   `yaml:"groups_claim" json:"groups_claim"`
 	Scopes            []string `yaml:"scopes" json:"scopes"`
+	// RoleMappings derive rbac roles from the claims of a token
+	RoleMappings []OIDCRoleMapping `yaml:"role_mappings" json:"role_mappings"`
+	// TenantClaim names a claim which restricts all requests of the subject
+	// to the tenant it contains
+	TenantClaim string `yaml:"tenant_claim" json:"tenant_claim"`
+}
+
+// OIDCRoleMapping assigns roles to all subjects whose token contains the
+// value in the claim. The claim can be a string or an array of strings, an
+// empty claim refers to the groups claim.
+type OIDCRoleMapping struct {
+	Claim string   `yaml:"claim" json:"claim"`
+	Value string   `yaml:"value" json:"value"`
+	Roles []string `yaml:"roles" json:"roles"`
+}
+
+// ParseOIDCRoleMappings parses mappings in the format
+// "[<claim>:]<value>=<role>[|<role>...]", separated by commas, as used in the
+// AUTHENTICATION_OIDC_ROLE_MAPPINGS environment variable
+func ParseOIDCRoleMappings(in string) ([]OIDCRoleMapping, error) {
+	var mappings []OIDCRoleMapping
+	for _, entry := range strings.Split(in, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		match, roles, ok := strings.Cut(entry, "=")
+		if !ok || match == "" || roles == "" {
+			return nil, fmt.Errorf("role mapping %q must have the format "+
+				"[<claim>:]<value>=<role>[|<role>...]", entry)
+		}
+
+		mapping := OIDCRoleMapping{Value: match, Roles: strings.Split(roles, "|")}
+		if claim, value, ok := strings.Cut(match, ":"); ok {
+			mapping.Claim, mapping.Value = claim, value
+		}
+		mappings = append(mappings, mapping)
+	}
+	return mappings, nil
 }
 
 type APIKey struct {
 	Enabled     bool     `json:"enabled" yaml:"enabled"`
 	Users       []string `json:"users" yaml:"users"`
 	AllowedKeys []string `json:"allowed_keys" yaml:"allowed_keys"`
+	// ManagementEnabled allows admins to create and revoke scoped keys at
+	// runtime through the /v1/apikeys API. The static keys above are optional
+	// if this is enabled.
+	ManagementEnabled bool `json:"management_enabled" yaml:"management_enabled"`
 }
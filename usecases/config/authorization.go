@@ -15,17 +15,37 @@ import (
 	"fmt"
 
 	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/masking"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 )
 
 // Authorization configuration
 type Authorization struct {
-	AdminList adminlist.Config `json:"admin_list" yaml:"admin_list"`
+	AdminList     adminlist.Config `json:"admin_list" yaml:"admin_list"`
+	RBAC          rbac.Config      `json:"rbac" yaml:"rbac"`
+	SensitiveData masking.Config   `json:"sensitive_data" yaml:"sensitive_data"`
+}
+
+// Enabled checks whether any authorization method is configured. Without
+// authorization every subject is allowed to do everything.
+func (a Authorization) Enabled() bool {
+	return a.AdminList.Enabled || a.RBAC.Enabled
 }
 
 // Validate the Authorization configuration. This only validates at a general
 // level. Validation specific to the individual auth methods should happen
 // inside their respective packages
 func (a Authorization) Validate() error {
+	if a.AdminList.Enabled && a.RBAC.Enabled {
+		return fmt.Errorf("authorization: admin list and rbac can not be enabled at the same time")
+	}
+
+	if a.RBAC.Enabled {
+		if err := a.RBAC.Validate(); err != nil {
+			return fmt.Errorf("authorization: %s", err)
+		}
+	}
+
 	if a.AdminList.Enabled {
 		if err := a.AdminList.Validate(); err != nil {
 			return fmt.Errorf("authorization: %s", err)
@@ -22,10 +22,20 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/deprecations"
+	"github.com/weaviate/weaviate/entities/cron"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/cdc"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/otlp"
+	"github.com/weaviate/weaviate/usecases/querycache"
+	"github.com/weaviate/weaviate/usecases/quota"
+	"github.com/weaviate/weaviate/usecases/slowquery"
 	"gopkg.in/yaml.v2"
 )
 
@@ -56,7 +66,10 @@ const (
 
 	DefaultDiskUseWarningPercentage  = uint64(80)
 	DefaultDiskUseReadonlyPercentage = uint64(90)
-	DefaultMemUseWarningPercentage   = uint64(80)
+	// DefaultDiskUseRecoveryMarginPercentage of the disk must be freed below
+	// the read-only thresholds before shards return to read-write
+	DefaultDiskUseRecoveryMarginPercentage = uint64(5)
+	DefaultMemUseWarningPercentage         = uint64(80)
 	// TODO: off by default for now, to make sure
 	//       the measurement is reliable. once
 	//       confirmed, we can set this to 90
@@ -75,6 +88,9 @@ type Config struct {
 	QueryDefaults                       QueryDefaults            `json:"query_defaults" yaml:"query_defaults"`
 	QueryMaximumResults                 int64                    `json:"query_maximum_results" yaml:"query_maximum_results"`
 	QueryNestedCrossReferenceLimit      int64                    `json:"query_nested_cross_reference_limit" yaml:"query_nested_cross_reference_limit"`
+	QueryShardConcurrency               int                      `json:"query_shard_concurrency" yaml:"query_shard_concurrency"`
+	QueryMaxShardSearches               int                      `json:"query_max_shard_searches" yaml:"query_max_shard_searches"`
+	QueryCrossTenantEnabled             bool                     `json:"query_cross_tenant_enabled" yaml:"query_cross_tenant_enabled"`
 	Contextionary                       Contextionary            `json:"contextionary" yaml:"contextionary"`
 	Authentication                      Authentication           `json:"authentication" yaml:"authentication"`
 	Authorization                       Authorization            `json:"authorization" yaml:"authorization"`
@@ -90,6 +106,8 @@ type Config struct {
 	Replication                         replication.GlobalConfig `json:"replication" yaml:"replication"`
 	Monitoring                          Monitoring               `json:"monitoring" yaml:"monitoring"`
 	GRPC                                GRPC                     `json:"grpc" yaml:"grpc"`
+	Postgres                            Postgres                 `json:"postgres" yaml:"postgres"`
+	HTTP3                               HTTP3                    `json:"http3" yaml:"http3"`
 	Profiling                           Profiling                `json:"profiling" yaml:"profiling"`
 	ResourceUsage                       ResourceUsage            `json:"resource_usage" yaml:"resource_usage"`
 	MaxImportGoroutinesFactor           float64                  `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
@@ -97,12 +115,37 @@ type Config struct {
 	TrackVectorDimensions               bool                     `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
 	ReindexVectorDimensionsAtStartup    bool                     `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
 	DisableLazyLoadShards               bool                     `json:"disable_lazy_load_shards" yaml:"disable_lazy_load_shards"`
+	StartupPriorityClasses              []string                 `json:"startup_priority_classes" yaml:"startup_priority_classes"`
 	RecountPropertiesAtStartup          bool                     `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
 	ReindexSetToRoaringsetAtStartup     bool                     `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
 	IndexMissingTextFilterableAtStartup bool                     `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
 	DisableGraphQL                      bool                     `json:"disable_graphql" yaml:"disable_graphql"`
 	AvoidMmap                           bool                     `json:"avoid_mmap" yaml:"avoid_mmap"`
+	LSMReadMode                         diskio.ReadMode          `json:"lsm_read_mode" yaml:"lsm_read_mode"`
 	CORS                                CORS                     `json:"cors" yaml:"cors"`
+	PropertyEncryption                  PropertyEncryption       `json:"property_encryption" yaml:"property_encryption"`
+	AuditLog                            audit.Config             `json:"audit_log" yaml:"audit_log"`
+	ChangeStream                        cdc.Config               `json:"change_stream" yaml:"change_stream"`
+	Quotas                              quota.Config             `json:"quotas" yaml:"quotas"`
+	TenantOffload                       TenantOffload            `json:"tenant_offload" yaml:"tenant_offload"`
+	TieredStorage                       TieredStorage            `json:"tiered_storage" yaml:"tiered_storage"`
+	PartitionRetentionInterval          time.Duration            `json:"partition_retention_interval" yaml:"partition_retention_interval"`
+	WALArchive                          WALArchive               `json:"wal_archive" yaml:"wal_archive"`
+	BackupSchedule                      BackupSchedule           `json:"backup_schedule" yaml:"backup_schedule"`
+	Standby                             Standby                  `json:"standby" yaml:"standby"`
+	AsyncReplication                    AsyncReplication         `json:"async_replication" yaml:"async_replication"`
+	ShardBalancer                       ShardBalancer            `json:"shard_balancer" yaml:"shard_balancer"`
+	Scrub                               Scrub                    `json:"scrub" yaml:"scrub"`
+	BatchBackpressure                   BatchBackpressure        `json:"batch_backpressure" yaml:"batch_backpressure"`
+	IngestQueue                         IngestQueue              `json:"ingest_queue" yaml:"ingest_queue"`
+	PriorityScheduler                   PriorityScheduler        `json:"priority_scheduler" yaml:"priority_scheduler"`
+	QueryAdmission                      QueryAdmission           `json:"query_admission" yaml:"query_admission"`
+	Idempotency                         Idempotency              `json:"idempotency" yaml:"idempotency"`
+	QueryCache                          querycache.Config        `json:"query_cache" yaml:"query_cache"`
+	SlowQueryLog                        slowquery.Config         `json:"slow_query_log" yaml:"slow_query_log"`
+	Tracing                             otlp.Config              `json:"tracing" yaml:"tracing"`
+	MemoryPressure                      memwatch.GovernorConfig  `json:"memory_pressure" yaml:"memory_pressure"`
+	LogLevel                            string                   `json:"log_level" yaml:"log_level"`
 }
 
 type moduleProvider interface {
@@ -178,6 +221,10 @@ type Monitoring struct {
 	Tool    string `json:"tool" yaml:"tool"`
 	Port    int    `json:"port" yaml:"port"`
 	Group   bool   `json:"group_classes" yaml:"group_classes"`
+	// DisableClassLabels and DisableShardLabels aggregate the metrics of
+	// all classes or all shards (including tenants) into a single label
+	DisableClassLabels bool `json:"disable_class_labels" yaml:"disable_class_labels"`
+	DisableShardLabels bool `json:"disable_shard_labels" yaml:"disable_shard_labels"`
 }
 
 // Support independent TLS credentials for gRPC
@@ -185,6 +232,22 @@ type GRPC struct {
 	Port     int    `json:"port" yaml:"port"`
 	CertFile string `json:"certFile" yaml:"certFile"`
 	KeyFile  string `json:"keyFile" yaml:"keyFile"`
+	// Compression are the compressors of replies in the order of preference,
+	// they are used if the client accepts them even if its request was not
+	// compressed. Otherwise replies are compressed like the requests.
+	Compression []string `json:"compression" yaml:"compression"`
+}
+
+// Postgres serves SQL queries with the Postgres wire protocol, it is
+// disabled if the port is 0
+type Postgres struct {
+	Port int `json:"port" yaml:"port"`
+}
+
+// HTTP3 additionally serves the REST API over HTTP/3 (QUIC) on the UDP port
+// of the https listener, it has no effect without TLS
+type HTTP3 struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
 }
 
 type Profiling struct {
@@ -211,6 +274,10 @@ func (p Persistence) Validate() error {
 type DiskUse struct {
 	WarningPercentage  uint64 `json:"warning_percentage" yaml:"warning_percentage"`
 	ReadOnlyPercentage uint64 `json:"readonly_percentage" yaml:"readonly_percentage"`
+	// ReadOnlyMinFreeBytes sets the shards to read-only if less bytes are
+	// available on the disk, 0 disables the check
+	ReadOnlyMinFreeBytes     uint64 `json:"readonly_min_free_bytes" yaml:"readonly_min_free_bytes"`
+	RecoveryMarginPercentage uint64 `json:"recovery_margin_percentage" yaml:"recovery_margin_percentage"`
 }
 
 func (d DiskUse) Validate() error {
@@ -222,6 +289,10 @@ func (d DiskUse) Validate() error {
 		return fmt.Errorf("disk_use.read_only_percentage must be between 0 and 100")
 	}
 
+	if d.RecoveryMarginPercentage > 100 {
+		return fmt.Errorf("disk_use.recovery_margin_percentage must be between 0 and 100")
+	}
+
 	return nil
 }
 
@@ -247,6 +318,444 @@ type ResourceUsage struct {
 	MemUse  MemUse
 }
 
+// PropertyEncryption configures the keys used to encrypt properties which
+// are marked as encrypted in the schema. Keys are never read from a config
+// file, they must be provided through the environment, either directly or as
+// a file, e.g. mounted from a KMS-backed secret store. To rotate keys, the
+// new key is put first and ReencryptAtStartup is set, once the re-encryption
+// has completed the retired keys can be removed.
+type PropertyEncryption struct {
+	Enabled            bool             `json:"enabled" yaml:"enabled"`
+	Keys               []encryption.Key `json:"-" yaml:"-"`
+	ReencryptAtStartup bool             `json:"reencrypt_at_startup" yaml:"reencrypt_at_startup"`
+}
+
+func (p PropertyEncryption) Validate() error {
+	if !p.Enabled {
+		return nil
+	}
+
+	if _, err := encryption.NewKeyring(p.Keys); err != nil {
+		return fmt.Errorf("property_encryption: %w", err)
+	}
+
+	return nil
+}
+
+const (
+	DefaultTenantOffloadInterval          = time.Hour
+	DefaultPartitionRetentionInterval     = time.Hour
+	DefaultTenantOffloadActivationTimeout = 30 * time.Second
+)
+
+// TenantOffload configures moving tenants which have not been accessed for
+// IdleAfter to the Backend, which is the name of a backup module such as
+// backup-s3 or backup-gcs.
+type TenantOffload struct {
+	Enabled   bool          `json:"enabled" yaml:"enabled"`
+	Backend   string        `json:"backend" yaml:"backend"`
+	IdleAfter time.Duration `json:"idle_after" yaml:"idle_after"`
+	// Interval in which idle tenants are looked for
+	Interval time.Duration `json:"interval" yaml:"interval"`
+	// ActivationTimeout is how long a request waits for an offloaded tenant
+	// to be activated. The activation continues in the background if it
+	// takes longer, so the request can be retried.
+	ActivationTimeout time.Duration `json:"activation_timeout" yaml:"activation_timeout"`
+}
+
+func (t TenantOffload) Validate() error {
+	if !t.Enabled {
+		return nil
+	}
+
+	if t.Backend == "" {
+		return fmt.Errorf("tenant_offload: backend is required")
+	}
+	if t.IdleAfter <= 0 {
+		return fmt.Errorf("tenant_offload: idle time must be positive")
+	}
+	if t.Interval <= 0 {
+		return fmt.Errorf("tenant_offload: interval must be positive")
+	}
+	if t.ActivationTimeout <= 0 {
+		return fmt.Errorf("tenant_offload: activation timeout must be positive")
+	}
+	return nil
+}
+
+const DefaultTieredStorageColdAfter = 7 * 24 * time.Hour
+
+// TieredStorage configures moving LSM segments which were neither written
+// nor read for ColdAfter to the Backend, which is the name of a backup
+// module. They are fetched back to local disk on their next read.
+type TieredStorage struct {
+	Enabled   bool          `json:"enabled" yaml:"enabled"`
+	Backend   string        `json:"backend" yaml:"backend"`
+	ColdAfter time.Duration `json:"cold_after" yaml:"cold_after"`
+}
+
+func (t TieredStorage) Validate() error {
+	if !t.Enabled {
+		return nil
+	}
+
+	if t.Backend == "" {
+		return fmt.Errorf("tiered_storage: backend is required")
+	}
+	if t.ColdAfter <= 0 {
+		return fmt.Errorf("tiered_storage: cold after must be positive")
+	}
+	return nil
+}
+
+const DefaultWALArchiveInterval = time.Minute
+
+// WALArchive configures shipping all object changes to the Backend, which is
+// the name of a backup module, so that backups can be restored to a point in
+// time. Changes are shipped every Interval, which is the maximum amount of
+// changes which can be lost.
+type WALArchive struct {
+	Enabled  bool          `json:"enabled" yaml:"enabled"`
+	Backend  string        `json:"backend" yaml:"backend"`
+	Interval time.Duration `json:"interval" yaml:"interval"`
+}
+
+func (w WALArchive) Validate() error {
+	if !w.Enabled {
+		return nil
+	}
+
+	if w.Backend == "" {
+		return fmt.Errorf("wal_archive: backend is required")
+	}
+	if w.Interval <= 0 {
+		return fmt.Errorf("wal_archive: interval must be positive")
+	}
+	return nil
+}
+
+// BackupSchedule configures backups of the Include classes, or all classes
+// except the Exclude classes, to the Backend whenever the Cron expression
+// matches. Backups beyond the KeepLast most recent ones or older than MaxAge
+// are deleted, the most recent successful backup is always kept.
+type BackupSchedule struct {
+	Enabled  bool          `json:"enabled" yaml:"enabled"`
+	Backend  string        `json:"backend" yaml:"backend"`
+	Cron     string        `json:"cron" yaml:"cron"`
+	Include  []string      `json:"include" yaml:"include"`
+	Exclude  []string      `json:"exclude" yaml:"exclude"`
+	KeepLast int           `json:"keep_last" yaml:"keep_last"`
+	MaxAge   time.Duration `json:"max_age" yaml:"max_age"`
+}
+
+func (b BackupSchedule) Validate() error {
+	if !b.Enabled {
+		return nil
+	}
+
+	if b.Backend == "" {
+		return fmt.Errorf("backup_schedule: backend is required")
+	}
+	if _, err := cron.Parse(b.Cron); err != nil {
+		return fmt.Errorf("backup_schedule: %w", err)
+	}
+	if len(b.Include) > 0 && len(b.Exclude) > 0 {
+		return fmt.Errorf("backup_schedule: include and exclude are mutually exclusive")
+	}
+	if b.KeepLast < 0 {
+		return fmt.Errorf("backup_schedule: keep last must not be negative")
+	}
+	if b.MaxAge < 0 {
+		return fmt.Errorf("backup_schedule: max age must not be negative")
+	}
+	return nil
+}
+
+const DefaultStandbyInterval = 10 * time.Second
+
+// Standby configures this cluster as a standby of a primary cluster, which
+// archives its changes to the Backend. The changes archived by the
+// PrimaryNodes are applied every Interval, starting with the changes made
+// after Since. Client writes are rejected until the standby is promoted.
+type Standby struct {
+	Enabled      bool          `json:"enabled" yaml:"enabled"`
+	Backend      string        `json:"backend" yaml:"backend"`
+	PrimaryNodes []string      `json:"primary_nodes" yaml:"primary_nodes"`
+	Interval     time.Duration `json:"interval" yaml:"interval"`
+	// Since is usually the time the backup this cluster was restored from
+	// was taken. It defaults to the first start of the standby.
+	Since time.Time `json:"since" yaml:"since"`
+}
+
+func (s Standby) Validate(archive WALArchive) error {
+	if !s.Enabled {
+		return nil
+	}
+
+	if s.Backend == "" {
+		return fmt.Errorf("standby: backend is required")
+	}
+	if len(s.PrimaryNodes) == 0 {
+		return fmt.Errorf("standby: primary nodes are required")
+	}
+	if s.Interval <= 0 {
+		return fmt.Errorf("standby: interval must be positive")
+	}
+	if archive.Enabled && archive.Backend == s.Backend {
+		return fmt.Errorf("standby: the wal archive must not use the backend of the primary")
+	}
+	return nil
+}
+
+const (
+	DefaultAsyncReplicationInterval      = time.Hour
+	DefaultAsyncReplicationHashTreeDepth = 10
+)
+
+// AsyncReplication configures the background repair of replicated shards
+// (anti-entropy). Every Interval each node compares the shards it leads with
+// their replicas and repairs the objects which differ. BandwidthLimit limits
+// the bytes per second used to transfer objects, zero means unlimited.
+// ConflictResolution is one of none, delete or restore and decides what
+// happens to objects which are deleted on some replicas only.
+type AsyncReplication struct {
+	Enabled            bool          `json:"enabled" yaml:"enabled"`
+	Interval           time.Duration `json:"interval" yaml:"interval"`
+	HashTreeDepth      int           `json:"hashtree_depth" yaml:"hashtree_depth"`
+	BandwidthLimit     int64         `json:"bandwidth_limit" yaml:"bandwidth_limit"`
+	ConflictResolution string        `json:"conflict_resolution" yaml:"conflict_resolution"`
+}
+
+func (a AsyncReplication) Validate() error {
+	if a.Interval < 0 {
+		return fmt.Errorf("async_replication: interval must not be negative")
+	}
+	if a.Enabled && a.Interval == 0 {
+		return fmt.Errorf("async_replication: interval must be positive")
+	}
+	if a.HashTreeDepth < 0 || a.HashTreeDepth > 16 {
+		return fmt.Errorf("async_replication: hash tree depth must be between 0 and 16")
+	}
+	if a.BandwidthLimit < 0 {
+		return fmt.Errorf("async_replication: bandwidth limit must not be negative")
+	}
+	switch a.ConflictResolution {
+	case "", "none", "delete", "restore":
+	default:
+		return fmt.Errorf("async_replication: conflict resolution must be one of none, delete or restore")
+	}
+	return nil
+}
+
+const (
+	DefaultShardBalancerInterval  = 5 * time.Minute
+	DefaultShardBalancerThreshold = 1
+)
+
+// ShardBalancer configures the placement of shard replicas. If enabled, the
+// coordinator of the cluster moves replicas every Interval from the nodes
+// holding the most replicas to the ones holding the fewest, until their
+// counts differ by no more than Threshold, e.g. after new nodes joined.
+// BandwidthLimit limits the bytes per second used by this node to copy
+// shards, zero means unlimited.
+type ShardBalancer struct {
+	Enabled        bool          `json:"enabled" yaml:"enabled"`
+	Interval       time.Duration `json:"interval" yaml:"interval"`
+	Threshold      int           `json:"threshold" yaml:"threshold"`
+	BandwidthLimit int64         `json:"bandwidth_limit" yaml:"bandwidth_limit"`
+}
+
+func (b ShardBalancer) Validate() error {
+	if b.Interval < 0 {
+		return fmt.Errorf("shard_balancer: interval must not be negative")
+	}
+	if b.Enabled && b.Interval == 0 {
+		return fmt.Errorf("shard_balancer: interval must be positive")
+	}
+	if b.Threshold < 1 {
+		return fmt.Errorf("shard_balancer: threshold must be at least 1")
+	}
+	if b.BandwidthLimit < 0 {
+		return fmt.Errorf("shard_balancer: bandwidth limit must not be negative")
+	}
+	return nil
+}
+
+const DefaultScrubInterval = 24 * time.Hour
+
+// Scrub configures the background verification of on-disk segments and
+// commit logs against their block checksums. If enabled, all shards of this
+// node are scrubbed every Interval. If RepairFromReplicas is set as well,
+// corrupt shards of replicated classes are repaired from their replicas.
+type Scrub struct {
+	Enabled            bool          `json:"enabled" yaml:"enabled"`
+	Interval           time.Duration `json:"interval" yaml:"interval"`
+	RepairFromReplicas bool          `json:"repair_from_replicas" yaml:"repair_from_replicas"`
+}
+
+func (s Scrub) Validate() error {
+	if s.Interval < 0 {
+		return fmt.Errorf("scrub: interval must not be negative")
+	}
+	if s.Enabled && s.Interval == 0 {
+		return fmt.Errorf("scrub: interval must be positive")
+	}
+	return nil
+}
+
+const (
+	DefaultBatchBackpressureMaxQueuedObjects = 100_000
+	DefaultBatchBackpressureMaxBatchSize     = 10_000
+	DefaultBatchBackpressureTargetLatency    = 10 * time.Second
+)
+
+// BatchBackpressure bounds the objects which are added in batch at the same
+// time. Batches which would exceed MaxQueuedObjects are rejected with a hint
+// when to retry, batches larger than MaxBatchSize are rejected right away.
+// Responses suggest the batch size which is added within TargetLatency under
+// the current load.
+type BatchBackpressure struct {
+	Enabled          bool          `json:"enabled" yaml:"enabled"`
+	MaxQueuedObjects int           `json:"max_queued_objects" yaml:"max_queued_objects"`
+	MaxBatchSize     int           `json:"max_batch_size" yaml:"max_batch_size"`
+	TargetLatency    time.Duration `json:"target_latency" yaml:"target_latency"`
+}
+
+func (b BatchBackpressure) Validate() error {
+	if !b.Enabled {
+		return nil
+	}
+	if b.MaxQueuedObjects <= 0 || b.MaxBatchSize <= 0 {
+		return fmt.Errorf("batch backpressure: max queued objects and max batch size must be positive")
+	}
+	if b.MaxBatchSize > b.MaxQueuedObjects {
+		return fmt.Errorf("batch backpressure: max batch size must not exceed max queued objects")
+	}
+	if b.TargetLatency <= 0 {
+		return fmt.Errorf("batch backpressure: target latency must be positive")
+	}
+	return nil
+}
+
+const DefaultIngestQueueMaxPendingObjects = 1_000_000
+
+// IngestQueue acknowledges batches of objects once they are appended to a
+// local queue on disk, they are written to the shards and indexed in the
+// background. Batches which would exceed MaxPendingObjects are rejected until
+// the queue caught up.
+type IngestQueue struct {
+	Enabled           bool `json:"enabled" yaml:"enabled"`
+	MaxPendingObjects int  `json:"max_pending_objects" yaml:"max_pending_objects"`
+}
+
+func (q IngestQueue) Validate() error {
+	if q.Enabled && q.MaxPendingObjects <= 0 {
+		return fmt.Errorf("ingest queue: max pending objects must be positive")
+	}
+	return nil
+}
+
+const (
+	DefaultPrioritySchedulerInteractiveShares = 80
+	DefaultPrioritySchedulerBulkShares        = 20
+)
+
+// PriorityScheduler shares the CPU slots of a node between interactive
+// queries and bulk work such as imports and reindexing. Under contention the
+// slots are granted in proportion to the shares of the classes, slots which
+// are not used by one class can be used by the other. Slots defaults to the
+// number of CPUs.
+type PriorityScheduler struct {
+	Enabled           bool `json:"enabled" yaml:"enabled"`
+	Slots             int  `json:"slots" yaml:"slots"`
+	InteractiveShares int  `json:"interactive_shares" yaml:"interactive_shares"`
+	BulkShares        int  `json:"bulk_shares" yaml:"bulk_shares"`
+}
+
+func (p PriorityScheduler) Validate() error {
+	if !p.Enabled {
+		return nil
+	}
+	if p.Slots < 0 {
+		return fmt.Errorf("priority scheduler: slots must not be negative")
+	}
+	if p.InteractiveShares <= 0 || p.BulkShares <= 0 {
+		return fmt.Errorf("priority scheduler: interactive and bulk shares must be positive")
+	}
+	return nil
+}
+
+const (
+	DefaultQueryAdmissionMaxInFlight      = 32
+	DefaultQueryAdmissionMaxQueueWait     = time.Second
+	DefaultQueryAdmissionBreakerThreshold = 10
+	DefaultQueryAdmissionBreakerCooldown  = 10 * time.Second
+)
+
+// QueryAdmission limits the queries of each class which run at the same
+// time, so an expensive class can not starve the others. Queries wait up to
+// MaxQueueWait for one of the MaxInFlight slots of their class, Classes
+// overrides the limit of single classes. After BreakerThreshold consecutive
+// queries of a class were rejected or timed out, the queries of the class
+// fail fast for BreakerCooldown.
+type QueryAdmission struct {
+	Enabled          bool           `json:"enabled" yaml:"enabled"`
+	MaxInFlight      int            `json:"max_in_flight" yaml:"max_in_flight"`
+	MaxQueueWait     time.Duration  `json:"max_queue_wait" yaml:"max_queue_wait"`
+	Classes          map[string]int `json:"classes" yaml:"classes"`
+	BreakerThreshold int            `json:"breaker_threshold" yaml:"breaker_threshold"`
+	BreakerCooldown  time.Duration  `json:"breaker_cooldown" yaml:"breaker_cooldown"`
+}
+
+func (q QueryAdmission) Validate() error {
+	if !q.Enabled {
+		return nil
+	}
+	if q.MaxInFlight <= 0 {
+		return fmt.Errorf("query admission: max in flight must be positive")
+	}
+	for class, limit := range q.Classes {
+		if limit <= 0 {
+			return fmt.Errorf("query admission: max in flight of class %q must be positive", class)
+		}
+	}
+	if q.MaxQueueWait < 0 {
+		return fmt.Errorf("query admission: max queue wait must not be negative")
+	}
+	if q.BreakerThreshold <= 0 || q.BreakerCooldown <= 0 {
+		return fmt.Errorf("query admission: breaker threshold and cooldown must be positive")
+	}
+	return nil
+}
+
+const (
+	DefaultIdempotencyWindow   = time.Hour
+	DefaultIdempotencyMaxBytes = 64 * 1024 * 1024
+)
+
+// Idempotency deduplicates object writes which carry an Idempotency-Key
+// header. The response to a key is kept for Window and returned again to
+// retries with the same key, instead of writing the objects twice. The kept
+// responses take at most MaxBytes of memory, the oldest are dropped first.
+type Idempotency struct {
+	Enabled  bool          `json:"enabled" yaml:"enabled"`
+	Window   time.Duration `json:"window" yaml:"window"`
+	MaxBytes int           `json:"max_bytes" yaml:"max_bytes"`
+}
+
+func (i Idempotency) Validate() error {
+	if !i.Enabled {
+		return nil
+	}
+	if i.Window <= 0 {
+		return fmt.Errorf("idempotency: window must be positive")
+	}
+	if i.MaxBytes <= 0 {
+		return fmt.Errorf("idempotency: max bytes must be positive")
+	}
+	return nil
+}
+
 type CORS struct {
 	AllowOrigin  string `json:"allow_origin" yaml:"allow_origin"`
 	AllowMethods string `json:"allow_methods" yaml:"allow_methods"`
@@ -256,7 +765,7 @@ type CORS struct {
 const (
 	DefaultCORSAllowOrigin  = "*"
 	DefaultCORSAllowMethods = "*"
-	DefaultCORSAllowHeaders = "Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Openai-Organization, X-Openai-Baseurl, X-Anyscale-Baseurl, X-Anyscale-Api-Key, X-Cohere-Api-Key, X-Cohere-Baseurl, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Palm-Api-Key, X-Jinaai-Api-Key, X-Aws-Access-Key, X-Aws-Secret-Key"
+	DefaultCORSAllowHeaders = "Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Openai-Organization, X-Openai-Baseurl, X-Anyscale-Baseurl, X-Anyscale-Api-Key, X-Cohere-Api-Key, X-Cohere-Baseurl, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Palm-Api-Key, X-Jinaai-Api-Key, X-Aws-Access-Key, X-Aws-Secret-Key, X-Request-Id, Traceparent, Tracestate, X-Weaviate-Session-Token, Idempotency-Key"
 )
 
 func (r ResourceUsage) Validate() error {
@@ -324,24 +833,140 @@ func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger
 		return configErr(err)
 	}
 
-	if err := f.Config.Authentication.Validate(); err != nil {
+	if err := f.Config.validateNested(); err != nil {
 		return configErr(err)
 	}
 
-	if err := f.Config.Authorization.Validate(); err != nil {
+	return nil
+}
+
+// LoadEmbeddedConfig reads the config of a node which runs inside another
+// process from the environment, configure overrides the settings before
+// they are validated. Config files are not read.
+func (f *WeaviateConfig) LoadEmbeddedConfig(configure func(*Config)) error {
+	if err := FromEnv(&f.Config); err != nil {
 		return configErr(err)
 	}
+	if configure != nil {
+		configure(&f.Config)
+	}
 
-	if err := f.Config.Persistence.Validate(); err != nil {
+	if err := f.Config.validateNested(); err != nil {
 		return configErr(err)
 	}
 
-	if err := f.Config.AutoSchema.Validate(); err != nil {
-		return configErr(err)
+	return nil
+}
+
+// validateNested validates the nested objects of the config, it is shared by
+// loading the config at startup and reloading it at runtime
+func (c Config) validateNested() error {
+	if _, err := ParseLogLevel(c.LogLevel); err != nil {
+		return err
 	}
 
-	if err := f.Config.ResourceUsage.Validate(); err != nil {
-		return configErr(err)
+	if err := c.Authentication.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Authorization.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Persistence.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.AutoSchema.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.ResourceUsage.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.PropertyEncryption.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.AuditLog.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.ChangeStream.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Quotas.Validate(c.TrackVectorDimensions); err != nil {
+		return err
+	}
+
+	if err := c.TenantOffload.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.TieredStorage.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.WALArchive.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.BackupSchedule.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Standby.Validate(c.WALArchive); err != nil {
+		return err
+	}
+
+	if err := c.AsyncReplication.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.ShardBalancer.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Scrub.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.BatchBackpressure.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.IngestQueue.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.PriorityScheduler.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.QueryAdmission.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Idempotency.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.QueryCache.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.SlowQueryLog.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Tracing.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.MemoryPressure.Validate(); err != nil {
+		return err
 	}
 
 	return nil
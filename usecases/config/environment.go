@@ -19,8 +19,17 @@ import (
 	"strings"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/cdc"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/otlp"
+	"github.com/weaviate/weaviate/usecases/querycache"
+	"github.com/weaviate/weaviate/usecases/quota"
+	"github.com/weaviate/weaviate/usecases/slowquery"
+	"gopkg.in/yaml.v2"
 )
 
 // FromEnv takes a *Config as it will respect initial config that has been
@@ -43,6 +52,13 @@ func FromEnv(config *Config) error {
 			// not about classes or shards.
 			config.Monitoring.Group = true
 		}
+
+		if Enabled(os.Getenv("PROMETHEUS_MONITORING_DISABLE_CLASS_LABELS")) {
+			config.Monitoring.DisableClassLabels = true
+		}
+		if Enabled(os.Getenv("PROMETHEUS_MONITORING_DISABLE_SHARD_LABELS")) {
+			config.Monitoring.DisableShardLabels = true
+		}
 	}
 
 	if Enabled(os.Getenv("TRACK_VECTOR_DIMENSIONS")) {
@@ -59,6 +75,18 @@ func FromEnv(config *Config) error {
 		config.DisableLazyLoadShards = true
 	}
 
+	// the shards of these classes are loaded before the shards of other
+	// classes at startup
+	if v := os.Getenv("STARTUP_PRIORITY_CLASSES"); v != "" {
+		config.StartupPriorityClasses = strings.Split(v, ",")
+	}
+
+	// the logger is created from LOG_LEVEL before the config is loaded, it
+	// is part of the config so that it can be changed by a reload
+	if v := os.Getenv("LOG_LEVEL"); v != "" {
+		config.LogLevel = v
+	}
+
 	// Recount all property lengths at startup to support accurate BM25 scoring
 	if Enabled(os.Getenv("RECOUNT_PROPERTIES_AT_STARTUP")) {
 		config.RecountPropertiesAtStartup = true
@@ -111,6 +139,18 @@ func FromEnv(config *Config) error {
 		if v := os.Getenv("AUTHENTICATION_OIDC_GROUPS_CLAIM"); v != "" {
 			config.Authentication.OIDC.GroupsClaim = v
 		}
+
+		if v := os.Getenv("AUTHENTICATION_OIDC_ROLE_MAPPINGS"); v != "" {
+			mappings, err := ParseOIDCRoleMappings(v)
+			if err != nil {
+				return fmt.Errorf("parse AUTHENTICATION_OIDC_ROLE_MAPPINGS: %w", err)
+			}
+			config.Authentication.OIDC.RoleMappings = mappings
+		}
+
+		if v := os.Getenv("AUTHENTICATION_OIDC_TENANT_CLAIM"); v != "" {
+			config.Authentication.OIDC.TenantClaim = v
+		}
 	}
 
 	if Enabled(os.Getenv("AUTHENTICATION_APIKEY_ENABLED")) {
@@ -125,6 +165,10 @@ func FromEnv(config *Config) error {
 			keys := strings.Split(keysString, ",")
 			config.Authentication.APIKey.Users = keys
 		}
+
+		if Enabled(os.Getenv("AUTHENTICATION_APIKEY_MANAGEMENT_ENABLED")) {
+			config.Authentication.APIKey.ManagementEnabled = true
+		}
 	}
 
 	if Enabled(os.Getenv("AUTHORIZATION_ADMINLIST_ENABLED")) {
@@ -151,8 +195,34 @@ func FromEnv(config *Config) error {
 		}
 	}
 
-	if os.Getenv("PERSISTENCE_LSM_ACCESS_STRATEGY") == "pread" {
+	if Enabled(os.Getenv("AUTHORIZATION_RBAC_ENABLED")) {
+		config.Authorization.RBAC.Enabled = true
+
+		if adminUsersString, ok := os.LookupEnv("AUTHORIZATION_RBAC_ADMIN_USERS"); ok {
+			config.Authorization.RBAC.AdminUsers = strings.Split(adminUsersString, ",")
+		}
+
+		if adminGroupsString, ok := os.LookupEnv("AUTHORIZATION_RBAC_ADMIN_GROUPS"); ok {
+			config.Authorization.RBAC.AdminGroups = strings.Split(adminGroupsString, ",")
+		}
+	}
+
+	if sensitiveUsersString, ok := os.LookupEnv("AUTHORIZATION_SENSITIVE_DATA_USERS"); ok {
+		config.Authorization.SensitiveData.Users = strings.Split(sensitiveUsersString, ",")
+	}
+
+	if sensitiveGroupsString, ok := os.LookupEnv("AUTHORIZATION_SENSITIVE_DATA_GROUPS"); ok {
+		config.Authorization.SensitiveData.Groups = strings.Split(sensitiveGroupsString, ",")
+	}
+
+	switch strategy := os.Getenv("PERSISTENCE_LSM_ACCESS_STRATEGY"); strategy {
+	case "pread":
+		config.AvoidMmap = true
+	case string(diskio.ReadModeIOUring), string(diskio.ReadModeDirect):
+		// segments are read with io_uring or O_DIRECT instead of mmapping them,
+		// both fall back to pread where they are not supported
 		config.AvoidMmap = true
+		config.LSMReadMode = diskio.ReadMode(strategy)
 	}
 
 	clusterCfg, err := parseClusterConfig()
@@ -213,6 +283,28 @@ func FromEnv(config *Config) error {
 		config.QueryNestedCrossReferenceLimit = DefaultQueryNestedCrossReferenceLimit
 	}
 
+	// the number of shards a query searches in parallel and the number of
+	// searches a shard runs at the same time, unset means the default of twice
+	// the number of CPUs, respectively unbounded
+	if err := parsePositiveInt(
+		"QUERY_SHARD_CONCURRENCY",
+		func(val int) { config.QueryShardConcurrency = val },
+		0,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"QUERY_MAX_SHARD_SEARCHES",
+		func(val int) { config.QueryMaxShardSearches = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if Enabled(os.Getenv("QUERY_CROSS_TENANT_ENABLED")) {
+		config.QueryCrossTenantEnabled = true
+	}
+
 	if v := os.Getenv("MAX_IMPORT_GOROUTINES_FACTOR"); v != "" {
 		asFloat, err := strconv.ParseFloat(v, 64)
 		if err != nil {
@@ -320,9 +412,126 @@ func FromEnv(config *Config) error {
 	if v := os.Getenv("GRPC_KEY_FILE"); v != "" {
 		config.GRPC.KeyFile = v
 	}
+	if v := os.Getenv("GRPC_COMPRESSION"); v != "" {
+		for _, name := range strings.Split(v, ",") {
+			switch name = strings.TrimSpace(name); name {
+			case "gzip", "zstd":
+				config.GRPC.Compression = append(config.GRPC.Compression, name)
+			default:
+				return fmt.Errorf("GRPC_COMPRESSION: unsupported compressor %q, "+
+					"must be one of [\"gzip\", \"zstd\"]", name)
+			}
+		}
+	}
+
+	if err := parsePositiveInt(
+		"POSTGRES_PORT",
+		func(val int) { config.Postgres.Port = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	config.HTTP3.Enabled = Enabled(os.Getenv("HTTP3_ENABLED"))
 
 	config.DisableGraphQL = Enabled(os.Getenv("DISABLE_GRAPHQL"))
 
+	if err := config.parsePropertyEncryptionConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseAuditLogConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseChangeStreamConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseQuotasConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseTenantOffloadConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseTieredStorageConfig(); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("PARTITION_RETENTION_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse PARTITION_RETENTION_INTERVAL as time.Duration: %w", err)
+		} else if interval <= 0 {
+			return fmt.Errorf("PARTITION_RETENTION_INTERVAL must be positive")
+		}
+		config.PartitionRetentionInterval = interval
+	} else if config.PartitionRetentionInterval == 0 {
+		config.PartitionRetentionInterval = DefaultPartitionRetentionInterval
+	}
+
+	if err := config.parseWALArchiveConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseBackupScheduleConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseStandbyConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseAsyncReplicationConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseShardBalancerConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseScrubConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseBatchBackpressureConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseIngestQueueConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parsePrioritySchedulerConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseQueryAdmissionConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseIdempotencyConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseQueryCacheConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseSlowQueryLogConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseTracingConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseMemoryPressureConfig(); err != nil {
+		return err
+	}
+
 	if err := parsePositiveInt(
 		"REPLICATION_MINIMUM_FACTOR",
 		func(val int) { config.Replication.MinimumFactor = val },
@@ -355,6 +564,755 @@ func (c *Config) parseCORSConfig() error {
 	return nil
 }
 
+func (c *Config) parsePropertyEncryptionConfig() error {
+	keys := os.Getenv("PROPERTY_ENCRYPTION_KEYS")
+	if path := os.Getenv("PROPERTY_ENCRYPTION_KEYS_FILE"); path != "" {
+		if keys != "" {
+			return fmt.Errorf("PROPERTY_ENCRYPTION_KEYS and PROPERTY_ENCRYPTION_KEYS_FILE " +
+				"can not be set at the same time")
+		}
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read PROPERTY_ENCRYPTION_KEYS_FILE: %w", err)
+		}
+		keys = strings.ReplaceAll(strings.TrimSpace(string(contents)), "\n", ",")
+	}
+
+	if keys == "" {
+		return nil
+	}
+
+	parsed, err := encryption.ParseKeys(keys)
+	if err != nil {
+		return fmt.Errorf("parse PROPERTY_ENCRYPTION_KEYS: %w", err)
+	}
+
+	c.PropertyEncryption.Enabled = true
+	c.PropertyEncryption.Keys = parsed
+	c.PropertyEncryption.ReencryptAtStartup = Enabled(os.Getenv("PROPERTY_ENCRYPTION_REENCRYPT_AT_STARTUP"))
+	return nil
+}
+
+func (c *Config) parseAuditLogConfig() error {
+	if Enabled(os.Getenv("AUDIT_LOG_ENABLED")) {
+		c.AuditLog.Enabled = true
+	}
+
+	if v := os.Getenv("AUDIT_LOG_SINKS"); v != "" {
+		c.AuditLog.Sinks = nil
+		for _, sink := range strings.Split(v, ",") {
+			if sink = strings.TrimSpace(sink); sink != "" {
+				c.AuditLog.Sinks = append(c.AuditLog.Sinks, sink)
+			}
+		}
+	}
+
+	if v := os.Getenv("AUDIT_LOG_SAMPLE_RATE"); v != "" {
+		rate, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("parse AUDIT_LOG_SAMPLE_RATE as float: %w", err)
+		}
+		c.AuditLog.SampleRate = rate
+	} else if c.AuditLog.SampleRate == 0 {
+		c.AuditLog.SampleRate = 1
+	}
+
+	if v := os.Getenv("AUDIT_LOG_FILE_PATH"); v != "" {
+		c.AuditLog.File.Path = v
+	}
+	if v := os.Getenv("AUDIT_LOG_SYSLOG_NETWORK"); v != "" {
+		c.AuditLog.Syslog.Network = v
+	}
+	if v := os.Getenv("AUDIT_LOG_SYSLOG_ADDRESS"); v != "" {
+		c.AuditLog.Syslog.Address = v
+	}
+	if v := os.Getenv("AUDIT_LOG_SYSLOG_TAG"); v != "" {
+		c.AuditLog.Syslog.Tag = v
+	}
+	if v := os.Getenv("AUDIT_LOG_WEBHOOK_URL"); v != "" {
+		c.AuditLog.Webhook.URL = v
+	}
+	if v := os.Getenv("AUDIT_LOG_WEBHOOK_TOKEN"); v != "" {
+		c.AuditLog.Webhook.Token = v
+	}
+	if v := os.Getenv("AUDIT_LOG_KAFKA_REST_PROXY_URL"); v != "" {
+		c.AuditLog.Kafka.RESTProxyURL = v
+	}
+	if v := os.Getenv("AUDIT_LOG_KAFKA_TOPIC"); v != "" {
+		c.AuditLog.Kafka.Topic = v
+	}
+
+	return nil
+}
+
+func (c *Config) parseChangeStreamConfig() error {
+	if Enabled(os.Getenv("CHANGE_STREAM_ENABLED")) {
+		c.ChangeStream.Enabled = true
+	}
+
+	if v := os.Getenv("CHANGE_STREAM_SINK"); v != "" {
+		c.ChangeStream.Sink = v
+	}
+
+	if v := os.Getenv("CHANGE_STREAM_TOPIC"); v != "" {
+		c.ChangeStream.Topic = v
+	} else if c.ChangeStream.Topic == "" {
+		c.ChangeStream.Topic = cdc.DefaultTopic
+	}
+
+	// CHANGE_STREAM_CLASS_TOPICS=Article:articles,Author:authors
+	if v := os.Getenv("CHANGE_STREAM_CLASS_TOPICS"); v != "" {
+		c.ChangeStream.ClassTopics = map[string]string{}
+		for _, pair := range strings.Split(v, ",") {
+			class, topic, ok := strings.Cut(strings.TrimSpace(pair), ":")
+			if !ok || class == "" || topic == "" {
+				return fmt.Errorf("parse CHANGE_STREAM_CLASS_TOPICS: expected class:topic, got %q", pair)
+			}
+			c.ChangeStream.ClassTopics[class] = topic
+		}
+	}
+
+	if v := os.Getenv("CHANGE_STREAM_CLASSES"); v != "" {
+		c.ChangeStream.Classes = nil
+		for _, class := range strings.Split(v, ",") {
+			if class = strings.TrimSpace(class); class != "" {
+				c.ChangeStream.Classes = append(c.ChangeStream.Classes, class)
+			}
+		}
+	}
+
+	if Enabled(os.Getenv("CHANGE_STREAM_INCLUDE_VECTORS")) {
+		c.ChangeStream.IncludeVectors = true
+	}
+
+	if v := os.Getenv("CHANGE_STREAM_WEBHOOK_URL"); v != "" {
+		c.ChangeStream.Webhook.URL = v
+	}
+	if v := os.Getenv("CHANGE_STREAM_WEBHOOK_TOKEN"); v != "" {
+		c.ChangeStream.Webhook.Token = v
+	}
+	if v := os.Getenv("CHANGE_STREAM_KAFKA_REST_PROXY_URL"); v != "" {
+		c.ChangeStream.Kafka.RESTProxyURL = v
+	}
+	if v := os.Getenv("CHANGE_STREAM_NATS_URL"); v != "" {
+		c.ChangeStream.NATS.URL = v
+	}
+	if v := os.Getenv("CHANGE_STREAM_NATS_TOKEN"); v != "" {
+		c.ChangeStream.NATS.Token = v
+	}
+	if Enabled(os.Getenv("CHANGE_STREAM_NATS_JETSTREAM")) {
+		c.ChangeStream.NATS.JetStream = true
+	}
+
+	return nil
+}
+
+// parseQuotasConfig reads the limits from the file set in QUOTAS_FILE, they
+// are nested per class and tenant, which is not practical to express in
+// environment variables
+func (c *Config) parseQuotasConfig() error {
+	path := os.Getenv("QUOTAS_FILE")
+	if path == "" {
+		return nil
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read QUOTAS_FILE: %w", err)
+	}
+
+	var quotas quota.Config
+	if err := yaml.Unmarshal(contents, &quotas); err != nil {
+		return fmt.Errorf("parse QUOTAS_FILE %s: %w", path, err)
+	}
+	quotas.Enabled = true
+	c.Quotas = quotas
+
+	return nil
+}
+
+func (c *Config) parseTenantOffloadConfig() error {
+	if Enabled(os.Getenv("TENANT_OFFLOAD_ENABLED")) {
+		c.TenantOffload.Enabled = true
+	}
+
+	if v := os.Getenv("TENANT_OFFLOAD_BACKEND"); v != "" {
+		c.TenantOffload.Backend = v
+	}
+
+	if v := os.Getenv("TENANT_OFFLOAD_IDLE_DAYS"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse TENANT_OFFLOAD_IDLE_DAYS as int: %w", err)
+		} else if days <= 0 {
+			return fmt.Errorf("TENANT_OFFLOAD_IDLE_DAYS must be a positive value larger 0")
+		}
+		c.TenantOffload.IdleAfter = time.Duration(days) * 24 * time.Hour
+	}
+
+	if v := os.Getenv("TENANT_OFFLOAD_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse TENANT_OFFLOAD_INTERVAL as time.Duration: %w", err)
+		}
+		c.TenantOffload.Interval = interval
+	} else if c.TenantOffload.Interval == 0 {
+		c.TenantOffload.Interval = DefaultTenantOffloadInterval
+	}
+
+	if v := os.Getenv("TENANT_OFFLOAD_ACTIVATION_TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse TENANT_OFFLOAD_ACTIVATION_TIMEOUT as time.Duration: %w", err)
+		}
+		c.TenantOffload.ActivationTimeout = timeout
+	} else if c.TenantOffload.ActivationTimeout == 0 {
+		c.TenantOffload.ActivationTimeout = DefaultTenantOffloadActivationTimeout
+	}
+
+	return nil
+}
+
+func (c *Config) parseTieredStorageConfig() error {
+	if Enabled(os.Getenv("TIERED_STORAGE_ENABLED")) {
+		c.TieredStorage.Enabled = true
+	}
+
+	if v := os.Getenv("TIERED_STORAGE_BACKEND"); v != "" {
+		c.TieredStorage.Backend = v
+	}
+
+	if v := os.Getenv("TIERED_STORAGE_COLD_AFTER"); v != "" {
+		coldAfter, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse TIERED_STORAGE_COLD_AFTER as time.Duration: %w", err)
+		}
+		c.TieredStorage.ColdAfter = coldAfter
+	} else if c.TieredStorage.ColdAfter == 0 {
+		c.TieredStorage.ColdAfter = DefaultTieredStorageColdAfter
+	}
+
+	return nil
+}
+
+func (c *Config) parseWALArchiveConfig() error {
+	if Enabled(os.Getenv("BACKUP_WAL_ARCHIVE_ENABLED")) {
+		c.WALArchive.Enabled = true
+	}
+
+	if v := os.Getenv("BACKUP_WAL_ARCHIVE_BACKEND"); v != "" {
+		c.WALArchive.Backend = v
+	}
+
+	if v := os.Getenv("BACKUP_WAL_ARCHIVE_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse BACKUP_WAL_ARCHIVE_INTERVAL as time.Duration: %w", err)
+		}
+		c.WALArchive.Interval = interval
+	} else if c.WALArchive.Interval == 0 {
+		c.WALArchive.Interval = DefaultWALArchiveInterval
+	}
+
+	return nil
+}
+
+func (c *Config) parseBackupScheduleConfig() error {
+	if Enabled(os.Getenv("BACKUP_SCHEDULE_ENABLED")) {
+		c.BackupSchedule.Enabled = true
+	}
+
+	if v := os.Getenv("BACKUP_SCHEDULE_BACKEND"); v != "" {
+		c.BackupSchedule.Backend = v
+	}
+
+	if v := os.Getenv("BACKUP_SCHEDULE_CRON"); v != "" {
+		c.BackupSchedule.Cron = v
+	}
+
+	if v := os.Getenv("BACKUP_SCHEDULE_INCLUDE"); v != "" {
+		c.BackupSchedule.Include = strings.Split(v, ",")
+	}
+
+	if v := os.Getenv("BACKUP_SCHEDULE_EXCLUDE"); v != "" {
+		c.BackupSchedule.Exclude = strings.Split(v, ",")
+	}
+
+	if v := os.Getenv("BACKUP_SCHEDULE_KEEP_LAST"); v != "" {
+		keep, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse BACKUP_SCHEDULE_KEEP_LAST as int: %w", err)
+		}
+		c.BackupSchedule.KeepLast = keep
+	}
+
+	if v := os.Getenv("BACKUP_SCHEDULE_MAX_AGE"); v != "" {
+		maxAge, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse BACKUP_SCHEDULE_MAX_AGE as time.Duration: %w", err)
+		}
+		c.BackupSchedule.MaxAge = maxAge
+	}
+
+	return nil
+}
+
+func (c *Config) parseStandbyConfig() error {
+	if Enabled(os.Getenv("STANDBY_ENABLED")) {
+		c.Standby.Enabled = true
+	}
+
+	if v := os.Getenv("STANDBY_BACKEND"); v != "" {
+		c.Standby.Backend = v
+	}
+
+	if v := os.Getenv("STANDBY_PRIMARY_NODES"); v != "" {
+		c.Standby.PrimaryNodes = strings.Split(v, ",")
+	}
+
+	if v := os.Getenv("STANDBY_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse STANDBY_INTERVAL as time.Duration: %w", err)
+		}
+		c.Standby.Interval = interval
+	} else if c.Standby.Interval == 0 {
+		c.Standby.Interval = DefaultStandbyInterval
+	}
+
+	if v := os.Getenv("STANDBY_SINCE"); v != "" {
+		since, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("parse STANDBY_SINCE as RFC3339 time: %w", err)
+		}
+		c.Standby.Since = since
+	}
+
+	return nil
+}
+
+func (c *Config) parseAsyncReplicationConfig() error {
+	if Enabled(os.Getenv("ASYNC_REPLICATION_ENABLED")) {
+		c.AsyncReplication.Enabled = true
+	}
+
+	if v := os.Getenv("ASYNC_REPLICATION_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse ASYNC_REPLICATION_INTERVAL as time.Duration: %w", err)
+		}
+		c.AsyncReplication.Interval = interval
+	} else if c.AsyncReplication.Interval == 0 {
+		c.AsyncReplication.Interval = DefaultAsyncReplicationInterval
+	}
+
+	if v := os.Getenv("ASYNC_REPLICATION_HASHTREE_DEPTH"); v != "" {
+		depth, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse ASYNC_REPLICATION_HASHTREE_DEPTH as int: %w", err)
+		}
+		c.AsyncReplication.HashTreeDepth = depth
+	} else if c.AsyncReplication.HashTreeDepth == 0 {
+		c.AsyncReplication.HashTreeDepth = DefaultAsyncReplicationHashTreeDepth
+	}
+
+	if v := os.Getenv("ASYNC_REPLICATION_BANDWIDTH_LIMIT"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse ASYNC_REPLICATION_BANDWIDTH_LIMIT as int: %w", err)
+		}
+		c.AsyncReplication.BandwidthLimit = limit
+	}
+
+	if v := os.Getenv("ASYNC_REPLICATION_CONFLICT_RESOLUTION"); v != "" {
+		c.AsyncReplication.ConflictResolution = v
+	} else if c.AsyncReplication.ConflictResolution == "" {
+		c.AsyncReplication.ConflictResolution = "none"
+	}
+
+	return nil
+}
+
+func (c *Config) parseShardBalancerConfig() error {
+	if Enabled(os.Getenv("SHARD_BALANCER_ENABLED")) {
+		c.ShardBalancer.Enabled = true
+	}
+
+	if v := os.Getenv("SHARD_BALANCER_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse SHARD_BALANCER_INTERVAL as time.Duration: %w", err)
+		}
+		c.ShardBalancer.Interval = interval
+	} else if c.ShardBalancer.Interval == 0 {
+		c.ShardBalancer.Interval = DefaultShardBalancerInterval
+	}
+
+	if v := os.Getenv("SHARD_BALANCER_THRESHOLD"); v != "" {
+		threshold, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse SHARD_BALANCER_THRESHOLD as int: %w", err)
+		}
+		c.ShardBalancer.Threshold = threshold
+	} else if c.ShardBalancer.Threshold == 0 {
+		c.ShardBalancer.Threshold = DefaultShardBalancerThreshold
+	}
+
+	if v := os.Getenv("SHARD_BALANCER_BANDWIDTH_LIMIT"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse SHARD_BALANCER_BANDWIDTH_LIMIT as int: %w", err)
+		}
+		c.ShardBalancer.BandwidthLimit = limit
+	}
+
+	return nil
+}
+
+func (c *Config) parseScrubConfig() error {
+	if Enabled(os.Getenv("SCRUB_ENABLED")) {
+		c.Scrub.Enabled = true
+	}
+
+	if v := os.Getenv("SCRUB_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse SCRUB_INTERVAL as time.Duration: %w", err)
+		}
+		c.Scrub.Interval = interval
+	} else if c.Scrub.Interval == 0 {
+		c.Scrub.Interval = DefaultScrubInterval
+	}
+
+	if Enabled(os.Getenv("SCRUB_REPAIR_FROM_REPLICAS")) {
+		c.Scrub.RepairFromReplicas = true
+	}
+
+	return nil
+}
+
+func (c *Config) parseBatchBackpressureConfig() error {
+	if Enabled(os.Getenv("BATCH_BACKPRESSURE_ENABLED")) {
+		c.BatchBackpressure.Enabled = true
+	}
+
+	if err := parsePositiveInt(
+		"BATCH_BACKPRESSURE_MAX_QUEUED_OBJECTS",
+		func(val int) { c.BatchBackpressure.MaxQueuedObjects = val },
+		DefaultBatchBackpressureMaxQueuedObjects,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"BATCH_BACKPRESSURE_MAX_BATCH_SIZE",
+		func(val int) { c.BatchBackpressure.MaxBatchSize = val },
+		DefaultBatchBackpressureMaxBatchSize,
+	); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("BATCH_BACKPRESSURE_TARGET_LATENCY"); v != "" {
+		latency, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse BATCH_BACKPRESSURE_TARGET_LATENCY as time.Duration: %w", err)
+		}
+		c.BatchBackpressure.TargetLatency = latency
+	} else if c.BatchBackpressure.TargetLatency == 0 {
+		c.BatchBackpressure.TargetLatency = DefaultBatchBackpressureTargetLatency
+	}
+
+	return nil
+}
+
+func (c *Config) parseIngestQueueConfig() error {
+	if Enabled(os.Getenv("INGEST_QUEUE_ENABLED")) {
+		c.IngestQueue.Enabled = true
+	}
+
+	return parsePositiveInt(
+		"INGEST_QUEUE_MAX_PENDING_OBJECTS",
+		func(val int) { c.IngestQueue.MaxPendingObjects = val },
+		DefaultIngestQueueMaxPendingObjects,
+	)
+}
+
+func (c *Config) parsePrioritySchedulerConfig() error {
+	if Enabled(os.Getenv("PRIORITY_SCHEDULER_ENABLED")) {
+		c.PriorityScheduler.Enabled = true
+	}
+
+	// without slots there is one per CPU
+	if err := parsePositiveInt(
+		"PRIORITY_SCHEDULER_SLOTS",
+		func(val int) { c.PriorityScheduler.Slots = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"PRIORITY_SCHEDULER_INTERACTIVE_SHARES",
+		func(val int) { c.PriorityScheduler.InteractiveShares = val },
+		DefaultPrioritySchedulerInteractiveShares,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt(
+		"PRIORITY_SCHEDULER_BULK_SHARES",
+		func(val int) { c.PriorityScheduler.BulkShares = val },
+		DefaultPrioritySchedulerBulkShares,
+	)
+}
+
+func (c *Config) parseQueryAdmissionConfig() error {
+	if Enabled(os.Getenv("QUERY_ADMISSION_ENABLED")) {
+		c.QueryAdmission.Enabled = true
+	}
+
+	if err := parsePositiveInt(
+		"QUERY_ADMISSION_MAX_IN_FLIGHT",
+		func(val int) { c.QueryAdmission.MaxInFlight = val },
+		DefaultQueryAdmissionMaxInFlight,
+	); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("QUERY_ADMISSION_MAX_QUEUE_WAIT"); v != "" {
+		wait, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse QUERY_ADMISSION_MAX_QUEUE_WAIT as time.Duration: %w", err)
+		}
+		c.QueryAdmission.MaxQueueWait = wait
+	} else if c.QueryAdmission.MaxQueueWait == 0 {
+		c.QueryAdmission.MaxQueueWait = DefaultQueryAdmissionMaxQueueWait
+	}
+
+	if err := parsePositiveInt(
+		"QUERY_ADMISSION_BREAKER_THRESHOLD",
+		func(val int) { c.QueryAdmission.BreakerThreshold = val },
+		DefaultQueryAdmissionBreakerThreshold,
+	); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("QUERY_ADMISSION_BREAKER_COOLDOWN"); v != "" {
+		cooldown, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse QUERY_ADMISSION_BREAKER_COOLDOWN as time.Duration: %w", err)
+		}
+		c.QueryAdmission.BreakerCooldown = cooldown
+	} else if c.QueryAdmission.BreakerCooldown == 0 {
+		c.QueryAdmission.BreakerCooldown = DefaultQueryAdmissionBreakerCooldown
+	}
+
+	return nil
+}
+
+func (c *Config) parseIdempotencyConfig() error {
+	if Enabled(os.Getenv("IDEMPOTENCY_ENABLED")) {
+		c.Idempotency.Enabled = true
+	}
+
+	if v := os.Getenv("IDEMPOTENCY_WINDOW"); v != "" {
+		window, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse IDEMPOTENCY_WINDOW as time.Duration: %w", err)
+		}
+		c.Idempotency.Window = window
+	} else if c.Idempotency.Window == 0 {
+		c.Idempotency.Window = DefaultIdempotencyWindow
+	}
+
+	return parsePositiveInt(
+		"IDEMPOTENCY_MAX_BYTES",
+		func(val int) { c.Idempotency.MaxBytes = val },
+		DefaultIdempotencyMaxBytes,
+	)
+}
+
+func (c *Config) parseQueryCacheConfig() error {
+	if Enabled(os.Getenv("QUERY_CACHE_ENABLED")) {
+		c.QueryCache.Enabled = true
+	}
+
+	if v := os.Getenv("QUERY_CACHE_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse QUERY_CACHE_TTL as time.Duration: %w", err)
+		}
+		c.QueryCache.TTL = ttl
+	} else if c.QueryCache.TTL == 0 {
+		c.QueryCache.TTL = querycache.DefaultTTL
+	}
+
+	if v := os.Getenv("QUERY_CACHE_MAX_ENTRIES"); v != "" {
+		entries, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse QUERY_CACHE_MAX_ENTRIES as int: %w", err)
+		}
+		c.QueryCache.MaxEntries = entries
+	} else if c.QueryCache.MaxEntries == 0 {
+		c.QueryCache.MaxEntries = querycache.DefaultMaxEntries
+	}
+
+	if v := os.Getenv("QUERY_CACHE_REDIS_URL"); v != "" {
+		c.QueryCache.Redis.URL = v
+	}
+	if v := os.Getenv("QUERY_CACHE_REDIS_PASSWORD"); v != "" {
+		c.QueryCache.Redis.Password = v
+	}
+
+	return nil
+}
+
+func (c *Config) parseSlowQueryLogConfig() error {
+	if Enabled(os.Getenv("SLOW_QUERY_LOG_ENABLED")) {
+		c.SlowQueryLog.Enabled = true
+	}
+
+	if v := os.Getenv("SLOW_QUERY_LOG_THRESHOLD"); v != "" {
+		threshold, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse SLOW_QUERY_LOG_THRESHOLD as time.Duration: %w", err)
+		}
+		c.SlowQueryLog.Threshold = threshold
+	} else if c.SlowQueryLog.Threshold == 0 {
+		c.SlowQueryLog.Threshold = slowquery.DefaultThreshold
+	}
+
+	if v := os.Getenv("SLOW_QUERY_LOG_SAMPLE_RATE"); v != "" {
+		rate, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("parse SLOW_QUERY_LOG_SAMPLE_RATE as float: %w", err)
+		}
+		c.SlowQueryLog.SampleRate = rate
+	} else if c.SlowQueryLog.SampleRate == 0 {
+		c.SlowQueryLog.SampleRate = slowquery.DefaultSampleRate
+	}
+
+	if v := os.Getenv("SLOW_QUERY_LOG_MAX_ENTRIES"); v != "" {
+		entries, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse SLOW_QUERY_LOG_MAX_ENTRIES as int: %w", err)
+		}
+		c.SlowQueryLog.MaxEntries = entries
+	} else if c.SlowQueryLog.MaxEntries == 0 {
+		c.SlowQueryLog.MaxEntries = slowquery.DefaultMaxEntries
+	}
+
+	return nil
+}
+
+func (c *Config) parseTracingConfig() error {
+	if Enabled(os.Getenv("TRACING_ENABLED")) {
+		c.Tracing.Enabled = true
+	}
+
+	if v := os.Getenv("TRACING_OTLP_ENDPOINT"); v != "" {
+		c.Tracing.Endpoint = v
+	}
+
+	if v := os.Getenv("TRACING_SERVICE_NAME"); v != "" {
+		c.Tracing.ServiceName = v
+	} else if c.Tracing.ServiceName == "" {
+		c.Tracing.ServiceName = otlp.DefaultServiceName
+	}
+
+	if v := os.Getenv("TRACING_SAMPLE_RATIO"); v != "" {
+		ratio, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("parse TRACING_SAMPLE_RATIO as float: %w", err)
+		}
+		c.Tracing.SampleRatio = ratio
+	} else if c.Tracing.SampleRatio == 0 {
+		c.Tracing.SampleRatio = otlp.DefaultSampleRatio
+	}
+
+	// headers sent to the collector, e.g. for authentication, in the form
+	// key1=value1,key2=value2
+	if v := os.Getenv("TRACING_OTLP_HEADERS"); v != "" {
+		c.Tracing.Headers = map[string]string{}
+		for _, header := range strings.Split(v, ",") {
+			key, value, ok := strings.Cut(header, "=")
+			if !ok || strings.TrimSpace(key) == "" {
+				return fmt.Errorf("parse TRACING_OTLP_HEADERS: expected key=value, got %q", header)
+			}
+			c.Tracing.Headers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	return nil
+}
+
+func (c *Config) parseMemoryPressureConfig() error {
+	if Enabled(os.Getenv("MEMORY_PRESSURE_ENABLED")) {
+		c.MemoryPressure.Enabled = true
+	}
+
+	if v := os.Getenv("MEMORY_PRESSURE_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse MEMORY_PRESSURE_INTERVAL as time.Duration: %w", err)
+		}
+		c.MemoryPressure.Interval = interval
+	} else if c.MemoryPressure.Interval == 0 {
+		c.MemoryPressure.Interval = memwatch.DefaultGovernorInterval
+	}
+
+	for _, limit := range []struct {
+		name  string
+		value *int64
+	}{
+		{"MEMORY_PRESSURE_MAX_HEAP_BYTES", &c.MemoryPressure.MaxHeapBytes},
+		{"MEMORY_PRESSURE_MAX_RSS_BYTES", &c.MemoryPressure.MaxRSSBytes},
+	} {
+		if v := os.Getenv(limit.name); v != "" {
+			bytes, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse %s as int: %w", limit.name, err)
+			}
+			*limit.value = bytes
+		}
+	}
+
+	for _, percentage := range []struct {
+		name  string
+		value *int
+		def   int
+	}{
+		{
+			"MEMORY_PRESSURE_SHRINK_CACHES_PERCENTAGE",
+			&c.MemoryPressure.ShrinkCachesPercentage, memwatch.DefaultShrinkCachesPercentage,
+		},
+		{
+			"MEMORY_PRESSURE_PAUSE_MAINTENANCE_PERCENTAGE",
+			&c.MemoryPressure.PauseMaintenancePercentage, memwatch.DefaultPauseMaintenancePercentage,
+		},
+		{
+			"MEMORY_PRESSURE_REJECT_IMPORTS_PERCENTAGE",
+			&c.MemoryPressure.RejectImportsPercentage, memwatch.DefaultRejectImportsPercentage,
+		},
+		{
+			"MEMORY_PRESSURE_HYSTERESIS_PERCENTAGE",
+			&c.MemoryPressure.HysteresisPercentage, memwatch.DefaultMemoryPressureHysteresisPercent,
+		},
+	} {
+		if v := os.Getenv(percentage.name); v != "" {
+			p, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("parse %s as int: %w", percentage.name, err)
+			}
+			*percentage.value = p
+		} else if *percentage.value == 0 {
+			*percentage.value = percentage.def
+		}
+	}
+
+	return nil
+}
+
 func (c *Config) parseMemtableConfig() error {
 	// first parse old name for flush value
 	if err := parsePositiveInt(
@@ -480,6 +1438,24 @@ func parseResourceUsageEnvVars() (ResourceUsage, error) {
 		ru.DiskUse.ReadOnlyPercentage = DefaultDiskUseReadonlyPercentage
 	}
 
+	if v := os.Getenv("DISK_USE_READONLY_MIN_FREE_BYTES"); v != "" {
+		asUint, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return ru, fmt.Errorf("parse DISK_USE_READONLY_MIN_FREE_BYTES as uint: %w", err)
+		}
+		ru.DiskUse.ReadOnlyMinFreeBytes = asUint
+	}
+
+	if v := os.Getenv("DISK_USE_RECOVERY_MARGIN_PERCENTAGE"); v != "" {
+		asUint, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return ru, fmt.Errorf("parse DISK_USE_RECOVERY_MARGIN_PERCENTAGE as uint: %w", err)
+		}
+		ru.DiskUse.RecoveryMarginPercentage = asUint
+	} else {
+		ru.DiskUse.RecoveryMarginPercentage = DefaultDiskUseRecoveryMarginPercentage
+	}
+
 	if v := os.Getenv("MEMORY_WARNING_PERCENTAGE"); v != "" {
 		asUint, err := strconv.ParseUint(v, 10, 64)
 		if err != nil {
@@ -543,6 +1519,7 @@ func parseClusterConfig() (cluster.Config, error) {
 		os.Getenv("CLUSTER_IGNORE_SCHEMA_SYNC"))
 	cfg.SkipSchemaSyncRepair = Enabled(
 		os.Getenv("CLUSTER_SKIP_SCHEMA_REPAIR"))
+	cfg.ReadOnly = Enabled(os.Getenv("CLUSTER_READ_ONLY"))
 
 	basicAuthUsername := os.Getenv("CLUSTER_BASIC_AUTH_USERNAME")
 	basicAuthPassword := os.Getenv("CLUSTER_BASIC_AUTH_PASSWORD")
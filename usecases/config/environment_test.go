@@ -14,11 +14,19 @@ package config
 import (
 	"errors"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/usecases/cdc"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/otlp"
+	"github.com/weaviate/weaviate/usecases/querycache"
+	"github.com/weaviate/weaviate/usecases/slowquery"
 )
 
 const DefaultGoroutineFactor = 1.5
@@ -280,6 +288,17 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 				IgnoreStartupSchemaSync: true,
 			},
 		},
+		{
+			name: "read-only node",
+			envVars: map[string]string{
+				"CLUSTER_READ_ONLY": "true",
+			},
+			expectedResult: cluster.Config{
+				GossipBindPort: 7946,
+				DataBindPort:   7947,
+				ReadOnly:       true,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -557,6 +576,34 @@ func TestEnvironmentPrometheusGroupClasses_NewName(t *testing.T) {
 	}
 }
 
+func TestEnvironmentPrometheusDisableLabels(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		t.Setenv("PROMETHEUS_MONITORING_ENABLED", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.Monitoring.DisableClassLabels)
+		assert.False(t, conf.Monitoring.DisableShardLabels)
+	})
+
+	t.Run("shard labels", func(t *testing.T) {
+		t.Setenv("PROMETHEUS_MONITORING_ENABLED", "true")
+		t.Setenv("PROMETHEUS_MONITORING_DISABLE_SHARD_LABELS", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.Monitoring.DisableClassLabels)
+		assert.True(t, conf.Monitoring.DisableShardLabels)
+	})
+
+	t.Run("class labels", func(t *testing.T) {
+		t.Setenv("PROMETHEUS_MONITORING_ENABLED", "true")
+		t.Setenv("PROMETHEUS_MONITORING_DISABLE_CLASS_LABELS", "on")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.True(t, conf.Monitoring.DisableClassLabels)
+		assert.False(t, conf.Monitoring.DisableShardLabels)
+	})
+}
+
 func TestEnvironmentMinimumReplicationFactor(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -586,3 +633,1138 @@ func TestEnvironmentMinimumReplicationFactor(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentPropertyEncryption(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.PropertyEncryption.Enabled)
+		assert.Empty(t, conf.PropertyEncryption.Keys)
+	})
+
+	t.Run("keys from env", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("PROPERTY_ENCRYPTION_KEYS",
+			"new:AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=,old:AAECAwQFBgcICQoLDA0ODw==")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.True(t, conf.PropertyEncryption.Enabled)
+		require.Len(t, conf.PropertyEncryption.Keys, 2)
+		assert.Equal(t, "new", conf.PropertyEncryption.Keys[0].ID)
+		assert.Len(t, conf.PropertyEncryption.Keys[0].Material, 32)
+		assert.Equal(t, "old", conf.PropertyEncryption.Keys[1].ID)
+		assert.Len(t, conf.PropertyEncryption.Keys[1].Material, 16)
+		assert.False(t, conf.PropertyEncryption.ReencryptAtStartup)
+		assert.Nil(t, conf.PropertyEncryption.Validate())
+	})
+
+	t.Run("re-encrypt at startup", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("PROPERTY_ENCRYPTION_KEYS", "k:AAECAwQFBgcICQoLDA0ODw==")
+		t.Setenv("PROPERTY_ENCRYPTION_REENCRYPT_AT_STARTUP", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.True(t, conf.PropertyEncryption.ReencryptAtStartup)
+	})
+
+	t.Run("keys from file", func(t *testing.T) {
+		os.Clearenv()
+		path := t.TempDir() + "/keys"
+		require.Nil(t, os.WriteFile(path,
+			[]byte("new:AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=\nold:AAECAwQFBgcICQoLDA0ODw==\n"), 0o600))
+		t.Setenv("PROPERTY_ENCRYPTION_KEYS_FILE", path)
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.True(t, conf.PropertyEncryption.Enabled)
+		require.Len(t, conf.PropertyEncryption.Keys, 2)
+	})
+
+	t.Run("env and file are mutually exclusive", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("PROPERTY_ENCRYPTION_KEYS", "k:AAECAwQFBgcICQoLDA0ODw==")
+		t.Setenv("PROPERTY_ENCRYPTION_KEYS_FILE", "/does/not/matter")
+		conf := Config{}
+		assert.NotNil(t, FromEnv(&conf))
+	})
+
+	t.Run("invalid key material", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("PROPERTY_ENCRYPTION_KEYS", "k:not-base64!")
+		conf := Config{}
+		assert.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentSensitiveDataReaders(t *testing.T) {
+	os.Clearenv()
+	t.Setenv("AUTHORIZATION_SENSITIVE_DATA_USERS", "alice,bob")
+	t.Setenv("AUTHORIZATION_SENSITIVE_DATA_GROUPS", "compliance")
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Equal(t, []string{"alice", "bob"}, conf.Authorization.SensitiveData.Users)
+	assert.Equal(t, []string{"compliance"}, conf.Authorization.SensitiveData.Groups)
+}
+
+func TestEnvironmentAuthorizationRBAC(t *testing.T) {
+	os.Clearenv()
+	t.Setenv("AUTHORIZATION_RBAC_ENABLED", "true")
+	t.Setenv("AUTHORIZATION_RBAC_ADMIN_USERS", "root")
+	t.Setenv("AUTHORIZATION_RBAC_ADMIN_GROUPS", "ops,security")
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.True(t, conf.Authorization.RBAC.Enabled)
+	assert.True(t, conf.Authorization.Enabled())
+	assert.Equal(t, []string{"root"}, conf.Authorization.RBAC.AdminUsers)
+	assert.Equal(t, []string{"ops", "security"}, conf.Authorization.RBAC.AdminGroups)
+	assert.Nil(t, conf.Authorization.Validate())
+
+	conf.Authorization.AdminList.Enabled = true
+	assert.ErrorContains(t, conf.Authorization.Validate(), "can not be enabled at the same time")
+}
+
+func TestEnvironmentAPIKeyManagement(t *testing.T) {
+	os.Clearenv()
+	t.Setenv("AUTHENTICATION_APIKEY_ENABLED", "true")
+	t.Setenv("AUTHENTICATION_APIKEY_MANAGEMENT_ENABLED", "true")
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.True(t, conf.Authentication.APIKey.Enabled)
+	assert.True(t, conf.Authentication.APIKey.ManagementEnabled)
+	assert.Empty(t, conf.Authentication.APIKey.AllowedKeys)
+}
+
+func TestEnvironmentOIDCRoleMappingsAndTenantClaim(t *testing.T) {
+	os.Clearenv()
+	t.Setenv("AUTHENTICATION_OIDC_ENABLED", "true")
+	t.Setenv("AUTHENTICATION_OIDC_ROLE_MAPPINGS", "admins=admin, department:sales=reader|sales")
+	t.Setenv("AUTHENTICATION_OIDC_TENANT_CLAIM", "org_id")
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Equal(t, []OIDCRoleMapping{
+		{Value: "admins", Roles: []string{"admin"}},
+		{Claim: "department", Value: "sales", Roles: []string{"reader", "sales"}},
+	}, conf.Authentication.OIDC.RoleMappings)
+	assert.Equal(t, "org_id", conf.Authentication.OIDC.TenantClaim)
+
+	t.Setenv("AUTHENTICATION_OIDC_ROLE_MAPPINGS", "admins")
+	assert.ErrorContains(t, FromEnv(&Config{}), "parse AUTHENTICATION_OIDC_ROLE_MAPPINGS")
+}
+
+func TestEnvironmentAuditLog(t *testing.T) {
+	os.Clearenv()
+	t.Setenv("AUDIT_LOG_ENABLED", "true")
+	t.Setenv("AUDIT_LOG_SINKS", "file, kafka")
+	t.Setenv("AUDIT_LOG_SAMPLE_RATE", "0.25")
+	t.Setenv("AUDIT_LOG_FILE_PATH", "/var/log/weaviate/audit.log")
+	t.Setenv("AUDIT_LOG_KAFKA_REST_PROXY_URL", "http://kafka-rest:8082")
+	t.Setenv("AUDIT_LOG_KAFKA_TOPIC", "audit")
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.True(t, conf.AuditLog.Enabled)
+	assert.Equal(t, []string{"file", "kafka"}, conf.AuditLog.Sinks)
+	assert.Equal(t, 0.25, conf.AuditLog.SampleRate)
+	assert.Equal(t, "/var/log/weaviate/audit.log", conf.AuditLog.File.Path)
+	assert.Equal(t, "http://kafka-rest:8082", conf.AuditLog.Kafka.RESTProxyURL)
+	assert.Equal(t, "audit", conf.AuditLog.Kafka.Topic)
+	assert.Nil(t, conf.AuditLog.Validate())
+
+	t.Run("sample rate defaults to all events", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, 1.0, conf.AuditLog.SampleRate)
+	})
+
+	t.Run("invalid sample rate", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("AUDIT_LOG_SAMPLE_RATE", "all")
+		assert.ErrorContains(t, FromEnv(&Config{}), "AUDIT_LOG_SAMPLE_RATE")
+	})
+}
+
+func TestEnvironmentChangeStream(t *testing.T) {
+	os.Clearenv()
+	t.Setenv("CHANGE_STREAM_ENABLED", "true")
+	t.Setenv("CHANGE_STREAM_SINK", "nats")
+	t.Setenv("CHANGE_STREAM_CLASS_TOPICS", "Article:articles, Author:authors")
+	t.Setenv("CHANGE_STREAM_CLASSES", "Article, Author")
+	t.Setenv("CHANGE_STREAM_INCLUDE_VECTORS", "true")
+	t.Setenv("CHANGE_STREAM_NATS_URL", "nats://nats:4222")
+	t.Setenv("CHANGE_STREAM_NATS_JETSTREAM", "true")
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Equal(t, cdc.Config{
+		Enabled:        true,
+		Sink:           "nats",
+		Topic:          cdc.DefaultTopic,
+		ClassTopics:    map[string]string{"Article": "articles", "Author": "authors"},
+		Classes:        []string{"Article", "Author"},
+		IncludeVectors: true,
+		NATS:           cdc.NATSConfig{URL: "nats://nats:4222", JetStream: true},
+	}, conf.ChangeStream)
+	assert.Nil(t, conf.ChangeStream.Validate())
+
+	t.Run("invalid class topics", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("CHANGE_STREAM_CLASS_TOPICS", "Article")
+		assert.ErrorContains(t, FromEnv(&Config{}), "CHANGE_STREAM_CLASS_TOPICS")
+	})
+}
+
+func TestEnvironmentQuotasFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quotas.yaml")
+	require.Nil(t, os.WriteFile(path, []byte(`
+default:
+  max_objects: 1000
+  tenant_default:
+    max_requests_per_second: 10
+classes:
+  article:
+    max_objects: 5000
+    max_vector_bytes: 1048576
+    tenants:
+      tenantA:
+        max_objects: 100
+`), 0o600))
+
+	os.Clearenv()
+	t.Setenv("QUOTAS_FILE", path)
+	t.Setenv("TRACK_VECTOR_DIMENSIONS", "true")
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.True(t, conf.Quotas.Enabled)
+	assert.Equal(t, int64(1000), conf.Quotas.Default.MaxObjects)
+	assert.Equal(t, 10.0, conf.Quotas.Default.TenantDefault.MaxRequestsPerSecond)
+	assert.Equal(t, int64(5000), conf.Quotas.Classes["article"].MaxObjects)
+	assert.Equal(t, int64(1048576), conf.Quotas.Classes["article"].MaxVectorBytes)
+	assert.Equal(t, int64(100), conf.Quotas.Classes["article"].Tenants["tenantA"].MaxObjects)
+	assert.Nil(t, conf.Quotas.Validate(conf.TrackVectorDimensions))
+
+	t.Run("missing file", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("QUOTAS_FILE", filepath.Join(t.TempDir(), "missing.yaml"))
+		assert.ErrorContains(t, FromEnv(&Config{}), "QUOTAS_FILE")
+	})
+}
+
+func TestEnvironmentTieredStorage(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, TieredStorage{ColdAfter: DefaultTieredStorageColdAfter}, conf.TieredStorage)
+		assert.Nil(t, conf.TieredStorage.Validate())
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("TIERED_STORAGE_ENABLED", "true")
+		t.Setenv("TIERED_STORAGE_BACKEND", "s3")
+		t.Setenv("TIERED_STORAGE_COLD_AFTER", "72h")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, TieredStorage{
+			Enabled:   true,
+			Backend:   "s3",
+			ColdAfter: 72 * time.Hour,
+		}, conf.TieredStorage)
+		assert.Nil(t, conf.TieredStorage.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("TIERED_STORAGE_COLD_AFTER", "a week")
+		assert.ErrorContains(t, FromEnv(&Config{}), "TIERED_STORAGE_COLD_AFTER")
+
+		os.Clearenv()
+		t.Setenv("TIERED_STORAGE_ENABLED", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.TieredStorage.Validate(), "backend")
+	})
+}
+
+func TestEnvironmentTenantOffload(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.TenantOffload.Enabled)
+		assert.Equal(t, DefaultTenantOffloadInterval, conf.TenantOffload.Interval)
+		assert.Equal(t, DefaultTenantOffloadActivationTimeout, conf.TenantOffload.ActivationTimeout)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("TENANT_OFFLOAD_ENABLED", "true")
+		t.Setenv("TENANT_OFFLOAD_BACKEND", "s3")
+		t.Setenv("TENANT_OFFLOAD_IDLE_DAYS", "14")
+		t.Setenv("TENANT_OFFLOAD_INTERVAL", "10m")
+		t.Setenv("TENANT_OFFLOAD_ACTIVATION_TIMEOUT", "5s")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, TenantOffload{
+			Enabled:           true,
+			Backend:           "s3",
+			IdleAfter:         14 * 24 * time.Hour,
+			Interval:          10 * time.Minute,
+			ActivationTimeout: 5 * time.Second,
+		}, conf.TenantOffload)
+		assert.Nil(t, conf.TenantOffload.Validate())
+	})
+
+	t.Run("invalid idle days", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("TENANT_OFFLOAD_IDLE_DAYS", "0")
+		assert.ErrorContains(t, FromEnv(&Config{}), "TENANT_OFFLOAD_IDLE_DAYS")
+	})
+
+	t.Run("enabled without backend", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("TENANT_OFFLOAD_ENABLED", "true")
+		t.Setenv("TENANT_OFFLOAD_IDLE_DAYS", "14")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.TenantOffload.Validate(), "backend")
+	})
+}
+
+func TestEnvironmentWALArchive(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.WALArchive.Enabled)
+		assert.Equal(t, DefaultWALArchiveInterval, conf.WALArchive.Interval)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("BACKUP_WAL_ARCHIVE_ENABLED", "true")
+		t.Setenv("BACKUP_WAL_ARCHIVE_BACKEND", "s3")
+		t.Setenv("BACKUP_WAL_ARCHIVE_INTERVAL", "30s")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, WALArchive{
+			Enabled:  true,
+			Backend:  "s3",
+			Interval: 30 * time.Second,
+		}, conf.WALArchive)
+		assert.Nil(t, conf.WALArchive.Validate())
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("BACKUP_WAL_ARCHIVE_INTERVAL", "often")
+		assert.ErrorContains(t, FromEnv(&Config{}), "BACKUP_WAL_ARCHIVE_INTERVAL")
+	})
+
+	t.Run("enabled without backend", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("BACKUP_WAL_ARCHIVE_ENABLED", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.WALArchive.Validate(), "backend")
+	})
+}
+
+func TestEnvironmentBackupSchedule(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, BackupSchedule{}, conf.BackupSchedule)
+		assert.Nil(t, conf.BackupSchedule.Validate())
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("BACKUP_SCHEDULE_ENABLED", "true")
+		t.Setenv("BACKUP_SCHEDULE_BACKEND", "s3")
+		t.Setenv("BACKUP_SCHEDULE_CRON", "0 2 * * *")
+		t.Setenv("BACKUP_SCHEDULE_EXCLUDE", "Logs,Events")
+		t.Setenv("BACKUP_SCHEDULE_KEEP_LAST", "7")
+		t.Setenv("BACKUP_SCHEDULE_MAX_AGE", "720h")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, BackupSchedule{
+			Enabled:  true,
+			Backend:  "s3",
+			Cron:     "0 2 * * *",
+			Exclude:  []string{"Logs", "Events"},
+			KeepLast: 7,
+			MaxAge:   30 * 24 * time.Hour,
+		}, conf.BackupSchedule)
+		assert.Nil(t, conf.BackupSchedule.Validate())
+	})
+
+	t.Run("invalid keep last", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("BACKUP_SCHEDULE_KEEP_LAST", "all")
+		assert.ErrorContains(t, FromEnv(&Config{}), "BACKUP_SCHEDULE_KEEP_LAST")
+	})
+
+	t.Run("invalid cron expression", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("BACKUP_SCHEDULE_ENABLED", "true")
+		t.Setenv("BACKUP_SCHEDULE_BACKEND", "s3")
+		t.Setenv("BACKUP_SCHEDULE_CRON", "every night")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.BackupSchedule.Validate(), "cron expression")
+	})
+
+	t.Run("include and exclude", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("BACKUP_SCHEDULE_ENABLED", "true")
+		t.Setenv("BACKUP_SCHEDULE_BACKEND", "s3")
+		t.Setenv("BACKUP_SCHEDULE_CRON", "@daily")
+		t.Setenv("BACKUP_SCHEDULE_INCLUDE", "Article")
+		t.Setenv("BACKUP_SCHEDULE_EXCLUDE", "Logs")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.BackupSchedule.Validate(), "mutually exclusive")
+	})
+}
+
+func TestEnvironmentStandby(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.Standby.Enabled)
+		assert.Equal(t, DefaultStandbyInterval, conf.Standby.Interval)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("STANDBY_ENABLED", "true")
+		t.Setenv("STANDBY_BACKEND", "s3")
+		t.Setenv("STANDBY_PRIMARY_NODES", "node1,node2")
+		t.Setenv("STANDBY_INTERVAL", "5s")
+		t.Setenv("STANDBY_SINCE", "2023-06-01T02:00:00Z")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Standby{
+			Enabled:      true,
+			Backend:      "s3",
+			PrimaryNodes: []string{"node1", "node2"},
+			Interval:     5 * time.Second,
+			Since:        time.Date(2023, 6, 1, 2, 0, 0, 0, time.UTC),
+		}, conf.Standby)
+		assert.Nil(t, conf.Standby.Validate(conf.WALArchive))
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("STANDBY_SINCE", "yesterday")
+		assert.ErrorContains(t, FromEnv(&Config{}), "STANDBY_SINCE")
+	})
+
+	t.Run("without primary nodes", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("STANDBY_ENABLED", "true")
+		t.Setenv("STANDBY_BACKEND", "s3")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.Standby.Validate(conf.WALArchive), "primary nodes")
+	})
+
+	t.Run("archive to the backend of the primary", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("STANDBY_ENABLED", "true")
+		t.Setenv("STANDBY_BACKEND", "s3")
+		t.Setenv("STANDBY_PRIMARY_NODES", "node1")
+		t.Setenv("BACKUP_WAL_ARCHIVE_ENABLED", "true")
+		t.Setenv("BACKUP_WAL_ARCHIVE_BACKEND", "s3")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.Standby.Validate(conf.WALArchive), "wal archive")
+	})
+}
+
+func TestEnvironmentAsyncReplication(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, AsyncReplication{
+			Interval:           DefaultAsyncReplicationInterval,
+			HashTreeDepth:      DefaultAsyncReplicationHashTreeDepth,
+			ConflictResolution: "none",
+		}, conf.AsyncReplication)
+		assert.Nil(t, conf.AsyncReplication.Validate())
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("ASYNC_REPLICATION_ENABLED", "true")
+		t.Setenv("ASYNC_REPLICATION_INTERVAL", "10m")
+		t.Setenv("ASYNC_REPLICATION_HASHTREE_DEPTH", "12")
+		t.Setenv("ASYNC_REPLICATION_BANDWIDTH_LIMIT", "1048576")
+		t.Setenv("ASYNC_REPLICATION_CONFLICT_RESOLUTION", "restore")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, AsyncReplication{
+			Enabled:            true,
+			Interval:           10 * time.Minute,
+			HashTreeDepth:      12,
+			BandwidthLimit:     1 << 20,
+			ConflictResolution: "restore",
+		}, conf.AsyncReplication)
+		assert.Nil(t, conf.AsyncReplication.Validate())
+	})
+
+	t.Run("invalid bandwidth limit", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("ASYNC_REPLICATION_BANDWIDTH_LIMIT", "fast")
+		assert.ErrorContains(t, FromEnv(&Config{}), "ASYNC_REPLICATION_BANDWIDTH_LIMIT")
+	})
+
+	t.Run("invalid depth and conflict resolution", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("ASYNC_REPLICATION_HASHTREE_DEPTH", "20")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.AsyncReplication.Validate(), "depth")
+
+		os.Clearenv()
+		t.Setenv("ASYNC_REPLICATION_CONFLICT_RESOLUTION", "newest")
+		conf = Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.AsyncReplication.Validate(), "conflict resolution")
+	})
+}
+
+func TestEnvironmentShardBalancer(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, ShardBalancer{
+			Interval:  DefaultShardBalancerInterval,
+			Threshold: DefaultShardBalancerThreshold,
+		}, conf.ShardBalancer)
+		assert.Nil(t, conf.ShardBalancer.Validate())
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("SHARD_BALANCER_ENABLED", "true")
+		t.Setenv("SHARD_BALANCER_INTERVAL", "1m")
+		t.Setenv("SHARD_BALANCER_THRESHOLD", "3")
+		t.Setenv("SHARD_BALANCER_BANDWIDTH_LIMIT", "1048576")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, ShardBalancer{
+			Enabled:        true,
+			Interval:       time.Minute,
+			Threshold:      3,
+			BandwidthLimit: 1 << 20,
+		}, conf.ShardBalancer)
+		assert.Nil(t, conf.ShardBalancer.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("SHARD_BALANCER_INTERVAL", "often")
+		assert.ErrorContains(t, FromEnv(&Config{}), "SHARD_BALANCER_INTERVAL")
+
+		os.Clearenv()
+		t.Setenv("SHARD_BALANCER_THRESHOLD", "-1")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.ShardBalancer.Validate(), "threshold")
+	})
+}
+
+func TestEnvironmentScrub(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Scrub{Interval: DefaultScrubInterval}, conf.Scrub)
+		assert.Nil(t, conf.Scrub.Validate())
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("SCRUB_ENABLED", "true")
+		t.Setenv("SCRUB_INTERVAL", "6h")
+		t.Setenv("SCRUB_REPAIR_FROM_REPLICAS", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Scrub{
+			Enabled:            true,
+			Interval:           6 * time.Hour,
+			RepairFromReplicas: true,
+		}, conf.Scrub)
+		assert.Nil(t, conf.Scrub.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("SCRUB_INTERVAL", "daily")
+		assert.ErrorContains(t, FromEnv(&Config{}), "SCRUB_INTERVAL")
+
+		os.Clearenv()
+		t.Setenv("SCRUB_INTERVAL", "-1h")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.Scrub.Validate(), "interval")
+	})
+}
+
+func TestEnvironmentLSMAccessStrategy(t *testing.T) {
+	for _, tc := range []struct {
+		strategy  string
+		avoidMmap bool
+		readMode  diskio.ReadMode
+	}{
+		{strategy: "", avoidMmap: false, readMode: ""},
+		{strategy: "mmap", avoidMmap: false, readMode: ""},
+		{strategy: "pread", avoidMmap: true, readMode: ""},
+		{strategy: "io_uring", avoidMmap: true, readMode: diskio.ReadModeIOUring},
+		{strategy: "direct", avoidMmap: true, readMode: diskio.ReadModeDirect},
+	} {
+		t.Run(tc.strategy, func(t *testing.T) {
+			os.Clearenv()
+			t.Setenv("PERSISTENCE_LSM_ACCESS_STRATEGY", tc.strategy)
+			conf := Config{}
+			require.Nil(t, FromEnv(&conf))
+			assert.Equal(t, tc.avoidMmap, conf.AvoidMmap)
+			assert.Equal(t, tc.readMode, conf.LSMReadMode)
+		})
+	}
+}
+
+func TestEnvironmentQueryShardConcurrency(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, 0, conf.QueryShardConcurrency)
+		assert.Equal(t, 0, conf.QueryMaxShardSearches)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("QUERY_SHARD_CONCURRENCY", "4")
+		t.Setenv("QUERY_MAX_SHARD_SEARCHES", "8")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, 4, conf.QueryShardConcurrency)
+		assert.Equal(t, 8, conf.QueryMaxShardSearches)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("QUERY_SHARD_CONCURRENCY", "many")
+		assert.ErrorContains(t, FromEnv(&Config{}), "QUERY_SHARD_CONCURRENCY")
+
+		os.Clearenv()
+		t.Setenv("QUERY_MAX_SHARD_SEARCHES", "0")
+		assert.ErrorContains(t, FromEnv(&Config{}), "QUERY_MAX_SHARD_SEARCHES")
+	})
+}
+
+func TestEnvironmentBatchBackpressure(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, BatchBackpressure{
+			MaxQueuedObjects: DefaultBatchBackpressureMaxQueuedObjects,
+			MaxBatchSize:     DefaultBatchBackpressureMaxBatchSize,
+			TargetLatency:    DefaultBatchBackpressureTargetLatency,
+		}, conf.BatchBackpressure)
+		assert.Nil(t, conf.BatchBackpressure.Validate())
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("BATCH_BACKPRESSURE_ENABLED", "true")
+		t.Setenv("BATCH_BACKPRESSURE_MAX_QUEUED_OBJECTS", "5000")
+		t.Setenv("BATCH_BACKPRESSURE_MAX_BATCH_SIZE", "1000")
+		t.Setenv("BATCH_BACKPRESSURE_TARGET_LATENCY", "5s")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, BatchBackpressure{
+			Enabled:          true,
+			MaxQueuedObjects: 5000,
+			MaxBatchSize:     1000,
+			TargetLatency:    5 * time.Second,
+		}, conf.BatchBackpressure)
+		assert.Nil(t, conf.BatchBackpressure.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("BATCH_BACKPRESSURE_TARGET_LATENCY", "soon")
+		assert.ErrorContains(t, FromEnv(&Config{}), "BATCH_BACKPRESSURE_TARGET_LATENCY")
+
+		os.Clearenv()
+		t.Setenv("BATCH_BACKPRESSURE_MAX_QUEUED_OBJECTS", "-1")
+		assert.ErrorContains(t, FromEnv(&Config{}), "BATCH_BACKPRESSURE_MAX_QUEUED_OBJECTS")
+
+		os.Clearenv()
+		t.Setenv("BATCH_BACKPRESSURE_ENABLED", "true")
+		t.Setenv("BATCH_BACKPRESSURE_MAX_QUEUED_OBJECTS", "100")
+		t.Setenv("BATCH_BACKPRESSURE_MAX_BATCH_SIZE", "1000")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.BatchBackpressure.Validate(), "max batch size")
+	})
+}
+
+func TestEnvironmentIngestQueue(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, IngestQueue{
+			MaxPendingObjects: DefaultIngestQueueMaxPendingObjects,
+		}, conf.IngestQueue)
+		assert.Nil(t, conf.IngestQueue.Validate())
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("INGEST_QUEUE_ENABLED", "true")
+		t.Setenv("INGEST_QUEUE_MAX_PENDING_OBJECTS", "5000")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, IngestQueue{
+			Enabled:           true,
+			MaxPendingObjects: 5000,
+		}, conf.IngestQueue)
+		assert.Nil(t, conf.IngestQueue.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("INGEST_QUEUE_MAX_PENDING_OBJECTS", "-1")
+		assert.ErrorContains(t, FromEnv(&Config{}), "INGEST_QUEUE_MAX_PENDING_OBJECTS")
+	})
+}
+
+func TestEnvironmentPriorityScheduler(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, PriorityScheduler{
+			InteractiveShares: DefaultPrioritySchedulerInteractiveShares,
+			BulkShares:        DefaultPrioritySchedulerBulkShares,
+		}, conf.PriorityScheduler)
+		assert.Nil(t, conf.PriorityScheduler.Validate())
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("PRIORITY_SCHEDULER_ENABLED", "true")
+		t.Setenv("PRIORITY_SCHEDULER_SLOTS", "16")
+		t.Setenv("PRIORITY_SCHEDULER_INTERACTIVE_SHARES", "3")
+		t.Setenv("PRIORITY_SCHEDULER_BULK_SHARES", "1")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, PriorityScheduler{
+			Enabled:           true,
+			Slots:             16,
+			InteractiveShares: 3,
+			BulkShares:        1,
+		}, conf.PriorityScheduler)
+		assert.Nil(t, conf.PriorityScheduler.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("PRIORITY_SCHEDULER_BULK_SHARES", "0")
+		assert.ErrorContains(t, FromEnv(&Config{}), "PRIORITY_SCHEDULER_BULK_SHARES")
+
+		os.Clearenv()
+		t.Setenv("PRIORITY_SCHEDULER_SLOTS", "many")
+		assert.ErrorContains(t, FromEnv(&Config{}), "PRIORITY_SCHEDULER_SLOTS")
+	})
+}
+
+func TestEnvironmentQueryAdmission(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, QueryAdmission{
+			MaxInFlight:      DefaultQueryAdmissionMaxInFlight,
+			MaxQueueWait:     DefaultQueryAdmissionMaxQueueWait,
+			BreakerThreshold: DefaultQueryAdmissionBreakerThreshold,
+			BreakerCooldown:  DefaultQueryAdmissionBreakerCooldown,
+		}, conf.QueryAdmission)
+		assert.Nil(t, conf.QueryAdmission.Validate())
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("QUERY_ADMISSION_ENABLED", "true")
+		t.Setenv("QUERY_ADMISSION_MAX_IN_FLIGHT", "8")
+		t.Setenv("QUERY_ADMISSION_MAX_QUEUE_WAIT", "250ms")
+		t.Setenv("QUERY_ADMISSION_BREAKER_THRESHOLD", "3")
+		t.Setenv("QUERY_ADMISSION_BREAKER_COOLDOWN", "30s")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, QueryAdmission{
+			Enabled:          true,
+			MaxInFlight:      8,
+			MaxQueueWait:     250 * time.Millisecond,
+			BreakerThreshold: 3,
+			BreakerCooldown:  30 * time.Second,
+		}, conf.QueryAdmission)
+		assert.Nil(t, conf.QueryAdmission.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("QUERY_ADMISSION_MAX_IN_FLIGHT", "0")
+		assert.ErrorContains(t, FromEnv(&Config{}), "QUERY_ADMISSION_MAX_IN_FLIGHT")
+
+		os.Clearenv()
+		t.Setenv("QUERY_ADMISSION_MAX_QUEUE_WAIT", "soon")
+		assert.ErrorContains(t, FromEnv(&Config{}), "QUERY_ADMISSION_MAX_QUEUE_WAIT")
+
+		conf := QueryAdmission{
+			Enabled:          true,
+			MaxInFlight:      8,
+			Classes:          map[string]int{"Article": 0},
+			BreakerThreshold: 3,
+			BreakerCooldown:  time.Second,
+		}
+		assert.ErrorContains(t, conf.Validate(), "Article")
+	})
+}
+
+func TestEnvironmentIdempotency(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Idempotency{
+			Window:   DefaultIdempotencyWindow,
+			MaxBytes: DefaultIdempotencyMaxBytes,
+		}, conf.Idempotency)
+		assert.Nil(t, conf.Idempotency.Validate())
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("IDEMPOTENCY_ENABLED", "true")
+		t.Setenv("IDEMPOTENCY_WINDOW", "10m")
+		t.Setenv("IDEMPOTENCY_MAX_BYTES", "1024")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Idempotency{
+			Enabled:  true,
+			Window:   10 * time.Minute,
+			MaxBytes: 1024,
+		}, conf.Idempotency)
+		assert.Nil(t, conf.Idempotency.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("IDEMPOTENCY_WINDOW", "forever")
+		assert.ErrorContains(t, FromEnv(&Config{}), "IDEMPOTENCY_WINDOW")
+
+		os.Clearenv()
+		t.Setenv("IDEMPOTENCY_MAX_BYTES", "-1")
+		assert.ErrorContains(t, FromEnv(&Config{}), "IDEMPOTENCY_MAX_BYTES")
+	})
+}
+
+func TestEnvironmentQueryCache(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, querycache.Config{
+			TTL:        querycache.DefaultTTL,
+			MaxEntries: querycache.DefaultMaxEntries,
+		}, conf.QueryCache)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("QUERY_CACHE_ENABLED", "true")
+		t.Setenv("QUERY_CACHE_TTL", "5s")
+		t.Setenv("QUERY_CACHE_MAX_ENTRIES", "100")
+		t.Setenv("QUERY_CACHE_REDIS_URL", "redis://redis:6379/1")
+		t.Setenv("QUERY_CACHE_REDIS_PASSWORD", "secret")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, querycache.Config{
+			Enabled:    true,
+			TTL:        5 * time.Second,
+			MaxEntries: 100,
+			Redis: querycache.RedisConfig{
+				URL:      "redis://redis:6379/1",
+				Password: "secret",
+			},
+		}, conf.QueryCache)
+		assert.Nil(t, conf.QueryCache.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("QUERY_CACHE_TTL", "soon")
+		assert.ErrorContains(t, FromEnv(&Config{}), "QUERY_CACHE_TTL")
+
+		os.Clearenv()
+		t.Setenv("QUERY_CACHE_ENABLED", "true")
+		t.Setenv("QUERY_CACHE_REDIS_URL", "http://redis")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.QueryCache.Validate(), "redis url")
+	})
+}
+
+func TestEnvironmentSlowQueryLog(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, slowquery.Config{
+			Threshold:  slowquery.DefaultThreshold,
+			SampleRate: slowquery.DefaultSampleRate,
+			MaxEntries: slowquery.DefaultMaxEntries,
+		}, conf.SlowQueryLog)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("SLOW_QUERY_LOG_ENABLED", "true")
+		t.Setenv("SLOW_QUERY_LOG_THRESHOLD", "250ms")
+		t.Setenv("SLOW_QUERY_LOG_SAMPLE_RATE", "0.1")
+		t.Setenv("SLOW_QUERY_LOG_MAX_ENTRIES", "50")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, slowquery.Config{
+			Enabled:    true,
+			Threshold:  250 * time.Millisecond,
+			SampleRate: 0.1,
+			MaxEntries: 50,
+		}, conf.SlowQueryLog)
+		assert.Nil(t, conf.SlowQueryLog.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("SLOW_QUERY_LOG_SAMPLE_RATE", "often")
+		assert.ErrorContains(t, FromEnv(&Config{}), "SLOW_QUERY_LOG_SAMPLE_RATE")
+
+		os.Clearenv()
+		t.Setenv("SLOW_QUERY_LOG_ENABLED", "true")
+		t.Setenv("SLOW_QUERY_LOG_SAMPLE_RATE", "2")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.SlowQueryLog.Validate(), "sample rate")
+	})
+}
+
+func TestEnvironmentTracing(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, otlp.Config{
+			ServiceName: otlp.DefaultServiceName,
+			SampleRatio: otlp.DefaultSampleRatio,
+		}, conf.Tracing)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("TRACING_ENABLED", "true")
+		t.Setenv("TRACING_OTLP_ENDPOINT", "http://collector:4318")
+		t.Setenv("TRACING_SERVICE_NAME", "weaviate-eu")
+		t.Setenv("TRACING_SAMPLE_RATIO", "0.05")
+		t.Setenv("TRACING_OTLP_HEADERS", "Authorization=Bearer abc, X-Tenant=prod")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, otlp.Config{
+			Enabled:     true,
+			Endpoint:    "http://collector:4318",
+			ServiceName: "weaviate-eu",
+			SampleRatio: 0.05,
+			Headers: map[string]string{
+				"Authorization": "Bearer abc",
+				"X-Tenant":      "prod",
+			},
+		}, conf.Tracing)
+		assert.Nil(t, conf.Tracing.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("TRACING_OTLP_HEADERS", "Authorization")
+		assert.ErrorContains(t, FromEnv(&Config{}), "TRACING_OTLP_HEADERS")
+
+		os.Clearenv()
+		t.Setenv("TRACING_ENABLED", "true")
+		t.Setenv("TRACING_OTLP_ENDPOINT", "collector:4318")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.ErrorContains(t, conf.Tracing.Validate(), "endpoint")
+	})
+}
+
+func TestEnvironmentMemoryPressure(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, memwatch.GovernorConfig{
+			Interval:                   memwatch.DefaultGovernorInterval,
+			ShrinkCachesPercentage:     memwatch.DefaultShrinkCachesPercentage,
+			PauseMaintenancePercentage: memwatch.DefaultPauseMaintenancePercentage,
+			RejectImportsPercentage:    memwatch.DefaultRejectImportsPercentage,
+			HysteresisPercentage:       memwatch.DefaultMemoryPressureHysteresisPercent,
+		}, conf.MemoryPressure)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("MEMORY_PRESSURE_ENABLED", "true")
+		t.Setenv("MEMORY_PRESSURE_INTERVAL", "500ms")
+		t.Setenv("MEMORY_PRESSURE_MAX_HEAP_BYTES", "4294967296")
+		t.Setenv("MEMORY_PRESSURE_MAX_RSS_BYTES", "6442450944")
+		t.Setenv("MEMORY_PRESSURE_SHRINK_CACHES_PERCENTAGE", "70")
+		t.Setenv("MEMORY_PRESSURE_PAUSE_MAINTENANCE_PERCENTAGE", "80")
+		t.Setenv("MEMORY_PRESSURE_REJECT_IMPORTS_PERCENTAGE", "95")
+		t.Setenv("MEMORY_PRESSURE_HYSTERESIS_PERCENTAGE", "3")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, memwatch.GovernorConfig{
+			Enabled:                    true,
+			Interval:                   500 * time.Millisecond,
+			MaxHeapBytes:               4 * memwatch.GiB,
+			MaxRSSBytes:                6 * memwatch.GiB,
+			ShrinkCachesPercentage:     70,
+			PauseMaintenancePercentage: 80,
+			RejectImportsPercentage:    95,
+			HysteresisPercentage:       3,
+		}, conf.MemoryPressure)
+		assert.Nil(t, conf.MemoryPressure.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("MEMORY_PRESSURE_MAX_RSS_BYTES", "6GB")
+		assert.ErrorContains(t, FromEnv(&Config{}), "MEMORY_PRESSURE_MAX_RSS_BYTES")
+	})
+}
+
+func TestEnvironmentDiskUse(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, DiskUse{
+			WarningPercentage:        DefaultDiskUseWarningPercentage,
+			ReadOnlyPercentage:       DefaultDiskUseReadonlyPercentage,
+			RecoveryMarginPercentage: DefaultDiskUseRecoveryMarginPercentage,
+		}, conf.ResourceUsage.DiskUse)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("DISK_USE_WARNING_PERCENTAGE", "70")
+		t.Setenv("DISK_USE_READONLY_PERCENTAGE", "95")
+		t.Setenv("DISK_USE_READONLY_MIN_FREE_BYTES", "1073741824")
+		t.Setenv("DISK_USE_RECOVERY_MARGIN_PERCENTAGE", "2")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, DiskUse{
+			WarningPercentage:        70,
+			ReadOnlyPercentage:       95,
+			ReadOnlyMinFreeBytes:     1 << 30,
+			RecoveryMarginPercentage: 2,
+		}, conf.ResourceUsage.DiskUse)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("DISK_USE_READONLY_MIN_FREE_BYTES", "1GB")
+		conf := Config{}
+		assert.ErrorContains(t, FromEnv(&conf), "DISK_USE_READONLY_MIN_FREE_BYTES")
+	})
+}
+
+func TestEnvironmentStartupPriorityClasses(t *testing.T) {
+	os.Clearenv()
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Nil(t, conf.StartupPriorityClasses)
+
+	t.Setenv("STARTUP_PRIORITY_CLASSES", "Article,Author")
+	conf = Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Equal(t, []string{"Article", "Author"}, conf.StartupPriorityClasses)
+}
+
+func TestEnvironmentLogLevel(t *testing.T) {
+	os.Clearenv()
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Equal(t, "", conf.LogLevel)
+	_, err := ParseLogLevel(conf.LogLevel)
+	assert.Nil(t, err)
+
+	t.Setenv("LOG_LEVEL", "debug")
+	conf = Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Equal(t, "debug", conf.LogLevel)
+
+	_, err = ParseLogLevel("verbose")
+	assert.NotNil(t, err)
+}
+
+func TestEnvironmentGRPCCompression(t *testing.T) {
+	os.Clearenv()
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Nil(t, conf.GRPC.Compression)
+
+	t.Setenv("GRPC_COMPRESSION", "zstd, gzip")
+	conf = Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Equal(t, []string{"zstd", "gzip"}, conf.GRPC.Compression)
+
+	t.Setenv("GRPC_COMPRESSION", "brotli")
+	assert.NotNil(t, FromEnv(&Config{}))
+}
+
+func TestEnvironmentHTTP3(t *testing.T) {
+	os.Clearenv()
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.False(t, conf.HTTP3.Enabled)
+
+	t.Setenv("HTTP3_ENABLED", "true")
+	conf = Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.True(t, conf.HTTP3.Enabled)
+}
+
+func TestEnvironmentPartitionRetentionInterval(t *testing.T) {
+	os.Clearenv()
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Equal(t, DefaultPartitionRetentionInterval, conf.PartitionRetentionInterval)
+
+	t.Setenv("PARTITION_RETENTION_INTERVAL", "10m")
+	conf = Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Equal(t, 10*time.Minute, conf.PartitionRetentionInterval)
+
+	t.Setenv("PARTITION_RETENTION_INTERVAL", "0s")
+	assert.ErrorContains(t, FromEnv(&Config{}), "PARTITION_RETENTION_INTERVAL")
+}
@@ -0,0 +1,265 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ReloadResult reports which changed settings were applied at runtime and
+// which only take effect after a restart of the node
+type ReloadResult struct {
+	Applied         []string          `json:"applied"`
+	RequiresRestart []string          `json:"requiresRestart"`
+	Failed          map[string]string `json:"failed,omitempty"`
+}
+
+func (r *ReloadResult) fail(setting string, err error) {
+	if r.Failed == nil {
+		r.Failed = map[string]string{}
+	}
+	r.Failed[setting] = err.Error()
+}
+
+// ApplyFunc applies a changed setting of the new config at runtime
+type ApplyFunc func(Config) error
+
+type refreshHook struct {
+	name    string
+	refresh func() error
+}
+
+// Reloader applies changes of the config at runtime. Settings are the top
+// level fields of the config, identified by their json name. Changed
+// settings without a registered ApplyFunc require a restart and are not
+// taken over into the current config, so they are reported again by the
+// next reload.
+type Reloader struct {
+	load   func() (Config, error)
+	logger logrus.FieldLogger
+
+	sync.Mutex
+	current  Config
+	appliers map[string]ApplyFunc
+	hooks    []refreshHook
+	signals  chan os.Signal
+}
+
+// NewReloader starts from the config loaded at startup, load reads the
+// config again from the config file and the environment
+func NewReloader(current Config, load func() (Config, error),
+	logger logrus.FieldLogger,
+) *Reloader {
+	return &Reloader{
+		load:     load,
+		logger:   logger,
+		current:  current,
+		appliers: map[string]ApplyFunc{},
+	}
+}
+
+// Register applies changes of the setting with the given json name at
+// runtime
+func (r *Reloader) Register(setting string, apply ApplyFunc) {
+	if _, ok := settingIndex[setting]; !ok {
+		panic(fmt.Sprintf("config has no setting %q", setting))
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	r.appliers[setting] = apply
+}
+
+// RegisterHook runs refresh on every reload. It is meant for state which is
+// not part of the config, such as credentials read from files, which can
+// not be compared.
+func (r *Reloader) RegisterHook(name string, refresh func() error) {
+	r.Lock()
+	defer r.Unlock()
+	r.hooks = append(r.hooks, refreshHook{name: name, refresh: refresh})
+}
+
+// Reload loads the config like at startup and applies the changed settings
+func (r *Reloader) Reload() (ReloadResult, error) {
+	next, err := r.load()
+	if err != nil {
+		return ReloadResult{}, err
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	return r.apply(next)
+}
+
+// Update merges the partial config in JSON onto the current config and
+// applies the changed settings
+func (r *Reloader) Update(patch []byte) (ReloadResult, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	next, err := mergeConfig(r.current, patch)
+	if err != nil {
+		return ReloadResult{}, err
+	}
+	return r.apply(next)
+}
+
+// Start reloads the config whenever the process receives SIGHUP
+func (r *Reloader) Start() {
+	r.signals = make(chan os.Signal, 1)
+	signal.Notify(r.signals, syscall.SIGHUP)
+	go func() {
+		for range r.signals {
+			if _, err := r.Reload(); err != nil {
+				r.logger.WithField("action", "config_reload").WithError(err).
+					Error("could not reload config")
+			}
+		}
+	}()
+}
+
+func (r *Reloader) Close() error {
+	if r == nil || r.signals == nil {
+		return nil
+	}
+
+	signal.Stop(r.signals)
+	close(r.signals)
+	return nil
+}
+
+func (r *Reloader) apply(next Config) (ReloadResult, error) {
+	if err := next.validateNested(); err != nil {
+		return ReloadResult{}, err
+	}
+
+	result := ReloadResult{Applied: []string{}, RequiresRestart: []string{}}
+	current := reflect.ValueOf(&r.current).Elem()
+	changed := reflect.ValueOf(next)
+	for i, setting := range settings {
+		if setting == "" ||
+			reflect.DeepEqual(current.Field(i).Interface(), changed.Field(i).Interface()) {
+			continue
+		}
+
+		apply, ok := r.appliers[setting]
+		if !ok {
+			result.RequiresRestart = append(result.RequiresRestart, setting)
+			continue
+		}
+		if err := apply(next); err != nil {
+			result.fail(setting, err)
+			continue
+		}
+		current.Field(i).Set(changed.Field(i))
+		result.Applied = append(result.Applied, setting)
+	}
+
+	for _, hook := range r.hooks {
+		if err := hook.refresh(); err != nil {
+			result.fail(hook.name, err)
+			continue
+		}
+		result.Applied = append(result.Applied, hook.name)
+	}
+
+	r.logger.WithFields(logrus.Fields{
+		"action":           "config_reload",
+		"applied":          result.Applied,
+		"requires_restart": result.RequiresRestart,
+		"failed":           result.Failed,
+	}).Info("config reloaded")
+	return result, nil
+}
+
+// settings are the json names of the fields of the config by field index,
+// unexported and ignored fields are empty
+var settings, settingIndex = configSettings()
+
+func configSettings() ([]string, map[string]int) {
+	t := reflect.TypeOf(Config{})
+	names := make([]string, t.NumField())
+	index := map[string]int{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[i] = name
+		index[name] = i
+	}
+	return names, index
+}
+
+// mergeConfig decodes each setting of the patch onto a copy of its current
+// value, so that nested settings can be changed partially without modifying
+// the current config
+func mergeConfig(current Config, patch []byte) (Config, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &fields); err != nil {
+		return Config{}, fmt.Errorf("parse config: %w", err)
+	}
+
+	next := current
+	v := reflect.ValueOf(&next).Elem()
+	for setting, raw := range fields {
+		i, ok := settingIndex[setting]
+		if !ok {
+			return Config{}, fmt.Errorf("unknown setting %q", setting)
+		}
+
+		field := v.Field(i)
+		merged := reflect.New(field.Type())
+		encoded, err := json.Marshal(field.Interface())
+		if err != nil {
+			return Config{}, fmt.Errorf("setting %q: %w", setting, err)
+		}
+		if err := json.Unmarshal(encoded, merged.Interface()); err != nil {
+			return Config{}, fmt.Errorf("setting %q: %w", setting, err)
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(merged.Interface()); err != nil {
+			return Config{}, fmt.Errorf("setting %q: %w", setting, err)
+		}
+		field.Set(merged.Elem())
+	}
+	return next, nil
+}
+
+// ParseLogLevel parses the log level of the config, the empty level is info
+func ParseLogLevel(level string) (logrus.Level, error) {
+	if level == "" {
+		return logrus.InfoLevel, nil
+	}
+
+	parsed, err := logrus.ParseLevel(level)
+	if err != nil {
+		return 0, fmt.Errorf("log level: %w", err)
+	}
+	return parsed, nil
+}
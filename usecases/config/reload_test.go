@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloader(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	// the defaults of the environment are a valid config
+	os.Clearenv()
+	current := Config{
+		Authentication: Authentication{AnonymousAccess: AnonymousAccess{Enabled: true}},
+		Persistence:    Persistence{DataPath: "./data"},
+	}
+	require.Nil(t, FromEnv(&current))
+	current.MaximumConcurrentGetRequests = 10
+
+	newReloader := func(load func() (Config, error)) (*Reloader, map[string]Config) {
+		applied := map[string]Config{}
+		r := NewReloader(current, load, logger)
+		for _, setting := range []string{"log_level", "auto_schema"} {
+			setting := setting
+			r.Register(setting, func(cfg Config) error {
+				applied[setting] = cfg
+				return nil
+			})
+		}
+		r.Register("maximum_concurrent_get_requests", func(cfg Config) error {
+			return errors.New("not now")
+		})
+		return r, applied
+	}
+
+	t.Run("update", func(t *testing.T) {
+		r, applied := newReloader(nil)
+		result, err := r.Update([]byte(`{
+			"log_level": "debug",
+			"auto_schema": {"enabled": false},
+			"maximum_concurrent_get_requests": 20,
+			"disable_graphql": true
+		}`))
+		require.Nil(t, err)
+		assert.Equal(t, []string{"auto_schema", "log_level"}, result.Applied)
+		assert.Equal(t, []string{"disable_graphql"}, result.RequiresRestart)
+		assert.Equal(t, map[string]string{"maximum_concurrent_get_requests": "not now"},
+			result.Failed)
+
+		// nested settings are changed partially
+		expected := current.AutoSchema
+		expected.Enabled = false
+		assert.Equal(t, expected, applied["auto_schema"].AutoSchema)
+
+		// only applied settings are taken over, the others are reported again
+		result, err = r.Update([]byte(`{"log_level": "debug", "disable_graphql": true}`))
+		require.Nil(t, err)
+		assert.Empty(t, result.Applied)
+		assert.Equal(t, []string{"disable_graphql"}, result.RequiresRestart)
+		assert.Empty(t, result.Failed)
+	})
+
+	t.Run("invalid update", func(t *testing.T) {
+		r, applied := newReloader(nil)
+		for _, patch := range []string{
+			`{"log_level": "verbose"}`,
+			`{"no_such_setting": true}`,
+			`{"auto_schema": {"no_such_field": true}}`,
+			`not json`,
+		} {
+			_, err := r.Update([]byte(patch))
+			assert.NotNil(t, err, patch)
+		}
+		assert.Empty(t, applied)
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		loaded := current
+		loaded.LogLevel = "warning"
+		r, applied := newReloader(func() (Config, error) { return loaded, nil })
+
+		refreshed := 0
+		r.RegisterHook("backup-s3/credentials", func() error {
+			refreshed++
+			return nil
+		})
+
+		result, err := r.Reload()
+		require.Nil(t, err)
+		assert.Equal(t, []string{"log_level", "backup-s3/credentials"}, result.Applied)
+		assert.Empty(t, result.RequiresRestart)
+		assert.Equal(t, "warning", applied["log_level"].LogLevel)
+		assert.Equal(t, 1, refreshed)
+	})
+}
@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Collector gathers a single file of a bundle
+type Collector struct {
+	Name    string
+	Collect func(ctx context.Context, w io.Writer) error
+}
+
+// JSON is a collector of a file which contains the value returned by get,
+// encoded as indented JSON
+func JSON(name string, get func(ctx context.Context) (interface{}, error)) Collector {
+	return Collector{
+		Name: name,
+		Collect: func(ctx context.Context, w io.Writer) error {
+			v, err := get(ctx)
+			if err != nil {
+				return err
+			}
+
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			return enc.Encode(v)
+		},
+	}
+}
+
+// Write gathers the files of the collectors into a gzipped tar archive. A
+// bundle is meant to help with support cases, so a collector which fails does
+// not fail the whole bundle: its error is listed in errors.txt instead. Only
+// errors writing the archive itself are returned.
+func Write(ctx context.Context, w io.Writer, collectors []Collector) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+
+	var errs bytes.Buffer
+	for _, c := range collectors {
+		// the size of a file is part of its tar header, so each file is
+		// gathered completely before it is written
+		var buf bytes.Buffer
+		if err := c.Collect(ctx, &buf); err != nil {
+			fmt.Fprintf(&errs, "%s: %v\n", c.Name, err)
+			continue
+		}
+		if err := writeFile(tw, c.Name, buf.Bytes(), now); err != nil {
+			return err
+		}
+	}
+
+	if errs.Len() > 0 {
+		if err := writeFile(tw, "errors.txt", errs.Bytes(), now); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("close tar: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("close gzip: %w", err)
+	}
+	return nil
+}
+
+func writeFile(tw *tar.Writer, name string, content []byte, modTime time.Time) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(content)),
+		ModTime: modTime,
+	}); err != nil {
+		return fmt.Errorf("write header of %s: %w", name, err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	return nil
+}
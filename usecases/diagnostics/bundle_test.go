@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readBundle(t *testing.T, r io.Reader) map[string]string {
+	gz, err := gzip.NewReader(r)
+	require.Nil(t, err)
+	tr := tar.NewReader(gz)
+
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+		require.Nil(t, err)
+		content, err := io.ReadAll(tr)
+		require.Nil(t, err)
+		files[hdr.Name] = string(content)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	collectors := append(Profiles(0),
+		JSON("schema.json", func(ctx context.Context) (interface{}, error) {
+			return map[string]string{"class": "Article"}, nil
+		}),
+		JSON("nodes.json", func(ctx context.Context) (interface{}, error) {
+			return nil, errors.New("node2 unreachable")
+		}),
+	)
+
+	var buf bytes.Buffer
+	require.Nil(t, Write(context.Background(), &buf, collectors))
+	files := readBundle(t, &buf)
+
+	assert.Contains(t, files, "profiles/heap.pprof")
+	assert.Contains(t, files, "profiles/goroutine.pprof")
+	assert.NotContains(t, files, "profiles/cpu.pprof")
+	assert.Contains(t, files["goroutines.txt"], "goroutine")
+	assert.JSONEq(t, `{"class": "Article"}`, files["schema.json"])
+	assert.NotContains(t, files, "nodes.json")
+	assert.Equal(t, "nodes.json: node2 unreachable\n", files["errors.txt"])
+}
+
+func TestRedact(t *testing.T) {
+	type redis struct {
+		URL      string `json:"url"`
+		Password string `json:"password"`
+	}
+	type config struct {
+		AllowedKeys []string `json:"allowed_keys"`
+		Users       []string `json:"users"`
+		Token       string   `json:"token"`
+		Redis       redis    `json:"redis"`
+	}
+
+	out, err := Redact(config{
+		AllowedKeys: []string{"key1"},
+		Users:       []string{"jane"},
+		Redis:       redis{URL: "redis://:secret@localhost:6379", Password: "secret"},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"allowed_keys": "<redacted>",
+		"users":        []interface{}{"jane"},
+		"token":        "",
+		"redis": map[string]interface{}{
+			"url":      "redis://:xxxxx@localhost:6379",
+			"password": "<redacted>",
+		},
+	}, out)
+}
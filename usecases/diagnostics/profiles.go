@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime/pprof"
+	"time"
+)
+
+// profiles which are snapshots of the process, the cpu profile is sampled
+// for a duration instead
+var profiles = []string{"heap", "allocs", "goroutine", "block", "mutex", "threadcreate"}
+
+// Profiles are collectors of the pprof profiles of the process and a dump of
+// the stacks of all goroutines. The cpu profile is sampled for the given
+// duration, it is skipped if the duration is zero.
+func Profiles(cpu time.Duration) []Collector {
+	var collectors []Collector
+	if cpu > 0 {
+		collectors = append(collectors, Collector{
+			Name: "profiles/cpu.pprof",
+			Collect: func(ctx context.Context, w io.Writer) error {
+				return cpuProfile(ctx, w, cpu)
+			},
+		})
+	}
+
+	for _, name := range profiles {
+		name := name
+		collectors = append(collectors, Collector{
+			Name: fmt.Sprintf("profiles/%s.pprof", name),
+			Collect: func(ctx context.Context, w io.Writer) error {
+				return pprof.Lookup(name).WriteTo(w, 0)
+			},
+		})
+	}
+
+	return append(collectors, Collector{
+		Name: "goroutines.txt",
+		Collect: func(ctx context.Context, w io.Writer) error {
+			return pprof.Lookup("goroutine").WriteTo(w, 2)
+		},
+	})
+}
+
+func cpuProfile(ctx context.Context, w io.Writer, d time.Duration) error {
+	// fails if another cpu profile is running, e.g. one of the pprof server
+	if err := pprof.StartCPUProfile(w); err != nil {
+		return err
+	}
+	defer pprof.StopCPUProfile()
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
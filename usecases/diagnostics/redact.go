@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diagnostics
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+const redacted = "<redacted>"
+
+// sensitiveKeys are parts of the names of config fields whose values are
+// secrets. Most secrets are not encoded at all, this guards the others.
+var sensitiveKeys = []string{
+	"password", "secret", "token", "credential", "allowed_keys", "apikey", "api_key",
+}
+
+// Redact returns the JSON representation of v with the values of sensitive
+// fields replaced and credentials removed from URLs, so that a config can be
+// shared in a support case
+func Redact(v interface{}) (interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var out interface{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+	return redact(out), nil
+}
+
+func redact(v interface{}) interface{} {
+	switch typed := v.(type) {
+	case map[string]interface{}:
+		for key, value := range typed {
+			if isSensitive(key) && !isEmpty(value) {
+				typed[key] = redacted
+			} else {
+				typed[key] = redact(value)
+			}
+		}
+		return typed
+	case []interface{}:
+		for i, value := range typed {
+			typed[i] = redact(value)
+		}
+		return typed
+	case string:
+		return redactURL(typed)
+	default:
+		return v
+	}
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+func isEmpty(v interface{}) bool {
+	switch typed := v.(type) {
+	case nil:
+		return true
+	case string:
+		return typed == ""
+	case []interface{}:
+		return len(typed) == 0
+	default:
+		return false
+	}
+}
+
+// redactURL masks the password of URLs, such as redis://:secret@host
+func redactURL(s string) string {
+	if !strings.Contains(s, "://") {
+		return s
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return s
+	}
+	if _, ok := u.User.Password(); !ok {
+		return s
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,542 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// enums of the arrow IPC format
+const (
+	arrowMetadataV5 = 4
+
+	arrowHeaderSchema          = 1
+	arrowHeaderDictionaryBatch = 2
+	arrowHeaderRecordBatch     = 3
+
+	arrowInt           = 2
+	arrowFloatingPoint = 3
+	arrowUtf8          = 5
+	arrowBool          = 6
+	arrowList          = 12
+	arrowFixedSizeList = 16
+	arrowLargeUtf8     = 20
+	arrowLargeList     = 21
+
+	arrowPrecisionSingle = 1
+	arrowPrecisionDouble = 2
+)
+
+// ArrowSchema is the schema message of the record batches of an export,
+// which has the columns of a parquet export
+func ArrowSchema() []byte {
+	fields := []fbTable{
+		arrowField("id", false, arrowUtf8, fbTable{}),
+		arrowField("creationTimeUnix", false, arrowInt, fbTable{int32(64), true}),
+		arrowField("lastUpdateTimeUnix", false, arrowInt, fbTable{int32(64), true}),
+		arrowField("properties", true, arrowUtf8, fbTable{}),
+		arrowField("vector", true, arrowList, fbTable{},
+			arrowField("item", false, arrowFloatingPoint, fbTable{int16(arrowPrecisionSingle)})),
+	}
+	return arrowMessage(arrowHeaderSchema, fbTable{int16(0), fields}, 0)
+}
+
+func arrowField(name string, nullable bool, typeID uint8, typ fbTable, children ...fbTable) fbTable {
+	if children == nil {
+		children = []fbTable{}
+	}
+	return fbTable{name, nullable, typeID, typ, nil, children}
+}
+
+func arrowMessage(headerType uint8, header fbTable, bodyLength int) []byte {
+	return fbBuild(fbTable{int16(arrowMetadataV5), headerType, header, int64(bodyLength)})
+}
+
+// EncapsulateArrowMessage frames a message like the messages of IPC streams,
+// which is how schemas are embedded into other messages
+func EncapsulateArrowMessage(message []byte) []byte {
+	out := make([]byte, 8, 8+len(message))
+	binary.LittleEndian.PutUint32(out, 0xFFFFFFFF)
+	binary.LittleEndian.PutUint32(out[4:], uint32(len(message)))
+	return append(out, message...)
+}
+
+// arrowBody collects the buffers of a record batch
+type arrowBody struct {
+	data    []byte
+	nodes   fbStructs
+	buffers fbStructs
+}
+
+func (a *arrowBody) node(length, nulls int) {
+	a.nodes.n++
+	a.nodes.data = binary.LittleEndian.AppendUint64(a.nodes.data, uint64(length))
+	a.nodes.data = binary.LittleEndian.AppendUint64(a.nodes.data, uint64(nulls))
+}
+
+func (a *arrowBody) buffer(data []byte) {
+	for len(a.data)%8 != 0 {
+		a.data = append(a.data, 0)
+	}
+	a.buffers.n++
+	a.buffers.data = binary.LittleEndian.AppendUint64(a.buffers.data, uint64(len(a.data)))
+	a.buffers.data = binary.LittleEndian.AppendUint64(a.buffers.data, uint64(len(data)))
+	a.data = append(a.data, data...)
+}
+
+// ArrowRecordBatch encodes objects as a record batch of the export schema,
+// it returns the message and the body of the batch
+func ArrowRecordBatch(objects []*models.Object) ([]byte, []byte, error) {
+	n := len(objects)
+	var (
+		idOffsets    = make([]byte, 0, 4*(n+1))
+		ids          []byte
+		created      = make([]byte, 0, 8*n)
+		updated      = make([]byte, 0, 8*n)
+		propsValid   = make([]byte, (n+7)/8)
+		propsOffsets = make([]byte, 0, 4*(n+1))
+		props        []byte
+		propsNulls   int
+		vecValid     = make([]byte, (n+7)/8)
+		vecOffsets   = make([]byte, 0, 4*(n+1))
+		vectors      []byte
+		vecNulls     int
+		dims         int
+	)
+	idOffsets = binary.LittleEndian.AppendUint32(idOffsets, 0)
+	propsOffsets = binary.LittleEndian.AppendUint32(propsOffsets, 0)
+	vecOffsets = binary.LittleEndian.AppendUint32(vecOffsets, 0)
+	for i, obj := range objects {
+		ids = append(ids, obj.ID...)
+		idOffsets = binary.LittleEndian.AppendUint32(idOffsets, uint32(len(ids)))
+		created = binary.LittleEndian.AppendUint64(created, uint64(obj.CreationTimeUnix))
+		updated = binary.LittleEndian.AppendUint64(updated, uint64(obj.LastUpdateTimeUnix))
+
+		if obj.Properties != nil {
+			encoded, err := json.Marshal(obj.Properties)
+			if err != nil {
+				return nil, nil, fmt.Errorf("encode properties of object %s: %w", obj.ID, err)
+			}
+			props = append(props, encoded...)
+			propsValid[i/8] |= 1 << (i % 8)
+		} else {
+			propsNulls++
+		}
+		propsOffsets = binary.LittleEndian.AppendUint32(propsOffsets, uint32(len(props)))
+
+		if obj.Vector != nil {
+			for _, v := range obj.Vector {
+				vectors = binary.LittleEndian.AppendUint32(vectors, math.Float32bits(v))
+			}
+			dims += len(obj.Vector)
+			vecValid[i/8] |= 1 << (i % 8)
+		} else {
+			vecNulls++
+		}
+		vecOffsets = binary.LittleEndian.AppendUint32(vecOffsets, uint32(dims))
+	}
+
+	body := &arrowBody{}
+	body.node(n, 0)
+	body.buffer(nil)
+	body.buffer(idOffsets)
+	body.buffer(ids)
+	body.node(n, 0)
+	body.buffer(nil)
+	body.buffer(created)
+	body.node(n, 0)
+	body.buffer(nil)
+	body.buffer(updated)
+	body.node(n, propsNulls)
+	body.buffer(propsValid)
+	body.buffer(propsOffsets)
+	body.buffer(props)
+	body.node(n, vecNulls)
+	body.buffer(vecValid)
+	body.buffer(vecOffsets)
+	body.node(dims, 0)
+	body.buffer(nil)
+	body.buffer(vectors)
+	for len(body.data)%8 != 0 {
+		body.data = append(body.data, 0)
+	}
+
+	message := arrowMessage(arrowHeaderRecordBatch,
+		fbTable{int64(n), body.nodes, body.buffers}, len(body.data))
+	return message, body.data, nil
+}
+
+// arrowColumn is a field of a schema with the parameters of its type
+type arrowColumn struct {
+	name      string
+	typeID    uint8
+	bitWidth  int
+	signed    bool
+	precision int16
+	listSize  int
+	child     *arrowColumn
+}
+
+// ArrowReader decodes the record batches of a stream of objects. The
+// columns are converted like the columns of a parquet file.
+type ArrowReader struct {
+	columns []*arrowColumn
+	class   *models.Class
+	rows    int
+}
+
+// NewArrowReader returns a reader of the record batches of the given
+// schema message
+func NewArrowReader(schema []byte, class *models.Class) (_ *ArrowReader, err error) {
+	defer recoverArrow(&err)
+
+	message := fbRoot(schema)
+	if message.uint8(1) != arrowHeaderSchema {
+		return nil, errors.New("expected an arrow schema message")
+	}
+	header, _ := message.table(2)
+	fields := header.tables(1)
+	if len(fields) == 0 {
+		return nil, errors.New("arrow schema has no fields")
+	}
+	columns := make([]*arrowColumn, len(fields))
+	for i, field := range fields {
+		if columns[i], err = parseArrowColumn(field, false); err != nil {
+			return nil, err
+		}
+	}
+	return &ArrowReader{columns: columns, class: class}, nil
+}
+
+func parseArrowColumn(field fbReader, inList bool) (*arrowColumn, error) {
+	c := &arrowColumn{name: field.string(0), typeID: field.uint8(2)}
+	if _, ok := field.table(4); ok {
+		return nil, fmt.Errorf("column %q: dictionary encoding is not supported", c.name)
+	}
+	typ, _ := field.table(3)
+
+	switch c.typeID {
+	case arrowInt:
+		c.bitWidth, c.signed = int(typ.int32(0)), typ.bool(1)
+		switch c.bitWidth {
+		case 8, 16, 32, 64:
+		default:
+			return nil, fmt.Errorf("column %q: invalid int bit width %d", c.name, c.bitWidth)
+		}
+	case arrowFloatingPoint:
+		c.precision = typ.int16(0)
+		if c.precision != arrowPrecisionSingle && c.precision != arrowPrecisionDouble {
+			return nil, fmt.Errorf("column %q: half floats are not supported", c.name)
+		}
+	case arrowBool, arrowUtf8, arrowLargeUtf8:
+	case arrowList, arrowLargeList, arrowFixedSizeList:
+		if inList {
+			return nil, fmt.Errorf("column %q: nested lists are not supported", c.name)
+		}
+		if c.typeID == arrowFixedSizeList {
+			if c.listSize = int(typ.int32(0)); c.listSize < 1 {
+				return nil, fmt.Errorf("column %q: invalid list size %d", c.name, c.listSize)
+			}
+		}
+		children := field.tables(5)
+		if len(children) != 1 {
+			return nil, fmt.Errorf("column %q: lists must have one child", c.name)
+		}
+		child, err := parseArrowColumn(children[0], true)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", c.name, err)
+		}
+		c.child = child
+	default:
+		return nil, fmt.Errorf("column %q: arrow type %d is not supported", c.name, c.typeID)
+	}
+	return c, nil
+}
+
+// Batch returns a reader of the objects of a record batch, the lines of
+// the objects are counted across the batches of the stream
+func (r *ArrowReader) Batch(message, body []byte) (_ Reader, err error) {
+	defer recoverArrow(&err)
+
+	msg := fbRoot(message)
+	switch msg.uint8(1) {
+	case arrowHeaderRecordBatch:
+	case arrowHeaderDictionaryBatch:
+		return nil, errors.New("dictionary batches are not supported")
+	default:
+		return nil, errors.New("expected an arrow record batch message")
+	}
+	header, _ := msg.table(2)
+	if _, ok := header.table(3); ok {
+		return nil, errors.New("compressed record batches are not supported")
+	}
+
+	d := &arrowBatch{body: body, nodes: header.structs(1, 16), buffers: header.structs(2, 16)}
+	length := int(header.int64(0))
+	var rows []map[string]interface{}
+	for _, c := range r.columns {
+		values, err := d.column(c)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", c.name, err)
+		}
+		if len(values) != length {
+			return nil, fmt.Errorf("column %q: expected %d values, got %d", c.name, length, len(values))
+		}
+		if rows == nil {
+			rows = make([]map[string]interface{}, length)
+			for i := range rows {
+				rows[i] = map[string]interface{}{}
+			}
+		}
+		for i, v := range values {
+			if v != nil {
+				rows[i][c.name] = v
+			}
+		}
+	}
+
+	first := r.rows
+	r.rows += len(rows)
+	next := 0
+	return &rowReader{class: r.class, next: func() (map[string]interface{}, int, error) {
+		if next >= len(rows) {
+			return nil, 0, io.EOF
+		}
+		next++
+		return rows[next-1], first + next, nil
+	}}, nil
+}
+
+func recoverArrow(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("invalid arrow message: %v", r)
+	}
+}
+
+// arrowBatch reads the field nodes and buffers of a record batch in the
+// order of the columns
+type arrowBatch struct {
+	body    []byte
+	nodes   []byte
+	buffers []byte
+}
+
+func (d *arrowBatch) nextNode() (int, int, error) {
+	if len(d.nodes) < 16 {
+		return 0, 0, errors.New("missing field node")
+	}
+	length := int64(binary.LittleEndian.Uint64(d.nodes))
+	nulls := int64(binary.LittleEndian.Uint64(d.nodes[8:]))
+	d.nodes = d.nodes[16:]
+	if length < 0 || nulls < 0 || nulls > length {
+		return 0, 0, errors.New("invalid field node")
+	}
+	return int(length), int(nulls), nil
+}
+
+func (d *arrowBatch) nextBuffer() ([]byte, error) {
+	if len(d.buffers) < 16 {
+		return nil, errors.New("missing buffer")
+	}
+	offset := binary.LittleEndian.Uint64(d.buffers)
+	length := binary.LittleEndian.Uint64(d.buffers[8:])
+	d.buffers = d.buffers[16:]
+	if offset > uint64(len(d.body)) || length > uint64(len(d.body))-offset {
+		return nil, errors.New("buffer exceeds the body")
+	}
+	return d.body[offset : offset+length], nil
+}
+
+// column decodes the values of a column, null values are nil
+func (d *arrowBatch) column(c *arrowColumn) ([]interface{}, error) {
+	length, nulls, err := d.nextNode()
+	if err != nil {
+		return nil, err
+	}
+	validity, err := d.nextBuffer()
+	if err != nil {
+		return nil, err
+	}
+	if nulls > 0 && len(validity)*8 < length {
+		return nil, errors.New("validity bitmap is too short")
+	}
+	isNull := func(i int) bool {
+		return nulls > 0 && validity[i/8]>>(i%8)&1 == 0
+	}
+
+	var values []interface{}
+	switch c.typeID {
+	case arrowInt, arrowFloatingPoint, arrowBool:
+		values, err = d.primitives(c, length)
+	case arrowUtf8, arrowLargeUtf8:
+		values, err = d.strings(c, length)
+	case arrowList, arrowLargeList:
+		values, err = d.lists(c, length)
+	case arrowFixedSizeList:
+		var child []interface{}
+		if child, err = d.column(c.child); err != nil {
+			break
+		}
+		if length > len(child)/c.listSize {
+			return nil, errors.New("fixed size list has too few values")
+		}
+		values = make([]interface{}, length)
+		for i := range values {
+			values[i] = child[i*c.listSize : (i+1)*c.listSize]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range values {
+		if isNull(i) {
+			values[i] = nil
+		}
+	}
+	return values, nil
+}
+
+func (d *arrowBatch) primitives(c *arrowColumn, length int) ([]interface{}, error) {
+	data, err := d.nextBuffer()
+	if err != nil {
+		return nil, err
+	}
+
+	size := c.bitWidth / 8
+	if c.typeID == arrowFloatingPoint {
+		size = 4
+		if c.precision == arrowPrecisionDouble {
+			size = 8
+		}
+	}
+	if c.typeID == arrowBool {
+		if len(data)*8 < length {
+			return nil, errors.New("values buffer is too short")
+		}
+	} else if len(data)/size < length {
+		return nil, errors.New("values buffer is too short")
+	}
+
+	values := make([]interface{}, length)
+	for i := range values {
+		switch c.typeID {
+		case arrowBool:
+			values[i] = data[i/8]>>(i%8)&1 == 1
+		case arrowFloatingPoint:
+			if size == 4 {
+				values[i] = float64(math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:])))
+			} else {
+				values[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+			}
+		default:
+			values[i] = arrowInteger(data[i*size:(i+1)*size], c.signed)
+		}
+	}
+	return values, nil
+}
+
+func arrowInteger(b []byte, signed bool) int64 {
+	switch len(b) {
+	case 1:
+		if signed {
+			return int64(int8(b[0]))
+		}
+		return int64(b[0])
+	case 2:
+		if signed {
+			return int64(int16(binary.LittleEndian.Uint16(b)))
+		}
+		return int64(binary.LittleEndian.Uint16(b))
+	case 4:
+		if signed {
+			return int64(int32(binary.LittleEndian.Uint32(b)))
+		}
+		return int64(binary.LittleEndian.Uint32(b))
+	default:
+		return int64(binary.LittleEndian.Uint64(b))
+	}
+}
+
+// offsets decodes the length+1 offsets of strings and lists
+func (d *arrowBatch) offsets(large bool, length int) ([]int, error) {
+	data, err := d.nextBuffer()
+	if err != nil {
+		return nil, err
+	}
+	size := 4
+	if large {
+		size = 8
+	}
+	if length == 0 {
+		return []int{0}, nil
+	}
+	if len(data)/size < length+1 {
+		return nil, errors.New("offsets buffer is too short")
+	}
+
+	offsets := make([]int, length+1)
+	for i := range offsets {
+		offsets[i] = int(arrowInteger(data[i*size:(i+1)*size], true))
+		if offsets[i] < 0 || (i > 0 && offsets[i] < offsets[i-1]) {
+			return nil, errors.New("invalid offsets")
+		}
+	}
+	return offsets, nil
+}
+
+func (d *arrowBatch) strings(c *arrowColumn, length int) ([]interface{}, error) {
+	offsets, err := d.offsets(c.typeID == arrowLargeUtf8, length)
+	if err != nil {
+		return nil, err
+	}
+	data, err := d.nextBuffer()
+	if err != nil {
+		return nil, err
+	}
+	if offsets[length] > len(data) {
+		return nil, errors.New("string data is too short")
+	}
+
+	values := make([]interface{}, length)
+	for i := range values {
+		values[i] = string(data[offsets[i]:offsets[i+1]])
+	}
+	return values, nil
+}
+
+func (d *arrowBatch) lists(c *arrowColumn, length int) ([]interface{}, error) {
+	offsets, err := d.offsets(c.typeID == arrowLargeList, length)
+	if err != nil {
+		return nil, err
+	}
+	child, err := d.column(c.child)
+	if err != nil {
+		return nil, err
+	}
+	if offsets[length] > len(child) {
+		return nil, errors.New("list has too few values")
+	}
+
+	values := make([]interface{}, length)
+	for i := range values {
+		values[i] = child[offsets[i]:offsets[i+1]]
+	}
+	return values, nil
+}
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArrowRoundTrip(t *testing.T) {
+	r, err := NewArrowReader(ArrowSchema(), nil)
+	require.Nil(t, err)
+
+	for i := 0; i < 2; i++ {
+		message, body, err := ArrowRecordBatch(testObjects())
+		require.Nil(t, err)
+		assert.Zero(t, len(body)%8)
+
+		batch, err := r.Batch(message, body)
+		require.Nil(t, err)
+		objects, errs := readAll(t, batch)
+		require.Empty(t, errs)
+		require.Len(t, objects, 2)
+		assert.Equal(t, 2*i+2, batch.Line())
+
+		assert.Equal(t, testObjects()[0].ID, objects[0].ID)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3}, []float32(objects[0].Vector))
+		assert.Equal(t, map[string]interface{}{"title": "hello"}, objects[0].Properties)
+		assert.Equal(t, testObjects()[1].ID, objects[1].ID)
+		assert.Nil(t, objects[1].Vector)
+		assert.Equal(t, map[string]interface{}{"title": "world"}, objects[1].Properties)
+	}
+
+	t.Run("empty batch", func(t *testing.T) {
+		message, body, err := ArrowRecordBatch(nil)
+		require.Nil(t, err)
+		batch, err := r.Batch(message, body)
+		require.Nil(t, err)
+		objects, errs := readAll(t, batch)
+		assert.Empty(t, objects)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("truncated body", func(t *testing.T) {
+		message, body, err := ArrowRecordBatch(testObjects())
+		require.Nil(t, err)
+		_, err = r.Batch(message, body[:8])
+		assert.ErrorContains(t, err, "buffer exceeds the body")
+	})
+
+	t.Run("not a schema", func(t *testing.T) {
+		message, _, err := ArrowRecordBatch(testObjects())
+		require.Nil(t, err)
+		_, err = NewArrowReader(message, nil)
+		assert.ErrorContains(t, err, "expected an arrow schema message")
+		_, err = NewArrowReader([]byte{1, 2, 3}, nil)
+		assert.ErrorContains(t, err, "invalid arrow message")
+	})
+}
+
+func TestArrowReaderColumns(t *testing.T) {
+	// property columns and a fixed size list of doubles as vector
+	schema := arrowMessage(arrowHeaderSchema, fbTable{int16(0), []fbTable{
+		arrowField("id", false, arrowUtf8, fbTable{}),
+		arrowField("count", true, arrowInt, fbTable{int32(32), true}),
+		arrowField("done", false, arrowBool, fbTable{}),
+		arrowField("vector", false, arrowFixedSizeList, fbTable{int32(2)},
+			arrowField("item", false, arrowFloatingPoint, fbTable{int16(arrowPrecisionDouble)})),
+	}}, 0)
+	r, err := NewArrowReader(schema, nil)
+	require.Nil(t, err)
+
+	body := &arrowBody{}
+	body.node(2, 0)
+	body.buffer(nil)
+	body.buffer([]byte{0, 0, 0, 0, 36, 0, 0, 0, 72, 0, 0, 0})
+	body.buffer([]byte("00000000-0000-0000-0000-00000000000100000000-0000-0000-0000-000000000002"))
+	body.node(2, 1)
+	body.buffer([]byte{1})
+	body.buffer([]byte{0xfe, 0xff, 0xff, 0xff, 0, 0, 0, 0})
+	body.node(2, 0)
+	body.buffer(nil)
+	body.buffer([]byte{2})
+	body.node(2, 0)
+	body.buffer(nil)
+	body.node(4, 0)
+	body.buffer(nil)
+	var doubles []byte
+	for _, v := range []float64{1, 2, 3, 4} {
+		doubles = binary.LittleEndian.AppendUint64(doubles, math.Float64bits(v))
+	}
+	body.buffer(doubles)
+	message := arrowMessage(arrowHeaderRecordBatch, fbTable{int64(2), body.nodes, body.buffers}, len(body.data))
+
+	batch, err := r.Batch(message, body.data)
+	require.Nil(t, err)
+	objects, errs := readAll(t, batch)
+	require.Empty(t, errs)
+	require.Len(t, objects, 2)
+	assert.Equal(t, map[string]interface{}{"count": float64(-2), "done": false}, objects[0].Properties)
+	assert.Equal(t, []float32{1, 2}, []float32(objects[0].Vector))
+	assert.Equal(t, map[string]interface{}{"done": true}, objects[1].Properties)
+	assert.Equal(t, []float32{3, 4}, []float32(objects[1].Vector))
+
+	t.Run("unsupported types", func(t *testing.T) {
+		schema := arrowMessage(arrowHeaderSchema, fbTable{int16(0), []fbTable{
+			arrowField("vector", false, arrowList, fbTable{},
+				arrowField("item", false, arrowList, fbTable{},
+					arrowField("item", false, arrowInt, fbTable{int32(8), true}))),
+		}}, 0)
+		_, err := NewArrowReader(schema, nil)
+		assert.ErrorContains(t, err, "nested lists are not supported")
+
+		schema = arrowMessage(arrowHeaderSchema, fbTable{int16(0), []fbTable{
+			arrowField("struct", false, 13, fbTable{}),
+		}}, 0)
+		_, err = NewArrowReader(schema, nil)
+		assert.ErrorContains(t, err, "arrow type 13 is not supported")
+	})
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package export encodes the objects of a class for bulk export and decodes
+// files of objects for bulk import, so that collections can be copied
+// between systems without paginating through the REST API.
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	FormatJSONL   = "jsonl"
+	FormatParquet = "parquet"
+	// FormatCSV can only be imported
+	FormatCSV = "csv"
+)
+
+// Writer encodes the objects of an export page by page
+type Writer interface {
+	Write(objects []*models.Object) error
+	// Close completes the export, it does not close the underlying writer
+	Close() error
+}
+
+// NewWriter returns a writer of the given format, the empty format is JSONL
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case "", FormatJSONL:
+		return newJSONLWriter(w), nil
+	case FormatParquet:
+		return newParquetWriter(w), nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q, use %q or %q",
+			format, FormatJSONL, FormatParquet)
+	}
+}
+
+// ContentType of the given format
+func ContentType(format string) string {
+	if format == FormatParquet {
+		return "application/vnd.apache.parquet"
+	}
+	return "application/x-ndjson"
+}
+
+// jsonlWriter writes each object as a line of JSON, an interrupted export
+// can be resumed after the last complete line
+type jsonlWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func newJSONLWriter(w io.Writer) *jsonlWriter {
+	buffered := bufio.NewWriter(w)
+	return &jsonlWriter{w: buffered, enc: json.NewEncoder(buffered)}
+}
+
+func (j *jsonlWriter) Write(objects []*models.Object) error {
+	for _, obj := range objects {
+		if err := j.enc.Encode(obj); err != nil {
+			return fmt.Errorf("encode object %s: %w", obj.ID, err)
+		}
+	}
+	return j.w.Flush()
+}
+
+func (j *jsonlWriter) Close() error {
+	return j.w.Flush()
+}
@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func testObjects() []*models.Object {
+	return []*models.Object{
+		{
+			ID:               "00000000-0000-0000-0000-000000000001",
+			Class:            "Article",
+			CreationTimeUnix: 1000,
+			Properties:       map[string]interface{}{"title": "hello"},
+			Vector:           []float32{0.1, 0.2, 0.3},
+		},
+		{
+			ID:                 "00000000-0000-0000-0000-000000000002",
+			Class:              "Article",
+			CreationTimeUnix:   2000,
+			LastUpdateTimeUnix: 3000,
+			Properties:         map[string]interface{}{"title": "world"},
+		},
+	}
+}
+
+func TestJSONLWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter("", &buf)
+	require.Nil(t, err)
+	require.Nil(t, w.Write(testObjects()[:1]))
+	require.Nil(t, w.Write(testObjects()[1:]))
+	require.Nil(t, w.Close())
+
+	var ids []string
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var obj models.Object
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &obj))
+		ids = append(ids, obj.ID.String())
+	}
+	assert.Equal(t, []string{
+		"00000000-0000-0000-0000-000000000001",
+		"00000000-0000-0000-0000-000000000002",
+	}, ids)
+}
+
+func TestNewWriterUnsupportedFormat(t *testing.T) {
+	_, err := NewWriter("csv", &bytes.Buffer{})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,281 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"encoding/binary"
+)
+
+// fbTable is a flatbuffers table by field id. Its values are scalars of
+// type bool, uint8, int16, int32 or int64, strings, tables, vectors of
+// tables or vectors of structs. Absent fields are nil.
+type fbTable []interface{}
+
+// fbStructs is a vector of structs of 8 byte aligned scalars
+type fbStructs struct {
+	n    int
+	data []byte
+}
+
+// fbBuilder writes flatbuffers front to back, tables are written before
+// the strings, tables and vectors they refer to, so that all offsets point
+// forward. Scalars are aligned to their size relative to the buffer start.
+type fbBuilder struct {
+	buf []byte
+}
+
+func fbBuild(root fbTable) []byte {
+	b := &fbBuilder{buf: make([]byte, 4, 256)}
+	pos := b.table(root)
+	binary.LittleEndian.PutUint32(b.buf, uint32(pos))
+	b.align(8)
+	return b.buf
+}
+
+func (b *fbBuilder) align(n int) {
+	for len(b.buf)%n != 0 {
+		b.buf = append(b.buf, 0)
+	}
+}
+
+func (b *fbBuilder) u16(v int) {
+	b.buf = binary.LittleEndian.AppendUint16(b.buf, uint16(v))
+}
+
+func (b *fbBuilder) u32(v int) {
+	b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(v))
+}
+
+func (b *fbBuilder) patch(pos, target int) {
+	binary.LittleEndian.PutUint32(b.buf[pos:], uint32(target-pos))
+}
+
+func fbSize(v interface{}) int {
+	switch v.(type) {
+	case nil:
+		return 0
+	case bool, uint8:
+		return 1
+	case int16:
+		return 2
+	case int64:
+		return 8
+	default:
+		// int32 and offsets of references
+		return 4
+	}
+}
+
+func (b *fbBuilder) table(t fbTable) int {
+	// the fields are ordered by size, so that they are aligned without
+	// padding between them
+	offsets := make([]int, len(t))
+	size := 4
+	for _, s := range []int{8, 4, 2, 1} {
+		for id, v := range t {
+			if v == nil || fbSize(v) != s {
+				continue
+			}
+			size = (size + s - 1) / s * s
+			offsets[id] = size
+			size += s
+		}
+	}
+
+	b.align(2)
+	vtable := len(b.buf)
+	b.u16(4 + 2*len(t))
+	b.u16(size)
+	for _, offset := range offsets {
+		b.u16(offset)
+	}
+
+	b.align(8)
+	pos := len(b.buf)
+	b.buf = append(b.buf, make([]byte, size)...)
+	binary.LittleEndian.PutUint32(b.buf[pos:], uint32(int32(pos-vtable)))
+	for id, v := range t {
+		field := b.buf[pos+offsets[id]:]
+		switch v := v.(type) {
+		case bool:
+			if v {
+				field[0] = 1
+			}
+		case uint8:
+			field[0] = v
+		case int16:
+			binary.LittleEndian.PutUint16(field, uint16(v))
+		case int32:
+			binary.LittleEndian.PutUint32(field, uint32(v))
+		case int64:
+			binary.LittleEndian.PutUint64(field, uint64(v))
+		}
+	}
+
+	for id, v := range t {
+		var child int
+		switch v := v.(type) {
+		case string:
+			child = b.string(v)
+		case fbTable:
+			child = b.table(v)
+		case []fbTable:
+			child = b.tables(v)
+		case fbStructs:
+			child = b.structs(v)
+		default:
+			continue
+		}
+		b.patch(pos+offsets[id], child)
+	}
+	return pos
+}
+
+func (b *fbBuilder) string(s string) int {
+	b.align(4)
+	pos := len(b.buf)
+	b.u32(len(s))
+	b.buf = append(b.buf, s...)
+	b.buf = append(b.buf, 0)
+	return pos
+}
+
+func (b *fbBuilder) tables(tables []fbTable) int {
+	b.align(4)
+	pos := len(b.buf)
+	b.u32(len(tables))
+	slots := len(b.buf)
+	b.buf = append(b.buf, make([]byte, 4*len(tables))...)
+	for i, t := range tables {
+		b.patch(slots+4*i, b.table(t))
+	}
+	return pos
+}
+
+func (b *fbBuilder) structs(s fbStructs) int {
+	// the elements following the length are 8 byte aligned
+	b.align(4)
+	if len(b.buf)%8 == 0 {
+		b.u32(0)
+	}
+	pos := len(b.buf)
+	b.u32(s.n)
+	b.buf = append(b.buf, s.data...)
+	return pos
+}
+
+// fbReader reads a table of a flatbuffer. Invalid buffers make it panic,
+// the panics are recovered by the decoders of messages.
+type fbReader struct {
+	buf []byte
+	pos int
+}
+
+func fbRoot(buf []byte) fbReader {
+	return fbReader{buf: buf, pos: int(binary.LittleEndian.Uint32(buf))}
+}
+
+// field returns the position of a field, or 0 if it is absent
+func (t fbReader) field(id int) int {
+	vtable := t.pos - int(int32(binary.LittleEndian.Uint32(t.buf[t.pos:])))
+	entry := 4 + 2*id
+	if entry >= int(binary.LittleEndian.Uint16(t.buf[vtable:])) {
+		return 0
+	}
+	offset := int(binary.LittleEndian.Uint16(t.buf[vtable+entry:]))
+	if offset == 0 {
+		return 0
+	}
+	return t.pos + offset
+}
+
+func (t fbReader) uint8(id int) uint8 {
+	if pos := t.field(id); pos != 0 {
+		return t.buf[pos]
+	}
+	return 0
+}
+
+func (t fbReader) bool(id int) bool {
+	return t.uint8(id) != 0
+}
+
+func (t fbReader) int16(id int) int16 {
+	if pos := t.field(id); pos != 0 {
+		return int16(binary.LittleEndian.Uint16(t.buf[pos:]))
+	}
+	return 0
+}
+
+func (t fbReader) int32(id int) int32 {
+	if pos := t.field(id); pos != 0 {
+		return int32(binary.LittleEndian.Uint32(t.buf[pos:]))
+	}
+	return 0
+}
+
+func (t fbReader) int64(id int) int64 {
+	if pos := t.field(id); pos != 0 {
+		return int64(binary.LittleEndian.Uint64(t.buf[pos:]))
+	}
+	return 0
+}
+
+// ref returns the position a reference field points to, or 0
+func (t fbReader) ref(id int) int {
+	pos := t.field(id)
+	if pos == 0 {
+		return 0
+	}
+	return pos + int(binary.LittleEndian.Uint32(t.buf[pos:]))
+}
+
+func (t fbReader) table(id int) (fbReader, bool) {
+	pos := t.ref(id)
+	return fbReader{buf: t.buf, pos: pos}, pos != 0
+}
+
+func (t fbReader) string(id int) string {
+	pos := t.ref(id)
+	if pos == 0 {
+		return ""
+	}
+	n := int(binary.LittleEndian.Uint32(t.buf[pos:]))
+	return string(t.buf[pos+4 : pos+4+n])
+}
+
+func (t fbReader) tables(id int) []fbReader {
+	pos := t.ref(id)
+	if pos == 0 {
+		return nil
+	}
+	n := int(binary.LittleEndian.Uint32(t.buf[pos:]))
+	if n > (len(t.buf)-pos)/4 {
+		panic("flatbuffer vector exceeds buffer")
+	}
+	tables := make([]fbReader, n)
+	for i := range tables {
+		slot := pos + 4 + 4*i
+		tables[i] = fbReader{buf: t.buf, pos: slot + int(binary.LittleEndian.Uint32(t.buf[slot:]))}
+	}
+	return tables
+}
+
+// structs returns the data of a vector of structs of the given size
+func (t fbReader) structs(id, size int) []byte {
+	pos := t.ref(id)
+	if pos == 0 {
+		return nil
+	}
+	n := int(binary.LittleEndian.Uint32(t.buf[pos:]))
+	return t.buf[pos+4 : pos+4+n*size]
+}
@@ -0,0 +1,370 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// maxLineSize bounds the size of a line of a JSONL file
+const maxLineSize = 64 * 1024 * 1024
+
+// Reader decodes the objects of an import file one by one
+type Reader interface {
+	// Next returns the next object, or io.EOF after the last one. Errors
+	// of single objects are wrapped in an ObjectError, the following
+	// objects can still be read.
+	Next() (*models.Object, error)
+	// Line of the object returned last
+	Line() int
+}
+
+// ObjectError is an object of a file which could not be decoded
+type ObjectError struct {
+	// Line of a JSONL or CSV file or row of a parquet file, counted from 1
+	Line int
+	Err  error
+}
+
+func (e *ObjectError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *ObjectError) Unwrap() error {
+	return e.Err
+}
+
+// FormatFromName derives the format of a file from its extension
+func FormatFromName(name string) (string, error) {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".jsonl", ".ndjson", ".json":
+		return FormatJSONL, nil
+	case ".parquet":
+		return FormatParquet, nil
+	case ".csv":
+		return FormatCSV, nil
+	default:
+		return "", fmt.Errorf("cannot derive the format of %q from its extension", name)
+	}
+}
+
+// NewReader returns a reader of a file of the given format. The files can
+// contain the columns of an export, or the properties of the class as top
+// level fields or columns, whose values are converted to the data types of
+// the class if they are not encoded in JSON.
+func NewReader(format string, r io.ReaderAt, size int64, class *models.Class) (Reader, error) {
+	switch format {
+	case FormatJSONL:
+		return newJSONLReader(io.NewSectionReader(r, 0, size)), nil
+	case FormatParquet:
+		p, err := newParquetReader(r, size)
+		if err != nil {
+			return nil, err
+		}
+		return &rowReader{class: class, next: p.next}, nil
+	case FormatCSV:
+		c, err := newCSVReader(io.NewSectionReader(r, 0, size))
+		if err != nil {
+			return nil, err
+		}
+		return &rowReader{class: class, next: c.next}, nil
+	default:
+		return nil, fmt.Errorf("unsupported import format %q, use %q, %q or %q",
+			format, FormatJSONL, FormatParquet, FormatCSV)
+	}
+}
+
+type jsonlReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func newJSONLReader(r io.Reader) *jsonlReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLineSize)
+	return &jsonlReader{scanner: scanner}
+}
+
+func (j *jsonlReader) Next() (*models.Object, error) {
+	for j.scanner.Scan() {
+		j.line++
+		line := j.scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+		var obj models.Object
+		if err := json.Unmarshal(line, &obj); err != nil {
+			return nil, &ObjectError{Line: j.line, Err: err}
+		}
+		return &obj, nil
+	}
+	if err := j.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+func (j *jsonlReader) Line() int {
+	return j.line
+}
+
+// csvReader reads the records of a CSV file with a header as rows of the
+// column names to the raw values, empty values are omitted
+type csvReader struct {
+	r      *csv.Reader
+	header []string
+}
+
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	c := csv.NewReader(r)
+	c.ReuseRecord = true
+	c.FieldsPerRecord = -1
+	header, err := c.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("csv file has no header")
+		}
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+	return &csvReader{r: c, header: append([]string(nil), header...)}, nil
+}
+
+func (c *csvReader) next() (map[string]interface{}, int, error) {
+	record, err := c.r.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, parseErr.StartLine, parseErr.Err
+		}
+		return nil, 0, err
+	}
+	line, _ := c.r.FieldPos(0)
+	if len(record) != len(c.header) {
+		return nil, line, fmt.Errorf("expected %d columns, got %d", len(c.header), len(record))
+	}
+
+	row := make(map[string]interface{}, len(record))
+	for i, value := range record {
+		if value != "" {
+			row[c.header[i]] = csvValue(value)
+		}
+	}
+	return row, line, nil
+}
+
+// SampleCSV returns the header and up to n records of a CSV file, so that
+// the schema of a class can be derived from them
+func SampleCSV(r io.Reader, n int) ([]string, [][]string, error) {
+	c, err := newCSVReader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.r.ReuseRecord = false
+
+	var records [][]string
+	for len(records) < n {
+		record, err := c.r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			// reported when the record is imported
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("read csv: %w", err)
+		}
+		records = append(records, record)
+	}
+	return c.header, records, nil
+}
+
+// csvValue marks the values of CSV files, which are converted according to
+// the data types of the class
+type csvValue string
+
+// rowReader converts the rows of parquet and CSV files to objects. The rows
+// are returned with their line, errors of rows with a line are errors of
+// single objects.
+type rowReader struct {
+	class *models.Class
+	next  func() (map[string]interface{}, int, error)
+	line  int
+}
+
+func (r *rowReader) Next() (*models.Object, error) {
+	row, line, err := r.next()
+	if line > 0 {
+		r.line = line
+	}
+	if err != nil {
+		if line > 0 {
+			return nil, &ObjectError{Line: line, Err: err}
+		}
+		return nil, err
+	}
+
+	obj, err := r.object(row)
+	if err != nil {
+		return nil, &ObjectError{Line: r.line, Err: err}
+	}
+	return obj, nil
+}
+
+func (r *rowReader) Line() int {
+	return r.line
+}
+
+func (r *rowReader) object(row map[string]interface{}) (*models.Object, error) {
+	obj := &models.Object{}
+	props := map[string]interface{}{}
+	for column, value := range row {
+		switch column {
+		case "id":
+			id, ok := rowString(value)
+			if !ok {
+				return nil, fmt.Errorf("id must be a string")
+			}
+			obj.ID = strfmt.UUID(id)
+		case "vector":
+			vector, err := rowVector(value)
+			if err != nil {
+				return nil, fmt.Errorf("vector: %w", err)
+			}
+			obj.Vector = vector
+		case "properties":
+			encoded, ok := rowString(value)
+			if !ok {
+				return nil, fmt.Errorf("properties must be a JSON object")
+			}
+			if err := json.Unmarshal([]byte(encoded), &props); err != nil {
+				return nil, fmt.Errorf("properties: %w", err)
+			}
+		case "class", "tenant", "creationTimeUnix", "lastUpdateTimeUnix":
+			// set by the import
+		default:
+			converted, err := r.property(column, value)
+			if err != nil {
+				return nil, fmt.Errorf("property %q: %w", column, err)
+			}
+			props[column] = converted
+		}
+	}
+	if len(props) > 0 {
+		obj.Properties = props
+	}
+	return obj, nil
+}
+
+func rowString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case csvValue:
+		return string(v), true
+	case string:
+		return v, true
+	default:
+		return "", false
+	}
+}
+
+func rowVector(value interface{}) ([]float32, error) {
+	var values []interface{}
+	switch v := value.(type) {
+	case csvValue:
+		if err := json.Unmarshal([]byte(v), &values); err != nil {
+			return nil, err
+		}
+	case []interface{}:
+		values = v
+	default:
+		return nil, fmt.Errorf("must be a list of numbers")
+	}
+
+	vector := make([]float32, len(values))
+	for i, value := range values {
+		switch v := value.(type) {
+		case float64:
+			vector[i] = float32(v)
+		case int64:
+			vector[i] = float32(v)
+		default:
+			return nil, fmt.Errorf("must be a list of numbers")
+		}
+	}
+	return vector, nil
+}
+
+// property converts the value of a column to the representation of
+// properties in JSON, so that it is validated like the properties of
+// objects of the REST API
+func (r *rowReader) property(name string, value interface{}) (interface{}, error) {
+	raw, ok := value.(csvValue)
+	if !ok {
+		return jsonValue(value), nil
+	}
+
+	var dataType schema.DataType
+	if r.class != nil {
+		for _, prop := range r.class.Properties {
+			if prop.Name == name && len(prop.DataType) > 0 {
+				dataType = schema.DataType(prop.DataType[0])
+			}
+		}
+	}
+
+	switch dataType {
+	case schema.DataTypeNumber, schema.DataTypeInt:
+		return strconv.ParseFloat(string(raw), 64)
+	case schema.DataTypeBoolean:
+		return strconv.ParseBool(string(raw))
+	case "", schema.DataTypeText, schema.DataTypeString, schema.DataTypeDate,
+		schema.DataTypeUUID, schema.DataTypeBlob:
+		return string(raw), nil
+	default:
+		// arrays, objects, geo coordinates, phone numbers and references
+		var v interface{}
+		if err := json.Unmarshal([]byte(raw), &v); err != nil {
+			return nil, fmt.Errorf("%s value must be encoded in JSON: %w", dataType, err)
+		}
+		return v, nil
+	}
+}
+
+// jsonValue converts the values of parquet columns to the types of decoded
+// JSON
+func jsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int64:
+		return float64(v)
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, elem := range v {
+			converted[i] = jsonValue(elem)
+		}
+		return converted
+	default:
+		return v
+	}
+}
@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func readAll(t *testing.T, r Reader) ([]*models.Object, []error) {
+	var (
+		objects []*models.Object
+		errs    []error
+	)
+	for {
+		obj, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return objects, errs
+		}
+		var objErr *ObjectError
+		if err != nil {
+			require.ErrorAs(t, err, &objErr)
+			errs = append(errs, err)
+			continue
+		}
+		objects = append(objects, obj)
+	}
+}
+
+func TestParquetRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(FormatParquet, &buf)
+	require.Nil(t, err)
+	require.Nil(t, w.Write(testObjects()))
+	require.Nil(t, w.Close())
+
+	r, err := NewReader(FormatParquet, bytes.NewReader(buf.Bytes()), int64(buf.Len()), nil)
+	require.Nil(t, err)
+	objects, errs := readAll(t, r)
+	require.Empty(t, errs)
+	require.Len(t, objects, 2)
+
+	assert.Equal(t, testObjects()[0].ID, objects[0].ID)
+	assert.Equal(t, []float32{0.1, 0.2, 0.3}, []float32(objects[0].Vector))
+	assert.Equal(t, map[string]interface{}{"title": "hello"}, objects[0].Properties)
+	assert.Equal(t, testObjects()[1].ID, objects[1].ID)
+	assert.Nil(t, objects[1].Vector)
+	assert.Equal(t, map[string]interface{}{"title": "world"}, objects[1].Properties)
+
+	t.Run("not a parquet file", func(t *testing.T) {
+		data := []byte(strings.Repeat("x", 20))
+		_, err := NewReader(FormatParquet, bytes.NewReader(data), int64(len(data)), nil)
+		assert.ErrorContains(t, err, "not a parquet file")
+	})
+}
+
+func TestJSONLReader(t *testing.T) {
+	data := `{"id":"00000000-0000-0000-0000-000000000001","properties":{"title":"hello"}}
+
+{"id":
+{"id":"00000000-0000-0000-0000-000000000002","vector":[0.5]}
+`
+	r, err := NewReader(FormatJSONL, strings.NewReader(data), int64(len(data)), nil)
+	require.Nil(t, err)
+	objects, errs := readAll(t, r)
+	require.Len(t, objects, 2)
+	assert.Equal(t, "hello", objects[0].Properties.(map[string]interface{})["title"])
+	assert.Equal(t, []float32{0.5}, []float32(objects[1].Vector))
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "line 3")
+}
+
+func TestCSVReader(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "wordCount", DataType: []string{"int"}},
+			{Name: "published", DataType: []string{"boolean"}},
+			{Name: "tags", DataType: []string{"text[]"}},
+		},
+	}
+	data := `id,title,wordCount,published,tags,vector,extra
+00000000-0000-0000-0000-000000000001,"hello, world",120,true,"[""a"",""b""]","[0.1,0.2]",x
+00000000-0000-0000-0000-000000000002,second,many,,,,
+00000000-0000-0000-0000-000000000003,third,,false,,,
+`
+	r, err := NewReader(FormatCSV, strings.NewReader(data), int64(len(data)), class)
+	require.Nil(t, err)
+	objects, errs := readAll(t, r)
+
+	require.Len(t, objects, 2)
+	assert.Equal(t, map[string]interface{}{
+		"title":     "hello, world",
+		"wordCount": float64(120),
+		"published": true,
+		"tags":      []interface{}{"a", "b"},
+		"extra":     "x",
+	}, objects[0].Properties)
+	assert.Equal(t, []float32{0.1, 0.2}, []float32(objects[0].Vector))
+	assert.Equal(t, map[string]interface{}{"title": "third", "published": false},
+		objects[1].Properties)
+
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "line 3")
+	assert.Contains(t, errs[0].Error(), "wordCount")
+}
+
+func TestFormatFromName(t *testing.T) {
+	for name, format := range map[string]string{
+		"a/b.jsonl": FormatJSONL, "b.NDJSON": FormatJSONL,
+		"c.parquet": FormatParquet, "d.csv": FormatCSV,
+	} {
+		got, err := FormatFromName(name)
+		require.Nil(t, err)
+		assert.Equal(t, format, got)
+	}
+	_, err := FormatFromName("e.txt")
+	assert.NotNil(t, err)
+}
+
+func TestParquetDecoding(t *testing.T) {
+	t.Run("bit packed levels", func(t *testing.T) {
+		// one group of eight 1-bit values followed by a run of three 1s
+		levels, err := readHybrid([]byte{3, 0b10110001, 6, 1}, 1, 11)
+		require.Nil(t, err)
+		assert.Equal(t, []uint32{1, 0, 0, 0, 1, 1, 0, 1, 1, 1, 1}, levels)
+	})
+
+	t.Run("dictionary", func(t *testing.T) {
+		values, err := dictionaryValues([]byte{1, 3, 0b10}, []interface{}{"a", "b"}, 3)
+		require.Nil(t, err)
+		assert.Equal(t, []interface{}{"a", "b", "a"}, values)
+	})
+
+	t.Run("snappy", func(t *testing.T) {
+		data, err := decompress(codecSnappy, snappy.Encode(nil, []byte("page")))
+		require.Nil(t, err)
+		assert.Equal(t, "page", string(data))
+	})
+}
+
+func TestSampleCSV(t *testing.T) {
+	data := "title,count\nhello,1\nbro\"ken,2\nworld,3\nlast,4\n"
+	header, rows, err := SampleCSV(strings.NewReader(data), 2)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"title", "count"}, header)
+	// malformed records are skipped
+	assert.Equal(t, [][]string{{"hello", "1"}, {"world", "3"}}, rows)
+
+	_, _, err = SampleCSV(strings.NewReader(""), 2)
+	assert.ErrorContains(t, err, "no header")
+}
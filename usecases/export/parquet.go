@@ -0,0 +1,350 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const parquetMagic = "PAR1"
+
+// rowGroupBytes is the size of the buffered columns at which a row group is
+// written, a row group is held in memory until then
+const rowGroupBytes = 64 * 1024 * 1024
+
+// enums of the parquet format
+const (
+	parquetBoolean   = 0
+	parquetInt64     = 2
+	parquetFloat     = 4
+	parquetByteArray = 6
+
+	parquetRequired = 0
+	parquetOptional = 1
+	parquetRepeated = 2
+
+	convertedUTF8 = 0
+	convertedList = 3
+	convertedJSON = 19
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	pageTypeData = 0
+)
+
+// parquetColumn buffers the values and levels of a leaf column of the
+// current row group. All values are PLAIN encoded and uncompressed.
+type parquetColumn struct {
+	path     []string
+	typ      int32
+	maxDef   uint8
+	maxRep   uint8
+	values   bytes.Buffer
+	defs     []uint8
+	reps     []uint8
+	numSlots int
+}
+
+func (c *parquetColumn) reset() {
+	c.values.Reset()
+	c.defs = c.defs[:0]
+	c.reps = c.reps[:0]
+	c.numSlots = 0
+}
+
+func (c *parquetColumn) slot(def, rep uint8) {
+	if c.maxDef > 0 {
+		c.defs = append(c.defs, def)
+	}
+	if c.maxRep > 0 {
+		c.reps = append(c.reps, rep)
+	}
+	c.numSlots++
+}
+
+func (c *parquetColumn) byteArray(v []byte) {
+	binary.Write(&c.values, binary.LittleEndian, uint32(len(v)))
+	c.values.Write(v)
+	c.slot(c.maxDef, 0)
+}
+
+func (c *parquetColumn) int64(v int64) {
+	binary.Write(&c.values, binary.LittleEndian, v)
+	c.slot(c.maxDef, 0)
+}
+
+func (c *parquetColumn) floatList(v []float32) {
+	switch {
+	case v == nil:
+		c.slot(0, 0)
+	case len(v) == 0:
+		c.slot(1, 0)
+	default:
+		for i, f := range v {
+			binary.Write(&c.values, binary.LittleEndian, math.Float32bits(f))
+			rep := uint8(1)
+			if i == 0 {
+				rep = 0
+			}
+			c.slot(c.maxDef, rep)
+		}
+	}
+}
+
+// page encodes the buffered column as a single data page
+func (c *parquetColumn) page() []byte {
+	var page bytes.Buffer
+	if c.maxRep > 0 {
+		writeLevels(&page, c.reps)
+	}
+	if c.maxDef > 0 {
+		writeLevels(&page, c.defs)
+	}
+	page.Write(c.values.Bytes())
+	return page.Bytes()
+}
+
+// writeLevels encodes levels as runs of the RLE/bit-packing hybrid, prefixed
+// by their length. All levels fit into a single byte, as no column is
+// nested deeper than a list.
+func writeLevels(w *bytes.Buffer, levels []uint8) {
+	var runs []byte
+	for i := 0; i < len(levels); {
+		j := i + 1
+		for j < len(levels) && levels[j] == levels[i] {
+			j++
+		}
+		runs = binary.AppendUvarint(runs, uint64(j-i)<<1)
+		runs = append(runs, levels[i])
+		i = j
+	}
+	binary.Write(w, binary.LittleEndian, uint32(len(runs)))
+	w.Write(runs)
+}
+
+// parquetWriter writes objects as a parquet file with the columns id,
+// creationTimeUnix, lastUpdateTimeUnix, properties as JSON and vector as a
+// list of floats. The rows are written in row groups, the file metadata is
+// written by Close.
+type parquetWriter struct {
+	w       io.Writer
+	offset  int64
+	columns []*parquetColumn
+	rows    int64
+	// groups are the encoded row groups written so far, which are repeated
+	// in the file metadata
+	groups    []rowGroupMeta
+	totalRows int64
+}
+
+type columnChunkMeta struct {
+	offset     int64
+	size       int64
+	numValues  int64
+	encodings  []int32
+	pathSchema []string
+	typ        int32
+}
+
+type rowGroupMeta struct {
+	columns []columnChunkMeta
+	size    int64
+	rows    int64
+}
+
+func newParquetWriter(w io.Writer) *parquetWriter {
+	return &parquetWriter{
+		w: w,
+		columns: []*parquetColumn{
+			{path: []string{"id"}, typ: parquetByteArray},
+			{path: []string{"creationTimeUnix"}, typ: parquetInt64},
+			{path: []string{"lastUpdateTimeUnix"}, typ: parquetInt64},
+			{path: []string{"properties"}, typ: parquetByteArray},
+			{path: []string{"vector", "list", "element"}, typ: parquetFloat, maxDef: 2, maxRep: 1},
+		},
+	}
+}
+
+func (p *parquetWriter) Write(objects []*models.Object) error {
+	for _, obj := range objects {
+		props, err := json.Marshal(obj.Properties)
+		if err != nil {
+			return fmt.Errorf("encode properties of %s: %w", obj.ID, err)
+		}
+
+		p.columns[0].byteArray([]byte(obj.ID))
+		p.columns[1].int64(obj.CreationTimeUnix)
+		p.columns[2].int64(obj.LastUpdateTimeUnix)
+		p.columns[3].byteArray(props)
+		p.columns[4].floatList(obj.Vector)
+		p.rows++
+	}
+
+	if p.buffered() >= rowGroupBytes {
+		return p.flush()
+	}
+	return nil
+}
+
+func (p *parquetWriter) buffered() int {
+	size := 0
+	for _, c := range p.columns {
+		size += c.values.Len() + len(c.defs) + len(c.reps)
+	}
+	return size
+}
+
+func (p *parquetWriter) write(b []byte) error {
+	n, err := p.w.Write(b)
+	p.offset += int64(n)
+	return err
+}
+
+func (p *parquetWriter) start() error {
+	if p.offset > 0 {
+		return nil
+	}
+	return p.write([]byte(parquetMagic))
+}
+
+// flush writes the buffered rows as a row group
+func (p *parquetWriter) flush() error {
+	if err := p.start(); err != nil {
+		return err
+	}
+	if p.rows == 0 {
+		return nil
+	}
+
+	group := rowGroupMeta{rows: p.rows}
+	for _, c := range p.columns {
+		page := c.page()
+		header := newThriftWriter()
+		header.i32(1, pageTypeData)
+		header.i32(2, int32(len(page)))
+		header.i32(3, int32(len(page)))
+		header.structField(5, func() {
+			header.i32(1, int32(c.numSlots))
+			header.i32(2, encodingPlain)
+			header.i32(3, encodingRLE)
+			header.i32(4, encodingRLE)
+		})
+
+		chunk := columnChunkMeta{
+			offset:     p.offset,
+			numValues:  int64(c.numSlots),
+			encodings:  []int32{encodingPlain, encodingRLE},
+			pathSchema: c.path,
+			typ:        c.typ,
+		}
+		if err := p.write(header.bytes()); err != nil {
+			return err
+		}
+		if err := p.write(page); err != nil {
+			return err
+		}
+		chunk.size = p.offset - chunk.offset
+		group.size += chunk.size
+		group.columns = append(group.columns, chunk)
+		c.reset()
+	}
+
+	p.groups = append(p.groups, group)
+	p.totalRows += p.rows
+	p.rows = 0
+	return nil
+}
+
+// Close writes the remaining rows and the file metadata, it does not close
+// the underlying writer
+func (p *parquetWriter) Close() error {
+	if err := p.flush(); err != nil {
+		return err
+	}
+
+	meta := newThriftWriter()
+	meta.i32(1, 1)
+	schema := p.schema()
+	meta.structList(2, len(schema), func(i int) { schema[i](meta) })
+	meta.i64(3, p.totalRows)
+	meta.structList(4, len(p.groups), func(i int) {
+		group := p.groups[i]
+		meta.structList(1, len(group.columns), func(j int) {
+			chunk := group.columns[j]
+			meta.i64(2, chunk.offset)
+			meta.structField(3, func() {
+				meta.i32(1, chunk.typ)
+				meta.i32List(2, chunk.encodings)
+				meta.stringList(3, chunk.pathSchema)
+				meta.i32(4, 0) // uncompressed
+				meta.i64(5, chunk.numValues)
+				meta.i64(6, chunk.size)
+				meta.i64(7, chunk.size)
+				meta.i64(9, chunk.offset)
+			})
+		})
+		meta.i64(2, group.size)
+		meta.i64(3, group.rows)
+	})
+	meta.string(6, "weaviate")
+
+	footer := meta.bytes()
+	if err := p.write(footer); err != nil {
+		return err
+	}
+	length := make([]byte, 4)
+	binary.LittleEndian.PutUint32(length, uint32(len(footer)))
+	if err := p.write(length); err != nil {
+		return err
+	}
+	return p.write([]byte(parquetMagic))
+}
+
+// schema are the schema elements of the file in depth-first order
+func (p *parquetWriter) schema() []func(*thriftWriter) {
+	element := func(name string, typ, repetition, converted, children int32) func(*thriftWriter) {
+		return func(t *thriftWriter) {
+			if typ >= 0 {
+				t.i32(1, typ)
+			}
+			if repetition >= 0 {
+				t.i32(3, repetition)
+			}
+			t.string(4, name)
+			if children > 0 {
+				t.i32(5, children)
+			}
+			if converted >= 0 {
+				t.i32(6, converted)
+			}
+		}
+	}
+
+	return []func(*thriftWriter){
+		element("schema", -1, -1, -1, 5),
+		element("id", parquetByteArray, parquetRequired, convertedUTF8, 0),
+		element("creationTimeUnix", parquetInt64, parquetRequired, -1, 0),
+		element("lastUpdateTimeUnix", parquetInt64, parquetRequired, -1, 0),
+		element("properties", parquetByteArray, parquetRequired, convertedJSON, 0),
+		element("vector", -1, parquetOptional, convertedList, 1),
+		element("list", -1, parquetRepeated, -1, 1),
+		element("element", parquetFloat, parquetRequired, -1, 0),
+	}
+}
@@ -0,0 +1,595 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"sync"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// enums of the parquet format which are only read
+const (
+	parquetInt32             = 1
+	parquetInt96             = 3
+	parquetDouble            = 5
+	parquetFixedLenByteArray = 7
+
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+	codecZstd         = 6
+
+	pageTypeDictionary = 2
+	pageTypeDataV2     = 3
+
+	encodingPlainDictionary = 2
+	encodingRLEDictionary   = 8
+)
+
+// maxChunkSize bounds the size of a column chunk which is read into memory
+const maxChunkSize = 1024 * 1024 * 1024
+
+var (
+	zstdDecoder     *zstd.Decoder
+	zstdDecoderOnce sync.Once
+)
+
+// parquetLeaf is a column of the file. Only columns of top level fields and
+// lists of primitive values are supported, values of lists are collected
+// into a slice per row.
+type parquetLeaf struct {
+	name       string
+	typ        int32
+	typeLength int
+	maxDef     uint8
+	maxRep     uint8
+	// listDef is the definition level of the repeated field of a list,
+	// entries defined below it are null or empty lists
+	listDef uint8
+}
+
+// parquetReader reads the rows of a parquet file row group by row group,
+// each row is a map of the top level field names to their values
+type parquetReader struct {
+	r      io.ReaderAt
+	leaves []parquetLeaf
+	groups []interface{}
+	group  int
+	rows   []map[string]interface{}
+	// row is the number of rows returned so far
+	row int
+}
+
+func newParquetReader(r io.ReaderAt, size int64) (*parquetReader, error) {
+	if size < 12 {
+		return nil, errors.New("parquet file is too small")
+	}
+	tail := make([]byte, 8)
+	if _, err := r.ReadAt(tail, size-8); err != nil {
+		return nil, fmt.Errorf("read parquet footer: %w", err)
+	}
+	if string(tail[4:]) != parquetMagic {
+		return nil, errors.New("not a parquet file")
+	}
+	footerLen := int64(binary.LittleEndian.Uint32(tail))
+	if footerLen > size-12 {
+		return nil, errors.New("invalid parquet footer length")
+	}
+
+	meta, err := newThriftReader(io.NewSectionReader(r, size-8-footerLen, footerLen)).readStruct()
+	if err != nil {
+		return nil, fmt.Errorf("read parquet metadata: %w", err)
+	}
+	leaves, err := parquetLeaves(meta.list(2))
+	if err != nil {
+		return nil, err
+	}
+	return &parquetReader{r: r, leaves: leaves, groups: meta.list(4)}, nil
+}
+
+// parquetLeaves walks the schema, which is flattened in depth-first order,
+// and returns the leaf columns with their levels
+func parquetLeaves(schema []interface{}) ([]parquetLeaf, error) {
+	if len(schema) == 0 {
+		return nil, errors.New("parquet file has no schema")
+	}
+
+	var (
+		leaves []parquetLeaf
+		walk   func(i int, depth int, name string, def, rep, listDef uint8) (int, error)
+	)
+	walk = func(i int, depth int, name string, def, rep, listDef uint8) (int, error) {
+		if i >= len(schema) {
+			return 0, errors.New("invalid parquet schema")
+		}
+		element, _ := schema[i].(thriftStructValue)
+		if depth == 1 {
+			name = element.string(4)
+		}
+		switch element.int(3) {
+		case parquetOptional:
+			def++
+		case parquetRepeated:
+			def++
+			rep++
+			listDef = def
+		}
+
+		children := int(element.int(5))
+		if children == 0 {
+			if rep > 1 {
+				return 0, fmt.Errorf("column %q: nested lists are not supported", name)
+			}
+			if depth > 1 && rep == 0 {
+				return 0, fmt.Errorf("column %q: nested structs are not supported", name)
+			}
+			leaves = append(leaves, parquetLeaf{
+				name:       name,
+				typ:        int32(element.int(1)),
+				typeLength: int(element.int(2)),
+				maxDef:     def,
+				maxRep:     rep,
+				listDef:    listDef,
+			})
+			return i + 1, nil
+		}
+
+		next := i + 1
+		for c := 0; c < children; c++ {
+			var err error
+			if next, err = walk(next, depth+1, name, def, rep, listDef); err != nil {
+				return 0, err
+			}
+		}
+		return next, nil
+	}
+
+	// the root is the message itself and is not counted as a level
+	root, _ := schema[0].(thriftStructValue)
+	next := 1
+	for c := 0; c < int(root.int(5)); c++ {
+		var err error
+		if next, err = walk(next, 1, "", 0, 0, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	seen := map[string]struct{}{}
+	for _, leaf := range leaves {
+		if _, ok := seen[leaf.name]; ok {
+			return nil, fmt.Errorf("column %q: nested structs are not supported", leaf.name)
+		}
+		seen[leaf.name] = struct{}{}
+	}
+	return leaves, nil
+}
+
+// next returns the next row and its number, or io.EOF after the last row
+func (p *parquetReader) next() (map[string]interface{}, int, error) {
+	for len(p.rows) == 0 {
+		if p.group >= len(p.groups) {
+			return nil, 0, io.EOF
+		}
+		group, _ := p.groups[p.group].(thriftStructValue)
+		p.group++
+		rows, err := p.readRowGroup(group)
+		if err != nil {
+			return nil, 0, fmt.Errorf("row group %d: %w", p.group-1, err)
+		}
+		p.rows = rows
+	}
+
+	row := p.rows[0]
+	p.rows = p.rows[1:]
+	p.row++
+	return row, p.row, nil
+}
+
+func (p *parquetReader) readRowGroup(group thriftStructValue) ([]map[string]interface{}, error) {
+	numRows := int(group.int(3))
+	chunks := group.list(1)
+	if len(chunks) != len(p.leaves) {
+		return nil, fmt.Errorf("expected %d columns, got %d", len(p.leaves), len(chunks))
+	}
+
+	rows := make([]map[string]interface{}, numRows)
+	for i := range rows {
+		rows[i] = map[string]interface{}{}
+	}
+	for i, leaf := range p.leaves {
+		chunk, _ := chunks[i].(thriftStructValue)
+		values, err := p.readColumn(leaf, chunk.structField(3), numRows)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", leaf.name, err)
+		}
+		for r, v := range values {
+			if v != nil {
+				rows[r][leaf.name] = v
+			}
+		}
+	}
+	return rows, nil
+}
+
+// readColumn returns the value of the column for each row of the row group,
+// which is nil for null values
+func (p *parquetReader) readColumn(leaf parquetLeaf, meta thriftStructValue,
+	numRows int,
+) ([]interface{}, error) {
+	start := meta.int(9)
+	if dict := meta.int(11); meta.has(11) && dict > 0 && dict < start {
+		start = dict
+	}
+	size := meta.int(7)
+	if size < 0 || size > maxChunkSize {
+		return nil, fmt.Errorf("invalid column chunk size %d", size)
+	}
+	data := make([]byte, size)
+	if _, err := p.r.ReadAt(data, start); err != nil {
+		return nil, fmt.Errorf("read column chunk: %w", err)
+	}
+
+	codec := meta.int(4)
+	numValues := int(meta.int(5))
+	var (
+		dict   []interface{}
+		defs   []uint8
+		reps   []uint8
+		values []interface{}
+		read   int
+		chunk  = bytes.NewReader(data)
+	)
+	for read < numValues {
+		header, err := newThriftReader(chunk).readStruct()
+		if err != nil {
+			return nil, fmt.Errorf("read page header: %w", err)
+		}
+		compressedSize := int(header.int(3))
+		if compressedSize < 0 || compressedSize > chunk.Len() {
+			return nil, errors.New("invalid page size")
+		}
+		page := make([]byte, compressedSize)
+		chunk.Read(page)
+
+		switch header.int(1) {
+		case pageTypeDictionary:
+			page, err = decompress(codec, page)
+			if err != nil {
+				return nil, err
+			}
+			dictHeader := header.structField(7)
+			dict, _, err = plainValues(leaf, page, int(dictHeader.int(1)))
+			if err != nil {
+				return nil, fmt.Errorf("dictionary: %w", err)
+			}
+		case pageTypeData, pageTypeDataV2:
+			pageDefs, pageReps, pageValues, err := dataPage(leaf, codec, header, page, dict)
+			if err != nil {
+				return nil, err
+			}
+			defs = append(defs, pageDefs...)
+			reps = append(reps, pageReps...)
+			values = append(values, pageValues...)
+			read += len(pageDefs)
+		default:
+			// index pages are skipped
+		}
+	}
+
+	return assembleRows(leaf, defs, reps, values, numRows)
+}
+
+// dataPage decodes the levels and values of a data page, the returned
+// levels contain one entry per value slot even for columns without levels
+func dataPage(leaf parquetLeaf, codec int64, header thriftStructValue, page []byte,
+	dict []interface{},
+) ([]uint8, []uint8, []interface{}, error) {
+	var (
+		numValues int
+		encoding  int64
+		defs      []uint8
+		reps      []uint8
+		err       error
+	)
+
+	if header.int(1) == pageTypeDataV2 {
+		v2 := header.structField(8)
+		numValues = int(v2.int(1))
+		encoding = v2.int(4)
+		defLen, repLen := int(v2.int(5)), int(v2.int(6))
+		if defLen < 0 || repLen < 0 || defLen+repLen > len(page) {
+			return nil, nil, nil, errors.New("invalid level lengths")
+		}
+		if reps, err = readLevels(page[:repLen], leaf.maxRep, numValues); err != nil {
+			return nil, nil, nil, fmt.Errorf("repetition levels: %w", err)
+		}
+		if defs, err = readLevels(page[repLen:repLen+defLen], leaf.maxDef, numValues); err != nil {
+			return nil, nil, nil, fmt.Errorf("definition levels: %w", err)
+		}
+		page = page[repLen+defLen:]
+		if v2.bool(7, true) {
+			if page, err = decompress(codec, page); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+	} else {
+		v1 := header.structField(5)
+		numValues = int(v1.int(1))
+		encoding = v1.int(2)
+		if page, err = decompress(codec, page); err != nil {
+			return nil, nil, nil, err
+		}
+		if leaf.maxRep > 0 {
+			if reps, page, err = readPrefixedLevels(page, leaf.maxRep, numValues); err != nil {
+				return nil, nil, nil, fmt.Errorf("repetition levels: %w", err)
+			}
+		}
+		if leaf.maxDef > 0 {
+			if defs, page, err = readPrefixedLevels(page, leaf.maxDef, numValues); err != nil {
+				return nil, nil, nil, fmt.Errorf("definition levels: %w", err)
+			}
+		}
+	}
+
+	if defs == nil {
+		defs = make([]uint8, numValues)
+	}
+	if reps == nil {
+		reps = make([]uint8, numValues)
+	}
+	nonNull := 0
+	for _, def := range defs {
+		if def == leaf.maxDef {
+			nonNull++
+		}
+	}
+
+	var values []interface{}
+	switch encoding {
+	case encodingPlain:
+		values, _, err = plainValues(leaf, page, nonNull)
+	case encodingPlainDictionary, encodingRLEDictionary:
+		values, err = dictionaryValues(page, dict, nonNull)
+	default:
+		err = fmt.Errorf("encoding %d is not supported", encoding)
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return defs, reps, values, nil
+}
+
+func assembleRows(leaf parquetLeaf, defs, reps []uint8, values []interface{},
+	numRows int,
+) ([]interface{}, error) {
+	rows := make([]interface{}, 0, numRows)
+	next := 0
+	for i, def := range defs {
+		var value interface{}
+		if def == leaf.maxDef {
+			if next >= len(values) {
+				return nil, errors.New("fewer values than definition levels")
+			}
+			value = values[next]
+			next++
+		}
+
+		if leaf.maxRep == 0 {
+			rows = append(rows, value)
+			continue
+		}
+
+		if reps[i] == 0 {
+			switch {
+			case def == 0 && leaf.listDef > 0:
+				rows = append(rows, nil)
+				continue
+			case def < leaf.listDef:
+				rows = append(rows, []interface{}{})
+				continue
+			default:
+				rows = append(rows, []interface{}{})
+			}
+		}
+		if len(rows) == 0 {
+			return nil, errors.New("list does not start with a new row")
+		}
+		list, _ := rows[len(rows)-1].([]interface{})
+		rows[len(rows)-1] = append(list, value)
+	}
+
+	if len(rows) != numRows {
+		return nil, fmt.Errorf("expected %d rows, got %d", numRows, len(rows))
+	}
+	return rows, nil
+}
+
+func decompress(codec int64, data []byte) ([]byte, error) {
+	switch codec {
+	case codecUncompressed:
+		return data, nil
+	case codecSnappy:
+		return snappy.Decode(nil, data)
+	case codecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return io.ReadAll(r)
+	case codecZstd:
+		zstdDecoderOnce.Do(func() {
+			zstdDecoder, _ = zstd.NewReader(nil)
+		})
+		return zstdDecoder.DecodeAll(data, nil)
+	default:
+		return nil, fmt.Errorf("compression codec %d is not supported", codec)
+	}
+}
+
+func readPrefixedLevels(data []byte, maxLevel uint8, n int) ([]uint8, []byte, error) {
+	if len(data) < 4 {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	length := int(binary.LittleEndian.Uint32(data))
+	if length > len(data)-4 {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	levels, err := readLevels(data[4:4+length], maxLevel, n)
+	return levels, data[4+length:], err
+}
+
+func readLevels(data []byte, maxLevel uint8, n int) ([]uint8, error) {
+	if maxLevel == 0 {
+		return nil, nil
+	}
+	decoded, err := readHybrid(data, bits.Len8(maxLevel), n)
+	if err != nil {
+		return nil, err
+	}
+	levels := make([]uint8, len(decoded))
+	for i, v := range decoded {
+		levels[i] = uint8(v)
+	}
+	return levels, nil
+}
+
+// readHybrid decodes n values of the RLE/bit-packing hybrid encoding
+func readHybrid(data []byte, bitWidth int, n int) ([]uint32, error) {
+	out := make([]uint32, 0, n)
+	byteWidth := (bitWidth + 7) / 8
+	r := bytes.NewReader(data)
+	for len(out) < n {
+		header, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if header&1 == 0 {
+			count := int(header >> 1)
+			var value uint32
+			for i := 0; i < byteWidth; i++ {
+				b, err := r.ReadByte()
+				if err != nil {
+					return nil, err
+				}
+				value |= uint32(b) << (8 * i)
+			}
+			for i := 0; i < count && len(out) < n; i++ {
+				out = append(out, value)
+			}
+			continue
+		}
+
+		groups := int(header >> 1)
+		packed := make([]byte, groups*bitWidth)
+		if _, err := io.ReadFull(r, packed); err != nil {
+			return nil, err
+		}
+		for i := 0; i < groups*8 && len(out) < n; i++ {
+			var value uint32
+			for b := 0; b < bitWidth; b++ {
+				bit := i*bitWidth + b
+				value |= uint32(packed[bit/8]>>(bit%8)&1) << b
+			}
+			out = append(out, value)
+		}
+	}
+	return out, nil
+}
+
+func dictionaryValues(data []byte, dict []interface{}, n int) ([]interface{}, error) {
+	if n == 0 {
+		return nil, nil
+	}
+	if len(data) == 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	indexes, err := readHybrid(data[1:], int(data[0]), n)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(indexes))
+	for i, index := range indexes {
+		if int(index) >= len(dict) {
+			return nil, errors.New("dictionary index out of range")
+		}
+		values[i] = dict[index]
+	}
+	return values, nil
+}
+
+// plainValues decodes n PLAIN encoded values. Integers are returned as
+// int64, floating point numbers as float64 and binaries as string.
+func plainValues(leaf parquetLeaf, data []byte, n int) ([]interface{}, []byte, error) {
+	values := make([]interface{}, 0, n)
+	if leaf.typ == parquetBoolean {
+		if len(data) < (n+7)/8 {
+			return nil, nil, io.ErrUnexpectedEOF
+		}
+		for i := 0; i < n; i++ {
+			values = append(values, data[i/8]>>(i%8)&1 == 1)
+		}
+		return values, data[(n+7)/8:], nil
+	}
+
+	for i := 0; i < n; i++ {
+		size := 0
+		switch leaf.typ {
+		case parquetInt32, parquetFloat:
+			size = 4
+		case parquetInt64, parquetDouble:
+			size = 8
+		case parquetByteArray:
+			if len(data) < 4 {
+				return nil, nil, io.ErrUnexpectedEOF
+			}
+			size = int(binary.LittleEndian.Uint32(data))
+			data = data[4:]
+		case parquetFixedLenByteArray:
+			size = leaf.typeLength
+		case parquetInt96:
+			return nil, nil, errors.New("INT96 values are not supported")
+		default:
+			return nil, nil, fmt.Errorf("type %d is not supported", leaf.typ)
+		}
+		if size < 0 || len(data) < size {
+			return nil, nil, io.ErrUnexpectedEOF
+		}
+
+		raw := data[:size]
+		data = data[size:]
+		switch leaf.typ {
+		case parquetInt32:
+			values = append(values, int64(int32(binary.LittleEndian.Uint32(raw))))
+		case parquetInt64:
+			values = append(values, int64(binary.LittleEndian.Uint64(raw)))
+		case parquetFloat:
+			values = append(values, float64(math.Float32frombits(binary.LittleEndian.Uint32(raw))))
+		case parquetDouble:
+			values = append(values, math.Float64frombits(binary.LittleEndian.Uint64(raw)))
+		default:
+			values = append(values, string(raw))
+		}
+	}
+	return values, data, nil
+}
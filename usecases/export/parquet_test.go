@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readThrift(t *testing.T, data []byte) (thriftStructValue, int) {
+	r := bytes.NewReader(data)
+	v, err := newThriftReader(r).readStruct()
+	require.Nil(t, err)
+	return v, len(data) - r.Len()
+}
+
+func TestParquetWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(FormatParquet, &buf)
+	require.Nil(t, err)
+	require.Nil(t, w.Write(testObjects()))
+	require.Nil(t, w.Close())
+
+	file := buf.Bytes()
+	require.Equal(t, parquetMagic, string(file[:4]))
+	require.Equal(t, parquetMagic, string(file[len(file)-4:]))
+	footerLen := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	footer := file[len(file)-8-footerLen : len(file)-8]
+
+	meta, _ := readThrift(t, footer)
+	assert.Equal(t, int64(2), meta.int(3))
+
+	schema := meta.list(2)
+	var names []string
+	for _, element := range schema {
+		names = append(names, element.(thriftStructValue).string(4))
+	}
+	assert.Equal(t, []string{
+		"schema", "id", "creationTimeUnix", "lastUpdateTimeUnix", "properties",
+		"vector", "list", "element",
+	}, names)
+
+	groups := meta.list(4)
+	require.Len(t, groups, 1)
+	columns := groups[0].(thriftStructValue).list(1)
+	require.Len(t, columns, 5)
+
+	// reads the page of a column chunk and returns its data after the page
+	// header
+	page := func(i int) (thriftStructValue, []byte) {
+		offset := columns[i].(thriftStructValue).structField(3).int(9)
+		header, start := readThrift(t, file[offset:])
+		size := int(header.int(3))
+		return header, file[offset+int64(start) : offset+int64(start+size)]
+	}
+
+	t.Run("id column", func(t *testing.T) {
+		header, data := page(0)
+		assert.Equal(t, int64(2), header.structField(5).int(1))
+		length := binary.LittleEndian.Uint32(data)
+		assert.Equal(t, "00000000-0000-0000-0000-000000000001", string(data[4:4+length]))
+	})
+
+	t.Run("vector column", func(t *testing.T) {
+		header, data := page(4)
+		// three values of the first vector and an empty slot of the second
+		assert.Equal(t, int64(4), header.structField(5).int(1))
+
+		repLen := binary.LittleEndian.Uint32(data)
+		// runs of one 0, two 1s and one 0
+		assert.Equal(t, []byte{2, 0, 4, 1, 2, 0}, data[4:4+repLen])
+		data = data[4+repLen:]
+		defLen := binary.LittleEndian.Uint32(data)
+		// runs of three 2s and one 0
+		assert.Equal(t, []byte{6, 2, 2, 0}, data[4:4+defLen])
+		data = data[4+defLen:]
+
+		require.Len(t, data, 12)
+		assert.Equal(t, float32(0.2), math.Float32frombits(binary.LittleEndian.Uint32(data[4:])))
+	})
+}
+
+func TestParquetWriterEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	w := newParquetWriter(&buf)
+	require.Nil(t, w.Close())
+
+	file := buf.Bytes()
+	footerLen := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	assert.Equal(t, len(file), 4+footerLen+8)
+	meta, _ := readThrift(t, file[4:4+footerLen])
+	assert.Equal(t, int64(0), meta.int(3))
+	assert.Empty(t, meta.list(4))
+}
@@ -0,0 +1,326 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// types of the thrift compact protocol
+const (
+	thriftTrue   = 1
+	thriftFalse  = 2
+	thriftByte   = 3
+	thriftI16    = 4
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftDouble = 7
+	thriftBinary = 8
+	thriftList   = 9
+	thriftSet    = 10
+	thriftMap    = 11
+	thriftStruct = 12
+)
+
+// maxThriftSize bounds the lengths read from the metadata, so that corrupt
+// files do not allocate unbounded memory
+const maxThriftSize = 64 * 1024 * 1024
+
+// thriftWriter encodes the parquet metadata with the thrift compact
+// protocol, so no thrift library is required. Only the types used by the
+// metadata are supported.
+type thriftWriter struct {
+	buf []byte
+	// last are the ids of the previous fields of the nested structs, field
+	// ids are encoded as deltas to them
+	last []int16
+}
+
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{last: []int16{0}}
+}
+
+// bytes ends the top level struct and returns the encoded bytes
+func (t *thriftWriter) bytes() []byte {
+	return append(t.buf, 0)
+}
+
+func (t *thriftWriter) field(id int16, typ byte) {
+	last := &t.last[len(t.last)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		t.buf = append(t.buf, byte(delta)<<4|typ)
+	} else {
+		t.buf = append(t.buf, typ)
+		t.varint(int64(id))
+	}
+	*last = id
+}
+
+func (t *thriftWriter) varint(v int64) {
+	t.buf = binary.AppendUvarint(t.buf, uint64((v<<1)^(v>>63)))
+}
+
+func (t *thriftWriter) i32(id int16, v int32) {
+	t.field(id, thriftI32)
+	t.varint(int64(v))
+}
+
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.field(id, thriftI64)
+	t.varint(v)
+}
+
+func (t *thriftWriter) string(id int16, v string) {
+	t.field(id, thriftBinary)
+	t.rawString(v)
+}
+
+func (t *thriftWriter) rawString(v string) {
+	t.buf = binary.AppendUvarint(t.buf, uint64(len(v)))
+	t.buf = append(t.buf, v...)
+}
+
+func (t *thriftWriter) listHeader(id int16, elemType byte, size int) {
+	t.field(id, thriftList)
+	if size < 15 {
+		t.buf = append(t.buf, byte(size)<<4|elemType)
+		return
+	}
+	t.buf = append(t.buf, 0xf0|elemType)
+	t.buf = binary.AppendUvarint(t.buf, uint64(size))
+}
+
+func (t *thriftWriter) i32List(id int16, values []int32) {
+	t.listHeader(id, thriftI32, len(values))
+	for _, v := range values {
+		t.varint(int64(v))
+	}
+}
+
+func (t *thriftWriter) stringList(id int16, values []string) {
+	t.listHeader(id, thriftBinary, len(values))
+	for _, v := range values {
+		t.rawString(v)
+	}
+}
+
+func (t *thriftWriter) structField(id int16, write func()) {
+	t.field(id, thriftStruct)
+	t.nested(write)
+}
+
+func (t *thriftWriter) structList(id int16, size int, write func(i int)) {
+	t.listHeader(id, thriftStruct, size)
+	for i := 0; i < size; i++ {
+		t.nested(func() { write(i) })
+	}
+}
+
+func (t *thriftWriter) nested(write func()) {
+	t.last = append(t.last, 0)
+	write()
+	t.buf = append(t.buf, 0)
+	t.last = t.last[:len(t.last)-1]
+}
+
+// thriftStructValue is a decoded struct by field id. Integers are decoded as
+// int64, binaries as string, lists and sets as []interface{} and maps as
+// map[interface{}]interface{}.
+type thriftStructValue map[int16]interface{}
+
+func (s thriftStructValue) int(id int16) int64 {
+	v, _ := s[id].(int64)
+	return v
+}
+
+func (s thriftStructValue) has(id int16) bool {
+	_, ok := s[id]
+	return ok
+}
+
+func (s thriftStructValue) string(id int16) string {
+	v, _ := s[id].(string)
+	return v
+}
+
+func (s thriftStructValue) bool(id int16, def bool) bool {
+	if v, ok := s[id].(bool); ok {
+		return v
+	}
+	return def
+}
+
+func (s thriftStructValue) structField(id int16) thriftStructValue {
+	v, _ := s[id].(thriftStructValue)
+	return v
+}
+
+func (s thriftStructValue) list(id int16) []interface{} {
+	v, _ := s[id].([]interface{})
+	return v
+}
+
+// thriftReader decodes structs of the thrift compact protocol without
+// knowing their definition
+type thriftReader struct {
+	r io.ByteReader
+}
+
+func newThriftReader(r io.Reader) *thriftReader {
+	if br, ok := r.(io.ByteReader); ok {
+		return &thriftReader{r: br}
+	}
+	return &thriftReader{r: bufio.NewReader(r)}
+}
+
+func (t *thriftReader) varint() (int64, error) {
+	v, err := binary.ReadUvarint(t.r)
+	if err != nil {
+		return 0, err
+	}
+	return int64(v>>1) ^ -int64(v&1), nil
+}
+
+func (t *thriftReader) size() (int, error) {
+	v, err := binary.ReadUvarint(t.r)
+	if err != nil {
+		return 0, err
+	}
+	if v > maxThriftSize {
+		return 0, fmt.Errorf("thrift size %d exceeds limit", v)
+	}
+	return int(v), nil
+}
+
+func (t *thriftReader) readStruct() (thriftStructValue, error) {
+	fields := thriftStructValue{}
+	last := int16(0)
+	for {
+		header, err := t.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if header == 0 {
+			return fields, nil
+		}
+
+		id := last + int16(header>>4)
+		if header>>4 == 0 {
+			v, err := t.varint()
+			if err != nil {
+				return nil, err
+			}
+			id = int16(v)
+		}
+		typ := header & 0x0f
+		switch typ {
+		case thriftTrue:
+			fields[id] = true
+		case thriftFalse:
+			fields[id] = false
+		default:
+			if fields[id], err = t.value(typ); err != nil {
+				return nil, err
+			}
+		}
+		last = id
+	}
+}
+
+func (t *thriftReader) value(typ byte) (interface{}, error) {
+	switch typ {
+	case thriftTrue, thriftFalse:
+		// booleans of lists are encoded as a single byte each
+		b, err := t.r.ReadByte()
+		return b == thriftTrue, err
+	case thriftByte:
+		b, err := t.r.ReadByte()
+		return int64(int8(b)), err
+	case thriftI16, thriftI32, thriftI64:
+		return t.varint()
+	case thriftDouble:
+		var b [8]byte
+		for i := range b {
+			var err error
+			if b[i], err = t.r.ReadByte(); err != nil {
+				return nil, err
+			}
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b[:])), nil
+	case thriftBinary:
+		size, err := t.size()
+		if err != nil {
+			return nil, err
+		}
+		b := make([]byte, size)
+		for i := range b {
+			if b[i], err = t.r.ReadByte(); err != nil {
+				return nil, err
+			}
+		}
+		return string(b), nil
+	case thriftList, thriftSet:
+		header, err := t.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		size := int(header >> 4)
+		if size == 15 {
+			if size, err = t.size(); err != nil {
+				return nil, err
+			}
+		}
+		list := make([]interface{}, 0, size)
+		for i := 0; i < size; i++ {
+			v, err := t.value(header & 0x0f)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+	case thriftMap:
+		size, err := t.size()
+		if err != nil || size == 0 {
+			return map[interface{}]interface{}{}, err
+		}
+		types, err := t.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[interface{}]interface{}, size)
+		for i := 0; i < size; i++ {
+			k, err := t.value(types >> 4)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := k.(thriftStructValue); ok {
+				return nil, errors.New("thrift map keys must not be structs")
+			}
+			v, err := t.value(types & 0x0f)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = v
+		}
+		return m, nil
+	case thriftStruct:
+		return t.readStruct()
+	default:
+		return nil, errors.New("invalid thrift type")
+	}
+}
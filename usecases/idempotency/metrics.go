@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package idempotency
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Metrics of the idempotency store
+type Metrics struct {
+	requests *prometheus.CounterVec
+	bytes    prometheus.Gauge
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		requests: prom.IdempotencyRequests,
+		bytes:    prom.IdempotencyStoredBytes,
+	}
+}
+
+func (m *Metrics) Request(result string) {
+	if m == nil {
+		return
+	}
+
+	m.requests.With(prometheus.Labels{"result": result}).Inc()
+}
+
+func (m *Metrics) Bytes(bytes int) {
+	if m == nil {
+		return
+	}
+
+	m.bytes.Set(float64(bytes))
+}
@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package idempotency deduplicates writes which clients retry, e.g. after a
+// timeout. The first request with an idempotency key is executed and its
+// response is kept for the configured window, retries with the same key
+// receive the kept response instead of writing the objects again. The
+// responses are kept in memory of the node which executed the request.
+package idempotency
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+var (
+	// ErrInProgress indicates that the first request with the key has not
+	// completed yet, the retry should be repeated later
+	ErrInProgress = errors.New("a request with this idempotency key is still in progress")
+
+	// ErrKeyReused indicates that the key was used before for a request with
+	// a different payload
+	ErrKeyReused = errors.New("idempotency key was already used for a different request")
+)
+
+// Response to a request which is returned again to its retries
+type Response struct {
+	Status      int
+	ContentType string
+	Body        []byte
+}
+
+func (r *Response) size() int {
+	return len(r.Body) + len(r.ContentType)
+}
+
+type entry struct {
+	key         string
+	fingerprint [32]byte
+	expires     time.Time
+	response    *Response
+}
+
+// Store keeps the responses to idempotency keys. A nil Store keeps nothing,
+// every request is executed.
+type Store struct {
+	window   time.Duration
+	maxBytes int
+	metrics  *Metrics
+	now      func() time.Time
+
+	sync.Mutex
+	entries map[string]*list.Element
+	order   list.List
+	bytes   int
+}
+
+// New creates a store with the window and size of the config, it returns nil
+// if idempotency keys are disabled
+func New(cfg config.Idempotency, metrics *Metrics) *Store {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	return &Store{
+		window:   cfg.Window,
+		maxBytes: cfg.MaxBytes,
+		metrics:  metrics,
+		now:      time.Now,
+		entries:  map[string]*list.Element{},
+	}
+}
+
+// Begin reserves the key for a request with the given fingerprint of its
+// payload. It returns the kept response if the key was completed before.
+// Otherwise the request must be executed and its response passed to the
+// returned func, passing nil releases the key so that a retry is executed
+// again.
+func (s *Store) Begin(key string, fingerprint [32]byte) (*Response, func(*Response), error) {
+	if s == nil {
+		return nil, func(*Response) {}, nil
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	now := s.now()
+	s.expire(now)
+
+	if elem, ok := s.entries[key]; ok {
+		e := elem.Value.(*entry)
+		switch {
+		case e.fingerprint != fingerprint:
+			s.metrics.Request("key_reused")
+			return nil, nil, ErrKeyReused
+		case e.response == nil:
+			s.metrics.Request("in_progress")
+			return nil, nil, ErrInProgress
+		default:
+			s.metrics.Request("replayed")
+			return e.response, nil, nil
+		}
+	}
+
+	e := &entry{key: key, fingerprint: fingerprint, expires: now.Add(s.window)}
+	s.entries[key] = s.order.PushBack(e)
+	s.metrics.Request("executed")
+	return nil, s.completeFunc(e), nil
+}
+
+func (s *Store) completeFunc(e *entry) func(*Response) {
+	var once sync.Once
+	return func(resp *Response) {
+		once.Do(func() {
+			s.Lock()
+			defer s.Unlock()
+			s.complete(e, resp)
+		})
+	}
+}
+
+// complete keeps the response of the entry, it must be called with the lock
+// held
+func (s *Store) complete(e *entry, resp *Response) {
+	elem, ok := s.entries[e.key]
+	if !ok || elem.Value.(*entry) != e {
+		// expired or dropped while the request was executed
+		return
+	}
+	if resp == nil || resp.size() > s.maxBytes {
+		s.remove(elem)
+		return
+	}
+
+	e.response = resp
+	s.bytes += resp.size()
+	for s.bytes > s.maxBytes {
+		s.dropOldest()
+	}
+	s.metrics.Bytes(s.bytes)
+}
+
+// expire removes the entries whose window has passed, it must be called with
+// the lock held
+func (s *Store) expire(now time.Time) {
+	for elem := s.order.Front(); elem != nil; elem = s.order.Front() {
+		if now.Before(elem.Value.(*entry).expires) {
+			break
+		}
+		s.remove(elem)
+	}
+	s.metrics.Bytes(s.bytes)
+}
+
+// dropOldest removes the oldest completed entry, it must be called with the
+// lock held
+func (s *Store) dropOldest() {
+	for elem := s.order.Front(); elem != nil; elem = elem.Next() {
+		if elem.Value.(*entry).response != nil {
+			s.remove(elem)
+			return
+		}
+	}
+}
+
+// remove must be called with the lock held
+func (s *Store) remove(elem *list.Element) {
+	e := s.order.Remove(elem).(*entry)
+	delete(s.entries, e.key)
+	if e.response != nil {
+		s.bytes -= e.response.size()
+	}
+}
@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package idempotency
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func newTestStore(maxBytes int) *Store {
+	return New(config.Idempotency{
+		Enabled:  true,
+		Window:   time.Hour,
+		MaxBytes: maxBytes,
+	}, nil)
+}
+
+func TestStore(t *testing.T) {
+	payload := sha256.Sum256([]byte(`{"class":"Article"}`))
+	other := sha256.Sum256([]byte(`{"class":"Product"}`))
+	created := &Response{Status: 200, ContentType: "application/json", Body: []byte(`{"id":"1"}`)}
+
+	t.Run("disabled", func(t *testing.T) {
+		s := New(config.Idempotency{}, nil)
+		require.Nil(t, s)
+		replay, complete, err := s.Begin("key", payload)
+		require.Nil(t, err)
+		assert.Nil(t, replay)
+		complete(created)
+	})
+
+	t.Run("retries receive the kept response", func(t *testing.T) {
+		s := newTestStore(1024)
+		replay, complete, err := s.Begin("key", payload)
+		require.Nil(t, err)
+		require.Nil(t, replay)
+
+		_, _, err = s.Begin("key", payload)
+		assert.ErrorIs(t, err, ErrInProgress)
+
+		complete(created)
+		replay, _, err = s.Begin("key", payload)
+		require.Nil(t, err)
+		assert.Equal(t, created, replay)
+
+		_, _, err = s.Begin("key", other)
+		assert.ErrorIs(t, err, ErrKeyReused)
+	})
+
+	t.Run("released keys are executed again", func(t *testing.T) {
+		s := newTestStore(1024)
+		_, complete, err := s.Begin("key", payload)
+		require.Nil(t, err)
+		complete(nil)
+
+		replay, _, err := s.Begin("key", payload)
+		require.Nil(t, err)
+		assert.Nil(t, replay)
+	})
+
+	t.Run("responses expire after the window", func(t *testing.T) {
+		s := newTestStore(1024)
+		now := time.Now()
+		s.now = func() time.Time { return now }
+
+		_, complete, err := s.Begin("key", payload)
+		require.Nil(t, err)
+		complete(created)
+
+		now = now.Add(time.Hour)
+		replay, _, err := s.Begin("key", other)
+		require.Nil(t, err)
+		assert.Nil(t, replay)
+	})
+
+	t.Run("oldest responses are dropped first", func(t *testing.T) {
+		s := newTestStore(2 * created.size())
+		for _, key := range []string{"a", "b", "c"} {
+			_, complete, err := s.Begin(key, payload)
+			require.Nil(t, err)
+			complete(created)
+		}
+
+		replay, _, err := s.Begin("a", payload)
+		require.Nil(t, err)
+		assert.Nil(t, replay)
+		for _, key := range []string{"b", "c"} {
+			replay, _, err := s.Begin(key, payload)
+			require.Nil(t, err)
+			assert.Equal(t, created, replay)
+		}
+	})
+
+	t.Run("responses larger than the store are not kept", func(t *testing.T) {
+		s := newTestStore(4)
+		_, complete, err := s.Begin("key", payload)
+		require.Nil(t, err)
+		complete(created)
+
+		replay, _, err := s.Begin("key", payload)
+		require.Nil(t, err)
+		assert.Nil(t, replay)
+		assert.Equal(t, 0, s.bytes)
+	})
+}
@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ingest
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Metrics of the ingest queue
+type Metrics struct {
+	pending prometheus.Gauge
+	lag     prometheus.Gauge
+	applied *prometheus.CounterVec
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		pending: prom.IngestQueuePendingObjects,
+		lag:     prom.IngestQueueLag,
+		applied: prom.IngestQueueAppliedObjects,
+	}
+}
+
+func (m *Metrics) Pending(objects int) {
+	if m == nil {
+		return
+	}
+
+	m.pending.Set(float64(objects))
+}
+
+func (m *Metrics) Lag(lag time.Duration) {
+	if m == nil {
+		return
+	}
+
+	m.lag.Set(lag.Seconds())
+}
+
+func (m *Metrics) Applied(succeeded, failed int) {
+	if m == nil {
+		return
+	}
+
+	m.applied.With(prometheus.Labels{"status": "success"}).Add(float64(succeeded))
+	m.applied.With(prometheus.Labels{"status": "failed"}).Add(float64(failed))
+}
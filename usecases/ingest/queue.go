@@ -0,0 +1,648 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package ingest decouples the throughput of batch imports from the speed at
+// which objects are indexed. Accepted batches are appended to a local queue
+// and synced to disk before they are acknowledged. They are applied to the
+// shards, i.e. stored and added to the inverted and vector indexes, in the
+// background in the order they were accepted. A batch is only dropped from
+// the queue once it was applied, so acknowledged batches survive a restart.
+// Applying a batch again after a crash is harmless, objects are written by
+// their id.
+package ingest
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const (
+	spoolCurrent   = "current.log"
+	spoolSuffix    = ".log"
+	checkpointFile = "applied"
+	retryInterval  = time.Second
+	maxBackoff     = time.Minute
+	// maxRetryAfter caps the retry hint of rejected batches
+	maxRetryAfter = time.Minute
+	// ewmaWeight of the latest batch in the observed apply rate
+	ewmaWeight = 0.2
+)
+
+// ErrQueueFull indicates that the objects of a batch would exceed the objects
+// allowed to be pending in the queue. Clients should retry after RetryAfter.
+type ErrQueueFull struct {
+	Pending    int
+	Requested  int
+	Limit      int
+	RetryAfter time.Duration
+}
+
+func (e ErrQueueFull) Error() string {
+	return fmt.Sprintf("batch of %d objects rejected, %d objects are pending in the ingest queue, "+
+		"limit %d: retry after %s", e.Requested, e.Pending, e.Limit, e.RetryAfter)
+}
+
+// Batch of objects which were accepted together
+type Batch struct {
+	Objects          []*models.Object
+	ConsistencyLevel string
+}
+
+// Applier writes a batch to the shards. It returns the number of objects
+// which could not be written, they are not retried. If an error is returned,
+// the whole batch is retried.
+type Applier func(ctx context.Context, batch Batch) (failed int, err error)
+
+// Status of the queue. Every accepted batch gets the next sequence number,
+// the batches up to AppliedSeq are visible to queries. Lag is the age of the
+// oldest batch which is not applied yet.
+type Status struct {
+	AcceptedSeq    uint64     `json:"acceptedSeq"`
+	AppliedSeq     uint64     `json:"appliedSeq"`
+	PendingBatches int        `json:"pendingBatches"`
+	PendingObjects int        `json:"pendingObjects"`
+	OldestPending  *time.Time `json:"oldestPending,omitempty"`
+	LagSeconds     float64    `json:"lagSeconds"`
+	AppliedObjects uint64     `json:"appliedObjects"`
+	FailedObjects  uint64     `json:"failedObjects"`
+	LastError      string     `json:"lastError,omitempty"`
+}
+
+// entry is a batch as it is spooled, one JSON document per line
+type entry struct {
+	Seq              uint64    `json:"seq"`
+	Time             time.Time `json:"time"`
+	ConsistencyLevel string    `json:"consistencyLevel,omitempty"`
+	Objects          []object  `json:"objects"`
+}
+
+// object in the binary format of the object store, which keeps the data
+// types of its properties. The tenant is not part of that format.
+type object struct {
+	Tenant string `json:"tenant,omitempty"`
+	Data   []byte `json:"data"`
+}
+
+type pendingBatch struct {
+	seq     uint64
+	time    time.Time
+	objects int
+}
+
+// Queue of accepted batches which are applied in the background. A nil Queue
+// is valid and reports an empty status.
+type Queue struct {
+	limit   int
+	dir     string
+	metrics *Metrics
+	logger  logrus.FieldLogger
+	now     func() time.Time
+
+	sync.Mutex
+	file           *os.File
+	size           int64
+	accepted       uint64
+	applied        uint64
+	pending        []pendingBatch
+	pendingObjects int
+	appliedObjects uint64
+	failedObjects  uint64
+	// rate of applied objects per second
+	rate    float64
+	lastErr error
+	started bool
+	closed  bool
+
+	apply  Applier
+	wake   chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New opens the queue spooled in dir. Batches left from a previous run are
+// applied once the queue is started.
+func New(cfg config.IngestQueue, dir string, metrics *Metrics,
+	logger logrus.FieldLogger,
+) (*Queue, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create ingest queue: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	q := &Queue{
+		limit:   cfg.MaxPendingObjects,
+		dir:     dir,
+		metrics: metrics,
+		logger:  logger.WithField("action", "ingest_queue"),
+		now:     time.Now,
+		wake:    make(chan struct{}, 1),
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	if err := q.load(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("load ingest queue: %w", err)
+	}
+	q.metrics.Pending(q.pendingObjects)
+	return q, nil
+}
+
+// Start applying the pending batches with apply, it must only be called once
+func (q *Queue) Start(apply Applier) {
+	q.Lock()
+	defer q.Unlock()
+
+	q.apply = apply
+	q.started = true
+	go q.run()
+}
+
+// Enqueue appends a batch to the queue and returns its sequence number. The
+// batch is synced to disk before Enqueue returns.
+func (q *Queue) Enqueue(batch Batch) (uint64, error) {
+	objects := make([]object, len(batch.Objects))
+	for i, obj := range batch.Objects {
+		data, err := storobj.FromObject(obj, obj.Vector).MarshalBinary()
+		if err != nil {
+			return 0, fmt.Errorf("marshal object %s: %w", obj.ID, err)
+		}
+		objects[i] = object{Tenant: obj.Tenant, Data: data}
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	if q.closed {
+		return 0, fmt.Errorf("ingest queue is shut down")
+	}
+	// a batch is always accepted by an empty queue, so that batches larger
+	// than the limit are not rejected forever
+	if q.pendingObjects > 0 && q.pendingObjects+len(objects) > q.limit {
+		return 0, ErrQueueFull{
+			Pending:    q.pendingObjects,
+			Requested:  len(objects),
+			Limit:      q.limit,
+			RetryAfter: q.retryAfter(q.pendingObjects + len(objects) - q.limit),
+		}
+	}
+
+	e := entry{
+		Seq:              q.accepted + 1,
+		Time:             q.now().UTC(),
+		ConsistencyLevel: batch.ConsistencyLevel,
+		Objects:          objects,
+	}
+	line, err := json.Marshal(e)
+	if err != nil {
+		return 0, err
+	}
+	if err := q.append(append(line, '\n')); err != nil {
+		return 0, fmt.Errorf("append to ingest queue: %w", err)
+	}
+
+	q.accepted = e.Seq
+	q.pending = append(q.pending, pendingBatch{seq: e.Seq, time: e.Time, objects: len(objects)})
+	q.pendingObjects += len(objects)
+	q.metrics.Pending(q.pendingObjects)
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return e.Seq, nil
+}
+
+// append writes a line to the current spool file and syncs it, it must be
+// called with the lock held
+func (q *Queue) append(line []byte) error {
+	if q.file == nil {
+		f, err := os.OpenFile(filepath.Join(q.dir, spoolCurrent),
+			os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return err
+		}
+		info, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return err
+		}
+		q.file, q.size = f, info.Size()
+	}
+
+	_, err := q.file.Write(line)
+	if err == nil {
+		err = q.file.Sync()
+	}
+	if err != nil {
+		// drop a partially written batch, it must not corrupt the next one
+		if truncErr := q.file.Truncate(q.size); truncErr != nil {
+			q.logger.WithError(truncErr).Error("could not truncate partially written batch")
+		}
+		return err
+	}
+	q.size += int64(len(line))
+	return nil
+}
+
+// retryAfter estimates when the given number of objects are applied, it must
+// be called with the lock held
+func (q *Queue) retryAfter(objects int) time.Duration {
+	if q.rate <= 0 {
+		return maxRetryAfter
+	}
+	d := time.Duration(float64(objects) / q.rate * float64(time.Second))
+	if d < retryInterval {
+		return retryInterval
+	}
+	if d > maxRetryAfter {
+		return maxRetryAfter
+	}
+	return d.Round(time.Second)
+}
+
+// Status of the queue, a nil queue reports an empty status
+func (q *Queue) Status() Status {
+	if q == nil {
+		return Status{}
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	s := Status{
+		AcceptedSeq:    q.accepted,
+		AppliedSeq:     q.applied,
+		PendingBatches: len(q.pending),
+		PendingObjects: q.pendingObjects,
+		AppliedObjects: q.appliedObjects,
+		FailedObjects:  q.failedObjects,
+		LagSeconds:     q.lag().Seconds(),
+	}
+	if len(q.pending) > 0 {
+		oldest := q.pending[0].time
+		s.OldestPending = &oldest
+	}
+	if q.lastErr != nil {
+		s.LastError = q.lastErr.Error()
+	}
+	return s
+}
+
+// lag is the age of the oldest pending batch, it must be called with the lock
+// held
+func (q *Queue) lag() time.Duration {
+	if len(q.pending) == 0 {
+		return 0
+	}
+	return q.now().Sub(q.pending[0].time)
+}
+
+// Shutdown stops applying batches, a batch which is applied at the time is
+// applied again after the next start
+func (q *Queue) Shutdown(ctx context.Context) error {
+	if q == nil {
+		return nil
+	}
+
+	q.Lock()
+	started := q.started
+	q.closed = true
+	q.Unlock()
+
+	q.cancel()
+	if started {
+		select {
+		case <-q.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	q.Lock()
+	defer q.Unlock()
+	if q.file != nil {
+		err := q.file.Close()
+		q.file = nil
+		return err
+	}
+	return nil
+}
+
+func (q *Queue) run() {
+	defer close(q.done)
+
+	ticker := time.NewTicker(retryInterval)
+	defer ticker.Stop()
+
+	var backoff time.Duration
+	var retryAt time.Time
+	for {
+		select {
+		case <-q.wake:
+		case <-ticker.C:
+		case <-q.ctx.Done():
+			return
+		}
+
+		q.Lock()
+		q.metrics.Lag(q.lag())
+		idle := len(q.pending) == 0
+		q.Unlock()
+		if idle || q.now().Before(retryAt) {
+			continue
+		}
+
+		if err := q.applyPending(); err != nil {
+			if q.ctx.Err() != nil {
+				return
+			}
+			backoff = nextBackoff(backoff)
+			retryAt = q.now().Add(backoff)
+			q.Lock()
+			q.lastErr = err
+			q.Unlock()
+			q.logger.WithField("retry_in", backoff).WithError(err).
+				Warn("could not apply queued batches")
+			continue
+		}
+		backoff, retryAt = 0, time.Time{}
+	}
+}
+
+// applyPending closes the current spool file and applies all spooled files in
+// the order they were written. A file is removed once all its batches are
+// applied.
+func (q *Queue) applyPending() error {
+	if err := q.rotate(); err != nil {
+		return fmt.Errorf("rotate spool: %w", err)
+	}
+
+	files, err := q.spoolFiles()
+	if err != nil {
+		return err
+	}
+	for _, name := range files {
+		path := filepath.Join(q.dir, name)
+		if err := q.readEntries(path, q.applyEntry); err != nil {
+			return err
+		}
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("remove applied spool file: %w", err)
+		}
+	}
+	return nil
+}
+
+// rotate renames the current spool file, so that new batches are spooled to a
+// new one
+func (q *Queue) rotate() error {
+	q.Lock()
+	defer q.Unlock()
+
+	if q.file == nil {
+		return nil
+	}
+	if err := q.file.Close(); err != nil {
+		return err
+	}
+	q.file = nil
+	return q.renameCurrent()
+}
+
+// renameCurrent names the current spool file by the sequence number of its
+// last batch, so that the names of spool files sort in the order they were
+// written. The time tells apart a file left from a previous run which only
+// holds an incomplete batch. It must be called with the lock held.
+func (q *Queue) renameCurrent() error {
+	name := fmt.Sprintf("%020d-%020d%s", q.accepted, time.Now().UnixNano(), spoolSuffix)
+	err := os.Rename(filepath.Join(q.dir, spoolCurrent), filepath.Join(q.dir, name))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// spoolFiles returns the names of the closed spool files in the order they
+// were written
+func (q *Queue) spoolFiles() ([]string, error) {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read spool: %w", err)
+	}
+	var files []string
+	for _, entry := range entries {
+		if name := entry.Name(); name != spoolCurrent && strings.HasSuffix(name, spoolSuffix) {
+			files = append(files, name)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func (q *Queue) readEntries(path string, fn func(entry) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open spool file: %w", err)
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(line) > 0 {
+				// the node crashed while the batch was written, so it was
+				// never acknowledged
+				q.logger.WithField("file", filepath.Base(path)).
+					Warn("skipping incomplete batch")
+			}
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read spool file: %w", err)
+		}
+
+		var e entry
+		if err := json.Unmarshal(line, &e); err != nil {
+			q.logger.WithField("file", filepath.Base(path)).WithError(err).
+				Warn("skipping unreadable batch")
+			continue
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+}
+
+func (q *Queue) applyEntry(e entry) error {
+	q.Lock()
+	applied := e.Seq <= q.applied
+	q.Unlock()
+	if applied {
+		return nil
+	}
+
+	batch := Batch{
+		ConsistencyLevel: e.ConsistencyLevel,
+		Objects:          make([]*models.Object, 0, len(e.Objects)),
+	}
+	failed := 0
+	for _, o := range e.Objects {
+		ko, err := storobj.FromBinary(o.Data)
+		if err != nil {
+			failed++
+			q.logger.WithField("seq", e.Seq).WithError(err).
+				Error("skipping unreadable object")
+			continue
+		}
+		obj := ko.Object
+		obj.Tenant = o.Tenant
+		if len(ko.Vector) > 0 {
+			obj.Vector = ko.Vector
+		}
+		batch.Objects = append(batch.Objects, &obj)
+	}
+
+	before := q.now()
+	if len(batch.Objects) > 0 {
+		n, err := q.apply(q.ctx, batch)
+		if err != nil {
+			return fmt.Errorf("apply batch %d: %w", e.Seq, err)
+		}
+		failed += n
+	}
+	q.markApplied(e.Seq, len(e.Objects), failed, q.now().Sub(before))
+
+	if err := q.writeCheckpoint(e.Seq); err != nil {
+		// the batches since the last checkpoint are applied again after a
+		// restart
+		q.logger.WithError(err).Warn("could not write checkpoint")
+	}
+	return nil
+}
+
+func (q *Queue) markApplied(seq uint64, objects, failed int, took time.Duration) {
+	q.Lock()
+	defer q.Unlock()
+
+	q.applied = seq
+	for len(q.pending) > 0 && q.pending[0].seq <= seq {
+		q.pendingObjects -= q.pending[0].objects
+		q.pending = q.pending[1:]
+	}
+	q.appliedObjects += uint64(objects - failed)
+	q.failedObjects += uint64(failed)
+	if took > 0 {
+		rate := float64(objects) / took.Seconds()
+		if q.rate == 0 {
+			q.rate = rate
+		} else {
+			q.rate = ewmaWeight*rate + (1-ewmaWeight)*q.rate
+		}
+	}
+	q.lastErr = nil
+
+	q.metrics.Pending(q.pendingObjects)
+	q.metrics.Applied(objects-failed, failed)
+}
+
+// writeCheckpoint records the sequence number of the last applied batch, it
+// is replaced atomically
+func (q *Queue) writeCheckpoint(seq uint64) error {
+	tmp := filepath.Join(q.dir, checkpointFile+".tmp")
+	if err := os.WriteFile(tmp, []byte(strconv.FormatUint(seq, 10)), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(q.dir, checkpointFile))
+}
+
+func (q *Queue) readCheckpoint() (uint64, error) {
+	data, err := os.ReadFile(filepath.Join(q.dir, checkpointFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	seq, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		// all spooled batches are applied again
+		q.logger.WithError(err).Warn("ignoring unreadable checkpoint")
+		return 0, nil
+	}
+	return seq, nil
+}
+
+// load restores the pending batches from the spool. The current spool file
+// of the previous run is closed, new batches are spooled to a new one.
+func (q *Queue) load() error {
+	applied, err := q.readCheckpoint()
+	if err != nil {
+		return err
+	}
+	q.applied, q.accepted = applied, applied
+
+	files, err := q.spoolFiles()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(q.dir, spoolCurrent)); err == nil {
+		files = append(files, spoolCurrent)
+	}
+
+	for _, name := range files {
+		err := q.readEntries(filepath.Join(q.dir, name), func(e entry) error {
+			if e.Seq > q.accepted {
+				q.accepted = e.Seq
+			}
+			if e.Seq > q.applied {
+				q.pending = append(q.pending, pendingBatch{
+					seq: e.Seq, time: e.Time, objects: len(e.Objects),
+				})
+				q.pendingObjects += len(e.Objects)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return q.renameCurrent()
+}
+
+func nextBackoff(backoff time.Duration) time.Duration {
+	switch {
+	case backoff == 0:
+		return retryInterval
+	case 2*backoff > maxBackoff:
+		return maxBackoff
+	default:
+		return 2 * backoff
+	}
+}
@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ingest
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type memoryApplier struct {
+	sync.Mutex
+	batches []Batch
+	failing bool
+}
+
+func (a *memoryApplier) apply(ctx context.Context, batch Batch) (int, error) {
+	a.Lock()
+	defer a.Unlock()
+	if a.failing {
+		return 0, errors.New("unavailable")
+	}
+	a.batches = append(a.batches, batch)
+	return 0, nil
+}
+
+func (a *memoryApplier) applied() []Batch {
+	a.Lock()
+	defer a.Unlock()
+	return append([]Batch(nil), a.batches...)
+}
+
+func (a *memoryApplier) setFailing(failing bool) {
+	a.Lock()
+	defer a.Unlock()
+	a.failing = failing
+}
+
+func newTestQueue(t *testing.T, dir string, limit int) *Queue {
+	logger, _ := test.NewNullLogger()
+	q, err := New(config.IngestQueue{Enabled: true, MaxPendingObjects: limit}, dir, nil, logger)
+	require.Nil(t, err)
+	t.Cleanup(func() { q.Shutdown(context.Background()) })
+	return q
+}
+
+func testObjects(ids ...string) []*models.Object {
+	objects := make([]*models.Object, len(ids))
+	for i, id := range ids {
+		objects[i] = &models.Object{
+			ID:     strfmt.UUID(id),
+			Class:  "Article",
+			Tenant: "t1",
+			Properties: map[string]interface{}{
+				"title": "article " + id,
+			},
+			Vector: []float32{1, 2, 3},
+		}
+	}
+	return objects
+}
+
+const (
+	id1 = "8d5a3aa2-3c8d-4589-9ae1-3f638f506001"
+	id2 = "8d5a3aa2-3c8d-4589-9ae1-3f638f506002"
+	id3 = "8d5a3aa2-3c8d-4589-9ae1-3f638f506003"
+)
+
+func TestQueue(t *testing.T) {
+	t.Run("applies batches in order", func(t *testing.T) {
+		q := newTestQueue(t, t.TempDir(), 100)
+
+		seq, err := q.Enqueue(Batch{Objects: testObjects(id1, id2), ConsistencyLevel: "QUORUM"})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), seq)
+		seq, err = q.Enqueue(Batch{Objects: testObjects(id3)})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2), seq)
+
+		status := q.Status()
+		assert.Equal(t, uint64(2), status.AcceptedSeq)
+		assert.Equal(t, uint64(0), status.AppliedSeq)
+		assert.Equal(t, 2, status.PendingBatches)
+		assert.Equal(t, 3, status.PendingObjects)
+		assert.NotNil(t, status.OldestPending)
+
+		applier := &memoryApplier{}
+		q.Start(applier.apply)
+		require.Eventually(t, func() bool {
+			return q.Status().AppliedSeq == 2
+		}, 5*time.Second, 10*time.Millisecond)
+
+		batches := applier.applied()
+		require.Len(t, batches, 2)
+		assert.Equal(t, "QUORUM", batches[0].ConsistencyLevel)
+		require.Len(t, batches[0].Objects, 2)
+		obj := batches[0].Objects[0]
+		assert.Equal(t, strfmt.UUID(id1), obj.ID)
+		assert.Equal(t, "Article", obj.Class)
+		assert.Equal(t, "t1", obj.Tenant)
+		assert.Equal(t, "article "+id1, obj.Properties.(map[string]interface{})["title"])
+		assert.Equal(t, []float32{1, 2, 3}, []float32(obj.Vector))
+		assert.Equal(t, strfmt.UUID(id3), batches[1].Objects[0].ID)
+
+		status = q.Status()
+		assert.Equal(t, 0, status.PendingBatches)
+		assert.Equal(t, 0, status.PendingObjects)
+		assert.Equal(t, uint64(3), status.AppliedObjects)
+		assert.Nil(t, status.OldestPending)
+		assert.Zero(t, status.LagSeconds)
+
+		files, err := q.spoolFiles()
+		require.Nil(t, err)
+		assert.Empty(t, files)
+	})
+
+	t.Run("retries failed batches", func(t *testing.T) {
+		q := newTestQueue(t, t.TempDir(), 100)
+		applier := &memoryApplier{failing: true}
+		q.Start(applier.apply)
+
+		_, err := q.Enqueue(Batch{Objects: testObjects(id1)})
+		require.Nil(t, err)
+		require.Eventually(t, func() bool {
+			return q.Status().LastError != ""
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 1, q.Status().PendingObjects)
+
+		applier.setFailing(false)
+		require.Eventually(t, func() bool {
+			return q.Status().AppliedSeq == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Empty(t, q.Status().LastError)
+		assert.Len(t, applier.applied(), 1)
+	})
+
+	t.Run("rejects batches exceeding the pending objects", func(t *testing.T) {
+		q := newTestQueue(t, t.TempDir(), 2)
+
+		// an empty queue accepts batches larger than the limit
+		_, err := q.Enqueue(Batch{Objects: testObjects(id1, id2, id3)})
+		require.Nil(t, err)
+
+		_, err = q.Enqueue(Batch{Objects: testObjects(id1)})
+		var full ErrQueueFull
+		require.ErrorAs(t, err, &full)
+		assert.Equal(t, 3, full.Pending)
+		assert.Equal(t, 1, full.Requested)
+		assert.Equal(t, 2, full.Limit)
+		assert.Equal(t, maxRetryAfter, full.RetryAfter)
+	})
+
+	t.Run("restores pending batches after a restart", func(t *testing.T) {
+		dir := t.TempDir()
+		q := newTestQueue(t, dir, 100)
+		applier := &memoryApplier{}
+		q.Start(applier.apply)
+		_, err := q.Enqueue(Batch{Objects: testObjects(id1)})
+		require.Nil(t, err)
+		require.Eventually(t, func() bool {
+			return q.Status().AppliedSeq == 1
+		}, 5*time.Second, 10*time.Millisecond)
+
+		applier.setFailing(true)
+		_, err = q.Enqueue(Batch{Objects: testObjects(id2)})
+		require.Nil(t, err)
+		_, err = q.Enqueue(Batch{Objects: testObjects(id3)})
+		require.Nil(t, err)
+		require.Nil(t, q.Shutdown(context.Background()))
+
+		q = newTestQueue(t, dir, 100)
+		status := q.Status()
+		assert.Equal(t, uint64(3), status.AcceptedSeq)
+		assert.Equal(t, uint64(1), status.AppliedSeq)
+		assert.Equal(t, 2, status.PendingObjects)
+
+		// sequence numbers continue where the previous run stopped
+		seq, err := q.Enqueue(Batch{Objects: testObjects(id1)})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(4), seq)
+
+		restarted := &memoryApplier{}
+		q.Start(restarted.apply)
+		require.Eventually(t, func() bool {
+			return q.Status().AppliedSeq == 4
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// the batch applied before the restart is not applied again
+		batches := restarted.applied()
+		require.Len(t, batches, 3)
+		assert.Equal(t, strfmt.UUID(id2), batches[0].Objects[0].ID)
+		assert.Equal(t, strfmt.UUID(id3), batches[1].Objects[0].ID)
+		assert.Equal(t, strfmt.UUID(id1), batches[2].Objects[0].ID)
+	})
+
+	t.Run("skips an incomplete batch", func(t *testing.T) {
+		dir := t.TempDir()
+		q := newTestQueue(t, dir, 100)
+		_, err := q.Enqueue(Batch{Objects: testObjects(id1)})
+		require.Nil(t, err)
+		require.Nil(t, q.Shutdown(context.Background()))
+
+		f, err := os.OpenFile(filepath.Join(dir, spoolCurrent), os.O_WRONLY|os.O_APPEND, 0o644)
+		require.Nil(t, err)
+		_, err = f.WriteString(`{"seq":2,"objects":[{"data":"AQ`)
+		require.Nil(t, err)
+		require.Nil(t, f.Close())
+
+		q = newTestQueue(t, dir, 100)
+		assert.Equal(t, uint64(1), q.Status().AcceptedSeq)
+		assert.Equal(t, 1, q.Status().PendingObjects)
+
+		applier := &memoryApplier{}
+		q.Start(applier.apply)
+		require.Eventually(t, func() bool {
+			return q.Status().AppliedSeq == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Len(t, applier.applied(), 1)
+	})
+
+	t.Run("nil queue", func(t *testing.T) {
+		var q *Queue
+		assert.Equal(t, Status{}, q.Status())
+		assert.Nil(t, q.Shutdown(context.Background()))
+	})
+}
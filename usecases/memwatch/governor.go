@@ -0,0 +1,263 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package memwatch
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"os"
+	"runtime/debug"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ErrMemoryPressure is returned for imports which are rejected, because the
+// memory usage of the node is close to its limit
+var ErrMemoryPressure = errors.New("node is under memory pressure, retry the import later or on another node")
+
+// Pressure is the level of memory pressure, each level sheds the load of the
+// lower levels as well
+type Pressure int32
+
+const (
+	PressureNone Pressure = iota
+	// PressureShrinkCaches shrinks the vector caches
+	PressureShrinkCaches
+	// PressurePauseMaintenance pauses background compactions and tombstone
+	// cleanups
+	PressurePauseMaintenance
+	// PressureRejectImports rejects new imports
+	PressureRejectImports
+)
+
+func (p Pressure) String() string {
+	switch p {
+	case PressureNone:
+		return "none"
+	case PressureShrinkCaches:
+		return "shrink_caches"
+	case PressurePauseMaintenance:
+		return "pause_maintenance"
+	case PressureRejectImports:
+		return "reject_imports"
+	default:
+		return "unknown"
+	}
+}
+
+// Shedder sheds load when the memory pressure rises and takes it on again
+// when the pressure eases. It is called with each new pressure.
+type Shedder interface {
+	ShedLoad(pressure Pressure)
+}
+
+// Governor watches the heap and the resident set size against their limits
+// and progressively sheds load before the process runs out of memory
+type Governor struct {
+	config      GovernorConfig
+	heapReader  metricsReader
+	rssReader   metricsReader
+	limitSetter limitSetter
+	logger      logrus.FieldLogger
+
+	pressure atomic.Int32
+
+	mu       sync.Mutex
+	shedders []Shedder
+	shutdown chan struct{}
+	done     chan struct{}
+}
+
+// NewGovernor creates a [Governor] which reads the live heap, the resident
+// set size and GOMEMLIMIT of the process. It returns nil if the governor is
+// disabled, all methods can be called on a nil governor.
+func NewGovernor(config GovernorConfig, logger logrus.FieldLogger) *Governor {
+	if !config.Enabled {
+		return nil
+	}
+
+	return newGovernor(config, LiveHeapReader, RSSReader, debug.SetMemoryLimit, logger)
+}
+
+func newGovernor(config GovernorConfig, heapReader, rssReader metricsReader,
+	limitSetter limitSetter, logger logrus.FieldLogger,
+) *Governor {
+	return &Governor{
+		config:      config,
+		heapReader:  heapReader,
+		rssReader:   rssReader,
+		limitSetter: limitSetter,
+		logger:      logger.WithField("action", "memory_pressure"),
+	}
+}
+
+// Register a shedder, it is called with the current pressure immediately if
+// the node is under pressure already
+func (g *Governor) Register(s Shedder) {
+	if g == nil {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.shedders = append(g.shedders, s)
+	if p := g.Pressure(); p != PressureNone {
+		s.ShedLoad(p)
+	}
+}
+
+// Pressure is the current memory pressure, it is cheap enough to be checked
+// on every request
+func (g *Governor) Pressure() Pressure {
+	if g == nil {
+		return PressureNone
+	}
+	return Pressure(g.pressure.Load())
+}
+
+// RejectImports is true while new imports are rejected
+func (g *Governor) RejectImports() bool {
+	return g.Pressure() >= PressureRejectImports
+}
+
+// Start checking the memory usage every interval until the governor is
+// closed
+func (g *Governor) Start() {
+	if g == nil {
+		return
+	}
+
+	g.shutdown = make(chan struct{})
+	g.done = make(chan struct{})
+	go func() {
+		defer close(g.done)
+		t := time.NewTicker(g.config.Interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-g.shutdown:
+				return
+			case <-t.C:
+				g.Check()
+			}
+		}
+	}()
+}
+
+func (g *Governor) Close() error {
+	if g == nil || g.shutdown == nil {
+		return nil
+	}
+
+	close(g.shutdown)
+	<-g.done
+	return nil
+}
+
+// Check reads the memory usage and notifies the shedders if the pressure
+// changed
+func (g *Governor) Check() {
+	if g == nil {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	usage := g.usagePercentage()
+	current := g.Pressure()
+	next := g.nextPressure(current, usage)
+	if next == current {
+		return
+	}
+
+	g.pressure.Store(int32(next))
+	logger := g.logger.WithField("usage_percentage", math.Round(usage*100)/100).
+		WithField("pressure", next.String())
+	if next > current {
+		logger.Warnf("memory pressure rose from %s, shedding load", current)
+	} else {
+		logger.Infof("memory pressure eased from %s, taking on load again", current)
+	}
+	for _, s := range g.shedders {
+		s.ShedLoad(next)
+	}
+}
+
+// usagePercentage is the highest usage of the heap and the resident set
+// size in percent of their limits
+func (g *Governor) usagePercentage() float64 {
+	heapLimit := g.config.MaxHeapBytes
+	if heapLimit == 0 {
+		// setting a negative limit is the only way to obtain the current limit
+		heapLimit = g.limitSetter(-1)
+	}
+	usage := percentage(g.heapReader(), heapLimit)
+	if g.config.MaxRSSBytes > 0 {
+		usage = math.Max(usage, percentage(g.rssReader(), g.config.MaxRSSBytes))
+	}
+	return usage
+}
+
+func percentage(used, limit int64) float64 {
+	if limit <= 0 {
+		return 0
+	}
+	return float64(used) / float64(limit) * 100
+}
+
+// nextPressure rises to the highest threshold the usage reached, but eases
+// only once the usage dropped below the threshold by the hysteresis
+func (g *Governor) nextPressure(current Pressure, usage float64) Pressure {
+	thresholds := []int{
+		PressureShrinkCaches:     g.config.ShrinkCachesPercentage,
+		PressurePauseMaintenance: g.config.PauseMaintenancePercentage,
+		PressureRejectImports:    g.config.RejectImportsPercentage,
+	}
+
+	next := PressureNone
+	for p := PressureShrinkCaches; p <= PressureRejectImports; p++ {
+		threshold := float64(thresholds[p])
+		if p <= current {
+			threshold -= float64(g.config.HysteresisPercentage)
+		}
+		if usage >= threshold {
+			next = p
+		}
+	}
+	return next
+}
+
+// RSSReader reads the resident set size of the process from procfs, it
+// returns 0 on systems without procfs
+func RSSReader() int64 {
+	statm, err := os.ReadFile("/proc/self/statm")
+	if err != nil {
+		return 0
+	}
+
+	// the second field is the resident set size in pages
+	fields := bytes.Fields(statm)
+	if len(fields) < 2 {
+		return 0
+	}
+	pages, err := strconv.ParseInt(string(fields[1]), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return pages * int64(os.Getpagesize())
+}
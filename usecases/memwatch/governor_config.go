@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package memwatch
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	DefaultGovernorInterval                = time.Second
+	DefaultShrinkCachesPercentage          = 80
+	DefaultPauseMaintenancePercentage      = 85
+	DefaultRejectImportsPercentage         = 90
+	DefaultMemoryPressureHysteresisPercent = 5
+)
+
+// GovernorConfig configures the memory pressure governor. The heap is
+// limited by MaxHeapBytes, or GOMEMLIMIT if it is zero, and the resident set
+// size of the process is limited by MaxRSSBytes, it is not watched if zero.
+// The percentages of the limits at which load is shed must be ascending.
+type GovernorConfig struct {
+	Enabled                    bool          `json:"enabled" yaml:"enabled"`
+	Interval                   time.Duration `json:"interval" yaml:"interval"`
+	MaxHeapBytes               int64         `json:"max_heap_bytes" yaml:"max_heap_bytes"`
+	MaxRSSBytes                int64         `json:"max_rss_bytes" yaml:"max_rss_bytes"`
+	ShrinkCachesPercentage     int           `json:"shrink_caches_percentage" yaml:"shrink_caches_percentage"`
+	PauseMaintenancePercentage int           `json:"pause_maintenance_percentage" yaml:"pause_maintenance_percentage"`
+	RejectImportsPercentage    int           `json:"reject_imports_percentage" yaml:"reject_imports_percentage"`
+	// HysteresisPercentage is how far the usage must drop below a threshold
+	// before the load shed at it is taken on again, so that the governor does
+	// not flap around a threshold
+	HysteresisPercentage int `json:"hysteresis_percentage" yaml:"hysteresis_percentage"`
+}
+
+// Validate the governor config, can be called from the central config
+// package
+func (c GovernorConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Interval <= 0 {
+		return fmt.Errorf("memory_pressure: interval must be positive")
+	}
+	if c.MaxHeapBytes < 0 || c.MaxRSSBytes < 0 {
+		return fmt.Errorf("memory_pressure: limits must not be negative")
+	}
+	if c.ShrinkCachesPercentage <= 0 ||
+		c.PauseMaintenancePercentage < c.ShrinkCachesPercentage ||
+		c.RejectImportsPercentage < c.PauseMaintenancePercentage ||
+		c.RejectImportsPercentage > 100 {
+		return fmt.Errorf("memory_pressure: percentages must be ascending between 1 and 100, "+
+			"got shrink caches %d, pause maintenance %d, reject imports %d",
+			c.ShrinkCachesPercentage, c.PauseMaintenancePercentage, c.RejectImportsPercentage)
+	}
+	if c.HysteresisPercentage < 0 || c.HysteresisPercentage >= c.ShrinkCachesPercentage {
+		return fmt.Errorf("memory_pressure: hysteresis must be between 0 and the shrink caches percentage")
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package memwatch
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingShedder struct {
+	pressures []Pressure
+}
+
+func (r *recordingShedder) ShedLoad(pressure Pressure) {
+	r.pressures = append(r.pressures, pressure)
+}
+
+func TestGovernor(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	config := GovernorConfig{
+		Enabled:                    true,
+		Interval:                   DefaultGovernorInterval,
+		ShrinkCachesPercentage:     80,
+		PauseMaintenancePercentage: 85,
+		RejectImportsPercentage:    90,
+		HysteresisPercentage:       5,
+		MaxRSSBytes:                2 * GiB,
+	}
+	require.Nil(t, config.Validate())
+
+	heap := &fakeHeapReader{}
+	rss := &fakeHeapReader{}
+	limiter := &fakeLimitSetter{limit: 1 * GiB}
+	g := newGovernor(config, func() int64 { return heap.val },
+		func() int64 { return rss.val }, limiter.SetMemoryLimit, logger)
+	shedder := &recordingShedder{}
+	g.Register(shedder)
+
+	check := func(heapBytes, rssBytes int64) Pressure {
+		heap.val = heapBytes
+		rss.val = rssBytes
+		g.Check()
+		return g.Pressure()
+	}
+
+	assert.Equal(t, PressureNone, check(500*MiB, 600*MiB))
+	assert.Equal(t, PressureShrinkCaches, check(830*MiB, 600*MiB))
+	assert.False(t, g.RejectImports())
+	// the resident set size is watched as well
+	assert.Equal(t, PressureRejectImports, check(830*MiB, 1900*MiB))
+	assert.True(t, g.RejectImports())
+	// eases only below the threshold minus the hysteresis
+	assert.Equal(t, PressureRejectImports, check(830*MiB, 1780*MiB))
+	assert.Equal(t, PressurePauseMaintenance, check(830*MiB, 1700*MiB))
+	assert.Equal(t, PressureNone, check(100*MiB, 100*MiB))
+
+	assert.Equal(t, []Pressure{
+		PressureShrinkCaches, PressureRejectImports, PressurePauseMaintenance, PressureNone,
+	}, shedder.pressures)
+
+	t.Run("nil governor", func(t *testing.T) {
+		var g *Governor
+		g.Register(shedder)
+		g.Check()
+		assert.Equal(t, PressureNone, g.Pressure())
+		assert.False(t, g.RejectImports())
+		assert.Nil(t, g.Close())
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		invalid := config
+		invalid.RejectImportsPercentage = 70
+		assert.ErrorContains(t, invalid.Validate(), "ascending")
+	})
+}
+
+func TestRSSReader(t *testing.T) {
+	// 0 on systems without procfs
+	assert.GreaterOrEqual(t, RSSReader(), int64(0))
+}
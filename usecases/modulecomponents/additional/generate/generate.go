@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/search"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/failover"
 )
 
 const maximumNumberOfGoroutines = 10
@@ -31,12 +32,21 @@ type generativeClient interface {
 }
 
 type GenerateProvider struct {
+	moduleName                string
 	client                    generativeClient
 	maximumNumberOfGoroutines int
+	breakers                  *failover.Breakers
 }
 
-func New(client generativeClient) *GenerateProvider {
-	return &GenerateProvider{client, maximumNumberOfGoroutines}
+// New creates the provider of the generate additional property. moduleName
+// is the name of the generative module, whose settings may list fallbacks.
+func New(moduleName string, client generativeClient) *GenerateProvider {
+	return &GenerateProvider{
+		moduleName:                moduleName,
+		client:                    client,
+		maximumNumberOfGoroutines: maximumNumberOfGoroutines,
+		breakers:                  failover.NewBreakers(failover.DefaultThreshold, failover.DefaultCooldown),
+	}
 }
 
 func (p *GenerateProvider) AdditionalPropertyDefaultValue() interface{} {
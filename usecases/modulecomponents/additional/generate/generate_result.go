@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/search"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/failover"
 )
 
 func (p *GenerateProvider) generateResult(ctx context.Context, in []search.Result, params *Params, limit *int, argumentModuleParams map[string]interface{}, cfg moduletools.ClassConfig) ([]search.Result, error) {
@@ -68,7 +69,10 @@ func (p *GenerateProvider) generatePerSearchResult(ctx context.Context, in []sea
 			sem <- struct{}{}
 			defer wg.Done()
 			defer func() { <-sem }()
-			generateResult, err := p.client.GenerateSingleResult(ctx, textProperties, prompt, cfg)
+			generateResult, err := p.generate(ctx, result.ClassName, cfg,
+				func(cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+					return p.client.GenerateSingleResult(ctx, textProperties, prompt, cfg)
+				})
 			p.setIndividualResult(in, i, generateResult, err)
 		}(result, textProperties, i)
 	}
@@ -81,11 +85,24 @@ func (p *GenerateProvider) generateForAllSearchResults(ctx context.Context, in [
 	for _, res := range in {
 		propertiesForAllDocs = append(propertiesForAllDocs, p.getTextProperties(res, properties))
 	}
-	generateResult, err := p.client.GenerateAllResults(ctx, propertiesForAllDocs, task, cfg)
+	generateResult, err := p.generate(ctx, in[0].ClassName, cfg,
+		func(cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error) {
+			return p.client.GenerateAllResults(ctx, propertiesForAllDocs, task, cfg)
+		})
 	p.setCombinedResult(in, 0, generateResult, err)
 	return in, nil
 }
 
+// generate calls the generative client with the fallbacks of the class, if
+// the configured provider fails
+func (p *GenerateProvider) generate(ctx context.Context, className string,
+	cfg moduletools.ClassConfig,
+	fn func(cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error),
+) (*generativemodels.GenerateResponse, error) {
+	return failover.Do(ctx, p.breakers, className+"/"+p.moduleName,
+		failover.Configs(cfg, p.moduleName), fn)
+}
+
 func (p *GenerateProvider) getTextProperties(result search.Result, properties []string) map[string]string {
 	textProperties := map[string]string{}
 	schema := result.Object().Properties.(map[string]interface{})
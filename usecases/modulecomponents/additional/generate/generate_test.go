@@ -26,7 +26,7 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 	t.Run("should answer", func(t *testing.T) {
 		// given
 		openaiClient := &fakeOpenAIClient{}
-		answerProvider := New(openaiClient)
+		answerProvider := New("generative-openai", openaiClient)
 		in := []search.Result{
 			{
 				ID: "some-uuid",
@@ -30,8 +30,8 @@ type GraphQLAdditionalGenerativeProvider struct {
 	generative AdditionalProperty
 }
 
-func NewGenerativeProvider(client generativeClient) *GraphQLAdditionalGenerativeProvider {
-	return &GraphQLAdditionalGenerativeProvider{generativegenerate.New(client)}
+func NewGenerativeProvider(moduleName string, client generativeClient) *GraphQLAdditionalGenerativeProvider {
+	return &GraphQLAdditionalGenerativeProvider{generativegenerate.New(moduleName, client)}
 }
 
 func (p *GraphQLAdditionalGenerativeProvider) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
@@ -0,0 +1,249 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package failover lets vectorizer and generative modules fall back to other
+// providers or models when the configured one fails. The fallbacks are an
+// ordered list in the module config of a class, each overriding some of the
+// settings of the module. Settings which are null are removed, e.g. to fall
+// back from Azure OpenAI to OpenAI and then to a local OpenAI compatible
+// server:
+//
+//	"text2vec-openai": {
+//	  "resourceName": "my-resource",
+//	  "deploymentId": "my-deployment",
+//	  "fallbacks": [
+//	    {"resourceName": null, "deploymentId": null},
+//	    {"resourceName": null, "deploymentId": null, "baseURL": "http://embeddings:8080"}
+//	  ]
+//	}
+//
+// Vectors of all providers of a class are stored in the same index, so the
+// fallbacks of vectorizers must produce vectors of the same model.
+//
+// Every provider has a circuit breaker. A provider which failed repeatedly is
+// skipped until its cooldown has passed, instead of adding its timeout to
+// every request.
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+const (
+	// FallbacksKey is the setting of the module config listing the fallbacks
+	FallbacksKey = "fallbacks"
+
+	// DefaultThreshold is the number of consecutive failures which open the
+	// circuit of a provider
+	DefaultThreshold = 3
+	// DefaultCooldown is the time a provider is skipped after its circuit
+	// opened
+	DefaultCooldown = 30 * time.Second
+)
+
+// Validate checks that the fallbacks of the module settings are a list of
+// objects
+func Validate(settings map[string]interface{}) error {
+	_, err := fallbacks(settings)
+	return err
+}
+
+// Configs returns the chain of configs to try: cfg itself followed by one
+// config per fallback in the settings of the module. Chains of classes
+// without fallbacks only contain cfg.
+func Configs(cfg moduletools.ClassConfig, moduleName string) []moduletools.ClassConfig {
+	if cfg == nil {
+		return []moduletools.ClassConfig{cfg}
+	}
+	list, _ := fallbacks(cfg.ClassByModuleName(moduleName))
+	configs := make([]moduletools.ClassConfig, 1, len(list)+1)
+	configs[0] = cfg
+	for i := range list {
+		configs = append(configs, fallbackConfig{ClassConfig: cfg, index: i})
+	}
+	return configs
+}
+
+func fallbacks(settings map[string]interface{}) ([]map[string]interface{}, error) {
+	raw, ok := settings[FallbacksKey]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	list, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list of objects, got %T", FallbacksKey, raw)
+	}
+	out := make([]map[string]interface{}, len(list))
+	for i, entry := range list {
+		overrides, ok := entry.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s[%d] must be an object, got %T", FallbacksKey, i, entry)
+		}
+		if _, ok := overrides[FallbacksKey]; ok {
+			return nil, fmt.Errorf("%s[%d] must not have fallbacks itself", FallbacksKey, i)
+		}
+		out[i] = overrides
+	}
+	return out, nil
+}
+
+// fallbackConfig applies the overrides of a fallback to the module settings
+// of the wrapped config. As generative modules read their settings by module
+// name, the overrides are applied to the settings of any module.
+type fallbackConfig struct {
+	moduletools.ClassConfig
+	index int
+}
+
+func (c fallbackConfig) Class() map[string]interface{} {
+	return c.apply(c.ClassConfig.Class())
+}
+
+func (c fallbackConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return c.apply(c.ClassConfig.ClassByModuleName(moduleName))
+}
+
+func (c fallbackConfig) apply(settings map[string]interface{}) map[string]interface{} {
+	list, err := fallbacks(settings)
+	if err != nil || c.index >= len(list) {
+		return settings
+	}
+
+	merged := make(map[string]interface{}, len(settings))
+	for key, value := range settings {
+		if key != FallbacksKey {
+			merged[key] = value
+		}
+	}
+	for key, value := range list[c.index] {
+		if value == nil {
+			delete(merged, key)
+		} else {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+// Breakers are the circuit breakers of the providers of all classes
+type Breakers struct {
+	sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	circuits  map[string]*circuit
+	now       func() time.Time
+}
+
+type circuit struct {
+	failures  int
+	openUntil time.Time
+}
+
+func NewBreakers(threshold int, cooldown time.Duration) *Breakers {
+	return &Breakers{
+		threshold: threshold,
+		cooldown:  cooldown,
+		circuits:  map[string]*circuit{},
+		now:       time.Now,
+	}
+}
+
+// allow is true if the circuit is closed, or if its cooldown has passed. In
+// the latter case the circuit stays open for other requests until the probe
+// completes.
+func (b *Breakers) allow(key string) bool {
+	b.Lock()
+	defer b.Unlock()
+
+	c, ok := b.circuits[key]
+	if !ok || c.failures < b.threshold {
+		return true
+	}
+	now := b.now()
+	if now.Before(c.openUntil) {
+		return false
+	}
+	c.openUntil = now.Add(b.cooldown)
+	return true
+}
+
+func (b *Breakers) record(key string, err error) {
+	b.Lock()
+	defer b.Unlock()
+
+	if err == nil {
+		delete(b.circuits, key)
+		return
+	}
+	c, ok := b.circuits[key]
+	if !ok {
+		c = &circuit{}
+		b.circuits[key] = c
+	}
+	c.failures++
+	if c.failures >= b.threshold {
+		c.openUntil = b.now().Add(b.cooldown)
+	}
+}
+
+// Do calls fn with the configs of the chain in order until a call succeeds.
+// Providers with open circuits are skipped, if all circuits are open the
+// first provider is tried anyway. Chains with a single config are called
+// without a circuit breaker. key identifies the chain, e.g. the class and
+// module name.
+func Do[T any](ctx context.Context, b *Breakers, key string,
+	configs []moduletools.ClassConfig, fn func(cfg moduletools.ClassConfig) (T, error),
+) (T, error) {
+	if len(configs) == 1 || b == nil {
+		return fn(configs[0])
+	}
+
+	var (
+		zero   T
+		errs   []string
+		called bool
+	)
+	for i, cfg := range configs {
+		providerKey := fmt.Sprintf("%s/%d", key, i)
+		if !b.allow(providerKey) {
+			continue
+		}
+		called = true
+		res, err := fn(cfg)
+		if err != nil && ctx.Err() != nil {
+			// the request was cancelled, which says nothing about the provider
+			return zero, err
+		}
+		b.record(providerKey, err)
+		if err == nil {
+			return res, nil
+		}
+		errs = append(errs, fmt.Sprintf("provider %d: %v", i, err))
+	}
+
+	if !called {
+		res, err := fn(configs[0])
+		b.record(key+"/0", err)
+		if err == nil {
+			return res, nil
+		}
+		errs = append(errs, fmt.Sprintf("provider 0: %v", err))
+	}
+
+	return zero, errors.New("all providers failed: " + strings.Join(errs, ", "))
+}
@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package failover
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+type fakeClassConfig map[string]interface{}
+
+func (f fakeClassConfig) Tenant() string { return "" }
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f["text2vec-openai"].(map[string]interface{})
+}
+func (f fakeClassConfig) Property(string) map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	settings, _ := f[moduleName].(map[string]interface{})
+	return settings
+}
+
+func newFakeConfig() fakeClassConfig {
+	return fakeClassConfig{"text2vec-openai": map[string]interface{}{
+		"model":        "ada",
+		"resourceName": "my-resource",
+		"deploymentId": "my-deployment",
+		FallbacksKey: []interface{}{
+			map[string]interface{}{"resourceName": nil, "deploymentId": nil},
+			map[string]interface{}{"resourceName": nil, "deploymentId": nil, "baseURL": "http://local"},
+		},
+	}}
+}
+
+func TestConfigs(t *testing.T) {
+	configs := Configs(newFakeConfig(), "text2vec-openai")
+	require.Len(t, configs, 3)
+
+	assert.Equal(t, "my-resource", configs[0].Class()["resourceName"])
+	assert.Equal(t, map[string]interface{}{"model": "ada"}, configs[1].Class())
+	assert.Equal(t, map[string]interface{}{"model": "ada", "baseURL": "http://local"},
+		configs[2].ClassByModuleName("text2vec-openai"))
+
+	t.Run("without fallbacks", func(t *testing.T) {
+		cfg := fakeClassConfig{"text2vec-openai": map[string]interface{}{"model": "ada"}}
+		assert.Len(t, Configs(cfg, "text2vec-openai"), 1)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, Validate(newFakeConfig().Class()))
+	assert.Nil(t, Validate(map[string]interface{}{}))
+	assert.NotNil(t, Validate(map[string]interface{}{FallbacksKey: "openai"}))
+	assert.NotNil(t, Validate(map[string]interface{}{FallbacksKey: []interface{}{"openai"}}))
+	assert.NotNil(t, Validate(map[string]interface{}{FallbacksKey: []interface{}{
+		map[string]interface{}{FallbacksKey: []interface{}{}},
+	}}))
+}
+
+func TestDo(t *testing.T) {
+	ctx := context.Background()
+	configs := Configs(newFakeConfig(), "text2vec-openai")
+	failing := map[string]bool{}
+	var calls []string
+	fn := func(cfg moduletools.ClassConfig) (string, error) {
+		provider := "openai"
+		if resource, ok := cfg.Class()["resourceName"].(string); ok {
+			provider = resource
+		} else if baseURL, ok := cfg.Class()["baseURL"].(string); ok {
+			provider = baseURL
+		}
+		calls = append(calls, provider)
+		if failing[provider] {
+			return "", errors.New(provider + " is down")
+		}
+		return provider, nil
+	}
+
+	now := time.Now()
+	b := NewBreakers(2, time.Minute)
+	b.now = func() time.Time { return now }
+
+	t.Run("the first provider is used while it works", func(t *testing.T) {
+		calls = nil
+		res, err := Do(ctx, b, "Article/text2vec-openai", configs, fn)
+		require.Nil(t, err)
+		assert.Equal(t, "my-resource", res)
+		assert.Equal(t, []string{"my-resource"}, calls)
+	})
+
+	t.Run("failures fall back to the next provider", func(t *testing.T) {
+		failing["my-resource"] = true
+		for i := 0; i < 2; i++ {
+			calls = nil
+			res, err := Do(ctx, b, "Article/text2vec-openai", configs, fn)
+			require.Nil(t, err)
+			assert.Equal(t, "openai", res)
+			assert.Equal(t, []string{"my-resource", "openai"}, calls)
+		}
+	})
+
+	t.Run("providers with open circuits are skipped", func(t *testing.T) {
+		calls = nil
+		res, err := Do(ctx, b, "Article/text2vec-openai", configs, fn)
+		require.Nil(t, err)
+		assert.Equal(t, "openai", res)
+		assert.Equal(t, []string{"openai"}, calls)
+
+		t.Run("but not for other classes", func(t *testing.T) {
+			calls = nil
+			_, err := Do(ctx, b, "Paragraph/text2vec-openai", configs, fn)
+			require.Nil(t, err)
+			assert.Equal(t, []string{"my-resource", "openai"}, calls)
+		})
+	})
+
+	t.Run("the provider is probed again after the cooldown", func(t *testing.T) {
+		failing["my-resource"] = false
+		now = now.Add(time.Minute)
+		calls = nil
+		res, err := Do(ctx, b, "Article/text2vec-openai", configs, fn)
+		require.Nil(t, err)
+		assert.Equal(t, "my-resource", res)
+		assert.Equal(t, []string{"my-resource"}, calls)
+	})
+
+	t.Run("all providers failing", func(t *testing.T) {
+		failing["my-resource"] = true
+		failing["openai"] = true
+		failing["http://local"] = true
+		calls = nil
+		_, err := Do(ctx, b, "Article/text2vec-openai", configs, fn)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "provider 2: http://local is down")
+		assert.Equal(t, []string{"my-resource", "openai", "http://local"}, calls)
+	})
+
+	t.Run("a single provider is always called", func(t *testing.T) {
+		single := Configs(fakeClassConfig{"text2vec-openai": map[string]interface{}{}}, "text2vec-openai")
+		for i := 0; i < 5; i++ {
+			calls = nil
+			_, err := Do(ctx, b, "Single/text2vec-openai", single, fn)
+			assert.EqualError(t, err, "openai is down")
+			assert.Equal(t, []string{"openai"}, calls)
+		}
+	})
+
+	t.Run("cancelled requests do not fall back", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		calls = nil
+		_, err := Do(cancelled, b, "Cancelled/text2vec-openai", configs, fn)
+		assert.NotNil(t, err)
+		assert.Equal(t, []string{"my-resource"}, calls)
+	})
+}
@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package httpclient creates the HTTP clients modules use to call inference
+// APIs. All clients share a transport, which is configured once at startup,
+// so that outbound proxies, custom certificate authorities, client
+// certificates and connection pool sizes apply to all modules alike.
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type Config struct {
+	// Proxy is the URL of the proxy all module requests are sent through. If
+	// it is empty, the standard HTTPS_PROXY, HTTP_PROXY and NO_PROXY
+	// environment variables are used.
+	Proxy string
+	// CABundle is the path of a PEM file with certificate authorities which
+	// are trusted in addition to the ones of the system
+	CABundle string
+	// ClientCert and ClientKey are the paths of the PEM files of the
+	// certificate modules authenticate with, both or neither must be set
+	ClientCert string
+	ClientKey  string
+	// The connection pool sizes, 0 keeps the defaults of the Go standard
+	// library
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+}
+
+// ConfigFromEnv reads the config from MODULES_HTTP_PROXY,
+// MODULES_HTTP_CA_BUNDLE, MODULES_HTTP_CLIENT_CERT, MODULES_HTTP_CLIENT_KEY,
+// MODULES_HTTP_MAX_IDLE_CONNS, MODULES_HTTP_MAX_IDLE_CONNS_PER_HOST and
+// MODULES_HTTP_MAX_CONNS_PER_HOST
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Proxy:      os.Getenv("MODULES_HTTP_PROXY"),
+		CABundle:   os.Getenv("MODULES_HTTP_CA_BUNDLE"),
+		ClientCert: os.Getenv("MODULES_HTTP_CLIENT_CERT"),
+		ClientKey:  os.Getenv("MODULES_HTTP_CLIENT_KEY"),
+	}
+	for name, target := range map[string]*int{
+		"MODULES_HTTP_MAX_IDLE_CONNS":          &cfg.MaxIdleConns,
+		"MODULES_HTTP_MAX_IDLE_CONNS_PER_HOST": &cfg.MaxIdleConnsPerHost,
+		"MODULES_HTTP_MAX_CONNS_PER_HOST":      &cfg.MaxConnsPerHost,
+	} {
+		if v := os.Getenv(name); v != "" {
+			parsed, err := strconv.Atoi(v)
+			if err != nil || parsed < 0 {
+				return cfg, fmt.Errorf("%s must be a non-negative integer, got %q", name, v)
+			}
+			*target = parsed
+		}
+	}
+	return cfg, nil
+}
+
+var (
+	lock      sync.RWMutex
+	transport = newDefaultTransport()
+)
+
+// Configure replaces the transport of all clients created afterwards. It is
+// called at startup, before the modules are initialized.
+func Configure(cfg Config) error {
+	t, err := newTransport(cfg)
+	if err != nil {
+		return err
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	transport = t
+	return nil
+}
+
+// Transport returns the transport shared by the clients of all modules
+func Transport() http.RoundTripper {
+	lock.RLock()
+	defer lock.RUnlock()
+	return transport
+}
+
+// New creates a client with the shared transport
+func New(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: Transport(),
+	}
+}
+
+func newDefaultTransport() *http.Transport {
+	return http.DefaultTransport.(*http.Transport).Clone()
+}
+
+func newTransport(cfg Config) (*http.Transport, error) {
+	t := newDefaultTransport()
+
+	if cfg.Proxy != "" {
+		proxy, err := url.Parse(cfg.Proxy)
+		if err != nil || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid modules http proxy %q", cfg.Proxy)
+		}
+		t.Proxy = http.ProxyURL(proxy)
+	}
+
+	if cfg.CABundle != "" || cfg.ClientCert != "" || cfg.ClientKey != "" {
+		tlsConfig, err := newTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		t.TLSClientConfig = tlsConfig
+	}
+
+	if cfg.MaxIdleConns > 0 {
+		t.MaxIdleConns = cfg.MaxIdleConns
+	}
+	if cfg.MaxIdleConnsPerHost > 0 {
+		t.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	}
+	if cfg.MaxConnsPerHost > 0 {
+		t.MaxConnsPerHost = cfg.MaxConnsPerHost
+	}
+
+	return t, nil
+}
+
+func newTLSConfig(cfg Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if cfg.CABundle != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		pem, err := os.ReadFile(cfg.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("read modules http CA bundle: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("modules http CA bundle %s contains no certificates",
+				cfg.CABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCert != "" || cfg.ClientKey != "" {
+		if cfg.ClientCert == "" || cfg.ClientKey == "" {
+			return nil, fmt.Errorf("modules http client certificate and key " +
+				"must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCert, cfg.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("load modules http client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package httpclient
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("MODULES_HTTP_PROXY", "http://proxy.internal:3128")
+	t.Setenv("MODULES_HTTP_CA_BUNDLE", "/etc/ssl/corp.pem")
+	t.Setenv("MODULES_HTTP_MAX_IDLE_CONNS_PER_HOST", "64")
+
+	cfg, err := ConfigFromEnv()
+	require.Nil(t, err)
+	assert.Equal(t, Config{
+		Proxy:               "http://proxy.internal:3128",
+		CABundle:            "/etc/ssl/corp.pem",
+		MaxIdleConnsPerHost: 64,
+	}, cfg)
+
+	t.Setenv("MODULES_HTTP_MAX_CONNS_PER_HOST", "many")
+	_, err = ConfigFromEnv()
+	assert.NotNil(t, err)
+}
+
+func TestConfigure(t *testing.T) {
+	t.Cleanup(func() { require.Nil(t, Configure(Config{})) })
+
+	t.Run("requests are sent through the proxy", func(t *testing.T) {
+		var proxied string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied = r.URL.String()
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer proxy.Close()
+
+		require.Nil(t, Configure(Config{Proxy: proxy.URL}))
+		res, err := New(time.Second).Get("http://inference.internal/v1/embeddings")
+		require.Nil(t, err)
+		res.Body.Close()
+
+		assert.Equal(t, "http://inference.internal/v1/embeddings", proxied)
+	})
+
+	t.Run("servers signed by the CA bundle are trusted", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		require.Nil(t, Configure(Config{}))
+		_, err := New(time.Second).Get(server.URL)
+		require.NotNil(t, err)
+
+		bundle := filepath.Join(t.TempDir(), "ca.pem")
+		require.Nil(t, os.WriteFile(bundle, pem.EncodeToMemory(&pem.Block{
+			Type: "CERTIFICATE", Bytes: server.Certificate().Raw,
+		}), 0o600))
+
+		require.Nil(t, Configure(Config{CABundle: bundle}))
+		res, err := New(time.Second).Get(server.URL)
+		require.Nil(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	})
+
+	t.Run("invalid configs are rejected", func(t *testing.T) {
+		assert.NotNil(t, Configure(Config{Proxy: "not a url"}))
+		assert.NotNil(t, Configure(Config{CABundle: filepath.Join(t.TempDir(), "missing.pem")}))
+		assert.NotNil(t, Configure(Config{ClientCert: "cert.pem"}))
+	})
+}
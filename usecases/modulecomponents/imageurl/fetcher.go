@@ -0,0 +1,264 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package imageurl lets image vectorizers accept https URLs of externally
+// hosted images in addition to base64 encoded images. Images are only
+// downloaded from allowlisted hosts, up to a maximum size, and are cached.
+package imageurl
+
+import (
+	"container/list"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
+)
+
+const (
+	DefaultMaxBytes   = 10 << 20
+	DefaultCacheBytes = 256 << 20
+	DefaultTimeout    = 30 * time.Second
+)
+
+type Config struct {
+	// AllowedHosts are the hosts images may be downloaded from. An entry
+	// "*.example.com" allows all subdomains of example.com. URLs are
+	// rejected if no host is allowed.
+	AllowedHosts []string
+	// MaxBytes is the maximum size of a single image
+	MaxBytes int64
+	// CacheBytes is the maximum size of all cached images, 0 disables the
+	// cache
+	CacheBytes int64
+	Timeout    time.Duration
+}
+
+// ConfigFromEnv reads the config from IMAGE_URL_ALLOWED_HOSTS (comma
+// separated), IMAGE_URL_MAX_BYTES, IMAGE_URL_CACHE_BYTES and
+// IMAGE_URL_TIMEOUT (e.g. "30s")
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		MaxBytes:   DefaultMaxBytes,
+		CacheBytes: DefaultCacheBytes,
+		Timeout:    DefaultTimeout,
+	}
+	for _, host := range strings.Split(os.Getenv("IMAGE_URL_ALLOWED_HOSTS"), ",") {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			cfg.AllowedHosts = append(cfg.AllowedHosts, host)
+		}
+	}
+	for name, target := range map[string]*int64{
+		"IMAGE_URL_MAX_BYTES":   &cfg.MaxBytes,
+		"IMAGE_URL_CACHE_BYTES": &cfg.CacheBytes,
+	} {
+		if v := os.Getenv(name); v != "" {
+			parsed, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || parsed < 0 {
+				return cfg, fmt.Errorf("%s must be a non-negative integer, got %q", name, v)
+			}
+			*target = parsed
+		}
+	}
+	if v := os.Getenv("IMAGE_URL_TIMEOUT"); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed <= 0 {
+			return cfg, fmt.Errorf("IMAGE_URL_TIMEOUT must be a positive duration, got %q", v)
+		}
+		cfg.Timeout = parsed
+	}
+	return cfg, nil
+}
+
+// Fetcher replaces image URLs by the base64 encoded images. A nil Fetcher
+// rejects all URLs.
+type Fetcher struct {
+	cfg        Config
+	httpClient *http.Client
+	cache      *cache
+}
+
+func NewFetcher(cfg Config) *Fetcher {
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = DefaultMaxBytes
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	f := &Fetcher{cfg: cfg, cache: newCache(cfg.CacheBytes)}
+	f.httpClient = httpclient.New(cfg.Timeout)
+	f.httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 5 {
+			return fmt.Errorf("stopped after %d redirects", len(via))
+		}
+		return f.check(req.URL)
+	}
+	return f
+}
+
+// IsURL is true for images given as URL instead of base64. Base64 can't
+// contain a colon, so there is no ambiguity.
+func IsURL(image string) bool {
+	return strings.HasPrefix(image, "https://") || strings.HasPrefix(image, "http://")
+}
+
+// Resolve returns base64 encoded images unchanged and downloads images
+// given as URL
+func (f *Fetcher) Resolve(ctx context.Context, image string) (string, error) {
+	if !IsURL(image) {
+		return image, nil
+	}
+	if f == nil {
+		return "", fmt.Errorf("image URLs are not supported by this module")
+	}
+
+	u, err := url.Parse(image)
+	if err != nil {
+		return "", fmt.Errorf("parse image URL: %w", err)
+	}
+	if err := f.check(u); err != nil {
+		return "", err
+	}
+	if cached, ok := f.cache.get(image); ok {
+		return cached, nil
+	}
+
+	encoded, err := f.download(ctx, image)
+	if err != nil {
+		return "", fmt.Errorf("download image %q: %w", image, err)
+	}
+	f.cache.add(image, encoded)
+	return encoded, nil
+}
+
+// ResolveAll resolves every image, see Resolve
+func (f *Fetcher) ResolveAll(ctx context.Context, images []string) ([]string, error) {
+	out := make([]string, len(images))
+	for i, image := range images {
+		resolved, err := f.Resolve(ctx, image)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = resolved
+	}
+	return out, nil
+}
+
+func (f *Fetcher) check(u *url.URL) error {
+	if u.Scheme != "https" {
+		return fmt.Errorf("only https image URLs are supported, got %q", u.Scheme)
+	}
+	if len(f.cfg.AllowedHosts) == 0 {
+		return fmt.Errorf("image URLs are disabled, no host is allowed")
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range f.cfg.AllowedHosts {
+		if host == allowed {
+			return nil
+		}
+		if suffix, ok := strings.CutPrefix(allowed, "*"); ok && strings.HasSuffix(host, suffix) {
+			return nil
+		}
+	}
+	return fmt.Errorf("host %q of image URL is not allowed", host)
+}
+
+func (f *Fetcher) download(ctx context.Context, image string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, image, nil)
+	if err != nil {
+		return "", err
+	}
+	res, err := f.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	if res.ContentLength > f.cfg.MaxBytes {
+		return "", fmt.Errorf("image exceeds the maximum of %d bytes", f.cfg.MaxBytes)
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, f.cfg.MaxBytes+1))
+	if err != nil {
+		return "", err
+	}
+	if int64(len(body)) > f.cfg.MaxBytes {
+		return "", fmt.Errorf("image exceeds the maximum of %d bytes", f.cfg.MaxBytes)
+	}
+	return base64.StdEncoding.EncodeToString(body), nil
+}
+
+// cache is a LRU cache of encoded images limited by their total size
+type cache struct {
+	sync.Mutex
+	maxBytes int64
+	bytes    int64
+	order    *list.List
+	entries  map[string]*list.Element
+}
+
+type cacheEntry struct {
+	url     string
+	encoded string
+}
+
+func newCache(maxBytes int64) *cache {
+	return &cache{
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  map[string]*list.Element{},
+	}
+}
+
+func (c *cache) get(url string) (string, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.entries[url]
+	if !ok {
+		return "", false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).encoded, true
+}
+
+func (c *cache) add(url, encoded string) {
+	size := int64(len(encoded))
+	if size > c.maxBytes {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if _, ok := c.entries[url]; ok {
+		return
+	}
+	c.entries[url] = c.order.PushFront(&cacheEntry{url: url, encoded: encoded})
+	c.bytes += size
+	for c.bytes > c.maxBytes {
+		oldest := c.order.Back()
+		entry := oldest.Value.(*cacheEntry)
+		c.order.Remove(oldest)
+		delete(c.entries, entry.url)
+		c.bytes -= int64(len(entry.encoded))
+	}
+}
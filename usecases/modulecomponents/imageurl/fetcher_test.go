@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package imageurl
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetcher(t *testing.T) {
+	ctx := context.Background()
+	image := []byte("not really a png")
+	var requests atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/image.png":
+			w.Write(image)
+		case "/large.png":
+			w.Write(make([]byte, 100))
+		case "/redirect":
+			http.Redirect(w, r, "https://elsewhere.example.com/image.png", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	newFetcher := func(cfg Config) *Fetcher {
+		f := NewFetcher(cfg)
+		f.httpClient.Transport = server.Client().Transport
+		return f
+	}
+	allowed := Config{AllowedHosts: []string{"127.0.0.1"}, MaxBytes: 50, CacheBytes: 1 << 10}
+
+	t.Run("base64 is unchanged", func(t *testing.T) {
+		var f *Fetcher
+		res, err := f.Resolve(ctx, "aW1hZ2U=")
+		require.Nil(t, err)
+		assert.Equal(t, "aW1hZ2U=", res)
+	})
+
+	t.Run("download and cache", func(t *testing.T) {
+		f := newFetcher(allowed)
+		requests.Store(0)
+		for i := 0; i < 3; i++ {
+			res, err := f.Resolve(ctx, server.URL+"/image.png")
+			require.Nil(t, err)
+			assert.Equal(t, base64.StdEncoding.EncodeToString(image), res)
+		}
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		var nilFetcher *Fetcher
+		_, err := nilFetcher.Resolve(ctx, server.URL+"/image.png")
+		assert.ErrorContains(t, err, "not supported")
+
+		for name, tc := range map[string]struct {
+			cfg Config
+			url string
+			err string
+		}{
+			"no allowed hosts": {Config{}, server.URL + "/image.png", "disabled"},
+			"host not allowed": {
+				Config{AllowedHosts: []string{"*.example.com"}},
+				server.URL + "/image.png", "not allowed",
+			},
+			"http":      {allowed, strings.Replace(server.URL, "https", "http", 1) + "/image.png", "only https"},
+			"too large": {allowed, server.URL + "/large.png", "maximum of 50 bytes"},
+			"not found": {allowed, server.URL + "/missing.png", "status 404"},
+			"redirect":  {allowed, server.URL + "/redirect", "not allowed"},
+		} {
+			_, err := newFetcher(tc.cfg).Resolve(ctx, tc.url)
+			assert.ErrorContains(t, err, tc.err, name)
+		}
+	})
+
+	t.Run("allowed subdomains", func(t *testing.T) {
+		f := NewFetcher(Config{AllowedHosts: []string{"*.example.com", "example.org"}})
+		for host, ok := range map[string]bool{
+			"https://img.example.com/a.png":     true,
+			"https://a.b.example.com/a.png":     true,
+			"https://example.org/a.png":         true,
+			"https://img.example.org/a.png":     false,
+			"https://badexample.com/a.png":      false,
+			"https://example.com.evil.io/a.png": false,
+		} {
+			u, err := http.NewRequest(http.MethodGet, host, nil)
+			require.Nil(t, err)
+			assert.Equal(t, ok, f.check(u.URL) == nil, host)
+		}
+	})
+}
+
+func TestCache(t *testing.T) {
+	c := newCache(10)
+	c.add("a", "aaaa")
+	c.add("b", "bbbb")
+	_, ok := c.get("a")
+	require.True(t, ok)
+	// evicts b, the least recently used
+	c.add("c", "cccc")
+	_, ok = c.get("b")
+	assert.False(t, ok)
+	_, ok = c.get("a")
+	assert.True(t, ok)
+	// larger than the whole cache
+	c.add("d", "ddddddddddd")
+	_, ok = c.get("d")
+	assert.False(t, ok)
+	assert.Equal(t, int64(8), c.bytes)
+}
@@ -46,7 +46,7 @@ func nearImageArgument(prefix, className string) *graphql.ArgumentConfig {
 func nearImageFields(prefix string) graphql.InputObjectConfigFieldMap {
 	return graphql.InputObjectConfigFieldMap{
 		"image": &graphql.InputObjectFieldConfig{
-			Description: "Base64 encoded image",
+			Description: "Base64 encoded image or https URL of an image",
 			Type:        graphql.NewNonNull(graphql.String),
 		},
 		"certainty": &graphql.InputObjectFieldConfig{
@@ -0,0 +1,292 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package modulecredentials stores the credentials and endpoints of modules
+// which are set at runtime instead of through environment variables, such
+// as the API keys of OpenAI or Cohere. They are stored under the names of
+// the request headers the modules already read, e.g. "X-Openai-Api-Key" or
+// "X-Openai-Baseurl", and are passed to the modules as if the request had
+// carried them. Headers sent with a request take precedence over the stored
+// values, values stored for the tenant a principal is bound to take
+// precedence over the values stored for all tenants.
+//
+// Credentials are stored in the metadata of the schema, so they are
+// replicated to every node of a cluster and survive restarts.
+package modulecredentials
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// credentialsPrefix is the prefix of the metadata keys of the credentials
+	credentialsPrefix = "modulecredentials/"
+	globalKey         = credentialsPrefix + "global"
+	tenantsPrefix     = credentialsPrefix + "tenants/"
+
+	// TenantHeader names the tenant of a request which does not name a
+	// tenant itself, e.g. for the attribution of its module calls. It never
+	// selects credentials, as any client can set it.
+	TenantHeader = "X-Weaviate-Tenant"
+)
+
+var (
+	ErrTenantNotFound = errors.New("no module credentials for tenant")
+
+	errNoMetadataStore = errors.New("module credentials can not be changed before the schema is loaded")
+)
+
+// Listing describes the stored credentials without their values
+type Listing struct {
+	Global  []string            `json:"global"`
+	Tenants map[string][]string `json:"tenants"`
+}
+
+// metadataStore persists the credentials and replicates them to all nodes in
+// the cluster. It is implemented by the schema manager.
+type metadataStore interface {
+	PutMetadata(ctx context.Context, key string, value []byte) error
+	DeleteMetadata(ctx context.Context, key string) error
+	Metadata(prefix string) map[string][]byte
+	RegisterMetadataCallback(prefix string, callback func())
+}
+
+// Store holds the credentials set at runtime
+type Store struct {
+	sync.RWMutex
+	// writeLock serializes the changes made on this node
+	writeLock sync.Mutex
+	metadata  metadataStore
+	global    map[string]string
+	tenants   map[string]map[string]string
+	logger    logrus.FieldLogger
+}
+
+func New(logger logrus.FieldLogger) *Store {
+	return &Store{
+		global:  map[string]string{},
+		tenants: map[string]map[string]string{},
+		logger:  logger,
+	}
+}
+
+// SetMetadataStore loads the credentials from the metadata store and keeps
+// them up to date. Credentials can not be changed before it is set.
+func (s *Store) SetMetadataStore(metadata metadataStore) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	s.metadata = metadata
+	metadata.RegisterMetadataCallback(credentialsPrefix, func() {
+		if err := s.load(); err != nil {
+			s.logger.WithField("action", "module_credentials_load").WithError(err).
+				Error("module credentials could not be loaded")
+		}
+	})
+	return s.load()
+}
+
+func (s *Store) load() error {
+	global := map[string]string{}
+	tenants := map[string]map[string]string{}
+	for key, value := range s.metadata.Metadata(credentialsPrefix) {
+		values := map[string]string{}
+		if err := json.Unmarshal(value, &values); err != nil {
+			return fmt.Errorf("parse module credentials %q: %w",
+				strings.TrimPrefix(key, credentialsPrefix), err)
+		}
+		if key == globalKey {
+			global = values
+		} else if tenant := strings.TrimPrefix(key, tenantsPrefix); tenant != key {
+			tenants[tenant] = values
+		}
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.global = global
+	s.tenants = tenants
+	return nil
+}
+
+// Set merges the given values into the credentials of the tenant, or into
+// the credentials of all tenants if tenant is empty. Names are header names,
+// values which are empty remove the credential.
+func (s *Store) Set(ctx context.Context, tenant string, values map[string]string) error {
+	if s == nil {
+		return fmt.Errorf("module credentials are not enabled")
+	}
+
+	normalized := make(map[string]string, len(values))
+	for name, value := range values {
+		name = http.CanonicalHeaderKey(strings.TrimSpace(name))
+		if !strings.HasPrefix(name, "X-") || name == "X-" {
+			return fmt.Errorf("invalid credential %q: names are request headers "+
+				"starting with X-, e.g. X-Openai-Api-Key", name)
+		}
+		if name == TenantHeader {
+			return fmt.Errorf("invalid credential %q: reserved header", name)
+		}
+		normalized[name] = value
+	}
+
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	if s.metadata == nil {
+		return errNoMetadataStore
+	}
+
+	target := map[string]string{}
+	s.RLock()
+	current := s.global
+	if tenant != "" {
+		current = s.tenants[tenant]
+	}
+	for name, value := range current {
+		target[name] = value
+	}
+	s.RUnlock()
+
+	for name, value := range normalized {
+		if value == "" {
+			delete(target, name)
+		} else {
+			target[name] = value
+		}
+	}
+
+	key := metadataKey(tenant)
+	if len(target) == 0 {
+		if len(current) == 0 {
+			return nil
+		}
+		return s.metadata.DeleteMetadata(ctx, key)
+	}
+	value, err := json.Marshal(target)
+	if err != nil {
+		return fmt.Errorf("marshal module credentials: %w", err)
+	}
+	return s.metadata.PutMetadata(ctx, key, value)
+}
+
+// Delete removes all credentials of the tenant, or all credentials for all
+// tenants if tenant is empty. The credentials of single tenants are kept in
+// the latter case.
+func (s *Store) Delete(ctx context.Context, tenant string) error {
+	if s == nil {
+		return fmt.Errorf("module credentials are not enabled")
+	}
+
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	if s.metadata == nil {
+		return errNoMetadataStore
+	}
+
+	s.RLock()
+	current := s.global
+	if tenant != "" {
+		var ok bool
+		if current, ok = s.tenants[tenant]; !ok {
+			s.RUnlock()
+			return fmt.Errorf("%w: %q", ErrTenantNotFound, tenant)
+		}
+	}
+	s.RUnlock()
+
+	if len(current) == 0 {
+		return nil
+	}
+	return s.metadata.DeleteMetadata(ctx, metadataKey(tenant))
+}
+
+// List returns the names of the stored credentials, never their values
+func (s *Store) List() Listing {
+	listing := Listing{Global: []string{}, Tenants: map[string][]string{}}
+	if s == nil {
+		return listing
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	listing.Global = sortedNames(s.global)
+	for tenant, values := range s.tenants {
+		listing.Tenants[tenant] = sortedNames(values)
+	}
+	return listing
+}
+
+// HasTenants reports whether credentials are stored for any single tenant.
+// If not, the tenant of a request does not need to be determined.
+func (s *Store) HasTenants() bool {
+	if s == nil {
+		return false
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+	return len(s.tenants) > 0
+}
+
+// Resolve returns the credentials which apply to requests of the tenant. An
+// empty tenant only resolves the credentials of all tenants. The tenant must
+// be the one the principal of the request is bound to, never a tenant named
+// by the request, or the credentials of any tenant could be used.
+func (s *Store) Resolve(tenant string) map[string]string {
+	if s == nil {
+		return nil
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	tenantValues := s.tenants[tenant]
+	if len(s.global) == 0 && len(tenantValues) == 0 {
+		return nil
+	}
+
+	resolved := make(map[string]string, len(s.global)+len(tenantValues))
+	for name, value := range s.global {
+		resolved[name] = value
+	}
+	for name, value := range tenantValues {
+		resolved[name] = value
+	}
+	return resolved
+}
+
+func metadataKey(tenant string) string {
+	if tenant == "" {
+		return globalKey
+	}
+	return tenantsPrefix + tenant
+}
+
+func sortedNames(values map[string]string) []string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modulecredentials
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	metadata := newFakeMetadata()
+	s := New(logger)
+
+	t.Run("changes require the metadata store", func(t *testing.T) {
+		err := s.Set(ctx, "", map[string]string{"X-Openai-Api-Key": "key"})
+		assert.ErrorIs(t, err, errNoMetadataStore)
+		require.Nil(t, s.SetMetadataStore(metadata))
+	})
+
+	t.Run("names are normalized to request headers", func(t *testing.T) {
+		require.Nil(t, s.Set(ctx, "", map[string]string{
+			"x-openai-api-key": "global-openai",
+			"X-Cohere-Api-Key": "global-cohere",
+		}))
+		require.Nil(t, s.Set(ctx, "tenant1", map[string]string{
+			"X-Openai-Api-Key": "tenant1-openai",
+			"X-Openai-Baseurl": "https://tenant1.example.com",
+		}))
+
+		assert.Equal(t, Listing{
+			Global: []string{"X-Cohere-Api-Key", "X-Openai-Api-Key"},
+			Tenants: map[string][]string{
+				"tenant1": {"X-Openai-Api-Key", "X-Openai-Baseurl"},
+			},
+		}, s.List())
+	})
+
+	t.Run("tenant values take precedence", func(t *testing.T) {
+		assert.Equal(t, map[string]string{
+			"X-Openai-Api-Key": "tenant1-openai",
+			"X-Openai-Baseurl": "https://tenant1.example.com",
+			"X-Cohere-Api-Key": "global-cohere",
+		}, s.Resolve("tenant1"))
+		assert.Equal(t, map[string]string{
+			"X-Openai-Api-Key": "global-openai",
+			"X-Cohere-Api-Key": "global-cohere",
+		}, s.Resolve("tenant2"))
+	})
+
+	t.Run("invalid names are rejected", func(t *testing.T) {
+		assert.NotNil(t, s.Set(ctx, "", map[string]string{"Authorization": "secret"}))
+		assert.NotNil(t, s.Set(ctx, "", map[string]string{TenantHeader: "tenant1"}))
+	})
+
+	t.Run("credentials are shared through the metadata", func(t *testing.T) {
+		other := New(logger)
+		require.Nil(t, other.SetMetadataStore(metadata))
+		assert.Equal(t, s.Resolve("tenant1"), other.Resolve("tenant1"))
+		assert.True(t, other.HasTenants())
+
+		require.Nil(t, other.Set(ctx, "tenant2", map[string]string{"X-Cohere-Api-Key": "tenant2"}))
+		assert.Equal(t, "tenant2", s.Resolve("tenant2")["X-Cohere-Api-Key"])
+		require.Nil(t, other.Delete(ctx, "tenant2"))
+		assert.Equal(t, "global-cohere", s.Resolve("tenant2")["X-Cohere-Api-Key"])
+	})
+
+	t.Run("empty values remove credentials", func(t *testing.T) {
+		require.Nil(t, s.Set(ctx, "", map[string]string{"X-Cohere-Api-Key": ""}))
+		require.Nil(t, s.Set(ctx, "tenant1", map[string]string{
+			"X-Openai-Api-Key": "",
+			"X-Openai-Baseurl": "",
+		}))
+
+		assert.Equal(t, Listing{
+			Global:  []string{"X-Openai-Api-Key"},
+			Tenants: map[string][]string{},
+		}, s.List())
+		assert.False(t, s.HasTenants())
+		assert.Len(t, metadata.Metadata(credentialsPrefix), 1)
+	})
+
+	t.Run("deleting", func(t *testing.T) {
+		assert.ErrorIs(t, s.Delete(ctx, "tenant1"), ErrTenantNotFound)
+		require.Nil(t, s.Delete(ctx, ""))
+		assert.Nil(t, s.Resolve(""))
+		assert.Empty(t, metadata.Metadata(credentialsPrefix))
+	})
+}
+
+func TestStoreNil(t *testing.T) {
+	var s *Store
+	assert.Nil(t, s.Resolve("tenant1"))
+	assert.Equal(t, Listing{Global: []string{}, Tenants: map[string][]string{}}, s.List())
+	assert.False(t, s.HasTenants())
+	assert.NotNil(t, s.Set(context.Background(), "", map[string]string{"X-Openai-Api-Key": "key"}))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/tokens"
 )
 
 func newDummyModule(name string, t modulecapabilities.ModuleType) modulecapabilities.Module {
@@ -67,6 +68,7 @@ func (m dummyText2VecModuleNoCapabilities) VectorizeObject(ctx context.Context,
 	in *models.Object, objDiff *moduletools.ObjectDiff, cfg moduletools.ClassConfig,
 ) error {
 	in.Vector = []float32{1, 2, 3}
+	tokens.Report(ctx, 3, 0)
 	return nil
 }
 
@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Metrics of the vectorizations by the modules
+type Metrics struct {
+	durations  *prometheus.HistogramVec
+	classLabel func(string) string
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		durations:  prom.VectorizerDurations,
+		classLabel: prom.ClassLabel,
+	}
+}
+
+// vectorized observes the duration of a vectorization of an object, an
+// input or a search param, the class is empty for cross class searches
+func (m *Metrics) vectorized(module, operation, class string, start time.Time) {
+	if m == nil {
+		return
+	}
+
+	m.durations.With(prometheus.Labels{
+		"module":     module,
+		"operation":  operation,
+		"class_name": m.classLabel(class),
+	}).Observe(time.Since(start).Seconds())
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/failover"
 )
 
 // SetClassDefaults sets the module-specific defaults for the class itself, but
@@ -135,10 +136,18 @@ func (p *Provider) ValidateClass(ctx context.Context, class *models.Class) error
 		}
 
 		cfg := NewClassBasedModuleConfig(class, key, "")
-		err := cc.ValidateClass(ctx, class, cfg)
-		if err != nil {
+		if err := failover.Validate(cfg.Class()); err != nil {
 			return errors.Wrapf(err, "module '%s'", key)
 		}
+		for i, cfg := range failover.Configs(cfg, key) {
+			err := cc.ValidateClass(ctx, class, cfg)
+			if err != nil && i > 0 {
+				return errors.Wrapf(err, "module '%s' fallback %d", key, i-1)
+			}
+			if err != nil {
+				return errors.Wrapf(err, "module '%s'", key)
+			}
+		}
 	}
 
 	return nil
@@ -221,6 +221,49 @@ func TestValidateClass(t *testing.T) {
 		require.NotNil(t, err)
 		assert.Equal(t, "module 'my-module': no can do!", err.Error())
 	})
+
+	t.Run("the fallbacks are validated with their overrides", func(t *testing.T) {
+		newClass := func(fallbacks interface{}) *models.Class {
+			return &models.Class{
+				Class:      "Foo",
+				Vectorizer: "my-module",
+				ModuleConfig: map[string]interface{}{
+					"my-module": map[string]interface{}{
+						"model":     "good",
+						"fallbacks": fallbacks,
+					},
+				},
+			}
+		}
+
+		p := NewProvider()
+		p.Register(&dummyModuleClassConfigurator{
+			validateFn: func(cfg moduletools.ClassConfig) error {
+				if cfg.Class()["model"] != "good" {
+					return errors.Errorf("model %v is not supported", cfg.Class()["model"])
+				}
+				return nil
+			},
+			dummyText2VecModuleNoCapabilities: dummyText2VecModuleNoCapabilities{
+				name: "my-module",
+			},
+		})
+
+		assert.Nil(t, p.ValidateClass(ctx, newClass([]interface{}{
+			map[string]interface{}{"baseURL": "http://local"},
+		})))
+
+		err := p.ValidateClass(ctx, newClass([]interface{}{
+			map[string]interface{}{"baseURL": "http://local"},
+			map[string]interface{}{"model": nil},
+		}))
+		require.NotNil(t, err)
+		assert.Equal(t, "module 'my-module' fallback 1: model <nil> is not supported", err.Error())
+
+		err = p.ValidateClass(ctx, newClass("openai"))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "fallbacks must be a list of objects")
+	})
 }
 
 func TestSetSinglePropertyDefaults(t *testing.T) {
@@ -278,6 +321,7 @@ func TestSetSinglePropertyDefaults(t *testing.T) {
 type dummyModuleClassConfigurator struct {
 	dummyText2VecModuleNoCapabilities
 	validateError error
+	validateFn    func(cfg moduletools.ClassConfig) error
 }
 
 func (d *dummyModuleClassConfigurator) ClassConfigDefaults() map[string]interface{} {
@@ -299,6 +343,9 @@ func (d *dummyModuleClassConfigurator) PropertyConfigDefaults(
 func (d *dummyModuleClassConfigurator) ValidateClass(ctx context.Context,
 	class *models.Class, cfg moduletools.ClassConfig,
 ) error {
+	if d.validateFn != nil {
+		return d.validateFn(cfg)
+	}
 	return d.validateError
 }
 
@@ -14,6 +14,8 @@ package modules
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -24,6 +26,10 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/tokens"
+	"github.com/weaviate/weaviate/entities/tracing"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/failover"
+	"github.com/weaviate/weaviate/usecases/usage"
 )
 
 var (
@@ -39,6 +45,9 @@ type Provider struct {
 	altNames               map[string]string
 	schemaGetter           schemaGetter
 	hasMultipleVectorizers bool
+	metrics                *Metrics
+	breakers               *failover.Breakers
+	usage                  *usage.Tracker
 }
 
 type schemaGetter interface {
@@ -49,6 +58,7 @@ func NewProvider() *Provider {
 	return &Provider{
 		registered: map[string]modulecapabilities.Module{},
 		altNames:   map[string]string{},
+		breakers:   failover.NewBreakers(failover.DefaultThreshold, failover.DefaultCooldown),
 	}
 }
 
@@ -96,6 +106,16 @@ func (p *Provider) SetSchemaGetter(sg schemaGetter) {
 	p.schemaGetter = sg
 }
 
+// SetMetrics sets the metrics of the vectorizations, optional
+func (p *Provider) SetMetrics(metrics *Metrics) {
+	p.metrics = metrics
+}
+
+// SetUsage sets the tracker of the calls and tokens of the modules, optional
+func (p *Provider) SetUsage(tracker *usage.Tracker) {
+	p.usage = tracker
+}
+
 func (p *Provider) Init(ctx context.Context,
 	params moduletools.ModuleInitParams, logger logrus.FieldLogger,
 ) error {
@@ -492,12 +512,14 @@ func (p *Provider) additionalExtend(ctx context.Context, in []search.Result,
 			return nil, err
 		}
 		allAdditionalProperties := map[string]modulecapabilities.AdditionalProperty{}
+		propertyModules := map[string]string{}
 		for _, module := range p.GetAll() {
 			if p.shouldIncludeClassArgument(class, module.Name(), module.Type()) {
 				if arg, ok := module.(modulecapabilities.AdditionalProperties); ok {
 					if arg != nil && arg.AdditionalProperties() != nil {
 						for name, additionalProperty := range arg.AdditionalProperties() {
 							allAdditionalProperties[name] = additionalProperty
+							propertyModules[name] = module.Name()
 						}
 					}
 				}
@@ -516,7 +538,13 @@ func (p *Provider) additionalExtend(ctx context.Context, in []search.Result,
 						searchVectorValue.SetSearchVector(searchVector)
 						searchValue = searchVectorValue
 					}
+					ctx, span := startModuleSpan(ctx, "AdditionalProperty", name)
+					ctx, meter := tokens.WithMeter(ctx)
 					resArray, err := additionalPropertyFn(ctx, toBeExtended, searchValue, nil, argumentModuleParams, cfg)
+					p.usage.Record(ctx, propertyModules[name], class.Class,
+						toBeExtended[0].Tenant, meter.Counts())
+					span.RecordError(err)
+					span.End()
 					if err != nil {
 						return nil, errors.Errorf("extend %s: %v", name, err)
 					}
@@ -629,7 +657,19 @@ func (p *Provider) VectorFromSearchParam(ctx context.Context,
 			if vectorSearches != nil {
 				if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
 					cfg := NewClassBasedModuleConfig(class, moduleName, tenant)
-					vector, err := searchVectorFn(ctx, params, class.Class, findVectorFn, cfg)
+					ctx, span := startModuleSpan(ctx, "VectorFromSearchParam", moduleName)
+					span.SetAttribute("param", param)
+					before := time.Now()
+					ctx, meter := tokens.WithMeter(ctx)
+					vector, err := failover.Do(ctx, p.breakers, failoverKey(class.Class, moduleName),
+						failover.Configs(cfg, moduleName),
+						func(cfg moduletools.ClassConfig) ([]float32, error) {
+							return searchVectorFn(ctx, params, class.Class, findVectorFn, cfg)
+						})
+					p.metrics.vectorized(moduleName, "search", class.Class, before)
+					p.usage.Record(ctx, moduleName, class.Class, tenant, meter.Counts())
+					span.RecordError(err)
+					span.End()
 					if err != nil {
 						return nil, errors.Errorf("vectorize params: %v", err)
 					}
@@ -654,7 +694,15 @@ func (p *Provider) CrossClassVectorFromSearchParam(ctx context.Context,
 			if vectorSearches := searcher.VectorSearches(); vectorSearches != nil {
 				if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
 					cfg := NewCrossClassModuleConfig()
+					ctx, span := startModuleSpan(ctx, "CrossClassVectorFromSearchParam", mod.Name())
+					span.SetAttribute("param", param)
+					before := time.Now()
+					ctx, meter := tokens.WithMeter(ctx)
 					vector, err := searchVectorFn(ctx, params, "", findVectorFn, cfg)
+					p.metrics.vectorized(mod.Name(), "search", "", before)
+					p.usage.Record(ctx, mod.Name(), "", "", meter.Counts())
+					span.RecordError(err)
+					span.End()
 					if err != nil {
 						return nil, errors.Errorf("vectorize params: %v", err)
 					}
@@ -680,7 +728,19 @@ func (p *Provider) VectorFromInput(ctx context.Context,
 			if vectorizer, ok := mod.(modulecapabilities.InputVectorizer); ok {
 				// does not access any objects, therefore tenant is irrelevant
 				cfg := NewClassBasedModuleConfig(class, mod.Name(), "")
-				return vectorizer.VectorizeInput(ctx, input, cfg)
+				ctx, span := startModuleSpan(ctx, "VectorizeInput", mod.Name())
+				defer span.End()
+				before := time.Now()
+				ctx, meter := tokens.WithMeter(ctx)
+				vector, err := failover.Do(ctx, p.breakers, failoverKey(class.Class, mod.Name()),
+					failover.Configs(cfg, mod.Name()),
+					func(cfg moduletools.ClassConfig) ([]float32, error) {
+						return vectorizer.VectorizeInput(ctx, input, cfg)
+					})
+				p.metrics.vectorized(mod.Name(), "input", className, before)
+				p.usage.Record(ctx, mod.Name(), className, "", meter.Counts())
+				span.RecordError(err)
+				return vector, err
 			}
 		}
 	}
@@ -770,3 +830,76 @@ func (p *Provider) BackupBackend(backend string) (modulecapabilities.BackupBacke
 	}
 	return nil, errors.Errorf("backup: %s not found", backend)
 }
+
+// ExtractFile turns file into the properties of an object of the class with
+// the enabled File2Text module
+func (p *Provider) ExtractFile(ctx context.Context, className string,
+	file modulecapabilities.File,
+) (map[string]interface{}, error) {
+	class, err := p.getClass(className)
+	if err != nil {
+		return nil, err
+	}
+	for _, mod := range p.GetAll() {
+		if mod.Type() != modulecapabilities.File2Text {
+			continue
+		}
+		if extractor, ok := mod.(modulecapabilities.FileExtractor); ok {
+			ctx, span := startModuleSpan(ctx, "ExtractFile", mod.Name())
+			defer span.End()
+			cfg := NewClassBasedModuleConfig(class, mod.Name(), "")
+			props, err := extractor.ExtractFile(ctx, file, cfg)
+			span.RecordError(err)
+			return props, err
+		}
+	}
+	return nil, errors.Errorf("no %s module enabled", modulecapabilities.File2Text)
+}
+
+// EnrichObject lets every module configured for the class which is an
+// ObjectEnricher add its derived properties to object
+func (p *Provider) EnrichObject(ctx context.Context, object *models.Object,
+	class *models.Class,
+) error {
+	moduleConfig, ok := class.ModuleConfig.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(moduleConfig))
+	for name := range moduleConfig {
+		names = append(names, name)
+	}
+	// enrichers run in a stable order
+	sort.Strings(names)
+
+	for _, name := range names {
+		enricher, ok := p.GetByName(name).(modulecapabilities.ObjectEnricher)
+		if !ok {
+			continue
+		}
+		if err := p.enrichObject(ctx, enricher, name, object, class); err != nil {
+			return errors.Wrapf(err, "module '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (p *Provider) enrichObject(ctx context.Context, enricher modulecapabilities.ObjectEnricher,
+	name string, object *models.Object, class *models.Class,
+) error {
+	ctx, span := startModuleSpan(ctx, "EnrichObject", name)
+	defer span.End()
+	cfg := NewClassBasedModuleConfig(class, name, object.Tenant)
+	err := enricher.EnrichObject(ctx, object, class, cfg)
+	span.RecordError(err)
+	return err
+}
+
+// startModuleSpan starts the span of a call to a module of a traced
+// request. Module clients propagate it to their provider.
+func startModuleSpan(ctx context.Context, operation, module string) (context.Context, *tracing.Span) {
+	ctx, span := tracing.Start(ctx, "module."+operation)
+	span.SetAttribute("module", module)
+	return ctx, span
+}
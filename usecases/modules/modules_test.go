@@ -20,6 +20,7 @@ import (
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tailor-inc/graphql"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
@@ -348,6 +349,24 @@ func TestModulesProvider(t *testing.T) {
 		assert.NotNil(t, backendByAltName)
 		assert.Nil(t, err2)
 	})
+
+	t.Run("should let configured modules enrich objects", func(t *testing.T) {
+		modulesProvider := NewProvider()
+		modulesProvider.Register(&dummyEnricherModule{name: "enricher"})
+		modulesProvider.Register(&dummyEnricherModule{name: "unconfigured"})
+
+		class := &models.Class{
+			Class: "Article",
+			ModuleConfig: map[string]interface{}{
+				"enricher": map[string]interface{}{"property": "enriched"},
+			},
+		}
+		obj := &models.Object{Class: "Article", Properties: map[string]interface{}{}}
+
+		err := modulesProvider.EnrichObject(context.Background(), obj, class)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"enriched": "enricher"}, obj.Properties)
+	})
 }
 
 func fakeExtractFn(param map[string]interface{}) interface{} {
@@ -543,3 +562,31 @@ func (m *dummyBackupModuleWithAltNames) PutObject(ctx context.Context, backupID,
 func (m *dummyBackupModuleWithAltNames) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
+
+type dummyEnricherModule struct {
+	name string
+}
+
+func (m *dummyEnricherModule) Name() string {
+	return m.name
+}
+
+func (m *dummyEnricherModule) Init(ctx context.Context, params moduletools.ModuleInitParams) error {
+	return nil
+}
+
+func (m *dummyEnricherModule) RootHandler() http.Handler {
+	return nil
+}
+
+func (m *dummyEnricherModule) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Text2TextNER
+}
+
+func (m *dummyEnricherModule) EnrichObject(ctx context.Context, object *models.Object,
+	class *models.Class, cfg moduletools.ClassConfig,
+) error {
+	property, _ := cfg.Class()["property"].(string)
+	object.Properties.(map[string]interface{})[property] = m.name
+	return nil
+}
@@ -14,6 +14,7 @@ package modules
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -21,9 +22,11 @@ import (
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tokens"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/failover"
 )
 
 const (
@@ -81,6 +84,23 @@ func (p *Provider) UsingRef2Vec(className string) bool {
 	return false
 }
 
+// RefVectorRecomputeInterval is the interval in which the ref2vec module of
+// the class wants its vectors recomputed, 0 if they don't need to be
+func (p *Provider) RefVectorRecomputeInterval(class *models.Class) time.Duration {
+	cfg, ok := class.ModuleConfig.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+
+	for modName := range cfg {
+		if recomputer, ok := p.GetByName(modName).(modulecapabilities.ReferenceVectorRecomputer); ok {
+			return recomputer.RecomputeInterval(NewClassBasedModuleConfig(class, modName, ""))
+		}
+	}
+
+	return 0
+}
+
 func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, class *models.Class,
 	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
 	logger logrus.FieldLogger,
@@ -124,17 +144,32 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	}
 
 	cfg := NewClassBasedModuleConfig(class, found.Name(), "")
+	ctx, span := startModuleSpan(ctx, "VectorizeObject", found.Name())
+	defer span.End()
 
 	if vectorizer, ok := found.(modulecapabilities.Vectorizer); ok {
 		if object.Vector == nil {
-			if err := vectorizer.VectorizeObject(ctx, object, objectDiff, cfg); err != nil {
+			before := time.Now()
+			ctx, meter := tokens.WithMeter(ctx)
+			_, err := failover.Do(ctx, p.breakers, failoverKey(class.Class, found.Name()),
+				failover.Configs(cfg, found.Name()),
+				func(cfg moduletools.ClassConfig) (struct{}, error) {
+					return struct{}{}, vectorizer.VectorizeObject(ctx, object, objectDiff, cfg)
+				})
+			p.metrics.vectorized(found.Name(), "object", object.Class, before)
+			p.usage.Record(ctx, found.Name(), object.Class, object.Tenant, meter.Counts())
+			if err != nil {
+				span.RecordError(err)
 				return fmt.Errorf("update vector: %w", err)
 			}
 		}
 	} else {
 		refVectorizer := found.(modulecapabilities.ReferenceVectorizer)
-		if err := refVectorizer.VectorizeObject(
-			ctx, object, cfg, findObjectFn); err != nil {
+		before := time.Now()
+		err := refVectorizer.VectorizeObject(ctx, object, cfg, findObjectFn)
+		p.metrics.vectorized(found.Name(), "object", object.Class, before)
+		if err != nil {
+			span.RecordError(err)
 			return fmt.Errorf("update reference vector: %w", err)
 		}
 	}
@@ -142,6 +177,12 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	return nil
 }
 
+// failoverKey identifies the providers of a module for a class, their
+// circuits are separate from the ones of other classes
+func failoverKey(className, moduleName string) string {
+	return className + "/" + moduleName
+}
+
 func (p *Provider) VectorizerName(className string) (string, error) {
 	name, _, err := p.getClassVectorizer(className)
 	if err != nil {
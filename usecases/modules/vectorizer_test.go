@@ -20,10 +20,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/usage"
 )
 
 func TestProvider_ValidateVectorizer(t *testing.T) {
@@ -178,6 +180,38 @@ func TestProvider_UpdateVector(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("with usage tracker", func(t *testing.T) {
+		modName := "some-vzr"
+		className := "SomeClass"
+		class := models.Class{
+			Class: className,
+			ModuleConfig: map[string]interface{}{
+				modName: struct{}{},
+			},
+			VectorIndexConfig: hnsw.UserConfig{},
+		}
+		logger, _ := test.NewNullLogger()
+		tracker := usage.NewTracker(nil)
+
+		p := NewProvider()
+		p.Register(newDummyModule(modName, modulecapabilities.Text2Vec))
+		p.SetSchemaGetter(&fakeSchemaGetter{schema.Schema{
+			Objects: &models.Schema{Classes: []*models.Class{&class}},
+		}})
+		p.SetUsage(tracker)
+
+		ctx := usage.WithPrincipal(context.Background(),
+			&models.Principal{Username: "alice", APIKeyID: "key1"}, "")
+		obj := &models.Object{Class: className, ID: newUUID(), Tenant: "tenant1"}
+		err := p.UpdateVector(ctx, obj, &class, nil, (&fakeObjectsRepo{}).Object, logger)
+		require.Nil(t, err)
+
+		assert.Equal(t, []usage.Totals{{
+			Module: modName, Class: className, Tenant: "tenant1", User: "alice",
+			APIKeyID: "key1", Calls: 1, InputTokens: 3,
+		}}, tracker.Report(usage.Filter{}).Totals)
+	})
+
 	t.Run("with ReferenceVectorizer", func(t *testing.T) {
 		ctx := context.Background()
 		modName := "some-vzr"
@@ -31,6 +31,9 @@ type PrometheusMetrics struct {
 	LSMSegmentSize                     *prometheus.GaugeVec
 	LSMMemtableSize                    *prometheus.GaugeVec
 	LSMMemtableDurations               *prometheus.SummaryVec
+	LSMFlushedBytes                    *prometheus.CounterVec
+	LSMCompactedBytes                  *prometheus.CounterVec
+	LSMWriteAmplification              *prometheus.GaugeVec
 	VectorIndexTombstones              *prometheus.GaugeVec
 	VectorIndexTombstoneCleanupThreads *prometheus.GaugeVec
 	VectorIndexTombstoneCleanedCount   *prometheus.CounterVec
@@ -66,7 +69,95 @@ type PrometheusMetrics struct {
 	ShardsLoading   *prometheus.GaugeVec
 	ShardsUnloading *prometheus.GaugeVec
 
+	QuotaUsage      *prometheus.GaugeVec
+	QuotaLimit      *prometheus.GaugeVec
+	QuotaRejections *prometheus.CounterVec
+
+	TenantOffloads            *prometheus.CounterVec
+	TenantActivations         *prometheus.CounterVec
+	TenantActivationDurations *prometheus.HistogramVec
+
+	BackupScheduleRuns        *prometheus.CounterVec
+	BackupScheduleLastSuccess *prometheus.GaugeVec
+	BackupSchedulePruned      *prometheus.CounterVec
+
+	StandbyReplicationLag *prometheus.GaugeVec
+	StandbyAppliedChanges *prometheus.CounterVec
+	StandbySyncs          *prometheus.CounterVec
+	StandbyLastSync       prometheus.Gauge
+
+	ChangeStreamPublished *prometheus.CounterVec
+
+	AsyncReplicationSyncs           *prometheus.CounterVec
+	AsyncReplicationSyncDurations   *prometheus.HistogramVec
+	AsyncReplicationDivergedLeaves  *prometheus.CounterVec
+	AsyncReplicationRepairedObjects *prometheus.CounterVec
+	AsyncReplicationConflicts       *prometheus.CounterVec
+	AsyncReplicationBytes           *prometheus.CounterVec
+
+	ShardMoves         *prometheus.CounterVec
+	ShardMoveDurations *prometheus.HistogramVec
+	ShardMoveBytes     *prometheus.CounterVec
+
+	ScrubDurations    *prometheus.HistogramVec
+	ScrubCorruptFiles *prometheus.CounterVec
+
+	BatchQueuedObjects          prometheus.Gauge
+	BatchSuggestedSize          prometheus.Gauge
+	BatchBackpressureRejections *prometheus.CounterVec
+
+	IngestQueuePendingObjects prometheus.Gauge
+	IngestQueueLag            prometheus.Gauge
+	IngestQueueAppliedObjects *prometheus.CounterVec
+
+	SchedulerRunning       *prometheus.GaugeVec
+	SchedulerWaitDurations *prometheus.HistogramVec
+
+	QueryAdmissionInFlight      *prometheus.GaugeVec
+	QueryAdmissionWaitDurations *prometheus.HistogramVec
+	QueryAdmissionRejections    *prometheus.CounterVec
+	QueryAdmissionBreakerOpen   *prometheus.GaugeVec
+
+	IdempotencyRequests    *prometheus.CounterVec
+	IdempotencyStoredBytes prometheus.Gauge
+
+	QueryCacheLookups *prometheus.CounterVec
+
+	VectorizerDurations *prometheus.HistogramVec
+	ModuleCalls         *prometheus.CounterVec
+	ModuleTokens        *prometheus.CounterVec
+	FilterSelectivity   *prometheus.HistogramVec
+	VectorIndexSearchEf *prometheus.HistogramVec
+	IndexQueueDepth     *prometheus.HistogramVec
+
+	DiskFreeBytes         prometheus.Gauge
+	DiskReadOnlyShards    prometheus.Gauge
+	DiskStatusTransitions *prometheus.CounterVec
+
 	Group bool
+	// DisableClassLabels and DisableShardLabels aggregate the metrics of all
+	// classes or shards (including tenants) into a single "n/a" label, to
+	// keep the cardinality low on clusters with many classes or tenants
+	DisableClassLabels bool
+	DisableShardLabels bool
+}
+
+// ClassLabel is the value of the class_name label of a metric for the
+// class, which is "n/a" if class labels are aggregated
+func (pm *PrometheusMetrics) ClassLabel(className string) string {
+	if pm != nil && (pm.Group || pm.DisableClassLabels) {
+		return "n/a"
+	}
+	return className
+}
+
+// ShardLabel is the value of the shard_name or tenant label of a metric for
+// the shard, which is "n/a" if shard labels are aggregated
+func (pm *PrometheusMetrics) ShardLabel(shardName string) string {
+	if pm != nil && (pm.Group || pm.DisableShardLabels) {
+		return "n/a"
+	}
+	return shardName
 }
 
 // Delete Shard deletes existing label combinations that match both
@@ -78,12 +169,13 @@ type PrometheusMetrics struct {
 // In addition, there are some metrics that we explicitly keep, such
 // as vector_dimensions_sum as they can be used in billing decisions.
 func (pm *PrometheusMetrics) DeleteShard(className, shardName string) error {
-	if pm == nil {
+	if pm == nil || pm.ShardLabel(shardName) != shardName {
+		// the shard's metrics are aggregated with the ones of other shards
 		return nil
 	}
 
 	labels := prometheus.Labels{
-		"class_name": className,
+		"class_name": pm.ClassLabel(className),
 		"shard_name": shardName,
 	}
 	pm.BatchTime.DeletePartialMatch(labels)
@@ -99,6 +191,9 @@ func (pm *PrometheusMetrics) DeleteShard(className, shardName string) error {
 	pm.LSMSegmentCount.DeletePartialMatch(labels)
 	pm.LSMSegmentSize.DeletePartialMatch(labels)
 	pm.LSMSegmentCountByLevel.DeletePartialMatch(labels)
+	pm.LSMFlushedBytes.DeletePartialMatch(labels)
+	pm.LSMCompactedBytes.DeletePartialMatch(labels)
+	pm.LSMWriteAmplification.DeletePartialMatch(labels)
 	pm.VectorIndexTombstones.DeletePartialMatch(labels)
 	pm.VectorIndexTombstoneCleanupThreads.DeletePartialMatch(labels)
 	pm.VectorIndexTombstoneCleanedCount.DeletePartialMatch(labels)
@@ -109,6 +204,9 @@ func (pm *PrometheusMetrics) DeleteShard(className, shardName string) error {
 	pm.StartupProgress.DeletePartialMatch(labels)
 	pm.StartupDurations.DeletePartialMatch(labels)
 	pm.StartupDiskIO.DeletePartialMatch(labels)
+	pm.FilterSelectivity.DeletePartialMatch(labels)
+	pm.VectorIndexSearchEf.DeletePartialMatch(labels)
+	pm.IndexQueueDepth.DeletePartialMatch(labels)
 	return nil
 }
 
@@ -116,7 +214,7 @@ func (pm *PrometheusMetrics) DeleteShard(className, shardName string) error {
 // not have a shard-specific label. See [DeleteShard] for more
 // information.
 func (pm *PrometheusMetrics) DeleteClass(className string) error {
-	if pm == nil {
+	if pm == nil || pm.ClassLabel(className) != className {
 		return nil
 	}
 
@@ -133,6 +231,9 @@ func (pm *PrometheusMetrics) DeleteClass(className string) error {
 	pm.BackupRestoreDataTransferred.DeletePartialMatch(labels)
 	pm.BackupStoreDataTransferred.DeletePartialMatch(labels)
 	pm.QueriesFilteredVectorDurations.DeletePartialMatch(labels)
+	pm.VectorizerDurations.DeletePartialMatch(labels)
+	pm.ModuleCalls.DeletePartialMatch(labels)
+	pm.ModuleTokens.DeletePartialMatch(labels)
 
 	return nil
 }
@@ -148,6 +249,8 @@ func init() {
 
 func InitConfig(cfg config.Monitoring) {
 	metrics.Group = cfg.Group
+	metrics.DisableClassLabels = cfg.DisableClassLabels
+	metrics.DisableShardLabels = cfg.DisableShardLabels
 }
 
 func GetMetrics() *PrometheusMetrics {
@@ -235,6 +338,18 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "lsm_memtable_durations_ms",
 			Help: "Time in ms for a bucket operation to complete",
 		}, []string{"strategy", "class_name", "shard_name", "path", "operation"}),
+		LSMFlushedBytes: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_flushed_bytes",
+			Help: "Bytes of segments written by memtable flushes",
+		}, []string{"strategy", "class_name", "shard_name"}),
+		LSMCompactedBytes: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_compacted_bytes",
+			Help: "Bytes of segments written by compactions",
+		}, []string{"strategy", "class_name", "shard_name"}),
+		LSMWriteAmplification: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "lsm_write_amplification",
+			Help: "Bytes written by flushes and compactions per byte flushed since the bucket was loaded",
+		}, []string{"strategy", "class_name", "shard_name", "path"}),
 
 		// Vector index metrics
 		VectorIndexTombstones: promauto.NewGaugeVec(prometheus.GaugeOpts{
@@ -347,6 +462,243 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "shards_unloading",
 			Help: "Number of shards in process of unloading",
 		}, []string{"class_name"}),
+
+		// Quota metrics
+		QuotaUsage: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "quota_usage",
+			Help: "Current consumption of a quota, i.e. the number of objects or vector bytes",
+		}, []string{"class_name", "tenant", "quota"}),
+		QuotaLimit: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "quota_limit",
+			Help: "Configured limit of a quota",
+		}, []string{"class_name", "tenant", "quota"}),
+		QuotaRejections: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "quota_rejections_total",
+			Help: "Number of requests and objects rejected because a quota was exceeded",
+		}, []string{"class_name", "tenant", "quota"}),
+
+		// Tenant offload metrics
+		TenantOffloads: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "tenant_offloads_total",
+			Help: "Number of idle tenants moved to cloud storage",
+		}, []string{"class_name", "status"}),
+		TenantActivations: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "tenant_activations_total",
+			Help: "Number of offloaded tenants activated on access",
+		}, []string{"class_name", "status"}),
+		TenantActivationDurations: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "tenant_activation_durations_seconds",
+			Help:    "Duration of activating an offloaded tenant",
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 12),
+		}, []string{"class_name"}),
+
+		// Backup schedule metrics
+		BackupScheduleRuns: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "backup_schedule_runs_total",
+			Help: "Number of scheduled backups taken",
+		}, []string{"backend_name", "status"}),
+		BackupScheduleLastSuccess: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "backup_schedule_last_success_timestamp_seconds",
+			Help: "Unix time the last successful scheduled backup completed",
+		}, []string{"backend_name"}),
+		BackupSchedulePruned: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "backup_schedule_pruned_total",
+			Help: "Number of scheduled backups deleted by the retention policy",
+		}, []string{"backend_name", "status"}),
+
+		// Standby metrics
+		StandbyReplicationLag: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "standby_replication_lag_seconds",
+			Help: "Time since the changes of a primary node applied last were archived",
+		}, []string{"node"}),
+		StandbyAppliedChanges: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "standby_applied_changes_total",
+			Help: "Number of object changes of the primary applied by the standby",
+		}, []string{"node"}),
+		StandbySyncs: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "standby_syncs_total",
+			Help: "Number of syncs of the standby with its primary",
+		}, []string{"status"}),
+		StandbyLastSync: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "standby_last_sync_timestamp_seconds",
+			Help: "Unix time of the last successful sync of the standby with its primary",
+		}),
+
+		// Change stream metrics
+		ChangeStreamPublished: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "change_stream_published_events_total",
+			Help: "Number of object changes published to the change stream sink",
+		}, []string{"sink", "topic", "status"}),
+
+		// Async replication metrics
+		AsyncReplicationSyncs: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "async_replication_syncs_total",
+			Help: "Number of shards compared with their replicas",
+		}, []string{"class_name", "status"}),
+		AsyncReplicationSyncDurations: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "async_replication_sync_durations_seconds",
+			Help:    "Duration of comparing and repairing the replicas of a shard",
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 16),
+		}, []string{"class_name"}),
+		AsyncReplicationDivergedLeaves: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "async_replication_diverged_leaves_total",
+			Help: "Number of hash tree leaves which differed between replicas",
+		}, []string{"class_name"}),
+		AsyncReplicationRepairedObjects: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "async_replication_repaired_objects_total",
+			Help: "Number of stale or missing objects repaired on replicas",
+		}, []string{"class_name"}),
+		AsyncReplicationConflicts: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "async_replication_conflicts_total",
+			Help: "Number of objects deleted on some replicas only",
+		}, []string{"class_name"}),
+		AsyncReplicationBytes: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "async_replication_transferred_bytes_total",
+			Help: "Number of bytes of objects transferred to repair replicas",
+		}, []string{"class_name"}),
+
+		// Shard balancer metrics
+		ShardMoves: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "shard_moves_total",
+			Help: "Number of shard replicas moved to another node",
+		}, []string{"class_name", "trigger", "status"}),
+		ShardMoveDurations: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "shard_move_durations_seconds",
+			Help:    "Duration of moving a shard replica to another node",
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 16),
+		}, []string{"class_name"}),
+		ShardMoveBytes: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "shard_move_transferred_bytes_total",
+			Help: "Number of bytes of shard files copied by this node to move replicas",
+		}, []string{"class_name"}),
+
+		// Scrub metrics
+		ScrubDurations: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "scrub_durations_seconds",
+			Help:    "Duration of comparing the files of a shard with their checksums",
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 16),
+		}, []string{"class_name"}),
+		ScrubCorruptFiles: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "scrub_corrupt_files_total",
+			Help: "Number of files found not to match their checksums",
+		}, []string{"class_name"}),
+
+		// Batch backpressure metrics
+		BatchQueuedObjects: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "batch_queued_objects",
+			Help: "Number of objects currently being added in batch",
+		}),
+		BatchSuggestedSize: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "batch_suggested_size",
+			Help: "Batch size suggested to clients, added within the target latency under the current load",
+		}),
+		BatchBackpressureRejections: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "batch_backpressure_rejections_total",
+			Help: "Number of batches rejected, because they were too large or the node was overloaded",
+		}, []string{"reason"}),
+
+		// Ingest queue metrics
+		IngestQueuePendingObjects: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "ingest_queue_pending_objects",
+			Help: "Number of objects which were accepted, but are not written and indexed yet",
+		}),
+		IngestQueueLag: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "ingest_queue_lag_seconds",
+			Help: "Age of the oldest batch in the ingest queue which is not applied yet",
+		}),
+		IngestQueueAppliedObjects: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "ingest_queue_applied_objects_total",
+			Help: "Number of objects applied from the ingest queue, by status success or failed",
+		}, []string{"status"}),
+
+		// Priority scheduler metrics
+		SchedulerRunning: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "scheduler_running_slots",
+			Help: "Number of CPU slots currently held by interactive or bulk work",
+		}, []string{"class"}),
+		SchedulerWaitDurations: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "scheduler_wait_duration_seconds",
+			Help:    "Duration interactive or bulk work waited for a CPU slot",
+			Buckets: prometheus.ExponentialBuckets(0.0001, 4, 10),
+		}, []string{"class"}),
+
+		// Query admission metrics
+		QueryAdmissionInFlight: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "query_admission_in_flight",
+			Help: "Number of queries of a class which hold one of its query slots",
+		}, []string{"class_name"}),
+		QueryAdmissionWaitDurations: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "query_admission_wait_duration_seconds",
+			Help:    "Duration queries of a class waited for one of its query slots",
+			Buckets: prometheus.ExponentialBuckets(0.0001, 4, 10),
+		}, []string{"class_name"}),
+		QueryAdmissionRejections: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "query_admission_rejections_total",
+			Help: "Number of queries rejected, because their class was overloaded or its circuit was open",
+		}, []string{"class_name", "reason"}),
+		QueryAdmissionBreakerOpen: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "query_admission_breaker_open",
+			Help: "Whether queries of a class are failed fast after repeated overload, 1 if open",
+		}, []string{"class_name"}),
+
+		// Idempotency metrics
+		IdempotencyRequests: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "idempotency_requests_total",
+			Help: "Number of writes with an idempotency key, by result executed, replayed, in_progress or key_reused",
+		}, []string{"result"}),
+		IdempotencyStoredBytes: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "idempotency_stored_bytes",
+			Help: "Size of the responses kept for retries of writes with an idempotency key",
+		}),
+
+		// Query cache metrics
+		QueryCacheLookups: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "query_cache_lookups_total",
+			Help: "Number of queries looked up in the query cache, by result hit or miss",
+		}, []string{"class_name", "result"}),
+
+		VectorizerDurations: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "vectorizer_durations_seconds",
+			Help:    "Duration of vectorizing objects, inputs and search params by the vectorizer modules",
+			Buckets: prometheus.ExponentialBuckets(0.005, 2, 14),
+		}, []string{"module", "operation", "class_name"}),
+		ModuleCalls: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "module_calls_total",
+			Help: "Number of calls of the vectorizer and generative modules",
+		}, []string{"module", "class_name"}),
+		ModuleTokens: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "module_tokens_total",
+			Help: "Number of tokens sent to (input) and received from (output) the providers of the modules",
+		}, []string{"module", "class_name", "direction"}),
+		FilterSelectivity: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "filter_selectivity",
+			Help:    "Ratio of the objects of a shard which match the filter of a query",
+			Buckets: []float64{0.0001, 0.001, 0.01, 0.05, 0.1, 0.25, 0.5, 0.75, 1},
+		}, []string{"class_name", "shard_name"}),
+		VectorIndexSearchEf: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "vector_index_search_ef",
+			Help:    "Ef used at runtime by searches of the vector index",
+			Buckets: prometheus.ExponentialBuckets(16, 2, 10),
+		}, []string{"class_name", "shard_name"}),
+		IndexQueueDepth: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "vector_index_queue_depth",
+			Help:    "Number of vectors waiting in the index queue when it pushes them to the indexing workers",
+			Buckets: prometheus.ExponentialBuckets(100, 4, 9),
+		}, []string{"class_name", "shard_name"}),
+
+		// Disk watchdog metrics
+		DiskFreeBytes: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "disk_free_bytes",
+			Help: "Bytes available on the disk of the data path",
+		}),
+		DiskReadOnlyShards: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "disk_read_only_shards",
+			Help: "Number of shards set to read-only because the disk is full",
+		}),
+		DiskStatusTransitions: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "disk_status_transitions_total",
+			Help: "Number of times the shards were set to read-only or back to read-write because of the disk usage",
+		}, []string{"status"}),
 	}
 }
 
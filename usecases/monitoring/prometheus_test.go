@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package monitoring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabels(t *testing.T) {
+	var nilMetrics *PrometheusMetrics
+	assert.Equal(t, "Article", nilMetrics.ClassLabel("Article"))
+	assert.Equal(t, "tenant1", nilMetrics.ShardLabel("tenant1"))
+
+	pm := &PrometheusMetrics{}
+	assert.Equal(t, "Article", pm.ClassLabel("Article"))
+	assert.Equal(t, "tenant1", pm.ShardLabel("tenant1"))
+
+	pm = &PrometheusMetrics{DisableShardLabels: true}
+	assert.Equal(t, "Article", pm.ClassLabel("Article"))
+	assert.Equal(t, "n/a", pm.ShardLabel("tenant1"))
+
+	pm = &PrometheusMetrics{DisableClassLabels: true}
+	assert.Equal(t, "n/a", pm.ClassLabel("Article"))
+	assert.Equal(t, "tenant1", pm.ShardLabel("tenant1"))
+
+	pm = &PrometheusMetrics{Group: true}
+	assert.Equal(t, "n/a", pm.ClassLabel("Article"))
+	assert.Equal(t, "n/a", pm.ShardLabel("tenant1"))
+}
@@ -22,7 +22,7 @@ func (pm *PrometheusMetrics) StartLoadingShard(className string) error {
 	}
 
 	labels := prometheus.Labels{
-		"class_name": className,
+		"class_name": pm.ClassLabel(className),
 	}
 	suld, err := pm.ShardsUnloaded.GetMetricWith(labels)
 	if err != nil {
@@ -46,7 +46,7 @@ func (pm *PrometheusMetrics) FinishLoadingShard(className string) error {
 	}
 
 	labels := prometheus.Labels{
-		"class_name": className,
+		"class_name": pm.ClassLabel(className),
 	}
 
 	slding, err := pm.ShardsLoading.GetMetricWith(labels)
@@ -72,7 +72,7 @@ func (pm *PrometheusMetrics) StartUnloadingShard(className string) error {
 	}
 
 	labels := prometheus.Labels{
-		"class_name": className,
+		"class_name": pm.ClassLabel(className),
 	}
 
 	sldd, err := pm.ShardsLoaded.GetMetricWith(labels)
@@ -98,7 +98,7 @@ func (pm *PrometheusMetrics) FinishUnloadingShard(className string) error {
 	}
 
 	labels := prometheus.Labels{
-		"class_name": className,
+		"class_name": pm.ClassLabel(className),
 	}
 
 	sulding, err := pm.ShardsUnloading.GetMetricWith(labels)
@@ -125,7 +125,7 @@ func (pm *PrometheusMetrics) NewUnloadedshard(className string) error {
 	}
 
 	labels := prometheus.Labels{
-		"class_name": className,
+		"class_name": pm.ClassLabel(className),
 	}
 
 	suld, err := pm.ShardsUnloaded.GetMetricWith(labels)
@@ -21,11 +21,13 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/cdc"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
 type schemaManager interface {
 	GetSchema(principal *models.Principal) (schema.Schema, error)
+	GetSchemaSkipAuth() schema.Schema
 	AddClass(ctx context.Context, principal *models.Principal,
 		class *models.Class) error
 	GetClass(ctx context.Context, principal *models.Principal,
@@ -40,9 +42,31 @@ type schemaManager interface {
 // AddObject Class Instance to the connected DB.
 func (m *Manager) AddObject(ctx context.Context, principal *models.Principal, object *models.Object,
 	repl *additional.ReplicationProperties,
-) (*models.Object, error) {
-	err := m.authorizer.Authorize(principal, "create", "objects")
+) (_ *models.Object, err error) {
+	defaultTenant(principal, object)
+	defer func() {
+		m.auditLog.Record(ctx, principal, "create", err, objectResource(object))
+	}()
+
+	if err = assignPartition(m.schemaManager.GetSchemaSkipAuth(), object); err != nil {
+		return nil, NewErrInvalidUserInput("invalid object: %v", err)
+	}
+
+	err = m.authorizer.Authorize(principal, "create", objectResource(object))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = m.quotas.Request(object.Class, object.Tenant); err != nil {
+		return nil, err
+	}
+
+	err = createPartitions(ctx, m.partitions, m.schemaManager.GetSchemaSkipAuth(), object)
 	if err != nil {
+		return nil, NewErrInternal("create partition: %v", err)
+	}
+
+	if err = activateTenant(ctx, m.offload, object.Class, object.Tenant); err != nil {
 		return nil, err
 	}
 
@@ -55,7 +79,15 @@ func (m *Manager) AddObject(ctx context.Context, principal *models.Principal, ob
 	m.metrics.AddObjectInc()
 	defer m.metrics.AddObjectDec()
 
-	return m.addObjectToConnectorAndSchema(ctx, principal, object, repl)
+	added, err := m.addObjectToConnectorAndSchema(ctx, principal, object, repl)
+	if err != nil {
+		return nil, err
+	}
+	m.changes.Record(ctx, cdc.OpCreate, added)
+	recordSessionWrite(ctx, added.Class, added.Tenant, added.ID,
+		added.LastUpdateTimeUnix, false, repl)
+	m.queryCache.Invalidate(ctx, added.Class)
+	return m.masker.MaskedCopy(principal, m.schemaManager, added), nil
 }
 
 func (m *Manager) checkIDOrAssignNew(ctx context.Context, class string, id strfmt.UUID,
@@ -121,16 +153,34 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	if err != nil {
 		return nil, err
 	}
+	if schema.ChunkingEnabled(class) {
+		// fail before the object is stored if its chunks can't be
+		if _, err := m.chunks().targetClass(ctx, principal, class); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.modulesProvider.EnrichObject(ctx, object, class); err != nil {
+		return nil, err
+	}
 	err = m.modulesProvider.UpdateVector(ctx, object, class, nil, m.findObject, m.logger)
 	if err != nil {
 		return nil, err
 	}
 
+	err = m.quotas.Admit(ctx, object.Class, object.Tenant, 1, vectorBytes(object))
+	if err != nil {
+		return nil, err
+	}
+
 	err = m.vectorRepo.PutObject(ctx, object, object.Vector, repl)
 	if err != nil {
 		return nil, fmt.Errorf("put object: %w", err)
 	}
 
+	if err := m.chunks().write(ctx, principal, class, object, repl); err != nil {
+		return nil, err
+	}
+
 	return object, nil
 }
 
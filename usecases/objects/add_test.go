@@ -22,8 +22,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/session"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
 )
@@ -123,6 +125,27 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 		assert.Equal(t, res.ID, uuidDuringCreation, "check that connector add ID and user response match")
 	})
 
+	t.Run("write is recorded in the session", func(t *testing.T) {
+		reset()
+
+		ctx := session.WithToken(context.Background(), session.NewToken())
+		object := &models.Object{
+			Vector: []float32{0.1, 0.2, 0.3},
+			Class:  "Foo",
+		}
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		res, err := manager.AddObject(ctx, nil, object,
+			&additional.ReplicationProperties{ConsistencyLevel: "ONE"})
+		require.Nil(t, err)
+		w, ok := session.Lookup(ctx, "Foo", "", res.ID)
+		require.True(t, ok)
+		assert.Equal(t, res.LastUpdateTimeUnix, w.Time)
+		assert.Equal(t, "ONE", w.Level)
+		assert.False(t, w.Deleted)
+	})
+
 	t.Run("with an explicit (correct) uppercase id set", func(t *testing.T) {
 		reset()
 
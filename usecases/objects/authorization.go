@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// objectResource is the authorization resource of a single object which has
+// not been persisted yet
+func objectResource(object *models.Object) string {
+	if object == nil {
+		return authorization.Objects("", "", "")
+	}
+	return authorization.Objects(object.Class, object.Tenant, object.ID)
+}
+
+// defaultTenant sets the tenant of the principal on objects which do not
+// specify a tenant themselves
+func defaultTenant(principal *models.Principal, object *models.Object) {
+	if object != nil {
+		object.Tenant = authorization.TenantFor(principal, object.Tenant)
+	}
+}
+
+// batchObjectsResources returns the distinct resources touched by a batch of
+// objects, so that each collection and tenant is only authorized once
+func batchObjectsResources(objects []*models.Object) []string {
+	resources := uniqueResources{}
+	for _, object := range objects {
+		if object == nil {
+			continue
+		}
+		resources.add(authorization.Objects(object.Class, object.Tenant, ""))
+	}
+	return resources.list()
+}
+
+// batchReferencesResources returns the distinct source resources of a batch
+// of references. Sources which cannot be parsed are authorized against all
+// collections, they are rejected during validation anyway.
+func batchReferencesResources(refs []*models.BatchReference) []string {
+	resources := uniqueResources{}
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		class := ""
+		if source, err := crossref.ParseSource(string(ref.From)); err == nil {
+			class = source.Class.String()
+		}
+		resources.add(authorization.Objects(class, ref.Tenant, ""))
+	}
+	return resources.list()
+}
+
+type uniqueResources struct {
+	seen    map[string]struct{}
+	ordered []string
+}
+
+func (u *uniqueResources) add(resource string) {
+	if u.seen == nil {
+		u.seen = map[string]struct{}{}
+	}
+	if _, ok := u.seen[resource]; ok {
+		return
+	}
+	u.seen[resource] = struct{}{}
+	u.ordered = append(u.ordered, resource)
+}
+
+// list returns the resources in the order they were added. An empty batch
+// still needs to be authorized, so the wildcard resource is returned instead.
+func (u *uniqueResources) list() []string {
+	if len(u.ordered) == 0 {
+		return []string{authorization.Objects("", "", "")}
+	}
+	return u.ordered
+}
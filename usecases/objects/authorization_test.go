@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"testing"
 
@@ -24,6 +25,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -44,43 +47,51 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName:       "AddObject",
 			additionalArgs:   []interface{}{(*models.Object)(nil)},
 			expectedVerb:     "create",
-			expectedResource: "objects",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
 		},
 		{
 			methodName:       "ValidateObject",
 			additionalArgs:   []interface{}{(*models.Object)(nil)},
 			expectedVerb:     "validate",
-			expectedResource: "objects",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
 		},
 		{
 			methodName:       "GetObject",
 			additionalArgs:   []interface{}{"", strfmt.UUID("foo"), additional.Properties{}},
 			expectedVerb:     "get",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
+		},
+		{
+			methodName: "ApplyTransaction",
+			additionalArgs: []interface{}{[]TransactionOperation{
+				{Op: TransactionDelete, Class: "class", ID: strfmt.UUID("foo")},
+			}},
+			expectedVerb:     "delete",
+			expectedResource: "data/collections/Class/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "DeleteObject",
 			additionalArgs:   []interface{}{"class", strfmt.UUID("foo")},
 			expectedVerb:     "delete",
-			expectedResource: "objects/class/foo",
+			expectedResource: "data/collections/Class/tenants/*/objects/foo",
 		},
 		{ // deprecated by the one above
 			methodName:       "DeleteObject",
 			additionalArgs:   []interface{}{"", strfmt.UUID("foo")},
 			expectedVerb:     "delete",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "UpdateObject",
 			additionalArgs:   []interface{}{"class", strfmt.UUID("foo"), (*models.Object)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "objects/class/foo",
+			expectedResource: "data/collections/Class/tenants/*/objects/foo",
 		},
 		{ // deprecated by the one above
 			methodName:       "UpdateObject",
 			additionalArgs:   []interface{}{"", strfmt.UUID("foo"), (*models.Object)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
 		},
 		{
 			methodName: "MergeObject",
@@ -89,31 +100,62 @@ func Test_Kinds_Authorization(t *testing.T) {
 				(*additional.ReplicationProperties)(nil),
 			},
 			expectedVerb:     "update",
-			expectedResource: "objects/class/foo",
+			expectedResource: "data/collections/Class/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "GetObjectsClass",
 			additionalArgs:   []interface{}{strfmt.UUID("foo")},
 			expectedVerb:     "get",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "GetObjectClassFromName",
 			additionalArgs:   []interface{}{strfmt.UUID("foo")},
 			expectedVerb:     "get",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "HeadObject",
 			additionalArgs:   []interface{}{"class", strfmt.UUID("foo")},
 			expectedVerb:     "head",
-			expectedResource: "objects/class/foo",
+			expectedResource: "data/collections/Class/tenants/*/objects/foo",
 		},
 		{ // deprecated by the one above
 			methodName:       "HeadObject",
 			additionalArgs:   []interface{}{"", strfmt.UUID("foo")},
 			expectedVerb:     "head",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
+		},
+
+		// object versions
+		{
+			methodName:       "GetObjectVersions",
+			additionalArgs:   []interface{}{"class", strfmt.UUID("foo"), ""},
+			expectedVerb:     "get",
+			expectedResource: "data/collections/Class/tenants/*/objects/foo",
+		},
+		{
+			methodName:       "DiffObjectVersions",
+			additionalArgs:   []interface{}{"class", strfmt.UUID("foo"), int64(1), int64(0), ""},
+			expectedVerb:     "get",
+			expectedResource: "data/collections/Class/tenants/*/objects/foo",
+		},
+		{
+			methodName: "RollbackObject",
+			additionalArgs: []interface{}{
+				"class", strfmt.UUID("foo"), int64(1), "",
+				(*additional.ReplicationProperties)(nil),
+			},
+			expectedVerb:     "get",
+			expectedResource: "data/collections/Class/tenants/*/objects/foo",
+		},
+
+		// near-duplicate objects
+		{
+			methodName:       "FindDuplicates",
+			additionalArgs:   []interface{}{DuplicatesParams{Class: "class"}},
+			expectedVerb:     "get",
+			expectedResource: "data/collections/Class/tenants/*/objects/*",
 		},
 
 		// query objects
@@ -121,14 +163,14 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName:       "Query",
 			additionalArgs:   []interface{}{new(QueryParams)},
 			expectedVerb:     "list",
-			expectedResource: "objects",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
 		},
 
 		{ // list objects is deprecated by query
 			methodName:       "GetObjects",
 			additionalArgs:   []interface{}{(*int64)(nil), (*int64)(nil), (*string)(nil), (*string)(nil), additional.Properties{}},
 			expectedVerb:     "list",
-			expectedResource: "objects",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
 		},
 
 		// reference on objects
@@ -136,19 +178,19 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName:       "AddObjectReference",
 			additionalArgs:   []interface{}{AddReferenceInput{Class: "class", ID: strfmt.UUID("foo"), Property: "some prop"}, (*models.SingleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "objects/class/foo",
+			expectedResource: "data/collections/Class/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "DeleteObjectReference",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "UpdateObjectReferences",
 			additionalArgs:   []interface{}{&PutReferenceInput{Class: "class", ID: strfmt.UUID("foo"), Property: "some prop"}},
 			expectedVerb:     "update",
-			expectedResource: "objects/class/foo",
+			expectedResource: "data/collections/Class/tenants/*/objects/foo",
 		},
 	}
 
@@ -159,6 +201,12 @@ func Test_Kinds_Authorization(t *testing.T) {
 		}
 
 		for _, method := range allExportedMethods(&Manager{}) {
+			if method == "SetAuditLog" || method == "SetQuotas" || method == "SetTenantOffload" ||
+				method == "SetChangeStream" || method == "SetQueryCache" || method == "SetAutoSchema" ||
+				method == "SetPartitionCreator" || method == "SetShardResolver" {
+				// configured at startup or reload, not called on behalf of a principal
+				continue
+			}
 			assert.Contains(t, testedMethods, method)
 		}
 	})
@@ -214,7 +262,7 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 				&additional.ReplicationProperties{},
 			},
 			expectedVerb:     "create",
-			expectedResource: "batch/objects",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
 		},
 
 		{
@@ -224,7 +272,7 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 				&additional.ReplicationProperties{},
 			},
 			expectedVerb:     "update",
-			expectedResource: "batch/*",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
 		},
 
 		{
@@ -237,7 +285,37 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 				"",
 			},
 			expectedVerb:     "delete",
-			expectedResource: "batch/objects",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
+		},
+
+		{
+			methodName:       "ExportTenant",
+			additionalArgs:   []interface{}{"Foo", "T1", io.Discard},
+			expectedVerb:     "get",
+			expectedResource: "data/collections/Foo/tenants/T1/objects/*",
+		},
+
+		{
+			methodName:       "ExportObjects",
+			additionalArgs:   []interface{}{ExportParams{Class: "Foo"}, &exportCollector{}},
+			expectedVerb:     "get",
+			expectedResource: "data/collections/Foo/tenants/*/objects/*",
+		},
+
+		{
+			methodName: "CSVSchema",
+			additionalArgs: []interface{}{
+				"Foo", []string{"title"}, [][]string{{"hello"}}, map[string]string(nil),
+			},
+			expectedVerb:     "create",
+			expectedResource: "data/collections/Foo/tenants/*/objects/*",
+		},
+
+		{
+			methodName:       "ImportTenant",
+			additionalArgs:   []interface{}{"Foo", "T1", tenantExportForTest(t)},
+			expectedVerb:     "create",
+			expectedResource: "data/collections/Foo/tenants/T1/objects/*",
 		},
 	}
 
@@ -248,6 +326,12 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 		}
 
 		for _, method := range allExportedMethods(&BatchManager{}) {
+			if method == "SetAuditLog" || method == "SetQuotas" || method == "SetTenantOffload" ||
+				method == "SetChangeStream" || method == "SetQueryCache" || method == "SetAutoSchema" ||
+				method == "SetPartitionCreator" || method == "SetIngestQueue" {
+				// configured at startup or reload, not called on behalf of a principal
+				continue
+			}
 			assert.Contains(t, testedMethods, method)
 		}
 	})
@@ -262,7 +346,7 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			authorizer := &authDenier{}
 			vectorRepo := &fakeVectorRepo{}
 			modulesProvider := getFakeModulesProvider()
-			manager := NewBatchManager(vectorRepo, modulesProvider, locks, schemaManager, cfg, logger, authorizer, nil)
+			manager := NewBatchManager(vectorRepo, modulesProvider, locks, schemaManager, cfg, logger, authorizer, nil, nil)
 
 			args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
 			out, _ := callFuncByName(manager, test.methodName, args...)
@@ -276,6 +360,74 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 	})
 }
 
+func Test_Kinds_Authorization_TenantBoundPrincipal(t *testing.T) {
+	principal := &models.Principal{Username: "someone", Tenant: "customer-a"}
+	logger, _ := test.NewNullLogger()
+	authorizer := &authDenier{}
+	manager := NewManager(&fakeLocks{}, &fakeSchemaManager{}, &config.WeaviateConfig{},
+		logger, authorizer, &fakeVectorRepo{}, getFakeModulesProvider(), nil)
+
+	t.Run("defaults to the tenant of the principal", func(t *testing.T) {
+		object := &models.Object{Class: "Article", ID: "foo"}
+		_, err := manager.AddObject(context.Background(), principal, object, nil)
+		require.NotNil(t, err)
+		assert.Equal(t, "customer-a", object.Tenant)
+		assert.Equal(t, "data/collections/Article/tenants/customer-a/objects/foo",
+			authorizer.calls[len(authorizer.calls)-1].resource)
+	})
+
+	t.Run("keeps an explicit tenant", func(t *testing.T) {
+		_, err := manager.GetObject(context.Background(), principal, "Article",
+			"foo", additional.Properties{}, nil, "customer-b")
+		require.NotNil(t, err)
+		assert.Equal(t, "data/collections/Article/tenants/customer-b/objects/foo",
+			authorizer.calls[len(authorizer.calls)-1].resource)
+	})
+}
+
+type auditSink struct {
+	events []audit.Event
+}
+
+func (s *auditSink) Name() string {
+	return "test"
+}
+
+func (s *auditSink) Write(events []audit.Event) error {
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func (s *auditSink) Close() error {
+	return nil
+}
+
+type forbiddingAuthorizer struct{}
+
+func (forbiddingAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return autherrs.NewForbidden(principal, verb, resource)
+}
+
+func Test_Kinds_Authorization_AuditLog(t *testing.T) {
+	principal := &models.Principal{Username: "someone"}
+	logger, _ := test.NewNullLogger()
+	manager := NewManager(&fakeLocks{}, &fakeSchemaManager{}, &config.WeaviateConfig{},
+		logger, forbiddingAuthorizer{}, &fakeVectorRepo{}, getFakeModulesProvider(), nil)
+	sink := &auditSink{}
+	auditLog := audit.NewWithSinks([]audit.Sink{sink}, 1, "node-1", logger)
+	manager.SetAuditLog(auditLog)
+
+	err := manager.DeleteObject(context.Background(), principal, "Article", "foo", nil, "")
+	require.NotNil(t, err)
+	require.Nil(t, auditLog.Close())
+
+	require.Len(t, sink.events, 1)
+	assert.Equal(t, "someone", sink.events[0].User)
+	assert.Equal(t, "delete", sink.events[0].Action)
+	assert.Equal(t, "data/collections/Article/tenants/*/objects/foo", sink.events[0].Resource)
+	assert.Equal(t, audit.OutcomeDenied, sink.events[0].Outcome)
+}
+
 type authorizeCall struct {
 	principal *models.Principal
 	verb      string
@@ -52,12 +52,21 @@ func newAutoSchemaManager(schemaManager schemaManager, vectorRepo VectorRepo,
 func (m *autoSchemaManager) autoSchema(ctx context.Context, principal *models.Principal,
 	object *models.Object, allowCreateClass bool,
 ) error {
-	if m.config.Enabled {
+	m.mutex.RLock()
+	enabled := m.config.Enabled
+	m.mutex.RUnlock()
+	if enabled {
 		return m.performAutoSchema(ctx, principal, object, allowCreateClass)
 	}
 	return nil
 }
 
+func (m *autoSchemaManager) setConfig(config config.AutoSchema) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.config = config
+}
+
 func (m *autoSchemaManager) performAutoSchema(ctx context.Context, principal *models.Principal,
 	object *models.Object, allowCreateClass bool,
 ) error {
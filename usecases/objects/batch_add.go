@@ -13,6 +13,7 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"runtime"
 	"time"
@@ -22,6 +23,8 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cdc"
+	"github.com/weaviate/weaviate/usecases/ingest"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
 	"golang.org/x/sync/errgroup"
 )
@@ -29,11 +32,40 @@ import (
 // AddObjects Class Instances in batch to the connected DB
 func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
-) (BatchObjects, error) {
-	err := b.authorizer.Authorize(principal, "create", "batch/objects")
+) (_ BatchObjects, err error) {
+	sch := b.schemaManager.GetSchemaSkipAuth()
+	for _, object := range objects {
+		defaultTenant(principal, object)
+		// objects without a valid partition are reported as failed when they
+		// are validated
+		assignPartition(sch, object)
+	}
+	resources := batchObjectsResources(objects)
+	defer func() {
+		b.auditLog.Record(ctx, principal, "create", err, resources...)
+	}()
+
+	for _, resource := range resources {
+		if err := b.authorizer.Authorize(principal, "create", resource); err != nil {
+			return nil, err
+		}
+	}
+
+	done, err := b.backpressure.Admit(len(objects))
 	if err != nil {
 		return nil, err
 	}
+	defer done()
+
+	if err = b.requestBatchQuotas(objects); err != nil {
+		return nil, err
+	}
+
+	if err = createPartitions(ctx, b.partitions, sch, objects...); err != nil {
+		return nil, NewErrInternal("create partitions: %v", err)
+	}
+
+	activateBatchTenants(ctx, b.offload, objects)
 
 	unlock, err := b.locks.LockConnector()
 	if err != nil {
@@ -46,7 +78,16 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 	defer b.metrics.BatchOp("total_uc_level", before.UnixNano())
 	defer b.metrics.BatchDec()
 
-	return b.addObjects(ctx, principal, objects, fields, repl)
+	res, err := b.addObjects(ctx, principal, objects, fields, repl)
+	if err != nil {
+		return nil, err
+	}
+	// the written objects are still referenced by the change feed, the
+	// response gets masked copies
+	for i := range res {
+		res[i].Object = b.masker.MaskedCopy(principal, b.schemaManager, res[i].Object)
+	}
+	return res, nil
 }
 
 func (b *BatchManager) addObjects(ctx context.Context, principal *models.Principal,
@@ -58,6 +99,7 @@ func (b *BatchManager) addObjects(ctx context.Context, principal *models.Princip
 	}
 
 	batchObjects := b.validateObjectsConcurrently(ctx, principal, classes, fields, repl)
+	b.admitBatchQuotas(ctx, classes, batchObjects)
 	b.metrics.BatchOp("total_preprocessing", beforePreProcessing.UnixNano())
 
 	var (
@@ -67,13 +109,111 @@ func (b *BatchManager) addObjects(ctx context.Context, principal *models.Princip
 
 	beforePersistence := time.Now()
 	defer b.metrics.BatchOp("total_persistence_level", beforePersistence.UnixNano())
+	if b.ingest != nil {
+		return b.enqueueObjects(ctx, principal, batchObjects, repl)
+	}
 	if res, err = b.vectorRepo.BatchPutObjects(ctx, batchObjects, repl); err != nil {
 		return nil, NewErrInternal("batch objects: %#v", err)
 	}
-
+	b.writeChunks(ctx, principal, res, repl)
+	b.recordWrites(ctx, res, repl)
 	return res, nil
 }
 
+// recordWrites publishes and invalidates the objects written in batch.
+// Objects added in batch might have existed before, they are published as
+// created like they are audited.
+func (b *BatchManager) recordWrites(ctx context.Context, objects BatchObjects,
+	repl *additional.ReplicationProperties,
+) {
+	written := map[string]struct{}{}
+	for _, obj := range objects {
+		if obj.Err == nil && obj.Object != nil {
+			b.changes.Record(ctx, cdc.OpCreate, obj.Object)
+			recordSessionWrite(ctx, obj.Object.Class, obj.Object.Tenant, obj.Object.ID,
+				obj.Object.LastUpdateTimeUnix, false, repl)
+			written[obj.Object.Class] = struct{}{}
+		}
+	}
+	for class := range written {
+		b.queryCache.Invalidate(ctx, class)
+	}
+}
+
+// enqueueObjects appends the valid objects to the ingest queue, they are
+// reported as added before they are written and indexed. Their chunks are
+// written right away, since they are vectorized on behalf of the principal.
+func (b *BatchManager) enqueueObjects(ctx context.Context, principal *models.Principal,
+	objects BatchObjects, repl *additional.ReplicationProperties,
+) (BatchObjects, error) {
+	b.writeChunks(ctx, principal, objects, repl)
+
+	batch := ingest.Batch{}
+	if repl != nil {
+		batch.ConsistencyLevel = repl.ConsistencyLevel
+	}
+	for _, obj := range objects {
+		if obj.Err == nil && obj.Object != nil {
+			batch.Objects = append(batch.Objects, obj.Object)
+		}
+	}
+	if len(batch.Objects) == 0 {
+		return objects, nil
+	}
+
+	if _, err := b.ingest.Enqueue(batch); err != nil {
+		var full ingest.ErrQueueFull
+		if errors.As(err, &full) {
+			return nil, full
+		}
+		return nil, NewErrInternal("enqueue objects: %v", err)
+	}
+	return objects, nil
+}
+
+// applyQueued writes a batch of the ingest queue to the shards. The batch was
+// acknowledged already, so objects which can't be written are only logged.
+// Queued writes are not part of the session of the client.
+func (b *BatchManager) applyQueued(ctx context.Context, batch ingest.Batch) (int, error) {
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return 0, fmt.Errorf("could not acquire lock: %w", err)
+	}
+	defer unlock()
+
+	objects := make(BatchObjects, len(batch.Objects))
+	for i, obj := range batch.Objects {
+		objects[i] = BatchObject{
+			OriginalIndex: i,
+			Object:        obj,
+			UUID:          obj.ID,
+			Vector:        obj.Vector,
+		}
+	}
+	var repl *additional.ReplicationProperties
+	if batch.ConsistencyLevel != "" {
+		repl = &additional.ReplicationProperties{ConsistencyLevel: batch.ConsistencyLevel}
+	}
+
+	res, err := b.vectorRepo.BatchPutObjects(ctx, objects, repl)
+	if err != nil {
+		return 0, err
+	}
+
+	failed := 0
+	for _, obj := range res {
+		if obj.Err != nil {
+			failed++
+			b.logger.WithField("action", "ingest_queue_apply").
+				WithField("class", obj.Object.Class).
+				WithField("id", obj.UUID).
+				WithError(obj.Err).Error("could not write queued object")
+		}
+	}
+	b.recordWrites(ctx, res, nil)
+	return failed, nil
+}
+
 func (b *BatchManager) validateObjectForm(classes []*models.Object) error {
 	if len(classes) == 0 {
 		return fmt.Errorf("cannot be empty, need at least one object for batching")
@@ -124,6 +264,8 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 	err := b.autoSchemaManager.autoSchema(ctx, principal, concept, true)
 	ec.Add(err)
 
+	ec.Add(assignPartition(b.schemaManager.GetSchemaSkipAuth(), concept))
+
 	if concept.ID == "" {
 		// Generate UUID for the new object
 		uid, err := generateUUID()
@@ -169,7 +311,11 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 		ec.Add(err)
 
 		if err == nil {
-			// update vector only if we passed validation
+			// enrich and update vector only if we passed validation
+			err = b.modulesProvider.EnrichObject(ctx, object, class)
+			ec.Add(err)
+		}
+		if err == nil {
 			err = b.modulesProvider.UpdateVector(ctx, object, class, nil, b.findObject, b.logger)
 			ec.Add(err)
 		}
@@ -196,3 +342,25 @@ func objectsChanToSlice(c chan BatchObject) BatchObjects {
 func unixNow() int64 {
 	return time.Now().UnixNano() / int64(time.Millisecond)
 }
+
+// writeChunks stores the chunks of the written objects, an object whose
+// chunks can't be written is reported as failed
+func (b *BatchManager) writeChunks(ctx context.Context, principal *models.Principal,
+	objects BatchObjects, repl *additional.ReplicationProperties,
+) {
+	chunks := b.chunks()
+	classes := map[string]*models.Class{}
+	for i, obj := range objects {
+		if obj.Err != nil || obj.Object == nil {
+			continue
+		}
+		class, ok := classes[obj.Object.Class]
+		if !ok {
+			class, _ = b.schemaManager.GetClass(ctx, principal, obj.Object.Class)
+			classes[obj.Object.Class] = class
+		}
+		if err := chunks.write(ctx, principal, class, obj.Object, repl); err != nil {
+			objects[i].Err = err
+		}
+	}
+}
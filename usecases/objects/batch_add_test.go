@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus/hooks/test"
@@ -25,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/ingest"
 )
 
 func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
@@ -71,7 +73,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
-			schemaManager, config, logger, authorizer, nil)
+			schemaManager, config, logger, authorizer, nil, nil)
 	}
 
 	reset := func() {
@@ -295,7 +297,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
-			schemaManager, config, logger, authorizer, nil)
+			schemaManager, config, logger, authorizer, nil, nil)
 	}
 
 	ctx := context.Background()
@@ -440,7 +442,7 @@ func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
-			schemaManager, config, logger, authorizer, nil)
+			schemaManager, config, logger, authorizer, nil, nil)
 	}
 	reset()
 	objects := []*models.Object{
@@ -470,3 +472,55 @@ func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
 	require.NotNil(t, addedObjects[0].Object.Properties)
 	require.NotNil(t, addedObjects[1].Object.Properties)
 }
+
+func Test_BatchManager_AddObjects_IngestQueue(t *testing.T) {
+	vectorRepo := &fakeVectorRepo{}
+	vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+	schemaManager := &fakeSchemaManager{
+		GetSchemaResponse: schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Vectorizer:        config.VectorizerModuleNone,
+						Class:             "Foo",
+						VectorIndexConfig: hnsw.UserConfig{},
+					},
+				},
+			},
+		},
+	}
+	logger, _ := test.NewNullLogger()
+	modulesProvider := getFakeModulesProvider()
+	modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+		Return(nil, nil)
+	manager := NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+		schemaManager, &config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil, nil)
+
+	queue, err := ingest.New(config.IngestQueue{Enabled: true, MaxPendingObjects: 100},
+		t.TempDir(), nil, logger)
+	require.Nil(t, err)
+	defer queue.Shutdown(context.Background())
+	manager.SetIngestQueue(queue)
+
+	objects := []*models.Object{
+		{
+			ID:     strfmt.UUID("cf918366-3d3b-4b90-9bc6-bc5ea8762ff6"),
+			Class:  "Foo",
+			Vector: []float32{0.1, 0.1, 0.1111},
+		},
+		{
+			Class: "NonExistent",
+		},
+	}
+	added, err := manager.AddObjects(context.Background(), nil, objects, []*string{}, nil)
+	require.Nil(t, err)
+	require.Len(t, added, 2)
+	assert.Nil(t, added[0].Err)
+	assert.NotNil(t, added[1].Err, "invalid objects are reported right away")
+
+	require.Eventually(t, func() bool {
+		return queue.Status().AppliedSeq == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	vectorRepo.AssertNumberOfCalls(t, "BatchPutObjects", 1)
+	assert.Equal(t, uint64(1), queue.Status().AppliedObjects)
+}
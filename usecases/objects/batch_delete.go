@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -22,18 +23,33 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/verbosity"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/cdc"
 )
 
 // DeleteObjects deletes objects in batch based on the match filter
 func (b *BatchManager) DeleteObjects(ctx context.Context, principal *models.Principal,
 	match *models.BatchDeleteMatch, dryRun *bool, output *string,
 	repl *additional.ReplicationProperties, tenant string,
-) (*BatchDeleteResponse, error) {
-	err := b.authorizer.Authorize(principal, "delete", "batch/objects")
+) (_ *BatchDeleteResponse, err error) {
+	class := ""
+	if match != nil {
+		class = match.Class
+	}
+	tenant = authorization.TenantFor(principal, tenant)
+	defer func() {
+		b.auditLog.Record(ctx, principal, "delete", err, authorization.Objects(class, tenant, ""))
+	}()
+
+	err = b.authorizer.Authorize(principal, "delete", authorization.Objects(class, tenant, ""))
 	if err != nil {
 		return nil, err
 	}
 
+	if err = activateTenant(ctx, b.offload, class, tenant); err != nil {
+		return nil, err
+	}
+
 	unlock, err := b.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -54,11 +70,27 @@ func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Prin
 	if err != nil {
 		return nil, NewErrInvalidUserInput("validate: %v", err)
 	}
+	// a dry run would reveal which objects match a sensitive value
+	if err := b.masker.CheckFilter(principal, b.schemaManager, params.Filters); err != nil {
+		return nil, NewErrInvalidUserInput("validate: %v", err)
+	}
 
 	result, err := b.vectorRepo.BatchDeleteObjects(ctx, *params, repl, tenant)
 	if err != nil {
 		return nil, fmt.Errorf("batch delete objects: %w", err)
 	}
+	if !result.DryRun {
+		now := time.Now().UnixMilli()
+		for _, obj := range result.Objects {
+			if obj.Err == nil {
+				b.changes.Record(ctx, cdc.OpDelete, &models.Object{
+					Class: params.ClassName.String(), ID: obj.UUID, Tenant: tenant,
+				})
+				recordSessionWrite(ctx, params.ClassName.String(), tenant, obj.UUID, now, true, repl)
+			}
+		}
+		b.queryCache.Invalidate(ctx, params.ClassName.String())
+	}
 
 	return b.toResponse(match, params.Output, result)
 }
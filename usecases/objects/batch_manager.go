@@ -16,8 +16,15 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/masking"
+	"github.com/weaviate/weaviate/usecases/backpressure"
+	"github.com/weaviate/weaviate/usecases/cdc"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/ingest"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/querycache"
+	"github.com/weaviate/weaviate/usecases/quota"
 )
 
 // BatchManager manages kind changes in batch at a use-case level , i.e.
@@ -32,6 +39,15 @@ type BatchManager struct {
 	modulesProvider   ModulesProvider
 	autoSchemaManager *autoSchemaManager
 	metrics           *Metrics
+	auditLog          *audit.Logger
+	changes           *cdc.Stream
+	quotas            *quota.Enforcer
+	backpressure      *backpressure.Controller
+	ingest            *ingest.Queue
+	offload           tenantActivator
+	masker            *masking.Masker
+	queryCache        *querycache.Cache
+	partitions        partitionCreator
 }
 
 type BatchVectorRepo interface {
@@ -48,11 +64,12 @@ type batchRepoNew interface {
 		repl *additional.ReplicationProperties) (BatchReferences, error)
 }
 
-// NewBatchManager creates a new manager
+// NewBatchManager creates a new manager. The backpressure controller bounds
+// the objects which are added in batch at the same time, nil means unbounded.
 func NewBatchManager(vectorRepo BatchVectorRepo, modulesProvider ModulesProvider,
 	locks locks, schemaManager schemaManager, config *config.WeaviateConfig,
 	logger logrus.FieldLogger, authorizer authorizer,
-	prom *monitoring.PrometheusMetrics,
+	prom *monitoring.PrometheusMetrics, backpressure *backpressure.Controller,
 ) *BatchManager {
 	return &BatchManager{
 		config:            config,
@@ -64,5 +81,55 @@ func NewBatchManager(vectorRepo BatchVectorRepo, modulesProvider ModulesProvider
 		authorizer:        authorizer,
 		autoSchemaManager: newAutoSchemaManager(schemaManager, vectorRepo, config, logger),
 		metrics:           NewMetrics(prom),
+		backpressure:      backpressure,
+		masker: masking.New(config.Config.Authorization.Enabled(),
+			config.Config.Authorization.SensitiveData),
 	}
 }
+
+// SetAuditLog records all mutations in the given audit log
+func (b *BatchManager) SetAuditLog(auditLog *audit.Logger) {
+	b.auditLog = auditLog
+}
+
+// SetChangeStream publishes all objects added or deleted in batch to the
+// given stream
+func (b *BatchManager) SetChangeStream(changes *cdc.Stream) {
+	b.changes = changes
+}
+
+// SetQuotas enforces the given quotas when objects are added in batch
+func (b *BatchManager) SetQuotas(quotas *quota.Enforcer) {
+	b.quotas = quotas
+}
+
+// SetIngestQueue acknowledges batches of objects once they are appended to
+// the given queue and starts applying the queued batches in the background
+func (b *BatchManager) SetIngestQueue(queue *ingest.Queue) {
+	b.ingest = queue
+	queue.Start(b.applyQueued)
+}
+
+// SetAutoSchema changes the auto schema settings of objects which are added
+// in batch
+func (b *BatchManager) SetAutoSchema(config config.AutoSchema) {
+	b.autoSchemaManager.setConfig(config)
+}
+
+// SetTenantOffload activates offloaded tenants when they are written to in
+// batch
+func (b *BatchManager) SetTenantOffload(offload tenantActivator) {
+	b.offload = offload
+}
+
+// SetQueryCache invalidates the cached queries of classes which are written
+// to in batch
+func (b *BatchManager) SetQueryCache(queryCache *querycache.Cache) {
+	b.queryCache = queryCache
+}
+
+// SetPartitionCreator creates the missing temporal partitions objects are
+// added to in batch
+func (b *BatchManager) SetPartitionCreator(partitions partitionCreator) {
+	b.partitions = partitions
+}
@@ -16,21 +16,34 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // AddReferences Class Instances in batch to the connected DB
 func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Principal,
 	refs []*models.BatchReference, repl *additional.ReplicationProperties,
-) (BatchReferences, error) {
-	err := b.authorizer.Authorize(principal, "update", "batch/*")
-	if err != nil {
-		return nil, err
+) (_ BatchReferences, err error) {
+	for _, ref := range refs {
+		if ref != nil {
+			ref.Tenant = authorization.TenantFor(principal, ref.Tenant)
+		}
+	}
+	resources := batchReferencesResources(refs)
+	defer func() {
+		b.auditLog.Record(ctx, principal, "update", err, resources...)
+	}()
+
+	for _, resource := range resources {
+		if err := b.authorizer.Authorize(principal, "update", resource); err != nil {
+			return nil, err
+		}
 	}
 
 	unlock, err := b.locks.LockSchema()
@@ -58,11 +71,24 @@ func (b *BatchManager) addReferences(ctx context.Context, principal *models.Prin
 		return nil, err
 	}
 
-	if res, err := b.vectorRepo.AddBatchReferences(ctx, batchReferences, repl); err != nil {
+	// the update time is assigned by the repo, it is not earlier than now
+	now := time.Now().UnixMilli()
+	res, err := b.vectorRepo.AddBatchReferences(ctx, batchReferences, repl)
+	if err != nil {
 		return nil, NewErrInternal("could not add batch request to connector: %v", err)
-	} else {
-		return res, nil
 	}
+	written := map[string]struct{}{}
+	for _, ref := range res {
+		if ref.Err == nil && ref.From != nil {
+			recordSessionWrite(ctx, ref.From.Class.String(), ref.Tenant, ref.From.TargetID,
+				now, false, repl)
+			written[ref.From.Class.String()] = struct{}{}
+		}
+	}
+	for class := range written {
+		b.queryCache.Invalidate(ctx, class)
+	}
+	return res, nil
 }
 
 func (b *BatchManager) validateReferenceForm(refs []*models.BatchReference) error {
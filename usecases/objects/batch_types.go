@@ -56,6 +56,10 @@ type BatchReference struct {
 	From          *crossref.RefSource `json:"from"`
 	To            *crossref.Ref       `json:"to"`
 	Tenant        string              `json:"tenant"`
+	// UpdateTime is assigned once by the coordinator, so that all replicas
+	// store the same last update time for the source object. Otherwise
+	// read repairs could not tell which replicas missed the reference.
+	UpdateTime int64 `json:"updateTime,omitempty"`
 }
 
 // BatchReferences groups many Reference items together. The order matches the
@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package chunking splits long texts into chunks of a maximum number of
+// whitespace separated tokens.
+package chunking
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// separator splits a text into smaller parts and joins consecutive parts
+// back into a chunk
+type separator struct {
+	split func(text string) []string
+	join  string
+}
+
+var (
+	paragraphs = separator{split: func(text string) []string {
+		return strings.Split(text, "\n\n")
+	}, join: "\n\n"}
+	lines = separator{split: func(text string) []string {
+		return strings.Split(text, "\n")
+	}, join: "\n"}
+	sentences = separator{split: Sentences, join: " "}
+)
+
+// Split splits text into chunks of at most size tokens using the given
+// splitter. Consecutive chunks of the token splitter share overlap tokens.
+func Split(text, splitter string, size, overlap int) ([]string, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if overlap < 0 || overlap >= size {
+		return nil, fmt.Errorf("chunk overlap must be in [0, %d), got %d", size, overlap)
+	}
+
+	switch splitter {
+	case schema.ChunkingSplitterToken:
+		return tokens(strings.Fields(text), size, overlap), nil
+	case schema.ChunkingSplitterSentence:
+		return split(text, size, []separator{sentences}), nil
+	case schema.ChunkingSplitterRecursive, "":
+		return split(text, size, []separator{paragraphs, lines, sentences}), nil
+	default:
+		return nil, fmt.Errorf("unknown splitter %q", splitter)
+	}
+}
+
+// split divides text with the first separator and merges consecutive parts
+// as long as they fit into a chunk. Parts which are too large on their own
+// are divided with the next separator, and as a last resort by tokens.
+func split(text string, size int, seps []separator) []string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil
+	}
+	if countTokens(text) <= size {
+		return []string{text}
+	}
+	if len(seps) == 0 {
+		return tokens(strings.Fields(text), size, 0)
+	}
+
+	var (
+		chunks  []string
+		current []string
+		count   int
+	)
+	flush := func() {
+		if len(current) > 0 {
+			chunks = append(chunks, strings.Join(current, seps[0].join))
+			current, count = nil, 0
+		}
+	}
+
+	for _, part := range seps[0].split(text) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		n := countTokens(part)
+		if n > size {
+			flush()
+			chunks = append(chunks, split(part, size, seps[1:])...)
+			continue
+		}
+		if count+n > size {
+			flush()
+		}
+		current = append(current, part)
+		count += n
+	}
+	flush()
+
+	return chunks
+}
+
+func tokens(words []string, size, overlap int) []string {
+	var chunks []string
+	for start := 0; start < len(words); start += size - overlap {
+		end := start + size
+		if end > len(words) {
+			end = len(words)
+		}
+		chunks = append(chunks, strings.Join(words[start:end], " "))
+		if end == len(words) {
+			break
+		}
+	}
+	return chunks
+}
+
+// Sentences splits text after every '.', '!' or '?' which is followed by
+// whitespace
+func Sentences(text string) []string {
+	var (
+		out   []string
+		start int
+	)
+	runes := []rune(text)
+	for i := 0; i < len(runes)-1; i++ {
+		if strings.ContainsRune(".!?", runes[i]) && unicode.IsSpace(runes[i+1]) {
+			if s := strings.TrimSpace(string(runes[start : i+1])); s != "" {
+				out = append(out, s)
+			}
+			start = i + 1
+		}
+	}
+	if s := strings.TrimSpace(string(runes[start:])); s != "" {
+		out = append(out, s)
+	}
+	return out
+}
+
+func countTokens(text string) int {
+	return len(strings.Fields(text))
+}
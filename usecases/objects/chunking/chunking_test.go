@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package chunking
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplit(t *testing.T) {
+	t.Run("short text is a single chunk", func(t *testing.T) {
+		for _, splitter := range []string{"token", "sentence", "recursive"} {
+			chunks, err := Split("  a short text.  ", splitter, 10, 0)
+			require.Nil(t, err)
+			assert.Equal(t, []string{"a short text."}, chunks, splitter)
+		}
+	})
+
+	t.Run("empty text", func(t *testing.T) {
+		chunks, err := Split(" \n ", "recursive", 10, 0)
+		require.Nil(t, err)
+		assert.Empty(t, chunks)
+	})
+
+	t.Run("token", func(t *testing.T) {
+		chunks, err := Split("a b c d e f g", "token", 3, 1)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"a b c", "c d e", "e f g"}, chunks)
+
+		chunks, err = Split("a b c d e f g", "token", 3, 0)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"a b c", "d e f", "g"}, chunks)
+	})
+
+	t.Run("sentence", func(t *testing.T) {
+		text := "One two three. Four five! Six seven eight nine? Ten."
+		chunks, err := Split(text, "sentence", 5, 0)
+		require.Nil(t, err)
+		assert.Equal(t, []string{
+			"One two three. Four five!",
+			"Six seven eight nine? Ten.",
+		}, chunks)
+	})
+
+	t.Run("sentence longer than a chunk", func(t *testing.T) {
+		chunks, err := Split("a b c d e. f.", "sentence", 3, 0)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"a b c", "d e.", "f."}, chunks)
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		text := "Title\n\nFirst paragraph has five words.\n\n" +
+			"Second one. It is longer than the chunk size."
+		chunks, err := Split(text, "recursive", 6, 0)
+		require.Nil(t, err)
+		assert.Equal(t, []string{
+			"Title\n\nFirst paragraph has five words.",
+			"Second one.",
+			"It is longer than the chunk",
+			"size.",
+		}, chunks)
+		for _, chunk := range chunks {
+			assert.LessOrEqual(t, len(strings.Fields(chunk)), 6)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := Split("text", "words", 10, 0)
+		assert.ErrorContains(t, err, "unknown splitter")
+		_, err = Split("text", "token", 0, 0)
+		assert.ErrorContains(t, err, "must be positive")
+		_, err = Split("text", "token", 3, 3)
+		assert.ErrorContains(t, err, "overlap")
+	})
+}
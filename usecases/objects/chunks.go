@@ -0,0 +1,228 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects/chunking"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+)
+
+// chunkWriter stores the chunks of the text property configured in the
+// chunking config of a class as objects of the target class. Every chunk
+// references its parent and has an id derived from the parent's id and its
+// position, so rewriting the chunks of an object overwrites the previous ones.
+type chunkWriter struct {
+	config          *config.WeaviateConfig
+	authorizer      authorizer
+	schemaManager   schemaManager
+	vectorRepo      VectorRepo
+	modulesProvider ModulesProvider
+	findObject      modulecapabilities.FindObjectFn
+	logger          logrus.FieldLogger
+}
+
+func (m *Manager) chunks() *chunkWriter {
+	return &chunkWriter{
+		config:          m.config,
+		authorizer:      m.authorizer,
+		schemaManager:   m.schemaManager,
+		vectorRepo:      m.vectorRepo,
+		modulesProvider: m.modulesProvider,
+		findObject:      m.findObject,
+		logger:          m.logger,
+	}
+}
+
+func (b *BatchManager) chunks() *chunkWriter {
+	return &chunkWriter{
+		config:          b.config,
+		authorizer:      b.authorizer,
+		schemaManager:   b.schemaManager,
+		vectorRepo:      b.vectorRepo,
+		modulesProvider: b.modulesProvider,
+		findObject:      b.findObject,
+		logger:          b.logger,
+	}
+}
+
+// chunkID is the id of the chunk at position index of the parent object
+func chunkID(parent strfmt.UUID, index int) strfmt.UUID {
+	ns, err := uuid.Parse(parent.String())
+	if err != nil {
+		ns = uuid.NameSpaceOID
+	}
+	return strfmt.UUID(uuid.NewSHA1(ns, []byte(strconv.Itoa(index))).String())
+}
+
+// targetClass returns the class the chunks of objects of class are stored in
+// and makes sure it has all properties a chunk needs
+func (c *chunkWriter) targetClass(ctx context.Context, principal *models.Principal,
+	class *models.Class,
+) (*models.Class, error) {
+	cfg := class.ChunkingConfig
+	target, err := c.schemaManager.GetClass(ctx, principal, cfg.TargetClass)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, NewErrInvalidUserInput("chunking: target class %q not found in schema",
+			cfg.TargetClass)
+	}
+
+	refProp := schema.ChunkReferenceProperty(cfg)
+	required := []struct {
+		name string
+		ok   func(dataType []string) bool
+	}{
+		{cfg.Property, func(dt []string) bool {
+			return len(dt) == 1 && dt[0] == string(schema.DataTypeText)
+		}},
+		{schema.ChunkIndexProperty, func(dt []string) bool {
+			return len(dt) == 1 && dt[0] == string(schema.DataTypeInt)
+		}},
+		{refProp, func(dt []string) bool {
+			for _, name := range dt {
+				if name == class.Class {
+					return true
+				}
+			}
+			return false
+		}},
+	}
+	for _, req := range required {
+		prop, err := schema.GetPropertyByName(target, req.name)
+		if err != nil || !req.ok(prop.DataType) {
+			return nil, NewErrInvalidUserInput("chunking: target class %q needs a text "+
+				"property %q, an int property %q and a reference %q to %q, "+
+				"missing or invalid: %q", target.Class, cfg.Property,
+				schema.ChunkIndexProperty, refProp, class.Class, req.name)
+		}
+	}
+
+	return target, nil
+}
+
+// write replaces the chunks of obj with the chunks of its current text. It
+// is a no-op for classes without chunking config.
+func (c *chunkWriter) write(ctx context.Context, principal *models.Principal,
+	class *models.Class, obj *models.Object, repl *additional.ReplicationProperties,
+) error {
+	if !schema.ChunkingEnabled(class) {
+		return nil
+	}
+	cfg := class.ChunkingConfig
+
+	target, err := c.targetClass(ctx, principal, class)
+	if err != nil {
+		return err
+	}
+	err = c.authorizer.Authorize(principal, "create",
+		authorization.Objects(target.Class, obj.Tenant, ""))
+	if err != nil {
+		return err
+	}
+
+	var text string
+	if props, ok := obj.Properties.(map[string]interface{}); ok {
+		text, _ = props[cfg.Property].(string)
+	}
+	size := int(cfg.ChunkSize)
+	if size == 0 {
+		size = schema.DefaultChunkSize
+	}
+	texts, err := chunking.Split(text, cfg.Splitter, size, int(cfg.ChunkOverlap))
+	if err != nil {
+		return NewErrInvalidUserInput("chunking: %v", err)
+	}
+
+	beacon := crossref.NewLocalhost(class.Class, obj.ID).String()
+	for i, text := range texts {
+		chunk := &models.Object{
+			Class:              target.Class,
+			ID:                 chunkID(obj.ID, i),
+			Tenant:             obj.Tenant,
+			CreationTimeUnix:   obj.LastUpdateTimeUnix,
+			LastUpdateTimeUnix: obj.LastUpdateTimeUnix,
+			Properties: map[string]interface{}{
+				cfg.Property:              text,
+				schema.ChunkIndexProperty: int64(i),
+				schema.ChunkReferenceProperty(cfg): []interface{}{
+					map[string]interface{}{"beacon": beacon},
+				},
+			},
+		}
+		err := validation.New(c.vectorRepo.Exists, c.config, repl).
+			Object(ctx, target, chunk, nil)
+		if err != nil {
+			return NewErrInvalidUserInput("chunking: invalid chunk %d: %v", i, err)
+		}
+		err = c.modulesProvider.UpdateVector(ctx, chunk, target, nil, c.findObject, c.logger)
+		if err != nil {
+			return fmt.Errorf("chunking: vectorize chunk %d: %w", i, err)
+		}
+		if err := c.vectorRepo.PutObject(ctx, chunk, chunk.Vector, repl); err != nil {
+			return fmt.Errorf("chunking: put chunk %d: %w", i, err)
+		}
+	}
+
+	return c.deleteFrom(ctx, target.Class, obj.ID, len(texts), repl, obj.Tenant)
+}
+
+// delete removes all chunks of the object id of class
+func (c *chunkWriter) delete(ctx context.Context, principal *models.Principal,
+	class *models.Class, id strfmt.UUID, repl *additional.ReplicationProperties,
+	tenant string,
+) error {
+	if !schema.ChunkingEnabled(class) {
+		return nil
+	}
+	err := c.authorizer.Authorize(principal, "delete",
+		authorization.Objects(class.ChunkingConfig.TargetClass, tenant, ""))
+	if err != nil {
+		return err
+	}
+	return c.deleteFrom(ctx, class.ChunkingConfig.TargetClass, id, 0, repl, tenant)
+}
+
+// deleteFrom removes the chunks of the parent starting at position from.
+// Chunks are consecutive, so the first missing one ends the search.
+func (c *chunkWriter) deleteFrom(ctx context.Context, target string, parent strfmt.UUID,
+	from int, repl *additional.ReplicationProperties, tenant string,
+) error {
+	for i := from; ; i++ {
+		id := chunkID(parent, i)
+		ok, err := c.vectorRepo.Exists(ctx, target, id, repl, tenant)
+		if err != nil {
+			return fmt.Errorf("chunking: check chunk %d: %w", i, err)
+		}
+		if !ok {
+			return nil
+		}
+		if err := c.vectorRepo.DeleteObject(ctx, target, id, repl, tenant); err != nil {
+			return fmt.Errorf("chunking: delete chunk %d: %w", i, err)
+		}
+	}
+}
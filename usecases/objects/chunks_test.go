@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_Chunking(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		id     = strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		chunks = &models.Class{
+			Class:             "Chunk",
+			Vectorizer:        config.VectorizerModuleNone,
+			VectorIndexConfig: hnsw.UserConfig{},
+			Properties: []*models.Property{
+				{Name: "text", DataType: schema.DataTypeText.PropString()},
+				{Name: "chunkIndex", DataType: schema.DataTypeInt.PropString()},
+				{Name: "parent", DataType: []string{"Document"}},
+			},
+		}
+		document = &models.Class{
+			Class:             "Document",
+			Vectorizer:        config.VectorizerModuleNone,
+			VectorIndexConfig: hnsw.UserConfig{},
+			Properties: []*models.Property{
+				{Name: "text", DataType: schema.DataTypeText.PropString()},
+			},
+			ChunkingConfig: &models.ChunkingConfig{
+				Property:    "text",
+				TargetClass: "Chunk",
+				Splitter:    schema.ChunkingSplitterToken,
+				ChunkSize:   3,
+			},
+		}
+	)
+
+	newManager := func(classes ...*models.Class) (*Manager, *fakeVectorRepo) {
+		repo := &fakeVectorRepo{}
+		repo.On("PutObject", mock.Anything, mock.Anything).Return(nil)
+		repo.On("DeleteObject", mock.Anything, mock.Anything).Return(nil)
+		modules := getFakeModulesProvider()
+		modules.On("UpdateVector", mock.Anything, mock.Anything).Return(nil, nil)
+		logger, _ := test.NewNullLogger()
+		sch := schema.Schema{Objects: &models.Schema{Classes: classes}}
+		m := NewManager(&fakeLocks{}, &fakeSchemaManager{GetSchemaResponse: sch},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, repo, modules, &fakeMetrics{})
+		return m, repo
+	}
+
+	putObjects := func(repo *fakeVectorRepo) []*models.Object {
+		var objects []*models.Object
+		for _, call := range repo.Calls {
+			if call.Method == "PutObject" {
+				objects = append(objects, call.Arguments.Get(0).(*models.Object))
+			}
+		}
+		return objects
+	}
+
+	t.Run("add object", func(t *testing.T) {
+		m, repo := newManager(document, chunks)
+		repo.On("Exists", "Document", id).Return(false, nil).Once()
+		repo.On("Exists", "Document", mock.Anything).Return(true, nil)
+		repo.On("Exists", "Chunk", chunkID(id, 3)).Return(true, nil).Once()
+		repo.On("Exists", "Chunk", mock.Anything).Return(false, nil)
+
+		_, err := m.AddObject(ctx, nil, &models.Object{
+			Class:      "Document",
+			ID:         id,
+			Properties: map[string]interface{}{"text": "a b c d e f g"},
+		}, nil)
+		require.Nil(t, err)
+
+		objects := putObjects(repo)
+		require.Len(t, objects, 4)
+		assert.Equal(t, "Document", objects[0].Class)
+		for i, text := range []string{"a b c", "d e f", "g"} {
+			chunk := objects[i+1]
+			assert.Equal(t, "Chunk", chunk.Class)
+			assert.Equal(t, chunkID(id, i), chunk.ID)
+			props := chunk.Properties.(map[string]interface{})
+			assert.Equal(t, text, props["text"])
+			assert.Equal(t, int64(i), props["chunkIndex"])
+			refs := props["parent"].(models.MultipleRef)
+			require.Len(t, refs, 1)
+			assert.Equal(t, strfmt.URI("weaviate://localhost/Document/"+id), refs[0].Beacon)
+		}
+		// a chunk left over from a longer version of the text is removed
+		repo.AssertCalled(t, "DeleteObject", "Chunk", chunkID(id, 3))
+	})
+
+	t.Run("missing target class", func(t *testing.T) {
+		m, repo := newManager(document)
+		repo.On("Exists", "Document", id).Return(false, nil)
+
+		_, err := m.AddObject(ctx, nil, &models.Object{
+			Class:      "Document",
+			ID:         id,
+			Properties: map[string]interface{}{"text": "a b c d e f g"},
+		}, nil)
+		assert.ErrorAs(t, err, &ErrInvalidUserInput{})
+		assert.Empty(t, putObjects(repo))
+	})
+
+	t.Run("invalid target class", func(t *testing.T) {
+		invalid := *chunks
+		invalid.Properties = chunks.Properties[:2]
+		m, repo := newManager(document, &invalid)
+		repo.On("Exists", "Document", id).Return(false, nil)
+
+		_, err := m.AddObject(ctx, nil, &models.Object{
+			Class:      "Document",
+			ID:         id,
+			Properties: map[string]interface{}{"text": "a b c d e f g"},
+		}, nil)
+		assert.ErrorContains(t, err, `missing or invalid: "parent"`)
+	})
+
+	t.Run("delete object", func(t *testing.T) {
+		m, repo := newManager(document, chunks)
+		repo.On("Exists", "Document", id).Return(true, nil)
+		repo.On("Exists", "Chunk", chunkID(id, 0)).Return(true, nil)
+		repo.On("Exists", "Chunk", chunkID(id, 1)).Return(true, nil)
+		repo.On("Exists", "Chunk", mock.Anything).Return(false, nil)
+
+		require.Nil(t, m.DeleteObject(ctx, nil, "Document", id, nil, ""))
+		repo.AssertCalled(t, "DeleteObject", "Document", id)
+		repo.AssertCalled(t, "DeleteObject", "Chunk", chunkID(id, 0))
+		repo.AssertCalled(t, "DeleteObject", "Chunk", chunkID(id, 1))
+		repo.AssertNumberOfCalls(t, "DeleteObject", 3)
+	})
+}
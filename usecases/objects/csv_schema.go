@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+)
+
+// csvReservedColumns are the columns of CSV files which are not imported as
+// properties
+var csvReservedColumns = map[string]struct{}{
+	"id": {}, "vector": {}, "properties": {}, "class": {}, "tenant": {},
+	"creationTimeUnix": {}, "lastUpdateTimeUnix": {},
+}
+
+// CSVSchema creates the class of a CSV file, or adds the properties of its
+// columns which are missing from the class. The data type of a column is
+// determined by auto schema from the values of the sample rows, unless it is
+// given by types. Columns without values in the sample are text.
+func (b *BatchManager) CSVSchema(ctx context.Context, principal *models.Principal,
+	className string, header []string, rows [][]string, types map[string]string,
+) (*models.Class, error) {
+	// the class is changed with the permissions of the principal as well
+	if err := b.authorizer.Authorize(principal, "create",
+		authorization.Objects(className, "", "")); err != nil {
+		return nil, err
+	}
+	return b.autoSchemaManager.csvSchema(ctx, principal, className, header, rows, types)
+}
+
+func (m *autoSchemaManager) csvSchema(ctx context.Context, principal *models.Principal,
+	className string, header []string, rows [][]string, types map[string]string,
+) (*models.Class, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if className == "" {
+		return nil, fmt.Errorf(validation.ErrorMissingClass)
+	}
+	className = schema.UppercaseClassName(className)
+	for column := range types {
+		if !containsString(header, column) {
+			return nil, NewErrInvalidUserInput("type of unknown column %q", column)
+		}
+	}
+
+	properties := []*models.Property{}
+	now := time.Now()
+	for i, column := range header {
+		if _, ok := csvReservedColumns[column]; ok {
+			continue
+		}
+
+		property := &models.Property{
+			Name:        column,
+			Description: "This property was generated by Weaviate's auto-schema feature on " + now.Format(time.ANSIC),
+		}
+		if dataType, ok := types[column]; ok {
+			property.DataType = []string{dataType}
+		} else {
+			values := make([]string, 0, len(rows))
+			for _, row := range rows {
+				if i < len(row) && row[i] != "" {
+					values = append(values, row[i])
+				}
+			}
+			dataTypes, nested, err := m.csvColumnType(values, now)
+			if err != nil {
+				return nil, NewErrInvalidUserInput("column %q: %v", column, err)
+			}
+			property.DataType = m.getDataTypes(dataTypes)
+			property.NestedProperties = nested
+		}
+		properties = append(properties, property)
+	}
+
+	class, err := m.getClass(principal, &models.Object{Class: className})
+	if err != nil {
+		return nil, err
+	}
+	if class == nil {
+		err = m.createClass(ctx, principal, className, properties)
+	} else {
+		err = m.updateClass(ctx, principal, className, properties, class.Properties)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m.getClass(principal, &models.Object{Class: className})
+}
+
+// csvColumnType merges the data types of the values of a column. Columns of
+// integers and numbers are numbers, all other mixed columns are text.
+func (m *autoSchemaManager) csvColumnType(values []string, now time.Time,
+) ([]schema.DataType, []*models.NestedProperty, error) {
+	var (
+		dataTypes []schema.DataType
+		first     interface{}
+	)
+	for i, raw := range values {
+		value := csvCellValue(raw)
+		dt, err := m.determineType(value, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		if i == 0 {
+			dataTypes, first = dt, value
+			continue
+		}
+		switch {
+		case len(dt) == 1 && len(dataTypes) == 1 && dt[0] == dataTypes[0]:
+		case isNumberType(dt) && isNumberType(dataTypes):
+			dataTypes = []schema.DataType{schema.DataTypeNumber}
+		default:
+			return []schema.DataType{schema.DataTypeText}, nil, nil
+		}
+	}
+	if len(dataTypes) == 0 {
+		return []schema.DataType{schema.DataTypeText}, nil, nil
+	}
+
+	// the nested properties are determined by the first value only, like
+	// auto schema does for the first object of a class
+	var (
+		nested []*models.NestedProperty
+		err    error
+	)
+	switch dataTypes[0] {
+	case schema.DataTypeObject:
+		nested, err = m.determineNestedProperties(first.(map[string]interface{}), now)
+	case schema.DataTypeObjectArray:
+		nested, err = m.determineNestedPropertiesOfArray(first.([]interface{}), now)
+	}
+	return dataTypes, nested, err
+}
+
+func isNumberType(dt []schema.DataType) bool {
+	return len(dt) == 1 && (dt[0] == schema.DataTypeInt || dt[0] == schema.DataTypeNumber)
+}
+
+// csvCellValue parses the text of a CSV cell into the value it would have in
+// JSON. JSON arrays and objects are decoded, integers are told apart from
+// numbers so that columns of integers become int properties.
+func csvCellValue(raw string) interface{} {
+	if i, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(raw, 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return f
+	}
+	switch strings.ToLower(raw) {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if trimmed := strings.TrimSpace(raw); strings.HasPrefix(trimmed, "[") ||
+		strings.HasPrefix(trimmed, "{") {
+		var v interface{}
+		dec := json.NewDecoder(strings.NewReader(trimmed))
+		dec.UseNumber()
+		if err := dec.Decode(&v); err == nil && !dec.More() {
+			return v
+		}
+	}
+	return raw
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestCSVSchema(t *testing.T) {
+	ctx := context.Background()
+	schemaManager := &fakeSchemaManager{}
+	logger, _ := test.NewNullLogger()
+	m := &autoSchemaManager{
+		schemaManager: schemaManager,
+		config: config.AutoSchema{
+			DefaultString: "text",
+			DefaultNumber: "number",
+			DefaultDate:   "date",
+		},
+		logger: logger,
+	}
+
+	header := []string{"id", "title", "wordCount", "rating", "published", "releaseDate", "tags", "zip", "empty", "author"}
+	rows := [][]string{
+		{"00000000-0000-0000-0000-000000000001", "hello", "120", "4", "true", "2002-10-02T15:00:00Z", `["a"]`, "01234", "", `{"name":"Jane"}`},
+		{"00000000-0000-0000-0000-000000000002", "world", "80", "4.5", "FALSE", "2003-10-02T15:00:00Z", `["b","c"]`, "05678", "", `{"name":"Joe"}`},
+	}
+
+	class, err := m.csvSchema(ctx, nil, "article", header, rows, map[string]string{"zip": "text"})
+	require.Nil(t, err)
+	require.NotNil(t, class)
+	assert.Equal(t, "Article", class.Class)
+
+	dataTypes := map[string]string{}
+	for _, prop := range class.Properties {
+		dataTypes[prop.Name] = prop.DataType[0]
+	}
+	assert.Equal(t, map[string]string{
+		"title":       "text",
+		"wordCount":   "int",
+		"rating":      "number",
+		"published":   "boolean",
+		"releaseDate": "date",
+		"tags":        "text[]",
+		"zip":         "text",
+		"empty":       "text",
+		"author":      "object",
+	}, dataTypes)
+	author := getProperty(class.Properties, "author")
+	require.Len(t, author.NestedProperties, 1)
+	assert.Equal(t, "name", author.NestedProperties[0].Name)
+
+	t.Run("missing columns are added", func(t *testing.T) {
+		class, err := m.csvSchema(ctx, nil, "Article", []string{"title", "pages"},
+			[][]string{{"x", "12"}}, nil)
+		require.Nil(t, err)
+		assert.Len(t, class.Properties, 10)
+		assert.Equal(t, "int", getProperty(class.Properties, "pages").DataType[0])
+	})
+
+	t.Run("mixed values are text", func(t *testing.T) {
+		dt, _, err := m.csvColumnType([]string{"12", "twelve"}, time.Now())
+		require.Nil(t, err)
+		assert.Equal(t, []schema.DataType{schema.DataTypeText}, dt)
+	})
+
+	t.Run("types of unknown columns", func(t *testing.T) {
+		_, err := m.csvSchema(ctx, nil, "Article", []string{"title"}, nil,
+			map[string]string{"other": "text"})
+		var invalid ErrInvalidUserInput
+		assert.ErrorAs(t, err, &invalid)
+	})
+}
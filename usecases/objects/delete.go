@@ -18,6 +18,8 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/cdc"
 )
 
 // DeleteObject Class Instance from the connected DB
@@ -27,16 +29,25 @@ import (
 func (m *Manager) DeleteObject(ctx context.Context,
 	principal *models.Principal, class string, id strfmt.UUID,
 	repl *additional.ReplicationProperties, tenant string,
-) error {
+) (err error) {
+	tenant = authorization.TenantFor(principal, tenant)
+	defer func() {
+		m.auditLog.Record(ctx, principal, "delete", err, authorization.Objects(class, tenant, id))
+	}()
+
 	path := fmt.Sprintf("objects/%s/%s", class, id)
 	if class == "" {
 		path = fmt.Sprintf("objects/%s", id)
 	}
-	err := m.authorizer.Authorize(principal, "delete", path)
+	err = m.authorizer.Authorize(principal, "delete", authorization.Objects(class, tenant, id))
 	if err != nil {
 		return err
 	}
 
+	if err = activateTenant(ctx, m.offload, class, tenant); err != nil {
+		return err
+	}
+
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return NewErrInternal("could not acquire lock: %v", err)
@@ -67,6 +78,12 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	if err != nil {
 		return NewErrInternal("could not delete object from vector repo: %v", err)
 	}
+	if err = m.deleteChunks(ctx, principal, class, id, repl, tenant); err != nil {
+		return NewErrInternal("could not delete chunks: %v", err)
+	}
+	m.changes.Record(ctx, cdc.OpDelete, &models.Object{Class: class, ID: id, Tenant: tenant})
+	recordSessionWrite(ctx, class, tenant, id, m.timeSource.Now(), true, repl)
+	m.queryCache.Invalidate(ctx, class)
 	return nil
 }
 
@@ -97,6 +114,19 @@ func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID) erro
 		if err != nil {
 			return NewErrInternal("could not delete object from vector repo: %v", err)
 		}
+		recordSessionWrite(ctx, object.Class, "", id, m.timeSource.Now(), true, nil)
+		m.queryCache.Invalidate(ctx, object.Class)
 		deleteCounter++
 	}
 }
+
+func (m *Manager) deleteChunks(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID, repl *additional.ReplicationProperties,
+	tenant string,
+) error {
+	class, err := m.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return err
+	}
+	return m.chunks().delete(ctx, principal, class, id, repl, tenant)
+}
@@ -20,7 +20,9 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/cdc"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -83,6 +85,53 @@ func Test_DeleteObject(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+type changeSink struct {
+	events []cdc.Event
+}
+
+func (s *changeSink) Name() string {
+	return "memory"
+}
+
+func (s *changeSink) Publish(topic string, events []cdc.Event) error {
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func (s *changeSink) Close() error {
+	return nil
+}
+
+func Test_DeleteObject_ChangeStream(t *testing.T) {
+	var (
+		cls = "MyClass"
+		id  = strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	)
+
+	logger, _ := test.NewNullLogger()
+	sink := &changeSink{}
+	changes, err := cdc.NewWithSink(cdc.Config{Topic: cdc.DefaultTopic}, sink, t.TempDir(),
+		"node-1", nil, logger)
+	require.Nil(t, err)
+
+	manager, repo := newDeleteDependency()
+	manager.SetChangeStream(changes)
+	repo.On("DeleteObject", cls, id).Return(nil).Once()
+	repo.On("Exists", cls, id).Return(true, nil).Twice()
+	repo.On("DeleteObject", cls, id).Return(errors.New("unavailable")).Once()
+
+	require.Nil(t, manager.DeleteObject(context.Background(), nil, cls, id, nil, "t1"))
+	// failed deletes are not published
+	require.NotNil(t, manager.DeleteObject(context.Background(), nil, cls, id, nil, "t1"))
+	require.Nil(t, changes.Close())
+
+	require.Len(t, sink.events, 1)
+	assert.Equal(t, cdc.OpDelete, sink.events[0].Op)
+	assert.Equal(t, cls, sink.events[0].Class)
+	assert.Equal(t, "t1", sink.events[0].Tenant)
+	assert.Equal(t, id, sink.events[0].ObjectID)
+}
+
 func newDeleteDependency() (*Manager, *fakeVectorRepo) {
 	vectorRepo := new(fakeVectorRepo)
 	logger, _ := test.NewNullLogger()
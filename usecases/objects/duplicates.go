@@ -0,0 +1,244 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const (
+	// DuplicatesActionDelete deletes all but the oldest object of a group
+	DuplicatesActionDelete = "delete"
+	// DuplicatesActionMerge sets the properties which the oldest object of a
+	// group lacks from the other objects, then deletes them
+	DuplicatesActionMerge = "merge"
+
+	DefaultDuplicatesNeighbors = 10
+	MaxDuplicatesNeighbors     = 100
+	DefaultDuplicatesLimit     = 100
+	MaxDuplicatesLimit         = 10000
+)
+
+// DuplicatesParams select the near-duplicate objects of a class. Two objects
+// are duplicates if the distance of their vectors is at most Distance and
+// all Properties are equal. Groups are formed transitively. Each object is
+// compared to its Neighbors nearest objects only.
+type DuplicatesParams struct {
+	Class      string   `json:"class"`
+	Tenant     string   `json:"tenant,omitempty"`
+	Distance   float32  `json:"distance"`
+	Properties []string `json:"properties,omitempty"`
+	Neighbors  int      `json:"neighbors,omitempty"`
+	// Limit is the maximum number of groups
+	Limit int `json:"limit,omitempty"`
+	// Action is empty to only report the groups
+	Action string `json:"action,omitempty"`
+}
+
+// DuplicateGroup is a group of near-duplicate objects, Keep is the oldest
+// one
+type DuplicateGroup struct {
+	Keep       strfmt.UUID `json:"keep"`
+	Duplicates []Duplicate `json:"duplicates"`
+}
+
+// Duplicate of the kept object of a group, Distance is the distance of
+// their vectors
+type Duplicate struct {
+	ID       strfmt.UUID `json:"id"`
+	Distance float32     `json:"distance"`
+}
+
+type DuplicatesResult struct {
+	Class   string           `json:"class"`
+	Scanned int              `json:"scanned"`
+	Groups  []DuplicateGroup `json:"groups"`
+	Deleted int              `json:"deleted"`
+	Merged  int              `json:"merged"`
+	Errors  []string         `json:"errors,omitempty"`
+}
+
+func (p *DuplicatesParams) setDefaults() error {
+	if p.Class == "" {
+		return NewErrInvalidUserInput("class is required")
+	}
+	if p.Distance < 0 {
+		return NewErrInvalidUserInput("distance must not be negative")
+	}
+	if p.Neighbors == 0 {
+		p.Neighbors = DefaultDuplicatesNeighbors
+	}
+	if p.Neighbors < 0 || p.Neighbors > MaxDuplicatesNeighbors {
+		return NewErrInvalidUserInput("neighbors must be between 1 and %d", MaxDuplicatesNeighbors)
+	}
+	if p.Limit == 0 {
+		p.Limit = DefaultDuplicatesLimit
+	}
+	if p.Limit < 0 || p.Limit > MaxDuplicatesLimit {
+		return NewErrInvalidUserInput("limit must be between 1 and %d", MaxDuplicatesLimit)
+	}
+	switch p.Action {
+	case "", DuplicatesActionDelete, DuplicatesActionMerge:
+	default:
+		return NewErrInvalidUserInput("action must be %q or %q, got %q",
+			DuplicatesActionDelete, DuplicatesActionMerge, p.Action)
+	}
+	return nil
+}
+
+// FindDuplicates groups the near-duplicate objects of a class and
+// optionally merges or deletes the duplicates. The deletions and merges are
+// regular requests, which are authorized and replicated one by one. A failed
+// action is reported and does not stop the others.
+func (m *Manager) FindDuplicates(ctx context.Context, principal *models.Principal,
+	params DuplicatesParams,
+) (*DuplicatesResult, error) {
+	if err := params.setDefaults(); err != nil {
+		return nil, err
+	}
+	params.Class = schema.UppercaseClassName(params.Class)
+	params.Tenant = authorization.TenantFor(principal, params.Tenant)
+	err := m.authorizer.Authorize(principal, "get", authorization.Objects(params.Class, params.Tenant, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := m.findDuplicates(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Action == "" {
+		return result, nil
+	}
+	for _, group := range result.Groups {
+		if params.Action == DuplicatesActionMerge {
+			merged, err := m.mergeDuplicates(ctx, principal, params, group)
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("merge into %s: %v", group.Keep, err))
+				continue
+			}
+			if merged {
+				result.Merged++
+			}
+		}
+		for _, d := range group.Duplicates {
+			if err := m.DeleteObject(ctx, principal, params.Class, d.ID, nil, params.Tenant); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("delete %s: %v", d.ID, err))
+				continue
+			}
+			result.Deleted++
+		}
+	}
+	return result, nil
+}
+
+func (m *Manager) findDuplicates(ctx context.Context, params DuplicatesParams,
+) (*DuplicatesResult, error) {
+	sch := m.schemaManager.GetSchemaSkipAuth()
+	if sch.GetClass(schema.ClassName(params.Class)) == nil {
+		return nil, NewErrNotFound("class %q not found", params.Class)
+	}
+	if err := activateTenant(ctx, m.offload, params.Class, params.Tenant); err != nil {
+		return nil, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	result, err := m.vectorRepo.FindDuplicates(ctx, params)
+	if err != nil {
+		switch err.(type) {
+		case ErrMultiTenancy, ErrNotFound, ErrInvalidUserInput:
+			return nil, err
+		default:
+			return nil, NewErrInternal("repo: find duplicates: %v", err)
+		}
+	}
+	return result, nil
+}
+
+// mergeDuplicates sets the properties which the kept object lacks, in the
+// order of the duplicates. It is false if there was nothing to set.
+func (m *Manager) mergeDuplicates(ctx context.Context, principal *models.Principal,
+	params DuplicatesParams, group DuplicateGroup,
+) (bool, error) {
+	keep, err := m.duplicateProperties(ctx, params, group.Keep)
+	if err != nil {
+		return false, err
+	}
+
+	missing := map[string]interface{}{}
+	for _, d := range group.Duplicates {
+		props, err := m.duplicateProperties(ctx, params, d.ID)
+		if err != nil {
+			return false, err
+		}
+		for name, value := range props {
+			if value == nil {
+				continue
+			}
+			if _, ok := keep[name]; ok {
+				continue
+			}
+			if _, ok := missing[name]; !ok {
+				missing[name] = value
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return false, nil
+	}
+
+	if err := m.MergeObject(ctx, principal, &models.Object{
+		Class:      params.Class,
+		ID:         group.Keep,
+		Tenant:     params.Tenant,
+		Properties: missing,
+	}, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *Manager) duplicateProperties(ctx context.Context, params DuplicatesParams,
+	id strfmt.UUID,
+) (map[string]interface{}, error) {
+	res, err := m.vectorRepo.Object(ctx, params.Class, id, search.SelectProperties{},
+		additional.Properties{}, nil, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, NewErrNotFound("object %s could not be found", id)
+	}
+	props, _ := res.Schema.(map[string]interface{})
+	// the id is part of the schema of a search result
+	delete(props, "id")
+	for name, value := range props {
+		if value == nil {
+			delete(props, name)
+		}
+	}
+	return props, nil
+}
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_FindDuplicates(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		keep = strfmt.UUID("2b9c4a35-7a55-4b27-8e70-5b0e1b3e4d0c")
+		dup1 = strfmt.UUID("6f1d7c0e-4c4b-4a3c-9e33-0e8b2d2b3f11")
+		dup2 = strfmt.UUID("9a3e5d27-1b8f-4c21-8d6e-7f5a4b3c2d10")
+		sch  = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class:             "Chunk",
+						VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+						Properties: []*models.Property{
+							{Name: "text", DataType: schema.DataTypeText.PropString()},
+							{Name: "source", DataType: schema.DataTypeText.PropString()},
+						},
+					},
+				},
+			},
+		}
+		groups = []DuplicateGroup{{
+			Keep:       keep,
+			Duplicates: []Duplicate{{ID: dup1, Distance: 0.01}, {ID: dup2}},
+		}}
+	)
+
+	newManager := func() (*Manager, *fakeVectorRepo, *fakeModulesProvider) {
+		repo := &fakeVectorRepo{}
+		repo.On("FindDuplicates", mock.Anything).
+			Return(&DuplicatesResult{Class: "Chunk", Scanned: 10, Groups: groups}, nil)
+		logger, _ := test.NewNullLogger()
+		modules := getFakeModulesProvider()
+		m := NewManager(&fakeLocks{}, &fakeSchemaManager{GetSchemaResponse: sch},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, repo, modules, &fakeMetrics{})
+		return m, repo, modules
+	}
+
+	t.Run("invalid params", func(t *testing.T) {
+		m, _, _ := newManager()
+		for _, params := range []DuplicatesParams{
+			{},
+			{Class: "Chunk", Distance: -1},
+			{Class: "Chunk", Neighbors: MaxDuplicatesNeighbors + 1},
+			{Class: "Chunk", Limit: -1},
+			{Class: "Chunk", Action: "drop"},
+		} {
+			_, err := m.FindDuplicates(ctx, nil, params)
+			assert.ErrorAs(t, err, &ErrInvalidUserInput{}, "%+v", params)
+		}
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		m, _, _ := newManager()
+		_, err := m.FindDuplicates(ctx, nil, DuplicatesParams{Class: "Unknown"})
+		assert.ErrorAs(t, err, &ErrNotFound{})
+	})
+
+	t.Run("report only", func(t *testing.T) {
+		m, repo, _ := newManager()
+		res, err := m.FindDuplicates(ctx, nil, DuplicatesParams{Class: "chunk"})
+		require.Nil(t, err)
+		assert.Equal(t, groups, res.Groups)
+		assert.Equal(t, 0, res.Deleted)
+		repo.AssertCalled(t, "FindDuplicates", DuplicatesParams{
+			Class:     "Chunk",
+			Neighbors: DefaultDuplicatesNeighbors,
+			Limit:     DefaultDuplicatesLimit,
+		})
+		repo.AssertNotCalled(t, "DeleteObject", mock.Anything, mock.Anything)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		m, repo, _ := newManager()
+		repo.On("Exists", "Chunk", mock.Anything).Return(true, nil)
+		repo.On("DeleteObject", "Chunk", dup1).Return(nil)
+		repo.On("DeleteObject", "Chunk", dup2).Return(errors.New("unavailable"))
+
+		res, err := m.FindDuplicates(ctx, nil, DuplicatesParams{
+			Class: "Chunk", Action: DuplicatesActionDelete,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, 1, res.Deleted)
+		require.Len(t, res.Errors, 1)
+		assert.Contains(t, res.Errors[0], dup2.String())
+		repo.AssertNotCalled(t, "DeleteObject", "Chunk", keep)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		m, repo, modules := newManager()
+		object := func(id strfmt.UUID, props map[string]interface{}) {
+			props["id"] = id
+			repo.On("Object", "Chunk", id, mock.Anything, mock.Anything, "").
+				Return(&search.Result{ID: id, ClassName: "Chunk", Schema: props}, nil)
+		}
+		object(keep, map[string]interface{}{"text": "a", "source": nil})
+		object(dup1, map[string]interface{}{"text": "b", "source": "wiki"})
+		object(dup2, map[string]interface{}{"text": "c", "source": "web"})
+		modules.On("VectorizerName", mock.Anything).Return("", nil)
+		modules.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).Return(nil, nil)
+		repo.On("Merge", mock.MatchedBy(func(doc MergeDocument) bool {
+			return doc.ID == keep && len(doc.PrimitiveSchema) == 1 &&
+				doc.PrimitiveSchema["source"] == "wiki"
+		})).Return(nil).Once()
+		repo.On("Exists", "Chunk", mock.Anything).Return(true, nil)
+		repo.On("DeleteObject", "Chunk", mock.Anything).Return(nil)
+
+		res, err := m.FindDuplicates(ctx, nil, DuplicatesParams{
+			Class: "Chunk", Action: DuplicatesActionMerge,
+		})
+		require.Nil(t, err)
+		assert.Empty(t, res.Errors)
+		assert.Equal(t, 1, res.Merged)
+		assert.Equal(t, 2, res.Deleted)
+		repo.AssertExpectations(t)
+	})
+}
@@ -56,6 +56,14 @@ func (e *Error) UnprocessableEntity() bool {
 	return e.Code == StatusUnprocessableEntity
 }
 
+// auditError avoids recording a nil *Error as a failed operation
+func auditError(err *Error) error {
+	if err == nil {
+		return nil
+	}
+	return err
+}
+
 // ErrInvalidUserInput indicates a client-side error
 type ErrInvalidUserInput struct {
 	msg string
@@ -106,6 +114,11 @@ func (e ErrMultiTenancy) Error() string {
 	return e.err.Error()
 }
 
+// Unwrap underlying error
+func (e ErrMultiTenancy) Unwrap() error {
+	return e.err
+}
+
 // NewErrMultiTenancy with error signature
 func NewErrMultiTenancy(err error) ErrMultiTenancy {
 	return ErrMultiTenancy{err}
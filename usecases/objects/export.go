@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+var exportBatchSize = 500
+
+// ExportParams select the objects of an export. The export continues after
+// the object with the id After and stops after Limit objects, if set. If
+// Snapshot is set the export reads a snapshot of each shard, taken when the
+// shard is read first, instead of seeing writes which happen while the
+// export runs.
+type ExportParams struct {
+	Class    string
+	Tenant   string
+	After    string
+	Limit    int
+	Snapshot bool
+}
+
+// ExportResult tells where an export stopped, the next export continues
+// after Cursor. Done is false if the export stopped at its limit.
+type ExportResult struct {
+	Objects int
+	Cursor  string
+	Done    bool
+}
+
+// ObjectsWriter encodes the objects of an export batch by batch
+type ObjectsWriter interface {
+	Write(objects []*models.Object) error
+}
+
+// ExportObjects writes the objects of a class including their vectors to w,
+// in the order of their ids. Unlike ExportTenant the objects are written as
+// they are read, so the export can be resumed after the last object which
+// was received.
+//
+// Nothing is written to w if the export fails before the first object was
+// read.
+func (b *BatchManager) ExportObjects(ctx context.Context, principal *models.Principal,
+	params ExportParams, w ObjectsWriter,
+) (*ExportResult, error) {
+	class := schema.UppercaseClassName(params.Class)
+	if class == "" {
+		return nil, NewErrInvalidUserInput("class is required")
+	}
+	params.Tenant = authorization.TenantFor(principal, params.Tenant)
+	if err := b.authorizer.Authorize(principal, "get",
+		authorization.Objects(class, params.Tenant, "")); err != nil {
+		return nil, err
+	}
+	sch := b.schemaManager.GetSchemaSkipAuth()
+	if sch.GetClass(schema.ClassName(class)) == nil {
+		return nil, NewErrNotFound("class %q not found", class)
+	}
+	if params.Tenant != "" {
+		if err := activateTenant(ctx, b.offload, class, params.Tenant); err != nil {
+			return nil, err
+		}
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	var snapshot string
+	if params.Snapshot {
+		snapshot = uuid.NewString()
+	}
+
+	result := &ExportResult{Cursor: params.After}
+	for {
+		limit := exportBatchSize
+		if params.Limit > 0 && params.Limit-result.Objects < limit {
+			limit = params.Limit - result.Objects
+		}
+		if limit == 0 {
+			return result, nil
+		}
+
+		res, qerr := b.vectorRepo.Query(ctx, &QueryInput{
+			Class:      class,
+			Limit:      limit,
+			Cursor:     &filters.Cursor{After: result.Cursor, Limit: limit, Snapshot: snapshot},
+			Tenant:     params.Tenant,
+			Additional: additional.Properties{Vector: true},
+		})
+		if qerr != nil {
+			if result.Objects == 0 {
+				return result, qerr
+			}
+			return result, fmt.Errorf("export %q: %w", class, qerr)
+		}
+
+		objs := res.ObjectsWithVector(true)
+		b.masker.MaskObjects(principal, b.schemaManager, objs...)
+		for _, obj := range objs {
+			obj.Additional = nil
+		}
+		if len(objs) > 0 {
+			if err := w.Write(objs); err != nil {
+				return result, fmt.Errorf("export %q: %w", class, err)
+			}
+			result.Objects += len(objs)
+			result.Cursor = objs[len(objs)-1].ID.String()
+		}
+		if len(objs) < limit {
+			result.Done = true
+			return result, nil
+		}
+	}
+}
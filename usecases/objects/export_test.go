@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type exportCollector struct {
+	batches [][]*models.Object
+}
+
+func (e *exportCollector) Write(objects []*models.Object) error {
+	e.batches = append(e.batches, objects)
+	return nil
+}
+
+func TestExportObjects(t *testing.T) {
+	defer func(size int) { exportBatchSize = size }(exportBatchSize)
+	exportBatchSize = 2
+
+	var (
+		ctx = context.Background()
+		ids = []strfmt.UUID{
+			"a0b55b05-bc5b-4cc9-b646-1452d1390a62",
+			"b0b55b05-bc5b-4cc9-b646-1452d1390a62",
+			"c0b55b05-bc5b-4cc9-b646-1452d1390a62",
+		}
+		class     = &models.Class{Class: "Foo"}
+		logger, _ = test.NewNullLogger()
+	)
+	result := func(i int) search.Result {
+		return search.Result{
+			ClassName: "Foo",
+			ID:        ids[i],
+			Schema:    map[string]interface{}{"name": string(ids[i])},
+			Vector:    []float32{float32(i), 1},
+		}
+	}
+	newManager := func() (*BatchManager, *fakeVectorRepo) {
+		vectorRepo := &fakeVectorRepo{}
+		return NewBatchManager(vectorRepo, getFakeModulesProvider(), &fakeLocks{},
+			&fakeSchemaManager{GetSchemaResponse: schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			}}}, &config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil, nil), vectorRepo
+	}
+
+	t.Run("all objects", func(t *testing.T) {
+		manager, vectorRepo := newManager()
+		vectorRepo.On("Query", mock.MatchedBy(func(q *QueryInput) bool {
+			return q.Cursor.After == "" && q.Additional.Vector
+		})).Return([]search.Result{result(0), result(1)}, (*Error)(nil)).Once()
+		vectorRepo.On("Query", mock.MatchedBy(func(q *QueryInput) bool {
+			return q.Cursor.After == ids[1].String()
+		})).Return([]search.Result{result(2)}, (*Error)(nil)).Once()
+
+		out := &exportCollector{}
+		res, err := manager.ExportObjects(ctx, nil, ExportParams{Class: "foo"}, out)
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+		assert.Equal(t, &ExportResult{Objects: 3, Cursor: ids[2].String(), Done: true}, res)
+		require.Len(t, out.batches, 2)
+		assert.Equal(t, models.C11yVector{2, 1}, out.batches[1][0].Vector)
+	})
+
+	t.Run("resumed with limit", func(t *testing.T) {
+		manager, vectorRepo := newManager()
+		vectorRepo.On("Query", mock.MatchedBy(func(q *QueryInput) bool {
+			return q.Cursor.After == ids[0].String() && q.Cursor.Limit == 1
+		})).Return([]search.Result{result(1)}, (*Error)(nil)).Once()
+
+		out := &exportCollector{}
+		res, err := manager.ExportObjects(ctx, nil, ExportParams{
+			Class: "Foo", After: ids[0].String(), Limit: 1,
+		}, out)
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+		assert.Equal(t, &ExportResult{Objects: 1, Cursor: ids[1].String()}, res)
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		manager, vectorRepo := newManager()
+		var snapshot string
+		vectorRepo.On("Query", mock.MatchedBy(func(q *QueryInput) bool {
+			snapshot = q.Cursor.Snapshot
+			return q.Cursor.After == "" && snapshot != ""
+		})).Return([]search.Result{result(0), result(1)}, (*Error)(nil)).Once()
+		vectorRepo.On("Query", mock.MatchedBy(func(q *QueryInput) bool {
+			return q.Cursor.After == ids[1].String() && q.Cursor.Snapshot == snapshot
+		})).Return([]search.Result{}, (*Error)(nil)).Once()
+
+		res, err := manager.ExportObjects(ctx, nil, ExportParams{Class: "Foo", Snapshot: true},
+			&exportCollector{})
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+		assert.True(t, res.Done)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		manager, _ := newManager()
+		_, err := manager.ExportObjects(ctx, nil, ExportParams{Class: "Bar"}, &exportCollector{})
+		assert.ErrorAs(t, err, &ErrNotFound{})
+	})
+}
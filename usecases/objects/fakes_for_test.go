@@ -31,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -66,13 +67,22 @@ func (f *fakeSchemaManager) GetSchema(principal *models.Principal) (schema.Schem
 	return f.GetSchemaResponse, f.GetschemaErr
 }
 
+func (f *fakeSchemaManager) GetSchemaSkipAuth() schema.Schema {
+	return f.GetSchemaResponse
+}
+
 func (f *fakeSchemaManager) ShardOwner(class, shard string) (string, error) { return "", nil }
 func (f *fakeSchemaManager) TenantShard(class, tenant string) string        { return tenant }
 func (f *fakeSchemaManager) ShardFromUUID(class string, uuid []byte) string { return "" }
 
+func (f *fakeSchemaManager) PreviousShardFromUUID(class string, uuid []byte) string { return "" }
+
 func (f *fakeSchemaManager) GetClass(ctx context.Context, principal *models.Principal,
 	name string,
 ) (*models.Class, error) {
+	if f.GetSchemaResponse.Objects == nil {
+		return nil, f.GetschemaErr
+	}
 	classes := f.GetSchemaResponse.Objects.Classes
 	for _, class := range classes {
 		if class.Class == name {
@@ -190,6 +200,25 @@ func (f *fakeVectorRepo) ObjectByID(ctx context.Context, id strfmt.UUID,
 	return nil, args.Error(1)
 }
 
+func (f *fakeVectorRepo) ObjectVersions(ctx context.Context, class string, id strfmt.UUID,
+	tenant string,
+) ([]*storobj.ObjectVersion, error) {
+	args := f.Called(class, id, tenant)
+	if args.Get(0) != nil {
+		return args.Get(0).([]*storobj.ObjectVersion), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (f *fakeVectorRepo) FindDuplicates(ctx context.Context, params DuplicatesParams,
+) (*DuplicatesResult, error) {
+	args := f.Called(params)
+	if args.Get(0) != nil {
+		return args.Get(0).(*DuplicatesResult), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func (f *fakeVectorRepo) ObjectSearch(ctx context.Context, offset, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, additional additional.Properties, tenant string,
 ) (search.Results, error) {
@@ -340,6 +369,12 @@ func (p *fakeModulesProvider) UsingRef2Vec(moduleName string) bool {
 	return args.Bool(0)
 }
 
+func (p *fakeModulesProvider) EnrichObject(ctx context.Context, object *models.Object,
+	class *models.Class,
+) error {
+	return nil
+}
+
 func (p *fakeModulesProvider) UpdateVector(ctx context.Context, object *models.Object, class *models.Class,
 	objectDiff *moduletools.ObjectDiff, findObjFn modulecapabilities.FindObjectFn, logger logrus.FieldLogger,
 ) error {
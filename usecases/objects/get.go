@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // GetObject Class from the connected DB
@@ -30,15 +31,16 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 	class string, id strfmt.UUID, additional additional.Properties,
 	replProps *additional.ReplicationProperties, tenant string,
 ) (*models.Object, error) {
-	path := fmt.Sprintf("objects/%s", id)
-	if class != "" {
-		path = fmt.Sprintf("objects/%s/%s", class, id)
-	}
-	err := m.authorizer.Authorize(principal, "get", path)
+	tenant = authorization.TenantFor(principal, tenant)
+	err := m.authorizer.Authorize(principal, "get", authorization.Objects(class, tenant, id))
 	if err != nil {
 		return nil, err
 	}
 
+	if err := activateTenant(ctx, m.offload, class, tenant); err != nil {
+		return nil, err
+	}
+
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -57,7 +59,9 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 		m.trackUsageSingle(res)
 	}
 
-	return res.ObjectWithVector(additional.Vector), nil
+	obj := res.ObjectWithVector(additional.Vector)
+	m.masker.MaskObjects(principal, m.schemaManager, obj)
+	return obj, nil
 }
 
 // GetObjects Class from the connected DB
@@ -65,7 +69,8 @@ func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
 	offset *int64, limit *int64, sort *string, order *string, after *string,
 	addl additional.Properties, tenant string,
 ) ([]*models.Object, error) {
-	err := m.authorizer.Authorize(principal, "list", "objects")
+	tenant = authorization.TenantFor(principal, tenant)
+	err := m.authorizer.Authorize(principal, "list", authorization.Objects("", tenant, ""))
 	if err != nil {
 		return nil, err
 	}
@@ -78,13 +83,32 @@ func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
 
 	m.metrics.GetObjectInc()
 	defer m.metrics.GetObjectDec()
-	return m.getObjectsFromRepo(ctx, offset, limit, sort, order, after, addl, tenant)
+
+	// objects of all classes are sorted, the sort properties must not be
+	// sensitive in any of them
+	for _, class := range m.schemaManager.GetSchemaSkipAuth().Objects.Classes {
+		for _, s := range m.getSort(sort, order) {
+			err := m.masker.CheckProperties(principal, m.schemaManager, class.Class,
+				"for sorting", s.Path...)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	objs, err := m.getObjectsFromRepo(ctx, offset, limit, sort, order, after, addl, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	m.masker.MaskObjects(principal, m.schemaManager, objs...)
+	return objs, nil
 }
 
 func (m *Manager) GetObjectsClass(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID,
 ) (*models.Class, error) {
-	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("objects/%s", id.String()))
+	err := m.authorizer.Authorize(principal, "get", authorization.Objects("", "", id))
 	if err != nil {
 		return nil, err
 	}
@@ -13,25 +13,27 @@ package objects
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // HeadObject check object's existence in the connected DB
 func (m *Manager) HeadObject(ctx context.Context, principal *models.Principal, class string,
 	id strfmt.UUID, repl *additional.ReplicationProperties, tenant string,
 ) (bool, *Error) {
-	path := fmt.Sprintf("objects/%s", id)
-	if class != "" {
-		path = fmt.Sprintf("objects/%s/%s", class, id)
-	}
+	tenant = authorization.TenantFor(principal, tenant)
+	path := authorization.Objects(class, tenant, id)
 	if err := m.authorizer.Authorize(principal, "head", path); err != nil {
 		return false, &Error{path, StatusForbidden, err}
 	}
 
+	if err := activateTenant(ctx, m.offload, class, tenant); err != nil {
+		return false, activationError(err)
+	}
+
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return false, &Error{"cannot lock", StatusInternalServerError, err}
@@ -30,7 +30,13 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/masking"
+	"github.com/weaviate/weaviate/usecases/cdc"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/querycache"
+	"github.com/weaviate/weaviate/usecases/quota"
 )
 
 // Manager manages kind changes at a use-case level, i.e. agnostic of
@@ -46,6 +52,15 @@ type Manager struct {
 	modulesProvider   ModulesProvider
 	autoSchemaManager *autoSchemaManager
 	metrics           objectsMetrics
+	masker            *masking.Masker
+	auditLog          *audit.Logger
+	changes           *cdc.Stream
+	quotas            *quota.Enforcer
+	offload           tenantActivator
+	queryCache        *querycache.Cache
+	partitions        partitionCreator
+	shards            shardResolver
+	transactions      transactionLocks
 }
 
 type objectsMetrics interface {
@@ -109,6 +124,11 @@ type VectorRepo interface {
 		target *crossref.Ref, repl *additional.ReplicationProperties, tenant string) error
 	Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties, tenant string) error
 	Query(context.Context, *QueryInput) (search.Results, *Error)
+	// ObjectVersions returns the kept versions of an object, newest first
+	ObjectVersions(ctx context.Context, class string, id strfmt.UUID,
+		tenant string) ([]*storobj.ObjectVersion, error)
+	// FindDuplicates groups the near-duplicate objects of a class
+	FindDuplicates(ctx context.Context, params DuplicatesParams) (*DuplicatesResult, error)
 }
 
 type ModulesProvider interface {
@@ -117,6 +137,7 @@ type ModulesProvider interface {
 	ListObjectsAdditionalExtend(ctx context.Context, in search.Results,
 		moduleParams map[string]interface{}) (search.Results, error)
 	UsingRef2Vec(className string) bool
+	EnrichObject(ctx context.Context, object *models.Object, class *models.Class) error
 	UpdateVector(ctx context.Context, object *models.Object, class *models.Class,
 		objectDiff *moduletools.ObjectDiff, repo modulecapabilities.FindObjectFn,
 		logger logrus.FieldLogger) error
@@ -140,9 +161,55 @@ func NewManager(locks locks, schemaManager schemaManager,
 		modulesProvider:   modulesProvider,
 		autoSchemaManager: newAutoSchemaManager(schemaManager, vectorRepo, config, logger),
 		metrics:           metrics,
+		masker: masking.New(config.Config.Authorization.Enabled(),
+			config.Config.Authorization.SensitiveData),
 	}
 }
 
+// SetAuditLog records all mutations in the given audit log
+func (m *Manager) SetAuditLog(auditLog *audit.Logger) {
+	m.auditLog = auditLog
+}
+
+// SetChangeStream publishes all changes of objects to the given stream
+func (m *Manager) SetChangeStream(changes *cdc.Stream) {
+	m.changes = changes
+}
+
+// SetQuotas enforces the given quotas when objects are added
+func (m *Manager) SetQuotas(quotas *quota.Enforcer) {
+	m.quotas = quotas
+}
+
+// SetAutoSchema changes the auto schema settings of objects which are added
+// or updated
+func (m *Manager) SetAutoSchema(config config.AutoSchema) {
+	m.autoSchemaManager.setConfig(config)
+}
+
+// SetTenantOffload activates offloaded tenants when they are accessed
+func (m *Manager) SetTenantOffload(offload tenantActivator) {
+	m.offload = offload
+}
+
+// SetQueryCache invalidates the cached queries of classes which are written
+// to
+func (m *Manager) SetQueryCache(queryCache *querycache.Cache) {
+	m.queryCache = queryCache
+}
+
+// SetPartitionCreator creates the missing temporal partitions objects are
+// added to
+func (m *Manager) SetPartitionCreator(partitions partitionCreator) {
+	m.partitions = partitions
+}
+
+// SetShardResolver determines whether the operations of a transaction fall
+// on one shard
+func (m *Manager) SetShardResolver(shards shardResolver) {
+	m.shards = shards
+}
+
 func generateUUID() (strfmt.UUID, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
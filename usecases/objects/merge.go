@@ -22,6 +22,8 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/cdc"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -38,16 +40,24 @@ type MergeDocument struct {
 
 func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	updates *models.Object, repl *additional.ReplicationProperties,
-) *Error {
+) (aerr *Error) {
 	if err := m.validateInputs(updates); err != nil {
 		return &Error{"bad request", StatusBadRequest, err}
 	}
+	defaultTenant(principal, updates)
 	cls, id := updates.Class, updates.ID
-	path := fmt.Sprintf("objects/%s/%s", cls, id)
+	path := authorization.Objects(cls, updates.Tenant, id)
+	defer func() {
+		m.auditLog.Record(ctx, principal, "update", auditError(aerr), path)
+	}()
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
 
+	if err := activateTenant(ctx, m.offload, cls, updates.Tenant); err != nil {
+		return activationError(err)
+	}
+
 	m.metrics.MergeObjectInc()
 	defer m.metrics.MergeObjectDec()
 
@@ -120,6 +130,20 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 		return &Error{"repo.merge", StatusInternalServerError, err}
 	}
 
+	if err := m.rechunkMerged(ctx, principal, updates, propertiesToDelete,
+		mergeDoc.UpdateTime, repl, tenant); err != nil {
+		return &Error{"chunking", StatusInternalServerError, err}
+	}
+
+	m.changes.Record(ctx, cdc.OpUpdate, &models.Object{
+		Class:      cls,
+		ID:         id,
+		Tenant:     tenant,
+		Properties: objWithVec.Properties,
+		Vector:     objWithVec.Vector,
+	})
+	recordSessionWrite(ctx, cls, tenant, id, mergeDoc.UpdateTime, false, repl)
+	m.queryCache.Invalidate(ctx, cls)
 	return nil
 }
 
@@ -180,6 +204,9 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 	if err != nil {
 		return nil, err
 	}
+	if err := m.modulesProvider.EnrichObject(ctx, obj, class); err != nil {
+		return nil, err
+	}
 	if err := m.modulesProvider.UpdateVector(ctx, obj, class, objDiff, m.findObject, m.logger); err != nil {
 		return nil, err
 	}
@@ -220,3 +247,35 @@ func (m *Manager) splitPrimitiveAndRefs(in map[string]interface{}, sourceClass s
 
 	return primitive, outRefs
 }
+
+// rechunkMerged rewrites the chunks of a merged object if its chunked
+// property was changed or removed
+func (m *Manager) rechunkMerged(ctx context.Context, principal *models.Principal,
+	updates *models.Object, propertiesToDelete []string, updateTime int64,
+	repl *additional.ReplicationProperties, tenant string,
+) error {
+	class, err := m.schemaManager.GetClass(ctx, principal, updates.Class)
+	if err != nil || !schema.ChunkingEnabled(class) {
+		return err
+	}
+	prop := class.ChunkingConfig.Property
+	props := map[string]interface{}{}
+	if value, ok := updates.Properties.(map[string]interface{})[prop]; ok {
+		props[prop] = value
+	} else {
+		deleted := false
+		for _, name := range propertiesToDelete {
+			deleted = deleted || name == prop
+		}
+		if !deleted {
+			return nil
+		}
+	}
+	return m.chunks().write(ctx, principal, class, &models.Object{
+		Class:              updates.Class,
+		ID:                 updates.ID,
+		Tenant:             tenant,
+		Properties:         props,
+		LastUpdateTimeUnix: updateTime,
+	}, repl)
+}
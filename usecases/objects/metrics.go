@@ -36,7 +36,7 @@ func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
 		batchTime:          prom.BatchTime,
 		dimensions:         prom.QueryDimensions,
 		dimensionsCombined: prom.QueryDimensionsCombined,
-		groupClasses:       prom.Group,
+		groupClasses:       prom.Group || prom.DisableClassLabels,
 	}
 }
 
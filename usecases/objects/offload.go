@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// tenantActivator activates offloaded tenants, it is implemented by the
+// offload package, which depends on this package
+type tenantActivator interface {
+	// Activate returns an ErrMultiTenancy if the tenant is still being
+	// activated
+	Activate(ctx context.Context, class, tenant string) error
+}
+
+// activateTenant activates the tenant if it was offloaded
+func activateTenant(ctx context.Context, activator tenantActivator, class, tenant string) error {
+	if activator == nil {
+		return nil
+	}
+
+	if err := activator.Activate(ctx, class, tenant); err != nil {
+		if errors.As(err, &ErrMultiTenancy{}) {
+			return err
+		}
+		return NewErrInternal("activate tenant %q: %v", tenant, err)
+	}
+	return nil
+}
+
+// activationError converts the error of activateTenant for methods which
+// return an *Error
+func activationError(err error) *Error {
+	if errors.As(err, &ErrMultiTenancy{}) {
+		return &Error{"activate tenant", StatusUnprocessableEntity, err}
+	}
+	return &Error{"activate tenant", StatusInternalServerError, err}
+}
+
+// activateBatchTenants activates the offloaded tenants a batch writes to.
+// Errors are not returned, objects of tenants which could not be activated
+// are reported as failed by the repo like objects of any inactive tenant.
+func activateBatchTenants(ctx context.Context, activator tenantActivator, objects []*models.Object) {
+	if activator == nil {
+		return
+	}
+
+	seen := map[[2]string]struct{}{}
+	for _, object := range objects {
+		if object == nil || object.Tenant == "" {
+			continue
+		}
+		key := [2]string{object.Class, object.Tenant}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		activator.Activate(ctx, object.Class, object.Tenant)
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// partitionCreator creates the temporal partitions objects are written to,
+// it is implemented by the schema manager
+type partitionCreator interface {
+	CreatePartitions(ctx context.Context, class string, names []string) error
+}
+
+// assignPartition sets the tenant of an object of a temporally partitioned
+// class to the partition of its date. A tenant set explicitly must be that
+// partition.
+func assignPartition(sch schema.Schema, object *models.Object) error {
+	if object == nil {
+		return nil
+	}
+	class := sch.FindClassByName(schema.ClassName(object.Class))
+	if !schema.TemporalPartitioningEnabled(class) {
+		return nil
+	}
+
+	cfg := class.TemporalPartitioningConfig
+	var value interface{}
+	if props, ok := object.Properties.(map[string]interface{}); ok {
+		value = props[cfg.Property]
+	}
+	partition, err := schema.TemporalPartitionOf(cfg, value)
+	if err != nil {
+		return err
+	}
+	if object.Tenant != "" && object.Tenant != partition {
+		return fmt.Errorf("object belongs to partition %q of class %q, got tenant %q",
+			partition, class.Class, object.Tenant)
+	}
+	object.Tenant = partition
+	return nil
+}
+
+// createPartitions creates the missing partitions the objects are written to.
+// Objects of classes which are not partitioned are ignored.
+func createPartitions(ctx context.Context, creator partitionCreator, sch schema.Schema,
+	objects ...*models.Object,
+) error {
+	if creator == nil {
+		return nil
+	}
+
+	partitions := map[string][]string{}
+	for _, object := range objects {
+		if object == nil || object.Tenant == "" {
+			continue
+		}
+		class := sch.FindClassByName(schema.ClassName(object.Class))
+		if !schema.TemporalPartitioningEnabled(class) {
+			continue
+		}
+		partitions[class.Class] = append(partitions[class.Class], object.Tenant)
+	}
+
+	for class, names := range partitions {
+		if err := creator.CreatePartitions(ctx, class, names); err != nil {
+			return fmt.Errorf("class %q: %w", class, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+type fakePartitionCreator struct {
+	created map[string][]string
+}
+
+func (f *fakePartitionCreator) CreatePartitions(ctx context.Context, class string, names []string) error {
+	f.created[class] = append(f.created[class], names...)
+	return nil
+}
+
+func TestTemporalPartitions(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+		{
+			Class:              "Log",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+			TemporalPartitioningConfig: &models.TemporalPartitioningConfig{
+				Property: "timestamp",
+				Interval: "month",
+			},
+		},
+		{Class: "Article"},
+	}}}
+	logEntry := func(tenant string, timestamp interface{}) *models.Object {
+		return &models.Object{
+			Class:      "Log",
+			Tenant:     tenant,
+			Properties: map[string]interface{}{"timestamp": timestamp},
+		}
+	}
+
+	t.Run("assign partition", func(t *testing.T) {
+		object := logEntry("", "2023-10-15T10:00:00+02:00")
+		require.Nil(t, assignPartition(sch, object))
+		assert.Equal(t, "2023-10", object.Tenant)
+
+		object = logEntry("2023-10", "2023-10-31T23:30:00-01:00")
+		assert.ErrorContains(t, assignPartition(sch, object),
+			`object belongs to partition "2023-11" of class "Log", got tenant "2023-10"`)
+
+		object = logEntry("", nil)
+		assert.ErrorContains(t, assignPartition(sch, object), `partition property "timestamp" must be set`)
+
+		object = &models.Object{Class: "Article", Properties: map[string]interface{}{}}
+		require.Nil(t, assignPartition(sch, object))
+		assert.Equal(t, "", object.Tenant)
+	})
+
+	t.Run("create partitions", func(t *testing.T) {
+		creator := &fakePartitionCreator{created: map[string][]string{}}
+		err := createPartitions(context.Background(), creator, sch,
+			logEntry("2023-10", nil),
+			logEntry("2023-11", nil),
+			&models.Object{Class: "Article", Tenant: "tenant1"},
+			nil,
+		)
+		require.Nil(t, err)
+		assert.Equal(t, map[string][]string{"Log": {"2023-10", "2023-11"}}, creator.created)
+
+		require.Nil(t, createPartitions(context.Background(), nil, sch, logEntry("2023-10", nil)))
+	})
+}
@@ -13,11 +13,11 @@ package objects
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 type QueryInput struct {
@@ -66,7 +66,14 @@ func (q *QueryParams) inputs(m *Manager) (*QueryInput, error) {
 
 func (m *Manager) Query(ctx context.Context, principal *models.Principal, params *QueryParams,
 ) ([]*models.Object, *Error) {
-	path := fmt.Sprintf("objects/%s", params.Class)
+	if params.Tenant == nil && principal != nil && principal.Tenant != "" {
+		params.Tenant = &principal.Tenant
+	}
+	tenant := ""
+	if params.Tenant != nil {
+		tenant = *params.Tenant
+	}
+	path := authorization.Objects(params.Class, tenant, "")
 	if err := m.authorizer.Authorize(principal, "list", path); err != nil {
 		return nil, &Error{path, StatusForbidden, err}
 	}
@@ -83,6 +90,13 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 	if err != nil {
 		return nil, &Error{"offset or limit", StatusBadRequest, err}
 	}
+	for _, sort := range q.Sort {
+		err := m.masker.CheckProperties(principal, m.schemaManager, q.Class,
+			"for sorting", sort.Path...)
+		if err != nil {
+			return nil, &Error{path, StatusForbidden, err}
+		}
+	}
 	res, rerr := m.vectorRepo.Query(ctx, q)
 	if rerr != nil {
 		return nil, rerr
@@ -99,5 +113,7 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 		m.trackUsageList(res)
 	}
 
-	return res.ObjectsWithVector(q.Additional.Vector), nil
+	objs := res.ObjectsWithVector(q.Additional.Vector)
+	m.masker.MaskObjects(principal, m.schemaManager, objs...)
+	return objs, nil
 }
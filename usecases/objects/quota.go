@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// vectorBytes of an object as they are counted by the quotas, vectors are
+// stored as float32
+func vectorBytes(object *models.Object) int64 {
+	return int64(len(object.Vector)) * 4
+}
+
+// requestBatchQuotas counts a batch as a single request for every class and
+// tenant it writes to
+func (b *BatchManager) requestBatchQuotas(objects []*models.Object) error {
+	seen := map[[2]string]struct{}{}
+	for _, object := range objects {
+		if object == nil {
+			continue
+		}
+		key := [2]string{object.Class, object.Tenant}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		if err := b.quotas.Request(object.Class, object.Tenant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// admitBatchQuotas marks the objects which would exceed a quota as failed,
+// the remaining objects of the batch are still imported
+func (b *BatchManager) admitBatchQuotas(ctx context.Context, objects []*models.Object,
+	batch BatchObjects,
+) {
+	for i := range batch {
+		if batch[i].Err != nil {
+			continue
+		}
+		// the class of the result is only set if it is part of the response
+		// fields, so it is taken from the request
+		incoming := objects[batch[i].OriginalIndex]
+		if err := b.quotas.Admit(ctx, incoming.Class, incoming.Tenant, 1,
+			vectorBytes(batch[i].Object)); err != nil {
+			batch[i].Err = err
+		}
+	}
+}
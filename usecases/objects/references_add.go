@@ -14,13 +14,13 @@ package objects
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
@@ -29,14 +29,24 @@ import (
 // include this particular network ref class.
 func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Principal,
 	input *AddReferenceInput, repl *additional.ReplicationProperties, tenant string,
-) *Error {
+) (aerr *Error) {
+	tenant = authorization.TenantFor(principal, tenant)
+	defer func() {
+		m.auditLog.Record(ctx, principal, "update", auditError(aerr),
+			authorization.Objects(input.Class, tenant, input.ID))
+	}()
+
 	m.metrics.AddReferenceInc()
 	defer m.metrics.AddReferenceDec()
 
+	if err := activateTenant(ctx, m.offload, input.Class, tenant); err != nil {
+		return activationError(err)
+	}
+
 	deprecatedEndpoint := input.Class == ""
 	if deprecatedEndpoint { // for backward compatibility only
 		objectRes, err := m.getObjectFromRepo(ctx, "", input.ID,
-			additional.Properties{}, nil, tenant)
+			additional.Properties{}, repl, tenant)
 		if err != nil {
 			errnf := ErrNotFound{} // treated as StatusBadRequest for backward comp
 			if errors.As(err, &errnf) {
@@ -48,7 +58,7 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 		}
 		input.Class = objectRes.Object().Class
 	}
-	path := fmt.Sprintf("objects/%s/%s", input.Class, input.ID)
+	path := authorization.Objects(input.Class, tenant, input.ID)
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
@@ -114,11 +124,15 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 		}
 	}
 
+	// the update time is assigned by the repo, it is not earlier than now
+	now := m.timeSource.Now()
 	if err := m.vectorRepo.AddReference(ctx, source, target, repl, tenant); err != nil {
 		return &Error{"add reference to repo", StatusInternalServerError, err}
 	}
+	recordSessionWrite(ctx, input.Class, tenant, input.ID, now, false, repl)
+	m.queryCache.Invalidate(ctx, input.Class)
 
-	if err := m.updateRefVector(ctx, principal, input.Class, input.ID, tenant); err != nil {
+	if err := m.updateRefVector(ctx, principal, input.Class, input.ID, repl, tenant); err != nil {
 		return &Error{"update ref vector", StatusInternalServerError, err}
 	}
 
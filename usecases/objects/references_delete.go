@@ -14,12 +14,12 @@ package objects
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // DeleteReferenceInput represents required inputs to delete a reference from an existing object.
@@ -36,10 +36,20 @@ type DeleteReferenceInput struct {
 
 func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.Principal,
 	input *DeleteReferenceInput, repl *additional.ReplicationProperties, tenant string,
-) *Error {
+) (aerr *Error) {
+	tenant = authorization.TenantFor(principal, tenant)
+	defer func() {
+		m.auditLog.Record(ctx, principal, "update", auditError(aerr),
+			authorization.Objects(input.Class, tenant, input.ID))
+	}()
+
 	m.metrics.DeleteReferenceInc()
 	defer m.metrics.DeleteReferenceDec()
 
+	if err := activateTenant(ctx, m.offload, input.Class, tenant); err != nil {
+		return activationError(err)
+	}
+
 	deprecatedEndpoint := input.Class == ""
 	beacon, err := crossref.Parse(input.Reference.Beacon.String())
 	if err != nil {
@@ -57,7 +67,7 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 	}
 
 	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID,
-		additional.Properties{}, nil, tenant)
+		additional.Properties{}, repl, tenant)
 	if err != nil {
 		errnf := ErrNotFound{}
 		if errors.As(err, &errnf) {
@@ -69,7 +79,7 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 	}
 	input.Class = res.ClassName
 
-	path := fmt.Sprintf("objects/%s/%s", input.Class, input.ID)
+	path := authorization.Objects(input.Class, tenant, input.ID)
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
@@ -105,8 +115,10 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 	if err != nil {
 		return &Error{"repo.putobject", StatusInternalServerError, err}
 	}
+	recordSessionWrite(ctx, obj.Class, tenant, obj.ID, obj.LastUpdateTimeUnix, false, repl)
+	m.queryCache.Invalidate(ctx, obj.Class)
 
-	if err := m.updateRefVector(ctx, principal, input.Class, input.ID, tenant); err != nil {
+	if err := m.updateRefVector(ctx, principal, input.Class, input.ID, repl, tenant); err != nil {
 		return &Error{"update ref vector", StatusInternalServerError, err}
 	}
 
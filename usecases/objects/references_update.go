@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
@@ -41,11 +42,21 @@ type PutReferenceInput struct {
 // include this particular network ref class.
 func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.Principal,
 	input *PutReferenceInput, repl *additional.ReplicationProperties, tenant string,
-) *Error {
+) (aerr *Error) {
+	tenant = authorization.TenantFor(principal, tenant)
+	defer func() {
+		m.auditLog.Record(ctx, principal, "update", auditError(aerr),
+			authorization.Objects(input.Class, tenant, input.ID))
+	}()
+
 	m.metrics.UpdateReferenceInc()
 	defer m.metrics.UpdateReferenceDec()
 
-	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, additional.Properties{}, nil, tenant)
+	if err := activateTenant(ctx, m.offload, input.Class, tenant); err != nil {
+		return activationError(err)
+	}
+
+	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, additional.Properties{}, repl, tenant)
 	if err != nil {
 		errnf := ErrNotFound{}
 		if errors.As(err, &errnf) {
@@ -60,7 +71,7 @@ func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.
 	}
 	input.Class = res.ClassName
 
-	path := fmt.Sprintf("objects/%s/%s", input.Class, input.ID)
+	path := authorization.Objects(input.Class, tenant, input.ID)
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
@@ -110,6 +121,8 @@ func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.
 	if err != nil {
 		return &Error{"repo.putobject", StatusInternalServerError, err}
 	}
+	recordSessionWrite(ctx, obj.Class, tenant, obj.ID, obj.LastUpdateTimeUnix, false, repl)
+	m.queryCache.Invalidate(ctx, obj.Class)
 	return nil
 }
 
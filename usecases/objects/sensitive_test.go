@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/masking"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_SensitiveProperties(t *testing.T) {
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             "Patient",
+					Vectorizer:        config.VectorizerModuleNone,
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties: []*models.Property{
+						{
+							Name:         "name",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationWhitespace,
+						},
+						{
+							Name:         "ssn",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationWhitespace,
+							Sensitive:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+	cfg := &config.WeaviateConfig{Config: config.Config{
+		Authorization: config.Authorization{
+			AdminList:     adminlist.Config{Enabled: true},
+			SensitiveData: masking.Config{Users: []string{"alice"}},
+		},
+	}}
+	bob := &models.Principal{Username: "bob"}
+	alice := &models.Principal{Username: "alice"}
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchemaManager{GetSchemaResponse: sch}
+	ctx := context.Background()
+
+	newManager := func() (*Manager, *fakeVectorRepo) {
+		vectorRepo := &fakeVectorRepo{}
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		return NewManager(&fakeLocks{}, schemaManager, cfg, logger, &fakeAuthorizer{},
+			vectorRepo, modulesProvider, &fakeMetrics{}), vectorRepo
+	}
+
+	t.Run("created object is echoed without sensitive properties", func(t *testing.T) {
+		manager, vectorRepo := newManager()
+		vectorRepo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+		object := &models.Object{
+			Class:      "Patient",
+			Vector:     []float32{0.1, 0.2, 0.3},
+			Properties: map[string]interface{}{"name": "John Doe", "ssn": "123-45-6789"},
+		}
+
+		res, err := manager.AddObject(ctx, bob, object, nil)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"name": "John Doe"}, res.Properties)
+
+		stored := vectorRepo.Mock.Calls[0].Arguments.Get(0).(*models.Object)
+		assert.Equal(t, "123-45-6789", stored.Properties.(map[string]interface{})["ssn"])
+	})
+
+	t.Run("created object is echoed in full to readers of sensitive data", func(t *testing.T) {
+		manager, vectorRepo := newManager()
+		vectorRepo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+		object := &models.Object{
+			Class:      "Patient",
+			Vector:     []float32{0.1, 0.2, 0.3},
+			Properties: map[string]interface{}{"name": "John Doe", "ssn": "123-45-6789"},
+		}
+
+		res, err := manager.AddObject(ctx, alice, object, nil)
+		require.Nil(t, err)
+		assert.Equal(t, "123-45-6789", res.Properties.(map[string]interface{})["ssn"])
+	})
+
+	t.Run("batch is echoed without sensitive properties", func(t *testing.T) {
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		manager := NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			schemaManager, cfg, logger, &fakeAuthorizer{}, nil, nil)
+		objects := []*models.Object{{
+			Class:      "Patient",
+			Vector:     []float32{0.1, 0.2, 0.3},
+			Properties: map[string]interface{}{"name": "John Doe", "ssn": "123-45-6789"},
+		}}
+
+		res, err := manager.AddObjects(ctx, bob, objects, []*string{}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, map[string]interface{}{"name": "John Doe"}, res[0].Object.Properties)
+		assert.Equal(t, "123-45-6789", objects[0].Properties.(map[string]interface{})["ssn"])
+	})
+
+	t.Run("listing sorted by a sensitive property", func(t *testing.T) {
+		manager, _ := newManager()
+		class, sort := "Patient", "ssn"
+
+		_, err := manager.Query(ctx, bob, &QueryParams{Class: class, Sort: &sort})
+		require.NotNil(t, err)
+		assert.Equal(t, StatusForbidden, err.Code)
+		assert.Equal(t, masking.ErrSensitive{
+			Class: "Patient", Property: "ssn", Use: "for sorting",
+		}, err.Err)
+
+		_, gerr := manager.GetObjects(ctx, bob, nil, nil, &sort, nil, nil,
+			additional.Properties{}, "")
+		assert.EqualError(t, gerr, "property 'ssn' of class 'Patient' "+
+			"is sensitive and can not be used for sorting")
+	})
+
+	t.Run("batch delete filtered by a sensitive property", func(t *testing.T) {
+		manager := NewBatchManager(&fakeVectorRepo{}, getFakeModulesProvider(), &fakeLocks{},
+			schemaManager, cfg, logger, &fakeAuthorizer{}, nil, nil)
+		match := &models.BatchDeleteMatch{
+			Class: "Patient",
+			Where: &models.WhereFilter{
+				Path:      []string{"ssn"},
+				Operator:  "Equal",
+				ValueText: ptString("123-45-6789"),
+			},
+		}
+
+		_, err := manager.DeleteObjects(ctx, bob, match, ptBool(true), nil, nil, "")
+		assert.EqualError(t, err, "validate: property 'ssn' of class 'Patient' "+
+			"is sensitive and can not be used in filters")
+	})
+}
@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/session"
+)
+
+// recordSessionWrite adds a write to the session token of the request, so
+// that the client can read it back from an up to date replica. The time must
+// not be later than the update time stored with the object.
+func recordSessionWrite(ctx context.Context, class, tenant string, id strfmt.UUID,
+	time int64, deleted bool, repl *additional.ReplicationProperties,
+) {
+	w := session.Write{
+		Class:   class,
+		Tenant:  tenant,
+		ID:      id,
+		Time:    time,
+		Deleted: deleted,
+	}
+	if repl != nil {
+		w.Level = repl.ConsistencyLevel
+	}
+	session.Record(ctx, w)
+}
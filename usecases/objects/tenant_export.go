@@ -0,0 +1,223 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// TenantExportVersion is the version of the format written by ExportTenant
+const TenantExportVersion = 1
+
+// maxTenantImportErrors limits the errors reported by ImportTenant, the
+// remaining failures are only counted
+const maxTenantImportErrors = 10
+
+var tenantExportBatchSize = 100
+
+// TenantExportHeader is the first document of a tenant export. It contains
+// the class the tenant was exported from, so that the class can be created
+// in the target cluster before the tenant is imported.
+type TenantExportHeader struct {
+	Version    int           `json:"version"`
+	Class      *models.Class `json:"class"`
+	Tenant     string        `json:"tenant"`
+	ExportedAt time.Time     `json:"exportedAt"`
+}
+
+// TenantImportResult summarizes the import of a tenant
+type TenantImportResult struct {
+	Class    string   `json:"class"`
+	Tenant   string   `json:"tenant"`
+	Imported int      `json:"imported"`
+	Failed   int      `json:"failed"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+// ExportTenant writes all objects of a tenant including their vectors to w.
+// The export is a gzip compressed stream of JSON documents, the header
+// followed by one document per object.
+//
+// Neither the vector index nor the inverted index are part of the export.
+// Both refer to objects by doc ids, which are local to a shard, and the
+// importing shard assigns new ones, so the exported graph could not be
+// reused as is. The indexes are rebuilt from the objects and vectors
+// instead, which makes the import as expensive as the original import, but
+// an export does not depend on the on-disk format and can be imported into
+// any class with compatible properties and index configuration.
+//
+// Nothing is written to w if the export fails before the first object was
+// read.
+func (b *BatchManager) ExportTenant(ctx context.Context, principal *models.Principal,
+	class, tenant string, w io.Writer,
+) error {
+	class = schema.UppercaseClassName(class)
+	if tenant == "" {
+		return NewErrInvalidUserInput("tenant is required")
+	}
+	if err := b.authorizer.Authorize(principal, "get",
+		authorization.Objects(class, tenant, "")); err != nil {
+		return err
+	}
+	sch := b.schemaManager.GetSchemaSkipAuth()
+	cls := sch.GetClass(schema.ClassName(class))
+	if cls == nil {
+		return NewErrNotFound("class %q not found", class)
+	}
+	if err := activateTenant(ctx, b.offload, class, tenant); err != nil {
+		return err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	var (
+		gz  *gzip.Writer
+		enc *json.Encoder
+	)
+	after := ""
+	for {
+		res, qerr := b.vectorRepo.Query(ctx, &QueryInput{
+			Class:      class,
+			Limit:      tenantExportBatchSize,
+			Cursor:     &filters.Cursor{After: after, Limit: tenantExportBatchSize},
+			Tenant:     tenant,
+			Additional: additional.Properties{Vector: true},
+		})
+		if qerr != nil {
+			if gz == nil {
+				return qerr
+			}
+			return fmt.Errorf("export tenant %q: %w", tenant, qerr)
+		}
+
+		if gz == nil {
+			gz = gzip.NewWriter(w)
+			enc = json.NewEncoder(gz)
+			if err := enc.Encode(TenantExportHeader{
+				Version:    TenantExportVersion,
+				Class:      cls,
+				Tenant:     tenant,
+				ExportedAt: time.Now().UTC(),
+			}); err != nil {
+				return fmt.Errorf("export tenant %q: %w", tenant, err)
+			}
+		}
+
+		objs := res.ObjectsWithVector(true)
+		b.masker.MaskObjects(principal, b.schemaManager, objs...)
+		for _, obj := range objs {
+			obj.Additional = nil
+			if err := enc.Encode(obj); err != nil {
+				return fmt.Errorf("export tenant %q: %w", tenant, err)
+			}
+		}
+		if len(objs) < tenantExportBatchSize {
+			break
+		}
+		after = objs[len(objs)-1].ID.String()
+	}
+
+	return gz.Close()
+}
+
+// ImportTenant adds the objects of an export written by ExportTenant to the
+// given class and tenant, which do not need to match the exported ones. The
+// objects are added in batches like any other batch, so objects which fail
+// validation are counted as failed instead of aborting the import.
+func (b *BatchManager) ImportTenant(ctx context.Context, principal *models.Principal,
+	class, tenant string, r io.Reader,
+) (*TenantImportResult, error) {
+	class = schema.UppercaseClassName(class)
+	if tenant == "" {
+		return nil, NewErrInvalidUserInput("tenant is required")
+	}
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("read tenant export: %v", err)
+	}
+	defer gz.Close()
+	dec := json.NewDecoder(gz)
+
+	var header TenantExportHeader
+	if err := dec.Decode(&header); err != nil {
+		return nil, NewErrInvalidUserInput("read tenant export header: %v", err)
+	}
+	if header.Version != TenantExportVersion {
+		return nil, NewErrInvalidUserInput("unsupported tenant export version %d", header.Version)
+	}
+
+	result := &TenantImportResult{Class: class, Tenant: tenant}
+	batch := make([]*models.Object, 0, tenantExportBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		res, err := b.AddObjects(ctx, principal, batch, nil, nil)
+		if err != nil {
+			return err
+		}
+		for _, obj := range res {
+			if obj.Err == nil {
+				result.Imported++
+				continue
+			}
+			result.Failed++
+			if len(result.Errors) < maxTenantImportErrors {
+				result.Errors = append(result.Errors,
+					fmt.Sprintf("object %s: %v", obj.UUID, obj.Err))
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		var obj models.Object
+		if err := dec.Decode(&obj); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, NewErrInvalidUserInput("read tenant export: %v", err)
+		}
+		obj.Class = class
+		obj.Tenant = tenant
+		obj.Additional = nil
+		batch = append(batch, &obj)
+
+		if len(batch) == tenantExportBatchSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestTenantExportImport(t *testing.T) {
+	defer func(size int) { tenantExportBatchSize = size }(tenantExportBatchSize)
+	tenantExportBatchSize = 2
+
+	var (
+		ctx   = context.Background()
+		ids   = []strfmt.UUID{"a0b55b05-bc5b-4cc9-b646-1452d1390a62", "b0b55b05-bc5b-4cc9-b646-1452d1390a62", "c0b55b05-bc5b-4cc9-b646-1452d1390a62"}
+		class = &models.Class{
+			Class:             "Foo",
+			Vectorizer:        config.VectorizerModuleNone,
+			VectorIndexConfig: hnsw.UserConfig{},
+			MultiTenancyConfig: &models.MultiTenancyConfig{
+				Enabled: true,
+			},
+			Properties: []*models.Property{{Name: "name", DataType: schema.DataTypeText.PropString()}},
+		}
+		vectorRepo      = &fakeVectorRepo{}
+		modulesProvider = getFakeModulesProvider()
+		logger, _       = test.NewNullLogger()
+		manager         = NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			&fakeSchemaManager{GetSchemaResponse: schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			}}}, &config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil, nil)
+	)
+
+	result := func(i int) search.Result {
+		return search.Result{
+			ClassName: "Foo",
+			ID:        ids[i],
+			Tenant:    "T1",
+			Schema:    map[string]interface{}{"name": string(ids[i])},
+			Vector:    []float32{float32(i), 1},
+		}
+	}
+
+	var export bytes.Buffer
+	t.Run("export", func(t *testing.T) {
+		vectorRepo.On("Query", mock.MatchedBy(func(q *QueryInput) bool {
+			return q.Tenant == "T1" && q.Cursor.After == "" && q.Additional.Vector
+		})).Return([]search.Result{result(0), result(1)}, (*Error)(nil)).Once()
+		vectorRepo.On("Query", mock.MatchedBy(func(q *QueryInput) bool {
+			return q.Cursor.After == ids[1].String()
+		})).Return([]search.Result{result(2)}, (*Error)(nil)).Once()
+
+		require.Nil(t, manager.ExportTenant(ctx, nil, "foo", "T1", &export))
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("import into another tenant", func(t *testing.T) {
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Twice()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		res, err := manager.ImportTenant(ctx, nil, "Foo", "T2", bytes.NewReader(export.Bytes()))
+		require.Nil(t, err)
+		assert.Equal(t, &TenantImportResult{Class: "Foo", Tenant: "T2", Imported: 3}, res)
+
+		var imported []BatchObject
+		for _, call := range vectorRepo.Calls {
+			if call.Method == "BatchPutObjects" {
+				imported = append(imported, call.Arguments[0].(BatchObjects)...)
+			}
+		}
+		require.Len(t, imported, 3)
+		for i, obj := range imported {
+			assert.Equal(t, ids[i], obj.UUID)
+			assert.Equal(t, "T2", obj.Object.Tenant)
+			assert.Equal(t, []float32{float32(i), 1}, obj.Vector)
+			assert.Equal(t, map[string]interface{}{"name": string(ids[i])}, obj.Object.Properties)
+		}
+	})
+
+	t.Run("invalid export", func(t *testing.T) {
+		_, err := manager.ImportTenant(ctx, nil, "Foo", "T2", bytes.NewReader([]byte("{}")))
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+
+		var unsupported bytes.Buffer
+		gz := gzip.NewWriter(&unsupported)
+		gz.Write([]byte(`{"version":2}`))
+		gz.Close()
+		_, err = manager.ImportTenant(ctx, nil, "Foo", "T2", &unsupported)
+		assert.EqualError(t, err, "unsupported tenant export version 2")
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		err := manager.ExportTenant(ctx, nil, "Bar", "T1", &bytes.Buffer{})
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+}
+
+// tenantExportForTest returns an export with a single object
+func tenantExportForTest(t *testing.T) io.Reader {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(gz)
+	require.Nil(t, enc.Encode(TenantExportHeader{Version: TenantExportVersion}))
+	require.Nil(t, enc.Encode(models.Object{ID: "a0b55b05-bc5b-4cc9-b646-1452d1390a62"}))
+	require.Nil(t, gz.Close())
+	return &buf
+}
@@ -0,0 +1,415 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/cdc"
+)
+
+const (
+	TransactionCreate          = "create"
+	TransactionUpdate          = "update"
+	TransactionDelete          = "delete"
+	TransactionAddReference    = "add_reference"
+	TransactionDeleteReference = "delete_reference"
+
+	// MaxTransactionOperations limits the size of a transaction, all objects
+	// it touches are read before it is applied
+	MaxTransactionOperations = 100
+)
+
+// TransactionOperation is a single write of a transaction. Create and update
+// take the Object, delete and the reference changes the Class, ID and
+// Tenant of the object they change.
+type TransactionOperation struct {
+	Op        string            `json:"op"`
+	Object    *models.Object    `json:"object,omitempty"`
+	Class     string            `json:"class,omitempty"`
+	ID        strfmt.UUID       `json:"id,omitempty"`
+	Tenant    string            `json:"tenant,omitempty"`
+	Property  string            `json:"property,omitempty"`
+	Reference *models.SingleRef `json:"reference,omitempty"`
+}
+
+// TransactionResult of an applied transaction. Atomic tells whether all
+// operations fell on one shard. Objects holds the created or updated object
+// of each operation, nil for deletes and reference changes.
+type TransactionResult struct {
+	Atomic  bool             `json:"atomic"`
+	Objects []*models.Object `json:"objects"`
+}
+
+// ErrTransactionAborted indicates that an operation of a transaction failed
+// and the operations before it were rolled back. RollbackErr is set if the
+// rollback failed too, the transaction is then partially applied.
+type ErrTransactionAborted struct {
+	Index       int
+	Err         error
+	RollbackErr error
+}
+
+func (e ErrTransactionAborted) Error() string {
+	if e.RollbackErr != nil {
+		return fmt.Sprintf("transaction aborted at operation %d: %v, rollback failed, "+
+			"the transaction is partially applied: %v", e.Index, e.Err, e.RollbackErr)
+	}
+	return fmt.Sprintf("transaction aborted at operation %d: %v", e.Index, e.Err)
+}
+
+func (e ErrTransactionAborted) Unwrap() error {
+	return e.Err
+}
+
+type shardResolver interface {
+	TenantShard(class, tenant string) (string, string)
+	ShardFromUUID(class string, uuid []byte) string
+}
+
+// transactionLocks serializes the transactions of each shard
+type transactionLocks struct {
+	sync.Mutex
+	shards map[string]*sync.Mutex
+}
+
+func (l *transactionLocks) lock(keys []string) func() {
+	l.Lock()
+	if l.shards == nil {
+		l.shards = map[string]*sync.Mutex{}
+	}
+	locks := make([]*sync.Mutex, len(keys))
+	for i, key := range keys {
+		if l.shards[key] == nil {
+			l.shards[key] = &sync.Mutex{}
+		}
+		locks[i] = l.shards[key]
+	}
+	l.Unlock()
+
+	// keys are sorted, so transactions on several shards can not deadlock
+	for _, lock := range locks {
+		lock.Lock()
+	}
+	return func() {
+		for _, lock := range locks {
+			lock.Unlock()
+		}
+	}
+}
+
+type transactionTarget struct {
+	class  string
+	id     strfmt.UUID
+	tenant string
+}
+
+// ApplyTransaction applies the operations in order. If an operation fails,
+// the objects changed by the operations before it are restored to the
+// versions read before the transaction was applied. Transactions on the same
+// shard are serialized, so a transaction whose operations all fall on one
+// shard is applied all-or-nothing. Transactions across shards are rolled
+// back the same way, but only best-effort: a rollback write on another shard
+// can fail. Writes outside of transactions are not serialized with them.
+func (m *Manager) ApplyTransaction(ctx context.Context, principal *models.Principal,
+	ops []TransactionOperation, repl *additional.ReplicationProperties,
+) (*TransactionResult, error) {
+	targets, err := m.transactionTargets(principal, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	// authorize every operation before the first one is applied
+	for i, op := range ops {
+		t := targets[i]
+		if err := m.authorizer.Authorize(principal, transactionVerb(op.Op),
+			authorization.Objects(t.class, t.tenant, t.id)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := m.checkTransactionClasses(targets); err != nil {
+		return nil, err
+	}
+
+	shards, err := m.transactionShards(targets)
+	if err != nil {
+		return nil, err
+	}
+	unlock := m.transactions.lock(shards)
+	defer unlock()
+
+	snapshots, err := m.transactionSnapshots(ctx, targets, repl)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TransactionResult{
+		Atomic:  len(shards) == 1,
+		Objects: make([]*models.Object, len(ops)),
+	}
+	for i, op := range ops {
+		obj, err := m.applyTransactionOperation(ctx, principal, op, targets[i], repl)
+		if err != nil {
+			return nil, ErrTransactionAborted{
+				Index:       i,
+				Err:         err,
+				RollbackErr: m.rollbackTransaction(ctx, snapshots, repl),
+			}
+		}
+		result.Objects[i] = obj
+	}
+	return result, nil
+}
+
+// transactionTargets validates the operations and returns the object each of
+// them changes. Created objects without an id are assigned one, so that
+// their shard is known before they are added.
+func (m *Manager) transactionTargets(principal *models.Principal,
+	ops []TransactionOperation,
+) ([]transactionTarget, error) {
+	if len(ops) == 0 {
+		return nil, NewErrInvalidUserInput("transaction has no operations")
+	}
+	if len(ops) > MaxTransactionOperations {
+		return nil, NewErrInvalidUserInput("transaction has %d operations, at most %d are allowed",
+			len(ops), MaxTransactionOperations)
+	}
+
+	sch := m.schemaManager.GetSchemaSkipAuth()
+	targets := make([]transactionTarget, len(ops))
+	for i, op := range ops {
+		var t transactionTarget
+		switch op.Op {
+		case TransactionCreate, TransactionUpdate:
+			if op.Object == nil || op.Object.Class == "" {
+				return nil, NewErrInvalidUserInput("operation %d: object with class is required", i)
+			}
+			defaultTenant(principal, op.Object)
+			if err := assignPartition(sch, op.Object); err != nil {
+				return nil, NewErrInvalidUserInput("operation %d: invalid object: %v", i, err)
+			}
+			if op.Object.ID == "" {
+				if op.Op == TransactionUpdate {
+					return nil, NewErrInvalidUserInput("operation %d: object id is required", i)
+				}
+				id, err := generateUUID()
+				if err != nil {
+					return nil, NewErrInternal("could not generate id: %v", err)
+				}
+				op.Object.ID = id
+			}
+			op.Object.ID = strfmt.UUID(strings.ToLower(op.Object.ID.String()))
+			t = transactionTarget{op.Object.Class, op.Object.ID, op.Object.Tenant}
+		case TransactionDelete, TransactionAddReference, TransactionDeleteReference:
+			if op.Class == "" || op.ID == "" {
+				return nil, NewErrInvalidUserInput("operation %d: class and id are required", i)
+			}
+			if op.Op != TransactionDelete && (op.Property == "" || op.Reference == nil) {
+				return nil, NewErrInvalidUserInput("operation %d: property and reference are required", i)
+			}
+			t = transactionTarget{op.Class, op.ID, authorization.TenantFor(principal, op.Tenant)}
+		default:
+			return nil, NewErrInvalidUserInput("operation %d: unknown op %q", i, op.Op)
+		}
+
+		t.class = schema.UppercaseClassName(t.class)
+		targets[i] = t
+	}
+	return targets, nil
+}
+
+// checkTransactionClasses makes sure that the classes of the targets exist.
+// Classes which chunk their objects are not supported, the chunks of an
+// object are not rolled back.
+func (m *Manager) checkTransactionClasses(targets []transactionTarget) error {
+	sch := m.schemaManager.GetSchemaSkipAuth()
+	for i, t := range targets {
+		class := sch.FindClassByName(schema.ClassName(t.class))
+		if class == nil {
+			return NewErrInvalidUserInput("operation %d: class %q not found", i, t.class)
+		}
+		if schema.ChunkingEnabled(class) {
+			return NewErrInvalidUserInput("operation %d: class %q chunks its objects, "+
+				"which is not supported in transactions", i, t.class)
+		}
+	}
+	return nil
+}
+
+// transactionShards returns the sorted shards the targets fall on
+func (m *Manager) transactionShards(targets []transactionTarget) ([]string, error) {
+	seen := map[string]struct{}{}
+	for _, t := range targets {
+		shard, err := m.shardOf(t)
+		if err != nil {
+			return nil, err
+		}
+		seen[t.class+"/"+shard] = struct{}{}
+	}
+
+	shards := make([]string, 0, len(seen))
+	for shard := range seen {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	return shards, nil
+}
+
+func (m *Manager) shardOf(t transactionTarget) (string, error) {
+	if m.shards == nil {
+		// every object is on its own shard, transactions are not atomic
+		return t.id.String(), nil
+	}
+	if t.tenant != "" {
+		shard, _ := m.shards.TenantShard(t.class, t.tenant)
+		if shard == "" {
+			return "", NewErrMultiTenancy(fmt.Errorf("tenant %q of class %q not found", t.tenant, t.class))
+		}
+		return shard, nil
+	}
+
+	id, err := uuid.Parse(t.id.String())
+	if err != nil {
+		return "", NewErrInvalidUserInput("invalid id %q: %v", t.id, err)
+	}
+	idBytes, _ := id.MarshalBinary() // cannot error
+	return m.shards.ShardFromUUID(t.class, idBytes), nil
+}
+
+type transactionSnapshot struct {
+	target transactionTarget
+	// object is nil if it did not exist before the transaction
+	object *models.Object
+}
+
+// transactionSnapshots reads the objects changed by the transaction, in the
+// order they are first changed
+func (m *Manager) transactionSnapshots(ctx context.Context, targets []transactionTarget,
+	repl *additional.ReplicationProperties,
+) ([]transactionSnapshot, error) {
+	seen := map[transactionTarget]struct{}{}
+	var snapshots []transactionSnapshot
+	for _, t := range targets {
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+
+		res, err := m.vectorRepo.Object(ctx, t.class, t.id, search.SelectProperties{},
+			additional.Properties{}, repl, t.tenant)
+		if err != nil {
+			return nil, NewErrInternal("read object %s: %v", t.id, err)
+		}
+		snapshot := transactionSnapshot{target: t}
+		if res != nil {
+			snapshot.object = res.Object()
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func (m *Manager) applyTransactionOperation(ctx context.Context, principal *models.Principal,
+	op TransactionOperation, t transactionTarget, repl *additional.ReplicationProperties,
+) (*models.Object, error) {
+	switch op.Op {
+	case TransactionCreate:
+		return m.AddObject(ctx, principal, op.Object, repl)
+	case TransactionUpdate:
+		return m.UpdateObject(ctx, principal, t.class, t.id, op.Object, repl)
+	case TransactionDelete:
+		return nil, m.DeleteObject(ctx, principal, t.class, t.id, repl, t.tenant)
+	case TransactionAddReference:
+		input := &AddReferenceInput{Class: t.class, ID: t.id, Property: op.Property, Ref: *op.Reference}
+		if err := m.AddObjectReference(ctx, principal, input, repl, t.tenant); err != nil {
+			return nil, err
+		}
+	case TransactionDeleteReference:
+		input := &DeleteReferenceInput{Class: t.class, ID: t.id, Property: op.Property, Reference: *op.Reference}
+		if err := m.DeleteObjectReference(ctx, principal, input, repl, t.tenant); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// rollbackTransaction restores the objects to their snapshots, newest
+// changes first. All objects are restored even if one of them fails.
+func (m *Manager) rollbackTransaction(ctx context.Context, snapshots []transactionSnapshot,
+	repl *additional.ReplicationProperties,
+) error {
+	var errs []string
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		s := snapshots[i]
+		if err := m.restoreSnapshot(ctx, s, repl); err != nil {
+			errs = append(errs, fmt.Sprintf("object %s: %v", s.target.id, err))
+			continue
+		}
+		m.queryCache.Invalidate(ctx, s.target.class)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+func (m *Manager) restoreSnapshot(ctx context.Context, s transactionSnapshot,
+	repl *additional.ReplicationProperties,
+) error {
+	t := s.target
+	now := m.timeSource.Now()
+	if s.object == nil {
+		exists, err := m.vectorRepo.Exists(ctx, t.class, t.id, repl, t.tenant)
+		if err != nil || !exists {
+			return err
+		}
+		if err := m.vectorRepo.DeleteObject(ctx, t.class, t.id, repl, t.tenant); err != nil {
+			return err
+		}
+		m.changes.Record(ctx, cdc.OpDelete, &models.Object{Class: t.class, ID: t.id, Tenant: t.tenant})
+		recordSessionWrite(ctx, t.class, t.tenant, t.id, now, true, repl)
+		return nil
+	}
+
+	// the restored version is a new write, older update times could be
+	// ignored by replicas which applied the transaction
+	s.object.LastUpdateTimeUnix = now
+	if err := m.vectorRepo.PutObject(ctx, s.object, s.object.Vector, repl); err != nil {
+		return err
+	}
+	m.changes.Record(ctx, cdc.OpUpdate, s.object)
+	recordSessionWrite(ctx, t.class, t.tenant, t.id, now, false, repl)
+	return nil
+}
+
+func transactionVerb(op string) string {
+	switch op {
+	case TransactionCreate:
+		return "create"
+	case TransactionDelete:
+		return "delete"
+	default:
+		return "update"
+	}
+}
@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// fakeShardResolver puts every object on the shard of its id, unless all
+// objects are on one shard
+type fakeShardResolver struct {
+	single bool
+}
+
+func (f *fakeShardResolver) TenantShard(class, tenant string) (string, string) {
+	return tenant, models.TenantActivityStatusHOT
+}
+
+func (f *fakeShardResolver) ShardFromUUID(class string, uuid []byte) string {
+	if f.single {
+		return "shard"
+	}
+	return string(uuid)
+}
+
+func newTransactionDependency(single bool) (*Manager, *fakeVectorRepo) {
+	vectorRepo := new(fakeVectorRepo)
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchemaManager{
+		GetSchemaResponse: schema.Schema{Objects: &models.Schema{
+			Classes: []*models.Class{{Class: "MyClass"}},
+		}},
+	}
+	manager := NewManager(new(fakeLocks), schemaManager, new(config.WeaviateConfig), logger,
+		new(fakeAuthorizer), vectorRepo, getFakeModulesProvider(), new(fakeMetrics))
+	manager.SetShardResolver(&fakeShardResolver{single: single})
+	return manager, vectorRepo
+}
+
+func Test_ApplyTransaction(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cls = "MyClass"
+		id1 = strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		id2 = strfmt.UUID("8d0f4f53-7c1b-4a5e-9a0f-3c4e0f7c1a2b")
+		ops = []TransactionOperation{
+			{Op: TransactionDelete, Class: cls, ID: id1},
+			{Op: TransactionDelete, Class: cls, ID: id2},
+		}
+	)
+
+	t.Run("all operations are applied", func(t *testing.T) {
+		manager, repo := newTransactionDependency(true)
+		for _, id := range []strfmt.UUID{id1, id2} {
+			repo.On("Object", cls, id, mock.Anything, mock.Anything, "").
+				Return(&search.Result{ClassName: cls, ID: id}, nil).Once()
+			repo.On("Exists", cls, id).Return(true, nil).Once()
+			repo.On("DeleteObject", cls, id).Return(nil).Once()
+		}
+
+		res, err := manager.ApplyTransaction(ctx, nil, ops, nil)
+		require.Nil(t, err)
+		assert.True(t, res.Atomic)
+		assert.Len(t, res.Objects, 2)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("transactions across shards are not atomic", func(t *testing.T) {
+		manager, repo := newTransactionDependency(false)
+		for _, id := range []strfmt.UUID{id1, id2} {
+			repo.On("Object", cls, id, mock.Anything, mock.Anything, "").
+				Return(&search.Result{ClassName: cls, ID: id}, nil).Once()
+			repo.On("Exists", cls, id).Return(true, nil).Once()
+			repo.On("DeleteObject", cls, id).Return(nil).Once()
+		}
+
+		res, err := manager.ApplyTransaction(ctx, nil, ops, nil)
+		require.Nil(t, err)
+		assert.False(t, res.Atomic)
+	})
+
+	t.Run("applied operations are rolled back", func(t *testing.T) {
+		manager, repo := newTransactionDependency(true)
+		repo.On("Object", cls, id1, mock.Anything, mock.Anything, "").
+			Return(&search.Result{ClassName: cls, ID: id1}, nil).Once()
+		repo.On("Object", cls, id2, mock.Anything, mock.Anything, "").Return(nil, nil).Once()
+		repo.On("Exists", cls, id1).Return(true, nil).Once()
+		repo.On("DeleteObject", cls, id1).Return(nil).Once()
+		// the second object does not exist, neither before nor after
+		repo.On("Exists", cls, id2).Return(false, nil).Twice()
+		repo.On("PutObject", mock.MatchedBy(func(obj *models.Object) bool {
+			return obj.ID == id1 && obj.Class == cls
+		}), mock.Anything).Return(nil).Once()
+
+		_, err := manager.ApplyTransaction(ctx, nil, ops, nil)
+		var aborted ErrTransactionAborted
+		require.True(t, errors.As(err, &aborted))
+		assert.Equal(t, 1, aborted.Index)
+		assert.ErrorAs(t, err, &ErrNotFound{})
+		assert.Nil(t, aborted.RollbackErr)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("failed rollback", func(t *testing.T) {
+		manager, repo := newTransactionDependency(true)
+		repo.On("Object", cls, id1, mock.Anything, mock.Anything, "").
+			Return(&search.Result{ClassName: cls, ID: id1}, nil).Once()
+		repo.On("Object", cls, id2, mock.Anything, mock.Anything, "").Return(nil, nil).Once()
+		repo.On("Exists", cls, id1).Return(true, nil).Once()
+		repo.On("DeleteObject", cls, id1).Return(nil).Once()
+		repo.On("Exists", cls, id2).Return(false, nil).Twice()
+		repo.On("PutObject", mock.Anything, mock.Anything).Return(errors.New("disk full")).Once()
+
+		_, err := manager.ApplyTransaction(ctx, nil, ops, nil)
+		var aborted ErrTransactionAborted
+		require.True(t, errors.As(err, &aborted))
+		assert.ErrorContains(t, aborted.RollbackErr, "disk full")
+		assert.ErrorContains(t, err, "partially applied")
+	})
+
+	t.Run("invalid operations are not applied", func(t *testing.T) {
+		manager, repo := newTransactionDependency(true)
+		tests := map[string][]TransactionOperation{
+			"no operations": nil,
+			"unknown op":    {ops[0], {Op: "upsert", Class: cls, ID: id2}},
+			"unknown class": {ops[0], {Op: TransactionDelete, Class: "Other", ID: id2}},
+			"missing ref":   {ops[0], {Op: TransactionAddReference, Class: cls, ID: id2}},
+			"update w/o id": {ops[0], {Op: TransactionUpdate, Object: &models.Object{Class: cls}}},
+			"too many":      make([]TransactionOperation, MaxTransactionOperations+1),
+		}
+		for name, ops := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := manager.ApplyTransaction(ctx, nil, ops, nil)
+				assert.ErrorAs(t, err, &ErrInvalidUserInput{})
+			})
+		}
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("created objects are assigned an id", func(t *testing.T) {
+		manager, _ := newTransactionDependency(true)
+		obj := &models.Object{Class: cls}
+		targets, err := manager.transactionTargets(nil, []TransactionOperation{
+			{Op: TransactionCreate, Object: obj},
+		})
+		require.Nil(t, err)
+		assert.NotEmpty(t, obj.ID)
+		assert.Equal(t, obj.ID, targets[0].id)
+	})
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/cdc"
 )
 
 // UpdateObject updates object of class.
@@ -26,16 +28,28 @@ import (
 func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 	class string, id strfmt.UUID, updates *models.Object,
 	repl *additional.ReplicationProperties,
-) (*models.Object, error) {
-	path := fmt.Sprintf("objects/%s/%s", class, id)
-	if class == "" {
-		path = fmt.Sprintf("objects/%s", id)
+) (_ *models.Object, err error) {
+	defaultTenant(principal, updates)
+	if err = assignPartition(m.schemaManager.GetSchemaSkipAuth(), updates); err != nil {
+		return nil, NewErrInvalidUserInput("invalid object: %v", err)
 	}
-	err := m.authorizer.Authorize(principal, "update", path)
+	tenant := ""
+	if updates != nil {
+		tenant = updates.Tenant
+	}
+	defer func() {
+		m.auditLog.Record(ctx, principal, "update", err, authorization.Objects(class, tenant, id))
+	}()
+
+	err = m.authorizer.Authorize(principal, "update", authorization.Objects(class, tenant, id))
 	if err != nil {
 		return nil, err
 	}
 
+	if err = activateTenant(ctx, m.offload, class, tenant); err != nil {
+		return nil, err
+	}
+
 	m.metrics.UpdateObjectInc()
 	defer m.metrics.UpdateObjectDec()
 
@@ -45,7 +59,15 @@ func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.updateObjectToConnectorAndSchema(ctx, principal, class, id, updates, repl)
+	updated, err := m.updateObjectToConnectorAndSchema(ctx, principal, class, id, updates, repl)
+	if err != nil {
+		return nil, err
+	}
+	m.changes.Record(ctx, cdc.OpUpdate, updated)
+	recordSessionWrite(ctx, updated.Class, updated.Tenant, updated.ID,
+		updated.LastUpdateTimeUnix, false, repl)
+	m.queryCache.Invalidate(ctx, updated.Class)
+	return m.masker.MaskedCopy(principal, m.schemaManager, updated), nil
 }
 
 func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
@@ -90,6 +112,9 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if err := m.modulesProvider.EnrichObject(ctx, updates, class); err != nil {
+		return nil, NewErrInternal("update object: %v", err)
+	}
 	err = m.modulesProvider.UpdateVector(ctx, updates, class, nil, m.findObject, m.logger)
 	if err != nil {
 		return nil, NewErrInternal("update object: %v", err)
@@ -100,5 +125,9 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 		return nil, fmt.Errorf("put object: %w", err)
 	}
 
+	if err := m.chunks().write(ctx, principal, class, updates, repl); err != nil {
+		return nil, err
+	}
+
 	return updates, nil
 }
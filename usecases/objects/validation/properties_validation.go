@@ -67,9 +67,13 @@ func (v *Validator) properties(ctx context.Context, class *models.Class,
 	}
 	returnSchema := map[string]interface{}{}
 
+	// explicit nulls are only kept if they can be told apart from missing
+	// properties, otherwise they are removed and filtered out
+	keepNulls := class.InvertedIndexConfig != nil && class.InvertedIndexConfig.IndexNullState
+
 	for propertyKey, propertyValue := range inputSchema {
-		if propertyValue == nil {
-			continue // nil values are removed and filtered out
+		if propertyValue == nil && !keepNulls {
+			continue
 		}
 
 		// properties in the class are saved with lower case first letter
@@ -86,6 +90,11 @@ func (v *Validator) properties(ctx context.Context, class *models.Class,
 			return err
 		}
 
+		if propertyValue == nil {
+			returnSchema[propertyKeyLowerCase] = nil
+			continue
+		}
+
 		// autodetect to_class in references
 		if dataType.String() == schema.DataTypeCRef.String() {
 			propertyValueSlice, ok := propertyValue.([]interface{})
@@ -188,6 +197,11 @@ func objectVal(ctx context.Context, v *Validator, val interface{}, propertyPrefi
 			return nil, fmt.Errorf("unknown property '%s'", propertyName)
 		}
 
+		if nestedValue == nil {
+			// nested properties are not indexed, explicit nulls are kept as is
+			continue
+		}
+
 		nestedDataType, err := schema.GetValueDataTypeFromString(nestedProperty.DataType[0])
 		if err != nil {
 			return nil, fmt.Errorf("property '%s': %w", propertyName, err)
@@ -145,3 +145,61 @@ func TestValidator_extractAndValidateProperty(t *testing.T) {
 func getDataType(dataType schema.DataType) *schema.DataType {
 	return &dataType
 }
+
+func TestValidator_properties_nullValues(t *testing.T) {
+	class := func(indexNullState bool) *models.Class {
+		return &models.Class{
+			Class:               "NullClass",
+			InvertedIndexConfig: &models.InvertedIndexConfig{IndexNullState: indexNullState},
+			Properties: []*models.Property{
+				{Name: "text", DataType: schema.DataTypeText.PropString()},
+				{Name: "numbers", DataType: schema.DataTypeNumberArray.PropString()},
+				{
+					Name:     "nested",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "int", DataType: schema.DataTypeInt.PropString()},
+					},
+				},
+			},
+		}
+	}
+	input := func() *models.Object {
+		return &models.Object{
+			Class: "NullClass",
+			Properties: map[string]interface{}{
+				"text":    nil,
+				"numbers": nil,
+				"nested":  map[string]interface{}{"int": nil},
+			},
+		}
+	}
+
+	t.Run("without null state index", func(t *testing.T) {
+		obj := input()
+		v := &Validator{config: &config.WeaviateConfig{}}
+		if err := v.properties(context.Background(), class(false), obj, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := map[string]interface{}{"nested": map[string]interface{}{"int": nil}}
+		if !reflect.DeepEqual(obj.Properties, want) {
+			t.Errorf("got %v, want %v", obj.Properties, want)
+		}
+	})
+
+	t.Run("with null state index", func(t *testing.T) {
+		obj := input()
+		v := &Validator{config: &config.WeaviateConfig{}}
+		if err := v.properties(context.Background(), class(true), obj, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := map[string]interface{}{
+			"text":    nil,
+			"numbers": nil,
+			"nested":  map[string]interface{}{"int": nil},
+		}
+		if !reflect.DeepEqual(obj.Properties, want) {
+			t.Errorf("got %v, want %v", obj.Properties, want)
+		}
+	})
+}
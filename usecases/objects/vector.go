@@ -22,11 +22,12 @@ import (
 )
 
 func (m *Manager) updateRefVector(ctx context.Context, principal *models.Principal,
-	className string, id strfmt.UUID, tenant string,
+	className string, id strfmt.UUID, repl *additional.ReplicationProperties,
+	tenant string,
 ) error {
 	if m.modulesProvider.UsingRef2Vec(className) {
 		parent, err := m.vectorRepo.Object(ctx, className, id,
-			search.SelectProperties{}, additional.Properties{}, nil, tenant)
+			search.SelectProperties{}, additional.Properties{}, repl, tenant)
 		if err != nil {
 			return fmt.Errorf("find parent '%s/%s': %w",
 				className, id, err)
@@ -44,9 +45,10 @@ func (m *Manager) updateRefVector(ctx context.Context, principal *models.Princip
 				className, id, err)
 		}
 
-		if err := m.vectorRepo.PutObject(ctx, obj, obj.Vector, nil); err != nil {
+		if err := m.vectorRepo.PutObject(ctx, obj, obj.Vector, repl); err != nil {
 			return fmt.Errorf("put object: %w", err)
 		}
+		m.queryCache.Invalidate(ctx, className)
 
 		return nil
 	}
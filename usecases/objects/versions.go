@@ -0,0 +1,237 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// VersionDiff lists the properties which differ between two versions of an
+// object. A deleted version has no properties.
+type VersionDiff struct {
+	ID            strfmt.UUID      `json:"id"`
+	From          int64            `json:"from"`
+	To            int64            `json:"to"`
+	Changes       []PropertyChange `json:"changes"`
+	VectorChanged bool             `json:"vectorChanged"`
+}
+
+// PropertyChange of a single property, a missing value means the property
+// was not set in the version
+type PropertyChange struct {
+	Property string      `json:"property"`
+	From     interface{} `json:"from,omitempty"`
+	To       interface{} `json:"to,omitempty"`
+}
+
+// GetObjectVersions returns the kept versions of an object, newest first.
+// The class may be empty as long as the object exists, it is then looked up
+// by the id of the object.
+func (m *Manager) GetObjectVersions(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, tenant string,
+) ([]*storobj.ObjectVersion, error) {
+	tenant = authorization.TenantFor(principal, tenant)
+	err := m.authorizer.Authorize(principal, "get", authorization.Objects(class, tenant, id))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := activateTenant(ctx, m.offload, class, tenant); err != nil {
+		return nil, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	return m.getObjectVersions(ctx, principal, class, id, tenant)
+}
+
+func (m *Manager) getObjectVersions(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID, tenant string,
+) ([]*storobj.ObjectVersion, error) {
+	if className == "" {
+		res, err := m.vectorRepo.ObjectByID(ctx, id, search.SelectProperties{},
+			additional.Properties{}, tenant)
+		if err != nil {
+			return nil, NewErrInternal("repo: object by id: %v", err)
+		}
+		if res == nil {
+			return nil, NewErrNotFound("no object with id '%s'", id)
+		}
+		className = res.ClassName
+	}
+
+	sch := m.schemaManager.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(className))
+	if class == nil {
+		return nil, NewErrNotFound("class %q not found", className)
+	}
+	if !schema.VersioningEnabled(class) {
+		return nil, NewErrInvalidUserInput("versioning is not enabled for class %q", class.Class)
+	}
+
+	versions, err := m.vectorRepo.ObjectVersions(ctx, class.Class, id, tenant)
+	if err != nil {
+		switch err.(type) {
+		case ErrMultiTenancy, ErrNotFound:
+			return nil, err
+		default:
+			return nil, NewErrInternal("repo: object versions: %v", err)
+		}
+	}
+	if len(versions) == 0 {
+		return nil, NewErrNotFound("no versions of object with id '%s'", id)
+	}
+
+	for _, v := range versions {
+		if v.Object != nil {
+			v.Object.Tenant = tenant
+			m.masker.MaskObjects(principal, m.schemaManager, v.Object)
+		}
+	}
+	return versions, nil
+}
+
+// DiffObjectVersions compares two versions of an object. If to is 0, the
+// version is compared to the latest one.
+func (m *Manager) DiffObjectVersions(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, from, to int64, tenant string,
+) (*VersionDiff, error) {
+	versions, err := m.GetObjectVersions(ctx, principal, class, id, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if to == 0 {
+		to = versions[0].Version
+	}
+
+	fromVersion, err := findVersion(versions, id, from)
+	if err != nil {
+		return nil, err
+	}
+	toVersion, err := findVersion(versions, id, to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &VersionDiff{ID: id, From: from, To: to, Changes: []PropertyChange{}}
+	fromProps, toProps := versionProperties(fromVersion), versionProperties(toVersion)
+	names := make([]string, 0, len(fromProps)+len(toProps))
+	for name := range fromProps {
+		names = append(names, name)
+	}
+	for name := range toProps {
+		if _, ok := fromProps[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !equalValues(fromProps[name], toProps[name]) {
+			diff.Changes = append(diff.Changes, PropertyChange{
+				Property: name,
+				From:     fromProps[name],
+				To:       toProps[name],
+			})
+		}
+	}
+	diff.VectorChanged = !reflect.DeepEqual(versionVector(fromVersion), versionVector(toVersion))
+	return diff, nil
+}
+
+// RollbackObject replaces an object with one of its previous versions. The
+// rollback is an update of the object like any other, so it becomes the
+// latest version. A deleted object is created again.
+func (m *Manager) RollbackObject(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, version int64, tenant string,
+	repl *additional.ReplicationProperties,
+) (*models.Object, error) {
+	versions, err := m.GetObjectVersions(ctx, principal, class, id, tenant)
+	if err != nil {
+		return nil, err
+	}
+	target, err := findVersion(versions, id, version)
+	if err != nil {
+		return nil, err
+	}
+	if target.Deleted {
+		return nil, NewErrInvalidUserInput("version %d of object %s is a deletion, "+
+			"delete the object instead", version, id)
+	}
+
+	obj := &models.Object{
+		Class:      target.Object.Class,
+		ID:         id,
+		Tenant:     tenant,
+		Properties: target.Object.Properties,
+		Vector:     target.Object.Vector,
+	}
+	if versions[0].Deleted {
+		return m.AddObject(ctx, principal, obj, repl)
+	}
+	return m.UpdateObject(ctx, principal, obj.Class, id, obj, repl)
+}
+
+func findVersion(versions []*storobj.ObjectVersion, id strfmt.UUID,
+	version int64,
+) (*storobj.ObjectVersion, error) {
+	for _, v := range versions {
+		if v.Version == version {
+			return v, nil
+		}
+	}
+	return nil, NewErrNotFound("no version %d of object with id '%s'", version, id)
+}
+
+func versionProperties(v *storobj.ObjectVersion) map[string]interface{} {
+	if v.Deleted || v.Object == nil {
+		return nil
+	}
+	props, _ := v.Object.Properties.(map[string]interface{})
+	return props
+}
+
+func versionVector(v *storobj.ObjectVersion) []float32 {
+	if v.Deleted || v.Object == nil {
+		return nil
+	}
+	return v.Object.Vector
+}
+
+// equalValues compares property values by their JSON representation, since
+// values read from storage and values in requests differ in their types
+func equalValues(a, b interface{}) bool {
+	aj, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bj, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return string(aj) == string(bj)
+}
@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_ObjectVersions(t *testing.T) {
+	var (
+		ctx = context.Background()
+		id  = strfmt.UUID("2b9c4a35-7a55-4b27-8e70-5b0e1b3e4d0c")
+		sch = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class:             "Contract",
+						VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+						VersioningConfig:  &models.VersioningConfig{Enabled: true, MaxVersions: 5},
+						Properties: []*models.Property{
+							{Name: "title", DataType: schema.DataTypeText.PropString()},
+							{Name: "amount", DataType: schema.DataTypeNumber.PropString()},
+						},
+					},
+					{
+						Class:             "Note",
+						VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+					},
+				},
+			},
+		}
+	)
+
+	version := func(v int64, props map[string]interface{}, vector []float32) *storobj.ObjectVersion {
+		return &storobj.ObjectVersion{
+			Version: v,
+			Object:  &models.Object{Class: "Contract", ID: id, Properties: props, Vector: vector},
+		}
+	}
+	newManager := func(versions ...*storobj.ObjectVersion) (*Manager, *fakeVectorRepo, *fakeModulesProvider) {
+		repo := &fakeVectorRepo{}
+		repo.On("ObjectVersions", "Contract", id, "").Return(versions, nil)
+		logger, _ := test.NewNullLogger()
+		modules := getFakeModulesProvider()
+		m := NewManager(&fakeLocks{}, &fakeSchemaManager{GetSchemaResponse: sch},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, repo, modules, &fakeMetrics{})
+		return m, repo, modules
+	}
+
+	t.Run("class without versioning", func(t *testing.T) {
+		m, _, _ := newManager()
+		_, err := m.GetObjectVersions(ctx, nil, "Note", id, "")
+		assert.ErrorAs(t, err, &ErrInvalidUserInput{})
+	})
+
+	t.Run("class is looked up by the object", func(t *testing.T) {
+		m, repo, _ := newManager(version(1, nil, nil))
+		repo.On("ObjectByID", id, mock.Anything, mock.Anything).
+			Return(&search.Result{ID: id, ClassName: "Contract"}, nil)
+
+		versions, err := m.GetObjectVersions(ctx, nil, "", id, "")
+		require.Nil(t, err)
+		assert.Len(t, versions, 1)
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		m, _, _ := newManager(
+			version(3, map[string]interface{}{"title": "B", "amount": 20.0}, []float32{1}),
+			version(2, map[string]interface{}{"title": "A", "amount": 20.0}, []float32{1}),
+			version(1, map[string]interface{}{"title": "A"}, []float32{0}),
+		)
+
+		diff, err := m.DiffObjectVersions(ctx, nil, "Contract", id, 1, 0, "")
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), diff.To)
+		assert.Equal(t, []PropertyChange{
+			{Property: "amount", To: 20.0},
+			{Property: "title", From: "A", To: "B"},
+		}, diff.Changes)
+		assert.True(t, diff.VectorChanged)
+
+		diff, err = m.DiffObjectVersions(ctx, nil, "Contract", id, 1, 2, "")
+		require.Nil(t, err)
+		assert.Equal(t, []PropertyChange{{Property: "amount", To: 20.0}}, diff.Changes)
+
+		_, err = m.DiffObjectVersions(ctx, nil, "Contract", id, 7, 0, "")
+		assert.ErrorAs(t, err, &ErrNotFound{})
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		m, repo, modules := newManager(
+			version(2, map[string]interface{}{"title": "B"}, []float32{2}),
+			version(1, map[string]interface{}{"title": "A"}, []float32{1}),
+		)
+		repo.On("Object", "Contract", id, mock.Anything, mock.Anything, "").
+			Return(&search.Result{ID: id, ClassName: "Contract", Created: 1}, nil)
+		modules.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		repo.On("PutObject", mock.Anything, []float32{1}).Return(nil).Once()
+
+		obj, err := m.RollbackObject(ctx, nil, "Contract", id, 1, "", nil)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"title": "A"}, obj.Properties)
+		assert.Equal(t, int64(1), obj.CreationTimeUnix)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rollback to a deletion", func(t *testing.T) {
+		m, _, _ := newManager(
+			&storobj.ObjectVersion{Version: 2, Deleted: true},
+			version(1, map[string]interface{}{"title": "A"}, nil),
+		)
+		_, err := m.RollbackObject(ctx, nil, "Contract", id, 2, "", nil)
+		assert.ErrorAs(t, err, &ErrInvalidUserInput{})
+	})
+}
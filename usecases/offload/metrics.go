@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package offload
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Metrics of offloaded and activated tenants
+type Metrics struct {
+	offloads            *prometheus.CounterVec
+	activations         *prometheus.CounterVec
+	activationDurations *prometheus.HistogramVec
+	classLabel          func(string) string
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		offloads:            prom.TenantOffloads,
+		activations:         prom.TenantActivations,
+		activationDurations: prom.TenantActivationDurations,
+		classLabel:          prom.ClassLabel,
+	}
+}
+
+func (m *Metrics) Offloaded(class string, tenants int, err error) {
+	if m == nil {
+		return
+	}
+
+	class = m.classLabel(class)
+	m.offloads.With(prometheus.Labels{
+		"class_name": class,
+		"status":     status(err),
+	}).Add(float64(tenants))
+}
+
+func (m *Metrics) Activated(class string, took time.Duration, err error) {
+	if m == nil {
+		return
+	}
+
+	class = m.classLabel(class)
+	m.activations.With(prometheus.Labels{
+		"class_name": class,
+		"status":     status(err),
+	}).Inc()
+	m.activationDurations.With(prometheus.Labels{
+		"class_name": class,
+	}).Observe(took.Seconds())
+}
+
+func status(err error) string {
+	if err != nil {
+		return "failed"
+	}
+	return "success"
+}
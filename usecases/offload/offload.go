@@ -0,0 +1,256 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package offload moves tenants which have not been accessed for a while to
+// cloud storage by setting them FROZEN, and activates them again on their
+// first access. The files of a frozen tenant are uploaded and downloaded by
+// the DB when the status of the tenant changes.
+package offload
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// ErrActivating indicates that a frozen tenant is being activated and the
+// activation took longer than the configured timeout. It is wrapped in an
+// objects.ErrMultiTenancy, like the error of any other inactive tenant.
+type ErrActivating struct {
+	Class  string
+	Tenant string
+}
+
+func (e ErrActivating) Error() string {
+	return fmt.Sprintf("tenant %q of class %q is being activated, retry later", e.Tenant, e.Class)
+}
+
+type schemaManager interface {
+	GetSchemaSkipAuth() schema.Schema
+	NodeName() string
+	CopyShardingState(class string) *sharding.State
+	TenantShard(class, tenant string) (string, string)
+	SetTenantsStatus(ctx context.Context, class, status string, tenants ...string) error
+}
+
+type accessTracker interface {
+	// TenantLastAccess returns when the tenant was last accessed on this node
+	TenantLastAccess(class, tenant string) (time.Time, bool)
+}
+
+type key struct {
+	class  string
+	tenant string
+}
+
+type activation struct {
+	done chan struct{}
+	err  error
+}
+
+// Manager offloads idle tenants and activates them on access. A nil Manager
+// is valid and does nothing.
+type Manager struct {
+	config  config.TenantOffload
+	schema  schemaManager
+	access  accessTracker
+	metrics *Metrics
+	logger  logrus.FieldLogger
+	now     func() time.Time
+	started time.Time
+
+	sync.Mutex
+	activations map[key]*activation
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewManager(cfg config.TenantOffload, schema schemaManager, access accessTracker,
+	metrics *Metrics, logger logrus.FieldLogger,
+) *Manager {
+	return &Manager{
+		config:      cfg,
+		schema:      schema,
+		access:      access,
+		metrics:     metrics,
+		logger:      logger.WithField("action", "tenant_offload"),
+		now:         time.Now,
+		started:     time.Now(),
+		activations: map[key]*activation{},
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// Activate makes sure the tenant can be used. If it is frozen it is
+// activated, waiting at most for the configured activation timeout.
+func (m *Manager) Activate(ctx context.Context, class, tenant string) error {
+	if m == nil || tenant == "" {
+		return nil
+	}
+
+	class = schema.UppercaseClassName(class)
+	if _, status := m.schema.TenantShard(class, tenant); status != models.TenantActivityStatusFROZEN {
+		return nil
+	}
+
+	a := m.activate(class, tenant)
+	timer := time.NewTimer(m.config.ActivationTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-a.done:
+		return a.err
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return objects.NewErrMultiTenancy(ErrActivating{Class: class, Tenant: tenant})
+	}
+}
+
+// activate starts the activation of a tenant unless it is already running.
+// It is not bound to the request, so it completes even if the request does
+// not wait for it.
+func (m *Manager) activate(class, tenant string) *activation {
+	k := key{class, tenant}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if a, ok := m.activations[k]; ok {
+		return a
+	}
+	a := &activation{done: make(chan struct{})}
+	m.activations[k] = a
+
+	go func() {
+		before := m.now()
+		a.err = m.schema.SetTenantsStatus(context.Background(), class,
+			models.TenantActivityStatusHOT, tenant)
+		m.metrics.Activated(class, m.now().Sub(before), a.err)
+		if a.err != nil {
+			m.logger.WithField("class", class).WithField("tenant", tenant).
+				WithError(a.err).Error("could not activate tenant")
+		}
+
+		m.Lock()
+		delete(m.activations, k)
+		m.Unlock()
+		close(a.done)
+	}()
+	return a
+}
+
+// Start looking for idle tenants in the configured interval
+func (m *Manager) Start() {
+	if m == nil {
+		return
+	}
+
+	go func() {
+		defer close(m.done)
+
+		ticker := time.NewTicker(m.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.offloadIdle(context.Background())
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Shutdown stops looking for idle tenants, a running offload is completed
+// first
+func (m *Manager) Shutdown(ctx context.Context) error {
+	if m == nil {
+		return nil
+	}
+
+	close(m.stop)
+	select {
+	case <-m.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// offloadIdle freezes the idle tenants of all classes. Only tenants which
+// are not replicated are offloaded, since every replica only sees the
+// accesses served by itself.
+func (m *Manager) offloadIdle(ctx context.Context) {
+	for _, class := range m.schema.GetSchemaSkipAuth().Objects.Classes {
+		if !schema.MultiTenancyEnabled(class) {
+			continue
+		}
+
+		idle := m.idleTenants(class.Class)
+		if len(idle) == 0 {
+			continue
+		}
+
+		err := m.schema.SetTenantsStatus(ctx, class.Class,
+			models.TenantActivityStatusFROZEN, idle...)
+		m.metrics.Offloaded(class.Class, len(idle), err)
+		if err != nil {
+			m.logger.WithField("class", class.Class).WithError(err).
+				Error("could not offload idle tenants")
+			continue
+		}
+		m.logger.WithField("class", class.Class).WithField("tenants", len(idle)).
+			Info("offloaded idle tenants")
+	}
+}
+
+// idleTenants of the class which are hot and owned by this node only.
+// Tenants which were not accessed since the node started are considered
+// accessed at startup.
+func (m *Manager) idleTenants(class string) []string {
+	st := m.schema.CopyShardingState(class)
+	if st == nil {
+		return nil
+	}
+
+	now, node := m.now(), m.schema.NodeName()
+	var idle []string
+	for name, physical := range st.Physical {
+		if physical.ActivityStatus() != models.TenantActivityStatusHOT {
+			continue
+		}
+		if len(physical.BelongsToNodes) != 1 || physical.BelongsToNodes[0] != node {
+			continue
+		}
+
+		last, ok := m.access.TenantLastAccess(class, name)
+		if !ok {
+			last = m.started
+		}
+		if now.Sub(last) >= m.config.IdleAfter {
+			idle = append(idle, name)
+		}
+	}
+	sort.Strings(idle)
+	return idle
+}
@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package offload
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type statusUpdate struct {
+	class   string
+	status  string
+	tenants []string
+}
+
+type fakeSchema struct {
+	sync.Mutex
+	states  map[string]*sharding.State
+	updates []statusUpdate
+	block   chan struct{}
+	err     error
+}
+
+func (f *fakeSchema) GetSchemaSkipAuth() schema.Schema {
+	classes := []*models.Class{{Class: "Plain"}}
+	for class := range f.states {
+		classes = append(classes, &models.Class{
+			Class:              class,
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		})
+	}
+	return schema.Schema{Objects: &models.Schema{Classes: classes}}
+}
+
+func (f *fakeSchema) NodeName() string { return "node1" }
+
+func (f *fakeSchema) CopyShardingState(class string) *sharding.State {
+	return f.states[class]
+}
+
+func (f *fakeSchema) TenantShard(class, tenant string) (string, string) {
+	f.Lock()
+	defer f.Unlock()
+	physical, ok := f.states[class].Physical[tenant]
+	if !ok {
+		return "", ""
+	}
+	return tenant, physical.ActivityStatus()
+}
+
+func (f *fakeSchema) SetTenantsStatus(ctx context.Context, class, status string, tenants ...string) error {
+	if f.block != nil {
+		<-f.block
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	f.updates = append(f.updates, statusUpdate{class, status, tenants})
+	if f.err != nil {
+		return f.err
+	}
+	for _, tenant := range tenants {
+		physical := f.states[class].Physical[tenant]
+		physical.Status = status
+		f.states[class].Physical[tenant] = physical
+	}
+	return nil
+}
+
+type fakeAccess map[string]time.Time
+
+func (f fakeAccess) TenantLastAccess(class, tenant string) (time.Time, bool) {
+	last, ok := f[class+"/"+tenant]
+	return last, ok
+}
+
+func physical(status string, nodes ...string) sharding.Physical {
+	return sharding.Physical{Status: status, BelongsToNodes: nodes}
+}
+
+func newTestManager(sm *fakeSchema, access fakeAccess) (*Manager, *time.Time) {
+	logger, _ := test.NewNullLogger()
+	now := time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC)
+	m := NewManager(config.TenantOffload{
+		Enabled:           true,
+		Backend:           "s3",
+		IdleAfter:         7 * 24 * time.Hour,
+		Interval:          time.Hour,
+		ActivationTimeout: 50 * time.Millisecond,
+	}, sm, access, nil, logger)
+	m.now = func() time.Time { return now }
+	m.started = now.Add(-24 * time.Hour)
+	return m, &now
+}
+
+func TestOffloadIdle(t *testing.T) {
+	sm := &fakeSchema{states: map[string]*sharding.State{
+		"Article": {Physical: map[string]sharding.Physical{
+			"idle":       physical("", "node1"),
+			"idle2":      physical(models.TenantActivityStatusHOT, "node1"),
+			"active":     physical(models.TenantActivityStatusHOT, "node1"),
+			"untracked":  physical(models.TenantActivityStatusHOT, "node1"),
+			"cold":       physical(models.TenantActivityStatusCOLD, "node1"),
+			"replicated": physical(models.TenantActivityStatusHOT, "node1", "node2"),
+			"remote":     physical(models.TenantActivityStatusHOT, "node2"),
+		}},
+	}}
+	longAgo := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+	m, now := newTestManager(sm, fakeAccess{
+		"Article/idle":       longAgo,
+		"Article/idle2":      longAgo,
+		"Article/active":     time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC),
+		"Article/cold":       longAgo,
+		"Article/replicated": longAgo,
+		"Article/remote":     longAgo,
+	})
+
+	m.offloadIdle(context.Background())
+	require.Len(t, sm.updates, 1)
+	assert.Equal(t, statusUpdate{
+		class:   "Article",
+		status:  models.TenantActivityStatusFROZEN,
+		tenants: []string{"idle", "idle2"},
+	}, sm.updates[0])
+
+	// untracked tenants count as accessed at startup
+	*now = now.Add(7 * 24 * time.Hour)
+	m.offloadIdle(context.Background())
+	require.Len(t, sm.updates, 2)
+	assert.Equal(t, []string{"active", "untracked"}, sm.updates[1].tenants)
+}
+
+func TestActivate(t *testing.T) {
+	newSchema := func() *fakeSchema {
+		return &fakeSchema{states: map[string]*sharding.State{
+			"Article": {Physical: map[string]sharding.Physical{
+				"frozen": physical(models.TenantActivityStatusFROZEN, "node1"),
+				"hot":    physical(models.TenantActivityStatusHOT, "node1"),
+			}},
+		}}
+	}
+	ctx := context.Background()
+
+	t.Run("nil manager", func(t *testing.T) {
+		var m *Manager
+		assert.Nil(t, m.Activate(ctx, "Article", "frozen"))
+	})
+
+	t.Run("hot tenant", func(t *testing.T) {
+		sm := newSchema()
+		m, _ := newTestManager(sm, fakeAccess{})
+		require.Nil(t, m.Activate(ctx, "Article", "hot"))
+		assert.Empty(t, sm.updates)
+	})
+
+	t.Run("frozen tenant", func(t *testing.T) {
+		sm := newSchema()
+		m, _ := newTestManager(sm, fakeAccess{})
+		require.Nil(t, m.Activate(ctx, "article", "frozen"))
+		assert.Equal(t, []statusUpdate{{
+			class:   "Article",
+			status:  models.TenantActivityStatusHOT,
+			tenants: []string{"frozen"},
+		}}, sm.updates)
+	})
+
+	t.Run("failed activation", func(t *testing.T) {
+		sm := newSchema()
+		sm.err = errors.New("download failed")
+		m, _ := newTestManager(sm, fakeAccess{})
+		assert.ErrorContains(t, m.Activate(ctx, "Article", "frozen"), "download failed")
+	})
+
+	t.Run("activation exceeds timeout", func(t *testing.T) {
+		sm := newSchema()
+		sm.block = make(chan struct{})
+		m, _ := newTestManager(sm, fakeAccess{})
+
+		err := m.Activate(ctx, "Article", "frozen")
+		var activating ErrActivating
+		require.True(t, errors.As(err, &activating))
+		assert.Equal(t, ErrActivating{Class: "Article", Tenant: "frozen"}, activating)
+		assert.True(t, errors.As(err, &objects.ErrMultiTenancy{}))
+		m.Lock()
+		assert.Len(t, m.activations, 1)
+		m.Unlock()
+
+		// the activation continues in the background and is shared by
+		// subsequent requests
+		close(sm.block)
+		require.Nil(t, m.Activate(ctx, "Article", "frozen"))
+		assert.Len(t, sm.updates, 1)
+	})
+}
@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package otlp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	DefaultServiceName = "weaviate"
+	DefaultSampleRatio = 1.0
+)
+
+// Config of request tracing. The spans of sampled requests are exported to
+// an OpenTelemetry collector with OTLP over HTTP. Endpoint is the base URL
+// of the collector, e.g. http://collector:4318, the spans are sent to its
+// /v1/traces path. Requests which continue the trace of a caller are
+// sampled if the caller sampled them, other requests with SampleRatio.
+type Config struct {
+	Enabled     bool              `json:"enabled" yaml:"enabled"`
+	Endpoint    string            `json:"endpoint" yaml:"endpoint"`
+	ServiceName string            `json:"service_name" yaml:"service_name"`
+	SampleRatio float64           `json:"sample_ratio" yaml:"sample_ratio"`
+	Headers     map[string]string `json:"-" yaml:"-"`
+}
+
+// Validate the tracing config, can be called from the central config
+// package
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	u, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return fmt.Errorf("tracing: parse endpoint: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("tracing: endpoint must be an http or https url, got %q", c.Endpoint)
+	}
+	if c.ServiceName == "" {
+		return fmt.Errorf("tracing: service name must not be empty")
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("tracing: sample ratio must be in [0, 1], got %v", c.SampleRatio)
+	}
+	return nil
+}
@@ -0,0 +1,313 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/tracing"
+)
+
+const (
+	tracesPath    = "/v1/traces"
+	queueSize     = 8192
+	maxBatchSize  = 512
+	flushInterval = 5 * time.Second
+	exportTimeout = 10 * time.Second
+)
+
+// Exporter sends finished spans in batches to the collector, encoded as
+// OTLP JSON. Spans are dropped if the collector can not keep up, so that
+// tracing never slows down requests.
+type Exporter struct {
+	url      string
+	headers  map[string]string
+	resource resource
+	client   *http.Client
+	logger   logrus.FieldLogger
+
+	spans   chan tracing.SpanData
+	done    chan struct{}
+	stopped sync.WaitGroup
+	close   sync.Once
+
+	sync.Mutex
+	dropped int
+}
+
+func New(config Config, nodeName string, logger logrus.FieldLogger) *Exporter {
+	url := strings.TrimSuffix(config.Endpoint, "/")
+	if !strings.HasSuffix(url, tracesPath) {
+		url += tracesPath
+	}
+
+	e := &Exporter{
+		url:     url,
+		headers: config.Headers,
+		resource: resource{Attributes: attributes(map[string]interface{}{
+			"service.name":        config.ServiceName,
+			"service.instance.id": nodeName,
+		})},
+		client: &http.Client{Timeout: exportTimeout},
+		logger: logger,
+		spans:  make(chan tracing.SpanData, queueSize),
+		done:   make(chan struct{}),
+	}
+	e.stopped.Add(1)
+	go e.run()
+	return e
+}
+
+// ExportSpan queues a span for the next batch, it never blocks
+func (e *Exporter) ExportSpan(span tracing.SpanData) {
+	select {
+	case e.spans <- span:
+	default:
+		e.Lock()
+		e.dropped++
+		e.Unlock()
+	}
+}
+
+// Close sends the queued spans
+func (e *Exporter) Close() error {
+	if e == nil {
+		return nil
+	}
+	e.close.Do(func() { close(e.done) })
+	e.stopped.Wait()
+	return nil
+}
+
+func (e *Exporter) run() {
+	defer e.stopped.Done()
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]tracing.SpanData, 0, maxBatchSize)
+	flush := func() {
+		if len(batch) > 0 {
+			e.send(batch)
+			batch = batch[:0]
+		}
+	}
+
+	for {
+		select {
+		case span := <-e.spans:
+			batch = append(batch, span)
+			if len(batch) == maxBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+			e.logDropped()
+		case <-e.done:
+			for {
+				select {
+				case span := <-e.spans:
+					batch = append(batch, span)
+					if len(batch) == maxBatchSize {
+						flush()
+					}
+				default:
+					flush()
+					e.logDropped()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (e *Exporter) logDropped() {
+	e.Lock()
+	dropped := e.dropped
+	e.dropped = 0
+	e.Unlock()
+
+	if dropped > 0 {
+		e.logger.WithField("action", "tracing_export").WithField("dropped", dropped).
+			Warn("dropped spans, the collector does not keep up")
+	}
+}
+
+func (e *Exporter) send(batch []tracing.SpanData) {
+	if err := e.post(batch); err != nil {
+		e.logger.WithField("action", "tracing_export").WithField("spans", len(batch)).
+			WithError(err).Warn("could not export spans")
+	}
+}
+
+func (e *Exporter) post(batch []tracing.SpanData) error {
+	body, err := json.Marshal(encode(e.resource, batch))
+	if err != nil {
+		return fmt.Errorf("marshal spans: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range e.headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("collector responded with status %d", res.StatusCode)
+	}
+	return nil
+}
+
+// the OTLP JSON encoding of ExportTraceServiceRequest, ids are hex encoded
+// and 64 bit integers are strings
+
+type exportRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+}
+
+type resource struct {
+	Attributes []attribute `json:"attributes"`
+}
+
+type scopeSpans struct {
+	Scope scope  `json:"scope"`
+	Spans []span `json:"spans"`
+}
+
+type scope struct {
+	Name string `json:"name"`
+}
+
+type span struct {
+	TraceID           string      `json:"traceId"`
+	SpanID            string      `json:"spanId"`
+	ParentSpanID      string      `json:"parentSpanId,omitempty"`
+	Name              string      `json:"name"`
+	Kind              int         `json:"kind"`
+	StartTimeUnixNano string      `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string      `json:"endTimeUnixNano"`
+	Attributes        []attribute `json:"attributes,omitempty"`
+	Status            *status     `json:"status,omitempty"`
+}
+
+type status struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+const statusCodeError = 2
+
+type attribute struct {
+	Key   string         `json:"key"`
+	Value attributeValue `json:"value"`
+}
+
+type attributeValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+}
+
+func encode(res resource, batch []tracing.SpanData) exportRequest {
+	spans := make([]span, len(batch))
+	for i, data := range batch {
+		spans[i] = span{
+			TraceID:           data.Context.TraceID.String(),
+			SpanID:            data.Context.SpanID.String(),
+			Name:              data.Name,
+			Kind:              int(data.Kind),
+			StartTimeUnixNano: strconv.FormatInt(data.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(data.End.UnixNano(), 10),
+			Attributes:        attributes(data.Attributes),
+		}
+		if data.Parent != (tracing.SpanID{}) {
+			spans[i].ParentSpanID = data.Parent.String()
+		}
+		if data.Error != "" {
+			spans[i].Status = &status{Code: statusCodeError, Message: data.Error}
+		}
+	}
+
+	return exportRequest{ResourceSpans: []resourceSpans{{
+		Resource: res,
+		ScopeSpans: []scopeSpans{{
+			Scope: scope{Name: "weaviate"},
+			Spans: spans,
+		}},
+	}}}
+}
+
+func attributes(values map[string]interface{}) []attribute {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]attribute, len(keys))
+	for i, key := range keys {
+		attrs[i] = attribute{Key: key, Value: attributeValueOf(values[key])}
+	}
+	return attrs
+}
+
+func attributeValueOf(value interface{}) attributeValue {
+	switch v := value.(type) {
+	case string:
+		return attributeValue{StringValue: &v}
+	case bool:
+		return attributeValue{BoolValue: &v}
+	case int:
+		s := strconv.Itoa(v)
+		return attributeValue{IntValue: &s}
+	case int64:
+		s := strconv.FormatInt(v, 10)
+		return attributeValue{IntValue: &s}
+	case float32:
+		f := float64(v)
+		return attributeValue{DoubleValue: &f}
+	case float64:
+		return attributeValue{DoubleValue: &v}
+	default:
+		s := fmt.Sprint(v)
+		return attributeValue{StringValue: &s}
+	}
+}
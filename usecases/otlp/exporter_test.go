@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package otlp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/tracing"
+)
+
+func TestExporter(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []map[string]interface{}
+	)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, tracesPath, r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer abc", r.Header.Get("Authorization"))
+
+		var body map[string]interface{}
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		mu.Lock()
+		requests = append(requests, body)
+		mu.Unlock()
+	}))
+	defer collector.Close()
+
+	logger, _ := test.NewNullLogger()
+	e := New(Config{
+		Enabled:     true,
+		Endpoint:    collector.URL,
+		ServiceName: "weaviate",
+		SampleRatio: 1,
+		Headers:     map[string]string{"Authorization": "Bearer abc"},
+	}, "node-1", logger)
+
+	parent, ok := tracing.ParseTraceparent("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	require.True(t, ok)
+	start := time.Unix(1700000000, 0)
+	e.ExportSpan(tracing.SpanData{
+		Name: "traverser.GetClass",
+		Kind: tracing.SpanKindInternal,
+		Context: tracing.SpanContext{
+			TraceID: parent.TraceID, SpanID: tracing.SpanID{1, 2, 3, 4, 5, 6, 7, 8}, Sampled: true,
+		},
+		Parent:     parent.SpanID,
+		Start:      start,
+		End:        start.Add(time.Millisecond),
+		Attributes: map[string]interface{}{"class": "Article", "limit": 10, "flat_search": true},
+		Error:      "shard down",
+	})
+	require.Nil(t, e.Close())
+	require.Nil(t, e.Close())
+
+	require.Len(t, requests, 1)
+	var expected map[string]interface{}
+	require.Nil(t, json.Unmarshal([]byte(`{"resourceSpans": [{
+		"resource": {"attributes": [
+			{"key": "service.instance.id", "value": {"stringValue": "node-1"}},
+			{"key": "service.name", "value": {"stringValue": "weaviate"}}
+		]},
+		"scopeSpans": [{
+			"scope": {"name": "weaviate"},
+			"spans": [{
+				"traceId": "0af7651916cd43dd8448eb211c80319c",
+				"spanId": "0102030405060708",
+				"parentSpanId": "b7ad6b7169203331",
+				"name": "traverser.GetClass",
+				"kind": 1,
+				"startTimeUnixNano": "1700000000000000000",
+				"endTimeUnixNano": "1700000000001000000",
+				"attributes": [
+					{"key": "class", "value": {"stringValue": "Article"}},
+					{"key": "flat_search", "value": {"boolValue": true}},
+					{"key": "limit", "value": {"intValue": "10"}}
+				],
+				"status": {"code": 2, "message": "shard down"}
+			}]
+		}]
+	}]}`), &expected))
+	assert.Equal(t, expected, requests[0])
+}
+
+func TestConfigValidate(t *testing.T) {
+	valid := Config{
+		Enabled: true, Endpoint: "http://collector:4318",
+		ServiceName: DefaultServiceName, SampleRatio: DefaultSampleRatio,
+	}
+	assert.Nil(t, valid.Validate())
+	assert.Nil(t, Config{}.Validate())
+
+	invalid := valid
+	invalid.Endpoint = "collector:4318"
+	assert.ErrorContains(t, invalid.Validate(), "endpoint")
+
+	invalid = valid
+	invalid.SampleRatio = 1.5
+	assert.ErrorContains(t, invalid.Validate(), "sample ratio")
+}
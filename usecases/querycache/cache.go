@@ -0,0 +1,237 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package querycache caches the results of queries, so that identical
+// queries, such as the ones of dashboards which are refreshed every few
+// seconds, do not search again until one of their classes is written to.
+package querycache
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Generations count the invalidations of each class, so that the caches
+// of all nodes can share them
+type Generations interface {
+	Get(ctx context.Context, classes []string) ([]uint64, error)
+	Bump(ctx context.Context, class string) error
+	Close() error
+}
+
+// Stamp is the state of the classes of a query when it was looked up. A
+// result is only cached if none of its classes changed in the meantime.
+type Stamp struct {
+	classes []string
+	local   []uint64
+	shared  []uint64
+	purges  uint64
+}
+
+type entry struct {
+	key     string
+	stamp   Stamp
+	value   interface{}
+	expires time.Time
+}
+
+// Cache of query results. Cached results are shared by all requests and
+// must not be modified. A nil Cache is valid and caches nothing.
+type Cache struct {
+	ttl        time.Duration
+	maxEntries int
+	shared     Generations
+	metrics    *Metrics
+	logger     logrus.FieldLogger
+	now        func() time.Time
+
+	sync.Mutex
+	local   map[string]uint64
+	purges  uint64
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+// New creates a cache, shared is nil if invalidations are not shared with
+// other nodes
+func New(cfg Config, shared Generations, metrics *Metrics,
+	logger logrus.FieldLogger,
+) *Cache {
+	return &Cache{
+		ttl:        cfg.TTL,
+		maxEntries: cfg.MaxEntries,
+		shared:     shared,
+		metrics:    metrics,
+		logger:     logger.WithField("action", "query_cache"),
+		now:        time.Now,
+		local:      map[string]uint64{},
+		entries:    map[string]*list.Element{},
+		lru:        list.New(),
+	}
+}
+
+// Key of the params of a query of the given kind. Params which can not be
+// encoded are not cached.
+func Key(kind string, params interface{}) (string, bool) {
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(encoded)
+	return kind + ":" + hex.EncodeToString(sum[:]), true
+}
+
+// Get returns the cached result of a query on the given classes, the first
+// of which is the class the query is counted for. The stamp is used to
+// Put the result of a miss.
+func (c *Cache) Get(ctx context.Context, key string, classes []string) (interface{}, Stamp, bool) {
+	if c == nil || len(classes) == 0 {
+		return nil, Stamp{}, false
+	}
+
+	stamp := Stamp{classes: classes}
+	if c.shared != nil {
+		shared, err := c.shared.Get(ctx, classes)
+		if err != nil {
+			// without the shared state a result might be stale
+			c.logger.WithError(err).Warn("could not get invalidations of query cache")
+			c.metrics.lookup(classes[0], false)
+			return nil, Stamp{}, false
+		}
+		stamp.shared = shared
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	stamp.local = c.localGenerations(classes)
+	stamp.purges = c.purges
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.metrics.lookup(classes[0], false)
+		return nil, stamp, false
+	}
+	e := elem.Value.(*entry)
+	if c.now().After(e.expires) || !e.stamp.equal(stamp) {
+		c.remove(elem)
+		c.metrics.lookup(classes[0], false)
+		return nil, stamp, false
+	}
+	c.lru.MoveToFront(elem)
+	c.metrics.lookup(classes[0], true)
+	return e.value, stamp, true
+}
+
+// Put the result of a query which missed the cache. It is dropped if one of
+// the classes of the query has been invalidated since the lookup.
+func (c *Cache) Put(key string, stamp Stamp, value interface{}) {
+	if c == nil || len(stamp.classes) == 0 {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if stamp.purges != c.purges || !equal(stamp.local, c.localGenerations(stamp.classes)) {
+		return
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+	c.entries[key] = c.lru.PushFront(&entry{
+		key:     key,
+		stamp:   stamp,
+		value:   value,
+		expires: c.now().Add(c.ttl),
+	})
+	for c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// Invalidate the cached results of all queries on a class. Results are
+// dropped lazily, when they are looked up or evicted.
+func (c *Cache) Invalidate(ctx context.Context, class string) {
+	if c == nil {
+		return
+	}
+
+	c.Lock()
+	c.local[class]++
+	c.Unlock()
+
+	if c.shared != nil {
+		if err := c.shared.Bump(ctx, class); err != nil {
+			c.logger.WithField("class", class).WithError(err).
+				Warn("could not share invalidation of query cache")
+		}
+	}
+}
+
+// Purge all cached results, e.g. after the schema changed. Every node
+// applies schema changes, so purges are not shared.
+func (c *Cache) Purge() {
+	if c == nil {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.purges++
+	c.entries = map[string]*list.Element{}
+	c.lru.Init()
+}
+
+// Close the connection used to share invalidations
+func (c *Cache) Close() error {
+	if c == nil || c.shared == nil {
+		return nil
+	}
+	return c.shared.Close()
+}
+
+// localGenerations must be called with the cache locked
+func (c *Cache) localGenerations(classes []string) []uint64 {
+	gens := make([]uint64, len(classes))
+	for i, class := range classes {
+		gens[i] = c.local[class]
+	}
+	return gens
+}
+
+// remove must be called with the cache locked
+func (c *Cache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*entry).key)
+}
+
+func (s Stamp) equal(o Stamp) bool {
+	return equal(s.local, o.local) && equal(s.shared, o.shared)
+}
+
+func equal(xs, ys []uint64) bool {
+	if len(xs) != len(ys) {
+		return false
+	}
+	for i := range xs {
+		if xs[i] != ys[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package querycache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeGenerations struct {
+	gens map[string]uint64
+	err  error
+}
+
+func (f *fakeGenerations) Get(ctx context.Context, classes []string) ([]uint64, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	gens := make([]uint64, len(classes))
+	for i, class := range classes {
+		gens[i] = f.gens[class]
+	}
+	return gens, nil
+}
+
+func (f *fakeGenerations) Bump(ctx context.Context, class string) error {
+	f.gens[class]++
+	return f.err
+}
+
+func (f *fakeGenerations) Close() error {
+	return nil
+}
+
+func newTestCache(shared Generations) (*Cache, *time.Time) {
+	logger, _ := test.NewNullLogger()
+	c := New(Config{Enabled: true, TTL: time.Minute, MaxEntries: 2}, shared, nil, logger)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	return c, &now
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	articles := []string{"Article"}
+	withAuthors := []string{"Article", "Author"}
+
+	t.Run("hit until invalidated", func(t *testing.T) {
+		c, _ := newTestCache(nil)
+		_, stamp, ok := c.Get(ctx, "q1", withAuthors)
+		require.False(t, ok)
+		c.Put("q1", stamp, "result")
+
+		value, _, ok := c.Get(ctx, "q1", withAuthors)
+		require.True(t, ok)
+		assert.Equal(t, "result", value)
+
+		c.Invalidate(ctx, "Author")
+		_, _, ok = c.Get(ctx, "q1", withAuthors)
+		assert.False(t, ok)
+	})
+
+	t.Run("results of invalidated lookups are not cached", func(t *testing.T) {
+		c, _ := newTestCache(nil)
+		_, stamp, _ := c.Get(ctx, "q1", articles)
+		c.Invalidate(ctx, "Article")
+		c.Put("q1", stamp, "stale")
+		_, _, ok := c.Get(ctx, "q1", articles)
+		assert.False(t, ok)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		c, now := newTestCache(nil)
+		_, stamp, _ := c.Get(ctx, "q1", articles)
+		c.Put("q1", stamp, "result")
+		*now = now.Add(time.Minute + time.Second)
+		_, _, ok := c.Get(ctx, "q1", articles)
+		assert.False(t, ok)
+	})
+
+	t.Run("least recently used are evicted", func(t *testing.T) {
+		c, _ := newTestCache(nil)
+		for _, key := range []string{"q1", "q2"} {
+			_, stamp, _ := c.Get(ctx, key, articles)
+			c.Put(key, stamp, key)
+		}
+		_, _, ok := c.Get(ctx, "q1", articles)
+		require.True(t, ok)
+		_, stamp, _ := c.Get(ctx, "q3", articles)
+		c.Put("q3", stamp, "q3")
+
+		_, _, ok = c.Get(ctx, "q2", articles)
+		assert.False(t, ok)
+		_, _, ok = c.Get(ctx, "q1", articles)
+		assert.True(t, ok)
+		_, _, ok = c.Get(ctx, "q3", articles)
+		assert.True(t, ok)
+	})
+
+	t.Run("shared invalidations", func(t *testing.T) {
+		shared := &fakeGenerations{gens: map[string]uint64{}}
+		c, _ := newTestCache(shared)
+		_, stamp, _ := c.Get(ctx, "q1", articles)
+		c.Put("q1", stamp, "result")
+		_, _, ok := c.Get(ctx, "q1", articles)
+		require.True(t, ok)
+
+		// invalidated by another node
+		shared.gens["Article"]++
+		_, _, ok = c.Get(ctx, "q1", articles)
+		assert.False(t, ok)
+
+		// nothing is cached without the shared state
+		shared.err = errors.New("connection refused")
+		_, stamp, ok = c.Get(ctx, "q1", articles)
+		assert.False(t, ok)
+		c.Put("q1", stamp, "result")
+		shared.err = nil
+		_, _, ok = c.Get(ctx, "q1", articles)
+		assert.False(t, ok)
+	})
+
+	t.Run("nil cache", func(t *testing.T) {
+		var c *Cache
+		_, stamp, ok := c.Get(ctx, "q1", articles)
+		assert.False(t, ok)
+		c.Put("q1", stamp, "result")
+		c.Invalidate(ctx, "Article")
+		assert.Nil(t, c.Close())
+	})
+}
+
+func TestKey(t *testing.T) {
+	type params struct {
+		Class string
+		Limit int
+	}
+	k1, ok := Key("get", params{"Article", 10})
+	require.True(t, ok)
+	k2, _ := Key("get", params{"Article", 10})
+	k3, _ := Key("get", params{"Article", 20})
+	k4, _ := Key("aggregate", params{"Article", 10})
+	assert.Equal(t, k1, k2)
+	assert.NotEqual(t, k1, k3)
+	assert.NotEqual(t, k1, k4)
+
+	_, ok = Key("get", func() {})
+	assert.False(t, ok)
+}
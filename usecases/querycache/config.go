@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package querycache
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	DefaultTTL        = 30 * time.Second
+	DefaultMaxEntries = 1000
+)
+
+// Config of the query cache. Results are cached in the memory of each node
+// for at most TTL. Writes invalidate the results of their class right away
+// on the node which received them. With Redis the invalidations are shared
+// with all nodes, otherwise other nodes pick up a write after TTL at the
+// latest.
+type Config struct {
+	Enabled    bool          `json:"enabled" yaml:"enabled"`
+	TTL        time.Duration `json:"ttl" yaml:"ttl"`
+	MaxEntries int           `json:"max_entries" yaml:"max_entries"`
+	Redis      RedisConfig   `json:"redis" yaml:"redis"`
+}
+
+// RedisConfig of the server which shares invalidations, in the form
+// redis://[:password@]host[:port][/db]
+type RedisConfig struct {
+	URL      string `json:"url" yaml:"url"`
+	Password string `json:"-" yaml:"-"`
+}
+
+// Validate the query cache config, can be called from the central config
+// package
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.TTL <= 0 {
+		return fmt.Errorf("query_cache: ttl must be positive")
+	}
+	if c.MaxEntries <= 0 {
+		return fmt.Errorf("query_cache: max entries must be positive")
+	}
+	if c.Redis.URL != "" {
+		u, err := url.Parse(c.Redis.URL)
+		if err != nil {
+			return fmt.Errorf("query_cache: parse redis url: %w", err)
+		}
+		if u.Scheme != "redis" || u.Host == "" {
+			return fmt.Errorf("query_cache: redis url must be of the form redis://host:port, got %q",
+				c.Redis.URL)
+		}
+	}
+	return nil
+}
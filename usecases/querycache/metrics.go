@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package querycache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics of cache lookups, the hit rate is the share of "hit" lookups.
+// The monitoring package depends on the config, which depends on this
+// package, so the collectors are passed in.
+type Metrics struct {
+	lookups    *prometheus.CounterVec
+	classLabel func(string) string
+}
+
+func NewMetrics(lookups *prometheus.CounterVec, classLabel func(string) string) *Metrics {
+	return &Metrics{
+		lookups:    lookups,
+		classLabel: classLabel,
+	}
+}
+
+func (m *Metrics) lookup(class string, hit bool) {
+	if m == nil {
+		return
+	}
+
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.lookups.With(prometheus.Labels{
+		"class_name": m.classLabel(class),
+		"result":     result,
+	}).Inc()
+}
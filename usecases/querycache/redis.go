@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package querycache
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	redisTimeout   = time.Second
+	redisKeyPrefix = "weaviate:query-cache:"
+)
+
+// RedisGenerations keeps the generations of all classes in Redis using its
+// text protocol, so no Redis client is required inside Weaviate. The
+// connection is reestablished on the next command after any error.
+type RedisGenerations struct {
+	addr     string
+	password string
+	db       int
+
+	sync.Mutex
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func NewRedisGenerations(cfg RedisConfig) (*RedisGenerations, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parse redis url: %w", err)
+	}
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), "6379")
+	}
+	password := cfg.Password
+	if password == "" && u.User != nil {
+		password, _ = u.User.Password()
+	}
+	db := 0
+	if path := strings.Trim(u.Path, "/"); path != "" {
+		if db, err = strconv.Atoi(path); err != nil {
+			return nil, fmt.Errorf("parse redis database %q: %w", path, err)
+		}
+	}
+	return &RedisGenerations{addr: addr, password: password, db: db}, nil
+}
+
+func (g *RedisGenerations) Get(ctx context.Context, classes []string) ([]uint64, error) {
+	args := make([]string, len(classes)+1)
+	args[0] = "MGET"
+	for i, class := range classes {
+		args[i+1] = redisKeyPrefix + class
+	}
+	reply, err := g.do(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	values, ok := reply.([]interface{})
+	if !ok || len(values) != len(classes) {
+		return nil, fmt.Errorf("redis: unexpected reply to MGET: %v", reply)
+	}
+	gens := make([]uint64, len(classes))
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		data, ok := value.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("redis: unexpected generation of class %q: %v", classes[i], value)
+		}
+		if gens[i], err = strconv.ParseUint(string(data), 10, 64); err != nil {
+			return nil, fmt.Errorf("redis: invalid generation of class %q: %w", classes[i], err)
+		}
+	}
+	return gens, nil
+}
+
+func (g *RedisGenerations) Bump(ctx context.Context, class string) error {
+	_, err := g.do(ctx, "INCR", redisKeyPrefix+class)
+	return err
+}
+
+func (g *RedisGenerations) Close() error {
+	g.Lock()
+	defer g.Unlock()
+	if g.conn == nil {
+		return nil
+	}
+	err := g.conn.Close()
+	g.conn = nil
+	return err
+}
+
+func (g *RedisGenerations) do(ctx context.Context, args ...string) (interface{}, error) {
+	g.Lock()
+	defer g.Unlock()
+
+	if g.conn == nil {
+		if err := g.connect(ctx); err != nil {
+			return nil, fmt.Errorf("connect to redis: %w", err)
+		}
+	}
+	reply, err := g.command(ctx, args...)
+	if err != nil {
+		g.conn.Close()
+		g.conn = nil
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (g *RedisGenerations) connect(ctx context.Context) error {
+	dialer := net.Dialer{Timeout: redisTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", g.addr)
+	if err != nil {
+		return err
+	}
+	g.conn, g.r = conn, bufio.NewReader(conn)
+
+	if g.password != "" {
+		_, err = g.command(ctx, "AUTH", g.password)
+	}
+	if err == nil && g.db != 0 {
+		_, err = g.command(ctx, "SELECT", strconv.Itoa(g.db))
+	}
+	if err != nil {
+		conn.Close()
+		g.conn = nil
+		return err
+	}
+	return nil
+}
+
+func (g *RedisGenerations) command(ctx context.Context, args ...string) (interface{}, error) {
+	deadline := time.Now().Add(redisTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	g.conn.SetDeadline(deadline)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&buf, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if _, err := g.conn.Write(buf.Bytes()); err != nil {
+		return nil, fmt.Errorf("write to redis: %w", err)
+	}
+	return readRedisReply(g.r)
+}
+
+// readRedisReply reads a reply of the Redis protocol. Bulk strings are
+// returned as []byte, integers as int64 and arrays as []interface{}.
+func readRedisReply(r *bufio.Reader) (interface{}, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("read from redis: %w", err)
+	}
+	line = strings.TrimRight(line, "\r\n")
+	if line == "" {
+		return nil, fmt.Errorf("redis: empty reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, fmt.Errorf("redis: %s", line[1:])
+	case ':':
+		n, err := strconv.ParseInt(line[1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("redis: invalid integer %q", line[1:])
+		}
+		return n, nil
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("redis: invalid bulk length %q", line[1:])
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		data := make([]byte, n+2)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, fmt.Errorf("read from redis: %w", err)
+		}
+		return data[:n], nil
+	case '*':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("redis: invalid array length %q", line[1:])
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		values := make([]interface{}, n)
+		for i := range values {
+			if values[i], err = readRedisReply(r); err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("redis: unexpected reply %q", line)
+	}
+}
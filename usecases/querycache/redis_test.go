@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package querycache
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis serves MGET, INCR and AUTH, the commands are parsed with the
+// reply parser since they are arrays of bulk strings as well
+type fakeRedis struct {
+	listener net.Listener
+	password string
+
+	sync.Mutex
+	values   map[string]int64
+	commands []string
+}
+
+func newFakeRedis(t *testing.T, password string) *fakeRedis {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	f := &fakeRedis{listener: l, password: password, values: map[string]int64{}}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+	t.Cleanup(func() { l.Close() })
+	return f
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	authed := f.password == ""
+	for {
+		cmd, err := readRedisReply(r)
+		if err != nil {
+			return
+		}
+		args := cmd.([]interface{})
+		name := string(args[0].([]byte))
+
+		f.Lock()
+		f.commands = append(f.commands, name)
+		var reply string
+		switch {
+		case name == "AUTH":
+			if string(args[1].([]byte)) == f.password {
+				authed = true
+				reply = "+OK\r\n"
+			} else {
+				reply = "-WRONGPASS invalid password\r\n"
+			}
+		case !authed:
+			reply = "-NOAUTH Authentication required\r\n"
+		case name == "INCR":
+			key := string(args[1].([]byte))
+			f.values[key]++
+			reply = fmt.Sprintf(":%d\r\n", f.values[key])
+		case name == "MGET":
+			reply = fmt.Sprintf("*%d\r\n", len(args)-1)
+			for _, arg := range args[1:] {
+				if v, ok := f.values[string(arg.([]byte))]; ok {
+					s := fmt.Sprint(v)
+					reply += fmt.Sprintf("$%d\r\n%s\r\n", len(s), s)
+				} else {
+					reply += "$-1\r\n"
+				}
+			}
+		default:
+			reply = "-ERR unknown command\r\n"
+		}
+		f.Unlock()
+
+		if _, err := conn.Write([]byte(reply)); err != nil {
+			return
+		}
+	}
+}
+
+func TestRedisGenerations(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeRedis(t, "secret")
+
+	g, err := NewRedisGenerations(RedisConfig{
+		URL: "redis://:secret@" + server.listener.Addr().String(),
+	})
+	require.Nil(t, err)
+	defer g.Close()
+
+	gens, err := g.Get(ctx, []string{"Article", "Author"})
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{0, 0}, gens)
+
+	require.Nil(t, g.Bump(ctx, "Author"))
+	require.Nil(t, g.Bump(ctx, "Author"))
+	gens, err = g.Get(ctx, []string{"Article", "Author"})
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{0, 2}, gens)
+
+	// reconnects after the connection broke
+	g.conn.Close()
+	_, err = g.Get(ctx, []string{"Article"})
+	require.NotNil(t, err)
+	gens, err = g.Get(ctx, []string{"Author"})
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{2}, gens)
+	assert.Equal(t, []string{"AUTH", "MGET", "INCR", "INCR", "MGET", "AUTH", "MGET"},
+		server.commands)
+
+	t.Run("wrong password", func(t *testing.T) {
+		g, err := NewRedisGenerations(RedisConfig{
+			URL:      "redis://" + server.listener.Addr().String(),
+			Password: "wrong",
+		})
+		require.Nil(t, err)
+		_, err = g.Get(ctx, []string{"Article"})
+		assert.ErrorContains(t, err, "WRONGPASS")
+	})
+}
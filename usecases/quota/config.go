@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package quota
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// Limits of a class or tenant. Zero values are not limited.
+type Limits struct {
+	MaxObjects           int64   `json:"max_objects" yaml:"max_objects"`
+	MaxVectorBytes       int64   `json:"max_vector_bytes" yaml:"max_vector_bytes"`
+	MaxRequestsPerSecond float64 `json:"max_requests_per_second" yaml:"max_requests_per_second"`
+}
+
+func (l Limits) validate() error {
+	if l.MaxObjects < 0 || l.MaxVectorBytes < 0 || l.MaxRequestsPerSecond < 0 {
+		return fmt.Errorf("limits must not be negative")
+	}
+	return nil
+}
+
+// ClassLimits apply to a class as a whole. Each tenant of the class is
+// additionally limited by its own entry in Tenants or by TenantDefault.
+type ClassLimits struct {
+	Limits        `json:",inline" yaml:",inline"`
+	TenantDefault Limits            `json:"tenant_default" yaml:"tenant_default"`
+	Tenants       map[string]Limits `json:"tenants" yaml:"tenants"`
+}
+
+// Config of the quotas. Classes without an entry use the default limits.
+type Config struct {
+	Enabled bool                   `json:"enabled" yaml:"enabled"`
+	Default ClassLimits            `json:"default" yaml:"default"`
+	Classes map[string]ClassLimits `json:"classes" yaml:"classes"`
+}
+
+// Validate the quota config, can be called from the central config package.
+// Vector bytes are derived from the tracked vector dimensions, so they can
+// only be limited if dimensions are tracked.
+func (c Config) Validate(trackVectorDimensions bool) error {
+	if !c.Enabled {
+		return nil
+	}
+
+	all := map[string]ClassLimits{"default": c.Default}
+	for class, limits := range c.Classes {
+		all[fmt.Sprintf("class %q", class)] = limits
+	}
+
+	for name, limits := range all {
+		for _, l := range append([]Limits{limits.Limits, limits.TenantDefault}, values(limits.Tenants)...) {
+			if err := l.validate(); err != nil {
+				return fmt.Errorf("quotas: %s: %w", name, err)
+			}
+			if l.MaxVectorBytes > 0 && !trackVectorDimensions {
+				return fmt.Errorf("quotas: %s: max_vector_bytes requires "+
+					"TRACK_VECTOR_DIMENSIONS to be enabled", name)
+			}
+		}
+	}
+	return nil
+}
+
+// classLimits returns the limits of the class with the normalized class
+// name, the configured names may use a lowercase first letter
+func (c Config) classLimits(class string) ClassLimits {
+	for name, limits := range c.Classes {
+		if schema.UppercaseClassName(name) == class {
+			return limits
+		}
+	}
+	return c.Default
+}
+
+func (c ClassLimits) tenantLimits(tenant string) Limits {
+	if limits, ok := c.Tenants[tenant]; ok {
+		return limits
+	}
+	return c.TenantDefault
+}
+
+func values(m map[string]Limits) []Limits {
+	out := make([]Limits, 0, len(m))
+	for _, l := range m {
+		out = append(out, l)
+	}
+	return out
+}
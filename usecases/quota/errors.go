@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package quota
+
+import "fmt"
+
+const (
+	QuotaObjects           = "max_objects"
+	QuotaVectorBytes       = "max_vector_bytes"
+	QuotaRequestsPerSecond = "max_requests_per_second"
+)
+
+// ErrRateLimited indicates that a class or tenant received more requests
+// than allowed. Clients should retry later.
+type ErrRateLimited struct {
+	Class  string
+	Tenant string
+	Limit  float64
+}
+
+func (e ErrRateLimited) Error() string {
+	return fmt.Sprintf("quota %s of %s exceeded: limit %v", QuotaRequestsPerSecond,
+		subject(e.Class, e.Tenant), e.Limit)
+}
+
+// ErrQuotaExceeded indicates that a write would exceed the objects or
+// vector bytes allowed for a class or tenant
+type ErrQuotaExceeded struct {
+	Class     string
+	Tenant    string
+	Quota     string
+	Limit     int64
+	Usage     int64
+	Requested int64
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("quota %s of %s exceeded: usage %d, requested %d, limit %d",
+		e.Quota, subject(e.Class, e.Tenant), e.Usage, e.Requested, e.Limit)
+}
+
+func subject(class, tenant string) string {
+	if tenant == "" {
+		return fmt.Sprintf("class %q", class)
+	}
+	return fmt.Sprintf("tenant %q of class %q", tenant, class)
+}
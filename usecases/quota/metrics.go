@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package quota
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics report the consumption of the quotas. They are built from the
+// vectors of the prometheus metrics instead of the monitoring package, since
+// the quota config is part of the central config which monitoring depends
+// on.
+type Metrics struct {
+	usage       *prometheus.GaugeVec
+	limit       *prometheus.GaugeVec
+	rejections  *prometheus.CounterVec
+	classLabel  func(string) string
+	tenantLabel func(string) string
+}
+
+// NewMetrics reports the quotas with the class and tenant labels returned by
+// classLabel and tenantLabel, which may aggregate them to keep the
+// cardinality low
+func NewMetrics(usage, limit *prometheus.GaugeVec, rejections *prometheus.CounterVec,
+	classLabel, tenantLabel func(string) string,
+) *Metrics {
+	return &Metrics{
+		usage:       usage,
+		limit:       limit,
+		rejections:  rejections,
+		classLabel:  classLabel,
+		tenantLabel: tenantLabel,
+	}
+}
+
+func (m *Metrics) Usage(class, tenant, quota string, usage int64) {
+	labels, ok := m.gaugeLabels(class, tenant, quota)
+	if !ok {
+		return
+	}
+
+	m.usage.With(labels).Set(float64(usage))
+}
+
+func (m *Metrics) Limit(class, tenant, quota string, limit int64) {
+	labels, ok := m.gaugeLabels(class, tenant, quota)
+	if !ok {
+		return
+	}
+
+	m.limit.With(labels).Set(float64(limit))
+}
+
+func (m *Metrics) Rejected(class, tenant, quota string) {
+	if m == nil {
+		return
+	}
+
+	m.rejections.With(m.labels(class, tenant, quota)).Inc()
+}
+
+// gaugeLabels returns false if the class or tenant labels are aggregated,
+// the usage and limit of different tenants can not be added up in a gauge
+// as each of them would overwrite the last one
+func (m *Metrics) gaugeLabels(class, tenant, quota string) (prometheus.Labels, bool) {
+	if m == nil || m.classLabel(class) != class || m.tenantLabel(tenant) != tenant {
+		return nil, false
+	}
+
+	return m.labels(class, tenant, quota), true
+}
+
+func (m *Metrics) labels(class, tenant, quota string) prometheus.Labels {
+	return prometheus.Labels{
+		"class_name": m.classLabel(class),
+		"tenant":     m.tenantLabel(tenant),
+		"quota":      quota,
+	}
+}
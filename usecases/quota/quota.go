@@ -0,0 +1,258 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package quota limits the resources a class or a tenant can consume, so a
+// single noisy tenant can not exhaust the cluster. Objects and vector bytes
+// are checked against the usage of the shards on this node, requests per
+// second are limited per node.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// usageTTL is how long the usage read from the shards is reused. Writes
+// admitted in the meantime are added to the cached usage.
+const usageTTL = 10 * time.Second
+
+// Usage of a class or tenant
+type Usage struct {
+	Objects     int64
+	VectorBytes int64
+}
+
+type usageSource interface {
+	// QuotaUsage of a class, or a single tenant of the class if tenant is set
+	QuotaUsage(ctx context.Context, class, tenant string) (Usage, error)
+}
+
+type key struct {
+	class  string
+	tenant string
+}
+
+type cachedUsage struct {
+	Usage
+	readAt time.Time
+}
+
+// Enforcer checks requests and writes against the configured limits. A nil
+// Enforcer is valid and limits nothing.
+type Enforcer struct {
+	usage   usageSource
+	metrics *Metrics
+	now     func() time.Time
+
+	sync.Mutex
+	config  Config
+	buckets map[key]*bucket
+	usages  map[key]*cachedUsage
+}
+
+func NewEnforcer(config Config, usage usageSource, metrics *Metrics) *Enforcer {
+	return &Enforcer{
+		config:  config,
+		usage:   usage,
+		metrics: metrics,
+		now:     time.Now,
+		buckets: map[key]*bucket{},
+		usages:  map[key]*cachedUsage{},
+	}
+}
+
+// SetConfig changes the limits, buckets of changed request limits start
+// full again. Disabling the quotas removes all limits.
+func (e *Enforcer) SetConfig(config Config) {
+	if e == nil {
+		return
+	}
+	if !config.Enabled {
+		config = Config{}
+	}
+
+	e.Lock()
+	defer e.Unlock()
+	e.config = config
+}
+
+// Request counts a request against the requests per second of the class
+// and the tenant. A rejected request does not consume from either limit.
+func (e *Enforcer) Request(class, tenant string) error {
+	if e == nil {
+		return nil
+	}
+
+	class = schema.UppercaseClassName(class)
+
+	e.Lock()
+	defer e.Unlock()
+
+	classLimits := e.config.classLimits(class)
+	keys := []key{{class, ""}}
+	limits := []float64{classLimits.MaxRequestsPerSecond}
+	if tenant != "" {
+		keys = append(keys, key{class, tenant})
+		limits = append(limits, classLimits.tenantLimits(tenant).MaxRequestsPerSecond)
+	}
+
+	now := e.now()
+	buckets := make([]*bucket, 0, len(limits))
+	for i, k := range keys {
+		limit := limits[i]
+		if limit == 0 {
+			continue
+		}
+
+		b, ok := e.buckets[k]
+		if !ok || b.rate != limit {
+			b = newBucket(limit, now)
+			e.buckets[k] = b
+		}
+		if !b.refill(now) {
+			e.metrics.Rejected(k.class, k.tenant, QuotaRequestsPerSecond)
+			return ErrRateLimited{Class: k.class, Tenant: k.tenant, Limit: limit}
+		}
+		buckets = append(buckets, b)
+	}
+
+	for _, b := range buckets {
+		b.tokens--
+	}
+	return nil
+}
+
+// Admit checks whether objects with the given vector bytes can be added to
+// the class and tenant. Admitted objects are counted right away, so
+// concurrent writes can not exceed the limits together.
+func (e *Enforcer) Admit(ctx context.Context, class, tenant string, objects, vectorBytes int64) error {
+	if e == nil {
+		return nil
+	}
+
+	class = schema.UppercaseClassName(class)
+
+	e.Lock()
+	defer e.Unlock()
+
+	classLimits := e.config.classLimits(class)
+	checks := []struct {
+		key    key
+		limits Limits
+	}{{key{class, ""}, classLimits.Limits}}
+	if tenant != "" {
+		checks = append(checks, struct {
+			key    key
+			limits Limits
+		}{key{class, tenant}, classLimits.tenantLimits(tenant)})
+	}
+
+	usages := make([]*cachedUsage, len(checks))
+	for i, check := range checks {
+		if check.limits.MaxObjects == 0 && check.limits.MaxVectorBytes == 0 {
+			continue
+		}
+
+		usage, err := e.currentUsage(ctx, check.key)
+		if err != nil {
+			return err
+		}
+		if err := e.checkLimit(check.key, QuotaObjects, check.limits.MaxObjects,
+			usage.Objects, objects); err != nil {
+			return err
+		}
+		if err := e.checkLimit(check.key, QuotaVectorBytes, check.limits.MaxVectorBytes,
+			usage.VectorBytes, vectorBytes); err != nil {
+			return err
+		}
+		usages[i] = usage
+	}
+
+	for i, usage := range usages {
+		if usage == nil {
+			continue
+		}
+		usage.Objects += objects
+		usage.VectorBytes += vectorBytes
+		e.metrics.Usage(checks[i].key.class, checks[i].key.tenant, QuotaObjects, usage.Objects)
+		e.metrics.Usage(checks[i].key.class, checks[i].key.tenant, QuotaVectorBytes, usage.VectorBytes)
+	}
+	return nil
+}
+
+func (e *Enforcer) checkLimit(k key, quota string, limit, usage, requested int64) error {
+	if limit == 0 {
+		return nil
+	}
+
+	e.metrics.Limit(k.class, k.tenant, quota, limit)
+	if usage+requested <= limit {
+		return nil
+	}
+
+	e.metrics.Rejected(k.class, k.tenant, quota)
+	return ErrQuotaExceeded{
+		Class:     k.class,
+		Tenant:    k.tenant,
+		Quota:     quota,
+		Limit:     limit,
+		Usage:     usage,
+		Requested: requested,
+	}
+}
+
+func (e *Enforcer) currentUsage(ctx context.Context, k key) (*cachedUsage, error) {
+	if usage, ok := e.usages[k]; ok && e.now().Sub(usage.readAt) < usageTTL {
+		return usage, nil
+	}
+
+	usage, err := e.usage.QuotaUsage(ctx, k.class, k.tenant)
+	if err != nil {
+		return nil, fmt.Errorf("read quota usage of %s: %w", subject(k.class, k.tenant), err)
+	}
+	cached := &cachedUsage{Usage: usage, readAt: e.now()}
+	e.usages[k] = cached
+	return cached, nil
+}
+
+// bucket is a token bucket which allows bursts of up to one second worth of
+// requests
+type bucket struct {
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(rate float64, now time.Time) *bucket {
+	return &bucket{rate: rate, tokens: burst(rate), last: now}
+}
+
+// refill the bucket up to now and report whether a token is available
+func (b *bucket) refill(now time.Time) bool {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if max := burst(b.rate); b.tokens > max {
+		b.tokens = max
+	}
+	b.last = now
+
+	return b.tokens >= 1
+}
+
+func burst(rate float64) float64 {
+	if rate < 1 {
+		return 1
+	}
+	return rate
+}
@@ -0,0 +1,225 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeUsage struct {
+	usages map[key]Usage
+	calls  int
+}
+
+func (f *fakeUsage) QuotaUsage(ctx context.Context, class, tenant string) (Usage, error) {
+	f.calls++
+	return f.usages[key{class, tenant}], nil
+}
+
+func newTestEnforcer(cfg Config, usage *fakeUsage) (*Enforcer, *time.Time) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := NewEnforcer(cfg, usage, nil)
+	e.now = func() time.Time { return now }
+	return e, &now
+}
+
+func TestEnforcerNil(t *testing.T) {
+	var e *Enforcer
+	assert.Nil(t, e.Request("Article", "tenantA"))
+	assert.Nil(t, e.Admit(context.Background(), "Article", "tenantA", 1, 4))
+}
+
+func TestEnforcerRequests(t *testing.T) {
+	cfg := Config{
+		Enabled: true,
+		Classes: map[string]ClassLimits{
+			"article": {
+				Limits:        Limits{MaxRequestsPerSecond: 3},
+				TenantDefault: Limits{MaxRequestsPerSecond: 2},
+			},
+		},
+	}
+
+	t.Run("tenant limit", func(t *testing.T) {
+		e, now := newTestEnforcer(cfg, &fakeUsage{})
+		require.Nil(t, e.Request("Article", "tenantA"))
+		require.Nil(t, e.Request("Article", "tenantA"))
+
+		err := e.Request("Article", "tenantA")
+		var rateLimited ErrRateLimited
+		require.True(t, errors.As(err, &rateLimited))
+		assert.Equal(t, ErrRateLimited{Class: "Article", Tenant: "tenantA", Limit: 2}, rateLimited)
+
+		// other tenants only share the limit of the class
+		require.Nil(t, e.Request("Article", "tenantB"))
+
+		*now = now.Add(500 * time.Millisecond)
+		assert.Nil(t, e.Request("Article", "tenantA"))
+	})
+
+	t.Run("class limit", func(t *testing.T) {
+		e, _ := newTestEnforcer(cfg, &fakeUsage{})
+		for i := 0; i < 3; i++ {
+			require.Nil(t, e.Request("article", ""))
+		}
+		assert.Equal(t, ErrRateLimited{Class: "Article", Limit: 3}, e.Request("Article", ""))
+	})
+
+	t.Run("unlimited class", func(t *testing.T) {
+		e, _ := newTestEnforcer(cfg, &fakeUsage{})
+		for i := 0; i < 100; i++ {
+			require.Nil(t, e.Request("Other", "tenantA"))
+		}
+	})
+
+	t.Run("changed config", func(t *testing.T) {
+		e, _ := newTestEnforcer(cfg, &fakeUsage{})
+		for i := 0; i < 3; i++ {
+			require.Nil(t, e.Request("Article", ""))
+		}
+		require.NotNil(t, e.Request("Article", ""))
+
+		changed := cfg
+		changed.Classes = map[string]ClassLimits{
+			"Article": {Limits: Limits{MaxRequestsPerSecond: 5}},
+		}
+		e.SetConfig(changed)
+		for i := 0; i < 5; i++ {
+			require.Nil(t, e.Request("Article", ""))
+		}
+		require.NotNil(t, e.Request("Article", ""))
+
+		e.SetConfig(Config{Classes: changed.Classes})
+		for i := 0; i < 100; i++ {
+			require.Nil(t, e.Request("Article", ""))
+		}
+	})
+}
+
+func TestEnforcerAdmit(t *testing.T) {
+	cfg := Config{
+		Enabled: true,
+		Default: ClassLimits{Limits: Limits{MaxObjects: 10}},
+		Classes: map[string]ClassLimits{
+			"Article": {
+				Limits:        Limits{MaxObjects: 100, MaxVectorBytes: 1000},
+				TenantDefault: Limits{MaxObjects: 5},
+				Tenants:       map[string]Limits{"tenantA": {MaxObjects: 20}},
+			},
+		},
+	}
+	ctx := context.Background()
+
+	t.Run("tenant limits", func(t *testing.T) {
+		usage := &fakeUsage{usages: map[key]Usage{
+			{"Article", ""}:        {Objects: 50, VectorBytes: 200},
+			{"Article", "tenantA"}: {Objects: 19},
+			{"Article", "tenantB"}: {Objects: 4},
+		}}
+		e, _ := newTestEnforcer(cfg, usage)
+
+		require.Nil(t, e.Admit(ctx, "Article", "tenantA", 1, 4))
+		assert.Equal(t, ErrQuotaExceeded{
+			Class: "Article", Tenant: "tenantA", Quota: QuotaObjects,
+			Limit: 20, Usage: 20, Requested: 1,
+		}, e.Admit(ctx, "Article", "tenantA", 1, 4))
+
+		require.Nil(t, e.Admit(ctx, "Article", "tenantB", 1, 4))
+		assert.Error(t, e.Admit(ctx, "Article", "tenantB", 1, 4))
+	})
+
+	t.Run("class vector bytes", func(t *testing.T) {
+		usage := &fakeUsage{usages: map[key]Usage{
+			{"Article", ""}: {Objects: 50, VectorBytes: 900},
+		}}
+		e, _ := newTestEnforcer(cfg, usage)
+
+		err := e.Admit(ctx, "Article", "", 1, 200)
+		var exceeded ErrQuotaExceeded
+		require.True(t, errors.As(err, &exceeded))
+		assert.Equal(t, QuotaVectorBytes, exceeded.Quota)
+		assert.Equal(t, int64(900), exceeded.Usage)
+		assert.Nil(t, e.Admit(ctx, "Article", "", 1, 100))
+	})
+
+	t.Run("default limits", func(t *testing.T) {
+		usage := &fakeUsage{usages: map[key]Usage{{"Other", ""}: {Objects: 10}}}
+		e, _ := newTestEnforcer(cfg, usage)
+		assert.Error(t, e.Admit(ctx, "Other", "", 1, 0))
+	})
+
+	t.Run("usage is cached", func(t *testing.T) {
+		usage := &fakeUsage{usages: map[key]Usage{{"Other", ""}: {Objects: 5}}}
+		e, now := newTestEnforcer(cfg, usage)
+		for i := 0; i < 5; i++ {
+			require.Nil(t, e.Admit(ctx, "Other", "", 1, 0))
+		}
+		assert.Error(t, e.Admit(ctx, "Other", "", 1, 0))
+		assert.Equal(t, 1, usage.calls)
+
+		// objects were deleted in the meantime
+		usage.usages[key{"Other", ""}] = Usage{Objects: 2}
+		*now = now.Add(usageTTL)
+		assert.Nil(t, e.Admit(ctx, "Other", "", 1, 0))
+		assert.Equal(t, 2, usage.calls)
+	})
+}
+
+func TestConfigValidate(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		cfg := Config{Default: ClassLimits{Limits: Limits{MaxObjects: -1}}}
+		assert.Nil(t, cfg.Validate(false))
+	})
+
+	t.Run("negative limit", func(t *testing.T) {
+		cfg := Config{Enabled: true, Classes: map[string]ClassLimits{
+			"Article": {Tenants: map[string]Limits{"tenantA": {MaxRequestsPerSecond: -1}}},
+		}}
+		assert.ErrorContains(t, cfg.Validate(false), `class "Article"`)
+	})
+
+	t.Run("vector bytes without tracked dimensions", func(t *testing.T) {
+		cfg := Config{Enabled: true, Default: ClassLimits{TenantDefault: Limits{MaxVectorBytes: 1}}}
+		assert.ErrorContains(t, cfg.Validate(false), "TRACK_VECTOR_DIMENSIONS")
+		assert.Nil(t, cfg.Validate(true))
+	})
+}
+
+func TestMetricsAggregatedTenants(t *testing.T) {
+	usage := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "usage"},
+		[]string{"class_name", "tenant", "quota"})
+	limit := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "limit"},
+		[]string{"class_name", "tenant", "quota"})
+	rejections := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "rejections"},
+		[]string{"class_name", "tenant", "quota"})
+	aggregated := func(string) string { return "n/a" }
+	m := NewMetrics(usage, limit, rejections, func(class string) string { return class }, aggregated)
+
+	m.Usage("Article", "tenantA", "objects", 10)
+	m.Limit("Article", "tenantA", "objects", 100)
+	m.Rejected("Article", "tenantA", "objects")
+	m.Rejected("Article", "tenantB", "objects")
+
+	// the gauges of different tenants can not be aggregated
+	assert.Equal(t, 0, testutil.CollectAndCount(usage))
+	assert.Equal(t, 0, testutil.CollectAndCount(limit))
+	assert.Equal(t, float64(2), testutil.ToFloat64(
+		rejections.WithLabelValues("Article", "n/a", "objects")))
+}
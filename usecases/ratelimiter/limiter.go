@@ -26,17 +26,20 @@ func New(maxRequests int) *Limiter {
 	}
 }
 
+// SetMax changes the maximum concurrent requests. Requests which are
+// already running are counted against the new maximum.
+func (l *Limiter) SetMax(maxRequests int) {
+	atomic.StoreInt64(&l.max, int64(maxRequests))
+}
+
 // If there is still room, TryInc, increases the counter and returns true. If
 // there are too many concurrent requests it does not increase the counter and
-// returns false
+// returns false. Requests are counted even without a maximum, so that the
+// maximum can be changed while requests are running.
 func (l *Limiter) TryInc() bool {
-	if l.max <= 0 {
-		return true
-	}
-
 	new := atomic.AddInt64(&l.current, 1)
 
-	if new <= l.max {
+	if max := atomic.LoadInt64(&l.max); max <= 0 || new <= max {
 		return true
 	}
 
@@ -46,10 +49,6 @@ func (l *Limiter) TryInc() bool {
 }
 
 func (l *Limiter) Dec() {
-	if l.max <= 0 {
-		return
-	}
-
 	new := atomic.AddInt64(&l.current, -1)
 	if new < 0 {
 		// Should not happen unless some client called Dec multiple times.
@@ -110,3 +110,19 @@ func BenchmarkLimiter(b *testing.B) {
 		l.Dec()
 	}
 }
+
+func TestLimiterSetMax(t *testing.T) {
+	l := New(-1)
+	assert.True(t, l.TryInc())
+	assert.True(t, l.TryInc())
+
+	// the running requests count against the new maximum
+	l.SetMax(2)
+	assert.False(t, l.TryInc())
+	l.Dec()
+	assert.True(t, l.TryInc())
+
+	l.SetMax(3)
+	assert.True(t, l.TryInc())
+	assert.False(t, l.TryInc())
+}
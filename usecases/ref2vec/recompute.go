@@ -0,0 +1,338 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package ref2vec recomputes the vectors of objects of classes vectorized
+// by a ref2vec module in the background. The vector of such an object is
+// otherwise only calculated when the object or its references are written,
+// so it goes stale when the referenced objects change or age.
+package ref2vec
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/scheduler"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// batchSize is the number of objects read per query of a recomputation
+const batchSize = 100
+
+type schemaManager interface {
+	GetSchemaSkipAuth() schema.Schema
+	NodeName() string
+	CopyShardingState(class string) *sharding.State
+}
+
+type vectorRepo interface {
+	Query(context.Context, *objects.QueryInput) (search.Results, *objects.Error)
+	Object(ctx context.Context, class string, id strfmt.UUID, props search.SelectProperties,
+		additional additional.Properties, repl *additional.ReplicationProperties,
+		tenant string) (*search.Result, error)
+	ObjectByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties,
+		additional additional.Properties, tenant string) (*search.Result, error)
+	PutObject(ctx context.Context, concept *models.Object, vector []float32,
+		repl *additional.ReplicationProperties) error
+}
+
+type modulesProvider interface {
+	RefVectorRecomputeInterval(class *models.Class) time.Duration
+	UpdateVector(ctx context.Context, object *models.Object, class *models.Class,
+		objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
+		logger logrus.FieldLogger) error
+}
+
+type locks interface {
+	LockConnector() (func() error, error)
+}
+
+type queryCache interface {
+	Invalidate(ctx context.Context, class string)
+}
+
+// key identifies the objects recomputed together, the tenant is empty for
+// classes without multi-tenancy
+type key struct {
+	class  string
+	tenant string
+}
+
+// Recomputer periodically recomputes the vectors of classes whose ref2vec
+// module asks for it. Each shard is recomputed by the first node it belongs
+// to only. A nil Recomputer is valid and does nothing.
+type Recomputer struct {
+	schema  schemaManager
+	repo    vectorRepo
+	modules modulesProvider
+	locks   locks
+	cache   queryCache
+	logger  logrus.FieldLogger
+	now     func() time.Time
+	// tick is the interval in which due recomputations are looked for
+	tick time.Duration
+
+	sync.Mutex
+	lastRun map[key]time.Time
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewRecomputer(schema schemaManager, repo vectorRepo, modules modulesProvider,
+	locks locks, cache queryCache, logger logrus.FieldLogger,
+) *Recomputer {
+	return &Recomputer{
+		schema:  schema,
+		repo:    repo,
+		modules: modules,
+		locks:   locks,
+		cache:   cache,
+		logger:  logger.WithField("action", "ref2vec_recompute"),
+		now:     time.Now,
+		tick:    time.Minute,
+		lastRun: map[key]time.Time{},
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start looking for due recomputations. A class is first recomputed one
+// interval after the start.
+func (r *Recomputer) Start() {
+	if r == nil {
+		return
+	}
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				// the searches of recomputations must not slow down queries
+				r.recomputeDue(scheduler.WithClass(context.Background(), scheduler.Bulk))
+			case <-r.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Shutdown stops the recomputations, a running one is completed first
+func (r *Recomputer) Shutdown(ctx context.Context) error {
+	if r == nil {
+		return nil
+	}
+
+	close(r.stop)
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *Recomputer) recomputeDue(ctx context.Context) {
+	for _, class := range r.schema.GetSchemaSkipAuth().Objects.Classes {
+		interval := r.modules.RefVectorRecomputeInterval(class)
+		if interval <= 0 {
+			continue
+		}
+
+		for _, k := range r.ownedKeys(class) {
+			if !r.due(k, interval) {
+				continue
+			}
+
+			updated, err := r.Recompute(ctx, class, k.tenant)
+			logger := r.logger.WithField("class", k.class).WithField("tenant", k.tenant)
+			if err != nil {
+				logger.WithError(err).Error("could not recompute ref2vec vectors")
+				continue
+			}
+			logger.WithField("updated", updated).Debug("recomputed ref2vec vectors")
+		}
+	}
+}
+
+// due records the run if the interval has passed since the last one. The
+// first run is an interval after the key is seen first.
+func (r *Recomputer) due(k key, interval time.Duration) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	now := r.now()
+	last, ok := r.lastRun[k]
+	if !ok {
+		r.lastRun[k] = now
+		return false
+	}
+	if now.Sub(last) < interval {
+		return false
+	}
+	r.lastRun[k] = now
+	return true
+}
+
+// ownedKeys are the tenants of the class this node recomputes. A class
+// without multi-tenancy is queried across all its shards, it is recomputed
+// by the node its first shard belongs to.
+func (r *Recomputer) ownedKeys(class *models.Class) []key {
+	st := r.schema.CopyShardingState(class.Class)
+	if st == nil {
+		return nil
+	}
+
+	node := r.schema.NodeName()
+	names := make([]string, 0, len(st.Physical))
+	for name := range st.Physical {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if !schema.MultiTenancyEnabled(class) {
+		if len(names) == 0 {
+			return nil
+		}
+		physical := st.Physical[names[0]]
+		if len(physical.BelongsToNodes) == 0 || physical.BelongsToNodes[0] != node {
+			return nil
+		}
+		return []key{{class: class.Class}}
+	}
+
+	var keys []key
+	for _, name := range names {
+		physical := st.Physical[name]
+		if physical.ActivityStatus() != models.TenantActivityStatusHOT {
+			continue
+		}
+		if len(physical.BelongsToNodes) == 0 || physical.BelongsToNodes[0] != node {
+			continue
+		}
+		keys = append(keys, key{class: class.Class, tenant: name})
+	}
+	return keys
+}
+
+// Recompute the vectors of all objects of the class and tenant, objects are
+// only written if their vector changed. It returns the number of objects
+// written.
+func (r *Recomputer) Recompute(ctx context.Context, class *models.Class, tenant string) (int, error) {
+	var (
+		updated int
+		cursor  string
+	)
+	for {
+		objs, err := r.batch(ctx, class.Class, tenant, cursor)
+		if err != nil {
+			return updated, err
+		}
+
+		n, err := r.recomputeBatch(ctx, class, tenant, objs)
+		updated += n
+		if err != nil {
+			return updated, err
+		}
+
+		if len(objs) < batchSize {
+			return updated, nil
+		}
+		cursor = objs[len(objs)-1].ID.String()
+	}
+}
+
+func (r *Recomputer) batch(ctx context.Context, class, tenant, after string) ([]*models.Object, error) {
+	res, err := r.repo.Query(ctx, &objects.QueryInput{
+		Class:      class,
+		Limit:      batchSize,
+		Cursor:     &filters.Cursor{After: after, Limit: batchSize},
+		Tenant:     tenant,
+		Additional: additional.Properties{Vector: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query %q: %w", class, err)
+	}
+	return res.ObjectsWithVector(true), nil
+}
+
+func (r *Recomputer) recomputeBatch(ctx context.Context, class *models.Class,
+	tenant string, objs []*models.Object,
+) (int, error) {
+	unlock, err := r.locks.LockConnector()
+	if err != nil {
+		return 0, fmt.Errorf("acquire lock: %w", err)
+	}
+	defer unlock()
+
+	updated := 0
+	for _, obj := range objs {
+		old := obj.Vector
+		obj.Additional = nil
+		obj.Tenant = tenant
+		if err := r.modules.UpdateVector(ctx, obj, class, nil, r.findObject, r.logger); err != nil {
+			return updated, fmt.Errorf("calculate ref vector for '%s/%s': %w",
+				class.Class, obj.ID, err)
+		}
+		if equalVectors(old, obj.Vector) {
+			continue
+		}
+
+		if err := r.repo.PutObject(ctx, obj, obj.Vector, nil); err != nil {
+			return updated, fmt.Errorf("put object '%s/%s': %w", class.Class, obj.ID, err)
+		}
+		updated++
+	}
+
+	if updated > 0 {
+		r.cache.Invalidate(ctx, class.Class)
+	}
+	return updated, nil
+}
+
+func (r *Recomputer) findObject(ctx context.Context, class string,
+	id strfmt.UUID, props search.SelectProperties, addl additional.Properties,
+	tenant string,
+) (*search.Result, error) {
+	// to support backwards compat
+	if class == "" {
+		return r.repo.ObjectByID(ctx, id, props, addl, tenant)
+	}
+	return r.repo.Object(ctx, class, id, props, addl, nil, tenant)
+}
+
+func equalVectors(a, b []float32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,220 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ref2vec
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestRecompute(t *testing.T) {
+	class := &models.Class{Class: "User"}
+	repo := newFakeRepo(250)
+	modules := &fakeModules{vector: func(id strfmt.UUID) []float32 {
+		// every third object has a stale vector
+		if repo.index[id]%3 == 0 {
+			return []float32{2}
+		}
+		return []float32{1}
+	}}
+	cache := &fakeCache{}
+	logger, _ := test.NewNullLogger()
+	r := NewRecomputer(&fakeSchema{}, repo, modules, fakeLocks{}, cache, logger)
+
+	updated, err := r.Recompute(context.Background(), class, "tenant1")
+	require.Nil(t, err)
+
+	assert.Equal(t, 84, updated)
+	assert.Len(t, repo.puts, 84)
+	for _, obj := range repo.puts {
+		assert.Equal(t, models.C11yVector{2}, obj.Vector)
+		assert.Equal(t, "tenant1", obj.Tenant)
+	}
+	assert.Equal(t, 250, modules.calls)
+	assert.Equal(t, 3, cache.invalidations)
+}
+
+func TestRecomputeDue(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	r := NewRecomputer(&fakeSchema{}, nil, nil, fakeLocks{}, nil, logger)
+	now := time.Now()
+	r.now = func() time.Time { return now }
+	k := key{class: "User"}
+
+	assert.False(t, r.due(k, time.Hour), "first seen")
+	now = now.Add(30 * time.Minute)
+	assert.False(t, r.due(k, time.Hour))
+	now = now.Add(30 * time.Minute)
+	assert.True(t, r.due(k, time.Hour))
+	assert.False(t, r.due(k, time.Hour), "just ran")
+}
+
+func TestRecomputeOwnedKeys(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	sch := &fakeSchema{
+		node: "node1",
+		states: map[string]*sharding.State{
+			"Single": {Physical: map[string]sharding.Physical{
+				"a": {BelongsToNodes: []string{"node1", "node2"}},
+				"b": {BelongsToNodes: []string{"node2", "node1"}},
+			}},
+			"Other": {Physical: map[string]sharding.Physical{
+				"a": {BelongsToNodes: []string{"node2", "node1"}},
+				"b": {BelongsToNodes: []string{"node1"}},
+			}},
+			"Multi": {Physical: map[string]sharding.Physical{
+				"t1": {BelongsToNodes: []string{"node1"}},
+				"t2": {BelongsToNodes: []string{"node1"}, Status: models.TenantActivityStatusCOLD},
+				"t3": {BelongsToNodes: []string{"node2", "node1"}},
+				"t4": {BelongsToNodes: []string{"node1", "node2"}},
+			}},
+		},
+	}
+	r := NewRecomputer(sch, nil, nil, fakeLocks{}, nil, logger)
+
+	assert.Equal(t, []key{{class: "Single"}}, r.ownedKeys(&models.Class{Class: "Single"}))
+	assert.Empty(t, r.ownedKeys(&models.Class{Class: "Other"}))
+	assert.Equal(t, []key{{class: "Multi", tenant: "t1"}, {class: "Multi", tenant: "t4"}},
+		r.ownedKeys(&models.Class{
+			Class:              "Multi",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		}))
+	assert.Empty(t, r.ownedKeys(&models.Class{Class: "Missing"}))
+}
+
+func TestNilRecomputer(t *testing.T) {
+	var r *Recomputer
+	r.Start()
+	assert.Nil(t, r.Shutdown(context.Background()))
+}
+
+type fakeSchema struct {
+	node   string
+	states map[string]*sharding.State
+}
+
+func (f *fakeSchema) GetSchemaSkipAuth() schema.Schema {
+	return schema.Schema{Objects: &models.Schema{}}
+}
+
+func (f *fakeSchema) NodeName() string {
+	return f.node
+}
+
+func (f *fakeSchema) CopyShardingState(class string) *sharding.State {
+	return f.states[class]
+}
+
+type fakeRepo struct {
+	ids   []strfmt.UUID
+	index map[strfmt.UUID]int
+	puts  []*models.Object
+}
+
+func newFakeRepo(n int) *fakeRepo {
+	r := &fakeRepo{index: map[strfmt.UUID]int{}}
+	for i := 0; i < n; i++ {
+		id := strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i))
+		r.ids = append(r.ids, id)
+		r.index[id] = i
+	}
+	sort.Slice(r.ids, func(i, j int) bool { return r.ids[i] < r.ids[j] })
+	return r
+}
+
+func (r *fakeRepo) Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error) {
+	var res search.Results
+	for _, id := range r.ids {
+		if string(id) <= q.Cursor.After {
+			continue
+		}
+		if len(res) == q.Limit {
+			break
+		}
+		res = append(res, search.Result{
+			ID:        id,
+			ClassName: q.Class,
+			Schema:    map[string]interface{}{},
+			Vector:    []float32{1},
+		})
+	}
+	return res, nil
+}
+
+func (r *fakeRepo) Object(ctx context.Context, class string, id strfmt.UUID, props search.SelectProperties,
+	additional additional.Properties, repl *additional.ReplicationProperties,
+	tenant string,
+) (*search.Result, error) {
+	return nil, nil
+}
+
+func (r *fakeRepo) ObjectByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties,
+	additional additional.Properties, tenant string,
+) (*search.Result, error) {
+	return nil, nil
+}
+
+func (r *fakeRepo) PutObject(ctx context.Context, concept *models.Object, vector []float32,
+	repl *additional.ReplicationProperties,
+) error {
+	r.puts = append(r.puts, concept)
+	return nil
+}
+
+type fakeModules struct {
+	vector func(id strfmt.UUID) []float32
+	calls  int
+}
+
+func (f *fakeModules) RefVectorRecomputeInterval(class *models.Class) time.Duration {
+	return time.Hour
+}
+
+func (f *fakeModules) UpdateVector(ctx context.Context, object *models.Object, class *models.Class,
+	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
+	logger logrus.FieldLogger,
+) error {
+	f.calls++
+	object.Vector = f.vector(object.ID)
+	return nil
+}
+
+type fakeLocks struct{}
+
+func (fakeLocks) LockConnector() (func() error, error) {
+	return func() error { return nil }, nil
+}
+
+type fakeCache struct {
+	invalidations int
+}
+
+func (f *fakeCache) Invalidate(ctx context.Context, class string) {
+	f.invalidations++
+}
@@ -0,0 +1,470 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"golang.org/x/sync/errgroup"
+)
+
+// Anti-entropy repairs the replicas of a shard in the background, including
+// objects which are never read and therefore never repaired on read. Every
+// replica hashes its objects into the leaves of a hash tree, where an object
+// belongs to the leaf given by the leading bits of its uuid. Only the objects
+// of leaves whose hashes differ are compared one by one.
+
+const (
+	// DefaultHashTreeDepth splits a shard into 1024 leaves
+	DefaultHashTreeDepth = 10
+	// MaxHashTreeDepth limits the number of leaves sent by a replica
+	MaxHashTreeDepth = 16
+
+	// syncBatchSize is the number of objects fetched and overwritten at once
+	syncBatchSize = 100
+)
+
+// ConflictResolution decides what happens to an object which exists on some
+// replicas but has been deleted on others. The order of the operations is
+// unknown: the deletion might have to be propagated, or the object might have
+// been created again after it was deleted.
+type ConflictResolution string
+
+const (
+	// ConflictNone leaves conflicting objects untouched, they are only counted
+	ConflictNone ConflictResolution = "none"
+	// ConflictDelete deletes the object on all replicas
+	ConflictDelete ConflictResolution = "delete"
+	// ConflictRestore restores the object on the replicas which deleted it
+	ConflictRestore ConflictResolution = "restore"
+)
+
+// ValidateConflictResolution fails for unknown conflict resolutions
+func ValidateConflictResolution(c ConflictResolution) error {
+	switch c {
+	case ConflictNone, ConflictDelete, ConflictRestore:
+		return nil
+	default:
+		return fmt.Errorf("unknown conflict resolution %q, must be one of %q, %q or %q",
+			c, ConflictNone, ConflictDelete, ConflictRestore)
+	}
+}
+
+// ValidateHashTreeDepth fails for depths out of the range [0, MaxHashTreeDepth]
+func ValidateHashTreeDepth(depth int) error {
+	if depth < 0 || depth > MaxHashTreeDepth {
+		return fmt.Errorf("hash tree depth %d out of range [0, %d]", depth, MaxHashTreeDepth)
+	}
+	return nil
+}
+
+// HashTreeRequest is sent to replicas to request their hash tree or the
+// digests of a single leaf
+type HashTreeRequest struct {
+	Depth int `json:"depth"`
+	Leaf  int `json:"leaf,omitempty"`
+}
+
+// SyncOptions configure a single run of SyncShard
+type SyncOptions struct {
+	// Depth of the hash tree, zero stands for DefaultHashTreeDepth
+	Depth int
+	// Conflicts defaults to ConflictNone
+	Conflicts ConflictResolution
+	// Limiter limits the bandwidth used to transfer objects, it may be nil
+	Limiter *Limiter
+}
+
+// SyncProgress is updated while SyncShard is running. It is safe to read it
+// concurrently.
+type SyncProgress struct {
+	LeavesTotal     atomic.Int64
+	LeavesCompared  atomic.Int64
+	LeavesDiverged  atomic.Int64
+	ObjectsCompared atomic.Int64
+	ObjectsRepaired atomic.Int64
+	Conflicts       atomic.Int64
+	Bytes           atomic.Int64
+}
+
+// SyncStats is a snapshot of SyncProgress
+type SyncStats struct {
+	LeavesTotal     int64 `json:"leavesTotal"`
+	LeavesCompared  int64 `json:"leavesCompared"`
+	LeavesDiverged  int64 `json:"leavesDiverged"`
+	ObjectsCompared int64 `json:"objectsCompared"`
+	ObjectsRepaired int64 `json:"objectsRepaired"`
+	Conflicts       int64 `json:"conflicts"`
+	Bytes           int64 `json:"bytes"`
+}
+
+// Stats returns the current progress
+func (p *SyncProgress) Stats() SyncStats {
+	return SyncStats{
+		LeavesTotal:     p.LeavesTotal.Load(),
+		LeavesCompared:  p.LeavesCompared.Load(),
+		LeavesDiverged:  p.LeavesDiverged.Load(),
+		ObjectsCompared: p.ObjectsCompared.Load(),
+		ObjectsRepaired: p.ObjectsRepaired.Load(),
+		Conflicts:       p.Conflicts.Load(),
+		Bytes:           p.Bytes.Load(),
+	}
+}
+
+// SyncShard compares all replicas of a shard and repairs the objects which
+// differ. The most recent copy of an object is written to the replicas which
+// miss it or hold an older one. All replicas must be reachable.
+func (r *Replicator) SyncShard(ctx context.Context, shard string,
+	opts SyncOptions, progress *SyncProgress,
+) error {
+	if progress == nil {
+		progress = &SyncProgress{}
+	}
+	if opts.Depth == 0 {
+		opts.Depth = DefaultHashTreeDepth
+	}
+	if opts.Conflicts == "" {
+		opts.Conflicts = ConflictNone
+	}
+	if err := ValidateHashTreeDepth(opts.Depth); err != nil {
+		return err
+	}
+	if err := ValidateConflictResolution(opts.Conflicts); err != nil {
+		return err
+	}
+
+	st, err := r.resolver.State(shard, All, "")
+	if err != nil {
+		return fmt.Errorf("resolve replicas of shard %q: %w", shard, err)
+	}
+	hosts := st.Hosts
+	nLeaves := 1 << opts.Depth
+	progress.LeavesTotal.Store(int64(nLeaves))
+	if len(hosts) < 2 {
+		progress.LeavesCompared.Store(int64(nLeaves))
+		return nil
+	}
+
+	trees := make([][]uint64, len(hosts))
+	gr, gctx := errgroup.WithContext(ctx)
+	for i, host := range hosts {
+		i, host := i, host
+		gr.Go(func() error {
+			leaves, err := r.client.HashTreeLeaves(gctx, host, r.class, shard, opts.Depth)
+			if err == nil && len(leaves) != nLeaves {
+				err = fmt.Errorf("malformed hash tree: length expected %d got %d", nLeaves, len(leaves))
+			}
+			if err != nil {
+				return fmt.Errorf("hash tree of %q: %w", host, err)
+			}
+			trees[i] = leaves
+			return nil
+		})
+	}
+	if err := gr.Wait(); err != nil {
+		return err
+	}
+
+	s := shardSync{Replicator: r, shard: shard, hosts: hosts, opts: opts, progress: progress}
+	for leaf := 0; leaf < nLeaves; leaf++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		diverged := false
+		for i := 1; i < len(trees); i++ {
+			if trees[i][leaf] != trees[0][leaf] {
+				diverged = true
+				break
+			}
+		}
+		if diverged {
+			progress.LeavesDiverged.Add(1)
+			if err := s.syncLeaf(ctx, leaf); err != nil {
+				return fmt.Errorf("leaf %d: %w", leaf, err)
+			}
+		}
+		progress.LeavesCompared.Add(1)
+	}
+	return nil
+}
+
+// shardSync holds the state of a single run of SyncShard
+type shardSync struct {
+	*Replicator
+	shard    string
+	hosts    []string
+	opts     SyncOptions
+	progress *SyncProgress
+}
+
+// syncLeaf compares and repairs the objects of a single leaf
+func (s *shardSync) syncLeaf(ctx context.Context, leaf int) error {
+	digests := make([][]RepairResponse, len(s.hosts))
+	gr, gctx := errgroup.WithContext(ctx)
+	for i, host := range s.hosts {
+		i, host := i, host
+		gr.Go(func() error {
+			xs, err := s.client.LeafDigests(gctx, host, s.class, s.shard, s.opts.Depth, leaf)
+			if err != nil {
+				return fmt.Errorf("digests of %q: %w", host, err)
+			}
+			digests[i] = xs
+			return nil
+		})
+	}
+	if err := gr.Wait(); err != nil {
+		return err
+	}
+
+	// votes[id][i] is the digest of the object sent by host i, nil if missing
+	votes := map[strfmt.UUID][]*RepairResponse{}
+	for i, xs := range digests {
+		for j := range xs {
+			id := strfmt.UUID(xs[j].ID)
+			if votes[id] == nil {
+				votes[id] = make([]*RepairResponse, len(s.hosts))
+			}
+			votes[id][i] = &xs[j]
+		}
+	}
+	s.progress.ObjectsCompared.Add(int64(len(votes)))
+
+	// objects missing on a host might have been deleted there
+	deleted := make([]map[strfmt.UUID]bool, len(s.hosts))
+	for i, host := range s.hosts {
+		var missing []strfmt.UUID
+		for id, vs := range votes {
+			if vs[i] == nil {
+				missing = append(missing, id)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		xs, err := s.client.DigestObjects(ctx, host, s.class, s.shard, missing)
+		if err != nil {
+			return fmt.Errorf("digests of missing objects of %q: %w", host, err)
+		}
+		deleted[i] = make(map[strfmt.UUID]bool, len(xs))
+		for _, x := range xs {
+			if x.Deleted {
+				deleted[i][strfmt.UUID(x.ID)] = true
+			}
+		}
+	}
+
+	// repairs[winner] are the objects to be copied from host winner
+	repairs := map[int][]syncRepair{}
+	ids := make([]strfmt.UUID, 0, len(votes))
+	for id := range votes {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		vs := votes[id]
+		winner, conflict := -1, false
+		for i, v := range vs {
+			if v == nil {
+				conflict = conflict || deleted[i][id]
+				continue
+			}
+			if winner == -1 || v.UpdateTime > vs[winner].UpdateTime {
+				winner = i
+			}
+		}
+		if conflict {
+			s.progress.Conflicts.Add(1)
+			switch s.opts.Conflicts {
+			case ConflictNone:
+				continue
+			case ConflictDelete:
+				if err := s.DeleteObject(ctx, s.shard, id, All); err != nil {
+					return fmt.Errorf("delete conflicting object %s: %w", id, err)
+				}
+				s.progress.ObjectsRepaired.Add(1)
+				continue
+			}
+		}
+
+		x := syncRepair{id: id, stale: map[int]int64{}}
+		for i, v := range vs {
+			switch {
+			case v == nil:
+				x.stale[i] = 0
+			case v.UpdateTime < vs[winner].UpdateTime:
+				x.stale[i] = v.UpdateTime
+			}
+		}
+		if len(x.stale) > 0 {
+			repairs[winner] = append(repairs[winner], x)
+		}
+	}
+
+	for winner, xs := range repairs {
+		for len(xs) > 0 {
+			n := len(xs)
+			if n > syncBatchSize {
+				n = syncBatchSize
+			}
+			if err := s.repair(ctx, winner, xs[:n]); err != nil {
+				return err
+			}
+			xs = xs[n:]
+		}
+	}
+	return nil
+}
+
+// syncRepair is an object to be copied to the stale replicas
+type syncRepair struct {
+	id    strfmt.UUID
+	stale map[int]int64 // update time of the stale copy by host, 0 if missing
+}
+
+// repair copies objects from the host winner to the stale replicas. Copies
+// which changed in the meantime are left untouched by the replicas.
+func (s *shardSync) repair(ctx context.Context, winner int, xs []syncRepair) error {
+	ids := make([]strfmt.UUID, len(xs))
+	for i, x := range xs {
+		ids[i] = x.id
+	}
+	host := s.hosts[winner]
+	replicas, err := s.client.FetchObjects(ctx, host, s.class, s.shard, ids)
+	if err == nil && len(replicas) != len(ids) {
+		err = fmt.Errorf("malformed full read response: length expected %d got %d", len(ids), len(replicas))
+	}
+	if err != nil {
+		return fmt.Errorf("fetch objects from %q: %w", host, err)
+	}
+
+	updates := map[int][]*objects.VObject{}
+	for i, x := range xs {
+		r := replicas[i]
+		if r.Deleted || r.Object == nil {
+			continue // deleted since it was compared
+		}
+		size := 0
+		if b, err := r.Object.MarshalBinary(); err == nil {
+			size = len(b)
+		}
+		size *= 1 + len(x.stale)
+		if err := s.opts.Limiter.Wait(ctx, size); err != nil {
+			return err
+		}
+		s.progress.Bytes.Add(int64(size))
+		for h, updateTime := range x.stale {
+			updates[h] = append(updates[h], &objects.VObject{
+				LatestObject:    &r.Object.Object,
+				Vector:          r.Object.Vector,
+				StaleUpdateTime: updateTime,
+			})
+		}
+	}
+
+	gr, gctx := errgroup.WithContext(ctx)
+	for h, vs := range updates {
+		host, vs := s.hosts[h], vs
+		gr.Go(func() error {
+			resp, err := s.client.OverwriteObjects(gctx, host, s.class, s.shard, vs)
+			if err != nil {
+				return fmt.Errorf("overwrite objects on %q: %w", host, err)
+			}
+			failed := 0
+			for _, r := range resp {
+				if r.Err != "" {
+					failed++
+				}
+			}
+			s.progress.ObjectsRepaired.Add(int64(len(vs) - failed))
+			if failed > 0 {
+				s.log.WithField("op", "sync").WithField("class", s.class).
+					WithField("shard", s.shard).WithField("host", host).
+					Debugf("%d objects changed during repair", failed)
+			}
+			return nil
+		})
+	}
+	return gr.Wait()
+}
+
+// Limiter limits the bandwidth used to transfer objects between replicas.
+// The limit can be changed while transfers are running. It is safe for
+// concurrent use.
+type Limiter struct {
+	sync.Mutex
+	limit int64     // bytes per second, <= 0 disables the limiter
+	next  time.Time // when the transfers reserved so far are done
+}
+
+// NewLimiter creates a limiter, a limit <= 0 disables it
+func NewLimiter(bytesPerSecond int64) *Limiter {
+	return &Limiter{limit: bytesPerSecond}
+}
+
+// Limit returns the current limit in bytes per second
+func (l *Limiter) Limit() int64 {
+	if l == nil {
+		return 0
+	}
+	l.Lock()
+	defer l.Unlock()
+	return l.limit
+}
+
+// SetLimit changes the limit, a limit <= 0 disables the limiter
+func (l *Limiter) SetLimit(bytesPerSecond int64) {
+	if l == nil {
+		return
+	}
+	l.Lock()
+	defer l.Unlock()
+	l.limit = bytesPerSecond
+	l.next = time.Time{}
+}
+
+// Wait blocks until n bytes may be transferred
+func (l *Limiter) Wait(ctx context.Context, n int) error {
+	if l == nil {
+		return nil
+	}
+	l.Lock()
+	if l.limit <= 0 {
+		l.Unlock()
+		return nil
+	}
+	now := time.Now()
+	start := l.next
+	if start.Before(now) {
+		start = now
+	}
+	l.next = start.Add(time.Duration(float64(n) / float64(l.limit) * float64(time.Second)))
+	l.Unlock()
+
+	if start == now {
+		return nil
+	}
+	t := time.NewTimer(start.Sub(now))
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
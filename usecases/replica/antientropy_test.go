@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestSyncShard(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		cls   = "C1"
+		shard = "S"
+		nodes = []string{"A", "B", "C"}
+		id1   = strfmt.UUID("8f4b0e8a-0f3c-4d0a-9d5e-1c1f4b3a2e01")
+		id2   = strfmt.UUID("8f4b0e8a-0f3c-4d0a-9d5e-1c1f4b3a2e02")
+		id3   = strfmt.UUID("8f4b0e8a-0f3c-4d0a-9d5e-1c1f4b3a2e03")
+	)
+
+	digest := func(id strfmt.UUID, updateTime int64) RepairResponse {
+		return RepairResponse{ID: id.String(), UpdateTime: updateTime}
+	}
+	vobject := func(r objects.Replica, staleTime int64) []*objects.VObject {
+		return []*objects.VObject{{
+			LatestObject:    &r.Object.Object,
+			Vector:          r.Object.Vector,
+			StaleUpdateTime: staleTime,
+		}}
+	}
+	// id1 is stale on B and missing on C, id3 exists on C only but has
+	// been deleted on B
+	newFactory := func() *fakeFactory {
+		f := newFakeFactory(cls, shard, nodes)
+		f.RClient.On("HashTreeLeaves", mock.Anything, "A", cls, shard, 1).Return([]uint64{1, 5}, nil)
+		f.RClient.On("HashTreeLeaves", mock.Anything, "B", cls, shard, 1).Return([]uint64{1, 6}, nil)
+		f.RClient.On("HashTreeLeaves", mock.Anything, "C", cls, shard, 1).Return([]uint64{1, 7}, nil)
+		f.RClient.On("LeafDigests", mock.Anything, "A", cls, shard, 1, 1).
+			Return([]RepairResponse{digest(id1, 10), digest(id2, 5)}, nil)
+		f.RClient.On("LeafDigests", mock.Anything, "B", cls, shard, 1, 1).
+			Return([]RepairResponse{digest(id1, 8), digest(id2, 5)}, nil)
+		f.RClient.On("LeafDigests", mock.Anything, "C", cls, shard, 1, 1).
+			Return([]RepairResponse{digest(id2, 5), digest(id3, 3)}, nil)
+		f.RClient.On("DigestObjects", mock.Anything, "A", cls, shard, []strfmt.UUID{id3}).
+			Return([]RepairResponse{{ID: id3.String()}}, nil)
+		f.RClient.On("DigestObjects", mock.Anything, "B", cls, shard, []strfmt.UUID{id3}).
+			Return([]RepairResponse{{ID: id3.String(), Deleted: true}}, nil)
+		f.RClient.On("DigestObjects", mock.Anything, "C", cls, shard, []strfmt.UUID{id1}).
+			Return([]RepairResponse{{ID: id1.String()}}, nil)
+		return f
+	}
+
+	t.Run("stale and missing objects are repaired", func(t *testing.T) {
+		f := newFactory()
+		r1 := replica(id1, 10, false)
+		r1.Object.MarshallerVersion = 1
+		f.RClient.On("FetchObjects", mock.Anything, "A", cls, shard, []strfmt.UUID{id1}).
+			Return([]objects.Replica{r1}, nil)
+		f.RClient.On("OverwriteObjects", mock.Anything, "B", cls, shard, vobject(r1, 8)).
+			Return([]RepairResponse{}, nil).Once()
+		f.RClient.On("OverwriteObjects", mock.Anything, "C", cls, shard, vobject(r1, 0)).
+			Return([]RepairResponse{}, nil).Once()
+
+		progress := &SyncProgress{}
+		err := f.newReplicator().SyncShard(ctx, shard, SyncOptions{Depth: 1}, progress)
+		require.Nil(t, err)
+		f.RClient.AssertExpectations(t)
+
+		stats := progress.Stats()
+		assert.Greater(t, stats.Bytes, int64(0))
+		stats.Bytes = 0
+		assert.Equal(t, SyncStats{
+			LeavesTotal:     2,
+			LeavesCompared:  2,
+			LeavesDiverged:  1,
+			ObjectsCompared: 3,
+			ObjectsRepaired: 2,
+			Conflicts:       1,
+		}, stats)
+	})
+
+	t.Run("deleted objects are restored", func(t *testing.T) {
+		f := newFactory()
+		r1, r3 := replica(id1, 10, false), replica(id3, 3, false)
+		f.RClient.On("FetchObjects", mock.Anything, "A", cls, shard, []strfmt.UUID{id1}).
+			Return([]objects.Replica{r1}, nil)
+		f.RClient.On("FetchObjects", mock.Anything, "C", cls, shard, []strfmt.UUID{id3}).
+			Return([]objects.Replica{r3}, nil)
+		f.RClient.On("OverwriteObjects", mock.Anything, "B", cls, shard, vobject(r1, 8)).
+			Return([]RepairResponse{}, nil).Once()
+		f.RClient.On("OverwriteObjects", mock.Anything, "C", cls, shard, vobject(r1, 0)).
+			Return([]RepairResponse{}, nil).Once()
+		f.RClient.On("OverwriteObjects", mock.Anything, "A", cls, shard, vobject(r3, 0)).
+			Return([]RepairResponse{}, nil).Once()
+		f.RClient.On("OverwriteObjects", mock.Anything, "B", cls, shard, vobject(r3, 0)).
+			Return([]RepairResponse{{ID: id3.String(), Err: "conflict"}}, nil).Once()
+
+		progress := &SyncProgress{}
+		opts := SyncOptions{Depth: 1, Conflicts: ConflictRestore}
+		err := f.newReplicator().SyncShard(ctx, shard, opts, progress)
+		require.Nil(t, err)
+		f.RClient.AssertExpectations(t)
+		assert.Equal(t, int64(3), progress.ObjectsRepaired.Load())
+		assert.Equal(t, int64(1), progress.Conflicts.Load())
+	})
+
+	t.Run("equal replicas", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		for _, host := range nodes {
+			f.RClient.On("HashTreeLeaves", mock.Anything, host, cls, shard, 2).
+				Return([]uint64{1, 2, 3, 4}, nil)
+		}
+
+		progress := &SyncProgress{}
+		err := f.newReplicator().SyncShard(ctx, shard, SyncOptions{Depth: 2}, progress)
+		require.Nil(t, err)
+		assert.Equal(t, SyncStats{LeavesTotal: 4, LeavesCompared: 4}, progress.Stats())
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		r := newFakeFactory(cls, shard, nodes).newReplicator()
+		err := r.SyncShard(ctx, shard, SyncOptions{Depth: MaxHashTreeDepth + 1}, nil)
+		assert.ErrorContains(t, err, "depth")
+		err = r.SyncShard(ctx, shard, SyncOptions{Conflicts: "newest"}, nil)
+		assert.ErrorContains(t, err, "conflict resolution")
+	})
+}
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disabled", func(t *testing.T) {
+		var nilLimiter *Limiter
+		assert.Nil(t, nilLimiter.Wait(ctx, 1<<30))
+		assert.Nil(t, NewLimiter(0).Wait(ctx, 1<<30))
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		l := NewLimiter(1000)
+		start := time.Now()
+		require.Nil(t, l.Wait(ctx, 50))
+		require.Nil(t, l.Wait(ctx, 50))
+		assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+		cctx, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, l.Wait(cctx, 50), context.Canceled)
+
+		l.SetLimit(0)
+		assert.Equal(t, int64(0), l.Limit())
+		assert.Nil(t, l.Wait(ctx, 1<<30))
+	})
+}
@@ -47,8 +47,13 @@ func ValidateConfigUpdate(old, updated *models.Class, nodeCounter nodeCounter) e
 		old.ReplicationConfig = &models.ReplicationConfig{Factor: 1}
 	}
 
+	// an omitted factor keeps the current one, since lowering the factor
+	// removes replicas
 	if updated.ReplicationConfig == nil {
-		updated.ReplicationConfig = &models.ReplicationConfig{Factor: 1}
+		updated.ReplicationConfig = &models.ReplicationConfig{Factor: old.ReplicationConfig.Factor}
+	}
+	if updated.ReplicationConfig.Factor < 1 {
+		updated.ReplicationConfig.Factor = old.ReplicationConfig.Factor
 	}
 
 	if old.ReplicationConfig.Factor != updated.ReplicationConfig.Factor {
@@ -88,6 +88,7 @@ func Test_ValidateConfigUpdate(t *testing.T) {
 		name          string
 		initial       *models.ReplicationConfig
 		update        *models.ReplicationConfig
+		expected      int64
 		expectedError error
 	}{
 		{
@@ -97,16 +98,35 @@ func Test_ValidateConfigUpdate(t *testing.T) {
 			expectedError: fmt.Errorf(
 				"cannot scale to 4 replicas, cluster has only 3 nodes"),
 		},
+		{
+			name:     "decreasing replicas",
+			initial:  &models.ReplicationConfig{Factor: 3},
+			update:   &models.ReplicationConfig{Factor: 1},
+			expected: 1,
+		},
+		{
+			name:     "omitted factor keeps the current one",
+			initial:  &models.ReplicationConfig{Factor: 3},
+			update:   &models.ReplicationConfig{},
+			expected: 3,
+		},
+		{
+			name:     "omitted config keeps the current factor",
+			initial:  &models.ReplicationConfig{Factor: 2},
+			expected: 2,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
+			updated := &models.Class{ReplicationConfig: test.update}
 			err := ValidateConfigUpdate(
 				&models.Class{ReplicationConfig: test.initial},
-				&models.Class{ReplicationConfig: test.update},
+				updated,
 				&fakeNodeCounter{3})
 			if test.expectedError == nil {
 				assert.Nil(t, err)
+				assert.Equal(t, test.expected, updated.ReplicationConfig.Factor)
 			} else {
 				require.NotNil(t, err, "update validation must error")
 				assert.Equal(t, test.expectedError.Error(), err.Error())
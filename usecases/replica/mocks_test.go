@@ -56,6 +56,20 @@ func (f *fakeRClient) DigestObjects(ctx context.Context, host, index, shard stri
 	return args.Get(0).([]RepairResponse), args.Error(1)
 }
 
+func (f *fakeRClient) HashTreeLeaves(ctx context.Context, host, index, shard string,
+	depth int,
+) ([]uint64, error) {
+	args := f.Called(ctx, host, index, shard, depth)
+	return args.Get(0).([]uint64), args.Error(1)
+}
+
+func (f *fakeRClient) LeafDigests(ctx context.Context, host, index, shard string,
+	depth, leaf int,
+) ([]RepairResponse, error) {
+	args := f.Called(ctx, host, index, shard, depth, leaf)
+	return args.Get(0).([]RepairResponse), args.Error(1)
+}
+
 type fakeClient struct {
 	mock.Mock
 }
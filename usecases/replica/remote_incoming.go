@@ -46,6 +46,11 @@ type RemoteIncomingRepo interface {
 		shardName string, ids []strfmt.UUID) ([]objects.Replica, error)
 	DigestObjects(ctx context.Context, class, shardName string,
 		ids []strfmt.UUID) (result []RepairResponse, err error)
+	// Anti-entropy endpoints
+	HashTreeLeaves(ctx context.Context, class, shardName string,
+		depth int) ([]uint64, error)
+	LeafDigests(ctx context.Context, class, shardName string,
+		depth, leaf int) ([]RepairResponse, error)
 }
 
 type RemoteReplicaIncoming struct {
@@ -129,3 +134,15 @@ func (rri *RemoteReplicaIncoming) DigestObjects(ctx context.Context,
 ) (result []RepairResponse, err error) {
 	return rri.repo.DigestObjects(ctx, indexName, shardName, ids)
 }
+
+func (rri *RemoteReplicaIncoming) HashTreeLeaves(ctx context.Context,
+	indexName, shardName string, depth int,
+) ([]uint64, error) {
+	return rri.repo.HashTreeLeaves(ctx, indexName, shardName, depth)
+}
+
+func (rri *RemoteReplicaIncoming) LeafDigests(ctx context.Context,
+	indexName, shardName string, depth, leaf int,
+) ([]RepairResponse, error) {
+	return rri.repo.LeafDigests(ctx, indexName, shardName, depth, leaf)
+}
@@ -38,6 +38,24 @@ func cLevel(l ConsistencyLevel, n int) int {
 	}
 }
 
+// ReadLevelAfter returns the lowest level at which a read involves at least
+// one replica which acknowledged a write made at level w
+func ReadLevelAfter(w ConsistencyLevel) ConsistencyLevel {
+	switch w {
+	case All:
+		return One
+	case One:
+		return All
+	default:
+		return Quorum
+	}
+}
+
+// Stronger tells if level l involves more replicas than level o
+func (l ConsistencyLevel) Stronger(o ConsistencyLevel) bool {
+	return cLevel(l, 3) > cLevel(o, 3)
+}
+
 var (
 	errNoReplicaFound = errors.New("no replica found")
 	errUnresolvedName = errors.New("unresolved node name")
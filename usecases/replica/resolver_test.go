@@ -111,3 +111,14 @@ func TestResolver(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestReadLevelAfter(t *testing.T) {
+	assert.Equal(t, All, ReadLevelAfter(One))
+	assert.Equal(t, Quorum, ReadLevelAfter(Quorum))
+	assert.Equal(t, One, ReadLevelAfter(All))
+
+	assert.True(t, All.Stronger(Quorum))
+	assert.True(t, Quorum.Stronger(One))
+	assert.False(t, Quorum.Stronger(Quorum))
+	assert.False(t, One.Stronger(All))
+}
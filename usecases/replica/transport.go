@@ -202,6 +202,15 @@ type rClient interface {
 	// object
 	DigestObjects(ctx context.Context, host, index, shard string,
 		ids []strfmt.UUID) ([]RepairResponse, error)
+
+	// HashTreeLeaves returns the hashes of the leaves of the hash tree of a
+	// shard. It is used by anti-entropy to find the objects which differ.
+	HashTreeLeaves(ctx context.Context, host, index, shard string,
+		depth int) ([]uint64, error)
+
+	// LeafDigests returns the digests of the objects of a single leaf
+	LeafDigests(ctx context.Context, host, index, shard string,
+		depth, leaf int) ([]RepairResponse, error)
 }
 
 // finderClient extends RClient with consistency checks
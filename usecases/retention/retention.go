@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package retention drops the temporal partitions of classes once they are
+// older than the retention of their class. A partition is a tenant, so it is
+// dropped as a whole instead of deleting its objects one by one.
+package retention
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type schemaManager interface {
+	GetSchemaSkipAuth() schema.Schema
+	Nodes() []string
+	NodeName() string
+	CopyShardingState(class string) *sharding.State
+	DropPartitions(ctx context.Context, class string, names []string) error
+}
+
+// Manager enforces the retention of temporal partitions in the configured
+// interval. A nil Manager is valid and does nothing.
+type Manager struct {
+	interval time.Duration
+	schema   schemaManager
+	logger   logrus.FieldLogger
+	now      func() time.Time
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewManager(interval time.Duration, schema schemaManager, logger logrus.FieldLogger) *Manager {
+	return &Manager{
+		interval: interval,
+		schema:   schema,
+		logger:   logger.WithField("action", "partition_retention"),
+		now:      time.Now,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start enforcing the retention in the configured interval
+func (m *Manager) Start() {
+	if m == nil {
+		return
+	}
+
+	go func() {
+		defer close(m.done)
+
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.dropExpired(context.Background())
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Shutdown stops enforcing the retention, a running drop is completed first
+func (m *Manager) Shutdown(ctx context.Context) error {
+	if m == nil {
+		return nil
+	}
+
+	close(m.stop)
+	select {
+	case <-m.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// dropExpired drops the expired partitions of all classes. Dropping tenants
+// is a cluster-wide transaction, so only the first node of the cluster
+// enforces the retention.
+func (m *Manager) dropExpired(ctx context.Context) {
+	if !m.responsible() {
+		return
+	}
+
+	for _, class := range m.schema.GetSchemaSkipAuth().Objects.Classes {
+		if !schema.TemporalPartitioningEnabled(class) ||
+			class.TemporalPartitioningConfig.RetentionDays <= 0 {
+			continue
+		}
+
+		expired := m.expiredPartitions(class)
+		if len(expired) == 0 {
+			continue
+		}
+
+		if err := m.schema.DropPartitions(ctx, class.Class, expired); err != nil {
+			m.logger.WithField("class", class.Class).WithError(err).
+				Error("could not drop expired partitions")
+			continue
+		}
+		m.logger.WithField("class", class.Class).WithField("partitions", expired).
+			Info("dropped expired partitions")
+	}
+}
+
+func (m *Manager) responsible() bool {
+	nodes := m.schema.Nodes()
+	if len(nodes) == 0 {
+		return true
+	}
+	sort.Strings(nodes)
+	return nodes[0] == m.schema.NodeName()
+}
+
+// expiredPartitions of the class are those whose time range ended more than
+// the retention ago. Tenants which are not named like partitions are kept.
+func (m *Manager) expiredPartitions(class *models.Class) []string {
+	st := m.schema.CopyShardingState(class.Class)
+	if st == nil {
+		return nil
+	}
+
+	cfg := class.TemporalPartitioningConfig
+	cutoff := m.now().AddDate(0, 0, -int(cfg.RetentionDays))
+	var expired []string
+	for name := range st.Physical {
+		_, end, ok := schema.TemporalPartitionRange(cfg, name)
+		if ok && !end.After(cutoff) {
+			expired = append(expired, name)
+		}
+	}
+	sort.Strings(expired)
+	return expired
+}
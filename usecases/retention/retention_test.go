@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeSchemaManager struct {
+	classes []*models.Class
+	states  map[string]*sharding.State
+	nodes   []string
+	node    string
+	dropped map[string][]string
+	err     error
+}
+
+func (f *fakeSchemaManager) GetSchemaSkipAuth() schema.Schema {
+	return schema.Schema{Objects: &models.Schema{Classes: f.classes}}
+}
+
+func (f *fakeSchemaManager) Nodes() []string { return f.nodes }
+
+func (f *fakeSchemaManager) NodeName() string { return f.node }
+
+func (f *fakeSchemaManager) CopyShardingState(class string) *sharding.State {
+	return f.states[class]
+}
+
+func (f *fakeSchemaManager) DropPartitions(ctx context.Context, class string, names []string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.dropped[class] = append(f.dropped[class], names...)
+	return nil
+}
+
+func TestDropExpired(t *testing.T) {
+	partitioned := func(name, interval string, retentionDays int64) *models.Class {
+		return &models.Class{
+			Class:              name,
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+			TemporalPartitioningConfig: &models.TemporalPartitioningConfig{
+				Property:      "timestamp",
+				Interval:      interval,
+				RetentionDays: retentionDays,
+			},
+		}
+	}
+	state := func(names ...string) *sharding.State {
+		st := &sharding.State{PartitioningEnabled: true, Physical: map[string]sharding.Physical{}}
+		for _, name := range names {
+			st.Physical[name] = sharding.Physical{Name: name}
+		}
+		return st
+	}
+	newManager := func(sm *fakeSchemaManager) *Manager {
+		logger, _ := test.NewNullLogger()
+		m := NewManager(time.Hour, sm, logger)
+		m.now = func() time.Time { return time.Date(2023, 10, 15, 12, 0, 0, 0, time.UTC) }
+		return m
+	}
+	schemaManager := func() *fakeSchemaManager {
+		return &fakeSchemaManager{
+			classes: []*models.Class{
+				partitioned("Log", "month", 30),
+				partitioned("Event", "day", 2),
+				partitioned("Metric", "month", 0),
+				{Class: "Article"},
+			},
+			states: map[string]*sharding.State{
+				// september ended 14.5 days ago, august more than 30 days ago
+				"Log":    state("2023-08", "2023-09", "2023-10", "archive"),
+				"Event":  state("2023-10-11", "2023-10-12", "2023-10-13", "2023-10-14"),
+				"Metric": state("2020-01"),
+			},
+			nodes:   []string{"node2", "node1"},
+			node:    "node1",
+			dropped: map[string][]string{},
+		}
+	}
+
+	t.Run("drops partitions older than the retention", func(t *testing.T) {
+		sm := schemaManager()
+		newManager(sm).dropExpired(context.Background())
+		assert.Equal(t, map[string][]string{
+			"Log":   {"2023-08"},
+			"Event": {"2023-10-11", "2023-10-12"},
+		}, sm.dropped)
+	})
+
+	t.Run("only the first node enforces the retention", func(t *testing.T) {
+		sm := schemaManager()
+		sm.node = "node2"
+		newManager(sm).dropExpired(context.Background())
+		assert.Empty(t, sm.dropped)
+	})
+
+	t.Run("errors are logged", func(t *testing.T) {
+		sm := schemaManager()
+		sm.err = errors.New("transaction failed")
+		newManager(sm).dropExpired(context.Background())
+		assert.Empty(t, sm.dropped)
+	})
+
+	t.Run("nil manager", func(t *testing.T) {
+		var m *Manager
+		m.Start()
+		assert.Nil(t, m.Shutdown(context.Background()))
+	})
+}
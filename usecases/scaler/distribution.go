@@ -76,6 +76,15 @@ func (m ShardDist) shards() []string {
 	return ns
 }
 
+// replicas returns the number of new shard replicas
+func (m ShardDist) replicas() int64 {
+	var n int64
+	for _, nodes := range m {
+		n += int64(len(nodes))
+	}
+	return n
+}
+
 // difference returns elements in xs which doesn't exists in ys
 func difference(xs, ys []string) []string {
 	m := make(map[string]struct{}, len(ys))
@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/usecases/replica"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -45,6 +46,8 @@ type rsync struct {
 	client          client
 	cluster         cluster
 	persistenceRoot string
+	limiter         *replica.Limiter // optional
+	progress        *Progress        // optional
 }
 
 func newRSync(c client, cl cluster, rootPath string) *rsync {
@@ -122,5 +125,29 @@ func (r *rsync) PutFile(ctx context.Context, sourceFileName string,
 		return fmt.Errorf("open file %q for reading: %w", absPath, err)
 	}
 
-	return r.client.PutFile(ctx, hostname, className, shardName, sourceFileName, f)
+	if r.limiter == nil && r.progress == nil {
+		return r.client.PutFile(ctx, hostname, className, shardName, sourceFileName, f)
+	}
+	payload := &throttledFile{ReadSeekCloser: f, ctx: ctx, limiter: r.limiter, progress: r.progress}
+	return r.client.PutFile(ctx, hostname, className, shardName, sourceFileName, payload)
+}
+
+// throttledFile limits the rate at which a file is read and counts the
+// bytes read
+type throttledFile struct {
+	io.ReadSeekCloser
+	ctx      context.Context
+	limiter  *replica.Limiter
+	progress *Progress
+}
+
+func (f *throttledFile) Read(p []byte) (int, error) {
+	n, err := f.ReadSeekCloser.Read(p)
+	if n > 0 {
+		if werr := f.limiter.Wait(f.ctx, n); werr != nil {
+			return n, werr
+		}
+		f.progress.sent(int64(n))
+	}
+	return n, err
 }
@@ -15,11 +15,13 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"sync/atomic"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"golang.org/x/sync/errgroup"
 )
@@ -30,8 +32,6 @@ import (
 // We could concurrently sync same files to different nodes  while avoiding overlapping
 //
 // 2. To fail fast, we might consider creating all shards at once and re-initialize them in the final step
-//
-// 3. implement scaler.scaleIn
 
 var (
 	// ErrUnresolvedName cannot resolve the host address of a node
@@ -41,7 +41,9 @@ var (
 
 // Scaler scales out/in class replicas.
 //
-// It scales out a class by replicating its shards on new replicas
+// It scales out a class by replicating its shards on new replicas and scales
+// in a class by removing replicas from the sharding state. The removed
+// replicas are dropped by their nodes once the state is committed.
 type Scaler struct {
 	schema          SchemaManager
 	cluster         cluster
@@ -49,6 +51,7 @@ type Scaler struct {
 	client          client    // client for remote nodes
 	logger          logrus.FieldLogger
 	persistenceRoot string
+	limiter         *replica.Limiter // limits the bandwidth used to copy shards
 }
 
 // New returns a new instance of Scaler
@@ -61,6 +64,7 @@ func New(cl cluster, source BackUpper,
 		client:          c,
 		logger:          logger,
 		persistenceRoot: persistenceRoot,
+		limiter:         replica.NewLimiter(0),
 	}
 }
 
@@ -91,13 +95,52 @@ func (s *Scaler) SetSchemaManager(sm SchemaManager) {
 	s.schema = sm
 }
 
+// BandwidthLimit returns the bytes per second used to copy shards from this
+// node, zero means unlimited
+func (s *Scaler) BandwidthLimit() int64 {
+	return s.limiter.Limit()
+}
+
+// SetBandwidthLimit changes the bytes per second used to copy shards from
+// this node, including running copies. Zero removes the limit.
+func (s *Scaler) SetBandwidthLimit(bytesPerSecond int64) {
+	s.limiter.SetLimit(bytesPerSecond)
+}
+
+// Progress of scaling a class, counted in shard replicas which have to be
+// copied to new nodes or removed from old ones. Bytes counts the bytes
+// copied by this node. A nil Progress is valid.
+type Progress struct {
+	Total atomic.Int64
+	Done  atomic.Int64
+	Bytes atomic.Int64
+}
+
+func (p *Progress) total(n int64) {
+	if p != nil {
+		p.Total.Store(n)
+	}
+}
+
+func (p *Progress) done(n int64) {
+	if p != nil {
+		p.Done.Add(n)
+	}
+}
+
+func (p *Progress) sent(n int64) {
+	if p != nil {
+		p.Bytes.Add(n)
+	}
+}
+
 // Scale increase/decrease class replicas.
 //
 // It returns the updated sharding state if successful. The caller must then
 // make sure to broadcast that state to all nodes as part of the "update"
 // transaction.
 func (s *Scaler) Scale(ctx context.Context, className string,
-	updated sharding.Config, prevReplFactor, newReplFactor int64,
+	updated sharding.Config, prevReplFactor, newReplFactor int64, progress *Progress,
 ) (*sharding.State, error) {
 	// First identify what the sharding state was before this change. This is
 	// mainly to be able to compare the diff later, so we know where we need to
@@ -107,11 +150,11 @@ func (s *Scaler) Scale(ctx context.Context, className string,
 		return nil, fmt.Errorf("no sharding state for class %q", className)
 	}
 	if newReplFactor > prevReplFactor {
-		return s.scaleOut(ctx, className, ssBefore, updated, newReplFactor)
+		return s.scaleOut(ctx, className, ssBefore, updated, newReplFactor, progress)
 	}
 
 	if newReplFactor < prevReplFactor {
-		return s.scaleIn(ctx, className, updated)
+		return s.scaleIn(ctx, className, ssBefore, updated, newReplFactor, progress)
 	}
 
 	return nil, nil
@@ -123,7 +166,7 @@ func (s *Scaler) Scale(ctx context.Context, className string,
 // * It pushes locally existing shards to new replicas
 // * It delegates replication of remote shards to owner nodes
 func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *sharding.State,
-	updated sharding.Config, replFactor int64,
+	updated sharding.Config, replFactor int64, progress *Progress,
 ) (*sharding.State, error) {
 	// Create a deep copy of the old sharding state, so we can start building the
 	// updated state. Because this is a deep copy we don't risk leaking our
@@ -141,6 +184,12 @@ func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *shard
 		ssAfter.Physical[name] = shard
 	}
 	lDist, nodeDist := distributions(ssBefore, &ssAfter)
+	total := lDist.replicas()
+	for _, dist := range nodeDist {
+		total += dist.replicas()
+	}
+	progress.total(total)
+
 	g, ctx := errgroup.WithContext(ctx)
 	// resolve hosts beforehand
 	nodes := nodeDist.nodes()
@@ -156,14 +205,16 @@ func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *shard
 			if err != nil {
 				return fmt.Errorf("increase replication factor for class %q on node %q: %w", className, nodes[i], err)
 			}
+			progress.done(dist.replicas())
 			return nil
 		})
 	}
 
 	g.Go(func() error {
-		if err := s.LocalScaleOut(ctx, className, lDist); err != nil {
+		if err := s.localScaleOut(ctx, className, lDist, progress); err != nil {
 			return fmt.Errorf("increase local replication factor: %w", err)
 		}
+		progress.done(lDist.replicas())
 		return nil
 	})
 	if err := g.Wait(); err != nil {
@@ -192,6 +243,12 @@ func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *shard
 //   - Release the single-shard backup
 func (s *Scaler) LocalScaleOut(ctx context.Context,
 	className string, dist ShardDist,
+) error {
+	return s.localScaleOut(ctx, className, dist, nil)
+}
+
+func (s *Scaler) localScaleOut(ctx context.Context,
+	className string, dist ShardDist, progress *Progress,
 ) error {
 	if len(dist) < 1 {
 		return nil
@@ -210,11 +267,88 @@ func (s *Scaler) LocalScaleOut(ctx context.Context,
 		}
 	}()
 	rsync := newRSync(s.client, s.cluster, s.persistenceRoot)
+	rsync.limiter, rsync.progress = s.limiter, progress
 	return rsync.Push(ctx, bak.Shards, dist, className)
 }
 
-func (s *Scaler) scaleIn(ctx context.Context, className string,
-	updated sharding.Config,
+// scaleIn removes replicas of class shards (nodes):
+//
+// * It calculates the new sharding state, in which each shard keeps its first replicas
+// * It leaves the removal of the data to the nodes, which drop their
+// replicas once the new state has been committed
+func (s *Scaler) scaleIn(ctx context.Context, className string, ssBefore *sharding.State,
+	updated sharding.Config, replFactor int64, progress *Progress,
 ) (*sharding.State, error) {
-	return nil, errors.Errorf("scaling in not supported yet")
+	if replFactor < 1 {
+		return nil, fmt.Errorf("replication factor must be at least 1, got %d", replFactor)
+	}
+	ssAfter := ssBefore.DeepCopy()
+	ssAfter.Config = updated
+
+	var removed int64
+	for name, shard := range ssAfter.Physical {
+		n := len(shard.BelongsToNodes)
+		if err := shard.AdjustReplicas(int(replFactor), s.cluster); err != nil {
+			return nil, err
+		}
+		if d := n - len(shard.BelongsToNodes); d > 0 {
+			removed += int64(d)
+		}
+		ssAfter.Physical[name] = shard
+	}
+	progress.total(removed)
+	return &ssAfter, nil
+}
+
+// CopyReplica copies the replica of a shard from the source to the target
+// node, in order to move it there. The source node copies the shard and
+// serves it meanwhile. Writes which arrive during the copy are only found on
+// the target, if they are repaired by the other replicas of the shard.
+//
+// The caller must then replace the source with the target in the sharding
+// state, the source drops its replica once that state has been committed.
+func (s *Scaler) CopyReplica(ctx context.Context, className, shard, source, target string,
+	progress *Progress,
+) error {
+	ss := s.schema.CopyShardingState(className)
+	if ss == nil {
+		return fmt.Errorf("no sharding state for class %q", className)
+	}
+	physical, ok := ss.Physical[shard]
+	if !ok {
+		return fmt.Errorf("shard %q of class %q not found", shard, className)
+	}
+	found := false
+	for _, node := range physical.BelongsToNodes {
+		switch node {
+		case source:
+			found = true
+		case target:
+			return fmt.Errorf("shard %q has a replica on node %q already", shard, target)
+		}
+	}
+	if !found {
+		return fmt.Errorf("shard %q has no replica on node %q", shard, source)
+	}
+	if _, ok := s.cluster.NodeHostname(target); !ok {
+		return fmt.Errorf("%w, %q", ErrUnresolvedName, target)
+	}
+	progress.total(1)
+
+	dist := ShardDist{shard: []string{target}}
+	if source == s.cluster.LocalName() {
+		if err := s.localScaleOut(ctx, className, dist, progress); err != nil {
+			return fmt.Errorf("copy shard to node %q: %w", target, err)
+		}
+	} else {
+		host, ok := s.cluster.NodeHostname(source)
+		if !ok {
+			return fmt.Errorf("%w, %q", ErrUnresolvedName, source)
+		}
+		if err := s.client.IncreaseReplicationFactor(ctx, host, className, dist); err != nil {
+			return fmt.Errorf("copy shard from node %q to node %q: %w", source, target, err)
+		}
+	}
+	progress.done(1)
+	return nil
 }
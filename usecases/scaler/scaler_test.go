@@ -13,12 +13,15 @@ package scaler
 
 import (
 	"context"
+	"io"
 	"os"
 	"path"
 	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -30,22 +33,30 @@ func TestScalerScale(t *testing.T) {
 		f.ShardingState.M = nil
 		scaler := f.Scaler("")
 		old := sharding.Config{}
-		_, err := scaler.Scale(ctx, "C", old, 1, 2)
+		_, err := scaler.Scale(ctx, "C", old, 1, 2, nil)
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "no sharding state")
 	})
 	t.Run("SameReplicationFactor", func(t *testing.T) {
 		scaler := newFakeFactory().Scaler("")
 		old := sharding.Config{}
-		_, err := scaler.Scale(ctx, "C", old, 2, 2)
+		_, err := scaler.Scale(ctx, "C", old, 2, 2, nil)
 		assert.Nil(t, err)
 	})
-	t.Run("ScaleInNotSupported", func(t *testing.T) {
+	t.Run("ScaleIn", func(t *testing.T) {
 		scaler := newFakeFactory().Scaler("")
-		old := sharding.Config{}
-		_, err := scaler.Scale(ctx, "C", old, 2, 1)
+		updated := sharding.Config{DesiredCount: 2}
+		progress := &Progress{}
+		ss, err := scaler.Scale(ctx, "C", updated, 2, 1, progress)
+		assert.Nil(t, err)
+		assert.Equal(t, updated, ss.Config)
+		assert.Equal(t, []string{"N1"}, ss.Physical["S1"].BelongsToNodes)
+		assert.Equal(t, []string{"N3"}, ss.Physical["S3"].BelongsToNodes)
+		assert.Equal(t, int64(1), progress.Total.Load())
+		assert.Equal(t, int64(0), progress.Done.Load())
+
+		_, err = scaler.Scale(ctx, "C", updated, 2, 0, nil)
 		assert.NotNil(t, err)
-		assert.Contains(t, err.Error(), "not supported")
 	})
 }
 
@@ -76,7 +87,7 @@ func TestScalerScaleOut(t *testing.T) {
 		f := newFakeFactory()
 		delete(f.NodeHostMap, "N3")
 		scaler := f.Scaler(dataDir)
-		_, err := scaler.Scale(ctx, "C", old, 1, 3)
+		_, err := scaler.Scale(ctx, "C", old, 1, 3, nil)
 		assert.ErrorIs(t, err, ErrUnresolvedName)
 	})
 	t.Run("GetLocalShards", func(t *testing.T) {
@@ -89,7 +100,7 @@ func TestScalerScaleOut(t *testing.T) {
 
 		f.Source.On("ReleaseBackup", anyVal, anyVal, "C").Return(nil)
 		scaler := f.Scaler(dataDir)
-		_, err := scaler.Scale(ctx, "C", old, 1, 3)
+		_, err := scaler.Scale(ctx, "C", old, 1, 3, nil)
 		assert.ErrorIs(t, err, errAny)
 	})
 
@@ -114,7 +125,7 @@ func TestScalerScaleOut(t *testing.T) {
 
 		f.Source.On("ReleaseBackup", anyVal, anyVal, "C").Return(nil)
 		scaler := f.Scaler(dataDir)
-		_, err := scaler.Scale(ctx, "C", old, 1, 3)
+		_, err := scaler.Scale(ctx, "C", old, 1, 3, nil)
 		assert.ErrorIs(t, err, errAny)
 	})
 
@@ -139,8 +150,11 @@ func TestScalerScaleOut(t *testing.T) {
 
 		f.Source.On("ReleaseBackup", anyVal, anyVal, "C").Return(nil)
 		scaler := f.Scaler(dataDir)
-		_, err := scaler.Scale(ctx, "C", old, 1, 3)
+		progress := &Progress{}
+		_, err := scaler.Scale(ctx, "C", old, 1, 3, progress)
 		assert.Nil(t, err)
+		assert.Equal(t, int64(3), progress.Total.Load())
+		assert.Equal(t, int64(3), progress.Done.Load())
 	})
 
 	t.Run("ReleaseBackupAsync", func(t *testing.T) {
@@ -164,7 +178,78 @@ func TestScalerScaleOut(t *testing.T) {
 
 		f.Source.On("ReleaseBackup", anyVal, anyVal, "C").Return(errAny)
 		scaler := f.Scaler(dataDir)
-		_, err := scaler.Scale(ctx, "C", old, 1, 3)
+		_, err := scaler.Scale(ctx, "C", old, 1, 3, nil)
 		assert.Nil(t, err)
 	})
 }
+
+func TestScalerCopyReplica(t *testing.T) {
+	var (
+		dataDir = t.TempDir()
+		ctx     = context.Background()
+		cls     = "C"
+		bak     = backup.ClassDescriptor{
+			Name: "C",
+			Shards: []*backup.ShardDescriptor{
+				{
+					Name: "S1", Files: []string{"f1"},
+					PropLengthTrackerPath: "f2",
+					ShardVersionPath:      "f2",
+					DocIDCounterPath:      "f2",
+				},
+			},
+		}
+	)
+	require.Nil(t, os.WriteFile(path.Join(dataDir, "f1"), make([]byte, 100), 0o644))
+	require.Nil(t, os.WriteFile(path.Join(dataDir, "f2"), make([]byte, 10), 0o644))
+
+	t.Run("LocalReplica", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(bak, nil)
+		f.Client.On("CreateShard", anyVal, "H2", cls, "S1").Return(nil)
+		f.Client.On("PutFile", anyVal, "H2", cls, "S1", anyVal, anyVal).Return(nil).
+			Run(func(args mock.Arguments) {
+				io.Copy(io.Discard, args.Get(5).(io.Reader))
+			})
+		f.Client.On("ReInitShard", anyVal, "H2", cls, "S1").Return(nil)
+		f.Source.On("ReleaseBackup", anyVal, anyVal, "C").Return(nil)
+		scaler := f.Scaler(dataDir)
+		scaler.SetBandwidthLimit(1 << 20)
+		assert.Equal(t, int64(1<<20), scaler.BandwidthLimit())
+
+		progress := &Progress{}
+		err := scaler.CopyReplica(ctx, cls, "S1", "N1", "N2", progress)
+		require.Nil(t, err)
+		f.Client.AssertExpectations(t)
+		assert.Equal(t, int64(1), progress.Total.Load())
+		assert.Equal(t, int64(1), progress.Done.Load())
+		assert.Equal(t, int64(130), progress.Bytes.Load())
+	})
+
+	t.Run("RemoteReplica", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H4", cls, ShardDist{"S3": {"N2"}}).Return(nil)
+		err := f.Scaler(dataDir).CopyReplica(ctx, cls, "S3", "N4", "N2", nil)
+		require.Nil(t, err)
+		f.Client.AssertExpectations(t)
+	})
+
+	t.Run("CopyFails", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H4", cls, anyVal).Return(errAny)
+		err := f.Scaler(dataDir).CopyReplica(ctx, cls, "S3", "N4", "N2", nil)
+		assert.ErrorIs(t, err, errAny)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		scaler := newFakeFactory().Scaler(dataDir)
+		err := scaler.CopyReplica(ctx, cls, "S2", "N1", "N2", nil)
+		assert.ErrorContains(t, err, "not found")
+		err = scaler.CopyReplica(ctx, cls, "S3", "N1", "N2", nil)
+		assert.ErrorContains(t, err, "no replica")
+		err = scaler.CopyReplica(ctx, cls, "S3", "N4", "N3", nil)
+		assert.ErrorContains(t, err, "already")
+		err = scaler.CopyReplica(ctx, cls, "S3", "N4", "N5", nil)
+		assert.ErrorIs(t, err, ErrUnresolvedName)
+	})
+}
@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scheduler
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Metrics of the scheduler
+type Metrics struct {
+	running *prometheus.GaugeVec
+	waits   *prometheus.HistogramVec
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		running: prom.SchedulerRunning,
+		waits:   prom.SchedulerWaitDurations,
+	}
+}
+
+func (m *Metrics) Running(class Class, slots int) {
+	if m == nil {
+		return
+	}
+
+	m.running.With(prometheus.Labels{"class": string(class)}).Set(float64(slots))
+}
+
+func (m *Metrics) Waited(class Class, took time.Duration) {
+	if m == nil {
+		return
+	}
+
+	m.waits.With(prometheus.Labels{"class": string(class)}).Observe(took.Seconds())
+}
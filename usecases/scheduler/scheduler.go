@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package scheduler shares the CPU of a node between interactive queries and
+// bulk work such as imports and reindexing, so that a large import doesn't
+// drive up the latency of searches. Work acquires one of a fixed number of
+// slots before it runs. Slots which are free are granted right away to any
+// class, so a single class may use all of them. Under contention, a released
+// slot goes to the waiting class which uses the least slots relative to its
+// shares.
+package scheduler
+
+import (
+	"container/list"
+	"context"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// Class of work, work is interactive unless its context says otherwise
+type Class string
+
+const (
+	Interactive Class = "interactive"
+	Bulk        Class = "bulk"
+)
+
+type classKey struct{}
+
+// WithClass marks the work done with the returned context as the given class
+func WithClass(ctx context.Context, class Class) context.Context {
+	return context.WithValue(ctx, classKey{}, class)
+}
+
+// ClassFrom returns the class of the work done with ctx
+func ClassFrom(ctx context.Context) Class {
+	if class, ok := ctx.Value(classKey{}).(Class); ok {
+		return class
+	}
+	return Interactive
+}
+
+type waiter struct {
+	ch      chan struct{}
+	granted bool
+}
+
+type class struct {
+	name    Class
+	shares  int
+	running int
+	waiters list.List
+}
+
+// Scheduler grants slots to the classes of work. A nil Scheduler grants all
+// slots right away.
+type Scheduler struct {
+	slots   int
+	metrics *Metrics
+
+	sync.Mutex
+	running int
+	classes []*class
+}
+
+// New creates a scheduler with the slots and shares of the config, it returns
+// nil if the scheduler is disabled. Without configured slots there is one
+// per CPU.
+func New(cfg config.PriorityScheduler, metrics *Metrics) *Scheduler {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	slots := cfg.Slots
+	if slots <= 0 {
+		slots = runtime.GOMAXPROCS(0)
+	}
+	return &Scheduler{
+		slots:   slots,
+		metrics: metrics,
+		classes: []*class{
+			{name: Interactive, shares: cfg.InteractiveShares},
+			{name: Bulk, shares: cfg.BulkShares},
+		},
+	}
+}
+
+// Acquire blocks until a slot is granted to the class or ctx is done. The
+// returned func releases the slot.
+func (s *Scheduler) Acquire(ctx context.Context, name Class) (func(), error) {
+	if s == nil {
+		return func() {}, nil
+	}
+
+	c := s.class(name)
+	before := time.Now()
+
+	s.Lock()
+	if s.running < s.slots {
+		// a free slot means that no class is waiting
+		s.grant(c)
+		s.Unlock()
+		s.metrics.Waited(c.name, time.Since(before))
+		return s.releaseFunc(c), nil
+	}
+	w := &waiter{ch: make(chan struct{})}
+	elem := c.waiters.PushBack(w)
+	s.Unlock()
+
+	select {
+	case <-w.ch:
+		s.metrics.Waited(c.name, time.Since(before))
+		return s.releaseFunc(c), nil
+	case <-ctx.Done():
+		s.Lock()
+		if w.granted {
+			// the slot was granted at the same time, pass it on
+			s.release(c)
+		} else {
+			c.waiters.Remove(elem)
+		}
+		s.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+func (s *Scheduler) class(name Class) *class {
+	for _, c := range s.classes {
+		if c.name == name {
+			return c
+		}
+	}
+	return s.classes[0]
+}
+
+func (s *Scheduler) releaseFunc(c *class) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.Lock()
+			defer s.Unlock()
+			s.release(c)
+		})
+	}
+}
+
+// grant a slot to the class, it must be called with the lock held
+func (s *Scheduler) grant(c *class) {
+	s.running++
+	c.running++
+	s.metrics.Running(c.name, c.running)
+}
+
+// release a slot of the class and grant the free slots to the waiting
+// classes, it must be called with the lock held
+func (s *Scheduler) release(c *class) {
+	s.running--
+	c.running--
+	s.metrics.Running(c.name, c.running)
+
+	for s.running < s.slots {
+		next := s.next()
+		if next == nil {
+			return
+		}
+		w := next.waiters.Remove(next.waiters.Front()).(*waiter)
+		w.granted = true
+		s.grant(next)
+		close(w.ch)
+	}
+}
+
+// next returns the waiting class which uses the least slots relative to its
+// shares, it must be called with the lock held
+func (s *Scheduler) next() *class {
+	var next *class
+	for _, c := range s.classes {
+		if c.waiters.Len() == 0 {
+			continue
+		}
+		// compares c.running/c.shares < next.running/next.shares
+		if next == nil || c.running*next.shares < next.running*c.shares {
+			next = c
+		}
+	}
+	return next
+}
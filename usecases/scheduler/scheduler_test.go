@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func newTestScheduler(slots, interactive, bulk int) *Scheduler {
+	return New(config.PriorityScheduler{
+		Enabled:           true,
+		Slots:             slots,
+		InteractiveShares: interactive,
+		BulkShares:        bulk,
+	}, nil)
+}
+
+// waiting returns once the class has the given number of waiters
+func waiting(t *testing.T, s *Scheduler, name Class, n int) {
+	require.Eventually(t, func() bool {
+		s.Lock()
+		defer s.Unlock()
+		return s.class(name).waiters.Len() == n
+	}, time.Second, time.Millisecond)
+}
+
+func running(s *Scheduler, name Class) int {
+	s.Lock()
+	defer s.Unlock()
+	return s.class(name).running
+}
+
+func TestScheduler(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disabled", func(t *testing.T) {
+		s := New(config.PriorityScheduler{}, nil)
+		require.Nil(t, s)
+		release, err := s.Acquire(ctx, Bulk)
+		require.Nil(t, err)
+		release()
+	})
+
+	t.Run("free slots are granted to any class", func(t *testing.T) {
+		s := newTestScheduler(2, 80, 20)
+		r1, err := s.Acquire(ctx, Bulk)
+		require.Nil(t, err)
+		r2, err := s.Acquire(ctx, Bulk)
+		require.Nil(t, err)
+		assert.Equal(t, 2, running(s, Bulk))
+
+		granted := make(chan struct{})
+		go func() {
+			release, err := s.Acquire(ctx, Interactive)
+			assert.Nil(t, err)
+			close(granted)
+			release()
+		}()
+		waiting(t, s, Interactive, 1)
+
+		r1()
+		<-granted
+		r2()
+		// releasing twice must not free another slot
+		r1()
+		assert.Equal(t, 0, running(s, Bulk))
+		assert.Equal(t, 0, running(s, Interactive))
+	})
+
+	t.Run("slots are granted by shares under contention", func(t *testing.T) {
+		s := newTestScheduler(4, 3, 1)
+		var bulk []func()
+		for i := 0; i < 4; i++ {
+			release, err := s.Acquire(ctx, Bulk)
+			require.Nil(t, err)
+			bulk = append(bulk, release)
+		}
+
+		for i := 0; i < 4; i++ {
+			go s.Acquire(ctx, Interactive)
+			go s.Acquire(ctx, Bulk)
+		}
+		waiting(t, s, Interactive, 4)
+		waiting(t, s, Bulk, 4)
+
+		for _, release := range bulk {
+			release()
+		}
+		assert.Equal(t, 3, running(s, Interactive))
+		assert.Equal(t, 1, running(s, Bulk))
+	})
+
+	t.Run("waiting is canceled with the context", func(t *testing.T) {
+		s := newTestScheduler(1, 80, 20)
+		release, err := s.Acquire(ctx, Bulk)
+		require.Nil(t, err)
+
+		canceled, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, err = s.Acquire(canceled, Interactive)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		waiting(t, s, Interactive, 0)
+
+		release()
+		release, err = s.Acquire(ctx, Interactive)
+		require.Nil(t, err)
+		release()
+	})
+
+	t.Run("class of the context", func(t *testing.T) {
+		assert.Equal(t, Interactive, ClassFrom(ctx))
+		assert.Equal(t, Bulk, ClassFrom(WithClass(ctx, Bulk)))
+	})
+}
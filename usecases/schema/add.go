@@ -31,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -40,8 +41,12 @@ import (
 // AddClass to the schema
 func (m *Manager) AddClass(ctx context.Context, principal *models.Principal,
 	class *models.Class,
-) error {
-	err := m.Authorizer.Authorize(principal, "create", "schema/objects")
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal, "create", err, authorization.CollectionsMetadata(class.Class))
+	}()
+
+	err = m.Authorizer.Authorize(principal, "create", authorization.CollectionsMetadata(class.Class))
 	if err != nil {
 		return err
 	}
@@ -243,6 +248,11 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 	}
 
 	setInvertedConfigDefaults(class)
+	setVersioningConfigDefaults(class)
+	setChunkingConfigDefaults(class)
+	setTemporalPartitioningDefaults(class)
+	setCompactionConfigDefaults(class)
+	setSegmentCompressionConfigDefaults(class)
 	for _, prop := range class.Properties {
 		setPropertyDefaults(prop)
 	}
@@ -285,6 +295,18 @@ func setPropertyDefaultIndexing(prop *models.Property) {
 	vTrue := true
 	vFalse := false
 
+	// encrypted values can not be indexed, as the inverted index would
+	// contain the plain text
+	if prop.Encrypted {
+		if prop.IndexFilterable == nil {
+			prop.IndexFilterable = &vFalse
+		}
+		if prop.IndexSearchable == nil {
+			prop.IndexSearchable = &vFalse
+		}
+		return
+	}
+
 	if prop.IndexFilterable == nil {
 		prop.IndexFilterable = &vTrue
 
@@ -457,6 +479,30 @@ func (m *Manager) validateCanAddClass(
 		return err
 	}
 
+	if err := validateVersioningConfig(class); err != nil {
+		return err
+	}
+
+	if err := validateChunkingConfig(class); err != nil {
+		return err
+	}
+
+	if err := validateTemporalPartitioningConfig(class); err != nil {
+		return err
+	}
+
+	if err := validateQueryConfig(class); err != nil {
+		return err
+	}
+
+	if err := validateCompactionConfig(class); err != nil {
+		return err
+	}
+
+	if err := validateSegmentCompressionConfig(class); err != nil {
+		return err
+	}
+
 	// all is fine!
 	return nil
 }
@@ -505,6 +551,10 @@ func (m *Manager) validateProperty(
 		return err
 	}
 
+	if err := m.validatePropertyEncryption(property); err != nil {
+		return err
+	}
+
 	// all is fine!
 	return nil
 }
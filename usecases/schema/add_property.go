@@ -19,13 +19,18 @@ import (
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // AddClassProperty to an existing Class
 func (m *Manager) AddClassProperty(ctx context.Context, principal *models.Principal,
 	class string, property *models.Property,
-) error {
-	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal, "update", err, authorization.CollectionsMetadata(class))
+	}()
+
+	err = m.Authorizer.Authorize(principal, "update", authorization.CollectionsMetadata(class))
 	if err != nil {
 		return err
 	}
@@ -40,67 +40,67 @@ func Test_Schema_Authorization(t *testing.T) {
 		{
 			methodName:       "GetSchema",
 			expectedVerb:     "list",
-			expectedResource: "schema/*",
+			expectedResource: "schema/collections/*",
 		},
 		{
 			methodName:       "GetClass",
 			additionalArgs:   []interface{}{"classname"},
 			expectedVerb:     "list",
-			expectedResource: "schema/*",
+			expectedResource: "schema/collections/Classname",
 		},
 		{
 			methodName:       "GetShardsStatus",
 			additionalArgs:   []interface{}{"className", "tenant"},
 			expectedVerb:     "list",
-			expectedResource: "schema/className/shards",
+			expectedResource: "schema/collections/ClassName/shards",
 		},
 		{
 			methodName:       "AddClass",
-			additionalArgs:   []interface{}{&models.Class{}},
+			additionalArgs:   []interface{}{&models.Class{Class: "somename"}},
 			expectedVerb:     "create",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/collections/Somename",
 		},
 		{
 			methodName:       "UpdateClass",
 			additionalArgs:   []interface{}{"somename", &models.Class{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/collections/Somename",
 		},
 		{
 			methodName:       "DeleteClass",
 			additionalArgs:   []interface{}{"somename"},
 			expectedVerb:     "delete",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/collections/Somename",
 		},
 		{
 			methodName:       "AddClassProperty",
 			additionalArgs:   []interface{}{"somename", &models.Property{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/collections/Somename",
 		},
 		{
 			methodName:       "MergeClassObjectProperty",
 			additionalArgs:   []interface{}{"somename", &models.Property{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/collections/Somename",
 		},
 		{
 			methodName:       "DeleteClassProperty",
 			additionalArgs:   []interface{}{"somename", "someprop"},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/collections/Somename",
 		},
 		{
 			methodName:       "UpdateShardStatus",
 			additionalArgs:   []interface{}{"className", "shardName", "targetStatus"},
 			expectedVerb:     "update",
-			expectedResource: "schema/className/shards/shardName",
+			expectedResource: "schema/collections/ClassName/shards",
 		},
 		{
 			methodName:       "AddTenants",
 			additionalArgs:   []interface{}{"className", []*models.Tenant{{Name: "P1"}}},
 			expectedVerb:     "update",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/collections/ClassName/tenants/P1",
 		},
 		{
 			methodName: "UpdateTenants",
@@ -108,19 +108,51 @@ func Test_Schema_Authorization(t *testing.T) {
 				{Name: "P1", ActivityStatus: models.TenantActivityStatusHOT},
 			}},
 			expectedVerb:     "update",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/collections/ClassName/tenants/P1",
 		},
 		{
 			methodName:       "DeleteTenants",
 			additionalArgs:   []interface{}{"className", []string{"P1"}},
 			expectedVerb:     "delete",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/collections/ClassName/tenants/P1",
 		},
 		{
 			methodName:       "GetTenants",
 			additionalArgs:   []interface{}{"className"},
 			expectedVerb:     "get",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/collections/ClassName/tenants/*",
+		},
+		{
+			methodName: "StartTenantsJob",
+			additionalArgs: []interface{}{"className", TenantsJobRequest{
+				Operation: TenantsJobDelete, Tenants: []string{"P1"},
+			}},
+			expectedVerb:     "delete",
+			expectedResource: "schema/collections/ClassName/tenants/P1",
+		},
+		{
+			methodName:       "TenantsJob",
+			additionalArgs:   []interface{}{"className", "id"},
+			expectedVerb:     "get",
+			expectedResource: "schema/collections/ClassName/tenants/*",
+		},
+		{
+			methodName:       "TenantsJobs",
+			additionalArgs:   []interface{}{"className"},
+			expectedVerb:     "get",
+			expectedResource: "schema/collections/ClassName/tenants/*",
+		},
+		{
+			methodName:       "Rebalance",
+			additionalArgs:   []interface{}{"className"},
+			expectedVerb:     "get",
+			expectedResource: "schema/collections/ClassName",
+		},
+		{
+			methodName:       "Reshard",
+			additionalArgs:   []interface{}{"className"},
+			expectedVerb:     "get",
+			expectedResource: "schema/collections/ClassName",
 		},
 	}
 
@@ -136,8 +168,10 @@ func Test_Schema_Authorization(t *testing.T) {
 				"UpdateMeta", "GetSchemaSkipAuth", "IndexedInverted", "RLock", "RUnlock", "Lock", "Unlock",
 				"TryLock", "RLocker", "TryRLock", // introduced by sync.Mutex in go 1.18
 				"Nodes", "NodeName", "ClusterHealthScore", "ClusterStatus", "ResolveParentNodes",
-				"CopyShardingState", "TxManager", "RestoreClass",
-				"ShardOwner", "TenantShard", "ShardFromUUID", "LockGuard", "RLockGuard", "ShardReplicas",
+				"CopyShardingState", "TxManager", "RestoreClass", "RestoreTenants", "SetAuditLog", "SetTenantsStatus", "FollowSchema",
+				"ShardOwner", "TenantShard", "ShardFromUUID", "PreviousShardFromUUID", "LockGuard", "RLockGuard", "ShardReplicas", "CommitShardMove",
+				"CreatePartitions", "DropPartitions",
+				"PutMetadata", "DeleteMetadata", "Metadata", "RegisterMetadataCallback",
 				"StartServing", "Shutdown": // internal methods to indicate readiness state
 				// don't require auth on methods which are exported because other
 				// packages need to call them for maintenance and other regular jobs,
@@ -32,6 +32,10 @@ var (
 type State struct {
 	ObjectSchema  *models.Schema `json:"object"`
 	ShardingState map[string]*sharding.State
+
+	// Metadata holds small documents of other usecases which need to be the
+	// same on every node, e.g. rbac roles. Keys are prefixed by the usecase.
+	Metadata map[string][]byte `json:"metadata,omitempty"`
 }
 
 // NewState returns a new state with room for nClasses classes
@@ -108,6 +112,18 @@ func (s *schemaCache) ShardFromUUID(class string, uuid []byte) string {
 	return ss.PhysicalShard(uuid)
 }
 
+// PreviousShardFromUUID returns the shard which may still hold the object
+// while the class is being resharded, otherwise an empty string
+func (s *schemaCache) PreviousShardFromUUID(class string, uuid []byte) string {
+	s.RLock()
+	defer s.RUnlock()
+	ss := s.ShardingState[class]
+	if ss == nil {
+		return ""
+	}
+	return ss.PreviousPhysicalShard(uuid)
+}
+
 func (s *schemaCache) CopyShardingState(className string) *sharding.State {
 	s.RLock()
 	defer s.RUnlock()
@@ -137,7 +153,7 @@ func (s *schemaCache) RLockGuard(reader func() error) error {
 func (s *schemaCache) isEmpty() bool {
 	s.RLock()
 	defer s.RUnlock()
-	return s.State.ObjectSchema == nil || len(s.State.ObjectSchema.Classes) == 0
+	return isEmpty(&s.State)
 }
 
 func (s *schemaCache) setState(st State) {
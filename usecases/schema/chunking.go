@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func setChunkingConfigDefaults(class *models.Class) {
+	if !schema.ChunkingEnabled(class) {
+		return
+	}
+	cfg := class.ChunkingConfig
+	if cfg.Splitter == "" {
+		cfg.Splitter = schema.ChunkingSplitterRecursive
+	}
+	if cfg.ChunkSize == 0 {
+		cfg.ChunkSize = schema.DefaultChunkSize
+	}
+	if cfg.ReferenceProperty == "" {
+		cfg.ReferenceProperty = schema.DefaultChunkReferenceProperty
+	}
+}
+
+// validateChunkingConfig checks the config itself, the target class is
+// looked up when objects are chunked as it may be created after this class
+func validateChunkingConfig(class *models.Class) error {
+	cfg := class.ChunkingConfig
+	if cfg == nil {
+		return nil
+	}
+	if cfg.Property == "" {
+		return fmt.Errorf("chunkingConfig.property must be set")
+	}
+	if cfg.TargetClass == "" {
+		return fmt.Errorf("chunkingConfig.targetClass must be set")
+	}
+	if schema.UppercaseClassName(cfg.TargetClass) == schema.UppercaseClassName(class.Class) {
+		return fmt.Errorf("chunkingConfig.targetClass must not be the chunked class")
+	}
+	switch cfg.Splitter {
+	case "", schema.ChunkingSplitterSentence, schema.ChunkingSplitterToken,
+		schema.ChunkingSplitterRecursive:
+	default:
+		return fmt.Errorf("chunkingConfig.splitter must be one of %q, %q or %q, got %q",
+			schema.ChunkingSplitterSentence, schema.ChunkingSplitterToken,
+			schema.ChunkingSplitterRecursive, cfg.Splitter)
+	}
+	if cfg.ChunkSize < 0 {
+		return fmt.Errorf("chunkingConfig.chunkSize must not be negative, got %d", cfg.ChunkSize)
+	}
+	if cfg.ChunkOverlap < 0 {
+		return fmt.Errorf("chunkingConfig.chunkOverlap must not be negative, got %d", cfg.ChunkOverlap)
+	}
+	size := cfg.ChunkSize
+	if size == 0 {
+		size = schema.DefaultChunkSize
+	}
+	if cfg.ChunkOverlap >= size {
+		return fmt.Errorf("chunkingConfig.chunkOverlap must be smaller than chunkSize %d, got %d",
+			size, cfg.ChunkOverlap)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestChunkingConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		class := &models.Class{ChunkingConfig: &models.ChunkingConfig{
+			Property: "text", TargetClass: "Chunk",
+		}}
+		setChunkingConfigDefaults(class)
+		assert.Equal(t, &models.ChunkingConfig{
+			Property:          "text",
+			TargetClass:       "Chunk",
+			Splitter:          "recursive",
+			ChunkSize:         200,
+			ReferenceProperty: "parent",
+		}, class.ChunkingConfig)
+
+		class = &models.Class{}
+		setChunkingConfigDefaults(class)
+		assert.Nil(t, class.ChunkingConfig)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		valid := models.ChunkingConfig{Property: "text", TargetClass: "Chunk"}
+		assert.Nil(t, validateChunkingConfig(&models.Class{}))
+		assert.Nil(t, validateChunkingConfig(&models.Class{Class: "Doc", ChunkingConfig: &valid}))
+
+		for _, tc := range []struct {
+			update func(cfg *models.ChunkingConfig)
+			err    string
+		}{
+			{func(cfg *models.ChunkingConfig) { cfg.Property = "" }, "property must be set"},
+			{func(cfg *models.ChunkingConfig) { cfg.TargetClass = "" }, "targetClass must be set"},
+			{func(cfg *models.ChunkingConfig) { cfg.TargetClass = "doc" }, "must not be the chunked class"},
+			{func(cfg *models.ChunkingConfig) { cfg.Splitter = "words" }, "splitter must be one of"},
+			{func(cfg *models.ChunkingConfig) { cfg.ChunkSize = -1 }, "chunkSize must not be negative"},
+			{func(cfg *models.ChunkingConfig) { cfg.ChunkOverlap = -1 }, "chunkOverlap must not be negative"},
+			{func(cfg *models.ChunkingConfig) { cfg.ChunkSize, cfg.ChunkOverlap = 10, 10 }, "must be smaller than chunkSize"},
+			{func(cfg *models.ChunkingConfig) { cfg.ChunkOverlap = 200 }, "must be smaller than chunkSize 200"},
+		} {
+			cfg := valid
+			tc.update(&cfg)
+			err := validateChunkingConfig(&models.Class{Class: "Doc", ChunkingConfig: &cfg})
+			assert.ErrorContains(t, err, tc.err)
+		}
+	})
+}
@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func setCompactionConfigDefaults(class *models.Class) {
+	if class.CompactionConfig != nil && class.CompactionConfig.Strategy == "" {
+		class.CompactionConfig.Strategy = schema.DefaultCompactionStrategy
+	}
+}
+
+// validateCompactionConfig is used on updates as well, the config of the
+// compactions can be changed at any time
+func validateCompactionConfig(class *models.Class) error {
+	cfg := class.CompactionConfig
+	if cfg == nil {
+		return nil
+	}
+	if cfg.Strategy != "" && !schema.ValidCompactionStrategy(cfg.Strategy) {
+		return fmt.Errorf("compactionConfig.strategy must be one of %q or %q, got %q",
+			schema.CompactionStrategySizeTiered, schema.CompactionStrategyLeveled, cfg.Strategy)
+	}
+	if cfg.MaxBytesPerSecond < 0 {
+		return fmt.Errorf("compactionConfig.maxBytesPerSecond must not be negative, got %d",
+			cfg.MaxBytesPerSecond)
+	}
+	return nil
+}
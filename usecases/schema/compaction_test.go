@@ -0,0 +1,36 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestValidateCompactionConfig(t *testing.T) {
+	assert.Nil(t, validateCompactionConfig(&models.Class{}))
+	assert.Nil(t, validateCompactionConfig(&models.Class{
+		CompactionConfig: &models.CompactionConfig{Strategy: "leveled", MaxBytesPerSecond: 1 << 20},
+	}))
+	assert.ErrorContains(t, validateCompactionConfig(&models.Class{
+		CompactionConfig: &models.CompactionConfig{Strategy: "universal"},
+	}), "compactionConfig.strategy")
+	assert.ErrorContains(t, validateCompactionConfig(&models.Class{
+		CompactionConfig: &models.CompactionConfig{MaxBytesPerSecond: -1},
+	}), "must not be negative")
+
+	class := &models.Class{CompactionConfig: &models.CompactionConfig{}}
+	setCompactionConfigDefaults(class)
+	assert.Equal(t, "sizeTiered", class.CompactionConfig.Strategy)
+}
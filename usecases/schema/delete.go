@@ -16,11 +16,16 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // DeleteClass from the schema
-func (m *Manager) DeleteClass(ctx context.Context, principal *models.Principal, class string) error {
-	err := m.Authorizer.Authorize(principal, "delete", "schema/objects")
+func (m *Manager) DeleteClass(ctx context.Context, principal *models.Principal, class string) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal, "delete", err, authorization.CollectionsMetadata(class))
+	}()
+
+	err = m.Authorizer.Authorize(principal, "delete", authorization.CollectionsMetadata(class))
 	if err != nil {
 		return err
 	}
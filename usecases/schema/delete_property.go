@@ -16,13 +16,18 @@ import (
 	"fmt"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // DeleteClassProperty from existing Schema
 func (m *Manager) DeleteClassProperty(ctx context.Context, principal *models.Principal,
 	class string, property string,
-) error {
-	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal, "update", err, authorization.CollectionsMetadata(class))
+	}()
+
+	err = m.Authorizer.Authorize(principal, "update", authorization.CollectionsMetadata(class))
 	if err != nil {
 		return err
 	}
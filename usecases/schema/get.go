@@ -18,11 +18,12 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // GetSchema retrieves a locally cached copy of the schema
 func (m *Manager) GetSchema(principal *models.Principal) (schema.Schema, error) {
-	err := m.Authorizer.Authorize(principal, "list", "schema/*")
+	err := m.Authorizer.Authorize(principal, "list", authorization.CollectionsMetadata(""))
 	if err != nil {
 		return schema.Schema{}, err
 	}
@@ -56,7 +57,7 @@ func (m *Manager) IndexedInverted(className, propertyName string) bool {
 func (m *Manager) GetClass(ctx context.Context, principal *models.Principal,
 	name string,
 ) (*models.Class, error) {
-	err := m.Authorizer.Authorize(principal, "list", "schema/*")
+	err := m.Authorizer.Authorize(principal, "list", authorization.CollectionsMetadata(name))
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +107,7 @@ func (m *Manager) ClusterHealthScore() int {
 func (m *Manager) GetShardsStatus(ctx context.Context, principal *models.Principal,
 	className, tenant string,
 ) (models.ShardStatusList, error) {
-	err := m.Authorizer.Authorize(principal, "list", fmt.Sprintf("schema/%s/shards", className))
+	err := m.Authorizer.Authorize(principal, "list", authorization.ShardsMetadata(className))
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +62,14 @@ func (f *fakeRepo) DeleteShards(_ context.Context, class string, _ []string) err
 	return nil
 }
 
+func (f *fakeRepo) PutMetadata(_ context.Context, key string, value []byte) error {
+	return nil
+}
+
+func (f *fakeRepo) DeleteMetadata(_ context.Context, key string) error {
+	return nil
+}
+
 type fakeAuthorizer struct{}
 
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
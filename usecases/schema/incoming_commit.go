@@ -38,6 +38,8 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 		return m.handleUpdateTenantsCommit(ctx, tx)
 	case deleteTenants:
 		return m.handleDeleteTenantsCommit(ctx, tx)
+	case putMetadata:
+		return m.handlePutMetadataCommit(ctx, tx)
 	case ReadSchema:
 		return nil
 	default:
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -35,6 +36,7 @@ type Manager struct {
 	migrator                migrate.Migrator
 	repo                    SchemaStore
 	callbacks               []func(updatedSchema schema.Schema)
+	metadataCallbacks       []metadataCallback
 	logger                  logrus.FieldLogger
 	Authorizer              authorizer
 	config                  config.Config
@@ -55,6 +57,16 @@ type Manager struct {
 	// schema is out of sync and only then do we try to resume transactions.
 	shouldTryToResumeTx bool
 
+	auditLog *audit.Logger
+
+	tenantsJobs tenantsJobs
+	rebalances  rebalances
+	reshards    reshards
+
+	// partitionsLock serializes the creation of temporal partitions, so that
+	// concurrent writes to a new partition only create it once
+	partitionsLock sync.Mutex
+
 	schemaCache
 }
 
@@ -73,6 +85,7 @@ type SchemaGetter interface {
 	ShardOwner(class, shard string) (string, error)
 	TenantShard(class, tenant string) (string, string)
 	ShardFromUUID(class string, uuid []byte) string
+	PreviousShardFromUUID(class string, uuid []byte) string
 	ShardReplicas(class, shard string) ([]string, error)
 }
 
@@ -114,6 +127,13 @@ type SchemaStore interface {
 	// DeleteShards deletes shards from a class
 	// If the class or a shard does not exist then nothing is done and a nil error is returned
 	DeleteShards(ctx context.Context, class string, shards []string) error
+
+	// PutMetadata sets the value of a metadata key
+	PutMetadata(ctx context.Context, key string, value []byte) error
+
+	// DeleteMetadata deletes a metadata key
+	// If the key does not exist then nothing is done and a nil error is returned
+	DeleteMetadata(ctx context.Context, key string) error
 }
 
 // KeyValuePair is used to serialize shards updates
@@ -153,7 +173,8 @@ type clusterState interface {
 type scaleOut interface {
 	SetSchemaManager(sm scaler.SchemaManager)
 	Scale(ctx context.Context, className string,
-		updated sharding.Config, prevReplFactor, newReplFactor int64) (*sharding.State, error)
+		updated sharding.Config, prevReplFactor, newReplFactor int64,
+		progress *scaler.Progress) (*sharding.State, error)
 }
 
 // NewManager creates a new manager
@@ -232,6 +253,11 @@ func (m *Manager) saveSchema(ctx context.Context, st State) error {
 	return nil
 }
 
+// SetAuditLog records all schema changes in the given audit log
+func (m *Manager) SetAuditLog(auditLog *audit.Logger) {
+	m.auditLog = auditLog
+}
+
 // RegisterSchemaUpdateCallback allows other usecases to register a primitive
 // type update callback. The callbacks will be called any time we persist a
 // schema update
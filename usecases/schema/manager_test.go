@@ -13,6 +13,7 @@ package schema
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 
 	"github.com/sirupsen/logrus/hooks/test"
@@ -73,6 +74,18 @@ func (n *NilMigrator) DeleteTenants(ctx context.Context, class *models.Class, te
 	return func(bool) {}, nil
 }
 
+func (n *NilMigrator) DropShards(ctx context.Context, className string, shards []string) error {
+	return nil
+}
+
+func (n *NilMigrator) AddShards(ctx context.Context, class *models.Class, shards []string) error {
+	return nil
+}
+
+func (n *NilMigrator) MoveReshardedObjects(ctx context.Context, className, shard string, moved *atomic.Int64) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
@@ -654,7 +667,7 @@ func Test_ExtendSchemaWithExistingPropName(t *testing.T) {
 type fakeScaleOutManager struct{}
 
 func (f *fakeScaleOutManager) Scale(ctx context.Context,
-	className string, updated sharding.Config, _, _ int64,
+	className string, updated sharding.Config, _, _ int64, _ *scaler.Progress,
 ) (*sharding.State, error) {
 	return nil, nil
 }
@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+// metadataCallback is called whenever a metadata key with the prefix changed
+type metadataCallback struct {
+	prefix string
+	fn     func()
+}
+
+// PutMetadata sets the value of a metadata key on all nodes in the cluster.
+// Metadata is replicated and persisted together with the schema, so other
+// usecases can keep small documents, e.g. rbac roles, in sync across nodes.
+func (m *Manager) PutMetadata(ctx context.Context, key string, value []byte) error {
+	if key == "" {
+		return fmt.Errorf("metadata key must not be empty")
+	}
+	if len(value) == 0 {
+		return fmt.Errorf("metadata value of %q must not be empty", key)
+	}
+	return m.updateMetadata(ctx, PutMetadataPayload{Key: key, Value: value})
+}
+
+// DeleteMetadata deletes a metadata key on all nodes in the cluster
+func (m *Manager) DeleteMetadata(ctx context.Context, key string) error {
+	if key == "" {
+		return fmt.Errorf("metadata key must not be empty")
+	}
+	return m.updateMetadata(ctx, PutMetadataPayload{Key: key})
+}
+
+// Metadata returns all keys with the given prefix and their values
+func (m *Manager) Metadata(prefix string) map[string][]byte {
+	out := map[string][]byte{}
+	m.schemaCache.RLockGuard(func() error {
+		for key, value := range m.schemaCache.Metadata {
+			if strings.HasPrefix(key, prefix) {
+				out[key] = value
+			}
+		}
+		return nil
+	})
+	return out
+}
+
+// RegisterMetadataCallback registers a callback which is called any time a
+// metadata key with the given prefix changed, no matter on which node the
+// change was made
+func (m *Manager) RegisterMetadataCallback(prefix string, callback func()) {
+	m.metadataCallbacks = append(m.metadataCallbacks,
+		metadataCallback{prefix: prefix, fn: callback})
+}
+
+func (m *Manager) updateMetadata(ctx context.Context, pl PutMetadataPayload) error {
+	// open cluster-wide transaction
+	tx, err := m.cluster.BeginTransaction(ctx, putMetadata, pl, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.onPutMetadata(ctx, pl) // actual update
+}
+
+func (m *Manager) handlePutMetadataCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	pl, ok := tx.Payload.(PutMetadataPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be PutMetadataPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.onPutMetadata(ctx, pl)
+}
+
+func (m *Manager) onPutMetadata(ctx context.Context, pl PutMetadataPayload) error {
+	m.logger.
+		WithField("action", "schema.put_metadata").
+		WithField("key", pl.Key).Debugf("persist metadata update")
+
+	if len(pl.Value) == 0 {
+		if err := m.repo.DeleteMetadata(ctx, pl.Key); err != nil {
+			return err
+		}
+	} else if err := m.repo.PutMetadata(ctx, pl.Key, pl.Value); err != nil {
+		return err
+	}
+
+	m.schemaCache.LockGuard(func() {
+		if len(pl.Value) == 0 {
+			delete(m.schemaCache.Metadata, pl.Key)
+			return
+		}
+		if m.schemaCache.Metadata == nil {
+			m.schemaCache.Metadata = map[string][]byte{}
+		}
+		m.schemaCache.Metadata[pl.Key] = pl.Value
+	})
+
+	m.triggerMetadataCallbacks(pl.Key)
+	return nil
+}
+
+func (m *Manager) triggerMetadataCallbacks(key string) {
+	for _, cb := range m.metadataCallbacks {
+		if strings.HasPrefix(key, cb.prefix) {
+			cb.fn()
+		}
+	}
+}
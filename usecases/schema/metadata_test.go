@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+func TestMetadata(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+
+	changes := 0
+	sm.RegisterMetadataCallback("roles/", func() { changes++ })
+
+	t.Run("put and delete keys", func(t *testing.T) {
+		require.Nil(t, sm.PutMetadata(ctx, "roles/reader", []byte(`{"name":"reader"}`)))
+		require.Nil(t, sm.PutMetadata(ctx, "apikeys/abc", []byte(`{"id":"abc"}`)))
+
+		assert.Equal(t, map[string][]byte{"roles/reader": []byte(`{"name":"reader"}`)},
+			sm.Metadata("roles/"))
+		assert.Equal(t, 1, changes)
+
+		require.Nil(t, sm.DeleteMetadata(ctx, "roles/reader"))
+		assert.Empty(t, sm.Metadata("roles/"))
+		assert.Len(t, sm.Metadata(""), 1)
+		assert.Equal(t, 2, changes)
+	})
+
+	t.Run("invalid keys and values", func(t *testing.T) {
+		assert.NotNil(t, sm.PutMetadata(ctx, "", []byte("{}")))
+		assert.NotNil(t, sm.PutMetadata(ctx, "roles/reader", nil))
+		assert.NotNil(t, sm.DeleteMetadata(ctx, ""))
+	})
+
+	t.Run("apply a change made on another node", func(t *testing.T) {
+		payload, err := UnmarshalTransaction(putMetadata,
+			[]byte(`{"key":"roles/writer","value":"eyJuYW1lIjoid3JpdGVyIn0="}`))
+		require.Nil(t, err)
+
+		err = sm.handleCommit(ctx, &cluster.Transaction{Type: putMetadata, Payload: payload})
+		require.Nil(t, err)
+		assert.Equal(t, map[string][]byte{"roles/writer": []byte(`{"name":"writer"}`)},
+			sm.Metadata("roles/"))
+		assert.Equal(t, 3, changes)
+	})
+}
@@ -16,6 +16,7 @@ package migrate
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -49,6 +50,9 @@ type Migrator interface {
 	NewTenants(ctx context.Context, class *models.Class, creates []*CreateTenantPayload) (commit func(success bool), err error)
 	UpdateTenants(ctx context.Context, class *models.Class, updates []*UpdateTenantPayload) (commit func(success bool), err error)
 	DeleteTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error)
+	DropShards(ctx context.Context, className string, shards []string) error
+	AddShards(ctx context.Context, class *models.Class, shards []string) error
+	MoveReshardedObjects(ctx context.Context, className, shard string, moved *atomic.Int64) error
 
 	ValidateVectorIndexConfigUpdate(ctx context.Context,
 		old, updated schema.VectorIndexConfig) error
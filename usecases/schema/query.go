@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func validateQueryConfig(class *models.Class) error {
+	if class.QueryConfig == nil {
+		return nil
+	}
+	if class.QueryConfig.TimeoutMilliseconds < 0 {
+		return fmt.Errorf("queryConfig.timeoutMilliseconds must not be negative, got %d",
+			class.QueryConfig.TimeoutMilliseconds)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestValidateQueryConfig(t *testing.T) {
+	assert.Nil(t, validateQueryConfig(&models.Class{}))
+	assert.Nil(t, validateQueryConfig(&models.Class{
+		QueryConfig: &models.QueryConfig{TimeoutMilliseconds: 500},
+	}))
+	assert.ErrorContains(t, validateQueryConfig(&models.Class{
+		QueryConfig: &models.QueryConfig{TimeoutMilliseconds: -1},
+	}), "must not be negative")
+}
@@ -12,6 +12,7 @@
 package schema
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -92,6 +93,21 @@ func Equal(lhs, rhs *State) error {
 	if err := equalSharding(lhs.ShardingState, rhs.ShardingState); err != nil {
 		return fmt.Errorf("sharding state mismatch: %w", err)
 	}
+	if err := equalMetadata(lhs.Metadata, rhs.Metadata); err != nil {
+		return fmt.Errorf("metadata mismatch: %w", err)
+	}
+	return nil
+}
+
+func equalMetadata(lhs, rhs map[string][]byte) error {
+	if m, n := len(lhs), len(rhs); m != n {
+		return fmt.Errorf("key count mismatch: %d!=%d", m, n)
+	}
+	for key, value := range lhs {
+		if !bytes.Equal(value, rhs[key]) {
+			return fmt.Errorf("value mismatch for key %q", key)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,312 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tracing"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// Statuses of a rebalance
+const (
+	RebalanceRunning = "RUNNING"
+	RebalanceSuccess = "SUCCESS"
+	RebalanceFailed  = "FAILED"
+)
+
+var (
+	// ErrRebalanceRunning indicates that the replication factor of a class is
+	// being changed already
+	ErrRebalanceRunning = errors.New("replication factor is being changed already")
+	// ErrRebalanceNotFound indicates that the replication factor of a class
+	// has not been changed since this node started
+	ErrRebalanceNotFound = errors.New("rebalance not found")
+)
+
+// Rebalance tracks the change of the replication factor of a class. The
+// shard replicas are copied to new nodes in the background, the new factor
+// takes effect once all of them have been copied. Replicas which are no
+// longer needed are dropped by their nodes when the new factor takes effect.
+type Rebalance struct {
+	Class      string `json:"class"`
+	FromFactor int64  `json:"fromFactor"`
+	ToFactor   int64  `json:"toFactor"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	// ReplicasTotal is the number of shard replicas to copy or to remove
+	ReplicasTotal int64      `json:"replicasTotal"`
+	ReplicasDone  int64      `json:"replicasDone"`
+	StartedAt     time.Time  `json:"startedAt"`
+	CompletedAt   *time.Time `json:"completedAt,omitempty"`
+}
+
+type rebalance struct {
+	Rebalance
+	progress *scaler.Progress
+}
+
+// rebalances are kept in memory of the node which received the request,
+// only the latest one of each class is kept
+type rebalances struct {
+	sync.Mutex
+	jobs map[string]*rebalance
+}
+
+// Rebalance returns the latest change of the replication factor of a class
+func (m *Manager) Rebalance(ctx context.Context, principal *models.Principal,
+	class string,
+) (*Rebalance, error) {
+	if err := m.Authorizer.Authorize(principal, "get", authorization.CollectionsMetadata(class)); err != nil {
+		return nil, err
+	}
+
+	m.rebalances.Lock()
+	defer m.rebalances.Unlock()
+	job, ok := m.rebalances.jobs[schema.UppercaseClassName(class)]
+	if !ok {
+		return nil, fmt.Errorf("class %q: %w", class, ErrRebalanceNotFound)
+	}
+	return job.snapshot(), nil
+}
+
+// checkRebalance fails if the replication factor of a class is being
+// changed already
+func (m *Manager) checkRebalance(class string) error {
+	m.rebalances.Lock()
+	defer m.rebalances.Unlock()
+	if job, ok := m.rebalances.jobs[class]; ok && job.Status == RebalanceRunning {
+		return fmt.Errorf("class %q: %w", class, ErrRebalanceRunning)
+	}
+	return nil
+}
+
+// startRebalance changes the replication factor of a class in the
+// background. It must be called with the lock of the manager held, so that
+// only one rebalance of a class is started.
+func (m *Manager) startRebalance(ctx context.Context, class string,
+	cfg sharding.Config, from, to int64,
+) {
+	job := &rebalance{
+		Rebalance: Rebalance{
+			Class:      class,
+			FromFactor: from,
+			ToFactor:   to,
+			Status:     RebalanceRunning,
+			StartedAt:  time.Now(),
+		},
+		progress: &scaler.Progress{},
+	}
+
+	m.rebalances.Lock()
+	if m.rebalances.jobs == nil {
+		m.rebalances.jobs = map[string]*rebalance{}
+	}
+	m.rebalances.jobs[class] = job
+	m.rebalances.Unlock()
+
+	// the job outlives the request, but keeps its id for the logs
+	jobCtx := tracing.WithRequestID(context.Background(), tracing.RequestID(ctx))
+	go m.runRebalance(jobCtx, job, cfg)
+}
+
+func (m *Manager) runRebalance(ctx context.Context, job *rebalance, cfg sharding.Config) {
+	logger := m.logger.WithField("action", "rebalance").WithField("class", job.Class).
+		WithField("from", job.FromFactor).WithField("to", job.ToFactor)
+	logger.Info("changing replication factor")
+
+	st, err := m.scaleOut.Scale(ctx, job.Class, cfg, job.FromFactor, job.ToFactor, job.progress)
+	if err == nil && st == nil {
+		err = fmt.Errorf("no sharding state for class %q", job.Class)
+	}
+	if err == nil {
+		err = m.commitRebalance(ctx, job.Class, job.ToFactor, st)
+	}
+
+	m.rebalances.Lock()
+	now := time.Now()
+	job.CompletedAt = &now
+	job.Status = RebalanceSuccess
+	if err != nil {
+		job.Status = RebalanceFailed
+		job.Error = err.Error()
+	} else {
+		// removed replicas have been dropped as part of the commit
+		job.progress.Done.Store(job.progress.Total.Load())
+	}
+	m.rebalances.Unlock()
+
+	if err != nil {
+		logger.WithError(err).Error("could not change replication factor")
+		return
+	}
+	logger.WithField("took", now.Sub(job.StartedAt)).Info("changed replication factor")
+}
+
+// commitRebalance broadcasts the new replication factor along with the
+// replicas of each shard. Other changes of the class and its shards which
+// were made during the rebalance are kept.
+func (m *Manager) commitRebalance(ctx context.Context, className string,
+	factor int64, scaled *sharding.State,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	current := m.getClassByName(className)
+	if current == nil {
+		return ErrNotFound
+	}
+	st := m.CopyShardingState(className)
+	if st == nil {
+		return fmt.Errorf("no sharding state for class %q", className)
+	}
+	for name, shard := range st.Physical {
+		replicas, ok := scaled.Physical[name]
+		if !ok {
+			return fmt.Errorf("shard %q has been added during the rebalance", name)
+		}
+		// replicas only get added or removed by a rebalance, otherwise
+		// the shard has been moved in the meantime
+		if !subset(shard.BelongsToNodes, replicas.BelongsToNodes) &&
+			!subset(replicas.BelongsToNodes, shard.BelongsToNodes) {
+			return fmt.Errorf("replicas of shard %q have been moved during the rebalance", name)
+		}
+		shard.BelongsToNodes = replicas.BelongsToNodes
+		st.Physical[name] = shard
+	}
+	st.Config = scaled.Config
+
+	updated := *current
+	rc := models.ReplicationConfig{}
+	if current.ReplicationConfig != nil {
+		rc = *current.ReplicationConfig
+	}
+	rc.Factor = factor
+	updated.ReplicationConfig = &rc
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
+		UpdateClassPayload{className, &updated, st}, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return fmt.Errorf("commit cluster-wide transaction: %w", err)
+	}
+
+	return m.updateClassApplyChanges(ctx, className, &updated, st)
+}
+
+// CommitShardMove replaces the source with the target node in the replicas
+// of a shard, once the shard has been copied to the target. The source node
+// drops its replica when the change is committed.
+func (m *Manager) CommitShardMove(ctx context.Context, className, shard, source, target string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if err := m.checkRebalance(className); err != nil {
+		return err
+	}
+	current := m.getClassByName(className)
+	if current == nil {
+		return ErrNotFound
+	}
+	st := m.CopyShardingState(className)
+	if st == nil {
+		return fmt.Errorf("no sharding state for class %q", className)
+	}
+	physical, ok := st.Physical[shard]
+	if !ok {
+		return fmt.Errorf("shard %q of class %q: %w", shard, className, ErrNotFound)
+	}
+	pos := -1
+	for i, node := range physical.BelongsToNodes {
+		switch node {
+		case source:
+			pos = i
+		case target:
+			return fmt.Errorf("shard %q has a replica on node %q already", shard, target)
+		}
+	}
+	if pos < 0 {
+		return fmt.Errorf("shard %q has no replica on node %q", shard, source)
+	}
+	physical.BelongsToNodes[pos] = target
+	st.Physical[shard] = physical
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
+		UpdateClassPayload{className, current, st}, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return fmt.Errorf("commit cluster-wide transaction: %w", err)
+	}
+
+	return m.updateClassApplyChanges(ctx, className, current, st)
+}
+
+// subset returns whether all xs are contained in ys
+func subset(xs, ys []string) bool {
+	for _, x := range xs {
+		found := false
+		for _, y := range ys {
+			if x == y {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// removedReplicas returns the shards which are no longer replicated on this
+// node in the updated sharding state
+func (m *Manager) removedReplicas(className string, updated *sharding.State) []string {
+	before := m.CopyShardingState(className)
+	if before == nil {
+		return nil
+	}
+	local := m.clusterState.LocalName()
+	var removed []string
+	for name, shard := range before.Physical {
+		if _, ok := updated.Physical[name]; !ok || updated.IsLocalShard(name) {
+			continue
+		}
+		for _, node := range shard.BelongsToNodes {
+			if node == local {
+				removed = append(removed, name)
+				break
+			}
+		}
+	}
+	return removed
+}
+
+// snapshot must be called with the rebalances locked
+func (j *rebalance) snapshot() *Rebalance {
+	r := j.Rebalance
+	r.ReplicasTotal = j.progress.Total.Load()
+	r.ReplicasDone = j.progress.Done.Load()
+	return &r
+}
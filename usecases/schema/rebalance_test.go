@@ -0,0 +1,214 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// fakeRebalancer assigns all shards to the given nodes
+type fakeRebalancer struct {
+	schema scaler.SchemaManager
+	nodes  []string
+	block  chan struct{}
+	err    error
+}
+
+func (f *fakeRebalancer) SetSchemaManager(sm scaler.SchemaManager) {
+	f.schema = sm
+}
+
+func (f *fakeRebalancer) Scale(ctx context.Context, className string,
+	updated sharding.Config, _, _ int64, progress *scaler.Progress,
+) (*sharding.State, error) {
+	progress.Total.Store(1)
+	if f.block != nil {
+		<-f.block
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	st := f.schema.CopyShardingState(className)
+	for name, shard := range st.Physical {
+		shard.BelongsToNodes = f.nodes
+		st.Physical[name] = shard
+	}
+	st.Config = updated
+	return st, nil
+}
+
+type twoNodes struct {
+	fakeClusterState
+}
+
+func (f *twoNodes) NodeCount() int {
+	return 2
+}
+
+type droppingMigrator struct {
+	NilMigrator
+	sync.Mutex
+	dropped []string
+}
+
+func (m *droppingMigrator) DropShards(ctx context.Context, className string, shards []string) error {
+	m.Lock()
+	defer m.Unlock()
+	m.dropped = append(m.dropped, shards...)
+	return nil
+}
+
+func TestRebalance(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	sm.clusterState = &twoNodes{fakeClusterState{hosts: []string{"node1", "node2"}}}
+	scale := &fakeRebalancer{schema: sm}
+	sm.scaleOut = scale
+	migrator := &droppingMigrator{}
+	sm.migrator = migrator
+
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:             "C1",
+		ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+	}))
+
+	update := func(factor int64, description string) error {
+		return sm.UpdateClass(ctx, nil, "C1", &models.Class{
+			Class:             "C1",
+			Description:       description,
+			ReplicationConfig: &models.ReplicationConfig{Factor: factor},
+		})
+	}
+	factor := func() int64 {
+		sm.RLock()
+		defer sm.RUnlock()
+		return sm.getClassByName("C1").ReplicationConfig.Factor
+	}
+	wait := func(t *testing.T) *Rebalance {
+		var r *Rebalance
+		require.Eventually(t, func() bool {
+			var err error
+			r, err = sm.Rebalance(ctx, nil, "c1")
+			require.Nil(t, err)
+			return r.Status != RebalanceRunning
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.NotNil(t, r.CompletedAt)
+		return r
+	}
+
+	t.Run("none yet", func(t *testing.T) {
+		_, err := sm.Rebalance(ctx, nil, "C1")
+		assert.True(t, errors.Is(err, ErrRebalanceNotFound))
+	})
+
+	t.Run("scale out", func(t *testing.T) {
+		scale.nodes = []string{"node1", "node2"}
+		scale.block = make(chan struct{})
+		require.Nil(t, update(2, "scaled out"))
+
+		// other changes take effect right away, the factor once the
+		// replicas have been copied
+		assert.Equal(t, "scaled out", sm.getClassByName("C1").Description)
+		assert.Equal(t, int64(1), factor())
+		r, err := sm.Rebalance(ctx, nil, "C1")
+		require.Nil(t, err)
+		assert.Equal(t, RebalanceRunning, r.Status)
+		assert.Equal(t, int64(1), r.FromFactor)
+		assert.Equal(t, int64(2), r.ToFactor)
+
+		err = update(2, "again")
+		assert.True(t, errors.Is(err, ErrRebalanceRunning))
+		require.Nil(t, update(1, "unchanged factor"))
+
+		close(scale.block)
+		r = wait(t)
+		assert.Equal(t, RebalanceSuccess, r.Status)
+		assert.Equal(t, int64(1), r.ReplicasTotal)
+		assert.Equal(t, int64(1), r.ReplicasDone)
+		assert.Equal(t, int64(2), factor())
+		assert.Equal(t, "unchanged factor", sm.getClassByName("C1").Description)
+		for _, shard := range sm.CopyShardingState("C1").Physical {
+			assert.Equal(t, []string{"node1", "node2"}, shard.BelongsToNodes)
+		}
+		assert.Empty(t, migrator.dropped)
+	})
+
+	t.Run("scale in drops local replicas", func(t *testing.T) {
+		scale.nodes = []string{"node2"}
+		require.Nil(t, update(1, ""))
+		r := wait(t)
+		assert.Equal(t, RebalanceSuccess, r.Status)
+		assert.Equal(t, int64(1), factor())
+
+		st := sm.CopyShardingState("C1")
+		shards := st.AllPhysicalShards()
+		sort.Strings(migrator.dropped)
+		assert.Equal(t, shards, migrator.dropped)
+		for _, name := range shards {
+			assert.False(t, st.IsLocalShard(name))
+		}
+	})
+
+	t.Run("failure keeps the factor", func(t *testing.T) {
+		scale.err = errors.New("node2 unreachable")
+		require.Nil(t, update(2, ""))
+		r := wait(t)
+		assert.Equal(t, RebalanceFailed, r.Status)
+		assert.Equal(t, "node2 unreachable", r.Error)
+		assert.Equal(t, int64(1), factor())
+	})
+}
+
+func TestCommitShardMove(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	sm.clusterState = &twoNodes{fakeClusterState{hosts: []string{"node1", "node2"}}}
+	migrator := &droppingMigrator{}
+	sm.migrator = migrator
+
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:             "C1",
+		ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+		ShardingConfig:    map[string]interface{}{"desiredCount": 2},
+	}))
+	var local string
+	for name, shard := range sm.CopyShardingState("C1").Physical {
+		if shard.BelongsToNodes[0] == "node1" {
+			local = name
+		}
+	}
+	require.NotEmpty(t, local)
+
+	require.Nil(t, sm.CommitShardMove(ctx, "C1", local, "node1", "node2"))
+	st := sm.CopyShardingState("C1")
+	assert.Equal(t, []string{"node2"}, st.Physical[local].BelongsToNodes)
+	assert.False(t, st.IsLocalShard(local))
+	assert.Equal(t, []string{local}, migrator.dropped)
+
+	err := sm.CommitShardMove(ctx, "C1", local, "node1", "node2")
+	assert.ErrorContains(t, err, "already")
+	err = sm.CommitShardMove(ctx, "C1", local, "node3", "node1")
+	assert.ErrorContains(t, err, "no replica")
+	err = sm.CommitShardMove(ctx, "C1", "unknown", "node2", "node1")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
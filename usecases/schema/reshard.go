@@ -0,0 +1,241 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tracing"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// Statuses of a reshard
+const (
+	ReshardRunning = "RUNNING"
+	ReshardSuccess = "SUCCESS"
+	ReshardFailed  = "FAILED"
+)
+
+var (
+	// ErrReshardRunning indicates that a class is being resharded already
+	ErrReshardRunning = errors.New("class is being resharded already")
+	// ErrReshardNotFound indicates that a class has not been resharded
+	// since this node started
+	ErrReshardNotFound = errors.New("reshard not found")
+)
+
+// Reshard tracks the increase of the shard count of a class. The new shards
+// are created and receive writes right away. Objects which belong to them
+// are moved in the background, until then they are read from the shards
+// they are moving from. The new shard count takes effect once all objects
+// have been moved.
+//
+// If the node running the reshard is restarted, the class stays in the
+// resharding state. Updating the class without changing the shard count
+// resumes the reshard.
+type Reshard struct {
+	Class     string `json:"class"`
+	FromCount int    `json:"fromCount"`
+	ToCount   int    `json:"toCount"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	// ShardsTotal is the number of shards which hand over objects
+	ShardsTotal  int64      `json:"shardsTotal"`
+	ShardsDone   int64      `json:"shardsDone"`
+	ObjectsMoved int64      `json:"objectsMoved"`
+	StartedAt    time.Time  `json:"startedAt"`
+	CompletedAt  *time.Time `json:"completedAt,omitempty"`
+}
+
+type reshard struct {
+	Reshard
+	shards     []string
+	shardsDone atomic.Int64
+	moved      atomic.Int64
+}
+
+// reshards are kept in memory of the node which started them, only the
+// latest one of each class is kept
+type reshards struct {
+	sync.Mutex
+	jobs map[string]*reshard
+}
+
+// Reshard returns the latest increase of the shard count of a class
+func (m *Manager) Reshard(ctx context.Context, principal *models.Principal,
+	class string,
+) (*Reshard, error) {
+	if err := m.Authorizer.Authorize(principal, "get", authorization.CollectionsMetadata(class)); err != nil {
+		return nil, err
+	}
+
+	m.reshards.Lock()
+	defer m.reshards.Unlock()
+	job, ok := m.reshards.jobs[schema.UppercaseClassName(class)]
+	if !ok {
+		return nil, fmt.Errorf("class %q: %w", class, ErrReshardNotFound)
+	}
+	return job.snapshot(), nil
+}
+
+// checkReshard fails if a class is being resharded already
+func (m *Manager) checkReshard(class string) error {
+	if m.reshardRunning(class) {
+		return fmt.Errorf("class %q: %w", class, ErrReshardRunning)
+	}
+	if st := m.CopyShardingState(class); st != nil && st.Resharding() {
+		return fmt.Errorf("class %q: %w", class, ErrReshardRunning)
+	}
+	return nil
+}
+
+func (m *Manager) reshardRunning(class string) bool {
+	m.reshards.Lock()
+	defer m.reshards.Unlock()
+	job, ok := m.reshards.jobs[class]
+	return ok && job.Status == ReshardRunning
+}
+
+// reshardInterrupted returns whether a class is in the resharding state
+// without this node moving its objects
+func (m *Manager) reshardInterrupted(class string) bool {
+	st := m.CopyShardingState(class)
+	return st != nil && st.Resharding() && !m.reshardRunning(class)
+}
+
+// startReshard moves the objects of the given shards which belong to the
+// new shards in the background. It must be called with the lock of the
+// manager held, so that only one reshard of a class is started.
+func (m *Manager) startReshard(ctx context.Context, class string,
+	shards []string, from, to int,
+) {
+	job := &reshard{
+		Reshard: Reshard{
+			Class:       class,
+			FromCount:   from,
+			ToCount:     to,
+			Status:      ReshardRunning,
+			ShardsTotal: int64(len(shards)),
+			StartedAt:   time.Now(),
+		},
+		shards: shards,
+	}
+
+	m.reshards.Lock()
+	if m.reshards.jobs == nil {
+		m.reshards.jobs = map[string]*reshard{}
+	}
+	m.reshards.jobs[class] = job
+	m.reshards.Unlock()
+
+	// the job outlives the request, but keeps its id for the logs
+	jobCtx := tracing.WithRequestID(context.Background(), tracing.RequestID(ctx))
+	go m.runReshard(jobCtx, job)
+}
+
+func (m *Manager) runReshard(ctx context.Context, job *reshard) {
+	logger := m.logger.WithField("action", "reshard").WithField("class", job.Class).
+		WithField("from", job.FromCount).WithField("to", job.ToCount)
+	logger.Info("resharding class")
+
+	var err error
+	for _, shard := range job.shards {
+		if err = m.migrator.MoveReshardedObjects(ctx, job.Class, shard, &job.moved); err != nil {
+			err = fmt.Errorf("move objects of shard %q: %w", shard, err)
+			break
+		}
+		job.shardsDone.Add(1)
+	}
+	if err == nil {
+		err = m.commitReshard(ctx, job.Class)
+	}
+
+	m.reshards.Lock()
+	now := time.Now()
+	job.CompletedAt = &now
+	job.Status = ReshardSuccess
+	if err != nil {
+		job.Status = ReshardFailed
+		job.Error = err.Error()
+	}
+	m.reshards.Unlock()
+
+	if err != nil {
+		logger.WithError(err).Error("could not reshard class")
+		return
+	}
+	logger.WithField("took", now.Sub(job.StartedAt)).
+		WithField("objects_moved", job.moved.Load()).Info("resharded class")
+}
+
+// commitReshard broadcasts the end of the reshard, objects are no longer
+// looked up in the shards they have been moved from
+func (m *Manager) commitReshard(ctx context.Context, className string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	current := m.getClassByName(className)
+	if current == nil {
+		return ErrNotFound
+	}
+	st := m.CopyShardingState(className)
+	if st == nil || !st.Resharding() {
+		return fmt.Errorf("class %q is not being resharded", className)
+	}
+	st.FinishResharding()
+
+	updated := *current
+	updated.ShardingConfig = st.Config
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
+		UpdateClassPayload{className, &updated, st}, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return fmt.Errorf("commit cluster-wide transaction: %w", err)
+	}
+
+	return m.updateClassApplyChanges(ctx, className, &updated, st)
+}
+
+// addedShards returns the shards of the updated sharding state which are
+// new and served by this node
+func (m *Manager) addedShards(className string, updated *sharding.State) []string {
+	before := m.CopyShardingState(className)
+	if before == nil {
+		return nil
+	}
+	var added []string
+	for name := range updated.Physical {
+		if _, ok := before.Physical[name]; !ok && updated.IsLocalShard(name) {
+			added = append(added, name)
+		}
+	}
+	return added
+}
+
+// snapshot must be called with the reshards locked
+func (j *reshard) snapshot() *Reshard {
+	r := j.Reshard
+	r.ShardsDone = j.shardsDone.Load()
+	r.ObjectsMoved = j.moved.Load()
+	return &r
+}
@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type reshardingMigrator struct {
+	NilMigrator
+	sync.Mutex
+	added []string
+	moved []string
+	block chan struct{}
+	err   error
+}
+
+func (m *reshardingMigrator) AddShards(ctx context.Context, class *models.Class, shards []string) error {
+	m.Lock()
+	defer m.Unlock()
+	m.added = append(m.added, shards...)
+	return nil
+}
+
+func (m *reshardingMigrator) MoveReshardedObjects(ctx context.Context, className, shard string, moved *atomic.Int64) error {
+	if m.block != nil {
+		<-m.block
+	}
+	if m.err != nil {
+		return m.err
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.moved = append(m.moved, shard)
+	moved.Add(10)
+	return nil
+}
+
+func TestReshard(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	migrator := &reshardingMigrator{}
+	sm.migrator = migrator
+
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:          "C1",
+		ShardingConfig: map[string]interface{}{"desiredCount": 2},
+	}))
+	before := sm.CopyShardingState("C1").AllPhysicalShards()
+
+	update := func(count int, description string) error {
+		return sm.UpdateClass(ctx, nil, "C1", &models.Class{
+			Class:          "C1",
+			Description:    description,
+			ShardingConfig: map[string]interface{}{"desiredCount": count},
+		})
+	}
+	config := func() sharding.Config {
+		sm.RLock()
+		defer sm.RUnlock()
+		return sm.getClassByName("C1").ShardingConfig.(sharding.Config)
+	}
+	wait := func(t *testing.T) *Reshard {
+		var r *Reshard
+		require.Eventually(t, func() bool {
+			var err error
+			r, err = sm.Reshard(ctx, nil, "c1")
+			require.Nil(t, err)
+			return r.Status != ReshardRunning
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.NotNil(t, r.CompletedAt)
+		return r
+	}
+
+	t.Run("none yet", func(t *testing.T) {
+		_, err := sm.Reshard(ctx, nil, "C1")
+		assert.True(t, errors.Is(err, ErrReshardNotFound))
+	})
+
+	t.Run("shard count cannot be decreased", func(t *testing.T) {
+		err := update(1, "")
+		assert.ErrorContains(t, err, "can only be increased")
+	})
+
+	t.Run("increase shard count", func(t *testing.T) {
+		migrator.block = make(chan struct{})
+		require.Nil(t, update(4, "resharded"))
+
+		// the new shards are created right away
+		st := sm.CopyShardingState("C1")
+		require.Len(t, st.Physical, 4)
+		assert.True(t, st.Resharding())
+		sort.Strings(migrator.added)
+		var added []string
+		for _, name := range st.AllPhysicalShards() {
+			if name != before[0] && name != before[1] {
+				added = append(added, name)
+			}
+		}
+		assert.Equal(t, added, migrator.added)
+		assert.Equal(t, 4, config().DesiredCount)
+		assert.Equal(t, 2, config().ActualCount)
+		assert.Equal(t, "resharded", sm.getClassByName("C1").Description)
+
+		r, err := sm.Reshard(ctx, nil, "C1")
+		require.Nil(t, err)
+		assert.Equal(t, ReshardRunning, r.Status)
+		assert.Equal(t, 2, r.FromCount)
+		assert.Equal(t, 4, r.ToCount)
+
+		err = update(6, "")
+		assert.True(t, errors.Is(err, ErrReshardRunning))
+		require.Nil(t, update(4, "unchanged count"))
+		assert.Equal(t, 2, config().ActualCount)
+
+		close(migrator.block)
+		r = wait(t)
+		assert.Equal(t, ReshardSuccess, r.Status)
+		assert.Equal(t, int64(2), r.ShardsTotal)
+		assert.Equal(t, int64(2), r.ShardsDone)
+		assert.Equal(t, int64(20), r.ObjectsMoved)
+		sort.Strings(migrator.moved)
+		assert.Equal(t, before, migrator.moved)
+
+		assert.False(t, sm.CopyShardingState("C1").Resharding())
+		assert.Equal(t, 4, config().ActualCount)
+		assert.Equal(t, "unchanged count", sm.getClassByName("C1").Description)
+	})
+
+	t.Run("failure keeps resharding until resumed", func(t *testing.T) {
+		migrator.block = nil
+		migrator.err = errors.New("disk full")
+		require.Nil(t, update(8, ""))
+		r := wait(t)
+		assert.Equal(t, ReshardFailed, r.Status)
+		assert.Contains(t, r.Error, "disk full")
+		assert.True(t, sm.CopyShardingState("C1").Resharding())
+		assert.Equal(t, 4, config().ActualCount)
+
+		err := update(4, "")
+		assert.ErrorContains(t, err, "can only be increased")
+
+		migrator.err = nil
+		require.Nil(t, update(8, ""))
+		r = wait(t)
+		assert.Equal(t, ReshardSuccess, r.Status)
+		assert.Equal(t, 4, r.FromCount)
+		assert.Equal(t, 8, r.ToCount)
+		assert.Equal(t, 8, config().ActualCount)
+	})
+}
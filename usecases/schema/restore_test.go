@@ -122,6 +122,56 @@ func TestRestoreClass_WithNodeMapping(t *testing.T) {
 	}
 }
 
+func TestRestoreTenants(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cls = &models.Class{
+			Class:              "Class_A",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+			ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+		}
+	)
+	mgr := newSchemaManager()
+	require.Nil(t, mgr.AddClass(ctx, nil, cls))
+	_, err := mgr.AddTenants(ctx, nil, cls.Class, []*models.Tenant{{Name: "existing"}})
+	require.Nil(t, err)
+
+	descriptor := func(tenants ...string) *backup.ClassDescriptor {
+		schemaBytes, err := json.Marshal(cls)
+		require.Nil(t, err)
+		shardingState := sharding.State{Physical: map[string]sharding.Physical{}, PartitioningEnabled: true}
+		for _, tenant := range tenants {
+			shardingState.AddPartition(tenant, []string{"node1"}, models.TenantActivityStatusCOLD)
+		}
+		shardingBytes, err := shardingState.JSON()
+		require.Nil(t, err)
+		return &backup.ClassDescriptor{Name: cls.Class, Schema: schemaBytes, ShardingState: shardingBytes}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		err := mgr.RestoreTenants(ctx, descriptor("restored"), map[string]string{"node1": "new-node1"})
+		require.Nil(t, err)
+		ss := mgr.schemaCache.ShardingState[cls.Class]
+		require.Contains(t, ss.Physical, "restored")
+		assert.Contains(t, ss.Physical, "existing")
+		assert.Equal(t, []string{"new-node1"}, ss.Physical["restored"].BelongsToNodes)
+		assert.Equal(t, models.TenantActivityStatusCOLD, ss.Physical["restored"].Status)
+	})
+
+	t.Run("tenant exists", func(t *testing.T) {
+		err := mgr.RestoreTenants(ctx, descriptor("existing"), nil)
+		assert.ErrorContains(t, err, "already exists")
+	})
+
+	t.Run("class not multi-tenant", func(t *testing.T) {
+		require.Nil(t, mgr.AddClass(ctx, nil, &models.Class{Class: "Class_B"}))
+		d := descriptor("restored")
+		d.Schema = []byte(`{"class":"Class_B"}`)
+		err := mgr.RestoreTenants(ctx, d, nil)
+		assert.ErrorContains(t, err, "not enabled")
+	})
+}
+
 type fakeNodes struct {
 	nodes []string
 }
@@ -205,6 +205,18 @@ func (ccc *classConfigComparison) diff() []string {
 		ccc.right.VectorIndexType, "vector index type")
 	ccc.compare(ccc.left.Vectorizer,
 		ccc.right.Vectorizer, "vectorizer")
+	ccc.compare(ccc.left.VersioningConfig,
+		ccc.right.VersioningConfig, "versioning config")
+	ccc.compare(ccc.left.ChunkingConfig,
+		ccc.right.ChunkingConfig, "chunking config")
+	ccc.compare(ccc.left.TemporalPartitioningConfig,
+		ccc.right.TemporalPartitioningConfig, "temporal partitioning config")
+	ccc.compare(ccc.left.QueryConfig,
+		ccc.right.QueryConfig, "query config")
+	ccc.compare(ccc.left.CompactionConfig,
+		ccc.right.CompactionConfig, "compaction config")
+	ccc.compare(ccc.left.SegmentCompressionConfig,
+		ccc.right.SegmentCompressionConfig, "segment compression config")
 	return ccc.msgs
 }
 
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func setSegmentCompressionConfigDefaults(class *models.Class) {
+	if class.SegmentCompressionConfig == nil {
+		return
+	}
+	class.SegmentCompressionConfig.Objects = schema.ObjectsCompression(class)
+	class.SegmentCompressionConfig.Inverted = schema.InvertedCompression(class)
+}
+
+func validateSegmentCompressionConfig(class *models.Class) error {
+	cfg := class.SegmentCompressionConfig
+	if cfg == nil {
+		return nil
+	}
+	for name, compression := range map[string]string{
+		"objects":  cfg.Objects,
+		"inverted": cfg.Inverted,
+	} {
+		if compression != "" && !schema.ValidSegmentCompression(compression) {
+			return fmt.Errorf("segmentCompressionConfig.%s must be one of %q, %q or %q, got %q",
+				name, schema.SegmentCompressionNone, schema.SegmentCompressionZstd,
+				schema.SegmentCompressionSnappy, compression)
+		}
+	}
+	return nil
+}
+
+// validateSegmentCompressionConfigUpdate does not allow any changes, the
+// buckets of the shards are configured when they are loaded
+func validateSegmentCompressionConfigUpdate(initial, updated *models.Class) error {
+	if schema.ObjectsCompression(initial) != schema.ObjectsCompression(updated) ||
+		schema.InvertedCompression(initial) != schema.InvertedCompression(updated) {
+		return fmt.Errorf("changing the segment compression of an existing class is not supported")
+	}
+	return nil
+}
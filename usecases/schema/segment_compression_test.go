@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestValidateSegmentCompressionConfig(t *testing.T) {
+	assert.Nil(t, validateSegmentCompressionConfig(&models.Class{}))
+	assert.Nil(t, validateSegmentCompressionConfig(&models.Class{
+		SegmentCompressionConfig: &models.SegmentCompressionConfig{Objects: "zstd", Inverted: "snappy"},
+	}))
+	assert.ErrorContains(t, validateSegmentCompressionConfig(&models.Class{
+		SegmentCompressionConfig: &models.SegmentCompressionConfig{Objects: "lz4"},
+	}), "segmentCompressionConfig.objects")
+
+	class := &models.Class{SegmentCompressionConfig: &models.SegmentCompressionConfig{Objects: "zstd"}}
+	setSegmentCompressionConfigDefaults(class)
+	assert.Equal(t, "zstd", class.SegmentCompressionConfig.Objects)
+	assert.Equal(t, "none", class.SegmentCompressionConfig.Inverted)
+}
+
+func TestValidateSegmentCompressionConfigUpdate(t *testing.T) {
+	compressed := &models.Class{
+		SegmentCompressionConfig: &models.SegmentCompressionConfig{Objects: "zstd", Inverted: "none"},
+	}
+
+	assert.Nil(t, validateSegmentCompressionConfigUpdate(compressed, compressed))
+	assert.Nil(t, validateSegmentCompressionConfigUpdate(&models.Class{}, &models.Class{
+		SegmentCompressionConfig: &models.SegmentCompressionConfig{Objects: "none"},
+	}))
+	assert.ErrorContains(t, validateSegmentCompressionConfigUpdate(&models.Class{}, compressed),
+		"not supported")
+	assert.ErrorContains(t, validateSegmentCompressionConfigUpdate(compressed, &models.Class{}),
+		"not supported")
+}
@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// FollowSchema makes the schema of a standby cluster follow the archived
+// schema of its primary cluster. Missing classes, properties and tenants are
+// added and tenants which are active on the primary are activated. Classes
+// and tenants which no longer exist on the primary are deleted and tenants
+// are deactivated only with prune, which is used once the changes made
+// before the schema was archived are applied. Other changes of a class are
+// not followed.
+func (m *Manager) FollowSchema(ctx context.Context, primary *backup.ArchivedSchema, prune bool) error {
+	for _, class := range primary.Classes {
+		local := m.getClassByName(class.Class)
+		if local == nil {
+			shardState, err := m.addClass(ctx, class)
+			if err != nil {
+				return fmt.Errorf("add class %s: %w", class.Class, err)
+			}
+			if err := m.migrator.AddClass(ctx, class, shardState); err != nil {
+				return fmt.Errorf("add class %s: %w", class.Class, err)
+			}
+			local = m.getClassByName(class.Class)
+		} else if err := m.followProperties(ctx, local, class.Properties); err != nil {
+			return err
+		}
+
+		if local != nil && schema.MultiTenancyEnabled(local) {
+			if err := m.followTenants(ctx, local, primary.Tenants[class.Class], prune); err != nil {
+				return fmt.Errorf("tenants of class %s: %w", class.Class, err)
+			}
+		}
+	}
+
+	if !prune {
+		return nil
+	}
+	followed := make(map[string]struct{}, len(primary.Classes))
+	for _, class := range primary.Classes {
+		followed[class.Class] = struct{}{}
+	}
+	for _, class := range m.getSchema().Objects.Classes {
+		if _, ok := followed[class.Class]; ok {
+			continue
+		}
+		if err := m.deleteClass(ctx, class.Class); err != nil {
+			return fmt.Errorf("delete class %s: %w", class.Class, err)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) followProperties(ctx context.Context, local *models.Class,
+	props []*models.Property,
+) error {
+	existing := make(map[string]struct{}, len(local.Properties))
+	for _, prop := range local.Properties {
+		existing[strings.ToLower(prop.Name)] = struct{}{}
+	}
+	for _, prop := range props {
+		if _, ok := existing[strings.ToLower(prop.Name)]; ok {
+			continue
+		}
+		if err := m.addClassProperty(ctx, local.Class, prop); err != nil {
+			return fmt.Errorf("add property %s of class %s: %w", prop.Name, local.Class, err)
+		}
+	}
+	return nil
+}
+
+// followTenants adds, updates and deletes tenants. Tenants which are not
+// HOT on the primary are COLD on the standby, since frozen tenants are only
+// stored on the backend of the primary.
+func (m *Manager) followTenants(ctx context.Context, cls *models.Class,
+	tenants []*models.Tenant, prune bool,
+) error {
+	local := map[string]string{}
+	if st := m.CopyShardingState(cls.Class); st != nil {
+		for name, physical := range st.Physical {
+			local[name] = physical.ActivityStatus()
+		}
+	}
+
+	var created, updated []*models.Tenant
+	followed := make(map[string]struct{}, len(tenants))
+	for _, tenant := range tenants {
+		followed[tenant.Name] = struct{}{}
+		status := models.TenantActivityStatusCOLD
+		if tenant.ActivityStatus == models.TenantActivityStatusHOT {
+			status = models.TenantActivityStatusHOT
+		}
+
+		current, ok := local[tenant.Name]
+		switch {
+		case !ok:
+			created = append(created, &models.Tenant{Name: tenant.Name, ActivityStatus: status})
+		case current != status && (status == models.TenantActivityStatusHOT || prune):
+			updated = append(updated, &models.Tenant{Name: tenant.Name, ActivityStatus: status})
+		}
+	}
+
+	if len(created) > 0 {
+		if err := m.createTenants(ctx, cls, created); err != nil {
+			return err
+		}
+	}
+	if len(updated) > 0 {
+		if err := m.updateTenants(ctx, cls.Class, updated); err != nil {
+			return err
+		}
+	}
+	if !prune {
+		return nil
+	}
+
+	var deleted []string
+	for name := range local {
+		if _, ok := followed[name]; !ok {
+			deleted = append(deleted, name)
+		}
+	}
+	if len(deleted) == 0 {
+		return nil
+	}
+	sort.Strings(deleted)
+	return m.dropTenants(ctx, cls, deleted)
+}
@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestFollowSchema(t *testing.T) {
+	ctx := context.Background()
+	mgr := newSchemaManager()
+	require.Nil(t, mgr.AddClass(ctx, nil, &models.Class{Class: "Removed"}))
+
+	article := func(props ...string) *models.Class {
+		class := &models.Class{
+			Class:              "Article",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+			ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+		}
+		for _, prop := range props {
+			class.Properties = append(class.Properties,
+				&models.Property{Name: prop, DataType: []string{"text"}})
+		}
+		return class
+	}
+	statuses := func() map[string]string {
+		out := map[string]string{}
+		for name, physical := range mgr.CopyShardingState("Article").Physical {
+			out[name] = physical.ActivityStatus()
+		}
+		return out
+	}
+
+	t.Run("add class and tenants", func(t *testing.T) {
+		primary := &backup.ArchivedSchema{
+			Classes: []*models.Class{article("title")},
+			Tenants: map[string][]*models.Tenant{"Article": {
+				{Name: "t1", ActivityStatus: models.TenantActivityStatusHOT},
+				{Name: "t2", ActivityStatus: models.TenantActivityStatusFROZEN},
+			}},
+		}
+		require.Nil(t, mgr.FollowSchema(ctx, primary, false))
+
+		class := mgr.getClassByName("Article")
+		require.NotNil(t, class)
+		require.Len(t, class.Properties, 1)
+		assert.Equal(t, map[string]string{
+			"t1": models.TenantActivityStatusHOT,
+			"t2": models.TenantActivityStatusCOLD,
+		}, statuses())
+		// classes are only deleted when pruning
+		assert.NotNil(t, mgr.getClassByName("Removed"))
+	})
+
+	t.Run("add property and activate tenant", func(t *testing.T) {
+		primary := &backup.ArchivedSchema{
+			Classes: []*models.Class{article("title", "body")},
+			Tenants: map[string][]*models.Tenant{"Article": {
+				{Name: "t1", ActivityStatus: models.TenantActivityStatusCOLD},
+				{Name: "t2", ActivityStatus: models.TenantActivityStatusHOT},
+			}},
+		}
+		require.Nil(t, mgr.FollowSchema(ctx, primary, false))
+		assert.Len(t, mgr.getClassByName("Article").Properties, 2)
+		// t1 is only deactivated when pruning
+		assert.Equal(t, map[string]string{
+			"t1": models.TenantActivityStatusHOT,
+			"t2": models.TenantActivityStatusHOT,
+		}, statuses())
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		primary := &backup.ArchivedSchema{
+			Classes: []*models.Class{article("title", "body")},
+			Tenants: map[string][]*models.Tenant{"Article": {
+				{Name: "t1", ActivityStatus: models.TenantActivityStatusCOLD},
+			}},
+		}
+		require.Nil(t, mgr.FollowSchema(ctx, primary, true))
+		assert.Nil(t, mgr.getClassByName("Removed"))
+		assert.Equal(t, map[string]string{"t1": models.TenantActivityStatusCOLD}, statuses())
+	})
+}
@@ -221,7 +221,8 @@ func logrusStartupSyncFields() logrus.Fields {
 }
 
 func isEmpty(schema *State) bool {
-	return schema == nil || schema.ObjectSchema == nil || len(schema.ObjectSchema.Classes) == 0
+	return schema == nil || ((schema.ObjectSchema == nil || len(schema.ObjectSchema.Classes) == 0) &&
+		len(schema.Metadata) == 0)
 }
 
 func (m *Manager) clusterSyncImpossibleBecauseRemoteNodeTooOld(err error) bool {
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func setTemporalPartitioningDefaults(class *models.Class) {
+	if !schema.TemporalPartitioningEnabled(class) {
+		return
+	}
+	cfg := class.TemporalPartitioningConfig
+	cfg.Property = schema.LowercaseFirstLetter(cfg.Property)
+	if cfg.Interval == "" {
+		cfg.Interval = schema.DefaultTemporalPartitioningInterval
+	}
+}
+
+func validateTemporalPartitioningConfig(class *models.Class) error {
+	cfg := class.TemporalPartitioningConfig
+	if cfg == nil {
+		return nil
+	}
+	if cfg.Property == "" {
+		return fmt.Errorf("temporalPartitioningConfig.property must be set")
+	}
+	if !schema.ValidTemporalPartitioningInterval(cfg.Interval) {
+		return fmt.Errorf("temporalPartitioningConfig.interval must be one of %q, %q or %q, got %q",
+			schema.TemporalPartitioningIntervalDay, schema.TemporalPartitioningIntervalMonth,
+			schema.TemporalPartitioningIntervalYear, cfg.Interval)
+	}
+	if cfg.RetentionDays < 0 {
+		return fmt.Errorf("temporalPartitioningConfig.retentionDays must not be negative, got %d",
+			cfg.RetentionDays)
+	}
+	if !schema.MultiTenancyEnabled(class) {
+		return fmt.Errorf("temporalPartitioningConfig requires multiTenancyConfig.enabled, " +
+			"as every partition is a tenant")
+	}
+
+	for _, prop := range class.Properties {
+		if prop.Name != cfg.Property {
+			continue
+		}
+		if len(prop.DataType) != 1 || prop.DataType[0] != schema.DataTypeDate.String() {
+			return fmt.Errorf("temporalPartitioningConfig.property %q must be of data type %q",
+				cfg.Property, schema.DataTypeDate)
+		}
+		return nil
+	}
+	return fmt.Errorf("temporalPartitioningConfig.property %q is not a property of the class",
+		cfg.Property)
+}
+
+// validateTemporalPartitioningConfigUpdate only allows to change the
+// retention, the partitioning itself is immutable
+func validateTemporalPartitioningConfigUpdate(initial, updated *models.Class) error {
+	partitioning := func(cfg *models.TemporalPartitioningConfig) *models.TemporalPartitioningConfig {
+		if cfg == nil {
+			return nil
+		}
+		return &models.TemporalPartitioningConfig{Property: cfg.Property, Interval: cfg.Interval}
+	}
+	if !reflect.DeepEqual(partitioning(initial.TemporalPartitioningConfig),
+		partitioning(updated.TemporalPartitioningConfig)) {
+		return fmt.Errorf("temporal partitioning config is immutable, only its retentionDays can be changed")
+	}
+	return validateTemporalPartitioningConfig(updated)
+}
+
+// CreatePartitions creates the missing temporal partitions of the class. It
+// is called when objects are written to partitions which don't exist yet, the
+// principal writing them is authorized to write to them, but not necessarily
+// to create tenants.
+func (m *Manager) CreatePartitions(ctx context.Context, class string, names []string) error {
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if !schema.TemporalPartitioningEnabled(cls) {
+		return fmt.Errorf("temporal partitioning is not enabled for class %q", class)
+	}
+
+	m.partitionsLock.Lock()
+	defer m.partitionsLock.Unlock()
+
+	missing := m.missingPartitions(cls.Class, names)
+	if len(missing) == 0 {
+		return nil
+	}
+	if err := m.createTenants(ctx, cls, missing); err != nil {
+		return fmt.Errorf("create partitions: %w", err)
+	}
+
+	m.logger.WithField("action", "create_partitions").
+		WithField("class", cls.Class).
+		WithField("partitions", tenantNames(missing)).
+		Info("created temporal partitions")
+	return nil
+}
+
+func (m *Manager) missingPartitions(class string, names []string) []*models.Tenant {
+	m.schemaCache.RLock()
+	defer m.schemaCache.RUnlock()
+
+	st := m.schemaCache.ShardingState[class]
+	seen := make(map[string]struct{}, len(names))
+	var missing []*models.Tenant
+	for _, name := range names {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		if st != nil {
+			if _, ok := st.Physical[name]; ok {
+				continue
+			}
+		}
+		missing = append(missing, &models.Tenant{Name: name})
+	}
+	return missing
+}
+
+// DropPartitions drops the temporal partitions of the class with all of their
+// objects. It is used to enforce the retention of the partitions and does
+// not require authorization.
+func (m *Manager) DropPartitions(ctx context.Context, class string, names []string) error {
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if !schema.TemporalPartitioningEnabled(cls) {
+		return fmt.Errorf("temporal partitioning is not enabled for class %q", class)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	m.partitionsLock.Lock()
+	defer m.partitionsLock.Unlock()
+
+	if err := m.dropTenants(ctx, cls, names); err != nil {
+		return fmt.Errorf("drop partitions: %w", err)
+	}
+	m.auditLog.Record(ctx, nil, "delete", nil, tenantResources(cls.Class, names)...)
+	return nil
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestTemporalPartitioningConfig(t *testing.T) {
+	class := func(cfg *models.TemporalPartitioningConfig) *models.Class {
+		return &models.Class{
+			Class:                      "Log",
+			MultiTenancyConfig:         &models.MultiTenancyConfig{Enabled: true},
+			TemporalPartitioningConfig: cfg,
+			Properties: []*models.Property{
+				{Name: "timestamp", DataType: []string{"date"}},
+				{Name: "message", DataType: []string{"text"}},
+			},
+		}
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		c := class(&models.TemporalPartitioningConfig{Property: "Timestamp"})
+		setTemporalPartitioningDefaults(c)
+		assert.Equal(t, &models.TemporalPartitioningConfig{
+			Property: "timestamp",
+			Interval: "month",
+		}, c.TemporalPartitioningConfig)
+
+		c = class(nil)
+		setTemporalPartitioningDefaults(c)
+		assert.Nil(t, c.TemporalPartitioningConfig)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		valid := models.TemporalPartitioningConfig{Property: "timestamp", Interval: "day"}
+		assert.Nil(t, validateTemporalPartitioningConfig(class(nil)))
+		assert.Nil(t, validateTemporalPartitioningConfig(class(&valid)))
+
+		for _, tc := range []struct {
+			update func(c *models.Class)
+			err    string
+		}{
+			{func(c *models.Class) { c.TemporalPartitioningConfig.Property = "" }, "property must be set"},
+			{func(c *models.Class) { c.TemporalPartitioningConfig.Interval = "week" }, "interval must be one of"},
+			{func(c *models.Class) { c.TemporalPartitioningConfig.RetentionDays = -1 }, "retentionDays must not be negative"},
+			{func(c *models.Class) { c.MultiTenancyConfig.Enabled = false }, "requires multiTenancyConfig.enabled"},
+			{func(c *models.Class) { c.TemporalPartitioningConfig.Property = "message" }, `must be of data type "date"`},
+			{func(c *models.Class) { c.TemporalPartitioningConfig.Property = "createdAt" }, "is not a property of the class"},
+		} {
+			cfg := valid
+			c := class(&cfg)
+			tc.update(c)
+			assert.ErrorContains(t, validateTemporalPartitioningConfig(c), tc.err)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		initial := class(&models.TemporalPartitioningConfig{Property: "timestamp", Interval: "month"})
+		updated := class(&models.TemporalPartitioningConfig{Property: "timestamp", Interval: "month"})
+		assert.Nil(t, validateTemporalPartitioningConfigUpdate(initial, updated))
+
+		updated.TemporalPartitioningConfig.RetentionDays = 30
+		assert.Nil(t, validateTemporalPartitioningConfigUpdate(initial, updated))
+
+		updated.TemporalPartitioningConfig.Interval = "day"
+		assert.ErrorContains(t, validateTemporalPartitioningConfigUpdate(initial, updated), "immutable")
+	})
+}
@@ -16,10 +16,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	uco "github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -27,9 +30,6 @@ import (
 
 var regexTenantName = regexp.MustCompile(`^` + schema.ShardNameRegexCore + `$`)
 
-// tenantsPath is the main path used for authorization
-const tenantsPath = "schema/tenants"
-
 // AddTenants is used to add new tenants to a class
 // Class must exist and has partitioning enabled
 func (m *Manager) AddTenants(ctx context.Context,
@@ -37,7 +37,11 @@ func (m *Manager) AddTenants(ctx context.Context,
 	class string,
 	tenants []*models.Tenant,
 ) (created []*models.Tenant, err error) {
-	if err = m.Authorizer.Authorize(principal, "update", tenantsPath); err != nil {
+	defer func() {
+		m.auditLog.Record(ctx, principal, "create", err, tenantResources(class, tenantNames(tenants))...)
+	}()
+
+	if err = m.authorizeTenants(principal, "update", class, tenantNames(tenants)...); err != nil {
 		return
 	}
 
@@ -58,6 +62,19 @@ func (m *Manager) AddTenants(ctx context.Context,
 		return
 	}
 
+	if err = m.createTenants(ctx, cls, validated); err != nil {
+		return
+	}
+
+	created = validated
+	return
+}
+
+// createTenants creates the validated tenants of the class. Failures to
+// apply the committed transaction locally are logged only.
+func (m *Manager) createTenants(ctx context.Context, cls *models.Class,
+	validated []*models.Tenant,
+) error {
 	names := make([]string, len(validated))
 	for i, tenant := range validated {
 		names[i] = tenant.Name
@@ -66,17 +83,16 @@ func (m *Manager) AddTenants(ctx context.Context,
 	// create transaction payload
 	partitions, err := m.getPartitions(cls, names)
 	if err != nil {
-		err = fmt.Errorf("get partitions from class %q: %w", class, err)
-		return
+		return fmt.Errorf("get partitions from class %q: %w", cls.Class, err)
 	}
 	if len(partitions) != len(names) {
 		m.logger.WithField("action", "add_tenants").
 			WithField("#partitions", len(partitions)).
 			WithField("#requested", len(names)).
-			Tracef("number of partitions for class %q does not match number of requested tenants", class)
+			Tracef("number of partitions for class %q does not match number of requested tenants", cls.Class)
 	}
 	request := AddTenantsPayload{
-		Class:   class,
+		Class:   cls.Class,
 		Tenants: make([]TenantCreate, 0, len(partitions)),
 	}
 	for i, name := range names {
@@ -94,23 +110,72 @@ func (m *Manager) AddTenants(ctx context.Context,
 	tx, err := m.cluster.BeginTransaction(ctx, addTenants,
 		request, DefaultTxTTL)
 	if err != nil {
-		err = fmt.Errorf("open cluster-wide transaction: %w", err)
-		return
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
 	}
 
 	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
 		m.logger.WithError(err).Errorf("not every node was able to commit")
 	}
 
-	err = m.onAddTenants(ctx, cls, request) // actual update
-	if err != nil {
+	if err := m.onAddTenants(ctx, cls, request); err != nil { // actual update
 		m.logger.WithField("action", "add_tenants").
 			WithField("n", len(request.Tenants)).
 			WithField("class", cls.Class).Error(err)
 	}
 
-	created = validated
-	return
+	return nil
+}
+
+// RestoreTenants adds the tenants of a backed up class to the existing class
+// of the same name. The files of the tenants must have been restored already.
+func (m *Manager) RestoreTenants(ctx context.Context, d *backup.ClassDescriptor,
+	nodeMapping map[string]string,
+) error {
+	class := &models.Class{}
+	if err := json.Unmarshal(d.Schema, &class); err != nil {
+		return fmt.Errorf("marshal class schema: %w", err)
+	}
+	var shardingState sharding.State
+	if err := json.Unmarshal(d.ShardingState, &shardingState); err != nil {
+		return fmt.Errorf("marshal sharding state: %w", err)
+	}
+	shardingState.MigrateFromOldFormat()
+	shardingState.ApplyNodeMapping(nodeMapping)
+
+	cls := m.getClassByName(schema.UppercaseClassName(class.Class))
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", class.Class, ErrNotFound)
+	}
+	if !schema.MultiTenancyEnabled(cls) {
+		return fmt.Errorf("multi-tenancy is not enabled for class %q", cls.Class)
+	}
+
+	request := AddTenantsPayload{
+		Class:   cls.Class,
+		Tenants: make([]TenantCreate, 0, len(shardingState.Physical)),
+	}
+	for name, p := range shardingState.Physical {
+		if _, status := m.TenantShard(cls.Class, name); status != "" {
+			return fmt.Errorf("tenant %q of class %q already exists", name, cls.Class)
+		}
+		request.Tenants = append(request.Tenants, TenantCreate{
+			Name:   name,
+			Nodes:  p.BelongsToNodes,
+			Status: p.ActivityStatus(),
+		})
+	}
+	sort.Slice(request.Tenants, func(i, j int) bool {
+		return request.Tenants[i].Name < request.Tenants[j].Name
+	})
+
+	if err := m.onAddTenants(ctx, cls, request); err != nil {
+		return err
+	}
+	m.logger.
+		WithField("action", "schema_restore_tenants").
+		WithField("n", len(request.Tenants)).
+		Debugf("restore tenants of class %q from backup", cls.Class)
+	return nil
 }
 
 func (m *Manager) getPartitions(cls *models.Class, shards []string) (map[string][]string, error) {
@@ -234,8 +299,12 @@ func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request
 // Class must exist and has partitioning enabled
 func (m *Manager) UpdateTenants(ctx context.Context, principal *models.Principal,
 	class string, tenants []*models.Tenant,
-) error {
-	if err := m.Authorizer.Authorize(principal, "update", tenantsPath); err != nil {
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal, "update", err, tenantResources(class, tenantNames(tenants))...)
+	}()
+
+	if err := m.authorizeTenants(principal, "update", class, tenantNames(tenants)...); err != nil {
 		return err
 	}
 	validated, err := validateTenants(tenants)
@@ -245,6 +314,25 @@ func (m *Manager) UpdateTenants(ctx context.Context, principal *models.Principal
 	if err := validateActivityStatuses(validated, false); err != nil {
 		return err
 	}
+	return m.updateTenants(ctx, class, tenants)
+}
+
+// SetTenantsStatus sets the activity status of tenants on behalf of the
+// server itself, e.g. to offload idle tenants. Unlike UpdateTenants it is
+// not authorized and also accepts the FROZEN status.
+func (m *Manager) SetTenantsStatus(ctx context.Context, class, status string,
+	tenants ...string,
+) error {
+	updates := make([]*models.Tenant, len(tenants))
+	for i, name := range tenants {
+		updates[i] = &models.Tenant{Name: name, ActivityStatus: status}
+	}
+	return m.updateTenants(ctx, class, updates)
+}
+
+func (m *Manager) updateTenants(ctx context.Context, class string,
+	tenants []*models.Tenant,
+) error {
 	cls := m.getClassByName(class)
 	if cls == nil {
 		return fmt.Errorf("class %q: %w", class, ErrNotFound)
@@ -357,8 +445,12 @@ func (m *Manager) onUpdateTenants(ctx context.Context, class *models.Class, requ
 // DeleteTenants is used to delete tenants of a class.
 //
 // Class must exist and has partitioning enabled
-func (m *Manager) DeleteTenants(ctx context.Context, principal *models.Principal, class string, tenants []string) error {
-	if err := m.Authorizer.Authorize(principal, "delete", tenantsPath); err != nil {
+func (m *Manager) DeleteTenants(ctx context.Context, principal *models.Principal, class string, tenants []string) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal, "delete", err, tenantResources(class, tenants)...)
+	}()
+
+	if err := m.authorizeTenants(principal, "delete", class, tenants...); err != nil {
 		return err
 	}
 	for i, name := range tenants {
@@ -374,8 +466,12 @@ func (m *Manager) DeleteTenants(ctx context.Context, principal *models.Principal
 		return fmt.Errorf("multi-tenancy is not enabled for class %q", class)
 	}
 
+	return m.dropTenants(ctx, cls, tenants)
+}
+
+func (m *Manager) dropTenants(ctx context.Context, cls *models.Class, tenants []string) error {
 	request := DeleteTenantsPayload{
-		Class:   class,
+		Class:   cls.Class,
 		Tenants: tenants,
 	}
 
@@ -427,7 +523,7 @@ func (m *Manager) onDeleteTenants(ctx context.Context, class *models.Class, req
 //
 // Class must exist and has partitioning enabled
 func (m *Manager) GetTenants(ctx context.Context, principal *models.Principal, class string) ([]*models.Tenant, error) {
-	if err := m.Authorizer.Authorize(principal, "get", tenantsPath); err != nil {
+	if err := m.Authorizer.Authorize(principal, "get", authorization.TenantsMetadata(class, "")); err != nil {
 		return nil, err
 	}
 	// validation
@@ -457,3 +553,39 @@ func (m *Manager) GetTenants(ctx context.Context, principal *models.Principal, c
 
 	return tenants, nil
 }
+
+// authorizeTenants checks the permission for every given tenant of the class.
+// If no tenants are given, the permission is checked for all tenants.
+func (m *Manager) authorizeTenants(principal *models.Principal, verb, class string,
+	tenants ...string,
+) error {
+	for _, resource := range tenantResources(class, tenants) {
+		if err := m.Authorizer.Authorize(principal, verb, resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tenantResources are the resources of the given tenants, if no tenants are
+// given all tenants of the class are affected
+func tenantResources(class string, tenants []string) []string {
+	if len(tenants) == 0 {
+		return []string{authorization.TenantsMetadata(class, "")}
+	}
+	resources := make([]string, len(tenants))
+	for i, tenant := range tenants {
+		resources[i] = authorization.TenantsMetadata(class, tenant)
+	}
+	return resources
+}
+
+func tenantNames(tenants []*models.Tenant) []string {
+	names := make([]string, 0, len(tenants))
+	for _, tenant := range tenants {
+		if tenant != nil {
+			names = append(names, tenant.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,317 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tracing"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// Operations of a tenants job
+const (
+	TenantsJobCreate     = "create"
+	TenantsJobActivate   = "activate"
+	TenantsJobDeactivate = "deactivate"
+	TenantsJobDelete     = "delete"
+)
+
+// Statuses of a tenants job
+const (
+	TenantsJobStarted  = "STARTED"
+	TenantsJobFinished = "FINISHED"
+)
+
+var (
+	// tenantsJobChunkSize is the number of tenants changed within a single
+	// cluster-wide transaction
+	tenantsJobChunkSize = 1000
+
+	// tenantsJobRetries of a chunk which conflicts with another transaction
+	tenantsJobRetries = 10
+
+	tenantsJobRetryInterval = time.Second
+
+	// tenantsJobRetention is how long finished jobs can be looked up
+	tenantsJobRetention = 24 * time.Hour
+)
+
+var ErrTenantsJobNotFound = errors.New("tenants job not found")
+
+// TenantsJobRequest changes many tenants of a class at once
+type TenantsJobRequest struct {
+	Operation string   `json:"operation"`
+	Tenants   []string `json:"tenants"`
+}
+
+// TenantFailure is a tenant a job could not change
+type TenantFailure struct {
+	Name  string `json:"name"`
+	Error string `json:"error"`
+}
+
+// TenantsJob tracks the progress of a TenantsJobRequest, which is applied
+// in the background in chunks of tenants
+type TenantsJob struct {
+	ID          string          `json:"id"`
+	Class       string          `json:"class"`
+	Operation   string          `json:"operation"`
+	Status      string          `json:"status"`
+	Total       int             `json:"total"`
+	Succeeded   int             `json:"succeeded"`
+	Failed      []TenantFailure `json:"failed"`
+	StartedAt   time.Time       `json:"startedAt"`
+	CompletedAt *time.Time      `json:"completedAt,omitempty"`
+}
+
+// tenantsJobs are kept in memory of the node which received the request
+type tenantsJobs struct {
+	sync.Mutex
+	jobs map[string]*TenantsJob
+
+	// running makes jobs run one after another, so that they do not
+	// compete for cluster-wide transactions
+	running sync.Mutex
+}
+
+// StartTenantsJob validates the request and applies it in the background.
+// Tenants which cannot be changed, e.g. because they do not exist, are
+// reported as failed by the job instead of failing the request.
+func (m *Manager) StartTenantsJob(ctx context.Context, principal *models.Principal,
+	class string, req TenantsJobRequest,
+) (*TenantsJob, error) {
+	verb := "update"
+	switch req.Operation {
+	case TenantsJobCreate, TenantsJobActivate, TenantsJobDeactivate:
+	case TenantsJobDelete:
+		verb = "delete"
+	default:
+		return nil, uco.NewErrInvalidUserInput("invalid operation %q, must be one of %q, %q, %q or %q",
+			req.Operation, TenantsJobCreate, TenantsJobActivate, TenantsJobDeactivate, TenantsJobDelete)
+	}
+	if len(req.Tenants) == 0 {
+		return nil, uco.NewErrInvalidUserInput("no tenants given")
+	}
+	if err := m.authorizeTenants(principal, verb, class, req.Tenants...); err != nil {
+		return nil, err
+	}
+
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if !schema.MultiTenancyEnabled(cls) {
+		return nil, fmt.Errorf("multi-tenancy is not enabled for class %q", class)
+	}
+
+	job := &TenantsJob{
+		ID:        uuid.New().String(),
+		Class:     cls.Class,
+		Operation: req.Operation,
+		Status:    TenantsJobStarted,
+		Total:     len(req.Tenants),
+		Failed:    []TenantFailure{},
+		StartedAt: time.Now(),
+	}
+	tenants := m.checkJobTenants(job, req.Tenants)
+
+	m.tenantsJobs.Lock()
+	if m.tenantsJobs.jobs == nil {
+		m.tenantsJobs.jobs = map[string]*TenantsJob{}
+	}
+	for id, other := range m.tenantsJobs.jobs {
+		if other.CompletedAt != nil && time.Since(*other.CompletedAt) > tenantsJobRetention {
+			delete(m.tenantsJobs.jobs, id)
+		}
+	}
+	m.tenantsJobs.jobs[job.ID] = job
+	started := job.copy()
+	m.tenantsJobs.Unlock()
+
+	// the job outlives the request, but keeps its id for the audit log
+	jobCtx := tracing.WithRequestID(context.Background(), tracing.RequestID(ctx))
+	go m.runTenantsJob(jobCtx, principal, cls, job, tenants)
+
+	return started, nil
+}
+
+// TenantsJob returns the current state of a job
+func (m *Manager) TenantsJob(ctx context.Context, principal *models.Principal,
+	class, id string,
+) (*TenantsJob, error) {
+	if err := m.Authorizer.Authorize(principal, "get", authorization.TenantsMetadata(class, "")); err != nil {
+		return nil, err
+	}
+
+	m.tenantsJobs.Lock()
+	defer m.tenantsJobs.Unlock()
+	job, ok := m.tenantsJobs.jobs[id]
+	if !ok || job.Class != schema.UppercaseClassName(class) {
+		return nil, fmt.Errorf("%w: %s", ErrTenantsJobNotFound, id)
+	}
+	return job.copy(), nil
+}
+
+// TenantsJobs returns the jobs of a class, the most recent one first
+func (m *Manager) TenantsJobs(ctx context.Context, principal *models.Principal,
+	class string,
+) ([]*TenantsJob, error) {
+	if err := m.Authorizer.Authorize(principal, "get", authorization.TenantsMetadata(class, "")); err != nil {
+		return nil, err
+	}
+
+	m.tenantsJobs.Lock()
+	defer m.tenantsJobs.Unlock()
+	jobs := []*TenantsJob{}
+	for _, job := range m.tenantsJobs.jobs {
+		if job.Class == schema.UppercaseClassName(class) {
+			jobs = append(jobs, job.copy())
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].StartedAt.After(jobs[j].StartedAt)
+	})
+	return jobs, nil
+}
+
+// copy must be called with the jobs locked
+func (j *TenantsJob) copy() *TenantsJob {
+	c := *j
+	c.Failed = append([]TenantFailure{}, j.Failed...)
+	return &c
+}
+
+// checkJobTenants returns the tenants the job can change and records all
+// others as failed
+func (m *Manager) checkJobTenants(job *TenantsJob, tenants []string) []string {
+	var existing map[string]sharding.Physical
+	if st := m.CopyShardingState(job.Class); st != nil {
+		existing = st.Physical
+	}
+	valid := make([]string, 0, len(tenants))
+	seen := make(map[string]struct{}, len(tenants))
+	for _, name := range tenants {
+		var reason string
+		_, exists := existing[name]
+		switch _, dup := seen[name]; {
+		case dup:
+			reason = "duplicate tenant"
+		case !regexTenantName.MatchString(name):
+			reason = "invalid tenant name"
+		case job.Operation == TenantsJobCreate && exists:
+			reason = "tenant already exists"
+		case job.Operation != TenantsJobCreate && !exists:
+			reason = "tenant not found"
+		}
+		seen[name] = struct{}{}
+
+		if reason != "" {
+			job.Failed = append(job.Failed, TenantFailure{Name: name, Error: reason})
+			continue
+		}
+		valid = append(valid, name)
+	}
+	return valid
+}
+
+func (m *Manager) runTenantsJob(ctx context.Context, principal *models.Principal,
+	cls *models.Class, job *TenantsJob, tenants []string,
+) {
+	m.tenantsJobs.running.Lock()
+	defer m.tenantsJobs.running.Unlock()
+
+	for len(tenants) > 0 {
+		n := tenantsJobChunkSize
+		if n > len(tenants) {
+			n = len(tenants)
+		}
+		chunk := tenants[:n]
+		tenants = tenants[n:]
+
+		err := m.applyTenantsJobChunk(ctx, principal, cls, job.Operation, chunk)
+		if err != nil {
+			m.logger.WithField("action", "tenants_job").WithField("id", job.ID).
+				WithField("class", cls.Class).WithField("n", len(chunk)).WithError(err).
+				Error("could not change tenants")
+		}
+
+		m.tenantsJobs.Lock()
+		if err != nil {
+			for _, name := range chunk {
+				job.Failed = append(job.Failed, TenantFailure{Name: name, Error: err.Error()})
+			}
+		} else {
+			job.Succeeded += len(chunk)
+		}
+		m.tenantsJobs.Unlock()
+	}
+
+	m.tenantsJobs.Lock()
+	now := time.Now()
+	job.Status = TenantsJobFinished
+	job.CompletedAt = &now
+	m.tenantsJobs.Unlock()
+}
+
+// applyTenantsJobChunk changes the tenants within a single transaction,
+// which is retried if it conflicts with another one
+func (m *Manager) applyTenantsJobChunk(ctx context.Context, principal *models.Principal,
+	cls *models.Class, operation string, tenants []string,
+) (err error) {
+	action := "update"
+	switch operation {
+	case TenantsJobCreate:
+		action = "create"
+	case TenantsJobDelete:
+		action = "delete"
+	}
+	defer func() {
+		m.auditLog.Record(ctx, principal, action, err, tenantResources(cls.Class, tenants)...)
+	}()
+
+	for i := 0; ; i++ {
+		switch operation {
+		case TenantsJobCreate:
+			err = m.createTenants(ctx, cls, jobTenants(tenants, ""))
+		case TenantsJobActivate:
+			err = m.updateTenants(ctx, cls.Class, jobTenants(tenants, models.TenantActivityStatusHOT))
+		case TenantsJobDeactivate:
+			err = m.updateTenants(ctx, cls.Class, jobTenants(tenants, models.TenantActivityStatusCOLD))
+		case TenantsJobDelete:
+			err = m.dropTenants(ctx, cls, tenants)
+		}
+		if err == nil || !errors.Is(err, cluster.ErrConcurrentTransaction) || i >= tenantsJobRetries {
+			return err
+		}
+		time.Sleep(tenantsJobRetryInterval)
+	}
+}
+
+func jobTenants(names []string, status string) []*models.Tenant {
+	tenants := make([]*models.Tenant, len(names))
+	for i, name := range names {
+		tenants[i] = &models.Tenant{Name: name, ActivityStatus: status}
+	}
+	return tenants
+}
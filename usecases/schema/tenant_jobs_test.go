@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestTenantsJob(t *testing.T) {
+	ctx := context.Background()
+	defer func(size int) { tenantsJobChunkSize = size }(tenantsJobChunkSize)
+	tenantsJobChunkSize = 2
+
+	sm := newSchemaManager()
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:              "C1",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+	}))
+
+	run := func(t *testing.T, operation string, tenants ...string) *TenantsJob {
+		started, err := sm.StartTenantsJob(ctx, nil, "C1", TenantsJobRequest{
+			Operation: operation,
+			Tenants:   tenants,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, TenantsJobStarted, started.Status)
+		assert.Equal(t, len(tenants), started.Total)
+
+		var job *TenantsJob
+		require.Eventually(t, func() bool {
+			job, err = sm.TenantsJob(ctx, nil, "C1", started.ID)
+			require.Nil(t, err)
+			return job.Status == TenantsJobFinished
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.NotNil(t, job.CompletedAt)
+		return job
+	}
+	status := func(tenant string) string {
+		_, status := sm.TenantShard("C1", tenant)
+		return status
+	}
+
+	t.Run("create", func(t *testing.T) {
+		job := run(t, TenantsJobCreate, "T1", "T2", "T3", "T1", "in valid")
+		assert.Equal(t, 3, job.Succeeded)
+		assert.Equal(t, []TenantFailure{
+			{Name: "T1", Error: "duplicate tenant"},
+			{Name: "in valid", Error: "invalid tenant name"},
+		}, job.Failed)
+		for _, tenant := range []string{"T1", "T2", "T3"} {
+			assert.Equal(t, models.TenantActivityStatusHOT, status(tenant))
+		}
+
+		job = run(t, TenantsJobCreate, "T3", "T4")
+		assert.Equal(t, 1, job.Succeeded)
+		assert.Equal(t, []TenantFailure{{Name: "T3", Error: "tenant already exists"}}, job.Failed)
+	})
+
+	t.Run("deactivate and activate", func(t *testing.T) {
+		job := run(t, TenantsJobDeactivate, "T1", "T2", "T5")
+		assert.Equal(t, 2, job.Succeeded)
+		assert.Equal(t, []TenantFailure{{Name: "T5", Error: "tenant not found"}}, job.Failed)
+		assert.Equal(t, models.TenantActivityStatusCOLD, status("T1"))
+		assert.Equal(t, models.TenantActivityStatusCOLD, status("T2"))
+		assert.Equal(t, models.TenantActivityStatusHOT, status("T3"))
+
+		job = run(t, TenantsJobActivate, "T1")
+		assert.Equal(t, 1, job.Succeeded)
+		assert.Empty(t, job.Failed)
+		assert.Equal(t, models.TenantActivityStatusHOT, status("T1"))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		job := run(t, TenantsJobDelete, "T1", "T2", "T3", "T4")
+		assert.Equal(t, 4, job.Succeeded)
+		assert.Empty(t, sm.CopyShardingState("C1").Physical)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		jobs, err := sm.TenantsJobs(ctx, nil, "C1")
+		require.Nil(t, err)
+		require.Len(t, jobs, 5)
+		assert.Equal(t, TenantsJobDelete, jobs[0].Operation)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := sm.StartTenantsJob(ctx, nil, "C1", TenantsJobRequest{
+			Operation: "freeze", Tenants: []string{"T1"},
+		})
+		assert.ErrorContains(t, err, "invalid operation")
+
+		_, err = sm.StartTenantsJob(ctx, nil, "C1", TenantsJobRequest{Operation: TenantsJobCreate})
+		assert.ErrorContains(t, err, "no tenants")
+
+		_, err = sm.StartTenantsJob(ctx, nil, "Unknown", TenantsJobRequest{
+			Operation: TenantsJobCreate, Tenants: []string{"T1"},
+		})
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		_, err = sm.TenantsJob(ctx, nil, "C1", "unknown")
+		assert.True(t, errors.Is(err, ErrTenantsJobNotFound))
+	})
+}
@@ -35,6 +35,9 @@ const (
 	DeleteClass cluster.TransactionType = "delete_class"
 	UpdateClass cluster.TransactionType = "update_class"
 
+	// metadata of other usecases, e.g. rbac roles
+	putMetadata cluster.TransactionType = "put_metadata"
+
 	// read-only
 	ReadSchema cluster.TransactionType = "read_schema"
 
@@ -119,6 +122,13 @@ type UpdateClassPayload struct {
 	State *sharding.State `json:"state"`
 }
 
+// PutMetadataPayload sets the value of a metadata key, the key is deleted if
+// the value is empty
+type PutMetadataPayload struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value,omitempty"`
+}
+
 type ReadSchemaPayload struct {
 	Schema *State `json:"schema"`
 }
@@ -145,6 +155,8 @@ func UnmarshalTransaction(txType cluster.TransactionType,
 		return unmarshalRawJson[UpdateTenantsPayload](payload)
 	case deleteTenants:
 		return unmarshalRawJson[DeleteTenantsPayload](payload)
+	case putMetadata:
+		return unmarshalRawJson[PutMetadataPayload](payload)
 	default:
 		return nil, errors.Errorf("unrecognized schema transaction type %q", txType)
 
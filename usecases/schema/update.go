@@ -19,17 +19,22 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 	className string, updated *models.Class,
-) error {
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal, "update", err, authorization.CollectionsMetadata(className))
+	}()
+
 	m.Lock()
 	defer m.Unlock()
 
-	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	err = m.Authorizer.Authorize(principal, "update", authorization.CollectionsMetadata(className))
 	if err != nil {
 		return err
 	}
@@ -77,21 +82,85 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return fmt.Errorf("replication config: %w", err)
 	}
 
-	updatedSharding := updated.ShardingConfig.(sharding.Config)
+	if err := validateVersioningConfigUpdate(initial, updated); err != nil {
+		return err
+	}
+
+	if err := validateChunkingConfig(updated); err != nil {
+		return err
+	}
+
+	if err := validateTemporalPartitioningConfigUpdate(initial, updated); err != nil {
+		return err
+	}
+
+	if err := validateQueryConfig(updated); err != nil {
+		return err
+	}
+
+	if err := validateCompactionConfig(updated); err != nil {
+		return err
+	}
+
+	if err := validateSegmentCompressionConfigUpdate(initial, updated); err != nil {
+		return err
+	}
+
 	initialRF := initial.ReplicationConfig.Factor
 	updatedRF := updated.ReplicationConfig.Factor
-	var updatedState *sharding.State
+	var (
+		resharded              *sharding.State
+		reshardFrom, reshardTo int
+		resumeReshard          bool
+	)
+	if !mtEnabled {
+		initialSharding := initial.ShardingConfig.(sharding.Config)
+		if count := updated.ShardingConfig.(sharding.Config).DesiredCount; count != initialSharding.DesiredCount {
+			if initialRF != updatedRF {
+				return fmt.Errorf("the shard count and the replication factor cannot be changed at the same time")
+			}
+			if err := m.checkRebalance(initial.Class); err != nil {
+				return err
+			}
+			if err := m.checkReshard(initial.Class); err != nil {
+				return err
+			}
+			resharded = m.CopyShardingState(initial.Class)
+			if resharded == nil {
+				return fmt.Errorf("no sharding state for class %q", initial.Class)
+			}
+			reshardFrom, reshardTo = len(resharded.Physical), count
+			if err := resharded.Reshard(count, m.clusterState); err != nil {
+				return fmt.Errorf("reshard: %w", err)
+			}
+			// the virtual shards are kept, the new shards take over some of them
+			updated.ShardingConfig = resharded.Config
+		} else {
+			// keeps the shard count of a resharding which is in progress
+			updated.ShardingConfig = initialSharding
+			resumeReshard = m.reshardInterrupted(initial.Class)
+		}
+	}
+
+	updatedSharding := updated.ShardingConfig.(sharding.Config)
 	if initialRF != updatedRF {
-		uss, err := m.scaleOut.Scale(ctx, className, updatedSharding, initialRF, updatedRF)
-		if err != nil {
-			return errors.Wrapf(err, "scale out from %d to %d replicas",
-				initialRF, updatedRF)
+		if err := m.checkRebalance(initial.Class); err != nil {
+			return err
 		}
-		updatedState = uss
+		if err := m.checkReshard(initial.Class); err != nil {
+			return err
+		}
+		// the replicas are rebalanced in the background, the new factor takes
+		// effect once they have been copied
+		rc := *updated.ReplicationConfig
+		rc.Factor = initialRF
+		applied := *updated
+		applied.ReplicationConfig = &rc
+		updated = &applied
 	}
 
 	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
-		UpdateClassPayload{className, updated, updatedState}, DefaultTxTTL)
+		UpdateClassPayload{className, updated, resharded}, DefaultTxTTL)
 	if err != nil {
 		// possible causes for errors could be nodes down (we expect every node to
 		// the up for a schema transaction) or concurrent transactions from other
@@ -103,7 +172,22 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return errors.Wrap(err, "commit cluster-wide transaction")
 	}
 
-	return m.updateClassApplyChanges(ctx, className, updated, updatedState)
+	if err := m.updateClassApplyChanges(ctx, className, updated, resharded); err != nil {
+		return err
+	}
+
+	if initialRF != updatedRF {
+		m.startRebalance(ctx, initial.Class, updatedSharding, initialRF, updatedRF)
+	}
+	switch {
+	case resharded != nil:
+		m.startReshard(ctx, initial.Class, resharded.ReshardedShards(), reshardFrom, reshardTo)
+	case resumeReshard:
+		st := m.CopyShardingState(initial.Class)
+		m.startReshard(ctx, initial.Class, st.ReshardedShards(),
+			st.Config.ActualCount, st.Config.DesiredCount)
+	}
+	return nil
 }
 
 // validateUpdatingMT validates toggling MT and returns whether mt is enabled
@@ -140,10 +224,13 @@ func validateShardingConfig(current, update *models.Class, mtEnabled bool, cl cl
 func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 	updated *models.Class, updatedShardingState *sharding.State,
 ) error {
+	var removed, added []string
 	if updatedShardingState != nil {
 		// the sharding state caches the node name, we must therefore set this
 		// explicitly now.
 		updatedShardingState.SetLocalName(m.clusterState.LocalName())
+		removed = m.removedReplicas(className, updatedShardingState)
+		added = m.addedShards(className, updatedShardingState)
 	}
 	if err := m.migrator.UpdateVectorIndexConfig(ctx,
 		className, updated.VectorIndexConfig.(schema.VectorIndexConfig)); err != nil {
@@ -166,6 +253,13 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 	payload.ReplaceShards = updatedShardingState != nil
 	// can be improved by updating the diff
 
+	if len(added) > 0 {
+		// the shards must exist before objects are routed to them
+		if err := m.migrator.AddShards(ctx, updated, added); err != nil {
+			return errors.Wrap(err, "add shards")
+		}
+	}
+
 	m.schemaCache.updateClass(updated, updatedShardingState)
 	m.logger.
 		WithField("action", "schema.update_class").
@@ -174,6 +268,12 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 	if err := m.repo.UpdateClass(ctx, payload); err != nil {
 		return err
 	}
+	if len(removed) > 0 {
+		// the replicas are dropped once this node no longer serves them
+		if err := m.migrator.DropShards(ctx, className, removed); err != nil {
+			return errors.Wrap(err, "drop removed replicas")
+		}
+	}
 	m.triggerSchemaUpdateCallbacks()
 
 	return nil
@@ -235,9 +335,12 @@ func (m *Manager) validateImmutableTextField(u immutableText,
 
 func (m *Manager) UpdateShardStatus(ctx context.Context, principal *models.Principal,
 	className, shardName, targetStatus string,
-) error {
-	err := m.Authorizer.Authorize(principal, "update",
-		fmt.Sprintf("schema/%s/shards/%s", className, shardName))
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal, "update", err, authorization.ShardsMetadata(className))
+	}()
+
+	err = m.Authorizer.Authorize(principal, "update", authorization.ShardsMetadata(className))
 	if err != nil {
 		return err
 	}
@@ -19,14 +19,19 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // MergeClassObjectProperty of an existing Class
 // Merges NestedProperties of incoming object/object[] property into existing one
 func (m *Manager) MergeClassObjectProperty(ctx context.Context, principal *models.Principal,
 	class string, property *models.Property,
-) error {
-	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal, "update", err, authorization.CollectionsMetadata(class))
+	}()
+
+	err = m.Authorizer.Authorize(principal, "update", authorization.CollectionsMetadata(class))
 	if err != nil {
 		return err
 	}
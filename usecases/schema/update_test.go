@@ -364,15 +364,15 @@ func TestClassUpdates(t *testing.T) {
 				assert.Nil(t, err)
 			})
 
-			t.Run("attempt an update of the vector index config", func(t *testing.T) {
+			t.Run("attempt an update of the sharding config", func(t *testing.T) {
 				err := sm.UpdateClass(context.Background(), nil,
 					"ClassWithShardingConfig", &models.Class{
 						Class: "ClassWithShardingConfig",
 						ShardingConfig: map[string]interface{}{
-							"desiredCount": json.Number("7"),
+							"virtualPerPhysical": json.Number("64"),
 						},
 					})
-				expectedErrMsg := "re-sharding not supported yet: shard count is immutable: attempted change from \"1\" to \"7\""
+				expectedErrMsg := "virtual shards per physical is immutable: attempted change from \"128\" to \"64\""
 				require.NotNil(t, err)
 				assert.Contains(t, err.Error(), expectedErrMsg)
 			})
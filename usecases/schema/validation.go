@@ -129,6 +129,33 @@ func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 	return nil
 }
 
+func (m *Manager) validatePropertyEncryption(prop *models.Property) error {
+	if !prop.Encrypted {
+		return nil
+	}
+
+	if !m.config.PropertyEncryption.Enabled {
+		return fmt.Errorf("property '%s': `encrypted` requires property encryption to be configured on the server",
+			prop.Name)
+	}
+
+	primitiveDataType, isPrimitive := schema.AsPrimitive(prop.DataType)
+	if !isPrimitive || (primitiveDataType != schema.DataTypeText &&
+		primitiveDataType != schema.DataTypeTextArray) {
+		return fmt.Errorf("property '%s': `encrypted` is not allowed for other than text/text[] data types",
+			prop.Name)
+	}
+
+	for _, indexed := range []*bool{prop.IndexInverted, prop.IndexFilterable, prop.IndexSearchable} {
+		if indexed != nil && *indexed {
+			return fmt.Errorf("property '%s': encrypted properties can not be indexed, "+
+				"set `indexFilterable` and `indexSearchable` to false", prop.Name)
+		}
+	}
+
+	return nil
+}
+
 type validatorNestedProperty func(property *models.NestedProperty,
 	primitiveDataType, nestedDataType schema.DataType,
 	isPrimitive, isNested bool, propNamePrefix string) error
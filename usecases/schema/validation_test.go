@@ -1336,3 +1336,91 @@ func (pdt *fakePropertyDataType) IsNested() bool {
 func (pdt *fakePropertyDataType) AsNested() schema.DataType {
 	return pdt.nestedDataType
 }
+
+func Test_Validation_PropertyEncryption(t *testing.T) {
+	vFalse := false
+	vTrue := true
+
+	type testCase struct {
+		name            string
+		dataType        schema.DataType
+		indexFilterable *bool
+		indexSearchable *bool
+		enabled         bool
+		expectedErrMsg  string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "text not indexed",
+			dataType: schema.DataTypeText, indexFilterable: &vFalse, indexSearchable: &vFalse,
+			enabled: true,
+		},
+		{
+			name:     "text[] not indexed",
+			dataType: schema.DataTypeTextArray, indexFilterable: &vFalse, indexSearchable: &vFalse,
+			enabled: true,
+		},
+		{
+			name:     "encryption not configured",
+			dataType: schema.DataTypeText, indexFilterable: &vFalse, indexSearchable: &vFalse,
+			expectedErrMsg: "property 'encrypted': `encrypted` requires property encryption to be configured on the server",
+		},
+		{
+			name:     "int",
+			dataType: schema.DataTypeInt, indexFilterable: &vFalse, indexSearchable: &vFalse,
+			enabled:        true,
+			expectedErrMsg: "property 'encrypted': `encrypted` is not allowed for other than text/text[] data types",
+		},
+		{
+			name:     "filterable",
+			dataType: schema.DataTypeText, indexFilterable: &vTrue, indexSearchable: &vFalse,
+			enabled: true,
+			expectedErrMsg: "property 'encrypted': encrypted properties can not be indexed, " +
+				"set `indexFilterable` and `indexSearchable` to false",
+		},
+		{
+			name:     "searchable",
+			dataType: schema.DataTypeText, indexFilterable: &vFalse, indexSearchable: &vTrue,
+			enabled: true,
+			expectedErrMsg: "property 'encrypted': encrypted properties can not be indexed, " +
+				"set `indexFilterable` and `indexSearchable` to false",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mgr := newSchemaManager()
+			mgr.config.PropertyEncryption.Enabled = tc.enabled
+
+			err := mgr.validatePropertyEncryption(&models.Property{
+				Name:            "encrypted",
+				DataType:        tc.dataType.PropString(),
+				IndexFilterable: tc.indexFilterable,
+				IndexSearchable: tc.indexSearchable,
+				Encrypted:       true,
+			})
+
+			if tc.expectedErrMsg != "" {
+				require.NotNil(t, err)
+				assert.EqualError(t, err, tc.expectedErrMsg)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+
+	t.Run("encrypted properties are not indexed by default", func(t *testing.T) {
+		prop := &models.Property{
+			Name:      "encrypted",
+			DataType:  schema.DataTypeText.PropString(),
+			Encrypted: true,
+		}
+		setPropertyDefaultIndexing(prop)
+
+		require.NotNil(t, prop.IndexFilterable)
+		require.NotNil(t, prop.IndexSearchable)
+		assert.False(t, *prop.IndexFilterable)
+		assert.False(t, *prop.IndexSearchable)
+	})
+}
@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func setVersioningConfigDefaults(class *models.Class) {
+	if schema.VersioningEnabled(class) && class.VersioningConfig.MaxVersions == 0 {
+		class.VersioningConfig.MaxVersions = schema.DefaultMaxVersions
+	}
+}
+
+func validateVersioningConfig(class *models.Class) error {
+	if class.VersioningConfig == nil {
+		return nil
+	}
+	if class.VersioningConfig.MaxVersions < 0 {
+		return fmt.Errorf("versioningConfig.maxVersions must not be negative, got %d",
+			class.VersioningConfig.MaxVersions)
+	}
+	return nil
+}
+
+// validateVersioningConfigUpdate only allows to change the number of kept
+// versions, the version history of a class is created along with its shards
+func validateVersioningConfigUpdate(initial, updated *models.Class) error {
+	if schema.VersioningEnabled(initial) != schema.VersioningEnabled(updated) {
+		if schema.VersioningEnabled(initial) {
+			return fmt.Errorf("disabling versioning for an existing class is not supported")
+		}
+		return fmt.Errorf("enabling versioning for an existing class is not supported")
+	}
+	return validateVersioningConfig(updated)
+}
@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestVersioningConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		class := &models.Class{VersioningConfig: &models.VersioningConfig{Enabled: true}}
+		setVersioningConfigDefaults(class)
+		assert.Equal(t, int64(10), class.VersioningConfig.MaxVersions)
+
+		class = &models.Class{}
+		setVersioningConfigDefaults(class)
+		assert.Nil(t, class.VersioningConfig)
+	})
+
+	t.Run("negative max versions", func(t *testing.T) {
+		class := &models.Class{VersioningConfig: &models.VersioningConfig{Enabled: true, MaxVersions: -1}}
+		assert.ErrorContains(t, validateVersioningConfig(class), "must not be negative")
+	})
+
+	t.Run("update", func(t *testing.T) {
+		enabled := &models.Class{VersioningConfig: &models.VersioningConfig{Enabled: true, MaxVersions: 10}}
+		disabled := &models.Class{}
+		fewer := &models.Class{VersioningConfig: &models.VersioningConfig{Enabled: true, MaxVersions: 3}}
+
+		assert.Nil(t, validateVersioningConfigUpdate(enabled, fewer))
+		assert.ErrorContains(t, validateVersioningConfigUpdate(enabled, disabled), "disabling")
+		assert.ErrorContains(t, validateVersioningConfigUpdate(disabled, enabled), "enabling")
+	})
+}
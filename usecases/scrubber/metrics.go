@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scrubber
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Metrics of shard scrubs
+type Metrics struct {
+	durations    *prometheus.HistogramVec
+	corruptFiles *prometheus.CounterVec
+	classLabel   func(string) string
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		durations:    prom.ScrubDurations,
+		corruptFiles: prom.ScrubCorruptFiles,
+		classLabel:   prom.ClassLabel,
+	}
+}
+
+func (m *Metrics) Scrubbed(class string, took time.Duration, corrupt int) {
+	if m == nil {
+		return
+	}
+
+	labels := prometheus.Labels{"class_name": m.classLabel(class)}
+	m.durations.With(labels).Observe(took.Seconds())
+	m.corruptFiles.With(labels).Add(float64(corrupt))
+}
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package scrubber periodically compares the on-disk segments and commit
+// logs of the local shards with their block checksums. Corrupt shards of
+// replicated classes can be repaired from their replicas.
+package scrubber
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/antientropy"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type schemaManager interface {
+	GetSchemaSkipAuth() schema.Schema
+	NodeName() string
+	CopyShardingState(class string) *sharding.State
+}
+
+type db interface {
+	ScrubShard(ctx context.Context, class, shard string) ([]diskio.CorruptFile, error)
+}
+
+type repairer interface {
+	Trigger(class, shard string) ([]antientropy.Job, error)
+}
+
+// Manager scrubs the shards of this node in the configured interval. A nil
+// Manager is valid and does nothing.
+type Manager struct {
+	config   config.Scrub
+	schema   schemaManager
+	db       db
+	repairer repairer
+	metrics  *Metrics
+	logger   logrus.FieldLogger
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewManager(cfg config.Scrub, schema schemaManager, db db, repairer repairer,
+	metrics *Metrics, logger logrus.FieldLogger,
+) *Manager {
+	return &Manager{
+		config:   cfg,
+		schema:   schema,
+		db:       db,
+		repairer: repairer,
+		metrics:  metrics,
+		logger:   logger.WithField("action", "scrub"),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start scrubbing in the configured interval
+func (m *Manager) Start() {
+	if m == nil {
+		return
+	}
+
+	// a running scrub is aborted on shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-m.stop
+		cancel()
+	}()
+
+	go func() {
+		defer close(m.done)
+
+		ticker := time.NewTicker(m.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.scrubLocal(ctx)
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Shutdown stops scrubbing, a running scrub is aborted after the current file
+func (m *Manager) Shutdown(ctx context.Context) error {
+	if m == nil {
+		return nil
+	}
+
+	close(m.stop)
+	select {
+	case <-m.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// scrubLocal scrubs all shards of which this node holds a replica
+func (m *Manager) scrubLocal(ctx context.Context) {
+	node := m.schema.NodeName()
+	for _, c := range m.schema.GetSchemaSkipAuth().Objects.Classes {
+		st := m.schema.CopyShardingState(c.Class)
+		if st == nil {
+			continue
+		}
+
+		for _, shard := range st.AllPhysicalShards() {
+			if ctx.Err() != nil {
+				return
+			}
+			if !belongsTo(st.Physical[shard], node) {
+				continue
+			}
+			m.scrubShard(ctx, c.Class, shard)
+		}
+	}
+}
+
+func (m *Manager) scrubShard(ctx context.Context, class, shard string) {
+	logger := m.logger.WithField("class", class).WithField("shard", shard)
+
+	start := time.Now()
+	corrupt, err := m.db.ScrubShard(ctx, class, shard)
+	m.metrics.Scrubbed(class, time.Since(start), len(corrupt))
+	if err != nil && !errors.Is(err, context.Canceled) {
+		logger.WithError(err).Error("could not scrub shard")
+	}
+	if len(corrupt) == 0 {
+		return
+	}
+
+	for _, file := range corrupt {
+		logger.WithField("path", file.Path).WithField("offsets", file.Offsets).
+			Error("file does not match its checksums")
+	}
+
+	if !m.config.RepairFromReplicas {
+		return
+	}
+	if _, err := m.repairer.Trigger(class, shard); err != nil {
+		if errors.Is(err, antientropy.ErrNotReplicated) {
+			logger.Warn("shard is corrupt, but cannot be repaired as its class is not replicated")
+			return
+		}
+		logger.WithError(err).Error("could not repair corrupt shard from its replicas")
+		return
+	}
+	logger.Info("repairing corrupt shard from its replicas")
+}
+
+func belongsTo(p sharding.Physical, node string) bool {
+	for _, n := range p.BelongsToNodes {
+		if n == node {
+			return true
+		}
+	}
+	return false
+}
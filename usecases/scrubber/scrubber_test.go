@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scrubber
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/antientropy"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeSchemaManager struct {
+	classes []*models.Class
+	states  map[string]*sharding.State
+	node    string
+}
+
+func (f *fakeSchemaManager) GetSchemaSkipAuth() schema.Schema {
+	return schema.Schema{Objects: &models.Schema{Classes: f.classes}}
+}
+
+func (f *fakeSchemaManager) NodeName() string { return f.node }
+
+func (f *fakeSchemaManager) CopyShardingState(class string) *sharding.State {
+	return f.states[class]
+}
+
+type fakeDB struct {
+	corrupt  map[string][]diskio.CorruptFile
+	scrubbed []string
+}
+
+func (f *fakeDB) ScrubShard(ctx context.Context, class, shard string) ([]diskio.CorruptFile, error) {
+	f.scrubbed = append(f.scrubbed, class+"/"+shard)
+	return f.corrupt[class+"/"+shard], nil
+}
+
+type fakeRepairer struct {
+	notReplicated map[string]bool
+	triggered     []string
+}
+
+func (f *fakeRepairer) Trigger(class, shard string) ([]antientropy.Job, error) {
+	if f.notReplicated[class] {
+		return nil, fmt.Errorf("class %q: %w", class, antientropy.ErrNotReplicated)
+	}
+	f.triggered = append(f.triggered, class+"/"+shard)
+	return []antientropy.Job{{Class: class, Shard: shard}}, nil
+}
+
+func TestScrubLocal(t *testing.T) {
+	sm := &fakeSchemaManager{
+		classes: []*models.Class{{Class: "Replicated"}, {Class: "Single"}},
+		states: map[string]*sharding.State{
+			"Replicated": {Physical: map[string]sharding.Physical{
+				"local":  {Name: "local", BelongsToNodes: []string{"node1", "node2"}},
+				"remote": {Name: "remote", BelongsToNodes: []string{"node2", "node3"}},
+			}},
+			"Single": {Physical: map[string]sharding.Physical{
+				"local": {Name: "local", BelongsToNodes: []string{"node1"}},
+			}},
+		},
+		node: "node1",
+	}
+	corrupt := []diskio.CorruptFile{{Path: "segment-1.db", Offsets: []int64{0}}}
+	newDB := func() *fakeDB {
+		return &fakeDB{corrupt: map[string][]diskio.CorruptFile{
+			"Replicated/local": corrupt,
+			"Single/local":     corrupt,
+		}}
+	}
+
+	t.Run("without repair", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		db, repairer := newDB(), &fakeRepairer{}
+		m := NewManager(config.Scrub{Interval: time.Hour}, sm, db, repairer, nil, logger)
+
+		m.scrubLocal(context.Background())
+		assert.ElementsMatch(t, []string{"Replicated/local", "Single/local"}, db.scrubbed)
+		assert.Empty(t, repairer.triggered)
+		assert.Len(t, hook.AllEntries(), 2)
+	})
+
+	t.Run("with repair", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		db := newDB()
+		repairer := &fakeRepairer{notReplicated: map[string]bool{"Single": true}}
+		cfg := config.Scrub{Interval: time.Hour, RepairFromReplicas: true}
+		m := NewManager(cfg, sm, db, repairer, nil, logger)
+
+		m.scrubLocal(context.Background())
+		assert.Equal(t, []string{"Replicated/local"}, repairer.triggered)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		db := newDB()
+		m := NewManager(config.Scrub{Interval: time.Hour}, sm, db, &fakeRepairer{}, nil, logger)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		m.scrubLocal(ctx)
+		assert.Empty(t, db.scrubbed)
+	})
+}
+
+func TestShutdown(t *testing.T) {
+	var m *Manager
+	m.Start()
+	assert.Nil(t, m.Shutdown(context.Background()))
+
+	logger, _ := test.NewNullLogger()
+	m = NewManager(config.Scrub{Interval: time.Hour}, &fakeSchemaManager{},
+		&fakeDB{}, &fakeRepairer{}, nil, logger)
+	m.Start()
+	assert.Nil(t, m.Shutdown(context.Background()))
+}
@@ -20,8 +20,9 @@ type nodeCounter interface {
 }
 
 func ValidateConfigUpdate(old, updated Config, nodeCounter nodeCounter) error {
-	if old.DesiredCount != updated.DesiredCount {
-		return fmt.Errorf("re-sharding not supported yet: shard count is immutable: "+
+	// shards can be split, but not merged
+	if updated.DesiredCount < old.DesiredCount {
+		return fmt.Errorf("shard count can only be increased: "+
 			"attempted change from \"%d\" to \"%d\"", old.DesiredCount,
 			updated.DesiredCount)
 	}
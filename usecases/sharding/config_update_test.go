@@ -30,12 +30,17 @@ func TestConfigUpdates(t *testing.T) {
 
 		tests := []test{
 			{
-				name:    "attempting to shard count",
+				name:    "attempting to decrease shard count",
+				initial: Config{DesiredCount: 8},
+				update:  Config{DesiredCount: 7},
+				expectedError: fmt.Errorf(
+					"shard count can only be increased: " +
+						"attempted change from \"8\" to \"7\""),
+			},
+			{
+				name:    "increasing shard count",
 				initial: Config{DesiredCount: 7},
 				update:  Config{DesiredCount: 8},
-				expectedError: fmt.Errorf(
-					"re-sharding not supported yet: shard count is immutable: " +
-						"attempted change from \"7\" to \"8\""),
 			},
 			{
 				name:    "attempting to shard count",
@@ -15,11 +15,17 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
 )
 
 type RemoteNodeClient interface {
 	GetNodeStatus(ctx context.Context, hostName, className, output string) (*models.NodeStatus, error)
+	GetTenantStats(ctx context.Context, hostName, className, tenant string) (*schema.TenantStats, error)
+	GetObjectVersions(ctx context.Context, hostName, className, shard string,
+		id strfmt.UUID) ([]*storobj.ObjectVersion, error)
 }
 
 type RemoteNode struct {
@@ -41,3 +47,21 @@ func (rn *RemoteNode) GetNodeStatus(ctx context.Context, nodeName, className, ou
 	}
 	return rn.client.GetNodeStatus(ctx, host, className, output)
 }
+
+func (rn *RemoteNode) GetTenantStats(ctx context.Context, nodeName, className, tenant string) (*schema.TenantStats, error) {
+	host, ok := rn.nodeResolver.NodeHostname(nodeName)
+	if !ok {
+		return nil, fmt.Errorf("resolve node name %q to host", nodeName)
+	}
+	return rn.client.GetTenantStats(ctx, host, className, tenant)
+}
+
+func (rn *RemoteNode) GetObjectVersions(ctx context.Context, nodeName, className, shard string,
+	id strfmt.UUID,
+) ([]*storobj.ObjectVersion, error) {
+	host, ok := rn.nodeResolver.NodeHostname(nodeName)
+	if !ok {
+		return nil, fmt.Errorf("resolve node name %q to host", nodeName)
+	}
+	return rn.client.GetObjectVersions(ctx, host, className, shard, id)
+}
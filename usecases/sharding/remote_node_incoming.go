@@ -14,11 +14,17 @@ package sharding
 import (
 	"context"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
 )
 
 type RemoteNodeIncomingRepo interface {
 	IncomingGetNodeStatus(ctx context.Context, className, output string) (*models.NodeStatus, error)
+	IncomingGetTenantStats(ctx context.Context, className, tenant string) (*schema.TenantStats, error)
+	IncomingGetObjectVersions(ctx context.Context, className, shard string,
+		id strfmt.UUID) ([]*storobj.ObjectVersion, error)
 }
 
 type RemoteNodeIncoming struct {
@@ -34,3 +40,13 @@ func NewRemoteNodeIncoming(repo RemoteNodeIncomingRepo) *RemoteNodeIncoming {
 func (rni *RemoteNodeIncoming) GetNodeStatus(ctx context.Context, className, output string) (*models.NodeStatus, error) {
 	return rni.repo.IncomingGetNodeStatus(ctx, className, output)
 }
+
+func (rni *RemoteNodeIncoming) GetTenantStats(ctx context.Context, className, tenant string) (*schema.TenantStats, error) {
+	return rni.repo.IncomingGetTenantStats(ctx, className, tenant)
+}
+
+func (rni *RemoteNodeIncoming) GetObjectVersions(ctx context.Context, className, shard string,
+	id strfmt.UUID,
+) ([]*storobj.ObjectVersion, error) {
+	return rni.repo.IncomingGetObjectVersions(ctx, className, shard, id)
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Reshard increases the number of physical shards to count. The number of
+// virtual shards stays the same, the new physical shards take over virtual
+// shards from the ones owning the most. Only the objects of those virtual
+// shards need to be moved, they are looked up in the shard they are moving
+// from until FinishResharding is called.
+func (s *State) Reshard(count int, nodes nodes) error {
+	if s.PartitioningEnabled {
+		return fmt.Errorf("classes with multi-tenancy cannot be resharded")
+	}
+	if s.Resharding() {
+		return fmt.Errorf("class is being resharded already")
+	}
+	if current := len(s.Physical); count <= current {
+		return fmt.Errorf("shard count must be greater than the current count of %d, got %d",
+			current, count)
+	}
+	if count > len(s.Virtual) {
+		return fmt.Errorf("shard count must not exceed the count of virtual shards %d, got %d",
+			len(s.Virtual), count)
+	}
+
+	replFactor := int64(1)
+	for _, physical := range s.Physical {
+		if n := int64(len(physical.BelongsToNodes)); n > replFactor {
+			replFactor = n
+		}
+	}
+	names := make([]string, count-len(s.Physical))
+	for i := range names {
+		names[i] = generateShardName()
+	}
+	placements, err := s.GetPartitions(nodes, names, replFactor)
+	if err != nil {
+		return err
+	}
+
+	owned := make(map[string][]int, count)
+	for i, v := range s.Virtual {
+		owned[v.AssignedToPhysical] = append(owned[v.AssignedToPhysical], i)
+	}
+	want := len(s.Virtual) / count
+	for _, name := range names {
+		s.Physical[name] = Physical{Name: name, BelongsToNodes: placements[name]}
+		for len(owned[name]) < want {
+			donor := s.largestPhysical(owned)
+			last := len(owned[donor]) - 1
+			i := owned[donor][last]
+			owned[donor] = owned[donor][:last]
+			owned[name] = append(owned[name], i)
+			s.Virtual[i].AssignedToPhysical = name
+			s.Virtual[i].MovingFrom = donor
+		}
+	}
+
+	for name, physical := range s.Physical {
+		physical.OwnsVirtual = make([]string, 0, len(owned[name]))
+		physical.OwnsPercentage = 0
+		for _, i := range owned[name] {
+			physical.OwnsVirtual = append(physical.OwnsVirtual, s.Virtual[i].Name)
+			physical.OwnsPercentage += s.Virtual[i].OwnsPercentage
+		}
+		s.Physical[name] = physical
+	}
+	s.Config.DesiredCount = count
+	return nil
+}
+
+// largestPhysical returns the physical shard owning the most virtual ones,
+// ties are broken by name
+func (s *State) largestPhysical(owned map[string][]int) string {
+	largest := ""
+	for name := range s.Physical {
+		if largest == "" || len(owned[name]) > len(owned[largest]) ||
+			(len(owned[name]) == len(owned[largest]) && name < largest) {
+			largest = name
+		}
+	}
+	return largest
+}
+
+// Resharding returns whether objects are being moved between physical shards
+func (s *State) Resharding() bool {
+	for _, v := range s.Virtual {
+		if v.MovingFrom != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// PreviousPhysicalShard returns the physical shard which may still hold the
+// object while the class is being resharded, otherwise an empty string
+func (s *State) PreviousPhysicalShard(in []byte) string {
+	if len(s.Virtual) == 0 {
+		return ""
+	}
+	return s.virtualByToken(token(in)).MovingFrom
+}
+
+// ReshardedShards returns the sorted physical shards which hold objects of
+// other physical shards while the class is being resharded
+func (s *State) ReshardedShards() []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, v := range s.Virtual {
+		if v.MovingFrom != "" && !seen[v.MovingFrom] {
+			seen[v.MovingFrom] = true
+			names = append(names, v.MovingFrom)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FinishResharding must be called once all objects have been moved
+func (s *State) FinishResharding() {
+	for i := range s.Virtual {
+		s.Virtual[i].MovingFrom = ""
+	}
+	s.Config.ActualCount = len(s.Physical)
+}
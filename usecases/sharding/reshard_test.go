@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReshard(t *testing.T) {
+	nodes := fakeNodes{[]string{"node1", "node2", "node3"}}
+	cfg, err := ParseConfig(map[string]interface{}{
+		"desiredCount":       float64(2),
+		"virtualPerPhysical": float64(8),
+	}, 3)
+	require.Nil(t, err)
+	before, err := InitState("my-index", cfg, nodes, 2, false)
+	require.Nil(t, err)
+
+	st := before.DeepCopy()
+	require.Nil(t, st.Reshard(5, nodes))
+	assert.Len(t, st.Physical, 5)
+	assert.Len(t, st.Virtual, 16)
+	assert.Equal(t, 5, st.Config.DesiredCount)
+	assert.Equal(t, 2, st.Config.ActualCount)
+	assert.True(t, st.Resharding())
+	assert.False(t, before.Resharding(), "the copy is not changed")
+
+	total := 0.0
+	for name, physical := range st.Physical {
+		assert.GreaterOrEqual(t, len(physical.OwnsVirtual), 3, name)
+		assert.Len(t, physical.BelongsToNodes, 2, name)
+		for _, v := range physical.OwnsVirtual {
+			assert.Equal(t, name, st.virtualByName(v).AssignedToPhysical)
+		}
+		total += physical.OwnsPercentage
+	}
+	assert.InDelta(t, 1.0, total, 1e-9)
+	assert.ElementsMatch(t, before.AllPhysicalShards(), st.ReshardedShards())
+
+	// objects either stay or move from their previous shard
+	moved := 0
+	for i := 0; i < 1000; i++ {
+		id := make([]byte, 16)
+		rand.Read(id)
+		old, current := before.PhysicalShard(id), st.PhysicalShard(id)
+		if old == current {
+			assert.Empty(t, st.PreviousPhysicalShard(id))
+			continue
+		}
+		moved++
+		assert.NotContains(t, before.Physical, current)
+		assert.Equal(t, old, st.PreviousPhysicalShard(id))
+	}
+	assert.Greater(t, moved, 0)
+
+	t.Run("serialization", func(t *testing.T) {
+		data, err := st.JSON()
+		require.Nil(t, err)
+		reloaded, err := StateFromJSON(data, nodes)
+		require.Nil(t, err)
+		assert.Equal(t, st.ReshardedShards(), reloaded.ReshardedShards())
+	})
+
+	t.Run("finish", func(t *testing.T) {
+		finished := st.DeepCopy()
+		finished.FinishResharding()
+		assert.False(t, finished.Resharding())
+		assert.Empty(t, finished.ReshardedShards())
+		assert.Equal(t, 5, finished.Config.ActualCount)
+		require.Nil(t, finished.Reshard(6, nodes))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		again := st.DeepCopy()
+		assert.ErrorContains(t, again.Reshard(6, nodes), "being resharded")
+
+		fresh := before.DeepCopy()
+		assert.ErrorContains(t, fresh.Reshard(2, nodes), "greater than")
+		assert.ErrorContains(t, fresh.Reshard(17, nodes), "virtual shards")
+		assert.ErrorContains(t, fresh.Reshard(3, fakeNodes{[]string{"node1"}}), "not enough replicas")
+
+		mt := State{PartitioningEnabled: true, Physical: map[string]Physical{}}
+		assert.ErrorContains(t, mt.Reshard(3, nodes), "multi-tenancy")
+	})
+}
@@ -55,6 +55,9 @@ type Virtual struct {
 	Upper              uint64  `json:"upper"`
 	OwnsPercentage     float64 `json:"ownsPercentage"`
 	AssignedToPhysical string  `json:"assignedToPhysical"`
+	// MovingFrom is the physical shard which still holds objects of this
+	// virtual shard while the class is being resharded
+	MovingFrom string `json:"movingFrom,omitempty"`
 }
 
 type Physical struct {
@@ -172,13 +175,13 @@ func (s *State) PhysicalShard(in []byte) string {
 		panic("no virtual shards present")
 	}
 
+	return s.virtualByToken(token(in)).AssignedToPhysical
+}
+
+func token(in []byte) uint64 {
 	h := murmur3.New64()
 	h.Write(in)
-	token := h.Sum64()
-
-	virtual := s.virtualByToken(token)
-
-	return virtual.AssignedToPhysical
+	return h.Sum64()
 }
 
 // CountPhysicalShards return a count of physical shards
@@ -530,5 +533,6 @@ func (v Virtual) DeepCopy() Virtual {
 		Upper:              v.Upper,
 		OwnsPercentage:     v.OwnsPercentage,
 		AssignedToPhysical: v.AssignedToPhysical,
+		MovingFrom:         v.MovingFrom,
 	}
 }
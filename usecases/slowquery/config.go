@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package slowquery
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	DefaultThreshold  = time.Second
+	DefaultSampleRate = 1.0
+	DefaultMaxEntries = 100
+)
+
+// Config of the slow query log. Queries which take at least Threshold are
+// logged and kept in memory, the latest MaxEntries of them can be listed
+// through the API. Only a SampleRate fraction of the queries is tracked,
+// since tracking times the stages of every shard search.
+type Config struct {
+	Enabled    bool          `json:"enabled" yaml:"enabled"`
+	Threshold  time.Duration `json:"threshold" yaml:"threshold"`
+	SampleRate float64       `json:"sample_rate" yaml:"sample_rate"`
+	MaxEntries int           `json:"max_entries" yaml:"max_entries"`
+}
+
+// Validate the slow query log config, can be called from the central config
+// package
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Threshold <= 0 {
+		return fmt.Errorf("slow_query_log: threshold must be positive")
+	}
+	if c.SampleRate <= 0 || c.SampleRate > 1 {
+		return fmt.Errorf("slow_query_log: sample rate must be in (0, 1], got %v", c.SampleRate)
+	}
+	if c.MaxEntries <= 0 {
+		return fmt.Errorf("slow_query_log: max entries must be positive")
+	}
+	return nil
+}
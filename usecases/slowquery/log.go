@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package slowquery
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// Entry is a slow query. Its parameters are redacted, the shards are only
+// known for Get queries.
+type Entry struct {
+	Time   time.Time              `json:"time"`
+	Type   string                 `json:"type"`
+	Class  string                 `json:"class"`
+	Tenant string                 `json:"tenant,omitempty"`
+	TookMs float64                `json:"tookMs"`
+	Params map[string]interface{} `json:"params"`
+	Shards []search.ShardPlan     `json:"shards,omitempty"`
+	Error  string                 `json:"error,omitempty"`
+}
+
+// Log keeps the latest slow queries of this node. A nil log tracks no
+// queries.
+type Log struct {
+	config Config
+	logger logrus.FieldLogger
+	random func() float64
+
+	sync.Mutex
+	entries []Entry // ring buffer of at most MaxEntries
+	next    int
+}
+
+func New(config Config, logger logrus.FieldLogger) *Log {
+	return &Log{
+		config:  config,
+		logger:  logger,
+		random:  rand.Float64,
+		entries: make([]Entry, 0, config.MaxEntries),
+	}
+}
+
+// Sample decides whether a query is tracked
+func (l *Log) Sample() bool {
+	if l == nil {
+		return false
+	}
+	return l.config.SampleRate >= 1 || l.random() < l.config.SampleRate
+}
+
+// Record keeps a tracked query which took at least the threshold, it
+// returns whether the query was slow
+func (l *Log) Record(entry Entry, took time.Duration) bool {
+	if l == nil || took < l.config.Threshold {
+		return false
+	}
+
+	entry.TookMs = float64(took.Microseconds()) / 1000
+	l.logger.WithFields(logrus.Fields{
+		"action":  "slow_query",
+		"type":    entry.Type,
+		"class":   entry.Class,
+		"took_ms": entry.TookMs,
+		"shards":  len(entry.Shards),
+	}).Warnf("query took %s, longer than the threshold of %s", took, l.config.Threshold)
+
+	l.Lock()
+	defer l.Unlock()
+	if len(l.entries) < l.config.MaxEntries {
+		l.entries = append(l.entries, entry)
+	} else {
+		l.entries[l.next] = entry
+	}
+	l.next = (l.next + 1) % l.config.MaxEntries
+	return true
+}
+
+// Entries are the kept slow queries, latest first
+func (l *Log) Entries() []Entry {
+	if l == nil {
+		return nil
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	entries := make([]Entry, 0, len(l.entries))
+	for i := 1; i <= len(l.entries); i++ {
+		entries = append(entries, l.entries[(l.next-i+len(l.entries))%len(l.entries)])
+	}
+	return entries
+}
+
+func (l *Log) Clear() {
+	if l == nil {
+		return
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	l.entries = l.entries[:0]
+	l.next = 0
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package slowquery
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func newTestLog(sampleRate float64) *Log {
+	logger, _ := test.NewNullLogger()
+	return New(Config{
+		Enabled: true, Threshold: 100 * time.Millisecond,
+		SampleRate: sampleRate, MaxEntries: 2,
+	}, logger)
+}
+
+func TestLog(t *testing.T) {
+	t.Run("threshold", func(t *testing.T) {
+		l := newTestLog(1)
+		assert.False(t, l.Record(Entry{Class: "Fast"}, 99*time.Millisecond))
+		assert.True(t, l.Record(Entry{Class: "Slow"}, 150*time.Millisecond))
+
+		entries := l.Entries()
+		require.Len(t, entries, 1)
+		assert.Equal(t, "Slow", entries[0].Class)
+		assert.Equal(t, 150.0, entries[0].TookMs)
+	})
+
+	t.Run("latest entries are kept", func(t *testing.T) {
+		l := newTestLog(1)
+		for _, class := range []string{"A", "B", "C"} {
+			l.Record(Entry{Class: class}, time.Second)
+		}
+		entries := l.Entries()
+		require.Len(t, entries, 2)
+		assert.Equal(t, "C", entries[0].Class)
+		assert.Equal(t, "B", entries[1].Class)
+
+		l.Clear()
+		assert.Empty(t, l.Entries())
+		l.Record(Entry{Class: "D"}, time.Second)
+		require.Len(t, l.Entries(), 1)
+		assert.Equal(t, "D", l.Entries()[0].Class)
+	})
+
+	t.Run("sampling", func(t *testing.T) {
+		l := newTestLog(0.25)
+		l.random = func() float64 { return 0.2 }
+		assert.True(t, l.Sample())
+		l.random = func() float64 { return 0.3 }
+		assert.False(t, l.Sample())
+	})
+
+	t.Run("nil log", func(t *testing.T) {
+		var l *Log
+		assert.False(t, l.Sample())
+		assert.False(t, l.Record(Entry{}, time.Hour))
+		assert.Nil(t, l.Entries())
+		l.Clear()
+	})
+}
+
+func TestGetParamsRedacted(t *testing.T) {
+	params := GetParams(dto.GetParams{
+		ClassName:  "Article",
+		Pagination: &filters.Pagination{Limit: 10},
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "Article", Property: "title"},
+			Value:    &filters.Value{Value: "secret title", Type: "text"},
+		}},
+		NearVector:   &searchparams.NearVector{Vector: []float32{0.1, 0.2, 0.3}},
+		NearObject:   &searchparams.NearObject{ID: "5a4bd6d1-3a9b-4a58-8c18-67b8b2dc6c9a"},
+		HybridSearch: &searchparams.HybridSearch{Query: "secret query", Alpha: 0.5},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Query: "secret keywords", Properties: []string{"title"},
+		},
+		ModuleParams: map[string]interface{}{"nearText": "secret concepts"},
+	})
+
+	encoded, err := json.Marshal(params)
+	require.Nil(t, err)
+	for _, value := range []string{
+		"secret", "0.1", "5a4bd6d1",
+	} {
+		assert.NotContains(t, string(encoded), value)
+	}
+	assert.Equal(t, map[string]interface{}{
+		"operator": "Equal", "path": "title",
+	}, params["where"])
+	assert.Equal(t, 3, params["nearVector"].(map[string]interface{})["dimensions"])
+	assert.Equal(t, []string{"nearText"}, params["modules"])
+}
@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package slowquery
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// GetParams are the parameters of a Get query without the values which
+// might contain user data, such as filter values, search terms, vectors and
+// object ids. The shape of the query is kept, as it decides how the query
+// is executed.
+func GetParams(p dto.GetParams) map[string]interface{} {
+	params := map[string]interface{}{}
+	if p.Pagination != nil {
+		params["limit"] = p.Pagination.Limit
+		params["offset"] = p.Pagination.Offset
+		if p.Pagination.Autocut > 0 {
+			params["autocut"] = p.Pagination.Autocut
+		}
+	}
+	if p.Cursor != nil {
+		params["after"] = redacted
+	}
+	addFilter(params, p.Filters)
+	addNearVector(params, p.NearVector)
+	addNearObject(params, p.NearObject)
+	addHybrid(params, p.HybridSearch)
+	if p.KeywordRanking != nil {
+		params["bm25"] = map[string]interface{}{"properties": p.KeywordRanking.Properties}
+	}
+	if len(p.Sort) > 0 {
+		paths := make([]string, len(p.Sort))
+		for i, s := range p.Sort {
+			paths[i] = strings.Join(s.Path, ".") + " " + s.Order
+		}
+		params["sort"] = paths
+	}
+	if p.GroupBy != nil {
+		params["groupBy"] = map[string]interface{}{
+			"property": p.GroupBy.Property, "groups": p.GroupBy.Groups,
+			"objectsPerGroup": p.GroupBy.ObjectsPerGroup,
+		}
+	}
+	addModules(params, p.ModuleParams)
+	if len(p.Tenants) > 0 {
+		params["tenants"] = len(p.Tenants)
+	}
+	params["properties"] = len(p.Properties)
+	return params
+}
+
+// AggregateParams are the parameters of an Aggregate query, redacted the
+// same way as the ones of Get queries
+func AggregateParams(p aggregation.Params) map[string]interface{} {
+	params := map[string]interface{}{}
+	if p.Limit != nil {
+		params["limit"] = *p.Limit
+	}
+	if p.ObjectLimit != nil {
+		params["objectLimit"] = *p.ObjectLimit
+	}
+	if p.GroupBy != nil {
+		params["groupBy"] = strings.Join(p.GroupBy.Slice(), ".")
+	}
+	addFilter(params, p.Filters)
+	addNearVector(params, p.NearVector)
+	addNearObject(params, p.NearObject)
+	addHybrid(params, p.Hybrid)
+	addModules(params, p.ModuleParams)
+	params["properties"] = len(p.Properties)
+	return params
+}
+
+const redacted = "<redacted>"
+
+func addFilter(params map[string]interface{}, filter *filters.LocalFilter) {
+	if filter != nil && filter.Root != nil {
+		params["where"] = redactClause(*filter.Root)
+	}
+}
+
+func redactClause(clause filters.Clause) map[string]interface{} {
+	redactedClause := map[string]interface{}{"operator": clause.Operator.Name()}
+	if clause.On != nil {
+		redactedClause["path"] = strings.Join(clause.On.Slice(), ".")
+	}
+	if len(clause.Operands) > 0 {
+		operands := make([]interface{}, len(clause.Operands))
+		for i := range clause.Operands {
+			operands[i] = redactClause(clause.Operands[i])
+		}
+		redactedClause["operands"] = operands
+	}
+	return redactedClause
+}
+
+func addNearVector(params map[string]interface{}, nearVector *searchparams.NearVector) {
+	if nearVector != nil {
+		params["nearVector"] = map[string]interface{}{
+			"dimensions": len(nearVector.Vector),
+			"certainty":  nearVector.Certainty,
+			"distance":   nearVector.Distance,
+		}
+	}
+}
+
+func addNearObject(params map[string]interface{}, nearObject *searchparams.NearObject) {
+	if nearObject != nil {
+		params["nearObject"] = map[string]interface{}{
+			"id":        redacted,
+			"certainty": nearObject.Certainty,
+			"distance":  nearObject.Distance,
+		}
+	}
+}
+
+func addHybrid(params map[string]interface{}, hybrid *searchparams.HybridSearch) {
+	if hybrid != nil {
+		params["hybrid"] = map[string]interface{}{
+			"alpha":      hybrid.Alpha,
+			"properties": hybrid.Properties,
+			"fusion":     hybrid.FusionAlgorithm,
+			"vector":     len(hybrid.Vector) > 0,
+		}
+	}
+}
+
+// addModules only keeps the names of the module arguments, e.g. nearText
+func addModules(params map[string]interface{}, moduleParams map[string]interface{}) {
+	if len(moduleParams) == 0 {
+		return
+	}
+	names := make([]string, 0, len(moduleParams))
+	for name := range moduleParams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	params["modules"] = names
+}
@@ -0,0 +1,377 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// special columns which are not properties
+const (
+	ColumnID       = "id"
+	ColumnDistance = "_distance"
+)
+
+type traverser interface {
+	GetClass(ctx context.Context, principal *models.Principal,
+		params dto.GetParams) ([]interface{}, error)
+}
+
+type schemaGetter interface {
+	GetSchema(principal *models.Principal) (schema.Schema, error)
+}
+
+// Executor answers queries with the traverser, like Get queries of the
+// GraphQL API
+type Executor struct {
+	traverser traverser
+	schema    schemaGetter
+}
+
+func NewExecutor(traverser traverser, schema schemaGetter) *Executor {
+	return &Executor{traverser: traverser, schema: schema}
+}
+
+// Column is a column of a result, its type is the data type of the
+// property, "uuid" for the id and "number" for the distance
+type Column struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type Result struct {
+	Columns []Column        `json:"columns"`
+	Rows    [][]interface{} `json:"rows"`
+}
+
+// Query runs a SELECT query on the objects of the tenant
+func (e *Executor) Query(ctx context.Context, principal *models.Principal,
+	query, tenant string,
+) (*Result, error) {
+	stmt, err := Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sch, err := e.schema.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+	class := findClass(sch, stmt.Class)
+	if class == nil {
+		return nil, invalidf("class %q not found", stmt.Class)
+	}
+
+	columns, err := stmt.columns(class)
+	if err != nil {
+		return nil, err
+	}
+	params, err := stmt.params(class, columns)
+	if err != nil {
+		return nil, err
+	}
+	params.Tenant = tenant
+	if params.Filters != nil {
+		if err := filters.ValidateFilters(sch, params.Filters); err != nil {
+			return nil, invalidf("%v", err)
+		}
+	}
+	if len(params.Sort) > 0 {
+		if err := filters.ValidateSort(sch, schema.ClassName(class.Class), params.Sort); err != nil {
+			return nil, invalidf("%v", err)
+		}
+	}
+
+	res, err := e.traverser.GetClass(ctx, principal, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Columns: columns, Rows: make([][]interface{}, 0, len(res))}
+	for _, r := range res {
+		obj, _ := r.(map[string]interface{})
+		add, _ := obj["_additional"].(map[string]interface{})
+		row := make([]interface{}, len(columns))
+		for i, column := range columns {
+			switch column.Name {
+			case ColumnID:
+				row[i] = add["id"]
+			case ColumnDistance:
+				row[i] = add["distance"]
+			default:
+				row[i] = obj[column.Name]
+			}
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	return result, nil
+}
+
+// findClass matches names case-insensitively if there is no exact match,
+// since SQL clients often fold the case of names
+func findClass(sch schema.Schema, name string) *models.Class {
+	if class := sch.FindClassByName(schema.ClassName(name)); class != nil {
+		return class
+	}
+	if sch.Objects == nil {
+		return nil
+	}
+	for _, class := range sch.Objects.Classes {
+		if strings.EqualFold(class.Class, name) {
+			return class
+		}
+	}
+	return nil
+}
+
+func findProperty(class *models.Class, name string) *models.Property {
+	var folded *models.Property
+	for _, prop := range class.Properties {
+		if prop.Name == name {
+			return prop
+		}
+		if strings.EqualFold(prop.Name, name) {
+			folded = prop
+		}
+	}
+	return folded
+}
+
+// columns resolves the selected columns, * selects the id and the
+// primitive properties
+func (s *Select) columns(class *models.Class) ([]Column, error) {
+	if s.Columns == nil {
+		columns := []Column{{Name: ColumnID, Type: "uuid"}}
+		for _, prop := range class.Properties {
+			if dataType, ok := schema.AsPrimitive(prop.DataType); ok {
+				columns = append(columns, Column{Name: prop.Name, Type: string(dataType)})
+			}
+		}
+		return columns, nil
+	}
+
+	columns := make([]Column, len(s.Columns))
+	for i, name := range s.Columns {
+		switch {
+		case strings.EqualFold(name, ColumnID):
+			columns[i] = Column{Name: ColumnID, Type: "uuid"}
+		case strings.EqualFold(name, ColumnDistance):
+			if s.Near == nil {
+				return nil, invalidf("column %s requires NEAR", ColumnDistance)
+			}
+			columns[i] = Column{Name: ColumnDistance, Type: string(schema.DataTypeNumber)}
+		default:
+			prop := findProperty(class, name)
+			if prop == nil {
+				return nil, invalidf("column %q not found in class %q", name, class.Class)
+			}
+			dataType, ok := schema.AsPrimitive(prop.DataType)
+			if !ok {
+				return nil, invalidf("column %q is not of a primitive type", prop.Name)
+			}
+			columns[i] = Column{Name: prop.Name, Type: string(dataType)}
+		}
+	}
+	return columns, nil
+}
+
+func (s *Select) params(class *models.Class, columns []Column) (dto.GetParams, error) {
+	params := dto.GetParams{
+		ClassName:  class.Class,
+		Pagination: &filters.Pagination{Offset: s.Offset, Limit: s.Limit},
+	}
+	if s.Limit < 0 {
+		params.Pagination.Limit = filters.LimitFlagNotSet
+	}
+
+	for _, column := range columns {
+		switch column.Name {
+		case ColumnID:
+			params.AdditionalProperties.ID = true
+		case ColumnDistance:
+			params.AdditionalProperties.Distance = true
+		default:
+			params.Properties = append(params.Properties,
+				search.SelectProperty{Name: column.Name, IsPrimitive: true})
+		}
+	}
+	if len(params.Properties) == 0 {
+		params.AdditionalProperties.NoProps = true
+	}
+
+	if s.Where != nil {
+		clause, err := s.clause(class, s.Where)
+		if err != nil {
+			return params, err
+		}
+		params.Filters = &filters.LocalFilter{Root: &clause}
+	}
+
+	if near := s.Near; near != nil {
+		if len(s.OrderBy) > 0 {
+			return params, invalidf("ORDER BY can not be combined with NEAR, " +
+				"the results are ordered by their distance")
+		}
+		if near.Vector != nil {
+			params.NearVector = &searchparams.NearVector{Vector: near.Vector}
+			if near.Distance != nil {
+				params.NearVector.Distance = *near.Distance
+				params.NearVector.WithDistance = true
+			}
+		} else {
+			params.NearObject = &searchparams.NearObject{ID: near.ID}
+			if near.Distance != nil {
+				params.NearObject.Distance = *near.Distance
+				params.NearObject.WithDistance = true
+			}
+		}
+	}
+
+	for _, order := range s.OrderBy {
+		name := filters.InternalPropID
+		if !strings.EqualFold(order.Column, ColumnID) {
+			prop := findProperty(class, order.Column)
+			if prop == nil {
+				return params, invalidf("column %q not found in class %q", order.Column, class.Class)
+			}
+			name = prop.Name
+		}
+		sort := filters.Sort{Path: []string{name}, Order: "asc"}
+		if order.Desc {
+			sort.Order = "desc"
+		}
+		params.Sort = append(params.Sort, sort)
+	}
+	return params, nil
+}
+
+var operators = map[string]filters.Operator{
+	"=":         filters.OperatorEqual,
+	"!=":        filters.OperatorNotEqual,
+	"<":         filters.OperatorLessThan,
+	"<=":        filters.OperatorLessThanEqual,
+	">":         filters.OperatorGreaterThan,
+	">=":        filters.OperatorGreaterThanEqual,
+	OpLike:      filters.OperatorLike,
+	OpIsNull:    filters.OperatorIsNull,
+	OpIsNotNull: filters.OperatorIsNull,
+}
+
+func (s *Select) clause(class *models.Class, c *Condition) (filters.Clause, error) {
+	if c.Op == OpAnd || c.Op == OpOr {
+		clause := filters.Clause{Operator: filters.OperatorAnd}
+		if c.Op == OpOr {
+			clause.Operator = filters.OperatorOr
+		}
+		for _, operand := range c.Operands {
+			o, err := s.clause(class, operand)
+			if err != nil {
+				return clause, err
+			}
+			clause.Operands = append(clause.Operands, o)
+		}
+		return clause, nil
+	}
+
+	clause := filters.Clause{Operator: operators[c.Op]}
+	var dataType schema.DataType
+	if strings.EqualFold(c.Column, ColumnID) {
+		if c.Op == OpIsNull || c.Op == OpIsNotNull {
+			return clause, invalidf("column %s is never null", ColumnID)
+		}
+		clause.On = &filters.Path{
+			Class:    schema.ClassName(class.Class),
+			Property: schema.PropertyName(filters.InternalPropID),
+		}
+		dataType = schema.DataTypeText
+	} else {
+		prop := findProperty(class, c.Column)
+		if prop == nil {
+			return clause, invalidf("column %q not found in class %q", c.Column, class.Class)
+		}
+		clause.On = &filters.Path{
+			Class:    schema.ClassName(class.Class),
+			Property: schema.PropertyName(prop.Name),
+		}
+		primitive, ok := schema.AsPrimitive(prop.DataType)
+		if !ok {
+			return clause, invalidf("column %q is not of a primitive type", prop.Name)
+		}
+		// array properties are filtered by the values of their elements
+		dataType = primitive
+		if base, isArray := schema.IsArrayType(primitive); isArray {
+			dataType = base
+		}
+		if dataType == schema.DataTypeUUID {
+			dataType = schema.DataTypeText
+		}
+	}
+
+	switch c.Op {
+	case OpIsNull, OpIsNotNull:
+		clause.Value = &filters.Value{Value: c.Op == OpIsNull, Type: schema.DataTypeBoolean}
+		return clause, nil
+	case OpLike:
+		if dataType != schema.DataTypeText && dataType != schema.DataTypeString {
+			return clause, invalidf("LIKE requires a text column, %q is %s", c.Column, dataType)
+		}
+	}
+
+	value, err := filterValue(c.Value, dataType)
+	if err != nil {
+		return clause, invalidf("column %q: %v", c.Column, err)
+	}
+	clause.Value = &filters.Value{Value: value, Type: dataType}
+	return clause, nil
+}
+
+// filterValue converts a literal to the value type of the filters of a
+// data type
+func filterValue(v interface{}, dataType schema.DataType) (interface{}, error) {
+	switch dataType {
+	case schema.DataTypeInt:
+		switch n := v.(type) {
+		case int64:
+			return int(n), nil
+		case float64:
+			if n == float64(int(n)) {
+				return int(n), nil
+			}
+		}
+	case schema.DataTypeNumber:
+		switch n := v.(type) {
+		case int64:
+			return float64(n), nil
+		case float64:
+			return n, nil
+		}
+	case schema.DataTypeBoolean:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case schema.DataTypeText, schema.DataTypeString, schema.DataTypeDate:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	default:
+		return nil, fmt.Errorf("can not filter by %s", dataType)
+	}
+	return nil, fmt.Errorf("expected a value of type %s, got %v", dataType, v)
+}
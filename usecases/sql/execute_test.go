@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+type fakeTraverser struct {
+	params  dto.GetParams
+	results []interface{}
+}
+
+func (f *fakeTraverser) GetClass(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) ([]interface{}, error) {
+	f.params = params
+	return f.results, nil
+}
+
+type fakeSchemaGetter struct{}
+
+func (f *fakeSchemaGetter) GetSchema(principal *models.Principal) (schema.Schema, error) {
+	return schema.Schema{Objects: &models.Schema{Classes: []*models.Class{{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "wordCount", DataType: schema.DataTypeInt.PropString()},
+			{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+			{Name: "hasAuthor", DataType: []string{"Author"}},
+		},
+	}}}}, nil
+}
+
+func TestExecutor(t *testing.T) {
+	ctx := context.Background()
+	id := strfmt.UUID("5a4bd6d1-3a9b-4a58-8c18-67b8b2dc6c9a")
+
+	t.Run("all columns", func(t *testing.T) {
+		traverser := &fakeTraverser{results: []interface{}{
+			map[string]interface{}{
+				"title":       "hello",
+				"wordCount":   int64(7),
+				"_additional": map[string]interface{}{"id": id},
+			},
+		}}
+		e := NewExecutor(traverser, &fakeSchemaGetter{})
+		res, err := e.Query(ctx, nil, "select * from article where WORDCOUNT > 5 "+
+			"and tags = 'news' order by title limit 10", "tenant1")
+		require.Nil(t, err)
+
+		assert.Equal(t, []Column{
+			{Name: "id", Type: "uuid"},
+			{Name: "title", Type: "text"},
+			{Name: "wordCount", Type: "int"},
+			{Name: "tags", Type: "text[]"},
+		}, res.Columns)
+		assert.Equal(t, [][]interface{}{{id, "hello", int64(7), nil}}, res.Rows)
+
+		path := func(prop string) *filters.Path {
+			return &filters.Path{Class: "Article", Property: schema.PropertyName(prop)}
+		}
+		assert.Equal(t, dto.GetParams{
+			ClassName: "Article",
+			Tenant:    "tenant1",
+			Properties: search.SelectProperties{
+				{Name: "title", IsPrimitive: true},
+				{Name: "wordCount", IsPrimitive: true},
+				{Name: "tags", IsPrimitive: true},
+			},
+			AdditionalProperties: additional.Properties{ID: true},
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorAnd,
+				Operands: []filters.Clause{
+					{
+						Operator: filters.OperatorGreaterThan,
+						On:       path("wordCount"),
+						Value:    &filters.Value{Value: 5, Type: schema.DataTypeInt},
+					},
+					{
+						Operator: filters.OperatorEqual,
+						On:       path("tags"),
+						Value:    &filters.Value{Value: "news", Type: schema.DataTypeText},
+					},
+				},
+			}},
+			Sort:       []filters.Sort{{Path: []string{"title"}, Order: "asc"}},
+			Pagination: &filters.Pagination{Limit: 10},
+		}, traverser.params)
+	})
+
+	t.Run("near", func(t *testing.T) {
+		traverser := &fakeTraverser{results: []interface{}{
+			map[string]interface{}{
+				"_additional": map[string]interface{}{"id": id, "distance": float32(0.1)},
+			},
+		}}
+		e := NewExecutor(traverser, &fakeSchemaGetter{})
+		res, err := e.Query(ctx, nil, "SELECT _distance, id FROM Article "+
+			"WHERE NEAR('"+id.String()+"', 0.5) AND title IS NOT NULL", "")
+		require.Nil(t, err)
+		assert.Equal(t, [][]interface{}{{float32(0.1), id}}, res.Rows)
+		assert.Equal(t, &searchparams.NearObject{ID: id.String(), Distance: 0.5, WithDistance: true},
+			traverser.params.NearObject)
+		assert.Equal(t, additional.Properties{ID: true, Distance: true, NoProps: true},
+			traverser.params.AdditionalProperties)
+		assert.Equal(t, filters.LimitFlagNotSet, traverser.params.Pagination.Limit)
+		assert.Equal(t, &filters.Value{Value: false, Type: schema.DataTypeBoolean},
+			traverser.params.Filters.Root.Value)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		queries := []string{
+			"SELECT * FROM Unknown",
+			"SELECT unknown FROM Article",
+			"SELECT hasAuthor FROM Article",
+			"SELECT _distance FROM Article",
+			"SELECT * FROM Article WHERE wordCount = 'many'",
+			"SELECT * FROM Article WHERE wordCount LIKE '1*'",
+			"SELECT * FROM Article WHERE hasAuthor = 'a'",
+			"SELECT * FROM Article WHERE id IS NULL",
+			"SELECT * FROM Article ORDER BY unknown",
+			"SELECT * FROM Article WHERE NEAR([1, 2]) ORDER BY title",
+		}
+		for _, query := range queries {
+			t.Run(query, func(t *testing.T) {
+				e := NewExecutor(&fakeTraverser{}, &fakeSchemaGetter{})
+				_, err := e.Query(ctx, nil, query, "")
+				require.NotNil(t, err)
+				assert.True(t, errors.Is(err, ErrInvalidQuery), err.Error())
+			})
+		}
+	})
+}
@@ -0,0 +1,567 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidQuery is wrapped by the errors of queries which can not be
+// parsed or do not match the schema
+var ErrInvalidQuery = errors.New("invalid query")
+
+func invalidf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidQuery, fmt.Sprintf(format, args...))
+}
+
+// Select is a parsed query:
+//
+//	SELECT * | column, ... FROM class
+//	[WHERE condition] [ORDER BY column [ASC|DESC], ...]
+//	[LIMIT n] [OFFSET n]
+//
+// Conditions compare columns with literals (=, !=, <>, <, <=, >, >=, LIKE,
+// IS [NOT] NULL) and are combined with AND, OR and parentheses. A condition
+// of the top level conjunction of WHERE can be NEAR(target[, distance]),
+// whose target is a vector like [0.1, 0.2] or the id of an object, the
+// results are ordered by their distance then.
+type Select struct {
+	// Columns are nil for *
+	Columns []string
+	Class   string
+	Where   *Condition
+	Near    *Near
+	OrderBy []OrderBy
+	// Limit is -1 if it is not set
+	Limit  int
+	Offset int
+}
+
+type OrderBy struct {
+	Column string
+	Desc   bool
+}
+
+type Near struct {
+	Vector   []float32
+	ID       string
+	Distance *float64
+}
+
+// Condition is either a comparison of a column with a value, or the And or
+// Or combination of its operands
+type Condition struct {
+	Op       string
+	Column   string
+	Value    interface{}
+	Operands []*Condition
+	near     *Near
+}
+
+// operators of conditions
+const (
+	OpAnd       = "AND"
+	OpOr        = "OR"
+	OpLike      = "LIKE"
+	OpIsNull    = "IS NULL"
+	OpIsNotNull = "IS NOT NULL"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenQuotedIdent
+	tokenString
+	tokenNumber
+	tokenSymbol
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func tokenize(query string) ([]token, error) {
+	var tokens []token
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{tokenIdent, string(runes[start:i]), start})
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' ||
+				runes[i] == 'e' || runes[i] == 'E' ||
+				((runes[i] == '-' || runes[i] == '+') && (runes[i-1] == 'e' || runes[i-1] == 'E'))) {
+				i++
+			}
+			tokens = append(tokens, token{tokenNumber, string(runes[start:i]), start})
+		case r == '\'' || r == '"':
+			start := i
+			var sb strings.Builder
+			for i++; ; i++ {
+				if i >= len(runes) {
+					return nil, invalidf("unterminated quote at position %d", start)
+				}
+				if runes[i] == r {
+					// quotes are escaped by doubling them
+					if i+1 < len(runes) && runes[i+1] == r {
+						sb.WriteRune(r)
+						i++
+						continue
+					}
+					i++
+					break
+				}
+				sb.WriteRune(runes[i])
+			}
+			kind := tokenString
+			if r == '"' {
+				kind = tokenQuotedIdent
+			}
+			tokens = append(tokens, token{kind, sb.String(), start})
+		default:
+			start := i
+			i++
+			if i < len(runes) {
+				switch two := string(runes[start : i+1]); two {
+				case "!=", "<>", "<=", ">=":
+					i++
+					tokens = append(tokens, token{tokenSymbol, two, start})
+					continue
+				}
+			}
+			if !strings.ContainsRune("*,()[]=<>;-", r) {
+				return nil, invalidf("unexpected %q at position %d", r, start)
+			}
+			tokens = append(tokens, token{tokenSymbol, string(r), start})
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse parses a SELECT query
+func Parse(query string) (*Select, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	return p.parseSelect()
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// keyword consumes the next token if it is one of the keywords
+func (p *parser) keyword(keywords ...string) bool {
+	t := p.peek()
+	if t.kind != tokenIdent {
+		return false
+	}
+	for _, k := range keywords {
+		if strings.EqualFold(t.text, k) {
+			p.pos++
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) symbol(symbol string) bool {
+	if t := p.peek(); t.kind == tokenSymbol && t.text == symbol {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) unexpected(expected string) error {
+	t := p.peek()
+	if t.kind == tokenEOF {
+		return invalidf("expected %s at the end of the query", expected)
+	}
+	return invalidf("expected %s at position %d, got %q", expected, t.pos, t.text)
+}
+
+func (p *parser) expectKeyword(keyword string) error {
+	if !p.keyword(keyword) {
+		return p.unexpected(keyword)
+	}
+	return nil
+}
+
+func (p *parser) expectSymbol(symbol string) error {
+	if !p.symbol(symbol) {
+		return p.unexpected(fmt.Sprintf("%q", symbol))
+	}
+	return nil
+}
+
+var reserved = map[string]bool{
+	"SELECT": true, "FROM": true, "WHERE": true, "ORDER": true, "BY": true,
+	"LIMIT": true, "OFFSET": true, "AND": true, "OR": true, "NOT": true,
+	"IS": true, "NULL": true, "LIKE": true, "ASC": true, "DESC": true,
+	"NEAR": true, "TRUE": true, "FALSE": true,
+}
+
+func (p *parser) ident() (string, error) {
+	t := p.peek()
+	switch {
+	case t.kind == tokenQuotedIdent:
+		p.pos++
+		return t.text, nil
+	case t.kind == tokenIdent && !reserved[strings.ToUpper(t.text)]:
+		p.pos++
+		return t.text, nil
+	default:
+		return "", p.unexpected("a name")
+	}
+}
+
+func (p *parser) integer() (int, error) {
+	t := p.peek()
+	if t.kind != tokenNumber {
+		return 0, p.unexpected("a number")
+	}
+	n, err := strconv.Atoi(t.text)
+	if err != nil || n < 0 {
+		return 0, invalidf("expected a positive integer at position %d, got %q", t.pos, t.text)
+	}
+	p.pos++
+	return n, nil
+}
+
+func (p *parser) parseSelect() (*Select, error) {
+	s := &Select{Limit: -1}
+	if err := p.expectKeyword("SELECT"); err != nil {
+		return nil, err
+	}
+	if !p.symbol("*") {
+		for {
+			column, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			s.Columns = append(s.Columns, column)
+			if !p.symbol(",") {
+				break
+			}
+		}
+	}
+
+	if err := p.expectKeyword("FROM"); err != nil {
+		return nil, err
+	}
+	class, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	s.Class = class
+
+	if p.keyword("WHERE") {
+		where, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if s.Where, s.Near, err = extractNear(where); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.keyword("ORDER") {
+		if err := p.expectKeyword("BY"); err != nil {
+			return nil, err
+		}
+		for {
+			column, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			order := OrderBy{Column: column}
+			if p.keyword("DESC") {
+				order.Desc = true
+			} else {
+				p.keyword("ASC")
+			}
+			s.OrderBy = append(s.OrderBy, order)
+			if !p.symbol(",") {
+				break
+			}
+		}
+	}
+
+	if p.keyword("LIMIT") {
+		if s.Limit, err = p.integer(); err != nil {
+			return nil, err
+		}
+	}
+	if p.keyword("OFFSET") {
+		if s.Offset, err = p.integer(); err != nil {
+			return nil, err
+		}
+	}
+
+	p.symbol(";")
+	if p.peek().kind != tokenEOF {
+		return nil, p.unexpected("the end of the query")
+	}
+	return s, nil
+}
+
+func (p *parser) parseOr() (*Condition, error) {
+	return p.parseBinary(OpOr, p.parseAnd)
+}
+
+func (p *parser) parseAnd() (*Condition, error) {
+	return p.parseBinary(OpAnd, p.parsePrimary)
+}
+
+func (p *parser) parseBinary(op string, operand func() (*Condition, error)) (*Condition, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	operands := []*Condition{first}
+	for p.keyword(op) {
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, next)
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return &Condition{Op: op, Operands: operands}, nil
+}
+
+func (p *parser) parsePrimary() (*Condition, error) {
+	if p.symbol("(") {
+		c, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return c, p.expectSymbol(")")
+	}
+	if p.keyword("NEAR") {
+		return p.parseNear()
+	}
+	if p.keyword("NOT") {
+		return nil, invalidf("NOT is only supported as IS NOT NULL")
+	}
+
+	column, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	c := &Condition{Column: column}
+	switch {
+	case p.keyword("IS"):
+		c.Op = OpIsNull
+		if p.keyword("NOT") {
+			c.Op = OpIsNotNull
+		}
+		return c, p.expectKeyword("NULL")
+	case p.keyword("LIKE"):
+		c.Op = OpLike
+	default:
+		t := p.peek()
+		switch t.text {
+		case "=", "!=", "<>", "<", "<=", ">", ">=":
+			if t.kind == tokenSymbol {
+				p.pos++
+				c.Op = t.text
+				if c.Op == "<>" {
+					c.Op = "!="
+				}
+			}
+		}
+		if c.Op == "" {
+			return nil, p.unexpected("an operator")
+		}
+	}
+
+	if c.Value, err = p.literal(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// literal parses strings, numbers and booleans, integers are int64 and
+// other numbers are float64
+func (p *parser) literal() (interface{}, error) {
+	negative := p.symbol("-")
+	t := p.peek()
+	switch {
+	case t.kind == tokenString && !negative:
+		p.pos++
+		return t.text, nil
+	case t.kind == tokenNumber:
+		p.pos++
+		text := t.text
+		if negative {
+			text = "-" + text
+		}
+		if i, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return i, nil
+		}
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, invalidf("invalid number %q at position %d", t.text, t.pos)
+		}
+		return f, nil
+	case t.kind == tokenIdent && !negative && p.keyword("TRUE"):
+		return true, nil
+	case t.kind == tokenIdent && !negative && p.keyword("FALSE"):
+		return false, nil
+	default:
+		return nil, p.unexpected("a value")
+	}
+}
+
+func (p *parser) number() (float64, error) {
+	v, err := p.literal()
+	if err != nil {
+		return 0, err
+	}
+	switch n := v.(type) {
+	case int64:
+		return float64(n), nil
+	case float64:
+		return n, nil
+	default:
+		return 0, invalidf("expected a number, got %v", v)
+	}
+}
+
+func (p *parser) parseNear() (*Condition, error) {
+	if err := p.expectSymbol("("); err != nil {
+		return nil, err
+	}
+	near := &Near{}
+	if p.symbol("[") {
+		for !p.symbol("]") {
+			if len(near.Vector) > 0 {
+				if err := p.expectSymbol(","); err != nil {
+					return nil, err
+				}
+			}
+			f, err := p.number()
+			if err != nil {
+				return nil, err
+			}
+			near.Vector = append(near.Vector, float32(f))
+		}
+		if len(near.Vector) == 0 {
+			return nil, invalidf("NEAR vector is empty")
+		}
+	} else if t := p.peek(); t.kind == tokenString {
+		p.pos++
+		near.ID = t.text
+	} else {
+		return nil, p.unexpected("a vector or an object id")
+	}
+
+	if p.symbol(",") {
+		distance, err := p.number()
+		if err != nil {
+			return nil, err
+		}
+		near.Distance = &distance
+	}
+	if err := p.expectSymbol(")"); err != nil {
+		return nil, err
+	}
+	return &Condition{near: near}, nil
+}
+
+// extractNear removes NEAR from the top level conjunction of a condition,
+// it can not be combined with other conditions otherwise
+func extractNear(c *Condition) (*Condition, *Near, error) {
+	if c.near != nil {
+		return nil, c.near, nil
+	}
+	if c.Op != OpAnd {
+		return c, nil, checkNoNear(c)
+	}
+
+	var (
+		near     *Near
+		operands []*Condition
+	)
+	for _, operand := range c.Operands {
+		if operand.near == nil {
+			if err := checkNoNear(operand); err != nil {
+				return nil, nil, err
+			}
+			operands = append(operands, operand)
+			continue
+		}
+		if near != nil {
+			return nil, nil, invalidf("only one NEAR is supported")
+		}
+		near = operand.near
+	}
+	switch len(operands) {
+	case 0:
+		return nil, near, nil
+	case 1:
+		return operands[0], near, nil
+	default:
+		return &Condition{Op: OpAnd, Operands: operands}, near, nil
+	}
+}
+
+func checkNoNear(c *Condition) error {
+	if c.near != nil {
+		return invalidf("NEAR can only be combined with other conditions by AND")
+	}
+	for _, operand := range c.Operands {
+		if err := checkNoNear(operand); err != nil {
+			return err
+		}
+	}
+	return nil
+}
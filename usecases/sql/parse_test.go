@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	distance := 0.3
+	tests := []struct {
+		name     string
+		query    string
+		expected *Select
+	}{
+		{
+			name:     "all columns",
+			query:    "SELECT * FROM Article",
+			expected: &Select{Class: "Article", Limit: -1},
+		},
+		{
+			name: "columns, order and pagination",
+			query: `select title, "wordCount" from Article order by wordCount desc, title
+				limit 10 offset 20;`,
+			expected: &Select{
+				Columns: []string{"title", "wordCount"},
+				Class:   "Article",
+				OrderBy: []OrderBy{{Column: "wordCount", Desc: true}, {Column: "title"}},
+				Limit:   10,
+				Offset:  20,
+			},
+		},
+		{
+			name: "conditions",
+			query: `SELECT * FROM Article -- comment
+				WHERE (title LIKE 'it''s*' OR wordCount <> -5) AND published = true
+				AND summary IS NOT NULL`,
+			expected: &Select{
+				Class: "Article",
+				Where: &Condition{Op: OpAnd, Operands: []*Condition{
+					{Op: OpOr, Operands: []*Condition{
+						{Op: OpLike, Column: "title", Value: "it's*"},
+						{Op: "!=", Column: "wordCount", Value: int64(-5)},
+					}},
+					{Op: "=", Column: "published", Value: true},
+					{Op: OpIsNotNull, Column: "summary"},
+				}},
+				Limit: -1,
+			},
+		},
+		{
+			name:  "near vector",
+			query: "SELECT id, _distance FROM Article WHERE NEAR([0.1, -1, 2e-1], 0.3) AND wordCount >= 1.5",
+			expected: &Select{
+				Columns: []string{"id", "_distance"},
+				Class:   "Article",
+				Where:   &Condition{Op: ">=", Column: "wordCount", Value: 1.5},
+				Near:    &Near{Vector: []float32{0.1, -1, 0.2}, Distance: &distance},
+				Limit:   -1,
+			},
+		},
+		{
+			name:  "near object",
+			query: "SELECT * FROM Article WHERE NEAR('5a4bd6d1-3a9b-4a58-8c18-67b8b2dc6c9a')",
+			expected: &Select{
+				Class: "Article",
+				Near:  &Near{ID: "5a4bd6d1-3a9b-4a58-8c18-67b8b2dc6c9a"},
+				Limit: -1,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := Parse(test.query)
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, s)
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	queries := []string{
+		"",
+		"SELECT FROM Article",
+		"SELECT * FROM",
+		"SELECT * FROM Article WHERE",
+		"SELECT * FROM Article WHERE title",
+		"SELECT * FROM Article WHERE title = 'unterminated",
+		"SELECT * FROM Article WHERE NOT title = 'a'",
+		"SELECT * FROM Article WHERE NEAR([])",
+		"SELECT * FROM Article WHERE NEAR([1]) OR title = 'a'",
+		"SELECT * FROM Article WHERE NEAR([1]) AND NEAR([2])",
+		"SELECT * FROM Article LIMIT -1",
+		"SELECT * FROM Article LIMIT 1 trailing",
+		"SELECT * FROM Article; SELECT * FROM Article",
+		"DELETE FROM Article",
+	}
+	for _, query := range queries {
+		t.Run(query, func(t *testing.T) {
+			_, err := Parse(query)
+			require.NotNil(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidQuery), err.Error())
+		})
+	}
+}
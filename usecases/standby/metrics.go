@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package standby
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Metrics of a standby following its primary
+type Metrics struct {
+	lag        *prometheus.GaugeVec
+	applied    *prometheus.CounterVec
+	syncs      *prometheus.CounterVec
+	lastSynced prometheus.Gauge
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		lag:        prom.StandbyReplicationLag,
+		applied:    prom.StandbyAppliedChanges,
+		syncs:      prom.StandbySyncs,
+		lastSynced: prom.StandbyLastSync,
+	}
+}
+
+func (m *Metrics) Applied(node string, changes int) {
+	if m == nil {
+		return
+	}
+
+	m.applied.With(prometheus.Labels{
+		"node": node,
+	}).Add(float64(changes))
+}
+
+func (m *Metrics) Synced(at time.Time, lags map[string]time.Duration, err error) {
+	if m == nil {
+		return
+	}
+
+	m.syncs.With(prometheus.Labels{
+		"status": status(err),
+	}).Inc()
+	if err == nil {
+		m.lastSynced.Set(float64(at.Unix()))
+	}
+	for node, lag := range lags {
+		m.lag.With(prometheus.Labels{
+			"node": node,
+		}).Set(lag.Seconds())
+	}
+}
+
+func status(err error) string {
+	if err != nil {
+		return "failed"
+	}
+	return "success"
+}
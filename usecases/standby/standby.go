@@ -0,0 +1,442 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package standby keeps a standby cluster in sync with a primary cluster in
+// another region. The primary archives the changes of its objects and its
+// schema to a backup backend (see the WAL archive), which the standby
+// follows. Client writes are rejected by the standby until it is promoted,
+// after which it no longer follows the primary.
+//
+// A standby is seeded by restoring a backup of the primary and setting the
+// start of the followed changes to the time the backup was taken. Objects
+// are stored in the shards the standby assigns them to, so the replication
+// factors of the classes must be satisfiable by the standby. Frozen tenants
+// of the primary are followed as COLD tenants.
+package standby
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const stateFile = "standby.json"
+
+// ErrStandby is returned for client writes while the cluster is a standby
+var ErrStandby = errors.New("this cluster is a standby of another cluster, " +
+	"writes are rejected until it is promoted")
+
+type schemaFollower interface {
+	FollowSchema(ctx context.Context, primary *backup.ArchivedSchema, prune bool) error
+}
+
+type archive interface {
+	ArchiveHead(ctx context.Context, backend modulecapabilities.BackupBackend,
+		node string) (*backup.ArchiveHead, error)
+	ArchivedSchema(ctx context.Context, backend modulecapabilities.BackupBackend,
+		node string) (*backup.ArchivedSchema, error)
+	ApplyArchivedChanges(ctx context.Context, backend modulecapabilities.BackupBackend,
+		node string, after time.Time) (time.Time, int, error)
+}
+
+// state is persisted, so a restarted standby continues where it stopped
+type state struct {
+	// Cursors are the times of the last changes applied per primary node
+	Cursors       map[string]time.Time `json:"cursors"`
+	SchemaVersion string               `json:"schemaVersion,omitempty"`
+	Promoted      bool                 `json:"promoted"`
+	PromotedAt    time.Time            `json:"promotedAt,omitempty"`
+}
+
+// Status of the standby, as returned by the API
+type Status struct {
+	Active     bool         `json:"active"`
+	Promoted   bool         `json:"promoted"`
+	PromotedAt *time.Time   `json:"promotedAt,omitempty"`
+	Backend    string       `json:"backend"`
+	LastSync   *time.Time   `json:"lastSync,omitempty"`
+	Nodes      []NodeStatus `json:"nodes"`
+}
+
+// NodeStatus is the replication status of a single primary node
+type NodeStatus struct {
+	Name string `json:"name"`
+	// LastChange is the time of the last change applied
+	LastChange time.Time `json:"lastChange"`
+	// SyncedUntil is the time until which all changes of the node are
+	// applied, it is not set until the first sync succeeded
+	SyncedUntil *time.Time `json:"syncedUntil,omitempty"`
+	LagSeconds  *float64   `json:"lagSeconds,omitempty"`
+	Error       string     `json:"error,omitempty"`
+}
+
+type nodeState struct {
+	syncedUntil time.Time
+	err         error
+}
+
+// Manager follows the primary cluster in the configured interval. A nil
+// Manager is valid, it is never active.
+type Manager struct {
+	config    config.Standby
+	statePath string
+	backend   modulecapabilities.BackupBackend
+	archive   archive
+	schema    schemaFollower
+	metrics   *Metrics
+	logger    logrus.FieldLogger
+	now       func() time.Time
+
+	// syncLock serializes syncs and promotion
+	syncLock sync.Mutex
+
+	sync.RWMutex
+	state    state
+	nodes    map[string]*nodeState
+	lastSync time.Time
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// NewManager loads the state of the standby from the data path. Without a
+// state the changes are followed from the configured start, or from now.
+func NewManager(cfg config.Standby, dataPath string,
+	backend modulecapabilities.BackupBackend, archive archive, schema schemaFollower,
+	metrics *Metrics, logger logrus.FieldLogger,
+) (*Manager, error) {
+	m := &Manager{
+		config:    cfg,
+		statePath: filepath.Join(dataPath, stateFile),
+		backend:   backend,
+		archive:   archive,
+		schema:    schema,
+		metrics:   metrics,
+		logger:    logger.WithField("action", "standby"),
+		now:       time.Now,
+		nodes:     map[string]*nodeState{},
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+
+	since := cfg.Since
+	if since.IsZero() {
+		since = m.now()
+	}
+	for _, node := range cfg.PrimaryNodes {
+		if _, ok := m.state.Cursors[node]; !ok {
+			m.state.Cursors[node] = since
+		}
+		m.nodes[node] = &nodeState{}
+	}
+	return m, m.save()
+}
+
+// Active is true until the standby is promoted
+func (m *Manager) Active() bool {
+	if m == nil {
+		return false
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+	return !m.state.Promoted
+}
+
+// Start following the primary cluster
+func (m *Manager) Start() {
+	if m == nil {
+		return
+	}
+
+	go func() {
+		defer close(m.done)
+		if !m.Active() {
+			m.logger.Info("standby is promoted, the primary is no longer followed")
+			return
+		}
+
+		ticker := time.NewTicker(m.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.syncLock.Lock()
+				if m.Active() {
+					if err := m.sync(context.Background()); err != nil {
+						m.logger.WithError(err).Warn("could not sync with primary")
+					}
+				}
+				m.syncLock.Unlock()
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Shutdown stops following the primary, a running sync is completed first
+func (m *Manager) Shutdown(ctx context.Context) error {
+	if m == nil {
+		return nil
+	}
+
+	m.stopOnce.Do(func() { close(m.stop) })
+	select {
+	case <-m.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Promote makes the standby a primary. The changes archived by the primary
+// so far are applied first. If that fails the standby is not promoted,
+// unless force is set, which is meant for a primary which is no longer
+// reachable. Once promoted, writes are accepted and the primary is no
+// longer followed, also after a restart.
+func (m *Manager) Promote(ctx context.Context, force bool) error {
+	m.syncLock.Lock()
+	defer m.syncLock.Unlock()
+
+	if !m.Active() {
+		return fmt.Errorf("standby is already promoted")
+	}
+
+	if err := m.sync(ctx); err != nil {
+		if !force {
+			return fmt.Errorf("final sync with primary: %w", err)
+		}
+		m.logger.WithError(err).Warn("final sync with primary failed, promoting anyway")
+	}
+
+	m.Lock()
+	m.state.Promoted = true
+	m.state.PromotedAt = m.now()
+	m.Unlock()
+	if err := m.save(); err != nil {
+		m.Lock()
+		m.state.Promoted = false
+		m.Unlock()
+		return err
+	}
+
+	m.stopOnce.Do(func() { close(m.stop) })
+	m.logger.WithField("forced", force).Info("standby promoted, writes are accepted")
+	return nil
+}
+
+// Status of the standby and of every primary node it follows
+func (m *Manager) Status() Status {
+	m.RLock()
+	defer m.RUnlock()
+
+	st := Status{
+		Active:   !m.state.Promoted,
+		Promoted: m.state.Promoted,
+		Backend:  m.config.Backend,
+		Nodes:    make([]NodeStatus, 0, len(m.config.PrimaryNodes)),
+	}
+	if m.state.Promoted {
+		promotedAt := m.state.PromotedAt
+		st.PromotedAt = &promotedAt
+	}
+	if !m.lastSync.IsZero() {
+		lastSync := m.lastSync
+		st.LastSync = &lastSync
+	}
+
+	now := m.now()
+	for _, name := range m.config.PrimaryNodes {
+		ns := NodeStatus{Name: name, LastChange: m.state.Cursors[name]}
+		if node := m.nodes[name]; node != nil {
+			if !node.syncedUntil.IsZero() {
+				syncedUntil := node.syncedUntil
+				lag := now.Sub(syncedUntil).Seconds()
+				ns.SyncedUntil, ns.LagSeconds = &syncedUntil, &lag
+			}
+			if node.err != nil {
+				ns.Error = node.err.Error()
+			}
+		}
+		st.Nodes = append(st.Nodes, ns)
+	}
+	return st
+}
+
+// sync applies the changes the primary nodes archived since the last sync.
+// A schema change is followed in two steps: classes, properties and tenants
+// are added before the changes are applied, since the changes might depend
+// on them, and deletes are only followed once the changes made before them
+// are applied. It must be called with the sync lock held.
+func (m *Manager) sync(ctx context.Context) error {
+	heads := make(map[string]*backup.ArchiveHead, len(m.config.PrimaryNodes))
+	var newest string
+	var errs []error
+	for _, node := range m.config.PrimaryNodes {
+		head, err := m.archive.ArchiveHead(ctx, m.backend, node)
+		if err != nil {
+			if errors.As(err, &backup.ErrNotFound{}) {
+				// the node has not shipped any changes yet
+				continue
+			}
+			m.setNodeError(node, err)
+			errs = append(errs, fmt.Errorf("node %s: %w", node, err))
+			continue
+		}
+		heads[node] = head
+		if newest == "" || head.ShippedAt.After(heads[newest].ShippedAt) {
+			newest = node
+		}
+	}
+
+	var primary *backup.ArchivedSchema
+	if newest != "" && heads[newest].SchemaVersion != m.schemaVersion() {
+		s, err := m.archive.ArchivedSchema(ctx, m.backend, newest)
+		if err != nil {
+			return m.synced(fmt.Errorf("get schema of node %s: %w", newest, err))
+		}
+		if err := m.schema.FollowSchema(ctx, s, false); err != nil {
+			return m.synced(fmt.Errorf("follow schema: %w", err))
+		}
+		primary = s
+	}
+
+	for node, head := range heads {
+		last, applied, err := m.archive.ApplyArchivedChanges(ctx, m.backend, node, m.cursor(node))
+		m.metrics.Applied(node, applied)
+		m.setCursor(node, last)
+		if err != nil {
+			m.setNodeError(node, err)
+			errs = append(errs, fmt.Errorf("node %s: %w", node, err))
+			continue
+		}
+		m.setSynced(node, head.ShippedAt)
+	}
+
+	if primary != nil && len(errs) == 0 {
+		if err := m.schema.FollowSchema(ctx, primary, true); err != nil {
+			errs = append(errs, fmt.Errorf("follow schema: %w", err))
+		} else {
+			m.Lock()
+			m.state.SchemaVersion = heads[newest].SchemaVersion
+			m.Unlock()
+		}
+	}
+	if err := m.save(); err != nil {
+		errs = append(errs, err)
+	}
+	return m.synced(errors.Join(errs...))
+}
+
+// synced records the end of a sync and reports its metrics
+func (m *Manager) synced(err error) error {
+	m.Lock()
+	now := m.now()
+	if err == nil {
+		m.lastSync = now
+	}
+	lags := make(map[string]time.Duration, len(m.nodes))
+	for name, node := range m.nodes {
+		if !node.syncedUntil.IsZero() {
+			lags[name] = now.Sub(node.syncedUntil)
+		}
+	}
+	m.Unlock()
+
+	m.metrics.Synced(now, lags, err)
+	return err
+}
+
+func (m *Manager) schemaVersion() string {
+	m.RLock()
+	defer m.RUnlock()
+	return m.state.SchemaVersion
+}
+
+func (m *Manager) cursor(node string) time.Time {
+	m.RLock()
+	defer m.RUnlock()
+	return m.state.Cursors[node]
+}
+
+func (m *Manager) setCursor(node string, last time.Time) {
+	m.Lock()
+	defer m.Unlock()
+	if last.After(m.state.Cursors[node]) {
+		m.state.Cursors[node] = last
+	}
+}
+
+func (m *Manager) setSynced(node string, until time.Time) {
+	m.Lock()
+	defer m.Unlock()
+	m.nodes[node].syncedUntil = until
+	m.nodes[node].err = nil
+}
+
+func (m *Manager) setNodeError(node string, err error) {
+	m.Lock()
+	defer m.Unlock()
+	m.nodes[node].err = err
+}
+
+func (m *Manager) load() error {
+	m.state = state{Cursors: map[string]time.Time{}}
+	data, err := os.ReadFile(m.statePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("read standby state: %w", err)
+	}
+	if err := json.Unmarshal(data, &m.state); err != nil {
+		return fmt.Errorf("parse standby state: %w", err)
+	}
+	if m.state.Cursors == nil {
+		m.state.Cursors = map[string]time.Time{}
+	}
+	return nil
+}
+
+// save writes the state to a temporary file first, so that a crash never
+// leaves a partial state behind
+func (m *Manager) save() error {
+	m.RLock()
+	data, err := json.Marshal(m.state)
+	m.RUnlock()
+	if err != nil {
+		return fmt.Errorf("marshal standby state: %w", err)
+	}
+
+	tmp := m.statePath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write standby state: %w", err)
+	}
+	if err := os.Rename(tmp, m.statePath); err != nil {
+		return fmt.Errorf("write standby state: %w", err)
+	}
+	return nil
+}
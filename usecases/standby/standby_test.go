@@ -0,0 +1,174 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package standby
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeArchive struct {
+	heads   map[string]*backup.ArchiveHead
+	schema  *backup.ArchivedSchema
+	changes map[string][]time.Time
+	failing map[string]error
+	applied map[string]int
+}
+
+func (f *fakeArchive) ArchiveHead(ctx context.Context, backend modulecapabilities.BackupBackend,
+	node string,
+) (*backup.ArchiveHead, error) {
+	head, ok := f.heads[node]
+	if !ok {
+		return nil, backup.NewErrNotFound(errors.New(node))
+	}
+	return head, nil
+}
+
+func (f *fakeArchive) ArchivedSchema(ctx context.Context, backend modulecapabilities.BackupBackend,
+	node string,
+) (*backup.ArchivedSchema, error) {
+	return f.schema, nil
+}
+
+func (f *fakeArchive) ApplyArchivedChanges(ctx context.Context, backend modulecapabilities.BackupBackend,
+	node string, after time.Time,
+) (time.Time, int, error) {
+	if err := f.failing[node]; err != nil {
+		return after, 0, err
+	}
+	last, applied := after, 0
+	for _, change := range f.changes[node] {
+		if change.After(last) {
+			last = change
+			applied++
+		}
+	}
+	f.applied[node] += applied
+	return last, applied, nil
+}
+
+type fakeSchema struct {
+	calls []bool
+}
+
+func (f *fakeSchema) FollowSchema(ctx context.Context, primary *backup.ArchivedSchema, prune bool) error {
+	f.calls = append(f.calls, prune)
+	return nil
+}
+
+func TestStandby(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		dataPath  = t.TempDir()
+		since     = time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+		now       = since.Add(time.Hour)
+		cfg       = config.Standby{
+			Enabled:      true,
+			Backend:      "s3",
+			PrimaryNodes: []string{"node1", "node2"},
+			Interval:     time.Second,
+			Since:        since,
+		}
+		archive = &fakeArchive{
+			heads: map[string]*backup.ArchiveHead{
+				"node1": {ShippedAt: now.Add(-time.Minute), SchemaVersion: "v1"},
+				"node2": {ShippedAt: now.Add(-2 * time.Minute), SchemaVersion: "v0"},
+			},
+			schema: &backup.ArchivedSchema{},
+			changes: map[string][]time.Time{
+				"node1": {since.Add(time.Minute), since.Add(2 * time.Minute)},
+				"node2": {since.Add(-time.Minute), since.Add(3 * time.Minute)},
+			},
+			failing: map[string]error{},
+			applied: map[string]int{},
+		}
+		sch = &fakeSchema{}
+	)
+	newManager := func() *Manager {
+		m, err := NewManager(cfg, dataPath, nil, archive, sch, nil, logger)
+		require.Nil(t, err)
+		m.now = func() time.Time { return now }
+		return m
+	}
+
+	t.Run("nil manager is not active", func(t *testing.T) {
+		var m *Manager
+		assert.False(t, m.Active())
+		m.Start()
+		assert.Nil(t, m.Shutdown(ctx))
+	})
+
+	t.Run("sync", func(t *testing.T) {
+		m := newManager()
+		require.True(t, m.Active())
+		require.Nil(t, m.sync(ctx))
+
+		assert.Equal(t, map[string]int{"node1": 2, "node2": 1}, archive.applied)
+		// the schema is followed before and after the changes are applied
+		assert.Equal(t, []bool{false, true}, sch.calls)
+
+		st := m.Status()
+		require.Len(t, st.Nodes, 2)
+		assert.Equal(t, since.Add(2*time.Minute), st.Nodes[0].LastChange)
+		assert.Equal(t, 60.0, *st.Nodes[0].LagSeconds)
+		assert.Equal(t, 120.0, *st.Nodes[1].LagSeconds)
+		assert.Equal(t, now, *st.LastSync)
+
+		// nothing changed since
+		require.Nil(t, m.sync(ctx))
+		assert.Equal(t, map[string]int{"node1": 2, "node2": 1}, archive.applied)
+		assert.Len(t, sch.calls, 2)
+	})
+
+	t.Run("state survives restarts", func(t *testing.T) {
+		m := newManager()
+		assert.Equal(t, since.Add(3*time.Minute), m.cursor("node2"))
+		assert.Equal(t, "v1", m.schemaVersion())
+	})
+
+	t.Run("failed node", func(t *testing.T) {
+		archive.heads["node3"] = &backup.ArchiveHead{ShippedAt: now, SchemaVersion: "v2"}
+		archive.failing["node3"] = errors.New("unavailable")
+		cfg.PrimaryNodes = append(cfg.PrimaryNodes, "node3")
+		sch.calls = nil
+
+		m := newManager()
+		assert.ErrorContains(t, m.sync(ctx), "unavailable")
+		// deletes are not followed before all changes are applied
+		assert.Equal(t, []bool{false}, sch.calls)
+		assert.Equal(t, "v1", m.schemaVersion())
+		assert.Equal(t, "unavailable", m.Status().Nodes[2].Error)
+
+		t.Run("promote", func(t *testing.T) {
+			assert.ErrorContains(t, m.Promote(ctx, false), "final sync")
+			assert.True(t, m.Active())
+
+			require.Nil(t, m.Promote(ctx, true))
+			assert.False(t, m.Active())
+			assert.True(t, m.Status().Promoted)
+			assert.ErrorContains(t, m.Promote(ctx, true), "already promoted")
+
+			assert.False(t, newManager().Active())
+		})
+	})
+}
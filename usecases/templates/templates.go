@@ -0,0 +1,239 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package templates stores named GraphQL queries, which clients execute by
+// name with variables. The retrieval logic of the queries, e.g. the hybrid
+// alpha or the filters, can so be changed centrally without changing every
+// client.
+package templates
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/tailor-inc/graphql/language/parser"
+)
+
+var ErrNotFound = errors.New("query template not found")
+
+const templatesFileName = "templates.json"
+
+var validName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]{0,127}$`)
+
+// Template is a GraphQL query with variables. Defaults are used for the
+// variables which are not set when the template is executed.
+type Template struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Query       string                 `json:"query"`
+	Defaults    map[string]interface{} `json:"defaults,omitempty"`
+	// Variables are the variables declared by the query
+	Variables []string  `json:"variables"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// validate the template and set the variables declared by its query
+func (t *Template) validate() error {
+	if !validName.MatchString(t.Name) {
+		return fmt.Errorf("invalid name %q, must start with a letter and contain "+
+			"letters, digits, '_' and '-' only", t.Name)
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{Source: t.Query})
+	if err != nil {
+		return fmt.Errorf("parse query: %w", err)
+	}
+	if len(doc.Definitions) != 1 {
+		return fmt.Errorf("query must contain a single operation")
+	}
+	op, ok := doc.Definitions[0].(*ast.OperationDefinition)
+	if !ok || op.Operation != ast.OperationTypeQuery {
+		return fmt.Errorf("query must contain a single operation")
+	}
+
+	declared := map[string]struct{}{}
+	t.Variables = make([]string, 0, len(op.VariableDefinitions))
+	for _, def := range op.VariableDefinitions {
+		name := def.Variable.Name.Value
+		declared[name] = struct{}{}
+		t.Variables = append(t.Variables, name)
+	}
+	for name := range t.Defaults {
+		if _, ok := declared[name]; !ok {
+			return fmt.Errorf("default of undeclared variable %q", name)
+		}
+	}
+	return nil
+}
+
+// Bind returns the variables to execute the template with, which are the
+// given variables and the defaults of the others
+func (t Template) Bind(variables map[string]interface{}) (map[string]interface{}, error) {
+	bound := make(map[string]interface{}, len(t.Variables))
+	for name, value := range t.Defaults {
+		bound[name] = value
+	}
+	for name, value := range variables {
+		if !t.declares(name) {
+			return nil, fmt.Errorf("template %q has no variable %q", t.Name, name)
+		}
+		bound[name] = value
+	}
+	return bound, nil
+}
+
+func (t Template) declares(name string) bool {
+	for _, declared := range t.Variables {
+		if declared == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Store manages the templates of a node. They are persisted as a single JSON
+// file, like the API keys managed at runtime.
+type Store struct {
+	sync.RWMutex
+	path      string
+	templates map[string]Template
+	now       func() time.Time
+}
+
+// NewStore loads the templates persisted in the given directory. The
+// directory is created if it does not exist yet.
+func NewStore(rootPath string) (*Store, error) {
+	if err := os.MkdirAll(rootPath, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("create query templates dir: %w", err)
+	}
+
+	s := &Store{
+		path:      filepath.Join(rootPath, templatesFileName),
+		templates: map[string]Template{},
+		now:       time.Now,
+	}
+
+	contents, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("read query templates: %w", err)
+	}
+
+	var templates []Template
+	if err := json.Unmarshal(contents, &templates); err != nil {
+		return nil, fmt.Errorf("parse query templates from %s: %w", s.path, err)
+	}
+	for _, t := range templates {
+		s.templates[t.Name] = t
+	}
+	return s, nil
+}
+
+// Put creates the template or replaces the existing one with the same name
+func (s *Store) Put(t Template) (Template, error) {
+	if err := t.validate(); err != nil {
+		return Template{}, err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	now := s.now().UTC()
+	t.CreatedAt, t.UpdatedAt = now, now
+	previous, exists := s.templates[t.Name]
+	if exists {
+		t.CreatedAt = previous.CreatedAt
+	}
+
+	s.templates[t.Name] = t
+	if err := s.persist(); err != nil {
+		if exists {
+			s.templates[t.Name] = previous
+		} else {
+			delete(s.templates, t.Name)
+		}
+		return Template{}, err
+	}
+	return t, nil
+}
+
+func (s *Store) Get(name string) (Template, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	t, ok := s.templates[name]
+	if !ok {
+		return Template{}, fmt.Errorf("%w: %q", ErrNotFound, name)
+	}
+	return t, nil
+}
+
+// List the templates sorted by name
+func (s *Store) List() []Template {
+	s.RLock()
+	defer s.RUnlock()
+	return s.sortedTemplates()
+}
+
+func (s *Store) Delete(name string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	t, ok := s.templates[name]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrNotFound, name)
+	}
+
+	delete(s.templates, name)
+	if err := s.persist(); err != nil {
+		s.templates[name] = t
+		return err
+	}
+	return nil
+}
+
+func (s *Store) persist() error {
+	contents, err := json.MarshalIndent(s.sortedTemplates(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal query templates: %w", err)
+	}
+
+	tmpPath := s.path + ".tmp"
+	if err := os.WriteFile(tmpPath, contents, 0o600); err != nil {
+		return fmt.Errorf("write query templates: %w", err)
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("write query templates: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) sortedTemplates() []Template {
+	templates := make([]Template, 0, len(s.templates))
+	for _, t := range s.templates {
+		templates = append(templates, t)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return templates
+}
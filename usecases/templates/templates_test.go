@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package templates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const hybridQuery = `query Search($query: String!, $alpha: Float, $limit: Int) {
+	Get {
+		Article(hybrid: {query: $query, alpha: $alpha}, limit: $limit) {
+			title
+		}
+	}
+}`
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore(dir)
+	require.Nil(t, err)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	created, err := store.Put(Template{
+		Name:     "article-search",
+		Query:    hybridQuery,
+		Defaults: map[string]interface{}{"alpha": 0.5, "limit": 10},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"query", "alpha", "limit"}, created.Variables)
+	assert.Equal(t, now, created.CreatedAt)
+
+	t.Run("bind variables", func(t *testing.T) {
+		vars, err := created.Bind(map[string]interface{}{"query": "cats", "limit": 3})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"query": "cats", "alpha": 0.5, "limit": 3}, vars)
+
+		_, err = created.Bind(map[string]interface{}{"where": "x"})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("replace keeps the creation time", func(t *testing.T) {
+		now = now.Add(time.Hour)
+		updated, err := store.Put(Template{
+			Name:     "article-search",
+			Query:    hybridQuery,
+			Defaults: map[string]interface{}{"alpha": 0.75},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, created.CreatedAt, updated.CreatedAt)
+		assert.Equal(t, now, updated.UpdatedAt)
+	})
+
+	t.Run("templates survive a restart", func(t *testing.T) {
+		restarted, err := NewStore(dir)
+		require.Nil(t, err)
+		templates := restarted.List()
+		require.Len(t, templates, 1)
+		assert.Equal(t, 0.75, templates[0].Defaults["alpha"])
+	})
+
+	t.Run("invalid templates", func(t *testing.T) {
+		for name, tmpl := range map[string]Template{
+			"name":               {Name: "1st", Query: hybridQuery},
+			"syntax":             {Name: "broken", Query: "{ Get { "},
+			"mutation":           {Name: "mutation", Query: "mutation { delete }"},
+			"several operations": {Name: "several", Query: "query A { a } query B { b }"},
+			"undeclared default": {
+				Name: "undeclared", Query: hybridQuery,
+				Defaults: map[string]interface{}{"where": "x"},
+			},
+		} {
+			_, err := store.Put(tmpl)
+			assert.NotNil(t, err, name)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.Nil(t, store.Delete("article-search"))
+		_, err := store.Get("article-search")
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorIs(t, store.Delete("article-search"), ErrNotFound)
+	})
+}
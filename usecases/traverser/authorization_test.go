@@ -43,21 +43,21 @@ func Test_Traverser_Authorization(t *testing.T) {
 			methodName:       "GetClass",
 			additionalArgs:   []interface{}{dto.GetParams{}},
 			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
 		},
 
 		{
 			methodName:       "Aggregate",
 			additionalArgs:   []interface{}{&aggregation.Params{}},
 			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
 		},
 
 		{
 			methodName:       "Explore",
 			additionalArgs:   []interface{}{ExploreParams{}},
 			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
 		},
 	}
 
@@ -68,6 +68,12 @@ func Test_Traverser_Authorization(t *testing.T) {
 		}
 
 		for _, method := range allExportedMethods(&Traverser{}) {
+			if method == "SetQuotas" || method == "SetTenantOffload" || method == "SetQueryCache" ||
+				method == "SetSlowQueryLog" || method == "SetMaxConcurrentGetRequests" ||
+				method == "SetQueryAdmission" {
+				// configured at startup or reload, not called on behalf of a principal
+				continue
+			}
 			assert.Contains(t, testedMethods, method)
 		}
 	})
@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// dedupCandidatesFactor is the multiple of offset plus limit which is
+// searched, so enough results remain after collapsing the duplicates
+const dedupCandidatesFactor = 4
+
+// getClassDeduplicated runs the query for more candidates and collapses the
+// results sharing the values of the dedupBy properties. The results are
+// ranked, so the first, best-scored one of each value is kept.
+func (e *Explorer) getClassDeduplicated(ctx context.Context,
+	params dto.GetParams,
+) ([]interface{}, error) {
+	if err := e.validateDedupBy(params); err != nil {
+		return nil, err
+	}
+
+	pagination := *params.Pagination
+	candidates := params
+	candidates.DedupBy = nil
+	candidates.Pagination = &filters.Pagination{
+		Limit:   pagination.Limit,
+		Autocut: pagination.Autocut,
+	}
+	// the properties must be present to compare them
+	candidates.AdditionalProperties.NoProps = false
+
+	limit := pagination.Limit
+	if limit != filters.LimitFlagSearchByDist {
+		if limit == filters.LimitFlagNotSet {
+			limit = int(e.config.QueryDefaults.Limit)
+		}
+		n := dedupCandidatesFactor * (pagination.Offset + limit)
+		if e.config.QueryMaximumResults > 0 {
+			n = MinInt(n, int(e.config.QueryMaximumResults))
+		}
+		candidates.Pagination.Limit = n
+	}
+
+	res, err := e.GetClass(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	res = dedupResults(res, params.DedupBy)
+	if pagination.Offset >= len(res) {
+		return []interface{}{}, nil
+	}
+	res = res[pagination.Offset:]
+	if limit != filters.LimitFlagSearchByDist && limit < len(res) {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+func (e *Explorer) validateDedupBy(params dto.GetParams) error {
+	if params.Group != nil || params.GroupBy != nil {
+		return errors.New("dedupBy: cannot be combined with group or groupBy")
+	}
+	if params.Cursor != nil {
+		return errors.New("dedupBy: cannot be combined with the cursor api")
+	}
+
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(params.ClassName))
+	if class == nil {
+		return errors.Errorf("dedupBy: class %q not found", params.ClassName)
+	}
+	for _, name := range params.DedupBy {
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			return errors.Errorf("dedupBy: no such prop with name '%s' found in class '%s'",
+				name, params.ClassName)
+		}
+		if schema.IsRefDataType(prop.DataType) {
+			return errors.Errorf("dedupBy: reference property '%s' is not supported", name)
+		}
+	}
+	return nil
+}
+
+// dedupResults keeps the first result of each combination of the property
+// values, results which have none of the properties set are all kept
+func dedupResults(results []interface{}, props []string) []interface{} {
+	seen := map[string]struct{}{}
+	out := make([]interface{}, 0, len(results))
+	for _, res := range results {
+		obj, ok := res.(map[string]interface{})
+		if !ok {
+			out = append(out, res)
+			continue
+		}
+
+		values := make([]interface{}, len(props))
+		isSet := false
+		for i, prop := range props {
+			values[i] = obj[prop]
+			isSet = isSet || values[i] != nil
+		}
+		if !isSet {
+			out = append(out, res)
+			continue
+		}
+
+		key, err := json.Marshal(values)
+		if err != nil {
+			out = append(out, res)
+			continue
+		}
+		if _, ok := seen[string(key)]; ok {
+			continue
+		}
+		seen[string(key)] = struct{}{}
+		out = append(out, res)
+	}
+	return out
+}
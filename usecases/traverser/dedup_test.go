@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestDedupResults(t *testing.T) {
+	results := []interface{}{
+		map[string]interface{}{"url": "a", "lang": "en", "chunk": 1},
+		map[string]interface{}{"url": "a", "lang": "en", "chunk": 2},
+		map[string]interface{}{"url": "a", "lang": "de", "chunk": 3},
+		map[string]interface{}{"url": "b", "lang": "en", "chunk": 4},
+		map[string]interface{}{"chunk": 5},
+		map[string]interface{}{"chunk": 6},
+	}
+	chunks := func(res []interface{}) []int {
+		out := make([]int, len(res))
+		for i := range res {
+			out[i] = res[i].(map[string]interface{})["chunk"].(int)
+		}
+		return out
+	}
+
+	assert.Equal(t, []int{1, 4, 5, 6}, chunks(dedupResults(results, []string{"url"})))
+	assert.Equal(t, []int{1, 3, 4, 5, 6}, chunks(dedupResults(results, []string{"url", "lang"})))
+}
+
+func TestExplorer_GetClass_DedupBy(t *testing.T) {
+	class := &models.Class{
+		Class: "BestClass",
+		Properties: []*models.Property{
+			{Name: "url", DataType: schema.DataTypeText.PropString()},
+			{Name: "ofDocument", DataType: []string{"Document"}},
+		},
+	}
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", "BestClass", "get_graphql", "nearVector", 0)
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), metrics, defaultConfig)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		})
+		return explorer, searcher
+	}
+	params := dto.GetParams{
+		ClassName:  "BestClass",
+		NearVector: &searchparams.NearVector{Vector: []float32{1, 0}},
+		Pagination: &filters.Pagination{Offset: 1, Limit: 2},
+		DedupBy:    []string{"url"},
+	}
+
+	t.Run("collapses duplicates before the offset and limit", func(t *testing.T) {
+		explorer, searcher := newExplorer()
+		expected := params
+		expected.DedupBy = nil
+		expected.SearchVector = []float32{1, 0}
+		expected.Pagination = &filters.Pagination{Limit: 12}
+		searcher.On("VectorSearch", expected).Return([]search.Result{
+			{ID: "1", Schema: map[string]interface{}{"url": "a", "n": 1}},
+			{ID: "2", Schema: map[string]interface{}{"url": "a", "n": 2}},
+			{ID: "3", Schema: map[string]interface{}{"url": "b", "n": 3}},
+			{ID: "4", Schema: map[string]interface{}{"url": "b", "n": 4}},
+			{ID: "5", Schema: map[string]interface{}{"url": "c", "n": 5}},
+			{ID: "6", Schema: map[string]interface{}{"url": "d", "n": 6}},
+		}, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"url": "b", "n": 3},
+			map[string]interface{}{"url": "c", "n": 5},
+		}, res)
+	})
+
+	t.Run("unknown property", func(t *testing.T) {
+		explorer, _ := newExplorer()
+		p := params
+		p.DedupBy = []string{"missing"}
+		_, err := explorer.GetClass(context.Background(), p)
+		assert.EqualError(t, err, "dedupBy: no such prop with name 'missing' found in class 'BestClass'")
+	})
+
+	t.Run("reference property", func(t *testing.T) {
+		explorer, _ := newExplorer()
+		p := params
+		p.DedupBy = []string{"ofDocument"}
+		_, err := explorer.GetClass(context.Background(), p)
+		assert.EqualError(t, err, "dedupBy: reference property 'ofDocument' is not supported")
+	})
+
+	t.Run("group by", func(t *testing.T) {
+		explorer, _ := newExplorer()
+		p := params
+		p.GroupBy = &searchparams.GroupBy{Property: "url"}
+		_, err := explorer.GetClass(context.Background(), p)
+		assert.EqualError(t, err, "dedupBy: cannot be combined with group or groupBy")
+	})
+}
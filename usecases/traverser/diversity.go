@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// diversityCandidatesFactor is the multiple of offset plus limit which is
+// searched for candidates, if the query doesn't set their number
+const diversityCandidatesFactor = 4
+
+func validateDiversity(params dto.GetParams) error {
+	d := params.Diversity
+	if d.Lambda < 0 || d.Lambda > 1 {
+		return errors.Errorf("diversity: lambda must be between 0 and 1, got %v", d.Lambda)
+	}
+	if d.Candidates < 0 {
+		return errors.Errorf("diversity: candidates must not be negative, got %d", d.Candidates)
+	}
+	if params.KeywordRanking != nil || params.HybridSearch != nil {
+		return errors.New("diversity: only vector searches can be diversified, " +
+			"not bm25 or hybrid searches")
+	}
+	if params.NearVector == nil && params.NearObject == nil && len(params.ModuleParams) == 0 {
+		return errors.New("diversity: requires a vector search")
+	}
+	if params.Group != nil || params.GroupBy != nil {
+		return errors.New("diversity: cannot be combined with group or groupBy")
+	}
+	return nil
+}
+
+// diversityCandidates are the params searching the candidates of the
+// diversified results, their vectors are needed to compare them
+func (e *Explorer) diversityCandidates(params dto.GetParams) dto.GetParams {
+	candidates := params
+	candidates.AdditionalProperties.Vector = true
+
+	pagination := *params.Pagination
+	candidates.Pagination = &pagination
+	if pagination.Limit == filters.LimitFlagSearchByDist {
+		// all results within the distance are re-ranked
+		return candidates
+	}
+
+	limit := pagination.Limit
+	if limit == filters.LimitFlagNotSet {
+		limit = int(e.config.QueryDefaults.Limit)
+	}
+	n := params.Diversity.Candidates
+	if n == 0 {
+		n = diversityCandidatesFactor * (pagination.Offset + limit)
+	}
+	n = MaxInt(n, pagination.Offset+limit)
+	if e.config.QueryMaximumResults > 0 {
+		n = MinInt(n, int(e.config.QueryMaximumResults))
+	}
+	pagination.Offset = 0
+	pagination.Limit = n
+	return candidates
+}
+
+// diversify selects the offset plus limit most relevant, least redundant
+// candidates and applies the offset
+func (e *Explorer) diversify(searchVector []float32, candidates search.Results,
+	params dto.GetParams,
+) search.Results {
+	n := len(candidates)
+	if params.Pagination.Limit != filters.LimitFlagSearchByDist {
+		limit := params.Pagination.Limit
+		if limit == filters.LimitFlagNotSet {
+			limit = int(e.config.QueryDefaults.Limit)
+		}
+		n = MinInt(n, params.Pagination.Offset+limit)
+	}
+
+	selected := selectMMR(searchVector, candidates, params.Diversity.Lambda, n)
+	if params.Pagination.Offset >= len(selected) {
+		return nil
+	}
+	return selected[params.Pagination.Offset:]
+}
+
+// selectMMR picks n results by Maximal Marginal Relevance: each step takes
+// the result maximizing
+//
+//	lambda * sim(query, result) - (1 - lambda) * max(sim(result, selected))
+//
+// with the cosine similarity of the vectors, so near duplicates of the
+// results selected before are pushed down
+func selectMMR(query []float32, results search.Results, lambda float64, n int) search.Results {
+	if n > len(results) {
+		n = len(results)
+	}
+
+	relevance := make([]float64, len(results))
+	for i := range results {
+		relevance[i] = cosineSimilarity(query, results[i].Vector)
+	}
+	// redundancy is the maximum similarity to the selected results so far
+	redundancy := make([]float64, len(results))
+	taken := make([]bool, len(results))
+
+	selected := make(search.Results, 0, n)
+	for len(selected) < n {
+		best, bestScore := -1, math.Inf(-1)
+		for i := range results {
+			if taken[i] {
+				continue
+			}
+			score := lambda*relevance[i] - (1-lambda)*redundancy[i]
+			if score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+
+		taken[best] = true
+		selected = append(selected, results[best])
+		for i := range results {
+			if taken[i] {
+				continue
+			}
+			sim := cosineSimilarity(results[best].Vector, results[i].Vector)
+			if len(selected) == 1 || sim > redundancy[i] {
+				redundancy[i] = sim
+			}
+		}
+	}
+	return selected
+}
+
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / math.Sqrt(normA*normB)
+}
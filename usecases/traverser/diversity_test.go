@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestSelectMMR(t *testing.T) {
+	query := []float32{1, 0.05}
+	results := search.Results{
+		{ID: "a", Vector: []float32{1, 0.1}},
+		{ID: "a-duplicate", Vector: []float32{1, 0.13}},
+		{ID: "b", Vector: []float32{0.5, 0.8}},
+		{ID: "c", Vector: []float32{0.7, -0.5}},
+	}
+	ids := func(res search.Results) []string {
+		out := make([]string, len(res))
+		for i := range res {
+			out[i] = string(res[i].ID)
+		}
+		return out
+	}
+
+	t.Run("relevance only keeps the order", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "a-duplicate", "c"}, ids(selectMMR(query, results, 1, 3)))
+	})
+
+	t.Run("balanced pushes the duplicate down", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "c", "a-duplicate", "b"}, ids(selectMMR(query, results, 0.5, 4)))
+	})
+
+	t.Run("n larger than the results", func(t *testing.T) {
+		assert.Len(t, selectMMR(query, results, 0.7, 10), 4)
+	})
+}
+
+func TestValidateDiversity(t *testing.T) {
+	nearVector := &searchparams.NearVector{Vector: []float32{1}}
+	tests := []struct {
+		name    string
+		params  dto.GetParams
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			params: dto.GetParams{NearVector: nearVector, Diversity: &searchparams.Diversity{Lambda: 0.7}},
+		},
+		{
+			name:    "lambda out of range",
+			params:  dto.GetParams{NearVector: nearVector, Diversity: &searchparams.Diversity{Lambda: 1.5}},
+			wantErr: "diversity: lambda must be between 0 and 1, got 1.5",
+		},
+		{
+			name:    "negative candidates",
+			params:  dto.GetParams{NearVector: nearVector, Diversity: &searchparams.Diversity{Candidates: -1}},
+			wantErr: "diversity: candidates must not be negative, got -1",
+		},
+		{
+			name: "hybrid search",
+			params: dto.GetParams{
+				HybridSearch: &searchparams.HybridSearch{Query: "foo"},
+				Diversity:    &searchparams.Diversity{Lambda: 0.5},
+			},
+			wantErr: "diversity: only vector searches can be diversified, not bm25 or hybrid searches",
+		},
+		{
+			name:    "list",
+			params:  dto.GetParams{Diversity: &searchparams.Diversity{Lambda: 0.5}},
+			wantErr: "diversity: requires a vector search",
+		},
+		{
+			name: "group by",
+			params: dto.GetParams{
+				NearVector: nearVector,
+				GroupBy:    &searchparams.GroupBy{Property: "title"},
+				Diversity:  &searchparams.Diversity{Lambda: 0.5},
+			},
+			wantErr: "diversity: cannot be combined with group or groupBy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDiversity(tt.params)
+			if tt.wantErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestExplorer_GetClass_Diversity(t *testing.T) {
+	params := dto.GetParams{
+		ClassName:  "BestClass",
+		NearVector: &searchparams.NearVector{Vector: []float32{1, 0.05}},
+		Pagination: &filters.Pagination{Offset: 1, Limit: 2},
+		Diversity:  &searchparams.Diversity{Lambda: 0.5},
+	}
+	searchResults := []search.Result{
+		{ID: "a", Schema: map[string]interface{}{"name": "a"}, Vector: []float32{1, 0.1}},
+		{ID: "a2", Schema: map[string]interface{}{"name": "a2"}, Vector: []float32{1, 0.13}},
+		{ID: "b", Schema: map[string]interface{}{"name": "b"}, Vector: []float32{0.5, 0.8}},
+		{ID: "c", Schema: map[string]interface{}{"name": "c"}, Vector: []float32{0.7, -0.5}},
+	}
+
+	searcher := &fakeVectorSearcher{}
+	metrics := &fakeMetrics{}
+	log, _ := test.NewNullLogger()
+	explorer := NewExplorer(searcher, log, getFakeModulesProvider(), metrics, config.Config{
+		QueryDefaults:       config.QueryDefaults{Limit: 100},
+		QueryMaximumResults: 10,
+	})
+	explorer.SetSchemaGetter(&fakeSchemaGetter{
+		schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+			{Class: "BestClass"},
+		}}},
+	})
+
+	// 4 times offset plus limit candidates are searched, capped at the
+	// maximum results, with their vectors
+	expected := params
+	expected.SearchVector = []float32{1, 0.05}
+	expected.Pagination = &filters.Pagination{Limit: 10}
+	expected.AdditionalProperties.Vector = true
+	searcher.On("VectorSearch", expected).Return(searchResults, nil)
+	metrics.On("AddUsageDimensions", "BestClass", "get_graphql", "nearVector", 0)
+
+	res, err := explorer.GetClass(context.Background(), params)
+	require.Nil(t, err)
+	searcher.AssertExpectations(t)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "c"},
+		map[string]interface{}{"name": "a2"},
+	}, res)
+}
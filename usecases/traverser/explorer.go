@@ -126,6 +126,28 @@ func (e *Explorer) GetClass(ctx context.Context,
 		}
 	}
 
+	if len(params.Facets) > 0 {
+		return e.getClassWithFacets(ctx, params)
+	}
+
+	if len(params.DedupBy) > 0 {
+		return e.getClassDeduplicated(ctx, params)
+	}
+
+	if params.Recommend != nil {
+		nearVector, err := e.nearVectorFromRecommend(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		params.NearVector = nearVector
+	}
+
+	if params.Diversity != nil {
+		if err := validateDiversity(params); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := e.validateFilters(params.Filters); err != nil {
 		return nil, errors.Wrap(err, "invalid 'where' filter")
 	}
@@ -212,7 +234,12 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 		params.AdditionalProperties.Vector = true
 	}
 
-	res, err := e.searcher.VectorSearch(ctx, params)
+	searchParams := params
+	if params.Diversity != nil {
+		searchParams = e.diversityCandidates(params)
+	}
+
+	res, err := e.searcher.VectorSearch(ctx, searchParams)
 	if err != nil {
 		return nil, errors.Errorf("explorer: get class: vector search: %v", err)
 	}
@@ -226,6 +253,10 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 		res = res[:cutOff]
 	}
 
+	if params.Diversity != nil {
+		res = e.diversify(searchVector, res, params)
+	}
+
 	if params.Group != nil {
 		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
 		if err != nil {
@@ -285,9 +316,10 @@ func (e *Explorer) CalculateTotalLimit(pagination *filters.Pagination) (int, err
 func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.Result, error) {
 	sparseSearch := func() ([]*storobj.Object, []float32, error) {
 		params.KeywordRanking = &searchparams.KeywordRanking{
-			Query:      params.HybridSearch.Query,
-			Type:       "bm25",
-			Properties: params.HybridSearch.Properties,
+			Query:                  params.HybridSearch.Query,
+			Type:                   "bm25",
+			Properties:             params.HybridSearch.Properties,
+			AdditionalExplanations: params.AdditionalProperties.ScoreExplanation,
 		}
 
 		if params.Pagination == nil {
@@ -453,6 +485,10 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 	if err != nil {
 		return nil, fmt.Errorf("search results to get response: %w", err)
 	}
+	highlighter, err := e.highlighter(params)
+	if err != nil {
+		return nil, fmt.Errorf("search results to get response: highlights: %w", err)
+	}
 	for _, res := range input {
 		additionalProperties := make(map[string]interface{})
 
@@ -507,6 +543,16 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 			additionalProperties["explainScore"] = res.ExplainScore
 		}
 
+		if params.AdditionalProperties.ScoreExplanation {
+			additionalProperties["scoreExplanation"] = scoreExplanation(res, searchVector != nil)
+		}
+
+		if highlighter != nil {
+			if props, ok := res.Schema.(map[string]interface{}); ok {
+				additionalProperties["highlights"] = highlighter.highlight(props)
+			}
+		}
+
 		if params.AdditionalProperties.Vector {
 			additionalProperties["vector"] = res.Vector
 		}
@@ -705,7 +751,7 @@ func (e *Explorer) vectorFromExploreParams(ctx context.Context,
 	}
 
 	if params.NearVector != nil {
-		return params.NearVector.Vector, nil
+		return vectorFromNearVector(params.NearVector)
 	}
 
 	if params.NearObject != nil {
@@ -773,6 +819,12 @@ func (e *Explorer) replicationEnabled(params dto.GetParams) (bool, error) {
 }
 
 func ExtractDistanceFromParams(params dto.GetParams) (distance float64, withDistance bool) {
+	if params.Recommend != nil {
+		distance = params.Recommend.Distance
+		withDistance = params.Recommend.WithDistance
+		return
+	}
+
 	if params.NearVector != nil {
 		distance = params.NearVector.Distance
 		withDistance = params.NearVector.WithDistance
@@ -793,6 +845,11 @@ func ExtractDistanceFromParams(params dto.GetParams) (distance float64, withDist
 }
 
 func ExtractCertaintyFromParams(params dto.GetParams) (certainty float64) {
+	if params.Recommend != nil {
+		certainty = params.Recommend.Certainty
+		return
+	}
+
 	if params.NearVector != nil {
 		certainty = params.NearVector.Certainty
 		return
@@ -899,6 +956,10 @@ func (e *Explorer) trackUsageGetExplicitVector(res search.Results, params dto.Ge
 }
 
 func (e *Explorer) usageOperationFromGetParams(params dto.GetParams) string {
+	if params.Recommend != nil {
+		return "recommend"
+	}
+
 	if params.NearObject != nil {
 		return "nearObject"
 	}
@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// getClassWithFacets runs the query for all its results, up to the maximum
+// results, counts the values of the facet properties over them and returns
+// the requested page. The counts are reported to the facets collector of
+// the request.
+func (e *Explorer) getClassWithFacets(ctx context.Context,
+	params dto.GetParams,
+) ([]interface{}, error) {
+	if err := e.validateFacets(params); err != nil {
+		return nil, err
+	}
+
+	pagination := *params.Pagination
+	matched := params
+	matched.Facets = nil
+	matched.Pagination = &filters.Pagination{
+		Limit:   pagination.Limit,
+		Autocut: pagination.Autocut,
+	}
+	// the properties must be present to count them
+	matched.AdditionalProperties.NoProps = false
+
+	limit := pagination.Limit
+	if limit == filters.LimitFlagNotSet {
+		limit = int(e.config.QueryDefaults.Limit)
+	}
+	if limit != filters.LimitFlagSearchByDist {
+		matched.Pagination.Limit = MaxInt(int(e.config.QueryMaximumResults),
+			pagination.Offset+limit)
+	}
+
+	res, err := e.GetClass(ctx, matched)
+	if err != nil {
+		return nil, err
+	}
+
+	search.RecordFacets(ctx, search.QueryFacets{
+		Class:   params.ClassName,
+		Tenant:  params.Tenant,
+		Objects: len(res),
+		Facets:  countFacets(res, params.Facets),
+	})
+
+	if pagination.Offset >= len(res) {
+		return []interface{}{}, nil
+	}
+	res = res[pagination.Offset:]
+	if limit != filters.LimitFlagSearchByDist && limit < len(res) {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+// validateFacetsReadable rejects facets on sensitive properties the
+// principal may not read, their counts would reveal the redacted values
+func (t *Traverser) validateFacetsReadable(principal *models.Principal,
+	params dto.GetParams,
+) error {
+	for _, facet := range params.Facets {
+		if t.masker.Masked(principal, t.schemaGetter, params.ClassName, facet.Property) {
+			return errors.Errorf("facets: property '%s' is sensitive and can not be counted",
+				facet.Property)
+		}
+	}
+	return nil
+}
+
+func (e *Explorer) validateFacets(params dto.GetParams) error {
+	if params.Group != nil || params.GroupBy != nil {
+		return errors.New("facets: cannot be combined with group or groupBy")
+	}
+	if params.Cursor != nil {
+		return errors.New("facets: cannot be combined with the cursor api")
+	}
+
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(params.ClassName))
+	if class == nil {
+		return errors.Errorf("facets: class %q not found", params.ClassName)
+	}
+	for _, facet := range params.Facets {
+		if facet.Limit < 0 {
+			return errors.Errorf("facets: limit of '%s' must not be negative, got %d",
+				facet.Property, facet.Limit)
+		}
+		prop, err := schema.GetPropertyByName(class, facet.Property)
+		if err != nil {
+			return errors.Errorf("facets: no such prop with name '%s' found in class '%s'",
+				facet.Property, params.ClassName)
+		}
+		switch dt := schema.DataType(prop.DataType[0]); dt {
+		case schema.DataTypeText, schema.DataTypeTextArray, schema.DataTypeString,
+			schema.DataTypeStringArray, schema.DataTypeInt, schema.DataTypeIntArray,
+			schema.DataTypeNumber, schema.DataTypeNumberArray, schema.DataTypeBoolean,
+			schema.DataTypeBooleanArray, schema.DataTypeDate, schema.DataTypeDateArray,
+			schema.DataTypeUUID, schema.DataTypeUUIDArray:
+		default:
+			return errors.Errorf("facets: property '%s' of data type %v can not be counted",
+				facet.Property, prop.DataType)
+		}
+	}
+	return nil
+}
+
+// countFacets counts the objects per value of each facet property, every
+// element of array properties is counted. The values are ordered by their
+// count, the most frequent first.
+func countFacets(results []interface{}, facets []searchparams.Facet) []search.FacetCounts {
+	out := make([]search.FacetCounts, len(facets))
+	for i, facet := range facets {
+		counts := map[string]*search.FacetValue{}
+		for _, res := range results {
+			obj, ok := res.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			seen := map[string]struct{}{}
+			for _, value := range facetValues(obj[facet.Property]) {
+				key := fmt.Sprint(value)
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				if c, ok := counts[key]; ok {
+					c.Count++
+				} else {
+					counts[key] = &search.FacetValue{Value: value, Count: 1}
+				}
+			}
+		}
+
+		values := make([]search.FacetValue, 0, len(counts))
+		for _, c := range counts {
+			values = append(values, *c)
+		}
+		sort.Slice(values, func(a, b int) bool {
+			if values[a].Count != values[b].Count {
+				return values[a].Count > values[b].Count
+			}
+			return fmt.Sprint(values[a].Value) < fmt.Sprint(values[b].Value)
+		})
+
+		limit := facet.Limit
+		if limit == 0 {
+			limit = searchparams.DefaultFacetLimit
+		}
+		if len(values) > limit {
+			values = values[:limit]
+		}
+		out[i] = search.FacetCounts{Property: facet.Property, Values: values}
+	}
+	return out
+}
+
+// facetValues are the elements of an array value or the value itself
+func facetValues(value interface{}) []interface{} {
+	if value == nil {
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice {
+		return []interface{}{value}
+	}
+	values := make([]interface{}, v.Len())
+	for i := range values {
+		values[i] = v.Index(i).Interface()
+	}
+	return values
+}
@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/masking"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestCountFacets(t *testing.T) {
+	results := []interface{}{
+		map[string]interface{}{"category": "news", "tags": []string{"go", "db", "go"}, "stars": float64(5)},
+		map[string]interface{}{"category": "blog", "tags": []string{"db"}, "stars": float64(4)},
+		map[string]interface{}{"category": "news", "stars": float64(5)},
+		map[string]interface{}{"category": "docs", "tags": []string{}},
+		map[string]interface{}{},
+	}
+
+	facets := countFacets(results, []searchparams.Facet{
+		{Property: "category", Limit: 2},
+		{Property: "tags"},
+		{Property: "stars"},
+	})
+
+	assert.Equal(t, []search.FacetCounts{
+		{Property: "category", Values: []search.FacetValue{
+			{Value: "news", Count: 2},
+			{Value: "blog", Count: 1},
+		}},
+		{Property: "tags", Values: []search.FacetValue{
+			{Value: "db", Count: 2},
+			{Value: "go", Count: 1},
+		}},
+		{Property: "stars", Values: []search.FacetValue{
+			{Value: float64(5), Count: 2},
+			{Value: float64(4), Count: 1},
+		}},
+	}, facets)
+}
+
+func TestExplorer_GetClass_Facets(t *testing.T) {
+	class := &models.Class{
+		Class: "BestClass",
+		Properties: []*models.Property{
+			{Name: "category", DataType: schema.DataTypeText.PropString()},
+			{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+		},
+	}
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", "BestClass", "get_graphql", "nearVector", 0)
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), metrics, defaultConfig)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		})
+		return explorer, searcher
+	}
+	params := dto.GetParams{
+		ClassName:  "BestClass",
+		NearVector: &searchparams.NearVector{Vector: []float32{1, 0}},
+		Pagination: &filters.Pagination{Offset: 1, Limit: 1},
+		Facets:     []searchparams.Facet{{Property: "category"}},
+	}
+
+	t.Run("counts over all results and returns the page", func(t *testing.T) {
+		explorer, searcher := newExplorer()
+		expected := params
+		expected.Facets = nil
+		expected.SearchVector = []float32{1, 0}
+		expected.Pagination = &filters.Pagination{Limit: 100}
+		searcher.On("VectorSearch", expected).Return([]search.Result{
+			{ID: "1", Schema: map[string]interface{}{"category": "a"}},
+			{ID: "2", Schema: map[string]interface{}{"category": "b"}},
+			{ID: "3", Schema: map[string]interface{}{"category": "a"}},
+		}, nil)
+
+		ctx, facets := search.WithFacets(context.Background())
+		res, err := explorer.GetClass(ctx, params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+		assert.Equal(t, []interface{}{map[string]interface{}{"category": "b"}}, res)
+		assert.Equal(t, []search.QueryFacets{{
+			Class:   "BestClass",
+			Objects: 3,
+			Facets: []search.FacetCounts{{Property: "category", Values: []search.FacetValue{
+				{Value: "a", Count: 2},
+				{Value: "b", Count: 1},
+			}}},
+		}}, facets.Queries())
+	})
+
+	t.Run("property which can not be counted", func(t *testing.T) {
+		explorer, _ := newExplorer()
+		p := params
+		p.Facets = []searchparams.Facet{{Property: "location"}}
+		_, err := explorer.GetClass(context.Background(), p)
+		assert.EqualError(t, err, "facets: property 'location' of data type [geoCoordinates] can not be counted")
+	})
+
+	t.Run("unknown property", func(t *testing.T) {
+		explorer, _ := newExplorer()
+		p := params
+		p.Facets = []searchparams.Facet{{Property: "missing"}}
+		_, err := explorer.GetClass(context.Background(), p)
+		assert.EqualError(t, err, "facets: no such prop with name 'missing' found in class 'BestClass'")
+	})
+
+	t.Run("facet queries are not cached", func(t *testing.T) {
+		_, cacheable := getQueryCacheKey(params)
+		assert.False(t, cacheable)
+	})
+}
+
+func TestTraverser_GetClass_SensitiveFacets(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	cfg := &config.WeaviateConfig{Config: config.Config{
+		Authorization: config.Authorization{
+			AdminList:     adminlist.Config{Enabled: true},
+			SensitiveData: masking.Config{Users: []string{"alice"}},
+		},
+	}}
+	schemaGetter := &fakeSchemaGetter{schema: schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{{
+			Class: "Patient",
+			Properties: []*models.Property{
+				{Name: "ward", DataType: schema.DataTypeText.PropString()},
+				{Name: "diagnosis", DataType: schema.DataTypeText.PropString(), Sensitive: true},
+			},
+		}},
+	}}}
+	explorer := &countingExplorer{}
+	traverser := NewTraverser(cfg, &fakeLocks{}, logger, &fakeAuthorizer{},
+		&fakeVectorSearcher{}, explorer, schemaGetter, nil, nil, -1)
+
+	params := dto.GetParams{
+		ClassName:  "Patient",
+		Pagination: &filters.Pagination{Limit: 10},
+		Facets:     []searchparams.Facet{{Property: "diagnosis"}},
+	}
+
+	t.Run("principal without the sensitive data reader role", func(t *testing.T) {
+		_, err := traverser.GetClass(context.Background(),
+			&models.Principal{Username: "bob"}, params)
+		assert.EqualError(t, err, "facets: property 'diagnosis' is sensitive and can not be counted")
+		assert.Equal(t, 0, explorer.calls)
+	})
+
+	t.Run("principal with the sensitive data reader role", func(t *testing.T) {
+		_, err := traverser.GetClass(context.Background(),
+			&models.Principal{Username: "alice"}, params)
+		require.Nil(t, err)
+		assert.Equal(t, 1, explorer.calls)
+	})
+
+	t.Run("facets on other properties", func(t *testing.T) {
+		p := params
+		p.Facets = []searchparams.Facet{{Property: "ward"}}
+		_, err := traverser.GetClass(context.Background(),
+			&models.Principal{Username: "bob"}, p)
+		require.Nil(t, err)
+		assert.Equal(t, 2, explorer.calls)
+	})
+}
@@ -244,6 +244,8 @@ func (f *fakeSchemaGetter) TenantShard(class, tenant string) (string, string) {
 }
 func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string { return string(uuid) }
 
+func (f *fakeSchemaGetter) PreviousShardFromUUID(class string, uuid []byte) string { return "" }
+
 func (f *fakeSchemaGetter) Nodes() []string {
 	panic("not implemented")
 }
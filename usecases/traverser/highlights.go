@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	// snippetLength is the maximum length of a snippet in characters, it
+	// starts snippetContext characters before the first match
+	snippetLength  = 200
+	snippetContext = 40
+)
+
+// highlighter finds the terms of the keyword query of a BM25 or hybrid search
+// in the text properties of the results. The query is tokenized like the
+// keyword search does it for each property, and the values of the
+// properties are tokenized the same way to find the offsets of the terms.
+type highlighter struct {
+	properties []highlightProperty
+}
+
+type highlightProperty struct {
+	name         string
+	tokenization string
+	terms        map[string]struct{}
+}
+
+// newHighlighter returns nil if the search has no keyword query
+func newHighlighter(class *models.Class, params dto.GetParams) (*highlighter, error) {
+	var query string
+	var properties []string
+	switch {
+	case params.KeywordRanking != nil:
+		query, properties = params.KeywordRanking.Query, params.KeywordRanking.Properties
+	case params.HybridSearch != nil:
+		query, properties = params.HybridSearch.Query, params.HybridSearch.Properties
+	}
+	if query == "" {
+		return nil, nil
+	}
+
+	var detector *stopwords.Detector
+	if class.InvertedIndexConfig != nil && class.InvertedIndexConfig.Stopwords != nil {
+		var err error
+		detector, err = stopwords.NewDetectorFromConfig(*class.InvertedIndexConfig.Stopwords)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var props []*models.Property
+	if len(properties) == 0 {
+		for _, prop := range class.Properties {
+			if isTextProperty(prop) && inverted.HasSearchableIndex(prop) {
+				props = append(props, prop)
+			}
+		}
+	} else {
+		for _, name := range properties {
+			// properties of BM25 queries can be boosted, e.g. title^2
+			name = strings.Split(name, "^")[0]
+			prop, err := schema.GetPropertyByName(class, name)
+			if err != nil {
+				return nil, err
+			}
+			if !isTextProperty(prop) {
+				return nil, errors.Errorf("cannot highlight datatype '%v' of property '%s'",
+					prop.DataType, prop.Name)
+			}
+			props = append(props, prop)
+		}
+	}
+
+	h := &highlighter{properties: make([]highlightProperty, len(props))}
+	for i, prop := range props {
+		terms := map[string]struct{}{}
+		for _, term := range helpers.Tokenize(prop.Tokenization, query) {
+			// like the keyword search, stopwords are removed for word tokenization only
+			if prop.Tokenization == models.PropertyTokenizationWord &&
+				detector != nil && detector.IsStopword(term) {
+				continue
+			}
+			terms[term] = struct{}{}
+		}
+		h.properties[i] = highlightProperty{
+			name: prop.Name, tokenization: prop.Tokenization, terms: terms,
+		}
+	}
+	return h, nil
+}
+
+func isTextProperty(prop *models.Property) bool {
+	dt, _ := schema.AsPrimitive(prop.DataType)
+	return dt == schema.DataTypeText || dt == schema.DataTypeTextArray
+}
+
+// highlight the properties of a result, properties which are not part of
+// the result can not be highlighted
+func (h *highlighter) highlight(properties map[string]interface{}) []additional.Highlight {
+	highlights := []additional.Highlight{}
+	for _, prop := range h.properties {
+		switch value := properties[prop.name].(type) {
+		case string:
+			highlights = prop.appendHighlight(highlights, 0, value)
+		case []string:
+			for i, v := range value {
+				highlights = prop.appendHighlight(highlights, i, v)
+			}
+		case []interface{}:
+			for i, v := range value {
+				if s, ok := v.(string); ok {
+					highlights = prop.appendHighlight(highlights, i, s)
+				}
+			}
+		}
+	}
+	return highlights
+}
+
+func (p highlightProperty) appendHighlight(highlights []additional.Highlight,
+	index int, value string,
+) []additional.Highlight {
+	var matches []additional.HighlightMatch
+	for _, token := range helpers.TokenizeWithOffsets(p.tokenization, value) {
+		if _, ok := p.terms[token.Term]; ok {
+			matches = append(matches, additional.HighlightMatch{
+				Term: token.Term, Start: token.Start, End: token.End,
+			})
+		}
+	}
+	if len(matches) == 0 {
+		return highlights
+	}
+
+	runes := []rune(value)
+	start := matches[0].Start - snippetContext
+	if start < 0 {
+		start = 0
+	}
+	end := start + snippetLength
+	if end > len(runes) {
+		end = len(runes)
+	}
+	return append(highlights, additional.Highlight{
+		Property:     p.name,
+		Index:        index,
+		Snippet:      string(runes[start:end]),
+		SnippetStart: start,
+		Matches:      matches,
+	})
+}
+
+func (e *Explorer) highlighter(params dto.GetParams) (*highlighter, error) {
+	if !params.AdditionalProperties.Highlights {
+		return nil, nil
+	}
+	s := e.schemaGetter.GetSchemaSkipAuth()
+	class := s.GetClass(schema.ClassName(params.ClassName))
+	if class == nil {
+		return nil, fmt.Errorf("class not found in schema: %q", params.ClassName)
+	}
+	return newHighlighter(class, params)
+}
@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestHighlighter(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Stopwords: &models.StopwordConfig{Preset: "en"},
+		},
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "tags",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{
+				Name:     "count",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+		},
+	}
+
+	t.Run("without keyword query", func(t *testing.T) {
+		h, err := newHighlighter(class, dto.GetParams{})
+		require.Nil(t, err)
+		assert.Nil(t, h)
+	})
+
+	t.Run("bm25 on all text properties", func(t *testing.T) {
+		h, err := newHighlighter(class, dto.GetParams{
+			KeywordRanking: &searchparams.KeywordRanking{Query: "the Quick fox"},
+		})
+		require.Nil(t, err)
+
+		highlights := h.highlight(map[string]interface{}{
+			"title": "The quick brown fox",
+			"tags":  []interface{}{"animals", "the Quick fox"},
+		})
+		assert.Equal(t, []additional.Highlight{
+			{
+				Property: "title",
+				Snippet:  "The quick brown fox",
+				Matches: []additional.HighlightMatch{
+					{Term: "quick", Start: 4, End: 9},
+					{Term: "fox", Start: 16, End: 19},
+				},
+			},
+			{
+				Property: "tags",
+				Index:    1,
+				Snippet:  "the Quick fox",
+				Matches: []additional.HighlightMatch{
+					{Term: "the Quick fox", Start: 0, End: 13},
+				},
+			},
+		}, highlights)
+	})
+
+	t.Run("hybrid on boosted property", func(t *testing.T) {
+		h, err := newHighlighter(class, dto.GetParams{
+			HybridSearch: &searchparams.HybridSearch{Query: "fox", Properties: []string{"title^2"}},
+		})
+		require.Nil(t, err)
+
+		highlights := h.highlight(map[string]interface{}{
+			"title": "no match here",
+			"tags":  []string{"fox"},
+		})
+		assert.Empty(t, highlights)
+	})
+
+	t.Run("snippet around the first match", func(t *testing.T) {
+		h, err := newHighlighter(class, dto.GetParams{
+			KeywordRanking: &searchparams.KeywordRanking{Query: "fox", Properties: []string{"title"}},
+		})
+		require.Nil(t, err)
+
+		prefix := ""
+		for i := 0; i < 10; i++ {
+			prefix += "lorem ipsum "
+		}
+		highlights := h.highlight(map[string]interface{}{"title": prefix + "fox"})
+		require.Len(t, highlights, 1)
+		assert.Equal(t, len(prefix)-snippetContext, highlights[0].SnippetStart)
+		assert.Equal(t, prefix[len(prefix)-snippetContext:]+"fox", highlights[0].Snippet)
+		assert.Equal(t, len(prefix), highlights[0].Matches[0].Start)
+	})
+
+	t.Run("non-text property", func(t *testing.T) {
+		_, err := newHighlighter(class, dto.GetParams{
+			KeywordRanking: &searchparams.KeywordRanking{Query: "1", Properties: []string{"count"}},
+		})
+		assert.ErrorContains(t, err, "cannot highlight datatype")
+	})
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/search"
 )
 
@@ -80,3 +81,72 @@ func TestFusionRelativeScoreExplain(t *testing.T) {
 	require.Contains(t, fused[0].ExplainScore, "(Result Set 'keyword') Document 1: original score 0.5, normalized score: 0.5")
 	require.Contains(t, fused[0].ExplainScore, "(Result Set 'vector') Document 1: original score 2, normalized score: 0.5")
 }
+
+func TestFusionScoreExplanation(t *testing.T) {
+	dist := float32(0.2)
+	newResults := func() [][]*Result {
+		keyword := []*Result{
+			{uint64(1), &search.Result{
+				SecondarySortValue: 2, ID: strfmt.UUID(fmt.Sprint(1)),
+				ScoreExplanation: &additional.ScoreExplanation{
+					Score: 2, Terms: []additional.TermScore{{Term: "fox", Score: 2}},
+				},
+			}},
+			{uint64(2), &search.Result{SecondarySortValue: 1, ID: strfmt.UUID(fmt.Sprint(2))}},
+		}
+		vector := []*Result{
+			{uint64(2), &search.Result{
+				SecondarySortValue: 0.9, ID: strfmt.UUID(fmt.Sprint(2)),
+				ScoreExplanation: &additional.ScoreExplanation{Distance: &dist},
+			}},
+			{uint64(1), &search.Result{
+				SecondarySortValue: 0.8, ID: strfmt.UUID(fmt.Sprint(1)),
+				ScoreExplanation: &additional.ScoreExplanation{Distance: &dist},
+			}},
+		}
+		return [][]*Result{keyword, vector}
+	}
+
+	t.Run("relative score fusion", func(t *testing.T) {
+		fused := FusionRelativeScore([]float64{0.25, 0.75}, newResults(), []string{"keyword", "vector"})
+		require.Len(t, fused, 2)
+		for _, res := range fused {
+			require.NotNil(t, res.ScoreExplanation)
+			assert.Equal(t, res.Score, res.ScoreExplanation.Score)
+			assert.Equal(t, "relativeScoreFusion", res.ScoreExplanation.FusionAlgorithm)
+			assert.Equal(t, &dist, res.ScoreExplanation.Distance)
+		}
+
+		explanation := fused[0].ScoreExplanation
+		assert.Equal(t, strfmt.UUID("2"), fused[0].ID)
+		assert.Empty(t, explanation.Terms)
+		assert.Equal(t, []additional.ScoreComponent{
+			{Name: "keyword", Weight: 0.25, Rank: 2, OriginalScore: 1, NormalizedScore: 0, Contribution: 0},
+			{Name: "vector", Weight: 0.75, Rank: 1, OriginalScore: 0.9, NormalizedScore: 1, Contribution: 0.75},
+		}, explanation.Components)
+
+		explanation = fused[1].ScoreExplanation
+		assert.Equal(t, []additional.TermScore{{Term: "fox", Score: 2}}, explanation.Terms)
+		require.Len(t, explanation.Components, 2)
+		assert.Equal(t, float32(0.25), explanation.Components[0].Contribution)
+		assert.Equal(t, float32(0), explanation.Components[1].Contribution)
+	})
+
+	t.Run("ranked fusion", func(t *testing.T) {
+		fused := FusionRanked([]float64{0.25, 0.75}, newResults(), []string{"keyword", "vector"})
+		require.Len(t, fused, 2)
+		for _, res := range fused {
+			require.NotNil(t, res.ScoreExplanation)
+			assert.Equal(t, res.Score, res.ScoreExplanation.Score)
+			assert.Equal(t, "rankedFusion", res.ScoreExplanation.FusionAlgorithm)
+			require.Len(t, res.ScoreExplanation.Components, 2)
+
+			var sum float32
+			for _, component := range res.ScoreExplanation.Components {
+				assert.InDelta(t, component.Weight/float64(component.Rank+60), component.Contribution, 1e-6)
+				sum += component.Contribution
+			}
+			assert.InDelta(t, res.Score, sum, 1e-6)
+		}
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"sort"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
 )
 
 func FusionRanked(weights []float64, resultSets [][]*Result, setNames []string) []*Result {
@@ -44,6 +45,13 @@ func FusionRanked(weights []float64, resultSets [][]*Result, setNames []string)
 			}
 			tempResult.AdditionalProperties["rank_score"] = score
 			tempResult.AdditionalProperties["score"] = score
+			explainFusion(tempResult, previousResult, "rankedFusion", additional.ScoreComponent{
+				Name:          setNames[resultSetIndex],
+				Weight:        weights[resultSetIndex],
+				Rank:          i + 1,
+				OriginalScore: tempResult.SecondarySortValue,
+				Contribution:  float32(weights[resultSetIndex] / float64(i+60+1)),
+			})
 
 			tempResult.Score = float32(score)
 			combinedResults[docId] = tempResult
@@ -57,6 +65,7 @@ func FusionRanked(weights []float64, resultSets [][]*Result, setNames []string)
 	)
 	for _, res := range combinedResults {
 		res.ExplainScore = res.AdditionalProperties["explainScore"].(string)
+		res.ScoreExplanation.Score = res.Score
 		concat[i] = res
 		i++
 	}
@@ -117,15 +126,24 @@ func FusionRelativeScore(weights []float64, resultSets [][]*Result, names []stri
 	mapResults := make(map[strfmt.UUID]*Result, numResults)
 	for i := range resultSets {
 		weight := float32(weights[i])
-		for _, res := range resultSets[i] {
+		for rank, res := range resultSets[i] {
 			// If all scores are identical min and max are the same => just set score to the weight.
-			score := weight
+			normalized := float32(1)
 			if maximum[i] != minimum[i] {
-				score *= (res.SecondarySortValue - minimum[i]) / (maximum[i] - minimum[i])
+				normalized = (res.SecondarySortValue - minimum[i]) / (maximum[i] - minimum[i])
 			}
+			score := weight * normalized
 
 			previousResult, ok := mapResults[res.ID]
 			explainScore := fmt.Sprintf("(Result Set '%v') Document %v: original score %v, normalized score: %v", names[i], res.ID, res.SecondarySortValue, score)
+			explainFusion(res, previousResult, "relativeScoreFusion", additional.ScoreComponent{
+				Name:            names[i],
+				Weight:          weights[i],
+				Rank:            rank + 1,
+				OriginalScore:   res.SecondarySortValue,
+				NormalizedScore: normalized,
+				Contribution:    score,
+			})
 			if ok {
 				score += previousResult.Score
 				explainScore += " - " + previousResult.ExplainScore
@@ -139,6 +157,7 @@ func FusionRelativeScore(weights []float64, resultSets [][]*Result, names []stri
 
 	concat := make([]*Result, 0, len(mapResults))
 	for _, res := range mapResults {
+		res.ScoreExplanation.Score = res.Score
 		concat = append(concat, res)
 	}
 
@@ -151,3 +170,22 @@ func FusionRelativeScore(weights []float64, resultSets [][]*Result, names []stri
 	})
 	return concat
 }
+
+// explainFusion replaces the score explanation of a result with one that
+// also contains the explanation of the same document from previous result
+// sets and the component of the current result set.
+func explainFusion(res, previous *Result, algorithm string, component additional.ScoreComponent) {
+	explanation := &additional.ScoreExplanation{FusionAlgorithm: algorithm}
+	for _, r := range []*Result{previous, res} {
+		if r == nil || r.ScoreExplanation == nil {
+			continue
+		}
+		explanation.Terms = append(explanation.Terms, r.ScoreExplanation.Terms...)
+		if r.ScoreExplanation.Distance != nil {
+			explanation.Distance = r.ScoreExplanation.Distance
+		}
+		explanation.Components = append(explanation.Components, r.ScoreExplanation.Components...)
+	}
+	explanation.Components = append(explanation.Components, component)
+	res.ScoreExplanation = explanation
+}
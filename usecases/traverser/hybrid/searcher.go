@@ -200,6 +200,7 @@ func processDenseSearch(ctx context.Context, denseSearch denseSearchFunc, params
 		sr.ExplainScore = fmt.Sprintf(
 			"(vector) %v %v ", truncateVectorString(10, vector),
 			res[i].ExplainScore())
+		sr.ScoreExplanation = &additional.ScoreExplanation{Distance: &dists[i]}
 		out[i] = &Result{obj.DocID(), &sr}
 	}
 	return out, nil
@@ -257,6 +258,7 @@ func nearTextSubSearch(ctx context.Context, subsearch *searchparams.WeightedSear
 	out := make([]*Result, len(res))
 	for i, obj := range res {
 		sr := obj.SearchResultWithDist(additional.Properties{}, dists[i])
+		sr.ScoreExplanation = &additional.ScoreExplanation{Distance: &dists[i]}
 		out[i] = &Result{obj.DocID(), &sr}
 	}
 
@@ -274,6 +276,7 @@ func nearVectorSubSearch(subsearch *searchparams.WeightedSearchResult, denseSear
 	out := make([]*Result, len(res))
 	for i, obj := range res {
 		sr := obj.SearchResultWithDist(additional.Properties{}, dists[i])
+		sr.ScoreExplanation = &additional.ScoreExplanation{Distance: &dists[i]}
 		out[i] = &Result{obj.DocID(), &sr}
 	}
 
@@ -36,7 +36,7 @@ func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
 		queriesDurations:   prom.QueriesDurations,
 		dimensions:         prom.QueryDimensions,
 		dimensionsCombined: prom.QueryDimensionsCombined,
-		groupClasses:       prom.Group,
+		groupClasses:       prom.Group || prom.DisableClassLabels,
 	}
 }
 
@@ -59,7 +59,7 @@ func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 	}
 
 	if nearVector != nil {
-		return nearVector.Vector, nil
+		return vectorFromNearVector(nearVector)
 	}
 
 	if nearObject != nil {
@@ -212,6 +212,42 @@ func Test_nearParamsVector_vectorFromParams(t *testing.T) {
 			want:    []float32{1.1, 1.0, 0.1},
 			wantErr: false,
 		},
+		{
+			name: "Should compose vector from weighted nearVector vectors",
+			args: args{
+				nearVector: &searchparams.NearVector{
+					Vectors: []searchparams.WeightedVector{
+						{Vector: []float32{1, 1, 0}},
+						{Vector: []float32{1, 0, 0}, Weight: -1},
+						{Vector: []float32{0, 0, 1}, Weight: 0.5},
+					},
+				},
+			},
+			want:    []float32{0, 1, 0.5},
+			wantErr: false,
+		},
+		{
+			name: "Should fail on nearVector vectors of different lengths",
+			args: args{
+				nearVector: &searchparams.NearVector{
+					Vectors: []searchparams.WeightedVector{
+						{Vector: []float32{1, 1, 0}},
+						{Vector: []float32{1, 0}, Weight: -1},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail on nearVector with both vector and vectors",
+			args: args{
+				nearVector: &searchparams.NearVector{
+					Vector:  []float32{1, 1, 0},
+					Vectors: []searchparams.WeightedVector{{Vector: []float32{1, 0, 0}}},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Should get vector from nearObject",
 			args: args{
@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// vectorFromNearVector is the vector a near vector search is run with, either
+// the given vector or the weighted sum of the given vectors, so concepts can
+// be added to and subtracted from each other
+func vectorFromNearVector(nearVector *searchparams.NearVector) ([]float32, error) {
+	if len(nearVector.Vectors) == 0 {
+		return nearVector.Vector, nil
+	}
+	if len(nearVector.Vector) > 0 {
+		return nil, errors.New("found both 'vector' and 'vectors' in nearVector " +
+			"which are conflicting, choose one instead")
+	}
+
+	var sum []float32
+	for i, wv := range nearVector.Vectors {
+		if len(wv.Vector) == 0 {
+			return nil, fmt.Errorf("nearVector vectors: vector %d is empty", i)
+		}
+		if sum == nil {
+			sum = make([]float32, len(wv.Vector))
+		} else if len(wv.Vector) != len(sum) {
+			return nil, fmt.Errorf("nearVector vectors: vector %d has length %d, "+
+				"expected %d", i, len(wv.Vector), len(sum))
+		}
+
+		weight := wv.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		for j := range wv.Vector {
+			sum[j] += float32(weight) * wv.Vector[j]
+		}
+	}
+	return sum, nil
+}
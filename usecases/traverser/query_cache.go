@@ -0,0 +1,166 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/querycache"
+)
+
+// getCacheKey contains the params which are not encoded, as WithDistance
+// changes the meaning of the distance of near params
+type getCacheKey struct {
+	Params                 dto.GetParams
+	NearVectorWithDistance bool
+	RecommendWithDistance  bool
+}
+
+type aggregateCacheKey struct {
+	Params                 aggregation.Params
+	NearVectorWithDistance bool
+}
+
+// getQueryCacheKey returns false for queries which can not be cached. Near
+// object, recommend examples and hybrid sub searches depend on objects which
+// might be of any class, so their results could not be invalidated. Explained
+// queries must search the shards to plan them and the facets are reported
+// next to the results.
+func getQueryCacheKey(params dto.GetParams) (string, bool) {
+	if params.NearObject != nil || hasSubSearches(params.HybridSearch) || params.Explain ||
+		(params.Recommend != nil && params.Recommend.HasObjects()) || len(params.Facets) > 0 {
+		return "", false
+	}
+	return querycache.Key("get", getCacheKey{
+		Params:                 params,
+		NearVectorWithDistance: nearVectorWithDistance(params.NearVector),
+		RecommendWithDistance:  params.Recommend != nil && params.Recommend.WithDistance,
+	})
+}
+
+func aggregateQueryCacheKey(params aggregation.Params) (string, bool) {
+	if params.NearObject != nil || hasSubSearches(params.Hybrid) {
+		return "", false
+	}
+	return querycache.Key("aggregate", aggregateCacheKey{
+		Params:                 params,
+		NearVectorWithDistance: nearVectorWithDistance(params.NearVector),
+	})
+}
+
+func hasSubSearches(hybrid *searchparams.HybridSearch) bool {
+	return hybrid != nil && hybrid.SubSearches != nil
+}
+
+func nearVectorWithDistance(nearVector *searchparams.NearVector) bool {
+	return nearVector != nil && nearVector.WithDistance
+}
+
+// getQueryClasses are the classes whose objects are contained in or
+// filter the results, starting with the queried class
+func getQueryClasses(params dto.GetParams) []string {
+	classes := newClassSet(params.ClassName)
+	classes.addProperties(params.Properties)
+	classes.addFilter(params.Filters)
+	return classes.names
+}
+
+func aggregateQueryClasses(params aggregation.Params) []string {
+	classes := newClassSet(params.ClassName.String())
+	classes.addFilter(params.Filters)
+	classes.addPath(params.GroupBy)
+	return classes.names
+}
+
+type classSet struct {
+	names []string
+	seen  map[string]struct{}
+}
+
+func newClassSet(className string) *classSet {
+	s := &classSet{seen: map[string]struct{}{}}
+	s.add(className)
+	return s
+}
+
+func (s *classSet) add(className string) {
+	if _, ok := s.seen[className]; ok || className == "" {
+		return
+	}
+	s.seen[className] = struct{}{}
+	s.names = append(s.names, className)
+}
+
+func (s *classSet) addProperties(props search.SelectProperties) {
+	for _, prop := range props {
+		for _, ref := range prop.Refs {
+			s.add(ref.ClassName)
+			s.addProperties(ref.RefProperties)
+		}
+		s.addProperties(prop.Props)
+	}
+}
+
+func (s *classSet) addFilter(filter *filters.LocalFilter) {
+	if filter != nil {
+		s.addClause(filter.Root)
+	}
+}
+
+func (s *classSet) addClause(clause *filters.Clause) {
+	if clause == nil {
+		return
+	}
+	s.addPath(clause.On)
+	for i := range clause.Operands {
+		s.addClause(&clause.Operands[i])
+	}
+}
+
+func (s *classSet) addPath(path *filters.Path) {
+	for ; path != nil; path = path.Child {
+		s.add(path.Class.String())
+	}
+}
+
+// copyResults copies the maps of cached results, so that they are not
+// modified when the results of a request are masked
+func copyResults(results []interface{}) []interface{} {
+	copied := make([]interface{}, len(results))
+	for i, res := range results {
+		copied[i] = copyResult(res)
+	}
+	return copied
+}
+
+func copyResult(res interface{}) interface{} {
+	switch v := res.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			copied[key] = copyResult(value)
+		}
+		return copied
+	case []interface{}:
+		return copyResults(v)
+	case search.LocalRef:
+		return search.LocalRef{
+			Class:  v.Class,
+			Fields: copyResult(v.Fields).(map[string]interface{}),
+		}
+	default:
+		return v
+	}
+}
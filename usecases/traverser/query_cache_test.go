@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/querycache"
+)
+
+type countingExplorer struct {
+	fakeExplorer
+	calls int
+}
+
+func (f *countingExplorer) GetClass(ctx context.Context, p dto.GetParams) ([]interface{}, error) {
+	f.calls++
+	return []interface{}{
+		map[string]interface{}{
+			"title": "hello",
+			"hasAuthor": []interface{}{
+				search.LocalRef{Class: "Author", Fields: map[string]interface{}{"name": "Jane"}},
+			},
+		},
+	}, nil
+}
+
+func TestGetClassQueryCache(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	explorer := &countingExplorer{}
+	traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+		&fakeAuthorizer{}, &fakeVectorSearcher{}, explorer, newFakeSchemaGetter("Article"),
+		nil, nil, -1)
+	cache := querycache.New(querycache.Config{
+		Enabled: true, TTL: time.Minute, MaxEntries: 10,
+	}, nil, nil, logger)
+	traverser.SetQueryCache(cache)
+
+	params := dto.GetParams{
+		ClassName: "Article",
+		Properties: search.SelectProperties{{
+			Name: "hasAuthor",
+			Refs: []search.SelectClass{{ClassName: "Author"}},
+		}},
+		Pagination: &filters.Pagination{Limit: 10},
+	}
+
+	res, err := traverser.GetClass(ctx, nil, params)
+	require.Nil(t, err)
+	assert.Equal(t, 1, explorer.calls)
+
+	// modifying a result does not modify the cached one
+	res[0].(map[string]interface{})["title"] = "modified"
+	res[0].(map[string]interface{})["hasAuthor"].([]interface{})[0].(search.LocalRef).
+		Fields["name"] = "modified"
+
+	res, err = traverser.GetClass(ctx, nil, params)
+	require.Nil(t, err)
+	assert.Equal(t, 1, explorer.calls)
+	assert.Equal(t, "hello", res[0].(map[string]interface{})["title"])
+	assert.Equal(t, "Jane", res[0].(map[string]interface{})["hasAuthor"].([]interface{})[0].(search.LocalRef).
+		Fields["name"])
+
+	t.Run("other params miss", func(t *testing.T) {
+		other := params
+		other.Pagination = &filters.Pagination{Limit: 20}
+		_, err := traverser.GetClass(ctx, nil, other)
+		require.Nil(t, err)
+		assert.Equal(t, 2, explorer.calls)
+	})
+
+	t.Run("writes to referenced classes invalidate", func(t *testing.T) {
+		cache.Invalidate(ctx, "Author")
+		_, err := traverser.GetClass(ctx, nil, params)
+		require.Nil(t, err)
+		assert.Equal(t, 3, explorer.calls)
+		_, err = traverser.GetClass(ctx, nil, params)
+		require.Nil(t, err)
+		assert.Equal(t, 3, explorer.calls)
+	})
+
+	t.Run("near object is not cached", func(t *testing.T) {
+		nearObject := params
+		nearObject.NearObject = &searchparams.NearObject{ID: "5a4bd6d1-3a9b-4a58-8c18-67b8b2dc6c9a"}
+		for i := 0; i < 2; i++ {
+			_, err := traverser.GetClass(ctx, nil, nearObject)
+			require.Nil(t, err)
+		}
+		assert.Equal(t, 5, explorer.calls)
+	})
+}
+
+func TestQueryClasses(t *testing.T) {
+	params := dto.GetParams{
+		ClassName: "Article",
+		Properties: search.SelectProperties{{
+			Name: "hasAuthor",
+			Refs: []search.SelectClass{{
+				ClassName: "Author",
+				RefProperties: search.SelectProperties{{
+					Name: "worksAt",
+					Refs: []search.SelectClass{{ClassName: "Publisher"}},
+				}},
+			}},
+		}},
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{{
+				On: &filters.Path{
+					Class: "Article", Property: "inCategory",
+					Child: &filters.Path{Class: "Category", Property: "name"},
+				},
+			}},
+		}},
+	}
+	assert.Equal(t, []string{"Article", "Author", "Publisher", "Category"},
+		getQueryClasses(params))
+}
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/slowquery"
+)
+
+// explainQuery explains the query if it asks for it, if its request only
+// plans queries or if it is sampled by the slow query log. The returned func
+// reports the plan once the query is done.
+func (t *Traverser) explainQuery(ctx context.Context, params *dto.GetParams) (context.Context, func(error)) {
+	planOnly := search.PlanOnly(ctx)
+	tracked := !planOnly && t.slowQueries.Sample()
+	explained := params.Explain || planOnly
+	if !explained && !tracked {
+		return ctx, func(error) {}
+	}
+
+	// explained queries are not cached, tracked ones are since their
+	// cached results are not slow anyway
+	params.Explain = explained
+	before := time.Now()
+	ctx, explain := search.WithExplain(ctx, !planOnly)
+	return ctx, func(err error) {
+		took := time.Since(before)
+		if explained && err == nil {
+			search.RecordQueryPlan(ctx, search.QueryPlan{
+				Class:    params.ClassName,
+				Executed: !planOnly,
+				TookMs:   float64(took.Microseconds()) / 1000,
+				Shards:   explain.Shards(),
+			})
+		}
+		if tracked {
+			t.slowQueries.Record(slowQueryEntry("get", params.ClassName, params.Tenant,
+				slowquery.GetParams(*params), explain.Shards(), err), took)
+		}
+	}
+}
+
+func slowQueryEntry(queryType, className, tenant string, params map[string]interface{},
+	shards []search.ShardPlan, err error,
+) slowquery.Entry {
+	entry := slowquery.Entry{
+		Time:   time.Now(),
+		Type:   queryType,
+		Class:  className,
+		Tenant: tenant,
+		Params: params,
+		Shards: shards,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	return entry
+}
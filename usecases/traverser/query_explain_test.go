@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// planningExplorer plans the search of a single shard
+type planningExplorer struct {
+	fakeExplorer
+}
+
+func (f *planningExplorer) GetClass(ctx context.Context, p dto.GetParams) ([]interface{}, error) {
+	explain := search.ExplainFromContext(ctx)
+	explain.AddShard(search.ShardPlan{Shard: "shard1", Strategy: search.StrategyVectorIndex})
+	if !explain.Execute() {
+		return nil, nil
+	}
+	return []interface{}{map[string]interface{}{"title": "hello"}}, nil
+}
+
+func TestGetClassExplain(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+		&fakeAuthorizer{}, &fakeVectorSearcher{}, &planningExplorer{}, newFakeSchemaGetter("Article"),
+		nil, nil, -1)
+
+	t.Run("explain", func(t *testing.T) {
+		ctx, plans := search.WithQueryPlans(context.Background(), false)
+		res, err := traverser.GetClass(ctx, nil, dto.GetParams{ClassName: "Article", Explain: true})
+		require.Nil(t, err)
+		assert.Len(t, res, 1)
+		require.Len(t, plans.Plans(), 1)
+		plan := plans.Plans()[0]
+		assert.Equal(t, "Article", plan.Class)
+		assert.True(t, plan.Executed)
+		assert.Equal(t, []search.ShardPlan{{Shard: "shard1", Strategy: search.StrategyVectorIndex}},
+			plan.Shards)
+	})
+
+	t.Run("not explained", func(t *testing.T) {
+		ctx, plans := search.WithQueryPlans(context.Background(), false)
+		_, err := traverser.GetClass(ctx, nil, dto.GetParams{ClassName: "Article"})
+		require.Nil(t, err)
+		assert.Empty(t, plans.Plans())
+	})
+
+	t.Run("plan only", func(t *testing.T) {
+		ctx, plans := search.WithQueryPlans(context.Background(), true)
+		res, err := traverser.GetClass(ctx, nil, dto.GetParams{ClassName: "Article"})
+		require.Nil(t, err)
+		assert.Empty(t, res)
+		require.Len(t, plans.Plans(), 1)
+		assert.False(t, plans.Plans()[0].Executed)
+	})
+}
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// queryTimeout is the shorter of the timeout of the query and the timeout
+// configured for its class, 0 if neither is set
+func (t *Traverser) queryTimeout(className string, timeout time.Duration) time.Duration {
+	sch := t.schemaGetter.GetSchemaSkipAuth()
+	classTimeout := schema.QueryTimeout(sch.GetClass(schema.ClassName(className)))
+	if timeout == 0 || (classTimeout > 0 && classTimeout < timeout) {
+		return classTimeout
+	}
+	return timeout
+}
+
+// withQueryTimeout bounds the query by its timeout. Queries which accept
+// partial results only bound the searches of the shards, so that the
+// results of the shards which finished in time can still be resolved. The
+// returned shard deadline is nil unless partial results are accepted.
+func (t *Traverser) withQueryTimeout(ctx context.Context, className string,
+	timeout time.Duration, partialResults bool,
+) (context.Context, context.CancelFunc, *search.ShardDeadline) {
+	timeout = t.queryTimeout(className, timeout)
+	if timeout == 0 {
+		return ctx, func() {}, nil
+	}
+	if partialResults {
+		ctx, deadline := search.WithShardDeadline(ctx, time.Now().Add(timeout))
+		return ctx, func() {}, deadline
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, nil
+}
+
+// queryTimeoutError replaces the error of a query which was cancelled
+// because it exceeded its timeout, rather than by the client
+func queryTimeoutError(parent, ctx context.Context, className string, err error) error {
+	if parent.Err() == nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("query on class %q exceeded its timeout: %w", className, ctx.Err())
+	}
+	return err
+}
+
+// recordPartialResults reports the shards which were skipped because they
+// did not finish before the deadline
+func recordPartialResults(ctx context.Context, className string, deadline *search.ShardDeadline) {
+	if skipped := deadline.Skipped(); len(skipped) > 0 {
+		search.RecordPartialQuery(ctx, search.PartialQuery{Class: className, Shards: skipped})
+	}
+}
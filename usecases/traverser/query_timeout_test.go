@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// slowShardsExplorer searches a fast and a slow shard, the slow shard only
+// finishes when its context is done
+type slowShardsExplorer struct {
+	fakeExplorer
+}
+
+func (f *slowShardsExplorer) GetClass(ctx context.Context, p dto.GetParams) ([]interface{}, error) {
+	var res []interface{}
+	for _, shard := range []string{"fast", "slow"} {
+		shardCtx, cancel := search.ShardContext(ctx)
+		var err error
+		if shard == "slow" {
+			<-shardCtx.Done()
+			err = shardCtx.Err()
+		}
+		cancel()
+		if err != nil {
+			if search.SkipShard(shardCtx, shard, err) {
+				continue
+			}
+			return nil, err
+		}
+		res = append(res, map[string]interface{}{"shard": shard})
+	}
+	return res, nil
+}
+
+func TestGetClassTimeout(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	schemaGetter := newFakeSchemaGetter("Article")
+	traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+		&fakeAuthorizer{}, &fakeVectorSearcher{}, &slowShardsExplorer{}, schemaGetter,
+		nil, nil, -1)
+
+	t.Run("query timeout", func(t *testing.T) {
+		_, err := traverser.GetClass(context.Background(), nil, dto.GetParams{
+			ClassName: "Article",
+			Timeout:   10 * time.Millisecond,
+		})
+		assert.ErrorContains(t, err, "exceeded its timeout")
+	})
+
+	t.Run("partial results", func(t *testing.T) {
+		ctx, partial := search.WithPartialResults(context.Background())
+		res, err := traverser.GetClass(ctx, nil, dto.GetParams{
+			ClassName:      "Article",
+			Timeout:        10 * time.Millisecond,
+			PartialResults: true,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []interface{}{map[string]interface{}{"shard": "fast"}}, res)
+		assert.Equal(t, []search.PartialQuery{{Class: "Article", Shards: []string{"slow"}}},
+			partial.Queries())
+	})
+
+	t.Run("class timeout", func(t *testing.T) {
+		schemaGetter.schema.Objects.Classes[0].QueryConfig = &models.QueryConfig{
+			TimeoutMilliseconds: 10,
+		}
+		defer func() { schemaGetter.schema.Objects.Classes[0].QueryConfig = nil }()
+
+		_, err := traverser.GetClass(context.Background(), nil, dto.GetParams{
+			ClassName: "Article",
+			Timeout:   time.Hour,
+		})
+		assert.ErrorContains(t, err, "exceeded its timeout")
+	})
+}
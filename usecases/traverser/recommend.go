@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// nearVectorFromRecommend composes the examples of a recommend search into
+// the near vector the search is run with
+func (e *Explorer) nearVectorFromRecommend(ctx context.Context,
+	params dto.GetParams,
+) (*searchparams.NearVector, error) {
+	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 ||
+		params.KeywordRanking != nil || params.HybridSearch != nil {
+		return nil, errors.Errorf("found both 'recommend' and other search parameters " +
+			"which are conflicting, choose one instead")
+	}
+
+	vector, err := e.nearParamsVector.vectorFromRecommendParams(ctx, params.ClassName,
+		params.Recommend, params.Tenant)
+	if err != nil {
+		return nil, errors.Errorf("recommend params: %v", err)
+	}
+
+	return &searchparams.NearVector{
+		Vector:       vector,
+		Certainty:    params.Recommend.Certainty,
+		Distance:     params.Recommend.Distance,
+		WithDistance: params.Recommend.WithDistance,
+	}, nil
+}
+
+// vectorFromRecommendParams is the Rocchio composition of the examples: the
+// weighted mean of the positive examples minus the negative weight times the
+// weighted mean of the negative examples
+func (v *nearParamsVector) vectorFromRecommendParams(ctx context.Context,
+	className string, params *searchparams.Recommend, tenant string,
+) ([]float32, error) {
+	if len(params.Positive) == 0 {
+		return nil, errors.New("at least one positive example is required")
+	}
+	if params.NegativeWeight < 0 {
+		return nil, errors.Errorf("negativeWeight must not be negative, got %v",
+			params.NegativeWeight)
+	}
+
+	positive, err := v.weightedMeanOfExamples(ctx, className, params.Positive, tenant)
+	if err != nil {
+		return nil, errors.Wrap(err, "positive examples")
+	}
+	if len(params.Negative) == 0 {
+		return positive, nil
+	}
+
+	negative, err := v.weightedMeanOfExamples(ctx, className, params.Negative, tenant)
+	if err != nil {
+		return nil, errors.Wrap(err, "negative examples")
+	}
+	if len(negative) != len(positive) {
+		return nil, errors.Errorf("vector lengths of positive (%d) and negative (%d) "+
+			"examples differ", len(positive), len(negative))
+	}
+
+	weight := params.NegativeWeight
+	if weight == 0 {
+		weight = searchparams.DefaultRecommendNegativeWeight
+	}
+	out := make([]float32, len(positive))
+	for i := range positive {
+		out[i] = positive[i] - float32(weight)*negative[i]
+	}
+	return out, nil
+}
+
+func (v *nearParamsVector) weightedMeanOfExamples(ctx context.Context,
+	className string, examples []searchparams.RecommendExample, tenant string,
+) ([]float32, error) {
+	var (
+		sum   []float32
+		total float64
+	)
+	for i, ex := range examples {
+		weight := ex.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		if weight < 0 {
+			return nil, fmt.Errorf("example %d: weight must not be negative, got %v", i, weight)
+		}
+
+		vector, err := v.vectorFromRecommendExample(ctx, className, ex, tenant)
+		if err != nil {
+			return nil, fmt.Errorf("example %d: %w", i, err)
+		}
+		if sum == nil {
+			sum = make([]float32, len(vector))
+		} else if len(vector) != len(sum) {
+			return nil, fmt.Errorf("example %d: vector length %d differs from %d",
+				i, len(vector), len(sum))
+		}
+
+		for j := range vector {
+			sum[j] += float32(weight) * vector[j]
+		}
+		total += weight
+	}
+
+	for j := range sum {
+		sum[j] /= float32(total)
+	}
+	return sum, nil
+}
+
+func (v *nearParamsVector) vectorFromRecommendExample(ctx context.Context,
+	className string, ex searchparams.RecommendExample, tenant string,
+) ([]float32, error) {
+	hasObject := ex.ID != "" || ex.Beacon != ""
+	if len(ex.Vector) > 0 {
+		if hasObject {
+			return nil, errors.New("an example is either an object or a vector, not both")
+		}
+		return ex.Vector, nil
+	}
+	if !hasObject {
+		return nil, errors.New("one of id, beacon or vector is required")
+	}
+
+	return v.vectorFromNearObjectParams(ctx, className,
+		&searchparams.NearObject{ID: ex.ID, Beacon: ex.Beacon}, tenant)
+}
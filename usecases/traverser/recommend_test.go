@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_nearParamsVector_vectorFromRecommendParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  searchparams.Recommend
+		want    []float32
+		wantErr string
+	}{
+		{
+			name: "weighted mean of positive vectors",
+			params: searchparams.Recommend{Positive: []searchparams.RecommendExample{
+				{Vector: []float32{1, 0}},
+				{Vector: []float32{0, 1}, Weight: 3},
+			}},
+			want: []float32{0.25, 0.75},
+		},
+		{
+			name: "negative examples with the default weight",
+			params: searchparams.Recommend{
+				Positive: []searchparams.RecommendExample{{ID: "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf"}},
+				Negative: []searchparams.RecommendExample{{Vector: []float32{0, 2, 0}}},
+			},
+			want: []float32{1, 0, 1},
+		},
+		{
+			name: "negative examples with an explicit weight",
+			params: searchparams.Recommend{
+				Positive:       []searchparams.RecommendExample{{ID: "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf"}},
+				Negative:       []searchparams.RecommendExample{{Vector: []float32{0, 2, 0}}, {Vector: []float32{0, 0, 2}}},
+				NegativeWeight: 2,
+			},
+			want: []float32{1, -1, -1},
+		},
+		{
+			name:    "no positive examples",
+			params:  searchparams.Recommend{Negative: []searchparams.RecommendExample{{Vector: []float32{1}}}},
+			wantErr: "at least one positive example is required",
+		},
+		{
+			name: "object and vector in one example",
+			params: searchparams.Recommend{Positive: []searchparams.RecommendExample{
+				{ID: "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf", Vector: []float32{1}},
+			}},
+			wantErr: "positive examples: example 0: an example is either an object or a vector, not both",
+		},
+		{
+			name:    "empty example",
+			params:  searchparams.Recommend{Positive: []searchparams.RecommendExample{{Weight: 1}}},
+			wantErr: "positive examples: example 0: one of id, beacon or vector is required",
+		},
+		{
+			name: "negative example weight",
+			params: searchparams.Recommend{Positive: []searchparams.RecommendExample{
+				{Vector: []float32{1}, Weight: -1},
+			}},
+			wantErr: "positive examples: example 0: weight must not be negative, got -1",
+		},
+		{
+			name: "differing vector lengths",
+			params: searchparams.Recommend{
+				Positive: []searchparams.RecommendExample{{Vector: []float32{1, 0}}},
+				Negative: []searchparams.RecommendExample{{Vector: []float32{1, 0, 0}}},
+			},
+			wantErr: "vector lengths of positive (2) and negative (3) examples differ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &nearParamsVector{search: &fakeNearParamsSearcher{}}
+			got, err := v.vectorFromRecommendParams(context.Background(), "Class", &tt.params, "")
+			if tt.wantErr != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.wantErr, err.Error())
+				return
+			}
+			require.Nil(t, err)
+			assert.InDeltaSlice(t, tt.want, got, 1e-6)
+		})
+	}
+}
+
+func TestExplorer_nearVectorFromRecommend(t *testing.T) {
+	e := &Explorer{nearParamsVector: &nearParamsVector{search: &fakeNearParamsSearcher{}}}
+	recommend := &searchparams.Recommend{
+		Positive:     []searchparams.RecommendExample{{Vector: []float32{1, 2}}},
+		Distance:     0.3,
+		WithDistance: true,
+	}
+
+	t.Run("composed near vector", func(t *testing.T) {
+		nearVector, err := e.nearVectorFromRecommend(context.Background(),
+			dto.GetParams{ClassName: "Class", Recommend: recommend})
+		require.Nil(t, err)
+		assert.Equal(t, &searchparams.NearVector{
+			Vector:       []float32{1, 2},
+			Distance:     0.3,
+			WithDistance: true,
+		}, nearVector)
+	})
+
+	t.Run("conflicting search", func(t *testing.T) {
+		_, err := e.nearVectorFromRecommend(context.Background(), dto.GetParams{
+			ClassName:  "Class",
+			Recommend:  recommend,
+			NearObject: &searchparams.NearObject{ID: "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf"},
+		})
+		assert.EqualError(t, err, "found both 'recommend' and other search parameters "+
+			"which are conflicting, choose one instead")
+	})
+
+	t.Run("examples of objects are not cached", func(t *testing.T) {
+		_, cacheable := getQueryCacheKey(dto.GetParams{ClassName: "Class", Recommend: recommend})
+		assert.True(t, cacheable)
+
+		_, cacheable = getQueryCacheKey(dto.GetParams{ClassName: "Class", Recommend: &searchparams.Recommend{
+			Positive: []searchparams.RecommendExample{{ID: "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf"}},
+		}})
+		assert.False(t, cacheable)
+	})
+}
@@ -0,0 +1,36 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/search"
+	rankmodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+// scoreExplanation of a result as it is returned to the user. The bm25 and
+// hybrid searches explain their scores while searching, the reranker score is
+// only known after the additional properties of the modules were resolved.
+func scoreExplanation(res search.Result, vectorSearch bool) *additional.ScoreExplanation {
+	explanation := additional.ScoreExplanation{Score: res.Score}
+	if res.ScoreExplanation != nil {
+		explanation = *res.ScoreExplanation
+	}
+	if vectorSearch && explanation.Distance == nil {
+		dist := res.Dist
+		explanation.Distance = &dist
+	}
+	if ranked, ok := res.AdditionalProperties["rerank"].([]*rankmodels.RankResult); ok && len(ranked) > 0 {
+		explanation.RerankerScore = ranked[0].Score
+	}
+	return &explanation
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	rankmodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+func TestScoreExplanation(t *testing.T) {
+	t.Run("without explanation of the search", func(t *testing.T) {
+		explanation := scoreExplanation(search.Result{Score: 1.5}, false)
+		assert.Equal(t, &additional.ScoreExplanation{Score: 1.5}, explanation)
+	})
+
+	t.Run("vector search", func(t *testing.T) {
+		explanation := scoreExplanation(search.Result{Dist: 0.25}, true)
+		dist := float32(0.25)
+		assert.Equal(t, &additional.ScoreExplanation{Distance: &dist}, explanation)
+	})
+
+	t.Run("reranked bm25 search", func(t *testing.T) {
+		rerankerScore := 0.9
+		bm25 := &additional.ScoreExplanation{
+			Score: 2,
+			Terms: []additional.TermScore{{Term: "fox", Frequency: 1, PropLength: 4, Idf: 1.5, Score: 2}},
+		}
+		explanation := scoreExplanation(search.Result{
+			Score:            2,
+			ScoreExplanation: bm25,
+			AdditionalProperties: models.AdditionalProperties{
+				"rerank": []*rankmodels.RankResult{{Score: &rerankerScore}},
+			},
+		}, false)
+
+		assert.Equal(t, bm25.Terms, explanation.Terms)
+		assert.Equal(t, &rerankerScore, explanation.RerankerScore)
+		// the explanation of the search result is not modified
+		assert.Nil(t, bm25.RerankerScore)
+	})
+}
@@ -0,0 +1,189 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/masking"
+)
+
+// checkSensitiveGet rejects Get queries which filter, sort, group, dedup or
+// search by keywords in sensitive properties the principal may not read, their
+// results would reveal the redacted values. So would module additional
+// properties, which are computed before the results are masked. Keyword
+// searches without explicit properties are restricted to the properties the
+// principal may read.
+func (t *Traverser) checkSensitiveGet(principal *models.Principal,
+	params *dto.GetParams,
+) error {
+	if t.masker.CanReadSensitive(principal) {
+		return nil
+	}
+
+	if err := t.masker.CheckFilter(principal, t.schemaGetter, params.Filters); err != nil {
+		return err
+	}
+
+	var sortBy []string
+	for _, sort := range params.Sort {
+		if len(sort.Path) > 0 {
+			sortBy = append(sortBy, sort.Path[0])
+		}
+	}
+	err := t.masker.CheckProperties(principal, t.schemaGetter, params.ClassName,
+		"for sorting", sortBy...)
+	if err != nil {
+		return err
+	}
+
+	if params.GroupBy != nil {
+		err := t.masker.CheckProperties(principal, t.schemaGetter, params.ClassName,
+			"for grouping", params.GroupBy.Property)
+		if err != nil {
+			return err
+		}
+	}
+
+	// collapsing the results by a property reveals which values are equal
+	err = t.masker.CheckProperties(principal, t.schemaGetter, params.ClassName,
+		"for deduplication", params.DedupBy...)
+	if err != nil {
+		return err
+	}
+
+	err = t.masker.CheckModuleAdditional(principal, t.schemaGetter, params.ClassName,
+		params.AdditionalProperties.ModuleParams)
+	if err != nil {
+		return err
+	}
+
+	if params.KeywordRanking != nil {
+		keyword := *params.KeywordRanking
+		keyword.Properties, err = t.readableKeywordProperties(principal,
+			params.ClassName, keyword.Properties)
+		if err != nil {
+			return err
+		}
+		params.KeywordRanking = &keyword
+	}
+	if params.HybridSearch != nil {
+		hybrid := *params.HybridSearch
+		hybrid.Properties, err = t.readableKeywordProperties(principal,
+			params.ClassName, hybrid.Properties)
+		if err != nil {
+			return err
+		}
+		params.HybridSearch = &hybrid
+	}
+
+	return nil
+}
+
+// readableKeywordProperties checks the properties of a keyword search. If
+// none are given, all searchable properties would be searched, these are
+// replaced by the searchable properties the principal may read.
+func (t *Traverser) readableKeywordProperties(principal *models.Principal,
+	className string, properties []string,
+) ([]string, error) {
+	if len(properties) > 0 {
+		names := make([]string, len(properties))
+		for i, name := range properties {
+			// properties of BM25 queries can be boosted, e.g. title^2
+			names[i] = strings.Split(name, "^")[0]
+		}
+		err := t.masker.CheckProperties(principal, t.schemaGetter, className,
+			"in keyword searches", names...)
+		return properties, err
+	}
+
+	sch := t.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(className))
+	if class == nil {
+		return properties, nil
+	}
+
+	sensitive := false
+	var readable []string
+	for _, prop := range class.Properties {
+		if !isTextProperty(prop) || !inverted.HasSearchableIndex(prop) {
+			continue
+		}
+		if masking.Restricted(prop) {
+			sensitive = true
+			continue
+		}
+		readable = append(readable, prop.Name)
+	}
+	if !sensitive {
+		return properties, nil
+	}
+	if len(readable) == 0 {
+		return nil, errors.Errorf("class '%s' has no searchable properties "+
+			"which are not sensitive", className)
+	}
+	return readable, nil
+}
+
+// checkSensitiveAggregate rejects aggregations which filter, group or
+// aggregate by sensitive properties the principal may not read
+func (t *Traverser) checkSensitiveAggregate(principal *models.Principal,
+	params *aggregation.Params,
+) error {
+	if t.masker.CanReadSensitive(principal) {
+		return nil
+	}
+
+	if err := t.masker.CheckFilter(principal, t.schemaGetter, params.Filters); err != nil {
+		return err
+	}
+
+	className := params.ClassName.String()
+	names := make([]string, len(params.Properties))
+	for i, prop := range params.Properties {
+		names[i] = prop.Name.String()
+	}
+	err := t.masker.CheckProperties(principal, t.schemaGetter, className,
+		"in aggregations", names...)
+	if err != nil {
+		return err
+	}
+
+	for path := params.GroupBy; path != nil; path = path.Child {
+		pathClass := path.Class.String()
+		if pathClass == "" {
+			pathClass = className
+		}
+		err := t.masker.CheckProperties(principal, t.schemaGetter, pathClass,
+			"for grouping", path.Property.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	if params.Hybrid != nil {
+		hybrid := *params.Hybrid
+		hybrid.Properties, err = t.readableKeywordProperties(principal,
+			className, hybrid.Properties)
+		if err != nil {
+			return err
+		}
+		params.Hybrid = &hybrid
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/masking"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type capturingExplorer struct {
+	fakeExplorer
+	params []dto.GetParams
+}
+
+func (f *capturingExplorer) GetClass(ctx context.Context, p dto.GetParams) ([]interface{}, error) {
+	f.params = append(f.params, p)
+	return nil, nil
+}
+
+func TestTraverser_SensitiveProperties(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	cfg := &config.WeaviateConfig{Config: config.Config{
+		Authorization: config.Authorization{
+			AdminList:     adminlist.Config{Enabled: true},
+			SensitiveData: masking.Config{Users: []string{"alice"}},
+		},
+	}}
+	schemaGetter := &fakeSchemaGetter{schema: schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{{
+			Class: "Patient",
+			Properties: []*models.Property{
+				{Name: "name", DataType: schema.DataTypeText.PropString()},
+				{Name: "notes", DataType: schema.DataTypeText.PropString()},
+				{Name: "ssn", DataType: schema.DataTypeText.PropString(), Sensitive: true},
+				{Name: "age", DataType: schema.DataTypeInt.PropString(), Sensitive: true},
+			},
+		}},
+	}}}
+	newTraverser := func() (*Traverser, *capturingExplorer) {
+		explorer := &capturingExplorer{}
+		return NewTraverser(cfg, &fakeLocks{}, logger, &fakeAuthorizer{},
+			&fakeVectorSearcher{}, explorer, schemaGetter, nil, nil, -1), explorer
+	}
+	bob := &models.Principal{Username: "bob"}
+	alice := &models.Principal{Username: "alice"}
+	bySSN := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorEqual,
+		On:       &filters.Path{Class: "Patient", Property: "ssn"},
+		Value:    &filters.Value{Value: "123-45-6789", Type: schema.DataTypeText},
+	}}
+
+	tests := []struct {
+		name        string
+		params      dto.GetParams
+		expectedErr string
+	}{
+		{
+			name:        "filter",
+			params:      dto.GetParams{Filters: bySSN},
+			expectedErr: "property 'ssn' of class 'Patient' is sensitive and can not be used in filters",
+		},
+		{
+			name: "nested filter on the length",
+			params: dto.GetParams{Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorOr,
+				Operands: []filters.Clause{{
+					Operator: filters.OperatorEqual,
+					On:       &filters.Path{Class: "Patient", Property: "len(ssn)"},
+					Value:    &filters.Value{Value: 11, Type: schema.DataTypeInt},
+				}},
+			}}},
+			expectedErr: "property 'len(ssn)' of class 'Patient' is sensitive and can not be used in filters",
+		},
+		{
+			name:        "sort",
+			params:      dto.GetParams{Sort: []filters.Sort{{Path: []string{"age"}, Order: "asc"}}},
+			expectedErr: "property 'age' of class 'Patient' is sensitive and can not be used for sorting",
+		},
+		{
+			name:        "group by",
+			params:      dto.GetParams{GroupBy: &searchparams.GroupBy{Property: "age"}},
+			expectedErr: "property 'age' of class 'Patient' is sensitive and can not be used for grouping",
+		},
+		{
+			name:        "dedup by",
+			params:      dto.GetParams{DedupBy: []string{"name", "ssn"}},
+			expectedErr: "property 'ssn' of class 'Patient' is sensitive and can not be used for deduplication",
+		},
+		{
+			name: "module additional properties",
+			params: dto.GetParams{AdditionalProperties: additional.Properties{
+				ModuleParams: map[string]interface{}{"generate": nil},
+			}},
+			expectedErr: "property 'ssn' of class 'Patient' is sensitive and can not be used by _additional { generate }",
+		},
+		{
+			name: "bm25 properties",
+			params: dto.GetParams{KeywordRanking: &searchparams.KeywordRanking{
+				Type: "bm25", Query: "123", Properties: []string{"name", "ssn^2"},
+			}},
+			expectedErr: "property 'ssn' of class 'Patient' is sensitive and can not be used in keyword searches",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := test.params
+			params.ClassName = "Patient"
+			params.Pagination = &filters.Pagination{Limit: 10}
+
+			traverser, explorer := newTraverser()
+			_, err := traverser.GetClass(context.Background(), bob, params)
+			assert.EqualError(t, err, test.expectedErr)
+			assert.Empty(t, explorer.params)
+
+			_, err = traverser.GetClass(context.Background(), alice, params)
+			require.Nil(t, err)
+			assert.Len(t, explorer.params, 1)
+		})
+	}
+
+	t.Run("keyword search without properties skips sensitive ones", func(t *testing.T) {
+		traverser, explorer := newTraverser()
+		keyword := &searchparams.KeywordRanking{Type: "bm25", Query: "123"}
+		params := dto.GetParams{
+			ClassName:      "Patient",
+			Pagination:     &filters.Pagination{Limit: 10},
+			KeywordRanking: keyword,
+		}
+
+		_, err := traverser.GetClass(context.Background(), bob, params)
+		require.Nil(t, err)
+		require.Len(t, explorer.params, 1)
+		assert.Equal(t, []string{"name", "notes"}, explorer.params[0].KeywordRanking.Properties)
+		assert.Empty(t, keyword.Properties)
+
+		_, err = traverser.GetClass(context.Background(), alice, params)
+		require.Nil(t, err)
+		require.Len(t, explorer.params, 2)
+		assert.Empty(t, explorer.params[1].KeywordRanking.Properties)
+	})
+
+	t.Run("aggregations", func(t *testing.T) {
+		traverser, _ := newTraverser()
+
+		_, err := traverser.Aggregate(context.Background(), bob, &aggregation.Params{
+			ClassName:  "Patient",
+			Properties: []aggregation.ParamProperty{{Name: "age"}},
+		})
+		assert.EqualError(t, err, "property 'age' of class 'Patient' "+
+			"is sensitive and can not be used in aggregations")
+
+		_, err = traverser.Aggregate(context.Background(), bob, &aggregation.Params{
+			ClassName: "Patient",
+			GroupBy:   &filters.Path{Class: "Patient", Property: "ssn"},
+		})
+		assert.EqualError(t, err, "property 'ssn' of class 'Patient' "+
+			"is sensitive and can not be used for grouping")
+
+		_, err = traverser.Aggregate(context.Background(), bob, &aggregation.Params{
+			ClassName: "Patient",
+			Filters:   bySSN,
+		})
+		assert.EqualError(t, err, "property 'ssn' of class 'Patient' "+
+			"is sensitive and can not be used in filters")
+	})
+}
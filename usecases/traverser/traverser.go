@@ -21,9 +21,15 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/admission"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/masking"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/offload"
+	"github.com/weaviate/weaviate/usecases/querycache"
+	"github.com/weaviate/weaviate/usecases/quota"
 	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/slowquery"
 )
 
 type locks interface {
@@ -47,6 +53,12 @@ type Traverser struct {
 	nearParamsVector *nearParamsVector
 	metrics          *Metrics
 	ratelimiter      *ratelimiter.Limiter
+	masker           *masking.Masker
+	quotas           *quota.Enforcer
+	offload          *offload.Manager
+	queryCache       *querycache.Cache
+	slowQueries      *slowquery.Log
+	admission        *admission.Controller
 }
 
 type VectorSearcher interface {
@@ -82,9 +94,45 @@ func NewTraverser(config *config.WeaviateConfig, locks locks,
 		nearParamsVector: newNearParamsVector(modulesProvider, vectorSearcher),
 		metrics:          metrics,
 		ratelimiter:      ratelimiter.New(maxGetRequests),
+		masker: masking.New(config.Config.Authorization.Enabled(),
+			config.Config.Authorization.SensitiveData),
 	}
 }
 
+// SetQuotas limits the requests per second of Get and Aggregate queries
+func (t *Traverser) SetQuotas(quotas *quota.Enforcer) {
+	t.quotas = quotas
+}
+
+// SetMaxConcurrentGetRequests changes the maximum of concurrent Get queries,
+// zero or less does not limit them
+func (t *Traverser) SetMaxConcurrentGetRequests(maxGetRequests int) {
+	t.ratelimiter.SetMax(maxGetRequests)
+}
+
+// SetTenantOffload activates offloaded tenants when they are queried
+func (t *Traverser) SetTenantOffload(offload *offload.Manager) {
+	t.offload = offload
+}
+
+// SetQueryCache answers repeated Get and Aggregate queries from the given
+// cache until one of their classes is written to
+func (t *Traverser) SetQueryCache(queryCache *querycache.Cache) {
+	t.queryCache = queryCache
+}
+
+// SetSlowQueryLog keeps the sampled Get and Aggregate queries which exceed
+// the threshold of the log
+func (t *Traverser) SetSlowQueryLog(slowQueries *slowquery.Log) {
+	t.slowQueries = slowQueries
+}
+
+// SetQueryAdmission limits the Get and Aggregate queries of each class which
+// run at the same time
+func (t *Traverser) SetQueryAdmission(admission *admission.Controller) {
+	t.admission = admission
+}
+
 // TraverserRepo describes the dependencies of the Traverser UC to the
 // connected database
 type TraverserRepo interface {
@@ -14,26 +14,95 @@ package traverser
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/tracing"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/querycache"
+	"github.com/weaviate/weaviate/usecases/slowquery"
 )
 
 // Aggregate resolves meta queries
 func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	params *aggregation.Params,
 ) (interface{}, error) {
+	done, err := t.admission.Admit(ctx, params.ClassName.String())
+	if err != nil {
+		return nil, err
+	}
+
 	t.metrics.QueriesAggregateInc(params.ClassName.String())
 	defer t.metrics.QueriesAggregateDec(params.ClassName.String())
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	ctx, span := tracing.Start(ctx, "traverser.Aggregate")
+	defer span.End()
+	span.SetAttribute("class", params.ClassName.String())
+
+	parent := ctx
+	ctx, cancel, _ := t.withQueryTimeout(ctx, params.ClassName.String(), params.Timeout, false)
+	defer cancel()
+
+	tracked := t.slowQueries.Sample()
+	before := time.Now()
+	res, err := t.aggregate(ctx, principal, params)
+	if err != nil {
+		err = queryTimeoutError(parent, ctx, params.ClassName.String(), err)
+	}
+	done(err)
+	if tracked {
+		t.slowQueries.Record(slowQueryEntry("aggregate", params.ClassName.String(), params.Tenant,
+			slowquery.AggregateParams(*params), nil, err), time.Since(before))
+	}
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (t *Traverser) aggregate(ctx context.Context, principal *models.Principal,
+	params *aggregation.Params,
+) (interface{}, error) {
+	params.Tenant = authorization.TenantFor(principal, params.Tenant)
+	err := t.authorizer.Authorize(principal, "get",
+		authorization.Objects(params.ClassName.String(), params.Tenant, ""))
 	if err != nil {
 		return nil, err
 	}
 
+	if err := t.checkSensitiveAggregate(principal, params); err != nil {
+		return nil, err
+	}
+
+	if err := t.quotas.Request(params.ClassName.String(), params.Tenant); err != nil {
+		return nil, err
+	}
+
+	if err := t.offload.Activate(ctx, params.ClassName.String(), params.Tenant); err != nil {
+		return nil, err
+	}
+
+	var (
+		cacheKey   string
+		cacheStamp querycache.Stamp
+		cacheable  bool
+	)
+	if t.queryCache != nil {
+		cacheKey, cacheable = aggregateQueryCacheKey(*params)
+	}
+	if cacheable {
+		cached, stamp, hit := t.queryCache.Get(ctx, cacheKey, aggregateQueryClasses(*params))
+		if hit {
+			return cached, nil
+		}
+		cacheStamp = stamp
+	}
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, enterrors.NewErrLockConnector(err)
@@ -84,5 +153,12 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
-	return inspector.WithTypes(res, *params)
+	typed, err := inspector.WithTypes(res, *params)
+	if err != nil {
+		return nil, err
+	}
+	if cacheable {
+		t.queryCache.Put(cacheKey, cacheStamp, typed)
+	}
+	return typed, nil
 }
@@ -0,0 +1,315 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"golang.org/x/sync/errgroup"
+)
+
+// rankings used to merge the results of the tenants
+const (
+	rankByDistance = "distance"
+	rankByScore    = "score"
+)
+
+// getClassAcrossTenants runs the query on every tenant and merges the results
+// by distance or score. Hybrid scores are normalized per tenant, so they are
+// only roughly comparable between tenants. Queries without a ranking are
+// merged in the order of the tenant names.
+func (t *Traverser) getClassAcrossTenants(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) ([]interface{}, error) {
+	if err := t.validateCrossTenantParams(params); err != nil {
+		return nil, err
+	}
+
+	tenants, offloaded, err := t.crossTenantTenants(principal, params)
+	if err != nil {
+		return nil, err
+	}
+	recordOffloadedTenants(ctx, params.ClassName, offloaded)
+
+	// offloaded tenants are only part of the list if they were named
+	return t.getClassOfTenants(ctx, principal, params, tenants, true, true)
+}
+
+// getClassOfTenants runs the query on the given tenants, which the principal
+// was authorized for, and merges their results. The results are annotated
+// with their tenant if annotate is set or the tenant was requested. Offloaded
+// tenants are only activated if activate is set, the callers which don't set
+// it must leave them out.
+func (t *Traverser) getClassOfTenants(ctx context.Context, principal *models.Principal,
+	params dto.GetParams, tenants []string, annotate, activate bool,
+) ([]interface{}, error) {
+	annotate = annotate || params.AdditionalProperties.Tenant
+	if err := t.quotas.Request(params.ClassName, ""); err != nil {
+		return nil, err
+	}
+
+	offset, limit := 0, filters.LimitFlagNotSet
+	if params.Pagination != nil {
+		offset, limit = params.Pagination.Offset, params.Pagination.Limit
+	}
+	if limit == filters.LimitFlagNotSet {
+		limit = int(t.config.Config.QueryDefaults.Limit)
+	}
+	perTenant := params
+	perTenant.Tenants = nil
+	perTenant.Pagination = &filters.Pagination{Limit: limit}
+	if limit >= 0 {
+		perTenant.Pagination.Limit = offset + limit
+	}
+
+	ranking := crossTenantRanking(params)
+	switch ranking {
+	case rankByDistance:
+		perTenant.AdditionalProperties.Distance = true
+	case rankByScore:
+		perTenant.AdditionalProperties.Score = true
+	}
+
+	certainty := ExtractCertaintyFromParams(params)
+	if certainty != 0 || params.AdditionalProperties.Certainty {
+		if err := t.validateGetDistanceParams(params); err != nil {
+			return nil, err
+		}
+	}
+
+	unlock, err := t.locks.LockConnector()
+	if err != nil {
+		return nil, enterrors.NewErrLockConnector(err)
+	}
+	defer unlock()
+
+	results := make([][]interface{}, len(tenants))
+	eg := new(errgroup.Group)
+	eg.SetLimit(2 * runtime.GOMAXPROCS(0))
+	for i, tenant := range tenants {
+		i, tenant := i, tenant
+		eg.Go(func() error {
+			params := perTenant
+			params.Tenant = tenant
+			if activate {
+				if err := t.offload.Activate(ctx, params.ClassName, tenant); err != nil {
+					return fmt.Errorf("tenant %q: %w", tenant, err)
+				}
+			}
+			res, err := t.explorer.GetClass(ctx, params)
+			if err != nil {
+				return fmt.Errorf("tenant %q: %w", tenant, err)
+			}
+			if annotate {
+				annotateTenant(res, tenant)
+			}
+			results[i] = res
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	var merged []interface{}
+	for _, res := range results {
+		merged = append(merged, res...)
+	}
+	sortCrossTenant(merged, ranking)
+
+	if offset >= len(merged) {
+		merged = merged[:0]
+	} else {
+		merged = merged[offset:]
+	}
+	if limit >= 0 && limit < len(merged) {
+		merged = merged[:limit]
+	}
+
+	stripRanking(merged, ranking, params)
+	t.masker.MaskGetResults(principal, t.schemaGetter, params.ClassName, merged)
+	return merged, nil
+}
+
+func (t *Traverser) validateCrossTenantParams(params dto.GetParams) error {
+	if !t.config.Config.QueryCrossTenantEnabled {
+		return fmt.Errorf("cross-tenant search is not enabled")
+	}
+	if params.Tenant != "" {
+		return fmt.Errorf("conflict: tenant and tenants arguments present, choose one")
+	}
+
+	if unsupported := unsupportedAcrossTenants(params); unsupported != "" {
+		return fmt.Errorf("%s is not supported by cross-tenant search", unsupported)
+	}
+	return nil
+}
+
+// unsupportedAcrossTenants is the argument of the query which can't be used
+// if the results of several tenants are merged, it is empty if there is none
+func unsupportedAcrossTenants(params dto.GetParams) string {
+	switch {
+	case params.Cursor != nil:
+		return "after"
+	case len(params.Sort) > 0:
+		return "sort"
+	case params.GroupBy != nil:
+		return "groupBy"
+	case params.Group != nil:
+		return "group"
+	case params.Pagination != nil && params.Pagination.Autocut > 0:
+		return "autocut"
+	default:
+		return ""
+	}
+}
+
+// crossTenantTenants authorizes the principal for every listed tenant, or for
+// all tenants if the wildcard is used. Only active tenants are searched for
+// the wildcard, the offloaded ones are returned separately so that they can
+// be reported as skipped. Listed tenants are activated if they were offloaded.
+func (t *Traverser) crossTenantTenants(principal *models.Principal,
+	params dto.GetParams,
+) ([]string, []string, error) {
+	all := false
+	for _, tenant := range params.Tenants {
+		if tenant == authorization.All {
+			all = true
+		}
+	}
+
+	if !all {
+		tenants := make([]string, 0, len(params.Tenants))
+		seen := make(map[string]struct{}, len(params.Tenants))
+		for _, tenant := range params.Tenants {
+			if _, ok := seen[tenant]; ok {
+				continue
+			}
+			seen[tenant] = struct{}{}
+			if err := t.authorizer.Authorize(principal, "get",
+				authorization.Objects(params.ClassName, tenant, "")); err != nil {
+				return nil, nil, err
+			}
+			tenants = append(tenants, tenant)
+		}
+		sort.Strings(tenants)
+		return tenants, nil, nil
+	}
+
+	if err := t.authorizer.Authorize(principal, "get",
+		authorization.Objects(params.ClassName, "", "")); err != nil {
+		return nil, nil, err
+	}
+	st := t.schemaGetter.CopyShardingState(params.ClassName)
+	if st == nil || !st.PartitioningEnabled {
+		return nil, nil, fmt.Errorf("multi-tenancy is not enabled for class %q", params.ClassName)
+	}
+	var tenants, offloaded []string
+	for name, physical := range st.Physical {
+		switch physical.ActivityStatus() {
+		case models.TenantActivityStatusHOT:
+			tenants = append(tenants, name)
+		case models.TenantActivityStatusFROZEN:
+			offloaded = append(offloaded, name)
+		}
+	}
+	sort.Strings(tenants)
+	sort.Strings(offloaded)
+	return tenants, offloaded, nil
+}
+
+// recordOffloadedTenants reports the offloaded tenants a query skipped next to
+// its results, activating all of them for a single query would undo the
+// offloading
+func recordOffloadedTenants(ctx context.Context, className string, tenants []string) {
+	if len(tenants) > 0 {
+		search.RecordPartialQuery(ctx, search.PartialQuery{Class: className, Offloaded: tenants})
+	}
+}
+
+func crossTenantRanking(params dto.GetParams) string {
+	switch {
+	case params.KeywordRanking != nil || params.HybridSearch != nil:
+		return rankByScore
+	case params.NearVector != nil || params.NearObject != nil || params.Recommend != nil ||
+		len(params.ModuleParams) > 0:
+		return rankByDistance
+	default:
+		return ""
+	}
+}
+
+// annotateTenant sets the tenant of every result in its additional properties
+func annotateTenant(results []interface{}, tenant string) {
+	for _, res := range results {
+		obj, ok := res.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		additional, ok := obj["_additional"].(map[string]interface{})
+		if !ok {
+			additional = map[string]interface{}{}
+			obj["_additional"] = additional
+		}
+		additional["tenant"] = tenant
+	}
+}
+
+func sortCrossTenant(results []interface{}, ranking string) {
+	if ranking == "" {
+		return
+	}
+
+	value := func(res interface{}) float32 {
+		obj, _ := res.(map[string]interface{})
+		additional, _ := obj["_additional"].(map[string]interface{})
+		v, _ := additional[ranking].(float32)
+		return v
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if ranking == rankByScore {
+			return value(results[i]) > value(results[j])
+		}
+		return value(results[i]) < value(results[j])
+	})
+}
+
+// stripRanking removes the distance or score from the results if it was only
+// added to merge them
+func stripRanking(results []interface{}, ranking string, params dto.GetParams) {
+	if ranking == "" ||
+		(ranking == rankByDistance && params.AdditionalProperties.Distance) ||
+		(ranking == rankByScore && params.AdditionalProperties.Score) {
+		return
+	}
+
+	for _, res := range results {
+		obj, ok := res.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		additional, ok := obj["_additional"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		delete(additional, ranking)
+	}
+}
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type tenantsExplorer struct {
+	fakeExplorer
+	sync.Mutex
+	distances map[string][]float32
+	limits    map[string]int
+}
+
+func (f *tenantsExplorer) GetClass(ctx context.Context, p dto.GetParams) ([]interface{}, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.limits[p.Tenant] = p.Pagination.Limit
+
+	var res []interface{}
+	for _, dist := range f.distances[p.Tenant] {
+		res = append(res, map[string]interface{}{
+			"_additional": map[string]interface{}{"distance": dist},
+		})
+	}
+	return res, nil
+}
+
+type tenantsAuthorizer struct {
+	denied string
+}
+
+func (f *tenantsAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if resource == f.denied {
+		return errors.New("forbidden")
+	}
+	return nil
+}
+
+func TestGetClassAcrossTenants(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	explorer := &tenantsExplorer{
+		distances: map[string][]float32{
+			"A": {0.1, 0.4},
+			"B": {0.2, 0.3, 0.5},
+		},
+		limits: map[string]int{},
+	}
+	authorizer := &tenantsAuthorizer{}
+	cfg := &config.WeaviateConfig{Config: config.Config{QueryCrossTenantEnabled: true}}
+	traverser := NewTraverser(cfg, &fakeLocks{}, logger, authorizer,
+		&fakeVectorSearcher{}, explorer, newFakeSchemaGetter("Article"), nil, nil, -1)
+
+	params := func() dto.GetParams {
+		return dto.GetParams{
+			ClassName:  "Article",
+			Tenants:    []string{"B", "A", "B"},
+			NearVector: &searchparams.NearVector{Vector: []float32{1, 2}},
+			Pagination: &filters.Pagination{Offset: 1, Limit: 3},
+		}
+	}
+	tenantsAndDistances := func(res []interface{}) ([]string, []interface{}) {
+		var tenants []string
+		var distances []interface{}
+		for _, r := range res {
+			additional := r.(map[string]interface{})["_additional"].(map[string]interface{})
+			tenants = append(tenants, additional["tenant"].(string))
+			if dist, ok := additional["distance"]; ok {
+				distances = append(distances, dist)
+			}
+		}
+		return tenants, distances
+	}
+
+	t.Run("merges by distance", func(t *testing.T) {
+		p := params()
+		p.AdditionalProperties.Distance = true
+		res, err := traverser.GetClass(context.Background(), nil, p)
+		require.Nil(t, err)
+
+		tenants, distances := tenantsAndDistances(res)
+		assert.Equal(t, []string{"B", "B", "A"}, tenants)
+		assert.Equal(t, []interface{}{float32(0.2), float32(0.3), float32(0.4)}, distances)
+		assert.Equal(t, map[string]int{"A": 4, "B": 4}, explorer.limits)
+	})
+
+	t.Run("removes the distance if it was not requested", func(t *testing.T) {
+		res, err := traverser.GetClass(context.Background(), nil, params())
+		require.Nil(t, err)
+
+		tenants, distances := tenantsAndDistances(res)
+		assert.Equal(t, []string{"B", "B", "A"}, tenants)
+		assert.Empty(t, distances)
+	})
+
+	t.Run("authorizes every tenant", func(t *testing.T) {
+		authorizer.denied = authorization.Objects("Article", "A", "")
+		defer func() { authorizer.denied = "" }()
+
+		_, err := traverser.GetClass(context.Background(), nil, params())
+		assert.ErrorContains(t, err, "forbidden")
+	})
+
+	t.Run("unsupported arguments", func(t *testing.T) {
+		p := params()
+		p.Tenant = "A"
+		_, err := traverser.GetClass(context.Background(), nil, p)
+		assert.ErrorContains(t, err, "conflict")
+
+		p = params()
+		p.Pagination.Autocut = 1
+		_, err = traverser.GetClass(context.Background(), nil, p)
+		assert.ErrorContains(t, err, "autocut is not supported")
+	})
+
+	t.Run("the wildcard skips offloaded tenants", func(t *testing.T) {
+		schemaGetter := &partitionsSchemaGetter{
+			fakeSchemaGetter: newFakeSchemaGetter("Article"),
+			state: &sharding.State{
+				PartitioningEnabled: true,
+				Physical: map[string]sharding.Physical{
+					"A": {Name: "A"},
+					"B": {Name: "B", Status: models.TenantActivityStatusFROZEN},
+					"C": {Name: "C", Status: models.TenantActivityStatusCOLD},
+				},
+			},
+		}
+		traverser := NewTraverser(cfg, &fakeLocks{}, logger, authorizer,
+			&fakeVectorSearcher{}, explorer, schemaGetter, nil, nil, -1)
+		explorer.limits = map[string]int{}
+
+		p := params()
+		p.Tenants = []string{authorization.All}
+		ctx, partial := search.WithPartialResults(context.Background())
+		res, err := traverser.GetClass(ctx, nil, p)
+		require.Nil(t, err)
+
+		tenants, _ := tenantsAndDistances(res)
+		assert.Equal(t, []string{"A"}, tenants)
+		assert.Equal(t, map[string]int{"A": 4}, explorer.limits)
+		assert.Equal(t, []search.PartialQuery{{Class: "Article", Offloaded: []string{"B"}}},
+			partial.Queries())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, authorizer,
+			&fakeVectorSearcher{}, explorer, newFakeSchemaGetter("Article"), nil, nil, -1)
+		_, err := traverser.GetClass(context.Background(), nil, params())
+		assert.ErrorContains(t, err, "not enabled")
+	})
+}
@@ -18,6 +18,10 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/tracing"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/querycache"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
@@ -35,15 +39,94 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 
 	defer t.ratelimiter.Dec()
 
+	done, err := t.admission.Admit(ctx, params.ClassName)
+	if err != nil {
+		return nil, err
+	}
+
 	t.metrics.QueriesGetInc(params.ClassName)
 	defer t.metrics.QueriesGetDec(params.ClassName)
 	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	ctx, span := tracing.Start(ctx, "traverser.GetClass")
+	defer span.End()
+	span.SetAttribute("class", params.ClassName)
+
+	parent := ctx
+	ctx, cancel, deadline := t.withQueryTimeout(ctx, params.ClassName,
+		params.Timeout, params.PartialResults)
+	defer cancel()
+	ctx = search.WithOversampling(ctx, params.Oversampling)
+	ctx, recordPlan := t.explainQuery(ctx, &params)
+
+	res, err := t.getClass(ctx, principal, params, deadline)
+	if err != nil {
+		err = queryTimeoutError(parent, ctx, params.ClassName, err)
+	}
+	done(err)
+	if err != nil {
+		recordPlan(err)
+		span.RecordError(err)
+		return nil, err
+	}
+	recordPartialResults(ctx, params.ClassName, deadline)
+	recordPlan(nil)
+	return res, nil
+}
+
+func (t *Traverser) getClass(ctx context.Context, principal *models.Principal,
+	params dto.GetParams, deadline *search.ShardDeadline,
+) ([]interface{}, error) {
+	if err := t.validateFacetsReadable(principal, params); err != nil {
+		return nil, err
+	}
+	if err := t.checkSensitiveGet(principal, &params); err != nil {
+		return nil, err
+	}
+
+	if len(params.Tenants) > 0 {
+		return t.getClassAcrossTenants(ctx, principal, params)
+	}
+
+	params.Tenant = authorization.TenantFor(principal, params.Tenant)
+	if params.Tenant == "" {
+		if class := t.partitionedClass(params.ClassName); class != nil {
+			return t.getClassOfPartitions(ctx, principal, params, class, deadline)
+		}
+	}
+
+	err := t.authorizer.Authorize(principal, "get",
+		authorization.Objects(params.ClassName, params.Tenant, ""))
 	if err != nil {
 		return nil, err
 	}
 
+	if err := t.quotas.Request(params.ClassName, params.Tenant); err != nil {
+		return nil, err
+	}
+
+	if err := t.offload.Activate(ctx, params.ClassName, params.Tenant); err != nil {
+		return nil, err
+	}
+
+	var (
+		cacheKey   string
+		cacheStamp querycache.Stamp
+		cacheable  bool
+	)
+	if t.queryCache != nil {
+		cacheKey, cacheable = getQueryCacheKey(params)
+	}
+	if cacheable {
+		cached, stamp, hit := t.queryCache.Get(ctx, cacheKey, getQueryClasses(params))
+		if hit {
+			res := copyResults(cached.([]interface{}))
+			t.masker.MaskGetResults(principal, t.schemaGetter, params.ClassName, res)
+			return res, nil
+		}
+		cacheStamp = stamp
+	}
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, enterrors.NewErrLockConnector(err)
@@ -60,5 +143,19 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		}
 	}
 
-	return t.explorer.GetClass(ctx, params)
+	res, err := t.explorer.GetClass(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if params.AdditionalProperties.Tenant && params.Tenant != "" {
+		annotateTenant(res, params.Tenant)
+	}
+	// partial results are not cached, the skipped shards might finish in time
+	// on the next attempt
+	if cacheable && len(deadline.Skipped()) == 0 {
+		t.queryCache.Put(cacheKey, cacheStamp, copyResults(res))
+	}
+
+	t.masker.MaskGetResults(principal, t.schemaGetter, params.ClassName, res)
+	return res, nil
 }
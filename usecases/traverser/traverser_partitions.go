@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// partitionedClass is the class if it is temporally partitioned, nil
+// otherwise
+func (t *Traverser) partitionedClass(className string) *models.Class {
+	sch := t.schemaGetter.GetSchemaSkipAuth()
+	class := sch.FindClassByName(schema.ClassName(className))
+	if !schema.TemporalPartitioningEnabled(class) {
+		return nil
+	}
+	return class
+}
+
+// getClassOfPartitions runs a query without a tenant on a temporally
+// partitioned class. Only the partitions overlapping the time range of the
+// filter on the partition property are searched. A query which matches a
+// single partition is run like a query on that tenant, otherwise the results
+// of the partitions are merged like the results of a cross-tenant search.
+func (t *Traverser) getClassOfPartitions(ctx context.Context, principal *models.Principal,
+	params dto.GetParams, class *models.Class, deadline *search.ShardDeadline,
+) ([]interface{}, error) {
+	partitions, offloaded := t.prunePartitions(class, params.Filters)
+	recordOffloadedTenants(ctx, params.ClassName, offloaded)
+	for _, partition := range partitions {
+		if err := t.authorizer.Authorize(principal, "get",
+			authorization.Objects(params.ClassName, partition, "")); err != nil {
+			return nil, err
+		}
+	}
+
+	switch len(partitions) {
+	case 0:
+		return []interface{}{}, nil
+	case 1:
+		params.Tenant = partitions[0]
+		return t.getClass(ctx, principal, params, deadline)
+	}
+
+	if unsupported := unsupportedAcrossTenants(params); unsupported != "" {
+		return nil, fmt.Errorf("%s is not supported by queries matching several "+
+			"partitions of class %q, set the tenant or narrow the filter on %q to a "+
+			"single partition", unsupported, class.Class, class.TemporalPartitioningConfig.Property)
+	}
+	return t.getClassOfTenants(ctx, principal, params, partitions, false, false)
+}
+
+// prunePartitions returns the active partitions of the class which may
+// contain objects matching the filter, and the offloaded ones which are
+// skipped. Tenants which are not named like partitions can't be pruned and
+// are always searched.
+func (t *Traverser) prunePartitions(class *models.Class,
+	filter *filters.LocalFilter,
+) (partitions, offloaded []string) {
+	st := t.schemaGetter.CopyShardingState(class.Class)
+	if st == nil {
+		return nil, nil
+	}
+
+	cfg := class.TemporalPartitioningConfig
+	bounds := unboundedTime()
+	if filter != nil && filter.Root != nil {
+		bounds = timeBoundsOf(filter.Root, cfg.Property)
+	}
+
+	partitions = make([]string, 0, len(st.Physical))
+	for name, physical := range st.Physical {
+		status := physical.ActivityStatus()
+		if status != models.TenantActivityStatusHOT && status != models.TenantActivityStatusFROZEN {
+			continue
+		}
+		start, end, ok := schema.TemporalPartitionRange(cfg, name)
+		if ok && !bounds.overlaps(start, end) {
+			continue
+		}
+		if status == models.TenantActivityStatusFROZEN {
+			offloaded = append(offloaded, name)
+			continue
+		}
+		partitions = append(partitions, name)
+	}
+	sort.Strings(partitions)
+	sort.Strings(offloaded)
+	return partitions, offloaded
+}
+
+// timeBounds is the inclusive time range a filter can match. It is
+// conservative, a filter may only match part of it.
+type timeBounds struct {
+	from, to       time.Time
+	hasFrom, hasTo bool
+	empty          bool
+}
+
+func unboundedTime() timeBounds {
+	return timeBounds{}
+}
+
+// overlaps is true if the range overlaps with [start, end)
+func (b timeBounds) overlaps(start, end time.Time) bool {
+	if b.empty {
+		return false
+	}
+	return (!b.hasFrom || b.from.Before(end)) && (!b.hasTo || !b.to.Before(start))
+}
+
+func (b timeBounds) intersect(other timeBounds) timeBounds {
+	if b.empty || other.empty {
+		return timeBounds{empty: true}
+	}
+	if other.hasFrom && (!b.hasFrom || other.from.After(b.from)) {
+		b.from, b.hasFrom = other.from, true
+	}
+	if other.hasTo && (!b.hasTo || other.to.Before(b.to)) {
+		b.to, b.hasTo = other.to, true
+	}
+	if b.hasFrom && b.hasTo && b.from.After(b.to) {
+		return timeBounds{empty: true}
+	}
+	return b
+}
+
+func (b timeBounds) union(other timeBounds) timeBounds {
+	if b.empty {
+		return other
+	}
+	if other.empty {
+		return b
+	}
+	if !other.hasFrom || (b.hasFrom && other.from.Before(b.from)) {
+		b.from, b.hasFrom = other.from, other.hasFrom
+	}
+	if !other.hasTo || (b.hasTo && other.to.After(b.to)) {
+		b.to, b.hasTo = other.to, other.hasTo
+	}
+	return b
+}
+
+// timeBoundsOf is the time range of the property a clause can match. Clauses
+// which don't restrict the property directly, e.g. negations or filters on
+// referenced objects, are unbounded.
+func timeBoundsOf(clause *filters.Clause, property string) timeBounds {
+	switch clause.Operator {
+	case filters.OperatorAnd:
+		bounds := unboundedTime()
+		for i := range clause.Operands {
+			bounds = bounds.intersect(timeBoundsOf(&clause.Operands[i], property))
+		}
+		return bounds
+	case filters.OperatorOr:
+		if len(clause.Operands) == 0 {
+			return unboundedTime()
+		}
+		bounds := timeBounds{empty: true}
+		for i := range clause.Operands {
+			bounds = bounds.union(timeBoundsOf(&clause.Operands[i], property))
+		}
+		return bounds
+	}
+
+	if clause.On == nil || clause.On.Child != nil ||
+		string(clause.On.Property) != property || clause.Value == nil {
+		return unboundedTime()
+	}
+	value, ok := timeValue(clause.Value.Value)
+	if !ok {
+		return unboundedTime()
+	}
+
+	switch clause.Operator {
+	case filters.OperatorEqual:
+		return timeBounds{from: value, to: value, hasFrom: true, hasTo: true}
+	case filters.OperatorGreaterThan, filters.OperatorGreaterThanEqual:
+		return timeBounds{from: value, hasFrom: true}
+	case filters.OperatorLessThan, filters.OperatorLessThanEqual:
+		return timeBounds{to: value, hasTo: true}
+	default:
+		return unboundedTime()
+	}
+}
+
+func timeValue(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		return t, err == nil
+	default:
+		return time.Time{}, false
+	}
+}
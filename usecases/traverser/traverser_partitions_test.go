@@ -0,0 +1,258 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type partitionsSchemaGetter struct {
+	*fakeSchemaGetter
+	state *sharding.State
+}
+
+func (f *partitionsSchemaGetter) CopyShardingState(class string) *sharding.State {
+	return f.state
+}
+
+func TestGetClassOfPartitions(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	explorer := &tenantsExplorer{
+		distances: map[string][]float32{
+			"2023-09": {0.3},
+			"2023-10": {0.1, 0.4},
+			"2023-11": {0.2},
+			"archive": {0.5},
+		},
+		limits: map[string]int{},
+	}
+	schemaGetter := &partitionsSchemaGetter{
+		fakeSchemaGetter: &fakeSchemaGetter{schema: schema.Schema{Objects: &models.Schema{
+			Classes: []*models.Class{{
+				Class:              "Log",
+				MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+				TemporalPartitioningConfig: &models.TemporalPartitioningConfig{
+					Property: "timestamp",
+					Interval: "month",
+				},
+			}},
+		}}},
+		state: &sharding.State{
+			PartitioningEnabled: true,
+			Physical: map[string]sharding.Physical{
+				"2023-07": {Name: "2023-07", Status: models.TenantActivityStatusFROZEN},
+				"2023-08": {Name: "2023-08", Status: models.TenantActivityStatusCOLD},
+				"2023-09": {Name: "2023-09"},
+				"2023-10": {Name: "2023-10"},
+				"2023-11": {Name: "2023-11"},
+				"archive": {Name: "archive"},
+			},
+		},
+	}
+	traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, &tenantsAuthorizer{},
+		&fakeVectorSearcher{}, explorer, schemaGetter, nil, nil, -1)
+
+	timestamp := func(op filters.Operator, value string) filters.Clause {
+		return filters.Clause{
+			Operator: op,
+			On:       &filters.Path{Class: "Log", Property: "timestamp"},
+			Value:    &filters.Value{Value: value, Type: schema.DataTypeDate},
+		}
+	}
+	params := func(clause *filters.Clause) dto.GetParams {
+		p := dto.GetParams{
+			ClassName:  "Log",
+			NearVector: &searchparams.NearVector{Vector: []float32{1, 2}},
+			Pagination: &filters.Pagination{Limit: 10},
+		}
+		if clause != nil {
+			p.Filters = &filters.LocalFilter{Root: clause}
+		}
+		return p
+	}
+	searched := func() []string {
+		var tenants []string
+		for tenant := range explorer.limits {
+			tenants = append(tenants, tenant)
+		}
+		explorer.limits = map[string]int{}
+		return tenants
+	}
+
+	t.Run("without filter all active partitions are searched", func(t *testing.T) {
+		res, err := traverser.GetClass(context.Background(), nil, params(nil))
+		require.Nil(t, err)
+		assert.Len(t, res, 5)
+		assert.ElementsMatch(t, []string{"2023-09", "2023-10", "2023-11", "archive"}, searched())
+	})
+
+	t.Run("offloaded partitions are skipped and reported", func(t *testing.T) {
+		ctx, partial := search.WithPartialResults(context.Background())
+		_, err := traverser.GetClass(ctx, nil, params(nil))
+		require.Nil(t, err)
+		assert.NotContains(t, searched(), "2023-07")
+		assert.Equal(t, []search.PartialQuery{{Class: "Log", Offloaded: []string{"2023-07"}}},
+			partial.Queries())
+
+		clause := timestamp(filters.OperatorGreaterThanEqual, "2023-09-01T00:00:00Z")
+		ctx, partial = search.WithPartialResults(context.Background())
+		_, err = traverser.GetClass(ctx, nil, params(&clause))
+		require.Nil(t, err)
+		searched()
+		assert.Empty(t, partial.Queries())
+	})
+
+	t.Run("a range within one partition", func(t *testing.T) {
+		clause := filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				timestamp(filters.OperatorGreaterThanEqual, "2023-10-03T00:00:00Z"),
+				timestamp(filters.OperatorLessThan, "2023-10-05T00:00:00Z"),
+			},
+		}
+		res, err := traverser.GetClass(context.Background(), nil, params(&clause))
+		require.Nil(t, err)
+		assert.Len(t, res, 3)
+		// tenants which are no partitions can't be pruned
+		assert.ElementsMatch(t, []string{"2023-10", "archive"}, searched())
+	})
+
+	t.Run("alternative ranges search the partitions between them", func(t *testing.T) {
+		clause := filters.Clause{
+			Operator: filters.OperatorOr,
+			Operands: []filters.Clause{
+				timestamp(filters.OperatorEqual, "2023-09-30T23:00:00Z"),
+				timestamp(filters.OperatorEqual, "2023-11-02T00:00:00Z"),
+			},
+		}
+		_, err := traverser.GetClass(context.Background(), nil, params(&clause))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []string{"2023-09", "2023-10", "2023-11", "archive"}, searched())
+	})
+
+	t.Run("a query matching a single partition supports all arguments", func(t *testing.T) {
+		archive := schemaGetter.state.Physical["archive"]
+		delete(schemaGetter.state.Physical, "archive")
+		defer func() { schemaGetter.state.Physical["archive"] = archive }()
+
+		clause := timestamp(filters.OperatorEqual, "2023-10-03T00:00:00Z")
+		p := params(&clause)
+		p.Pagination.Autocut = 1
+		res, err := traverser.GetClass(context.Background(), nil, p)
+		require.Nil(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, []string{"2023-10"}, searched())
+	})
+
+	t.Run("a range before all partitions", func(t *testing.T) {
+		clause := timestamp(filters.OperatorLessThan, "2023-01-01T00:00:00Z")
+		_, err := traverser.GetClass(context.Background(), nil, params(&clause))
+		require.Nil(t, err)
+		assert.Equal(t, []string{"archive"}, searched())
+	})
+
+	t.Run("unsupported arguments across partitions", func(t *testing.T) {
+		p := params(nil)
+		p.Sort = []filters.Sort{{Path: []string{"timestamp"}, Order: "desc"}}
+		_, err := traverser.GetClass(context.Background(), nil, p)
+		assert.ErrorContains(t, err, "sort is not supported by queries matching several partitions")
+	})
+}
+
+func TestTimeBoundsOf(t *testing.T) {
+	ts := func(day int) time.Time {
+		return time.Date(2023, 10, day, 0, 0, 0, 0, time.UTC)
+	}
+	clause := func(op filters.Operator, property string, day int) filters.Clause {
+		return filters.Clause{
+			Operator: op,
+			On:       &filters.Path{Class: "Log", Property: schema.PropertyName(property)},
+			Value:    &filters.Value{Value: ts(day).Format(time.RFC3339), Type: schema.DataTypeDate},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		clause   filters.Clause
+		expected timeBounds
+	}{
+		{
+			name:     "greater than",
+			clause:   clause(filters.OperatorGreaterThan, "timestamp", 3),
+			expected: timeBounds{from: ts(3), hasFrom: true},
+		},
+		{
+			name:     "other property",
+			clause:   clause(filters.OperatorGreaterThan, "createdAt", 3),
+			expected: timeBounds{},
+		},
+		{
+			name:     "not equal",
+			clause:   clause(filters.OperatorNotEqual, "timestamp", 3),
+			expected: timeBounds{},
+		},
+		{
+			name: "and narrows the range",
+			clause: filters.Clause{Operator: filters.OperatorAnd, Operands: []filters.Clause{
+				clause(filters.OperatorGreaterThan, "timestamp", 3),
+				clause(filters.OperatorGreaterThan, "timestamp", 5),
+				clause(filters.OperatorLessThan, "timestamp", 9),
+				clause(filters.OperatorEqual, "createdAt", 1),
+			}},
+			expected: timeBounds{from: ts(5), to: ts(9), hasFrom: true, hasTo: true},
+		},
+		{
+			name: "and of disjoint ranges",
+			clause: filters.Clause{Operator: filters.OperatorAnd, Operands: []filters.Clause{
+				clause(filters.OperatorGreaterThan, "timestamp", 9),
+				clause(filters.OperatorLessThan, "timestamp", 3),
+			}},
+			expected: timeBounds{empty: true},
+		},
+		{
+			name: "or widens the range",
+			clause: filters.Clause{Operator: filters.OperatorOr, Operands: []filters.Clause{
+				clause(filters.OperatorEqual, "timestamp", 3),
+				clause(filters.OperatorEqual, "timestamp", 9),
+			}},
+			expected: timeBounds{from: ts(3), to: ts(9), hasFrom: true, hasTo: true},
+		},
+		{
+			name: "or with an unbounded operand",
+			clause: filters.Clause{Operator: filters.OperatorOr, Operands: []filters.Clause{
+				clause(filters.OperatorEqual, "timestamp", 3),
+				clause(filters.OperatorEqual, "createdAt", 9),
+			}},
+			expected: timeBounds{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, timeBoundsOf(&tt.clause, "timestamp"))
+		})
+	}
+}
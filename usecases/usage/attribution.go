@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package usage
+
+import (
+	"context"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Attribution is who the module calls of a request are charged to. The user
+// is empty for anonymous requests, the API key id for users which did not
+// authenticate with a managed API key.
+type Attribution struct {
+	User     string
+	APIKeyID string
+	// Tenant of the request, used for module calls which are not specific to
+	// a tenant, e.g. vectorizing the input of a search
+	Tenant string
+}
+
+// FromPrincipal attributes the module calls to an authenticated principal,
+// the principal is nil for anonymous requests
+func FromPrincipal(principal *models.Principal, tenant string) Attribution {
+	if principal == nil {
+		return Attribution{Tenant: tenant}
+	}
+
+	return Attribution{
+		User:     principal.Username,
+		APIKeyID: principal.APIKeyID,
+		Tenant:   tenant,
+	}
+}
+
+type attributionKey struct{}
+
+// WithAttribution attributes the module calls made with the returned context.
+// resolve is called at most once, on the first module call, so that requests
+// which don't call any modules don't pay for authenticating them again.
+func WithAttribution(ctx context.Context, resolve func() Attribution) context.Context {
+	return context.WithValue(ctx, attributionKey{}, sync.OnceValue(resolve))
+}
+
+// WithPrincipal attributes the module calls made with the returned context to
+// an already authenticated principal
+func WithPrincipal(ctx context.Context, principal *models.Principal, tenant string) context.Context {
+	attribution := FromPrincipal(principal, tenant)
+	return context.WithValue(ctx, attributionKey{}, func() Attribution { return attribution })
+}
+
+// attributionFrom falls back to the principal which the GraphQL API puts into
+// the context
+func attributionFrom(ctx context.Context) Attribution {
+	if resolve, ok := ctx.Value(attributionKey{}).(func() Attribution); ok {
+		return resolve()
+	}
+	if principal, ok := ctx.Value("principal").(*models.Principal); ok {
+		return FromPrincipal(principal, "")
+	}
+	return Attribution{}
+}
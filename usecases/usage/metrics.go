@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package usage
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Metrics of the calls and tokens of the modules. Users, API keys and tenants
+// are left out of the labels to keep the cardinality low, their totals are
+// served by the usage API.
+type Metrics struct {
+	calls      *prometheus.CounterVec
+	tokens     *prometheus.CounterVec
+	classLabel func(string) string
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return nil
+	}
+
+	return &Metrics{
+		calls:      prom.ModuleCalls,
+		tokens:     prom.ModuleTokens,
+		classLabel: prom.ClassLabel,
+	}
+}
+
+func (m *Metrics) recorded(module, class string, input, output int64) {
+	if m == nil {
+		return
+	}
+
+	class = m.classLabel(class)
+	m.calls.With(prometheus.Labels{
+		"module":     module,
+		"class_name": class,
+	}).Inc()
+	m.tokens.With(prometheus.Labels{
+		"module":     module,
+		"class_name": class,
+		"direction":  "input",
+	}).Add(float64(input))
+	m.tokens.With(prometheus.Labels{
+		"module":     module,
+		"class_name": class,
+		"direction":  "output",
+	}).Add(float64(output))
+}
@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package usage accounts the calls of modules and the tokens they send to
+// and receive from their providers, per module, class, tenant, user and API
+// key, so that the costs of the providers can be charged back.
+//
+// The totals are kept in memory per node and count from the start of the
+// node, consumers compute the usage of a period from the difference of two
+// reports with the same start.
+package usage
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/tokens"
+)
+
+// Totals of the module calls of one module, class, tenant, user and API key
+type Totals struct {
+	Module       string `json:"module"`
+	Class        string `json:"class,omitempty"`
+	Tenant       string `json:"tenant,omitempty"`
+	User         string `json:"user,omitempty"`
+	APIKeyID     string `json:"apiKeyId,omitempty"`
+	Calls        int64  `json:"calls"`
+	InputTokens  int64  `json:"inputTokens"`
+	OutputTokens int64  `json:"outputTokens"`
+}
+
+type key struct {
+	module, class, tenant, user, apiKeyID string
+}
+
+// Filter selects the totals of a report, empty fields match everything
+type Filter struct {
+	Module   string
+	Class    string
+	Tenant   string
+	User     string
+	APIKeyID string
+}
+
+func (f Filter) matches(t *Totals) bool {
+	return (f.Module == "" || f.Module == t.Module) &&
+		(f.Class == "" || f.Class == t.Class) &&
+		(f.Tenant == "" || f.Tenant == t.Tenant) &&
+		(f.User == "" || f.User == t.User) &&
+		(f.APIKeyID == "" || f.APIKeyID == t.APIKeyID)
+}
+
+// Report of the totals since the start of the node
+type Report struct {
+	Since        time.Time `json:"since"`
+	Calls        int64     `json:"calls"`
+	InputTokens  int64     `json:"inputTokens"`
+	OutputTokens int64     `json:"outputTokens"`
+	Totals       []Totals  `json:"totals"`
+}
+
+// Tracker adds up the module calls, all methods of a nil tracker are no-ops
+type Tracker struct {
+	sync.Mutex
+	since   time.Time
+	totals  map[key]*Totals
+	metrics *Metrics
+}
+
+func NewTracker(metrics *Metrics) *Tracker {
+	return &Tracker{
+		since:   time.Now(),
+		totals:  map[key]*Totals{},
+		metrics: metrics,
+	}
+}
+
+// Record a call of a module for a class, attributed to the principal of the
+// context. The tenant of the request is used if the call is not specific to
+// a tenant.
+func (t *Tracker) Record(ctx context.Context, module, class, tenant string,
+	counts tokens.Counts,
+) {
+	if t == nil {
+		return
+	}
+
+	attribution := attributionFrom(ctx)
+	if tenant == "" {
+		tenant = attribution.Tenant
+	}
+	k := key{
+		module:   module,
+		class:    class,
+		tenant:   tenant,
+		user:     attribution.User,
+		apiKeyID: attribution.APIKeyID,
+	}
+
+	t.Lock()
+	totals, ok := t.totals[k]
+	if !ok {
+		totals = &Totals{
+			Module:   module,
+			Class:    class,
+			Tenant:   tenant,
+			User:     attribution.User,
+			APIKeyID: attribution.APIKeyID,
+		}
+		t.totals[k] = totals
+	}
+	totals.Calls++
+	totals.InputTokens += counts.Input
+	totals.OutputTokens += counts.Output
+	t.Unlock()
+
+	t.metrics.recorded(module, class, counts.Input, counts.Output)
+}
+
+// Report returns the totals matching the filter, sorted by module, class,
+// tenant, user and API key
+func (t *Tracker) Report(filter Filter) Report {
+	if t == nil {
+		return Report{Totals: []Totals{}}
+	}
+
+	t.Lock()
+	report := Report{Since: t.since, Totals: []Totals{}}
+	for _, totals := range t.totals {
+		if !filter.matches(totals) {
+			continue
+		}
+		report.Totals = append(report.Totals, *totals)
+		report.Calls += totals.Calls
+		report.InputTokens += totals.InputTokens
+		report.OutputTokens += totals.OutputTokens
+	}
+	t.Unlock()
+
+	sort.Slice(report.Totals, func(i, j int) bool {
+		a, b := report.Totals[i], report.Totals[j]
+		if a.Module != b.Module {
+			return a.Module < b.Module
+		}
+		if a.Class != b.Class {
+			return a.Class < b.Class
+		}
+		if a.Tenant != b.Tenant {
+			return a.Tenant < b.Tenant
+		}
+		if a.User != b.User {
+			return a.User < b.User
+		}
+		return a.APIKeyID < b.APIKeyID
+	})
+	return report
+}
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package usage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/tokens"
+)
+
+func TestTracker(t *testing.T) {
+	tr := NewTracker(nil)
+
+	alice := WithPrincipal(context.Background(),
+		&models.Principal{Username: "alice", APIKeyID: "key1"}, "")
+	resolved := 0
+	bob := WithAttribution(context.Background(), func() Attribution {
+		resolved++
+		return Attribution{User: "bob", Tenant: "tenant2"}
+	})
+
+	tr.Record(alice, "text2vec-openai", "Article", "tenant1", tokens.Counts{Input: 10})
+	tr.Record(alice, "text2vec-openai", "Article", "tenant1", tokens.Counts{Input: 5})
+	tr.Record(alice, "generative-openai", "Article", "tenant1", tokens.Counts{Input: 100, Output: 20})
+	tr.Record(bob, "text2vec-openai", "Article", "", tokens.Counts{Input: 7})
+	tr.Record(bob, "text2vec-openai", "Article", "", tokens.Counts{Input: 3})
+	tr.Record(context.Background(), "text2vec-openai", "Article", "", tokens.Counts{})
+
+	t.Run("attribution is resolved once", func(t *testing.T) {
+		assert.Equal(t, 1, resolved)
+	})
+
+	t.Run("all totals", func(t *testing.T) {
+		report := tr.Report(Filter{})
+		assert.Equal(t, int64(6), report.Calls)
+		assert.Equal(t, int64(125), report.InputTokens)
+		assert.Equal(t, int64(20), report.OutputTokens)
+		assert.Equal(t, []Totals{
+			{
+				Module: "generative-openai", Class: "Article", Tenant: "tenant1",
+				User: "alice", APIKeyID: "key1", Calls: 1, InputTokens: 100, OutputTokens: 20,
+			},
+			{Module: "text2vec-openai", Class: "Article", Calls: 1},
+			{
+				Module: "text2vec-openai", Class: "Article", Tenant: "tenant1",
+				User: "alice", APIKeyID: "key1", Calls: 2, InputTokens: 15,
+			},
+			{
+				Module: "text2vec-openai", Class: "Article", Tenant: "tenant2",
+				User: "bob", Calls: 2, InputTokens: 10,
+			},
+		}, report.Totals)
+	})
+
+	t.Run("filtered totals", func(t *testing.T) {
+		report := tr.Report(Filter{User: "alice", Module: "text2vec-openai"})
+		assert.Len(t, report.Totals, 1)
+		assert.Equal(t, int64(15), report.InputTokens)
+	})
+
+	t.Run("principal of the GraphQL API", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), "principal",
+			&models.Principal{Username: "carol"})
+		tr.Record(ctx, "qna-openai", "Article", "", tokens.Counts{Input: 1, Output: 1})
+
+		report := tr.Report(Filter{User: "carol"})
+		assert.Equal(t, int64(1), report.Calls)
+	})
+}
+
+func TestNilTracker(t *testing.T) {
+	var tr *Tracker
+	tr.Record(context.Background(), "text2vec-openai", "Article", "", tokens.Counts{Input: 1})
+	assert.Empty(t, tr.Report(Filter{}).Totals)
+}